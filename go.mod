@@ -7,7 +7,7 @@ require (
 	github.com/IBM-Cloud/bluemix-go v0.0.0-20210521083814-a0bd7732f494
 	github.com/IBM-Cloud/power-go-client v1.0.55
 	github.com/IBM/apigateway-go-sdk v0.0.0-20200414212859-416e5948678a
-	github.com/IBM/appconfiguration-go-admin-sdk v0.1.0 // indirect
+	github.com/IBM/appconfiguration-go-admin-sdk v0.1.0
 	github.com/IBM/container-registry-go-sdk v0.0.12
 	github.com/IBM/go-sdk-core v1.1.0
 	github.com/IBM/go-sdk-core/v3 v3.3.1
@@ -32,6 +32,7 @@ require (
 	github.com/dchest/safefile v0.0.0-20151022103144-855e8d98f185 // indirect
 	github.com/dgrijalva/jwt-go v3.2.0+incompatible
 	github.com/ghodss/yaml v1.0.0
+	github.com/go-openapi/runtime v0.19.24
 	github.com/go-openapi/strfmt v0.20.1
 	github.com/go-openapi/validate v0.20.1 // indirect
 	github.com/go-test/deep v1.0.4 // indirect
@@ -0,0 +1,137 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// APIError is the error of a request to an IBM Cloud API. It carries the service
+// and the operation which failed, the HTTP status and the transaction ID of the
+// request, so the error reported by terraform is enough to open a support ticket.
+type APIError struct {
+	// Service is the service of the API, named as in the provider endpoints block.
+	Service string
+	// Operation describes what the provider was doing, e.g. "Error creating VPC".
+	Operation string
+	// StatusCode is the HTTP status of the response, 0 when the request got none.
+	StatusCode int
+	// TransactionID identifies the request for the IBM Cloud support.
+	TransactionID string
+	// Code is the error code returned by the API, if any.
+	Code string
+	// Message is the error message returned by the API.
+	Message string
+	// Err is the error returned by the SDK of the service.
+	Err error
+}
+
+// transactionIDHeaders are the response headers carrying the transaction ID of a
+// request, by order of preference.
+var transactionIDHeaders = []string{"X-Request-Id", "Transaction-Id", "X-Correlation-Id", "X-Global-Transaction-Id"}
+
+// apiErrorf returns the error of a request to the API of the service, the operation
+// being formatted from format and args. The response is the one returned along the
+// error by the SDKs built on the IBM go-sdk-core, nil for the other SDKs.
+func apiErrorf(service string, err error, response *core.DetailedResponse, format string, args ...interface{}) error {
+	e := &APIError{
+		Service:   service,
+		Operation: fmt.Sprintf(format, args...),
+		Message:   err.Error(),
+		Err:       err,
+	}
+
+	var inner *APIError
+	var failure bmxerror.RequestFailure
+	switch {
+	case errors.As(err, &inner):
+		e.Service = inner.Service
+		e.StatusCode = inner.StatusCode
+		e.TransactionID = inner.TransactionID
+		e.Code = inner.Code
+		e.Message = inner.Operation + ": " + inner.Message
+	case errors.As(err, &failure):
+		e.StatusCode = failure.StatusCode()
+		e.Message = failure.Description()
+		if failure.Code() != "ServerErrorResponse" {
+			e.Code = failure.Code()
+		}
+		body := map[string]interface{}{}
+		if json.Unmarshal([]byte(failure.Description()), &body) == nil {
+			e.parseBody(body)
+		}
+	}
+
+	if response != nil {
+		e.StatusCode = response.StatusCode
+		for _, h := range transactionIDHeaders {
+			if id := response.Headers.Get(h); id != "" {
+				e.TransactionID = id
+				break
+			}
+		}
+		if body, ok := response.Result.(map[string]interface{}); ok {
+			e.parseBody(body)
+		}
+	}
+	e.Message = strings.TrimSpace(e.Message)
+	return e
+}
+
+// parseBody reads the message, code and transaction ID of the error body returned
+// by the API. The services report them with different fields.
+func (e *APIError) parseBody(body map[string]interface{}) {
+	first := func(values ...interface{}) string {
+		for _, v := range values {
+			if s, ok := v.(string); ok && s != "" {
+				return s
+			}
+		}
+		return ""
+	}
+	var item, context map[string]interface{}
+	if list, ok := body["errors"].([]interface{}); ok && len(list) > 0 {
+		item, _ = list[0].(map[string]interface{})
+	}
+	context, _ = body["context"].(map[string]interface{})
+
+	if message := first(item["message"], body["message"], body["errorMessage"], body["description"]); message != "" {
+		e.Message = message
+	}
+	if code := first(item["code"], body["error_code"], body["errorCode"], body["code"]); code != "" {
+		e.Code = code
+	}
+	if e.TransactionID == "" {
+		e.TransactionID = first(body["trace"], body["transaction_id"], body["incidentID"], context["transactionId"], context["requestId"])
+	}
+}
+
+func (e *APIError) Error() string {
+	var details []string
+	if e.StatusCode != 0 {
+		details = append(details, fmt.Sprintf("status %d %s", e.StatusCode, http.StatusText(e.StatusCode)))
+	}
+	if e.Code != "" {
+		details = append(details, "code "+e.Code)
+	}
+	if e.TransactionID != "" {
+		details = append(details, "transaction ID "+e.TransactionID)
+	}
+	message := fmt.Sprintf("%s: %s", e.Operation, e.Message)
+	if len(details) == 0 {
+		return message
+	}
+	return fmt.Sprintf("%s [%s API, %s]", message, e.Service, strings.Join(details, ", "))
+}
+
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
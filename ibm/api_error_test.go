@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"gotest.tools/assert"
+)
+
+func TestAPIErrorVPC(t *testing.T) {
+	sdkErr := errors.New("The VPC name is already in use")
+	response := &core.DetailedResponse{
+		StatusCode: 409,
+		Headers:    http.Header{"X-Request-Id": []string{"3b0e5a1c-req"}},
+		Result: map[string]interface{}{
+			"errors": []interface{}{map[string]interface{}{
+				"code":    "vpc_duplicate_name",
+				"message": "The VPC name test-vpc is already in use",
+			}},
+			"trace": "3b0e5a1c-trace",
+		},
+	}
+	err := apiErrorf("vpc", sdkErr, response, "Error creating VPC %s", "test-vpc")
+
+	var apiErr *APIError
+	assert.Assert(t, errors.As(err, &apiErr))
+	assert.Equal(t, apiErr.StatusCode, 409)
+	assert.Equal(t, apiErr.Code, "vpc_duplicate_name")
+	assert.Equal(t, apiErr.TransactionID, "3b0e5a1c-req")
+	assert.Assert(t, errors.Is(err, sdkErr))
+	assert.Equal(t, err.Error(), "Error creating VPC test-vpc: The VPC name test-vpc is already in use [vpc API, status 409 Conflict, code vpc_duplicate_name, transaction ID 3b0e5a1c-req]")
+}
+
+func TestAPIErrorResourceController(t *testing.T) {
+	response := &core.DetailedResponse{
+		StatusCode: 400,
+		Headers:    http.Header{},
+		Result: map[string]interface{}{
+			"message":        "Invalid plan",
+			"error_code":     "RC-BadRequest",
+			"transaction_id": "rc-transaction",
+		},
+	}
+	err := apiErrorf("resource_controller", errors.New("Bad Request"), response, "Error creating resource instance")
+	assert.Equal(t, err.Error(), "Error creating resource instance: Invalid plan [resource_controller API, status 400 Bad Request, code RC-BadRequest, transaction ID rc-transaction]")
+}
+
+func TestAPIErrorContainer(t *testing.T) {
+	body := `{"incidentID":"container-incident","code":"E0003","description":"The specified cluster could not be found.","type":"Authentication"}`
+	err := apiErrorf("container", bmxerror.NewRequestFailure("ServerErrorResponse", body, 404), nil, "Error retrieving cluster %s", "test")
+	assert.Equal(t, err.Error(), "Error retrieving cluster test: The specified cluster could not be found. [container API, status 404 Not Found, code E0003, transaction ID container-incident]")
+}
+
+func TestAPIErrorIAM(t *testing.T) {
+	body := `{"errorCode":"BXNIM0407","errorMessage":"API key not found","context":{"transactionId":"iam-transaction"}}`
+	err := apiErrorf("iam", bmxerror.NewRequestFailure("ServerErrorResponse", body, 404), nil, "Error retrieving API Key")
+	assert.Equal(t, err.Error(), "Error retrieving API Key: API key not found [iam API, status 404 Not Found, code BXNIM0407, transaction ID iam-transaction]")
+}
+
+func TestAPIErrorWrapped(t *testing.T) {
+	inner := apiErrorf("container", bmxerror.NewRequestFailure("E0003", "cluster not found", 404), nil, "Error retrieving cluster")
+	err := apiErrorf("container", fmt.Errorf("wait: %w", inner), nil, "Error waiting for cluster (%s) to become ready", "test")
+	assert.Equal(t, err.Error(), "Error waiting for cluster (test) to become ready: Error retrieving cluster: cluster not found [container API, status 404 Not Found, code E0003]")
+
+	err = apiErrorf("container", errors.New("timeout while waiting for state"), nil, "Error waiting for cluster (%s) to become ready", "test")
+	assert.Equal(t, err.Error(), "Error waiting for cluster (test) to become ready: timeout while waiting for state")
+}
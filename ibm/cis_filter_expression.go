@@ -0,0 +1,508 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// The types of the fields of the CIS filter expressions.
+const (
+	cisFilterString = "string"
+	cisFilterInt    = "integer"
+	cisFilterIP     = "IP address"
+	cisFilterBool   = "boolean"
+	cisFilterArray  = "array"
+)
+
+// cisFilterFields are the fields the CIS filter expressions can match on.
+var cisFilterFields = map[string]string{
+	"ip.src":                           cisFilterIP,
+	"ip.geoip.asnum":                   cisFilterInt,
+	"ip.geoip.country":                 cisFilterString,
+	"ip.geoip.continent":               cisFilterString,
+	"ip.geoip.is_in_european_union":    cisFilterBool,
+	"http.cookie":                      cisFilterString,
+	"http.host":                        cisFilterString,
+	"http.referer":                     cisFilterString,
+	"http.request.full_uri":            cisFilterString,
+	"http.request.method":              cisFilterString,
+	"http.request.uri":                 cisFilterString,
+	"http.request.uri.path":            cisFilterString,
+	"http.request.uri.query":           cisFilterString,
+	"http.request.version":             cisFilterString,
+	"http.user_agent":                  cisFilterString,
+	"http.x_forwarded_for":             cisFilterString,
+	"ssl":                              cisFilterBool,
+	"cf.threat_score":                  cisFilterInt,
+	"cf.client.bot":                    cisFilterBool,
+	"cf.edge.server_ip":                cisFilterIP,
+	"cf.edge.server_port":              cisFilterInt,
+	"cf.bot_management.verified_bot":   cisFilterBool,
+	"cf.bot_management.score":          cisFilterInt,
+	"cf.tls_client_auth.cert_verified": cisFilterBool,
+	"cf.waf.score":                     cisFilterInt,
+	"cf.waf.score.sqli":                cisFilterInt,
+	"cf.waf.score.xss":                 cisFilterInt,
+	"cf.waf.score.rce":                 cisFilterInt,
+}
+
+// cisFilterCollections are the map and array fields, with the type of their values
+// selected by an index such as http.request.headers["x-foo"][0].
+var cisFilterCollections = map[string]string{
+	"http.request.headers":            cisFilterString,
+	"http.request.headers.names":      cisFilterString,
+	"http.request.headers.values":     cisFilterString,
+	"http.request.uri.args":           cisFilterString,
+	"http.request.uri.args.names":     cisFilterString,
+	"http.request.uri.args.values":    cisFilterString,
+	"http.request.cookies":            cisFilterString,
+	"http.request.accepted_languages": cisFilterString,
+}
+
+// cisFilterFunctions maps the functions of the expressions to the type of their
+// result. The arguments of any and all are expressions, those of the others fields,
+// function calls or literals.
+var cisFilterFunctions = map[string]string{
+	"any":                 cisFilterBool,
+	"all":                 cisFilterBool,
+	"starts_with":         cisFilterBool,
+	"ends_with":           cisFilterBool,
+	"len":                 cisFilterInt,
+	"lookup_json_integer": cisFilterInt,
+	"lower":               cisFilterString,
+	"upper":               cisFilterString,
+	"concat":              cisFilterString,
+	"substring":           cisFilterString,
+	"to_string":           cisFilterString,
+	"url_decode":          cisFilterString,
+	"decode_base64":       cisFilterString,
+	"remove_bytes":        cisFilterString,
+	"regex_replace":       cisFilterString,
+	"wildcard_replace":    cisFilterString,
+	"lookup_json_string":  cisFilterString,
+}
+
+// cisFilterOperators maps the comparison operators, in their English and C-like
+// notations, to the field types they apply to.
+var cisFilterOperators = map[string][]string{
+	"eq":       {cisFilterString, cisFilterInt, cisFilterIP},
+	"ne":       {cisFilterString, cisFilterInt, cisFilterIP},
+	"lt":       {cisFilterString, cisFilterInt},
+	"le":       {cisFilterString, cisFilterInt},
+	"gt":       {cisFilterString, cisFilterInt},
+	"ge":       {cisFilterString, cisFilterInt},
+	"contains": {cisFilterString},
+	"matches":  {cisFilterString},
+	"in":       {cisFilterString, cisFilterInt, cisFilterIP},
+}
+
+var cisFilterOperatorSymbols = map[string]string{
+	"==": "eq", "!=": "ne", "<=": "le", ">=": "ge", "<": "lt", ">": "gt", "~": "matches",
+}
+
+// cisFilterParser checks the syntax of a filter expression of the Cloudflare rules
+// language, with recursive descent on the precedence of the logical operators: not,
+// then and, xor and or. The fields and functions it doesn't know are reported as
+// warnings, their comparisons being only checked for their syntax.
+type cisFilterParser struct {
+	expr     string
+	pos      int
+	warnings []string
+}
+
+// parseCISFilterExpression returns the warnings of a filter expression, such as an
+// unknown field, and an error describing its first syntax error, such as an operator
+// the type of a field doesn't support.
+func parseCISFilterExpression(expr string) ([]string, error) {
+	p := &cisFilterParser{expr: expr}
+	if p.skipSpaces(); p.pos == len(expr) {
+		return nil, fmt.Errorf("the filter expression is empty")
+	}
+	if err := p.parseLogical(0); err != nil {
+		return p.warnings, err
+	}
+	if p.skipSpaces(); p.pos < len(expr) {
+		return p.warnings, p.errorf("unexpected %q", p.rest(10))
+	}
+	return p.warnings, nil
+}
+
+func validateCISFilterExpression(v interface{}, k string) (ws []string, errors []error) {
+	warnings, err := parseCISFilterExpression(v.(string))
+	for _, w := range warnings {
+		ws = append(ws, fmt.Sprintf("%q: %s", k, w))
+	}
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid filter expression: %s", k, err))
+	}
+	return
+}
+
+func (p *cisFilterParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("%s at position %d", fmt.Sprintf(format, args...), p.pos+1)
+}
+
+func (p *cisFilterParser) warnf(pos int, format string, args ...interface{}) {
+	p.warnings = append(p.warnings, fmt.Sprintf("%s at position %d", fmt.Sprintf(format, args...), pos+1))
+}
+
+func (p *cisFilterParser) rest(max int) string {
+	rest := p.expr[p.pos:]
+	if len(rest) > max {
+		rest = rest[:max]
+	}
+	return rest
+}
+
+func (p *cisFilterParser) skipSpaces() {
+	for p.pos < len(p.expr) && strings.ContainsRune(" \t\r\n", rune(p.expr[p.pos])) {
+		p.pos++
+	}
+}
+
+// word returns the identifier or field name at the position, without consuming it.
+func (p *cisFilterParser) word() string {
+	end := p.pos
+	for end < len(p.expr) {
+		c := p.expr[end]
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '.') {
+			break
+		}
+		end++
+	}
+	return p.expr[p.pos:end]
+}
+
+// consume consumes one of the keywords or symbols at the position.
+func (p *cisFilterParser) consume(tokens ...string) bool {
+	p.skipSpaces()
+	for _, t := range tokens {
+		if t[0] >= 'a' && t[0] <= 'z' {
+			if strings.ToLower(p.word()) == t {
+				p.pos += len(t)
+				return true
+			}
+		} else if strings.HasPrefix(p.expr[p.pos:], t) {
+			p.pos += len(t)
+			return true
+		}
+	}
+	return false
+}
+
+// cisFilterLogical are the binary logical operators from the lowest precedence.
+var cisFilterLogical = [][]string{{"or", "||"}, {"xor", "^^"}, {"and", "&&"}}
+
+func (p *cisFilterParser) parseLogical(level int) error {
+	if level == len(cisFilterLogical) {
+		return p.parseUnary()
+	}
+	if err := p.parseLogical(level + 1); err != nil {
+		return err
+	}
+	for p.consume(cisFilterLogical[level]...) {
+		if err := p.parseLogical(level + 1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *cisFilterParser) parseUnary() error {
+	if p.consume("not", "!") {
+		return p.parseUnary()
+	}
+	p.skipSpaces()
+	if p.pos == len(p.expr) {
+		return p.errorf("the expression ends where a field or '(' is expected")
+	}
+	if p.expr[p.pos] == '(' {
+		p.pos++
+		if err := p.parseLogical(0); err != nil {
+			return err
+		}
+		if !p.consume(")") {
+			return p.errorf("missing ')'")
+		}
+		return nil
+	}
+	return p.parseComparison()
+}
+
+func (p *cisFilterParser) parseComparison() error {
+	start := p.pos
+	field, fieldType, err := p.parseOperand()
+	if err != nil {
+		return err
+	}
+	if fieldType == cisFilterArray {
+		p.pos = start
+		return p.errorf("the values of the map or array field %s are compared with an index, such as %s[0]", field, field)
+	}
+
+	p.skipSpaces()
+	operator := ""
+	for _, symbol := range []string{"==", "!=", "<=", ">=", "<", ">", "~"} {
+		if strings.HasPrefix(p.expr[p.pos:], symbol) {
+			operator = cisFilterOperatorSymbols[symbol]
+			p.pos += len(symbol)
+			break
+		}
+	}
+	if operator == "" {
+		word := strings.ToLower(p.word())
+		if _, ok := cisFilterOperators[word]; ok {
+			operator = word
+			p.pos += len(word)
+		}
+	}
+	switch {
+	case fieldType == cisFilterBool:
+		if operator != "" {
+			return p.errorf("the boolean %s takes no operator, use %s or not %s", cisFilterOperandKind(field), field, field)
+		}
+		return nil
+	case fieldType == "":
+		if operator == "" {
+			return nil
+		}
+	case operator == "":
+		return p.errorf("the %s %s must be compared with an operator", fieldType, cisFilterOperandKind(field))
+	default:
+		supported := false
+		for _, t := range cisFilterOperators[operator] {
+			supported = supported || t == fieldType
+		}
+		if !supported {
+			return p.errorf("the operator %s doesn't apply to the %s %s", operator, fieldType, cisFilterOperandKind(field))
+		}
+	}
+
+	p.skipSpaces()
+	if operator == "in" {
+		return p.parseSet(field, fieldType)
+	}
+	start = p.pos
+	value, err := p.parseValue(fieldType, false)
+	if err != nil {
+		return err
+	}
+	if operator == "matches" {
+		if _, err := regexp.Compile(value); err != nil {
+			p.pos = start
+			return p.errorf("invalid regular expression %q: %s", value, err)
+		}
+	}
+	return nil
+}
+
+// cisFilterKeywords are the logical operators, which can't be used as fields.
+var cisFilterKeywords = map[string]bool{"and": true, "or": true, "xor": true, "not": true}
+
+// parseOperand parses the field, with its indexes, or the function call a comparison
+// starts with, and returns its name, function calls being named such as lower(), and
+// the type of its value, empty when it isn't known.
+func (p *cisFilterParser) parseOperand() (string, string, error) {
+	start := p.pos
+	name := p.word()
+	if name == "" || cisFilterKeywords[strings.ToLower(name)] {
+		return "", "", p.errorf("unexpected %q where a field is expected", p.rest(10))
+	}
+	p.pos += len(name)
+	if p.pos < len(p.expr) && p.expr[p.pos] == '(' {
+		return p.parseFunction(name, start)
+	}
+
+	fieldType, scalar := cisFilterFields[name]
+	elementType, collection := cisFilterCollections[name]
+	if !scalar && !collection {
+		p.warnf(start, "unknown field %q, its comparison is only checked for its syntax", name)
+	}
+	indexed := false
+	for p.pos < len(p.expr) && p.expr[p.pos] == '[' {
+		if err := p.parseIndex(); err != nil {
+			return "", "", err
+		}
+		indexed = true
+	}
+	switch {
+	case collection && indexed:
+		return name, elementType, nil
+	case collection:
+		return name, cisFilterArray, nil
+	case scalar && indexed:
+		p.pos = start
+		return "", "", p.errorf("the field %s is not a map or an array and has no index", name)
+	}
+	return name, fieldType, nil
+}
+
+// cisFilterOperandKind describes a field or a function call for the messages.
+func cisFilterOperandKind(operand string) string {
+	if strings.HasSuffix(operand, "()") {
+		return "function " + operand
+	}
+	return "field " + operand
+}
+
+// parseIndex parses an index of a map or array field, a key string, a position or
+// the * wildcard of the arguments of the any and all functions.
+func (p *cisFilterParser) parseIndex() error {
+	p.pos++
+	p.skipSpaces()
+	switch {
+	case strings.HasPrefix(p.expr[p.pos:], "*"):
+		p.pos++
+	case strings.HasPrefix(p.expr[p.pos:], `"`):
+		if _, err := p.parseValue(cisFilterString, false); err != nil {
+			return err
+		}
+	default:
+		if _, err := p.parseValue(cisFilterInt, false); err != nil {
+			return err
+		}
+	}
+	if !p.consume("]") {
+		return p.errorf("missing ']'")
+	}
+	return nil
+}
+
+func (p *cisFilterParser) parseFunction(name string, start int) (string, string, error) {
+	resultType, ok := cisFilterFunctions[name]
+	if !ok {
+		p.warnf(start, "unknown function %q, its comparison is only checked for its syntax", name)
+	}
+	p.pos++
+	if p.consume(")") {
+		return name + "()", resultType, nil
+	}
+	for {
+		var err error
+		if name == "any" || name == "all" {
+			err = p.parseLogical(0)
+		} else {
+			err = p.parseArgument()
+		}
+		if err != nil {
+			return "", "", err
+		}
+		if p.consume(")") {
+			return name + "()", resultType, nil
+		}
+		if !p.consume(",") {
+			return "", "", p.errorf("missing ')' of the function %s", name)
+		}
+	}
+}
+
+// parseArgument parses an argument of a function: a quoted string, an integer, a field
+// or a function call.
+func (p *cisFilterParser) parseArgument() error {
+	p.skipSpaces()
+	if p.pos == len(p.expr) {
+		return p.errorf("the expression ends where a function argument is expected")
+	}
+	var err error
+	switch c := p.expr[p.pos]; {
+	case c == '"':
+		_, err = p.parseValue(cisFilterString, false)
+	case c == '-' || c >= '0' && c <= '9':
+		_, err = p.parseValue(cisFilterInt, false)
+	default:
+		_, _, err = p.parseOperand()
+	}
+	return err
+}
+
+func (p *cisFilterParser) parseSet(field, fieldType string) error {
+	if p.pos == len(p.expr) || p.expr[p.pos] != '{' {
+		return p.errorf("the in operator of %s takes a set of values in braces", field)
+	}
+	p.pos++
+	count := 0
+	for {
+		p.skipSpaces()
+		if p.pos == len(p.expr) {
+			return p.errorf("missing '}'")
+		}
+		if p.expr[p.pos] == '}' {
+			p.pos++
+			if count == 0 {
+				return p.errorf("the set of values of %s is empty", field)
+			}
+			return nil
+		}
+		if _, err := p.parseValue(fieldType, true); err != nil {
+			return err
+		}
+		count++
+	}
+}
+
+// parseValue parses a value of the field type, an integer range or a CIDR block
+// being only accepted in sets, and returns the content of the strings. The values of
+// an unknown type are either quoted strings or unquoted words.
+func (p *cisFilterParser) parseValue(fieldType string, inSet bool) (string, error) {
+	start := p.pos
+	quoted := p.pos < len(p.expr) && p.expr[p.pos] == '"'
+	if fieldType == cisFilterString || fieldType == "" && quoted {
+		if p.pos == len(p.expr) || p.expr[p.pos] != '"' {
+			return "", p.errorf("expected a quoted string")
+		}
+		var b strings.Builder
+		for p.pos++; p.pos < len(p.expr) && p.expr[p.pos] != '"'; p.pos++ {
+			if p.expr[p.pos] == '\\' {
+				if p.pos+1 == len(p.expr) || !strings.ContainsRune(`"\`, rune(p.expr[p.pos+1])) {
+					return "", p.errorf("invalid escape sequence in a string, only \\\" and \\\\ are supported")
+				}
+				p.pos++
+			}
+			b.WriteByte(p.expr[p.pos])
+		}
+		if p.pos == len(p.expr) {
+			p.pos = start
+			return "", p.errorf("unterminated string")
+		}
+		p.pos++
+		return b.String(), nil
+	}
+
+	for p.pos < len(p.expr) && !strings.ContainsRune(" \t\r\n(){}[],", rune(p.expr[p.pos])) {
+		p.pos++
+	}
+	value := p.expr[start:p.pos]
+	if value == "" {
+		return "", p.errorf("expected a value")
+	}
+	switch fieldType {
+	case cisFilterInt:
+		bounds := []string{value}
+		if inSet && strings.Contains(value, "..") {
+			bounds = strings.SplitN(value, "..", 2)
+		}
+		for _, bound := range bounds {
+			if _, err := strconv.ParseInt(bound, 10, 64); err != nil {
+				p.pos = start
+				return "", p.errorf("%q is not an integer", value)
+			}
+		}
+	case cisFilterIP:
+		valid := net.ParseIP(value) != nil
+		if !valid && inSet {
+			_, _, err := net.ParseCIDR(value)
+			valid = err == nil
+		}
+		if !valid {
+			p.pos = start
+			return "", p.errorf("%q is not an IP address", value)
+		}
+	}
+	return value, nil
+}
@@ -0,0 +1,133 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/firewallrulesv1"
+)
+
+// cisFirewallRuleRequestOptions describes a request to the CIS firewall rules API
+// sent by cisFirewallRuleRequest. The path parameters are escaped into the path,
+// such as /v1/{crn}/zones/{zone_identifier}/firewall/rules/{firewall_rule_identifier}.
+type cisFirewallRuleRequestOptions struct {
+	Method     string
+	Path       string
+	PathParams map[string]string
+	Body       interface{}
+}
+
+// cisFirewallRuleRequest sends a request to the CIS firewall rules API with the URL
+// of the client and the IAM token of the user, since the networking-go-sdk doesn't
+// set the priority of the firewall rules nor pause them on creation. The "result" of
+// the JSON response is decoded into result when it is not nil.
+func cisFirewallRuleRequest(sess *firewallrulesv1.FirewallRulesV1, token string, options cisFirewallRuleRequestOptions, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(options.Method)
+	builder.EnableGzipCompression = sess.GetEnableGzipCompression()
+	if _, err := builder.ResolveRequestURL(sess.Service.Options.URL, options.Path, options.PathParams); err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	builder.AddHeader("X-Auth-User-Token", token)
+	if options.Body != nil {
+		if _, err := builder.SetBodyContentJSON(options.Body); err != nil {
+			return nil, err
+		}
+		builder.AddHeader("Content-Type", "application/json")
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	envelope := &struct {
+		Result interface{} `json:"result"`
+	}{Result: result}
+	return sess.Service.Request(request, envelope)
+}
+
+// cisFirewallRuleFilter is the filter of a firewall rule, only its ID being sent.
+type cisFirewallRuleFilter struct {
+	ID          string `json:"id"`
+	Expression  string `json:"expression,omitempty"`
+	Paused      bool   `json:"paused,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// cisFirewallRule is a firewall rule applying its action to the requests matching its
+// filter, /v1/{crn}/zones/{zone_identifier}/firewall/rules/{firewall_rule_identifier}.
+// The rules with a priority are evaluated first, by increasing priority.
+type cisFirewallRule struct {
+	ID          string                `json:"id,omitempty"`
+	Filter      cisFirewallRuleFilter `json:"filter"`
+	Action      string                `json:"action"`
+	Priority    *int64                `json:"priority,omitempty"`
+	Paused      bool                  `json:"paused"`
+	Description string                `json:"description,omitempty"`
+	CreatedOn   string                `json:"created_on,omitempty"`
+	ModifiedOn  string                `json:"modified_on,omitempty"`
+}
+
+func cisFirewallRulePathParams(crn, zoneID, ruleID string) map[string]string {
+	params := map[string]string{"crn": crn, "zone_identifier": zoneID}
+	if ruleID != "" {
+		params["firewall_rule_identifier"] = ruleID
+	}
+	return params
+}
+
+func createCISFirewallRule(sess *firewallrulesv1.FirewallRulesV1, token, crn, zoneID string, rule cisFirewallRule) (*cisFirewallRule, *core.DetailedResponse, error) {
+	result := []cisFirewallRule{}
+	options := cisFirewallRuleRequestOptions{
+		Method:     core.POST,
+		Path:       "/v1/{crn}/zones/{zone_identifier}/firewall/rules",
+		PathParams: cisFirewallRulePathParams(crn, zoneID, ""),
+		Body:       []cisFirewallRule{rule},
+	}
+	response, err := cisFirewallRuleRequest(sess, token, options, &result)
+	if err != nil {
+		return nil, response, err
+	}
+	if len(result) == 0 {
+		return nil, response, nil
+	}
+	return &result[0], response, nil
+}
+
+func getCISFirewallRule(sess *firewallrulesv1.FirewallRulesV1, token, crn, zoneID, ruleID string) (*cisFirewallRule, *core.DetailedResponse, error) {
+	result := &cisFirewallRule{}
+	options := cisFirewallRuleRequestOptions{
+		Method:     core.GET,
+		Path:       "/v1/{crn}/zones/{zone_identifier}/firewall/rules/{firewall_rule_identifier}",
+		PathParams: cisFirewallRulePathParams(crn, zoneID, ruleID),
+	}
+	response, err := cisFirewallRuleRequest(sess, token, options, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+func updateCISFirewallRule(sess *firewallrulesv1.FirewallRulesV1, token, crn, zoneID string, rule cisFirewallRule) (*cisFirewallRule, *core.DetailedResponse, error) {
+	result := &cisFirewallRule{}
+	options := cisFirewallRuleRequestOptions{
+		Method:     core.PUT,
+		Path:       "/v1/{crn}/zones/{zone_identifier}/firewall/rules/{firewall_rule_identifier}",
+		PathParams: cisFirewallRulePathParams(crn, zoneID, rule.ID),
+		Body:       rule,
+	}
+	response, err := cisFirewallRuleRequest(sess, token, options, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+func deleteCISFirewallRule(sess *firewallrulesv1.FirewallRulesV1, token, crn, zoneID, ruleID string) (*core.DetailedResponse, error) {
+	options := cisFirewallRuleRequestOptions{
+		Method:     core.DELETE,
+		Path:       "/v1/{crn}/zones/{zone_identifier}/firewall/rules/{firewall_rule_identifier}",
+		PathParams: cisFirewallRulePathParams(crn, zoneID, ruleID),
+	}
+	return cisFirewallRuleRequest(sess, token, options, nil)
+}
@@ -0,0 +1,507 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+)
+
+// cisZoneFileRecordTypes are the record types managed by ibm_cis_dns_zone_file. The
+// SOA record of a zone file is ignored, CIS serves its own.
+var cisZoneFileRecordTypes = []string{
+	cisDNSRecordTypeA,
+	cisDNSRecordTypeAAAA,
+	cisDNSRecordTypeCNAME,
+	cisDNSRecordTypeMX,
+	cisDNSRecordTypeTXT,
+	cisDNSRecordTypeSPF,
+	cisDNSRecordTypeNS,
+	cisDNSRecordTypePTR,
+	cisDNSRecordTypeSRV,
+	cisDNSRecordTypeCAA,
+}
+
+// cisZoneFileProxiedTag is the comment CIS adds to the exported proxied records.
+const cisZoneFileProxiedTag = "cf-proxied:true"
+
+// cisZoneRecord is a DNS record of a zone file or of CIS in a canonical form: the
+// names are lowercase and fully qualified without the trailing dot, the content of
+// the MX and SRV records excludes their priority and the content of the TXT records
+// is unquoted. Proxied records always have the automatic TTL of 1.
+type cisZoneRecord struct {
+	ID       string
+	Name     string
+	Type     string
+	Content  string
+	Priority int64
+	TTL      int64
+	Proxied  bool
+}
+
+// key identifies the record in a zone, regardless of its TTL and proxy status.
+func (r cisZoneRecord) key() string {
+	return fmt.Sprintf("%s %s %d %s", r.Name, r.Type, r.Priority, r.Content)
+}
+
+func (r cisZoneRecord) String() string {
+	return fmt.Sprintf("%s %d %t", r.key(), r.TTL, r.Proxied)
+}
+
+func isCISZoneFileRecordType(recordType string) bool {
+	for _, t := range cisZoneFileRecordTypes {
+		if t == recordType {
+			return true
+		}
+	}
+	return false
+}
+
+// parseCISZoneFile parses a BIND zone file into the records it defines. The names
+// are relative to the zone unless a $ORIGIN directive sets another origin, the TTL
+// of the records without one is the $TTL of the file, or automatic when it has none.
+func parseCISZoneFile(content, zoneName string) ([]cisZoneRecord, error) {
+	origin := strings.ToLower(strings.TrimSuffix(zoneName, "."))
+	defaultTTL := int64(1)
+	owner := ""
+	records := []cisZoneRecord{}
+
+	lines, err := cisZoneFileLines(content)
+	if err != nil {
+		return nil, err
+	}
+	for _, line := range lines {
+		tokens := line.tokens
+		if tokens[0].text == "$ORIGIN" || tokens[0].text == "$TTL" {
+			if len(tokens) != 2 {
+				return nil, fmt.Errorf("line %d: %s takes one argument", line.number, tokens[0].text)
+			}
+			if tokens[0].text == "$ORIGIN" {
+				if origin, err = cisZoneFileName(tokens[1].text, origin); err != nil {
+					return nil, fmt.Errorf("line %d: %s", line.number, err)
+				}
+			} else if defaultTTL, err = parseCISZoneFileTTL(tokens[1].text); err != nil {
+				return nil, fmt.Errorf("line %d: %s", line.number, err)
+			}
+			continue
+		}
+		if strings.HasPrefix(tokens[0].text, "$") {
+			return nil, fmt.Errorf("line %d: the %s directive is not supported", line.number, tokens[0].text)
+		}
+
+		if !line.continued {
+			if owner, err = cisZoneFileName(tokens[0].text, origin); err != nil {
+				return nil, fmt.Errorf("line %d: %s", line.number, err)
+			}
+			tokens = tokens[1:]
+		} else if owner == "" {
+			return nil, fmt.Errorf("line %d: the record has no owner name", line.number)
+		}
+
+		ttl := defaultTTL
+	fields:
+		for len(tokens) > 0 {
+			switch t := strings.ToUpper(tokens[0].text); {
+			case t == "IN":
+			case t == "CH" || t == "HS":
+				return nil, fmt.Errorf("line %d: only the IN class is supported", line.number)
+			case t[0] >= '0' && t[0] <= '9':
+				if ttl, err = parseCISZoneFileTTL(t); err != nil {
+					return nil, fmt.Errorf("line %d: %s", line.number, err)
+				}
+			default:
+				break fields
+			}
+			tokens = tokens[1:]
+		}
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("line %d: the record has no type", line.number)
+		}
+
+		record := cisZoneRecord{
+			Name:    owner,
+			Type:    strings.ToUpper(tokens[0].text),
+			TTL:     ttl,
+			Proxied: strings.Contains(line.comment, cisZoneFileProxiedTag),
+		}
+		if record.Type == "SOA" {
+			continue
+		}
+		if !isCISZoneFileRecordType(record.Type) {
+			return nil, fmt.Errorf("line %d: the %s records are not supported, the supported types are %s", line.number, record.Type, strings.Join(cisZoneFileRecordTypes, ", "))
+		}
+		if err := record.parseData(tokens[1:], origin); err != nil {
+			return nil, fmt.Errorf("line %d: %s", line.number, err)
+		}
+		if record.Proxied {
+			if record.Type != cisDNSRecordTypeA && record.Type != cisDNSRecordTypeAAAA && record.Type != cisDNSRecordTypeCNAME {
+				return nil, fmt.Errorf("line %d: only the A, AAAA and CNAME records can be proxied", line.number)
+			}
+			record.TTL = 1
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// parseData sets the content and priority of the record from its data fields.
+func (r *cisZoneRecord) parseData(tokens []cisZoneFileToken, origin string) error {
+	want := map[string]int{
+		cisDNSRecordTypeMX:  2,
+		cisDNSRecordTypeSRV: 4,
+		cisDNSRecordTypeCAA: 3,
+	}[r.Type]
+	if want == 0 && r.Type != cisDNSRecordTypeTXT && r.Type != cisDNSRecordTypeSPF {
+		want = 1
+	}
+	if want != 0 && len(tokens) != want {
+		return fmt.Errorf("the %s records take %d data fields, not %d", r.Type, want, len(tokens))
+	}
+	if len(tokens) == 0 {
+		return fmt.Errorf("the %s record has no data", r.Type)
+	}
+
+	var err error
+	switch r.Type {
+	case cisDNSRecordTypeA, cisDNSRecordTypeAAAA:
+		ip := net.ParseIP(tokens[0].text)
+		if ip == nil || (ip.To4() != nil) != (r.Type == cisDNSRecordTypeA) {
+			return fmt.Errorf("%s is not a valid address for an %s record", tokens[0].text, r.Type)
+		}
+		r.Content = ip.String()
+	case cisDNSRecordTypeCNAME, cisDNSRecordTypeNS, cisDNSRecordTypePTR:
+		r.Content, err = cisZoneFileName(tokens[0].text, origin)
+	case cisDNSRecordTypeTXT, cisDNSRecordTypeSPF:
+		// The character strings of a record are concatenated, as DNS clients do
+		// with the strings of a TXT record longer than 255 characters.
+		parts := make([]string, len(tokens))
+		quoted := false
+		for i, t := range tokens {
+			parts[i] = t.text
+			quoted = quoted || t.quoted
+		}
+		if quoted {
+			r.Content = strings.Join(parts, "")
+		} else {
+			r.Content = strings.Join(parts, " ")
+		}
+	case cisDNSRecordTypeMX:
+		if r.Priority, err = strconv.ParseInt(tokens[0].text, 10, 64); err != nil {
+			return fmt.Errorf("%s is not a valid MX priority", tokens[0].text)
+		}
+		r.Content, err = cisZoneFileName(tokens[1].text, origin)
+	case cisDNSRecordTypeSRV:
+		if err = cisValidateSRVName(r.Name); err != nil {
+			return err
+		}
+		values := make([]int64, 3)
+		for i, t := range tokens[:3] {
+			if values[i], err = strconv.ParseInt(t.text, 10, 64); err != nil {
+				return fmt.Errorf("%s is not a valid number in an SRV record", t.text)
+			}
+		}
+		target, err := cisZoneFileName(tokens[3].text, origin)
+		if err != nil {
+			return err
+		}
+		r.Priority = values[0]
+		r.Content = fmt.Sprintf("%d %d %s", values[1], values[2], target)
+	case cisDNSRecordTypeCAA:
+		flags, err := strconv.ParseInt(tokens[0].text, 10, 64)
+		if err != nil {
+			return fmt.Errorf("%s is not valid CAA flags", tokens[0].text)
+		}
+		r.Content = fmt.Sprintf("%d %s \"%s\"", flags, strings.ToLower(tokens[1].text), tokens[2].text)
+	}
+	return err
+}
+
+// cisValidateSRVName checks that the name of an SRV record starts with the service
+// and protocol labels, as in _sip._tcp.example.com.
+func cisValidateSRVName(name string) error {
+	labels := strings.SplitN(name, ".", 3)
+	if len(labels) != 3 || !strings.HasPrefix(labels[0], "_") || !strings.HasPrefix(labels[1], "_") {
+		return fmt.Errorf("the name %s of the SRV record must be _<service>._<protocol>.<name>", name)
+	}
+	return nil
+}
+
+// cisZoneFileName returns the canonical form of a name of the zone file, relative to
+// the origin unless it ends with a dot.
+func cisZoneFileName(name, origin string) (string, error) {
+	name = strings.ToLower(name)
+	switch {
+	case name == "@":
+		name = origin
+	case strings.HasSuffix(name, "."):
+		name = strings.TrimSuffix(name, ".")
+	case origin == "":
+		return "", fmt.Errorf("the relative name %s has no origin", name)
+	default:
+		name = name + "." + origin
+	}
+	if name == "" {
+		return "", fmt.Errorf("the root zone is not a valid name")
+	}
+	return name, nil
+}
+
+// parseCISZoneFileTTL parses a TTL in seconds or with the BIND units, such as 1h30m.
+func parseCISZoneFileTTL(value string) (int64, error) {
+	units := map[byte]int64{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	ttl, number, digits := int64(0), int64(0), 0
+	for i := 0; i < len(value); i++ {
+		c := value[i] | 0x20
+		switch {
+		case value[i] >= '0' && value[i] <= '9':
+			number = number*10 + int64(value[i]-'0')
+			digits++
+		case units[c] != 0 && digits > 0:
+			ttl += number * units[c]
+			number, digits = 0, 0
+		default:
+			return 0, fmt.Errorf("%s is not a valid TTL", value)
+		}
+	}
+	if digits == 0 && ttl == 0 {
+		return 0, fmt.Errorf("%s is not a valid TTL", value)
+	}
+	return ttl + number, nil
+}
+
+type cisZoneFileToken struct {
+	text   string
+	quoted bool
+}
+
+// cisZoneFileLine is a logical line of a zone file, with the lines in parentheses
+// joined. It is continued when it starts with a blank, the record then belongs to
+// the owner of the previous record.
+type cisZoneFileLine struct {
+	number    int
+	continued bool
+	tokens    []cisZoneFileToken
+	comment   string
+}
+
+// cisZoneFileLines splits a zone file into its logical lines, skipping the blank
+// and comment lines.
+func cisZoneFileLines(content string) ([]cisZoneFileLine, error) {
+	lines := []cisZoneFileLine{}
+	var current *cisZoneFileLine
+	depth := 0
+	for i, text := range strings.Split(strings.Replace(content, "\r\n", "\n", -1), "\n") {
+		if depth == 0 {
+			current = &cisZoneFileLine{number: i + 1, continued: len(text) > 0 && (text[0] == ' ' || text[0] == '\t')}
+		}
+		for j := 0; j < len(text); j++ {
+			switch c := text[j]; {
+			case c == ' ' || c == '\t':
+			case c == ';':
+				current.comment += text[j+1:]
+				j = len(text)
+			case c == '(':
+				depth++
+			case c == ')':
+				if depth == 0 {
+					return nil, fmt.Errorf("line %d: unbalanced parentheses", i+1)
+				}
+				depth--
+			case c == '"':
+				var b strings.Builder
+				for j++; j < len(text) && text[j] != '"'; j++ {
+					if text[j] == '\\' && j+1 < len(text) {
+						j++
+					}
+					b.WriteByte(text[j])
+				}
+				if j == len(text) {
+					return nil, fmt.Errorf("line %d: unterminated quoted string", i+1)
+				}
+				current.tokens = append(current.tokens, cisZoneFileToken{text: b.String(), quoted: true})
+			default:
+				start := j
+				for j < len(text) && !strings.ContainsRune(" \t;()\"", rune(text[j])) {
+					j++
+				}
+				current.tokens = append(current.tokens, cisZoneFileToken{text: text[start:j]})
+				j--
+			}
+		}
+		if depth == 0 && len(current.tokens) > 0 {
+			lines = append(lines, *current)
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", current.number)
+	}
+	return lines, nil
+}
+
+// renderCISZoneFile writes the records as a zone file of the zone, sorted so the same
+// records always give the same file.
+func renderCISZoneFile(records []cisZoneRecord, zoneName string) string {
+	sorted := append([]cisZoneRecord{}, records...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].String() < sorted[j].String() })
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "$ORIGIN %s.\n", strings.TrimSuffix(zoneName, "."))
+	for _, r := range sorted {
+		var data string
+		switch r.Type {
+		case cisDNSRecordTypeCNAME, cisDNSRecordTypeNS, cisDNSRecordTypePTR:
+			data = r.Content + "."
+		case cisDNSRecordTypeMX:
+			data = fmt.Sprintf("%d %s.", r.Priority, r.Content)
+		case cisDNSRecordTypeSRV:
+			data = fmt.Sprintf("%d %s.", r.Priority, r.Content)
+		case cisDNSRecordTypeTXT, cisDNSRecordTypeSPF:
+			data = quoteCISZoneFileText(r.Content)
+		default:
+			data = r.Content
+		}
+		fmt.Fprintf(&b, "%s.\t%d\tIN\t%s\t%s", r.Name, r.TTL, r.Type, data)
+		if r.Proxied {
+			fmt.Fprintf(&b, " ; cf_tags=%s", cisZoneFileProxiedTag)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// quoteCISZoneFileText quotes a text in character strings of at most 255 bytes.
+func quoteCISZoneFileText(text string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	parts := []string{}
+	for len(text) > 255 {
+		parts = append(parts, `"`+escaped.Replace(text[:255])+`"`)
+		text = text[255:]
+	}
+	parts = append(parts, `"`+escaped.Replace(text)+`"`)
+	return strings.Join(parts, " ")
+}
+
+// equalCISZoneRecords reports whether two sets of records are the same, regardless of
+// their order and IDs.
+func equalCISZoneRecords(a, b []cisZoneRecord) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	as, bs := make([]string, len(a)), make([]string, len(b))
+	for i := range a {
+		as[i], bs[i] = a[i].String(), b[i].String()
+	}
+	sort.Strings(as)
+	sort.Strings(bs)
+	for i := range as {
+		if as[i] != bs[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// cisZoneRecordFromDetails returns the canonical form of a CIS record. The SRV and
+// CAA records are read from their data, which holds the fields of their content.
+func cisZoneRecordFromDetails(details dnsrecordsv1.DnsrecordDetails) cisZoneRecord {
+	r := cisZoneRecord{}
+	if details.ID != nil {
+		r.ID = *details.ID
+	}
+	if details.Name != nil {
+		r.Name = strings.ToLower(strings.TrimSuffix(*details.Name, "."))
+	}
+	if details.Type != nil {
+		r.Type = *details.Type
+	}
+	if details.Content != nil {
+		r.Content = *details.Content
+	}
+	if details.Priority != nil {
+		r.Priority = *details.Priority
+	}
+	if details.TTL != nil {
+		r.TTL = *details.TTL
+	}
+	if details.Proxied != nil {
+		r.Proxied = *details.Proxied
+	}
+	data, _ := details.Data.(map[string]interface{})
+	switch r.Type {
+	case cisDNSRecordTypeA, cisDNSRecordTypeAAAA:
+		if ip := net.ParseIP(r.Content); ip != nil {
+			r.Content = ip.String()
+		}
+	case cisDNSRecordTypeCNAME, cisDNSRecordTypeNS, cisDNSRecordTypePTR, cisDNSRecordTypeMX:
+		r.Content = strings.ToLower(strings.TrimSuffix(r.Content, "."))
+	case cisDNSRecordTypeSRV:
+		if data != nil {
+			r.Priority = cisZoneRecordNumber(data["priority"])
+			r.Content = fmt.Sprintf("%d %d %v", cisZoneRecordNumber(data["weight"]), cisZoneRecordNumber(data["port"]), data["target"])
+		}
+		if fields := strings.Fields(r.Content); len(fields) == 3 {
+			r.Content = fmt.Sprintf("%s %s %s", fields[0], fields[1], strings.ToLower(strings.TrimSuffix(fields[2], ".")))
+		}
+	case cisDNSRecordTypeCAA:
+		if data != nil {
+			r.Content = fmt.Sprintf("%d %v \"%v\"", cisZoneRecordNumber(data["flags"]), data["tag"], data["value"])
+		} else if fields := strings.SplitN(r.Content, " ", 3); len(fields) == 3 {
+			r.Content = fmt.Sprintf("%s %s \"%s\"", fields[0], strings.ToLower(fields[1]), strings.Trim(fields[2], "\""))
+		}
+	}
+	if r.Proxied {
+		r.TTL = 1
+	}
+	return r
+}
+
+func cisZoneRecordNumber(v interface{}) int64 {
+	switch n := v.(type) {
+	case float64:
+		return int64(n)
+	case int64:
+		return n
+	case int:
+		return int64(n)
+	case string:
+		i, _ := strconv.ParseInt(n, 10, 64)
+		return i
+	}
+	return 0
+}
+
+// cisZoneRecordData returns the data CIS takes for the SRV and CAA records, which
+// it builds their content from, and nil for the other types.
+func cisZoneRecordData(r cisZoneRecord) map[string]interface{} {
+	switch r.Type {
+	case cisDNSRecordTypeSRV:
+		labels := strings.SplitN(r.Name, ".", 3)
+		fields := strings.Fields(r.Content)
+		weight, _ := strconv.ParseInt(fields[0], 10, 64)
+		port, _ := strconv.ParseInt(fields[1], 10, 64)
+		return map[string]interface{}{
+			"service":  labels[0],
+			"proto":    labels[1],
+			"name":     labels[2],
+			"priority": r.Priority,
+			"weight":   weight,
+			"port":     port,
+			"target":   fields[2],
+		}
+	case cisDNSRecordTypeCAA:
+		fields := strings.SplitN(r.Content, " ", 3)
+		flags, _ := strconv.ParseInt(fields[0], 10, 64)
+		return map[string]interface{}{
+			"flags": flags,
+			"tag":   fields[1],
+			"value": strings.Trim(fields[2], "\""),
+		}
+	}
+	return nil
+}
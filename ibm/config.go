@@ -31,6 +31,8 @@ import (
 	cisdnsrecordsv1 "github.com/IBM/networking-go-sdk/dnsrecordsv1"
 	dns "github.com/IBM/networking-go-sdk/dnssvcsv1"
 	cisedgefunctionv1 "github.com/IBM/networking-go-sdk/edgefunctionsapiv1"
+	cisfiltersv1 "github.com/IBM/networking-go-sdk/filtersv1"
+	cisfirewallrulesv1 "github.com/IBM/networking-go-sdk/firewallrulesv1"
 	cisglbhealthcheckv1 "github.com/IBM/networking-go-sdk/globalloadbalancermonitorv1"
 	cisglbpoolv0 "github.com/IBM/networking-go-sdk/globalloadbalancerpoolsv0"
 	cisglbv1 "github.com/IBM/networking-go-sdk/globalloadbalancerv1"
@@ -62,6 +64,7 @@ import (
 	vpc "github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/apache/openwhisk-client-go/whisk"
 	jwt "github.com/dgrijalva/jwt-go"
+	httptransport "github.com/go-openapi/runtime/client"
 	slsession "github.com/softlayer/softlayer-go/session"
 	"github.ibm.com/ibmcloud/kubernetesservice-go-sdk/kubernetesserviceapiv1"
 
@@ -90,6 +93,7 @@ import (
 	"github.com/IBM-Cloud/bluemix-go/api/usermanagement/usermanagementv2"
 	"github.com/IBM-Cloud/bluemix-go/authentication"
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/bluemix-go/http"
 	"github.com/IBM-Cloud/bluemix-go/rest"
 	bxsession "github.com/IBM-Cloud/bluemix-go/session"
@@ -105,7 +109,7 @@ var BluemixRegion string
 
 var (
 	errEmptySoftLayerCredentials = errors.New("iaas_classic_username and iaas_classic_api_key must be provided. Please see the documentation on how to configure them")
-	errEmptyBluemixCredentials   = errors.New("ibmcloud_api_key or bluemix_api_key or iam_token and iam_refresh_token must be provided. Please see the documentation on how to configure it")
+	errEmptyBluemixCredentials   = errors.New("ibmcloud_api_key or bluemix_api_key or iam_token and iam_refresh_token or assume_trusted_profile must be provided. Please see the documentation on how to configure it")
 )
 
 //UserConfig ...
@@ -142,12 +146,11 @@ type Config struct {
 	SoftLayerAPIKey string
 
 	//Retry Count for API calls
-	//Unexposed in the schema at this point as they are used only during session creation for a few calls
-	//When sdk implements it we an expose them for expected behaviour
-	//https://github.com/softlayer/softlayer-go/issues/41
 	RetryCount int
-	//Constant Retry Delay for API calls
+	//Constant Retry Delay for Classic Infrastructure API calls
 	RetryDelay time.Duration
+	//Maximum wait between two retries of an API call
+	RetryMaxWait time.Duration
 
 	// FunctionNameSpace ...
 	FunctionNameSpace string
@@ -164,12 +167,27 @@ type Config struct {
 	//IAM Refresh Token
 	IAMRefreshToken string
 
+	// TrustedProfileID or TrustedProfileName is the trusted profile assumed with the
+	// compute resource token read from CRTokenFile
+	TrustedProfileID   string
+	TrustedProfileName string
+	CRTokenFile        string
+
 	// PowerService Instance
 	PowerServiceInstance string
 
 	// Zone
 	Zone       string
 	Visibility string
+
+	// Endpoints overrides the service endpoints, keyed by service
+	Endpoints map[string]string
+
+	// EndpointsFile overrides the service endpoints per visibility and region
+	EndpointsFile EndpointsFile
+
+	// DefaultTags are attached to every resource supporting tags
+	DefaultTags []string
 }
 
 //Session stores the information required for communication with the SoftLayer and Bluemix API
@@ -179,6 +197,9 @@ type Session struct {
 
 	// BluemixSession is the the Bluemix session used to connect to the Bluemix API
 	BluemixSession *bxsession.Session
+
+	// TrustedProfile authenticates the requests when the provider assumes a trusted profile
+	TrustedProfile *trustedProfileAuthenticator
 }
 
 // ClientSession ...
@@ -246,6 +267,8 @@ type ClientSession interface {
 	CisLockdownClientSession() (*cislockdownv1.ZoneLockdownV1, error)
 	CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error)
 	CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error)
+	CisFiltersSession() (*cisfiltersv1.FiltersV1, error)
+	CisFirewallRulesSession() (*cisfirewallrulesv1.FirewallRulesV1, error)
 	IAMIdentityV1API() (*iamidentity.IamIdentityV1, error)
 	ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error)
 	CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error)
@@ -254,11 +277,14 @@ type ClientSession interface {
 	SecretsManagerV1() (*secretsmanagerv1.SecretsManagerV1, error)
 	SchematicsV1() (*schematicsv1.SchematicsV1, error)
 	SatelliteClientSession() (*kubernetesserviceapiv1.KubernetesServiceApiV1, error)
+	DefaultTags() []string
 }
 
 type clientSession struct {
 	session *Session
 
+	defaultTags []string
+
 	apigatewayErr error
 	apigatewayAPI *apigateway.ApiGatewayControllerApiV1
 
@@ -468,6 +494,14 @@ type clientSession struct {
 	// CIS WAF rule service options
 	cisWAFRuleErr    error
 	cisWAFRuleClient *ciswafrulev1.WafRulesApiV1
+
+	// CIS Filters service options
+	cisFiltersErr    error
+	cisFiltersClient *cisfiltersv1.FiltersV1
+
+	// CIS Firewall rules service options
+	cisFirewallRulesErr    error
+	cisFirewallRulesClient *cisfirewallrulesv1.FirewallRulesV1
 	//IAM Identity Option
 	iamIdentityErr error
 	iamIdentityAPI *iamidentity.IamIdentityV1
@@ -883,6 +917,22 @@ func (sess clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1
 	return sess.cisWAFRuleClient.Clone(), nil
 }
 
+// CIS Filters
+func (sess clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
+	if sess.cisFiltersErr != nil {
+		return sess.cisFiltersClient, sess.cisFiltersErr
+	}
+	return sess.cisFiltersClient.Clone(), nil
+}
+
+// CIS Firewall Rules
+func (sess clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.FirewallRulesV1, error) {
+	if sess.cisFirewallRulesErr != nil {
+		return sess.cisFirewallRulesClient, sess.cisFirewallRulesErr
+	}
+	return sess.cisFirewallRulesClient.Clone(), nil
+}
+
 // IAM Identity Session
 func (sess clientSession) IAMIdentityV1API() (*iamidentity.IamIdentityV1, error) {
 	return sess.iamIdentityAPI, sess.iamIdentityErr
@@ -909,6 +959,11 @@ func (session clientSession) SecretsManagerV1() (*secretsmanagerv1.SecretsManage
 
 var cloudEndpoint = "cloud.ibm.com"
 
+// DefaultTags returns the tags the provider attaches to every resource supporting tags
+func (sess clientSession) DefaultTags() []string {
+	return sess.defaultTags
+}
+
 // Session to the Satellite client
 func (sess clientSession) SatelliteClientSession() (*kubernetesserviceapiv1.KubernetesServiceApiV1, error) {
 	return sess.satelliteClient, sess.satelliteClientErr
@@ -921,8 +976,10 @@ func (c *Config) ClientSession() (interface{}, error) {
 		return nil, err
 	}
 	log.Printf("[INFO] Configured Region: %s\n", c.Region)
+	retries := c.retryPolicy()
 	session := clientSession{
-		session: sess,
+		session:     sess,
+		defaultTags: c.DefaultTags,
 	}
 
 	if sess.BluemixSession == nil {
@@ -990,6 +1047,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.cisLockdownErr = errEmptyBluemixCredentials
 		session.cisRangeAppErr = errEmptyBluemixCredentials
 		session.cisWAFRuleErr = errEmptyBluemixCredentials
+		session.cisFiltersErr = errEmptyBluemixCredentials
+		session.cisFirewallRulesErr = errEmptyBluemixCredentials
 		session.iamIdentityErr = errEmptyBluemixCredentials
 		session.secretsManagerClientErr = errEmptyBluemixCredentials
 
@@ -1003,7 +1062,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				if err == nil || !isRetryable(err) {
 					break
 				}
-				time.Sleep(c.RetryDelay)
+				time.Sleep(retries.backoff(c.RetryCount-count, nil))
 				log.Printf("Retrying IAM Authentication %d", count)
 				err = authenticateAPIKey(sess.BluemixSession)
 			}
@@ -1020,7 +1079,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				if err == nil || !isRetryable(err) {
 					break
 				}
-				time.Sleep(c.RetryDelay)
+				time.Sleep(retries.backoff(c.RetryCount-count, nil))
 				log.Printf("Retrying CF Authentication %d", count)
 				err = authenticateCF(sess.BluemixSession)
 			}
@@ -1030,14 +1089,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 		}
 	}
 
-	if sess.BluemixSession.Config.IAMAccessToken != "" && sess.BluemixSession.Config.BluemixAPIKey == "" {
+	if sess.BluemixSession.Config.IAMAccessToken != "" && sess.BluemixSession.Config.BluemixAPIKey == "" && sess.TrustedProfile == nil {
 		err := refreshToken(sess.BluemixSession)
 		if err != nil {
 			for count := c.RetryCount; count >= 0; count-- {
 				if err == nil || !isRetryable(err) {
 					break
 				}
-				time.Sleep(c.RetryDelay)
+				time.Sleep(retries.backoff(c.RetryCount-count, nil))
 				log.Printf("Retrying refresh token %d", count)
 				err = refreshToken(sess.BluemixSession)
 			}
@@ -1105,7 +1164,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	var options kp.ClientConfig
 	if c.BluemixAPIKey != "" {
 		options = kp.ClientConfig{
-			BaseURL: envFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
+			BaseURL: c.endpointFor("kms", kpurl),
 			APIKey:  sess.BluemixSession.Config.BluemixAPIKey, //pragma: allowlist secret
 			// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
 			Verbose: kp.VerboseFailOnly,
@@ -1113,13 +1172,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 
 	} else {
 		options = kp.ClientConfig{
-			BaseURL:       envFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
+			BaseURL:       c.endpointFor("kms", kpurl),
 			Authorization: sess.BluemixSession.Config.IAMAccessToken,
 			// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
 			Verbose: kp.VerboseFailOnly,
 		}
 	}
-	kpAPIclient, err := kp.New(options, kp.DefaultTransport())
+	// The Key Protect client retries its requests itself
+	kp.RetryMax = retries.maxRetries
+	kp.RetryWaitMax = retries.maxWait
+	kpTransport := kp.DefaultTransport()
+	if sess.TrustedProfile != nil {
+		kpTransport = sess.TrustedProfile.transport(kpTransport)
+	}
+	kpAPIclient, err := kp.New(options, kpTransport)
 	if err != nil {
 		session.kpErr = fmt.Errorf("Error occured while configuring Key Protect Service: %q", err)
 	}
@@ -1132,7 +1198,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	var kmsOptions kp.ClientConfig
 	if c.BluemixAPIKey != "" {
 		kmsOptions = kp.ClientConfig{
-			BaseURL: envFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
+			BaseURL: c.endpointFor("kms", kmsurl),
 			APIKey:  sess.BluemixSession.Config.BluemixAPIKey, //pragma: allowlist secret
 			// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
 			Verbose: kp.VerboseFailOnly,
@@ -1140,13 +1206,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 
 	} else {
 		kmsOptions = kp.ClientConfig{
-			BaseURL:       envFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
+			BaseURL:       c.endpointFor("kms", kmsurl),
 			Authorization: sess.BluemixSession.Config.IAMAccessToken,
 			// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
 			Verbose: kp.VerboseFailOnly,
 		}
 	}
-	kmsAPIclient, err := kp.New(kmsOptions, DefaultTransport())
+	kmsTransport := DefaultTransport()
+	if sess.TrustedProfile != nil {
+		kmsTransport = sess.TrustedProfile.transport(kmsTransport)
+	}
+	kmsAPIclient, err := kp.New(kmsOptions, kmsTransport)
 	if err != nil {
 		session.kmsErr = fmt.Errorf("Error occured while configuring key Service: %q", err)
 	}
@@ -1154,10 +1224,12 @@ func (c *Config) ClientSession() (interface{}, error) {
 
 	var authenticator core.Authenticator
 
-	if c.BluemixAPIKey != "" {
+	if sess.TrustedProfile != nil {
+		authenticator = sess.TrustedProfile
+	} else if c.BluemixAPIKey != "" {
 		authenticator = &core.IamAuthenticator{
 			ApiKey: c.BluemixAPIKey,
-			URL:    envFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, "https://iam.cloud.ibm.com") + "/identity/token",
+			URL:    c.endpointFor("iam", "https://iam.cloud.ibm.com") + "/identity/token",
 		}
 	} else if strings.HasPrefix(sess.BluemixSession.Config.IAMAccessToken, "Bearer") {
 		authenticator = &core.BearerTokenAuthenticator{
@@ -1175,7 +1247,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.catalogManagementClientErr = fmt.Errorf("Catalog Management resource doesnot support private endpoints")
 	}
 	catalogManagementClientOptions := &catalogmanagementv1.CatalogManagementV1Options{
-		URL:           envFallBack([]string{"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT"}, catalogManagementURL),
+		URL:           c.endpointFor("catalog_management", catalogManagementURL),
 		Authenticator: authenticator,
 	}
 
@@ -1183,7 +1255,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.catalogManagementClient, err = catalogmanagementv1.NewCatalogManagementV1(catalogManagementClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.catalogManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(session.catalogManagementClient.Service)
 		// Add custom header for analytics
 		session.catalogManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1203,14 +1275,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	schematicsClientOptions := &schematicsv1.SchematicsV1Options{
 		Authenticator: authenticator,
-		URL:           envFallBack([]string{"IBMCLOUD_SCHEMATICS_API_ENDPOINT"}, schematicsEndpoint),
+		URL:           c.endpointFor("schematics", schematicsEndpoint),
 	}
 
 	// Construct the service client.
 	schematicsClient, err := schematicsv1.NewSchematicsV1(schematicsClientOptions)
 	// Enable retries for API calls
 	if schematicsClient != nil && schematicsClient.Service != nil {
-		schematicsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(schematicsClient.Service)
 		if err != nil {
 			session.schematicsClientErr = fmt.Errorf("Error occurred while configuring Schematics Service API service: %q", err)
 		}
@@ -1233,7 +1305,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		}
 	}
 	vpcclassicoptions := &vpcclassic.VpcClassicV1Options{
-		URL:           envFallBack([]string{"IBMCLOUD_IS_API_ENDPOINT"}, vpcclassicurl),
+		URL:           c.endpointFor("vpc_classic", vpcclassicurl),
 		Authenticator: authenticator,
 	}
 	vpcclassicclient, err := vpcclassic.NewVpcClassicV1(vpcclassicoptions)
@@ -1241,7 +1313,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.vpcErr = fmt.Errorf("Error occured while configuring vpc classic service: %q", err)
 	}
 	if vpcclassicclient != nil && vpcclassicclient.Service != nil {
-		vpcclassicclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(vpcclassicclient.Service)
 	}
 
 	session.vpcClassicAPI = vpcclassicclient
@@ -1261,7 +1333,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		vpcurl = contructEndpoint(fmt.Sprintf("%s.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
 	}
 	vpcoptions := &vpc.VpcV1Options{
-		URL:           envFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, vpcurl),
+		URL:           c.endpointFor("vpc", vpcurl),
 		Authenticator: authenticator,
 	}
 	vpcclient, err := vpc.NewVpcV1(vpcoptions)
@@ -1269,7 +1341,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.vpcErr = fmt.Errorf("Error occured while configuring vpc service: %q", err)
 	}
 	if vpcclient != nil && vpcclient.Service != nil {
-		vpcclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(vpcclient.Service)
 	}
 	session.vpcAPI = vpcclient
 
@@ -1278,13 +1350,13 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.pushServiceClientErr = fmt.Errorf("Push Notifications Service API doesnot support private endpoints")
 	}
 	pushNotificationOptions := &pushservicev1.PushServiceV1Options{
-		URL:           envFallBack([]string{"IBMCLOUD_PUSH_API_ENDPOINT"}, pnurl),
+		URL:           c.endpointFor("push_notifications", pnurl),
 		Authenticator: authenticator,
 	}
 	pnclient, err := pushservicev1.NewPushServiceV1(pushNotificationOptions)
 	if pnclient != nil {
 		// Enable retries for API calls
-		pnclient.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(pnclient.Service)
 		session.pushServiceClient = pnclient
 	} else {
 		session.pushServiceClientErr = fmt.Errorf("Error occured while configuring push notification service: %q", err)
@@ -1298,7 +1370,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	appConfigClient, err := appconfigurationv1.NewAppConfigurationV1(appConfigurationClientOptions)
 	if appConfigClient != nil {
 		// Enable retries for API calls
-		appConfigClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(appConfigClient.Service)
 		session.appConfigurationClient = appConfigClient
 	} else {
 		session.appConfigurationClientErr = fmt.Errorf("Error occurred while configuring App Configuration service: %q", err)
@@ -1316,7 +1388,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	containerRegistryClientOptions := &containerregistryv1.ContainerRegistryV1Options{
 		Authenticator: authenticator,
-		URL:           envFallBack([]string{"IBMCLOUD_CR_API_ENDPOINT"}, containerRegistryClientURL),
+		URL:           c.endpointFor("container_registry", containerRegistryClientURL),
 		Account:       core.StringPtr(userConfig.userAccount),
 	}
 
@@ -1324,7 +1396,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.containerRegistryClient, err = containerregistryv1.NewContainerRegistryV1(containerRegistryClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.containerRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(session.containerRegistryClient.Service)
 		// Add custom header for analytics
 		session.containerRegistryClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1336,12 +1408,15 @@ func (c *Config) ClientSession() (interface{}, error) {
 	//cosconfigurl := fmt.Sprintf("https://%s.iaas.cloud.ibm.com/v1", c.Region)
 	cosconfigoptions := &cosconfig.ResourceConfigurationV1Options{
 		Authenticator: authenticator,
-		URL:           envFallBack([]string{"IBMCLOUD_COS_CONFIG_ENDPOINT"}, "https://config.cloud-object-storage.cloud.ibm.com/v1"),
+		URL:           c.endpointFor("cos_config", "https://config.cloud-object-storage.cloud.ibm.com/v1"),
 	}
 	cosconfigclient, err := cosconfig.NewResourceConfigurationV1(cosconfigoptions)
 	if err != nil {
 		session.cosConfigErr = fmt.Errorf("Error occured while configuring COS config service: %q", err)
 	}
+	if cosconfigclient != nil && cosconfigclient.Service != nil {
+		retries.enableRetries(cosconfigclient.Service)
+	}
 	session.cosConfigAPI = cosconfigclient
 
 	cisAPI, err := cisv1.New(sess.BluemixSession)
@@ -1374,7 +1449,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 
 	globalTaggingV1Options := &globaltaggingv1.GlobalTaggingV1Options{
-		URL:           envFallBack([]string{"IBMCLOUD_GT_API_ENDPOINT"}, globalTaggingEndpoint),
+		URL:           c.endpointFor("global_tagging", globalTaggingEndpoint),
 		Authenticator: authenticator,
 	}
 
@@ -1384,7 +1459,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if globalTaggingAPIV1 != nil {
 		session.globalTaggingServiceAPIV1 = *globalTaggingAPIV1
-		session.globalTaggingServiceAPIV1.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(session.globalTaggingServiceAPIV1.Service)
 	}
 
 	iam, err := iamv1.New(sess.BluemixSession)
@@ -1463,13 +1538,16 @@ func (c *Config) ClientSession() (interface{}, error) {
 		apicurl = contructEndpoint(fmt.Sprintf("api.private.%s.apigw", c.Region), fmt.Sprintf("%s/controller", cloudEndpoint))
 	}
 	APIGatewayControllerAPIV1Options := &apigateway.ApiGatewayControllerApiV1Options{
-		URL:           envFallBack([]string{"IBMCLOUD_API_GATEWAY_ENDPOINT"}, apicurl),
+		URL:           c.endpointFor("api_gateway", apicurl),
 		Authenticator: &core.NoAuthAuthenticator{},
 	}
 	apigatewayAPI, err := apigateway.NewApiGatewayControllerApiV1(APIGatewayControllerAPIV1Options)
 	if err != nil {
 		session.apigatewayErr = fmt.Errorf("Error occured while configuring  APIGateway service: %q", err)
 	}
+	if apigatewayAPI != nil && apigatewayAPI.Service != nil {
+		retries.enableRetries(apigatewayAPI.Service)
+	}
 	session.apigatewayAPI = apigatewayAPI
 
 	ibmpisession, err := ibmpisession.New(sess.BluemixSession.Config.IAMAccessToken, c.Region, false, 90000000000, session.bmxUserDetails.userAccount, c.Zone)
@@ -1477,6 +1555,16 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.ibmpiConfigErr = err
 		return nil, err
 	}
+	if powerURL := c.endpointOverride("power"); powerURL != "" {
+		if err := setPowerEndpoint(ibmpisession, powerURL); err != nil {
+			session.ibmpiConfigErr = err
+		}
+	}
+	if sess.TrustedProfile != nil {
+		if powerRuntime, ok := ibmpisession.Power.Transport.(*httptransport.Runtime); ok {
+			powerRuntime.Transport = sess.TrustedProfile.transport(powerRuntime.Transport)
+		}
+	}
 
 	session.ibmpiSession = ibmpisession
 
@@ -1485,7 +1573,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		pdnsURL = contructEndpoint("api.private.dns-svcs", fmt.Sprintf("%s/v1", cloudEndpoint))
 	}
 	dnsOptions := &dns.DnsSvcsV1Options{
-		URL:           envFallBack([]string{"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT"}, pdnsURL),
+		URL:           c.endpointFor("private_dns", pdnsURL),
 		Authenticator: authenticator,
 	}
 
@@ -1494,7 +1582,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.pDNSErr = fmt.Errorf("Error occured while configuring PrivateDNS Service: %s", session.pDNSErr)
 	}
 	if session.pDNSClient != nil && session.pDNSClient.Service != nil {
-		session.pDNSClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(session.pDNSClient.Service)
 	}
 
 	ver := time.Now().Format("2006-01-02")
@@ -1504,7 +1592,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		dlURL = contructEndpoint("private.directlink", fmt.Sprintf("%s/v1", cloudEndpoint))
 	}
 	directlinkOptions := &dl.DirectLinkV1Options{
-		URL:           envFallBack([]string{"IBMCLOUD_DL_API_ENDPOINT"}, dlURL),
+		URL:           c.endpointFor("directlink", dlURL),
 		Authenticator: authenticator,
 		Version:       &ver,
 	}
@@ -1514,7 +1602,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.directlinkErr = fmt.Errorf("Error occured while configuring Direct Link Service: %s", session.directlinkErr)
 	}
 	if session.directlinkAPI != nil && session.directlinkAPI.Service != nil {
-		session.directlinkAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(session.directlinkAPI.Service)
 	}
 
 	//Direct link provider
@@ -1523,7 +1611,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		dlproviderURL = contructEndpoint("private.directlink", fmt.Sprintf("%s/provider/v2", cloudEndpoint))
 	}
 	directLinkProviderV2Options := &dlProviderV2.DirectLinkProviderV2Options{
-		URL:           envFallBack([]string{"IBMCLOUD_DL_PROVIDER_API_ENDPOINT"}, dlproviderURL),
+		URL:           c.endpointFor("directlink_provider", dlproviderURL),
 		Authenticator: authenticator,
 		Version:       &ver,
 	}
@@ -1533,7 +1621,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.dlProviderErr = fmt.Errorf("Error occured while configuring Direct Link Provider Service: %s", session.dlProviderErr)
 	}
 	if session.dlProviderAPI != nil && session.dlProviderAPI.Service != nil {
-		session.dlProviderAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(session.dlProviderAPI.Service)
 	}
 
 	tgURL := tg.DefaultServiceURL
@@ -1541,7 +1629,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		tgURL = contructEndpoint("private.transit", fmt.Sprintf("%s/v1", cloudEndpoint))
 	}
 	transitgatewayOptions := &tg.TransitGatewayApisV1Options{
-		URL:           envFallBack([]string{"IBMCLOUD_TG_API_ENDPOINT"}, tgURL),
+		URL:           c.endpointFor("transit_gateway", tgURL),
 		Authenticator: authenticator,
 		Version:       CreateVersionDate(),
 	}
@@ -1551,7 +1639,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.transitgatewayErr = fmt.Errorf("Error occured while configuring Transit Gateway Service: %s", session.transitgatewayErr)
 	}
 	if session.transitgatewayAPI != nil && session.transitgatewayAPI.Service != nil {
-		session.transitgatewayAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(session.transitgatewayAPI.Service)
 	}
 
 	// CIS Service instances starts here.
@@ -1579,8 +1667,10 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.cisLockdownErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
 		session.cisRangeAppErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
 		session.cisWAFRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisFiltersErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisFirewallRulesErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
 	}
-	cisEndPoint := envFallBack([]string{"IBMCLOUD_CIS_API_ENDPOINT"}, cisURL)
+	cisEndPoint := c.endpointFor("cis", cisURL)
 
 	// IBM Network CIS Zones service
 	cisZonesV1Opt := &ciszonesv1.ZonesV1Options{
@@ -1595,7 +1685,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisZonesErr)
 	}
 	if session.cisZonesV1Client != nil && session.cisZonesV1Client.Service != nil {
-		session.cisZonesV1Client.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(session.cisZonesV1Client.Service)
 	}
 
 	// IBM Network CIS DNS Record service
@@ -1610,7 +1700,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.cisDNSErr = fmt.Errorf("Error occured while configuring CIS DNS Service: %s", session.cisDNSErr)
 	}
 	if session.cisDNSRecordsClient != nil && session.cisDNSRecordsClient.Service != nil {
-		session.cisDNSRecordsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(session.cisDNSRecordsClient.Service)
 	}
 
 	// IBM Network CIS DNS Record bulk service
@@ -1627,7 +1717,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisDNSBulkErr)
 	}
 	if session.cisDNSRecordBulkClient != nil && session.cisDNSRecordBulkClient.Service != nil {
-		session.cisDNSRecordBulkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(session.cisDNSRecordBulkClient.Service)
 	}
 
 	// IBM Network CIS Global load balancer pool
@@ -1644,7 +1734,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisGLBPoolErr)
 	}
 	if session.cisGLBPoolClient != nil && session.cisGLBPoolClient.Service != nil {
-		session.cisGLBPoolClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(session.cisGLBPoolClient.Service)
 	}
 
 	// IBM Network CIS Global load balancer
@@ -1661,7 +1751,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisGLBErr)
 	}
 	if session.cisGLBClient != nil && session.cisGLBClient.Service != nil {
-		session.cisGLBClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(session.cisGLBClient.Service)
 	}
 
 	// IBM Network CIS Global load balancer health check/monitor
@@ -1678,7 +1768,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisGLBHealthCheckErr)
 	}
 	if session.cisGLBHealthCheckClient != nil && session.cisGLBHealthCheckClient.Service != nil {
-		session.cisGLBHealthCheckClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(session.cisGLBHealthCheckClient.Service)
 	}
 
 	// IBM Network CIS IP
@@ -1692,7 +1782,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisIPErr)
 	}
 	if session.cisIPClient != nil && session.cisIPClient.Service != nil {
-		session.cisIPClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(session.cisIPClient.Service)
 	}
 
 	// IBM Network CIS Zone Rate Limit
@@ -1709,7 +1799,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisRLErr)
 	}
 	if session.cisRLClient != nil && session.cisRLClient.Service != nil {
-		session.cisRLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(session.cisRLClient.Service)
 	}
 
 	// IBM Network CIS Page Rules
@@ -1726,7 +1816,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisPageRuleErr)
 	}
 	if session.cisPageRuleClient != nil && session.cisPageRuleClient.Service != nil {
-		session.cisPageRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(session.cisPageRuleClient.Service)
 	}
 
 	// IBM Network CIS Edge Function
@@ -1744,7 +1834,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisEdgeFunctionErr)
 	}
 	if session.cisEdgeFunctionClient != nil && session.cisEdgeFunctionClient.Service != nil {
-		session.cisEdgeFunctionClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(session.cisEdgeFunctionClient.Service)
 	}
 
 	// IBM Network CIS SSL certificate
@@ -1762,7 +1852,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisSSLErr)
 	}
 	if session.cisSSLClient != nil && session.cisSSLClient.Service != nil {
-		session.cisSSLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(session.cisSSLClient.Service)
 	}
 
 	// IBM Network CIS WAF Package
@@ -1780,7 +1870,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisWAFPackageErr)
 	}
 	if session.cisWAFPackageClient != nil && session.cisWAFPackageClient.Service != nil {
-		session.cisWAFPackageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(session.cisWAFPackageClient.Service)
 	}
 
 	// IBM Network CIS Domain settings
@@ -1798,7 +1888,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisDomainSettingsErr)
 	}
 	if session.cisDomainSettingsClient != nil && session.cisDomainSettingsClient.Service != nil {
-		session.cisDomainSettingsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(session.cisDomainSettingsClient.Service)
 	}
 
 	// IBM Network CIS Routing
@@ -1816,7 +1906,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisRoutingErr)
 	}
 	if session.cisRoutingClient != nil && session.cisRoutingClient.Service != nil {
-		session.cisRoutingClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(session.cisRoutingClient.Service)
 	}
 
 	// IBM Network CIS WAF Group
@@ -1834,7 +1924,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisWAFGroupErr)
 	}
 	if session.cisWAFGroupClient != nil && session.cisWAFGroupClient.Service != nil {
-		session.cisWAFGroupClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(session.cisWAFGroupClient.Service)
 	}
 
 	// IBM Network CIS Cache service
@@ -1852,7 +1942,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisCacheErr)
 	}
 	if session.cisCacheClient != nil && session.cisCacheClient.Service != nil {
-		session.cisCacheClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(session.cisCacheClient.Service)
 	}
 
 	// IBM Network CIS Custom pages service
@@ -1871,7 +1961,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisCustomPageErr)
 	}
 	if session.cisCustomPageClient != nil && session.cisCustomPageClient.Service != nil {
-		session.cisCustomPageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(session.cisCustomPageClient.Service)
 	}
 
 	// IBM Network CIS Firewall Access rule
@@ -1889,7 +1979,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisAccessRuleErr)
 	}
 	if session.cisAccessRuleClient != nil && session.cisAccessRuleClient.Service != nil {
-		session.cisAccessRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(session.cisAccessRuleClient.Service)
 	}
 
 	// IBM Network CIS Firewall User Agent Blocking rule
@@ -1907,7 +1997,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisUARuleErr)
 	}
 	if session.cisUARuleClient != nil && session.cisUARuleClient.Service != nil {
-		session.cisUARuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(session.cisUARuleClient.Service)
 	}
 
 	// IBM Network CIS Firewall Lockdown rule
@@ -1925,7 +2015,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisLockdownErr)
 	}
 	if session.cisLockdownClient != nil && session.cisLockdownClient.Service != nil {
-		session.cisLockdownClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(session.cisLockdownClient.Service)
 	}
 
 	// IBM Network CIS Range Application rule
@@ -1943,7 +2033,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisRangeAppErr)
 	}
 	if session.cisRangeAppClient != nil && session.cisRangeAppClient.Service != nil {
-		session.cisRangeAppClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(session.cisRangeAppClient.Service)
 	}
 
 	// IBM Network CIS WAF Rule Service
@@ -1961,7 +2051,37 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisWAFRuleErr)
 	}
 	if session.cisWAFRuleClient != nil && session.cisWAFRuleClient.Service != nil {
-		session.cisWAFRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(session.cisWAFRuleClient.Service)
+	}
+
+	// IBM Network CIS Filters Service
+	cisFiltersOpt := &cisfiltersv1.FiltersV1Options{
+		URL:           cisEndPoint,
+		Authenticator: authenticator,
+	}
+	session.cisFiltersClient, session.cisFiltersErr = cisfiltersv1.NewFiltersV1(cisFiltersOpt)
+	if session.cisFiltersErr != nil {
+		session.cisFiltersErr = fmt.Errorf(
+			"Error occured while configuring CIS Filters service: %s",
+			session.cisFiltersErr)
+	}
+	if session.cisFiltersClient != nil && session.cisFiltersClient.Service != nil {
+		retries.enableRetries(session.cisFiltersClient.Service)
+	}
+
+	// IBM Network CIS Firewall Rules Service
+	cisFirewallRulesOpt := &cisfirewallrulesv1.FirewallRulesV1Options{
+		URL:           cisEndPoint,
+		Authenticator: authenticator,
+	}
+	session.cisFirewallRulesClient, session.cisFirewallRulesErr = cisfirewallrulesv1.NewFirewallRulesV1(cisFirewallRulesOpt)
+	if session.cisFirewallRulesErr != nil {
+		session.cisFirewallRulesErr = fmt.Errorf(
+			"Error occured while configuring CIS Firewall Rules service: %s",
+			session.cisFirewallRulesErr)
+	}
+	if session.cisFirewallRulesClient != nil && session.cisFirewallRulesClient.Service != nil {
+		retries.enableRetries(session.cisFirewallRulesClient.Service)
 	}
 
 	// iamIdenityURL := fmt.Sprintf("https://%s.iam.cloud.ibm.com/v1", c.Region)
@@ -1975,14 +2095,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	iamIdentityOptions := &iamidentity.IamIdentityV1Options{
 		Authenticator: authenticator,
-		URL:           envFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
+		URL:           c.endpointFor("iam", iamURL),
 	}
 	iamIdentityClient, err := iamidentity.NewIamIdentityV1(iamIdentityOptions)
 	if err != nil {
 		session.vpcErr = fmt.Errorf("Error occured while configuring IAM Identity service: %q", err)
 	}
 	if iamIdentityClient != nil && iamIdentityClient.Service != nil {
-		iamIdentityClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(iamIdentityClient.Service)
 	}
 	session.iamIdentityAPI = iamIdentityClient
 
@@ -1996,14 +2116,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	iamPolicyManagementOptions := &iampolicymanagement.IamPolicyManagementV1Options{
 		Authenticator: authenticator,
-		URL:           envFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamPolicyManagementURL),
+		URL:           c.endpointFor("iam", iamPolicyManagementURL),
 	}
 	iamPolicyManagementClient, err := iampolicymanagement.NewIamPolicyManagementV1(iamPolicyManagementOptions)
 	if err != nil {
 		session.vpcErr = fmt.Errorf("Error occured while configuring IAM Policy Management service: %q", err)
 	}
 	if iamPolicyManagementClient != nil && iamPolicyManagementClient.Service != nil {
-		iamPolicyManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(iamPolicyManagementClient.Service)
 	}
 	session.iamPolicyManagementAPI = iamPolicyManagementClient
 
@@ -2025,14 +2145,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	resourceManagerOptions := &resourcemanager.ResourceManagerV2Options{
 		Authenticator: authenticator,
-		URL:           envFallBack([]string{"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT"}, rmURL),
+		URL:           c.endpointFor("resource_manager", rmURL),
 	}
 	resourceManagerClient, err := resourcemanager.NewResourceManagerV2(resourceManagerOptions)
 	if err != nil {
 		session.resourceManagerErr = fmt.Errorf("Error occured while configuring Resource Manager service: %q", err)
 	}
 	if resourceManagerClient != nil {
-		resourceManagerClient.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(resourceManagerClient.Service)
 	}
 	session.resourceManagerAPI = resourceManagerClient
 
@@ -2055,11 +2175,11 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	enterpriseManagementClientOptions := &enterprisemanagementv1.EnterpriseManagementV1Options{
 		Authenticator: authenticator,
-		URL:           envFallBack([]string{"IBMCLOUD_ENTERPRISE_API_ENDPOINT"}, enterpriseURL),
+		URL:           c.endpointFor("enterprise", enterpriseURL),
 	}
 	enterpriseManagementClient, err := enterprisemanagementv1.NewEnterpriseManagementV1(enterpriseManagementClientOptions)
 	if err == nil {
-		enterpriseManagementClient.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(enterpriseManagementClient.Service)
 	} else {
 		session.enterpriseManagementClientErr = fmt.Errorf("Error occurred while configuring IBM Cloud Enterprise Management API service: %q", err)
 	}
@@ -2084,14 +2204,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	resourceControllerOptions := &resourcecontroller.ResourceControllerV2Options{
 		Authenticator: authenticator,
-		URL:           envFallBack([]string{"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT"}, rcURL),
+		URL:           c.endpointFor("resource_controller", rcURL),
 	}
 	resourceControllerClient, err := resourcecontroller.NewResourceControllerV2(resourceControllerOptions)
 	if err != nil {
 		session.resourceControllerErr = fmt.Errorf("Error occured while configuring Resource Controller service: %q", err)
 	}
 	if resourceControllerClient != nil {
-		resourceControllerClient.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(resourceControllerClient.Service)
 	}
 	session.resourceControllerAPI = resourceControllerClient
 	// var authenticator2 *core.BearerTokenAuthenticator
@@ -2103,8 +2223,12 @@ func (c *Config) ClientSession() (interface{}, error) {
 	/// Construct the service client.
 	session.secretsManagerClient, err = secretsmanagerv1.NewSecretsManagerV1(secretsManagerClientOptions)
 	if err == nil {
+		// The endpoint is otherwise the one of each instance, set by the resources
+		if smURL := c.endpointFor("secrets_manager", ""); smURL != "" {
+			session.secretsManagerClient.Service.Options.URL = smURL
+		}
 		// Enable retries for API calls
-		session.secretsManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		retries.enableRetries(session.secretsManagerClient.Service)
 		// Add custom header for analytics
 		session.secretsManagerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2119,7 +2243,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 
 	kubernetesServiceV1Options := &kubernetesserviceapiv1.KubernetesServiceApiV1Options{
-		URL:           envFallBack([]string{"IBMCLOUD_SATELLITE_API_ENDPOINT"}, containerEndpoint),
+		URL:           c.endpointFor("satellite", containerEndpoint),
 		Authenticator: authenticator,
 	}
 
@@ -2128,7 +2252,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.satelliteClientErr = fmt.Errorf("Error occured while configuring satellite client: %q", err)
 	}
 	// Enable retries for API calls
-	session.satelliteClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+	retries.enableRetries(session.satelliteClient.Service)
 
 	return session, nil
 }
@@ -2165,6 +2289,48 @@ func newSession(c *Config) (*Session, error) {
 	softlayerSession.AppendUserAgent(fmt.Sprintf("terraform-provider-ibm/%s", version.Version))
 	ibmSession.SoftLayerSession = softlayerSession
 
+	if c.TrustedProfileID != "" || c.TrustedProfileName != "" {
+		log.Println("Configuring IBM Cloud Session with trusted profile")
+		auth := &trustedProfileAuthenticator{
+			URL:         c.endpointFor("iam", "https://iam.cloud.ibm.com") + "/identity/token",
+			ProfileID:   c.TrustedProfileID,
+			ProfileName: c.TrustedProfileName,
+			CRTokenFile: c.CRTokenFile,
+			Client:      c.retryPolicy().httpClient(&gohttp.Client{Timeout: c.BluemixTimeout}),
+		}
+		if err := auth.Validate(); err != nil {
+			return nil, err
+		}
+		token, err := auth.token()
+		if err != nil {
+			return nil, err
+		}
+		bmxConfig := &bluemix.Config{
+			IAMAccessToken:  "Bearer " + token,
+			HTTPTimeout:     c.BluemixTimeout,
+			Region:          c.Region,
+			ResourceGroup:   c.ResourceGroup,
+			MaxRetries:      helpers.Int(0),
+			Visibility:      c.Visibility,
+			EndpointLocator: newEndpointLocator(c),
+		}
+		sess, err := bxsession.New(bmxConfig)
+		if err != nil {
+			return nil, err
+		}
+		// The API key sourced from the environment must not replace the trusted profile
+		sess.Config.BluemixAPIKey = ""
+		// The bluemix-go clients keep the token they were created with, the trusted
+		// profile replaces it with the current one
+		client := http.NewHTTPClient(sess.Config)
+		auth.Transport = client.Transport
+		client.Transport = auth
+		sess.Config.HTTPClient = c.retryPolicy().httpClient(client)
+		ibmSession.BluemixSession = sess
+		ibmSession.TrustedProfile = auth
+		return ibmSession, nil
+	}
+
 	if (c.IAMToken != "" && c.IAMRefreshToken == "") || (c.IAMToken == "" && c.IAMRefreshToken != "") {
 		return nil, fmt.Errorf("iam_token and iam_refresh_token must be provided")
 	}
@@ -2177,17 +2343,19 @@ func newSession(c *Config) (*Session, error) {
 			IAMRefreshToken: c.IAMRefreshToken,
 			//Comment out debug mode for v0.12
 			//Debug:         os.Getenv("TF_LOG") != "",
-			HTTPTimeout:   c.BluemixTimeout,
-			Region:        c.Region,
-			ResourceGroup: c.ResourceGroup,
-			RetryDelay:    &c.RetryDelay,
-			MaxRetries:    &c.RetryCount,
-			Visibility:    c.Visibility,
+			HTTPTimeout:     c.BluemixTimeout,
+			Region:          c.Region,
+			ResourceGroup:   c.ResourceGroup,
+			MaxRetries:      helpers.Int(0),
+			Visibility:      c.Visibility,
+			EndpointLocator: newEndpointLocator(c),
 		}
 		sess, err := bxsession.New(bmxConfig)
 		if err != nil {
 			return nil, err
 		}
+		// The requests are retried by the HTTP client, bluemix-go retrying only the timeouts
+		sess.Config.HTTPClient = c.retryPolicy().httpClient(http.NewHTTPClient(sess.Config))
 		ibmSession.BluemixSession = sess
 	}
 
@@ -2198,18 +2366,20 @@ func newSession(c *Config) (*Session, error) {
 			BluemixAPIKey: c.BluemixAPIKey,
 			//Comment out debug mode for v0.12
 			//Debug:         os.Getenv("TF_LOG") != "",
-			HTTPTimeout:   c.BluemixTimeout,
-			Region:        c.Region,
-			ResourceGroup: c.ResourceGroup,
-			RetryDelay:    &c.RetryDelay,
-			MaxRetries:    &c.RetryCount,
-			Visibility:    c.Visibility,
+			HTTPTimeout:     c.BluemixTimeout,
+			Region:          c.Region,
+			ResourceGroup:   c.ResourceGroup,
+			MaxRetries:      helpers.Int(0),
+			Visibility:      c.Visibility,
+			EndpointLocator: newEndpointLocator(c),
 			//PowerServiceInstance: c.PowerServiceInstance,
 		}
 		sess, err := bxsession.New(bmxConfig)
 		if err != nil {
 			return nil, err
 		}
+		// The requests are retried by the HTTP client, bluemix-go retrying only the timeouts
+		sess.Config.HTTPClient = c.retryPolicy().httpClient(http.NewHTTPClient(sess.Config))
 		ibmSession.BluemixSession = sess
 	}
 
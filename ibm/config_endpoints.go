@@ -0,0 +1,257 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/IBM-Cloud/bluemix-go/endpoints"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	powerclient "github.com/IBM-Cloud/power-go-client/power/client"
+	httptransport "github.com/go-openapi/runtime/client"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// endpointServices maps every service accepted in the provider endpoints block and
+// in the endpoints file to the environment variables overriding the same endpoint.
+var endpointServices = map[string][]string{
+	"account":             {"IBMCLOUD_ACCOUNT_MANAGEMENT_API_ENDPOINT"},
+	"api_gateway":         {"IBMCLOUD_API_GATEWAY_ENDPOINT"},
+	"catalog_management":  {"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT"},
+	"certificate_manager": {"IBMCLOUD_CERTIFICATE_MANAGER_API_ENDPOINT"},
+	"cis":                 {"IBMCLOUD_CIS_API_ENDPOINT"},
+	"container":           {"IBMCLOUD_CS_API_ENDPOINT"},
+	"container_registry":  {"IBMCLOUD_CR_API_ENDPOINT"},
+	"cos_config":          {"IBMCLOUD_COS_CONFIG_ENDPOINT"},
+	"directlink":          {"IBMCLOUD_DL_API_ENDPOINT"},
+	"directlink_provider": {"IBMCLOUD_DL_PROVIDER_API_ENDPOINT"},
+	"enterprise":          {"IBMCLOUD_ENTERPRISE_API_ENDPOINT"},
+	"global_search":       {"IBMCLOUD_GS_API_ENDPOINT"},
+	"global_tagging":      {"IBMCLOUD_GT_API_ENDPOINT"},
+	"hpcs":                {"IBMCLOUD_HPCS_API_ENDPOINT"},
+	"iam":                 {"IBMCLOUD_IAM_API_ENDPOINT"},
+	"iam_pap":             {"IBMCLOUD_IAMPAP_API_ENDPOINT"},
+	"icd":                 {"IBMCLOUD_ICD_API_ENDPOINT"},
+	"kms":                 {"IBMCLOUD_KP_API_ENDPOINT"},
+	"power":               {"IBMCLOUD_POWER_API_ENDPOINT"},
+	"private_dns":         {"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT"},
+	"push_notifications":  {"IBMCLOUD_PUSH_API_ENDPOINT"},
+	"resource_catalog":    {"IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT"},
+	"resource_controller": {"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT"},
+	"resource_manager":    {"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT"},
+	"satellite":           {"IBMCLOUD_SATELLITE_API_ENDPOINT"},
+	"schematics":          {"IBMCLOUD_SCHEMATICS_API_ENDPOINT"},
+	"secrets_manager":     {"IBMCLOUD_SECRETS_MANAGER_API_ENDPOINT"},
+	"transit_gateway":     {"IBMCLOUD_TG_API_ENDPOINT"},
+	"user_management":     {"IBMCLOUD_USER_MANAGEMENT_ENDPOINT"},
+	"vpc":                 {"IBMCLOUD_IS_NG_API_ENDPOINT"},
+	"vpc_classic":         {"IBMCLOUD_IS_API_ENDPOINT"},
+}
+
+// endpointsFileRegionGlobal is the region key of an endpoints file entry which
+// applies when the file has no entry for the configured region.
+const endpointsFileRegionGlobal = "global"
+
+// EndpointsFile is the content of the file referenced by endpoints_file_path,
+// keyed by service, visibility (public or private) and region.
+type EndpointsFile map[string]map[string]map[string]string
+
+// loadEndpointsFile reads and validates the endpoints file at the given path.
+func loadEndpointsFile(path string) (EndpointsFile, error) {
+	filePath, err := homedir.Expand(path)
+	if err != nil {
+		return nil, fmt.Errorf("Error expanding endpoints_file_path %s: %s", path, err)
+	}
+	content, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading endpoints file %s: %s", filePath, err)
+	}
+	file := EndpointsFile{}
+	if err := json.Unmarshal(content, &file); err != nil {
+		return nil, fmt.Errorf("Error parsing endpoints file %s, expected a JSON object keyed by service, visibility and region: %s", filePath, err)
+	}
+	if err := file.validate(); err != nil {
+		return nil, fmt.Errorf("Invalid endpoints file %s: %s", filePath, err)
+	}
+	return file, nil
+}
+
+func (f EndpointsFile) validate() error {
+	for service, visibilities := range f {
+		if _, ok := endpointServices[service]; !ok {
+			return fmt.Errorf("unsupported service %q, supported services are %s", service, strings.Join(endpointServiceNames(), ", "))
+		}
+		for visibility, regions := range visibilities {
+			if visibility != "public" && visibility != "private" {
+				return fmt.Errorf("unsupported visibility %q for service %q, must be public or private", visibility, service)
+			}
+			for region, endpoint := range regions {
+				if err := validateEndpoint(endpoint); err != nil {
+					return fmt.Errorf("%s.%s.%s: %s", service, visibility, region, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// lookup returns the endpoint of the service for the given visibility and region. The
+// private endpoints are preferred for public-and-private and a global entry is used
+// when the region has none.
+func (f EndpointsFile) lookup(service, visibility, region string) string {
+	visibilities := []string{visibility}
+	if visibility == "public-and-private" {
+		visibilities = []string{"private", "public"}
+	}
+	for _, v := range visibilities {
+		regions := f[service][v]
+		if endpoint, ok := regions[region]; ok {
+			return endpoint
+		}
+		if endpoint, ok := regions[endpointsFileRegionGlobal]; ok {
+			return endpoint
+		}
+	}
+	return ""
+}
+
+func endpointServiceNames() []string {
+	names := make([]string, 0, len(endpointServices))
+	for name := range endpointServices {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func validateEndpoint(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid endpoint %q: %s", endpoint, err)
+	}
+	if (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return fmt.Errorf("invalid endpoint %q, expected an http or https URL", endpoint)
+	}
+	return nil
+}
+
+// endpointOverride returns the endpoint configured for the service in the provider
+// endpoints block or, failing that, in the endpoints file.
+func (c *Config) endpointOverride(service string) string {
+	if endpoint := c.Endpoints[service]; endpoint != "" {
+		return endpoint
+	}
+	return c.EndpointsFile.lookup(service, c.Visibility, c.Region)
+}
+
+// endpointFor returns the endpoint of the service from the provider endpoints block,
+// the endpoints file, the service environment variables or defaultValue, in that order.
+func (c *Config) endpointFor(service, defaultValue string) string {
+	if endpoint := c.endpointOverride(service); endpoint != "" {
+		return endpoint
+	}
+	return envFallBack(endpointServices[service], defaultValue)
+}
+
+// setPowerEndpoint sends the requests of the Power session to endpoint, the Power
+// session reading its endpoint only from the IBMCLOUD_POWER_API_ENDPOINT variable.
+func setPowerEndpoint(sess *ibmpisession.IBMPISession, endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("Invalid power endpoint %s, expected a URL", endpoint)
+	}
+	basePath := u.Path
+	if basePath == "" {
+		basePath = "/"
+	}
+	runtime := httptransport.New(u.Host, basePath, []string{u.Scheme})
+	if current, ok := sess.Power.Transport.(*httptransport.Runtime); ok {
+		runtime.Consumers = current.Consumers
+		runtime.Debug = current.Debug
+	}
+	sess.Power = powerclient.New(runtime, nil)
+	return nil
+}
+
+// endpointLocator resolves the bluemix-go service endpoints through the provider
+// endpoint overrides before falling back to the bluemix-go defaults.
+type endpointLocator struct {
+	endpoints.EndpointLocator
+	config *Config
+}
+
+func newEndpointLocator(c *Config) endpoints.EndpointLocator {
+	return endpointLocator{
+		EndpointLocator: endpoints.NewEndpointLocator(c.Region, c.Visibility),
+		config:          c,
+	}
+}
+
+func (e endpointLocator) resolve(service string, fallback func() (string, error)) (string, error) {
+	if endpoint := e.config.endpointOverride(service); endpoint != "" {
+		return endpoint, nil
+	}
+	return fallback()
+}
+
+func (e endpointLocator) AccountManagementEndpoint() (string, error) {
+	return e.resolve("account", e.EndpointLocator.AccountManagementEndpoint)
+}
+
+func (e endpointLocator) CertificateManagerEndpoint() (string, error) {
+	return e.resolve("certificate_manager", e.EndpointLocator.CertificateManagerEndpoint)
+}
+
+func (e endpointLocator) ContainerEndpoint() (string, error) {
+	return e.resolve("container", e.EndpointLocator.ContainerEndpoint)
+}
+
+func (e endpointLocator) CisEndpoint() (string, error) {
+	return e.resolve("cis", e.EndpointLocator.CisEndpoint)
+}
+
+func (e endpointLocator) GlobalSearchEndpoint() (string, error) {
+	return e.resolve("global_search", e.EndpointLocator.GlobalSearchEndpoint)
+}
+
+func (e endpointLocator) GlobalTaggingEndpoint() (string, error) {
+	return e.resolve("global_tagging", e.EndpointLocator.GlobalTaggingEndpoint)
+}
+
+func (e endpointLocator) IAMEndpoint() (string, error) {
+	return e.resolve("iam", e.EndpointLocator.IAMEndpoint)
+}
+
+func (e endpointLocator) IAMPAPEndpoint() (string, error) {
+	return e.resolve("iam_pap", e.EndpointLocator.IAMPAPEndpoint)
+}
+
+func (e endpointLocator) ICDEndpoint() (string, error) {
+	return e.resolve("icd", e.EndpointLocator.ICDEndpoint)
+}
+
+func (e endpointLocator) ResourceCatalogEndpoint() (string, error) {
+	return e.resolve("resource_catalog", e.EndpointLocator.ResourceCatalogEndpoint)
+}
+
+func (e endpointLocator) ResourceControllerEndpoint() (string, error) {
+	return e.resolve("resource_controller", e.EndpointLocator.ResourceControllerEndpoint)
+}
+
+func (e endpointLocator) SchematicsEndpoint() (string, error) {
+	return e.resolve("schematics", e.EndpointLocator.SchematicsEndpoint)
+}
+
+func (e endpointLocator) UserManagementEndpoint() (string, error) {
+	return e.resolve("user_management", e.EndpointLocator.UserManagementEndpoint)
+}
+
+func (e endpointLocator) HpcsEndpoint() (string, error) {
+	return e.resolve("hpcs", e.EndpointLocator.HpcsEndpoint)
+}
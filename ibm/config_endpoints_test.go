@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	httptransport "github.com/go-openapi/runtime/client"
+	"gotest.tools/assert"
+)
+
+const testEndpointsFile = `{
+	"vpc": {
+		"public": {"us-south": "https://us-south.iaas.test.cloud.ibm.com/v1"},
+		"private": {"us-south": "https://us-south.private.iaas.test.cloud.ibm.com/v1"}
+	},
+	"iam": {
+		"public": {"global": "https://iam.test.cloud.ibm.com"}
+	}
+}`
+
+func writeTestEndpointsFile(t *testing.T, content string) string {
+	dir, err := ioutil.TempDir("", "endpoints")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	path := filepath.Join(dir, "endpoints.json")
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadEndpointsFile(t *testing.T) {
+	file, err := loadEndpointsFile(writeTestEndpointsFile(t, testEndpointsFile))
+	assert.NilError(t, err)
+	assert.Equal(t, file.lookup("vpc", "public", "us-south"), "https://us-south.iaas.test.cloud.ibm.com/v1")
+	assert.Equal(t, file.lookup("vpc", "public-and-private", "us-south"), "https://us-south.private.iaas.test.cloud.ibm.com/v1")
+	assert.Equal(t, file.lookup("vpc", "public", "eu-de"), "")
+	assert.Equal(t, file.lookup("iam", "public", "eu-de"), "https://iam.test.cloud.ibm.com")
+	assert.Equal(t, file.lookup("iam", "private", "eu-de"), "")
+}
+
+func TestLoadEndpointsFileInvalid(t *testing.T) {
+	invalid := []string{
+		`[]`,
+		`{"unknown": {"public": {"us-south": "https://example.com"}}}`,
+		`{"vpc": {"internal": {"us-south": "https://example.com"}}}`,
+		`{"vpc": {"public": {"us-south": "example.com"}}}`,
+	}
+	for _, content := range invalid {
+		_, err := loadEndpointsFile(writeTestEndpointsFile(t, content))
+		assert.Assert(t, err != nil, content)
+	}
+	_, err := loadEndpointsFile(filepath.Join(os.TempDir(), "missing-endpoints.json"))
+	assert.Assert(t, err != nil)
+}
+
+func TestConfigEndpointFor(t *testing.T) {
+	file, err := loadEndpointsFile(writeTestEndpointsFile(t, testEndpointsFile))
+	assert.NilError(t, err)
+	c := &Config{
+		Region:        "us-south",
+		Visibility:    "public",
+		Endpoints:     map[string]string{"iam": "https://iam.block.cloud.ibm.com"},
+		EndpointsFile: file,
+	}
+	os.Setenv("IBMCLOUD_IS_NG_API_ENDPOINT", "https://env.iaas.cloud.ibm.com/v1")
+	defer os.Unsetenv("IBMCLOUD_IS_NG_API_ENDPOINT")
+
+	assert.Equal(t, c.endpointFor("iam", "https://iam.cloud.ibm.com"), "https://iam.block.cloud.ibm.com")
+	assert.Equal(t, c.endpointFor("vpc", "https://us-south.iaas.cloud.ibm.com/v1"), "https://us-south.iaas.test.cloud.ibm.com/v1")
+	assert.Equal(t, c.endpointFor("transit_gateway", "https://transit.cloud.ibm.com/v1"), "https://transit.cloud.ibm.com/v1")
+
+	c.EndpointsFile = nil
+	assert.Equal(t, c.endpointFor("vpc", "https://us-south.iaas.cloud.ibm.com/v1"), "https://env.iaas.cloud.ibm.com/v1")
+
+	locator := newEndpointLocator(c)
+	iamEndpoint, err := locator.IAMEndpoint()
+	assert.NilError(t, err)
+	assert.Equal(t, iamEndpoint, "https://iam.block.cloud.ibm.com")
+}
+
+func TestSetPowerEndpoint(t *testing.T) {
+	sess, err := ibmpisession.New("Bearer token", "us-south", false, time.Minute, "account", "us-south")
+	assert.NilError(t, err)
+
+	assert.NilError(t, setPowerEndpoint(sess, "http://localhost:8080/power"))
+	runtime, ok := sess.Power.Transport.(*httptransport.Runtime)
+	assert.Assert(t, ok)
+	assert.Equal(t, runtime.Host, "localhost:8080")
+	assert.Equal(t, runtime.BasePath, "/power")
+
+	assert.ErrorContains(t, setPowerEndpoint(sess, "localhost"), "Invalid power endpoint")
+}
@@ -0,0 +1,184 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultCRTokenFile is where IKS projects the service account token of the pods
+// configured for the compute resource authentication.
+const defaultCRTokenFile = "/var/run/secrets/tokens/vault-token"
+
+// crTokenGrantType is the IAM grant type exchanging a compute resource token for
+// the access token of a trusted profile.
+const crTokenGrantType = "urn:ibm:params:oauth:grant-type:cr-token"
+
+// trustedProfileAuthenticator authenticates the requests with the IAM access token
+// of a trusted profile, obtained from the compute resource token of the workload
+// running the provider. The compute resource token is read from its file at each
+// refresh, the file being rotated by the compute resource.
+//
+// It implements the Authenticator interface of all the go-sdk-core versions and,
+// as an http.RoundTripper, refreshes the token of the bluemix-go requests.
+type trustedProfileAuthenticator struct {
+	// URL is the IAM token endpoint
+	URL string
+	// ProfileID or ProfileName identifies the trusted profile
+	ProfileID   string
+	ProfileName string
+	// CRTokenFile is the path of the compute resource token
+	CRTokenFile string
+	// Client sends the token requests
+	Client *http.Client
+	// Transport sends the requests authenticated by RoundTrip
+	Transport http.RoundTripper
+
+	mu          sync.Mutex
+	accessToken string
+	refreshAt   time.Time
+}
+
+type iamTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+	Expiration  int64  `json:"expiration"`
+}
+
+// AuthenticationType is the type of the authenticators built from a compute
+// resource token in the IBM SDKs.
+func (a *trustedProfileAuthenticator) AuthenticationType() string {
+	return "container"
+}
+
+// Validate checks the configuration of the authenticator.
+func (a *trustedProfileAuthenticator) Validate() error {
+	if a.ProfileID == "" && a.ProfileName == "" {
+		return errors.New("profile_id or profile_name of the trusted profile must be provided")
+	}
+	if a.ProfileID != "" && a.ProfileName != "" {
+		return errors.New("only one of profile_id or profile_name of the trusted profile must be provided")
+	}
+	if a.CRTokenFile == "" {
+		return errors.New("cr_token_file must be provided")
+	}
+	return nil
+}
+
+// Authenticate sets the access token of the trusted profile on the request.
+func (a *trustedProfileAuthenticator) Authenticate(request *http.Request) error {
+	token, err := a.token()
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// RoundTrip replaces the access token of the bluemix-go requests, which keep the
+// token they were created with, by the current access token.
+func (a *trustedProfileAuthenticator) RoundTrip(request *http.Request) (*http.Response, error) {
+	return a.transport(a.Transport).RoundTrip(request)
+}
+
+// transport returns a RoundTripper replacing the access token of the requests sent
+// through next by the current access token, for the Key Protect and Power clients
+// which, like bluemix-go, keep the token they were created with.
+func (a *trustedProfileAuthenticator) transport(next http.RoundTripper) http.RoundTripper {
+	return &trustedProfileTransport{auth: a, next: next}
+}
+
+type trustedProfileTransport struct {
+	auth *trustedProfileAuthenticator
+	next http.RoundTripper
+}
+
+func (t *trustedProfileTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	if strings.HasPrefix(request.Header.Get("Authorization"), "Bearer ") {
+		token, err := t.auth.token()
+		if err != nil {
+			return nil, err
+		}
+		request = request.Clone(request.Context())
+		request.Header.Set("Authorization", "Bearer "+token)
+	}
+	next := t.next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return next.RoundTrip(request)
+}
+
+// token returns the current access token, requesting a new one when 80% of the
+// lifetime of the current one has elapsed.
+func (a *trustedProfileAuthenticator) token() (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.accessToken != "" && time.Now().Before(a.refreshAt) {
+		return a.accessToken, nil
+	}
+	response, err := a.requestToken()
+	if err != nil {
+		return "", err
+	}
+	lifetime := time.Duration(response.ExpiresIn) * time.Second
+	if lifetime <= 0 && response.Expiration > 0 {
+		lifetime = time.Until(time.Unix(response.Expiration, 0))
+	}
+	a.accessToken = response.AccessToken
+	a.refreshAt = time.Now().Add(lifetime * 8 / 10)
+	return a.accessToken, nil
+}
+
+func (a *trustedProfileAuthenticator) requestToken() (*iamTokenResponse, error) {
+	crToken, err := ioutil.ReadFile(a.CRTokenFile)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading the compute resource token: %s", err)
+	}
+	form := url.Values{
+		"grant_type": {crTokenGrantType},
+		"cr_token":   {strings.TrimSpace(string(crToken))},
+	}
+	if a.ProfileID != "" {
+		form.Set("profile_id", a.ProfileID)
+	} else {
+		form.Set("profile_name", a.ProfileName)
+	}
+	request, err := http.NewRequest(http.MethodPost, a.URL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Accept", "application/json")
+
+	client := a.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("Error requesting the access token of the trusted profile: %s", err)
+	}
+	defer response.Body.Close()
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Error requesting the access token of the trusted profile: %s %s", response.Status, body)
+	}
+	token := &iamTokenResponse{}
+	if err := json.Unmarshal(body, token); err != nil || token.AccessToken == "" {
+		return nil, fmt.Errorf("Error reading the access token of the trusted profile: %s", body)
+	}
+	return token, nil
+}
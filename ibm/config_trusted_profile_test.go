@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	kp "github.com/IBM/keyprotect-go-client"
+	"gotest.tools/assert"
+)
+
+func testTrustedProfileIAM(t *testing.T, expiresIn int) (*httptest.Server, *int) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.NilError(t, r.ParseForm())
+		assert.Equal(t, r.PostForm.Get("grant_type"), crTokenGrantType)
+		assert.Equal(t, r.PostForm.Get("profile_id"), "Profile-test")
+		if r.PostForm.Get("cr_token") != "cr-token" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token": "token-%d", "expires_in": %d}`, requests, expiresIn)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &requests
+}
+
+func testCRTokenFile(t *testing.T, content string) string {
+	dir, err := ioutil.TempDir("", "crtoken")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	path := filepath.Join(dir, "vault-token")
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestTrustedProfileAuthenticator(t *testing.T) {
+	srv, requests := testTrustedProfileIAM(t, 3600)
+	auth := &trustedProfileAuthenticator{
+		URL:         srv.URL,
+		ProfileID:   "Profile-test",
+		CRTokenFile: testCRTokenFile(t, "cr-token\n"),
+	}
+	assert.NilError(t, auth.Validate())
+
+	for i := 0; i < 2; i++ {
+		request, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
+		assert.NilError(t, auth.Authenticate(request))
+		assert.Equal(t, request.Header.Get("Authorization"), "Bearer token-1")
+	}
+	assert.Equal(t, *requests, 1)
+
+	auth.refreshAt = time.Now().Add(-time.Second)
+	request, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
+	assert.NilError(t, auth.Authenticate(request))
+	assert.Equal(t, request.Header.Get("Authorization"), "Bearer token-2")
+
+	auth.CRTokenFile = testCRTokenFile(t, "expired")
+	auth.refreshAt = time.Now().Add(-time.Second)
+	assert.ErrorContains(t, auth.Authenticate(request), "400")
+}
+
+func TestTrustedProfileAuthenticatorRoundTrip(t *testing.T) {
+	iam, _ := testTrustedProfileIAM(t, 3600)
+	var authorization string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+	}))
+	defer api.Close()
+
+	auth := &trustedProfileAuthenticator{
+		URL:         iam.URL,
+		ProfileID:   "Profile-test",
+		CRTokenFile: testCRTokenFile(t, "cr-token"),
+	}
+	client := &http.Client{Transport: auth}
+
+	request, _ := http.NewRequest(http.MethodGet, api.URL, nil)
+	request.Header.Set("Authorization", "Bearer stale")
+	_, err := client.Do(request)
+	assert.NilError(t, err)
+	assert.Equal(t, authorization, "Bearer token-1")
+	assert.Equal(t, request.Header.Get("Authorization"), "Bearer stale")
+
+	request, _ = http.NewRequest(http.MethodGet, api.URL, nil)
+	request.SetBasicAuth("bx", "bx")
+	_, err = client.Do(request)
+	assert.NilError(t, err)
+	assert.Equal(t, authorization, request.Header.Get("Authorization"))
+}
+
+func TestTrustedProfileAuthenticatorKeyProtect(t *testing.T) {
+	iam, _ := testTrustedProfileIAM(t, 3600)
+	var authorization string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"metadata": {"collectionTotal": 0}, "resources": []}`)
+	}))
+	defer api.Close()
+
+	auth := &trustedProfileAuthenticator{
+		URL:         iam.URL,
+		ProfileID:   "Profile-test",
+		CRTokenFile: testCRTokenFile(t, "cr-token"),
+	}
+	client, err := kp.New(kp.ClientConfig{
+		BaseURL:       api.URL,
+		Authorization: "Bearer stale",
+		InstanceID:    "instance",
+	}, auth.transport(kp.DefaultTransport()))
+	assert.NilError(t, err)
+
+	_, err = client.GetKeys(context.Background(), 0, 0)
+	assert.NilError(t, err)
+	assert.Equal(t, authorization, "Bearer token-1")
+
+	auth.refreshAt = time.Now().Add(-time.Second)
+	_, err = client.GetKeys(context.Background(), 0, 0)
+	assert.NilError(t, err)
+	assert.Equal(t, authorization, "Bearer token-2")
+}
+
+func TestTrustedProfileAuthenticatorValidate(t *testing.T) {
+	invalid := []*trustedProfileAuthenticator{
+		{CRTokenFile: defaultCRTokenFile},
+		{ProfileID: "Profile-test", ProfileName: "test", CRTokenFile: defaultCRTokenFile},
+		{ProfileName: "test"},
+	}
+	for _, auth := range invalid {
+		assert.Assert(t, auth.Validate() != nil)
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// containerKubeRESTClient calls the Kubernetes API of a cluster, at the server of
+// the kubeconfig returned by the Kubernetes Service, with the OIDC ID token of the
+// IAM user that the kubeconfig carries. It is used for the configuration which the
+// Kubernetes Service keeps in the cluster, such as the config map of the
+// cluster-autoscaler add-on.
+type containerKubeRESTClient struct {
+	URL    string
+	Token  string
+	Client *http.Client
+}
+
+// kubeConfigMap is a Kubernetes ConfigMap.
+type kubeConfigMap struct {
+	APIVersion string            `json:"apiVersion"`
+	Kind       string            `json:"kind"`
+	Metadata   kubeObjectMeta    `json:"metadata"`
+	Data       map[string]string `json:"data"`
+}
+
+type kubeObjectMeta struct {
+	Name            string `json:"name"`
+	Namespace       string `json:"namespace"`
+	ResourceVersion string `json:"resourceVersion,omitempty"`
+}
+
+func createContainerKubeRESTClient(meta interface{}, clusterNameOrID string, target v2.ClusterTargetHeader) (*containerKubeRESTClient, error) {
+	csClient, err := meta.(ClientSession).VpcContainerAPI()
+	if err != nil {
+		return nil, err
+	}
+	bxSession, err := meta.(ClientSession).BluemixSession()
+	if err != nil {
+		return nil, err
+	}
+	// The kubeconfig is downloaded to a directory of its own, only the server,
+	// token and certificate authority are kept.
+	dir, err := ioutil.TempDir("", "ibm-container-kubeconfig")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+	config, err := csClient.Clusters().GetClusterConfigDetail(clusterNameOrID, dir, false, target)
+	if err != nil {
+		return nil, apiErrorf("container", err, nil, "Error getting the kubeconfig of cluster %s", clusterNameOrID)
+	}
+	if config.Host == "" {
+		return nil, fmt.Errorf("The kubeconfig of cluster %s has no server", clusterNameOrID)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if config.ClusterCACertificate != "" {
+		pool := x509.NewCertPool()
+		pool.AppendCertsFromPEM([]byte(config.ClusterCACertificate))
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+	}
+	return &containerKubeRESTClient{
+		URL:    strings.TrimSuffix(config.Host, "/"),
+		Token:  config.Token,
+		Client: &http.Client{Transport: transport, Timeout: bxSession.Config.HTTPTimeout},
+	}, nil
+}
+
+// do sends a request with the JSON body and decodes the JSON response into result
+// when it is not nil. The error responses are returned as an *APIError.
+func (c *containerKubeRESTClient) do(method, path string, body, result interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+	request, err := http.NewRequest(method, c.URL+path, reader)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Authorization", "Bearer "+c.Token)
+	request.Header.Set("Accept", "application/json")
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	response, err := c.Client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	data, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode >= 300 {
+		return containerKubeAPIError(fmt.Sprintf("Error calling %s %s", method, path), response, data)
+	}
+	if result != nil && len(data) > 0 {
+		return json.Unmarshal(data, result)
+	}
+	return nil
+}
+
+func (c *containerKubeRESTClient) getConfigMap(namespace, name string) (*kubeConfigMap, error) {
+	configMap := &kubeConfigMap{}
+	err := c.do(http.MethodGet, fmt.Sprintf("/api/v1/namespaces/%s/configmaps/%s", namespace, name), nil, configMap)
+	if err != nil {
+		return nil, err
+	}
+	return configMap, nil
+}
+
+// replaceConfigMap replaces the data of the config map. The update fails with a
+// conflict when the config map was changed since its resourceVersion was read.
+func (c *containerKubeRESTClient) replaceConfigMap(configMap *kubeConfigMap) error {
+	path := fmt.Sprintf("/api/v1/namespaces/%s/configmaps/%s", configMap.Metadata.Namespace, configMap.Metadata.Name)
+	return c.do(http.MethodPut, path, configMap, configMap)
+}
+
+// containerKubeAPIError returns the error of a response of the Kubernetes API, a
+// Status object {"kind": "Status", "message": "...", "reason": "NotFound"}.
+func containerKubeAPIError(operation string, response *http.Response, data []byte) error {
+	body := map[string]interface{}{}
+	json.Unmarshal(data, &body)
+	if reason, ok := body["reason"].(string); ok {
+		body["code"] = reason
+	}
+	message := strings.TrimSpace(string(data))
+	if message == "" {
+		message = response.Status
+	}
+	detailed := &core.DetailedResponse{
+		StatusCode: response.StatusCode,
+		Headers:    response.Header,
+		Result:     body,
+	}
+	return apiErrorf("container", errors.New(message), detailed, "%s", operation)
+}
+
+// isContainerNotFound reports whether err is a 404 of the Kubernetes Service API or
+// of the Kubernetes API of a cluster.
+func isContainerNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
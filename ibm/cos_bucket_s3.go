@@ -0,0 +1,233 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/private/checksum"
+	"github.com/IBM/ibm-cos-sdk-go/private/protocol"
+	"github.com/IBM/ibm-cos-sdk-go/private/protocol/restxml"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+)
+
+// The lifecycle and replication configurations of COS support more than the
+// shapes of ibm-cos-sdk-go. The operations below send them with the S3 client,
+// which marshals any shape described by the struct tags of the SDK.
+
+type cosLifecycleConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	Rules []*cosLifecycleRule `locationName:"Rule" type:"list" flattened:"true"`
+}
+
+type cosLifecycleRule struct {
+	_ struct{} `type:"structure"`
+
+	AbortIncompleteMultipartUpload *cosAbortIncompleteMultipartUpload `type:"structure"`
+	Expiration                     *cosLifecycleExpiration            `type:"structure"`
+	Filter                         *cosLifecycleRuleFilter            `type:"structure"`
+	ID                             *string                            `type:"string"`
+	NoncurrentVersionExpiration    *cosNoncurrentVersionExpiration    `type:"structure"`
+	Status                         *string                            `type:"string"`
+	Transitions                    []*s3.Transition                   `locationName:"Transition" type:"list" flattened:"true"`
+}
+
+type cosLifecycleRuleFilter struct {
+	_ struct{} `type:"structure"`
+
+	And    *cosLifecycleRuleAndOperator `type:"structure"`
+	Prefix *string                      `type:"string"`
+	Tag    *s3.Tag                      `type:"structure"`
+}
+
+type cosLifecycleRuleAndOperator struct {
+	_ struct{} `type:"structure"`
+
+	Prefix *string   `type:"string"`
+	Tags   []*s3.Tag `locationName:"Tag" type:"list" flattened:"true"`
+}
+
+type cosLifecycleExpiration struct {
+	_ struct{} `type:"structure"`
+
+	Date                      *time.Time `type:"timestamp" timestampFormat:"iso8601"`
+	Days                      *int64     `type:"integer"`
+	ExpiredObjectDeleteMarker *bool      `type:"boolean"`
+}
+
+type cosNoncurrentVersionExpiration struct {
+	_ struct{} `type:"structure"`
+
+	NoncurrentDays *int64 `type:"integer"`
+}
+
+type cosAbortIncompleteMultipartUpload struct {
+	_ struct{} `type:"structure"`
+
+	DaysAfterInitiation *int64 `type:"integer"`
+}
+
+type cosReplicationConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	Rules []*cosReplicationRule `locationName:"Rule" type:"list" flattened:"true"`
+}
+
+type cosReplicationRule struct {
+	_ struct{} `type:"structure"`
+
+	DeleteMarkerReplication *cosDeleteMarkerReplication `type:"structure"`
+	Destination             *cosReplicationDestination  `type:"structure"`
+	Filter                  *s3.LifecycleRuleFilter     `type:"structure"`
+	ID                      *string                     `type:"string"`
+	Priority                *int64                      `type:"integer"`
+	Status                  *string                     `type:"string"`
+}
+
+type cosReplicationDestination struct {
+	_ struct{} `type:"structure"`
+
+	// Bucket is the CRN of the destination bucket
+	Bucket *string `type:"string"`
+}
+
+type cosDeleteMarkerReplication struct {
+	_ struct{} `type:"structure"`
+
+	Status *string `type:"string"`
+}
+
+type cosPutBucketLifecycleInput struct {
+	_ struct{} `type:"structure" payload:"LifecycleConfiguration"`
+
+	Bucket                 *string                    `location:"uri" locationName:"Bucket" type:"string"`
+	LifecycleConfiguration *cosLifecycleConfiguration `locationName:"LifecycleConfiguration" type:"structure" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+}
+
+type cosPutBucketReplicationInput struct {
+	_ struct{} `type:"structure" payload:"ReplicationConfiguration"`
+
+	Bucket                   *string                      `location:"uri" locationName:"Bucket" type:"string"`
+	ReplicationConfiguration *cosReplicationConfiguration `locationName:"ReplicationConfiguration" type:"structure" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+}
+
+type cosBucketInput struct {
+	_ struct{} `type:"structure"`
+
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string"`
+}
+
+type cosGetBucketReplicationOutput struct {
+	_ struct{} `type:"structure" payload:"ReplicationConfiguration"`
+
+	ReplicationConfiguration *cosReplicationConfiguration `type:"structure"`
+}
+
+type cosEmptyOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// cosSend sends an operation of the S3 API. The configurations are sent with
+// their MD5 checksum, as required by COS.
+func cosSend(client *s3.S3, name, method, path string, input, output interface{}) error {
+	op := &request.Operation{
+		Name:       name,
+		HTTPMethod: method,
+		HTTPPath:   path,
+	}
+	req := client.NewRequest(op, input, output)
+	if method == "PUT" {
+		req.Handlers.Build.PushBackNamed(request.NamedHandler{
+			Name: "contentMd5Handler",
+			Fn:   checksum.AddBodyContentMD5Handler,
+		})
+	}
+	if _, ok := output.(*cosEmptyOutput); ok {
+		req.Handlers.Unmarshal.Swap(restxml.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	}
+	return req.Send()
+}
+
+func cosPutBucketLifecycle(client *s3.S3, bucket string, rules []*cosLifecycleRule) error {
+	input := &cosPutBucketLifecycleInput{
+		Bucket:                 aws.String(bucket),
+		LifecycleConfiguration: &cosLifecycleConfiguration{Rules: rules},
+	}
+	return cosSend(client, "PutBucketLifecycleConfiguration", "PUT", "/{Bucket}?lifecycle", input, &cosEmptyOutput{})
+}
+
+func cosGetBucketLifecycle(client *s3.S3, bucket string) ([]*cosLifecycleRule, error) {
+	output := &cosLifecycleConfiguration{}
+	err := cosSend(client, "GetBucketLifecycleConfiguration", "GET", "/{Bucket}?lifecycle", &cosBucketInput{Bucket: aws.String(bucket)}, output)
+	return output.Rules, err
+}
+
+// cosArchiveExpireRules returns the lifecycle rules as the rules of archive_rule
+// and expire_rule, if they all have their shape: a single archive rule with a
+// transition after some days, and expire rules with an expiration after some
+// days, filtered by prefix only.
+func cosArchiveExpireRules(in []*cosLifecycleRule) ([]*s3.LifecycleRule, bool) {
+	rules := make([]*s3.LifecycleRule, 0, len(in))
+	archives := 0
+	for _, r := range in {
+		if r.NoncurrentVersionExpiration != nil || r.AbortIncompleteMultipartUpload != nil {
+			return nil, false
+		}
+		if r.Filter != nil && (r.Filter.Tag != nil || r.Filter.And != nil) {
+			return nil, false
+		}
+		prefix := ""
+		if r.Filter != nil {
+			prefix = aws.StringValue(r.Filter.Prefix)
+		}
+		rule := &s3.LifecycleRule{
+			ID:     r.ID,
+			Status: r.Status,
+			Filter: &s3.LifecycleRuleFilter{Prefix: aws.String(prefix)},
+		}
+		switch {
+		case r.Expiration == nil && len(r.Transitions) == 1:
+			t := r.Transitions[0]
+			archives++
+			if archives > 1 || prefix != "" || t.Days == nil || t.Date != nil {
+				return nil, false
+			}
+			rule.Transitions = r.Transitions
+		case r.Expiration != nil && len(r.Transitions) == 0:
+			e := r.Expiration
+			if e.Days == nil || e.Date != nil || aws.BoolValue(e.ExpiredObjectDeleteMarker) {
+				return nil, false
+			}
+			rule.Expiration = &s3.LifecycleExpiration{Days: e.Days}
+		default:
+			return nil, false
+		}
+		rules = append(rules, rule)
+	}
+	return rules, true
+}
+
+func cosPutBucketReplication(client *s3.S3, bucket string, rules []*cosReplicationRule) error {
+	input := &cosPutBucketReplicationInput{
+		Bucket:                   aws.String(bucket),
+		ReplicationConfiguration: &cosReplicationConfiguration{Rules: rules},
+	}
+	return cosSend(client, "PutBucketReplication", "PUT", "/{Bucket}?replication", input, &cosEmptyOutput{})
+}
+
+func cosGetBucketReplication(client *s3.S3, bucket string) ([]*cosReplicationRule, error) {
+	output := &cosGetBucketReplicationOutput{}
+	err := cosSend(client, "GetBucketReplication", "GET", "/{Bucket}?replication", &cosBucketInput{Bucket: aws.String(bucket)}, output)
+	if output.ReplicationConfiguration == nil {
+		return nil, err
+	}
+	return output.ReplicationConfiguration.Rules, err
+}
+
+func cosDeleteBucketReplication(client *s3.S3, bucket string) error {
+	return cosSend(client, "DeleteBucketReplication", "DELETE", "/{Bucket}?replication", &cosBucketInput{Bucket: aws.String(bucket)}, &cosEmptyOutput{})
+}
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIBMCISDNSZoneFile() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMCISDNSZoneFileRead,
+
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "CIS instance crn",
+			},
+			cisDomainID: {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressDomainIDDiff,
+				Description:      "Associated CIS domain",
+			},
+			cisZoneName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the domain",
+			},
+			cisDNSZoneFileContent: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The records of the domain exported in the BIND zone file format",
+			},
+		},
+	}
+}
+
+func dataSourceIBMCISDNSZoneFileRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(ClientSession).CisDNSRecordBulkClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	crn := d.Get(cisID).(string)
+	zoneID, _, _ := convertTftoCisTwoVar(d.Get(cisDomainID).(string))
+	sess.Crn = core.StringPtr(crn)
+	sess.ZoneIdentifier = core.StringPtr(zoneID)
+
+	zoneName, _, err := getCISZoneName(meta, crn, zoneID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	result, response, err := sess.GetDnsRecordsBulk(sess.NewGetDnsRecordsBulkOptions())
+	if err != nil {
+		log.Printf("Error exporting dns records: %s", response)
+		return diag.FromErr(fmt.Errorf("Error exporting DNS records of domain %s: %s", zoneID, err))
+	}
+	defer result.Close()
+	content, err := ioutil.ReadAll(result)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error reading DNS records of domain %s: %s", zoneID, err))
+	}
+
+	d.SetId(convertCisToTfTwoVar(zoneID, crn))
+	d.Set(cisDomainID, zoneID)
+	d.Set(cisZoneName, zoneName)
+	d.Set(cisDNSZoneFileContent, string(content))
+	return nil
+}
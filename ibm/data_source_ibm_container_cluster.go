@@ -4,7 +4,6 @@
 package ibm
 
 import (
-	"fmt"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -366,11 +365,11 @@ func dataSourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{})
 	}
 	clusterFields, err := csAPI.Find(name, targetEnv)
 	if err != nil {
-		return fmt.Errorf("Error retrieving cluster: %s", err)
+		return apiErrorf("container", err, nil, "Error retrieving cluster")
 	}
 	workerFields, err := wrkAPI.List(name, targetEnv)
 	if err != nil {
-		return fmt.Errorf("Error retrieving workers for cluster: %s", err)
+		return apiErrorf("container", err, nil, "Error retrieving workers for cluster")
 	}
 	workers := make([]string, len(workerFields))
 	for i, worker := range workerFields {
@@ -382,7 +381,7 @@ func dataSourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{})
 	if listBoundedServices {
 		servicesBoundToCluster, err := csAPI.ListServicesBoundToCluster(name, "", targetEnv)
 		if err != nil {
-			return fmt.Errorf("Error retrieving services bound to cluster: %s", err)
+			return apiErrorf("container", err, nil, "Error retrieving services bound to cluster")
 		}
 		for _, service := range servicesBoundToCluster {
 			boundedService := make(map[string]interface{})
@@ -396,12 +395,12 @@ func dataSourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{})
 
 	workerPools, err := workerPoolsAPI.ListWorkerPools(name, targetEnv)
 	if err != nil {
-		return fmt.Errorf("Error retrieving worker pools of the cluster %s: %s", name, err)
+		return apiErrorf("container", err, nil, "Error retrieving worker pools of the cluster %s", name)
 	}
 
 	albs, err := albsAPI.ListClusterALBs(name, targetEnv)
 	if err != nil && !strings.Contains(err.Error(), "The specified cluster is a lite cluster.") && !strings.Contains(err.Error(), "This operation is not supported for your cluster's version.") && !strings.Contains(err.Error(), "The specified cluster is a free cluster.") {
-		return fmt.Errorf("Error retrieving alb's of the cluster %s: %s", name, err)
+		return apiErrorf("container", err, nil, "Error retrieving alb's of the cluster %s", name)
 	}
 
 	filterType := d.Get("alb_type").(string)
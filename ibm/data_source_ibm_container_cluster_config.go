@@ -156,7 +156,7 @@ func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interf
 			// For the Network config we need to gather the certs so we must override the admin value
 			calicoConfigFilePath, clusterKeyDetails, err := csAPI.StoreConfigDetail(name, configDir, admin || true, network, targetEnv)
 			if err != nil {
-				return fmt.Errorf("Error downloading the cluster config [%s]: %s", name, err)
+				return apiErrorf("container", err, nil, "Error downloading the cluster config [%s]", name)
 			}
 			d.Set("calico_config_file_path", calicoConfigFilePath)
 			d.Set("admin_key", clusterKeyDetails.AdminKey)
@@ -169,7 +169,7 @@ func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interf
 		} else {
 			clusterKeyDetails, err := csAPI.GetClusterConfigDetail(name, configDir, admin, targetEnv)
 			if err != nil {
-				return fmt.Errorf("Error downloading the cluster config [%s]: %s", name, err)
+				return apiErrorf("container", err, nil, "Error downloading the cluster config [%s]", name)
 			}
 			d.Set("admin_key", clusterKeyDetails.AdminKey)
 			d.Set("admin_certificate", clusterKeyDetails.Admin)
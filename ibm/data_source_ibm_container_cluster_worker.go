@@ -4,8 +4,6 @@
 package ibm
 
 import (
-	"fmt"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -98,7 +96,7 @@ func dataSourceIBMContainerClusterWorkerRead(d *schema.ResourceData, meta interf
 
 	workerFields, err := wrkAPI.Get(workerID, targetEnv)
 	if err != nil {
-		return fmt.Errorf("Error retrieving worker: %s", err)
+		return apiErrorf("container", err, nil, "Error retrieving worker")
 	}
 
 	d.SetId(workerFields.ID)
@@ -4,7 +4,6 @@
 package ibm
 
 import (
-	"fmt"
 	"log"
 	"strings"
 
@@ -308,7 +307,7 @@ func dataSourceIBMContainerClusterVPCRead(d *schema.ResourceData, meta interface
 
 	cls, err := csClient.Clusters().GetCluster(clusterID, targetEnv)
 	if err != nil {
-		return fmt.Errorf("Error retrieving container vpc cluster: %s", err)
+		return apiErrorf("container", err, nil, "Error retrieving container vpc cluster")
 	}
 
 	d.SetId(cls.ID)
@@ -336,7 +335,7 @@ func dataSourceIBMContainerClusterVPCRead(d *schema.ResourceData, meta interface
 
 	workerFields, err := csClient.Workers().ListWorkers(clusterID, false, targetEnv)
 	if err != nil {
-		return fmt.Errorf("Error retrieving workers for cluster: %s", err)
+		return apiErrorf("container", err, nil, "Error retrieving workers for cluster")
 	}
 	workers := make([]string, len(workerFields))
 	for i, worker := range workerFields {
@@ -348,7 +347,7 @@ func dataSourceIBMContainerClusterVPCRead(d *schema.ResourceData, meta interface
 	//Get worker pools
 	pools, err := csClient.WorkerPools().ListWorkerPools(clusterID, targetEnv)
 	if err != nil {
-		return fmt.Errorf("Error retrieving worker pools for container vpc cluster: %s", err)
+		return apiErrorf("container", err, nil, "Error retrieving worker pools for container vpc cluster")
 	}
 
 	d.Set("worker_pools", flattenVpcWorkerPools(pools))
@@ -356,7 +355,7 @@ func dataSourceIBMContainerClusterVPCRead(d *schema.ResourceData, meta interface
 	if !strings.HasSuffix(cls.MasterKubeVersion, _OPENSHIFT) {
 		albs, err := csClient.Albs().ListClusterAlbs(clusterID, targetEnv)
 		if err != nil && !strings.Contains(err.Error(), "The specified cluster is a lite cluster.") {
-			return fmt.Errorf("Error retrieving alb's of the cluster %s: %s", clusterID, err)
+			return apiErrorf("container", err, nil, "Error retrieving alb's of the cluster %s", clusterID)
 		}
 
 		filterType := d.Get("alb_type").(string)
@@ -4,8 +4,6 @@
 package ibm
 
 import (
-	"fmt"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -100,7 +98,7 @@ func dataSourceIBMContainerVPCClusterWorkerRead(d *schema.ResourceData, meta int
 
 	workerFields, err := wrkAPI.Get(clusterID, workerID, targetEnv)
 	if err != nil {
-		return fmt.Errorf("Error retrieving worker: %s", err)
+		return apiErrorf("container", err, nil, "Error retrieving worker")
 	}
 
 	d.SetId(workerFields.ID)
@@ -0,0 +1,293 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM/networking-go-sdk/directlinkv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	dlRouteReportID                = "route_report_id"
+	dlRouteReportStatus            = "status"
+	dlAdvertisedRoutes             = "advertised_routes"
+	dlGatewayRoutes                = "gateway_routes"
+	dlOnPremRoutes                 = "on_prem_routes"
+	dlOverlappingRoutes            = "overlapping_routes"
+	dlVirtualConnectionRoutes      = "virtual_connection_routes"
+	dlRouteReportVirtualConnection = "virtual_connection_id"
+	dlRouteReportPending           = "pending"
+	dlRouteReportComplete          = "complete"
+)
+
+func dataSourceIBMDLRouteReport() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMDLRouteReportRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			dlActionGateway: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Direct Link gateway identifier",
+			},
+			dlRouteReportID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The route report identifier",
+			},
+			dlRouteReportStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The route report status",
+			},
+			dlCreatedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the route report was requested",
+			},
+			dlCakUpdatedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the route report was generated",
+			},
+			dlAdvertisedRoutes: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The routes advertised to the on prem network",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"as_path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			dlGatewayRoutes: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The routes of the gateway",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"prefix": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			dlOnPremRoutes: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The routes learned from the on prem network over BGP",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"as_path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"next_hop": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			dlOverlappingRoutes: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The sets of on prem and virtual connection routes overlapping each other",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"routes": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"prefix": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The type of the route, on_prem or virtual_connection",
+									},
+									dlRouteReportVirtualConnection: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The virtual connection identifier of a virtual_connection route",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			dlVirtualConnectionRoutes: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The routes of the virtual connections of the gateway",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						dlRouteReportVirtualConnection: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The virtual connection identifier",
+						},
+						"virtual_connection_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"virtual_connection_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"routes": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"active": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"local_preference": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"prefix": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMDLRouteReportRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	directLink, err := directlinkClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	gatewayId := d.Get(dlActionGateway).(string)
+	report, response, err := createDLRouteReport(directLink, gatewayId)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error creating Direct Link Gateway route report: %s\n%s", err, response))
+	}
+
+	result, err := isWaitForDirectLinkRouteReportComplete(context, directLink, gatewayId, report.ID, d.Timeout(schema.TimeoutRead))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	report = result.(*dlRouteReport)
+
+	advertised := make([]map[string]interface{}, 0, len(report.AdvertisedRoutes))
+	for _, route := range report.AdvertisedRoutes {
+		advertised = append(advertised, map[string]interface{}{
+			"as_path": route.AsPath,
+			"prefix":  route.Prefix,
+		})
+	}
+	gateway := make([]map[string]interface{}, 0, len(report.GatewayRoutes))
+	for _, route := range report.GatewayRoutes {
+		gateway = append(gateway, map[string]interface{}{"prefix": route.Prefix})
+	}
+	onPrem := make([]map[string]interface{}, 0, len(report.OnPremRoutes))
+	for _, route := range report.OnPremRoutes {
+		onPrem = append(onPrem, map[string]interface{}{
+			"as_path":  route.AsPath,
+			"next_hop": route.NextHop,
+			"prefix":   route.Prefix,
+		})
+	}
+	overlapping := make([]map[string]interface{}, 0, len(report.OverlappingRoutes))
+	for _, overlap := range report.OverlappingRoutes {
+		routes := make([]map[string]interface{}, 0, len(overlap.Routes))
+		for _, route := range overlap.Routes {
+			routes = append(routes, map[string]interface{}{
+				"prefix":                       route.Prefix,
+				"type":                         route.Type,
+				dlRouteReportVirtualConnection: route.VirtualConnectionID,
+			})
+		}
+		overlapping = append(overlapping, map[string]interface{}{"routes": routes})
+	}
+	virtualConnections := make([]map[string]interface{}, 0, len(report.VirtualConnectionRoutes))
+	for _, vc := range report.VirtualConnectionRoutes {
+		routes := make([]map[string]interface{}, 0, len(vc.Routes))
+		for _, route := range vc.Routes {
+			routes = append(routes, map[string]interface{}{
+				"active":           route.Active,
+				"local_preference": route.LocalPreference,
+				"prefix":           route.Prefix,
+			})
+		}
+		virtualConnections = append(virtualConnections, map[string]interface{}{
+			dlRouteReportVirtualConnection: vc.VirtualConnectionID,
+			"virtual_connection_name":      vc.VirtualConnectionName,
+			"virtual_connection_type":      vc.VirtualConnectionType,
+			"routes":                       routes,
+		})
+	}
+
+	d.SetId(report.ID)
+	d.Set(dlRouteReportID, report.ID)
+	d.Set(dlRouteReportStatus, report.Status)
+	d.Set(dlCreatedAt, report.CreatedAt)
+	d.Set(dlCakUpdatedAt, report.UpdatedAt)
+	d.Set(dlAdvertisedRoutes, advertised)
+	d.Set(dlGatewayRoutes, gateway)
+	d.Set(dlOnPremRoutes, onPrem)
+	d.Set(dlOverlappingRoutes, overlapping)
+	d.Set(dlVirtualConnectionRoutes, virtualConnections)
+
+	return nil
+}
+
+func isWaitForDirectLinkRouteReportComplete(context context.Context, client *directlinkv1.DirectLinkV1, gatewayId, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for direct link gateway route report (%s) to be complete.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"retry", dlRouteReportPending},
+		Target:  []string{dlRouteReportComplete},
+		Refresh: func() (interface{}, string, error) {
+			report, response, err := getDLRouteReport(client, gatewayId, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error Getting Direct Link Gateway route report: %s\n%s", err, response)
+			}
+			return report, report.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
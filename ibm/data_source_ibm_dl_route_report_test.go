@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gotest.tools/assert"
+)
+
+func TestAccIBMDLRouteReportDataSource_basic(t *testing.T) {
+	node := "data.ibm_dl_route_report.test_dl_route_report"
+	vcName := fmt.Sprintf("vc-name-%d", acctest.RandIntRange(10, 100))
+	gatewayname := fmt.Sprintf("gateway-name-%d", acctest.RandIntRange(10, 100))
+	custname := fmt.Sprintf("customer-name-%d", acctest.RandIntRange(10, 100))
+	carriername := fmt.Sprintf("carrier-name-%d", acctest.RandIntRange(10, 100))
+	vpcname := fmt.Sprintf("tf-vpcname-%d", acctest.RandIntRange(100, 200))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDLRouteReportDataSourceConfig(vcName, gatewayname, custname, carriername, vpcname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(node, "route_report_id"),
+					resource.TestCheckResourceAttr(node, "status", "complete"),
+					resource.TestCheckResourceAttr(node, "virtual_connection_routes.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDLRouteReportDataSourceConfig(vcName, gatewayname, custname, carriername, vpcname string) string {
+	return testAccCheckIBMDLGatewayVCConfig("vpc", vcName, gatewayname, custname, carriername, vpcname) + `
+	data "ibm_dl_route_report" "test_dl_route_report" {
+		gateway = ibm_dl_virtual_connection.test_dl_gateway_vc.gateway
+	}
+	`
+}
+
+func TestIBMDLRouteReportDataSourceMock(t *testing.T) {
+	t.Parallel()
+	srv := testMockServer(t)
+	meta := testMockClientSession(t, srv)
+	gatewayID := testMockDLMacsecGateway(t, meta)
+
+	client, err := directlinkClient(meta)
+	assert.NilError(t, err)
+	options := client.NewCreateGatewayVirtualConnectionOptions(gatewayID, "mock-vpc", "vpc")
+	options.SetNetworkID("crn:v1:bluemix:public:is:us-south:a/mockserver::vpc:mock-vpc")
+	vc, _, err := client.CreateGatewayVirtualConnection(options)
+	assert.NilError(t, err)
+
+	ds := dataSourceIBMDLRouteReport()
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{"gateway": gatewayID})
+	assert.NilError(t, testDiagsErr(ds.ReadContext(context.Background(), d, meta)))
+	assert.Equal(t, d.Id(), d.Get("route_report_id"))
+	assert.Equal(t, d.Get("status"), "complete")
+	assert.Equal(t, d.Get("gateway_routes.#"), 1)
+	assert.Equal(t, d.Get("gateway_routes.0.prefix"), "169.254.0.0/30")
+	assert.Equal(t, d.Get("on_prem_routes.#"), 2)
+	assert.Equal(t, d.Get("on_prem_routes.0.next_hop"), "169.254.0.2")
+	assert.Equal(t, d.Get("on_prem_routes.0.as_path"), "64999")
+
+	assert.Equal(t, d.Get("virtual_connection_routes.#"), 1)
+	assert.Equal(t, d.Get("virtual_connection_routes.0.virtual_connection_id"), *vc.ID)
+	assert.Equal(t, d.Get("virtual_connection_routes.0.virtual_connection_type"), "vpc")
+	assert.Equal(t, d.Get("virtual_connection_routes.0.routes.0.prefix"), "10.240.0.0/18")
+	assert.Equal(t, d.Get("virtual_connection_routes.0.routes.0.active"), true)
+	assert.Equal(t, d.Get("advertised_routes.#"), 1)
+	assert.Equal(t, d.Get("advertised_routes.0.as_path"), "13884")
+
+	// The on prem route overlaps the VPC route
+	assert.Equal(t, d.Get("overlapping_routes.#"), 1)
+	assert.Equal(t, d.Get("overlapping_routes.0.routes.0.type"), "on_prem")
+	assert.Equal(t, d.Get("overlapping_routes.0.routes.0.prefix"), "10.240.0.0/24")
+	assert.Equal(t, d.Get("overlapping_routes.0.routes.1.type"), "virtual_connection")
+	assert.Equal(t, d.Get("overlapping_routes.0.routes.1.virtual_connection_id"), *vc.ID)
+
+	d = schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{"gateway": "mock-missing"})
+	assert.ErrorContains(t, testDiagsErr(ds.ReadContext(context.Background(), d, meta)), "not found")
+}
@@ -146,7 +146,7 @@ func dataIBMIAMAccessGroupRead(d *schema.ResourceData, meta interface{}) error {
 
 	retreivedGroups, err := iamuumClient.AccessGroup().List(accountID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving access groups: %s", err)
+		return apiErrorf("iam", err, nil, "Error retrieving access groups")
 	}
 
 	if len(retreivedGroups) == 0 {
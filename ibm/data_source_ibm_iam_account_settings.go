@@ -135,7 +135,7 @@ func dataSourceIbmIamAccountSettingsRead(context context.Context, d *schema.Reso
 	accountSettingsResponse, response, err := iamIdentityClient.GetAccountSettings(getAccountSettingsOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetAccountSettings failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return diag.FromErr(apiErrorf("iam", err, response, "Error getting account settings"))
 	}
 
 	d.SetId(userDetails.userAccount)
@@ -96,7 +96,7 @@ func dataSourceIbmIamApiKeyRead(context context.Context, d *schema.ResourceData,
 	apiKey, response, err := iamIdentityClient.GetAPIKey(getApiKeyOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetApiKey failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return diag.FromErr(apiErrorf("iam", err, response, "Error getting API key"))
 	}
 
 	d.SetId(*apiKey.ID)
@@ -344,7 +344,7 @@ func dataSourceIbmIsDedicatedHostRead(context context.Context, d *schema.Resourc
 	dedicatedHostCollection, response, err := vpcClient.ListDedicatedHostsWithContext(context, listDedicatedHostsOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListDedicatedHostsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error listing dedicated hosts"))
 	}
 	name := d.Get("name").(string)
 	if len(dedicatedHostCollection.DedicatedHosts) != 0 {
@@ -143,7 +143,7 @@ func dataSourceIbmIsDedicatedHostDiskRead(context context.Context, d *schema.Res
 	dedicatedHostDisk, response, err := vpcClient.GetDedicatedHostDiskWithContext(context, getDedicatedHostDiskOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetDedicatedHostDiskWithContext failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error getting dedicated host disk"))
 	}
 
 	d.SetId(*dedicatedHostDisk.ID)
@@ -152,7 +152,7 @@ func dataSourceIbmIsDedicatedHostDisksRead(context context.Context, d *schema.Re
 	dedicatedHostDiskCollection, response, err := vpcClient.ListDedicatedHostDisksWithContext(context, listDedicatedHostDisksOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListDedicatedHostDisksWithContext failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error listing dedicated host disks"))
 	}
 
 	d.SetId(dataSourceIbmIsDedicatedHostDisksID(d))
@@ -146,7 +146,7 @@ func dataSourceIbmIsDedicatedHostGroupRead(context context.Context, d *schema.Re
 	dedicatedHostGroupCollection, response, err := vpcClient.ListDedicatedHostGroupsWithContext(context, listDedicatedHostGroupsOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListDedicatedHostGroupsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error listing dedicated host groups"))
 	}
 
 	name := d.Get("name").(string)
@@ -198,7 +198,7 @@ func dataSourceIbmIsDedicatedHostGroupsRead(context context.Context, d *schema.R
 	dedicatedHostGroupCollection, response, err := vpcClient.ListDedicatedHostGroupsWithContext(context, listDedicatedHostGroupsOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListDedicatedHostGroupsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error listing dedicated host groups"))
 	}
 
 	if len(dedicatedHostGroupCollection.Groups) != 0 {
@@ -318,7 +318,7 @@ func dataSourceIbmIsDedicatedHostProfileRead(context context.Context, d *schema.
 	dedicatedHostProfile, response, err := vpcClient.GetDedicatedHostProfileWithContext(context, getDedicatedHostProfileOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListDedicatedHostProfilesWithContext failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error getting dedicated host profile"))
 	}
 	if dedicatedHostProfile == nil {
 		return diag.FromErr(fmt.Errorf("No Dedicated Host Profile found with name %s", name))
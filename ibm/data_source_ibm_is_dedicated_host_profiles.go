@@ -365,7 +365,7 @@ func dataSourceIbmIsDedicatedHostProfilesRead(context context.Context, d *schema
 	dedicatedHostProfileCollection, response, err := vpcClient.ListDedicatedHostProfilesWithContext(context, listDedicatedHostProfilesOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListDedicatedHostProfilesWithContext failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error listing dedicated host profiles"))
 	}
 
 	if dedicatedHostProfileCollection.First != nil {
@@ -400,7 +400,7 @@ func dataSourceIbmIsDedicatedHostsRead(context context.Context, d *schema.Resour
 	dedicatedHostCollection, response, err := vpcClient.ListDedicatedHostsWithContext(context, listDedicatedHostsOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListDedicatedHostsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error listing dedicated hosts"))
 	}
 
 	if len(dedicatedHostCollection.DedicatedHosts) != 0 {
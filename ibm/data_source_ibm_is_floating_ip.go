@@ -102,7 +102,7 @@ func classicFloatingIPGet(d *schema.ResourceData, meta interface{}, name string)
 		}
 		floatingIPs, response, err := sess.ListFloatingIps(floatingIPOptions)
 		if err != nil {
-			return fmt.Errorf("Error Fetching floating IPs %s\n%s", err, response)
+			return apiErrorf("vpc_classic", err, response, "Error Fetching floating IPs")
 		}
 		start = GetNext(floatingIPs.Next)
 		allFloatingIPs = append(allFloatingIPs, floatingIPs.FloatingIps...)
@@ -154,7 +154,7 @@ func floatingIPGet(d *schema.ResourceData, meta interface{}, name string) error
 		}
 		floatingIPs, response, err := sess.ListFloatingIps(floatingIPOptions)
 		if err != nil {
-			return fmt.Errorf("Error Fetching floating IPs %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Fetching floating IPs")
 		}
 		start = GetNext(floatingIPs.Next)
 		allFloatingIPs = append(allFloatingIPs, floatingIPs.FloatingIps...)
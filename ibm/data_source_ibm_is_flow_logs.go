@@ -4,7 +4,6 @@
 package ibm
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -104,7 +103,7 @@ func dataSourceIBMISFlowLogsRead(d *schema.ResourceData, meta interface{}) error
 		}
 		flowlogCollectors, response, err := sess.ListFlowLogCollectors(listOptions)
 		if err != nil {
-			return fmt.Errorf("Error Fetching Flow Logs for VPC %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Fetching Flow Logs for VPC")
 		}
 		start = GetNext(flowlogCollectors.Next)
 		allrecs = append(allrecs, flowlogCollectors.FlowLogCollectors...)
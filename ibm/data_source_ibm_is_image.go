@@ -4,16 +4,18 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMISImage() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMISImageRead,
+		ReadContext: dataSourceIBMISImageRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -70,10 +72,10 @@ func dataSourceIBMISImage() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISImageRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISImageRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	name := d.Get("name").(string)
 	var visibility string
@@ -83,15 +85,11 @@ func dataSourceIBMISImageRead(d *schema.ResourceData, meta interface{}) error {
 	if userDetails.generation == 1 {
 		err := classicImageGet(d, meta, name, visibility)
 		if err != nil {
-			return err
-		}
-	} else {
-		err := imageGet(d, meta, name, visibility)
-		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
+		return nil
 	}
-	return nil
+	return imageGet(d, meta, name, visibility)
 }
 
 func classicImageGet(d *schema.ResourceData, meta interface{}, name, visibility string) error {
@@ -111,7 +109,7 @@ func classicImageGet(d *schema.ResourceData, meta interface{}, name, visibility
 		}
 		availableImages, response, err := sess.ListImages(listImagesOptions)
 		if err != nil {
-			return fmt.Errorf("Error Fetching Images %s\n%s", err, response)
+			return apiErrorf("vpc_classic", err, response, "Error Fetching Images")
 		}
 		start = GetNext(availableImages.Next)
 		allrecs = append(allrecs, availableImages.Images...)
@@ -134,10 +132,11 @@ func classicImageGet(d *schema.ResourceData, meta interface{}, name, visibility
 	return fmt.Errorf("No Image found with name %s", name)
 }
 
-func imageGet(d *schema.ResourceData, meta interface{}, name, visibility string) error {
+func imageGet(d *schema.ResourceData, meta interface{}, name, visibility string) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	start := ""
 	allrecs := []vpcv1.Image{}
@@ -151,7 +150,7 @@ func imageGet(d *schema.ResourceData, meta interface{}, name, visibility string)
 		}
 		availableImages, response, err := sess.ListImages(listImagesOptions)
 		if err != nil {
-			return fmt.Errorf("Error Fetching Images %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error Fetching Images"))
 		}
 		start = GetNext(availableImages.Next)
 		allrecs = append(allrecs, availableImages.Images...)
@@ -165,7 +164,11 @@ func imageGet(d *schema.ResourceData, meta interface{}, name, visibility string)
 			d.SetId(*image.ID)
 			d.Set("status", *image.Status)
 			if *image.Status == "deprecated" {
-				fmt.Printf("[WARN] Given image %s is deprecated and soon will be obsolete.", name)
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("Image %s is deprecated", name),
+					Detail:   "The image is deprecated and will soon be obsolete.",
+				})
 			}
 			d.Set("name", *image.Name)
 			d.Set("visibility", *image.Visibility)
@@ -181,9 +184,9 @@ func imageGet(d *schema.ResourceData, meta interface{}, name, visibility string)
 			if image.File != nil && image.File.Checksums != nil {
 				d.Set(isImageCheckSum, *image.File.Checksums.Sha256)
 			}
-			return nil
+			return diags
 		}
 	}
 
-	return fmt.Errorf("No image found with name  %s", name)
+	return diag.FromErr(fmt.Errorf("No image found with name  %s", name))
 }
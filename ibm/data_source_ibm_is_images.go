@@ -4,7 +4,6 @@
 package ibm
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
@@ -118,7 +117,7 @@ func classicImageList(d *schema.ResourceData, meta interface{}) error {
 		}
 		availableImages, response, err := sess.ListImages(listImagesOptions)
 		if err != nil {
-			return fmt.Errorf("Error Fetching Images %s\n%s", err, response)
+			return apiErrorf("vpc_classic", err, response, "Error Fetching Images")
 		}
 		start = GetNext(availableImages.Next)
 		allrecs = append(allrecs, availableImages.Images...)
@@ -159,7 +158,7 @@ func imageList(d *schema.ResourceData, meta interface{}) error {
 		}
 		availableImages, response, err := sess.ListImages(listImagesOptions)
 		if err != nil {
-			return fmt.Errorf("Error Fetching Images %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Fetching Images")
 		}
 		start = GetNext(availableImages.Next)
 		allrecs = append(allrecs, availableImages.Images...)
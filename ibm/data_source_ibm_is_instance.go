@@ -460,7 +460,7 @@ func classicInstanceGetByName(d *schema.ResourceData, meta interface{}, name str
 		}
 		instances, response, err := sess.ListInstances(listInstancesOptions)
 		if err != nil {
-			return fmt.Errorf("Error Fetching Instances %s\n%s", err, response)
+			return apiErrorf("vpc_classic", err, response, "Error Fetching Instances")
 		}
 		start = GetNext(instances.Next)
 		allrecs = append(allrecs, instances.Instances...)
@@ -501,7 +501,7 @@ func classicInstanceGetByName(d *schema.ResourceData, meta interface{}, name str
 				}
 				insnic, response, err := sess.GetInstanceNetworkInterface(getnicoptions)
 				if err != nil {
-					return fmt.Errorf("Error getting network interfaces attached to the instance %s\n%s", err, response)
+					return apiErrorf("vpc_classic", err, response, "Error getting network interfaces attached to the instance")
 				}
 				currentPrimNic[isInstanceNicSubnet] = *insnic.Subnet.ID
 				if len(insnic.SecurityGroups) != 0 {
@@ -530,7 +530,7 @@ func classicInstanceGetByName(d *schema.ResourceData, meta interface{}, name str
 						}
 						insnic, response, err := sess.GetInstanceNetworkInterface(getnicoptions)
 						if err != nil {
-							return fmt.Errorf("Error getting network interfaces attached to the instance %s\n%s", err, response)
+							return apiErrorf("vpc_classic", err, response, "Error getting network interfaces attached to the instance")
 						}
 						currentNic[isInstanceNicSubnet] = *insnic.Subnet.ID
 						if len(insnic.SecurityGroups) != 0 {
@@ -611,7 +611,7 @@ func classicInstanceGetByName(d *schema.ResourceData, meta interface{}, name str
 			}
 			initParms, response, err := sess.GetInstanceInitialization(getInstanceInitializationOptions)
 			if err != nil {
-				return fmt.Errorf("Error Getting instance Initialization: %s\n%s", err, response)
+				return apiErrorf("vpc_classic", err, response, "Error Getting instance Initialization")
 			}
 			if initParms.Keys != nil {
 				initKeyList := make([]map[string]interface{}, 0)
@@ -740,7 +740,7 @@ func instanceGetByName(d *schema.ResourceData, meta interface{}, name string) er
 		}
 		instances, response, err := sess.ListInstances(listInstancesOptions)
 		if err != nil {
-			return fmt.Errorf("Error Fetching Instances %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Fetching Instances")
 		}
 		start = GetNext(instances.Next)
 		allrecs = append(allrecs, instances.Instances...)
@@ -788,7 +788,7 @@ func instanceGetByName(d *schema.ResourceData, meta interface{}, name string) er
 				}
 				insnic, response, err := sess.GetInstanceNetworkInterface(getnicoptions)
 				if err != nil {
-					return fmt.Errorf("Error getting network interfaces attached to the instance %s\n%s", err, response)
+					return apiErrorf("vpc", err, response, "Error getting network interfaces attached to the instance")
 				}
 				currentPrimNic[isInstanceNicSubnet] = *insnic.Subnet.ID
 				if len(insnic.SecurityGroups) != 0 {
@@ -817,7 +817,7 @@ func instanceGetByName(d *schema.ResourceData, meta interface{}, name string) er
 						}
 						insnic, response, err := sess.GetInstanceNetworkInterface(getnicoptions)
 						if err != nil {
-							return fmt.Errorf("Error getting network interfaces attached to the instance %s\n%s", err, response)
+							return apiErrorf("vpc", err, response, "Error getting network interfaces attached to the instance")
 						}
 						currentNic[isInstanceNicSubnet] = *insnic.Subnet.ID
 						if len(insnic.SecurityGroups) != 0 {
@@ -898,7 +898,7 @@ func instanceGetByName(d *schema.ResourceData, meta interface{}, name string) er
 			}
 			initParms, response, err := sess.GetInstanceInitialization(getInstanceInitializationOptions)
 			if err != nil {
-				return fmt.Errorf("Error Getting instance Initialization: %s\n%s", err, response)
+				return apiErrorf("vpc", err, response, "Error Getting instance Initialization")
 			}
 			if initParms.Keys != nil {
 				initKeyList := make([]map[string]interface{}, 0)
@@ -77,7 +77,7 @@ func dataSourceIbmIsInstanceDiskRead(context context.Context, d *schema.Resource
 	instanceDisk, response, err := vpcClient.GetInstanceDiskWithContext(context, getInstanceDiskOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetInstanceDiskWithContext failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error getting instance disk"))
 	}
 
 	d.SetId(*instanceDisk.ID)
@@ -85,7 +85,7 @@ func dataSourceIbmIsInstanceDisksRead(context context.Context, d *schema.Resourc
 	instanceDiskCollection, response, err := vpcClient.ListInstanceDisksWithContext(context, listInstanceDisksOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListInstanceDisksWithContext failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error listing instance disks"))
 	}
 
 	d.SetId(dataSourceIbmIsInstanceDisksID(d))
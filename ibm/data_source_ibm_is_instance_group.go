@@ -99,7 +99,7 @@ func dataSourceIBMISInstanceGroupRead(d *schema.ResourceData, meta interface{})
 		}
 		instanceGroupsCollection, response, err := sess.ListInstanceGroups(&listInstanceGroupOptions)
 		if err != nil {
-			return fmt.Errorf("Error Fetching InstanceGroups %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Fetching InstanceGroups")
 		}
 		start = GetNext(instanceGroupsCollection.Next)
 		allrecs = append(allrecs, instanceGroupsCollection.InstanceGroups...)
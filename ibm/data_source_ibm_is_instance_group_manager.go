@@ -92,7 +92,7 @@ func dataSourceIBMISInstanceGroupManagerRead(d *schema.ResourceData, meta interf
 		}
 		instanceGroupManagerCollections, response, err := sess.ListInstanceGroupManagers(&listInstanceGroupManagerOptions)
 		if err != nil {
-			return fmt.Errorf("Error Getting InstanceGroup Managers %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Getting InstanceGroup Managers")
 		}
 		start = GetNext(instanceGroupManagerCollections.Next)
 		allrecs = append(allrecs, instanceGroupManagerCollections.Managers...)
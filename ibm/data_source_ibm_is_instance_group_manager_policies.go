@@ -98,7 +98,7 @@ func dataSourceIBMISInstanceGroupManagerPoliciesRead(d *schema.ResourceData, met
 
 		instanceGroupManagerPolicyCollection, response, err := sess.ListInstanceGroupManagerPolicies(&listInstanceGroupManagerPoliciesOptions)
 		if err != nil {
-			return fmt.Errorf("Error Getting InstanceGroup Manager Policies %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Getting InstanceGroup Manager Policies")
 		}
 		start = GetNext(instanceGroupManagerPolicyCollection.Next)
 		allrecs = append(allrecs, instanceGroupManagerPolicyCollection.Policies...)
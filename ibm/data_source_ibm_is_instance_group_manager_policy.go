@@ -82,7 +82,7 @@ func dataSourceIBMISInstanceGroupManagerPolicyRead(d *schema.ResourceData, meta
 
 		instanceGroupManagerPolicyCollection, response, err := sess.ListInstanceGroupManagerPolicies(&listInstanceGroupManagerPoliciesOptions)
 		if err != nil {
-			return fmt.Errorf("Error Getting InstanceGroup Manager Policies %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Getting InstanceGroup Manager Policies")
 		}
 		start = GetNext(instanceGroupManagerPolicyCollection.Next)
 		allrecs = append(allrecs, instanceGroupManagerPolicyCollection.Policies...)
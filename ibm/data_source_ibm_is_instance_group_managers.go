@@ -109,7 +109,7 @@ func dataSourceIBMISInstanceGroupManagersRead(d *schema.ResourceData, meta inter
 		}
 		instanceGroupManagerCollections, response, err := sess.ListInstanceGroupManagers(&listInstanceGroupManagerOptions)
 		if err != nil {
-			return fmt.Errorf("Error Getting InstanceGroup Managers %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Getting InstanceGroup Managers")
 		}
 
 		start = GetNext(instanceGroupManagerCollections.Next)
@@ -112,7 +112,7 @@ func dataSourceIBMISInstanceGroupMembershipRead(d *schema.ResourceData, meta int
 		}
 		instanceGroupMembershipCollection, response, err := sess.ListInstanceGroupMemberships(&listInstanceGroupMembershipsOptions)
 		if err != nil || instanceGroupMembershipCollection == nil {
-			return fmt.Errorf("Error Getting InstanceGroup Membership Collection %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Getting InstanceGroup Membership Collection")
 		}
 
 		start = GetNext(instanceGroupMembershipCollection.Next)
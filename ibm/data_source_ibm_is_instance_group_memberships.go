@@ -4,7 +4,6 @@
 package ibm
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -127,7 +126,7 @@ func dataSourceIBMISInstanceGroupMembershipsRead(d *schema.ResourceData, meta in
 		}
 		instanceGroupMembershipCollection, response, err := sess.ListInstanceGroupMemberships(&listInstanceGroupMembershipsOptions)
 		if err != nil {
-			return fmt.Errorf("Error Getting InstanceGroup Membership Collection %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Getting InstanceGroup Membership Collection")
 		}
 
 		start = GetNext(instanceGroupMembershipCollection.Next)
@@ -407,7 +407,7 @@ func classicInstanceProfilesList(d *schema.ResourceData, meta interface{}) error
 		}
 		availableProfiles, response, err := sess.ListInstanceProfiles(listInstanceProfilesOptions)
 		if err != nil {
-			return fmt.Errorf("Error Fetching Instance Profiles %s\n%s", err, response)
+			return apiErrorf("vpc_classic", err, response, "Error Fetching Instance Profiles")
 		}
 		start = GetNext(availableProfiles.Next)
 		allrecs = append(allrecs, availableProfiles.Profiles...)
@@ -437,7 +437,7 @@ func instanceProfilesList(d *schema.ResourceData, meta interface{}) error {
 	listInstanceProfilesOptions := &vpcv1.ListInstanceProfilesOptions{}
 	availableProfiles, response, err := sess.ListInstanceProfiles(listInstanceProfilesOptions)
 	if err != nil {
-		return fmt.Errorf("Error Fetching Instance Profiles %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Fetching Instance Profiles")
 	}
 	profilesInfo := make([]map[string]interface{}, 0)
 	for _, profile := range availableProfiles.Profiles {
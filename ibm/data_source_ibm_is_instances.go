@@ -4,7 +4,6 @@
 package ibm
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
@@ -332,7 +331,7 @@ func classicInstancesList(d *schema.ResourceData, meta interface{}) error {
 		}
 		instances, response, err := sess.ListInstances(listInstancesOptions)
 		if err != nil {
-			return fmt.Errorf("Error Fetching Instances %s\n%s", err, response)
+			return apiErrorf("vpc_classic", err, response, "Error Fetching Instances")
 		}
 		start = GetNext(instances.Next)
 		allrecs = append(allrecs, instances.Instances...)
@@ -395,7 +394,7 @@ func classicInstancesList(d *schema.ResourceData, meta interface{}) error {
 			}
 			insnic, response, err := sess.GetInstanceNetworkInterface(getnicoptions)
 			if err != nil {
-				return fmt.Errorf("Error getting network interfaces attached to the instance %s\n%s", err, response)
+				return apiErrorf("vpc_classic", err, response, "Error getting network interfaces attached to the instance")
 			}
 			currentPrimNic[isInstanceNicSubnet] = *insnic.Subnet.ID
 			if len(insnic.SecurityGroups) != 0 {
@@ -424,7 +423,7 @@ func classicInstancesList(d *schema.ResourceData, meta interface{}) error {
 					}
 					insnic, response, err := sess.GetInstanceNetworkInterface(getnicoptions)
 					if err != nil {
-						return fmt.Errorf("Error getting network interfaces attached to the instance %s\n%s", err, response)
+						return apiErrorf("vpc_classic", err, response, "Error getting network interfaces attached to the instance")
 					}
 					currentNic[isInstanceNicSubnet] = *insnic.Subnet.ID
 					if len(insnic.SecurityGroups) != 0 {
@@ -495,7 +494,7 @@ func instancesList(d *schema.ResourceData, meta interface{}) error {
 
 		instances, response, err := sess.ListInstances(listInstancesOptions)
 		if err != nil {
-			return fmt.Errorf("Error Fetching Instances %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Fetching Instances")
 		}
 		start = GetNext(instances.Next)
 		allrecs = append(allrecs, instances.Instances...)
@@ -558,7 +557,7 @@ func instancesList(d *schema.ResourceData, meta interface{}) error {
 			}
 			insnic, response, err := sess.GetInstanceNetworkInterface(getnicoptions)
 			if err != nil {
-				return fmt.Errorf("Error getting network interfaces attached to the instance %s\n%s", err, response)
+				return apiErrorf("vpc", err, response, "Error getting network interfaces attached to the instance")
 			}
 			currentPrimNic[isInstanceNicSubnet] = *insnic.Subnet.ID
 			if len(insnic.SecurityGroups) != 0 {
@@ -587,7 +586,7 @@ func instancesList(d *schema.ResourceData, meta interface{}) error {
 					}
 					insnic, response, err := sess.GetInstanceNetworkInterface(getnicoptions)
 					if err != nil {
-						return fmt.Errorf("Error getting network interfaces attached to the instance %s\n%s", err, response)
+						return apiErrorf("vpc", err, response, "Error getting network interfaces attached to the instance")
 					}
 					currentNic[isInstanceNicSubnet] = *insnic.Subnet.ID
 					if len(insnic.SecurityGroups) != 0 {
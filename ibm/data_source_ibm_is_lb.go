@@ -261,7 +261,7 @@ func classiclbGetbyName(d *schema.ResourceData, meta interface{}, name string) e
 	listLoadBalancersOptions := &vpcclassicv1.ListLoadBalancersOptions{}
 	lbs, response, err := sess.ListLoadBalancers(listLoadBalancersOptions)
 	if err != nil {
-		return fmt.Errorf("Error Fetching Load Balancers %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Fetching Load Balancers")
 	}
 	for _, lb := range lbs.LoadBalancers {
 		if *lb.Name == name {
@@ -396,7 +396,7 @@ func lbGetByName(d *schema.ResourceData, meta interface{}, name string) error {
 	listLoadBalancersOptions := &vpcv1.ListLoadBalancersOptions{}
 	lbs, response, err := sess.ListLoadBalancers(listLoadBalancersOptions)
 	if err != nil {
-		return fmt.Errorf("Error Fetching Load Balancers %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Fetching Load Balancers")
 	}
 	for _, lb := range lbs.LoadBalancers {
 		if *lb.Name == name {
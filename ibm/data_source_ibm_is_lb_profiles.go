@@ -4,7 +4,6 @@
 package ibm
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -64,7 +63,7 @@ func dataSourceIBMISLbProfilesRead(d *schema.ResourceData, meta interface{}) err
 		}
 		profileCollectors, response, err := sess.ListLoadBalancerProfiles(listOptions)
 		if err != nil {
-			return fmt.Errorf("Error Fetching Load Balancer Profiles for VPC %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Fetching Load Balancer Profiles for VPC")
 		}
 		start = GetNext(profileCollectors.Next)
 		allrecs = append(allrecs, profileCollectors.Profiles...)
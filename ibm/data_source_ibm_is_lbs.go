@@ -240,7 +240,7 @@ func classiclbs(d *schema.ResourceData, meta interface{}) error {
 	listLoadBalancersOptions := &vpcclassicv1.ListLoadBalancersOptions{}
 	lbs, response, err := sess.ListLoadBalancers(listLoadBalancersOptions)
 	if err != nil {
-		return fmt.Errorf("Error Fetching Load Balancers %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Fetching Load Balancers")
 	}
 
 	lbList := make([]map[string]interface{}, 0)
@@ -364,7 +364,7 @@ func getLbs(d *schema.ResourceData, meta interface{}) error {
 	listLoadBalancersOptions := &vpcv1.ListLoadBalancersOptions{}
 	lbs, response, err := sess.ListLoadBalancers(listLoadBalancersOptions)
 	if err != nil {
-		return fmt.Errorf("Error Fetching Load Balancers %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Fetching Load Balancers")
 	}
 	lbList := make([]map[string]interface{}, 0)
 
@@ -129,7 +129,7 @@ func classicPublicGatewayGet(d *schema.ResourceData, meta interface{}, name stri
 		}
 		publicgws, response, err := sess.ListPublicGateways(listPublicGatewaysOptions)
 		if err != nil {
-			return fmt.Errorf("Error Fetching public gateways %s\n%s", err, response)
+			return apiErrorf("vpc_classic", err, response, "Error Fetching public gateways")
 		}
 		start = GetNext(publicgws.Next)
 		allrecs = append(allrecs, publicgws.PublicGateways...)
@@ -193,7 +193,7 @@ func publicGatewayGet(d *schema.ResourceData, meta interface{}, name string) err
 		}
 		publicgws, response, err := sess.ListPublicGateways(listPublicGatewaysOptions)
 		if err != nil {
-			return fmt.Errorf("Error Fetching public gateways %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Fetching public gateways")
 		}
 		start = GetNext(publicgws.Next)
 		allrecs = append(allrecs, publicgws.PublicGateways...)
@@ -4,7 +4,6 @@
 package ibm
 
 import (
-	"fmt"
 	"log"
 	"time"
 
@@ -142,7 +141,7 @@ func publicGatewaysGet(d *schema.ResourceData, meta interface{}, name string) er
 		}
 		publicgws, response, err := sess.ListPublicGateways(listPublicGatewaysOptions)
 		if err != nil {
-			return fmt.Errorf("Error Fetching public gateways %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Fetching public gateways")
 		}
 		start = GetNext(publicgws.Next)
 		allrecs = append(allrecs, publicgws.PublicGateways...)
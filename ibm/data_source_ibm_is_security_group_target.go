@@ -69,7 +69,7 @@ func dataSourceIBMISSecurityGroupTargetRead(d *schema.ResourceData, meta interfa
 
 		groups, response, err := sess.ListSecurityGroupTargets(listSecurityGroupTargetsOptions)
 		if err != nil {
-			return fmt.Errorf("Error Getting InstanceGroup Managers %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Getting InstanceGroup Managers")
 		}
 		if *groups.TotalCount == int64(0) {
 			break
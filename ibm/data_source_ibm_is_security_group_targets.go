@@ -4,8 +4,6 @@
 package ibm
 
 import (
-	"fmt"
-
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -78,7 +76,7 @@ func dataSourceIBMISSecurityGroupTargetsRead(d *schema.ResourceData, meta interf
 
 		groups, response, err := sess.ListSecurityGroupTargets(listSecurityGroupTargetsOptions)
 		if err != nil || groups == nil {
-			return fmt.Errorf("Error Getting InstanceGroup Managers %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Getting InstanceGroup Managers")
 		}
 		if *groups.TotalCount == int64(0) {
 			break
@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const isSnapshotIdentifier = "identifier"
+
+func dataSourceIBMISSnapshot() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMISSnapshotRead,
+
+		Schema: map[string]*schema.Schema{
+			isSnapshotIdentifier: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{isSnapshotIdentifier, isSnapshotName},
+				Description:  "The unique identifier of the snapshot",
+			},
+			isSnapshotName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{isSnapshotIdentifier, isSnapshotName},
+				Description:  "The name of the snapshot",
+			},
+			isSnapshotSourceVolume: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the volume the snapshot was taken from",
+			},
+			isSnapshotResourceGroup: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the resource group of the snapshot",
+			},
+			isSnapshotTags: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         resourceIBMVPCHash,
+				Description: "Tags for the snapshot",
+			},
+			isSnapshotCRN: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the snapshot",
+			},
+			isSnapshotHref: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the snapshot",
+			},
+			isSnapshotBootable: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether an instance can be provisioned from the snapshot",
+			},
+			isSnapshotDeletable: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the snapshot can be deleted",
+			},
+			isSnapshotEncryption: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of encryption of the snapshot, provider_managed or user_managed",
+			},
+			isSnapshotEncryptionKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the root key of the snapshot, for user_managed encryption",
+			},
+			isSnapshotLifecycleState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the snapshot",
+			},
+			isSnapshotMinimumCapacity: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The minimum capacity, in gigabytes, of a volume created from the snapshot",
+			},
+			isSnapshotSize: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The size of the snapshot, in gigabytes",
+			},
+			isSnapshotOperatingSystem: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the operating system of a bootable snapshot",
+			},
+			isSnapshotSourceImage: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the image the source volume was created from",
+			},
+			isSnapshotResourceType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type of the snapshot",
+			},
+			isSnapshotCreatedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time the snapshot was created",
+			},
+		},
+	}
+}
+
+func dataSourceIBMISSnapshotRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var snapshot *vpcSnapshot
+	if id, ok := d.GetOk(isSnapshotIdentifier); ok {
+		found, response, err := getSnapshot(sess, id.(string))
+		if err != nil {
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error getting snapshot (%s)", id))
+		}
+		snapshot = found
+	} else {
+		name := d.Get(isSnapshotName).(string)
+		snapshots, err := listSnapshots(sess, map[string]string{"name": name})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if len(snapshots) == 0 {
+			return diag.FromErr(fmt.Errorf("No snapshot found with name %s", name))
+		}
+		snapshot = &snapshots[0]
+	}
+	d.SetId(snapshot.ID)
+	d.Set(isSnapshotIdentifier, snapshot.ID)
+	if err := setSnapshot(d, snapshot); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set(isSnapshotSourceVolume, snapshot.SourceVolume.ID)
+	d.Set(isSnapshotResourceGroup, snapshot.ResourceGroup.ID)
+	tags, err := GetTagsUsingCRN(meta, snapshot.CRN)
+	if err != nil {
+		log.Printf(
+			"Error on get of snapshot (%s) tags: %s", d.Id(), err)
+	}
+	d.Set(isSnapshotTags, tags)
+	return nil
+}
@@ -0,0 +1,229 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const isSnapshots = "snapshots"
+
+func dataSourceIBMISSnapshots() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMISSnapshotsRead,
+
+		Schema: map[string]*schema.Schema{
+			isSnapshotName: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filters the snapshots by name",
+			},
+			isSnapshotSourceVolume: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filters the snapshots by the unique identifier of their source volume",
+			},
+			isSnapshotSourceImage: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filters the snapshots by the unique identifier of the image of their source volume",
+			},
+			isSnapshotResourceGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filters the snapshots by the unique identifier of their resource group",
+			},
+			isSnapshots: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The snapshots",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the snapshot",
+						},
+						isSnapshotName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the snapshot",
+						},
+						isSnapshotSourceVolume: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the volume the snapshot was taken from",
+						},
+						isSnapshotResourceGroup: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the resource group of the snapshot",
+						},
+						isSnapshotCRN: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the snapshot",
+						},
+						isSnapshotHref: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL of the snapshot",
+						},
+						isSnapshotBootable: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether an instance can be provisioned from the snapshot",
+						},
+						isSnapshotDeletable: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the snapshot can be deleted",
+						},
+						isSnapshotEncryption: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of encryption of the snapshot, provider_managed or user_managed",
+						},
+						isSnapshotEncryptionKey: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the root key of the snapshot, for user_managed encryption",
+						},
+						isSnapshotLifecycleState: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The lifecycle state of the snapshot",
+						},
+						isSnapshotMinimumCapacity: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The minimum capacity, in gigabytes, of a volume created from the snapshot",
+						},
+						isSnapshotSize: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The size of the snapshot, in gigabytes",
+						},
+						isSnapshotOperatingSystem: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the operating system of a bootable snapshot",
+						},
+						isSnapshotSourceImage: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the image the source volume was created from",
+						},
+						isSnapshotCreatedAt: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time the snapshot was created",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMISSnapshotsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	query := map[string]string{}
+	filters := map[string]string{
+		isSnapshotName:          "name",
+		isSnapshotSourceVolume:  "source_volume.id",
+		isSnapshotSourceImage:   "source_image.id",
+		isSnapshotResourceGroup: "resource_group.id",
+	}
+	for key, param := range filters {
+		if v, ok := d.GetOk(key); ok {
+			query[param] = v.(string)
+		}
+	}
+	snapshots, err := listSnapshots(sess, query)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	snapshotsInfo := make([]map[string]interface{}, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		l := map[string]interface{}{
+			"id":                      snapshot.ID,
+			isSnapshotName:            snapshot.Name,
+			isSnapshotSourceVolume:    snapshot.SourceVolume.ID,
+			isSnapshotResourceGroup:   snapshot.ResourceGroup.ID,
+			isSnapshotCRN:             snapshot.CRN,
+			isSnapshotHref:            snapshot.Href,
+			isSnapshotBootable:        snapshot.Bootable,
+			isSnapshotDeletable:       snapshot.Deletable,
+			isSnapshotEncryption:      snapshot.Encryption,
+			isSnapshotLifecycleState:  snapshot.LifecycleState,
+			isSnapshotMinimumCapacity: snapshot.MinimumCapacity,
+			isSnapshotSize:            snapshot.Size,
+			isSnapshotCreatedAt:       snapshot.CreatedAt,
+		}
+		if snapshot.EncryptionKey != nil {
+			l[isSnapshotEncryptionKey] = snapshot.EncryptionKey.CRN
+		}
+		if snapshot.OperatingSystem != nil {
+			l[isSnapshotOperatingSystem] = snapshot.OperatingSystem.Name
+		}
+		if snapshot.SourceImage != nil {
+			l[isSnapshotSourceImage] = snapshot.SourceImage.ID
+		}
+		snapshotsInfo = append(snapshotsInfo, l)
+	}
+	d.SetId(dataSourceIBMISSnapshotsID(d))
+	if err := d.Set(isSnapshots, snapshotsInfo); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting snapshots: %s", err))
+	}
+	return nil
+}
+
+// dataSourceIBMISSnapshotsID returns a reasonable ID for the snapshot list.
+func dataSourceIBMISSnapshotsID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
+
+// listSnapshots returns every snapshot matching the filters of the query, following
+// the pages of the collection.
+func listSnapshots(sess *vpcv1.VpcV1, query map[string]string) ([]vpcSnapshot, error) {
+	snapshots := []vpcSnapshot{}
+	start := ""
+	for {
+		pageQuery := map[string]string{}
+		for k, v := range query {
+			pageQuery[k] = v
+		}
+		if start != "" {
+			pageQuery["start"] = start
+		}
+		page := &vpcSnapshotCollection{}
+		response, err := vpcRequest(sess, vpcRequestOptions{Method: core.GET, Path: "/snapshots", Query: pageQuery}, page)
+		if err != nil {
+			return nil, apiErrorf("vpc", err, response, "Error listing snapshots")
+		}
+		snapshots = append(snapshots, page.Snapshots...)
+		start = ""
+		if page.Next != nil {
+			if u, err := url.Parse(page.Next.Href); err == nil {
+				start = u.Query().Get("start")
+			}
+		}
+		if start == "" {
+			break
+		}
+	}
+	return snapshots, nil
+}
@@ -107,7 +107,7 @@ func classicKeyGetByName(d *schema.ResourceData, meta interface{}, name string)
 		}
 		keys, response, err := sess.ListKeys(listKeysOptions)
 		if err != nil {
-			return fmt.Errorf("Error Fetching Keys %s\n%s", err, response)
+			return apiErrorf("vpc_classic", err, response, "Error Fetching Keys")
 		}
 		start = GetNext(keys.Next)
 		allrecs = append(allrecs, keys.Keys...)
@@ -149,7 +149,7 @@ func keyGetByName(d *schema.ResourceData, meta interface{}, name string) error {
 	listKeysOptions := &vpcv1.ListKeysOptions{}
 	keys, response, err := sess.ListKeys(listKeysOptions)
 	if err != nil {
-		return fmt.Errorf("Error Fetching Keys %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Fetching Keys")
 	}
 	for _, key := range keys.Keys {
 		if *key.Name == name {
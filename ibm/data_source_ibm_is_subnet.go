@@ -180,7 +180,7 @@ func classicSubnetGetByNameOrID(d *schema.ResourceData, meta interface{}) error
 		}
 		subnetinfo, response, err := sess.GetSubnet(getSubnetOptions)
 		if err != nil {
-			return fmt.Errorf("Error Getting Subnet (%s): %s\n%s", id, err, response)
+			return apiErrorf("vpc_classic", err, response, "Error Getting Subnet (%s)", id)
 		}
 		subnet = subnetinfo
 	}
@@ -189,7 +189,7 @@ func classicSubnetGetByNameOrID(d *schema.ResourceData, meta interface{}) error
 		getSubnetsListOptions := &vpcclassicv1.ListSubnetsOptions{}
 		subnetsCollection, response, err := sess.ListSubnets(getSubnetsListOptions)
 		if err != nil {
-			return fmt.Errorf("Error Getting Subnets List : %s\n%s", err, response)
+			return apiErrorf("vpc_classic", err, response, "Error Getting Subnets List")
 		}
 		for _, subnetInfo := range subnetsCollection.Subnets {
 			if *subnetInfo.Name == name {
@@ -247,7 +247,7 @@ func subnetGetByNameOrID(d *schema.ResourceData, meta interface{}) error {
 		}
 		subnetinfo, response, err := sess.GetSubnet(getSubnetOptions)
 		if err != nil {
-			return fmt.Errorf("Error Getting Subnet (%s): %s\n%s", id, err, response)
+			return apiErrorf("vpc", err, response, "Error Getting Subnet (%s)", id)
 		}
 		subnet = subnetinfo
 	} else if v, ok := d.GetOk(isSubnetName); ok {
@@ -255,7 +255,7 @@ func subnetGetByNameOrID(d *schema.ResourceData, meta interface{}) error {
 		getSubnetsListOptions := &vpcv1.ListSubnetsOptions{}
 		subnetsCollection, response, err := sess.ListSubnets(getSubnetsListOptions)
 		if err != nil {
-			return fmt.Errorf("Error Getting Subnets List : %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Getting Subnets List")
 		}
 		for _, subnetInfo := range subnetsCollection.Subnets {
 			if *subnetInfo.Name == name {
@@ -4,8 +4,6 @@
 package ibm
 
 import (
-	"fmt"
-
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -112,7 +110,7 @@ func dataSdataSourceIBMISReservedIPRead(d *schema.ResourceData, meta interface{}
 	reserveIP, response, err := sess.GetSubnetReservedIP(options)
 
 	if err != nil || response == nil || reserveIP == nil {
-		return fmt.Errorf("Error fetching the reserved IP %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error fetching the reserved IP")
 	}
 
 	d.SetId(*reserveIP.ID)
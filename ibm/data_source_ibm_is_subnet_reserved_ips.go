@@ -4,7 +4,6 @@
 package ibm
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -124,7 +123,7 @@ func dataSdataSourceIBMISReservedIPsRead(d *schema.ResourceData, meta interface{
 
 		result, response, err := sess.ListSubnetReservedIps(options)
 		if err != nil || response == nil || result == nil {
-			return fmt.Errorf("Error fetching reserved ips %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error fetching reserved ips")
 		}
 		start = GetNext(result.Next)
 		allrecs = append(allrecs, result.ReservedIps...)
@@ -4,7 +4,6 @@
 package ibm
 
 import (
-	"fmt"
 	"strconv"
 	"time"
 
@@ -121,7 +120,7 @@ func classicSubnetList(d *schema.ResourceData, meta interface{}) error {
 		}
 		subnets, response, err := sess.ListSubnets(options)
 		if err != nil {
-			return fmt.Errorf("Error Fetching subnets %s\n%s", err, response)
+			return apiErrorf("vpc_classic", err, response, "Error Fetching subnets")
 		}
 		start = GetNext(subnets.Next)
 		allrecs = append(allrecs, subnets.Subnets...)
@@ -170,7 +169,7 @@ func subnetList(d *schema.ResourceData, meta interface{}) error {
 		}
 		subnets, response, err := sess.ListSubnets(options)
 		if err != nil {
-			return fmt.Errorf("Error Fetching subnets %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Fetching subnets")
 		}
 		start = GetNext(subnets.Next)
 		allrecs = append(allrecs, subnets.Subnets...)
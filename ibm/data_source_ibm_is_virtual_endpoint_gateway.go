@@ -117,7 +117,7 @@ func dataSourceIBMISEndpointGatewayRead(
 		}
 		result, response, err := sess.ListEndpointGateways(options)
 		if err != nil {
-			return fmt.Errorf("Error fetching endpoint gateways %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error fetching endpoint gateways")
 		}
 		start = GetNext(result.Next)
 		allrecs = append(allrecs, result.EndpointGateways...)
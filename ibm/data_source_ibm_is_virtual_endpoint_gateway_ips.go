@@ -4,7 +4,6 @@
 package ibm
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -102,7 +101,7 @@ func dataSourceIBMISEndpointGatewayIPsRead(d *schema.ResourceData, meta interfac
 		}
 		result, response, err := sess.ListEndpointGatewayIps(options)
 		if err != nil {
-			return fmt.Errorf("Error fetching endpoint gateway ips %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error fetching endpoint gateway ips")
 		}
 		start = GetNext(result.Next)
 		allrecs = append(allrecs, result.Ips...)
@@ -4,7 +4,6 @@
 package ibm
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -131,7 +130,7 @@ func dataSourceIBMISEndpointGatewaysRead(d *schema.ResourceData, meta interface{
 		}
 		result, response, err := sess.ListEndpointGateways(options)
 		if err != nil {
-			return fmt.Errorf("Error fetching endpoint gateways %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error fetching endpoint gateways")
 		}
 		start = GetNext(result.Next)
 		allrecs = append(allrecs, result.EndpointGateways...)
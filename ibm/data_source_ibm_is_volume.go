@@ -189,7 +189,7 @@ func classicVolumeGet(d *schema.ResourceData, meta interface{}, name string) err
 		listVolumesOptions.Name = &name
 		vols, response, err := sess.ListVolumes(listVolumesOptions)
 		if err != nil {
-			return fmt.Errorf("Error Fetching volumes %s\n%s", err, response)
+			return apiErrorf("vpc_classic", err, response, "Error Fetching volumes")
 		}
 		start = GetNext(vols.Next)
 		allrecs = append(allrecs, vols.Volumes...)
@@ -254,7 +254,7 @@ func volumeGet(d *schema.ResourceData, meta interface{}, name string) error {
 		listVolumesOptions.Name = &name
 		vols, response, err := sess.ListVolumes(listVolumesOptions)
 		if err != nil {
-			return fmt.Errorf("Error Fetching volumes %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Fetching volumes")
 		}
 		start = GetNext(vols.Next)
 		allrecs = append(allrecs, vols.Volumes...)
@@ -4,7 +4,6 @@
 package ibm
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
@@ -76,7 +75,7 @@ func classicVolumeProfilesList(d *schema.ResourceData, meta interface{}) error {
 		}
 		availableProfiles, response, err := sess.ListVolumeProfiles(listVolumeProfilesOptions)
 		if err != nil {
-			return fmt.Errorf("Error Fetching Volume Profiles %s\n%s", err, response)
+			return apiErrorf("vpc_classic", err, response, "Error Fetching Volume Profiles")
 		}
 		start = GetNext(availableProfiles.Next)
 		allrecs = append(allrecs, availableProfiles.Profiles...)
@@ -106,7 +105,7 @@ func volumeProfilesList(d *schema.ResourceData, meta interface{}) error {
 	listVolumeProfilesOptions := &vpcv1.ListVolumeProfilesOptions{}
 	availableProfiles, response, err := sess.ListVolumeProfiles(listVolumeProfilesOptions)
 	if err != nil {
-		return fmt.Errorf("Error Fetching Volume Profiles %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Fetching Volume Profiles")
 	}
 	profilesInfo := make([]map[string]interface{}, 0)
 	for _, profile := range availableProfiles.Profiles {
@@ -310,7 +310,7 @@ func classicVpcGetByName(d *schema.ResourceData, meta interface{}, name string)
 		}
 		vpcs, response, err := sess.ListVpcs(listVpcsOptions)
 		if err != nil {
-			return fmt.Errorf("Error Fetching vpcs %s\n%s", err, response)
+			return apiErrorf("vpc_classic", err, response, "Error Fetching vpcs")
 		}
 		start = GetNext(vpcs.Next)
 		allrecs = append(allrecs, vpcs.Vpcs...)
@@ -524,7 +524,7 @@ func vpcGetByName(d *schema.ResourceData, meta interface{}, name string) error {
 		}
 		vpcs, response, err := sess.ListVpcs(listVpcsOptions)
 		if err != nil {
-			return fmt.Errorf("Error Fetching vpcs %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Fetching vpcs")
 		}
 		start = GetNext(vpcs.Next)
 		allrecs = append(allrecs, vpcs.Vpcs...)
@@ -4,7 +4,6 @@
 package ibm
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -128,7 +127,7 @@ func dataSourceIBMVPNGatewaysRead(d *schema.ResourceData, meta interface{}) erro
 		}
 		availableVPNGateways, detail, err := sess.ListVPNGateways(listvpnGWOptions)
 		if err != nil {
-			return fmt.Errorf("Error reading list of VPN Gateways:%s\n%s", err, detail)
+			return apiErrorf("vpc", err, detail, "Error reading list of VPN Gateways")
 		}
 		start = GetNext(availableVPNGateways.Next)
 		allrecs = append(allrecs, availableVPNGateways.VPNGateways...)
@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	pdnsForwardingRules = "forwarding_rules"
+)
+
+func dataSourceIBMPrivateDNSCustomResolverForwardingRules() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMPrivateDNSCustomResolverForwardingRulesRead,
+		Schema: map[string]*schema.Schema{
+			pdnsInstanceID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Instance ID",
+			},
+			pdnsResolverID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Custom resolver ID",
+			},
+			pdnsForwardingRules: {
+				Type:        schema.TypeList,
+				Description: "Collection of forwarding rules, including the default rule",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						pdnsForwardingRuleID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Forwarding rule id",
+						},
+						pdnsForwardingRuleType: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the forwarding rule, zone or default",
+						},
+						pdnsForwardingRuleMatch: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Zone whose names the queries are forwarded for",
+						},
+						pdnsForwardingRuleForwardTo: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "IP addresses of the DNS servers the queries are forwarded to",
+						},
+						pdnsForwardingRuleDescription: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Descriptive text of the forwarding rule",
+						},
+						pdnsForwardingRuleCreatedOn: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Forwarding rule creation date",
+						},
+						pdnsForwardingRuleModifiedOn: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Forwarding rule modification date",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMPrivateDNSCustomResolverForwardingRulesRead(d *schema.ResourceData, meta interface{}) error {
+	sess, err := meta.(ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		return err
+	}
+	instanceID := d.Get(pdnsInstanceID).(string)
+	resolverID := d.Get(pdnsResolverID).(string)
+	rules, detail, err := listDNSForwardingRules(sess, instanceID, resolverID)
+	if err != nil {
+		return fmt.Errorf("Error reading list of pdns forwarding rules:%s\n%s", err, detail)
+	}
+	d.Set(pdnsInstanceID, instanceID)
+	d.Set(pdnsResolverID, resolverID)
+	forwardingRules := make([]map[string]interface{}, 0)
+	for _, instance := range rules {
+		forwardingRule := map[string]interface{}{}
+		forwardingRule[pdnsForwardingRuleID] = instance.ID
+		forwardingRule[pdnsForwardingRuleType] = instance.Type
+		forwardingRule[pdnsForwardingRuleMatch] = instance.Match
+		forwardingRule[pdnsForwardingRuleForwardTo] = instance.ForwardTo
+		forwardingRule[pdnsForwardingRuleDescription] = instance.Description
+		forwardingRule[pdnsForwardingRuleCreatedOn] = instance.CreatedOn
+		forwardingRule[pdnsForwardingRuleModifiedOn] = instance.ModifiedOn
+
+		forwardingRules = append(forwardingRules, forwardingRule)
+	}
+	d.SetId(dataSourceIBMPrivateDNSCustomResolverForwardingRulesID(d))
+	d.Set(pdnsForwardingRules, forwardingRules)
+	return nil
+}
+
+// dataSourceIBMPrivateDNSCustomResolverForwardingRulesID returns a reasonable ID list.
+func dataSourceIBMPrivateDNSCustomResolverForwardingRulesID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
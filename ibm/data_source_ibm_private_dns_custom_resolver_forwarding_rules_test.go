@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMPrivateDNSCustomResolverForwardingRulesDataSource_basic(t *testing.T) {
+	node := "data.ibm_dns_custom_resolver_forwarding_rules.test1"
+	name := fmt.Sprintf("tf-pdns-cr-%d", acctest.RandIntRange(100, 200))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPrivateDNSCustomResolverForwardingRulesDataSourceConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					// The default rule is listed with the zone rule
+					resource.TestCheckResourceAttr(node, "forwarding_rules.#", "2"),
+					resource.TestCheckResourceAttrSet(node, "forwarding_rules.0.rule_id"),
+					resource.TestCheckResourceAttrSet(node, "forwarding_rules.0.type"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPrivateDNSCustomResolverForwardingRulesDataSourceConfig(name string) string {
+	return testAccCheckIBMPrivateDNSCustomResolverForwardingRuleConfig(name, `"10.0.0.53"`) + `
+	data "ibm_dns_custom_resolver_forwarding_rules" "test1" {
+		depends_on  = [ibm_dns_custom_resolver_forwarding_rule.test-pdns-cr-fr]
+		instance_id = ibm_resource_instance.test-pdns-cr-instance.guid
+		resolver_id = ibm_dns_custom_resolver.test-pdns-cr.custom_resolver_id
+	}`
+}
@@ -0,0 +1,141 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	pdnsCustomResolvers = "custom_resolvers"
+)
+
+func dataSourceIBMPrivateDNSCustomResolvers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMPrivateDNSCustomResolversRead,
+		Schema: map[string]*schema.Schema{
+			pdnsInstanceID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Instance ID",
+			},
+			pdnsCustomResolvers: {
+				Type:        schema.TypeList,
+				Description: "Collection of custom resolvers",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						pdnsCustomResolverID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Custom resolver id",
+						},
+						pdnsCustomResolverName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Custom resolver name",
+						},
+						pdnsCustomResolverDescription: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Descriptive text of the custom resolver",
+						},
+						pdnsCustomResolverEnabled: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the custom resolver is enabled",
+						},
+						pdnsCustomResolverHealth: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Health of the custom resolver, CRITICAL, DEGRADED or HEALTHY",
+						},
+						pdnsCustomResolverLocations: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Subnets the custom resolver has a DNS server in",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									pdnsCustomResolverLocationID: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Location id",
+									},
+									pdnsCustomResolverLocationSubnetCrn: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "CRN of the subnet",
+									},
+									pdnsCustomResolverLocationEnabled: {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Whether the location is enabled",
+									},
+									pdnsCustomResolverLocationHealthy: {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Whether the DNS server of the location is healthy",
+									},
+									pdnsCustomResolverLocationServerIP: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "IP address of the DNS server of the location",
+									},
+								},
+							},
+						},
+						pdnsCustomResolverCreatedOn: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Custom resolver creation date",
+						},
+						pdnsCustomResolverModifiedOn: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Custom resolver modification date",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMPrivateDNSCustomResolversRead(d *schema.ResourceData, meta interface{}) error {
+	sess, err := meta.(ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		return err
+	}
+	instanceID := d.Get(pdnsInstanceID).(string)
+	resolvers, detail, err := listDNSCustomResolvers(sess, instanceID)
+	if err != nil {
+		return fmt.Errorf("Error reading list of pdns custom resolvers:%s\n%s", err, detail)
+	}
+	d.Set(pdnsInstanceID, instanceID)
+	customResolvers := make([]map[string]interface{}, 0)
+	for _, instance := range resolvers {
+		customResolver := map[string]interface{}{}
+		customResolver[pdnsCustomResolverID] = instance.ID
+		customResolver[pdnsCustomResolverName] = instance.Name
+		customResolver[pdnsCustomResolverDescription] = instance.Description
+		customResolver[pdnsCustomResolverEnabled] = instance.Enabled
+		customResolver[pdnsCustomResolverHealth] = instance.Health
+		customResolver[pdnsCustomResolverLocations] = flattenPDNSCustomResolverLocations(instance.Locations, nil)
+		customResolver[pdnsCustomResolverCreatedOn] = instance.CreatedOn
+		customResolver[pdnsCustomResolverModifiedOn] = instance.ModifiedOn
+
+		customResolvers = append(customResolvers, customResolver)
+	}
+	d.SetId(dataSourceIBMPrivateDNSCustomResolversID(d))
+	d.Set(pdnsCustomResolvers, customResolvers)
+	return nil
+}
+
+// dataSourceIBMPrivateDNSCustomResolversID returns a reasonable ID list.
+func dataSourceIBMPrivateDNSCustomResolversID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMPrivateDNSCustomResolversDataSource_basic(t *testing.T) {
+	node := "data.ibm_dns_custom_resolvers.test1"
+	name := fmt.Sprintf("tf-pdns-cr-%d", acctest.RandIntRange(100, 200))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPrivateDNSCustomResolversDataSourceConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(node, "custom_resolvers.#", "1"),
+					resource.TestCheckResourceAttr(node, "custom_resolvers.0.name", name),
+					resource.TestCheckResourceAttrSet(node, "custom_resolvers.0.health"),
+					resource.TestCheckResourceAttrSet(node, "custom_resolvers.0.locations.0.subnet_crn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPrivateDNSCustomResolversDataSourceConfig(name string) string {
+	return testAccCheckIBMPrivateDNSCustomResolverConfig(name, "description", false, false) + `
+	data "ibm_dns_custom_resolvers" "test1" {
+		depends_on  = [ibm_dns_custom_resolver.test-pdns-cr]
+		instance_id = ibm_resource_instance.test-pdns-cr-instance.guid
+	}`
+}
@@ -0,0 +1,232 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	tgRouteReportID       = "route_report_id"
+	tgOverlappingRoutes   = "overlapping_routes"
+	tgRouteReportPending  = "pending"
+	tgRouteReportComplete = "complete"
+)
+
+func dataSourceIBMTransitGatewayRouteReport() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMTransitGatewayRouteReportRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			tgGatewayId: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Transit Gateway identifier",
+			},
+			tgRouteReportID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The route report identifier",
+			},
+			tgStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The route report status",
+			},
+			tgCreatedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the route report was requested",
+			},
+			tgUpdatedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the route report was generated",
+			},
+			tgConnections: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The routes of the transit gateway connections",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						tgConnectionId: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Transit Gateway Connection identifier",
+						},
+						tgConnName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The network type of the connection",
+						},
+						"routes": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The routes of the connection permitted by its prefix filters",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"prefix": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"bgps": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The BGP routes of a GRE tunnel connection",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"as_path": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"is_used": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"local_preference": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"prefix": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			tgOverlappingRoutes: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The routes of different connections that overlap",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"routes": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									tgConnectionId: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"prefix": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMTransitGatewayRouteReportRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := transitgatewayClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	gatewayId := d.Get(tgGatewayId).(string)
+	report, response, err := createTGRouteReport(client, gatewayId)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error creating Transit Gateway route report: %s\n%s", err, response))
+	}
+
+	result, err := isWaitForTransitGatewayRouteReportComplete(context, client, gatewayId, report.ID, d.Timeout(schema.TimeoutRead))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	report = result.(*tgRouteReport)
+
+	connections := make([]map[string]interface{}, 0, len(report.Connections))
+	for _, conn := range report.Connections {
+		routes := make([]map[string]interface{}, 0, len(conn.Routes))
+		for _, route := range conn.Routes {
+			routes = append(routes, map[string]interface{}{"prefix": route.Prefix})
+		}
+		bgps := make([]map[string]interface{}, 0, len(conn.Bgps))
+		for _, bgp := range conn.Bgps {
+			bgps = append(bgps, map[string]interface{}{
+				"as_path":          bgp.AsPath,
+				"is_used":          bgp.IsUsed,
+				"local_preference": bgp.LocalPreference,
+				"prefix":           bgp.Prefix,
+			})
+		}
+		connections = append(connections, map[string]interface{}{
+			tgConnectionId: conn.ID,
+			tgConnName:     conn.Name,
+			"type":         conn.Type,
+			"routes":       routes,
+			"bgps":         bgps,
+		})
+	}
+	overlapping := make([]map[string]interface{}, 0, len(report.OverlappingRoutes))
+	for _, overlap := range report.OverlappingRoutes {
+		routes := make([]map[string]interface{}, 0, len(overlap.Routes))
+		for _, route := range overlap.Routes {
+			routes = append(routes, map[string]interface{}{
+				tgConnectionId: route.ConnectionID,
+				"prefix":       route.Prefix,
+			})
+		}
+		overlapping = append(overlapping, map[string]interface{}{"routes": routes})
+	}
+
+	d.SetId(report.ID)
+	d.Set(tgRouteReportID, report.ID)
+	d.Set(tgStatus, report.Status)
+	d.Set(tgCreatedAt, report.CreatedAt)
+	d.Set(tgUpdatedAt, report.UpdatedAt)
+	d.Set(tgConnections, connections)
+	d.Set(tgOverlappingRoutes, overlapping)
+
+	return nil
+}
+
+func isWaitForTransitGatewayRouteReportComplete(context context.Context, client *transitgatewayapisv1.TransitGatewayApisV1, gatewayId, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for transit gateway route report (%s) to be complete.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"retry", tgRouteReportPending},
+		Target:  []string{tgRouteReportComplete},
+		Refresh: func() (interface{}, string, error) {
+			report, response, err := getTGRouteReport(client, gatewayId, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error Getting Transit Gateway route report: %s\n%s", err, response)
+			}
+			return report, report.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gotest.tools/assert"
+)
+
+func TestAccIBMTransitGatewayRouteReportDataSource_basic(t *testing.T) {
+	node := "data.ibm_tg_route_report.test_tg_route_report"
+	gatewayName := fmt.Sprintf("tg-gateway-name-%d", acctest.RandIntRange(10, 100))
+	vpcName := fmt.Sprintf("vpc-name-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMTransitGatewayRouteReportDataSourceConfig(gatewayName, vpcName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(node, "route_report_id"),
+					resource.TestCheckResourceAttr(node, "status", "complete"),
+					resource.TestCheckResourceAttr(node, "connections.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMTransitGatewayRouteReportDataSourceConfig(gatewayName, vpcName string) string {
+	return testAccCheckIBMTransitGatewayConnectionConfig(vpcName+"-conn", gatewayName, vpcName) + `
+	data "ibm_tg_route_report" "test_tg_route_report" {
+		gateway = ibm_tg_connection.test_ibm_tg_connection.gateway
+	}
+	`
+}
+
+func TestIBMTransitGatewayRouteReportDataSourceMock(t *testing.T) {
+	t.Parallel()
+	srv := testMockServer(t)
+	meta := testMockClientSession(t, srv)
+	gatewayID, classicID := testMockTransitGateway(t, meta)
+
+	client, err := transitgatewayClient(meta)
+	assert.NilError(t, err)
+	vpc, _, err := createTGConnection(client, gatewayID, tgConnection{
+		Name:        "mock-vpc",
+		NetworkType: "vpc",
+		NetworkID:   "crn:v1:bluemix:public:is:us-south:a/mockserver::vpc:mock-vpc",
+	})
+	assert.NilError(t, err)
+	gre, _, err := createTGConnection(client, gatewayID, tgConnection{
+		Name:             "mock-gre",
+		NetworkType:      "gre_tunnel",
+		BaseConnectionID: classicID,
+		Zone:             &tgZoneReference{Name: "us-south-1"},
+		LocalGatewayIP:   "192.168.100.1",
+		LocalTunnelIP:    "169.254.100.1",
+		RemoteGatewayIP:  "10.242.63.12",
+		RemoteTunnelIP:   "169.254.100.2",
+		RemoteBgpASN:     65010,
+	})
+	assert.NilError(t, err)
+	_, _, err = createTGPrefixFilter(client, gatewayID, gre.ID, tgPrefixFilter{Action: "deny", Prefix: "172.16.10.0/24"})
+	assert.NilError(t, err)
+
+	ds := dataSourceIBMTransitGatewayRouteReport()
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{"gateway": gatewayID})
+	assert.NilError(t, testDiagsErr(ds.ReadContext(context.Background(), d, meta)))
+	assert.Equal(t, d.Id(), d.Get("route_report_id"))
+	assert.Equal(t, d.Get("status"), "complete")
+	assert.Equal(t, d.Get("connections.#"), 3)
+
+	connections := map[string]int{}
+	for i := 0; i < 3; i++ {
+		connections[d.Get(fmt.Sprintf("connections.%d.connection_id", i)).(string)] = i
+	}
+	greIndex := connections[gre.ID]
+	assert.Equal(t, d.Get(fmt.Sprintf("connections.%d.type", greIndex)), "gre_tunnel")
+	assert.Equal(t, d.Get(fmt.Sprintf("connections.%d.routes.#", greIndex)), 0)
+	// The denied route of the GRE tunnel isn't in the report
+	assert.Equal(t, d.Get(fmt.Sprintf("connections.%d.bgps.#", greIndex)), 1)
+	assert.Equal(t, d.Get(fmt.Sprintf("connections.%d.bgps.0.prefix", greIndex)), "172.16.0.0/16")
+	assert.Equal(t, d.Get(fmt.Sprintf("connections.%d.bgps.0.as_path", greIndex)), "(65010)")
+	assert.Equal(t, d.Get(fmt.Sprintf("connections.%d.bgps.0.is_used", greIndex)), true)
+	assert.Equal(t, d.Get(fmt.Sprintf("connections.%d.routes.0.prefix", connections[vpc.ID])), "10.240.0.0/18")
+
+	// The VPC route overlaps the classic route
+	assert.Equal(t, d.Get("overlapping_routes.#"), 1)
+	overlap := []string{
+		d.Get("overlapping_routes.0.routes.0.connection_id").(string),
+		d.Get("overlapping_routes.0.routes.1.connection_id").(string),
+	}
+	assert.Assert(t, (overlap[0] == classicID && overlap[1] == vpc.ID) || (overlap[0] == vpc.ID && overlap[1] == classicID))
+}
@@ -0,0 +1,194 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/IBM/networking-go-sdk/directlinkv1"
+)
+
+// directLinkRequestOptions describes a request to the Direct Link API sent by
+// directLinkRequest. The path parameters are escaped into the path, such as
+// /gateways/{gateway_id}/macsec/caks/{id}.
+type directLinkRequestOptions struct {
+	Method     string
+	Path       string
+	PathParams map[string]string
+	Body       interface{}
+}
+
+// directLinkRequest sends a request to the Direct Link API with the URL, the
+// authenticator and the API version of the client, for the MACsec CAKs and route
+// reports the networking-go-sdk doesn't offer yet. The JSON response is decoded into
+// result when it is not nil.
+func directLinkRequest(client *directlinkv1.DirectLinkV1, options directLinkRequestOptions, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(options.Method)
+	if _, err := builder.ResolveRequestURL(client.Service.Options.URL, options.Path, options.PathParams); err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	if client.Version != nil {
+		builder.AddQuery("version", *client.Version)
+	}
+	if options.Body != nil {
+		if _, err := builder.SetBodyContentJSON(options.Body); err != nil {
+			return nil, err
+		}
+		builder.AddHeader("Content-Type", "application/json")
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return client.Service.Request(request, result)
+}
+
+// dlCakKey is the Hyper Protect Crypto Services key of a MACsec CAK.
+type dlCakKey struct {
+	Crn string `json:"crn"`
+}
+
+// dlMacsecCak is a connectivity association key of the MACsec config of a dedicated
+// gateway, /gateways/{gateway_id}/macsec/caks/{id}. Its name is the connectivity
+// association key name (CKN). Updating the name or key of a CAK rotates it: its
+// status is rotating and active_delta holds the new name and key until the rotation
+// is complete.
+type dlMacsecCak struct {
+	ID          string            `json:"id,omitempty"`
+	Name        string            `json:"name,omitempty"`
+	Key         *dlCakKey         `json:"key,omitempty"`
+	Session     string            `json:"session,omitempty"`
+	Status      string            `json:"status,omitempty"`
+	ActiveDelta *dlMacsecCakDelta `json:"active_delta,omitempty"`
+	CreatedAt   string            `json:"created_at,omitempty"`
+	UpdatedAt   string            `json:"updated_at,omitempty"`
+}
+
+type dlMacsecCakDelta struct {
+	Name   string    `json:"name"`
+	Key    *dlCakKey `json:"key"`
+	Status string    `json:"status"`
+}
+
+// dlRouteReport is a report of the routes of a gateway, /gateways/{gateway_id}/
+// route_reports/{id}. It is generated asynchronously, its status being pending
+// until it is complete.
+type dlRouteReport struct {
+	ID               string `json:"id"`
+	Status           string `json:"status"`
+	AdvertisedRoutes []struct {
+		AsPath string `json:"as_path"`
+		Prefix string `json:"prefix"`
+	} `json:"advertised_routes"`
+	GatewayRoutes []struct {
+		Prefix string `json:"prefix"`
+	} `json:"gateway_routes"`
+	OnPremRoutes []struct {
+		AsPath  string `json:"as_path"`
+		NextHop string `json:"next_hop"`
+		Prefix  string `json:"prefix"`
+	} `json:"on_prem_routes"`
+	OverlappingRoutes []struct {
+		Routes []struct {
+			Prefix              string `json:"prefix"`
+			Type                string `json:"type"`
+			VirtualConnectionID string `json:"virtual_connection_id"`
+		} `json:"routes"`
+	} `json:"overlapping_routes"`
+	VirtualConnectionRoutes []struct {
+		VirtualConnectionID   string `json:"virtual_connection_id"`
+		VirtualConnectionName string `json:"virtual_connection_name"`
+		VirtualConnectionType string `json:"virtual_connection_type"`
+		Routes                []struct {
+			Active          bool   `json:"active"`
+			LocalPreference string `json:"local_preference"`
+			Prefix          string `json:"prefix"`
+		} `json:"routes"`
+	} `json:"virtual_connection_routes"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+func createDLMacsecCak(client *directlinkv1.DirectLinkV1, gatewayID string, cak dlMacsecCak) (*dlMacsecCak, *core.DetailedResponse, error) {
+	result := &dlMacsecCak{}
+	options := directLinkRequestOptions{
+		Method:     core.POST,
+		Path:       "/gateways/{gateway_id}/macsec/caks",
+		PathParams: map[string]string{"gateway_id": gatewayID},
+		Body:       cak,
+	}
+	response, err := directLinkRequest(client, options, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+func getDLMacsecCak(client *directlinkv1.DirectLinkV1, gatewayID, cakID string) (*dlMacsecCak, *core.DetailedResponse, error) {
+	result := &dlMacsecCak{}
+	options := directLinkRequestOptions{
+		Method:     core.GET,
+		Path:       "/gateways/{gateway_id}/macsec/caks/{id}",
+		PathParams: map[string]string{"gateway_id": gatewayID, "id": cakID},
+	}
+	response, err := directLinkRequest(client, options, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+// updateDLMacsecCak patches the name or key fields of the CAK set in patch, which
+// starts its rotation.
+func updateDLMacsecCak(client *directlinkv1.DirectLinkV1, gatewayID, cakID string, patch map[string]interface{}) (*dlMacsecCak, *core.DetailedResponse, error) {
+	result := &dlMacsecCak{}
+	options := directLinkRequestOptions{
+		Method:     core.PATCH,
+		Path:       "/gateways/{gateway_id}/macsec/caks/{id}",
+		PathParams: map[string]string{"gateway_id": gatewayID, "id": cakID},
+		Body:       patch,
+	}
+	response, err := directLinkRequest(client, options, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+func deleteDLMacsecCak(client *directlinkv1.DirectLinkV1, gatewayID, cakID string) (*core.DetailedResponse, error) {
+	options := directLinkRequestOptions{
+		Method:     core.DELETE,
+		Path:       "/gateways/{gateway_id}/macsec/caks/{id}",
+		PathParams: map[string]string{"gateway_id": gatewayID, "id": cakID},
+	}
+	return directLinkRequest(client, options, nil)
+}
+
+func createDLRouteReport(client *directlinkv1.DirectLinkV1, gatewayID string) (*dlRouteReport, *core.DetailedResponse, error) {
+	result := &dlRouteReport{}
+	options := directLinkRequestOptions{
+		Method:     core.POST,
+		Path:       "/gateways/{gateway_id}/route_reports",
+		PathParams: map[string]string{"gateway_id": gatewayID},
+	}
+	response, err := directLinkRequest(client, options, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+func getDLRouteReport(client *directlinkv1.DirectLinkV1, gatewayID, reportID string) (*dlRouteReport, *core.DetailedResponse, error) {
+	result := &dlRouteReport{}
+	options := directLinkRequestOptions{
+		Method:     core.GET,
+		Path:       "/gateways/{gateway_id}/route_reports/{id}",
+		PathParams: map[string]string{"gateway_id": gatewayID, "id": reportID},
+	}
+	response, err := directLinkRequest(client, options, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
@@ -0,0 +1,275 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"github.com/IBM/go-sdk-core/v4/core"
+	dns "github.com/IBM/networking-go-sdk/dnssvcsv1"
+)
+
+// dnsCustomResolverRequestOptions describes a request to the custom resolvers API
+// of DNS Services sent by dnsCustomResolverRequest. The path parameters are escaped
+// into the path, such as /instances/{instance_id}/custom_resolvers/{resolver_id}.
+type dnsCustomResolverRequestOptions struct {
+	Method     string
+	Path       string
+	PathParams map[string]string
+	Body       interface{}
+}
+
+// dnsCustomResolverRequest sends a request to the custom resolvers API, which the
+// networking-go-sdk doesn't offer yet, with the URL and the authenticator of the DNS
+// Services client. The JSON response is decoded into result when it is not nil.
+func dnsCustomResolverRequest(sess *dns.DnsSvcsV1, options dnsCustomResolverRequestOptions, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(options.Method)
+	builder.EnableGzipCompression = sess.GetEnableGzipCompression()
+	if _, err := builder.ResolveRequestURL(sess.Service.Options.URL, options.Path, options.PathParams); err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	if options.Body != nil {
+		if _, err := builder.SetBodyContentJSON(options.Body); err != nil {
+			return nil, err
+		}
+		builder.AddHeader("Content-Type", "application/json")
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return sess.Service.Request(request, result)
+}
+
+// dnsCustomResolverLocation is a location of a custom resolver, the subnet of a VPC
+// the resolver has a DNS server in.
+type dnsCustomResolverLocation struct {
+	ID          string `json:"id,omitempty"`
+	SubnetCrn   string `json:"subnet_crn"`
+	Enabled     bool   `json:"enabled"`
+	Healthy     bool   `json:"healthy,omitempty"`
+	DNSServerIP string `json:"dns_server_ip,omitempty"`
+}
+
+// dnsCustomResolver is a custom resolver of a DNS Services instance, resolving the
+// names of the private zones and forwarding the other queries according to its
+// forwarding rules, /instances/{instance_id}/custom_resolvers/{resolver_id}.
+type dnsCustomResolver struct {
+	ID          string                      `json:"id,omitempty"`
+	Name        string                      `json:"name"`
+	Description string                      `json:"description,omitempty"`
+	Enabled     bool                        `json:"enabled,omitempty"`
+	Health      string                      `json:"health,omitempty"`
+	Locations   []dnsCustomResolverLocation `json:"locations"`
+	CreatedOn   string                      `json:"created_on,omitempty"`
+	ModifiedOn  string                      `json:"modified_on,omitempty"`
+}
+
+// dnsForwardingRule forwards the queries of the names matching a zone to DNS servers,
+// /instances/{instance_id}/custom_resolvers/{resolver_id}/forwarding_rules/{rule_id}.
+// Each custom resolver has a default rule, matching the names of no other rule.
+type dnsForwardingRule struct {
+	ID          string   `json:"id,omitempty"`
+	Type        string   `json:"type,omitempty"`
+	Match       string   `json:"match,omitempty"`
+	ForwardTo   []string `json:"forward_to"`
+	Description string   `json:"description,omitempty"`
+	CreatedOn   string   `json:"created_on,omitempty"`
+	ModifiedOn  string   `json:"modified_on,omitempty"`
+}
+
+func createDNSCustomResolver(sess *dns.DnsSvcsV1, instanceID string, resolver dnsCustomResolver) (*dnsCustomResolver, *core.DetailedResponse, error) {
+	result := &dnsCustomResolver{}
+	options := dnsCustomResolverRequestOptions{
+		Method:     core.POST,
+		Path:       "/instances/{instance_id}/custom_resolvers",
+		PathParams: map[string]string{"instance_id": instanceID},
+		Body:       resolver,
+	}
+	response, err := dnsCustomResolverRequest(sess, options, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+func listDNSCustomResolvers(sess *dns.DnsSvcsV1, instanceID string) ([]dnsCustomResolver, *core.DetailedResponse, error) {
+	result := &struct {
+		CustomResolvers []dnsCustomResolver `json:"custom_resolvers"`
+	}{}
+	options := dnsCustomResolverRequestOptions{
+		Method:     core.GET,
+		Path:       "/instances/{instance_id}/custom_resolvers",
+		PathParams: map[string]string{"instance_id": instanceID},
+	}
+	response, err := dnsCustomResolverRequest(sess, options, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result.CustomResolvers, response, nil
+}
+
+func getDNSCustomResolver(sess *dns.DnsSvcsV1, instanceID, resolverID string) (*dnsCustomResolver, *core.DetailedResponse, error) {
+	result := &dnsCustomResolver{}
+	options := dnsCustomResolverRequestOptions{
+		Method:     core.GET,
+		Path:       "/instances/{instance_id}/custom_resolvers/{resolver_id}",
+		PathParams: map[string]string{"instance_id": instanceID, "resolver_id": resolverID},
+	}
+	response, err := dnsCustomResolverRequest(sess, options, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+// updateDNSCustomResolver patches the name, description or enabled fields of the
+// custom resolver set in patch.
+func updateDNSCustomResolver(sess *dns.DnsSvcsV1, instanceID, resolverID string, patch map[string]interface{}) (*dnsCustomResolver, *core.DetailedResponse, error) {
+	result := &dnsCustomResolver{}
+	options := dnsCustomResolverRequestOptions{
+		Method:     core.PATCH,
+		Path:       "/instances/{instance_id}/custom_resolvers/{resolver_id}",
+		PathParams: map[string]string{"instance_id": instanceID, "resolver_id": resolverID},
+		Body:       patch,
+	}
+	response, err := dnsCustomResolverRequest(sess, options, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+func deleteDNSCustomResolver(sess *dns.DnsSvcsV1, instanceID, resolverID string) (*core.DetailedResponse, error) {
+	options := dnsCustomResolverRequestOptions{
+		Method:     core.DELETE,
+		Path:       "/instances/{instance_id}/custom_resolvers/{resolver_id}",
+		PathParams: map[string]string{"instance_id": instanceID, "resolver_id": resolverID},
+	}
+	return dnsCustomResolverRequest(sess, options, nil)
+}
+
+func addDNSCustomResolverLocation(sess *dns.DnsSvcsV1, instanceID, resolverID string, location dnsCustomResolverLocation) (*dnsCustomResolverLocation, *core.DetailedResponse, error) {
+	result := &dnsCustomResolverLocation{}
+	options := dnsCustomResolverRequestOptions{
+		Method:     core.POST,
+		Path:       "/instances/{instance_id}/custom_resolvers/{resolver_id}/locations",
+		PathParams: map[string]string{"instance_id": instanceID, "resolver_id": resolverID},
+		Body:       location,
+	}
+	response, err := dnsCustomResolverRequest(sess, options, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+func updateDNSCustomResolverLocation(sess *dns.DnsSvcsV1, instanceID, resolverID, locationID string, enabled bool) (*core.DetailedResponse, error) {
+	options := dnsCustomResolverRequestOptions{
+		Method: core.PATCH,
+		Path:   "/instances/{instance_id}/custom_resolvers/{resolver_id}/locations/{location_id}",
+		PathParams: map[string]string{
+			"instance_id": instanceID,
+			"resolver_id": resolverID,
+			"location_id": locationID,
+		},
+		Body: map[string]interface{}{"enabled": enabled},
+	}
+	return dnsCustomResolverRequest(sess, options, nil)
+}
+
+func deleteDNSCustomResolverLocation(sess *dns.DnsSvcsV1, instanceID, resolverID, locationID string) (*core.DetailedResponse, error) {
+	options := dnsCustomResolverRequestOptions{
+		Method: core.DELETE,
+		Path:   "/instances/{instance_id}/custom_resolvers/{resolver_id}/locations/{location_id}",
+		PathParams: map[string]string{
+			"instance_id": instanceID,
+			"resolver_id": resolverID,
+			"location_id": locationID,
+		},
+	}
+	return dnsCustomResolverRequest(sess, options, nil)
+}
+
+func createDNSForwardingRule(sess *dns.DnsSvcsV1, instanceID, resolverID string, rule dnsForwardingRule) (*dnsForwardingRule, *core.DetailedResponse, error) {
+	result := &dnsForwardingRule{}
+	options := dnsCustomResolverRequestOptions{
+		Method:     core.POST,
+		Path:       "/instances/{instance_id}/custom_resolvers/{resolver_id}/forwarding_rules",
+		PathParams: map[string]string{"instance_id": instanceID, "resolver_id": resolverID},
+		Body:       rule,
+	}
+	response, err := dnsCustomResolverRequest(sess, options, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+func listDNSForwardingRules(sess *dns.DnsSvcsV1, instanceID, resolverID string) ([]dnsForwardingRule, *core.DetailedResponse, error) {
+	result := &struct {
+		ForwardingRules []dnsForwardingRule `json:"forwarding_rules"`
+	}{}
+	options := dnsCustomResolverRequestOptions{
+		Method:     core.GET,
+		Path:       "/instances/{instance_id}/custom_resolvers/{resolver_id}/forwarding_rules",
+		PathParams: map[string]string{"instance_id": instanceID, "resolver_id": resolverID},
+	}
+	response, err := dnsCustomResolverRequest(sess, options, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result.ForwardingRules, response, nil
+}
+
+func getDNSForwardingRule(sess *dns.DnsSvcsV1, instanceID, resolverID, ruleID string) (*dnsForwardingRule, *core.DetailedResponse, error) {
+	result := &dnsForwardingRule{}
+	options := dnsCustomResolverRequestOptions{
+		Method: core.GET,
+		Path:   "/instances/{instance_id}/custom_resolvers/{resolver_id}/forwarding_rules/{rule_id}",
+		PathParams: map[string]string{
+			"instance_id": instanceID,
+			"resolver_id": resolverID,
+			"rule_id":     ruleID,
+		},
+	}
+	response, err := dnsCustomResolverRequest(sess, options, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+// updateDNSForwardingRule patches the match, forward_to or description fields of the
+// forwarding rule set in patch.
+func updateDNSForwardingRule(sess *dns.DnsSvcsV1, instanceID, resolverID, ruleID string, patch map[string]interface{}) (*dnsForwardingRule, *core.DetailedResponse, error) {
+	result := &dnsForwardingRule{}
+	options := dnsCustomResolverRequestOptions{
+		Method: core.PATCH,
+		Path:   "/instances/{instance_id}/custom_resolvers/{resolver_id}/forwarding_rules/{rule_id}",
+		PathParams: map[string]string{
+			"instance_id": instanceID,
+			"resolver_id": resolverID,
+			"rule_id":     ruleID,
+		},
+		Body: patch,
+	}
+	response, err := dnsCustomResolverRequest(sess, options, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+func deleteDNSForwardingRule(sess *dns.DnsSvcsV1, instanceID, resolverID, ruleID string) (*core.DetailedResponse, error) {
+	options := dnsCustomResolverRequestOptions{
+		Method: core.DELETE,
+		Path:   "/instances/{instance_id}/custom_resolvers/{resolver_id}/forwarding_rules/{rule_id}",
+		PathParams: map[string]string{
+			"instance_id": instanceID,
+			"resolver_id": resolverID,
+			"rule_id":     ruleID,
+		},
+	}
+	return dnsCustomResolverRequest(sess, options, nil)
+}
@@ -0,0 +1,210 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/mockserver"
+	"github.com/Shopify/sarama"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gotest.tools/assert"
+)
+
+const testEventStreamsAPIKey = "mock-event-streams-api-key"
+
+// testEventStreams is an Event Streams instance served by the stand-ins, its Kafka
+// API by a sarama mock broker and its admin REST API by a local server.
+type testEventStreams struct {
+	InstanceCRN string
+	Meta        ClientSession
+	Broker      *sarama.MockBroker
+
+	mu       sync.Mutex
+	quotas   map[string]map[string]interface{}
+	subjects map[string][]string
+	configs  map[string]string
+}
+
+// TestIBMEventStreamsMock runs the CRUD tests of the Event Streams resources
+// against a single instance. They can't run in parallel with the other tests as
+// they replace the Kafka admin client constructor.
+func TestIBMEventStreamsMock(t *testing.T) {
+	es := testMockEventStreams(t)
+	t.Run("acl", func(t *testing.T) { testIBMEventStreamsACLMock(t, es) })
+	t.Run("quota", func(t *testing.T) { testIBMEventStreamsQuotaMock(t, es) })
+	t.Run("schema", func(t *testing.T) { testIBMEventStreamsSchemaMock(t, es) })
+}
+
+// testMockEventStreams returns an Event Streams instance whose broker serves the
+// ACL requests.
+func testMockEventStreams(t *testing.T) *testEventStreams {
+	if mockserver.ModeFromEnv() != mockserver.ModeStandIn {
+		t.Skip("the Event Streams APIs are only served by the stand-ins")
+	}
+	es := &testEventStreams{
+		quotas:   make(map[string]map[string]interface{}),
+		subjects: make(map[string][]string),
+		configs:  map[string]string{"": "BACKWARD"},
+	}
+	rest := httptest.NewServer(http.HandlerFunc(es.serveREST))
+	t.Cleanup(rest.Close)
+
+	es.Broker = sarama.NewMockBroker(t, 1)
+	t.Cleanup(es.Broker.Close)
+	es.Broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetController(es.Broker.BrokerID()).
+			SetBroker(es.Broker.Addr(), es.Broker.BrokerID()),
+		"CreateAclsRequest":   sarama.NewMockCreateAclsResponse(t),
+		"DescribeAclsRequest": sarama.NewMockListAclsResponse(t),
+		"DeleteAclsRequest":   sarama.NewMockDeleteAclsResponse(t),
+	})
+	newSaramaClusterAdmin = func(addrs []string, config *sarama.Config) (sarama.ClusterAdmin, error) {
+		assert.Equal(t, config.Net.SASL.Password, testEventStreamsAPIKey)
+		config.Net.SASL.Enable = false
+		config.Net.TLS.Enable = false
+		return sarama.NewClusterAdmin(addrs, config)
+	}
+	t.Cleanup(func() { newSaramaClusterAdmin = sarama.NewClusterAdmin })
+
+	srv := testMockServer(t)
+	srv.Extensions["messagehub"] = map[string]interface{}{
+		"kafka_http_url":     rest.URL,
+		"kafka_brokers_sasl": []interface{}{es.Broker.Addr()},
+	}
+	c := &Config{
+		Region:         srv.Region,
+		Visibility:     "public",
+		BluemixTimeout: 60 * time.Second,
+		RetryDelay:     time.Second,
+		Endpoints:      srv.Endpoints(),
+		BluemixAPIKey:  testEventStreamsAPIKey,
+	}
+	sess, err := c.ClientSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	es.Meta = sess.(ClientSession)
+
+	instanceResource := resourceIBMResourceInstance()
+	instance := schema.TestResourceDataRaw(t, instanceResource.Schema, map[string]interface{}{
+		"name":              "mock-event-streams",
+		"service":           "messagehub",
+		"plan":              "standard",
+		"location":          "us-south",
+		"resource_group_id": "mock-resource-group",
+	})
+	assert.NilError(t, testDiagsErr(instanceResource.CreateContext(context.Background(), instance, es.Meta)))
+	es.InstanceCRN = instance.Id()
+	return es
+}
+
+// serveREST serves the quotas of the admin REST API and the subjects and
+// compatibility configurations of the schema registry.
+func (es *testEventStreams) serveREST(w http.ResponseWriter, r *http.Request) {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	if user, password, ok := r.BasicAuth(); !ok || user != "token" || password != testEventStreamsAPIKey {
+		testEventStreamsError(w, http.StatusUnauthorized, 401, "Unauthorized")
+		return
+	}
+	body := map[string]interface{}{}
+	json.NewDecoder(r.Body).Decode(&body)
+	segments := strings.Split(strings.Trim(r.URL.EscapedPath(), "/"), "/")
+	for i := range segments {
+		segments[i], _ = url.PathUnescape(segments[i])
+	}
+	switch {
+	case len(segments) == 3 && segments[0] == "admin" && segments[1] == "quotas":
+		es.serveQuota(w, r, segments[2], body)
+	case len(segments) >= 3 && segments[0] == "confluent" && segments[1] == "subjects":
+		es.serveSubject(w, r, segments[2], segments[3:], body)
+	case len(segments) <= 3 && segments[0] == "confluent" && segments[1] == "config":
+		subject := ""
+		if len(segments) == 3 {
+			subject = segments[2]
+		}
+		switch r.Method {
+		case http.MethodGet:
+			if _, ok := es.configs[subject]; !ok {
+				testEventStreamsError(w, http.StatusNotFound, 40401, "Subject not found")
+				return
+			}
+			testEventStreamsJSON(w, http.StatusOK, map[string]interface{}{"compatibilityLevel": es.configs[subject]})
+		case http.MethodPut:
+			es.configs[subject] = body["compatibility"].(string)
+			testEventStreamsJSON(w, http.StatusOK, body)
+		}
+	default:
+		testEventStreamsError(w, http.StatusNotFound, 404, "Not found")
+	}
+}
+
+func (es *testEventStreams) serveQuota(w http.ResponseWriter, r *http.Request, entity string, body map[string]interface{}) {
+	quota, ok := es.quotas[entity]
+	switch {
+	case r.Method == http.MethodPost && ok:
+		testEventStreamsError(w, http.StatusUnprocessableEntity, 422, "Quota already exists")
+	case r.Method == http.MethodPost:
+		es.quotas[entity] = body
+		w.WriteHeader(http.StatusCreated)
+	case !ok:
+		testEventStreamsError(w, http.StatusNotFound, 404, "Quota not found")
+	case r.Method == http.MethodGet:
+		testEventStreamsJSON(w, http.StatusOK, quota)
+	case r.Method == http.MethodPatch:
+		es.quotas[entity] = body
+		w.WriteHeader(http.StatusAccepted)
+	case r.Method == http.MethodDelete:
+		delete(es.quotas, entity)
+		w.WriteHeader(http.StatusAccepted)
+	}
+}
+
+func (es *testEventStreams) serveSubject(w http.ResponseWriter, r *http.Request, subject string, path []string, body map[string]interface{}) {
+	versions := es.subjects[subject]
+	switch {
+	case r.Method == http.MethodPost && len(path) == 1 && path[0] == "versions":
+		es.subjects[subject] = append(versions, body["schema"].(string))
+		testEventStreamsJSON(w, http.StatusOK, map[string]interface{}{"id": 100 + len(es.subjects[subject])})
+	case len(versions) == 0:
+		testEventStreamsError(w, http.StatusNotFound, 40401, "Subject not found")
+	case r.Method == http.MethodGet && len(path) == 2 && path[1] == "latest":
+		testEventStreamsJSON(w, http.StatusOK, map[string]interface{}{
+			"subject": subject,
+			"version": len(versions),
+			"id":      100 + len(versions),
+			"schema":  versions[len(versions)-1],
+		})
+	case r.Method == http.MethodDelete && len(path) == 0:
+		delete(es.subjects, subject)
+		delete(es.configs, subject)
+		var deleted []int
+		for i := range versions {
+			deleted = append(deleted, i+1)
+		}
+		testEventStreamsJSON(w, http.StatusOK, deleted)
+	default:
+		testEventStreamsError(w, http.StatusNotFound, 404, "Not found")
+	}
+}
+
+func testEventStreamsJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+func testEventStreamsError(w http.ResponseWriter, status, code int, message string) {
+	testEventStreamsJSON(w, status, map[string]interface{}{"error_code": code, "message": message})
+}
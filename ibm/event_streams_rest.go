@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// eventStreamsRESTClient calls the admin REST API of an Event Streams instance,
+// served at its kafka_http_url, which also serves the schema registry under
+// /confluent. The requests are authenticated with the API key, like the Kafka
+// connections of the admin client.
+type eventStreamsRESTClient struct {
+	URL    string
+	APIKey string
+	Client *http.Client
+}
+
+func createEventStreamsRESTClient(d *schema.ResourceData, meta interface{}) (*eventStreamsRESTClient, string, error) {
+	instanceCRN, adminURL, _, apiKey, err := getEventStreamsInstance(d, meta)
+	if err != nil {
+		return nil, "", err
+	}
+	bxSession, err := meta.(ClientSession).BluemixSession()
+	if err != nil {
+		return nil, "", err
+	}
+	client := &eventStreamsRESTClient{
+		URL:    strings.TrimSuffix(adminURL, "/"),
+		APIKey: apiKey,
+		Client: bxSession.Config.HTTPClient,
+	}
+	if client.Client == nil {
+		client.Client = &http.Client{Timeout: bxSession.Config.HTTPTimeout}
+	}
+	return client, instanceCRN, nil
+}
+
+// do sends a request with the JSON body and decodes the JSON response into result
+// when it is not nil. The error responses are returned as an *APIError.
+func (c *eventStreamsRESTClient) do(method, path string, body, result interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+	request, err := http.NewRequest(method, c.URL+path, reader)
+	if err != nil {
+		return err
+	}
+	request.SetBasicAuth("token", c.APIKey)
+	request.Header.Set("Accept", "application/json")
+	if body != nil {
+		contentType := "application/json"
+		if strings.HasPrefix(path, "/confluent/") {
+			contentType = "application/vnd.schemaregistry.v1+json"
+		}
+		request.Header.Set("Content-Type", contentType)
+	}
+	response, err := c.Client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	data, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode >= 300 {
+		return eventStreamsAPIError(fmt.Sprintf("Error calling %s %s", method, path), response, data)
+	}
+	if result != nil && len(data) > 0 {
+		return json.Unmarshal(data, result)
+	}
+	return nil
+}
+
+// eventStreamsAPIError returns the error of a response of the admin REST API,
+// {"error_code": 404, "message": "..."}, or of the schema registry,
+// {"error_code": 40401, "message": "..."}.
+func eventStreamsAPIError(operation string, response *http.Response, data []byte) error {
+	body := map[string]interface{}{}
+	json.Unmarshal(data, &body)
+	if code, ok := body["error_code"].(float64); ok {
+		body["error_code"] = fmt.Sprint(int(code))
+	}
+	message := strings.TrimSpace(string(data))
+	if message == "" {
+		message = response.Status
+	}
+	detailed := &core.DetailedResponse{
+		StatusCode: response.StatusCode,
+		Headers:    response.Header,
+		Result:     body,
+	}
+	return apiErrorf("event_streams", errors.New(message), detailed, "%s", operation)
+}
+
+// isEventStreamsNotFound reports whether err is a 404 of the admin REST API or of
+// the schema registry.
+func isEventStreamsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+)
+
+// iamIdentityRequestOptions describes a request to the IAM Identity API sent by
+// iamIdentityRequest. The path parameters are escaped into the path, such as
+// /v1/profiles/{profile-id}.
+type iamIdentityRequestOptions struct {
+	Method     string
+	Path       string
+	PathParams map[string]string
+	IfMatch    string
+	Body       interface{}
+}
+
+// iamIdentityRequest sends a request to the IAM Identity API for the trusted profile
+// operations the platform-services-go-sdk doesn't offer yet, with the URL and the
+// authenticator of the client. The JSON response is decoded into result when it is
+// not nil.
+func iamIdentityRequest(sess *iamidentityv1.IamIdentityV1, options iamIdentityRequestOptions, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(options.Method)
+	builder.EnableGzipCompression = sess.GetEnableGzipCompression()
+	if _, err := builder.ResolveRequestURL(sess.Service.Options.URL, options.Path, options.PathParams); err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	if options.IfMatch != "" {
+		builder.AddHeader("If-Match", options.IfMatch)
+	}
+	if options.Body != nil {
+		if _, err := builder.SetBodyContentJSON(options.Body); err != nil {
+			return nil, err
+		}
+		builder.AddHeader("Content-Type", "application/json")
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return sess.Service.Request(request, result)
+}
+
+// iamTrustedProfile is a trusted profile, /v1/profiles/{profile-id}.
+type iamTrustedProfile struct {
+	ID           string `json:"id,omitempty"`
+	EntityTag    string `json:"entity_tag,omitempty"`
+	CRN          string `json:"crn,omitempty"`
+	Name         string `json:"name"`
+	Description  string `json:"description,omitempty"`
+	AccountID    string `json:"account_id,omitempty"`
+	IamID        string `json:"iam_id,omitempty"`
+	ImsAccountID int64  `json:"ims_account_id,omitempty"`
+	ImsUserID    int64  `json:"ims_user_id,omitempty"`
+	CreatedAt    string `json:"created_at,omitempty"`
+	ModifiedAt   string `json:"modified_at,omitempty"`
+}
+
+// iamProfileClaimRuleCondition is a condition on a claim of the identity token
+// presented to assume the trusted profile.
+type iamProfileClaimRuleCondition struct {
+	Claim    string `json:"claim"`
+	Operator string `json:"operator"`
+	Value    string `json:"value"`
+}
+
+// iamProfileClaimRule grants the federated users of an identity provider
+// (Profile-SAML) or the compute resources (Profile-CR) matching its conditions the
+// right to assume a trusted profile, /v1/profiles/{profile-id}/rules/{rule-id}.
+type iamProfileClaimRule struct {
+	ID         string                         `json:"id,omitempty"`
+	EntityTag  string                         `json:"entity_tag,omitempty"`
+	Name       string                         `json:"name,omitempty"`
+	Type       string                         `json:"type"`
+	RealmName  string                         `json:"realm_name,omitempty"`
+	CrType     string                         `json:"cr_type,omitempty"`
+	Expiration int                            `json:"expiration,omitempty"`
+	Conditions []iamProfileClaimRuleCondition `json:"conditions"`
+	CreatedAt  string                         `json:"created_at,omitempty"`
+	ModifiedAt string                         `json:"modified_at,omitempty"`
+}
+
+// iamProfileLinkTarget identifies the compute resource of a link, the namespace and
+// name of the service account for the Kubernetes clusters.
+type iamProfileLinkTarget struct {
+	CRN       string `json:"crn"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name,omitempty"`
+}
+
+// iamProfileLink links a compute resource to a trusted profile, so the resource can
+// assume the profile without a claim rule, /v1/profiles/{profile-id}/links/{link-id}.
+type iamProfileLink struct {
+	ID         string               `json:"id,omitempty"`
+	EntityTag  string               `json:"entity_tag,omitempty"`
+	Name       string               `json:"name,omitempty"`
+	CrType     string               `json:"cr_type"`
+	Link       iamProfileLinkTarget `json:"link"`
+	CreatedAt  string               `json:"created_at,omitempty"`
+	ModifiedAt string               `json:"modified_at,omitempty"`
+}
+
+func getIAMTrustedProfile(sess *iamidentityv1.IamIdentityV1, profileID string) (*iamTrustedProfile, *core.DetailedResponse, error) {
+	profile := &iamTrustedProfile{}
+	options := iamIdentityRequestOptions{
+		Method:     core.GET,
+		Path:       "/v1/profiles/{profile-id}",
+		PathParams: map[string]string{"profile-id": profileID},
+	}
+	response, err := iamIdentityRequest(sess, options, profile)
+	if err != nil {
+		return nil, response, err
+	}
+	return profile, response, nil
+}
@@ -0,0 +1,156 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package mockserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Interaction is a recorded request and the response of the live API.
+type Interaction struct {
+	Service    string            `json:"service"`
+	Method     string            `json:"method"`
+	URI        string            `json:"uri"`
+	StatusCode int               `json:"status_code"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body       string            `json:"body,omitempty"`
+}
+
+// recordedHeaders are the response headers kept in a cassette.
+var recordedHeaders = []string{"Content-Type", "Location", "ETag"}
+
+// cassette holds the interactions of a test. A replayed request is answered by the
+// first unused interaction with the same service, method and URI, or by the last
+// one once they are all used, so polling a resource repeats its final state.
+type cassette struct {
+	path string
+
+	mu           sync.Mutex
+	Interactions []*Interaction `json:"interactions"`
+	used         map[*Interaction]bool
+}
+
+func newCassette(path string) *cassette {
+	return &cassette{path: path, used: make(map[*Interaction]bool)}
+}
+
+func loadCassette(path string) (*cassette, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading cassette %s, record it first with %s=%s: %s", path, ModeEnvVar, ModeRecord, err)
+	}
+	c := newCassette(path)
+	if err := json.Unmarshal(content, c); err != nil {
+		return nil, fmt.Errorf("Error parsing cassette %s: %s", path, err)
+	}
+	return c, nil
+}
+
+func (c *cassette) add(i *Interaction) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Interactions = append(c.Interactions, i)
+}
+
+func (c *cassette) match(service, method, uri string) *Interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var last *Interaction
+	for _, i := range c.Interactions {
+		if i.Service != service || i.Method != method || i.URI != uri {
+			continue
+		}
+		if !c.used[i] {
+			c.used[i] = true
+			return i
+		}
+		last = i
+	}
+	return last
+}
+
+func (c *cassette) save() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	content, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(c.path, append(content, '\n'), 0644)
+}
+
+// record proxies the request to the live endpoint of the service and adds the
+// interaction to the cassette. The IAM token requests are proxied but never
+// recorded, so no credential ends up in a cassette.
+func (s *Server) record(service string, w http.ResponseWriter, r *http.Request) {
+	req, err := http.NewRequest(r.Method, s.upstreams[service]+r.URL.RequestURI(), r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadGateway, "mockserver_record", "%s", err)
+		return
+	}
+	for k, v := range r.Header {
+		req.Header[k] = v
+	}
+	// Let the transport negotiate the encoding, so the recorded bodies are plain text.
+	req.Header.Del("Accept-Encoding")
+	req.ContentLength = r.ContentLength
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		writeError(w, http.StatusBadGateway, "mockserver_record", "%s", err)
+		return
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		writeError(w, http.StatusBadGateway, "mockserver_record", "%s", err)
+		return
+	}
+
+	interaction := &Interaction{
+		Service:    service,
+		Method:     r.Method,
+		URI:        r.URL.RequestURI(),
+		StatusCode: resp.StatusCode,
+		Headers:    map[string]string{},
+		Body:       string(body),
+	}
+	for _, h := range recordedHeaders {
+		if v := resp.Header.Get(h); v != "" {
+			interaction.Headers[h] = v
+		}
+	}
+	if !isIAMToken(service, r) {
+		s.cassette.add(interaction)
+	}
+	for k, v := range resp.Header {
+		w.Header()[k] = v
+	}
+	w.WriteHeader(resp.StatusCode)
+	io.Copy(w, bytes.NewReader(body))
+}
+
+// replay answers the request from the cassette.
+func (s *Server) replay(service string, w http.ResponseWriter, r *http.Request) {
+	i := s.cassette.match(service, r.Method, r.URL.RequestURI())
+	if i == nil {
+		writeError(w, http.StatusNotImplemented, "mockserver_replay", "No interaction recorded in %s for %s %s %s", s.cassette.path, service, r.Method, r.URL.RequestURI())
+		return
+	}
+	for k, v := range i.Headers {
+		w.Header().Set(k, v)
+	}
+	w.WriteHeader(i.StatusCode)
+	io.WriteString(w, i.Body)
+}
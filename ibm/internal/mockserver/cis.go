@@ -0,0 +1,537 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package mockserver
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// cis serves the domains and DNS records of Cloud Internet Services, the export of
+// the records of a domain as a zone file, and the filters and firewall rules of the
+// domains. Like the live API, a CNAME record can't share its name with other records,
+// the proxied records have an automatic TTL, the filter and firewall rule requests
+// need the X-Auth-User-Token header and a filter can't be deleted while a firewall
+// rule uses it.
+type cis struct {
+	server        *Server
+	zones         *collection
+	records       *collection
+	filters       *collection
+	firewallRules *collection
+}
+
+func newCIS(s *Server) *cis {
+	return &cis{
+		server:        s,
+		zones:         newCollection(),
+		records:       newCollection(),
+		filters:       newCollection(),
+		firewallRules: newCollection(),
+	}
+}
+
+// cisResult returns the envelope of the responses of the API.
+func cisResult(result interface{}) object {
+	return object{"success": true, "errors": []interface{}{}, "messages": []interface{}{}, "result": result}
+}
+
+func (c *cis) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// The CRN of the instance is escaped in the path
+	parts := strings.Split(strings.Trim(r.URL.EscapedPath(), "/"), "/")
+	for i, part := range parts {
+		parts[i], _ = url.PathUnescape(part)
+	}
+	if len(parts) < 3 || parts[0] != "v1" || parts[2] != "zones" {
+		notFound(w, r)
+		return
+	}
+	crn := parts[1]
+	if len(parts) == 3 && r.Method == http.MethodPost {
+		c.createZone(w, r, crn)
+		return
+	}
+	if len(parts) < 4 {
+		notFound(w, r)
+		return
+	}
+	zone, ok := c.zones.get(parts[3])
+	if !ok || zone["crn"] != crn {
+		writeError(w, http.StatusNotFound, "1001", "Zone %s not found", parts[3])
+		return
+	}
+	switch {
+	case len(parts) == 4 && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, cisResult(zone))
+	case len(parts) == 4 && r.Method == http.MethodDelete:
+		for _, record := range c.zoneRecords(zone) {
+			c.records.remove(record["id"].(string))
+		}
+		for _, rule := range c.zoneObjects(c.firewallRules, zone) {
+			c.firewallRules.remove(rule["id"].(string))
+		}
+		for _, filter := range c.zoneObjects(c.filters, zone) {
+			c.filters.remove(filter["id"].(string))
+		}
+		c.zones.remove(parts[3])
+		writeJSON(w, http.StatusOK, cisResult(object{"id": parts[3]}))
+	case len(parts) == 5 && parts[4] == "dns_records_bulk" && r.Method == http.MethodGet:
+		c.exportRecords(w, zone)
+	case len(parts) >= 5 && parts[4] == "dns_records":
+		c.serveRecords(w, r, zone, parts[5:])
+	case len(parts) >= 5 && parts[4] == "filters":
+		if c.authorizeUser(w, r) {
+			c.serveFilters(w, r, zone, parts[5:])
+		}
+	case len(parts) >= 6 && parts[4] == "firewall" && parts[5] == "rules":
+		if c.authorizeUser(w, r) {
+			c.serveFirewallRules(w, r, zone, parts[6:])
+		}
+	default:
+		notFound(w, r)
+	}
+}
+
+func (c *cis) createZone(w http.ResponseWriter, r *http.Request, crn string) {
+	body := object{}
+	if err := readJSON(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "1000", "%s", err)
+		return
+	}
+	name, _ := body["name"].(string)
+	if name == "" {
+		writeError(w, http.StatusBadRequest, "1000", "name is required")
+		return
+	}
+	id := strings.Replace(c.server.newID()[5:], "-", "", -1)
+	now := timestamp()
+	zone := c.zones.add(id, object{
+		"id":           id,
+		"crn":          crn,
+		"name":         strings.ToLower(name),
+		"status":       "active",
+		"paused":       false,
+		"name_servers": []string{"ns1.mockserver.invalid", "ns2.mockserver.invalid"},
+		"created_on":   now,
+		"modified_on":  now,
+	})
+	writeJSON(w, http.StatusOK, cisResult(zone))
+}
+
+func (c *cis) zoneRecords(zone object) []object {
+	return c.records.list(func(o object) bool { return o["zone_id"] == zone["id"] })
+}
+
+func (c *cis) serveRecords(w http.ResponseWriter, r *http.Request, zone object, path []string) {
+	switch {
+	case len(path) == 0 && r.Method == http.MethodGet:
+		c.listRecords(w, r, zone)
+	case len(path) == 0 && r.Method == http.MethodPost:
+		body := object{}
+		if err := readJSON(r, &body); err != nil {
+			writeError(w, http.StatusBadRequest, "1000", "%s", err)
+			return
+		}
+		id := strings.Replace(c.server.newID()[5:], "-", "", -1)
+		now := timestamp()
+		record, err := c.record(zone, id, body)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "81053", "%s", err)
+			return
+		}
+		record["created_on"] = now
+		record["modified_on"] = now
+		writeJSON(w, http.StatusOK, cisResult(c.records.add(id, record)))
+	case len(path) == 1:
+		record, ok := c.records.get(path[0])
+		if !ok || record["zone_id"] != zone["id"] {
+			writeError(w, http.StatusNotFound, "81044", "Record %s not found", path[0])
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, cisResult(record))
+		case http.MethodPut:
+			body := object{}
+			if err := readJSON(r, &body); err != nil {
+				writeError(w, http.StatusBadRequest, "1000", "%s", err)
+				return
+			}
+			updated, err := c.record(zone, path[0], body)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, "81053", "%s", err)
+				return
+			}
+			updated["created_on"] = record["created_on"]
+			updated["modified_on"] = timestamp()
+			c.records.add(path[0], updated)
+			writeJSON(w, http.StatusOK, cisResult(updated))
+		case http.MethodDelete:
+			c.records.remove(path[0])
+			writeJSON(w, http.StatusOK, cisResult(object{"id": path[0]}))
+		default:
+			notFound(w, r)
+		}
+	default:
+		notFound(w, r)
+	}
+}
+
+// record returns the record of the zone described by the body of a request, or the
+// error of the API when it is invalid or conflicts with another record.
+func (c *cis) record(zone object, id string, body object) (object, error) {
+	zoneName := zone["name"].(string)
+	recordType, _ := body["type"].(string)
+	name, _ := body["name"].(string)
+	content, _ := body["content"].(string)
+	data, _ := body["data"].(map[string]interface{})
+	priority := body["priority"]
+	ttl := 1
+	if v, ok := body["ttl"].(float64); ok && v > 0 {
+		ttl = int(v)
+	}
+
+	switch recordType {
+	case "A", "AAAA", "CNAME", "MX", "TXT", "SPF", "NS", "PTR":
+		if content == "" {
+			return nil, fmt.Errorf("content is required for the %s records", recordType)
+		}
+	case "SRV":
+		if data == nil {
+			return nil, fmt.Errorf("data is required for the SRV records")
+		}
+		name = fmt.Sprintf("%v.%v.%v", data["service"], data["proto"], data["name"])
+		priority = data["priority"]
+		content = fmt.Sprintf("%v %v %v", data["weight"], data["port"], data["target"])
+	case "CAA":
+		if data == nil {
+			return nil, fmt.Errorf("data is required for the CAA records")
+		}
+		content = fmt.Sprintf("%v %v %v", data["flags"], data["tag"], data["value"])
+	default:
+		return nil, fmt.Errorf("the %q record type is not supported", recordType)
+	}
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	if name == "@" || name == "" {
+		name = zoneName
+	} else if name != zoneName && !strings.HasSuffix(name, "."+zoneName) {
+		name = name + "." + zoneName
+	}
+
+	proxiable := recordType == "A" || recordType == "AAAA" || recordType == "CNAME"
+	proxied, _ := body["proxied"].(bool)
+	if proxied && !proxiable {
+		return nil, fmt.Errorf("the %s records can't be proxied", recordType)
+	}
+	if proxied {
+		ttl = 1
+	}
+
+	for _, other := range c.zoneRecords(zone) {
+		if other["id"] == id || other["name"] != name {
+			continue
+		}
+		if (recordType == "CNAME") != (other["type"] == "CNAME") || recordType == "CNAME" {
+			return nil, fmt.Errorf("a CNAME record can't share the name %s with other records", name)
+		}
+		if other["type"] == recordType && other["content"] == content && fmt.Sprint(other["priority"]) == fmt.Sprint(priority) {
+			return nil, fmt.Errorf("the record already exists")
+		}
+	}
+
+	record := object{
+		"id":        id,
+		"zone_id":   zone["id"],
+		"zone_name": zoneName,
+		"name":      name,
+		"type":      recordType,
+		"content":   content,
+		"ttl":       ttl,
+		"proxiable": proxiable,
+		"proxied":   proxied,
+	}
+	if priority != nil {
+		record["priority"] = priority
+	}
+	if data != nil {
+		record["data"] = data
+	}
+	return record, nil
+}
+
+func (c *cis) listRecords(w http.ResponseWriter, r *http.Request, zone object) {
+	records := c.zoneRecords(zone)
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+	if page < 1 {
+		page = 1
+	}
+	if perPage < 1 {
+		perPage = 20
+	}
+	start, end := (page-1)*perPage, page*perPage
+	if start > len(records) {
+		start = len(records)
+	}
+	if end > len(records) {
+		end = len(records)
+	}
+	response := cisResult(records[start:end])
+	response["result_info"] = object{"page": page, "per_page": perPage, "count": end - start, "total_count": len(records)}
+	writeJSON(w, http.StatusOK, response)
+}
+
+// exportRecords writes the records of the zone as a BIND zone file, the way the
+// live API exports them with the proxied records tagged in a comment.
+func (c *cis) exportRecords(w http.ResponseWriter, zone object) {
+	var b strings.Builder
+	fmt.Fprintf(&b, ";; Domain:     %s.\n;; Exported:   %s\n\n", zone["name"], timestamp())
+	fmt.Fprintf(&b, "%s.\t3600\tIN\tSOA\tns1.mockserver.invalid. dns.mockserver.invalid. 2021010101 10000 2400 604800 3600\n", zone["name"])
+	lines := []string{}
+	for _, record := range c.zoneRecords(zone) {
+		content := record["content"]
+		switch record["type"] {
+		case "CNAME", "NS", "PTR":
+			content = fmt.Sprintf("%s.", content)
+		case "MX", "SRV":
+			content = fmt.Sprintf("%v %s.", record["priority"], content)
+		case "TXT", "SPF":
+			content = strconv.Quote(content.(string))
+		case "CAA":
+			data := record["data"].(map[string]interface{})
+			content = fmt.Sprintf("%v %v %q", data["flags"], data["tag"], data["value"])
+		}
+		line := fmt.Sprintf("%s.\t%v\tIN\t%s\t%s", record["name"], record["ttl"], record["type"], content)
+		if record["proxied"] == true {
+			line += " ; cf_tags=cf-proxied:true"
+		}
+		lines = append(lines, line)
+	}
+	sort.Strings(lines)
+	b.WriteString(strings.Join(lines, "\n") + "\n")
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(b.String()))
+}
+
+// authorizeUser writes the error of the API when the request has no IAM token in its
+// X-Auth-User-Token header, as the filters and firewall rules APIs require.
+func (c *cis) authorizeUser(w http.ResponseWriter, r *http.Request) bool {
+	if r.Header.Get("X-Auth-User-Token") == "" {
+		writeError(w, http.StatusUnauthorized, "10000", "X-Auth-User-Token header is required")
+		return false
+	}
+	return true
+}
+
+func (c *cis) zoneObjects(objects *collection, zone object) []object {
+	return objects.list(func(o object) bool { return o["zone_id"] == zone["id"] })
+}
+
+// readArray reads the body of the requests creating several objects at once.
+func readArray(r *http.Request) ([]object, error) {
+	body := []object{}
+	if err := readJSON(r, &body); err != nil {
+		return nil, err
+	}
+	if len(body) == 0 {
+		return nil, fmt.Errorf("at least one item is required")
+	}
+	return body, nil
+}
+
+func (c *cis) serveFilters(w http.ResponseWriter, r *http.Request, zone object, path []string) {
+	switch {
+	case len(path) == 0 && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, cisResult(c.zoneObjects(c.filters, zone)))
+	case len(path) == 0 && r.Method == http.MethodPost:
+		body, err := readArray(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "10014", "%s", err)
+			return
+		}
+		filters := []object{}
+		for _, item := range body {
+			filter, err := c.filter(zone, "", item)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, "10014", "%s", err)
+				return
+			}
+			filters = append(filters, filter)
+		}
+		for _, filter := range filters {
+			c.filters.add(filter["id"].(string), filter)
+		}
+		writeJSON(w, http.StatusOK, cisResult(filters))
+	case len(path) == 1:
+		filter, ok := c.filters.get(path[0])
+		if !ok || filter["zone_id"] != zone["id"] {
+			writeError(w, http.StatusNotFound, "10001", "Filter %s not found", path[0])
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, cisResult(filter))
+		case http.MethodPut:
+			body := object{}
+			if err := readJSON(r, &body); err != nil {
+				writeError(w, http.StatusBadRequest, "10014", "%s", err)
+				return
+			}
+			updated, err := c.filter(zone, path[0], body)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, "10014", "%s", err)
+				return
+			}
+			writeJSON(w, http.StatusOK, cisResult(c.filters.add(path[0], updated)))
+		case http.MethodDelete:
+			for _, rule := range c.zoneObjects(c.firewallRules, zone) {
+				if rule["filter_id"] == path[0] {
+					writeError(w, http.StatusBadRequest, "10018", "Filter %s is referenced by the firewall rule %s", path[0], rule["id"])
+					return
+				}
+			}
+			c.filters.remove(path[0])
+			writeJSON(w, http.StatusOK, cisResult(object{"id": path[0]}))
+		default:
+			notFound(w, r)
+		}
+	default:
+		notFound(w, r)
+	}
+}
+
+// filter returns the filter of the zone described by the body of a request. The
+// expression is not parsed, the live API rejecting the invalid ones with a 400.
+func (c *cis) filter(zone object, id string, body object) (object, error) {
+	expression, _ := body["expression"].(string)
+	if strings.TrimSpace(expression) == "" {
+		return nil, fmt.Errorf("expression is required")
+	}
+	if id == "" {
+		id = strings.Replace(c.server.newID()[5:], "-", "", -1)
+	}
+	filter := object{"id": id, "zone_id": zone["id"], "expression": expression, "paused": body["paused"] == true}
+	if description, ok := body["description"].(string); ok && description != "" {
+		filter["description"] = description
+	}
+	return filter, nil
+}
+
+func (c *cis) serveFirewallRules(w http.ResponseWriter, r *http.Request, zone object, path []string) {
+	switch {
+	case len(path) == 0 && r.Method == http.MethodGet:
+		rules := []object{}
+		for _, rule := range c.zoneObjects(c.firewallRules, zone) {
+			rules = append(rules, c.firewallRuleResult(rule))
+		}
+		writeJSON(w, http.StatusOK, cisResult(rules))
+	case len(path) == 0 && r.Method == http.MethodPost:
+		body, err := readArray(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "10014", "%s", err)
+			return
+		}
+		rules := []object{}
+		for _, item := range body {
+			rule, err := c.firewallRule(zone, "", item)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, "10014", "%s", err)
+				return
+			}
+			rules = append(rules, rule)
+		}
+		results := []object{}
+		for _, rule := range rules {
+			results = append(results, c.firewallRuleResult(c.firewallRules.add(rule["id"].(string), rule)))
+		}
+		writeJSON(w, http.StatusOK, cisResult(results))
+	case len(path) == 1:
+		rule, ok := c.firewallRules.get(path[0])
+		if !ok || rule["zone_id"] != zone["id"] {
+			writeError(w, http.StatusNotFound, "10001", "Firewall rule %s not found", path[0])
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, cisResult(c.firewallRuleResult(rule)))
+		case http.MethodPut:
+			body := object{}
+			if err := readJSON(r, &body); err != nil {
+				writeError(w, http.StatusBadRequest, "10014", "%s", err)
+				return
+			}
+			updated, err := c.firewallRule(zone, path[0], body)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, "10014", "%s", err)
+				return
+			}
+			updated["created_on"] = rule["created_on"]
+			writeJSON(w, http.StatusOK, cisResult(c.firewallRuleResult(c.firewallRules.add(path[0], updated))))
+		case http.MethodDelete:
+			c.firewallRules.remove(path[0])
+			writeJSON(w, http.StatusOK, cisResult(object{"id": path[0]}))
+		default:
+			notFound(w, r)
+		}
+	default:
+		notFound(w, r)
+	}
+}
+
+// firewallRule returns the firewall rule of the zone described by the body of a
+// request, which must reference a filter of the zone.
+func (c *cis) firewallRule(zone object, id string, body object) (object, error) {
+	action, _ := body["action"].(string)
+	switch action {
+	case "allow", "block", "challenge", "js_challenge", "log":
+	default:
+		return nil, fmt.Errorf("the action %q is not supported", action)
+	}
+	filterRef, _ := body["filter"].(map[string]interface{})
+	filterID, _ := filterRef["id"].(string)
+	if filter, ok := c.filters.get(filterID); !ok || filter["zone_id"] != zone["id"] {
+		return nil, fmt.Errorf("the filter %q of the firewall rule doesn't exist", filterID)
+	}
+	if id == "" {
+		id = strings.Replace(c.server.newID()[5:], "-", "", -1)
+	}
+	now := timestamp()
+	rule := object{
+		"id":          id,
+		"zone_id":     zone["id"],
+		"filter_id":   filterID,
+		"action":      action,
+		"paused":      body["paused"] == true,
+		"created_on":  now,
+		"modified_on": now,
+	}
+	if description, ok := body["description"].(string); ok && description != "" {
+		rule["description"] = description
+	}
+	if priority, ok := body["priority"].(float64); ok {
+		if priority < 1 || priority > 2147483647 {
+			return nil, fmt.Errorf("the priority must be between 1 and 2147483647")
+		}
+		rule["priority"] = int(priority)
+	}
+	return rule, nil
+}
+
+// firewallRuleResult returns the firewall rule with its filter, as the API returns it.
+func (c *cis) firewallRuleResult(rule object) object {
+	result := object{}
+	for k, v := range rule {
+		if k != "filter_id" {
+			result[k] = v
+		}
+	}
+	filter, _ := c.filters.get(rule["filter_id"].(string))
+	result["filter"] = filter
+	return result
+}
@@ -0,0 +1,807 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package mockserver
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	// containerKubeVersion is the master version of the clusters created without
+	// a kubeVersion.
+	containerKubeVersion = "1.20.7"
+	// containerMasterFixpack and containerWorkerFixpack are the fixpacks of the
+	// masters and of the workers. The workers advertise the newer fixpack in their
+	// target version, and are updated to it when they are replaced.
+	containerMasterFixpack = "1535"
+	containerWorkerFixpack = "1540"
+
+	containerAutoscalerAddon     = "cluster-autoscaler"
+	containerAutoscalerConfigMap = "iks-ca-configmap"
+	containerAutoscalerPoolsKey  = "workerPoolsConfig.json"
+)
+
+// container serves the Kubernetes Service API of VPC clusters: the clusters, their
+// worker pools, workers, ALBs and add-ons, and the kubeconfig of a cluster. Each cluster
+// also has a Kubernetes API, at its masterURL under /kube/<cluster ID>, serving the
+// config maps, where the cluster-autoscaler add-on keeps its configuration.
+// Clusters, pools and workers are deployed as soon as they are created, and a
+// replaced worker is deleted and replaced by a worker at the target version at once.
+// Each zone of a cluster has an enabled public ALB and a disabled private ALB.
+type container struct {
+	server     *Server
+	clusters   *collection
+	pools      *collection
+	workers    *collection
+	albs       *collection
+	configMaps *collection
+	mu         sync.Mutex
+
+	// addons holds the names of the add-ons enabled on each cluster.
+	addons map[string][]string
+}
+
+func newContainer(s *Server) *container {
+	return &container{
+		server:     s,
+		clusters:   newCollection(),
+		pools:      newCollection(),
+		workers:    newCollection(),
+		albs:       newCollection(),
+		configMaps: newCollection(),
+		addons:     make(map[string][]string),
+	}
+}
+
+func (c *container) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if strings.HasPrefix(r.URL.Path, "/kube/") {
+		c.serveKube(w, r)
+		return
+	}
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, basePaths[ServiceContainer]), "/")
+	parts := strings.Split(path, "/")
+	query := r.URL.Query()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	switch {
+	case path == "v2/vpc/createCluster" && r.Method == http.MethodPost:
+		c.createCluster(w, r)
+	case (path == "v2/vpc/getCluster" || path == "v2/getCluster") && r.Method == http.MethodGet:
+		if cluster, ok := c.findCluster(w, query.Get("cluster")); ok {
+			writeJSON(w, http.StatusOK, cluster)
+		}
+	case len(parts) == 3 && parts[0] == "v1" && parts[1] == "clusters" && r.Method == http.MethodDelete:
+		c.deleteCluster(w, parts[2])
+	case path == "v2/vpc/getWorkerPools" && r.Method == http.MethodGet:
+		if cluster, ok := c.findCluster(w, query.Get("cluster")); ok {
+			pools := []object{}
+			for _, pool := range c.clusterPools(cluster["id"].(string)) {
+				pools = append(pools, c.renderPool(pool))
+			}
+			writeJSON(w, http.StatusOK, pools)
+		}
+	case path == "v2/vpc/getWorkerPool" && r.Method == http.MethodGet:
+		if _, pool, ok := c.findPool(w, query.Get("cluster"), query.Get("workerpool")); ok {
+			writeJSON(w, http.StatusOK, c.renderPool(pool))
+		}
+	case path == "v2/vpc/createWorkerPool" && r.Method == http.MethodPost:
+		c.createWorkerPool(w, r)
+	case path == "v2/setWorkerPoolTaints" && r.Method == http.MethodPost:
+		c.setWorkerPoolTaints(w, r)
+	case len(parts) == 5 && parts[0] == "v1" && parts[1] == "clusters" && parts[3] == "workerpools":
+		c.serveWorkerPool(w, r, parts[2], parts[4])
+	case path == "v2/vpc/getWorkers" && r.Method == http.MethodGet:
+		if cluster, ok := c.findCluster(w, query.Get("cluster")); ok {
+			showDeleted := query.Get("showDeleted") == "true"
+			pool := query.Get("pool")
+			workers := c.workers.list(func(o object) bool {
+				deleted := o["lifecycle"].(object)["actualState"] == "deleted"
+				return o["cluster"] == cluster["id"] && (showDeleted || !deleted) &&
+					(pool == "" || o["poolid"] == pool || o["poolName"] == pool)
+			})
+			for _, worker := range workers {
+				delete(worker, "cluster")
+			}
+			writeJSON(w, http.StatusOK, workers)
+		}
+	case path == "v2/vpc/getWorker" && r.Method == http.MethodGet:
+		if worker, ok := c.findWorker(w, query.Get("cluster"), query.Get("worker")); ok {
+			delete(worker, "cluster")
+			writeJSON(w, http.StatusOK, worker)
+		}
+	case path == "v2/vpc/replaceWorker" && r.Method == http.MethodPost:
+		c.replaceWorker(w, r)
+	case path == "v2/alb/getClusterAlbs" && r.Method == http.MethodGet:
+		if cluster, ok := c.findCluster(w, query.Get("cluster")); ok {
+			albs := c.albs.list(func(o object) bool { return o["cluster"] == cluster["id"] })
+			writeJSON(w, http.StatusOK, object{"id": cluster["id"], "alb": albs})
+		}
+	case path == "v2/alb/getAlb" && r.Method == http.MethodGet:
+		if alb, ok := c.albs.get(query.Get("albID")); ok {
+			writeJSON(w, http.StatusOK, alb)
+		} else {
+			writeError(w, http.StatusNotFound, "E0380", "The specified ALB ID could not be found.")
+		}
+	case (path == "v2/alb/vpc/enableAlb" || path == "v2/alb/vpc/disableAlb") && r.Method == http.MethodPost:
+		c.enableAlb(w, r, path == "v2/alb/vpc/enableAlb")
+	case len(parts) == 4 && parts[0] == "v1" && parts[1] == "clusters" && parts[3] == "addons":
+		c.serveAddons(w, r, parts[2])
+	case path == "v2/applyRBACAndGetKubeconfig" && r.Method == http.MethodPost:
+		c.getKubeconfig(w, r)
+	default:
+		notFound(w, r)
+	}
+}
+
+func (c *container) findCluster(w http.ResponseWriter, nameOrID string) (object, bool) {
+	for _, cluster := range c.clusters.list(nil) {
+		if cluster["id"] == nameOrID || cluster["name"] == nameOrID {
+			return cluster, true
+		}
+	}
+	writeError(w, http.StatusNotFound, "E0006", "The specified cluster could not be found. Target: vpc-gen2, Cluster: %s", nameOrID)
+	return nil, false
+}
+
+func (c *container) clusterPools(clusterID string) []object {
+	return c.pools.list(func(o object) bool { return o["cluster"] == clusterID })
+}
+
+func (c *container) findPool(w http.ResponseWriter, clusterNameOrID, poolNameOrID string) (object, object, bool) {
+	cluster, ok := c.findCluster(w, clusterNameOrID)
+	if !ok {
+		return nil, nil, false
+	}
+	for _, pool := range c.clusterPools(cluster["id"].(string)) {
+		if pool["id"] == poolNameOrID || pool["poolName"] == poolNameOrID {
+			return cluster, pool, true
+		}
+	}
+	writeError(w, http.StatusNotFound, "G0004", "The specified worker pool could not be found. Worker pool: %s", poolNameOrID)
+	return nil, nil, false
+}
+
+func (c *container) findWorker(w http.ResponseWriter, clusterNameOrID, workerID string) (object, bool) {
+	cluster, ok := c.findCluster(w, clusterNameOrID)
+	if !ok {
+		return nil, false
+	}
+	if worker, ok := c.workers.get(workerID); ok && worker["cluster"] == cluster["id"] {
+		return worker, true
+	}
+	writeError(w, http.StatusNotFound, "G0005", "The specified worker node could not be found. Worker: %s", workerID)
+	return nil, false
+}
+
+func (c *container) createCluster(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Name          string     `json:"name"`
+		KubeVersion   string     `json:"kubeVersion"`
+		PodSubnet     string     `json:"podSubnet"`
+		ServiceSubnet string     `json:"serviceSubnet"`
+		WorkerPool    poolConfig `json:"workerPool"`
+	}
+	if err := readJSON(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "E0002", "%s", err)
+		return
+	}
+	if body.Name == "" || body.WorkerPool.Flavor == "" || len(body.WorkerPool.Zones) == 0 {
+		writeError(w, http.StatusBadRequest, "E0002", "The name, flavor and zones of the cluster are required.")
+		return
+	}
+	version := strings.SplitN(body.KubeVersion, "_", 2)[0]
+	if version == "" {
+		version = containerKubeVersion
+	}
+	if body.PodSubnet == "" {
+		body.PodSubnet = "172.17.0.0/18"
+	}
+	if body.ServiceSubnet == "" {
+		body.ServiceSubnet = "172.21.0.0/16"
+	}
+	id := strings.Replace(c.server.newID(), "-", "", -1)[4:24]
+	zones := []string{}
+	for _, zone := range body.WorkerPool.Zones {
+		zones = append(zones, zone.ID)
+	}
+	masterURL := fmt.Sprintf("%s/kube/%s", strings.TrimSuffix(c.server.URL(ServiceContainer), basePaths[ServiceContainer]), id)
+	c.clusters.add(id, object{
+		"id":                id,
+		"name":              body.Name,
+		"crn":               fmt.Sprintf("crn:v1:bluemix:public:containers-kubernetes:%s:a/%s:%s::", c.server.Region, c.server.Account, id),
+		"region":            c.server.Region,
+		"location":          "Dallas",
+		"provider":          "vpc-gen2",
+		"type":              "kubernetes",
+		"state":             "normal",
+		"masterKubeVersion": version + "_" + containerMasterFixpack,
+		"targetVersion":     version + "_" + containerMasterFixpack,
+		"resourceGroup":     defaultResourceGroupID,
+		"resourceGroupName": "Default",
+		"vpcs":              []string{body.WorkerPool.VpcID},
+		"workerZones":       zones,
+		"podSubnet":         body.PodSubnet,
+		"serviceSubnet":     body.ServiceSubnet,
+		"masterURL":         masterURL,
+		"serviceEndpoints": object{
+			"publicServiceEndpointEnabled": true,
+			"publicServiceEndpointURL":     masterURL,
+		},
+		"ingress": object{
+			"hostname":   body.Name + ".us-south.containers.appdomain.cloud",
+			"secretName": body.Name,
+		},
+		"lifecycle": object{
+			"masterStatus": "Ready",
+			"masterState":  "deployed",
+			"masterHealth": "normal",
+		},
+		"createdDate": timestamp(),
+	})
+	if body.WorkerPool.Name == "" {
+		body.WorkerPool.Name = "default"
+	}
+	c.addPool(id, body.WorkerPool)
+	c.updateWorkerCount(id)
+	for i, zone := range zones {
+		for _, albType := range []string{"public", "private"} {
+			albID := fmt.Sprintf("%s-cr%s-alb%d", albType, id, i+1)
+			alb := object{
+				"albID":                albID,
+				"albType":              albType,
+				"cluster":              id,
+				"name":                 "",
+				"zone":                 zone,
+				"enable":               albType == "public",
+				"state":                "disabled",
+				"status":               "",
+				"albBuild":             "1.0.0_1645_iks",
+				"loadBalancerHostname": "",
+				"createdDate":          timestamp(),
+			}
+			if albType == "public" {
+				alb["state"], alb["status"] = "enabled", "healthy"
+				alb["loadBalancerHostname"] = fmt.Sprintf("%s-%s.lb.appdomain.cloud", id, zone)
+			}
+			c.albs.add(albID, alb)
+		}
+	}
+	writeJSON(w, http.StatusCreated, object{"clusterID": id})
+}
+
+// enableAlb enables or disables the ALB, which is healthy as soon as it is enabled.
+func (c *container) enableAlb(w http.ResponseWriter, r *http.Request, enable bool) {
+	var body struct {
+		AlbID string `json:"albID"`
+	}
+	if err := readJSON(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "E0002", "%s", err)
+		return
+	}
+	alb, ok := c.albs.get(body.AlbID)
+	if !ok {
+		writeError(w, http.StatusNotFound, "E0380", "The specified ALB ID could not be found.")
+		return
+	}
+	patch := object{"enable": false, "state": "disabled", "status": ""}
+	if enable {
+		patch = object{"enable": true, "state": "enabled", "status": "healthy",
+			"loadBalancerHostname": fmt.Sprintf("%s-%s.lb.appdomain.cloud", alb["cluster"], alb["zone"])}
+	}
+	c.albs.update(body.AlbID, patch)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (c *container) deleteCluster(w http.ResponseWriter, nameOrID string) {
+	cluster, ok := c.findCluster(w, nameOrID)
+	if !ok {
+		return
+	}
+	id := cluster["id"].(string)
+	for _, worker := range c.workers.list(func(o object) bool { return o["cluster"] == id }) {
+		c.workers.remove(worker["id"].(string))
+	}
+	for _, pool := range c.clusterPools(id) {
+		c.pools.remove(pool["id"].(string))
+	}
+	for _, alb := range c.albs.list(func(o object) bool { return o["cluster"] == id }) {
+		c.albs.remove(alb["albID"].(string))
+	}
+	for _, configMap := range c.configMaps.list(func(o object) bool { return o["cluster"] == id }) {
+		c.configMaps.remove(configMapKey(id, configMap["namespace"].(string), configMap["name"].(string)))
+	}
+	delete(c.addons, id)
+	c.clusters.remove(id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// poolConfig is the worker pool configuration of the create requests.
+type poolConfig struct {
+	Name        string            `json:"name"`
+	Flavor      string            `json:"flavor"`
+	VpcID       string            `json:"vpcID"`
+	WorkerCount int               `json:"workerCount"`
+	Labels      map[string]string `json:"labels"`
+	Zones       []struct {
+		ID       string `json:"id"`
+		SubnetID string `json:"subnetID"`
+	} `json:"zones"`
+}
+
+// addPool adds a worker pool to the cluster with workerCount workers in each zone.
+func (c *container) addPool(clusterID string, config poolConfig) object {
+	if config.WorkerCount == 0 {
+		config.WorkerCount = 1
+	}
+	labels := object{}
+	for k, v := range config.Labels {
+		labels[k] = v
+	}
+	zones := []interface{}{}
+	for _, zone := range config.Zones {
+		zones = append(zones, object{
+			"id":          zone.ID,
+			"workerCount": config.WorkerCount,
+			"subnets":     []interface{}{object{"id": zone.SubnetID, "primary": true}},
+		})
+	}
+	id := clusterID + "-" + strings.Replace(c.server.newID(), "-", "", -1)[4:12]
+	pool := c.pools.add(id, object{
+		"id":          id,
+		"cluster":     clusterID,
+		"poolName":    config.Name,
+		"flavor":      config.Flavor,
+		"isolation":   "public",
+		"provider":    "vpc-gen2",
+		"vpcID":       config.VpcID,
+		"workerCount": config.WorkerCount,
+		"labels":      labels,
+		"taints":      object{},
+		"zones":       zones,
+		"lifecycle":   object{"actualState": "active", "desiredState": "active"},
+	})
+	c.resizePool(pool)
+	return pool
+}
+
+// resizePool adds or deletes the workers of each zone of the pool to match its
+// workerCount.
+func (c *container) resizePool(pool object) {
+	cluster, _ := c.clusters.get(pool["cluster"].(string))
+	for _, z := range pool["zones"].([]interface{}) {
+		zone := z.(object)
+		workers := c.workers.list(func(o object) bool {
+			return o["poolid"] == pool["id"] && o["location"] == zone["id"] && o["lifecycle"].(object)["actualState"] != "deleted"
+		})
+		for i := len(workers); i < pool["workerCount"].(int); i++ {
+			subnetID := zone["subnets"].([]interface{})[0].(object)["id"].(string)
+			c.addWorker(cluster, pool, zone["id"].(string), subnetID, strings.Split(cluster["masterKubeVersion"].(string), "_")[0]+"_"+containerMasterFixpack)
+		}
+		for i := pool["workerCount"].(int); i < len(workers); i++ {
+			c.deleteWorker(workers[i])
+		}
+	}
+}
+
+func (c *container) addWorker(cluster, pool object, zone, subnetID, version string) object {
+	seq := strings.Replace(c.server.newID(), "-", "", -1)[4:12]
+	id := fmt.Sprintf("kube-%s-%s-%s", cluster["id"], pool["poolName"], seq)
+	target := strings.Split(version, "_")[0] + "_" + containerWorkerFixpack
+	n, _ := strconv.ParseUint(seq, 16, 32)
+	return c.workers.add(id, object{
+		"id":       id,
+		"cluster":  cluster["id"],
+		"flavor":   pool["flavor"],
+		"location": zone,
+		"poolid":   pool["id"],
+		"poolName": pool["poolName"],
+		"kubeVersion": object{
+			"actual":  version,
+			"desired": version,
+			"target":  target,
+		},
+		"lifecycle": object{"actualState": "deployed", "desiredState": "deployed"},
+		"health":    object{"state": "normal", "message": "Ready"},
+		"networkInterfaces": []object{{
+			"cidr":      "10.240.0.0/24",
+			"ipAddress": fmt.Sprintf("10.240.0.%d", 4+n%250),
+			"primary":   true,
+			"subnetID":  subnetID,
+		}},
+	})
+}
+
+func (c *container) deleteWorker(worker object) {
+	lifecycle := copyObject(worker["lifecycle"].(object))
+	lifecycle["actualState"] = "deleted"
+	lifecycle["desiredState"] = "deleted"
+	c.workers.update(worker["id"].(string), object{"lifecycle": lifecycle})
+}
+
+// updateWorkerCount sets the number of workers of the cluster.
+func (c *container) updateWorkerCount(clusterID string) {
+	count := 0
+	for _, pool := range c.clusterPools(clusterID) {
+		count += pool["workerCount"].(int) * len(pool["zones"].([]interface{}))
+	}
+	c.clusters.update(clusterID, object{"workerCount": count})
+}
+
+// renderPool returns the pool as the API does, with autoscaleEnabled set when the
+// pool is enabled in the configuration of the cluster-autoscaler add-on.
+func (c *container) renderPool(pool object) object {
+	pool = copyObject(pool)
+	clusterID := pool["cluster"].(string)
+	delete(pool, "cluster")
+	if configMap, ok := c.configMaps.get(configMapKey(clusterID, "kube-system", containerAutoscalerConfigMap)); ok {
+		var pools []struct {
+			Name    string `json:"name"`
+			Enabled bool   `json:"enabled"`
+		}
+		data, _ := configMap["data"].(object)
+		config, _ := data[containerAutoscalerPoolsKey].(string)
+		json.Unmarshal([]byte(config), &pools)
+		for _, p := range pools {
+			if p.Name == pool["poolName"] && p.Enabled {
+				pool["autoscaleEnabled"] = true
+			}
+		}
+	}
+	return pool
+}
+
+func (c *container) createWorkerPool(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Cluster string `json:"cluster"`
+		poolConfig
+	}
+	if err := readJSON(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "E0002", "%s", err)
+		return
+	}
+	cluster, ok := c.findCluster(w, body.Cluster)
+	if !ok {
+		return
+	}
+	clusterID := cluster["id"].(string)
+	for _, pool := range c.clusterPools(clusterID) {
+		if pool["poolName"] == body.Name {
+			writeError(w, http.StatusConflict, "E0019", "The worker pool name %s is already in use in the cluster.", body.Name)
+			return
+		}
+	}
+	if body.Name == "" || body.Flavor == "" || len(body.Zones) == 0 {
+		writeError(w, http.StatusBadRequest, "E0002", "The name, flavor and zones of the worker pool are required.")
+		return
+	}
+	pool := c.addPool(clusterID, body.poolConfig)
+	c.updateWorkerCount(clusterID)
+	writeJSON(w, http.StatusCreated, object{"workerPoolID": pool["id"]})
+}
+
+// serveWorkerPool serves the v1 API to resize, label and delete a worker pool.
+func (c *container) serveWorkerPool(w http.ResponseWriter, r *http.Request, clusterNameOrID, poolNameOrID string) {
+	cluster, pool, ok := c.findPool(w, clusterNameOrID, poolNameOrID)
+	if !ok {
+		return
+	}
+	switch r.Method {
+	case http.MethodPatch:
+		var body struct {
+			State       string            `json:"state"`
+			SizePerZone int               `json:"sizePerZone"`
+			Labels      map[string]string `json:"labels"`
+		}
+		if err := readJSON(r, &body); err != nil {
+			writeError(w, http.StatusBadRequest, "E0002", "%s", err)
+			return
+		}
+		switch body.State {
+		case "resizing":
+			if body.SizePerZone < 1 {
+				writeError(w, http.StatusBadRequest, "E0002", "The size per zone must be at least 1.")
+				return
+			}
+			pool, _ = c.pools.update(pool["id"].(string), object{"workerCount": body.SizePerZone})
+			c.resizePool(pool)
+			c.updateWorkerCount(cluster["id"].(string))
+		case "labels":
+			labels := object{}
+			for k, v := range body.Labels {
+				labels[k] = v
+			}
+			c.pools.update(pool["id"].(string), object{"labels": labels})
+		default:
+			writeError(w, http.StatusBadRequest, "E0002", "The state %q is not supported.", body.State)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodDelete:
+		for _, worker := range c.workers.list(func(o object) bool { return o["poolid"] == pool["id"] }) {
+			c.deleteWorker(worker)
+		}
+		c.pools.remove(pool["id"].(string))
+		c.updateWorkerCount(cluster["id"].(string))
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		notFound(w, r)
+	}
+}
+
+// setWorkerPoolTaints replaces the taints of a pool, given as key to value:effect.
+func (c *container) setWorkerPoolTaints(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Cluster    string            `json:"cluster"`
+		Workerpool string            `json:"workerpool"`
+		Taints     map[string]string `json:"taints"`
+	}
+	if err := readJSON(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "E0002", "%s", err)
+		return
+	}
+	_, pool, ok := c.findPool(w, body.Cluster, body.Workerpool)
+	if !ok {
+		return
+	}
+	taints := object{}
+	for key, value := range body.Taints {
+		parts := strings.Split(value, ":")
+		switch {
+		case len(parts) != 2 || key == "":
+			writeError(w, http.StatusBadRequest, "E0002", "The taint %s=%s is not in the format key=value:effect.", key, value)
+			return
+		case parts[1] != "NoSchedule" && parts[1] != "PreferNoSchedule" && parts[1] != "NoExecute":
+			writeError(w, http.StatusBadRequest, "E0002", "The effect %s of the taint %s is not supported.", parts[1], key)
+			return
+		}
+		taints[key] = value
+	}
+	c.pools.update(pool["id"].(string), object{"taints": taints})
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// replaceWorker deletes the worker and adds a worker to its pool and zone, at the
+// target version of the worker when update is set.
+func (c *container) replaceWorker(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Cluster  string `json:"cluster"`
+		Update   bool   `json:"update"`
+		WorkerID string `json:"workerID"`
+	}
+	if err := readJSON(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "E0002", "%s", err)
+		return
+	}
+	worker, ok := c.findWorker(w, body.Cluster, body.WorkerID)
+	if !ok {
+		return
+	}
+	if worker["lifecycle"].(object)["actualState"] == "deleted" {
+		writeError(w, http.StatusConflict, "E0024", "The worker node %s is already deleted.", body.WorkerID)
+		return
+	}
+	cluster, _ := c.clusters.get(worker["cluster"].(string))
+	pool, _ := c.pools.get(worker["poolid"].(string))
+	version := worker["kubeVersion"].(object)["actual"].(string)
+	if body.Update {
+		version = worker["kubeVersion"].(object)["target"].(string)
+	}
+	c.deleteWorker(worker)
+	subnetID := worker["networkInterfaces"].([]object)[0]["subnetID"].(string)
+	c.addWorker(cluster, pool, worker["location"].(string), subnetID, version)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// serveAddons lists and configures the add-ons of a cluster. Enabling the
+// cluster-autoscaler add-on creates its config map, with every pool disabled.
+func (c *container) serveAddons(w http.ResponseWriter, r *http.Request, nameOrID string) {
+	cluster, ok := c.findCluster(w, nameOrID)
+	if !ok {
+		return
+	}
+	id := cluster["id"].(string)
+	switch r.Method {
+	case http.MethodGet:
+		addons := []object{}
+		for _, name := range c.addons[id] {
+			addons = append(addons, object{"name": name, "version": "1.0.3", "healthState": "normal", "healthStatus": "Addon Ready"})
+		}
+		writeJSON(w, http.StatusOK, addons)
+	case http.MethodPatch:
+		var body struct {
+			Addons []struct {
+				Name string `json:"name"`
+			} `json:"addons"`
+			Enable bool `json:"enable"`
+		}
+		if err := readJSON(r, &body); err != nil {
+			writeError(w, http.StatusBadRequest, "E0002", "%s", err)
+			return
+		}
+		for _, addon := range body.Addons {
+			enabled := c.addons[id][:0:0]
+			for _, name := range c.addons[id] {
+				if name != addon.Name {
+					enabled = append(enabled, name)
+				}
+			}
+			if body.Enable {
+				enabled = append(enabled, addon.Name)
+			}
+			c.addons[id] = enabled
+			if addon.Name == containerAutoscalerAddon && body.Enable {
+				c.createAutoscalerConfigMap(id)
+			}
+		}
+		writeJSON(w, http.StatusOK, object{})
+	default:
+		notFound(w, r)
+	}
+}
+
+func (c *container) createAutoscalerConfigMap(clusterID string) {
+	key := configMapKey(clusterID, "kube-system", containerAutoscalerConfigMap)
+	if _, ok := c.configMaps.get(key); ok {
+		return
+	}
+	pools := []object{}
+	for _, pool := range c.clusterPools(clusterID) {
+		pools = append(pools, object{"name": pool["poolName"], "minSize": 1, "maxSize": 2, "enabled": false})
+	}
+	config, _ := json.MarshalIndent(pools, "", " ")
+	c.configMaps.add(key, object{
+		"cluster":         clusterID,
+		"namespace":       "kube-system",
+		"name":            containerAutoscalerConfigMap,
+		"resourceVersion": "1",
+		"data":            object{containerAutoscalerPoolsKey: string(config)},
+	})
+}
+
+// getKubeconfig returns the zip archive of the kubeconfig of the cluster, which
+// authenticates with the IAM token of the request as OIDC ID token.
+func (c *container) getKubeconfig(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Cluster string `json:"cluster"`
+	}
+	if err := readJSON(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "E0002", "%s", err)
+		return
+	}
+	cluster, ok := c.findCluster(w, body.Cluster)
+	if !ok {
+		return
+	}
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	name := cluster["name"].(string)
+	kubeconfig := fmt.Sprintf(`apiVersion: v1
+kind: Config
+current-context: %[1]s/%[2]s
+clusters:
+- name: %[1]s/%[2]s
+  cluster:
+    server: %[3]s
+contexts:
+- name: %[1]s/%[2]s
+  context:
+    cluster: %[1]s/%[2]s
+    user: mockserver
+users:
+- name: mockserver
+  user:
+    auth-provider:
+      name: oidc
+      config:
+        client-id: kube
+        id-token: %[4]s
+        idp-issuer-url: https://iam.cloud.ibm.com/identity
+`, name, cluster["id"], cluster["masterURL"], token)
+	w.Header().Set("Content-Type", "application/zip")
+	w.WriteHeader(http.StatusOK)
+	archive := zip.NewWriter(w)
+	f, _ := archive.Create(fmt.Sprintf("kube-config-%s-%s.yaml", c.server.Region, name))
+	f.Write([]byte(kubeconfig))
+	archive.Close()
+}
+
+func configMapKey(clusterID, namespace, name string) string {
+	return clusterID + "/" + namespace + "/" + name
+}
+
+// serveKube serves the config maps of the Kubernetes API of a cluster, at
+// /kube/<cluster ID>/api/v1/namespaces/<namespace>/configmaps[/<name>]. An update
+// with a stale resourceVersion fails with a conflict, as in Kubernetes.
+func (c *container) serveKube(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(parts) < 7 || parts[2] != "api" || parts[3] != "v1" || parts[4] != "namespaces" || parts[6] != "configmaps" {
+		kubeStatus(w, http.StatusNotFound, "NotFound", "the server could not find the requested resource")
+		return
+	}
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+		kubeStatus(w, http.StatusUnauthorized, "Unauthorized", "Unauthorized")
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clusterID, namespace := parts[1], parts[5]
+	if _, ok := c.clusters.get(clusterID); !ok {
+		kubeStatus(w, http.StatusNotFound, "NotFound", "the server could not find the requested resource")
+		return
+	}
+	var name string
+	if len(parts) == 8 {
+		name = parts[7]
+	}
+	key := configMapKey(clusterID, namespace, name)
+	switch {
+	case name != "" && r.Method == http.MethodGet:
+		configMap, ok := c.configMaps.get(key)
+		if !ok {
+			kubeStatus(w, http.StatusNotFound, "NotFound", "configmaps %q not found", name)
+			return
+		}
+		writeJSON(w, http.StatusOK, renderConfigMap(configMap))
+	case name != "" && r.Method == http.MethodPut:
+		var body struct {
+			Metadata struct {
+				Name            string `json:"name"`
+				ResourceVersion string `json:"resourceVersion"`
+			} `json:"metadata"`
+			Data map[string]string `json:"data"`
+		}
+		if err := readJSON(r, &body); err != nil {
+			kubeStatus(w, http.StatusBadRequest, "BadRequest", "%s", err)
+			return
+		}
+		configMap, ok := c.configMaps.get(key)
+		if !ok {
+			kubeStatus(w, http.StatusNotFound, "NotFound", "configmaps %q not found", name)
+			return
+		}
+		if body.Metadata.ResourceVersion != "" && body.Metadata.ResourceVersion != configMap["resourceVersion"] {
+			kubeStatus(w, http.StatusConflict, "Conflict", "Operation cannot be fulfilled on configmaps %q: the object has been modified; please apply your changes to the latest version and try again", name)
+			return
+		}
+		version, _ := strconv.Atoi(configMap["resourceVersion"].(string))
+		data := object{}
+		for k, v := range body.Data {
+			data[k] = v
+		}
+		configMap, _ = c.configMaps.update(key, object{"data": data, "resourceVersion": strconv.Itoa(version + 1)})
+		writeJSON(w, http.StatusOK, renderConfigMap(configMap))
+	default:
+		kubeStatus(w, http.StatusMethodNotAllowed, "MethodNotAllowed", "the server does not allow this method on the requested resource")
+	}
+}
+
+func renderConfigMap(configMap object) object {
+	return object{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": object{
+			"name":            configMap["name"],
+			"namespace":       configMap["namespace"],
+			"resourceVersion": configMap["resourceVersion"],
+		},
+		"data": configMap["data"],
+	}
+}
+
+// kubeStatus writes an error in the Status format of the Kubernetes API.
+func kubeStatus(w http.ResponseWriter, code int, reason, format string, args ...interface{}) {
+	writeJSON(w, code, object{
+		"kind":       "Status",
+		"apiVersion": "v1",
+		"status":     "Failure",
+		"message":    fmt.Sprintf(format, args...),
+		"reason":     reason,
+		"code":       code,
+	})
+}
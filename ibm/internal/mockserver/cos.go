@@ -0,0 +1,281 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package mockserver
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const s3Namespace = "http://s3.amazonaws.com/doc/2006-03-01/"
+
+// bucket is a cloud object storage bucket, shared by the S3 and the resource
+// configuration stand-ins.
+type bucket struct {
+	name               string
+	locationConstraint string
+	serviceInstanceID  string
+	created            time.Time
+	versioning         string
+	// acl is the canned ACL of the bucket, private when empty.
+	acl string
+	// lifecycle, protection, replication, cors, website and publicAccessBlock
+	// hold the configurations as sent by the client.
+	lifecycle         []byte
+	protection        []byte
+	replication       []byte
+	cors              []byte
+	website           []byte
+	publicAccessBlock []byte
+	// config holds the firewall, activity tracking and metrics monitoring settings.
+	config object
+}
+
+// cos serves the S3 API of cloud object storage for buckets and their lifecycle,
+// protection, versioning, replication, CORS, website, public access and ACL
+// configurations, and the resource configuration API.
+type cos struct {
+	server  *Server
+	mu      sync.Mutex
+	buckets map[string]*bucket
+}
+
+func newCOS(s *Server) *cos {
+	return &cos{server: s, buckets: make(map[string]*bucket)}
+}
+
+func (c *cos) serveS3(w http.ResponseWriter, r *http.Request) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	name := strings.SplitN(strings.Trim(r.URL.Path, "/"), "/", 2)[0]
+	query := r.URL.Query()
+	if name == "" {
+		if r.Method != http.MethodGet {
+			writeS3Error(w, http.StatusMethodNotAllowed, "MethodNotAllowed", "The specified method is not allowed against this resource.", "/")
+			return
+		}
+		c.listBuckets(w)
+		return
+	}
+	b, ok := c.buckets[name]
+	if r.Method == http.MethodPut && len(query) == 0 {
+		if ok {
+			writeS3Error(w, http.StatusConflict, "BucketAlreadyExists", "The requested bucket name is not available.", "/"+name)
+			return
+		}
+		c.createBucket(w, r, name)
+		return
+	}
+	if !ok {
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		writeS3Error(w, http.StatusNotFound, "NoSuchBucket", "The specified bucket does not exist.", "/"+name)
+		return
+	}
+
+	body, _ := ioutil.ReadAll(r.Body)
+	switch {
+	case r.Method == http.MethodHead:
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodDelete && len(query) == 0:
+		delete(c.buckets, name)
+		w.WriteHeader(http.StatusNoContent)
+	case hasQuery(query, "lifecycle"):
+		serveConfiguration(w, r, body, &b.lifecycle, "NoSuchLifecycleConfiguration", "The lifecycle configuration does not exist")
+	case hasQuery(query, "replication"):
+		serveConfiguration(w, r, body, &b.replication, "ReplicationConfigurationNotFoundError", "The replication configuration was not found")
+	case hasQuery(query, "cors"):
+		serveConfiguration(w, r, body, &b.cors, "NoSuchCORSConfiguration", "The CORS configuration does not exist")
+	case hasQuery(query, "website"):
+		serveConfiguration(w, r, body, &b.website, "NoSuchWebsiteConfiguration", "The specified bucket does not have a website configuration")
+	case hasQuery(query, "publicAccessBlock"):
+		serveConfiguration(w, r, body, &b.publicAccessBlock, "NoSuchPublicAccessBlockConfiguration", "The public access block configuration was not found")
+	case hasQuery(query, "acl"):
+		switch r.Method {
+		case http.MethodGet:
+			grants := `<Grant><Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="CanonicalUser"><ID>` + b.serviceInstanceID + `</ID></Grantee><Permission>FULL_CONTROL</Permission></Grant>`
+			if b.acl == "public-read" {
+				grants += `<Grant><Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="Group"><URI>http://acs.amazonaws.com/groups/global/AllUsers</URI></Grantee><Permission>READ</Permission></Grant>`
+			}
+			writeXMLBytes(w, []byte(`<AccessControlPolicy xmlns="`+s3Namespace+`"><Owner><ID>`+b.serviceInstanceID+`</ID></Owner><AccessControlList>`+grants+`</AccessControlList></AccessControlPolicy>`))
+		case http.MethodPut:
+			b.acl = r.Header.Get("x-amz-acl")
+			w.WriteHeader(http.StatusOK)
+		}
+	case hasQuery(query, "protection"):
+		switch r.Method {
+		case http.MethodGet:
+			if b.protection == nil {
+				writeXMLBytes(w, []byte(`<ProtectionConfiguration xmlns="`+s3Namespace+`"><Status>Retention-Disabled</Status></ProtectionConfiguration>`))
+				return
+			}
+			writeXMLBytes(w, b.protection)
+		case http.MethodPut:
+			b.protection = body
+			w.WriteHeader(http.StatusOK)
+		}
+	case hasQuery(query, "versioning"):
+		switch r.Method {
+		case http.MethodGet:
+			status := ""
+			if b.versioning != "" {
+				status = "<Status>" + b.versioning + "</Status>"
+			}
+			writeXMLBytes(w, []byte(`<VersioningConfiguration xmlns="`+s3Namespace+`">`+status+`</VersioningConfiguration>`))
+		case http.MethodPut:
+			versioning := struct {
+				Status string `xml:"Status"`
+			}{}
+			if err := xml.Unmarshal(body, &versioning); err != nil {
+				writeS3Error(w, http.StatusBadRequest, "MalformedXML", err.Error(), "/"+name)
+				return
+			}
+			b.versioning = versioning.Status
+			w.WriteHeader(http.StatusOK)
+		}
+	case r.Method == http.MethodGet && len(query) == 0 || hasQuery(query, "list-type"):
+		writeXMLBytes(w, []byte(`<ListBucketResult xmlns="`+s3Namespace+`"><Name>`+name+`</Name><Prefix></Prefix><Marker></Marker><MaxKeys>1000</MaxKeys><IsTruncated>false</IsTruncated></ListBucketResult>`))
+	default:
+		writeS3Error(w, http.StatusNotImplemented, "NotImplemented", "The request is not served by the stand-in.", r.URL.RequestURI())
+	}
+}
+
+// serveConfiguration serves the GET, PUT and DELETE of a bucket configuration
+// stored in config, answering code when it is not set.
+func serveConfiguration(w http.ResponseWriter, r *http.Request, body []byte, config *[]byte, code, message string) {
+	switch r.Method {
+	case http.MethodGet:
+		if *config == nil {
+			writeS3Error(w, http.StatusNotFound, code, message, r.URL.Path)
+			return
+		}
+		writeXMLBytes(w, *config)
+	case http.MethodPut:
+		*config = body
+		w.WriteHeader(http.StatusOK)
+	case http.MethodDelete:
+		*config = nil
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (c *cos) createBucket(w http.ResponseWriter, r *http.Request, name string) {
+	configuration := struct {
+		LocationConstraint string `xml:"LocationConstraint"`
+	}{}
+	body, _ := ioutil.ReadAll(r.Body)
+	if len(body) > 0 {
+		if err := xml.Unmarshal(body, &configuration); err != nil {
+			writeS3Error(w, http.StatusBadRequest, "MalformedXML", err.Error(), "/"+name)
+			return
+		}
+	}
+	if configuration.LocationConstraint == "" {
+		configuration.LocationConstraint = c.server.Region + "-standard"
+	}
+	c.buckets[name] = &bucket{
+		name:               name,
+		locationConstraint: configuration.LocationConstraint,
+		serviceInstanceID:  r.Header.Get("ibm-service-instance-id"),
+		created:            time.Now().UTC(),
+		config:             object{},
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (c *cos) listBuckets(w http.ResponseWriter) {
+	var buckets strings.Builder
+	for _, b := range c.buckets {
+		fmt.Fprintf(&buckets, "<Bucket><Name>%s</Name><CreationDate>%s</CreationDate><LocationConstraint>%s</LocationConstraint></Bucket>",
+			b.name, b.created.Format("2006-01-02T15:04:05.000Z"), b.locationConstraint)
+	}
+	writeXMLBytes(w, []byte(`<ListAllMyBucketsResult xmlns="`+s3Namespace+`"><Owner><ID>`+c.server.Account+`</ID><DisplayName>`+c.server.Account+
+		`</DisplayName></Owner><IsTruncated>false</IsTruncated><Buckets>`+buckets.String()+`</Buckets></ListAllMyBucketsResult>`))
+}
+
+// serveConfig serves the resource configuration API reading and patching the
+// firewall, activity tracking and metrics monitoring of a bucket.
+func (c *cos) serveConfig(w http.ResponseWriter, r *http.Request) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	name := strings.TrimPrefix(r.URL.Path, basePaths[ServiceCOSConfig]+"/b/")
+	if name == r.URL.Path || strings.Contains(name, "/") {
+		notFound(w, r)
+		return
+	}
+	b, ok := c.buckets[name]
+	if !ok {
+		writeError(w, http.StatusNotFound, "not_found", "The specified bucket %s does not exist", name)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		instance := b.serviceInstanceID
+		if parts := strings.Split(instance, ":"); len(parts) > 7 {
+			instance = parts[7]
+		}
+		config := object{
+			"name":                 b.name,
+			"crn":                  fmt.Sprintf("crn:v1:bluemix:public:cloud-object-storage:global:a/%s:%s:bucket:%s", c.server.Account, instance, b.name),
+			"service_instance_id":  instance,
+			"service_instance_crn": b.serviceInstanceID,
+			"time_created":         b.created.Format(time.RFC3339),
+			"time_updated":         b.created.Format(time.RFC3339),
+			"object_count":         0,
+			"bytes_used":           0,
+		}
+		for k, v := range b.config {
+			config[k] = v
+		}
+		writeJSON(w, http.StatusOK, config)
+	case http.MethodPatch:
+		patch := object{}
+		if err := readJSON(r, &patch); err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+			return
+		}
+		for k, v := range patch {
+			switch k {
+			case "firewall", "activity_tracking", "metrics_monitoring", "hard_quota":
+				if v == nil {
+					delete(b.config, k)
+				} else {
+					b.config[k] = v
+				}
+			default:
+				writeError(w, http.StatusBadRequest, "bad_request", "Unsupported bucket configuration %q", k)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		notFound(w, r)
+	}
+}
+
+func hasQuery(query map[string][]string, key string) bool {
+	_, ok := query[key]
+	return ok
+}
+
+func writeXMLBytes(w http.ResponseWriter, body []byte) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(xml.Header))
+	w.Write(body)
+}
+
+func writeS3Error(w http.ResponseWriter, status int, code, message, resource string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, "%s<Error><Code>%s</Code><Message>%s</Message><Resource>%s</Resource><RequestId>mockserver</RequestId><httpStatusCode>%d</httpStatusCode></Error>",
+		xml.Header, code, message, resource, status)
+}
@@ -0,0 +1,742 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package mockserver
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// The routes the stand-in networks of the virtual connections of a Direct Link
+// gateway advertise, by virtual connection type, and the routes the gateways learn
+// from the on-premises network. The VPC prefix overlaps the second on-premises one.
+var (
+	dlVirtualConnectionRoutes = map[string][]string{
+		"classic": {"10.0.0.0/8"},
+		"vpc":     {"10.240.0.0/18"},
+	}
+	dlOnPremRoutes = []string{"172.16.0.0/16", "10.240.0.0/24"}
+)
+
+// dlIBMBGPASN is the ASN of the IBM end of the BGP sessions of the gateways.
+const dlIBMBGPASN = 13884
+
+var dlCakName = regexp.MustCompile(`^([a-fA-F0-9]{2}){1,32}$`)
+
+// directLink serves the Direct Link API, the gateways with their virtual connections,
+// MACsec CAKs, route reports and the actions approving or rejecting the changes
+// requested by a provider, and the Direct Link Provider API the providers create,
+// update and delete the gateways of their customers with. Like the live API, a
+// gateway created or changed by a provider has a change request until the customer
+// approves or rejects it, updating a CAK rotates it, the rotation completing when
+// the CAK is read again, or failing when the new key is named mock-failed, and a
+// route report is pending when it is created and complete when it is read again.
+type directLink struct {
+	server             *Server
+	gateways           *collection
+	virtualConnections *collection
+	caks               *collection
+	reports            *collection
+}
+
+func newDirectLink(s *Server) *directLink {
+	return &directLink{
+		server:             s,
+		gateways:           newCollection(),
+		virtualConnections: newCollection(),
+		caks:               newCollection(),
+		reports:            newCollection(),
+	}
+}
+
+// serveCustomer serves the Direct Link API, at /v1/gateways.
+func (l *directLink) serveCustomer(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(parts) < 2 || parts[0] != "v1" || parts[1] != "gateways" {
+		notFound(w, r)
+		return
+	}
+	path := parts[2:]
+	if len(path) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			views := []object{}
+			for _, gateway := range l.gateways.list(nil) {
+				views = append(views, l.gatewayView(gateway))
+			}
+			writeJSON(w, http.StatusOK, object{"gateways": views})
+		case http.MethodPost:
+			l.createGateway(w, r)
+		default:
+			notFound(w, r)
+		}
+		return
+	}
+	gateway, ok := l.gateways.get(path[0])
+	if !ok {
+		writeError(w, http.StatusNotFound, "not_found", "Gateway %s not found", path[0])
+		return
+	}
+	switch {
+	case len(path) == 1:
+		l.serveGateway(w, r, gateway)
+	case len(path) == 2 && path[1] == "actions" && r.Method == http.MethodPost:
+		l.gatewayAction(w, r, gateway)
+	case path[1] == "virtual_connections":
+		l.serveVirtualConnections(w, r, gateway, path[2:])
+	case len(path) >= 3 && path[1] == "macsec" && path[2] == "caks":
+		l.serveCaks(w, r, gateway, path[3:])
+	case path[1] == "route_reports":
+		l.serveReports(w, r, gateway, path[2:])
+	default:
+		notFound(w, r)
+	}
+}
+
+// serveProvider serves the Direct Link Provider API, at /provider/v2/gateways.
+func (l *directLink) serveProvider(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(parts) < 3 || parts[0] != "provider" || parts[1] != "v2" || parts[2] != "gateways" {
+		notFound(w, r)
+		return
+	}
+	path := parts[3:]
+	if len(path) == 0 {
+		if r.Method != http.MethodPost {
+			notFound(w, r)
+			return
+		}
+		l.createProviderGateway(w, r)
+		return
+	}
+	gateway, ok := l.gateways.get(path[0])
+	if !ok || len(path) != 1 || gateway["provider_api_managed"] != true {
+		writeError(w, http.StatusNotFound, "not_found", "Gateway %s not found", path[0])
+		return
+	}
+	id := gateway["id"].(string)
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, l.gatewayView(gateway))
+	case http.MethodPatch:
+		body := object{}
+		if err := readJSON(r, &body); err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+			return
+		}
+		patch := object{}
+		if name, ok := body["name"]; ok {
+			patch["name"] = name
+		}
+		if speed, ok := body["speed_mbps"]; ok {
+			// The speed of a provisioned gateway changes once the customer approves it
+			if gateway["operational_status"] == "provisioned" {
+				if _, pending := gateway["change_request"]; pending {
+					writeError(w, http.StatusConflict, "conflict", "Gateway %s has a pending change request", id)
+					return
+				}
+				patch["change_request"] = object{"type": "update_attributes", "updates": []object{{"speed_mbps": speed}}}
+			} else {
+				patch["speed_mbps"] = speed
+			}
+		}
+		gateway, _ = l.gateways.update(id, patch)
+		writeJSON(w, http.StatusOK, l.gatewayView(gateway))
+	case http.MethodDelete:
+		if gateway["operational_status"] != "provisioned" {
+			l.deleteGateway(id)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		// The deletion of a provisioned gateway is approved by the customer
+		gateway, _ = l.gateways.update(id, object{"change_request": object{"type": "delete_gateway"}})
+		writeJSON(w, http.StatusAccepted, l.gatewayView(gateway))
+	default:
+		notFound(w, r)
+	}
+}
+
+func (l *directLink) newGateway(body object) (object, error) {
+	name, _ := body["name"].(string)
+	speed, _ := body["speed_mbps"].(float64)
+	if name == "" || speed == 0 {
+		return nil, fmt.Errorf("name and speed_mbps are required")
+	}
+	id := l.server.newID()[5:]
+	now := timestamp()
+	bgpASN := 64999.0
+	if v, ok := body["bgp_asn"].(float64); ok {
+		bgpASN = v
+	}
+	return object{
+		"id":                    id,
+		"crn":                   l.server.crn("directlink", "global", "dedicated", id),
+		"name":                  name,
+		"speed_mbps":            speed,
+		"bgp_asn":               bgpASN,
+		"bgp_ibm_asn":           dlIBMBGPASN,
+		"bgp_cer_cidr":          "169.254.0.2/30",
+		"bgp_ibm_cidr":          "169.254.0.1/30",
+		"bgp_status":            "active",
+		"link_status":           "up",
+		"global":                body["global"] == true,
+		"metered":               body["metered"] == true,
+		"location_name":         "dal10",
+		"location_display_name": "Dallas 10",
+		"operational_status":    "provisioned",
+		"created_at":            now,
+	}, nil
+}
+
+func (l *directLink) createGateway(w http.ResponseWriter, r *http.Request) {
+	body := object{}
+	if err := readJSON(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+		return
+	}
+	gateway, err := l.newGateway(body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+		return
+	}
+	gatewayType, _ := body["type"].(string)
+	switch gatewayType {
+	case "dedicated":
+		if location, _ := body["location_name"].(string); location != "" {
+			gateway["location_name"] = location
+		}
+		gateway["cross_connect_router"] = body["cross_connect_router"]
+	case "connect":
+		if _, ok := body["macsec_config"]; ok {
+			writeError(w, http.StatusBadRequest, "bad_request", "MACsec is only supported by dedicated gateways")
+			return
+		}
+		port, _ := body["port"].(map[string]interface{})
+		gateway["port"] = object{"id": port["id"]}
+	default:
+		writeError(w, http.StatusBadRequest, "bad_request", "type must be dedicated or connect")
+		return
+	}
+	gateway["type"] = gatewayType
+	if rg, ok := body["resource_group"].(map[string]interface{}); ok {
+		gateway["resource_group"] = object{"id": rg["id"]}
+	}
+	id := gateway["id"].(string)
+	if macsec, ok := body["macsec_config"].(map[string]interface{}); ok {
+		primary, _ := macsec["primary_cak"].(map[string]interface{})
+		if crn, _ := primary["crn"].(string); crn == "" {
+			writeError(w, http.StatusBadRequest, "bad_request", "macsec_config.primary_cak is required")
+			return
+		}
+		gateway["macsec_config"] = object{"active": macsec["active"] == true, "window_size": macsec["window_size"]}
+		l.addCak(id, "primary", primary["crn"].(string))
+		if fallback, ok := macsec["fallback_cak"].(map[string]interface{}); ok {
+			l.addCak(id, "fallback", fallback["crn"].(string))
+		}
+	}
+	writeJSON(w, http.StatusCreated, l.gatewayView(l.gateways.add(id, gateway)))
+}
+
+func (l *directLink) createProviderGateway(w http.ResponseWriter, r *http.Request) {
+	body := object{}
+	if err := readJSON(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+		return
+	}
+	account, _ := body["customer_account_id"].(string)
+	port, _ := body["port"].(map[string]interface{})
+	if account == "" || port["id"] == nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "customer_account_id and port are required")
+		return
+	}
+	gateway, err := l.newGateway(body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+		return
+	}
+	gateway["type"] = "connect"
+	gateway["port"] = object{"id": port["id"]}
+	gateway["customer_account_id"] = account
+	gateway["provider_api_managed"] = true
+	gateway["operational_status"] = "create_pending"
+	gateway["change_request"] = object{"type": "create_gateway"}
+	writeJSON(w, http.StatusCreated, l.gatewayView(l.gateways.add(gateway["id"].(string), gateway)))
+}
+
+// gatewayView returns the gateway with the keys of its CAKs in its MACsec config.
+func (l *directLink) gatewayView(gateway object) object {
+	view := copyObject(gateway)
+	macsec, ok := gateway["macsec_config"].(object)
+	if !ok {
+		return view
+	}
+	config := copyObject(macsec)
+	config["status"] = "secured"
+	for _, cak := range l.gatewayCaks(gateway["id"].(string)) {
+		key := object{"crn": cak["key"].(object)["crn"]}
+		if cak["session"] == "primary" {
+			config["primary_cak"] = key
+			config["active_cak"] = object{"crn": key["crn"], "status": "success"}
+		} else {
+			config["fallback_cak"] = key
+		}
+	}
+	view["macsec_config"] = config
+	return view
+}
+
+func (l *directLink) serveGateway(w http.ResponseWriter, r *http.Request, gateway object) {
+	id := gateway["id"].(string)
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, l.gatewayView(gateway))
+	case http.MethodPatch:
+		body := object{}
+		if err := readJSON(r, &body); err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+			return
+		}
+		patch := object{}
+		for _, k := range []string{"name", "speed_mbps", "global", "metered"} {
+			if v, ok := body[k]; ok {
+				patch[k] = v
+			}
+		}
+		if macsec, ok := body["macsec_config"].(map[string]interface{}); ok {
+			config, ok := gateway["macsec_config"].(object)
+			if !ok {
+				writeError(w, http.StatusBadRequest, "bad_request", "MACsec is not enabled on gateway %s", id)
+				return
+			}
+			config = copyObject(config)
+			for _, k := range []string{"active", "window_size"} {
+				if v, ok := macsec[k]; ok {
+					config[k] = v
+				}
+			}
+			patch["macsec_config"] = config
+			l.setCakKeys(id, macsec)
+		}
+		gateway, _ = l.gateways.update(id, patch)
+		writeJSON(w, http.StatusOK, l.gatewayView(gateway))
+	case http.MethodDelete:
+		if len(l.gatewayVirtualConnections(id)) > 0 {
+			writeError(w, http.StatusConflict, "conflict", "Gateway %s has virtual connections", id)
+			return
+		}
+		l.deleteGateway(id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		notFound(w, r)
+	}
+}
+
+func (l *directLink) deleteGateway(id string) {
+	for _, cak := range l.gatewayCaks(id) {
+		l.caks.remove(cak["id"].(string))
+	}
+	l.gateways.remove(id)
+}
+
+// gatewayAction approves or rejects the change requested by the provider of the
+// gateway. The updates of the update_attributes actions must be the pending ones.
+func (l *directLink) gatewayAction(w http.ResponseWriter, r *http.Request, gateway object) {
+	body := object{}
+	if err := readJSON(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+		return
+	}
+	id := gateway["id"].(string)
+	action, _ := body["action"].(string)
+	request, _ := gateway["change_request"].(object)
+	requestType := strings.TrimSuffix(strings.TrimSuffix(action, "_approve"), "_reject")
+	if request == nil || request["type"] != requestType {
+		writeError(w, http.StatusConflict, "conflict", "Gateway %s has no pending %s change request", id, requestType)
+		return
+	}
+	patch := object{"change_request": nil}
+	switch action {
+	case "create_gateway_approve":
+		global, globalOK := body["global"].(bool)
+		metered, meteredOK := body["metered"].(bool)
+		if !globalOK || !meteredOK {
+			writeError(w, http.StatusBadRequest, "bad_request", "global and metered are required to approve the creation of a gateway")
+			return
+		}
+		patch["global"] = global
+		patch["metered"] = metered
+		patch["operational_status"] = "provisioned"
+		patch["resource_group"] = object{"id": "mock-resource-group"}
+		if rg, ok := body["resource_group"].(map[string]interface{}); ok {
+			patch["resource_group"] = object{"id": rg["id"]}
+		}
+	case "create_gateway_reject":
+		patch["operational_status"] = "create_rejected"
+	case "delete_gateway_approve":
+		gateway["operational_status"] = "deleting"
+		delete(gateway, "change_request")
+		l.deleteGateway(id)
+		writeJSON(w, http.StatusOK, l.gatewayView(gateway))
+		return
+	case "delete_gateway_reject":
+	case "update_attributes_approve", "update_attributes_reject":
+		updates, _ := body["updates"].([]interface{})
+		pending := []interface{}{}
+		for _, update := range request["updates"].([]object) {
+			pending = append(pending, map[string]interface{}(update))
+		}
+		if !reflect.DeepEqual(updates, pending) {
+			writeError(w, http.StatusBadRequest, "bad_request", "updates must match the pending updates of gateway %s", id)
+			return
+		}
+		if action == "update_attributes_approve" {
+			for _, update := range request["updates"].([]object) {
+				for k, v := range update {
+					patch[k] = v
+				}
+			}
+		}
+	default:
+		writeError(w, http.StatusBadRequest, "bad_request", "action %q is not supported", action)
+		return
+	}
+	gateway, _ = l.gateways.update(id, patch)
+	writeJSON(w, http.StatusOK, l.gatewayView(gateway))
+}
+
+func (l *directLink) gatewayVirtualConnections(gatewayID string) []object {
+	return l.virtualConnections.list(func(o object) bool { return o["gateway_id"] == gatewayID })
+}
+
+func (l *directLink) serveVirtualConnections(w http.ResponseWriter, r *http.Request, gateway object, path []string) {
+	gatewayID := gateway["id"].(string)
+	if len(path) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, object{"virtual_connections": l.gatewayVirtualConnections(gatewayID)})
+		case http.MethodPost:
+			body := object{}
+			if err := readJSON(r, &body); err != nil {
+				writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+				return
+			}
+			vcType, _ := body["type"].(string)
+			if _, ok := dlVirtualConnectionRoutes[vcType]; !ok {
+				writeError(w, http.StatusBadRequest, "bad_request", "type must be classic or vpc")
+				return
+			}
+			networkID, _ := body["network_id"].(string)
+			if vcType == "vpc" && networkID == "" {
+				writeError(w, http.StatusBadRequest, "bad_request", "network_id is required for type vpc")
+				return
+			}
+			id := l.server.newID()[5:]
+			vc := object{
+				"id":         id,
+				"gateway_id": gatewayID,
+				"name":       body["name"],
+				"type":       vcType,
+				"status":     "attached",
+				"created_at": timestamp(),
+			}
+			if networkID != "" {
+				vc["network_id"] = networkID
+			}
+			writeJSON(w, http.StatusCreated, l.virtualConnections.add(id, vc))
+		default:
+			notFound(w, r)
+		}
+		return
+	}
+	vc, ok := l.virtualConnections.get(path[0])
+	if !ok || len(path) != 1 || vc["gateway_id"] != gatewayID {
+		writeError(w, http.StatusNotFound, "not_found", "Virtual connection %s not found", path[0])
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, vc)
+	case http.MethodDelete:
+		l.virtualConnections.remove(path[0])
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		notFound(w, r)
+	}
+}
+
+func (l *directLink) gatewayCaks(gatewayID string) []object {
+	return l.caks.list(func(o object) bool { return o["gateway_id"] == gatewayID })
+}
+
+func (l *directLink) gatewayCak(gatewayID, session string) (object, bool) {
+	for _, cak := range l.gatewayCaks(gatewayID) {
+		if cak["session"] == session {
+			return cak, true
+		}
+	}
+	return nil, false
+}
+
+// addCak adds the CAK of the session of a gateway, named after its ID the way the
+// API names the CAKs of the MACsec config a gateway is created with.
+func (l *directLink) addCak(gatewayID, session, crn string) object {
+	id := l.server.newID()[5:]
+	now := timestamp()
+	status := "operational"
+	if session == "fallback" {
+		status = "inactive"
+	}
+	return l.caks.add(id, object{
+		"id":         id,
+		"gateway_id": gatewayID,
+		"name":       strings.Replace(id, "-", "", -1),
+		"key":        object{"crn": crn},
+		"session":    session,
+		"status":     status,
+		"created_at": now,
+		"updated_at": now,
+	})
+}
+
+// setCakKeys sets the keys of the primary_cak and fallback_cak of a gateway MACsec
+// config patch on the CAKs of the gateway. An empty fallback_cak removes the
+// fallback CAK.
+func (l *directLink) setCakKeys(gatewayID string, macsec map[string]interface{}) {
+	for _, session := range []string{"primary", "fallback"} {
+		key, ok := macsec[session+"_cak"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		crn, _ := key["crn"].(string)
+		cak, exists := l.gatewayCak(gatewayID, session)
+		switch {
+		case exists && crn == "":
+			l.caks.remove(cak["id"].(string))
+		case exists:
+			l.caks.update(cak["id"].(string), object{"key": object{"crn": crn}, "updated_at": timestamp()})
+		case crn != "":
+			l.addCak(gatewayID, session, crn)
+		}
+	}
+}
+
+func cakView(cak object) object {
+	view := copyObject(cak)
+	delete(view, "gateway_id")
+	return view
+}
+
+func (l *directLink) serveCaks(w http.ResponseWriter, r *http.Request, gateway object, path []string) {
+	gatewayID := gateway["id"].(string)
+	if _, ok := gateway["macsec_config"]; !ok {
+		writeError(w, http.StatusBadRequest, "bad_request", "MACsec is not enabled on gateway %s", gatewayID)
+		return
+	}
+	if len(path) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			views := []object{}
+			for _, cak := range l.gatewayCaks(gatewayID) {
+				views = append(views, cakView(cak))
+			}
+			writeJSON(w, http.StatusOK, object{"caks": views})
+		case http.MethodPost:
+			body := object{}
+			if err := readJSON(r, &body); err != nil {
+				writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+				return
+			}
+			name, _ := body["name"].(string)
+			session, _ := body["session"].(string)
+			key, _ := body["key"].(map[string]interface{})
+			crn, _ := key["crn"].(string)
+			switch {
+			case !dlCakName.MatchString(name):
+				writeError(w, http.StatusBadRequest, "bad_request", "name must be an even number of 2 to 64 hexadecimal characters")
+				return
+			case session != "primary" && session != "fallback":
+				writeError(w, http.StatusBadRequest, "bad_request", "session must be primary or fallback")
+				return
+			case crn == "":
+				writeError(w, http.StatusBadRequest, "bad_request", "key.crn is required")
+				return
+			}
+			if _, exists := l.gatewayCak(gatewayID, session); exists {
+				writeError(w, http.StatusConflict, "conflict", "Gateway %s already has a %s CAK", gatewayID, session)
+				return
+			}
+			cak := l.addCak(gatewayID, session, crn)
+			cak, _ = l.caks.update(cak["id"].(string), object{"name": name})
+			writeJSON(w, http.StatusCreated, cakView(cak))
+		default:
+			notFound(w, r)
+		}
+		return
+	}
+	cak, ok := l.caks.get(path[0])
+	if !ok || len(path) != 1 || cak["gateway_id"] != gatewayID {
+		writeError(w, http.StatusNotFound, "not_found", "CAK %s not found", path[0])
+		return
+	}
+	id := cak["id"].(string)
+	switch r.Method {
+	case http.MethodGet:
+		// The rotation completes once the CAK is read again
+		if delta, ok := cak["active_delta"].(object); ok && strings.HasSuffix(fmt.Sprint(delta["key"].(object)["crn"]), ":mock-failed") {
+			delta = copyObject(delta)
+			delta["status"] = "failed"
+			cak, _ = l.caks.update(id, object{"status": "failed", "active_delta": delta, "updated_at": timestamp()})
+		} else if ok {
+			status := "operational"
+			if cak["session"] == "fallback" {
+				status = "inactive"
+			}
+			cak, _ = l.caks.update(id, object{
+				"name":         delta["name"],
+				"key":          delta["key"],
+				"status":       status,
+				"active_delta": nil,
+				"updated_at":   timestamp(),
+			})
+		}
+		writeJSON(w, http.StatusOK, cakView(cak))
+	case http.MethodPatch:
+		body := object{}
+		if err := readJSON(r, &body); err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+			return
+		}
+		if cak["status"] == "rotating" {
+			writeError(w, http.StatusConflict, "conflict", "CAK %s is being rotated", id)
+			return
+		}
+		delta := object{"name": cak["name"], "key": cak["key"], "status": "rotating"}
+		if name, ok := body["name"].(string); ok {
+			if !dlCakName.MatchString(name) {
+				writeError(w, http.StatusBadRequest, "bad_request", "name must be an even number of 2 to 64 hexadecimal characters")
+				return
+			}
+			delta["name"] = name
+		}
+		if key, ok := body["key"].(map[string]interface{}); ok {
+			delta["key"] = object{"crn": key["crn"]}
+		}
+		if !reflect.DeepEqual(delta["name"], cak["name"]) || !reflect.DeepEqual(delta["key"], cak["key"]) {
+			cak, _ = l.caks.update(id, object{"status": "rotating", "active_delta": delta, "updated_at": timestamp()})
+		}
+		writeJSON(w, http.StatusOK, cakView(cak))
+	case http.MethodDelete:
+		if cak["session"] == "primary" {
+			writeError(w, http.StatusConflict, "conflict", "The primary CAK of gateway %s can't be deleted while MACsec is enabled", gatewayID)
+			return
+		}
+		l.caks.remove(id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		notFound(w, r)
+	}
+}
+
+func (l *directLink) serveReports(w http.ResponseWriter, r *http.Request, gateway object, path []string) {
+	gatewayID := gateway["id"].(string)
+	if len(path) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			views := []object{}
+			for _, report := range l.reports.list(func(o object) bool { return o["gateway_id"] == gatewayID }) {
+				views = append(views, dlReportView(report))
+			}
+			writeJSON(w, http.StatusOK, object{"route_reports": views})
+		case http.MethodPost:
+			id := l.server.newID()[5:]
+			now := timestamp()
+			report := object{
+				"id":         id,
+				"gateway_id": gatewayID,
+				"status":     "pending",
+				"created_at": now,
+				"updated_at": now,
+			}
+			writeJSON(w, http.StatusAccepted, dlReportView(l.reports.add(id, report)))
+		default:
+			notFound(w, r)
+		}
+		return
+	}
+	report, ok := l.reports.get(path[0])
+	if !ok || len(path) != 1 || report["gateway_id"] != gatewayID {
+		writeError(w, http.StatusNotFound, "not_found", "Route report %s not found", path[0])
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		if report["status"] == "pending" {
+			l.generateReport(gateway, report)
+			report = l.reports.add(path[0], report)
+		}
+		writeJSON(w, http.StatusOK, dlReportView(report))
+	case http.MethodDelete:
+		l.reports.remove(path[0])
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		notFound(w, r)
+	}
+}
+
+func dlReportView(report object) object {
+	view := copyObject(report)
+	delete(view, "gateway_id")
+	return view
+}
+
+// generateReport completes the report with the route of the BGP session of the
+// gateway, the on-premises routes it learns, the routes of its virtual
+// connections, which it advertises, and the on-premises routes overlapping
+// the routes of the virtual connections.
+func (l *directLink) generateReport(gateway object, report object) {
+	_, bgp, _ := net.ParseCIDR(gateway["bgp_ibm_cidr"].(string))
+	nextHop, _, _ := net.ParseCIDR(gateway["bgp_cer_cidr"].(string))
+	onPrem := []object{}
+	for _, prefix := range dlOnPremRoutes {
+		onPrem = append(onPrem, object{"prefix": prefix, "next_hop": nextHop.String(), "as_path": fmt.Sprint(gateway["bgp_asn"])})
+	}
+
+	advertised := []object{}
+	vcRoutes := []object{}
+	overlapping := []object{}
+	for _, vc := range l.gatewayVirtualConnections(gateway["id"].(string)) {
+		routes := []object{}
+		for _, prefix := range dlVirtualConnectionRoutes[vc["type"].(string)] {
+			routes = append(routes, object{"prefix": prefix, "active": true, "local_preference": "200"})
+			advertised = append(advertised, object{"prefix": prefix, "as_path": fmt.Sprint(dlIBMBGPASN)})
+			_, network, _ := net.ParseCIDR(prefix)
+			for _, onPremPrefix := range dlOnPremRoutes {
+				_, onPremNetwork, _ := net.ParseCIDR(onPremPrefix)
+				if network.Contains(onPremNetwork.IP) || onPremNetwork.Contains(network.IP) {
+					overlapping = append(overlapping, object{"routes": []object{
+						{"prefix": onPremPrefix, "type": "on_prem"},
+						{"prefix": prefix, "type": "virtual_connection", "virtual_connection_id": vc["id"]},
+					}})
+				}
+			}
+		}
+		vcRoutes = append(vcRoutes, object{
+			"virtual_connection_id":   vc["id"],
+			"virtual_connection_name": vc["name"],
+			"virtual_connection_type": vc["type"],
+			"routes":                  routes,
+		})
+	}
+	report["status"] = "complete"
+	report["gateway_routes"] = []object{{"prefix": bgp.String()}}
+	report["on_prem_routes"] = onPrem
+	report["advertised_routes"] = advertised
+	report["virtual_connection_routes"] = vcRoutes
+	report["overlapping_routes"] = overlapping
+	report["updated_at"] = timestamp()
+}
@@ -0,0 +1,391 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package mockserver
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// dnsSvcs serves the custom resolvers of DNS Services and their locations and
+// forwarding rules. Like the live API, a custom resolver is created with the default
+// forwarding rule, it can only be enabled with an enabled location and must be
+// disabled before it is deleted, and an enabled location can't be deleted.
+type dnsSvcs struct {
+	server    *Server
+	resolvers *collection
+	rules     *collection
+	seq       int
+}
+
+func newDNSSvcs(s *Server) *dnsSvcs {
+	return &dnsSvcs{server: s, resolvers: newCollection(), rules: newCollection()}
+}
+
+func (d *dnsSvcs) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(parts) < 3 || parts[0] != "v1" || parts[1] != "instances" {
+		notFound(w, r)
+		return
+	}
+	instanceID := parts[2]
+	if len(parts) < 4 || parts[3] != "custom_resolvers" {
+		notFound(w, r)
+		return
+	}
+	path := parts[4:]
+	if len(path) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			resolvers := d.resolvers.list(func(o object) bool { return o["instance_id"] == instanceID })
+			writeJSON(w, http.StatusOK, object{"custom_resolvers": d.views(resolvers)})
+		case http.MethodPost:
+			d.createResolver(w, r, instanceID)
+		default:
+			notFound(w, r)
+		}
+		return
+	}
+	resolver, ok := d.resolvers.get(path[0])
+	if !ok || resolver["instance_id"] != instanceID {
+		writeError(w, http.StatusNotFound, "not_found", "Custom resolver %s not found", path[0])
+		return
+	}
+	switch {
+	case len(path) == 1:
+		d.serveResolver(w, r, resolver)
+	case path[1] == "locations":
+		d.serveLocations(w, r, resolver, path[2:])
+	case path[1] == "forwarding_rules":
+		d.serveRules(w, r, resolver, path[2:])
+	default:
+		notFound(w, r)
+	}
+}
+
+func (d *dnsSvcs) createResolver(w http.ResponseWriter, r *http.Request, instanceID string) {
+	body := object{}
+	if err := readJSON(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+		return
+	}
+	name, _ := body["name"].(string)
+	if name == "" {
+		writeError(w, http.StatusBadRequest, "bad_request", "name is required")
+		return
+	}
+	id := d.server.newID()[5:]
+	now := timestamp()
+	resolver := object{
+		"id":          id,
+		"instance_id": instanceID,
+		"name":        name,
+		"description": body["description"],
+		"enabled":     false,
+		"locations":   []object{},
+		"created_on":  now,
+		"modified_on": now,
+	}
+	if resolver["description"] == nil {
+		resolver["description"] = ""
+	}
+	locations, _ := body["locations"].([]interface{})
+	for _, l := range locations {
+		location, err := d.location(resolver, l.(map[string]interface{}))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+			return
+		}
+		resolver["locations"] = append(resolver["locations"].([]object), location)
+	}
+	d.resolvers.add(id, resolver)
+	ruleID := d.server.newID()[5:]
+	d.rules.add(ruleID, object{
+		"id":          ruleID,
+		"resolver_id": id,
+		"type":        "default",
+		"match":       ".",
+		"forward_to":  []interface{}{},
+		"description": "",
+		"created_on":  now,
+		"modified_on": now,
+	})
+	writeJSON(w, http.StatusOK, d.view(resolver))
+}
+
+// location returns a new location of the resolver in the subnet of the body.
+func (d *dnsSvcs) location(resolver object, body map[string]interface{}) (object, error) {
+	subnet, _ := body["subnet_crn"].(string)
+	if !strings.HasPrefix(subnet, "crn:") {
+		return nil, fmt.Errorf("subnet_crn %q is not a subnet CRN", subnet)
+	}
+	for _, l := range resolver["locations"].([]object) {
+		if l["subnet_crn"] == subnet {
+			return nil, fmt.Errorf("the custom resolver already has a location in subnet %s", subnet)
+		}
+	}
+	enabled := true
+	if v, ok := body["enabled"].(bool); ok {
+		enabled = v
+	}
+	d.server.mu.Lock()
+	d.seq++
+	ip := net.IPv4(10, 10, byte(d.seq>>8), byte(d.seq)).String()
+	d.server.mu.Unlock()
+	return object{
+		"id":            d.server.newID()[5:],
+		"subnet_crn":    subnet,
+		"enabled":       enabled,
+		"healthy":       enabled,
+		"dns_server_ip": ip,
+	}, nil
+}
+
+// view returns the resolver as the API returns it, with its health computed from the
+// number of its enabled locations.
+func (d *dnsSvcs) view(resolver object) object {
+	view := object{}
+	for k, v := range resolver {
+		if k != "instance_id" {
+			view[k] = v
+		}
+	}
+	switch enabled := d.enabledLocations(resolver); {
+	case enabled == 0:
+		view["health"] = "CRITICAL"
+	case enabled == 1:
+		view["health"] = "DEGRADED"
+	default:
+		view["health"] = "HEALTHY"
+	}
+	return view
+}
+
+func (d *dnsSvcs) views(resolvers []object) []object {
+	views := []object{}
+	for _, resolver := range resolvers {
+		views = append(views, d.view(resolver))
+	}
+	return views
+}
+
+func (d *dnsSvcs) enabledLocations(resolver object) int {
+	enabled := 0
+	for _, l := range resolver["locations"].([]object) {
+		if l["enabled"] == true {
+			enabled++
+		}
+	}
+	return enabled
+}
+
+func (d *dnsSvcs) serveResolver(w http.ResponseWriter, r *http.Request, resolver object) {
+	id := resolver["id"].(string)
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, d.view(resolver))
+	case http.MethodPatch:
+		body := object{}
+		if err := readJSON(r, &body); err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+			return
+		}
+		if enabled, ok := body["enabled"].(bool); ok {
+			if enabled && d.enabledLocations(resolver) == 0 {
+				writeError(w, http.StatusBadRequest, "bad_request", "Custom resolver %s has no enabled location", id)
+				return
+			}
+			resolver["enabled"] = enabled
+		}
+		for _, k := range []string{"name", "description"} {
+			if v, ok := body[k].(string); ok {
+				resolver[k] = v
+			}
+		}
+		resolver["modified_on"] = timestamp()
+		writeJSON(w, http.StatusOK, d.view(d.resolvers.add(id, resolver)))
+	case http.MethodDelete:
+		if resolver["enabled"] == true {
+			writeError(w, http.StatusBadRequest, "bad_request", "Custom resolver %s must be disabled before it is deleted", id)
+			return
+		}
+		for _, rule := range d.resolverRules(resolver) {
+			d.rules.remove(rule["id"].(string))
+		}
+		d.resolvers.remove(id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		notFound(w, r)
+	}
+}
+
+func (d *dnsSvcs) serveLocations(w http.ResponseWriter, r *http.Request, resolver object, path []string) {
+	id := resolver["id"].(string)
+	locations := resolver["locations"].([]object)
+	if len(path) == 0 {
+		if r.Method != http.MethodPost {
+			notFound(w, r)
+			return
+		}
+		body := object{}
+		if err := readJSON(r, &body); err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+			return
+		}
+		location, err := d.location(resolver, body)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+			return
+		}
+		resolver["locations"] = append(locations, location)
+		d.resolvers.add(id, resolver)
+		writeJSON(w, http.StatusOK, location)
+		return
+	}
+	index := -1
+	for i, l := range locations {
+		if l["id"] == path[0] {
+			index = i
+		}
+	}
+	if len(path) != 1 || index < 0 {
+		writeError(w, http.StatusNotFound, "not_found", "Location %s not found", path[0])
+		return
+	}
+	location := locations[index]
+	switch r.Method {
+	case http.MethodPatch:
+		body := object{}
+		if err := readJSON(r, &body); err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+			return
+		}
+		if enabled, ok := body["enabled"].(bool); ok {
+			if !enabled && location["enabled"] == true && resolver["enabled"] == true && d.enabledLocations(resolver) == 1 {
+				writeError(w, http.StatusBadRequest, "bad_request", "The last enabled location of the enabled custom resolver %s can't be disabled", id)
+				return
+			}
+			location["enabled"] = enabled
+			location["healthy"] = enabled
+		}
+		d.resolvers.add(id, resolver)
+		writeJSON(w, http.StatusOK, location)
+	case http.MethodDelete:
+		if location["enabled"] == true {
+			writeError(w, http.StatusBadRequest, "bad_request", "Location %s must be disabled before it is deleted", path[0])
+			return
+		}
+		resolver["locations"] = append(locations[:index:index], locations[index+1:]...)
+		d.resolvers.add(id, resolver)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		notFound(w, r)
+	}
+}
+
+func (d *dnsSvcs) resolverRules(resolver object) []object {
+	return d.rules.list(func(o object) bool { return o["resolver_id"] == resolver["id"] })
+}
+
+func (d *dnsSvcs) serveRules(w http.ResponseWriter, r *http.Request, resolver object, path []string) {
+	if len(path) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, object{"forwarding_rules": d.resolverRules(resolver)})
+		case http.MethodPost:
+			body := object{}
+			if err := readJSON(r, &body); err != nil {
+				writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+				return
+			}
+			if body["type"] != "zone" {
+				writeError(w, http.StatusBadRequest, "bad_request", "Only the zone forwarding rules can be created")
+				return
+			}
+			id := d.server.newID()[5:]
+			now := timestamp()
+			rule := object{"id": id, "resolver_id": resolver["id"], "type": "zone", "created_on": now, "modified_on": now}
+			if err := d.setRule(resolver, rule, body); err != nil {
+				writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+				return
+			}
+			writeJSON(w, http.StatusOK, d.rules.add(id, rule))
+		default:
+			notFound(w, r)
+		}
+		return
+	}
+	rule, ok := d.rules.get(path[0])
+	if len(path) != 1 || !ok || rule["resolver_id"] != resolver["id"] {
+		writeError(w, http.StatusNotFound, "not_found", "Forwarding rule %s not found", path[0])
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, rule)
+	case http.MethodPatch:
+		body := object{}
+		if err := readJSON(r, &body); err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+			return
+		}
+		if _, ok := body["match"]; ok && rule["type"] == "default" {
+			writeError(w, http.StatusBadRequest, "bad_request", "The match of the default forwarding rule can't be changed")
+			return
+		}
+		if err := d.setRule(resolver, rule, body); err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+			return
+		}
+		rule["modified_on"] = timestamp()
+		writeJSON(w, http.StatusOK, d.rules.add(path[0], rule))
+	case http.MethodDelete:
+		if rule["type"] == "default" {
+			writeError(w, http.StatusBadRequest, "bad_request", "The default forwarding rule can't be deleted")
+			return
+		}
+		d.rules.remove(path[0])
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		notFound(w, r)
+	}
+}
+
+// setRule sets the fields of the body on the forwarding rule, checking its match is
+// unique to the resolver and it forwards to IP addresses.
+func (d *dnsSvcs) setRule(resolver object, rule object, body object) error {
+	if match, ok := body["match"].(string); ok {
+		match = strings.ToLower(strings.TrimSuffix(match, "."))
+		if match == "" {
+			return fmt.Errorf("match is required")
+		}
+		for _, other := range d.resolverRules(resolver) {
+			if other["id"] != rule["id"] && other["match"] == match {
+				return fmt.Errorf("a forwarding rule of the custom resolver already matches %s", match)
+			}
+		}
+		rule["match"] = match
+	} else if rule["match"] == nil {
+		return fmt.Errorf("match is required")
+	}
+	if forwardTo, ok := body["forward_to"].([]interface{}); ok {
+		for _, ip := range forwardTo {
+			if s, _ := ip.(string); net.ParseIP(s) == nil {
+				return fmt.Errorf("forward_to %v is not an IP address", ip)
+			}
+		}
+		rule["forward_to"] = forwardTo
+	}
+	if forwardTo, _ := rule["forward_to"].([]interface{}); rule["type"] == "zone" && len(forwardTo) == 0 {
+		return fmt.Errorf("forward_to is required")
+	}
+	if description, ok := body["description"].(string); ok {
+		rule["description"] = description
+	} else if rule["description"] == nil {
+		rule["description"] = ""
+	}
+	return nil
+}
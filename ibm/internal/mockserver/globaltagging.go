@@ -0,0 +1,151 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package mockserver
+
+import (
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// globalTagging serves the global tagging API. Tags are attached to any resource ID
+// without checking the resource exists, and are kept separately for each tag type.
+type globalTagging struct {
+	mu sync.Mutex
+	// attached holds the tags attached to every resource for every tag type.
+	attached map[string]map[string][]string
+}
+
+func newGlobalTagging() *globalTagging {
+	return &globalTagging{attached: make(map[string]map[string][]string)}
+}
+
+func (g *globalTagging) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	tagType := r.URL.Query().Get("tag_type")
+	if tagType == "" {
+		tagType = "user"
+	}
+	switch {
+	case r.URL.Path == "/v3/tags" && r.Method == http.MethodGet:
+		g.list(w, r.URL.Query().Get("attached_to"), tagType)
+	case r.URL.Path == "/v3/tags/attach" && r.Method == http.MethodPost:
+		g.update(w, r, tagType, true)
+	case r.URL.Path == "/v3/tags/detach" && r.Method == http.MethodPost:
+		g.update(w, r, tagType, false)
+	case strings.HasPrefix(r.URL.Path, "/v3/tags/") && r.Method == http.MethodDelete:
+		name, _ := url.PathUnescape(strings.TrimPrefix(r.URL.Path, "/v3/tags/"))
+		g.delete(w, name, tagType)
+	default:
+		notFound(w, r)
+	}
+}
+
+func (g *globalTagging) list(w http.ResponseWriter, resourceID, tagType string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	var names []string
+	if resourceID != "" {
+		names = g.attached[tagType][resourceID]
+	} else {
+		seen := map[string]bool{}
+		for _, tags := range g.attached[tagType] {
+			for _, name := range tags {
+				if !seen[name] {
+					seen[name] = true
+					names = append(names, name)
+				}
+			}
+		}
+		sort.Strings(names)
+	}
+	items := make([]object, 0, len(names))
+	for _, name := range names {
+		items = append(items, object{"name": name})
+	}
+	writeJSON(w, http.StatusOK, object{
+		"total_count": len(items),
+		"offset":      0,
+		"limit":       100,
+		"items":       items,
+	})
+}
+
+func (g *globalTagging) update(w http.ResponseWriter, r *http.Request, tagType string, attach bool) {
+	body := struct {
+		Resources []struct {
+			ResourceID string `json:"resource_id"`
+		} `json:"resources"`
+		TagName  string   `json:"tag_name"`
+		TagNames []string `json:"tag_names"`
+	}{}
+	if err := readJSON(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+		return
+	}
+	names := body.TagNames
+	if body.TagName != "" {
+		names = append(names, body.TagName)
+	}
+	if len(body.Resources) == 0 || len(names) == 0 {
+		writeError(w, http.StatusBadRequest, "bad_request", "resources and tag names are required")
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.attached[tagType] == nil {
+		g.attached[tagType] = make(map[string][]string)
+	}
+	results := make([]object, 0, len(body.Resources))
+	for _, resource := range body.Resources {
+		tags := g.attached[tagType][resource.ResourceID]
+		for _, name := range names {
+			name = strings.TrimSpace(name)
+			if attach {
+				tags = appendTag(tags, name)
+			} else {
+				tags = removeTag(tags, name)
+			}
+		}
+		g.attached[tagType][resource.ResourceID] = tags
+		results = append(results, object{"resource_id": resource.ResourceID, "is_error": false})
+	}
+	writeJSON(w, http.StatusOK, object{"results": results})
+}
+
+func (g *globalTagging) delete(w http.ResponseWriter, name, tagType string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for _, tags := range g.attached[tagType] {
+		for _, tag := range tags {
+			if strings.EqualFold(tag, name) {
+				writeJSON(w, http.StatusOK, object{"results": []object{{"provider": "ghost", "is_error": true}}})
+				return
+			}
+		}
+	}
+	writeJSON(w, http.StatusOK, object{"results": []object{{"provider": "ghost", "is_error": false}}})
+}
+
+// appendTag adds the tag unless already there, tags are case insensitive.
+func appendTag(tags []string, name string) []string {
+	for _, tag := range tags {
+		if strings.EqualFold(tag, name) {
+			return tags
+		}
+	}
+	return append(tags, name)
+}
+
+func removeTag(tags []string, name string) []string {
+	kept := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if !strings.EqualFold(tag, name) {
+			kept = append(kept, tag)
+		}
+	}
+	return kept
+}
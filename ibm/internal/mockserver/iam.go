@@ -0,0 +1,87 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package mockserver
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+)
+
+// iam serves the IAM token endpoint for the API key and refresh token grants, and the
+// trusted profiles of the IAM Identity API served by the same host.
+type iam struct {
+	server   *Server
+	identity *iamIdentity
+}
+
+func newIAM(s *Server) *iam {
+	return &iam{server: s, identity: newIAMIdentity(s)}
+}
+
+// isIAMToken reports whether the request of the service asks for an IAM token. The
+// tokens are never recorded nor replayed, they are always issued by the stand-in.
+func isIAMToken(service string, r *http.Request) bool {
+	return service == ServiceIAM && r.URL.Path == "/identity/token"
+}
+
+// Token returns an access token for the account of the server, signed with a key
+// only known to the stand-in.
+func (s *Server) Token() string {
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iam_id":  "IBMid-mockserver",
+		"id":      "IBMid-mockserver",
+		"sub":     "mockserver@ibm.com",
+		"email":   "mockserver@ibm.com",
+		"account": map[string]interface{}{"bss": s.Account, "valid": true},
+		"iss":     "https://iam.cloud.ibm.com/identity",
+		"iat":     now.Unix(),
+		"exp":     now.Add(time.Hour).Unix(),
+	})
+	signed, err := token.SignedString([]byte("mockserver"))
+	if err != nil {
+		panic(err)
+	}
+	return signed
+}
+
+func (i *iam) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if strings.HasPrefix(r.URL.Path, "/v1/profiles") {
+		i.identity.ServeHTTP(w, r)
+		return
+	}
+	if r.Method != http.MethodPost || r.URL.Path != "/identity/token" {
+		notFound(w, r)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeIAMError(w, "BXNIM0109E", "Invalid form: "+err.Error())
+		return
+	}
+	switch grant := r.PostForm.Get("grant_type"); grant {
+	case "urn:ibm:params:oauth:grant-type:apikey", "refresh_token":
+	default:
+		writeIAMError(w, "BXNIM0104E", "Unsupported grant type "+grant)
+		return
+	}
+	now := time.Now()
+	writeJSON(w, http.StatusOK, object{
+		"access_token":  i.server.Token(),
+		"refresh_token": "mockserver-refresh-token",
+		"token_type":    "Bearer",
+		"expires_in":    3600,
+		"expiration":    now.Add(time.Hour).Unix(),
+		"scope":         "ibm openid",
+	})
+}
+
+func writeIAMError(w http.ResponseWriter, code, message string) {
+	writeJSON(w, http.StatusBadRequest, object{
+		"errorCode":    code,
+		"errorMessage": message,
+	})
+}
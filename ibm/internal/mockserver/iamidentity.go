@@ -0,0 +1,295 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package mockserver
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// iamIdentity serves the trusted profiles of the IAM Identity API along with their
+// claim rules and links. The updates require the entity tag of the resource in the
+// If-Match header, as the live API does.
+type iamIdentity struct {
+	server   *Server
+	profiles *collection
+	rules    *collection
+	links    *collection
+}
+
+func newIAMIdentity(s *Server) *iamIdentity {
+	return &iamIdentity{server: s, profiles: newCollection(), rules: newCollection(), links: newCollection()}
+}
+
+func (i *iamIdentity) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/v1/profiles"), "/"), "/")
+	switch {
+	case parts[0] == "" && r.Method == http.MethodPost:
+		i.createProfile(w, r)
+	case len(parts) == 1 && parts[0] != "":
+		i.serveProfile(w, r, parts[0])
+	case len(parts) >= 2 && (parts[1] == "rules" || parts[1] == "links"):
+		profile, ok := i.profiles.get(parts[0])
+		if !ok {
+			writeError(w, http.StatusNotFound, "not_found", "Trusted profile %s not found", parts[0])
+			return
+		}
+		if parts[1] == "rules" {
+			i.serveRules(w, r, profile, parts[2:])
+		} else {
+			i.serveLinks(w, r, profile, parts[2:])
+		}
+	default:
+		notFound(w, r)
+	}
+}
+
+// entityTag returns a new version of a resource.
+func (i *iamIdentity) entityTag() string {
+	return "1-" + strings.Replace(i.server.newID(), "-", "", -1)
+}
+
+// checkIfMatch writes a conflict and returns false when the If-Match header of the
+// request is not the entity tag of the resource.
+func checkIfMatch(w http.ResponseWriter, r *http.Request, o object) bool {
+	ifMatch := r.Header.Get("If-Match")
+	if ifMatch == "" {
+		writeError(w, http.StatusBadRequest, "bad_request", "The If-Match header is required")
+		return false
+	}
+	if ifMatch != "*" && ifMatch != o["entity_tag"] {
+		writeError(w, http.StatusConflict, "conflict", "The entity tag %s does not match the version %s of %s", ifMatch, o["entity_tag"], o["id"])
+		return false
+	}
+	return true
+}
+
+func (i *iamIdentity) createProfile(w http.ResponseWriter, r *http.Request) {
+	body := object{}
+	if err := readJSON(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+		return
+	}
+	name, _ := body["name"].(string)
+	accountID, _ := body["account_id"].(string)
+	if name == "" || accountID == "" {
+		writeError(w, http.StatusBadRequest, "bad_request", "name and account_id are required")
+		return
+	}
+	for _, profile := range i.profiles.list(nil) {
+		if profile["name"] == name && profile["account_id"] == accountID {
+			writeError(w, http.StatusConflict, "conflict", "A trusted profile with the name %s already exists", name)
+			return
+		}
+	}
+	id := "Profile-" + i.server.newID()[5:]
+	now := timestamp()
+	profile := i.profiles.add(id, object{
+		"id":             id,
+		"entity_tag":     i.entityTag(),
+		"crn":            fmt.Sprintf("crn:v1:bluemix:public:iam-identity::a/%s::profile:%s", accountID, id),
+		"name":           name,
+		"description":    body["description"],
+		"account_id":     accountID,
+		"iam_id":         "iam-" + id,
+		"ims_account_id": 0,
+		"ims_user_id":    0,
+		"created_at":     now,
+		"modified_at":    now,
+	})
+	writeJSON(w, http.StatusCreated, profile)
+}
+
+func (i *iamIdentity) serveProfile(w http.ResponseWriter, r *http.Request, id string) {
+	profile, ok := i.profiles.get(id)
+	if !ok {
+		writeError(w, http.StatusNotFound, "not_found", "Trusted profile %s not found", id)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, profile)
+	case http.MethodPut:
+		if !checkIfMatch(w, r, profile) {
+			return
+		}
+		body := object{}
+		if err := readJSON(r, &body); err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+			return
+		}
+		patch := object{"entity_tag": i.entityTag(), "modified_at": timestamp()}
+		for _, key := range []string{"name", "description"} {
+			if value, ok := body[key]; ok {
+				patch[key] = value
+			}
+		}
+		profile, _ = i.profiles.update(id, patch)
+		writeJSON(w, http.StatusOK, profile)
+	case http.MethodDelete:
+		for _, c := range []*collection{i.rules, i.links} {
+			for _, o := range c.list(func(o object) bool { return o["profile_id"] == id }) {
+				c.remove(o["id"].(string))
+			}
+		}
+		i.profiles.remove(id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		notFound(w, r)
+	}
+}
+
+// validateClaimRule checks the fields of a claim rule required by its type.
+func validateClaimRule(body object) error {
+	conditions, _ := body["conditions"].([]interface{})
+	if len(conditions) == 0 {
+		return fmt.Errorf("conditions are required")
+	}
+	switch body["type"] {
+	case "Profile-SAML":
+		if realm, _ := body["realm_name"].(string); realm == "" {
+			return fmt.Errorf("realm_name is required for the Profile-SAML rules")
+		}
+	case "Profile-CR":
+		if !validComputeResourceType(body["cr_type"]) {
+			return fmt.Errorf("cr_type %v is not a valid compute resource type", body["cr_type"])
+		}
+	default:
+		return fmt.Errorf("type %v is not a valid claim rule type", body["type"])
+	}
+	return nil
+}
+
+func validComputeResourceType(crType interface{}) bool {
+	return crType == "VSI" || crType == "IKS_SA" || crType == "ROKS_SA"
+}
+
+func (i *iamIdentity) serveRules(w http.ResponseWriter, r *http.Request, profile object, path []string) {
+	profileID := profile["id"].(string)
+	switch {
+	case len(path) == 0 && r.Method == http.MethodPost:
+		body := object{}
+		if err := readJSON(r, &body); err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+			return
+		}
+		if err := validateClaimRule(body); err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+			return
+		}
+		id := "ClaimRule-" + i.server.newID()[5:]
+		now := timestamp()
+		rule := copyObject(body)
+		rule["id"] = id
+		rule["profile_id"] = profileID
+		rule["entity_tag"] = i.entityTag()
+		rule["created_at"] = now
+		rule["modified_at"] = now
+		writeJSON(w, http.StatusCreated, i.rules.add(id, rule))
+	case len(path) == 0 && r.Method == http.MethodGet:
+		rules := i.rules.list(func(o object) bool { return o["profile_id"] == profileID })
+		writeJSON(w, http.StatusOK, object{"rules": rules})
+	case len(path) == 1:
+		rule, ok := i.rules.get(path[0])
+		if !ok || rule["profile_id"] != profileID {
+			writeError(w, http.StatusNotFound, "not_found", "Claim rule %s of trusted profile %s not found", path[0], profileID)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, rule)
+		case http.MethodPut:
+			if !checkIfMatch(w, r, rule) {
+				return
+			}
+			body := object{}
+			if err := readJSON(r, &body); err != nil {
+				writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+				return
+			}
+			if err := validateClaimRule(body); err != nil {
+				writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+				return
+			}
+			patch := object{"name": nil, "realm_name": nil, "cr_type": nil, "expiration": nil}
+			for k, v := range body {
+				patch[k] = v
+			}
+			patch["entity_tag"] = i.entityTag()
+			patch["modified_at"] = timestamp()
+			rule, _ = i.rules.update(path[0], patch)
+			writeJSON(w, http.StatusOK, rule)
+		case http.MethodDelete:
+			i.rules.remove(path[0])
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			notFound(w, r)
+		}
+	default:
+		notFound(w, r)
+	}
+}
+
+func (i *iamIdentity) serveLinks(w http.ResponseWriter, r *http.Request, profile object, path []string) {
+	profileID := profile["id"].(string)
+	switch {
+	case len(path) == 0 && r.Method == http.MethodPost:
+		body := object{}
+		if err := readJSON(r, &body); err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+			return
+		}
+		link, _ := body["link"].(map[string]interface{})
+		crn, _ := link["crn"].(string)
+		if !validComputeResourceType(body["cr_type"]) || crn == "" {
+			writeError(w, http.StatusBadRequest, "bad_request", "cr_type and link.crn are required")
+			return
+		}
+		if namespace, _ := link["namespace"].(string); body["cr_type"] != "VSI" && namespace == "" {
+			writeError(w, http.StatusBadRequest, "bad_request", "link.namespace is required for the %s links", body["cr_type"])
+			return
+		}
+		if body["cr_type"] != "VSI" && link["name"] == nil {
+			link["name"] = "default"
+		}
+		id := "Link-" + i.server.newID()[5:]
+		now := timestamp()
+		name := body["name"]
+		if name == nil {
+			name = id
+		}
+		stored := i.links.add(id, object{
+			"id":          id,
+			"profile_id":  profileID,
+			"entity_tag":  i.entityTag(),
+			"name":        name,
+			"cr_type":     body["cr_type"],
+			"link":        link,
+			"created_at":  now,
+			"modified_at": now,
+		})
+		writeJSON(w, http.StatusCreated, stored)
+	case len(path) == 0 && r.Method == http.MethodGet:
+		links := i.links.list(func(o object) bool { return o["profile_id"] == profileID })
+		writeJSON(w, http.StatusOK, object{"links": links})
+	case len(path) == 1:
+		link, ok := i.links.get(path[0])
+		if !ok || link["profile_id"] != profileID {
+			writeError(w, http.StatusNotFound, "not_found", "Link %s of trusted profile %s not found", path[0], profileID)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, link)
+		case http.MethodDelete:
+			i.links.remove(path[0])
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			notFound(w, r)
+		}
+	default:
+		notFound(w, r)
+	}
+}
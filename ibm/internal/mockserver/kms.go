@@ -0,0 +1,388 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package mockserver
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	kmsKeyType         = "application/vnd.ibm.kms.key+json"
+	kmsPolicyType      = "application/vnd.ibm.kms.policy+json"
+	kmsKmipAdapterType = "application/vnd.ibm.kms.kmip_adapter+json"
+	kmsKmipCertType    = "application/vnd.ibm.kms.kmip_client_certificate+json"
+	kmsErrorType       = "application/vnd.ibm.kms.error+json"
+)
+
+// kmsPolicyTypes are the instance policies served by the stand-in.
+var kmsPolicyTypes = []string{"allowedIP", "allowedNetwork", "dualAuthDelete", "keyCreateImportAccess", "metrics"}
+
+// kms serves the Key Protect API for the keys, the instance policies and the KMIP
+// adapters of the instances, the instance being selected by the bluemix-instance
+// header of the requests. Only root and standard keys created by the stand-in are
+// known, their payload is never returned.
+type kms struct {
+	server       *Server
+	keys         *collection
+	policies     *collection
+	adapters     *collection
+	certificates *collection
+}
+
+func newKMS(s *Server) *kms {
+	return &kms{server: s, keys: newCollection(), policies: newCollection(), adapters: newCollection(), certificates: newCollection()}
+}
+
+func (k *kms) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	const prefix = "/api/v2/"
+	instance := r.Header.Get("bluemix-instance")
+	if !strings.HasPrefix(r.URL.Path, prefix) {
+		notFound(w, r)
+		return
+	}
+	if instance == "" {
+		writeKMSError(w, http.StatusBadRequest, "BAD_HEADER_ERR", "The bluemix-instance header is required")
+		return
+	}
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, prefix), "/"), "/")
+	switch {
+	case parts[0] == "keys" && len(parts) == 1 && r.Method == http.MethodPost:
+		k.createKey(w, r, instance)
+	case parts[0] == "keys" && len(parts) >= 2:
+		k.serveKey(w, r, instance, parts[1], parts[2:])
+	case parts[0] == "instance" && len(parts) == 2 && parts[1] == "policies":
+		k.servePolicies(w, r, instance)
+	case parts[0] == "kmip_adapters" && len(parts) == 1 && r.Method == http.MethodPost:
+		k.createAdapter(w, r, instance)
+	case parts[0] == "kmip_adapters" && len(parts) == 1 && r.Method == http.MethodGet:
+		adapters := k.adapters.list(func(o object) bool { return o["instance"] == instance })
+		writeJSON(w, http.StatusOK, kmsCollection(kmsKmipAdapterType, kmsResources(adapters)...))
+	case parts[0] == "kmip_adapters" && len(parts) == 2:
+		k.serveAdapter(w, r, instance, parts[1])
+	case parts[0] == "kmip_adapters" && len(parts) >= 3 && parts[2] == "certificates":
+		k.serveCertificates(w, r, instance, parts[1], parts[3:])
+	default:
+		notFound(w, r)
+	}
+}
+
+// kmsCollection returns the collection format of the requests and responses of the API.
+func kmsCollection(collectionType string, resources ...object) object {
+	if resources == nil {
+		resources = []object{}
+	}
+	return object{
+		"metadata":  object{"collectionType": collectionType, "collectionTotal": len(resources)},
+		"resources": resources,
+	}
+}
+
+// kmsResources returns the objects without the instance they are kept for.
+func kmsResources(objects []object) []object {
+	resources := make([]object, 0, len(objects))
+	for _, o := range objects {
+		o = copyObject(o)
+		delete(o, "instance")
+		resources = append(resources, o)
+	}
+	return resources
+}
+
+// writeKMSError writes an error in the format of the Key Protect API, which holds the
+// message and the reasons in a collection.
+func writeKMSError(w http.ResponseWriter, status int, code, format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	writeJSON(w, status, kmsCollection(kmsErrorType, object{
+		"errorMsg": fmt.Sprintf("%s: %s", http.StatusText(status), message),
+		"reasons":  []object{{"code": code, "message": message, "status": status}},
+	}))
+}
+
+// readKMSResource returns the single resource of a request collection.
+func readKMSResource(r *http.Request) (object, error) {
+	body := struct {
+		Resources []object `json:"resources"`
+	}{}
+	if err := readJSON(r, &body); err != nil {
+		return nil, err
+	}
+	if len(body.Resources) != 1 {
+		return nil, fmt.Errorf("resources must hold a single resource")
+	}
+	return body.Resources[0], nil
+}
+
+func (k *kms) createKey(w http.ResponseWriter, r *http.Request, instance string) {
+	resource, err := readKMSResource(r)
+	if err != nil {
+		writeKMSError(w, http.StatusBadRequest, "BAD_BODY_ERR", "%s", err)
+		return
+	}
+	name, _ := resource["name"].(string)
+	if name == "" {
+		writeKMSError(w, http.StatusBadRequest, "BAD_BODY_ERR", "name is required")
+		return
+	}
+	id := k.server.newID()[5:]
+	now := timestamp()
+	extractable, _ := resource["extractable"].(bool)
+	key := k.keys.add(id, object{
+		"instance":       instance,
+		"id":             id,
+		"type":           kmsKeyType,
+		"name":           name,
+		"description":    resource["description"],
+		"extractable":    extractable,
+		"state":          1,
+		"algorithmType":  "AES",
+		"crn":            fmt.Sprintf("crn:v1:bluemix:public:kms:%s:a/%s:%s:key:%s", k.server.Region, k.server.Account, instance, id),
+		"createdBy":      "IBMid-mockserver",
+		"creationDate":   now,
+		"lastUpdateDate": now,
+		"keyVersion":     object{"id": id, "creationDate": now},
+		"keyRingID":      "default",
+		"deleted":        false,
+	})
+	writeJSON(w, http.StatusCreated, kmsCollection(kmsKeyType, kmsResources([]object{key})...))
+}
+
+func (k *kms) serveKey(w http.ResponseWriter, r *http.Request, instance, id string, path []string) {
+	key, ok := k.keys.get(id)
+	if !ok || key["instance"] != instance {
+		writeKMSError(w, http.StatusNotFound, "KEY_NOT_FOUND_ERR", "Key %s does not exist", id)
+		return
+	}
+	switch {
+	case len(path) == 0 && r.Method == http.MethodGet,
+		len(path) == 1 && path[0] == "metadata" && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, kmsCollection(kmsKeyType, kmsResources([]object{key})...))
+	case len(path) == 0 && r.Method == http.MethodPost:
+		k.keyAction(w, r, key)
+	case len(path) == 0 && r.Method == http.MethodDelete:
+		k.keys.remove(id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		notFound(w, r)
+	}
+}
+
+// keyAction performs the rotate action on a root key, which creates a new version.
+func (k *kms) keyAction(w http.ResponseWriter, r *http.Request, key object) {
+	if action := r.URL.Query().Get("action"); action != "rotate" {
+		writeKMSError(w, http.StatusBadRequest, "BAD_ACTION_ERR", "Action %s is not supported", action)
+		return
+	}
+	if key["extractable"] == true {
+		writeKMSError(w, http.StatusBadRequest, "KEY_ACTION_INVALID_ERR", "Standard key %s cannot be rotated", key["id"])
+		return
+	}
+	now := timestamp()
+	k.keys.update(key["id"].(string), object{
+		"lastRotateDate": now,
+		"lastUpdateDate": now,
+		"keyVersion":     object{"id": k.server.newID()[5:], "creationDate": now},
+	})
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// servePolicies serves the instance policies, all of them or the one of the policy
+// query parameter. The policies which were never set are not returned.
+func (k *kms) servePolicies(w http.ResponseWriter, r *http.Request, instance string) {
+	policyType := r.URL.Query().Get("policy")
+	switch r.Method {
+	case http.MethodGet:
+		policies := k.policies.list(func(o object) bool {
+			return o["instance"] == instance && (policyType == "" || o["policy_type"] == policyType)
+		})
+		writeJSON(w, http.StatusOK, kmsCollection(kmsPolicyType, kmsResources(policies)...))
+	case http.MethodPut:
+		body := struct {
+			Resources []object `json:"resources"`
+		}{}
+		if err := readJSON(r, &body); err != nil || len(body.Resources) == 0 {
+			writeKMSError(w, http.StatusBadRequest, "BAD_BODY_ERR", "resources must hold the policies to set")
+			return
+		}
+		for _, policy := range body.Resources {
+			if err := validateKMSPolicy(policy, policyType); err != nil {
+				writeKMSError(w, http.StatusBadRequest, "BAD_BODY_ERR", "%s", err)
+				return
+			}
+		}
+		now := timestamp()
+		for _, policy := range body.Resources {
+			policy = copyObject(policy)
+			policy["instance"] = instance
+			policy["creationDate"] = now
+			policy["lastUpdated"] = now
+			policy["updatedBy"] = "IBMid-mockserver"
+			k.policies.add(instance+"/"+policy["policy_type"].(string), policy)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		notFound(w, r)
+	}
+}
+
+func validateKMSPolicy(policy object, policyType string) error {
+	name, _ := policy["policy_type"].(string)
+	if policyType != "" && name != policyType {
+		return fmt.Errorf("policy_type %s does not match the policy query parameter %s", name, policyType)
+	}
+	known := false
+	for _, t := range kmsPolicyTypes {
+		known = known || t == name
+	}
+	if !known {
+		return fmt.Errorf("policy_type %q is not supported", name)
+	}
+	data, _ := policy["policy_data"].(map[string]interface{})
+	if _, ok := data["enabled"].(bool); !ok {
+		return fmt.Errorf("policy_data.enabled of the %s policy is required", name)
+	}
+	if name == "allowedNetwork" && data["enabled"] == true {
+		attributes, _ := data["attributes"].(map[string]interface{})
+		network, _ := attributes["allowed_network"].(string)
+		if network != "public-and-private" && network != "private-only" {
+			return fmt.Errorf("allowed_network %q of the allowedNetwork policy must be public-and-private or private-only", network)
+		}
+	}
+	return nil
+}
+
+func (k *kms) createAdapter(w http.ResponseWriter, r *http.Request, instance string) {
+	resource, err := readKMSResource(r)
+	if err != nil {
+		writeKMSError(w, http.StatusBadRequest, "BAD_BODY_ERR", "%s", err)
+		return
+	}
+	if profile := resource["profile"]; profile != "native_1.0" {
+		writeKMSError(w, http.StatusBadRequest, "BAD_BODY_ERR", "profile %v is not supported", profile)
+		return
+	}
+	profileData, _ := resource["profile_data"].(map[string]interface{})
+	crkID, _ := profileData["crk_id"].(string)
+	if key, ok := k.keys.get(crkID); !ok || key["instance"] != instance || key["extractable"] == true {
+		writeKMSError(w, http.StatusBadRequest, "KEY_NOT_FOUND_ERR", "profile_data.crk_id %q is not a root key of the instance", crkID)
+		return
+	}
+	id := k.server.newID()[5:]
+	name, _ := resource["name"].(string)
+	if name == "" {
+		name = "kmip_adapter-" + id
+	}
+	for _, adapter := range k.adapters.list(func(o object) bool { return o["instance"] == instance }) {
+		if adapter["name"] == name {
+			writeKMSError(w, http.StatusConflict, "KMIP_ADAPTER_DUPLICATE_NAME_ERR", "A KMIP adapter with the name %s already exists", name)
+			return
+		}
+	}
+	now := timestamp()
+	adapter := k.adapters.add(id, object{
+		"instance":     instance,
+		"id":           id,
+		"name":         name,
+		"description":  resource["description"],
+		"profile":      "native_1.0",
+		"profile_data": object{"crk_id": crkID},
+		"created_by":   "IBMid-mockserver",
+		"created_at":   now,
+		"updated_by":   "IBMid-mockserver",
+		"updated_at":   now,
+	})
+	writeJSON(w, http.StatusCreated, kmsCollection(kmsKmipAdapterType, kmsResources([]object{adapter})...))
+}
+
+// getAdapter returns the adapter of the instance by ID or name.
+func (k *kms) getAdapter(instance, idOrName string) (object, bool) {
+	adapters := k.adapters.list(func(o object) bool {
+		return o["instance"] == instance && (o["id"] == idOrName || o["name"] == idOrName)
+	})
+	if len(adapters) == 0 {
+		return nil, false
+	}
+	return adapters[0], true
+}
+
+// serveAdapter serves a KMIP adapter, its deletion deletes its client certificates.
+func (k *kms) serveAdapter(w http.ResponseWriter, r *http.Request, instance, idOrName string) {
+	adapter, ok := k.getAdapter(instance, idOrName)
+	if !ok {
+		writeKMSError(w, http.StatusNotFound, "KMIP_ADAPTER_NOT_FOUND_ERR", "KMIP adapter %s does not exist", idOrName)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, kmsCollection(kmsKmipAdapterType, kmsResources([]object{adapter})...))
+	case http.MethodDelete:
+		for _, cert := range k.certificates.list(func(o object) bool { return o["adapter_id"] == adapter["id"] }) {
+			k.certificates.remove(cert["id"].(string))
+		}
+		k.adapters.remove(adapter["id"].(string))
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		notFound(w, r)
+	}
+}
+
+func (k *kms) serveCertificates(w http.ResponseWriter, r *http.Request, instance, adapterIDOrName string, path []string) {
+	adapter, ok := k.getAdapter(instance, adapterIDOrName)
+	if !ok {
+		writeKMSError(w, http.StatusNotFound, "KMIP_ADAPTER_NOT_FOUND_ERR", "KMIP adapter %s does not exist", adapterIDOrName)
+		return
+	}
+	adapterID := adapter["id"].(string)
+	switch {
+	case len(path) == 0 && r.Method == http.MethodPost:
+		resource, err := readKMSResource(r)
+		if err != nil {
+			writeKMSError(w, http.StatusBadRequest, "BAD_BODY_ERR", "%s", err)
+			return
+		}
+		certificate, _ := resource["certificate"].(string)
+		if !strings.Contains(certificate, "-----BEGIN CERTIFICATE-----") {
+			writeKMSError(w, http.StatusBadRequest, "KMIP_CERT_INVALID_ERR", "certificate must be a PEM encoded X.509 certificate")
+			return
+		}
+		id := k.server.newID()[5:]
+		name, _ := resource["name"].(string)
+		if name == "" {
+			name = "kmip_cert-" + id
+		}
+		cert := k.certificates.add(id, object{
+			"instance":    instance,
+			"adapter_id":  adapterID,
+			"id":          id,
+			"name":        name,
+			"certificate": certificate,
+			"created_by":  "IBMid-mockserver",
+			"created_at":  timestamp(),
+		})
+		writeJSON(w, http.StatusCreated, kmsCollection(kmsKmipCertType, kmsResources([]object{cert})...))
+	case len(path) == 0 && r.Method == http.MethodGet:
+		certs := k.certificates.list(func(o object) bool { return o["adapter_id"] == adapterID })
+		writeJSON(w, http.StatusOK, kmsCollection(kmsKmipCertType, kmsResources(certs)...))
+	case len(path) == 1:
+		certs := k.certificates.list(func(o object) bool {
+			return o["adapter_id"] == adapterID && (o["id"] == path[0] || o["name"] == path[0])
+		})
+		if len(certs) == 0 {
+			writeKMSError(w, http.StatusNotFound, "KMIP_CERT_NOT_FOUND_ERR", "KMIP client certificate %s does not exist", path[0])
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, kmsCollection(kmsKmipCertType, kmsResources(certs)...))
+		case http.MethodDelete:
+			k.certificates.remove(certs[0]["id"].(string))
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			notFound(w, r)
+		}
+	default:
+		notFound(w, r)
+	}
+}
@@ -0,0 +1,265 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Package mockserver provides local stand-ins for the IBM Cloud APIs used by the
+// provider resources so their CRUD functions can be exercised without an account.
+//
+// A Server runs in one of three modes. The stand-in mode, the default, serves every
+// request from in-memory fakes of the IAM token and trusted profile, VPC, resource
+// controller, global catalog, global tagging, cloud object storage, Secrets Manager,
+// Key Protect, Cloud Internet Services, DNS Services, Transit Gateway, Direct Link and
+// Kubernetes Service APIs. The record mode proxies the requests to the live
+// endpoints and writes the interactions to a cassette file, and the replay mode
+// serves the interactions back from that cassette.
+package mockserver
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sort"
+	"sync"
+)
+
+// Mode selects how a Server answers the requests it receives.
+type Mode string
+
+const (
+	// ModeStandIn serves the requests from the in-memory stand-ins.
+	ModeStandIn Mode = "standin"
+	// ModeRecord proxies the requests to the live endpoints and records them.
+	ModeRecord Mode = "record"
+	// ModeReplay serves the requests from a recorded cassette.
+	ModeReplay Mode = "replay"
+)
+
+// ModeEnvVar is the environment variable read by ModeFromEnv.
+const ModeEnvVar = "IBMCLOUD_MOCK_MODE"
+
+// The services served by a Server, named after the provider endpoints block keys.
+// Service COS is the S3 API of cloud object storage, which has no key there. The
+// Kubernetes Service API is served at both the container and satellite endpoints,
+// and the Direct Link and Direct Link Provider APIs serve the same gateways.
+const (
+	ServiceIAM                = "iam"
+	ServiceVPC                = "vpc"
+	ServiceResourceController = "resource_controller"
+	ServiceResourceCatalog    = "resource_catalog"
+	ServiceGlobalTagging      = "global_tagging"
+	ServiceCOSConfig          = "cos_config"
+	ServiceCOS                = "cos"
+	ServiceSecretsManager     = "secrets_manager"
+	ServiceContainer          = "container"
+	ServiceSatellite          = "satellite"
+	ServiceKMS                = "kms"
+	ServiceCIS                = "cis"
+	ServicePrivateDNS         = "private_dns"
+	ServiceTransitGateway     = "transit_gateway"
+	ServiceDirectLink         = "directlink"
+	ServiceDirectLinkProvider = "directlink_provider"
+)
+
+// basePaths holds the path the clients of a service expect after its host.
+var basePaths = map[string]string{
+	ServiceVPC:                "/v1",
+	ServiceCOSConfig:          "/v1",
+	ServiceContainer:          "/global",
+	ServiceSatellite:          "/global",
+	ServicePrivateDNS:         "/v1",
+	ServiceTransitGateway:     "/v1",
+	ServiceDirectLink:         "/v1",
+	ServiceDirectLinkProvider: "/provider/v2",
+}
+
+// DefaultUpstreams are the live endpoints proxied to in record mode.
+var DefaultUpstreams = map[string]string{
+	ServiceIAM:                "https://iam.cloud.ibm.com",
+	ServiceVPC:                "https://us-south.iaas.cloud.ibm.com",
+	ServiceResourceController: "https://resource-controller.cloud.ibm.com",
+	ServiceResourceCatalog:    "https://globalcatalog.cloud.ibm.com",
+	ServiceGlobalTagging:      "https://tags.global-search-tagging.cloud.ibm.com",
+	ServiceCOSConfig:          "https://config.cloud-object-storage.cloud.ibm.com",
+	ServiceCOS:                "https://s3.us-south.cloud-object-storage.appdomain.cloud",
+	// The Secrets Manager API is served by each instance, at
+	// https://<instance GUID>.<region>.secrets-manager.appdomain.cloud.
+	ServiceSecretsManager:     "https://secrets-manager.cloud.ibm.com",
+	ServiceContainer:          "https://containers.cloud.ibm.com",
+	ServiceSatellite:          "https://containers.cloud.ibm.com",
+	ServiceKMS:                "https://us-south.kms.cloud.ibm.com",
+	ServiceCIS:                "https://api.cis.cloud.ibm.com",
+	ServicePrivateDNS:         "https://api.dns-svcs.cloud.ibm.com",
+	ServiceTransitGateway:     "https://transit.cloud.ibm.com",
+	ServiceDirectLink:         "https://directlink.cloud.ibm.com",
+	ServiceDirectLinkProvider: "https://directlink.cloud.ibm.com",
+}
+
+// ModeFromEnv returns the mode set in the IBMCLOUD_MOCK_MODE environment variable,
+// ModeStandIn when it is unset.
+func ModeFromEnv() Mode {
+	switch mode := Mode(os.Getenv(ModeEnvVar)); mode {
+	case ModeRecord, ModeReplay:
+		return mode
+	default:
+		return ModeStandIn
+	}
+}
+
+// Server is a set of local HTTP servers, one for each service.
+type Server struct {
+	// Account is the account ID carried by the tokens and CRNs of the stand-ins.
+	Account string
+	// Region is the region of the CRNs of the stand-ins.
+	Region string
+	// Extensions are the extensions of the resource instances created by the
+	// resource controller stand-in, by service name. They hold the endpoints of the
+	// instances, such as the kafka_http_url and kafka_brokers_sasl of Event Streams.
+	Extensions map[string]map[string]interface{}
+
+	mode      Mode
+	cassette  *cassette
+	upstreams map[string]string
+	servers   map[string]*httptest.Server
+	standins  map[string]http.Handler
+
+	mu  sync.Mutex
+	seq int
+}
+
+// New starts a Server in the given mode. The cassette path is read in replay mode
+// and written on Close in record mode, it is unused in stand-in mode.
+func New(mode Mode, cassettePath string) (*Server, error) {
+	s := &Server{
+		Account:    "a1b2c3d4e5f60718293a4b5c6d7e8f90",
+		Region:     "us-south",
+		Extensions: make(map[string]map[string]interface{}),
+		mode:       mode,
+		upstreams:  make(map[string]string, len(DefaultUpstreams)),
+		servers:    make(map[string]*httptest.Server),
+	}
+	for service, upstream := range DefaultUpstreams {
+		s.upstreams[service] = upstream
+	}
+	switch mode {
+	case ModeRecord:
+		s.cassette = newCassette(cassettePath)
+	case ModeReplay:
+		c, err := loadCassette(cassettePath)
+		if err != nil {
+			return nil, err
+		}
+		s.cassette = c
+	case ModeStandIn:
+	default:
+		return nil, fmt.Errorf("unsupported mock server mode %q", mode)
+	}
+
+	cos := newCOS(s)
+	container := newContainer(s)
+	directLink := newDirectLink(s)
+	s.standins = map[string]http.Handler{
+		ServiceIAM:                newIAM(s),
+		ServiceVPC:                newVPC(s),
+		ServiceResourceController: newResourceController(s),
+		ServiceResourceCatalog:    newResourceCatalog(),
+		ServiceGlobalTagging:      newGlobalTagging(),
+		ServiceCOSConfig:          http.HandlerFunc(cos.serveConfig),
+		ServiceCOS:                http.HandlerFunc(cos.serveS3),
+		ServiceSecretsManager:     newSecretsManager(s),
+		ServiceContainer:          container,
+		ServiceSatellite:          container,
+		ServiceKMS:                newKMS(s),
+		ServiceCIS:                newCIS(s),
+		ServicePrivateDNS:         newDNSSvcs(s),
+		ServiceTransitGateway:     newTransitGateway(s),
+		ServiceDirectLink:         http.HandlerFunc(directLink.serveCustomer),
+		ServiceDirectLinkProvider: http.HandlerFunc(directLink.serveProvider),
+	}
+	for service := range s.standins {
+		s.servers[service] = httptest.NewServer(s.handler(service))
+	}
+	return s, nil
+}
+
+// Mode returns the mode the server runs in.
+func (s *Server) Mode() Mode {
+	return s.mode
+}
+
+// URL returns the base URL of the service, to be used as its endpoint.
+func (s *Server) URL(service string) string {
+	server, ok := s.servers[service]
+	if !ok {
+		panic(fmt.Sprintf("mockserver: unknown service %q", service))
+	}
+	return server.URL + basePaths[service]
+}
+
+// Endpoints returns the base URL of every service keyed by service name.
+func (s *Server) Endpoints() map[string]string {
+	endpoints := make(map[string]string, len(s.servers))
+	for service := range s.servers {
+		endpoints[service] = s.URL(service)
+	}
+	return endpoints
+}
+
+// SetUpstream sets the live endpoint a service is proxied to in record mode.
+func (s *Server) SetUpstream(service, upstream string) {
+	s.upstreams[service] = upstream
+}
+
+// Close shuts the servers down and, in record mode, writes the cassette.
+func (s *Server) Close() error {
+	services := make([]string, 0, len(s.servers))
+	for service := range s.servers {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+	for _, service := range services {
+		s.servers[service].Close()
+	}
+	if s.mode == ModeRecord {
+		return s.cassette.save()
+	}
+	return nil
+}
+
+func (s *Server) handler(service string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		log.Printf("[DEBUG] mockserver %s %s %s", service, r.Method, r.URL.RequestURI())
+		switch {
+		case s.mode == ModeRecord:
+			s.record(service, w, r)
+		case s.mode == ModeReplay && !isIAMToken(service, r):
+			s.replay(service, w, r)
+		default:
+			s.standins[service].ServeHTTP(w, r)
+		}
+	})
+}
+
+// newID returns a unique identifier shaped like the UUIDs of the live APIs.
+func (s *Server) newID() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.seq++
+	return fmt.Sprintf("r006-%08x-0000-4000-8000-%012x", s.seq, s.seq)
+}
+
+// instanceExtensions returns a copy of the extensions of the instances of a service.
+func (s *Server) instanceExtensions(service string) object {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	extensions := object{}
+	for k, v := range s.Extensions[service] {
+		extensions[k] = v
+	}
+	return extensions
+}
+
+// crn returns the CRN of a resource of the service in the region of the server.
+func (s *Server) crn(serviceName, location, resourceType, id string) string {
+	return fmt.Sprintf("crn:v1:bluemix:public:%s:%s:a/%s::%s:%s", serviceName, location, s.Account, resourceType, id)
+}
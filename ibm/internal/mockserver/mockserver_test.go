@@ -0,0 +1,193 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package mockserver
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type testExchange struct {
+	method, path, body string
+}
+
+func doRequest(t *testing.T, method, url, body string) (int, object) {
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	content, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	o := object{}
+	if len(content) > 0 {
+		if err := json.Unmarshal(content, &o); err != nil {
+			t.Fatalf("%s %s: %s: %s", method, url, err, content)
+		}
+	}
+	return resp.StatusCode, o
+}
+
+func newTestServer(t *testing.T, mode Mode, cassette string) *Server {
+	s, err := New(mode, cassette)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestStandInVPC(t *testing.T) {
+	s := newTestServer(t, ModeStandIn, "")
+	defer s.Close()
+	base := s.URL(ServiceVPC)
+
+	status, vpc := doRequest(t, http.MethodPost, base+"/vpcs", `{"name": "test-vpc"}`)
+	if status != http.StatusCreated || vpc["status"] != "available" {
+		t.Fatalf("unexpected VPC creation %d: %v", status, vpc)
+	}
+	id := vpc["id"].(string)
+	sg := vpc["default_security_group"].(map[string]interface{})
+
+	status, _ = doRequest(t, http.MethodPatch, base+"/security_groups/"+sg["id"].(string), `{"name": "renamed-sg"}`)
+	if status != http.StatusOK {
+		t.Fatalf("unexpected security group update %d", status)
+	}
+	_, vpc = doRequest(t, http.MethodGet, base+"/vpcs/"+id, "")
+	if name := vpc["default_security_group"].(map[string]interface{})["name"]; name != "renamed-sg" {
+		t.Fatalf("expected the default security group renamed, got %v", name)
+	}
+
+	status, subnet := doRequest(t, http.MethodPost, base+"/subnets", `{"name": "test-subnet", "vpc": {"id": "`+id+`"}, "zone": {"name": "us-south-2"}, "ipv4_cidr_block": "10.240.64.0/26"}`)
+	if status != http.StatusCreated || subnet["total_ipv4_address_count"] != float64(64) {
+		t.Fatalf("unexpected subnet creation %d: %v", status, subnet)
+	}
+	if status, _ := doRequest(t, http.MethodDelete, base+"/vpcs/"+id, ""); status != http.StatusConflict {
+		t.Fatalf("expected the deletion of a VPC with subnets to conflict, got %d", status)
+	}
+	if status, _ := doRequest(t, http.MethodDelete, base+"/subnets/"+subnet["id"].(string), ""); status != http.StatusNoContent {
+		t.Fatalf("unexpected subnet deletion %d", status)
+	}
+	if status, _ := doRequest(t, http.MethodDelete, base+"/vpcs/"+id, ""); status != http.StatusNoContent {
+		t.Fatalf("unexpected VPC deletion %d", status)
+	}
+	if status, _ := doRequest(t, http.MethodGet, base+"/vpcs/"+id, ""); status != http.StatusNotFound {
+		t.Fatalf("expected the deleted VPC not found, got %d", status)
+	}
+}
+
+func TestStandInGlobalTagging(t *testing.T) {
+	s := newTestServer(t, ModeStandIn, "")
+	defer s.Close()
+	base := s.URL(ServiceGlobalTagging)
+	crn := s.crn("is", s.Region, "vpc", "test")
+
+	doRequest(t, http.MethodPost, base+"/v3/tags/attach", `{"resources": [{"resource_id": "`+crn+`"}], "tag_names": ["env:test", "Env:Test", "team:a"]}`)
+	doRequest(t, http.MethodPost, base+"/v3/tags/attach?tag_type=access", `{"resources": [{"resource_id": "`+crn+`"}], "tag_names": ["project:x"]}`)
+	doRequest(t, http.MethodPost, base+"/v3/tags/detach", `{"resources": [{"resource_id": "`+crn+`"}], "tag_names": ["team:a"]}`)
+
+	_, tags := doRequest(t, http.MethodGet, base+"/v3/tags?attached_to="+crn, "")
+	items := tags["items"].([]interface{})
+	if len(items) != 1 || items[0].(map[string]interface{})["name"] != "env:test" {
+		t.Fatalf("unexpected user tags %v", items)
+	}
+	_, tags = doRequest(t, http.MethodGet, base+"/v3/tags?tag_type=access&attached_to="+crn, "")
+	if items := tags["items"].([]interface{}); len(items) != 1 {
+		t.Fatalf("unexpected access tags %v", items)
+	}
+}
+
+func TestStandInIAM(t *testing.T) {
+	s := newTestServer(t, ModeStandIn, "")
+	defer s.Close()
+
+	resp, err := http.PostForm(s.URL(ServiceIAM)+"/identity/token", map[string][]string{
+		"grant_type": {"urn:ibm:params:oauth:grant-type:apikey"},
+		"apikey":     {"key"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	token := object{}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		t.Fatal(err)
+	}
+	if token["token_type"] != "Bearer" || strings.Count(token["access_token"].(string), ".") != 2 {
+		t.Fatalf("unexpected token response %v", token)
+	}
+}
+
+func TestRecordReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mockserver")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cassette := filepath.Join(dir, "cassettes", "test.json")
+
+	// Record against a stand-in playing the live API
+	live := newTestServer(t, ModeStandIn, "")
+	defer live.Close()
+	recorder := newTestServer(t, ModeRecord, cassette)
+	recorder.SetUpstream(ServiceVPC, live.servers[ServiceVPC].URL)
+	recorder.SetUpstream(ServiceIAM, live.servers[ServiceIAM].URL)
+
+	run := func(s *Server) []object {
+		var responses []object
+		_, vpc := doRequest(t, http.MethodPost, s.URL(ServiceVPC)+"/vpcs", `{"name": "recorded-vpc"}`)
+		responses = append(responses, vpc)
+		for _, e := range []testExchange{
+			{http.MethodGet, "/vpcs/" + vpc["id"].(string), ""},
+			{http.MethodDelete, "/vpcs/" + vpc["id"].(string), ""},
+			{http.MethodGet, "/vpcs/" + vpc["id"].(string), ""},
+			{http.MethodGet, "/vpcs/" + vpc["id"].(string), ""},
+		} {
+			status, o := doRequest(t, e.method, s.URL(ServiceVPC)+e.path, e.body)
+			o["status_code"] = status
+			responses = append(responses, o)
+		}
+		return responses
+	}
+	recorded := run(recorder)
+	http.PostForm(recorder.URL(ServiceIAM)+"/identity/token", map[string][]string{"grant_type": {"refresh_token"}})
+	if err := recorder.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	content, err := ioutil.ReadFile(cassette)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(content), "access_token") {
+		t.Fatal("the IAM token response must not be recorded")
+	}
+
+	player := newTestServer(t, ModeReplay, cassette)
+	defer player.Close()
+	replayed := run(player)
+	recordedJSON, _ := json.Marshal(recorded)
+	replayedJSON, _ := json.Marshal(replayed)
+	if string(recordedJSON) != string(replayedJSON) {
+		t.Fatalf("replayed responses differ from the recorded ones:\n%s\n%s", recordedJSON, replayedJSON)
+	}
+
+	if status, _ := doRequest(t, http.MethodGet, player.URL(ServiceVPC)+"/subnets", ""); status != http.StatusNotImplemented {
+		t.Fatalf("expected an unrecorded request to fail, got %d", status)
+	}
+	if _, err := New(ModeReplay, filepath.Join(dir, "missing.json")); err == nil {
+		t.Fatal("expected an error replaying a missing cassette")
+	}
+}
@@ -0,0 +1,299 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package mockserver
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// catalogService is a service offering of the global catalog stand-in.
+type catalogService struct {
+	name      string
+	plans     []string
+	locations []string
+}
+
+// catalogServices are the offerings known to the global catalog stand-in.
+var catalogServices = []catalogService{
+	{name: "cloud-object-storage", plans: []string{"lite", "standard"}, locations: []string{"global"}},
+	{name: "kms", plans: []string{"tiered-pricing"}, locations: []string{"us-south", "eu-de"}},
+	{name: "secrets-manager", plans: []string{"lite", "standard"}, locations: []string{"us-south", "eu-de"}},
+	{name: "messagehub", plans: []string{"standard", "enterprise-3nodes-2tb"}, locations: []string{"us-south"}},
+}
+
+func catalogServiceID(name string) string {
+	return "mockserver-service-" + name
+}
+
+func catalogPlanID(service, plan string) string {
+	return fmt.Sprintf("mockserver-plan-%s-%s", service, plan)
+}
+
+func catalogDeploymentCRN(service, location string) string {
+	return fmt.Sprintf("crn:v1:bluemix:public:globalcatalog::::deployment:%s-%s", service, location)
+}
+
+// resourceCatalog serves the global catalog lookups of service offerings, plans and
+// deployments made when provisioning resource instances.
+type resourceCatalog struct {
+	entries map[string]object
+	plans   map[string][]object
+	deploys map[string][]object
+}
+
+func newResourceCatalog() *resourceCatalog {
+	c := &resourceCatalog{
+		entries: make(map[string]object),
+		plans:   make(map[string][]object),
+		deploys: make(map[string][]object),
+	}
+	for _, service := range catalogServices {
+		serviceID := catalogServiceID(service.name)
+		c.entries[serviceID] = object{
+			"id":          serviceID,
+			"name":        service.name,
+			"kind":        "service",
+			"active":      true,
+			"catalog_crn": "crn:v1:bluemix:public:globalcatalog::::service:" + serviceID,
+			"metadata": object{"service": object{
+				"rc_provisionable": true,
+				"iam_compatible":   true,
+				"bindable":         true,
+			}},
+		}
+		for _, plan := range service.plans {
+			planID := catalogPlanID(service.name, plan)
+			entry := object{
+				"id":          planID,
+				"name":        plan,
+				"kind":        "plan",
+				"catalog_crn": "crn:v1:bluemix:public:globalcatalog::::plan:" + planID,
+			}
+			c.entries[planID] = entry
+			c.plans[serviceID] = append(c.plans[serviceID], entry)
+			for _, location := range service.locations {
+				c.deploys[planID] = append(c.deploys[planID], object{
+					"id":          fmt.Sprintf("%s-%s", planID, location),
+					"name":        fmt.Sprintf("%s-%s", service.name, location),
+					"catalog_crn": catalogDeploymentCRN(service.name, location),
+					"metadata": object{
+						"rc_compatible":  true,
+						"iam_compatible": true,
+						"deployment": object{
+							"location":   location,
+							"target_crn": fmt.Sprintf("crn:v1:bluemix:public:%s:%s::::", service.name, location),
+						},
+					},
+				})
+			}
+		}
+	}
+	return c
+}
+
+func (c *resourceCatalog) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet || !strings.HasPrefix(r.URL.Path, "/api/v1") {
+		notFound(w, r)
+		return
+	}
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/v1"), "/"), "/")
+	switch {
+	case parts[0] == "":
+		query := r.URL.Query().Get("q")
+		resources := []object{}
+		for _, service := range catalogServices {
+			if query == "" || query == service.name {
+				resources = append(resources, c.entries[catalogServiceID(service.name)])
+			}
+		}
+		writeJSON(w, http.StatusOK, catalogPage(resources))
+	case len(parts) == 1:
+		entry, ok := c.entries[parts[0]]
+		if !ok {
+			writeError(w, http.StatusNotFound, "not_found", "Catalog entry %s not found", parts[0])
+			return
+		}
+		writeJSON(w, http.StatusOK, entry)
+	case len(parts) == 2 && (parts[1] == "plan" || parts[1] == "flavor"):
+		writeJSON(w, http.StatusOK, catalogPage(c.plans[parts[0]]))
+	case len(parts) == 2 && parts[1] == "deployment":
+		writeJSON(w, http.StatusOK, catalogPage(c.deploys[parts[0]]))
+	default:
+		notFound(w, r)
+	}
+}
+
+func catalogPage(resources []object) object {
+	if resources == nil {
+		resources = []object{}
+	}
+	return object{
+		"offset":         0,
+		"limit":          len(resources),
+		"count":          len(resources),
+		"resource_count": len(resources),
+		"resources":      resources,
+	}
+}
+
+// resourceController serves the resource controller API for resource instances.
+// Deleted instances are kept in the removed state, as the live API does.
+type resourceController struct {
+	server    *Server
+	instances *collection
+}
+
+func newResourceController(s *Server) *resourceController {
+	return &resourceController{server: s, instances: newCollection()}
+}
+
+func (rc *resourceController) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	const prefix = "/v2/resource_instances"
+	path := r.URL.Path
+	// The v1 API, still used by bluemix-go, represents the instances the same way.
+	if strings.HasPrefix(path, "/v1/resource_instances/") && r.Method == http.MethodGet {
+		path = "/v2" + strings.TrimPrefix(path, "/v1")
+	}
+	if !strings.HasPrefix(path, prefix) {
+		notFound(w, r)
+		return
+	}
+	// Instance IDs are CRNs, which contain slashes.
+	id := strings.TrimPrefix(strings.TrimPrefix(path, prefix), "/")
+	switch {
+	case id == "" && r.Method == http.MethodGet:
+		resources := rc.instances.list(func(o object) bool { return o["state"] != "removed" })
+		writeJSON(w, http.StatusOK, object{"rows_count": len(resources), "resources": resources})
+	case id == "" && r.Method == http.MethodPost:
+		rc.create(w, r)
+	case id != "":
+		o, ok := rc.instances.get(id)
+		if !ok {
+			// Instances are also looked up by GUID.
+			if matching := rc.instances.list(func(o object) bool { return o["guid"] == id }); len(matching) > 0 {
+				o, ok = matching[0], true
+			}
+		}
+		if !ok {
+			writeError(w, http.StatusNotFound, "not_found", "Instance with ID %s is not found", id)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, o)
+		case http.MethodPatch:
+			rc.update(w, r, o)
+		case http.MethodDelete:
+			if o["state"] == "removed" {
+				writeError(w, http.StatusGone, "gone", "Instance with ID %s has been removed", id)
+				return
+			}
+			rc.instances.update(o["id"].(string), object{"state": "removed", "deleted_at": timestamp(), "deleted_by": "IBMid-mockserver"})
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			notFound(w, r)
+		}
+	default:
+		notFound(w, r)
+	}
+}
+
+func (rc *resourceController) create(w http.ResponseWriter, r *http.Request) {
+	body := object{}
+	if err := readJSON(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+		return
+	}
+	name, _ := body["name"].(string)
+	target, _ := body["target"].(string)
+	planID, _ := body["resource_plan_id"].(string)
+	if name == "" || target == "" || planID == "" {
+		writeError(w, http.StatusBadRequest, "bad_request", "name, target and resource_plan_id are required")
+		return
+	}
+	// Bare locations are shared by several services, the plan selects the service.
+	var service, location string
+	for _, s := range catalogServices {
+		for _, l := range s.locations {
+			if (target == catalogDeploymentCRN(s.name, l) || target == l) && strings.HasPrefix(planID, catalogPlanID(s.name, "")) {
+				service, location = s.name, l
+			}
+		}
+	}
+	if service == "" || !strings.HasPrefix(planID, catalogPlanID(service, "")) {
+		writeError(w, http.StatusBadRequest, "bad_request", "Plan %s is not deployable to %s", planID, target)
+		return
+	}
+	rg, _ := body["resource_group"].(string)
+	if rg == "" {
+		rg = defaultResourceGroupID
+	}
+	guid := rc.server.newID()[5:]
+	crn := fmt.Sprintf("crn:v1:bluemix:public:%s:%s:a/%s:%s::", service, location, rc.server.Account, guid)
+	now := timestamp()
+	parameters := body["parameters"]
+	if parameters == nil {
+		parameters = object{}
+	}
+	o := rc.instances.add(crn, object{
+		"id":                    crn,
+		"guid":                  guid,
+		"crn":                   crn,
+		"url":                   "/v2/resource_instances/" + guid,
+		"name":                  name,
+		"account_id":            rc.server.Account,
+		"resource_group_id":     rg,
+		"resource_group_crn":    fmt.Sprintf("crn:v1:bluemix:public:resource-controller::a/%s::resource-group:%s", rc.server.Account, rg),
+		"resource_id":           catalogServiceID(service),
+		"resource_plan_id":      planID,
+		"target_crn":            catalogDeploymentCRN(service, location),
+		"region_id":             location,
+		"parameters":            parameters,
+		"state":                 "active",
+		"type":                  "service_instance",
+		"sub_type":              "",
+		"locked":                false,
+		"allow_cleanup":         false,
+		"dashboard_url":         "https://cloud.ibm.com/services/" + service + "/" + guid,
+		"created_at":            now,
+		"created_by":            "IBMid-mockserver",
+		"updated_at":            now,
+		"updated_by":            "IBMid-mockserver",
+		"resource_keys_url":     "/v2/resource_instances/" + guid + "/resource_keys",
+		"resource_bindings_url": "/v2/resource_instances/" + guid + "/resource_bindings",
+		"resource_aliases_url":  "/v2/resource_instances/" + guid + "/resource_aliases",
+		"last_operation":        object{"type": "create", "state": "succeeded", "async": false},
+		"plan_history":          []object{{"resource_plan_id": planID, "start_date": now}},
+		"extensions":            rc.server.instanceExtensions(service),
+	})
+	writeJSON(w, http.StatusCreated, o)
+}
+
+func (rc *resourceController) update(w http.ResponseWriter, r *http.Request, o object) {
+	body := object{}
+	if err := readJSON(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+		return
+	}
+	patch := object{
+		"updated_at":     timestamp(),
+		"updated_by":     "IBMid-mockserver",
+		"last_operation": object{"type": "update", "state": "succeeded", "async": false},
+	}
+	if name, ok := body["name"].(string); ok && name != "" {
+		patch["name"] = name
+	}
+	if parameters, ok := body["parameters"]; ok {
+		patch["parameters"] = parameters
+	}
+	if planID, ok := body["resource_plan_id"].(string); ok && planID != "" && planID != o["resource_plan_id"] {
+		patch["resource_plan_id"] = planID
+		history := append([]object{}, o["plan_history"].([]object)...)
+		patch["plan_history"] = append(history, object{"resource_plan_id": planID, "start_date": timestamp()})
+	}
+	updated, _ := rc.instances.update(o["id"].(string), patch)
+	writeJSON(w, http.StatusOK, updated)
+}
@@ -0,0 +1,372 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package mockserver
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	secretsManagerGroupType  = "application/vnd.ibm.secrets-manager.secret.group+json"
+	secretsManagerSecretType = "application/vnd.ibm.secrets-manager.secret+json"
+	secretsManagerPolicyType = "application/vnd.ibm.secrets-manager.secret.policy+json"
+)
+
+// secretsManagerSecretTypes are the secret types served by the stand-in, with the
+// fields of their secret data.
+var secretsManagerSecretTypes = map[string][]string{
+	"arbitrary":         {"payload"},
+	"username_password": {"username", "password"},
+	"iam_credentials":   {"api_key"},
+	"imported_cert":     {"certificate", "private_key", "intermediate"},
+}
+
+// secretsManager serves the Secrets Manager API for secret groups, secrets and
+// their rotation policies. Secrets are assigned to the default group when they
+// have no group, and the secret data is only returned when getting a secret, as
+// the live API does.
+type secretsManager struct {
+	server   *Server
+	groups   *collection
+	secrets  *collection
+	policies *collection
+}
+
+func newSecretsManager(s *Server) *secretsManager {
+	return &secretsManager{server: s, groups: newCollection(), secrets: newCollection(), policies: newCollection()}
+}
+
+func (sm *secretsManager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	const prefix = "/api/v1/"
+	if !strings.HasPrefix(r.URL.Path, prefix) {
+		notFound(w, r)
+		return
+	}
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, prefix), "/")
+	switch {
+	case parts[0] == "secret_groups" && len(parts) == 1 && r.Method == http.MethodPost:
+		sm.createGroup(w, r)
+	case parts[0] == "secret_groups" && len(parts) == 2:
+		sm.serveGroup(w, r, parts[1])
+	case parts[0] == "secrets" && len(parts) >= 2:
+		if _, ok := secretsManagerSecretTypes[parts[1]]; !ok {
+			writeError(w, http.StatusBadRequest, "bad_request", "Secret type %s is not supported", parts[1])
+			return
+		}
+		switch {
+		case len(parts) == 2 && r.Method == http.MethodPost:
+			sm.createSecret(w, r, parts[1])
+		case len(parts) >= 3:
+			sm.serveSecret(w, r, parts[1], parts[2], parts[3:])
+		default:
+			notFound(w, r)
+		}
+	default:
+		notFound(w, r)
+	}
+}
+
+// secretsManagerCollection returns the collection format of the requests and
+// responses of the API.
+func secretsManagerCollection(collectionType string, resources ...object) object {
+	if resources == nil {
+		resources = []object{}
+	}
+	return object{
+		"metadata":  object{"collection_type": collectionType, "collection_total": len(resources)},
+		"resources": resources,
+	}
+}
+
+// readSecretsManagerResource returns the single resource of a request collection.
+func readSecretsManagerResource(r *http.Request) (object, error) {
+	body := struct {
+		Resources []object `json:"resources"`
+	}{}
+	if err := readJSON(r, &body); err != nil {
+		return nil, err
+	}
+	if len(body.Resources) != 1 {
+		return nil, fmt.Errorf("resources must hold a single resource")
+	}
+	return body.Resources[0], nil
+}
+
+func (sm *secretsManager) createGroup(w http.ResponseWriter, r *http.Request) {
+	resource, err := readSecretsManagerResource(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+		return
+	}
+	name, _ := resource["name"].(string)
+	if name == "" {
+		writeError(w, http.StatusBadRequest, "bad_request", "name is required")
+		return
+	}
+	for _, group := range sm.groups.list(nil) {
+		if group["name"] == name {
+			writeError(w, http.StatusConflict, "conflict", "A secret group with the name %s already exists", name)
+			return
+		}
+	}
+	id := sm.server.newID()[5:]
+	now := timestamp()
+	group := sm.groups.add(id, object{
+		"id":               id,
+		"name":             name,
+		"description":      resource["description"],
+		"creation_date":    now,
+		"last_update_date": now,
+		"type":             secretsManagerGroupType,
+	})
+	writeJSON(w, http.StatusOK, secretsManagerCollection(secretsManagerGroupType, group))
+}
+
+func (sm *secretsManager) serveGroup(w http.ResponseWriter, r *http.Request, id string) {
+	group, ok := sm.groups.get(id)
+	if !ok {
+		writeError(w, http.StatusNotFound, "not_found", "Secret group %s not found", id)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, secretsManagerCollection(secretsManagerGroupType, group))
+	case http.MethodPut:
+		resource, err := readSecretsManagerResource(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+			return
+		}
+		patch := object{"last_update_date": timestamp()}
+		for _, key := range []string{"name", "description"} {
+			if value, ok := resource[key]; ok {
+				patch[key] = value
+			}
+		}
+		group, _ = sm.groups.update(id, patch)
+		writeJSON(w, http.StatusOK, secretsManagerCollection(secretsManagerGroupType, group))
+	case http.MethodDelete:
+		if len(sm.secrets.list(func(o object) bool { return o["secret_group_id"] == id })) > 0 {
+			writeError(w, http.StatusPreconditionFailed, "precondition_failed", "Secret group %s is not empty", id)
+			return
+		}
+		sm.groups.remove(id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		notFound(w, r)
+	}
+}
+
+func (sm *secretsManager) createSecret(w http.ResponseWriter, r *http.Request, secretType string) {
+	resource, err := readSecretsManagerResource(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+		return
+	}
+	name, _ := resource["name"].(string)
+	if name == "" {
+		writeError(w, http.StatusBadRequest, "bad_request", "name is required")
+		return
+	}
+	groupID, _ := resource["secret_group_id"].(string)
+	if groupID == "" {
+		groupID = "default"
+	} else if _, ok := sm.groups.get(groupID); !ok {
+		writeError(w, http.StatusNotFound, "not_found", "Secret group %s not found", groupID)
+		return
+	}
+	id := sm.server.newID()[5:]
+	data, err := sm.secretData(secretType, resource)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+		return
+	}
+	now := timestamp()
+	secret := object{
+		"id":                id,
+		"type":              secretsManagerSecretType,
+		"name":              name,
+		"description":       resource["description"],
+		"labels":            resource["labels"],
+		"secret_group_id":   groupID,
+		"secret_type":       secretType,
+		"state":             1,
+		"state_description": "Active",
+		"crn":               sm.server.crn("secrets-manager", sm.server.Region, "secret", id),
+		"creation_date":     now,
+		"created_by":        "IBMid-mockserver",
+		"last_update_date":  now,
+		"versions":          []object{{"id": sm.server.newID()[5:], "creation_date": now, "created_by": "IBMid-mockserver", "auto_rotated": false}},
+		"secret_data":       data,
+	}
+	for _, key := range []string{"expiration_date", "ttl", "access_groups", "reuse_api_key"} {
+		if value, ok := resource[key]; ok {
+			secret[key] = value
+		}
+	}
+	if secretType == "iam_credentials" {
+		secret["service_id"] = "ServiceId-" + id
+	}
+	secret = sm.secrets.add(id, secret)
+	writeJSON(w, http.StatusOK, secretsManagerCollection(secretsManagerSecretType, secretsManagerMetadata(secret)))
+}
+
+// secretData returns the secret data of a new version of a secret, generating the
+// passwords and API keys the request does not set.
+func (sm *secretsManager) secretData(secretType string, resource object) (object, error) {
+	data := object{}
+	for _, key := range secretsManagerSecretTypes[secretType] {
+		if value, ok := resource[key].(string); ok && value != "" {
+			data[key] = value
+		}
+	}
+	switch secretType {
+	case "arbitrary":
+		if data["payload"] == nil {
+			return nil, fmt.Errorf("payload is required")
+		}
+	case "username_password":
+		if data["password"] == nil {
+			data["password"] = "mockserver-password-" + sm.server.newID()[5:]
+		}
+	case "iam_credentials":
+		data["api_key"] = "mockserver-api-key-" + sm.server.newID()[5:]
+	case "imported_cert":
+		if data["certificate"] == nil {
+			return nil, fmt.Errorf("certificate is required")
+		}
+	}
+	return data, nil
+}
+
+// secretsManagerSecret returns the secret with its secret data, the API key of the
+// IAM credentials being returned with the other fields of the secret.
+func secretsManagerSecret(secret object) object {
+	if apiKey, ok := secret["secret_data"].(object)["api_key"]; ok {
+		secret = copyObject(secret)
+		secret["api_key"] = apiKey
+	}
+	return secret
+}
+
+// secretsManagerMetadata returns the secret without its secret data.
+func secretsManagerMetadata(secret object) object {
+	metadata := copyObject(secret)
+	delete(metadata, "secret_data")
+	return metadata
+}
+
+func (sm *secretsManager) serveSecret(w http.ResponseWriter, r *http.Request, secretType, id string, path []string) {
+	secret, ok := sm.secrets.get(id)
+	if !ok || secret["secret_type"] != secretType {
+		writeError(w, http.StatusNotFound, "not_found", "Secret %s not found", id)
+		return
+	}
+	switch {
+	case len(path) == 0 && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, secretsManagerCollection(secretsManagerSecretType, secretsManagerSecret(secret)))
+	case len(path) == 0 && r.Method == http.MethodPost:
+		sm.rotateSecret(w, r, secret)
+	case len(path) == 0 && r.Method == http.MethodDelete:
+		sm.secrets.remove(id)
+		sm.policies.remove(id)
+		w.WriteHeader(http.StatusNoContent)
+	case len(path) == 1 && path[0] == "metadata" && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, secretsManagerCollection(secretsManagerSecretType, secretsManagerMetadata(secret)))
+	case len(path) == 1 && path[0] == "metadata" && r.Method == http.MethodPut:
+		resource, err := readSecretsManagerResource(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+			return
+		}
+		patch := object{"last_update_date": timestamp()}
+		for _, key := range []string{"name", "description", "labels", "expiration_date", "ttl"} {
+			if value, ok := resource[key]; ok {
+				patch[key] = value
+			}
+		}
+		secret, _ = sm.secrets.update(id, patch)
+		writeJSON(w, http.StatusOK, secretsManagerCollection(secretsManagerSecretType, secretsManagerMetadata(secret)))
+	case len(path) == 1 && path[0] == "policies" && r.URL.Query().Get("policy") == "rotation":
+		sm.servePolicy(w, r, secret)
+	default:
+		notFound(w, r)
+	}
+}
+
+func (sm *secretsManager) rotateSecret(w http.ResponseWriter, r *http.Request, secret object) {
+	if action := r.URL.Query().Get("action"); action != "rotate" {
+		writeError(w, http.StatusBadRequest, "bad_request", "Action %s is not supported", action)
+		return
+	}
+	body := object{}
+	if err := readJSON(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+		return
+	}
+	secretType := secret["secret_type"].(string)
+	id := secret["id"].(string)
+	if secretType == "username_password" {
+		body["username"] = secret["secret_data"].(object)["username"]
+	}
+	data, err := sm.secretData(secretType, body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+		return
+	}
+	now := timestamp()
+	versions := append([]object{}, secret["versions"].([]object)...)
+	versions = append(versions, object{"id": sm.server.newID()[5:], "creation_date": now, "created_by": "IBMid-mockserver", "auto_rotated": false})
+	secret, _ = sm.secrets.update(id, object{"secret_data": data, "versions": versions, "last_update_date": now})
+	writeJSON(w, http.StatusOK, secretsManagerCollection(secretsManagerSecretType, secretsManagerSecret(secret)))
+}
+
+func (sm *secretsManager) servePolicy(w http.ResponseWriter, r *http.Request, secret object) {
+	id := secret["id"].(string)
+	switch r.Method {
+	case http.MethodGet:
+		policy, ok := sm.policies.get(id)
+		if !ok {
+			writeJSON(w, http.StatusOK, secretsManagerCollection(secretsManagerPolicyType))
+			return
+		}
+		writeJSON(w, http.StatusOK, secretsManagerCollection(secretsManagerPolicyType, policy))
+	case http.MethodPut:
+		if secret["secret_type"] != "username_password" {
+			writeError(w, http.StatusBadRequest, "bad_request", "Rotation policies are not supported for %s secrets", secret["secret_type"])
+			return
+		}
+		resource, err := readSecretsManagerResource(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+			return
+		}
+		rotation, _ := resource["rotation"].(map[string]interface{})
+		if rotation == nil || rotation["interval"] == nil || rotation["unit"] == nil {
+			writeError(w, http.StatusBadRequest, "bad_request", "rotation interval and unit are required")
+			return
+		}
+		now := timestamp()
+		created := now
+		if existing, ok := sm.policies.get(id); ok {
+			created = existing["creation_date"].(string)
+		}
+		policy := sm.policies.add(id, object{
+			"id":               sm.server.newID()[5:],
+			"crn":              secret["crn"],
+			"type":             secretsManagerPolicyType,
+			"rotation":         rotation,
+			"creation_date":    created,
+			"created_by":       "IBMid-mockserver",
+			"last_update_date": now,
+			"updated_by":       "IBMid-mockserver",
+		})
+		sm.secrets.update(id, object{"next_rotation_date": now})
+		writeJSON(w, http.StatusOK, secretsManagerCollection(secretsManagerPolicyType, policy))
+	default:
+		notFound(w, r)
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package mockserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// object is the JSON representation of a resource held by a stand-in.
+type object map[string]interface{}
+
+// collection is an ordered, concurrency safe set of objects keyed by ID.
+type collection struct {
+	mu    sync.Mutex
+	items map[string]object
+	order []string
+}
+
+func newCollection() *collection {
+	return &collection{items: make(map[string]object)}
+}
+
+func (c *collection) add(id string, o object) object {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.items[id]; !ok {
+		c.order = append(c.order, id)
+	}
+	c.items[id] = o
+	return copyObject(o)
+}
+
+func (c *collection) get(id string) (object, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	o, ok := c.items[id]
+	return copyObject(o), ok
+}
+
+// update merges the patch into the object, following JSON merge patch semantics
+// for the top level keys.
+func (c *collection) update(id string, patch object) (object, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	o, ok := c.items[id]
+	if !ok {
+		return nil, false
+	}
+	for k, v := range patch {
+		if v == nil {
+			delete(o, k)
+		} else {
+			o[k] = v
+		}
+	}
+	return copyObject(o), true
+}
+
+func (c *collection) remove(id string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.items[id]; !ok {
+		return false
+	}
+	delete(c.items, id)
+	for i, v := range c.order {
+		if v == id {
+			c.order = append(c.order[:i], c.order[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+// list returns the objects matching the filter in creation order, all of them when
+// the filter is nil.
+func (c *collection) list(filter func(object) bool) []object {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	objects := make([]object, 0, len(c.order))
+	for _, id := range c.order {
+		if o := c.items[id]; filter == nil || filter(o) {
+			objects = append(objects, copyObject(o))
+		}
+	}
+	return objects
+}
+
+func copyObject(o object) object {
+	if o == nil {
+		return nil
+	}
+	c := make(object, len(o))
+	for k, v := range o {
+		c[k] = v
+	}
+	return c
+}
+
+// reference returns the subset of the object embedded by the APIs when referring to it.
+func reference(o object, keys ...string) object {
+	ref := object{}
+	for _, k := range keys {
+		if v, ok := o[k]; ok {
+			ref[k] = v
+		}
+	}
+	return ref
+}
+
+func timestamp() string {
+	return time.Now().UTC().Format("2006-01-02T15:04:05.000Z")
+}
+
+func readJSON(r *http.Request, v interface{}) error {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+	if len(body) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("invalid JSON body: %s", err)
+	}
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if v != nil {
+		json.NewEncoder(w).Encode(v)
+	}
+}
+
+// writeError writes an error in the format of the IBM Cloud platform APIs, which the
+// go-sdk-core and bluemix-go clients both understand.
+func writeError(w http.ResponseWriter, status int, code, format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	writeJSON(w, status, object{
+		"errors": []object{{"code": code, "message": message}},
+		"error":  message,
+		"trace":  "mockserver",
+	})
+}
+
+func notFound(w http.ResponseWriter, r *http.Request) {
+	writeError(w, http.StatusNotFound, "not_found", "%s %s is not served by the stand-in", r.Method, r.URL.Path)
+}
@@ -0,0 +1,629 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package mockserver
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// The routes the stand-in networks advertise to a transit gateway, by network type.
+// The VPC prefix is the default address prefix of us-south-1, so two VPCs overlap.
+var tgAdvertisedRoutes = map[string][]string{
+	"classic":              {"10.0.0.0/8"},
+	"vpc":                  {"10.240.0.0/18"},
+	"power_virtual_server": {"192.168.0.0/24"},
+	"gre_tunnel":           {"172.16.0.0/16", "172.16.10.0/24"},
+	"unbound_gre_tunnel":   {"172.17.0.0/16", "172.17.10.0/24"},
+}
+
+// tgLocalBGPASN is the ASN of the transit gateway end of the GRE tunnels.
+const tgLocalBGPASN = 64490
+
+// transitGateway serves transit gateways, their connections with the prefix filters
+// of the connections, and route reports. Like the live API, a GRE tunnel is carried
+// by a classic or Power Virtual Server connection, which can't be deleted while the
+// tunnel exists, and a route report is generated asynchronously: it is pending when
+// it is created and complete when it is read again. The routes of the report are the
+// tgAdvertisedRoutes of the connections, filtered by their prefix filters.
+type transitGateway struct {
+	server      *Server
+	gateways    *collection
+	connections *collection
+	reports     *collection
+}
+
+func newTransitGateway(s *Server) *transitGateway {
+	return &transitGateway{server: s, gateways: newCollection(), connections: newCollection(), reports: newCollection()}
+}
+
+func (t *transitGateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(parts) < 2 || parts[0] != "v1" || parts[1] != "transit_gateways" {
+		notFound(w, r)
+		return
+	}
+	path := parts[2:]
+	if len(path) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, object{"transit_gateways": t.gateways.list(nil)})
+		case http.MethodPost:
+			t.createGateway(w, r)
+		default:
+			notFound(w, r)
+		}
+		return
+	}
+	gateway, ok := t.gateways.get(path[0])
+	if !ok {
+		writeError(w, http.StatusNotFound, "not_found", "Transit gateway %s not found", path[0])
+		return
+	}
+	switch {
+	case len(path) == 1:
+		t.serveGateway(w, r, gateway)
+	case path[1] == "connections":
+		t.serveConnections(w, r, gateway, path[2:])
+	case path[1] == "route_reports":
+		t.serveReports(w, r, gateway, path[2:])
+	default:
+		notFound(w, r)
+	}
+}
+
+func (t *transitGateway) createGateway(w http.ResponseWriter, r *http.Request) {
+	body := object{}
+	if err := readJSON(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+		return
+	}
+	name, _ := body["name"].(string)
+	location, _ := body["location"].(string)
+	if name == "" || location == "" {
+		writeError(w, http.StatusBadRequest, "bad_request", "name and location are required")
+		return
+	}
+	id := t.server.newID()[5:]
+	now := timestamp()
+	global, _ := body["global"].(bool)
+	gateway := object{
+		"id":         id,
+		"crn":        t.server.crn("transit", "global", "gateway", id),
+		"name":       name,
+		"location":   location,
+		"global":     global,
+		"status":     "available",
+		"created_at": now,
+		"updated_at": now,
+	}
+	if rg, ok := body["resource_group"].(map[string]interface{}); ok {
+		gateway["resource_group"] = object{"id": rg["id"]}
+	}
+	writeJSON(w, http.StatusCreated, t.gateways.add(id, gateway))
+}
+
+func (t *transitGateway) serveGateway(w http.ResponseWriter, r *http.Request, gateway object) {
+	id := gateway["id"].(string)
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, gateway)
+	case http.MethodDelete:
+		if len(t.gatewayConnections(id)) > 0 {
+			writeError(w, http.StatusConflict, "conflict", "Transit gateway %s has connections", id)
+			return
+		}
+		t.gateways.remove(id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		notFound(w, r)
+	}
+}
+
+func (t *transitGateway) gatewayConnections(gatewayID string) []object {
+	return t.connections.list(func(o object) bool { return o["transit_gateway_id"] == gatewayID })
+}
+
+// connectionView returns the connection as the API returns it, without its prefix
+// filters, which are served at their own path.
+func connectionView(connection object) object {
+	view := object{}
+	for k, v := range connection {
+		if k != "transit_gateway_id" && k != "prefix_filters" {
+			view[k] = v
+		}
+	}
+	return view
+}
+
+func (t *transitGateway) serveConnections(w http.ResponseWriter, r *http.Request, gateway object, path []string) {
+	gatewayID := gateway["id"].(string)
+	if len(path) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			views := []object{}
+			for _, c := range t.gatewayConnections(gatewayID) {
+				views = append(views, connectionView(c))
+			}
+			writeJSON(w, http.StatusOK, object{"connections": views})
+		case http.MethodPost:
+			body := object{}
+			if err := readJSON(r, &body); err != nil {
+				writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+				return
+			}
+			connection, err := t.newConnection(gatewayID, body)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+				return
+			}
+			writeJSON(w, http.StatusCreated, connectionView(t.connections.add(connection["id"].(string), connection)))
+		default:
+			notFound(w, r)
+		}
+		return
+	}
+	connection, ok := t.connections.get(path[0])
+	if !ok || connection["transit_gateway_id"] != gatewayID {
+		writeError(w, http.StatusNotFound, "not_found", "Connection %s not found", path[0])
+		return
+	}
+	if len(path) > 1 {
+		if path[1] != "prefix_filters" {
+			notFound(w, r)
+			return
+		}
+		t.servePrefixFilters(w, r, connection, path[2:])
+		return
+	}
+	id := connection["id"].(string)
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, connectionView(connection))
+	case http.MethodPatch:
+		body := object{}
+		if err := readJSON(r, &body); err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+			return
+		}
+		if name, ok := body["name"].(string); ok {
+			connection["name"] = name
+		}
+		if v, ok := body["prefix_filters_default"]; ok {
+			if v != "permit" && v != "deny" {
+				writeError(w, http.StatusBadRequest, "bad_request", "prefix_filters_default must be permit or deny")
+				return
+			}
+			connection["prefix_filters_default"] = v
+		}
+		connection["updated_at"] = timestamp()
+		writeJSON(w, http.StatusOK, connectionView(t.connections.add(id, connection)))
+	case http.MethodDelete:
+		for _, c := range t.gatewayConnections(gatewayID) {
+			if c["base_connection_id"] == id {
+				writeError(w, http.StatusConflict, "conflict", "Connection %s is the base connection of the GRE tunnel %s", id, c["id"])
+				return
+			}
+		}
+		t.connections.remove(id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		notFound(w, r)
+	}
+}
+
+// newConnection returns a connection of the gateway described by the body, checking
+// the fields required by its network type.
+func (t *transitGateway) newConnection(gatewayID string, body object) (object, error) {
+	networkType, _ := body["network_type"].(string)
+	if _, ok := tgAdvertisedRoutes[networkType]; !ok {
+		return nil, fmt.Errorf("network_type %q is not supported", networkType)
+	}
+	id := t.server.newID()[5:]
+	now := timestamp()
+	connection := object{
+		"id":                     id,
+		"transit_gateway_id":     gatewayID,
+		"network_type":           networkType,
+		"status":                 "attached",
+		"prefix_filters_default": "permit",
+		"prefix_filters":         []object{},
+		"created_at":             now,
+		"updated_at":             now,
+	}
+	if v, ok := body["prefix_filters_default"]; ok {
+		if v != "permit" && v != "deny" {
+			return nil, fmt.Errorf("prefix_filters_default must be permit or deny")
+		}
+		connection["prefix_filters_default"] = v
+	}
+	networkID, _ := body["network_id"].(string)
+	switch networkType {
+	case "classic":
+		if networkID != "" {
+			return nil, fmt.Errorf("network_id must not be specified for network type classic")
+		}
+	case "vpc", "power_virtual_server":
+		if networkID == "" {
+			return nil, fmt.Errorf("network_id is required for network type %s", networkType)
+		}
+		connection["network_id"] = networkID
+	default:
+		if err := t.setGRETunnel(gatewayID, connection, body); err != nil {
+			return nil, err
+		}
+	}
+	if account, ok := body["network_account_id"].(string); ok && account != "" {
+		connection["network_account_id"] = account
+		connection["request_status"] = "pending"
+		connection["status"] = "pending"
+	}
+	connection["name"] = body["name"]
+	if name, _ := body["name"].(string); name == "" {
+		connection["name"] = strings.Replace(networkType, "_", "-", -1) + "-" + id[:8]
+		if networkType == "classic" {
+			connection["name"] = "Classic"
+		}
+	}
+	return connection, nil
+}
+
+// setGRETunnel sets the tunnel fields of the body on a GRE tunnel connection. A bound
+// tunnel is carried by a base connection of the gateway and an unbound one by a base
+// network type. The tunnel addresses are link local addresses in the same /30 subnet.
+func (t *transitGateway) setGRETunnel(gatewayID string, connection object, body object) error {
+	networkType := connection["network_type"].(string)
+	if networkID, _ := body["network_id"].(string); networkID != "" {
+		return fmt.Errorf("network_id must not be specified for network type %s", networkType)
+	}
+	baseConnectionID, _ := body["base_connection_id"].(string)
+	baseNetworkType, _ := body["base_network_type"].(string)
+	if networkType == "gre_tunnel" {
+		if baseNetworkType != "" {
+			return fmt.Errorf("base_network_type must not be specified for network type gre_tunnel")
+		}
+		base, ok := t.connections.get(baseConnectionID)
+		if !ok || base["transit_gateway_id"] != gatewayID {
+			return fmt.Errorf("base_connection_id %q is not a connection of the transit gateway", baseConnectionID)
+		}
+		if base["network_type"] != "classic" && base["network_type"] != "power_virtual_server" {
+			return fmt.Errorf("the base connection of a GRE tunnel must be a classic or power_virtual_server connection, not %s", base["network_type"])
+		}
+		connection["base_connection_id"] = baseConnectionID
+	} else {
+		if baseConnectionID != "" {
+			return fmt.Errorf("base_connection_id must not be specified for network type unbound_gre_tunnel")
+		}
+		if baseNetworkType != "classic" && baseNetworkType != "power_virtual_server" {
+			return fmt.Errorf("base_network_type must be classic or power_virtual_server")
+		}
+		connection["base_network_type"] = baseNetworkType
+	}
+
+	zone, _ := body["zone"].(map[string]interface{})
+	if name, _ := zone["name"].(string); name == "" {
+		return fmt.Errorf("zone is required for network type %s", networkType)
+	}
+	connection["zone"] = object{"name": zone["name"]}
+	ips := map[string]net.IP{}
+	for _, k := range []string{"local_gateway_ip", "remote_gateway_ip", "local_tunnel_ip", "remote_tunnel_ip"} {
+		s, _ := body[k].(string)
+		ip := net.ParseIP(s).To4()
+		if ip == nil {
+			return fmt.Errorf("%s %q is not an IPv4 address", k, s)
+		}
+		ips[k] = ip
+		connection[k] = s
+	}
+	linkLocal := &net.IPNet{IP: net.IPv4(169, 254, 0, 0), Mask: net.CIDRMask(16, 32)}
+	tunnel := &net.IPNet{IP: ips["local_tunnel_ip"].Mask(net.CIDRMask(30, 32)), Mask: net.CIDRMask(30, 32)}
+	if !linkLocal.Contains(ips["local_tunnel_ip"]) || !tunnel.Contains(ips["remote_tunnel_ip"]) || ips["local_tunnel_ip"].Equal(ips["remote_tunnel_ip"]) {
+		return fmt.Errorf("the tunnel IP addresses must be distinct link local addresses in the same /30 subnet")
+	}
+	remoteASN := 64999.0
+	if v, ok := body["remote_bgp_asn"].(float64); ok {
+		remoteASN = v
+	}
+	if remoteASN == tgLocalBGPASN {
+		return fmt.Errorf("remote_bgp_asn must differ from the transit gateway ASN %d", tgLocalBGPASN)
+	}
+	connection["remote_bgp_asn"] = remoteASN
+	connection["local_bgp_asn"] = tgLocalBGPASN
+	connection["mtu"] = 9000
+	return nil
+}
+
+func (t *transitGateway) servePrefixFilters(w http.ResponseWriter, r *http.Request, connection object, path []string) {
+	id := connection["id"].(string)
+	filters := connection["prefix_filters"].([]object)
+	if len(path) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, object{"prefix_filters": prefixFilterViews(filters)})
+		case http.MethodPost:
+			body := object{}
+			if err := readJSON(r, &body); err != nil {
+				writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+				return
+			}
+			now := timestamp()
+			filter := object{"id": t.server.newID()[5:], "created_at": now, "updated_at": now}
+			if err := setPrefixFilter(filter, body); err != nil {
+				writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+				return
+			}
+			before, _ := body["before"].(string)
+			filters, err := insertPrefixFilter(filters, filter, before)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+				return
+			}
+			connection["prefix_filters"] = filters
+			t.connections.add(id, connection)
+			writeJSON(w, http.StatusCreated, prefixFilterView(filters, filter["id"].(string)))
+		default:
+			notFound(w, r)
+		}
+		return
+	}
+	index := -1
+	for i, f := range filters {
+		if f["id"] == path[0] {
+			index = i
+		}
+	}
+	if len(path) != 1 || index < 0 {
+		writeError(w, http.StatusNotFound, "not_found", "Prefix filter %s not found", path[0])
+		return
+	}
+	filter := copyObject(filters[index])
+	rest := append(filters[:index:index], filters[index+1:]...)
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, prefixFilterView(filters, path[0]))
+	case http.MethodPatch:
+		body := object{}
+		if err := readJSON(r, &body); err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+			return
+		}
+		for _, k := range []string{"action", "prefix", "ge", "le"} {
+			if _, ok := body[k]; !ok {
+				body[k] = filter[k]
+			}
+		}
+		if err := setPrefixFilter(filter, body); err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+			return
+		}
+		filter["updated_at"] = timestamp()
+		before, ok := body["before"].(string)
+		if !ok && index+1 < len(filters) {
+			before = filters[index+1]["id"].(string)
+		}
+		updated, err := insertPrefixFilter(rest, filter, before)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+			return
+		}
+		connection["prefix_filters"] = updated
+		t.connections.add(id, connection)
+		writeJSON(w, http.StatusOK, prefixFilterView(updated, path[0]))
+	case http.MethodDelete:
+		connection["prefix_filters"] = rest
+		t.connections.add(id, connection)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		notFound(w, r)
+	}
+}
+
+// setPrefixFilter sets the action, prefix, ge and le of the body on the filter. The
+// ge and le bounds are removed when they are zero or absent.
+func setPrefixFilter(filter object, body object) error {
+	action, _ := body["action"].(string)
+	if action != "permit" && action != "deny" {
+		return fmt.Errorf("action must be permit or deny")
+	}
+	prefix, _ := body["prefix"].(string)
+	_, network, err := net.ParseCIDR(prefix)
+	if err != nil || network.IP.To4() == nil {
+		return fmt.Errorf("prefix %q is not an IPv4 CIDR block", prefix)
+	}
+	length, _ := network.Mask.Size()
+	ge, _ := body["ge"].(float64)
+	le, _ := body["le"].(float64)
+	switch {
+	case ge != 0 && (int(ge) < length || ge > 32):
+		return fmt.Errorf("ge must be between the prefix length %d and 32", length)
+	case le != 0 && (int(le) < length || le > 32):
+		return fmt.Errorf("le must be between the prefix length %d and 32", length)
+	case ge != 0 && le != 0 && ge > le:
+		return fmt.Errorf("ge must not be greater than le")
+	}
+	filter["action"] = action
+	filter["prefix"] = prefix
+	delete(filter, "ge")
+	delete(filter, "le")
+	if ge != 0 {
+		filter["ge"] = ge
+	}
+	if le != 0 {
+		filter["le"] = le
+	}
+	return nil
+}
+
+// insertPrefixFilter returns the filters with the filter inserted before the filter
+// with the given ID, or last when before is empty.
+func insertPrefixFilter(filters []object, filter object, before string) ([]object, error) {
+	if before == "" {
+		return append(filters[:len(filters):len(filters)], filter), nil
+	}
+	for i, f := range filters {
+		if f["id"] == before {
+			result := make([]object, 0, len(filters)+1)
+			result = append(result, filters[:i]...)
+			result = append(result, filter)
+			return append(result, filters[i:]...), nil
+		}
+	}
+	return nil, fmt.Errorf("before %q is not a prefix filter of the connection", before)
+}
+
+// prefixFilterViews returns the filters in order, each with the ID of the filter it
+// precedes in before.
+func prefixFilterViews(filters []object) []object {
+	views := make([]object, 0, len(filters))
+	for i, f := range filters {
+		view := copyObject(f)
+		if i+1 < len(filters) {
+			view["before"] = filters[i+1]["id"]
+		}
+		views = append(views, view)
+	}
+	return views
+}
+
+func prefixFilterView(filters []object, id string) object {
+	for _, view := range prefixFilterViews(filters) {
+		if view["id"] == id {
+			return view
+		}
+	}
+	return nil
+}
+
+// prefixFilterPermits returns whether the first prefix filter matching the route,
+// or the default action when none does, permits it.
+func prefixFilterPermits(connection object, route *net.IPNet) bool {
+	length, _ := route.Mask.Size()
+	for _, f := range connection["prefix_filters"].([]object) {
+		_, network, _ := net.ParseCIDR(f["prefix"].(string))
+		prefixLength, _ := network.Mask.Size()
+		if !network.Contains(route.IP) || length < prefixLength {
+			continue
+		}
+		ge, _ := f["ge"].(float64)
+		le, _ := f["le"].(float64)
+		matches := length == prefixLength
+		if ge != 0 || le != 0 {
+			matches = (ge == 0 || length >= int(ge)) && (le == 0 || length <= int(le))
+		}
+		if matches {
+			return f["action"] == "permit"
+		}
+	}
+	return connection["prefix_filters_default"] == "permit"
+}
+
+func (t *transitGateway) serveReports(w http.ResponseWriter, r *http.Request, gateway object, path []string) {
+	gatewayID := gateway["id"].(string)
+	if len(path) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			reports := t.reports.list(func(o object) bool { return o["transit_gateway_id"] == gatewayID })
+			views := []object{}
+			for _, report := range reports {
+				views = append(views, reportView(report))
+			}
+			writeJSON(w, http.StatusOK, object{"route_reports": views})
+		case http.MethodPost:
+			id := t.server.newID()[5:]
+			now := timestamp()
+			report := object{
+				"id":                 id,
+				"transit_gateway_id": gatewayID,
+				"status":             "pending",
+				"created_at":         now,
+				"updated_at":         now,
+			}
+			writeJSON(w, http.StatusAccepted, reportView(t.reports.add(id, report)))
+		default:
+			notFound(w, r)
+		}
+		return
+	}
+	report, ok := t.reports.get(path[0])
+	if !ok || len(path) != 1 || report["transit_gateway_id"] != gatewayID {
+		writeError(w, http.StatusNotFound, "not_found", "Route report %s not found", path[0])
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		if report["status"] == "pending" {
+			t.generateReport(report)
+			report = t.reports.add(path[0], report)
+		}
+		writeJSON(w, http.StatusOK, reportView(report))
+	case http.MethodDelete:
+		t.reports.remove(path[0])
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		notFound(w, r)
+	}
+}
+
+func reportView(report object) object {
+	view := copyObject(report)
+	delete(view, "transit_gateway_id")
+	return view
+}
+
+type tgRoute struct {
+	connectionID string
+	network      *net.IPNet
+}
+
+// generateReport completes the report with the routes the connections of the gateway
+// advertise and their prefix filters permit. The routes learned over BGP from a GRE
+// tunnel are reported as bgps, the others as routes, and the routes of different
+// connections with overlapping prefixes are reported together.
+func (t *transitGateway) generateReport(report object) {
+	connections := []object{}
+	routes := []tgRoute{}
+	for _, c := range t.gatewayConnections(report["transit_gateway_id"].(string)) {
+		if c["status"] != "attached" {
+			continue
+		}
+		networkType := c["network_type"].(string)
+		view := object{"id": c["id"], "name": c["name"], "type": networkType, "routes": []object{}, "bgps": []object{}}
+		for _, prefix := range tgAdvertisedRoutes[networkType] {
+			_, network, _ := net.ParseCIDR(prefix)
+			if !prefixFilterPermits(c, network) {
+				continue
+			}
+			routes = append(routes, tgRoute{c["id"].(string), network})
+			if strings.HasSuffix(networkType, "gre_tunnel") {
+				view["bgps"] = append(view["bgps"].([]object), object{
+					"prefix":           prefix,
+					"as_path":          fmt.Sprintf("(%v)", c["remote_bgp_asn"]),
+					"is_used":          true,
+					"local_preference": "190",
+				})
+			} else {
+				view["routes"] = append(view["routes"].([]object), object{"prefix": prefix})
+			}
+		}
+		connections = append(connections, view)
+	}
+
+	overlapping := []object{}
+	for i, a := range routes {
+		for _, b := range routes[i+1:] {
+			if a.connectionID != b.connectionID && (a.network.Contains(b.network.IP) || b.network.Contains(a.network.IP)) {
+				overlapping = append(overlapping, object{"routes": []object{
+					{"connection_id": a.connectionID, "prefix": a.network.String()},
+					{"connection_id": b.connectionID, "prefix": b.network.String()},
+				}})
+			}
+		}
+	}
+	report["status"] = "complete"
+	report["connections"] = connections
+	report["overlapping_routes"] = overlapping
+	report["updated_at"] = timestamp()
+}
@@ -0,0 +1,551 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package mockserver
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+const defaultResourceGroupID = "mockserver-default-resource-group"
+
+// vpc serves the VPC API for VPCs, subnets and the default security group, network
+// ACL and routing table created with every VPC, the rules of the network ACLs, and
+// the volumes, snapshots, instance templates, instances and floating IPs of
+// vpc_compute.go. Every resource is available as soon as it is created and gone as
+// soon as it is deleted.
+type vpc struct {
+	server            *Server
+	vpcs              *collection
+	subnets           *collection
+	securityGroups    *collection
+	networkACLs       *collection
+	routingTables     *collection
+	volumes           *collection
+	snapshots         *collection
+	instances         *collection
+	instanceTemplates *collection
+	floatingIPs       *collection
+	computeMu         sync.Mutex
+
+	// aclRules holds the rules of each network ACL in evaluation order.
+	aclRulesMu sync.Mutex
+	aclRules   map[string][]object
+}
+
+func newVPC(s *Server) *vpc {
+	return &vpc{
+		server:            s,
+		vpcs:              newCollection(),
+		subnets:           newCollection(),
+		securityGroups:    newCollection(),
+		networkACLs:       newCollection(),
+		routingTables:     newCollection(),
+		volumes:           newCollection(),
+		snapshots:         newCollection(),
+		instances:         newCollection(),
+		instanceTemplates: newCollection(),
+		floatingIPs:       newCollection(),
+		aclRules:          make(map[string][]object),
+	}
+}
+
+func (v *vpc) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, basePaths[ServiceVPC]), "/")
+	parts := strings.Split(path, "/")
+	switch {
+	case path == "vpcs":
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, v.page(r, "vpcs", v.vpcs.list(nil), v.renderVPC))
+		case http.MethodPost:
+			v.createVPC(w, r)
+		default:
+			notFound(w, r)
+		}
+	case len(parts) == 2 && parts[0] == "vpcs":
+		v.serveItem(w, r, v.vpcs, parts[1], v.renderVPC, v.deleteVPC)
+	case len(parts) == 4 && parts[0] == "vpcs" && parts[2] == "routing_tables":
+		v.serveItem(w, r, v.routingTables, parts[3], nil, nil)
+	case path == "subnets":
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, v.page(r, "subnets", v.subnets.list(nil), nil))
+		case http.MethodPost:
+			v.createSubnet(w, r)
+		default:
+			notFound(w, r)
+		}
+	case len(parts) == 2 && parts[0] == "subnets":
+		v.serveItem(w, r, v.subnets, parts[1], nil, v.deleteSubnet)
+	case path == "security_groups" && r.Method == http.MethodGet:
+		vpcID := r.URL.Query().Get("vpc.id")
+		groups := v.securityGroups.list(func(o object) bool {
+			return vpcID == "" || o["vpc"].(object)["id"] == vpcID
+		})
+		writeJSON(w, http.StatusOK, v.page(r, "security_groups", groups, nil))
+	case len(parts) == 2 && parts[0] == "security_groups":
+		v.serveItem(w, r, v.securityGroups, parts[1], nil, nil)
+	case len(parts) == 2 && parts[0] == "network_acls":
+		v.serveItem(w, r, v.networkACLs, parts[1], v.renderNetworkACL, nil)
+	case len(parts) >= 3 && parts[0] == "network_acls" && parts[2] == "rules":
+		if _, ok := v.networkACLs.get(parts[1]); !ok {
+			writeError(w, http.StatusNotFound, "not_found", "Network ACL %s not found", parts[1])
+			return
+		}
+		if len(parts) == 3 {
+			v.serveNetworkACLRules(w, r, parts[1])
+		} else if len(parts) == 4 {
+			v.serveNetworkACLRule(w, r, parts[1], parts[3])
+		} else {
+			notFound(w, r)
+		}
+	default:
+		if !v.serveCompute(w, r, parts) {
+			notFound(w, r)
+		}
+	}
+}
+
+// serveItem serves GET, PATCH and, when remove is set, DELETE of a single resource.
+func (v *vpc) serveItem(w http.ResponseWriter, r *http.Request, c *collection, id string, render func(object) object, remove func(http.ResponseWriter, object)) {
+	if render == nil {
+		render = func(o object) object { return o }
+	}
+	o, ok := c.get(id)
+	if !ok {
+		writeError(w, http.StatusNotFound, "not_found", "Resource %s not found", id)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, render(o))
+	case http.MethodPatch:
+		patch := object{}
+		if err := readJSON(r, &patch); err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+			return
+		}
+		for _, k := range []string{"id", "crn", "href", "created_at", "vpc"} {
+			delete(patch, k)
+		}
+		o, _ = c.update(id, patch)
+		writeJSON(w, http.StatusOK, render(o))
+	case http.MethodDelete:
+		if remove == nil {
+			notFound(w, r)
+			return
+		}
+		remove(w, o)
+	default:
+		notFound(w, r)
+	}
+}
+
+func (v *vpc) page(r *http.Request, key string, items []object, render func(object) object) object {
+	if render != nil {
+		for i, o := range items {
+			items[i] = render(o)
+		}
+	}
+	return object{
+		key:           items,
+		"first":       object{"href": v.server.URL(ServiceVPC) + "/" + key + "?limit=50"},
+		"limit":       50,
+		"total_count": len(items),
+	}
+}
+
+func (v *vpc) href(collection, id string) string {
+	return fmt.Sprintf("%s/%s/%s", v.server.URL(ServiceVPC), collection, id)
+}
+
+func (v *vpc) resourceGroup(body object) object {
+	id := defaultResourceGroupID
+	if rg, ok := body["resource_group"].(map[string]interface{}); ok {
+		if rgID, ok := rg["id"].(string); ok && rgID != "" {
+			id = rgID
+		}
+	}
+	name := "Default"
+	if id != defaultResourceGroupID {
+		name = id
+	}
+	return object{
+		"id":   id,
+		"href": "https://resource-controller.cloud.ibm.com/v2/resource_groups/" + id,
+		"name": name,
+	}
+}
+
+func (v *vpc) createVPC(w http.ResponseWriter, r *http.Request) {
+	body := object{}
+	if err := readJSON(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+		return
+	}
+	id := v.server.newID()
+	name, _ := body["name"].(string)
+	if name == "" {
+		name = "vpc-" + id[5:13]
+	}
+	classicAccess, _ := body["classic_access"].(bool)
+	rg := v.resourceGroup(body)
+	vpcRef := object{
+		"id":   id,
+		"crn":  v.server.crn("is", v.server.Region, "vpc", id),
+		"href": v.href("vpcs", id),
+		"name": name,
+	}
+
+	aclID := v.server.newID()
+	v.networkACLs.add(aclID, object{
+		"id":             aclID,
+		"crn":            v.server.crn("is", v.server.Region, "network-acl", aclID),
+		"href":           v.href("network_acls", aclID),
+		"name":           name + "-default-network-acl",
+		"created_at":     timestamp(),
+		"resource_group": rg,
+		"rules":          []object{},
+		"subnets":        []object{},
+		"vpc":            vpcRef,
+	})
+	sgID := v.server.newID()
+	v.securityGroups.add(sgID, object{
+		"id":                 sgID,
+		"crn":                v.server.crn("is", v.server.Region, "security-group", sgID),
+		"href":               v.href("security_groups", sgID),
+		"name":               name + "-default-security-group",
+		"created_at":         timestamp(),
+		"resource_group":     rg,
+		"rules":              []object{},
+		"network_interfaces": []object{},
+		"targets":            []object{},
+		"vpc":                vpcRef,
+	})
+	rtID := v.server.newID()
+	v.routingTables.add(rtID, object{
+		"id":                            rtID,
+		"href":                          v.href("vpcs/"+id+"/routing_tables", rtID),
+		"name":                          name + "-default-routing-table",
+		"created_at":                    timestamp(),
+		"is_default":                    true,
+		"lifecycle_state":               "stable",
+		"resource_type":                 "routing_table",
+		"route_direct_link_ingress":     false,
+		"route_transit_gateway_ingress": false,
+		"route_vpc_zone_ingress":        false,
+		"routes":                        []object{},
+		"subnets":                       []object{},
+	})
+
+	o := v.vpcs.add(id, object{
+		"id":                     id,
+		"crn":                    vpcRef["crn"],
+		"href":                   vpcRef["href"],
+		"name":                   name,
+		"classic_access":         classicAccess,
+		"created_at":             timestamp(),
+		"status":                 "available",
+		"resource_group":         rg,
+		"cse_source_ips":         []object{},
+		"default_network_acl":    aclID,
+		"default_security_group": sgID,
+		"default_routing_table":  rtID,
+	})
+	writeJSON(w, http.StatusCreated, v.renderVPC(o))
+}
+
+// renderVPC replaces the IDs of the default resources by references reflecting their
+// current names.
+func (v *vpc) renderVPC(o object) object {
+	defaults := []struct {
+		key  string
+		c    *collection
+		keys []string
+	}{
+		{"default_network_acl", v.networkACLs, []string{"crn", "href", "id", "name"}},
+		{"default_security_group", v.securityGroups, []string{"crn", "href", "id", "name"}},
+		{"default_routing_table", v.routingTables, []string{"href", "id", "name", "resource_type"}},
+	}
+	for _, d := range defaults {
+		if id, ok := o[d.key].(string); ok {
+			if def, ok := d.c.get(id); ok {
+				o[d.key] = reference(def, d.keys...)
+			} else {
+				delete(o, d.key)
+			}
+		}
+	}
+	return o
+}
+
+func (v *vpc) deleteVPC(w http.ResponseWriter, o object) {
+	id := o["id"].(string)
+	if len(v.subnets.list(func(s object) bool { return s["vpc"].(object)["id"] == id })) > 0 {
+		writeError(w, http.StatusConflict, "vpc_in_use", "The VPC %s has subnets, delete them first", id)
+		return
+	}
+	v.networkACLs.remove(o["default_network_acl"].(string))
+	v.aclRulesMu.Lock()
+	delete(v.aclRules, o["default_network_acl"].(string))
+	v.aclRulesMu.Unlock()
+	v.securityGroups.remove(o["default_security_group"].(string))
+	v.routingTables.remove(o["default_routing_table"].(string))
+	v.vpcs.remove(id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (v *vpc) createSubnet(w http.ResponseWriter, r *http.Request) {
+	body := object{}
+	if err := readJSON(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+		return
+	}
+	vpcID := ""
+	if ref, ok := body["vpc"].(map[string]interface{}); ok {
+		vpcID, _ = ref["id"].(string)
+	}
+	parent, ok := v.vpcs.get(vpcID)
+	if !ok {
+		writeError(w, http.StatusNotFound, "vpc_not_found", "VPC %s not found", vpcID)
+		return
+	}
+	zone := ""
+	if ref, ok := body["zone"].(map[string]interface{}); ok {
+		zone, _ = ref["name"].(string)
+	}
+	if !strings.HasPrefix(zone, v.server.Region+"-") {
+		writeError(w, http.StatusBadRequest, "validation_invalid_argument", "Zone %q is not in region %s", zone, v.server.Region)
+		return
+	}
+
+	id := v.server.newID()
+	cidr, _ := body["ipv4_cidr_block"].(string)
+	var total int
+	if cidr != "" {
+		_, ipnet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "validation_invalid_argument", "Invalid CIDR block %q", cidr)
+			return
+		}
+		ones, bits := ipnet.Mask.Size()
+		total = 1 << uint(bits-ones)
+	} else {
+		count, _ := body["total_ipv4_address_count"].(float64)
+		total = int(count)
+		prefix := 32
+		for size := 1; size < total; size <<= 1 {
+			prefix--
+		}
+		if total < 8 || 1<<uint(32-prefix) != total {
+			writeError(w, http.StatusBadRequest, "validation_invalid_argument", "total_ipv4_address_count must be a power of two of at least 8")
+			return
+		}
+		n := len(v.subnets.list(nil)) + 1
+		if prefix >= 24 {
+			cidr = fmt.Sprintf("10.240.%d.0/%d", n, prefix)
+		} else {
+			cidr = fmt.Sprintf("10.%d.0.0/%d", n, prefix)
+		}
+	}
+
+	acl, _ := v.networkACLs.get(parent["default_network_acl"].(string))
+	if ref, ok := body["network_acl"].(map[string]interface{}); ok {
+		if found, ok := v.networkACLs.get(fmt.Sprint(ref["id"])); ok {
+			acl = found
+		}
+	}
+	rt, _ := v.routingTables.get(parent["default_routing_table"].(string))
+
+	o := v.subnets.add(id, object{
+		"id":                           id,
+		"crn":                          v.server.crn("is", zone, "subnet", id),
+		"href":                         v.href("subnets", id),
+		"name":                         body["name"],
+		"created_at":                   timestamp(),
+		"status":                       "available",
+		"ip_version":                   "ipv4",
+		"ipv4_cidr_block":              cidr,
+		"total_ipv4_address_count":     total,
+		"available_ipv4_address_count": total - 5,
+		"resource_group":               v.resourceGroup(body),
+		"network_acl":                  reference(acl, "crn", "href", "id", "name"),
+		"routing_table":                reference(rt, "href", "id", "name", "resource_type"),
+		"vpc":                          reference(parent, "crn", "href", "id", "name"),
+		"zone":                         object{"name": zone, "href": fmt.Sprintf("%s/regions/%s/zones/%s", v.server.URL(ServiceVPC), v.server.Region, zone)},
+	})
+	writeJSON(w, http.StatusCreated, o)
+}
+
+func (v *vpc) deleteSubnet(w http.ResponseWriter, o object) {
+	if v.subnetInUse(o["id"].(string)) {
+		writeError(w, http.StatusConflict, "subnet_in_use", "The subnet %s has network interfaces, delete their instances first", o["id"])
+		return
+	}
+	v.subnets.remove(o["id"].(string))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// renderNetworkACL adds the rules of the network ACL, in evaluation order.
+func (v *vpc) renderNetworkACL(o object) object {
+	v.aclRulesMu.Lock()
+	defer v.aclRulesMu.Unlock()
+	o["rules"] = v.renderNetworkACLRules(o["id"].(string))
+	return o
+}
+
+// renderNetworkACLRules returns the rules of a network ACL, each referring to the
+// rule evaluated after it. It is called with aclRulesMu held.
+func (v *vpc) renderNetworkACLRules(aclID string) []object {
+	rules := v.aclRules[aclID]
+	rendered := make([]object, len(rules))
+	for i, rule := range rules {
+		rendered[i] = copyObject(rule)
+		if i+1 < len(rules) {
+			rendered[i]["before"] = reference(rules[i+1], "href", "id", "name")
+		}
+	}
+	return rendered
+}
+
+// networkACLRuleIndex returns the position of a rule in its network ACL, -1 when
+// it doesn't exist. It is called with aclRulesMu held.
+func (v *vpc) networkACLRuleIndex(aclID, ruleID string) int {
+	for i, rule := range v.aclRules[aclID] {
+		if rule["id"] == ruleID {
+			return i
+		}
+	}
+	return -1
+}
+
+// insertNetworkACLRule inserts the rule before the rule referred to by before, at
+// the end when before is nil. It is called with aclRulesMu held.
+func (v *vpc) insertNetworkACLRule(w http.ResponseWriter, aclID string, rule object, before interface{}) bool {
+	rules := v.aclRules[aclID]
+	index := len(rules)
+	if ref, ok := before.(map[string]interface{}); ok {
+		index = v.networkACLRuleIndex(aclID, fmt.Sprint(ref["id"]))
+		if index < 0 {
+			writeError(w, http.StatusNotFound, "network_acl_rule_not_found", "Network ACL rule %v not found", ref["id"])
+			return false
+		}
+	}
+	rules = append(rules, nil)
+	copy(rules[index+1:], rules[index:])
+	rules[index] = rule
+	v.aclRules[aclID] = rules
+	return true
+}
+
+func (v *vpc) serveNetworkACLRules(w http.ResponseWriter, r *http.Request, aclID string) {
+	v.aclRulesMu.Lock()
+	defer v.aclRulesMu.Unlock()
+	switch r.Method {
+	case http.MethodGet:
+		direction := r.URL.Query().Get("direction")
+		rules := []object{}
+		for _, rule := range v.renderNetworkACLRules(aclID) {
+			if direction == "" || rule["direction"] == direction {
+				rules = append(rules, rule)
+			}
+		}
+		writeJSON(w, http.StatusOK, v.page(r, "network_acls/"+aclID+"/rules", rules, nil))
+	case http.MethodPost:
+		body := object{}
+		if err := readJSON(r, &body); err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+			return
+		}
+		for _, k := range []string{"action", "direction", "source", "destination", "protocol"} {
+			if _, ok := body[k].(string); !ok {
+				writeError(w, http.StatusBadRequest, "validation_required_field_missing", "%s is required", k)
+				return
+			}
+		}
+		id := v.server.newID()
+		rule := object{
+			"id":         id,
+			"href":       v.href("network_acls/"+aclID+"/rules", id),
+			"name":       body["name"],
+			"created_at": timestamp(),
+			"ip_version": "ipv4",
+		}
+		if rule["name"] == nil {
+			rule["name"] = "rule-" + id[5:13]
+		}
+		for _, k := range []string{"action", "direction", "source", "destination", "protocol"} {
+			rule[k] = body[k]
+		}
+		switch body["protocol"] {
+		case "tcp", "udp":
+			for k, def := range map[string]float64{"destination_port_min": 1, "destination_port_max": 65535, "source_port_min": 1, "source_port_max": 65535} {
+				rule[k] = def
+				if port, ok := body[k].(float64); ok {
+					rule[k] = port
+				}
+			}
+		case "icmp":
+			for _, k := range []string{"type", "code"} {
+				if value, ok := body[k]; ok {
+					rule[k] = value
+				}
+			}
+		}
+		if !v.insertNetworkACLRule(w, aclID, rule, body["before"]) {
+			return
+		}
+		writeJSON(w, http.StatusCreated, v.renderNetworkACLRules(aclID)[v.networkACLRuleIndex(aclID, id)])
+	default:
+		notFound(w, r)
+	}
+}
+
+func (v *vpc) serveNetworkACLRule(w http.ResponseWriter, r *http.Request, aclID, ruleID string) {
+	v.aclRulesMu.Lock()
+	defer v.aclRulesMu.Unlock()
+	index := v.networkACLRuleIndex(aclID, ruleID)
+	if index < 0 {
+		writeError(w, http.StatusNotFound, "network_acl_rule_not_found", "Network ACL rule %s not found", ruleID)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, v.renderNetworkACLRules(aclID)[index])
+	case http.MethodPatch:
+		patch := object{}
+		if err := readJSON(r, &patch); err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+			return
+		}
+		if before, ok := patch["before"].(map[string]interface{}); ok {
+			if fmt.Sprint(before["id"]) == ruleID || v.networkACLRuleIndex(aclID, fmt.Sprint(before["id"])) < 0 {
+				writeError(w, http.StatusBadRequest, "network_acl_rule_before_invalid", "Network ACL rule %v can't be before rule %s", before["id"], ruleID)
+				return
+			}
+		}
+		rule := v.aclRules[aclID][index]
+		for _, k := range []string{"name", "action", "direction", "source", "destination", "destination_port_min", "destination_port_max", "source_port_min", "source_port_max", "type", "code"} {
+			if value, ok := patch[k]; ok {
+				rule[k] = value
+			}
+		}
+		if before, ok := patch["before"].(map[string]interface{}); ok {
+			rules := v.aclRules[aclID]
+			v.aclRules[aclID] = append(rules[:index:index], rules[index+1:]...)
+			v.insertNetworkACLRule(w, aclID, rule, before)
+		}
+		writeJSON(w, http.StatusOK, v.renderNetworkACLRules(aclID)[v.networkACLRuleIndex(aclID, ruleID)])
+	case http.MethodDelete:
+		rules := v.aclRules[aclID]
+		v.aclRules[aclID] = append(rules[:index:index], rules[index+1:]...)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		notFound(w, r)
+	}
+}
@@ -0,0 +1,1262 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package mockserver
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// The compute part of the VPC stand-in serves volumes, snapshots, instance templates,
+// instances with their network interfaces and volume attachments, and the floating
+// IPs bound to the network interfaces. Instances run as soon as they are created
+// and take their actions at once. An instance boots
+// from an image, from a snapshot or from an existing volume. The boot volume of an
+// image or a snapshot is created with the instance. The properties of the source
+// template of an instance are the defaults of its prototype.
+//
+// The network interfaces and volume attachments are held by their instance, the
+// volume attachments refer to their volume by volume_id. A floating IP refers to the
+// network interface it is bound to by its target, a network interface can have one
+// floating IP. computeMu serializes the changes to the volumes, the snapshots, the
+// instances and the floating IPs.
+
+// volumeIOPSPerGB holds the IOPS of the tiered volume profiles.
+var volumeIOPSPerGB = map[string]int{
+	"general-purpose": 3,
+	"5iops-tier":      5,
+	"10iops-tier":     10,
+}
+
+// serveCompute serves the paths under volumes, snapshots, instance/templates,
+// instances and floating_ips, and the network interfaces of the security groups, it
+// reports whether the path is one of them.
+func (v *vpc) serveCompute(w http.ResponseWriter, r *http.Request, parts []string) bool {
+	switch {
+	case len(parts) == 1 && parts[0] == "volumes":
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, v.page(r, "volumes", v.volumes.list(nil), v.renderVolume))
+		case http.MethodPost:
+			v.createVolume(w, r)
+		default:
+			notFound(w, r)
+		}
+	case len(parts) == 2 && parts[0] == "volumes":
+		v.serveItem(w, r, v.volumes, parts[1], v.renderVolume, v.deleteVolume)
+	case len(parts) == 1 && parts[0] == "snapshots":
+		switch r.Method {
+		case http.MethodGet:
+			q := r.URL.Query()
+			snapshots := v.snapshots.list(func(o object) bool {
+				return (q.Get("name") == "" || o["name"] == q.Get("name")) &&
+					(q.Get("source_volume.id") == "" || o["source_volume"].(object)["id"] == q.Get("source_volume.id")) &&
+					(q.Get("resource_group.id") == "" || o["resource_group"].(object)["id"] == q.Get("resource_group.id"))
+			})
+			writeJSON(w, http.StatusOK, v.page(r, "snapshots", snapshots, nil))
+		case http.MethodPost:
+			v.createSnapshot(w, r)
+		default:
+			notFound(w, r)
+		}
+	case len(parts) == 2 && parts[0] == "snapshots":
+		v.serveItem(w, r, v.snapshots, parts[1], nil, func(w http.ResponseWriter, o object) {
+			v.snapshots.remove(o["id"].(string))
+			w.WriteHeader(http.StatusNoContent)
+		})
+	case len(parts) == 2 && parts[0] == "instance" && parts[1] == "templates":
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, v.page(r, "templates", v.instanceTemplates.list(nil), nil))
+		case http.MethodPost:
+			v.createInstanceTemplate(w, r)
+		default:
+			notFound(w, r)
+		}
+	case len(parts) == 3 && parts[0] == "instance" && parts[1] == "templates":
+		v.serveItem(w, r, v.instanceTemplates, parts[2], nil, func(w http.ResponseWriter, o object) {
+			v.instanceTemplates.remove(o["id"].(string))
+			w.WriteHeader(http.StatusNoContent)
+		})
+	case len(parts) == 1 && parts[0] == "floating_ips":
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, v.page(r, "floating_ips", v.floatingIPs.list(nil), nil))
+		case http.MethodPost:
+			v.createFloatingIP(w, r)
+		default:
+			notFound(w, r)
+		}
+	case len(parts) == 2 && parts[0] == "floating_ips":
+		v.serveItem(w, r, v.floatingIPs, parts[1], nil, func(w http.ResponseWriter, o object) {
+			v.floatingIPs.remove(o["id"].(string))
+			w.WriteHeader(http.StatusNoContent)
+		})
+	case len(parts) == 4 && parts[0] == "security_groups" && parts[2] == "network_interfaces":
+		v.computeMu.Lock()
+		defer v.computeMu.Unlock()
+		v.serveSecurityGroupNetworkInterface(w, r, parts[1], parts[3])
+	case len(parts) == 1 && parts[0] == "instances":
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, v.page(r, "instances", v.instances.list(nil), v.renderInstance))
+		case http.MethodPost:
+			v.createInstance(w, r)
+		default:
+			notFound(w, r)
+		}
+	case len(parts) >= 2 && parts[0] == "instances":
+		v.computeMu.Lock()
+		defer v.computeMu.Unlock()
+		instance, ok := v.instances.get(parts[1])
+		if !ok {
+			writeError(w, http.StatusNotFound, "instance_not_found", "Instance %s not found", parts[1])
+			return true
+		}
+		switch {
+		case len(parts) == 2:
+			v.serveInstance(w, r, instance)
+		case len(parts) == 3 && parts[2] == "actions" && r.Method == http.MethodPost:
+			v.createInstanceAction(w, r, instance)
+		case len(parts) == 3 && parts[2] == "network_interfaces":
+			v.serveInstanceNetworkInterfaces(w, r, instance)
+		case len(parts) == 4 && parts[2] == "network_interfaces":
+			v.serveInstanceNetworkInterface(w, r, instance, parts[3])
+		case len(parts) >= 5 && len(parts) <= 6 && parts[2] == "network_interfaces" && parts[4] == "floating_ips":
+			fipID := ""
+			if len(parts) == 6 {
+				fipID = parts[5]
+			}
+			v.serveNetworkInterfaceFloatingIPs(w, r, instance, parts[3], fipID)
+		case len(parts) == 3 && parts[2] == "volume_attachments":
+			v.serveInstanceVolumeAttachments(w, r, instance)
+		case len(parts) == 4 && parts[2] == "volume_attachments":
+			v.serveInstanceVolumeAttachment(w, r, instance, parts[3])
+		default:
+			notFound(w, r)
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+func (v *vpc) createVolume(w http.ResponseWriter, r *http.Request) {
+	body := object{}
+	if err := readJSON(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+		return
+	}
+	zone := ""
+	if ref, ok := body["zone"].(map[string]interface{}); ok {
+		zone, _ = ref["name"].(string)
+	}
+	if !strings.HasPrefix(zone, v.server.Region+"-") {
+		writeError(w, http.StatusBadRequest, "validation_invalid_argument", "Zone %q is not in region %s", zone, v.server.Region)
+		return
+	}
+	v.computeMu.Lock()
+	defer v.computeMu.Unlock()
+	var snapshot object
+	if ref, ok := body["source_snapshot"].(map[string]interface{}); ok {
+		if snapshot, ok = v.snapshots.get(fmt.Sprint(ref["id"])); !ok {
+			writeError(w, http.StatusNotFound, "snapshot_not_found", "Snapshot %v not found", ref["id"])
+			return
+		}
+	}
+	o, err := v.newVolume(body, zone, snapshot, nil)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "validation_invalid_argument", "%s", err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, v.renderVolume(o))
+}
+
+// newVolume adds a volume created from the prototype, restoring the snapshot or
+// the image when set. It is called with computeMu held.
+func (v *vpc) newVolume(prototype object, zone string, snapshot, image object) (object, error) {
+	id := v.server.newID()
+	name, _ := prototype["name"].(string)
+	if name == "" {
+		name = "volume-" + id[5:13]
+	}
+	profile := "general-purpose"
+	if ref, ok := prototype["profile"].(map[string]interface{}); ok {
+		if n, ok := ref["name"].(string); ok && n != "" {
+			profile = n
+		}
+	}
+	capacity := 100
+	if snapshot != nil {
+		capacity = toInt(snapshot["minimum_capacity"])
+	}
+	if c, ok := prototype["capacity"].(float64); ok {
+		if snapshot != nil && int(c) < capacity {
+			return nil, fmt.Errorf("The capacity %d is smaller than the minimum capacity %d of snapshot %s", int(c), capacity, snapshot["id"])
+		}
+		capacity = int(c)
+	}
+	iops := capacity * volumeIOPSPerGB[profile]
+	if i, ok := prototype["iops"].(float64); ok {
+		iops = int(i)
+	}
+	if iops < 100 {
+		iops = 100
+	}
+	volume := object{
+		"id":             id,
+		"crn":            v.server.crn("is", zone, "volume", id),
+		"href":           v.href("volumes", id),
+		"name":           name,
+		"created_at":     timestamp(),
+		"status":         "available",
+		"status_reasons": []object{},
+		"capacity":       capacity,
+		"iops":           iops,
+		"encryption":     "provider_managed",
+		"profile":        object{"name": profile, "href": v.href("volume/profiles", profile)},
+		"zone":           object{"name": zone, "href": fmt.Sprintf("%s/regions/%s/zones/%s", v.server.URL(ServiceVPC), v.server.Region, zone)},
+		"resource_group": v.resourceGroup(prototype),
+	}
+	if key, ok := prototype["encryption_key"].(map[string]interface{}); ok {
+		volume["encryption"] = "user_managed"
+		volume["encryption_key"] = object{"crn": key["crn"]}
+	}
+	if snapshot != nil {
+		volume["source_snapshot"] = reference(snapshot, "crn", "href", "id", "name")
+		for _, k := range []string{"operating_system", "source_image"} {
+			if value, ok := snapshot[k]; ok {
+				volume[k] = value
+			}
+		}
+	}
+	if image != nil {
+		volume["source_image"] = image
+		volume["operating_system"] = object{"name": "mock-os", "href": v.href("operating_systems", "mock-os")}
+	}
+	return v.volumes.add(id, volume), nil
+}
+
+// renderVolume adds the attachments of the volume to its instances.
+func (v *vpc) renderVolume(o object) object {
+	attachments := []object{}
+	for _, instance := range v.instances.list(nil) {
+		for _, a := range instance["volume_attachments"].([]object) {
+			if a["volume_id"] == o["id"] {
+				ref := reference(a, "delete_volume_on_instance_delete", "device", "href", "id", "name", "type")
+				ref["instance"] = reference(instance, "crn", "href", "id", "name")
+				attachments = append(attachments, ref)
+			}
+		}
+	}
+	o["volume_attachments"] = attachments
+	return o
+}
+
+func (v *vpc) deleteVolume(w http.ResponseWriter, o object) {
+	v.computeMu.Lock()
+	defer v.computeMu.Unlock()
+	if len(v.renderVolume(o)["volume_attachments"].([]object)) > 0 {
+		writeError(w, http.StatusConflict, "volume_in_use", "The volume %s is attached to an instance, detach it first", o["id"])
+		return
+	}
+	v.volumes.remove(o["id"].(string))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (v *vpc) createSnapshot(w http.ResponseWriter, r *http.Request) {
+	body := object{}
+	if err := readJSON(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+		return
+	}
+	v.computeMu.Lock()
+	defer v.computeMu.Unlock()
+	volumeID := ""
+	if ref, ok := body["source_volume"].(map[string]interface{}); ok {
+		volumeID = fmt.Sprint(ref["id"])
+	}
+	volume, ok := v.volumes.get(volumeID)
+	if !ok {
+		writeError(w, http.StatusNotFound, "volume_not_found", "Volume %s not found", volumeID)
+		return
+	}
+	id := v.server.newID()
+	name, _ := body["name"].(string)
+	if name == "" {
+		name = "snapshot-" + id[5:13]
+	}
+	_, bootable := volume["operating_system"]
+	snapshot := object{
+		"id":               id,
+		"crn":              v.server.crn("is", v.server.Region, "snapshot", id),
+		"href":             v.href("snapshots", id),
+		"name":             name,
+		"created_at":       timestamp(),
+		"lifecycle_state":  "stable",
+		"resource_type":    "snapshot",
+		"bootable":         bootable,
+		"deletable":        true,
+		"encryption":       volume["encryption"],
+		"minimum_capacity": volume["capacity"],
+		"size":             volume["capacity"],
+		"resource_group":   v.resourceGroup(body),
+		"source_volume":    reference(volume, "crn", "href", "id", "name"),
+	}
+	for _, k := range []string{"encryption_key", "operating_system", "source_image"} {
+		if value, ok := volume[k]; ok {
+			snapshot[k] = value
+		}
+	}
+	writeJSON(w, http.StatusCreated, v.snapshots.add(id, snapshot))
+}
+
+func (v *vpc) createInstance(w http.ResponseWriter, r *http.Request) {
+	body := object{}
+	if err := readJSON(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+		return
+	}
+	// The properties of the source template are the defaults of the prototype.
+	if ref, ok := body["source_template"].(map[string]interface{}); ok {
+		template, ok := v.instanceTemplates.get(fmt.Sprint(ref["id"]))
+		if !ok {
+			writeError(w, http.StatusNotFound, "instance_template_not_found", "Instance template %v not found", ref["id"])
+			return
+		}
+		for k, value := range template {
+			switch k {
+			case "id", "crn", "href", "created_at", "name", "resource_group":
+				continue
+			}
+			if _, ok := body[k]; !ok {
+				body[k] = cloneJSON(value)
+			}
+		}
+		delete(body, "source_template")
+	}
+	vpcID, zone, profile := "", "", ""
+	if ref, ok := body["vpc"].(map[string]interface{}); ok {
+		vpcID, _ = ref["id"].(string)
+	}
+	if ref, ok := body["zone"].(map[string]interface{}); ok {
+		zone, _ = ref["name"].(string)
+	}
+	if ref, ok := body["profile"].(map[string]interface{}); ok {
+		profile, _ = ref["name"].(string)
+	}
+	parent, ok := v.vpcs.get(vpcID)
+	if !ok {
+		writeError(w, http.StatusNotFound, "vpc_not_found", "VPC %s not found", vpcID)
+		return
+	}
+	if !strings.HasPrefix(zone, v.server.Region+"-") {
+		writeError(w, http.StatusBadRequest, "validation_invalid_argument", "Zone %q is not in region %s", zone, v.server.Region)
+		return
+	}
+	if profile == "" {
+		writeError(w, http.StatusBadRequest, "validation_required_field_missing", "profile is required")
+		return
+	}
+	primary, ok := body["primary_network_interface"].(map[string]interface{})
+	if !ok {
+		writeError(w, http.StatusBadRequest, "validation_required_field_missing", "primary_network_interface is required")
+		return
+	}
+
+	v.computeMu.Lock()
+	defer v.computeMu.Unlock()
+
+	// The boot volume comes from exactly one of the image, the source snapshot
+	// and the existing volume.
+	var image, snapshot, bootVolume object
+	sources := 0
+	if ref, ok := body["image"].(map[string]interface{}); ok {
+		image = object{"id": ref["id"], "name": ref["id"], "href": v.href("images", fmt.Sprint(ref["id"]))}
+		image["crn"] = v.server.crn("is", v.server.Region, "image", fmt.Sprint(ref["id"]))
+		sources++
+	}
+	attachment, _ := body["boot_volume_attachment"].(map[string]interface{})
+	volumePrototype := object{}
+	if ref, ok := attachment["volume"].(map[string]interface{}); ok {
+		volumePrototype = ref
+	}
+	if ref, ok := volumePrototype["source_snapshot"].(map[string]interface{}); ok {
+		if snapshot, ok = v.snapshots.get(fmt.Sprint(ref["id"])); !ok {
+			writeError(w, http.StatusNotFound, "snapshot_not_found", "Snapshot %v not found", ref["id"])
+			return
+		}
+		if snapshot["bootable"] != true {
+			writeError(w, http.StatusBadRequest, "snapshot_not_bootable", "Snapshot %s has no operating system", snapshot["id"])
+			return
+		}
+		sources++
+	}
+	if volumeID, ok := volumePrototype["id"].(string); ok {
+		if bootVolume, ok = v.volumes.get(volumeID); !ok {
+			writeError(w, http.StatusNotFound, "volume_not_found", "Volume %s not found", volumeID)
+			return
+		}
+		if _, ok := bootVolume["operating_system"]; !ok {
+			writeError(w, http.StatusBadRequest, "volume_not_bootable", "Volume %s has no operating system", volumeID)
+			return
+		}
+		if len(v.renderVolume(bootVolume)["volume_attachments"].([]object)) > 0 {
+			writeError(w, http.StatusConflict, "volume_in_use", "The volume %s is attached to an instance", volumeID)
+			return
+		}
+		if bootVolume["zone"].(object)["name"] != zone {
+			writeError(w, http.StatusBadRequest, "validation_invalid_argument", "Volume %s is not in zone %s", volumeID, zone)
+			return
+		}
+		sources++
+	}
+	if sources != 1 {
+		writeError(w, http.StatusBadRequest, "validation_invalid_argument", "Exactly one of image, boot_volume_attachment.volume.source_snapshot and boot_volume_attachment.volume.id must be set")
+		return
+	}
+
+	// The data volumes are existing volumes or created with the instance.
+	var dataPrototypes []object
+	var dataVolumes []object
+	if prototypes, ok := body["volume_attachments"].([]interface{}); ok {
+		for _, p := range prototypes {
+			prototype, _ := p.(map[string]interface{})
+			volumePrototype, _ := prototype["volume"].(map[string]interface{})
+			var volume object
+			if volumeID, ok := volumePrototype["id"].(string); ok {
+				if volume, ok = v.volumes.get(volumeID); !ok {
+					writeError(w, http.StatusNotFound, "volume_not_found", "Volume %s not found", volumeID)
+					return
+				}
+				if len(v.renderVolume(volume)["volume_attachments"].([]object)) > 0 {
+					writeError(w, http.StatusConflict, "volume_in_use", "The volume %s is attached to an instance", volumeID)
+					return
+				}
+			}
+			dataPrototypes = append(dataPrototypes, prototype)
+			dataVolumes = append(dataVolumes, volume)
+		}
+	}
+
+	id := v.server.newID()
+	name, _ := body["name"].(string)
+	if name == "" {
+		name = "instance-" + id[5:13]
+	}
+	nics := []object{}
+	nicPrototypes := []interface{}{primary}
+	if others, ok := body["network_interfaces"].([]interface{}); ok {
+		nicPrototypes = append(nicPrototypes, others...)
+	}
+	for i, p := range nicPrototypes {
+		prototype, _ := p.(map[string]interface{})
+		nic, err := v.newNetworkInterface(parent, zone, id, prototype, i, nics)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "validation_invalid_argument", "%s", err)
+			return
+		}
+		nics = append(nics, nic)
+	}
+
+	deleteVolume := bootVolume == nil
+	if value, ok := attachment["delete_volume_on_instance_delete"].(bool); ok {
+		deleteVolume = value
+	}
+	if bootVolume == nil {
+		if _, ok := volumePrototype["name"].(string); !ok {
+			volumePrototype["name"] = name + "-boot"
+		}
+		var err error
+		if bootVolume, err = v.newVolume(volumePrototype, zone, snapshot, image); err != nil {
+			writeError(w, http.StatusBadRequest, "validation_invalid_argument", "%s", err)
+			return
+		}
+	}
+	attachments := []object{v.newVolumeAttachment(id, attachment, bootVolume["id"].(string), "boot", deleteVolume)}
+	for i, prototype := range dataPrototypes {
+		volume := dataVolumes[i]
+		if volume == nil {
+			volumePrototype, _ := prototype["volume"].(map[string]interface{})
+			var err error
+			if volume, err = v.newVolume(volumePrototype, zone, nil, nil); err != nil {
+				writeError(w, http.StatusBadRequest, "validation_invalid_argument", "%s", err)
+				return
+			}
+		}
+		deleteVolume, _ := prototype["delete_volume_on_instance_delete"].(bool)
+		attachments = append(attachments, v.newVolumeAttachment(id, prototype, volume["id"].(string), "data", deleteVolume))
+	}
+
+	vcpu, memory := 2, 8
+	if i := strings.LastIndex(profile, "-"); i >= 0 {
+		if sizes := strings.Split(profile[i+1:], "x"); len(sizes) == 2 {
+			if c, err := strconv.Atoi(sizes[0]); err == nil {
+				vcpu = c
+			}
+			if m, err := strconv.Atoi(sizes[1]); err == nil {
+				memory = m
+			}
+		}
+	}
+	instance := object{
+		"id":                 id,
+		"crn":                v.server.crn("is", zone, "instance", id),
+		"href":               v.href("instances", id),
+		"name":               name,
+		"created_at":         timestamp(),
+		"status":             "running",
+		"bandwidth":          vcpu * 2000,
+		"memory":             memory,
+		"vcpu":               object{"architecture": "amd64", "count": vcpu},
+		"profile":            object{"name": profile, "href": v.href("instance/profiles", profile)},
+		"zone":               object{"name": zone, "href": fmt.Sprintf("%s/regions/%s/zones/%s", v.server.URL(ServiceVPC), v.server.Region, zone)},
+		"vpc":                reference(parent, "crn", "href", "id", "name"),
+		"resource_group":     v.resourceGroup(body),
+		"disks":              []object{},
+		"volume_attachments": attachments,
+		"network_interfaces": nics,
+	}
+	if image != nil {
+		instance["image"] = image
+	}
+	writeJSON(w, http.StatusCreated, v.renderInstance(v.instances.add(id, instance)))
+}
+
+// newNetworkInterface returns the index-th network interface of an instance, with
+// the next address of its subnet not used by the pending interfaces of the instance.
+func (v *vpc) newNetworkInterface(parent object, zone, instanceID string, prototype object, index int, pending []object) (object, error) {
+	subnetID := ""
+	if ref, ok := prototype["subnet"].(map[string]interface{}); ok {
+		subnetID, _ = ref["id"].(string)
+	}
+	subnet, ok := v.subnets.get(subnetID)
+	if !ok {
+		return nil, fmt.Errorf("Subnet %q not found", subnetID)
+	}
+	if subnet["vpc"].(object)["id"] != parent["id"] || subnet["zone"].(object)["name"] != zone {
+		return nil, fmt.Errorf("Subnet %s is not in VPC %s and zone %s", subnetID, parent["id"], zone)
+	}
+	address, _ := prototype["primary_ipv4_address"].(string)
+	if address == "" {
+		address = v.nextIPv4Address(subnet, pending)
+	}
+	groups := []object{}
+	if refs, ok := prototype["security_groups"].([]interface{}); ok {
+		for _, ref := range refs {
+			id := fmt.Sprint(ref.(map[string]interface{})["id"])
+			group, ok := v.securityGroups.get(id)
+			if !ok {
+				return nil, fmt.Errorf("Security group %s not found", id)
+			}
+			groups = append(groups, reference(group, "crn", "href", "id", "name"))
+		}
+	} else if group, ok := v.securityGroups.get(parent["default_security_group"].(string)); ok {
+		groups = append(groups, reference(group, "crn", "href", "id", "name"))
+	}
+	id := v.server.newID()
+	name, _ := prototype["name"].(string)
+	if name == "" {
+		name = fmt.Sprintf("eth%d", index)
+	}
+	nicType := "secondary"
+	if index == 0 {
+		nicType = "primary"
+	}
+	allowIPSpoofing, _ := prototype["allow_ip_spoofing"].(bool)
+	return object{
+		"id":                   id,
+		"href":                 v.href("instances/"+instanceID+"/network_interfaces", id),
+		"name":                 name,
+		"created_at":           timestamp(),
+		"status":               "available",
+		"type":                 nicType,
+		"resource_type":        "network_interface",
+		"port_speed":           1000,
+		"allow_ip_spoofing":    allowIPSpoofing,
+		"primary_ipv4_address": address,
+		"floating_ips":         []object{},
+		"security_groups":      groups,
+		"subnet":               reference(subnet, "crn", "href", "id", "name"),
+	}, nil
+}
+
+// nextIPv4Address returns the first address of the subnet not used by a network
+// interface, after the addresses reserved by the platform.
+func (v *vpc) nextIPv4Address(subnet object, pending []object) string {
+	used := map[string]bool{}
+	for _, nic := range pending {
+		used[fmt.Sprint(nic["primary_ipv4_address"])] = true
+	}
+	for _, instance := range v.instances.list(nil) {
+		for _, nic := range instance["network_interfaces"].([]object) {
+			used[fmt.Sprint(nic["primary_ipv4_address"])] = true
+		}
+	}
+	_, ipnet, _ := net.ParseCIDR(fmt.Sprint(subnet["ipv4_cidr_block"]))
+	base := binary.BigEndian.Uint32(ipnet.IP.To4())
+	for offset := uint32(4); ; offset++ {
+		ip := make(net.IP, 4)
+		binary.BigEndian.PutUint32(ip, base+offset)
+		if !used[ip.String()] {
+			return ip.String()
+		}
+	}
+}
+
+// subnetInUse reports whether a network interface is attached to the subnet.
+func (v *vpc) subnetInUse(subnetID string) bool {
+	for _, instance := range v.instances.list(nil) {
+		for _, nic := range instance["network_interfaces"].([]object) {
+			if nic["subnet"].(object)["id"] == subnetID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// renderInstance replaces the network interfaces and volume attachments held by
+// the instance with the references the API embeds.
+func (v *vpc) renderInstance(o object) object {
+	nics := []object{}
+	for _, nic := range o["network_interfaces"].([]object) {
+		ref := reference(nic, "href", "id", "name", "primary_ipv4_address", "resource_type", "subnet")
+		if nic["type"] == "primary" {
+			o["primary_network_interface"] = ref
+		}
+		nics = append(nics, ref)
+	}
+	o["network_interfaces"] = nics
+	attachments := []object{}
+	for _, a := range o["volume_attachments"].([]object) {
+		ref := reference(v.renderVolumeAttachment(a), "device", "href", "id", "name", "volume")
+		if a["type"] == "boot" {
+			o["boot_volume_attachment"] = ref
+		}
+		attachments = append(attachments, ref)
+	}
+	o["volume_attachments"] = attachments
+	return o
+}
+
+// renderVolumeAttachment replaces the volume_id of a volume attachment with a
+// reference to its volume.
+func (v *vpc) renderVolumeAttachment(a object) object {
+	a = copyObject(a)
+	volume, _ := v.volumes.get(fmt.Sprint(a["volume_id"]))
+	a["volume"] = reference(volume, "crn", "href", "id", "name")
+	delete(a, "volume_id")
+	return a
+}
+
+// serveInstance serves an instance, it is called with computeMu held.
+func (v *vpc) serveInstance(w http.ResponseWriter, r *http.Request, instance object) {
+	id := instance["id"].(string)
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, v.renderInstance(instance))
+	case http.MethodPatch:
+		patch := object{}
+		if err := readJSON(r, &patch); err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+			return
+		}
+		update := object{}
+		if name, ok := patch["name"].(string); ok {
+			update["name"] = name
+		}
+		if ref, ok := patch["profile"].(map[string]interface{}); ok {
+			if instance["status"] != "stopped" {
+				writeError(w, http.StatusConflict, "instance_not_stopped", "The instance %s must be stopped to change its profile", id)
+				return
+			}
+			update["profile"] = object{"name": ref["name"], "href": v.href("instance/profiles", fmt.Sprint(ref["name"]))}
+		}
+		instance, _ = v.instances.update(id, update)
+		writeJSON(w, http.StatusOK, v.renderInstance(instance))
+	case http.MethodDelete:
+		for _, a := range instance["volume_attachments"].([]object) {
+			if a["delete_volume_on_instance_delete"] == true {
+				v.volumes.remove(fmt.Sprint(a["volume_id"]))
+			}
+		}
+		for _, nic := range instance["network_interfaces"].([]object) {
+			v.unbindFloatingIPs(nic["id"].(string))
+		}
+		v.instances.remove(id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		notFound(w, r)
+	}
+}
+
+// createInstanceAction applies an action at once, it is called with computeMu held.
+func (v *vpc) createInstanceAction(w http.ResponseWriter, r *http.Request, instance object) {
+	body := object{}
+	if err := readJSON(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+		return
+	}
+	statuses := map[string]string{"start": "running", "reboot": "running", "stop": "stopped"}
+	actionType, _ := body["type"].(string)
+	status, ok := statuses[actionType]
+	if !ok {
+		writeError(w, http.StatusBadRequest, "validation_invalid_argument", "Unsupported action type %q", actionType)
+		return
+	}
+	if actionType == "reboot" && instance["status"] != "running" {
+		writeError(w, http.StatusConflict, "instance_not_running", "The instance %s must be running to reboot", instance["id"])
+		return
+	}
+	v.instances.update(instance["id"].(string), object{"status": status})
+	id := v.server.newID()
+	force, _ := body["force"].(bool)
+	now := timestamp()
+	writeJSON(w, http.StatusCreated, object{
+		"id":           id,
+		"href":         v.href("instances/"+instance["id"].(string)+"/actions", id),
+		"type":         actionType,
+		"force":        force,
+		"status":       "completed",
+		"created_at":   now,
+		"started_at":   now,
+		"completed_at": now,
+	})
+}
+
+// serveInstanceNetworkInterface serves a network interface of an instance, it is
+// called with computeMu held.
+func (v *vpc) serveInstanceNetworkInterface(w http.ResponseWriter, r *http.Request, instance object, nicID string) {
+	nics := instance["network_interfaces"].([]object)
+	index := -1
+	for i, nic := range nics {
+		if nic["id"] == nicID {
+			index = i
+		}
+	}
+	if index < 0 {
+		writeError(w, http.StatusNotFound, "network_interface_not_found", "Network interface %s not found", nicID)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, v.renderNetworkInterface(nics[index]))
+	case http.MethodPatch:
+		patch := object{}
+		if err := readJSON(r, &patch); err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+			return
+		}
+		nic := copyObject(nics[index])
+		for _, k := range []string{"name", "allow_ip_spoofing"} {
+			if value, ok := patch[k]; ok {
+				nic[k] = value
+			}
+		}
+		updated := append([]object{}, nics...)
+		updated[index] = nic
+		v.instances.update(instance["id"].(string), object{"network_interfaces": updated})
+		writeJSON(w, http.StatusOK, v.renderNetworkInterface(nic))
+	case http.MethodDelete:
+		if nics[index]["type"] == "primary" {
+			writeError(w, http.StatusBadRequest, "network_interface_primary", "The primary network interface %s can't be deleted", nicID)
+			return
+		}
+		if instance["status"] != "stopped" {
+			writeError(w, http.StatusConflict, "instance_not_stopped", "The instance %s must be stopped to delete a network interface", instance["id"])
+			return
+		}
+		v.unbindFloatingIPs(nicID)
+		updated := append(append([]object{}, nics[:index]...), nics[index+1:]...)
+		v.instances.update(instance["id"].(string), object{"network_interfaces": updated})
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		notFound(w, r)
+	}
+}
+
+// serveInstanceNetworkInterfaces lists and creates the network interfaces of an
+// instance, it is called with computeMu held. The instance must be stopped to add
+// a network interface.
+func (v *vpc) serveInstanceNetworkInterfaces(w http.ResponseWriter, r *http.Request, instance object) {
+	nics := instance["network_interfaces"].([]object)
+	switch r.Method {
+	case http.MethodGet:
+		rendered := []object{}
+		for _, nic := range nics {
+			rendered = append(rendered, v.renderNetworkInterface(nic))
+		}
+		writeJSON(w, http.StatusOK, object{"network_interfaces": rendered})
+	case http.MethodPost:
+		body := object{}
+		if err := readJSON(r, &body); err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+			return
+		}
+		if instance["status"] != "stopped" {
+			writeError(w, http.StatusConflict, "instance_not_stopped", "The instance %s must be stopped to add a network interface", instance["id"])
+			return
+		}
+		parent, _ := v.vpcs.get(fmt.Sprint(instance["vpc"].(object)["id"]))
+		nic, err := v.newNetworkInterface(parent, fmt.Sprint(instance["zone"].(object)["name"]), instance["id"].(string), body, len(nics), nil)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "validation_invalid_argument", "%s", err)
+			return
+		}
+		v.instances.update(instance["id"].(string), object{"network_interfaces": append(append([]object{}, nics...), nic)})
+		writeJSON(w, http.StatusCreated, v.renderNetworkInterface(nic))
+	default:
+		notFound(w, r)
+	}
+}
+
+// renderNetworkInterface fills in the floating IPs bound to a network interface.
+func (v *vpc) renderNetworkInterface(nic object) object {
+	nic = copyObject(nic)
+	fips := []object{}
+	for _, fip := range v.floatingIPs.list(func(o object) bool {
+		target, ok := o["target"].(object)
+		return ok && target["id"] == nic["id"]
+	}) {
+		fips = append(fips, reference(fip, "address", "crn", "href", "id", "name"))
+	}
+	nic["floating_ips"] = fips
+	return nic
+}
+
+// findNetworkInterface returns the instance with the network interface and the
+// index of the interface.
+func (v *vpc) findNetworkInterface(nicID string) (object, int, bool) {
+	for _, instance := range v.instances.list(nil) {
+		for i, nic := range instance["network_interfaces"].([]object) {
+			if nic["id"] == nicID {
+				return instance, i, true
+			}
+		}
+	}
+	return nil, -1, false
+}
+
+// createFloatingIP reserves a floating IP in a zone or bound to a network interface.
+func (v *vpc) createFloatingIP(w http.ResponseWriter, r *http.Request) {
+	v.computeMu.Lock()
+	defer v.computeMu.Unlock()
+	body := object{}
+	if err := readJSON(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+		return
+	}
+	zone := ""
+	if ref, ok := body["zone"].(map[string]interface{}); ok {
+		zone = fmt.Sprint(ref["name"])
+	}
+	var target object
+	if ref, ok := body["target"].(map[string]interface{}); ok {
+		nicID := fmt.Sprint(ref["id"])
+		instance, index, ok := v.findNetworkInterface(nicID)
+		if !ok {
+			writeError(w, http.StatusNotFound, "network_interface_not_found", "Network interface %s not found", nicID)
+			return
+		}
+		if len(v.renderNetworkInterface(instance["network_interfaces"].([]object)[index])["floating_ips"].([]object)) > 0 {
+			writeError(w, http.StatusConflict, "network_interface_has_floating_ip", "The network interface %s has a floating IP", nicID)
+			return
+		}
+		target = reference(instance["network_interfaces"].([]object)[index], "href", "id", "name", "primary_ipv4_address", "resource_type")
+		zone = fmt.Sprint(instance["zone"].(object)["name"])
+	}
+	if zone == "" {
+		writeError(w, http.StatusBadRequest, "validation_required_field_missing", "A zone or a target is required")
+		return
+	}
+	id := v.server.newID()
+	name, _ := body["name"].(string)
+	if name == "" {
+		name = "floating-ip-" + id[5:13]
+	}
+	fip := object{
+		"id":             id,
+		"crn":            v.server.crn("is", zone, "floating-ip", id),
+		"href":           v.href("floating_ips", id),
+		"name":           name,
+		"address":        v.nextFloatingIPAddress(),
+		"created_at":     timestamp(),
+		"status":         "available",
+		"zone":           object{"name": zone, "href": fmt.Sprintf("%s/regions/%s/zones/%s", v.server.URL(ServiceVPC), v.server.Region, zone)},
+		"resource_group": v.resourceGroup(body),
+	}
+	if target != nil {
+		fip["target"] = target
+	}
+	writeJSON(w, http.StatusCreated, v.floatingIPs.add(id, fip))
+}
+
+// nextFloatingIPAddress returns the first public address not used by a floating IP.
+func (v *vpc) nextFloatingIPAddress() string {
+	used := map[string]bool{}
+	for _, fip := range v.floatingIPs.list(nil) {
+		used[fmt.Sprint(fip["address"])] = true
+	}
+	for i := 4; ; i++ {
+		address := fmt.Sprintf("52.116.%d.%d", i/256, i%256)
+		if !used[address] {
+			return address
+		}
+	}
+}
+
+// unbindFloatingIPs releases the floating IPs bound to a network interface.
+func (v *vpc) unbindFloatingIPs(nicID string) {
+	for _, fip := range v.floatingIPs.list(func(o object) bool {
+		target, ok := o["target"].(object)
+		return ok && target["id"] == nicID
+	}) {
+		delete(fip, "target")
+		v.floatingIPs.add(fip["id"].(string), fip)
+	}
+}
+
+// serveNetworkInterfaceFloatingIPs lists, binds and unbinds the floating IPs of a
+// network interface, it is called with computeMu held.
+func (v *vpc) serveNetworkInterfaceFloatingIPs(w http.ResponseWriter, r *http.Request, instance object, nicID, fipID string) {
+	var nic object
+	for _, n := range instance["network_interfaces"].([]object) {
+		if n["id"] == nicID {
+			nic = n
+		}
+	}
+	if nic == nil {
+		writeError(w, http.StatusNotFound, "network_interface_not_found", "Network interface %s not found", nicID)
+		return
+	}
+	bound := v.renderNetworkInterface(nic)["floating_ips"].([]object)
+	if fipID == "" {
+		if r.Method != http.MethodGet {
+			notFound(w, r)
+			return
+		}
+		fips := []object{}
+		for _, ref := range bound {
+			fip, _ := v.floatingIPs.get(ref["id"].(string))
+			fips = append(fips, fip)
+		}
+		writeJSON(w, http.StatusOK, object{"floating_ips": fips})
+		return
+	}
+	fip, ok := v.floatingIPs.get(fipID)
+	if !ok {
+		writeError(w, http.StatusNotFound, "floating_ip_not_found", "Floating IP %s not found", fipID)
+		return
+	}
+	target, _ := fip["target"].(object)
+	switch r.Method {
+	case http.MethodGet:
+		if target == nil || target["id"] != nicID {
+			writeError(w, http.StatusNotFound, "floating_ip_not_found", "Floating IP %s is not bound to network interface %s", fipID, nicID)
+			return
+		}
+		writeJSON(w, http.StatusOK, fip)
+	case http.MethodPut:
+		if target != nil && target["id"] == nicID {
+			writeJSON(w, http.StatusCreated, fip)
+			return
+		}
+		if target != nil {
+			writeError(w, http.StatusConflict, "floating_ip_in_use", "The floating IP %s is bound to network interface %s", fipID, target["id"])
+			return
+		}
+		if len(bound) > 0 {
+			writeError(w, http.StatusConflict, "network_interface_has_floating_ip", "The network interface %s has a floating IP", nicID)
+			return
+		}
+		if fip["zone"].(object)["name"] != instance["zone"].(object)["name"] {
+			writeError(w, http.StatusBadRequest, "validation_invalid_argument", "Floating IP %s is not in the zone of instance %s", fipID, instance["id"])
+			return
+		}
+		fip, _ = v.floatingIPs.update(fipID, object{"target": reference(nic, "href", "id", "name", "primary_ipv4_address", "resource_type")})
+		writeJSON(w, http.StatusCreated, fip)
+	case http.MethodDelete:
+		if target == nil || target["id"] != nicID {
+			writeError(w, http.StatusNotFound, "floating_ip_not_found", "Floating IP %s is not bound to network interface %s", fipID, nicID)
+			return
+		}
+		v.unbindFloatingIPs(nicID)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		notFound(w, r)
+	}
+}
+
+// serveSecurityGroupNetworkInterface adds and removes a network interface of an
+// instance to and from a security group, it is called with computeMu held.
+func (v *vpc) serveSecurityGroupNetworkInterface(w http.ResponseWriter, r *http.Request, groupID, nicID string) {
+	group, ok := v.securityGroups.get(groupID)
+	if !ok {
+		writeError(w, http.StatusNotFound, "security_group_not_found", "Security group %s not found", groupID)
+		return
+	}
+	instance, index, ok := v.findNetworkInterface(nicID)
+	if !ok {
+		writeError(w, http.StatusNotFound, "network_interface_not_found", "Network interface %s not found", nicID)
+		return
+	}
+	nics := instance["network_interfaces"].([]object)
+	nic := copyObject(nics[index])
+	groups := []object{}
+	member := false
+	for _, g := range nic["security_groups"].([]object) {
+		if g["id"] == groupID {
+			member = true
+		} else {
+			groups = append(groups, g)
+		}
+	}
+	switch r.Method {
+	case http.MethodGet:
+		if !member {
+			writeError(w, http.StatusNotFound, "network_interface_not_found", "Network interface %s is not in security group %s", nicID, groupID)
+			return
+		}
+		writeJSON(w, http.StatusOK, v.renderNetworkInterface(nic))
+		return
+	case http.MethodPut:
+		if group["vpc"].(object)["id"] != instance["vpc"].(object)["id"] {
+			writeError(w, http.StatusBadRequest, "validation_invalid_argument", "Security group %s is not in the VPC of instance %s", groupID, instance["id"])
+			return
+		}
+		groups = append(groups, reference(group, "crn", "href", "id", "name"))
+	case http.MethodDelete:
+		if !member {
+			writeError(w, http.StatusNotFound, "network_interface_not_found", "Network interface %s is not in security group %s", nicID, groupID)
+			return
+		}
+		if len(groups) == 0 {
+			writeError(w, http.StatusBadRequest, "network_interface_security_group_required", "The network interface %s must be in a security group", nicID)
+			return
+		}
+	default:
+		notFound(w, r)
+		return
+	}
+	nic["security_groups"] = groups
+	updated := append([]object{}, nics...)
+	updated[index] = nic
+	v.instances.update(instance["id"].(string), object{"network_interfaces": updated})
+	if r.Method == http.MethodDelete {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	writeJSON(w, http.StatusCreated, v.renderNetworkInterface(nic))
+}
+
+// serveInstanceVolumeAttachments lists and creates the data volume attachments of
+// an instance, it is called with computeMu held.
+func (v *vpc) serveInstanceVolumeAttachments(w http.ResponseWriter, r *http.Request, instance object) {
+	attachments := instance["volume_attachments"].([]object)
+	switch r.Method {
+	case http.MethodGet:
+		rendered := []object{}
+		for _, a := range attachments {
+			rendered = append(rendered, v.renderVolumeAttachment(a))
+		}
+		writeJSON(w, http.StatusOK, object{"volume_attachments": rendered})
+	case http.MethodPost:
+		body := object{}
+		if err := readJSON(r, &body); err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+			return
+		}
+		volumeID := ""
+		if ref, ok := body["volume"].(map[string]interface{}); ok {
+			volumeID = fmt.Sprint(ref["id"])
+		}
+		volume, ok := v.volumes.get(volumeID)
+		if !ok {
+			writeError(w, http.StatusNotFound, "volume_not_found", "Volume %s not found", volumeID)
+			return
+		}
+		if len(v.renderVolume(volume)["volume_attachments"].([]object)) > 0 {
+			writeError(w, http.StatusConflict, "volume_in_use", "The volume %s is attached to an instance", volumeID)
+			return
+		}
+		if volume["zone"].(object)["name"] != instance["zone"].(object)["name"] {
+			writeError(w, http.StatusBadRequest, "validation_invalid_argument", "Volume %s is not in the zone of instance %s", volumeID, instance["id"])
+			return
+		}
+		deleteVolume, _ := body["delete_volume_on_instance_delete"].(bool)
+		attachment := v.newVolumeAttachment(instance["id"].(string), body, volumeID, "data", deleteVolume)
+		v.instances.update(instance["id"].(string), object{"volume_attachments": append(append([]object{}, attachments...), attachment)})
+		writeJSON(w, http.StatusCreated, v.renderVolumeAttachment(attachment))
+	default:
+		notFound(w, r)
+	}
+}
+
+// serveInstanceVolumeAttachment serves a volume attachment of an instance, it is
+// called with computeMu held. The boot volume can't be detached.
+func (v *vpc) serveInstanceVolumeAttachment(w http.ResponseWriter, r *http.Request, instance object, attachmentID string) {
+	attachments := instance["volume_attachments"].([]object)
+	index := -1
+	for i, a := range attachments {
+		if a["id"] == attachmentID {
+			index = i
+		}
+	}
+	if index < 0 {
+		writeError(w, http.StatusNotFound, "volume_attachment_not_found", "Volume attachment %s not found", attachmentID)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, v.renderVolumeAttachment(attachments[index]))
+	case http.MethodPatch:
+		patch := object{}
+		if err := readJSON(r, &patch); err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+			return
+		}
+		attachment := copyObject(attachments[index])
+		for _, k := range []string{"name", "delete_volume_on_instance_delete"} {
+			if value, ok := patch[k]; ok {
+				attachment[k] = value
+			}
+		}
+		updated := append([]object{}, attachments...)
+		updated[index] = attachment
+		v.instances.update(instance["id"].(string), object{"volume_attachments": updated})
+		writeJSON(w, http.StatusOK, v.renderVolumeAttachment(attachment))
+	case http.MethodDelete:
+		if attachments[index]["type"] == "boot" {
+			writeError(w, http.StatusBadRequest, "volume_attachment_boot", "The boot volume attachment %s can't be deleted", attachmentID)
+			return
+		}
+		updated := append(append([]object{}, attachments[:index]...), attachments[index+1:]...)
+		v.instances.update(instance["id"].(string), object{"volume_attachments": updated})
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		notFound(w, r)
+	}
+}
+
+// newVolumeAttachment returns the boot or data attachment of the volume to the
+// instance, named by the prototype.
+func (v *vpc) newVolumeAttachment(instanceID string, prototype object, volumeID, kind string, deleteVolume bool) object {
+	id := v.server.newID()
+	name, _ := prototype["name"].(string)
+	if name == "" {
+		name = "volume-attachment-" + id[5:13]
+	}
+	return object{
+		"id":                               id,
+		"href":                             v.href("instances/"+instanceID+"/volume_attachments", id),
+		"name":                             name,
+		"created_at":                       timestamp(),
+		"status":                           "attached",
+		"type":                             kind,
+		"device":                           object{"id": id + "-" + kind},
+		"delete_volume_on_instance_delete": deleteVolume,
+		"volume_id":                        volumeID,
+	}
+}
+
+// createInstanceTemplate adds an instance template, which must boot from an image.
+// The names of the network interfaces and the volume attachments are filled in.
+func (v *vpc) createInstanceTemplate(w http.ResponseWriter, r *http.Request) {
+	body := object{}
+	if err := readJSON(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "%s", err)
+		return
+	}
+	for _, k := range []string{"vpc", "zone", "profile", "image", "primary_network_interface"} {
+		if _, ok := body[k].(map[string]interface{}); !ok {
+			writeError(w, http.StatusBadRequest, "validation_required_field_missing", "%s is required", k)
+			return
+		}
+	}
+	vpcID := fmt.Sprint(body["vpc"].(map[string]interface{})["id"])
+	if _, ok := v.vpcs.get(vpcID); !ok {
+		writeError(w, http.StatusNotFound, "vpc_not_found", "VPC %s not found", vpcID)
+		return
+	}
+	id := v.server.newID()
+	template := object{}
+	for k, value := range body {
+		template[k] = value
+	}
+	if name, _ := template["name"].(string); name == "" {
+		template["name"] = "instance-template-" + id[5:13]
+	}
+	template["id"] = id
+	template["crn"] = v.server.crn("is", v.server.Region, "instance-template", id)
+	template["href"] = v.href("instance/templates", id)
+	template["created_at"] = timestamp()
+	template["resource_group"] = v.resourceGroup(body)
+	nics := []interface{}{body["primary_network_interface"]}
+	if others, ok := body["network_interfaces"].([]interface{}); ok {
+		nics = append(nics, others...)
+	}
+	for i, nic := range nics {
+		if nic, ok := nic.(map[string]interface{}); ok {
+			if name, _ := nic["name"].(string); name == "" {
+				nic["name"] = fmt.Sprintf("eth%d", i)
+			}
+		}
+	}
+	if attachments, ok := body["volume_attachments"].([]interface{}); ok {
+		for i, a := range attachments {
+			if a, ok := a.(map[string]interface{}); ok {
+				if name, _ := a["name"].(string); name == "" {
+					a["name"] = fmt.Sprintf("volume-attachment-%d", i)
+				}
+				if _, ok := a["delete_volume_on_instance_delete"]; !ok {
+					a["delete_volume_on_instance_delete"] = false
+				}
+			}
+		}
+	}
+	if boot, ok := body["boot_volume_attachment"].(map[string]interface{}); ok {
+		if _, ok := boot["delete_volume_on_instance_delete"]; !ok {
+			boot["delete_volume_on_instance_delete"] = true
+		}
+	}
+	writeJSON(w, http.StatusCreated, v.instanceTemplates.add(id, template))
+}
+
+// cloneJSON returns a deep copy of a value decoded from JSON.
+func cloneJSON(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		c := make(map[string]interface{}, len(value))
+		for k, v := range value {
+			c[k] = cloneJSON(v)
+		}
+		return c
+	case object:
+		return cloneJSON(map[string]interface{}(value))
+	case []interface{}:
+		c := make([]interface{}, len(value))
+		for i, v := range value {
+			c[i] = cloneJSON(v)
+		}
+		return c
+	}
+	return value
+}
+
+// toInt returns a number held by an object, which is a float64 once decoded from
+// JSON.
+func toInt(value interface{}) int {
+	switch n := value.(type) {
+	case int:
+		return n
+	case float64:
+		return int(n)
+	}
+	return 0
+}
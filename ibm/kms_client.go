@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	kp "github.com/IBM/keyprotect-go-client"
+)
+
+// kmsInstanceClient returns the key management client of a Key Protect (kms) or
+// Hyper Protect Crypto Services (hs-crypto) instance, at its public or private
+// endpoint. The client is a copy of the one of the session, as the instance and
+// endpoint are part of its configuration.
+func kmsInstanceClient(meta interface{}, instanceID, endpointType string) (*kp.Client, error) {
+	kpAPI, err := meta.(ClientSession).keyManagementAPI()
+	if err != nil {
+		return nil, err
+	}
+	rContollerClient, err := meta.(ClientSession).ResourceControllerAPIV2()
+	if err != nil {
+		return nil, err
+	}
+	instanceData, err := rContollerClient.ResourceServiceInstanceV2().GetInstance(instanceID)
+	if err != nil {
+		return nil, apiErrorf("resource_controller", err, nil, "Error retrieving the key management instance %s", instanceID)
+	}
+	crnData := strings.Split(instanceData.Crn.String(), ":")
+	if len(crnData) < 5 {
+		return nil, fmt.Errorf("Invalid CRN %q of the key management instance %s", instanceData.Crn.String(), instanceID)
+	}
+
+	client := *kpAPI
+	switch crnData[4] {
+	case "hs-crypto":
+		hpcsEndpointAPI, err := meta.(ClientSession).HpcsEndpointAPI()
+		if err != nil {
+			return nil, err
+		}
+		resp, err := hpcsEndpointAPI.Endpoint().GetAPIEndpoint(instanceID)
+		if err != nil {
+			return nil, apiErrorf("hs-crypto", err, nil, "Error retrieving the endpoints of instance %s", instanceID)
+		}
+		host := resp.Kms.Public
+		if endpointType == "private" {
+			host = resp.Kms.Private
+		}
+		u, err := url.Parse("https://" + host + "/api/v2/")
+		if err != nil {
+			return nil, fmt.Errorf("Error Parsing hpcs EndpointURL")
+		}
+		client.URL = u
+	case "kms":
+		if endpointType == "private" && !strings.HasPrefix(client.URL.Host, "private.") {
+			u := *client.URL
+			u.Host = "private." + u.Host
+			client.URL = &u
+		}
+	default:
+		return nil, fmt.Errorf("Invalid or unsupported service Instance")
+	}
+	client.Config.InstanceID = instanceID
+	return &client, nil
+}
+
+// kmsAPIError returns the error of a request of the key management client, the
+// *kp.Error it returns carrying the status code and correlation ID of the response.
+func kmsAPIError(err error, format string, args ...interface{}) error {
+	var response *core.DetailedResponse
+	var kpErr *kp.Error
+	if errors.As(err, &kpErr) {
+		response = &core.DetailedResponse{StatusCode: kpErr.StatusCode, Headers: http.Header{}}
+		response.Headers.Set("X-Correlation-Id", kpErr.CorrelationID)
+	}
+	return apiErrorf("kms", err, response, format, args...)
+}
+
+// isKmsNotFound reports whether err is a 404 of the key management APIs.
+func isKmsNotFound(err error) bool {
+	var kpErr *kp.Error
+	var apiErr *APIError
+	switch {
+	case errors.As(err, &apiErr):
+		return apiErr.StatusCode == http.StatusNotFound
+	case errors.As(err, &kpErr):
+		return kpErr.StatusCode == http.StatusNotFound
+	}
+	return false
+}
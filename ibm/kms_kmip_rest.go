@@ -0,0 +1,213 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+const (
+	kmsKmipAdapterType    = "application/vnd.ibm.kms.kmip_adapter+json"
+	kmsKmipClientCertType = "application/vnd.ibm.kms.kmip_client_certificate+json"
+)
+
+// kmsKmipRESTClient calls the KMIP adapter API of a key management instance, which
+// the keyprotect-go-client does not cover, at the endpoint and with the credentials
+// of the key management client of the instance.
+type kmsKmipRESTClient struct {
+	URL        string
+	InstanceID string
+	Token      string
+	Client     *http.Client
+}
+
+// kmsKmipAdapter is a KMIP adapter, the native_1.0 profile of which holds the ID of
+// the root key that wraps the KMIP objects.
+type kmsKmipAdapter struct {
+	ID          string            `json:"id,omitempty"`
+	Name        string            `json:"name,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Profile     string            `json:"profile"`
+	ProfileData map[string]string `json:"profile_data"`
+	CreatedBy   string            `json:"created_by,omitempty"`
+	CreatedAt   string            `json:"created_at,omitempty"`
+	UpdatedAt   string            `json:"updated_at,omitempty"`
+}
+
+// kmsKmipClientCert is a client certificate of a KMIP adapter.
+type kmsKmipClientCert struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Certificate string `json:"certificate"`
+	CreatedBy   string `json:"created_by,omitempty"`
+	CreatedAt   string `json:"created_at,omitempty"`
+}
+
+type kmsCollectionMetadata struct {
+	CollectionType  string `json:"collectionType"`
+	CollectionTotal int    `json:"collectionTotal"`
+}
+
+func createKmsKmipRESTClient(meta interface{}, instanceID, endpointType string) (*kmsKmipRESTClient, error) {
+	kpAPI, err := kmsInstanceClient(meta, instanceID, endpointType)
+	if err != nil {
+		return nil, err
+	}
+	// Without an IAM token the key management client authenticates with the API
+	// key, the session holds the token of that API key.
+	token := kpAPI.Config.Authorization
+	if token == "" {
+		bxSession, err := meta.(ClientSession).BluemixSession()
+		if err != nil {
+			return nil, err
+		}
+		token = bxSession.Config.IAMAccessToken
+	}
+	if !strings.HasPrefix(token, "Bearer ") {
+		token = "Bearer " + token
+	}
+	return &kmsKmipRESTClient{
+		URL:        strings.TrimSuffix(kpAPI.URL.String(), "/"),
+		InstanceID: instanceID,
+		Token:      token,
+		Client:     &kpAPI.HttpClient,
+	}, nil
+}
+
+// do sends a request with the resource in a collection of the given type, and
+// decodes the single resource of the response into result when it is not nil. The
+// error responses are returned as an *APIError.
+func (c *kmsKmipRESTClient) do(method, path, collectionType string, resource, result interface{}) error {
+	var reader io.Reader
+	if resource != nil {
+		data, err := json.Marshal(map[string]interface{}{
+			"metadata":  kmsCollectionMetadata{CollectionType: collectionType, CollectionTotal: 1},
+			"resources": []interface{}{resource},
+		})
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+	request, err := http.NewRequest(method, c.URL+path, reader)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Authorization", c.Token)
+	request.Header.Set("bluemix-instance", c.InstanceID)
+	request.Header.Set("Accept", "application/json")
+	if resource != nil {
+		request.Header.Set("Content-Type", collectionType)
+	}
+	response, err := c.Client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	data, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode >= 300 {
+		return kmsKmipAPIError(fmt.Sprintf("Error calling %s %s", method, path), response, data)
+	}
+	if result == nil || len(data) == 0 {
+		return nil
+	}
+	collection := struct {
+		Resources []json.RawMessage `json:"resources"`
+	}{}
+	if err := json.Unmarshal(data, &collection); err != nil {
+		return err
+	}
+	if len(collection.Resources) == 0 {
+		return fmt.Errorf("The response of %s %s holds no resource", method, path)
+	}
+	return json.Unmarshal(collection.Resources[0], result)
+}
+
+func (c *kmsKmipRESTClient) createAdapter(adapter kmsKmipAdapter) (*kmsKmipAdapter, error) {
+	result := &kmsKmipAdapter{}
+	if err := c.do(http.MethodPost, "/kmip_adapters", kmsKmipAdapterType, adapter, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *kmsKmipRESTClient) getAdapter(idOrName string) (*kmsKmipAdapter, error) {
+	result := &kmsKmipAdapter{}
+	if err := c.do(http.MethodGet, "/kmip_adapters/"+idOrName, "", nil, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// deleteAdapter deletes the KMIP adapter along with its client certificates.
+func (c *kmsKmipRESTClient) deleteAdapter(idOrName string) error {
+	return c.do(http.MethodDelete, "/kmip_adapters/"+idOrName, "", nil, nil)
+}
+
+func (c *kmsKmipRESTClient) createClientCert(adapterID string, cert kmsKmipClientCert) (*kmsKmipClientCert, error) {
+	result := &kmsKmipClientCert{}
+	if err := c.do(http.MethodPost, fmt.Sprintf("/kmip_adapters/%s/certificates", adapterID), kmsKmipClientCertType, cert, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *kmsKmipRESTClient) getClientCert(adapterID, idOrName string) (*kmsKmipClientCert, error) {
+	result := &kmsKmipClientCert{}
+	if err := c.do(http.MethodGet, fmt.Sprintf("/kmip_adapters/%s/certificates/%s", adapterID, idOrName), "", nil, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *kmsKmipRESTClient) deleteClientCert(adapterID, idOrName string) error {
+	return c.do(http.MethodDelete, fmt.Sprintf("/kmip_adapters/%s/certificates/%s", adapterID, idOrName), "", nil, nil)
+}
+
+// kmsKmipAPIError returns the error of a response of the key management API, a
+// collection of application/vnd.ibm.kms.error+json resources with the message and
+// the reasons of the error.
+func kmsKmipAPIError(operation string, response *http.Response, data []byte) error {
+	collection := struct {
+		Resources []struct {
+			ErrorMsg string `json:"errorMsg"`
+			Reasons  []struct {
+				Code    string `json:"code"`
+				Message string `json:"message"`
+			} `json:"reasons"`
+		} `json:"resources"`
+	}{}
+	body := map[string]interface{}{}
+	message := strings.TrimSpace(string(data))
+	if json.Unmarshal(data, &collection) == nil && len(collection.Resources) > 0 {
+		resource := collection.Resources[0]
+		message = resource.ErrorMsg
+		if len(resource.Reasons) > 0 {
+			body["code"] = resource.Reasons[0].Code
+			message = fmt.Sprintf("%s: %s", resource.Reasons[0].Code, resource.Reasons[0].Message)
+		}
+		body["message"] = message
+	}
+	if message == "" {
+		message = response.Status
+	}
+	detailed := &core.DetailedResponse{
+		StatusCode: response.StatusCode,
+		Headers:    response.Header,
+		Result:     body,
+	}
+	return apiErrorf("kms", errors.New(message), detailed, "%s", operation)
+}
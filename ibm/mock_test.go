@@ -0,0 +1,261 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/mockserver"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func init() {
+	// The mock server applies the changes at once, its resources are polled without
+	// the delays of the live APIs, unless the requests are recorded from them or
+	// the acceptance tests run
+	if mockserver.ModeFromEnv() != mockserver.ModeRecord && os.Getenv(resource.TestEnvVar) == "" {
+		vpcWaitDelay, vpcWaitMinTimeout = 0, 0
+	}
+}
+
+// testMockServer starts the offline stand-ins of the IBM Cloud APIs for the test.
+// With IBMCLOUD_MOCK_MODE=record the requests are proxied to the live APIs, using
+// IC_API_KEY, and recorded to test-fixtures/cassettes/<test name>.json, with
+// IBMCLOUD_MOCK_MODE=replay they are served from that recording.
+func testMockServer(t *testing.T) *mockserver.Server {
+	mode := mockserver.ModeFromEnv()
+	if mode == mockserver.ModeRecord && os.Getenv("IC_API_KEY") == "" {
+		t.Skip("IC_API_KEY must be set to record a cassette")
+	}
+	cassette := filepath.Join("test-fixtures", "cassettes", strings.Replace(t.Name(), "/", "_", -1)+".json")
+	srv, err := mockserver.New(mode, cassette)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := srv.Close(); err != nil {
+			t.Error(err)
+		}
+	})
+	return srv
+}
+
+// testMockClientSession returns a client session with every endpoint served by srv.
+func testMockClientSession(t *testing.T, srv *mockserver.Server) ClientSession {
+	c := &Config{
+		Region:         srv.Region,
+		Visibility:     "public",
+		BluemixTimeout: 60 * time.Second,
+		RetryDelay:     time.Second,
+		Endpoints:      srv.Endpoints(),
+	}
+	if srv.Mode() == mockserver.ModeRecord {
+		c.BluemixAPIKey = os.Getenv("IC_API_KEY")
+	} else {
+		c.IAMToken = srv.Token()
+		c.IAMRefreshToken = "mockserver-refresh-token"
+	}
+	sess, err := c.ClientSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sess.(ClientSession)
+}
+
+// testMockResourceData returns the resource data of a resource applied from the
+// given state with the raw configuration, the way the SDK prepares an update.
+func testMockResourceData(t *testing.T, r *schema.Resource, d *schema.ResourceData, raw map[string]interface{}) *schema.ResourceData {
+	state := d.State()
+	if state == nil {
+		t.Fatal("resource has no state")
+	}
+	diff, err := schema.InternalMap(r.Schema).Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := schema.InternalMap(r.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// testDiagsErr returns the first error of the diagnostics of a CRUD function.
+func testDiagsErr(diags diag.Diagnostics) error {
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			if d.Detail != "" {
+				return fmt.Errorf("%s: %s", d.Summary, d.Detail)
+			}
+			return errors.New(d.Summary)
+		}
+	}
+	return nil
+}
+
+// testMockSubnet creates a VPC with a subnet in us-south-1 for the instances of the
+// test, without the waits of the resources, and returns their IDs.
+func testMockSubnet(t *testing.T, meta interface{}) (vpcID, subnetID string) {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vpc, _, err := sess.CreateVPC(&vpcv1.CreateVPCOptions{Name: core.StringPtr("mock-vpc")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	subnet, _, err := sess.CreateSubnet(&vpcv1.CreateSubnetOptions{
+		SubnetPrototype: &vpcv1.SubnetPrototypeSubnetByCIDR{
+			Name:          core.StringPtr("mock-subnet"),
+			VPC:           &vpcv1.VPCIdentityByID{ID: vpc.ID},
+			Ipv4CIDRBlock: core.StringPtr("10.240.0.0/24"),
+			Zone:          &vpcv1.ZoneIdentityByName{Name: core.StringPtr("us-south-1")},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return *vpc.ID, *subnet.ID
+}
+
+// testMockVpcCluster creates a VPC cluster with one worker in the subnet, without
+// the waits of the resource, and returns its ID.
+func testMockVpcCluster(t *testing.T, meta interface{}, vpcID, subnetID string) string {
+	csClient, err := meta.(ClientSession).VpcContainerAPI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cls, err := csClient.Clusters().Create(v2.ClusterCreateRequest{
+		Name:     "mock-cluster",
+		Provider: "vpc-gen2",
+		WorkerPools: v2.WorkerPoolConfig{
+			Name:        "default",
+			Flavor:      "bx2.4x16",
+			VpcID:       vpcID,
+			WorkerCount: 1,
+			Zones:       []v2.Zone{{ID: "us-south-1", SubnetID: subnetID}},
+		},
+	}, v2.ClusterTargetHeader{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cls.ID
+}
+
+// testMockInstance creates an ibm_is_instance from the raw configuration the way
+// the SDK applies a new resource.
+func testMockInstance(t *testing.T, meta interface{}, raw map[string]interface{}) *schema.ResourceData {
+	r := resourceIBMISInstance()
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	d.MarkNewResource()
+	if err := testDiagsErr(r.CreateContext(context.Background(), d, meta)); err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+// testMockKMSInstance creates a Key Protect instance with a root key, without the
+// waits of the resources, and returns their IDs.
+func testMockKMSInstance(t *testing.T, meta interface{}) (instanceID, rootKeyID string) {
+	rc, err := meta.(ClientSession).ResourceControllerV2API()
+	if err != nil {
+		t.Fatal(err)
+	}
+	instance, _, err := rc.CreateResourceInstance(&resourcecontrollerv2.CreateResourceInstanceOptions{
+		Name:           core.StringPtr("mock-kms"),
+		Target:         core.StringPtr("us-south"),
+		ResourceGroup:  core.StringPtr("mock-resource-group"),
+		ResourcePlanID: core.StringPtr("mockserver-plan-kms-tiered-pricing"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	kpAPI, err := kmsInstanceClient(meta, *instance.GUID, "public")
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := kpAPI.CreateRootKey(context.Background(), "mock-root-key", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return *instance.GUID, key.ID
+}
+
+// testMockTransitGateway creates a transit gateway with a classic connection,
+// without the waits of the resources, and returns their IDs.
+func testMockTransitGateway(t *testing.T, meta interface{}) (gatewayID, classicConnectionID string) {
+	client, err := transitgatewayClient(meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gateway, _, err := client.CreateTransitGateway(client.NewCreateTransitGatewayOptions("us-south", "mock-tg"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	connection, _, err := createTGConnection(client, *gateway.ID, tgConnection{Name: "mock-classic", NetworkType: "classic"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return *gateway.ID, connection.ID
+}
+
+// testMockDLProviderGateway creates a Direct Link Connect gateway through the
+// provider API, pending the approval of its creation by the customer, and
+// returns its ID.
+func testMockDLProviderGateway(t *testing.T, meta interface{}) string {
+	client, err := meta.(ClientSession).DirectlinkProviderV2API()
+	if err != nil {
+		t.Fatal(err)
+	}
+	port, err := client.NewProviderGatewayPortIdentity("mock-port")
+	if err != nil {
+		t.Fatal(err)
+	}
+	gateway, _, err := client.CreateProviderGateway(client.NewCreateProviderGatewayOptions(64999, "mock-account", "mock-dl-connect", port, 1000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return *gateway.ID
+}
+
+// testMockDLMacsecGateway creates a Direct Link dedicated gateway with MACsec
+// enabled and a primary CAK, without the waits of the resource, and returns its ID.
+func testMockDLMacsecGateway(t *testing.T, meta interface{}) string {
+	client, err := directlinkClient(meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	primary, err := client.NewGatewayMacsecConfigTemplatePrimaryCak("crn:v1:bluemix:public:hs-crypto:us-south:a/mockserver:mock-hpcs:key:mock-primary")
+	if err != nil {
+		t.Fatal(err)
+	}
+	macsec, err := client.NewGatewayMacsecConfigTemplate(true, primary)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template, err := client.NewGatewayTemplateGatewayTypeDedicatedTemplate(64999, false, false, "mock-dl-dedicated", 1000, "dedicated", "mock-carrier", "LAB-xcr01.dal09", "mock-customer", "dal09")
+	if err != nil {
+		t.Fatal(err)
+	}
+	template.MacsecConfig = macsec
+	gateway, _, err := client.CreateGateway(client.NewCreateGatewayOptions(template))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return *gateway.ID
+}
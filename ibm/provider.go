@@ -4,12 +4,15 @@
 package ibm
 
 import (
+	"fmt"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/mutexkv"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // This is a global MutexKV for use within this plugin.
@@ -121,6 +124,12 @@ func Provider() *schema.Provider {
 				Description: "The retry count to set for API calls.",
 				DefaultFunc: schema.EnvDefaultFunc("MAX_RETRIES", 10),
 			},
+			"retry_max_wait": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The maximum wait (in seconds) between two retries of an API call.",
+				DefaultFunc: schema.EnvDefaultFunc("RETRY_MAX_WAIT", 30),
+			},
 			"function_namespace": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -154,6 +163,34 @@ func Provider() *schema.Provider {
 				Description: "IAM Authentication refresh token",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_IAM_REFRESH_TOKEN", "IBMCLOUD_IAM_REFRESH_TOKEN"}, nil),
 			},
+			"assume_trusted_profile": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The trusted profile assumed with the compute resource token of the workload running terraform.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"profile_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The ID of the trusted profile",
+							DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_IAM_PROFILE_ID", "IBMCLOUD_IAM_PROFILE_ID"}, nil),
+						},
+						"profile_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The name of the trusted profile",
+							DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_IAM_PROFILE_NAME", "IBMCLOUD_IAM_PROFILE_NAME"}, nil),
+						},
+						"cr_token_file": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Path of the file with the compute resource token",
+							DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_CR_TOKEN_FILENAME", "IBMCLOUD_CR_TOKEN_FILENAME"}, defaultCRTokenFile),
+						},
+					},
+				},
+			},
 			"visibility": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -161,6 +198,38 @@ func Provider() *schema.Provider {
 				Description:  "Visibility of the provider if it is private or public.",
 				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"IC_VISIBILITY", "IBMCLOUD_VISIBILITY"}, "public"),
 			},
+			"endpoints": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The service endpoints overriding the default and environment provided endpoints.",
+				Elem: &schema.Resource{
+					Schema: endpointsSchema(),
+				},
+			},
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The tags attached to every resource supporting tags.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         resourceIBMVPCHash,
+							Description: "List of tags",
+						},
+					},
+				},
+			},
+			"endpoints_file_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path of the JSON file with the service endpoints keyed by service, visibility and region.",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_ENDPOINTS_FILE_PATH", "IBMCLOUD_ENDPOINTS_FILE_PATH"}, nil),
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -179,6 +248,7 @@ func Provider() *schema.Provider {
 			"ibm_certificate_manager_certificate":    dataIBMCertificateManagerCertificate(),
 			"ibm_cis":                                dataSourceIBMCISInstance(),
 			"ibm_cis_dns_records":                    dataSourceIBMCISDNSRecords(),
+			"ibm_cis_dns_zone_file":                  dataSourceIBMCISDNSZoneFile(),
 			"ibm_cis_certificates":                   dataIBMCISCertificates(),
 			"ibm_cis_global_load_balancers":          dataSourceIBMCISGlbs(),
 			"ibm_cis_origin_pools":                   dataSourceIBMCISOriginPools(),
@@ -272,6 +342,8 @@ func Provider() *schema.Provider {
 			"ibm_is_public_gateway":                  dataSourceIBMISPublicGateway(),
 			"ibm_is_public_gateways":                 dataSourceIBMISPublicGateways(),
 			"ibm_is_region":                          dataSourceIBMISRegion(),
+			"ibm_is_snapshot":                        dataSourceIBMISSnapshot(),
+			"ibm_is_snapshots":                       dataSourceIBMISSnapshots(),
 			"ibm_is_ssh_key":                         dataSourceIBMISSSHKey(),
 			"ibm_is_subnet":                          dataSourceIBMISSubnet(),
 			"ibm_is_subnets":                         dataSourceIBMISSubnets(),
@@ -345,12 +417,14 @@ func Provider() *schema.Provider {
 
 			// Added for private dns zones
 
-			"ibm_dns_zones":              dataSourceIBMPrivateDNSZones(),
-			"ibm_dns_permitted_networks": dataSourceIBMPrivateDNSPermittedNetworks(),
-			"ibm_dns_resource_records":   dataSourceIBMPrivateDNSResourceRecords(),
-			"ibm_dns_glb_monitors":       dataSourceIBMPrivateDNSGLBMonitors(),
-			"ibm_dns_glb_pools":          dataSourceIBMPrivateDNSGLBPools(),
-			"ibm_dns_glbs":               dataSourceIBMPrivateDNSGLBs(),
+			"ibm_dns_zones":                            dataSourceIBMPrivateDNSZones(),
+			"ibm_dns_permitted_networks":               dataSourceIBMPrivateDNSPermittedNetworks(),
+			"ibm_dns_resource_records":                 dataSourceIBMPrivateDNSResourceRecords(),
+			"ibm_dns_glb_monitors":                     dataSourceIBMPrivateDNSGLBMonitors(),
+			"ibm_dns_glb_pools":                        dataSourceIBMPrivateDNSGLBPools(),
+			"ibm_dns_glbs":                             dataSourceIBMPrivateDNSGLBs(),
+			"ibm_dns_custom_resolvers":                 dataSourceIBMPrivateDNSCustomResolvers(),
+			"ibm_dns_custom_resolver_forwarding_rules": dataSourceIBMPrivateDNSCustomResolverForwardingRules(),
 
 			// Added for Direct Link
 
@@ -363,12 +437,14 @@ func Provider() *schema.Provider {
 			"ibm_dl_routers":           dataSourceIBMDLRouters(),
 			"ibm_dl_provider_ports":    dataSourceIBMDirectLinkProviderPorts(),
 			"ibm_dl_provider_gateways": dataSourceIBMDirectLinkProviderGateways(),
+			"ibm_dl_route_report":      dataSourceIBMDLRouteReport(),
 
 			//Added for Transit Gateway
-			"ibm_tg_gateway":   dataSourceIBMTransitGateway(),
-			"ibm_tg_gateways":  dataSourceIBMTransitGateways(),
-			"ibm_tg_locations": dataSourceIBMTransitGatewaysLocations(),
-			"ibm_tg_location":  dataSourceIBMTransitGatewaysLocation(),
+			"ibm_tg_gateway":      dataSourceIBMTransitGateway(),
+			"ibm_tg_gateways":     dataSourceIBMTransitGateways(),
+			"ibm_tg_locations":    dataSourceIBMTransitGatewaysLocations(),
+			"ibm_tg_location":     dataSourceIBMTransitGatewaysLocation(),
+			"ibm_tg_route_report": dataSourceIBMTransitGatewayRouteReport(),
 
 			//Added for BSS Enterprise
 			"ibm_enterprises":               dataSourceIbmEnterprises(),
@@ -405,13 +481,15 @@ func Provider() *schema.Provider {
 			"ibm_function_rule":                                  resourceIBMFunctionRule(),
 			"ibm_function_trigger":                               resourceIBMFunctionTrigger(),
 			"ibm_function_namespace":                             resourceIBMFunctionNamespace(),
-			"ibm_cis":                                            resourceIBMCISInstance(),
-			"ibm_database":                                       resourceIBMDatabaseInstance(),
+			"ibm_cis":                                            resourceWithDefaultTags(resourceIBMCISInstance()),
+			"ibm_database":                                       resourceWithDefaultTags(resourceIBMDatabaseInstance()),
 			"ibm_certificate_manager_import":                     resourceIBMCertificateManagerImport(),
 			"ibm_certificate_manager_order":                      resourceIBMCertificateManagerOrder(),
 			"ibm_cis_domain":                                     resourceIBMCISDomain(),
 			"ibm_cis_domain_settings":                            resourceIBMCISSettings(),
 			"ibm_cis_firewall":                                   resourceIBMCISFirewallRecord(),
+			"ibm_cis_filter":                                     resourceIBMCISFilter(),
+			"ibm_cis_firewall_rule":                              resourceIBMCISFirewallRule(),
 			"ibm_cis_range_app":                                  resourceIBMCISRangeApp(),
 			"ibm_cis_healthcheck":                                resourceIBMCISHealthCheck(),
 			"ibm_cis_origin_pool":                                resourceIBMCISPool(),
@@ -419,6 +497,7 @@ func Provider() *schema.Provider {
 			"ibm_cis_certificate_upload":                         resourceIBMCISCertificateUpload(),
 			"ibm_cis_dns_record":                                 resourceIBMCISDnsRecord(),
 			"ibm_cis_dns_records_import":                         resourceIBMCISDNSRecordsImport(),
+			"ibm_cis_dns_zone_file":                              resourceIBMCISDNSZoneFile(),
 			"ibm_cis_rate_limit":                                 resourceIBMCISRateLimit(),
 			"ibm_cis_page_rule":                                  resourceIBMCISPageRule(),
 			"ibm_cis_edge_functions_action":                      resourceIBMCISEdgeFunctionsAction(),
@@ -447,9 +526,10 @@ func Provider() *schema.Provider {
 			"ibm_container_api_key_reset":                        resourceIBMContainerAPIKeyReset(),
 			"ibm_container_vpc_alb":                              resourceIBMContainerVpcALB(),
 			"ibm_container_vpc_worker_pool":                      resourceIBMContainerVpcWorkerPool(),
-			"ibm_container_vpc_cluster":                          resourceIBMContainerVpcCluster(),
+			"ibm_container_vpc_worker_pool_autoscaler":           resourceIBMContainerVpcWorkerPoolAutoscaler(),
+			"ibm_container_vpc_cluster":                          resourceWithDefaultTags(resourceIBMContainerVpcCluster()),
 			"ibm_container_alb_cert":                             resourceIBMContainerALBCert(),
-			"ibm_container_cluster":                              resourceIBMContainerCluster(),
+			"ibm_container_cluster":                              resourceWithDefaultTags(resourceIBMContainerCluster()),
 			"ibm_container_cluster_feature":                      resourceIBMContainerClusterFeature(),
 			"ibm_container_bind_service":                         resourceIBMContainerBindService(),
 			"ibm_container_worker_pool":                          resourceIBMContainerWorkerPool(),
@@ -465,6 +545,12 @@ func Provider() *schema.Provider {
 			"ibm_dns_secondary":                                  resourceIBMDNSSecondary(),
 			"ibm_dns_record":                                     resourceIBMDNSRecord(),
 			"ibm_event_streams_topic":                            resourceIBMEventStreamsTopic(),
+			"ibm_event_streams_acl":                              resourceIBMEventStreamsACL(),
+			"ibm_event_streams_quota":                            resourceIBMEventStreamsQuota(),
+			"ibm_event_streams_schema":                           resourceIBMEventStreamsSchema(),
+			"ibm_secrets_manager_secret_group":                   resourceIBMSecretsManagerSecretGroup(),
+			"ibm_secrets_manager_secret":                         resourceIBMSecretsManagerSecret(),
+			"ibm_secrets_manager_secret_rotation_policy":         resourceIBMSecretsManagerSecretRotationPolicy(),
 			"ibm_firewall":                                       resourceIBMFirewall(),
 			"ibm_firewall_policy":                                resourceIBMFirewallPolicy(),
 			"ibm_iam_access_group":                               resourceIBMIAMAccessGroup(),
@@ -482,48 +568,57 @@ func Provider() *schema.Provider {
 			"ibm_iam_service_policy":                             resourceIBMIAMServicePolicy(),
 			"ibm_iam_user_invite":                                resourceIBMUserInvite(),
 			"ibm_iam_api_key":                                    resourceIbmIamApiKey(),
+			"ibm_iam_trusted_profile":                            resourceIBMIAMTrustedProfile(),
+			"ibm_iam_trusted_profile_claim_rule":                 resourceIBMIAMTrustedProfileClaimRule(),
+			"ibm_iam_trusted_profile_link":                       resourceIBMIAMTrustedProfileLink(),
+			"ibm_iam_trusted_profile_policy":                     resourceIBMIAMTrustedProfilePolicy(),
 			"ibm_ipsec_vpn":                                      resourceIBMIPSecVPN(),
 			"ibm_is_dedicated_host":                              resourceIbmIsDedicatedHost(),
 			"ibm_is_dedicated_host_group":                        resourceIbmIsDedicatedHostGroup(),
 			"ibm_is_dedicated_host_disk_management":              resourceIBMISDedicatedHostDiskManagement(),
-			"ibm_is_floating_ip":                                 resourceIBMISFloatingIP(),
-			"ibm_is_flow_log":                                    resourceIBMISFlowLog(),
-			"ibm_is_instance":                                    resourceIBMISInstance(),
+			"ibm_is_floating_ip":                                 resourceWithDefaultTags(resourceIBMISFloatingIP()),
+			"ibm_is_flow_log":                                    resourceWithDefaultTags(resourceIBMISFlowLog()),
+			"ibm_is_instance":                                    resourceWithDefaultTags(resourceIBMISInstance()),
+			"ibm_is_instance_action":                             resourceIBMISInstanceAction(),
 			"ibm_is_instance_disk_management":                    resourceIBMISInstanceDiskManagement(),
-			"ibm_is_instance_group":                              resourceIBMISInstanceGroup(),
+			"ibm_is_instance_group":                              resourceWithDefaultTags(resourceIBMISInstanceGroup()),
 			"ibm_is_instance_group_membership":                   resourceIBMISInstanceGroupMembership(),
 			"ibm_is_instance_group_manager":                      resourceIBMISInstanceGroupManager(),
 			"ibm_is_instance_group_manager_policy":               resourceIBMISInstanceGroupManagerPolicy(),
-			"ibm_is_virtual_endpoint_gateway":                    resourceIBMISEndpointGateway(),
+			"ibm_is_virtual_endpoint_gateway":                    resourceWithDefaultTags(resourceIBMISEndpointGateway()),
 			"ibm_is_virtual_endpoint_gateway_ip":                 resourceIBMISEndpointGatewayIP(),
 			"ibm_is_instance_template":                           resourceIBMISInstanceTemplate(),
+			"ibm_is_instance_network_interface":                  resourceIBMISInstanceNetworkInterface(),
+			"ibm_is_instance_volume_attachment":                  resourceIBMISInstanceVolumeAttachment(),
 			"ibm_is_ike_policy":                                  resourceIBMISIKEPolicy(),
 			"ibm_is_ipsec_policy":                                resourceIBMISIPSecPolicy(),
-			"ibm_is_lb":                                          resourceIBMISLB(),
+			"ibm_is_lb":                                          resourceWithDefaultTags(resourceIBMISLB()),
 			"ibm_is_lb_listener":                                 resourceIBMISLBListener(),
 			"ibm_is_lb_listener_policy":                          resourceIBMISLBListenerPolicy(),
 			"ibm_is_lb_listener_policy_rule":                     resourceIBMISLBListenerPolicyRule(),
 			"ibm_is_lb_pool":                                     resourceIBMISLBPool(),
 			"ibm_is_lb_pool_member":                              resourceIBMISLBPoolMember(),
-			"ibm_is_network_acl":                                 resourceIBMISNetworkACL(),
-			"ibm_is_public_gateway":                              resourceIBMISPublicGateway(),
-			"ibm_is_security_group":                              resourceIBMISSecurityGroup(),
+			"ibm_is_network_acl":                                 resourceWithDefaultTags(resourceIBMISNetworkACL()),
+			"ibm_is_network_acl_rule":                            resourceIBMISNetworkACLRule(),
+			"ibm_is_public_gateway":                              resourceWithDefaultTags(resourceIBMISPublicGateway()),
+			"ibm_is_security_group":                              resourceWithDefaultTags(resourceIBMISSecurityGroup()),
 			"ibm_is_security_group_rule":                         resourceIBMISSecurityGroupRule(),
 			"ibm_is_security_group_target":                       resourceIBMISSecurityGroupTarget(),
 			"ibm_is_security_group_network_interface_attachment": resourceIBMISSecurityGroupNetworkInterfaceAttachment(),
-			"ibm_is_subnet":                                      resourceIBMISSubnet(),
+			"ibm_is_subnet":                                      resourceWithDefaultTags(resourceIBMISSubnet()),
 			"ibm_is_subnet_reserved_ip":                          resourceIBMISReservedIP(),
 			"ibm_is_subnet_network_acl_attachment":               resourceIBMISSubnetNetworkACLAttachment(),
-			"ibm_is_ssh_key":                                     resourceIBMISSSHKey(),
-			"ibm_is_volume":                                      resourceIBMISVolume(),
-			"ibm_is_vpn_gateway":                                 resourceIBMISVPNGateway(),
+			"ibm_is_ssh_key":                                     resourceWithDefaultTags(resourceIBMISSSHKey()),
+			"ibm_is_snapshot":                                    resourceWithDefaultTags(resourceIBMISSnapshot()),
+			"ibm_is_volume":                                      resourceWithDefaultTags(resourceIBMISVolume()),
+			"ibm_is_vpn_gateway":                                 resourceWithDefaultTags(resourceIBMISVPNGateway()),
 			"ibm_is_vpn_gateway_connection":                      resourceIBMISVPNGatewayConnection(),
-			"ibm_is_vpc":                                         resourceIBMISVPC(),
+			"ibm_is_vpc":                                         resourceWithDefaultTags(resourceIBMISVPC()),
 			"ibm_is_vpc_address_prefix":                          resourceIBMISVpcAddressPrefix(),
 			"ibm_is_vpc_route":                                   resourceIBMISVpcRoute(),
 			"ibm_is_vpc_routing_table":                           resourceIBMISVPCRoutingTable(),
 			"ibm_is_vpc_routing_table_route":                     resourceIBMISVPCRoutingTableRoute(),
-			"ibm_is_image":                                       resourceIBMISImage(),
+			"ibm_is_image":                                       resourceWithDefaultTags(resourceIBMISImage()),
 			"ibm_lb":                                             resourceIBMLb(),
 			"ibm_lbaas":                                          resourceIBMLbaas(),
 			"ibm_lbaas_health_monitor":                           resourceIBMLbaasHealthMonitor(),
@@ -551,9 +646,13 @@ func Provider() *schema.Provider {
 			"ibm_kms_key":                                        resourceIBMKmskey(),
 			"ibm_kms_key_alias":                                  resourceIBMKmskeyAlias(),
 			"ibm_kms_key_rings":                                  resourceIBMKmskeyRings(),
+			"ibm_kms_key_rotation":                               resourceIBMKmsKeyRotation(),
+			"ibm_kms_instance_policies":                          resourceIBMKmsInstancePolicies(),
+			"ibm_kms_kmip_adapter":                               resourceIBMKmsKmipAdapter(),
+			"ibm_kms_kmip_client_cert":                           resourceIBMKmsKmipClientCert(),
 			"ibm_kp_key":                                         resourceIBMkey(),
 			"ibm_resource_group":                                 resourceIBMResourceGroup(),
-			"ibm_resource_instance":                              resourceIBMResourceInstance(),
+			"ibm_resource_instance":                              resourceWithDefaultTags(resourceIBMResourceInstance()),
 			"ibm_resource_key":                                   resourceIBMResourceKey(),
 			"ibm_security_group":                                 resourceIBMSecurityGroup(),
 			"ibm_security_group_rule":                            resourceIBMSecurityGroupRule(),
@@ -584,20 +683,25 @@ func Provider() *schema.Provider {
 			"ibm_pi_network_port_attach": resourceIBMPINetworkPortAttach(),
 
 			//Private DNS related resources
-			"ibm_dns_zone":              resourceIBMPrivateDNSZone(),
-			"ibm_dns_permitted_network": resourceIBMPrivateDNSPermittedNetwork(),
-			"ibm_dns_resource_record":   resourceIBMPrivateDNSResourceRecord(),
-			"ibm_dns_glb_monitor":       resourceIBMPrivateDNSGLBMonitor(),
-			"ibm_dns_glb_pool":          resourceIBMPrivateDNSGLBPool(),
-			"ibm_dns_glb":               resourceIBMPrivateDNSGLB(),
+			"ibm_dns_zone":                            resourceIBMPrivateDNSZone(),
+			"ibm_dns_permitted_network":               resourceIBMPrivateDNSPermittedNetwork(),
+			"ibm_dns_resource_record":                 resourceIBMPrivateDNSResourceRecord(),
+			"ibm_dns_glb_monitor":                     resourceIBMPrivateDNSGLBMonitor(),
+			"ibm_dns_glb_pool":                        resourceIBMPrivateDNSGLBPool(),
+			"ibm_dns_glb":                             resourceIBMPrivateDNSGLB(),
+			"ibm_dns_custom_resolver":                 resourceIBMPrivateDNSCustomResolver(),
+			"ibm_dns_custom_resolver_forwarding_rule": resourceIBMPrivateDNSCustomResolverForwardingRule(),
 
 			//Direct Link related resources
-			"ibm_dl_gateway":            resourceIBMDLGateway(),
+			"ibm_dl_gateway":            resourceWithDefaultTags(resourceIBMDLGateway()),
 			"ibm_dl_virtual_connection": resourceIBMDLGatewayVC(),
-			"ibm_dl_provider_gateway":   resourceIBMDLProviderGateway(),
+			"ibm_dl_provider_gateway":   resourceWithDefaultTags(resourceIBMDLProviderGateway()),
+			"ibm_dl_gateway_action":     resourceIBMDLGatewayAction(),
+			"ibm_dl_gateway_macsec_cak": resourceIBMDLGatewayMacsecCak(),
 			//Added for Transit Gateway
-			"ibm_tg_gateway":    resourceIBMTransitGateway(),
-			"ibm_tg_connection": resourceIBMTransitGatewayConnection(),
+			"ibm_tg_gateway":                  resourceWithDefaultTags(resourceIBMTransitGateway()),
+			"ibm_tg_connection":               resourceIBMTransitGatewayConnection(),
+			"ibm_tg_connection_prefix_filter": resourceIBMTransitGatewayConnectionPrefixFilter(),
 
 			//Catalog related resources
 			"ibm_cm_offering_instance": resourceIBMCmOfferingInstance(),
@@ -616,7 +720,7 @@ func Provider() *schema.Provider {
 			"ibm_schematics_job":       resourceIBMSchematicsJob(),
 
 			//satellite  resources
-			"ibm_satellite_location": resourceIBMSatelliteLocation(),
+			"ibm_satellite_location": resourceWithDefaultTags(resourceIBMSatelliteLocation()),
 			"ibm_satellite_host":     resourceIBMSatelliteHost(),
 
 			//Resource Tag
@@ -650,6 +754,7 @@ func Validator() ValidatorDict {
 				"ibm_cis_cache_settings":                resourceIBMCISCacheSettingsValidator(),
 				"ibm_cis_custom_page":                   resourceIBMCISCustomPageValidator(),
 				"ibm_cis_firewall":                      resourceIBMCISFirewallValidator(),
+				"ibm_cis_firewall_rule":                 resourceIBMCISFirewallRuleValidator(),
 				"ibm_cis_range_app":                     resourceIBMCISRangeAppValidator(),
 				"ibm_cis_waf_rule":                      resourceIBMCISWAFRuleValidator(),
 				"ibm_cis_certificate_order":             resourceIBMCISCertificateOrderValidator(),
@@ -657,9 +762,12 @@ func Validator() ValidatorDict {
 				"ibm_tg_gateway":                        resourceIBMTGValidator(),
 				"ibm_app_config_feature":                resourceIbmAppConfigFeatureValidator(),
 				"ibm_tg_connection":                     resourceIBMTransitGatewayConnectionValidator(),
+				"ibm_tg_connection_prefix_filter":       resourceIBMTransitGatewayConnectionPrefixFilterValidator(),
 				"ibm_dl_virtual_connection":             resourceIBMdlGatewayVCValidator(),
 				"ibm_dl_gateway":                        resourceIBMDLGatewayValidator(),
 				"ibm_dl_provider_gateway":               resourceIBMDLProviderGatewayValidator(),
+				"ibm_dl_gateway_action":                 resourceIBMDLGatewayActionValidator(),
+				"ibm_dl_gateway_macsec_cak":             resourceIBMDLGatewayMacsecCakValidator(),
 				"ibm_database":                          resourceIBMICDValidator(),
 				"ibm_function_package":                  resourceIBMFuncPackageValidator(),
 				"ibm_function_action":                   resourceIBMFuncActionValidator(),
@@ -678,7 +786,10 @@ func Validator() ValidatorDict {
 				"ibm_is_ike_policy":                     resourceIBMISIKEValidator(),
 				"ibm_is_image":                          resourceIBMISImageValidator(),
 				"ibm_is_instance":                       resourceIBMISInstanceValidator(),
+				"ibm_is_instance_action":                resourceIBMISInstanceActionValidator(),
 				"ibm_is_instance_disk_management":       resourceIBMISInstanceDiskManagementValidator(),
+				"ibm_is_instance_network_interface":     resourceIBMISInstanceNetworkInterfaceValidator(),
+				"ibm_is_instance_volume_attachment":     resourceIBMISInstanceVolumeAttachmentValidator(),
 				"ibm_is_ipsec_policy":                   resourceIBMISIPSECValidator(),
 				"ibm_is_lb_listener_policy_rule":        resourceIBMISLBListenerPolicyRuleValidator(),
 				"ibm_is_lb_listener_policy":             resourceIBMISLBListenerPolicyValidator(),
@@ -686,11 +797,13 @@ func Validator() ValidatorDict {
 				"ibm_is_lb_pool":                        resourceIBMISLBPoolValidator(),
 				"ibm_is_lb":                             resourceIBMISLBValidator(),
 				"ibm_is_network_acl":                    resourceIBMISNetworkACLValidator(),
+				"ibm_is_network_acl_rule":               resourceIBMISNetworkACLRuleValidator(),
 				"ibm_is_public_gateway":                 resourceIBMISPublicGatewayValidator(),
 				"ibm_is_security_group_target":          resourceIBMISSecurityGroupTargetValidator(),
 				"ibm_is_security_group_rule":            resourceIBMISSecurityGroupRuleValidator(),
 				"ibm_is_security_group":                 resourceIBMISSecurityGroupValidator(),
 				"ibm_is_ssh_key":                        resourceIBMISSHKeyValidator(),
+				"ibm_is_snapshot":                       resourceIBMISSnapshotValidator(),
 				"ibm_is_subnet":                         resourceIBMISSubnetValidator(),
 				"ibm_is_subnet_reserved_ip":             resourceIBMISSubnetReservedIPValidator(),
 				"ibm_is_volume":                         resourceIBMISVolumeValidator(),
@@ -728,6 +841,19 @@ func Validator() ValidatorDict {
 	return globalValidatorDict
 }
 
+func endpointsSchema() map[string]*schema.Schema {
+	endpoints := make(map[string]*schema.Schema, len(endpointServices))
+	for service, envs := range endpointServices {
+		endpoints[service] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			Description:  fmt.Sprintf("The %s service endpoint, overriding the %s environment variable.", service, strings.Join(envs, ", ")),
+		}
+	}
+	return endpoints
+}
+
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	var bluemixAPIKey string
 	var bluemixTimeout int
@@ -744,6 +870,13 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	if rtoken, ok := d.GetOk("iam_refresh_token"); ok {
 		iamRefreshToken = rtoken.(string)
 	}
+	var trustedProfileID, trustedProfileName, crTokenFile string
+	if v, ok := d.GetOk("assume_trusted_profile"); ok && v.([]interface{})[0] != nil {
+		profile := v.([]interface{})[0].(map[string]interface{})
+		trustedProfileID = profile["profile_id"].(string)
+		trustedProfileName = profile["profile_name"].(string)
+		crTokenFile = profile["cr_token_file"].(string)
+	}
 	var softlayerUsername, softlayerAPIKey, softlayerEndpointUrl string
 	var softlayerTimeout int
 	if username, ok := d.GetOk("softlayer_username"); ok {
@@ -782,10 +915,34 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		visibility = v.(string)
 	}
 
+	endpoints := map[string]string{}
+	if v, ok := d.GetOk("endpoints"); ok && v.([]interface{})[0] != nil {
+		for service, endpoint := range v.([]interface{})[0].(map[string]interface{}) {
+			if endpoint.(string) != "" {
+				endpoints[service] = endpoint.(string)
+			}
+		}
+	}
+	var endpointsFile EndpointsFile
+	if v, ok := d.GetOk("endpoints_file_path"); ok {
+		file, err := loadEndpointsFile(v.(string))
+		if err != nil {
+			return nil, err
+		}
+		endpointsFile = file
+	}
+
+	var defaultTags []string
+	if v, ok := d.GetOk("default_tags"); ok && v.([]interface{})[0] != nil {
+		tags := v.([]interface{})[0].(map[string]interface{})["tags"].(*schema.Set)
+		defaultTags = expandStringList(tags.List())
+	}
+
 	resourceGrp := d.Get("resource_group").(string)
 	region := d.Get("region").(string)
 	zone := d.Get("zone").(string)
 	retryCount := d.Get("max_retries").(int)
+	retryMaxWait := d.Get("retry_max_wait").(int)
 	wskNameSpace := d.Get("function_namespace").(string)
 	riaasEndPoint := d.Get("riaas_endpoint").(string)
 
@@ -809,12 +966,19 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		RetryCount:           retryCount,
 		SoftLayerEndpointURL: softlayerEndpointUrl,
 		RetryDelay:           RetryAPIDelay,
+		RetryMaxWait:         time.Duration(retryMaxWait) * time.Second,
 		FunctionNameSpace:    wskNameSpace,
 		RiaasEndPoint:        riaasEndPoint,
 		IAMToken:             iamToken,
 		IAMRefreshToken:      iamRefreshToken,
+		TrustedProfileID:     trustedProfileID,
+		TrustedProfileName:   trustedProfileName,
+		CRTokenFile:          crTokenFile,
 		Zone:                 zone,
 		Visibility:           visibility,
+		Endpoints:            endpoints,
+		EndpointsFile:        endpointsFile,
+		DefaultTags:          defaultTags,
 		//PowerServiceInstance: powerServiceInstance,
 	}
 
@@ -98,6 +98,11 @@ var image_operating_system string
 // Transit Gateway cross account
 var tg_cross_network_account_id string
 var tg_cross_network_id string
+var kmipClientCert string
+var iamTrustedProfileVSICRN string
+var dlProviderGatewayID string
+var dlMacsecGatewayID string
+var dlMacsecCakCRN string
 
 //Enterprise Management
 var account_to_be_imported string
@@ -552,6 +557,26 @@ func init() {
 	if tg_cross_network_id == "" {
 		fmt.Println("[INFO] Set the environment variable IBM_TG_CROSS_NETWORK_ID for testing ibm_tg_connection resource else  tests will fail if this is not set correctly")
 	}
+	kmipClientCert = os.Getenv("IBM_KMS_KMIP_CLIENT_CERT")
+	if kmipClientCert == "" {
+		fmt.Println("[INFO] Set the environment variable IBM_KMS_KMIP_CLIENT_CERT for testing ibm_kms_kmip_client_cert resource else  tests will fail if this is not set correctly")
+	}
+	iamTrustedProfileVSICRN = os.Getenv("IBM_IAM_TRUSTED_PROFILE_VSI_CRN")
+	if iamTrustedProfileVSICRN == "" {
+		fmt.Println("[INFO] Set the environment variable IBM_IAM_TRUSTED_PROFILE_VSI_CRN for testing ibm_iam_trusted_profile_link resource else  tests will fail if this is not set correctly")
+	}
+	dlProviderGatewayID = os.Getenv("IBM_DL_PROVIDER_GATEWAY_ID")
+	if dlProviderGatewayID == "" {
+		fmt.Println("[INFO] Set the environment variable IBM_DL_PROVIDER_GATEWAY_ID for testing ibm_dl_gateway_action resource else  tests will fail if this is not set correctly")
+	}
+	dlMacsecGatewayID = os.Getenv("IBM_DL_MACSEC_GATEWAY_ID")
+	if dlMacsecGatewayID == "" {
+		fmt.Println("[INFO] Set the environment variable IBM_DL_MACSEC_GATEWAY_ID for testing ibm_dl_gateway_macsec_cak resource else  tests will fail if this is not set correctly")
+	}
+	dlMacsecCakCRN = os.Getenv("IBM_DL_MACSEC_CAK_CRN")
+	if dlMacsecCakCRN == "" {
+		fmt.Println("[INFO] Set the environment variable IBM_DL_MACSEC_CAK_CRN for testing ibm_dl_gateway_macsec_cak resource else  tests will fail if this is not set correctly")
+	}
 	account_to_be_imported = os.Getenv("ACCOUNT_TO_BE_IMPORTED")
 	if account_to_be_imported == "" {
 		fmt.Println("[INFO] Set the environment variable ACCOUNT_TO_BE_IMPORTED for testing import enterprise account resource else  tests will fail if this is not set correctly")
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"path"
@@ -11,18 +12,18 @@ import (
 
 	apigatewaysdk "github.com/IBM/apigateway-go-sdk"
 	"github.com/ghodss/yaml"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMApiGatewayEndPoint() *schema.Resource {
 
 	return &schema.Resource{
-		Create:   resourceIBMApiGatewayEndPointCreate,
-		Read:     resourceIBMApiGatewayEndPointGet,
-		Update:   resourceIBMApiGatewayEndPointUpdate,
-		Delete:   resourceIBMApiGatewayEndPointDelete,
-		Importer: &schema.ResourceImporter{},
-		Exists:   resourceIBMApiGatewayEndPointExists,
+		CreateContext: resourceIBMApiGatewayEndPointCreate,
+		ReadContext:   resourceIBMApiGatewayEndPointGet,
+		UpdateContext: resourceIBMApiGatewayEndPointUpdate,
+		DeleteContext: resourceIBMApiGatewayEndPointDelete,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			"service_instance_crn": {
 				Type:        schema.TypeString,
@@ -83,14 +84,14 @@ func resourceIBMApiGatewayEndPoint() *schema.Resource {
 	}
 }
 
-func resourceIBMApiGatewayEndPointCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMApiGatewayEndPointCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	endpointservice, err := meta.(ClientSession).APIGateway()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	payload := &apigatewaysdk.CreateEndpointOptions{}
 
@@ -117,23 +118,23 @@ func resourceIBMApiGatewayEndPointCreate(d *schema.ResourceData, meta interface{
 			data, err := ioutil.ReadFile(openAPIDocName)
 			if err != nil {
 				fmt.Println("Error uploading file", err)
-				return err
+				return diag.FromErr(err)
 			}
 			document = data
 		} else if strings.ToLower(ext) == ".yaml" || strings.ToLower(ext) == ".yml" {
 			data, err := ioutil.ReadFile(openAPIDocName)
 			if err != nil {
 				fmt.Println("Error uploading file", err)
-				return err
+				return diag.FromErr(err)
 			}
 			y2j, yErr := yaml.YAMLToJSON(data)
 			if yErr != nil {
 				fmt.Println("Error parsing yaml file", err)
-				return err
+				return diag.FromErr(err)
 			}
 			document = y2j
 		} else {
-			return fmt.Errorf("File extension type must be json or yaml")
+			return diag.FromErr(fmt.Errorf("File extension type must be json or yaml"))
 
 		}
 	}
@@ -152,22 +153,31 @@ func resourceIBMApiGatewayEndPointCreate(d *schema.ResourceData, meta interface{
 
 	result, response, err := endpointservice.CreateEndpoint(payload)
 	if err != nil {
-		return fmt.Errorf("Error creating Endpoint: %s,%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error creating Endpoint: %s,%s", err, response))
 	}
 
 	d.SetId(fmt.Sprintf("%s//%s", *result.ServiceInstanceCrn, *result.ArtifactID))
 
-	return resourceIBMApiGatewayEndPointGet(d, meta)
+	return resourceIBMApiGatewayEndPointGet(context, d, meta)
 }
 
-func resourceIBMApiGatewayEndPointGet(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMApiGatewayEndPointGet(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMApiGatewayEndPointExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	endpointservice, err := meta.(ClientSession).APIGateway()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts := d.Id()
@@ -190,7 +200,7 @@ func resourceIBMApiGatewayEndPointGet(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Endpoint: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error Getting Endpoint: %s\n%s", err, response))
 	}
 	d.Set("service_instance_crn", serviceInstanceCrn)
 	d.Set("endpoint_id", apiID)
@@ -209,14 +219,14 @@ func resourceIBMApiGatewayEndPointGet(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceIBMApiGatewayEndPointUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMApiGatewayEndPointUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	endpointservice, err := meta.(ClientSession).APIGateway()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	//payload for updating endpoint
 	payload := &apigatewaysdk.UpdateEndpointOptions{}
@@ -251,23 +261,23 @@ func resourceIBMApiGatewayEndPointUpdate(d *schema.ResourceData, meta interface{
 			data, err := ioutil.ReadFile(openAPIDocName)
 			if err != nil {
 				fmt.Println("Error uploading file", err)
-				return err
+				return diag.FromErr(err)
 			}
 			document = data
 		} else if strings.ToLower(ext) == ".yaml" || strings.ToLower(ext) == ".yml" {
 			data, err := ioutil.ReadFile(openAPIDocName)
 			if err != nil {
 				fmt.Println("Error uploading file", err)
-				return err
+				return diag.FromErr(err)
 			}
 			y2j, yErr := yaml.YAMLToJSON(data)
 			if yErr != nil {
 				fmt.Println("Error parsing yaml file", err)
-				return err
+				return diag.FromErr(err)
 			}
 			document = y2j
 		} else {
-			return fmt.Errorf("File extension type must be json or yaml")
+			return diag.FromErr(fmt.Errorf("File extension type must be json or yaml"))
 
 		}
 	}
@@ -301,13 +311,13 @@ func resourceIBMApiGatewayEndPointUpdate(d *schema.ResourceData, meta interface{
 		actionType := d.Get("type").(string)
 
 		if managed == false && actionType == "share" {
-			return fmt.Errorf("Endpoint %s not managed", apiID)
+			return diag.FromErr(fmt.Errorf("Endpoint %s not managed", apiID))
 		}
 		actionPayload.Type = &actionType
 
 		_, response, err := endpointservice.EndpointActions(actionPayload)
 		if err != nil {
-			return fmt.Errorf("Error updating Endpoint Action: %s,%s", err, response)
+			return diag.FromErr(fmt.Errorf("Error updating Endpoint Action: %s,%s", err, response))
 		}
 	}
 
@@ -321,23 +331,23 @@ func resourceIBMApiGatewayEndPointUpdate(d *schema.ResourceData, meta interface{
 				data, err := ioutil.ReadFile(openAPIDocName)
 				if err != nil {
 					fmt.Println("Error uploading file", err)
-					return err
+					return diag.FromErr(err)
 				}
 				document = data
 			} else if strings.ToLower(ext) == ".yaml" || strings.ToLower(ext) == ".yml" {
 				data, err := ioutil.ReadFile(openAPIDocName)
 				if err != nil {
 					fmt.Println("Error uploading file", err)
-					return err
+					return diag.FromErr(err)
 				}
 				y2j, yErr := yaml.YAMLToJSON(data)
 				if yErr != nil {
 					fmt.Println("Error parsing yaml file", err)
-					return err
+					return diag.FromErr(err)
 				}
 				document = y2j
 			} else {
-				return fmt.Errorf("File extension type must be json or yaml")
+				return diag.FromErr(fmt.Errorf("File extension type must be json or yaml"))
 
 			}
 		}
@@ -352,19 +362,19 @@ func resourceIBMApiGatewayEndPointUpdate(d *schema.ResourceData, meta interface{
 	if update {
 		_, response, err := endpointservice.UpdateEndpoint(payload)
 		if err != nil {
-			return fmt.Errorf("Error updating Endpoint: %s,%s", err, response)
+			return diag.FromErr(fmt.Errorf("Error updating Endpoint: %s,%s", err, response))
 		}
 	}
-	return resourceIBMApiGatewayEndPointGet(d, meta)
+	return resourceIBMApiGatewayEndPointGet(context, d, meta)
 }
-func resourceIBMApiGatewayEndPointDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMApiGatewayEndPointDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	endpointservice, err := meta.(ClientSession).APIGateway()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts := d.Id()
@@ -387,7 +397,7 @@ func resourceIBMApiGatewayEndPointDelete(d *schema.ResourceData, meta interface{
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error deleting Endpoint: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error deleting Endpoint: %s\n%s", err, response))
 	}
 	d.SetId("")
 
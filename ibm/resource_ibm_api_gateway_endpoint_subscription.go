@@ -4,22 +4,23 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	apigatewaysdk "github.com/IBM/apigateway-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMApiGatewayEndpointSubscription() *schema.Resource {
 
 	return &schema.Resource{
-		Create:   resourceIBMApiGatewayEndpointSubscriptionCreate,
-		Read:     resourceIBMApiGatewayEndpointSubscriptionGet,
-		Update:   resourceIBMApiGatewayEndpointSubscriptionUpdate,
-		Delete:   resourceIBMApiGatewayEndpointSubscriptionDelete,
-		Importer: &schema.ResourceImporter{},
-		Exists:   resourceIBMApiGatewayEndpointSubscriptionExists,
+		CreateContext: resourceIBMApiGatewayEndpointSubscriptionCreate,
+		ReadContext:   resourceIBMApiGatewayEndpointSubscriptionGet,
+		UpdateContext: resourceIBMApiGatewayEndpointSubscriptionUpdate,
+		DeleteContext: resourceIBMApiGatewayEndpointSubscriptionDelete,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			"artifact_id": {
 				Type:        schema.TypeString,
@@ -65,14 +66,14 @@ func resourceIBMApiGatewayEndpointSubscription() *schema.Resource {
 		},
 	}
 }
-func resourceIBMApiGatewayEndpointSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMApiGatewayEndpointSubscriptionCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	endpointservice, err := meta.(ClientSession).APIGateway()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	payload := &apigatewaysdk.CreateSubscriptionOptions{}
 
@@ -114,21 +115,30 @@ func resourceIBMApiGatewayEndpointSubscriptionCreate(d *schema.ResourceData, met
 
 	result, response, err := endpointservice.CreateSubscription(payload)
 	if err != nil {
-		return fmt.Errorf("Error creating Subscription: %s %s", err, response)
+		return diag.FromErr(fmt.Errorf("Error creating Subscription: %s %s", err, response))
 	}
 	d.SetId(fmt.Sprintf("%s//%s", *result.ArtifactID, *result.ClientID))
 
-	return resourceIBMApiGatewayEndpointSubscriptionGet(d, meta)
+	return resourceIBMApiGatewayEndpointSubscriptionGet(context, d, meta)
 }
 
-func resourceIBMApiGatewayEndpointSubscriptionGet(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMApiGatewayEndpointSubscriptionGet(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMApiGatewayEndpointSubscriptionExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	endpointservice, err := meta.(ClientSession).APIGateway()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts := d.Id()
@@ -150,7 +160,7 @@ func resourceIBMApiGatewayEndpointSubscriptionGet(d *schema.ResourceData, meta i
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Subscription: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error Getting Subscription: %s\n%s", err, response))
 	}
 	d.Set("artifact_id", result.ArtifactID)
 	d.Set("client_id", result.ClientID)
@@ -165,14 +175,14 @@ func resourceIBMApiGatewayEndpointSubscriptionGet(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceIBMApiGatewayEndpointSubscriptionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMApiGatewayEndpointSubscriptionUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	endpointservice, err := meta.(ClientSession).APIGateway()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	payload := &apigatewaysdk.UpdateSubscriptionOptions{}
 
@@ -211,26 +221,26 @@ func resourceIBMApiGatewayEndpointSubscriptionUpdate(d *schema.ResourceData, met
 		}
 		_, SecretResponse, err := endpointservice.AddSubscriptionSecret(secretpayload)
 		if err != nil {
-			return fmt.Errorf("Error Adding Secret to Subscription: %s,%s", err, SecretResponse)
+			return diag.FromErr(fmt.Errorf("Error Adding Secret to Subscription: %s,%s", err, SecretResponse))
 		}
 	}
 	if update {
 		_, response, err := endpointservice.UpdateSubscription(payload)
 		if err != nil {
-			return fmt.Errorf("Error updating Subscription: %s,%s", err, response)
+			return diag.FromErr(fmt.Errorf("Error updating Subscription: %s,%s", err, response))
 		}
 	}
-	return resourceIBMApiGatewayEndpointSubscriptionGet(d, meta)
+	return resourceIBMApiGatewayEndpointSubscriptionGet(context, d, meta)
 }
 
-func resourceIBMApiGatewayEndpointSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMApiGatewayEndpointSubscriptionDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	endpointservice, err := meta.(ClientSession).APIGateway()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts := d.Id()
 	partslist := strings.Split(parts, "//")
@@ -250,7 +260,7 @@ func resourceIBMApiGatewayEndpointSubscriptionDelete(d *schema.ResourceData, met
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error deleting Subscription: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error deleting Subscription: %s\n%s", err, response))
 	}
 	d.SetId("")
 
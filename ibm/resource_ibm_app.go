@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -11,18 +12,18 @@ import (
 	v2 "github.com/IBM-Cloud/bluemix-go/api/mccp/mccpv2"
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/bluemix-go/helpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	homedir "github.com/mitchellh/go-homedir"
 )
 
 func resourceIBMApp() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMAppCreate,
-		Read:     resourceIBMAppRead,
-		Update:   resourceIBMAppUpdate,
-		Delete:   resourceIBMAppDelete,
-		Exists:   resourceIBMAppExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMAppCreate,
+		ReadContext:   resourceIBMAppRead,
+		UpdateContext: resourceIBMAppUpdate,
+		DeleteContext: resourceIBMAppDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -126,10 +127,10 @@ func resourceIBMApp() *schema.Resource {
 	}
 }
 
-func resourceIBMAppCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	appAPI := cfClient.Apps()
 	name := d.Get("name").(string)
@@ -177,13 +178,13 @@ func resourceIBMAppCreate(d *schema.ResourceData, meta interface{}) error {
 
 	_, err = appAPI.FindByName(spaceGUID, name)
 	if err == nil {
-		return fmt.Errorf("%s already exists in the given space %s", name, spaceGUID)
+		return diag.FromErr(fmt.Errorf("%s already exists in the given space %s", name, spaceGUID))
 	}
 
 	log.Println("[INFO] Creating Cloud Foundary Application")
 	app, err := appAPI.Create(appCreatePayload)
 	if err != nil {
-		return fmt.Errorf("Error creating app: %s", err)
+		return diag.FromErr(fmt.Errorf("Error creating app: %s", err))
 	}
 
 	appGUID := app.Metadata.GUID
@@ -196,7 +197,7 @@ func resourceIBMAppCreate(d *schema.ResourceData, meta interface{}) error {
 		for _, routeID := range v.List() {
 			_, err := appAPI.BindRoute(appGUID, routeID.(string))
 			if err != nil {
-				return fmt.Errorf("Error binding route %s to app: %s", routeID.(string), err)
+				return diag.FromErr(fmt.Errorf("Error binding route %s to app: %s", routeID.(string), err))
 			}
 		}
 	}
@@ -209,40 +210,49 @@ func resourceIBMAppCreate(d *schema.ResourceData, meta interface{}) error {
 			}
 			_, err := sbAPI.Create(req)
 			if err != nil {
-				return fmt.Errorf("Error binding service instance %s to  app: %s", svcID.(string), err)
+				return diag.FromErr(fmt.Errorf("Error binding service instance %s to  app: %s", svcID.(string), err))
 			}
 		}
 	}
 	log.Println("[INFO] Upload the app bits to the cloud foundary application")
 	applicationZip, err := processAppZipPath(d.Get("app_path").(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_, err = appAPI.Upload(appGUID, applicationZip)
 	if err != nil {
-		return fmt.Errorf("Error uploading app bits: %s", err)
+		return diag.FromErr(fmt.Errorf("Error uploading app bits: %s", err))
 	}
 
 	err = restartApp(appGUID, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Application: %s has started successfully", name)
-	return resourceIBMAppRead(d, meta)
+	return resourceIBMAppRead(context, d, meta)
 }
 
-func resourceIBMAppRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMAppExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	appAPI := cfClient.Apps()
 	appGUID := d.Id()
 
 	appData, err := appAPI.Get(appGUID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving app details %s : %s", appGUID, err)
+		return diag.FromErr(fmt.Errorf("Error retrieving app details %s : %s", appGUID, err))
 	}
 
 	d.SetId(appData.Metadata.GUID)
@@ -260,7 +270,7 @@ func resourceIBMAppRead(d *schema.ResourceData, meta interface{}) error {
 
 	route, err := appAPI.ListRoutes(appGUID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if len(route) > 0 {
 		d.Set("route_guid", flattenRoute(route))
@@ -268,7 +278,7 @@ func resourceIBMAppRead(d *schema.ResourceData, meta interface{}) error {
 
 	svcBindings, err := appAPI.ListServiceBindings(appGUID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if len(svcBindings) > 0 {
 		d.Set("service_instance_guid", flattenServiceBindings(svcBindings))
@@ -278,10 +288,10 @@ func resourceIBMAppRead(d *schema.ResourceData, meta interface{}) error {
 
 }
 
-func resourceIBMAppUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	appAPI := cfClient.Apps()
 	appGUID := d.Id()
@@ -346,30 +356,30 @@ func resourceIBMAppUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	_, err = appAPI.Update(appGUID, appUpdatePayload)
 	if err != nil {
-		return fmt.Errorf("Error updating application: %s", err)
+		return diag.FromErr(fmt.Errorf("Error updating application: %s", err))
 	}
 	//TODO find the digest of the zip and avoid upload if it is same
 	if d.HasChange("app_path") || d.HasChange("app_version") {
 		appZipLoc, err := processAppZipPath(d.Get("app_path").(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		log.Println("[DEBUG] Uploading application bits")
 		_, err = appAPI.Upload(appGUID, appZipLoc)
 		if err != nil {
-			return fmt.Errorf("Error uploading  app: %s", err)
+			return diag.FromErr(fmt.Errorf("Error uploading  app: %s", err))
 		}
 		restartRequired = true
 	}
 
 	err = updateRouteGUID(appGUID, appAPI, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	restage, err := updateServiceInstanceGUID(appGUID, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if restage {
 		restageRequired = true
@@ -387,36 +397,36 @@ func resourceIBMAppUpdate(d *schema.ResourceData, meta interface{}) error {
 		log.Println("[INFO] Restage since buildpack has changed")
 		err := restageApp(appGUID, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else if restartRequired {
 		err := restartApp(appGUID, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		//In case only memory/disk etc are updated then cloud controller would destroy the current instances
 		//and spin new ones, so we are waiting till they come up again
 		state, err := appAPI.WaitForInstanceStatus(v2.AppRunningState, appGUID, waitTimeout)
 		if waitTimeout != 0 && (err != nil || state != v2.AppRunningState) {
-			return fmt.Errorf("All applications instances aren't %s, Current status is %s, %q", v2.AppRunningState, state, err)
+			return diag.FromErr(fmt.Errorf("All applications instances aren't %s, Current status is %s, %q", v2.AppRunningState, state, err))
 		}
 	}
 
-	return resourceIBMAppRead(d, meta)
+	return resourceIBMAppRead(context, d, meta)
 }
 
-func resourceIBMAppDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	appAPI := cfClient.Apps()
 	id := d.Id()
 
 	err = appAPI.Delete(id, false, true)
 	if err != nil {
-		return fmt.Errorf("Error deleting app: %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting app: %s", err))
 	}
 
 	d.SetId("")
@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
@@ -14,11 +16,11 @@ import (
 
 func resourceIbmAppConfigCollection() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIbmAppConfigCollectionCreate,
-		Read:     resourceIbmAppConfigCollectionRead,
-		Update:   resourceIbmAppConfigCollectionUpdate,
-		Delete:   resourceIbmAppConfigCollectionDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIbmAppConfigCollectionCreate,
+		ReadContext:   resourceIbmAppConfigCollectionRead,
+		UpdateContext: resourceIbmAppConfigCollectionUpdate,
+		DeleteContext: resourceIbmAppConfigCollectionDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"guid": {
@@ -66,11 +68,11 @@ func resourceIbmAppConfigCollection() *schema.Resource {
 	}
 }
 
-func resourceIbmAppConfigCollectionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIbmAppConfigCollectionCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	guid := d.Get("guid").(string)
 	appconfigClient, err := getAppConfigClient(meta, guid)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	options := &appconfigurationv1.CreateCollectionOptions{}
@@ -87,22 +89,22 @@ func resourceIbmAppConfigCollectionCreate(d *schema.ResourceData, meta interface
 	result, response, err := appconfigClient.CreateCollection(options)
 	if err != nil {
 		log.Printf("[DEBUG] CreateCollection failed %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", guid, *result.CollectionID))
 
-	return resourceIbmAppConfigCollectionRead(d, meta)
+	return resourceIbmAppConfigCollectionRead(context, d, meta)
 }
 
-func resourceIbmAppConfigCollectionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIbmAppConfigCollectionRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := idParts(d.Id())
 	if err != nil {
 		return nil
 	}
 	appconfigClient, err := getAppConfigClient(meta, parts[0])
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	options := &appconfigurationv1.GetCollectionOptions{}
@@ -113,49 +115,49 @@ func resourceIbmAppConfigCollectionRead(d *schema.ResourceData, meta interface{}
 	result, response, err := appconfigClient.GetCollection(options)
 	if err != nil {
 		log.Printf("[DEBUG] GetCollection failed %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set("guid", parts[0])
 	if result.Name != nil {
 		if err = d.Set("name", result.Name); err != nil {
-			return fmt.Errorf("error setting name: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting name: %s", err))
 		}
 	}
 	if result.CollectionID != nil {
 		if err = d.Set("collection_id", result.CollectionID); err != nil {
-			return fmt.Errorf("error setting collection_id: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting collection_id: %s", err))
 		}
 	}
 	if result.Description != nil {
 		if err = d.Set("description", result.Description); err != nil {
-			return fmt.Errorf("error setting description: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting description: %s", err))
 		}
 	}
 	if result.Tags != nil {
 		if err = d.Set("tags", result.Tags); err != nil {
-			return fmt.Errorf("error setting tags: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting tags: %s", err))
 		}
 	}
 	if result.CreatedTime != nil {
 		if err = d.Set("created_time", result.CreatedTime.String()); err != nil {
-			return fmt.Errorf("error setting created_time: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting created_time: %s", err))
 		}
 	}
 	if result.UpdatedTime != nil {
 		if err = d.Set("updated_time", result.UpdatedTime.String()); err != nil {
-			return fmt.Errorf("error setting updated_time: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting updated_time: %s", err))
 		}
 	}
 	if result.Href != nil {
 		if err = d.Set("href", result.Href); err != nil {
-			return fmt.Errorf("error setting href: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting href: %s", err))
 		}
 	}
 
 	return nil
 }
 
-func resourceIbmAppConfigCollectionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIbmAppConfigCollectionUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if ok := d.HasChanges("name", "tags", "description"); ok {
 		parts, err := idParts(d.Id())
 		if err != nil {
@@ -163,7 +165,7 @@ func resourceIbmAppConfigCollectionUpdate(d *schema.ResourceData, meta interface
 		}
 		appconfigClient, err := getAppConfigClient(meta, parts[0])
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		options := &appconfigurationv1.UpdateCollectionOptions{}
 
@@ -179,15 +181,15 @@ func resourceIbmAppConfigCollectionUpdate(d *schema.ResourceData, meta interface
 		_, response, err := appconfigClient.UpdateCollection(options)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateCollection failed %s\n%s", err, response)
-			return err
+			return diag.FromErr(err)
 		}
 
-		return resourceIbmAppConfigCollectionRead(d, meta)
+		return resourceIbmAppConfigCollectionRead(context, d, meta)
 	}
 	return nil
 }
 
-func resourceIbmAppConfigCollectionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIbmAppConfigCollectionDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := idParts(d.Id())
 	if err != nil {
 		return nil
@@ -195,7 +197,7 @@ func resourceIbmAppConfigCollectionDelete(d *schema.ResourceData, meta interface
 
 	appconfigClient, err := getAppConfigClient(meta, parts[0])
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	options := &appconfigurationv1.DeleteCollectionOptions{}
 
@@ -208,7 +210,7 @@ func resourceIbmAppConfigCollectionDelete(d *schema.ResourceData, meta interface
 			return nil
 		}
 		log.Printf("[DEBUG] DeleteCollection failed %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
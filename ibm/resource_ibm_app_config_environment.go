@@ -4,19 +4,21 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIbmAppConfigEnvironment() *schema.Resource {
 	return &schema.Resource{
-		Read:     resourceEnvironmentRead,
-		Create:   resourceEnvironmentCreate,
-		Update:   resourceEnvironmentUpdate,
-		Delete:   resourceEnvironmentDelete,
-		Importer: &schema.ResourceImporter{},
+		ReadContext:   resourceEnvironmentRead,
+		CreateContext: resourceEnvironmentCreate,
+		UpdateContext: resourceEnvironmentUpdate,
+		DeleteContext: resourceEnvironmentDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"guid": {
@@ -84,11 +86,11 @@ func getAppConfigClient(meta interface{}, guid string) (*appconfigurationv1.AppC
 	return appconfigClient, nil
 }
 
-func resourceEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceEnvironmentCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	guid := d.Get("guid").(string)
 	appconfigClient, err := getAppConfigClient(meta, guid)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	options := &appconfigurationv1.CreateEnvironmentOptions{}
 
@@ -106,14 +108,14 @@ func resourceEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
 	_, response, err := appconfigClient.CreateEnvironment(options)
 
 	if err != nil {
-		return fmt.Errorf("[DEBUG] CreateEnvironment failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[DEBUG] CreateEnvironment failed %s\n%s", err, response))
 	}
 	d.SetId(fmt.Sprintf("%s/%s", guid, *options.EnvironmentID))
 
-	return resourceEnvironmentRead(d, meta)
+	return resourceEnvironmentRead(context, d, meta)
 }
 
-func resourceEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceEnvironmentUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if ok := d.HasChanges("name", "tags", "color_code", "description"); ok {
 		parts, err := idParts(d.Id())
 		if err != nil {
@@ -121,7 +123,7 @@ func resourceEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		appconfigClient, err := getAppConfigClient(meta, parts[0])
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		options := &appconfigurationv1.UpdateEnvironmentOptions{}
@@ -140,21 +142,21 @@ func resourceEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		_, response, err := appconfigClient.UpdateEnvironment(options)
 		if err != nil {
-			return fmt.Errorf("[DEBUG] UpdateEnvironment failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[DEBUG] UpdateEnvironment failed %s\n%s", err, response))
 		}
-		return resourceEnvironmentRead(d, meta)
+		return resourceEnvironmentRead(context, d, meta)
 	}
 	return nil
 }
 
-func resourceEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceEnvironmentRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := idParts(d.Id())
 	if err != nil {
 		return nil
 	}
 	appconfigClient, err := getAppConfigClient(meta, parts[0])
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	options := &appconfigurationv1.GetEnvironmentOptions{}
@@ -165,53 +167,53 @@ func resourceEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
 	result, response, err := appconfigClient.GetEnvironment(options)
 
 	if err != nil {
-		return fmt.Errorf("[DEBUG] GetEnvironment failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[DEBUG] GetEnvironment failed %s\n%s", err, response))
 	}
 	d.Set("guid", parts[0])
 	if result.Name != nil {
 		if err = d.Set("name", result.Name); err != nil {
-			return fmt.Errorf("error setting name: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting name: %s", err))
 		}
 	}
 	if result.EnvironmentID != nil {
 		if err = d.Set("environment_id", result.EnvironmentID); err != nil {
-			return fmt.Errorf("error setting environment_id: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting environment_id: %s", err))
 		}
 	}
 	if result.Description != nil {
 		if err = d.Set("description", result.Description); err != nil {
-			return fmt.Errorf("error setting description: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting description: %s", err))
 		}
 	}
 	if result.Tags != nil {
 		if err = d.Set("tags", result.Tags); err != nil {
-			return fmt.Errorf("error setting tags: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting tags: %s", err))
 		}
 	}
 	if result.ColorCode != nil {
 		if err = d.Set("color_code", result.ColorCode); err != nil {
-			return fmt.Errorf("error setting color_code: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting color_code: %s", err))
 		}
 	}
 	if result.CreatedTime != nil {
 		if err = d.Set("created_time", result.CreatedTime.String()); err != nil {
-			return fmt.Errorf("error setting created_time: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting created_time: %s", err))
 		}
 	}
 	if result.UpdatedTime != nil {
 		if err = d.Set("updated_time", result.UpdatedTime.String()); err != nil {
-			return fmt.Errorf("error setting updated_time: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting updated_time: %s", err))
 		}
 	}
 	if result.Href != nil {
 		if err = d.Set("href", result.Href); err != nil {
-			return fmt.Errorf("error setting href: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting href: %s", err))
 		}
 	}
 	return nil
 }
 
-func resourceEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceEnvironmentDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := idParts(d.Id())
 	if err != nil {
 		return nil
@@ -219,7 +221,7 @@ func resourceEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
 
 	appconfigClient, err := getAppConfigClient(meta, parts[0])
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	options := &appconfigurationv1.DeleteEnvironmentOptions{}
@@ -232,7 +234,7 @@ func resourceEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[DEBUG] DeleteEnvironment failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[DEBUG] DeleteEnvironment failed %s\n%s", err, response))
 	}
 	d.SetId("")
 	return nil
@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
@@ -16,11 +18,11 @@ import (
 
 func resourceIbmIbmAppConfigFeature() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIbmIbmAppConfigFeatureCreate,
-		Read:     resourceIbmIbmAppConfigFeatureRead,
-		Update:   resourceIbmIbmAppConfigFeatureUpdate,
-		Delete:   resourceIbmIbmAppConfigFeatureDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIbmIbmAppConfigFeatureCreate,
+		ReadContext:   resourceIbmIbmAppConfigFeatureRead,
+		UpdateContext: resourceIbmIbmAppConfigFeatureUpdate,
+		DeleteContext: resourceIbmIbmAppConfigFeatureDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"guid": {
@@ -146,11 +148,11 @@ func resourceIbmIbmAppConfigFeature() *schema.Resource {
 	}
 }
 
-func resourceIbmIbmAppConfigFeatureCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIbmIbmAppConfigFeatureCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	guid := d.Get("guid").(string)
 	appconfigClient, err := getAppConfigClient(meta, guid)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	options := &appconfigurationv1.CreateFeatureOptions{}
 	options.SetType(d.Get("type").(string))
@@ -173,7 +175,7 @@ func resourceIbmIbmAppConfigFeatureCreate(d *schema.ResourceData, meta interface
 			value := e.(map[string]interface{})
 			segmentRulesItem, err := resourceIbmAppConfigFeatureMapToSegmentRule(d, value)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			segmentRules = append(segmentRules, segmentRulesItem)
 		}
@@ -193,20 +195,20 @@ func resourceIbmIbmAppConfigFeatureCreate(d *schema.ResourceData, meta interface
 
 	if err != nil {
 		log.Printf("CreateFeature failed %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%s/%s/%s", guid, *options.EnvironmentID, *feature.FeatureID))
-	return resourceIbmIbmAppConfigFeatureRead(d, meta)
+	return resourceIbmIbmAppConfigFeatureRead(context, d, meta)
 }
 
-func resourceIbmIbmAppConfigFeatureUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIbmIbmAppConfigFeatureUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := idParts(d.Id())
 	if err != nil {
 		return nil
 	}
 	appconfigClient, err := getAppConfigClient(meta, parts[0])
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	options := &appconfigurationv1.UpdateFeatureOptions{}
@@ -230,7 +232,7 @@ func resourceIbmIbmAppConfigFeatureUpdate(d *schema.ResourceData, meta interface
 				value := e.(map[string]interface{})
 				segmentRulesItem, err := resourceIbmAppConfigFeatureMapToSegmentRule(d, value)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 				segmentRules = append(segmentRules, segmentRulesItem)
 			}
@@ -249,21 +251,21 @@ func resourceIbmIbmAppConfigFeatureUpdate(d *schema.ResourceData, meta interface
 		_, response, err := appconfigClient.UpdateFeature(options)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateFeature %s\n%s", err, response)
-			return err
+			return diag.FromErr(err)
 		}
-		return resourceIbmIbmAppConfigFeatureRead(d, meta)
+		return resourceIbmIbmAppConfigFeatureRead(context, d, meta)
 	}
 	return nil
 }
 
-func resourceIbmIbmAppConfigFeatureRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIbmIbmAppConfigFeatureRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := idParts(d.Id())
 	if err != nil {
 		return nil
 	}
 	appconfigClient, err := getAppConfigClient(meta, parts[0])
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	options := &appconfigurationv1.GetFeatureOptions{}
@@ -272,35 +274,35 @@ func resourceIbmIbmAppConfigFeatureRead(d *schema.ResourceData, meta interface{}
 
 	result, response, err := appconfigClient.GetFeature(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] GetFeature failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[DEBUG] GetFeature failed %s\n%s", err, response))
 	}
 
 	d.Set("guid", parts[0])
 	d.Set("environment_id", parts[1])
 	if result.Name != nil {
 		if err = d.Set("name", result.Name); err != nil {
-			return fmt.Errorf("error setting name: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting name: %s", err))
 		}
 	}
 	if result.FeatureID != nil {
 		if err = d.Set("feature_id", result.FeatureID); err != nil {
-			return fmt.Errorf("error setting feature_id: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting feature_id: %s", err))
 		}
 	}
 	if result.Type != nil {
 		if err = d.Set("type", result.Type); err != nil {
-			return fmt.Errorf("error setting type: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting type: %s", err))
 		}
 	}
 	if result.Description != nil {
 		if err = d.Set("description", result.Description); err != nil {
-			return fmt.Errorf("error setting description: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting description: %s", err))
 		}
 
 	}
 	if result.Tags != nil {
 		if err = d.Set("tags", result.Tags); err != nil {
-			return fmt.Errorf("error setting tags: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting tags: %s", err))
 		}
 	}
 
@@ -311,7 +313,7 @@ func resourceIbmIbmAppConfigFeatureRead(d *schema.ResourceData, meta interface{}
 			segmentRules = append(segmentRules, segmentRulesItemMap)
 		}
 		if err = d.Set("segment_rules", segmentRules); err != nil {
-			return fmt.Errorf("error setting segment_rules: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting segment_rules: %s", err))
 		}
 	}
 	if result.Collections != nil {
@@ -321,32 +323,32 @@ func resourceIbmIbmAppConfigFeatureRead(d *schema.ResourceData, meta interface{}
 			collections = append(collections, collectionsItemMap)
 		}
 		if err = d.Set("collections", collections); err != nil {
-			return fmt.Errorf("error setting collections: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting collections: %s", err))
 		}
 	}
 	if result.SegmentExists != nil {
 		if err = d.Set("segment_exists", result.SegmentExists); err != nil {
-			return fmt.Errorf("error setting segment_exists: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting segment_exists: %s", err))
 		}
 	}
 	if result.CreatedTime != nil {
 		if err = d.Set("created_time", result.CreatedTime.String()); err != nil {
-			return fmt.Errorf("error setting created_time: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting created_time: %s", err))
 		}
 	}
 	if result.UpdatedTime != nil {
 		if err = d.Set("updated_time", result.UpdatedTime.String()); err != nil {
-			return fmt.Errorf("error setting updated_time: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting updated_time: %s", err))
 		}
 	}
 	if result.Href != nil {
 		if err = d.Set("href", result.Href); err != nil {
-			return fmt.Errorf("error setting href: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting href: %s", err))
 		}
 	}
 	if result.Enabled != nil {
 		if err = d.Set("enabled", result.Enabled); err != nil {
-			return fmt.Errorf("error setting enabled: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting enabled: %s", err))
 		}
 	}
 
@@ -378,14 +380,14 @@ func resourceIbmIbmAppConfigFeatureRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceIbmIbmAppConfigFeatureDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIbmIbmAppConfigFeatureDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := idParts(d.Id())
 	if err != nil {
 		return nil
 	}
 	appconfigClient, err := getAppConfigClient(meta, parts[0])
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	options := &appconfigurationv1.DeleteFeatureOptions{}
@@ -398,7 +400,7 @@ func resourceIbmIbmAppConfigFeatureDelete(d *schema.ResourceData, meta interface
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[DEBUG] DeleteFeature failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[DEBUG] DeleteFeature failed %s\n%s", err, response))
 	}
 
 	d.SetId("")
@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
@@ -15,11 +17,11 @@ import (
 
 func resourceIbmAppConfigSegment() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIbmAppConfigSegmentCreate,
-		Read:     resourceIbmAppConfigSegmentRead,
-		Update:   resourceIbmAppConfigSegmentUpdate,
-		Delete:   resourceIbmAppConfigSegmentDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIbmAppConfigSegmentCreate,
+		ReadContext:   resourceIbmAppConfigSegmentRead,
+		UpdateContext: resourceIbmAppConfigSegmentUpdate,
+		DeleteContext: resourceIbmAppConfigSegmentDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"guid": {
@@ -91,11 +93,11 @@ func resourceIbmAppConfigSegment() *schema.Resource {
 	}
 }
 
-func resourceIbmAppConfigSegmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIbmAppConfigSegmentCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	guid := d.Get("guid").(string)
 	appconfigClient, err := getAppConfigClient(meta, guid)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	options := &appconfigurationv1.CreateSegmentOptions{}
 
@@ -120,12 +122,12 @@ func resourceIbmAppConfigSegmentCreate(d *schema.ResourceData, meta interface{})
 	result, response, err := appconfigClient.CreateSegment(options)
 	if err != nil {
 		log.Printf("[DEBUG] CreateSegment failed %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", guid, *result.SegmentID))
 
-	return resourceIbmAppConfigSegmentRead(d, meta)
+	return resourceIbmAppConfigSegmentRead(context, d, meta)
 }
 
 func resourceIbmAppConfigSegmentMapToRuleObject(ruleObjectMap map[string]interface{}) appconfigurationv1.Rule {
@@ -142,14 +144,14 @@ func resourceIbmAppConfigSegmentMapToRuleObject(ruleObjectMap map[string]interfa
 	return ruleObject
 }
 
-func resourceIbmAppConfigSegmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIbmAppConfigSegmentRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := idParts(d.Id())
 	if err != nil {
 		return nil
 	}
 	appconfigClient, err := getAppConfigClient(meta, parts[0])
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	options := &appconfigurationv1.GetSegmentOptions{}
 
@@ -158,27 +160,27 @@ func resourceIbmAppConfigSegmentRead(d *schema.ResourceData, meta interface{}) e
 	result, response, err := appconfigClient.GetSegment(options)
 	if err != nil {
 		log.Printf("[DEBUG] GetSegment failed %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if result.Name != nil {
 		if err = d.Set("name", result.Name); err != nil {
-			return fmt.Errorf("error setting name: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting name: %s", err))
 		}
 	}
 	if result.SegmentID != nil {
 		if err = d.Set("segment_id", result.SegmentID); err != nil {
-			return fmt.Errorf("error setting segment_id: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting segment_id: %s", err))
 		}
 	}
 	if result.Description != nil {
 		if err = d.Set("description", result.Description); err != nil {
-			return fmt.Errorf("error setting description: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting description: %s", err))
 		}
 	}
 	if result.Tags != nil {
 		if err = d.Set("tags", result.Tags); err != nil {
-			return fmt.Errorf("error setting tags: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting tags: %s", err))
 		}
 	}
 
@@ -189,22 +191,22 @@ func resourceIbmAppConfigSegmentRead(d *schema.ResourceData, meta interface{}) e
 			rules = append(rules, rulesItemMap)
 		}
 		if err = d.Set("rules", rules); err != nil {
-			return fmt.Errorf("error setting rules: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting rules: %s", err))
 		}
 	}
 	if result.CreatedTime != nil {
 		if err = d.Set("created_time", result.CreatedTime.String()); err != nil {
-			return fmt.Errorf("error setting created_time: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting created_time: %s", err))
 		}
 	}
 	if result.UpdatedTime != nil {
 		if err = d.Set("updated_time", result.UpdatedTime.String()); err != nil {
-			return fmt.Errorf("error setting updated_time: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting updated_time: %s", err))
 		}
 	}
 	if result.Href != nil {
 		if err = d.Set("href", result.Href); err != nil {
-			return fmt.Errorf("error setting href: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting href: %s", err))
 		}
 	}
 
@@ -221,7 +223,7 @@ func resourceIbmAppConfigSegmentRuleObjectToMap(ruleObject appconfigurationv1.Ru
 	return ruleObjectMap
 }
 
-func resourceIbmAppConfigSegmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIbmAppConfigSegmentUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if ok := d.HasChanges("name", "tags", "color_code", "description"); ok {
 		parts, err := idParts(d.Id())
 		if err != nil {
@@ -229,7 +231,7 @@ func resourceIbmAppConfigSegmentUpdate(d *schema.ResourceData, meta interface{})
 		}
 		appconfigClient, err := getAppConfigClient(meta, parts[0])
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		options := &appconfigurationv1.UpdateSegmentOptions{}
@@ -254,15 +256,15 @@ func resourceIbmAppConfigSegmentUpdate(d *schema.ResourceData, meta interface{})
 		_, response, err := appconfigClient.UpdateSegment(options)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateSegment failed %s\n%s", err, response)
-			return err
+			return diag.FromErr(err)
 		}
 
-		return resourceIbmAppConfigSegmentRead(d, meta)
+		return resourceIbmAppConfigSegmentRead(context, d, meta)
 	}
 	return nil
 }
 
-func resourceIbmAppConfigSegmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIbmAppConfigSegmentDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := idParts(d.Id())
 	if err != nil {
 		return nil
@@ -270,7 +272,7 @@ func resourceIbmAppConfigSegmentDelete(d *schema.ResourceData, meta interface{})
 
 	appconfigClient, err := getAppConfigClient(meta, parts[0])
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	options := &appconfigurationv1.DeleteSegmentOptions{}
@@ -284,7 +286,7 @@ func resourceIbmAppConfigSegmentDelete(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 		log.Printf("[DEBUG] DeleteSegment failed %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
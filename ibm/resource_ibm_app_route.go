@@ -4,23 +4,24 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	v2 "github.com/IBM-Cloud/bluemix-go/api/mccp/mccpv2"
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMAppRoute() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMAppRouteCreate,
-		Read:     resourceIBMAppRouteRead,
-		Update:   resourceIBMAppRouteUpdate,
-		Delete:   resourceIBMAppRouteDelete,
-		Exists:   resourceIBMAppRouteExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMAppRouteCreate,
+		ReadContext:   resourceIBMAppRouteRead,
+		UpdateContext: resourceIBMAppRouteUpdate,
+		DeleteContext: resourceIBMAppRouteDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"host": {
@@ -67,10 +68,10 @@ func resourceIBMAppRoute() *schema.Resource {
 	}
 }
 
-func resourceIBMAppRouteCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppRouteCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	spaceGUID := d.Get("space_guid").(string)
@@ -95,24 +96,33 @@ func resourceIBMAppRouteCreate(d *schema.ResourceData, meta interface{}) error {
 
 	route, err := cfClient.Routes().Create(params)
 	if err != nil {
-		return fmt.Errorf("Error creating route: %s", err)
+		return diag.FromErr(fmt.Errorf("Error creating route: %s", err))
 	}
 
 	d.SetId(route.Metadata.GUID)
 
-	return resourceIBMAppRouteRead(d, meta)
+	return resourceIBMAppRouteRead(context, d, meta)
 }
 
-func resourceIBMAppRouteRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppRouteRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMAppRouteExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	routeGUID := d.Id()
 
 	route, err := cfClient.Routes().Get(routeGUID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving route: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving route: %s", err))
 	}
 
 	d.Set("host", route.Entity.Host)
@@ -126,10 +136,10 @@ func resourceIBMAppRouteRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMAppRouteUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppRouteUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	routeGUID := d.Id()
@@ -149,21 +159,21 @@ func resourceIBMAppRouteUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	_, err = cfClient.Routes().Update(routeGUID, params)
 	if err != nil {
-		return fmt.Errorf("Error updating route: %s", err)
+		return diag.FromErr(fmt.Errorf("Error updating route: %s", err))
 	}
-	return resourceIBMAppRouteRead(d, meta)
+	return resourceIBMAppRouteRead(context, d, meta)
 }
 
-func resourceIBMAppRouteDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppRouteDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	routeGUID := d.Id()
 
 	err = cfClient.Routes().Delete(routeGUID, false)
 	if err != nil {
-		return fmt.Errorf("Error deleting route: %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting route: %s", err))
 	}
 
 	d.SetId("")
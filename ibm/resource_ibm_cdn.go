@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/services"
@@ -19,11 +21,10 @@ const str string = ".cdn.appdomain.cloud"
 
 func resourceIBMCDN() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMCDNCreate,
-		Read:   resourceIBMCDNRead,
-		Update: resourceIBMCDNUpdate,
-		Delete: resourceIBMCDNDelete,
-		Exists: resourceIBMCDNExists,
+		CreateContext: resourceIBMCDNCreate,
+		ReadContext:   resourceIBMCDNRead,
+		UpdateContext: resourceIBMCDNUpdate,
+		DeleteContext: resourceIBMCDNDelete,
 
 		Schema: map[string]*schema.Schema{
 			"host_name": &schema.Schema{
@@ -149,7 +150,7 @@ func resourceIBMCDN() *schema.Resource {
 	}
 }
 
-func resourceIBMCDNCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCDNCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	///create  session
 	sess := meta.(ClientSession).SoftLayerSession()
 	///get the value of all the parameters
@@ -196,14 +197,14 @@ func resourceIBMCDNCreate(d *schema.ResourceData, meta interface{}) error {
 			PerformanceConfiguration: sl.String(performanceconfiguration),
 		})
 		if err != nil {
-			return fmt.Errorf("Error creating CDN: %s", err)
+			return diag.FromErr(fmt.Errorf("Error creating CDN: %s", err))
 		}
 
 		d.SetId(*receipt1[0].UniqueId)
 		id, err := strconv.Atoi((d.Id()))
 		result1, err := service.VerifyDomainMapping(&id)
 		log.Print("The status of domain mapping ", result1)
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(context, d, meta)
 
 	}
 	if origintype == "OBJECT_STORAGE" && protocol == "HTTPS" {
@@ -224,14 +225,14 @@ func resourceIBMCDNCreate(d *schema.ResourceData, meta interface{}) error {
 			PerformanceConfiguration: sl.String(performanceconfiguration),
 		})
 		if err != nil {
-			return fmt.Errorf("Error creating CDN: %s", err)
+			return diag.FromErr(fmt.Errorf("Error creating CDN: %s", err))
 		}
 
 		d.SetId(*receipt2[0].UniqueId)
 		id, err := strconv.Atoi((d.Id()))
 		result2, err := service.VerifyDomainMapping(&id)
 		log.Print("The status of domain mapping ", result2)
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(context, d, meta)
 	}
 	if origintype == "OBJECT_STORAGE" && protocol == "HTTP_AND_HTTPS" {
 		receipt3, err := service.CreateDomainMapping(&datatypes.Container_Network_CdnMarketplace_Configuration_Input{
@@ -252,14 +253,14 @@ func resourceIBMCDNCreate(d *schema.ResourceData, meta interface{}) error {
 			PerformanceConfiguration: sl.String(performanceconfiguration),
 		})
 		if err != nil {
-			return fmt.Errorf("Error creating CDN: %s", err)
+			return diag.FromErr(fmt.Errorf("Error creating CDN: %s", err))
 		}
 
 		d.SetId(*receipt3[0].UniqueId)
 		id, err := strconv.Atoi((d.Id()))
 		result3, err := service.VerifyDomainMapping(&id)
 		log.Print("The status of domain mapping ", result3)
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(context, d, meta)
 	}
 	if origintype == "HOST_SERVER" && protocol == "HTTP" {
 		receipt4, err := service.CreateDomainMapping(&datatypes.Container_Network_CdnMarketplace_Configuration_Input{
@@ -277,14 +278,14 @@ func resourceIBMCDNCreate(d *schema.ResourceData, meta interface{}) error {
 			PerformanceConfiguration: sl.String(performanceconfiguration),
 		})
 		if err != nil {
-			return fmt.Errorf("Error creating CDN: %s", err)
+			return diag.FromErr(fmt.Errorf("Error creating CDN: %s", err))
 		}
 
 		d.SetId(*receipt4[0].UniqueId)
 		id, err := strconv.Atoi((d.Id()))
 		result4, err := service.VerifyDomainMapping(&id)
 		log.Print("The status of domain mapping ", result4)
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(context, d, meta)
 	}
 	if origintype == "HOST_SERVER" && protocol == "HTTPS" {
 		receipt5, err := service.CreateDomainMapping(&datatypes.Container_Network_CdnMarketplace_Configuration_Input{
@@ -303,14 +304,14 @@ func resourceIBMCDNCreate(d *schema.ResourceData, meta interface{}) error {
 			PerformanceConfiguration: sl.String(performanceconfiguration),
 		})
 		if err != nil {
-			return fmt.Errorf("Error creating CDN: %s", err)
+			return diag.FromErr(fmt.Errorf("Error creating CDN: %s", err))
 		}
 
 		d.SetId(*receipt5[0].UniqueId)
 		id, err := strconv.Atoi((d.Id()))
 		result5, err := service.VerifyDomainMapping(&id)
 		log.Print("The status of domain mapping ", result5)
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(context, d, meta)
 	}
 	if origintype == "HOST_SERVER" && protocol == "HTTP_AND_HTTPS" {
 		receipt6, err := service.CreateDomainMapping(&datatypes.Container_Network_CdnMarketplace_Configuration_Input{
@@ -330,20 +331,29 @@ func resourceIBMCDNCreate(d *schema.ResourceData, meta interface{}) error {
 			PerformanceConfiguration: sl.String(performanceconfiguration),
 		})
 		if err != nil {
-			return fmt.Errorf("Error creating CDN: %s", err)
+			return diag.FromErr(fmt.Errorf("Error creating CDN: %s", err))
 		}
 
 		d.SetId(*receipt6[0].UniqueId)
 		id, err := strconv.Atoi((d.Id()))
 		result6, err := service.VerifyDomainMapping(&id)
 		log.Print("The status of domain mapping ", result6)
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(context, d, meta)
 	}
 
 	return nil
 }
 
-func resourceIBMCDNRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCDNRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMCDNExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkCdnMarketplaceConfigurationMappingService(sess)
 	cdnId := sl.String(d.Id())
@@ -378,7 +388,7 @@ func resourceIBMCDNRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMCDNUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCDNUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	/// Nothing to update for now. Not supported.
 	sess := meta.(ClientSession).SoftLayerSession()
 	domain := d.Get("host_name").(string)
@@ -434,7 +444,7 @@ func resourceIBMCDNUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			log.Println(err)
 		}
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(context, d, meta)
 	}
 
 	if origintype == "HOST_SERVER" && protocol == "HTTPS" {
@@ -459,7 +469,7 @@ func resourceIBMCDNUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			log.Println(err)
 		}
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(context, d, meta)
 
 	}
 
@@ -484,7 +494,7 @@ func resourceIBMCDNUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			log.Println(err)
 		}
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(context, d, meta)
 
 	}
 
@@ -513,7 +523,7 @@ func resourceIBMCDNUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			log.Println(err)
 		}
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(context, d, meta)
 	}
 
 	if origintype == "OBJECT_STORAGE" && protocol == "HTTPS" {
@@ -540,7 +550,7 @@ func resourceIBMCDNUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			log.Println(err)
 		}
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(context, d, meta)
 	}
 
 	if origintype == "OBJECT_STORAGE" && protocol == "HTTP" {
@@ -566,13 +576,13 @@ func resourceIBMCDNUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			log.Println(err)
 		}
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(context, d, meta)
 	}
 
 	return nil
 }
 
-func resourceIBMCDNDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCDNDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkCdnMarketplaceConfigurationMappingService(sess)
 
@@ -581,7 +591,7 @@ func resourceIBMCDNDelete(d *schema.ResourceData, meta interface{}) error {
 	delete, err := service.DeleteDomainMapping(cdnId)
 	if err != nil {
 		log.Println(err)
-		return err
+		return diag.FromErr(err)
 	}
 	///print the delete response
 	log.Print("Delete response is : ", delete)
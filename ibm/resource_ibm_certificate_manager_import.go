@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
@@ -15,12 +17,11 @@ import (
 
 func resourceIBMCertificateManagerImport() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMCertificateManagerImportCertificate,
-		Read:     resourceIBMCertificateManagerGet,
-		Update:   resourceIBMCertificateManagerUpdate,
-		Importer: &schema.ResourceImporter{},
-		Delete:   resourceIBMCertificateManagerDelete,
-		Exists:   resourceIBMCertificateManagerExists,
+		CreateContext: resourceIBMCertificateManagerImportCertificate,
+		ReadContext:   resourceIBMCertificateManagerGet,
+		UpdateContext: resourceIBMCertificateManagerUpdate,
+		Importer:      &schema.ResourceImporter{},
+		DeleteContext: resourceIBMCertificateManagerDelete,
 		Schema: map[string]*schema.Schema{
 			"certificate_manager_instance_id": {
 				Type:        schema.TypeString,
@@ -81,11 +82,11 @@ func resourceIBMCertificateManagerImport() *schema.Resource {
 	}
 }
 
-func resourceIBMCertificateManagerImportCertificate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCertificateManagerImportCertificate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	cmService, err := meta.(ClientSession).CertificateManagerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceID := d.Get("certificate_manager_instance_id").(string)
@@ -111,15 +112,24 @@ func resourceIBMCertificateManagerImportCertificate(d *schema.ResourceData, meta
 
 	result, importCertError := client.ImportCertificate(instanceID, payload)
 	if importCertError != nil {
-		return importCertError
+		return diag.FromErr(importCertError)
 	}
 	d.SetId(result.ID)
-	return resourceIBMCertificateManagerUpdate(d, meta)
+	return resourceIBMCertificateManagerUpdate(context, d, meta)
 }
-func resourceIBMCertificateManagerGet(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCertificateManagerGet(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMCertificateManagerExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	cmService, err := meta.(ClientSession).CertificateManagerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	certID := d.Id()
 	certificatedata, err := cmService.Certificate().GetCertData(certID)
@@ -152,10 +162,10 @@ func resourceIBMCertificateManagerGet(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceIBMCertificateManagerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCertificateManagerUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cmService, err := meta.(ClientSession).CertificateManagerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	certID := d.Id()
 	client := cmService.Certificate()
@@ -166,7 +176,7 @@ func resourceIBMCertificateManagerUpdate(d *schema.ResourceData, meta interface{
 
 		importCertError := client.UpdateCertificateMetaData(certID, payload)
 		if importCertError != nil {
-			return importCertError
+			return diag.FromErr(importCertError)
 		}
 	}
 	if d.HasChange("data") {
@@ -186,20 +196,20 @@ func resourceIBMCertificateManagerUpdate(d *schema.ResourceData, meta interface{
 		payload := models.CertificateReimportData{Content: importData.Content, Privatekey: importData.Privatekey, IntermediateCertificate: importData.IntermediateCertificate}
 		_, reImportCertError := client.ReimportCertificate(certID, payload)
 		if reImportCertError != nil {
-			return reImportCertError
+			return diag.FromErr(reImportCertError)
 		}
 	}
-	return resourceIBMCertificateManagerGet(d, meta)
+	return resourceIBMCertificateManagerGet(context, d, meta)
 }
-func resourceIBMCertificateManagerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCertificateManagerDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cmService, err := meta.(ClientSession).CertificateManagerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	certID := d.Id()
 	err = cmService.Certificate().DeleteCertificate(certID)
 	if err != nil {
-		return fmt.Errorf("Error deleting Certificate: %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting Certificate: %s", err))
 	}
 	d.SetId("")
 
@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -18,12 +20,11 @@ import (
 
 func resourceIBMCertificateManagerOrder() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMCertificateManagerOrderCertificate,
-		Read:     resourceIBMCertificateManagerRead,
-		Update:   resourceIBMCertificateManagerRenew,
-		Importer: &schema.ResourceImporter{},
-		Delete:   resourceIBMCertificateManagerDelete,
-		Exists:   resourceIBMCertificateManagerExists,
+		CreateContext: resourceIBMCertificateManagerOrderCertificate,
+		ReadContext:   resourceIBMCertificateManagerRead,
+		UpdateContext: resourceIBMCertificateManagerRenew,
+		Importer:      &schema.ResourceImporter{},
+		DeleteContext: resourceIBMCertificateManagerDelete,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -130,11 +131,11 @@ func resourceIBMCertificateManagerOrder() *schema.Resource {
 	}
 }
 
-func resourceIBMCertificateManagerOrderCertificate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCertificateManagerOrderCertificate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	cmService, err := meta.(ClientSession).CertificateManagerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceID := d.Get("certificate_manager_instance_id").(string)
@@ -163,27 +164,36 @@ func resourceIBMCertificateManagerOrderCertificate(d *schema.ResourceData, meta
 	payload := models.CertificateOrderData{Name: name, Description: description, Domains: domainList, DomainValidationMethod: domainValidationMethod, DNSProviderInstanceCrn: dnsProviderInstanceCrn, KeyAlgorithm: keyAlgorithm, AutoRenewEnabled: autoRenew}
 	result, err := client.OrderCertificate(instanceID, payload)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(result.ID)
 
-	_, err = waitForCertificateOrder(d, meta)
+	_, err = waitForCertificateOrder(context, d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for Ordering Certificate (%s) to be succeeded: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf(
+			"Error waiting for Ordering Certificate (%s) to be succeeded: %s", d.Id(), err))
 	}
 
-	return resourceIBMCertificateManagerRead(d, meta)
+	return resourceIBMCertificateManagerRead(context, d, meta)
 }
-func resourceIBMCertificateManagerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCertificateManagerRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMCertificateManagerExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	cmService, err := meta.(ClientSession).CertificateManagerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	certID := d.Id()
 	certificatedata, err := cmService.Certificate().GetMetaData(certID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cminstanceid := strings.Split(certID, ":certificate:")
 	d.Set("certificate_manager_instance_id", cminstanceid[0]+"::")
@@ -223,10 +233,10 @@ func resourceIBMCertificateManagerRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceIBMCertificateManagerRenew(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCertificateManagerRenew(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cmService, err := meta.(ClientSession).CertificateManagerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	certID := d.Id()
 	client := cmService.Certificate()
@@ -237,7 +247,7 @@ func resourceIBMCertificateManagerRenew(d *schema.ResourceData, meta interface{}
 
 		_, err := client.RenewCertificate(certID, payload)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if d.HasChange("name") || d.HasChange("description") {
@@ -247,7 +257,7 @@ func resourceIBMCertificateManagerRenew(d *schema.ResourceData, meta interface{}
 
 		err := client.UpdateCertificateMetaData(certID, payload)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if d.HasChange("auto_renew_enabled") {
@@ -256,17 +266,17 @@ func resourceIBMCertificateManagerRenew(d *schema.ResourceData, meta interface{}
 
 		_, err := client.UpdateOrderPolicy(certID, payload)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	_, err = waitForCertificateRenew(d, meta)
+	_, err = waitForCertificateRenew(context, d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for Renew Certificate (%s) to be succeeded: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf(
+			"Error waiting for Renew Certificate (%s) to be succeeded: %s", d.Id(), err))
 	}
-	return resourceIBMCertificateManagerRead(d, meta)
+	return resourceIBMCertificateManagerRead(context, d, meta)
 }
-func waitForCertificateOrder(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForCertificateOrder(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	cmService, err := meta.(ClientSession).CertificateManagerAPI()
 	if err != nil {
 		return false, err
@@ -294,9 +304,9 @@ func waitForCertificateOrder(d *schema.ResourceData, meta interface{}) (interfac
 		MinTimeout: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
-func waitForCertificateRenew(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForCertificateRenew(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	cmService, err := meta.(ClientSession).CertificateManagerAPI()
 	if err != nil {
 		return false, err
@@ -324,5 +334,5 @@ func waitForCertificateRenew(d *schema.ResourceData, meta interface{}) (interfac
 		MinTimeout: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -12,6 +13,7 @@ import (
 	"time"
 
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -31,12 +33,11 @@ const (
 
 func resourceIBMCISInstance() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMCISInstanceCreate,
-		Read:     resourceIBMCISInstanceRead,
-		Update:   resourceIBMCISInstanceUpdate,
-		Delete:   resourceIBMCISInstanceDelete,
-		Exists:   resourceIBMCISInstanceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMCISInstanceCreate,
+		ReadContext:   resourceIBMCISInstanceRead,
+		UpdateContext: resourceIBMCISInstanceUpdate,
+		DeleteContext: resourceIBMCISInstanceDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -154,11 +155,12 @@ func resourceIBMCISValidator() *ResourceValidator {
 }
 
 // Replace with func wrapper for resourceIBMResourceInstanceCreate specifying serviceName := "internet-svcs"
-func resourceIBMCISInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISInstanceCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 
 	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	serviceName := "internet-svcs"
 	plan := d.Get("plan").(string)
@@ -171,27 +173,27 @@ func resourceIBMCISInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 
 	rsCatClient, err := meta.(ClientSession).ResourceCatalogAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	rsCatRepo := rsCatClient.ResourceCatalog()
 
 	serviceOff, err := rsCatRepo.FindByName(serviceName, true)
 	if err != nil {
-		return fmt.Errorf("Error retrieving service offering: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving service offering: %s", err))
 	}
 
 	servicePlan, err := rsCatRepo.GetServicePlanID(serviceOff[0], plan)
 	if err != nil {
-		return fmt.Errorf("Error retrieving plan: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving plan: %s", err))
 	}
 	rsInst.ResourcePlanID = &servicePlan
 
 	deployments, err := rsCatRepo.ListDeployments(servicePlan)
 	if err != nil {
-		return fmt.Errorf("Error retrieving deployment for plan %s : %s", plan, err)
+		return diag.FromErr(fmt.Errorf("Error retrieving deployment for plan %s : %s", plan, err))
 	}
 	if len(deployments) == 0 {
-		return fmt.Errorf("No deployment found for service plan : %s", plan)
+		return diag.FromErr(fmt.Errorf("No deployment found for service plan : %s", plan))
 	}
 	deployments, supportedLocations := filterCISDeployments(deployments, location)
 
@@ -200,7 +202,7 @@ func resourceIBMCISInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 		for l := range supportedLocations {
 			locationList = append(locationList, l)
 		}
-		return fmt.Errorf("No deployment found for service plan %s at location %s.\nValid location(s) are: %q.", plan, location, locationList)
+		return diag.FromErr(fmt.Errorf("No deployment found for service plan %s at location %s.\nValid location(s) are: %q.", plan, location, locationList))
 	}
 
 	rsInst.Target = &deployments[0].CatalogCRN
@@ -211,7 +213,7 @@ func resourceIBMCISInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 	} else {
 		defaultRg, err := defaultResourceGroup(meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		rsInst.ResourceGroup = &defaultRg
 	}
@@ -222,7 +224,7 @@ func resourceIBMCISInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 
 	instance, response, err := rsConClient.CreateResourceInstance(&rsInst)
 	if err != nil {
-		return fmt.Errorf("Error creating resource instance: %s %s", err, response)
+		return diag.FromErr(fmt.Errorf("Error creating resource instance: %s %s", err, response))
 	}
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk("tags"); ok || v != "" {
@@ -231,28 +233,37 @@ func resourceIBMCISInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 		if err != nil {
 			log.Printf(
 				"Error on create of ibm cis (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 
 	// Moved d.SetId(instance.ID) to after waiting for resource to finish creation. Otherwise Terraform initates depedent tasks too early.
 	// Original flow had SetId here as its required as input to waitForCISInstanceCreate
 
-	_, err = waitForCISInstanceCreate(d, meta, *instance.ID)
+	_, err = waitForCISInstanceCreate(context, d, meta, *instance.ID)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for create resource instance (%s) to be succeeded: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf(
+			"Error waiting for create resource instance (%s) to be succeeded: %s", d.Id(), err))
 	}
 
 	d.SetId(*instance.ID)
 
-	return resourceIBMCISInstanceRead(d, meta)
+	return append(diags, resourceIBMCISInstanceRead(context, d, meta)...)
 }
 
-func resourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISInstanceRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMCISInstanceExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
 
 	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceID := d.Id()
@@ -267,7 +278,7 @@ func resourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error retrieving resource instance: %s %s", err, response)
+		return diag.FromErr(fmt.Errorf("Error retrieving resource instance: %s %s", err, response))
 	}
 	if strings.Contains(*instance.State, "removed") {
 		log.Printf("[WARN] Removing instance from TF state because it's now in removed state")
@@ -294,7 +305,7 @@ func resourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) error
 
 	rsCatClient, err := meta.(ClientSession).ResourceCatalogAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	rsCatRepo := rsCatClient.ResourceCatalog()
 
@@ -302,7 +313,7 @@ func resourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) error
 
 	servicePlan, err := rsCatRepo.GetServicePlanName(*instance.ResourcePlanID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving plan: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving plan: %s", err))
 	}
 	d.Set("plan", servicePlan)
 
@@ -313,18 +324,19 @@ func resourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) error
 
 	rcontroller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(ResourceControllerURL, rcontroller+"/internet-svcs/"+url.QueryEscape(*instance.CRN))
 
 	return nil
 }
 
-func resourceIBMCISInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISInstanceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 
 	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceID := d.Id()
@@ -342,18 +354,18 @@ func resourceIBMCISInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 		service := d.Get("service").(string)
 		rsCatClient, err := meta.(ClientSession).ResourceCatalogAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		rsCatRepo := rsCatClient.ResourceCatalog()
 
 		serviceOff, err := rsCatRepo.FindByName(service, true)
 		if err != nil {
-			return fmt.Errorf("Error retrieving service offering: %s", err)
+			return diag.FromErr(fmt.Errorf("Error retrieving service offering: %s", err))
 		}
 
 		servicePlan, err := rsCatRepo.GetServicePlanID(serviceOff[0], plan)
 		if err != nil {
-			return fmt.Errorf("Error retrieving plan: %s", err)
+			return diag.FromErr(fmt.Errorf("Error retrieving plan: %s", err))
 		}
 
 		updateReq.ResourcePlanID = &servicePlan
@@ -366,28 +378,29 @@ func resourceIBMCISInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 		if err != nil {
 			log.Printf(
 				"Error on update of CIS (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 
 	_, response, err := rsConClient.UpdateResourceInstance(&updateReq)
 	if err != nil {
-		return fmt.Errorf("Error updating resource instance: %s %s", err, response)
+		return diag.FromErr(fmt.Errorf("Error updating resource instance: %s %s", err, response))
 	}
 
-	_, err = waitForCISInstanceUpdate(d, meta)
+	_, err = waitForCISInstanceUpdate(context, d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for update resource instance (%s) to be succeeded: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf(
+			"Error waiting for update resource instance (%s) to be succeeded: %s", d.Id(), err))
 	}
 
-	return resourceIBMCISInstanceRead(d, meta)
+	return append(diags, resourceIBMCISInstanceRead(context, d, meta)...)
 }
 
-func resourceIBMCISInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISInstanceDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	recursive := true
@@ -404,14 +417,14 @@ func resourceIBMCISInstanceDelete(d *schema.ResourceData, meta interface{}) erro
 			log.Printf("[WARN] Resource instance already deleted %s\n %s", err, response)
 			err = nil
 		} else {
-			return fmt.Errorf("Error deleting resource instance: %s %s", err, response)
+			return diag.FromErr(fmt.Errorf("Error deleting resource instance: %s %s", err, response))
 		}
 	}
 
-	_, err = waitForCISInstanceDelete(d, meta)
+	_, err = waitForCISInstanceDelete(context, d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for resource instance (%s) to be deleted: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf(
+			"Error waiting for resource instance (%s) to be deleted: %s", d.Id(), err))
 	}
 
 	d.SetId("")
@@ -446,7 +459,7 @@ func resourceIBMCISInstanceExists(d *schema.ResourceData, meta interface{}) (boo
 	return *instance.ID == instanceID, nil
 }
 
-func waitForCISInstanceCreate(d *schema.ResourceData, meta interface{}, instanceID string) (interface{}, error) {
+func waitForCISInstanceCreate(context context.Context, d *schema.ResourceData, meta interface{}, instanceID string) (interface{}, error) {
 
 	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
@@ -478,10 +491,10 @@ func waitForCISInstanceCreate(d *schema.ResourceData, meta interface{}, instance
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
-func waitForCISInstanceUpdate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForCISInstanceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 
 	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
@@ -513,10 +526,10 @@ func waitForCISInstanceUpdate(d *schema.ResourceData, meta interface{}) (interfa
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
-func waitForCISInstanceDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForCISInstanceDelete(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 
 	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
@@ -547,7 +560,7 @@ func waitForCISInstanceDelete(d *schema.ResourceData, meta interface{}) (interfa
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func filterCISDeployments(deployments []models.ServiceDeployment, location string) ([]models.ServiceDeployment, map[string]bool) {
@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"log"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -125,11 +127,11 @@ func resourceIBMCISCacheSettings() *schema.Resource {
 				},
 			},
 		},
-		Create:   resourceCISCacheSettingsUpdate,
-		Read:     resourceCISCacheSettingsRead,
-		Update:   resourceCISCacheSettingsUpdate,
-		Delete:   resourceCISCacheSettingsDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceCISCacheSettingsUpdate,
+		ReadContext:   resourceCISCacheSettingsRead,
+		UpdateContext: resourceCISCacheSettingsUpdate,
+		DeleteContext: resourceCISCacheSettingsDelete,
+		Importer:      &schema.ResourceImporter{},
 	}
 }
 
@@ -172,10 +174,10 @@ func resourceIBMCISCacheSettingsValidator() *ResourceValidator {
 	return &ibmCISCacheSettingsResourceValidator
 }
 
-func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISCacheSettingsUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisCacheClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	crn := d.Get(cisID).(string)
 	zoneID, _, err := convertTftoCisTwoVar(d.Get(cisDomainID).(string))
@@ -199,7 +201,7 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 			_, resp, err := cisClient.UpdateCacheLevel(opt)
 			if err != nil {
 				log.Printf("Update caching level failed : %v\n", resp)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 		// Serve Stale Content Setting
@@ -209,7 +211,7 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 			_, resp, err := cisClient.UpdateServeStaleContent(opt)
 			if err != nil {
 				log.Printf("Update Serve Stale Content Setting failed : %v\n", resp)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
@@ -220,7 +222,7 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 			_, resp, err := cisClient.UpdateBrowserCacheTTL(opt)
 			if err != nil {
 				log.Printf("Update browser expiration setting failed : %v\n", resp)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
@@ -231,7 +233,7 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 			_, resp, err := cisClient.UpdateDevelopmentMode(opt)
 			if err != nil {
 				log.Printf("Update development mode setting failed : %v\n", resp)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 		// Query string sort setting
@@ -241,7 +243,7 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 			_, resp, err := cisClient.UpdateQueryStringSort(opt)
 			if err != nil {
 				log.Printf("Update query string sort setting failed : %v\n", resp)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
@@ -251,7 +253,7 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 				result, response, err := cisClient.PurgeAll(opt)
 				if err != nil {
 					log.Printf("Purge all failed : %v", response)
-					return err
+					return diag.FromErr(err)
 				}
 				log.Printf("Purge all successful : %s", *result.Result.ID)
 			}
@@ -263,7 +265,7 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 			_, response, err := cisClient.PurgeByUrls(opt)
 			if err != nil {
 				log.Printf("Purge by urls failed : %v", response)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 		if value, ok := d.GetOk(cisCachePurgeByCacheTags); ok {
@@ -273,7 +275,7 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 			result, response, err := cisClient.PurgeByCacheTags(opt)
 			if err != nil {
 				log.Printf("Purge by cache tags failed : %v", response)
-				return err
+				return diag.FromErr(err)
 			}
 			log.Printf("Purge by tags successful : %s", *result.Result.ID)
 
@@ -285,19 +287,19 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 			result, response, err := cisClient.PurgeByHosts(opt)
 			if err != nil {
 				log.Printf("Purge by hosts failed : %v", response)
-				return err
+				return diag.FromErr(err)
 			}
 			log.Printf("Purge by hosts successful : %s", *result.Result.ID)
 		}
 	}
 	d.SetId(convertCisToTfTwoVar(zoneID, crn))
-	return resourceCISCacheSettingsRead(d, meta)
+	return resourceCISCacheSettingsRead(context, d, meta)
 }
 
-func resourceCISCacheSettingsRead(d *schema.ResourceData, meta interface{}) error {
+func resourceCISCacheSettingsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisCacheClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	zoneID, crn, _ := convertTftoCisTwoVar(d.Id())
 	cisClient.Crn = core.StringPtr(crn)
@@ -307,14 +309,14 @@ func resourceCISCacheSettingsRead(d *schema.ResourceData, meta interface{}) erro
 	cacheLevel, resp, err := cisClient.GetCacheLevel(cisClient.NewGetCacheLevelOptions())
 	if err != nil {
 		log.Printf("Get caching leve setting failed : %v\n", resp)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Serve Stale Content setting
 	servestaleContent, resp, err := cisClient.GetServeStaleContent(cisClient.NewGetServeStaleContentOptions())
 	if err != nil {
 		log.Printf("Get Serve Stale Content setting failed : %v\n", resp)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Browser Expiration setting
@@ -322,7 +324,7 @@ func resourceCISCacheSettingsRead(d *schema.ResourceData, meta interface{}) erro
 		cisClient.NewGetBrowserCacheTtlOptions())
 	if err != nil {
 		log.Printf("Get browser expiration setting failed : %v\n", resp)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// development mode setting
@@ -330,7 +332,7 @@ func resourceCISCacheSettingsRead(d *schema.ResourceData, meta interface{}) erro
 		cisClient.NewGetDevelopmentModeOptions())
 	if err != nil {
 		log.Printf("Get development mode setting failed : %v", resp)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Query string sort setting
@@ -338,7 +340,7 @@ func resourceCISCacheSettingsRead(d *schema.ResourceData, meta interface{}) erro
 		cisClient.NewGetQueryStringSortOptions())
 	if err != nil {
 		log.Printf("Get query string sort setting failed : %v", resp)
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -350,7 +352,7 @@ func resourceCISCacheSettingsRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceCISCacheSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceCISCacheSettingsDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Nothing to delete on CIS resource
 	d.SetId("")
 	return nil
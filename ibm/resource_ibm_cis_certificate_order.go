@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"log"
 	"time"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -25,12 +27,11 @@ const (
 
 func resourceIBMCISCertificateOrder() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMCISCertificateOrderCreate,
-		Update:   resourceIBMCISCertificateOrderRead,
-		Read:     resourceIBMCISCertificateOrderRead,
-		Delete:   resourceIBMCISCertificateOrderDelete,
-		Exists:   resourceIBMCISCertificateOrderExist,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMCISCertificateOrderCreate,
+		UpdateContext: resourceIBMCISCertificateOrderRead,
+		ReadContext:   resourceIBMCISCertificateOrderRead,
+		DeleteContext: resourceIBMCISCertificateOrderDelete,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -85,10 +86,10 @@ func resourceIBMCISCertificateOrderValidator() *ResourceValidator {
 	return &cisCertificateOrderValidator
 }
 
-func resourceIBMCISCertificateOrderCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISCertificateOrderCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisSSLClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	crn := d.Get(cisID).(string)
 	zoneID, _, err := convertTftoCisTwoVar(d.Get(cisDomainID).(string))
@@ -105,22 +106,31 @@ func resourceIBMCISCertificateOrderCreate(d *schema.ResourceData, meta interface
 	result, resp, err := cisClient.OrderCertificate(opt)
 	if err != nil {
 		log.Printf("Certificate order failed: %v", resp)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(convertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
-	return resourceIBMCISCertificateOrderRead(d, meta)
+	return resourceIBMCISCertificateOrderRead(context, d, meta)
 }
 
-func resourceIBMCISCertificateOrderRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISCertificateOrderRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMCISCertificateOrderExist(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	cisClient, err := meta.(ClientSession).CisSSLClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	certificateID, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
 	if err != nil {
 		log.Println("Error in reading certificate id")
-		return err
+		return diag.FromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -128,7 +138,7 @@ func resourceIBMCISCertificateOrderRead(d *schema.ResourceData, meta interface{}
 	result, resp, err := cisClient.GetCustomCertificate(opt)
 	if err != nil {
 		log.Printf("Certificate read failed: %v", resp)
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -139,15 +149,15 @@ func resourceIBMCISCertificateOrderRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceIBMCISCertificateOrderDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISCertificateOrderDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisSSLClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	certificateID, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
 	if err != nil {
 		log.Println("Error in reading certificate id")
-		return err
+		return diag.FromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -155,12 +165,12 @@ func resourceIBMCISCertificateOrderDelete(d *schema.ResourceData, meta interface
 	resp, err := cisClient.DeleteCertificate(opt)
 	if err != nil {
 		log.Printf("Certificate delete failed: %v", resp)
-		return err
+		return diag.FromErr(err)
 	}
 
-	_, err = waitForCISCertificateOrderDelete(d, meta)
+	_, err = waitForCISCertificateOrderDelete(context, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -191,7 +201,7 @@ func resourceIBMCISCertificateOrderExist(d *schema.ResourceData, meta interface{
 	return true, nil
 }
 
-func waitForCISCertificateOrderDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForCISCertificateOrderDelete(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	cisClient, err := meta.(ClientSession).CisSSLClientSession()
 	if err != nil {
 		return nil, err
@@ -223,5 +233,5 @@ func waitForCISCertificateOrderDelete(d *schema.ResourceData, meta interface{})
 		PollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"log"
 	"strings"
 	"time"
 
 	"github.com/IBM/go-sdk-core/v4/core"
 	cissslv1 "github.com/IBM/networking-go-sdk/sslcertificateapiv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -36,12 +38,11 @@ const (
 
 func resourceIBMCISCertificateUpload() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceCISCertificateUploadCreate,
-		Read:     resourceCISCertificateUploadRead,
-		Update:   resourceCISCertificateUploadUpdate,
-		Delete:   resourceCISCertificateUploadDelete,
-		Exists:   resourceCISCertificateUploadExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceCISCertificateUploadCreate,
+		ReadContext:   resourceCISCertificateUploadRead,
+		UpdateContext: resourceCISCertificateUploadUpdate,
+		DeleteContext: resourceCISCertificateUploadDelete,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -142,10 +143,10 @@ func resourceCISCertificateUploadValidator() *ResourceValidator {
 	return &cisCertificateUploadValidator
 }
 
-func resourceCISCertificateUploadCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISCertificateUploadCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisSSLClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -164,7 +165,7 @@ func resourceCISCertificateUploadCreate(d *schema.ResourceData, meta interface{}
 	result, response, err := cisClient.UploadCustomCertificate(opt)
 	if err != nil {
 		log.Printf("Upload custom certificate failed: %v", response)
-		return err
+		return diag.FromErr(err)
 	}
 	certID := *result.Result.ID
 	d.SetId(convertCisToTfThreeVar(certID, zoneID, crn))
@@ -182,21 +183,30 @@ func resourceCISCertificateUploadCreate(d *schema.ResourceData, meta interface{}
 		priorityResponse, err := cisClient.ChangeCertificatePriority(priorityOpt)
 		if err != nil {
 			log.Printf("Change certificate priority failed: %v", priorityResponse)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceCISCertificateUploadRead(d, meta)
+	return resourceCISCertificateUploadRead(context, d, meta)
 }
-func resourceCISCertificateUploadRead(d *schema.ResourceData, meta interface{}) error {
+func resourceCISCertificateUploadRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceCISCertificateUploadExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	cisClient, err := meta.(ClientSession).CisSSLClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	certID, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -205,7 +215,7 @@ func resourceCISCertificateUploadRead(d *schema.ResourceData, meta interface{})
 	result, response, err := cisClient.GetCustomCertificate(opt)
 	if err != nil {
 		log.Printf("Get custom certificate failed: %v", response)
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -221,15 +231,15 @@ func resourceCISCertificateUploadRead(d *schema.ResourceData, meta interface{})
 	d.Set(cisCertificateUploadExpiresOn, result.Result.ExpiresOn)
 	return nil
 }
-func resourceCISCertificateUploadUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISCertificateUploadUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisSSLClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	certID, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -245,7 +255,7 @@ func resourceCISCertificateUploadUpdate(d *schema.ResourceData, meta interface{}
 		_, response, err := cisClient.UpdateCustomCertificate(opt)
 		if err != nil {
 			log.Printf("Update custom certificate failed: %v", response)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -263,22 +273,22 @@ func resourceCISCertificateUploadUpdate(d *schema.ResourceData, meta interface{}
 			_, err := cisClient.ChangeCertificatePriority(priorityOpt)
 			if err != nil {
 				log.Printf("Change certificate priority failed: %v", err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
-	return resourceCISCertificateUploadRead(d, meta)
+	return resourceCISCertificateUploadRead(context, d, meta)
 }
 
-func resourceCISCertificateUploadDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceCISCertificateUploadDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisSSLClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	certID, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -286,11 +296,11 @@ func resourceCISCertificateUploadDelete(d *schema.ResourceData, meta interface{}
 	_, err = cisClient.DeleteCustomCertificate(opt)
 	if err != nil {
 		log.Printf("Delete custom certificate failed: %v", err)
-		return err
+		return diag.FromErr(err)
 	}
-	_, err = waitForCISCertificateUploadDelete(d, meta)
+	_, err = waitForCISCertificateUploadDelete(context, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return nil
@@ -321,7 +331,7 @@ func resourceCISCertificateUploadExists(d *schema.ResourceData, meta interface{}
 	return true, nil
 }
 
-func waitForCISCertificateUploadDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForCISCertificateUploadDelete(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	cisClient, err := meta.(ClientSession).CisSSLClientSession()
 	if err != nil {
 		return nil, err
@@ -354,5 +364,5 @@ func waitForCISCertificateUploadDelete(d *schema.ResourceData, meta interface{})
 		PollInterval: 5 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
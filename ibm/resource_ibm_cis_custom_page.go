@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"log"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -85,11 +87,11 @@ func resourceIBMCISCustomPage() *schema.Resource {
 				Computed:    true,
 			},
 		},
-		Create:   resourceCISCustomPageUpdate,
-		Read:     resourceCISCustomPageRead,
-		Update:   resourceCISCustomPageUpdate,
-		Delete:   resourceCISCustomPageDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceCISCustomPageUpdate,
+		ReadContext:   resourceCISCustomPageRead,
+		UpdateContext: resourceCISCustomPageUpdate,
+		DeleteContext: resourceCISCustomPageDelete,
+		Importer:      &schema.ResourceImporter{},
 	}
 }
 
@@ -111,10 +113,10 @@ func resourceIBMCISCustomPageValidator() *ResourceValidator {
 	return &ibmCISCustomPageResourceValidator
 }
 
-func resourceCISCustomPageUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISCustomPageUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisCustomPageClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	crn := d.Get(cisID).(string)
 	zoneID, _, err := convertTftoCisTwoVar(d.Get(cisDomainID).(string))
@@ -136,17 +138,17 @@ func resourceCISCustomPageUpdate(d *schema.ResourceData, meta interface{}) error
 		result, response, err := cisClient.UpdateZoneCustomPage(opt)
 		if err != nil {
 			log.Printf("Update custom page failed : %v", response)
-			return err
+			return diag.FromErr(err)
 		}
 		d.SetId(convertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
 	}
-	return resourceCISCustomPageRead(d, meta)
+	return resourceCISCustomPageRead(context, d, meta)
 }
 
-func resourceCISCustomPageRead(d *schema.ResourceData, meta interface{}) error {
+func resourceCISCustomPageRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisCustomPageClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	pageID, zoneID, crn, _ := convertTfToCisThreeVar(d.Id())
 	cisClient.Crn = core.StringPtr(crn)
@@ -162,7 +164,7 @@ func resourceCISCustomPageRead(d *schema.ResourceData, meta interface{}) error {
 			return nil
 		}
 		log.Printf("Get custom page failed : %v", response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set(cisID, crn)
@@ -178,7 +180,7 @@ func resourceCISCustomPageRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceCISCustomPageDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceCISCustomPageDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Nothing to delete on CIS resource
 	d.SetId("")
 	return nil
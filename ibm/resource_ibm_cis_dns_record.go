@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -48,12 +50,11 @@ const (
 
 func resourceIBMCISDnsRecord() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMCISDnsRecordCreate,
-		Read:     resourceIBMCISDnsRecordRead,
-		Update:   resourceIBMCISDnsRecordUpdate,
-		Delete:   resourceIBMCISDnsRecordDelete,
-		Exists:   resourceIBMCISDnsRecordExist,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMCISDnsRecordCreate,
+		ReadContext:   resourceIBMCISDnsRecordRead,
+		UpdateContext: resourceIBMCISDnsRecordUpdate,
+		DeleteContext: resourceIBMCISDnsRecordDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			cisID: {
@@ -137,12 +138,12 @@ func resourceIBMCISDnsRecord() *schema.Resource {
 	}
 }
 
-func resourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISDnsRecordCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := meta.(ClientSession).CisDNSRecordClientSession()
 	if err != nil {
 		log.Printf("Error: %s", err)
-		return err
+		return diag.FromErr(err)
 	}
 	var (
 		crn            string
@@ -208,7 +209,7 @@ func resourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) err
 		data, ok = d.GetOk(cisDNSRecordData)
 		if ok == false {
 			log.Printf("Error in getting data")
-			return err
+			return diag.FromErr(err)
 		}
 		recordData = make(map[string]interface{}, 0)
 		var dataMap map[string]interface{} = data.(map[string]interface{})
@@ -216,14 +217,14 @@ func resourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) err
 		// altitude
 		v, ok = strconv.ParseFloat(dataMap["altitude"].(string), 64)
 		if ok != nil {
-			return fmt.Errorf("data input error")
+			return diag.FromErr(fmt.Errorf("data input error"))
 		}
 		recordData["altitude"] = v
 
 		// lat_degrees
 		v, ok = strconv.Atoi(dataMap["lat_degrees"].(string))
 		if ok != nil {
-			return fmt.Errorf("data input error")
+			return diag.FromErr(fmt.Errorf("data input error"))
 		}
 		recordData["lat_degrees"] = v
 
@@ -236,14 +237,14 @@ func resourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) err
 		// lat_minutes
 		v, ok = strconv.Atoi(dataMap["lat_minutes"].(string))
 		if ok != nil {
-			return fmt.Errorf("data input error")
+			return diag.FromErr(fmt.Errorf("data input error"))
 		}
 		recordData["lat_minutes"] = v
 
 		// lat_seconds
 		v, ok = strconv.ParseFloat(dataMap["lat_seconds"].(string), 64)
 		if ok != nil {
-			return fmt.Errorf("data input error")
+			return diag.FromErr(fmt.Errorf("data input error"))
 
 		}
 		recordData["lat_seconds"] = v
@@ -251,42 +252,42 @@ func resourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) err
 		// long_degrees
 		v, ok := strconv.Atoi(dataMap["long_degrees"].(string))
 		if ok != nil {
-			return ok
+			return diag.FromErr(ok)
 		}
 		recordData["long_degrees"] = v
 
 		// long_minutes
 		v, ok = strconv.Atoi(dataMap["long_minutes"].(string))
 		if ok != nil {
-			return ok
+			return diag.FromErr(ok)
 		}
 		recordData["long_minutes"] = v
 
 		// long_seconds
 		i, ok := strconv.ParseFloat(dataMap["long_seconds"].(string), 64)
 		if ok != nil {
-			return ok
+			return diag.FromErr(ok)
 		}
 		recordData["long_seconds"] = i
 
 		// percision_horz
 		i, ok = strconv.ParseFloat(dataMap["precision_horz"].(string), 64)
 		if ok != nil {
-			return ok
+			return diag.FromErr(ok)
 		}
 		recordData["precision_horz"] = v
 
 		// precision_vert
 		i, ok = strconv.ParseFloat(dataMap["precision_vert"].(string), 64)
 		if ok != nil {
-			return ok
+			return diag.FromErr(ok)
 		}
 		recordData["precision_vert"] = i
 
 		// size
 		i, ok = strconv.ParseFloat(dataMap["size"].(string), 64)
 		if ok != nil {
-			return ok
+			return diag.FromErr(ok)
 		}
 		recordData["size"] = i
 
@@ -301,7 +302,7 @@ func resourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) err
 		data, ok = d.GetOk(cisDNSRecordData)
 		if ok == false {
 			log.Printf("Error in getting data")
-			return err
+			return diag.FromErr(err)
 		}
 		recordData = make(map[string]interface{}, 0)
 		var dataMap map[string]interface{} = data.(map[string]interface{})
@@ -321,7 +322,7 @@ func resourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) err
 		data, ok = d.GetOk(cisDNSRecordData)
 		if ok == false {
 			log.Printf("Error in getting data")
-			return err
+			return diag.FromErr(err)
 		}
 		recordData = make(map[string]interface{}, 0)
 		var dataMap map[string]interface{} = data.(map[string]interface{})
@@ -346,21 +347,21 @@ func resourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) err
 		// port
 		s, ok := strconv.Atoi(dataMap["port"].(string))
 		if ok != nil {
-			return ok
+			return diag.FromErr(ok)
 		}
 		recordData["port"] = s
 
 		// priority
 		s, ok = strconv.Atoi(dataMap["priority"].(string))
 		if ok != nil {
-			return ok
+			return diag.FromErr(ok)
 		}
 		recordData["priority"] = s
 
 		// weight
 		s, ok = strconv.Atoi(dataMap["weight"].(string))
 		if ok != nil {
-			return ok
+			return diag.FromErr(ok)
 		}
 		recordData["weight"] = s
 		opt.SetData(recordData)
@@ -383,7 +384,7 @@ func resourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) err
 			for id, content := range data.(map[string]interface{}) {
 				newData, err := transformToIBMCISDnsData(recordType, id, content)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				} else if newData == nil {
 					continue
 				}
@@ -394,9 +395,9 @@ func resourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) err
 		}
 
 		if contentOk == dataOk {
-			return fmt.Errorf(
+			return diag.FromErr(fmt.Errorf(
 				"either 'content' (present: %t) or 'data' (present: %t) must be provided",
-				contentOk, dataOk)
+				contentOk, dataOk))
 		}
 
 		if priority, ok := d.GetOk("priority"); ok {
@@ -411,15 +412,24 @@ func resourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) err
 	result, response, err := sess.CreateDnsRecord(opt)
 	if err != nil {
 		log.Printf("Error creating dns record: %s, error %s", response, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(convertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
-	return resourceIBMCISDnsRecordUpdate(d, meta)
+	return resourceIBMCISDnsRecordUpdate(context, d, meta)
 
 }
 
-func resourceIBMCISDnsRecordRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISDnsRecordRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMCISDnsRecordExist(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	var (
 		crn      string
 		zoneID   string
@@ -427,12 +437,12 @@ func resourceIBMCISDnsRecordRead(d *schema.ResourceData, meta interface{}) error
 	)
 	sess, err := meta.(ClientSession).CisDNSRecordClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	recordID, zoneID, crn, _ = convertTfToCisThreeVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	sess.Crn = core.StringPtr(crn)
 	sess.ZoneIdentifier = core.StringPtr(zoneID)
@@ -445,7 +455,7 @@ func resourceIBMCISDnsRecordRead(d *schema.ResourceData, meta interface{}) error
 			return nil
 		}
 		log.Printf("Error reading dns record: %s", response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set(cisID, crn)
@@ -471,11 +481,11 @@ func resourceIBMCISDnsRecordRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISDnsRecordUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).CisDNSRecordClientSession()
 	if err != nil {
 		log.Printf("Error: %s", err)
-		return err
+		return diag.FromErr(err)
 	}
 	var (
 		recordID       string
@@ -495,7 +505,7 @@ func resourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) err
 	recordID, zoneID, crn, err = convertTfToCisThreeVar(d.Id())
 	if err != nil {
 		log.Println("Error in reading record id")
-		return err
+		return diag.FromErr(err)
 	}
 	sess.Crn = core.StringPtr(crn)
 	sess.ZoneIdentifier = core.StringPtr(zoneID)
@@ -563,7 +573,7 @@ func resourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) err
 			data, ok = d.GetOk(cisDNSRecordData)
 			if ok == false {
 				log.Printf("Error in getting data")
-				return err
+				return diag.FromErr(err)
 			}
 			recordData = make(map[string]interface{}, 0)
 			var dataMap map[string]interface{} = data.(map[string]interface{})
@@ -571,14 +581,14 @@ func resourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) err
 			// altitude
 			v, ok := strconv.ParseFloat(dataMap["altitude"].(string), 64)
 			if ok != nil {
-				return ok
+				return diag.FromErr(ok)
 			}
 			recordData["altitude"] = v
 
 			// lat_degrees
 			i, ok := strconv.Atoi(dataMap["lat_degrees"].(string))
 			if ok != nil {
-				return ok
+				return diag.FromErr(ok)
 			}
 			recordData["lat_degrees"] = i
 
@@ -591,56 +601,56 @@ func resourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) err
 			// lat_minutes
 			i, ok = strconv.Atoi(dataMap["lat_minutes"].(string))
 			if ok != nil {
-				return ok
+				return diag.FromErr(ok)
 			}
 			recordData["lat_minutes"] = i
 
 			// lat_seconds
 			v, ok = strconv.ParseFloat(dataMap["lat_seconds"].(string), 64)
 			if ok != nil {
-				return ok
+				return diag.FromErr(ok)
 			}
 			recordData["lat_seconds"] = v
 
 			// long_degrees
 			i, ok = strconv.Atoi(dataMap["long_degrees"].(string))
 			if ok != nil {
-				return ok
+				return diag.FromErr(ok)
 			}
 			recordData["long_degrees"] = i
 
 			// long_minutes
 			i, ok = strconv.Atoi(dataMap["long_minutes"].(string))
 			if ok != nil {
-				return ok
+				return diag.FromErr(ok)
 			}
 			recordData["long_minutes"] = i
 
 			// long_seconds
 			v, ok = strconv.ParseFloat(dataMap["long_seconds"].(string), 64)
 			if ok != nil {
-				return ok
+				return diag.FromErr(ok)
 			}
 			recordData["long_seconds"] = v
 
 			// percision_horz
 			v, ok = strconv.ParseFloat(dataMap["precision_horz"].(string), 64)
 			if ok != nil {
-				return ok
+				return diag.FromErr(ok)
 			}
 			recordData["precision_horz"] = v
 
 			// precision_vert
 			v, ok = strconv.ParseFloat(dataMap["precision_vert"].(string), 64)
 			if ok != nil {
-				return ok
+				return diag.FromErr(ok)
 			}
 			recordData["precision_vert"] = v
 
 			// size
 			v, ok = strconv.ParseFloat(dataMap["size"].(string), 64)
 			if ok != nil {
-				return ok
+				return diag.FromErr(ok)
 			}
 			recordData["size"] = v
 
@@ -655,7 +665,7 @@ func resourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) err
 			data, ok = d.GetOk(cisDNSRecordData)
 			if ok == false {
 				log.Printf("Error in getting data")
-				return err
+				return diag.FromErr(err)
 			}
 			recordData = make(map[string]interface{}, 0)
 			var dataMap map[string]interface{} = data.(map[string]interface{})
@@ -675,7 +685,7 @@ func resourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) err
 			data, ok = d.GetOk(cisDNSRecordData)
 			if ok == false {
 				log.Printf("Error in getting data")
-				return err
+				return diag.FromErr(err)
 			}
 			recordData = make(map[string]interface{}, 0)
 			var dataMap map[string]interface{} = data.(map[string]interface{})
@@ -700,21 +710,21 @@ func resourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) err
 			// port
 			s, ok := strconv.Atoi(dataMap["port"].(string))
 			if ok != nil {
-				return ok
+				return diag.FromErr(ok)
 			}
 			recordData["port"] = s
 
 			// priority
 			s, ok = strconv.Atoi(dataMap["priority"].(string))
 			if ok != nil {
-				return ok
+				return diag.FromErr(ok)
 			}
 			recordData["priority"] = s
 
 			// weight
 			s, ok = strconv.Atoi(dataMap["weight"].(string))
 			if ok != nil {
-				return ok
+				return diag.FromErr(ok)
 			}
 			recordData["weight"] = s
 			opt.SetData(recordData)
@@ -742,8 +752,8 @@ func resourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) err
 					opt.SetTTL(int64(ttl.(int)))
 				}
 				if ttl != 1 && proxied == true {
-					return fmt.Errorf("To enable proxy TTL should be Automatic %s",
-						"i.e it should be set to 1. For the the values other than Automatic, proxy should be disabled.")
+					return diag.FromErr(fmt.Errorf("To enable proxy TTL should be Automatic %s",
+						"i.e it should be set to 1. For the the values other than Automatic, proxy should be disabled."))
 				}
 				priority, priorityOk := d.GetOk(cisDNSRecordPriority)
 				if priorityOk {
@@ -756,7 +766,7 @@ func resourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) err
 					for id, content := range data.(map[string]interface{}) {
 						newData, err := transformToIBMCISDnsData(recordType, id, content)
 						if err != nil {
-							return err
+							return diag.FromErr(err)
 						} else if newData == nil {
 							continue
 						}
@@ -766,9 +776,9 @@ func resourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) err
 					opt.SetData(newDataMap)
 				}
 				if contentOk == dataOk {
-					return fmt.Errorf(
+					return diag.FromErr(fmt.Errorf(
 						"either 'content' (present: %t) or 'data' (present: %t) must be provided",
-						contentOk, dataOk)
+						contentOk, dataOk))
 				}
 			}
 		}
@@ -776,14 +786,14 @@ func resourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) err
 		result, response, err := sess.UpdateDnsRecord(opt)
 		if err != nil {
 			log.Printf("Error updating dns record: %s, error %s", response, err)
-			return err
+			return diag.FromErr(err)
 		}
 		log.Printf("record id: %s", *result.Result.ID)
 	}
-	return resourceIBMCISDnsRecordRead(d, meta)
+	return resourceIBMCISDnsRecordRead(context, d, meta)
 }
 
-func resourceIBMCISDnsRecordDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISDnsRecordDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var (
 		crn      string
 		zoneID   string
@@ -792,13 +802,13 @@ func resourceIBMCISDnsRecordDelete(d *schema.ResourceData, meta interface{}) err
 	sess, err := meta.(ClientSession).CisDNSRecordClientSession()
 	if err != nil {
 		log.Printf("Error: %s", err)
-		return err
+		return diag.FromErr(err)
 	}
 	// session options
 	recordID, zoneID, crn, _ = convertTfToCisThreeVar(d.Id())
 	if err != nil {
 		log.Println("Error in reading input")
-		return err
+		return diag.FromErr(err)
 	}
 	sess.Crn = core.StringPtr(crn)
 	sess.ZoneIdentifier = core.StringPtr(zoneID)
@@ -808,7 +818,7 @@ func resourceIBMCISDnsRecordDelete(d *schema.ResourceData, meta interface{}) err
 
 	if err != nil && !strings.Contains(err.Error(), "Request failed with status code: 404") {
 		log.Printf("Error deleting dns record %s: %s", *result.Result.ID, response)
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return nil
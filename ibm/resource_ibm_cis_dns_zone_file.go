@@ -0,0 +1,528 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	cisDNSZoneFileContent       = "content"
+	cisDNSZoneFileAuthoritative = "authoritative"
+	cisDNSZoneFileRecords       = "records"
+)
+
+func resourceIBMCISDNSZoneFile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCISDNSZoneFileCreate,
+		ReadContext:   resourceIBMCISDNSZoneFileRead,
+		UpdateContext: resourceIBMCISDNSZoneFileUpdate,
+		DeleteContext: resourceIBMCISDNSZoneFileDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMCISDNSZoneFileImport,
+		},
+
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+			if !diff.NewValueKnown(cisDNSZoneFileContent) {
+				return nil
+			}
+			// The relative names only need an origin to be checked
+			zoneName := diff.Get(cisZoneName).(string)
+			if zoneName == "" {
+				zoneName = "zone.invalid"
+			}
+			_, err := parseCISZoneFile(diff.Get(cisDNSZoneFileContent).(string), zoneName)
+			return err
+		},
+
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "CIS instance crn",
+			},
+			cisDomainID: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressDomainIDDiff,
+				Description:      "Associated CIS domain",
+			},
+			cisDNSZoneFileContent: {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressCISZoneFileDiff,
+				Description:      "The records of the domain in the BIND zone file format",
+			},
+			cisDNSZoneFileAuthoritative: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the records of the domain missing from the zone file are deleted, not only the ones managed by the resource",
+			},
+			cisZoneName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the domain, the origin of the relative names of the zone file",
+			},
+			cisDNSZoneFileRecords: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The DNS records managed by the zone file",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						cisDNSRecordID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "DNS record id",
+						},
+						cisDNSRecordName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "DNS record name",
+						},
+						cisDNSRecordType: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "DNS record type",
+						},
+						cisDNSRecordContent: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "DNS record content, without the priority of the MX and SRV records",
+						},
+						cisDNSRecordPriority: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Priority of the MX and SRV records",
+						},
+						cisDNSRecordTTL: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "DNS record Time To Live, 1 for automatic",
+						},
+						cisDNSRecordProxied: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the DNS record is proxied",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// suppressCISZoneFileDiff suppresses the differences of zone files defining the same
+// records, such as with relative names, other TTL units or in another order.
+func suppressCISZoneFileDiff(k, old, new string, d *schema.ResourceData) bool {
+	zoneName := d.Get(cisZoneName).(string)
+	if zoneName == "" {
+		return false
+	}
+	oldRecords, err := parseCISZoneFile(old, zoneName)
+	if err != nil {
+		return false
+	}
+	newRecords, err := parseCISZoneFile(new, zoneName)
+	if err != nil {
+		return false
+	}
+	return equalCISZoneRecords(oldRecords, newRecords)
+}
+
+// getCISZoneName returns the name of the domain of a CIS instance.
+func getCISZoneName(meta interface{}, crn, zoneID string) (string, *core.DetailedResponse, error) {
+	sess, err := meta.(ClientSession).CisZonesV1ClientSession()
+	if err != nil {
+		return "", nil, err
+	}
+	sess.Crn = core.StringPtr(crn)
+	result, response, err := sess.GetZone(sess.NewGetZoneOptions(zoneID))
+	if err != nil {
+		return "", response, fmt.Errorf("Error getting domain %s: %s", zoneID, err)
+	}
+	return *result.Result.Name, response, nil
+}
+
+// listCISZoneRecords returns every DNS record of the domain of the client session.
+func listCISZoneRecords(sess *dnsrecordsv1.DnsRecordsV1) ([]cisZoneRecord, error) {
+	records := []cisZoneRecord{}
+	opt := sess.NewListAllDnsRecordsOptions()
+	opt.SetPerPage(1000)
+	for page := int64(1); ; page++ {
+		opt.SetPage(page)
+		result, response, err := sess.ListAllDnsRecords(opt)
+		if err != nil {
+			log.Printf("Error listing dns records: %s", response)
+			return nil, fmt.Errorf("Error listing DNS records of domain %s: %s", *sess.ZoneIdentifier, err)
+		}
+		for _, details := range result.Result {
+			records = append(records, cisZoneRecordFromDetails(details))
+		}
+		if len(result.Result) == 0 || result.ResultInfo == nil || result.ResultInfo.TotalCount == nil ||
+			int64(len(records)) >= *result.ResultInfo.TotalCount {
+			return records, nil
+		}
+	}
+}
+
+// createCISZoneRecord creates a record of the zone file. The records can't be
+// proxied when they are created, the proxied ones are then updated.
+func createCISZoneRecord(sess *dnsrecordsv1.DnsRecordsV1, r cisZoneRecord) (cisZoneRecord, error) {
+	opt := sess.NewCreateDnsRecordOptions()
+	opt.SetName(r.Name)
+	opt.SetType(r.Type)
+	opt.SetTTL(r.TTL)
+	if data := cisZoneRecordData(r); data != nil {
+		opt.SetData(data)
+	} else {
+		opt.SetContent(r.Content)
+	}
+	if r.Type == cisDNSRecordTypeMX {
+		opt.SetPriority(r.Priority)
+	}
+	result, response, err := sess.CreateDnsRecord(opt)
+	if err != nil {
+		log.Printf("Error creating dns record: %s", response)
+		return r, fmt.Errorf("Error creating %s record %s: %s", r.Type, r.Name, err)
+	}
+	r.ID = *result.Result.ID
+	if r.Proxied {
+		return r, updateCISZoneRecord(sess, r)
+	}
+	return r, nil
+}
+
+func updateCISZoneRecord(sess *dnsrecordsv1.DnsRecordsV1, r cisZoneRecord) error {
+	opt := sess.NewUpdateDnsRecordOptions(r.ID)
+	opt.SetName(r.Name)
+	opt.SetType(r.Type)
+	opt.SetTTL(r.TTL)
+	if data := cisZoneRecordData(r); data != nil {
+		opt.SetData(data)
+	} else {
+		opt.SetContent(r.Content)
+	}
+	switch r.Type {
+	case cisDNSRecordTypeMX:
+		opt.SetPriority(r.Priority)
+	case cisDNSRecordTypeA, cisDNSRecordTypeAAAA, cisDNSRecordTypeCNAME:
+		opt.SetProxied(r.Proxied)
+	}
+	_, response, err := sess.UpdateDnsRecord(opt)
+	if err != nil {
+		log.Printf("Error updating dns record: %s", response)
+		return fmt.Errorf("Error updating %s record %s (%s): %s", r.Type, r.Name, r.ID, err)
+	}
+	return nil
+}
+
+func deleteCISZoneRecord(sess *dnsrecordsv1.DnsRecordsV1, r cisZoneRecord) error {
+	_, response, err := sess.DeleteDnsRecord(sess.NewDeleteDnsRecordOptions(r.ID))
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("Error deleting dns record: %s", response)
+		return fmt.Errorf("Error deleting %s record %s (%s): %s", r.Type, r.Name, r.ID, err)
+	}
+	return nil
+}
+
+// syncCISZoneFile changes the live records of the domain into the desired ones and
+// returns the records managed afterwards, the ones managed so far on an error.
+//
+// The managed records identical to a desired record are kept, and only have their
+// TTL and proxy status updated. The other desired records update a managed record
+// with the same name and type, or are created. The managed records left are deleted.
+// When the zone file is authoritative, the unmanaged records of the supported types
+// are handled as managed ones, else a desired record identical to an unmanaged one
+// is an error, the resource not taking over the records it didn't create. The
+// records are deleted first so a CNAME record can replace the other records of its
+// name.
+func syncCISZoneFile(sess *dnsrecordsv1.DnsRecordsV1, desired []cisZoneRecord, managed map[string]bool, authoritative bool) ([]cisZoneRecord, error) {
+	live, err := listCISZoneRecords(sess)
+	if err != nil {
+		return nil, err
+	}
+	owned := func(r cisZoneRecord) bool {
+		return isCISZoneFileRecordType(r.Type) && (managed[r.ID] || authoritative)
+	}
+
+	result := map[string]cisZoneRecord{}
+	used := map[string]bool{}
+	updates, pending, creates := []cisZoneRecord{}, []cisZoneRecord{}, []cisZoneRecord{}
+	for _, want := range desired {
+		found := false
+		for _, have := range live {
+			if !used[have.ID] && owned(have) && have.key() == want.key() {
+				used[have.ID], found = true, true
+				result[have.ID] = have
+				if want.ID = have.ID; want.String() != have.String() {
+					updates = append(updates, want)
+				}
+				break
+			}
+		}
+		if !found {
+			pending = append(pending, want)
+		}
+	}
+	for _, want := range pending {
+		found := false
+		for _, have := range live {
+			if !used[have.ID] && owned(have) && have.Name == want.Name && have.Type == want.Type {
+				used[have.ID], found = true, true
+				result[have.ID] = have
+				want.ID = have.ID
+				updates = append(updates, want)
+				break
+			}
+		}
+		if !found {
+			creates = append(creates, want)
+		}
+	}
+	for _, want := range creates {
+		for _, have := range live {
+			if !owned(have) && have.key() == want.key() {
+				return nil, fmt.Errorf("The %s record %s (%s) of the zone file already exists and isn't managed by the zone file, delete it or set authoritative to manage it", have.Type, have.Name, have.ID)
+			}
+		}
+	}
+	for _, have := range live {
+		if !used[have.ID] && managed[have.ID] {
+			result[have.ID] = have
+		}
+	}
+
+	err = func() error {
+		for _, have := range live {
+			if used[have.ID] || !owned(have) {
+				continue
+			}
+			log.Printf("[INFO] Deleting %s record %s (%s) missing from the zone file", have.Type, have.Name, have.ID)
+			if err := deleteCISZoneRecord(sess, have); err != nil {
+				return err
+			}
+			delete(result, have.ID)
+		}
+		for _, want := range updates {
+			log.Printf("[INFO] Updating %s record %s (%s) of the zone file", want.Type, want.Name, want.ID)
+			if err := updateCISZoneRecord(sess, want); err != nil {
+				return err
+			}
+			result[want.ID] = want
+		}
+		for _, want := range creates {
+			log.Printf("[INFO] Creating %s record %s of the zone file", want.Type, want.Name)
+			created, err := createCISZoneRecord(sess, want)
+			if created.ID != "" {
+				result[created.ID] = created
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}()
+
+	records := make([]cisZoneRecord, 0, len(result))
+	for _, r := range result {
+		records = append(records, r)
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].String() < records[j].String() })
+	return records, err
+}
+
+func flattenCISZoneRecords(records []cisZoneRecord) []map[string]interface{} {
+	list := make([]map[string]interface{}, len(records))
+	for i, r := range records {
+		list[i] = map[string]interface{}{
+			cisDNSRecordID:       r.ID,
+			cisDNSRecordName:     r.Name,
+			cisDNSRecordType:     r.Type,
+			cisDNSRecordContent:  r.Content,
+			cisDNSRecordPriority: r.Priority,
+			cisDNSRecordTTL:      r.TTL,
+			cisDNSRecordProxied:  r.Proxied,
+		}
+	}
+	return list
+}
+
+// cisZoneFileManagedRecordIDs returns the IDs of the records managed by the resource.
+func cisZoneFileManagedRecordIDs(d *schema.ResourceData) map[string]bool {
+	ids := map[string]bool{}
+	for _, e := range d.Get(cisDNSZoneFileRecords).([]interface{}) {
+		if r, ok := e.(map[string]interface{}); ok {
+			ids[r[cisDNSRecordID].(string)] = true
+		}
+	}
+	return ids
+}
+
+func resourceIBMCISDNSZoneFileCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	crn := d.Get(cisID).(string)
+	zoneID, _, _ := convertTftoCisTwoVar(d.Get(cisDomainID).(string))
+	zoneName, _, err := getCISZoneName(meta, crn, zoneID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set(cisZoneName, zoneName)
+	d.SetId(convertCisToTfTwoVar(zoneID, crn))
+
+	diags := resourceIBMCISDNSZoneFileSync(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	return resourceIBMCISDNSZoneFileRead(context, d, meta)
+}
+
+func resourceIBMCISDNSZoneFileUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange(cisDNSZoneFileContent) || d.HasChange(cisDNSZoneFileAuthoritative) {
+		diags := resourceIBMCISDNSZoneFileSync(d, meta)
+		if diags.HasError() {
+			return diags
+		}
+	}
+	return resourceIBMCISDNSZoneFileRead(context, d, meta)
+}
+
+// resourceIBMCISDNSZoneFileSync applies the zone file to the domain and saves the
+// records it manages, even when the changes only partly succeed.
+func resourceIBMCISDNSZoneFileSync(d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(ClientSession).CisDNSRecordClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	zoneID, crn, err := convertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	sess.Crn = core.StringPtr(crn)
+	sess.ZoneIdentifier = core.StringPtr(zoneID)
+
+	desired, err := parseCISZoneFile(d.Get(cisDNSZoneFileContent).(string), d.Get(cisZoneName).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	records, err := syncCISZoneFile(sess, desired, cisZoneFileManagedRecordIDs(d), d.Get(cisDNSZoneFileAuthoritative).(bool))
+	if records != nil {
+		d.Set(cisDNSZoneFileRecords, flattenCISZoneRecords(records))
+	}
+	return diag.FromErr(err)
+}
+
+func resourceIBMCISDNSZoneFileRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(ClientSession).CisDNSRecordClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	zoneID, crn, err := convertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	sess.Crn = core.StringPtr(crn)
+	sess.ZoneIdentifier = core.StringPtr(zoneID)
+
+	zoneName, response, err := getCISZoneName(meta, crn, zoneID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	live, err := listCISZoneRecords(sess)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	managed := cisZoneFileManagedRecordIDs(d)
+	authoritative := d.Get(cisDNSZoneFileAuthoritative).(bool)
+	records := []cisZoneRecord{}
+	for _, r := range live {
+		if isCISZoneFileRecordType(r.Type) && (managed[r.ID] || authoritative) {
+			records = append(records, r)
+		}
+	}
+
+	d.Set(cisID, crn)
+	d.Set(cisDomainID, zoneID)
+	d.Set(cisZoneName, zoneName)
+	d.Set(cisDNSZoneFileAuthoritative, authoritative)
+	d.Set(cisDNSZoneFileRecords, flattenCISZoneRecords(records))
+	// The zone file is kept as written while it matches the live records, it is
+	// rendered from them on a drift so the plan shows the changes
+	desired, err := parseCISZoneFile(d.Get(cisDNSZoneFileContent).(string), zoneName)
+	if err != nil || !equalCISZoneRecords(desired, records) {
+		d.Set(cisDNSZoneFileContent, renderCISZoneFile(records, zoneName))
+	}
+
+	return nil
+}
+
+func resourceIBMCISDNSZoneFileDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(ClientSession).CisDNSRecordClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	zoneID, crn, err := convertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	sess.Crn = core.StringPtr(crn)
+	sess.ZoneIdentifier = core.StringPtr(zoneID)
+
+	for _, e := range d.Get(cisDNSZoneFileRecords).([]interface{}) {
+		r := e.(map[string]interface{})
+		record := cisZoneRecord{
+			ID:   r[cisDNSRecordID].(string),
+			Name: r[cisDNSRecordName].(string),
+			Type: r[cisDNSRecordType].(string),
+		}
+		if err := deleteCISZoneRecord(sess, record); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMCISDNSZoneFileImport imports the zone file of a domain, <domain_id>:<cis_id>,
+// with the records of the supported types it has.
+func resourceIBMCISDNSZoneFileImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	sess, err := meta.(ClientSession).CisDNSRecordClientSession()
+	if err != nil {
+		return nil, err
+	}
+	zoneID, crn, err := convertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("Invalid ID %s of the zone file, it must be <domain_id>:<cis_id>", d.Id())
+	}
+	sess.Crn = core.StringPtr(crn)
+	sess.ZoneIdentifier = core.StringPtr(zoneID)
+
+	live, err := listCISZoneRecords(sess)
+	if err != nil {
+		return nil, err
+	}
+	records := []cisZoneRecord{}
+	for _, r := range live {
+		if isCISZoneFileRecordType(r.Type) {
+			records = append(records, r)
+		}
+	}
+	d.Set(cisDNSZoneFileRecords, flattenCISZoneRecords(records))
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,321 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
+)
+
+func TestAccIBMCisDNSZoneFile_Basic(t *testing.T) {
+	name := "ibm_cis_dns_zone_file.zone"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckCis(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMCisDNSZoneFileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCisDNSZoneFileConfig("192.0.2.10"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_name", cisDomainStatic),
+					resource.TestCheckResourceAttr(name, "records.#", "3"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCisDNSZoneFileConfig("192.0.2.20"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "records.#", "3"),
+					resource.TestCheckResourceAttrSet("data.ibm_cis_dns_zone_file.zone", "content"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content", "records"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCisDNSZoneFileDestroy(s *terraform.State) error {
+	cisClient, err := testAccProvider.Meta().(ClientSession).CisDNSRecordClientSession()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_cis_dns_zone_file" {
+			continue
+		}
+		zoneID, crn, _ := convertTftoCisTwoVar(rs.Primary.ID)
+		cisClient.Crn = core.StringPtr(crn)
+		cisClient.ZoneIdentifier = core.StringPtr(zoneID)
+		for k, id := range rs.Primary.Attributes {
+			if !strings.HasSuffix(k, ".record_id") {
+				continue
+			}
+			if _, _, err := cisClient.GetDnsRecord(cisClient.NewGetDnsRecordOptions(id)); err == nil {
+				return fmt.Errorf("Record %s of the zone file still exists", id)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMCisDNSZoneFileConfig(address string) string {
+	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + fmt.Sprintf(`
+	resource "ibm_cis_dns_zone_file" "zone" {
+		cis_id    = data.ibm_cis.cis.id
+		domain_id = data.ibm_cis_domain.cis_domain.domain_id
+		content   = <<-EOT
+			$TTL 300
+			tf-acc-zone-file      IN A     %[1]s
+			tf-acc-zone-file-www  IN CNAME tf-acc-zone-file ; cf_tags=cf-proxied:true
+			tf-acc-zone-file      IN TXT   "managed by terraform"
+		EOT
+	}
+
+	data "ibm_cis_dns_zone_file" "zone" {
+		cis_id    = data.ibm_cis.cis.id
+		domain_id = ibm_cis_dns_zone_file.zone.domain_id
+	}
+	`, address)
+}
+
+func TestParseCISZoneFile(t *testing.T) {
+	records, err := parseCISZoneFile(`
+$ORIGIN Example.com.
+$TTL 1h
+@           IN  SOA  ns1.example.com. admin.example.com. (
+                2021010101 ; serial
+                3600 600 604800 300 )
+@           IN  MX   10 mail
+            IN  MX   20 mail.backup.net.
+www    300  IN  A    192.0.2.1
+api         IN  CNAME www ; cf_tags=cf-proxied:true
+v6          AAAA     2001:DB8::1
+_sip._tcp   IN  SRV  10 5 5060 sip
+@           TXT "v=spf1 include:example.net " "-all"
+@       60  IN  CAA  0 ISSUE "letsencrypt.org"
+$ORIGIN sub.example.com.
+host        IN  A    192.0.2.2
+`, "example.com")
+	assert.NilError(t, err)
+
+	got := make([]string, len(records))
+	for i, r := range records {
+		got[i] = r.String()
+	}
+	assert.DeepEqual(t, got, []string{
+		"example.com MX 10 mail.example.com 3600 false",
+		"example.com MX 20 mail.backup.net 3600 false",
+		"www.example.com A 0 192.0.2.1 300 false",
+		"api.example.com CNAME 0 www.example.com 1 true",
+		"v6.example.com AAAA 0 2001:db8::1 3600 false",
+		"_sip._tcp.example.com SRV 10 5 5060 sip.example.com 3600 false",
+		"example.com TXT 0 v=spf1 include:example.net -all 3600 false",
+		"example.com CAA 0 0 issue \"letsencrypt.org\" 60 false",
+		"host.sub.example.com A 0 192.0.2.2 3600 false",
+	})
+
+	// A rendered zone file defines the same records
+	rendered, err := parseCISZoneFile(renderCISZoneFile(records, "example.com"), "example.com")
+	assert.NilError(t, err)
+	assert.Assert(t, equalCISZoneRecords(records, rendered))
+
+	for content, message := range map[string]string{
+		"www IN A 2001:db8::1":                         "not a valid address",
+		"www IN LOC 52 22 23 N 4 53 32 E -2m":          "LOC records are not supported",
+		"$INCLUDE other.zone":                          "$INCLUDE directive is not supported",
+		"www IN MX mail":                               "take 2 data fields",
+		"sip IN SRV 10 5 5060 sip":                     "must be _<service>._<protocol>.<name>",
+		"www IN TXT \"unterminated":                    "unterminated quoted string",
+		"@ IN SOA ns1 admin ( 1 2 3 4 5":               "unbalanced parentheses",
+		"mail IN MX 10 mail ; cf_tags=cf-proxied:true": "only the A, AAAA and CNAME records can be proxied",
+		"www 1x IN A 192.0.2.1":                        "not a valid TTL",
+	} {
+		_, err := parseCISZoneFile(content, "example.com")
+		assert.ErrorContains(t, err, message, content)
+	}
+}
+
+// testMockCISZone creates a domain in a CIS instance and returns its CRN and ID.
+func testMockCISZone(t *testing.T, meta interface{}, name string) (crn, zoneID string) {
+	sess, err := meta.(ClientSession).CisZonesV1ClientSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	crn = "crn:v1:bluemix:public:internet-svcs:global:a/mock::mock-cis"
+	sess.Crn = core.StringPtr(crn)
+	opt := sess.NewCreateZoneOptions()
+	opt.SetName(name)
+	zone, _, err := sess.CreateZone(opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return crn, *zone.Result.ID
+}
+
+func TestIBMCISDNSZoneFileMock(t *testing.T) {
+	t.Parallel()
+	srv := testMockServer(t)
+	meta := testMockClientSession(t, srv)
+	r := resourceIBMCISDNSZoneFile()
+	crn, zoneID := testMockCISZone(t, meta, "example.com")
+
+	sess, err := meta.(ClientSession).CisDNSRecordClientSession()
+	assert.NilError(t, err)
+	sess.Crn = core.StringPtr(crn)
+	sess.ZoneIdentifier = core.StringPtr(zoneID)
+	create := func(name, recordType, content string) string {
+		opt := sess.NewCreateDnsRecordOptions()
+		opt.SetName(name)
+		opt.SetType(recordType)
+		opt.SetContent(content)
+		opt.SetTTL(3600)
+		result, _, err := sess.CreateDnsRecord(opt)
+		assert.NilError(t, err)
+		return *result.Result.ID
+	}
+	live := func() map[string]cisZoneRecord {
+		records, err := listCISZoneRecords(sess)
+		assert.NilError(t, err)
+		byKey := map[string]cisZoneRecord{}
+		for _, r := range records {
+			byKey[r.Name+" "+r.Type] = r
+		}
+		return byKey
+	}
+	legacyID := create("legacy", "A", "192.0.2.99")
+	wwwID := create("www", "A", "192.0.2.1")
+
+	content := `$ORIGIN example.com.
+$TTL 1h
+@          IN MX    10 mail
+www        IN A     192.0.2.1
+api        IN CNAME www ; cf_tags=cf-proxied:true
+_sip._tcp  IN SRV   10 5 5060 sip
+@          IN TXT   "v=spf1 " "-all"
+@          IN CAA   0 issue "letsencrypt.org"
+`
+	raw := map[string]interface{}{"cis_id": crn, "domain_id": zoneID, "content": content}
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	d.MarkNewResource()
+	// An identical record the zone file didn't create isn't taken over
+	assert.ErrorContains(t, testDiagsErr(r.CreateContext(context.Background(), d, meta)), "already exists")
+	assert.Equal(t, len(live()), 2)
+	_, _, err = sess.DeleteDnsRecord(sess.NewDeleteDnsRecordOptions(wwwID))
+	assert.NilError(t, err)
+
+	d = schema.TestResourceDataRaw(t, r.Schema, raw)
+	d.MarkNewResource()
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), d, meta)))
+	assert.Equal(t, d.Id(), zoneID+":"+crn)
+	assert.Equal(t, d.Get("zone_name"), "example.com")
+	assert.Equal(t, d.Get("records.#"), 6)
+	assert.Equal(t, d.Get("content"), content)
+	records := live()
+	assert.Equal(t, len(records), 7)
+	wwwID = records["www.example.com A"].ID
+	assert.Equal(t, records["www.example.com A"].TTL, int64(3600))
+	assert.Equal(t, records["api.example.com CNAME"].Proxied, true)
+	assert.Equal(t, records["_sip._tcp.example.com SRV"].Content, "5 5060 sip.example.com")
+
+	// The changed record is updated in place, the removed one deleted
+	raw["content"] = strings.Replace(strings.Replace(content, "192.0.2.1", "192.0.2.2", 1),
+		"@          IN CAA   0 issue \"letsencrypt.org\"\n", "v6 IN AAAA 2001:db8::1\n", 1)
+	updated := testMockResourceData(t, r, d, raw)
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), updated, meta)))
+	records = live()
+	assert.Equal(t, records["www.example.com A"].ID, wwwID)
+	assert.Equal(t, records["www.example.com A"].Content, "192.0.2.2")
+	_, caa := records["example.com CAA"]
+	assert.Assert(t, !caa)
+	assert.Equal(t, records["v6.example.com AAAA"].Content, "2001:db8::1")
+	assert.Equal(t, records["legacy.example.com A"].ID, legacyID)
+	assert.Equal(t, updated.Get("records.#"), 6)
+
+	// The same records in another order and form make no difference
+	reordered := "www 3600 IN A 192.0.2.2\n" + strings.Replace(raw["content"].(string), "www        IN A     192.0.2.2\n", "", 1)
+	assert.Assert(t, suppressCISZoneFileDiff("content", updated.Get("content").(string), reordered, updated))
+
+	// A record deleted out of band shows in the zone file as a drift
+	_, _, err = sess.DeleteDnsRecord(sess.NewDeleteDnsRecordOptions(records["v6.example.com AAAA"].ID))
+	assert.NilError(t, err)
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), updated, meta)))
+	assert.Equal(t, updated.Get("records.#"), 5)
+	assert.Assert(t, !strings.Contains(updated.Get("content").(string), "AAAA"))
+	assert.Assert(t, !suppressCISZoneFileDiff("content", updated.Get("content").(string), raw["content"].(string), updated))
+
+	// An authoritative zone file deletes the records it doesn't have
+	raw["authoritative"] = true
+	authoritative := testMockResourceData(t, r, updated, raw)
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), authoritative, meta)))
+	records = live()
+	_, legacy := records["legacy.example.com A"]
+	assert.Assert(t, !legacy)
+	assert.Equal(t, len(records), 6)
+
+	ds := dataSourceIBMCISDNSZoneFile()
+	data := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{"cis_id": crn, "domain_id": zoneID})
+	assert.NilError(t, testDiagsErr(ds.ReadContext(context.Background(), data, meta)))
+	exported := data.Get("content").(string)
+	assert.Assert(t, strings.Contains(exported, "api.example.com.\t1\tIN\tCNAME\twww.example.com. ; cf_tags=cf-proxied:true"), exported)
+	// The export can be managed by the resource as is
+	exportedRecords, err := parseCISZoneFile(exported, "example.com")
+	assert.NilError(t, err)
+	managedRecords, err := parseCISZoneFile(authoritative.Get("content").(string), "example.com")
+	assert.NilError(t, err)
+	assert.Assert(t, equalCISZoneRecords(exportedRecords, managedRecords))
+
+	imported := r.Data(nil)
+	imported.SetId(d.Id())
+	states, err := r.Importer.StateContext(context.Background(), imported, meta)
+	assert.NilError(t, err)
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), states[0], meta)))
+	assert.Equal(t, states[0].Get("records.#"), 6)
+	assert.Assert(t, suppressCISZoneFileDiff("content", states[0].Get("content").(string), authoritative.Get("content").(string), states[0]))
+
+	assert.NilError(t, testDiagsErr(r.DeleteContext(context.Background(), authoritative, meta)))
+	assert.Equal(t, authoritative.Id(), "")
+	assert.Equal(t, len(live()), 0)
+}
+
+// The records in front of a CNAME record of the same name are deleted before it is created.
+func TestIBMCISDNSZoneFileMock_replaceWithCNAME(t *testing.T) {
+	t.Parallel()
+	srv := testMockServer(t)
+	meta := testMockClientSession(t, srv)
+	r := resourceIBMCISDNSZoneFile()
+	crn, zoneID := testMockCISZone(t, meta, "example.org")
+
+	raw := map[string]interface{}{"cis_id": crn, "domain_id": zoneID, "content": "www IN A 192.0.2.1\nwww IN A 192.0.2.2\n"}
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	d.MarkNewResource()
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), d, meta)))
+	assert.Equal(t, d.Get("records.#"), 2)
+
+	raw["content"] = "www IN CNAME cdn.example.net.\n"
+	updated := testMockResourceData(t, r, d, raw)
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), updated, meta)))
+	assert.Equal(t, updated.Get("records.#"), 1)
+	assert.Equal(t, updated.Get("records.0.type"), "CNAME")
+	assert.Equal(t, updated.Get("records.0.content"), "cdn.example.net")
+
+	// A record the API rejects leaves the records applied so far managed
+	raw["content"] = "www IN CNAME cdn.example.net.\nwww IN A 192.0.2.3\n"
+	failed := testMockResourceData(t, r, updated, raw)
+	assert.ErrorContains(t, testDiagsErr(r.UpdateContext(context.Background(), failed, meta)), "Error creating A record www.example.org")
+	assert.Equal(t, failed.Get("records.#"), 1)
+
+}
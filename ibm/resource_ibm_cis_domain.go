@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"log"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -54,19 +56,18 @@ func resourceIBMCISDomain() *schema.Resource {
 				Computed: true,
 			},
 		},
-		Create:   resourceCISdomainCreate,
-		Read:     resourceCISdomainRead,
-		Exists:   resourceCISdomainExists,
-		Update:   resourceCISdomainUpdate,
-		Delete:   resourceCISdomainDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceCISdomainCreate,
+		ReadContext:   resourceCISdomainRead,
+		UpdateContext: resourceCISdomainUpdate,
+		DeleteContext: resourceCISdomainDelete,
+		Importer:      &schema.ResourceImporter{},
 	}
 }
 
-func resourceCISdomainCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISdomainCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisZonesV1ClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -78,28 +79,37 @@ func resourceCISdomainCreate(d *schema.ResourceData, meta interface{}) error {
 	result, resp, err := cisClient.CreateZone(opt)
 	if err != nil {
 		log.Printf("CreateZones Failed %s", resp)
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(convertCisToTfTwoVar(*result.Result.ID, crn))
-	return resourceCISdomainRead(d, meta)
+	return resourceCISdomainRead(context, d, meta)
 }
 
-func resourceCISdomainRead(d *schema.ResourceData, meta interface{}) error {
+func resourceCISdomainRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceCISdomainExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	cisClient, err := meta.(ClientSession).CisZonesV1ClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	zoneID, crn, err := convertTftoCisTwoVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	opt := cisClient.NewGetZoneOptions(zoneID)
 	result, resp, err := cisClient.GetZone(opt)
 	if err != nil {
 		log.Printf("[WARN] Error getting zone %v\n", resp)
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, result.Result.ID)
@@ -137,34 +147,34 @@ func resourceCISdomainExists(d *schema.ResourceData, meta interface{}) (bool, er
 	return true, nil
 }
 
-func resourceCISdomainUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceCISdomainRead(d, meta)
+func resourceCISdomainUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceCISdomainRead(context, d, meta)
 }
 
-func resourceCISdomainDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceCISdomainDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisZonesV1ClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	zoneID, crn, err := convertTftoCisTwoVar(d.Id())
 	log.Println("resource delete :", d.Id())
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	opt := cisClient.NewGetZoneOptions(zoneID)
 	_, resp, err := cisClient.GetZone(opt)
 	if err != nil {
 		log.Printf("[WARN] Error getting zone %v\n", resp)
-		return err
+		return diag.FromErr(err)
 	}
 	delOpt := cisClient.NewDeleteZoneOptions(zoneID)
 	_, resp, err = cisClient.DeleteZone(delOpt)
 	if err != nil {
 		log.Printf("[ERR] Error deleting zone %v\n", resp)
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
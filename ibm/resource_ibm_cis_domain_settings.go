@@ -4,9 +4,12 @@
 package ibm
 
 import (
+	"context"
+	"fmt"
 	"log"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -443,11 +446,11 @@ func resourceIBMCISSettings() *schema.Resource {
 			},
 		},
 
-		Create:   resourceCISSettingsUpdate,
-		Read:     resourceCISSettingsRead,
-		Update:   resourceCISSettingsUpdate,
-		Delete:   resourceCISSettingsDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceCISSettingsUpdate,
+		ReadContext:   resourceCISSettingsRead,
+		UpdateContext: resourceCISSettingsUpdate,
+		DeleteContext: resourceCISSettingsDelete,
+		Importer:      &schema.ResourceImporter{},
 	}
 }
 
@@ -582,12 +585,13 @@ var settingsList = []string{
 	cisDomainSettingsCipher,
 }
 
-func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISSettingsUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisDomainSettingsClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
+	var diags diag.Diagnostics
 	cisID := d.Get(cisID).(string)
 	zoneID, _, err := convertTftoCisTwoVar(d.Get(cisDomainID).(string))
 	cisClient.Crn = core.StringPtr(cisID)
@@ -619,7 +623,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					cisClient, err := meta.(ClientSession).CisSSLClientSession()
 					if err != nil {
-						return err
+						return diag.FromErr(err)
 					}
 					cisClient.Crn = core.StringPtr(cisID)
 					cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -832,7 +836,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 					minifyVal, err := cisClient.NewMinifySettingValue(css, html, js)
 					if err != nil {
 						log.Println("Invalid minfiy setting values")
-						return err
+						return diag.FromErr(err)
 					}
 					opt := cisClient.NewUpdateMinifyOptions()
 					opt.SetValue(minifyVal)
@@ -851,12 +855,12 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 						enabled, maxAge, includeSubdomain, nosniff)
 					if err != nil {
 						log.Println("Invalid security header setting values")
-						return err
+						return diag.FromErr(err)
 					}
 					securityOpt, err := cisClient.NewSecurityHeaderSettingValue(securityVal)
 					if err != nil {
 						log.Println("Invalid security header setting options")
-						return err
+						return diag.FromErr(err)
 					}
 					opt := cisClient.NewUpdateSecurityHeaderOptions()
 					opt.SetValue(securityOpt)
@@ -873,7 +877,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 					mobileOpt, err := cisClient.NewMobileRedirecSettingValue(status, mobileSubdomain, stripURI)
 					if err != nil {
 						log.Println("Invalid mobile redirect options")
-						return err
+						return diag.FromErr(err)
 					}
 					opt := cisClient.NewUpdateMobileRedirectOptions()
 					opt.SetValue(mobileOpt)
@@ -883,21 +887,25 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		if err != nil {
 			if resp != nil && resp.StatusCode == 405 {
-				log.Printf("[WARN] Update %s : %s", item, err)
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("Error updating the %s setting", item),
+					Detail:   err.Error(),
+				})
 				continue
 			}
 			log.Printf("Update settings Failed on %s, %v\n", item, resp)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	d.SetId(convertCisToTfTwoVar(zoneID, cisID))
-	return resourceCISSettingsRead(d, meta)
+	return append(diags, resourceCISSettingsRead(context, d, meta)...)
 }
 
-func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
+func resourceCISSettingsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisDomainSettingsClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	zoneID, crn, _ := convertTftoCisTwoVar(d.Id())
@@ -929,7 +937,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 		case cisDomainSettingsSSL:
 			cisClient, err := meta.(ClientSession).CisSSLClientSession()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			cisClient.Crn = core.StringPtr(crn)
 			cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -944,7 +952,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 		case cisDomainSettingsBrotli:
 			cisClient, err := meta.(ClientSession).CisAPI()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			settingsResult, err := cisClient.Settings().GetSetting(crn, zoneID, item)
 			if err == nil {
@@ -1242,7 +1250,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 				continue
 			}
 			log.Printf("Get settings failed on %s, %v\n", item, settingErr)
-			return settingErr
+			return diag.FromErr(settingErr)
 		}
 	}
 	d.Set(cisID, crn)
@@ -1250,7 +1258,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceCISSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceCISSettingsDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Nothing to delete on CIS resource
 	d.SetId("")
 	return nil
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -11,6 +12,7 @@ import (
 	"strings"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -21,12 +23,11 @@ const (
 
 func resourceIBMCISEdgeFunctionsAction() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMCISEdgeFunctionsActionCreate,
-		Read:     resourceIBMCISEdgeFunctionsActionRead,
-		Update:   resourceIBMCISEdgeFunctionsActionUpdate,
-		Delete:   resourceIBMCISEdgeFunctionsActionDelete,
-		Exists:   resourceIBMCISEdgeFunctionsActionExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMCISEdgeFunctionsActionCreate,
+		ReadContext:   resourceIBMCISEdgeFunctionsActionRead,
+		UpdateContext: resourceIBMCISEdgeFunctionsActionUpdate,
+		DeleteContext: resourceIBMCISEdgeFunctionsActionDelete,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -54,10 +55,10 @@ func resourceIBMCISEdgeFunctionsAction() *schema.Resource {
 	}
 }
 
-func resourceIBMCISEdgeFunctionsActionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISEdgeFunctionsActionCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisEdgeFunctionClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -73,24 +74,33 @@ func resourceIBMCISEdgeFunctionsActionCreate(d *schema.ResourceData, meta interf
 
 	_, _, err = cisClient.UpdateEdgeFunctionsAction(opt)
 	if err != nil {
-		return fmt.Errorf("Error: %v", err)
+		return diag.FromErr(fmt.Errorf("Error: %v", err))
 	}
 	d.SetId(convertCisToTfThreeVar(scriptName, zoneID, crn))
-	return resourceIBMCISEdgeFunctionsActionRead(d, meta)
+	return resourceIBMCISEdgeFunctionsActionRead(context, d, meta)
 }
 
-func resourceIBMCISEdgeFunctionsActionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISEdgeFunctionsActionUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if d.HasChange(cisEdgeFunctionsActionScript) {
-		return resourceIBMCISEdgeFunctionsActionCreate(d, meta)
+		return resourceIBMCISEdgeFunctionsActionCreate(context, d, meta)
 	}
 
-	return resourceIBMCISEdgeFunctionsActionRead(d, meta)
+	return resourceIBMCISEdgeFunctionsActionRead(context, d, meta)
 }
 
-func resourceIBMCISEdgeFunctionsActionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISEdgeFunctionsActionRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMCISEdgeFunctionsActionExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	cisClient, err := meta.(ClientSession).CisEdgeFunctionClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	scriptName, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
@@ -100,7 +110,7 @@ func resourceIBMCISEdgeFunctionsActionRead(d *schema.ResourceData, meta interfac
 	opt := cisClient.NewGetEdgeFunctionsActionOptions(scriptName)
 	result, resp, err := cisClient.GetEdgeFunctionsAction(opt)
 	if err != nil {
-		return fmt.Errorf("Error: %v", resp)
+		return diag.FromErr(fmt.Errorf("Error: %v", resp))
 	}
 
 	// read script content
@@ -115,7 +125,7 @@ func resourceIBMCISEdgeFunctionsActionRead(d *schema.ResourceData, meta interfac
 	}
 	err = result.Close()
 	if err != nil {
-		return fmt.Errorf("Error in closing reader")
+		return diag.FromErr(fmt.Errorf("Error in closing reader"))
 	}
 
 	d.Set(cisID, crn)
@@ -147,10 +157,10 @@ func resourceIBMCISEdgeFunctionsActionExists(d *schema.ResourceData, meta interf
 	return true, nil
 }
 
-func resourceIBMCISEdgeFunctionsActionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISEdgeFunctionsActionDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisEdgeFunctionClientSession()
 	if err != nil {
-		return fmt.Errorf("Error in creating CIS object")
+		return diag.FromErr(fmt.Errorf("Error in creating CIS object"))
 	}
 
 	scriptName, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
@@ -160,7 +170,7 @@ func resourceIBMCISEdgeFunctionsActionDelete(d *schema.ResourceData, meta interf
 	opt := cisClient.NewDeleteEdgeFunctionsActionOptions(scriptName)
 	_, response, err := cisClient.DeleteEdgeFunctionsAction(opt)
 	if err != nil {
-		return fmt.Errorf("Error in edge function action script deletion: %v", response)
+		return diag.FromErr(fmt.Errorf("Error in edge function action script deletion: %v", response))
 	}
 	return nil
 }
@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -20,12 +22,11 @@ const (
 
 func resourceIBMCISEdgeFunctionsTrigger() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMCISEdgeFunctionsTriggerCreate,
-		Read:     resourceIBMCISEdgeFunctionsTriggerRead,
-		Update:   resourceIBMCISEdgeFunctionsTriggerUpdate,
-		Delete:   resourceIBMCISEdgeFunctionsTriggerDelete,
-		Exists:   resourceIBMCISEdgeFunctionsTriggerExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMCISEdgeFunctionsTriggerCreate,
+		ReadContext:   resourceIBMCISEdgeFunctionsTriggerRead,
+		UpdateContext: resourceIBMCISEdgeFunctionsTriggerUpdate,
+		DeleteContext: resourceIBMCISEdgeFunctionsTriggerDelete,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -62,10 +63,10 @@ func resourceIBMCISEdgeFunctionsTrigger() *schema.Resource {
 	}
 }
 
-func resourceIBMCISEdgeFunctionsTriggerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISEdgeFunctionsTriggerCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisEdgeFunctionClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -81,16 +82,16 @@ func resourceIBMCISEdgeFunctionsTriggerCreate(d *schema.ResourceData, meta inter
 
 	result, _, err := cisClient.CreateEdgeFunctionsTrigger(opt)
 	if err != nil {
-		return fmt.Errorf("Error creating edge function trigger route : %v", err)
+		return diag.FromErr(fmt.Errorf("Error creating edge function trigger route : %v", err))
 	}
 	d.SetId(convertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
-	return resourceIBMCISEdgeFunctionsTriggerRead(d, meta)
+	return resourceIBMCISEdgeFunctionsTriggerRead(context, d, meta)
 }
 
-func resourceIBMCISEdgeFunctionsTriggerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISEdgeFunctionsTriggerUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisEdgeFunctionClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	routeID, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
@@ -109,16 +110,25 @@ func resourceIBMCISEdgeFunctionsTriggerUpdate(d *schema.ResourceData, meta inter
 
 		_, _, err := cisClient.UpdateEdgeFunctionsTrigger(opt)
 		if err != nil {
-			return fmt.Errorf("Error updating edge function trigger route : %v", err)
+			return diag.FromErr(fmt.Errorf("Error updating edge function trigger route : %v", err))
 		}
 	}
-	return resourceIBMCISEdgeFunctionsTriggerRead(d, meta)
+	return resourceIBMCISEdgeFunctionsTriggerRead(context, d, meta)
 }
 
-func resourceIBMCISEdgeFunctionsTriggerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISEdgeFunctionsTriggerRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMCISEdgeFunctionsTriggerExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	cisClient, err := meta.(ClientSession).CisEdgeFunctionClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	routeID, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
@@ -128,7 +138,7 @@ func resourceIBMCISEdgeFunctionsTriggerRead(d *schema.ResourceData, meta interfa
 	opt := cisClient.NewGetEdgeFunctionsTriggerOptions(routeID)
 	result, resp, err := cisClient.GetEdgeFunctionsTrigger(opt)
 	if err != nil {
-		return fmt.Errorf("Error: %v", resp)
+		return diag.FromErr(fmt.Errorf("Error: %v", resp))
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -161,10 +171,10 @@ func resourceIBMCISEdgeFunctionsTriggerExists(d *schema.ResourceData, meta inter
 	return true, nil
 }
 
-func resourceIBMCISEdgeFunctionsTriggerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISEdgeFunctionsTriggerDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisEdgeFunctionClientSession()
 	if err != nil {
-		return fmt.Errorf("Error in creating CIS object")
+		return diag.FromErr(fmt.Errorf("Error in creating CIS object"))
 	}
 
 	routeID, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
@@ -174,7 +184,7 @@ func resourceIBMCISEdgeFunctionsTriggerDelete(d *schema.ResourceData, meta inter
 	opt := cisClient.NewDeleteEdgeFunctionsTriggerOptions(routeID)
 	_, response, err := cisClient.DeleteEdgeFunctionsTrigger(opt)
 	if err != nil {
-		return fmt.Errorf("Error in edge function trigger route deletion: %v", response)
+		return diag.FromErr(fmt.Errorf("Error in edge function trigger route deletion: %v", response))
 	}
 	return nil
 }
@@ -0,0 +1,229 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/filtersv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	cisFilterID          = "filter_id"
+	cisFilterExpression  = "expression"
+	cisFilterPaused      = "paused"
+	cisFilterDescription = "description"
+)
+
+func resourceIBMCISFilter() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCISFilterCreate,
+		ReadContext:   resourceIBMCISFilterRead,
+		UpdateContext: resourceIBMCISFilterUpdate,
+		DeleteContext: resourceIBMCISFilterDelete,
+		Importer:      &schema.ResourceImporter{},
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "CIS instance crn",
+			},
+			cisDomainID: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressDomainIDDiff,
+				Description:      "Associated CIS domain",
+			},
+			cisFilterID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "CIS filter id",
+			},
+			cisFilterExpression: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateCISFilterExpression,
+				Description:  "Filter expression, in the rules language, matching the requests",
+			},
+			cisFilterPaused: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the filter is paused",
+			},
+			cisFilterDescription: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter description",
+			},
+		},
+	}
+}
+
+// cisFilter is a filter of the requests to a domain, referenced by the firewall rules.
+type cisFilter struct {
+	ID          string `json:"id"`
+	Expression  string `json:"expression"`
+	Paused      bool   `json:"paused"`
+	Description string `json:"description"`
+}
+
+// getCISUserToken returns the IAM token the filters and firewall rules APIs expect
+// in the X-Auth-User-Token header.
+func getCISUserToken(meta interface{}) (string, error) {
+	sess, err := meta.(ClientSession).BluemixSession()
+	if err != nil {
+		return "", err
+	}
+	return sess.Config.IAMAccessToken, nil
+}
+
+// decodeCISResult decodes the "result" of the responses of the APIs the
+// networking-go-sdk returns unparsed.
+func decodeCISResult(body io.ReadCloser, result interface{}) error {
+	if body == nil {
+		return fmt.Errorf("the response has no body")
+	}
+	defer body.Close()
+	envelope := struct {
+		Result interface{} `json:"result"`
+	}{Result: result}
+	return json.NewDecoder(body).Decode(&envelope)
+}
+
+func resourceIBMCISFilterCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cisClient, err := meta.(ClientSession).CisFiltersSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	token, err := getCISUserToken(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	crn := d.Get(cisID).(string)
+	zoneID, _, _ := convertTftoCisTwoVar(d.Get(cisDomainID).(string))
+	input, err := cisClient.NewFilterInput(d.Get(cisFilterExpression).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	input.Paused = core.BoolPtr(d.Get(cisFilterPaused).(bool))
+	if description, ok := d.GetOk(cisFilterDescription); ok {
+		input.Description = core.StringPtr(description.(string))
+	}
+	opt := cisClient.NewCreateFilterOptions(token, crn, zoneID)
+	opt.SetFilterInput([]filtersv1.FilterInput{*input})
+
+	body, response, err := cisClient.CreateFilterWithContext(context, opt)
+	if err != nil {
+		return diag.FromErr(apiErrorf("cis", err, response, "Error creating CIS filter"))
+	}
+	filters := []cisFilter{}
+	if err := decodeCISResult(body, &filters); err != nil || len(filters) == 0 {
+		return diag.FromErr(fmt.Errorf("Error reading the created CIS filter: %v", err))
+	}
+	d.SetId(convertCisToTfThreeVar(filters[0].ID, zoneID, crn))
+	return resourceIBMCISFilterRead(context, d, meta)
+}
+
+func resourceIBMCISFilterRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cisClient, err := meta.(ClientSession).CisFiltersSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	token, err := getCISUserToken(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filterID, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	opt := cisClient.NewGetFilterOptions(token, crn, zoneID, filterID)
+	body, response, err := cisClient.GetFilterWithContext(context, opt)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("CIS filter %s is not found", filterID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(apiErrorf("cis", err, response, "Error reading CIS filter %s", filterID))
+	}
+	filter := cisFilter{}
+	if err := decodeCISResult(body, &filter); err != nil {
+		return diag.FromErr(fmt.Errorf("Error reading CIS filter %s: %s", filterID, err))
+	}
+	d.Set(cisID, crn)
+	d.Set(cisDomainID, zoneID)
+	d.Set(cisFilterID, filter.ID)
+	d.Set(cisFilterExpression, filter.Expression)
+	d.Set(cisFilterPaused, filter.Paused)
+	d.Set(cisFilterDescription, filter.Description)
+	return nil
+}
+
+func resourceIBMCISFilterUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cisClient, err := meta.(ClientSession).CisFiltersSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	token, err := getCISUserToken(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filterID, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChange(cisFilterExpression) || d.HasChange(cisFilterPaused) || d.HasChange(cisFilterDescription) {
+		opt := cisClient.NewUpdateFilterOptions(token, crn, zoneID, filterID)
+		opt.SetID(filterID)
+		opt.SetExpression(d.Get(cisFilterExpression).(string))
+		opt.SetPaused(d.Get(cisFilterPaused).(bool))
+		opt.SetDescription(d.Get(cisFilterDescription).(string))
+		body, response, err := cisClient.UpdateFilterWithContext(context, opt)
+		if err != nil {
+			return diag.FromErr(apiErrorf("cis", err, response, "Error updating CIS filter %s", filterID))
+		}
+		body.Close()
+	}
+	return resourceIBMCISFilterRead(context, d, meta)
+}
+
+func resourceIBMCISFilterDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cisClient, err := meta.(ClientSession).CisFiltersSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	token, err := getCISUserToken(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filterID, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	opt := cisClient.NewDeleteFilterOptions(token, crn, zoneID, filterID)
+	body, response, err := cisClient.DeleteFilterWithContext(context, opt)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil
+		}
+		return diag.FromErr(apiErrorf("cis", err, response, "Error deleting CIS filter %s", filterID))
+	}
+	body.Close()
+	return nil
+}
@@ -0,0 +1,210 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
+)
+
+func TestAccIBMCisFilter_Basic(t *testing.T) {
+	name := "ibm_cis_filter.filter"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckCis(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMCisFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMCisFilterConfig(`ip.src in {192.0.2.0/24} and http.request.uri.path contain "/admin"`, false),
+				ExpectError: regexp.MustCompile(`must be compared with an operator`),
+			},
+			{
+				Config: testAccCheckIBMCisFilterConfig(`ip.src in {192.0.2.0/24} and http.request.uri.path contains "/admin"`, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "filter_id"),
+					resource.TestCheckResourceAttr(name, "paused", "false"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCisFilterConfig(`http.request.uri.path matches "^/admin/.*"`, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "expression", `http.request.uri.path matches "^/admin/.*"`),
+					resource.TestCheckResourceAttr(name, "paused", "true"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCisFilterDestroy(s *terraform.State) error {
+	cisClient, err := testAccProvider.Meta().(ClientSession).CisFiltersSession()
+	if err != nil {
+		return err
+	}
+	token, err := getCISUserToken(testAccProvider.Meta())
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_cis_filter" {
+			continue
+		}
+		filterID, zoneID, crn, _ := convertTfToCisThreeVar(rs.Primary.ID)
+		if _, _, err := cisClient.GetFilter(cisClient.NewGetFilterOptions(token, crn, zoneID, filterID)); err == nil {
+			return fmt.Errorf("Filter %s still exists", filterID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMCisFilterConfig(expression string, paused bool) string {
+	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + fmt.Sprintf(`
+	resource "ibm_cis_filter" "filter" {
+		cis_id      = data.ibm_cis.cis.id
+		domain_id   = data.ibm_cis_domain.cis_domain.domain_id
+		expression  = %q
+		paused      = %t
+		description = "tf-acc filter"
+	}
+	`, expression, paused)
+}
+
+func TestParseCISFilterExpression(t *testing.T) {
+	valid := []string{
+		`ip.src in {192.0.2.0/24 198.51.100.7 2001:db8::/32} and http.request.uri.path contains "/admin"`,
+		`(http.host eq "example.com" || http.host == "www.example.com") && not ssl`,
+		`ip.geoip.country in {"CN" "RU"} xor ip.geoip.asnum in {64496..64511 65550}`,
+		`http.user_agent matches "(?i)curl|wget" and cf.threat_score gt 10`,
+		`!(ip.src eq 192.0.2.1) or cf.client.bot`,
+		`http.request.uri.query contains "q=\"x\\y\""`,
+		`lower(http.host) eq "example.com" and len(http.request.uri.path) gt 100`,
+		`http.request.headers["x-foo"][0] eq "bar" or http.request.headers["x-foo"] contains "bar"`,
+		`any(http.request.headers.names[*] == "x-foo") and not all(http.request.uri.args.values[*] eq "")`,
+		`http.request.uri.args["q"][0] in {"a" "b"} and cf.waf.score lt 20`,
+		`starts_with(lower(url_decode(http.request.uri.path)), "/admin")`,
+		`substring(http.user_agent, -4) eq "curl" and len(http.request.cookies) eq 0`,
+	}
+	for _, expr := range valid {
+		warnings, err := parseCISFilterExpression(expr)
+		assert.NilError(t, err, expr)
+		assert.Equal(t, len(warnings), 0, "%s: %v", expr, warnings)
+	}
+
+	warned := map[string]string{
+		`HTTP.HOST eq "x"`:                              `unknown field "HTTP.HOST", its comparison is only checked for its syntax at position 1`,
+		`http.request.uri.pth contains "/admin"`:        `unknown field "http.request.uri.pth"`,
+		`ssl and cf.bot_management.js_detection.passed`: `unknown field "cf.bot_management.js_detection.passed"`,
+		`cf.edge.client_port in {80 443}`:               `unknown field "cf.edge.client_port"`,
+		`http.request.body.form["x"][0] eq "y"`:         `unknown field "http.request.body.form"`,
+		`to_lower(http.host) eq "x"`:                    `unknown function "to_lower"`,
+	}
+	for expr, message := range warned {
+		warnings, err := parseCISFilterExpression(expr)
+		assert.NilError(t, err, expr)
+		assert.Equal(t, len(warnings), 1, expr)
+		assert.Assert(t, strings.Contains(warnings[0], message), "%s: %s", expr, warnings[0])
+	}
+
+	invalid := map[string]string{
+		``:                                              "empty",
+		`http.request.uri.path contain "/admin"`:        "the string field http.request.uri.path must be compared with an operator at position 23",
+		`ip.src contains "192.0.2.1"`:                   "doesn't apply to the IP address field ip.src",
+		`ip.src eq 192.0.2.0/24`:                        `"192.0.2.0/24" is not an IP address`,
+		`ip.src in {192.0.2.0/33}`:                      "is not an IP address",
+		`ip.src in {}`:                                  "empty",
+		`ip.src in {192.0.2.1`:                          "missing '}'",
+		`cf.threat_score gt "10"`:                       `"\"10\"" is not an integer`,
+		`cf.threat_score in {1..x}`:                     "is not an integer",
+		`http.host eq example.com`:                      "expected a quoted string",
+		`http.host eq "example.com`:                     "unterminated string at position 14",
+		`http.host eq "a\n"`:                            "invalid escape sequence",
+		`http.user_agent matches "(curl"`:               "invalid regular expression",
+		`ssl eq true`:                                   "takes no operator",
+		`http.host`:                                     "must be compared with an operator",
+		`(http.host eq "x"`:                             "missing ')'",
+		`http.host eq "x" and`:                          "the expression ends",
+		`http.host eq "x" http.host eq "y"`:             `unexpected "http.host`,
+		`http.host eq "x" and or http.host eq "y"`:      `unexpected "or http.ho" where a field is expected`,
+		`ip.src in 192.0.2.1`:                           "takes a set of values in braces",
+		`http.cookie eq "a" and cf.edge.server_port`:    "must be compared",
+		`http.request.headers eq "x"`:                   "the values of the map or array field http.request.headers are compared with an index",
+		`http.host["x"] eq "y"`:                         "the field http.host is not a map or an array",
+		`http.request.headers["x" eq "y"`:               "missing ']'",
+		`lower(http.host eq "x"`:                        "missing ')' of the function lower",
+		`len(http.host) contains "x"`:                   "the operator contains doesn't apply to the integer function len()",
+		`lower(http.host)`:                              "the string function lower() must be compared with an operator",
+		`any(http.request.headers.names[*] contains 1)`: "expected a quoted string",
+	}
+	for expr, message := range invalid {
+		_, err := parseCISFilterExpression(expr)
+		if err == nil {
+			t.Errorf("%s: expected an error", expr)
+			continue
+		}
+		assert.ErrorContains(t, err, message, expr)
+	}
+
+	ws, errs := validateCISFilterExpression(`ip.src eq "192.0.2.1"`, "expression")
+	assert.Equal(t, len(ws), 0)
+	assert.Equal(t, len(errs), 1)
+	assert.ErrorContains(t, errs[0], `"expression" is not a valid filter expression: "\"192.0.2.1\"" is not an IP address at position 11`)
+
+	ws, errs = validateCISFilterExpression(`cf.waf.score.class eq "attack"`, "expression")
+	assert.Equal(t, len(errs), 0)
+	assert.DeepEqual(t, ws, []string{`"expression": unknown field "cf.waf.score.class", its comparison is only checked for its syntax at position 1`})
+}
+
+func TestIBMCISFilterMock(t *testing.T) {
+	t.Parallel()
+	srv := testMockServer(t)
+	meta := testMockClientSession(t, srv)
+	r := resourceIBMCISFilter()
+	crn, zoneID := testMockCISZone(t, meta, "example.com")
+
+	raw := map[string]interface{}{
+		"cis_id":      crn,
+		"domain_id":   zoneID,
+		"expression":  `ip.src in {192.0.2.0/24} and http.request.uri.path contains "/admin"`,
+		"description": "admin from the office",
+	}
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	d.MarkNewResource()
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), d, meta)))
+	filterID, _, _, err := convertTfToCisThreeVar(d.Id())
+	assert.NilError(t, err)
+	assert.Equal(t, d.Get("filter_id"), filterID)
+	assert.Equal(t, d.Get("paused"), false)
+	assert.Equal(t, d.Get("description"), "admin from the office")
+
+	raw["expression"] = `http.request.uri.path matches "^/admin/.*"`
+	raw["paused"] = true
+	updated := testMockResourceData(t, r, d, raw)
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), updated, meta)))
+	assert.Equal(t, updated.Get("expression"), `http.request.uri.path matches "^/admin/.*"`)
+	assert.Equal(t, updated.Get("paused"), true)
+
+	imported := r.Data(nil)
+	imported.SetId(d.Id())
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), imported, meta)))
+	assert.Equal(t, imported.Get("domain_id"), zoneID)
+	assert.Equal(t, imported.Get("expression"), updated.Get("expression"))
+
+	assert.NilError(t, testDiagsErr(r.DeleteContext(context.Background(), updated, meta)))
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), imported, meta)))
+	assert.Equal(t, imported.Id(), "")
+}
@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/IBM/go-sdk-core/v4/core"
 	cislockdownv1 "github.com/IBM/networking-go-sdk/zonelockdownv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -57,12 +59,11 @@ const (
 
 func resourceIBMCISFirewallRecord() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMCISFirewallRecordCreate,
-		Read:     resourceIBMCISFirewallRecordRead,
-		Update:   resourceIBMCISFirewallRecordUpdate,
-		Delete:   resourceIBMCISFirewallRecordDelete,
-		Exists:   resourceIBMCISFirewallRecordExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMCISFirewallRecordCreate,
+		ReadContext:   resourceIBMCISFirewallRecordRead,
+		UpdateContext: resourceIBMCISFirewallRecordUpdate,
+		DeleteContext: resourceIBMCISFirewallRecordDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			cisID: {
@@ -312,7 +313,7 @@ func resourceIBMCISFirewallValidator() *ResourceValidator {
 	return &cisFirewallValidator
 }
 
-func resourceIBMCISFirewallRecordCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISFirewallRecordCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	crn := d.Get(cisID).(string)
 	zoneID, _, _ := convertTftoCisTwoVar(d.Get(cisDomainID).(string))
 	firewallType := d.Get(cisFirewallType).(string)
@@ -322,7 +323,7 @@ func resourceIBMCISFirewallRecordCreate(d *schema.ResourceData, meta interface{}
 
 		cisClient, err := meta.(ClientSession).CisLockdownClientSession()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		lockdown := d.Get(cisFirewallLockdown).([]interface{})[0].(map[string]interface{})
 
@@ -340,7 +341,7 @@ func resourceIBMCISFirewallRecordCreate(d *schema.ResourceData, meta interface{}
 		configurations, err := expandLockdownsTypeConfiguration(
 			lockdown[cisFirewallLockdownConfigurations].([]interface{}))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		opt.SetUrls(urls)
 		opt.SetConfigurations(configurations)
@@ -350,7 +351,7 @@ func resourceIBMCISFirewallRecordCreate(d *schema.ResourceData, meta interface{}
 		result, response, err := cisClient.CreateZoneLockdownRule(opt)
 		if err != nil {
 			log.Printf("Create zone firewall lockdown failed: %v", response)
-			return err
+			return diag.FromErr(err)
 		}
 		d.SetId(convertCisToTfFourVar(firewallType, *result.Result.ID, zoneID, crn))
 
@@ -358,7 +359,7 @@ func resourceIBMCISFirewallRecordCreate(d *schema.ResourceData, meta interface{}
 
 		cisClient, err := meta.(ClientSession).CisAccessRuleClientSession()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		cisClient.Crn = core.StringPtr(crn)
 		cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -375,7 +376,7 @@ func resourceIBMCISFirewallRecordCreate(d *schema.ResourceData, meta interface{}
 		configOpt, err := cisClient.NewZoneAccessRuleInputConfiguration(target, value)
 		if err != nil {
 			log.Printf("Error in firewall type %s input: %s", firewallType, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 		opt := cisClient.NewCreateZoneAccessRuleOptions()
@@ -388,14 +389,14 @@ func resourceIBMCISFirewallRecordCreate(d *schema.ResourceData, meta interface{}
 		result, response, err := cisClient.CreateZoneAccessRule(opt)
 		if err != nil {
 			log.Printf("Create zone firewall access rule failed: %v", response)
-			return err
+			return diag.FromErr(err)
 		}
 		d.SetId(convertCisToTfFourVar(firewallType, *result.Result.ID, zoneID, crn))
 
 	} else if firewallType == cisFirewallTypeUARules {
 		cisClient, err := meta.(ClientSession).CisUARuleClientSession()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		cisClient.Crn = core.StringPtr(crn)
 		cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -404,7 +405,7 @@ func resourceIBMCISFirewallRecordCreate(d *schema.ResourceData, meta interface{}
 		mode := uaRule[cisFirewallUARuleMode].(string)
 		configList := uaRule[cisFirewallUARuleConfiguration].([]interface{})
 		if len(configList) > 1 {
-			return fmt.Errorf("Only one configuration is allowed for %s type", firewallType)
+			return diag.FromErr(fmt.Errorf("Only one configuration is allowed for %s type", firewallType))
 		}
 		config := configList[0].(map[string]interface{})
 		target := config[cisFirewallLockdownConfigurationsTarget].(string)
@@ -413,7 +414,7 @@ func resourceIBMCISFirewallRecordCreate(d *schema.ResourceData, meta interface{}
 		configOpt, err := cisClient.NewUseragentRuleInputConfiguration(target, value)
 		if err != nil {
 			log.Printf("Error in firewall type %s input: %s", firewallType, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 		opt := cisClient.NewCreateZoneUserAgentRuleOptions()
@@ -430,22 +431,31 @@ func resourceIBMCISFirewallRecordCreate(d *schema.ResourceData, meta interface{}
 		result, response, err := cisClient.CreateZoneUserAgentRule(opt)
 		if err != nil {
 			log.Printf("Create zone user agent rule failed: %v", response)
-			return err
+			return diag.FromErr(err)
 		}
 		d.SetId(convertCisToTfFourVar(firewallType, *result.Result.ID, zoneID, crn))
 	}
 
-	return resourceIBMCISFirewallRecordRead(d, meta)
+	return resourceIBMCISFirewallRecordRead(context, d, meta)
 }
 
-func resourceIBMCISFirewallRecordRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISFirewallRecordRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMCISFirewallRecordExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	firewallType, lockdownID, zoneID, crn, _ := convertTfToCisFourVar(d.Id())
 
 	if firewallType == cisFirewallTypeLockdowns {
 		// Firewall Type : Lockdowns
 		cisClient, err := meta.(ClientSession).CisLockdownClientSession()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		cisClient.Crn = core.StringPtr(crn)
@@ -456,7 +466,7 @@ func resourceIBMCISFirewallRecordRead(d *schema.ResourceData, meta interface{})
 		result, response, err := cisClient.GetLockdown(opt)
 		if err != nil {
 			log.Printf("Get zone firewall lockdown failed: %v", response)
-			return err
+			return diag.FromErr(err)
 		}
 		lockdownList := []interface{}{}
 		lockdown := map[string]interface{}{}
@@ -479,7 +489,7 @@ func resourceIBMCISFirewallRecordRead(d *schema.ResourceData, meta interface{})
 		// Firewall Type : Zone Access firewall rules
 		cisClient, err := meta.(ClientSession).CisAccessRuleClientSession()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		cisClient.Crn = core.StringPtr(crn)
 		cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -489,7 +499,7 @@ func resourceIBMCISFirewallRecordRead(d *schema.ResourceData, meta interface{})
 		result, response, err := cisClient.GetZoneAccessRule(opt)
 		if err != nil {
 			log.Printf("Get zone firewall lockdown failed: %v", response)
-			return err
+			return diag.FromErr(err)
 		}
 
 		config := map[string]interface{}{}
@@ -511,7 +521,7 @@ func resourceIBMCISFirewallRecordRead(d *schema.ResourceData, meta interface{})
 		// Firewall Type: User Agent access rules
 		cisClient, err := meta.(ClientSession).CisUARuleClientSession()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		cisClient.Crn = core.StringPtr(crn)
 		cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -520,7 +530,7 @@ func resourceIBMCISFirewallRecordRead(d *schema.ResourceData, meta interface{})
 		result, response, err := cisClient.GetUserAgentRule(opt)
 		if err != nil {
 			log.Printf("Get zone user agent rule failed: %v", response)
-			return err
+			return diag.FromErr(err)
 		}
 
 		config := map[string]interface{}{}
@@ -547,7 +557,7 @@ func resourceIBMCISFirewallRecordRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceIBMCISFirewallRecordUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISFirewallRecordUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	firewallType, lockdownID, zoneID, crn, _ := convertTfToCisFourVar(d.Id())
 
@@ -561,7 +571,7 @@ func resourceIBMCISFirewallRecordUpdate(d *schema.ResourceData, meta interface{}
 
 			cisClient, err := meta.(ClientSession).CisLockdownClientSession()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			opt := cisClient.NewUpdateLockdownRuleOptions(lockdownID)
@@ -577,7 +587,7 @@ func resourceIBMCISFirewallRecordUpdate(d *schema.ResourceData, meta interface{}
 			urls := expandStringList(lockdown[cisFirewallLockdownURLs].([]interface{}))
 			configurations, err := expandLockdownsTypeConfiguration(lockdown[cisFirewallLockdownConfigurations].([]interface{}))
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			opt.SetUrls(urls)
 			opt.SetConfigurations(configurations)
@@ -587,7 +597,7 @@ func resourceIBMCISFirewallRecordUpdate(d *schema.ResourceData, meta interface{}
 			_, response, err := cisClient.UpdateLockdownRule(opt)
 			if err != nil {
 				log.Printf("Update zone firewall lockdown failed: %v", response)
-				return err
+				return diag.FromErr(err)
 			}
 
 		} else if firewallType == cisFirewallTypeAccessRules {
@@ -597,7 +607,7 @@ func resourceIBMCISFirewallRecordUpdate(d *schema.ResourceData, meta interface{}
 			// Firewall Type : Zone Access firewall rules
 			cisClient, err := meta.(ClientSession).CisAccessRuleClientSession()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			cisClient.Crn = core.StringPtr(crn)
 			cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -612,7 +622,7 @@ func resourceIBMCISFirewallRecordUpdate(d *schema.ResourceData, meta interface{}
 			_, response, err := cisClient.UpdateZoneAccessRule(opt)
 			if err != nil {
 				log.Printf("Update zone firewall access rule failed: %v", response)
-				return err
+				return diag.FromErr(err)
 			}
 
 		} else if firewallType == cisFirewallTypeUARules {
@@ -620,7 +630,7 @@ func resourceIBMCISFirewallRecordUpdate(d *schema.ResourceData, meta interface{}
 			uaRule := d.Get(cisFirewallUARule).([]interface{})[0].(map[string]interface{})
 			cisClient, err := meta.(ClientSession).CisUARuleClientSession()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			cisClient.Crn = core.StringPtr(crn)
 			cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -633,7 +643,7 @@ func resourceIBMCISFirewallRecordUpdate(d *schema.ResourceData, meta interface{}
 			configOpt, err := cisClient.NewUseragentRuleInputConfiguration(target, value)
 			if err != nil {
 				log.Printf("Error in firewall type %s input: %s", firewallType, err)
-				return err
+				return diag.FromErr(err)
 			}
 
 			opt := cisClient.NewUpdateUserAgentRuleOptions(lockdownID)
@@ -650,22 +660,22 @@ func resourceIBMCISFirewallRecordUpdate(d *schema.ResourceData, meta interface{}
 			_, response, err := cisClient.UpdateUserAgentRule(opt)
 			if err != nil {
 				log.Printf("Update zone user agent rule failed: %v", response)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
 	}
-	return resourceIBMCISFirewallRecordRead(d, meta)
+	return resourceIBMCISFirewallRecordRead(context, d, meta)
 }
 
-func resourceIBMCISFirewallRecordDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISFirewallRecordDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	firewallType, lockdownID, zoneID, crn, _ := convertTfToCisFourVar(d.Id())
 
 	if firewallType == cisFirewallTypeLockdowns {
 		// Firewall Type : Lockdowns
 		cisClient, err := meta.(ClientSession).CisLockdownClientSession()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		cisClient.Crn = core.StringPtr(crn)
@@ -676,7 +686,7 @@ func resourceIBMCISFirewallRecordDelete(d *schema.ResourceData, meta interface{}
 		_, response, err := cisClient.DeleteZoneLockdownRule(opt)
 		if err != nil {
 			log.Printf("Delete zone firewall lockdown failed: %v", response)
-			return err
+			return diag.FromErr(err)
 		}
 
 	} else if firewallType == cisFirewallTypeAccessRules {
@@ -684,7 +694,7 @@ func resourceIBMCISFirewallRecordDelete(d *schema.ResourceData, meta interface{}
 		// Firewall Type : Zone Access firewall rules
 		cisClient, err := meta.(ClientSession).CisAccessRuleClientSession()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		cisClient.Crn = core.StringPtr(crn)
 		cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -694,14 +704,14 @@ func resourceIBMCISFirewallRecordDelete(d *schema.ResourceData, meta interface{}
 		_, response, err := cisClient.DeleteZoneAccessRule(opt)
 		if err != nil {
 			log.Printf("Delete zone firewall access rule failed: %v", response)
-			return err
+			return diag.FromErr(err)
 		}
 
 	} else if firewallType == cisFirewallTypeUARules {
 		// Firewall Type: User Agent access rules
 		cisClient, err := meta.(ClientSession).CisUARuleClientSession()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		cisClient.Crn = core.StringPtr(crn)
 		cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -710,7 +720,7 @@ func resourceIBMCISFirewallRecordDelete(d *schema.ResourceData, meta interface{}
 		_, response, err := cisClient.DeleteZoneUserAgentRule(opt)
 		if err != nil {
 			log.Printf("Delete zone user agent rule failed: %v", response)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -0,0 +1,223 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	ibmCISFirewallRule         = "ibm_cis_firewall_rule"
+	cisFirewallRuleID          = "firewall_rule_id"
+	cisFirewallRuleFilterID    = "filter_id"
+	cisFirewallRuleAction      = "action"
+	cisFirewallRulePriority    = "priority"
+	cisFirewallRulePaused      = "paused"
+	cisFirewallRuleDescription = "description"
+)
+
+func resourceIBMCISFirewallRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCISFirewallRuleCreate,
+		ReadContext:   resourceIBMCISFirewallRuleRead,
+		UpdateContext: resourceIBMCISFirewallRuleUpdate,
+		DeleteContext: resourceIBMCISFirewallRuleDelete,
+		Importer:      &schema.ResourceImporter{},
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "CIS instance crn",
+			},
+			cisDomainID: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressDomainIDDiff,
+				Description:      "Associated CIS domain",
+			},
+			cisFirewallRuleID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "CIS firewall rule id",
+			},
+			cisFirewallRuleFilterID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the filter matching the requests the action applies to",
+			},
+			cisFirewallRuleAction: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: InvokeValidator(ibmCISFirewallRule, cisFirewallRuleAction),
+				Description:  "Action applied to the requests matching the filter",
+			},
+			cisFirewallRulePriority: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: InvokeValidator(ibmCISFirewallRule, cisFirewallRulePriority),
+				Description:  "Priority of the firewall rule, the rules with a lower priority being evaluated first and the rules without a priority last",
+			},
+			cisFirewallRulePaused: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the firewall rule is paused",
+			},
+			cisFirewallRuleDescription: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Firewall rule description",
+			},
+		},
+	}
+}
+
+func resourceIBMCISFirewallRuleValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 cisFirewallRuleAction,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Required:                   true,
+			AllowedValues:              "allow, block, challenge, js_challenge, log"})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 cisFirewallRulePriority,
+			ValidateFunctionIdentifier: IntBetween,
+			Type:                       TypeInt,
+			Optional:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "2147483647"})
+	cisFirewallRuleValidator := ResourceValidator{ResourceName: ibmCISFirewallRule, Schema: validateSchema}
+	return &cisFirewallRuleValidator
+}
+
+// expandCISFirewallRule returns the firewall rule described by the configuration.
+func expandCISFirewallRule(d *schema.ResourceData) cisFirewallRule {
+	rule := cisFirewallRule{
+		Filter:      cisFirewallRuleFilter{ID: d.Get(cisFirewallRuleFilterID).(string)},
+		Action:      d.Get(cisFirewallRuleAction).(string),
+		Paused:      d.Get(cisFirewallRulePaused).(bool),
+		Description: d.Get(cisFirewallRuleDescription).(string),
+	}
+	if priority, ok := d.GetOk(cisFirewallRulePriority); ok {
+		p := int64(priority.(int))
+		rule.Priority = &p
+	}
+	return rule
+}
+
+func resourceIBMCISFirewallRuleCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cisClient, err := meta.(ClientSession).CisFirewallRulesSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	token, err := getCISUserToken(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	crn := d.Get(cisID).(string)
+	zoneID, _, _ := convertTftoCisTwoVar(d.Get(cisDomainID).(string))
+	rule, response, err := createCISFirewallRule(cisClient, token, crn, zoneID, expandCISFirewallRule(d))
+	if err != nil {
+		return diag.FromErr(apiErrorf("cis", err, response, "Error creating CIS firewall rule"))
+	}
+	if rule == nil {
+		return diag.Errorf("Error creating CIS firewall rule: the response has no firewall rule")
+	}
+	d.SetId(convertCisToTfThreeVar(rule.ID, zoneID, crn))
+	return resourceIBMCISFirewallRuleRead(context, d, meta)
+}
+
+func resourceIBMCISFirewallRuleRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cisClient, err := meta.(ClientSession).CisFirewallRulesSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	token, err := getCISUserToken(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ruleID, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	rule, response, err := getCISFirewallRule(cisClient, token, crn, zoneID, ruleID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("CIS firewall rule %s is not found", ruleID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(apiErrorf("cis", err, response, "Error reading CIS firewall rule %s", ruleID))
+	}
+	d.Set(cisID, crn)
+	d.Set(cisDomainID, zoneID)
+	d.Set(cisFirewallRuleID, rule.ID)
+	d.Set(cisFirewallRuleFilterID, rule.Filter.ID)
+	d.Set(cisFirewallRuleAction, rule.Action)
+	d.Set(cisFirewallRulePaused, rule.Paused)
+	d.Set(cisFirewallRuleDescription, rule.Description)
+	if rule.Priority != nil {
+		d.Set(cisFirewallRulePriority, *rule.Priority)
+	} else {
+		d.Set(cisFirewallRulePriority, nil)
+	}
+	return nil
+}
+
+func resourceIBMCISFirewallRuleUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cisClient, err := meta.(ClientSession).CisFirewallRulesSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	token, err := getCISUserToken(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ruleID, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChanges(cisFirewallRuleFilterID, cisFirewallRuleAction, cisFirewallRulePriority, cisFirewallRulePaused, cisFirewallRuleDescription) {
+		rule := expandCISFirewallRule(d)
+		rule.ID = ruleID
+		_, response, err := updateCISFirewallRule(cisClient, token, crn, zoneID, rule)
+		if err != nil {
+			return diag.FromErr(apiErrorf("cis", err, response, "Error updating CIS firewall rule %s", ruleID))
+		}
+	}
+	return resourceIBMCISFirewallRuleRead(context, d, meta)
+}
+
+func resourceIBMCISFirewallRuleDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cisClient, err := meta.(ClientSession).CisFirewallRulesSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	token, err := getCISUserToken(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ruleID, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	response, err := deleteCISFirewallRule(cisClient, token, crn, zoneID, ruleID)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return diag.FromErr(apiErrorf("cis", err, response, "Error deleting CIS firewall rule %s", ruleID))
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
+)
+
+func TestAccIBMCisFirewallRule_Basic(t *testing.T) {
+	name := "ibm_cis_firewall_rule.rule"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckCis(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMCisFirewallRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCisFirewallRuleConfig("challenge", 100),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "firewall_rule_id"),
+					resource.TestCheckResourceAttrPair(name, "filter_id", "ibm_cis_filter.filter", "filter_id"),
+					resource.TestCheckResourceAttr(name, "action", "challenge"),
+					resource.TestCheckResourceAttr(name, "priority", "100"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCisFirewallRuleConfig("block", 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "action", "block"),
+					resource.TestCheckResourceAttr(name, "priority", "10"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCisFirewallRuleDestroy(s *terraform.State) error {
+	cisClient, err := testAccProvider.Meta().(ClientSession).CisFirewallRulesSession()
+	if err != nil {
+		return err
+	}
+	token, err := getCISUserToken(testAccProvider.Meta())
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_cis_firewall_rule" {
+			continue
+		}
+		ruleID, zoneID, crn, _ := convertTfToCisThreeVar(rs.Primary.ID)
+		if _, _, err := getCISFirewallRule(cisClient, token, crn, zoneID, ruleID); err == nil {
+			return fmt.Errorf("Firewall rule %s still exists", ruleID)
+		}
+	}
+	return testAccCheckIBMCisFilterDestroy(s)
+}
+
+func testAccCheckIBMCisFirewallRuleConfig(action string, priority int) string {
+	return testAccCheckIBMCisFilterConfig(`ip.src in {192.0.2.0/24} and http.request.uri.path contains "/admin"`, false) + fmt.Sprintf(`
+	resource "ibm_cis_firewall_rule" "rule" {
+		cis_id      = data.ibm_cis.cis.id
+		domain_id   = data.ibm_cis_domain.cis_domain.domain_id
+		filter_id   = ibm_cis_filter.filter.filter_id
+		action      = %q
+		priority    = %d
+		description = "tf-acc firewall rule"
+	}
+	`, action, priority)
+}
+
+func TestIBMCISFirewallRuleMock(t *testing.T) {
+	t.Parallel()
+	srv := testMockServer(t)
+	meta := testMockClientSession(t, srv)
+	r := resourceIBMCISFirewallRule()
+	crn, zoneID := testMockCISZone(t, meta, "example.com")
+
+	filter := resourceIBMCISFilter()
+	filterData := schema.TestResourceDataRaw(t, filter.Schema, map[string]interface{}{
+		"cis_id":     crn,
+		"domain_id":  zoneID,
+		"expression": `ip.src in {192.0.2.0/24} and http.request.uri.path contains "/admin"`,
+	})
+	filterData.MarkNewResource()
+	assert.NilError(t, testDiagsErr(filter.CreateContext(context.Background(), filterData, meta)))
+	filterID := filterData.Get("filter_id").(string)
+
+	raw := map[string]interface{}{
+		"cis_id":    crn,
+		"domain_id": zoneID,
+		"filter_id": filterID,
+		"action":    "challenge",
+		"priority":  100,
+		"paused":    true,
+	}
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	d.MarkNewResource()
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), d, meta)))
+	ruleID, _, _, err := convertTfToCisThreeVar(d.Id())
+	assert.NilError(t, err)
+	assert.Equal(t, d.Get("firewall_rule_id"), ruleID)
+	assert.Equal(t, d.Get("filter_id"), filterID)
+	// The priority and the pause are set on creation, which the SDK doesn't support
+	assert.Equal(t, d.Get("priority"), 100)
+	assert.Equal(t, d.Get("paused"), true)
+
+	// A filter can't be deleted while a firewall rule uses it
+	err = testDiagsErr(filter.DeleteContext(context.Background(), filterData, meta))
+	assert.ErrorContains(t, err, "Error deleting CIS filter")
+	assert.ErrorContains(t, err, "is referenced by the firewall rule")
+
+	raw["action"] = "block"
+	raw["paused"] = false
+	delete(raw, "priority")
+	updated := testMockResourceData(t, r, d, raw)
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), updated, meta)))
+	assert.Equal(t, updated.Get("action"), "block")
+	assert.Equal(t, updated.Get("paused"), false)
+	_, ok := updated.GetOk("priority")
+	assert.Assert(t, !ok)
+
+	raw["filter_id"] = "missing"
+	invalid := testMockResourceData(t, r, updated, raw)
+	err = testDiagsErr(r.UpdateContext(context.Background(), invalid, meta))
+	assert.ErrorContains(t, err, "Error updating CIS firewall rule "+ruleID)
+	assert.ErrorContains(t, err, "[cis API, status 400 Bad Request")
+
+	imported := r.Data(nil)
+	imported.SetId(d.Id())
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), imported, meta)))
+	assert.Equal(t, imported.Get("action"), "block")
+	assert.Equal(t, imported.Get("filter_id"), filterID)
+
+	assert.NilError(t, testDiagsErr(r.DeleteContext(context.Background(), updated, meta)))
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), imported, meta)))
+	assert.Equal(t, imported.Id(), "")
+	assert.NilError(t, testDiagsErr(filter.DeleteContext(context.Background(), filterData, meta)))
+}
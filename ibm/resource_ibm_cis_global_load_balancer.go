@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -156,18 +158,18 @@ func resourceIBMCISGlb() *schema.Resource {
 			},
 		},
 
-		Create:   resourceCISGlbCreate,
-		Read:     resourceCISGlbRead,
-		Update:   resourceCISGlbUpdate,
-		Delete:   resourceCISGlbDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceCISGlbCreate,
+		ReadContext:   resourceCISGlbRead,
+		UpdateContext: resourceCISGlbUpdate,
+		DeleteContext: resourceCISGlbDelete,
+		Importer:      &schema.ResourceImporter{},
 	}
 }
 
-func resourceCISGlbCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISGlbCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisGLBClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -196,14 +198,14 @@ func resourceCISGlbCreate(d *schema.ResourceData, meta interface{}) error {
 	if regionPools, ok := d.GetOk(cisGLBRegionPools); ok {
 		expandedRegionPools, err := expandGeoPools(regionPools, cisGLBRegionPoolsRegion)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		opt.SetRegionPools(expandedRegionPools)
 	}
 	if popPools, ok := d.GetOk(cisGLBPopPools); ok {
 		expandedPopPools, err := expandGeoPools(popPools, cisGLBPopPoolsPop)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		opt.SetPopPools(expandedPopPools)
 	}
@@ -211,22 +213,22 @@ func resourceCISGlbCreate(d *schema.ResourceData, meta interface{}) error {
 	result, resp, err := cisClient.CreateLoadBalancer(opt)
 	if err != nil {
 		log.Printf("Create GLB failed %s\n", resp)
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(convertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
-	return resourceCISGlbUpdate(d, meta)
+	return resourceCISGlbUpdate(context, d, meta)
 }
 
-func resourceCISGlbRead(d *schema.ResourceData, meta interface{}) error {
+func resourceCISGlbRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisGLBClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Extract CIS Ids from TF Id
 	glbID, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	cisClient.Crn = core.StringPtr(crn)
@@ -237,7 +239,7 @@ func resourceCISGlbRead(d *schema.ResourceData, meta interface{}) error {
 	result, resp, err := cisClient.GetLoadBalancerSettings(opt)
 	if err != nil {
 		log.Printf("[WARN] GLB Read failed: %v\n", resp)
-		return err
+		return diag.FromErr(err)
 	}
 	glbObj := result.Result
 	d.Set(cisID, crn)
@@ -261,15 +263,15 @@ func resourceCISGlbRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceCISGlbUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISGlbUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisGLBClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// Extract CIS Ids from TF Id
 	glbID, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -303,14 +305,14 @@ func resourceCISGlbUpdate(d *schema.ResourceData, meta interface{}) error {
 		if regionPools, ok := d.GetOk(cisGLBRegionPools); ok {
 			expandedRegionPools, err := expandGeoPools(regionPools, cisGLBRegionPoolsRegion)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			opt.SetRegionPools(expandedRegionPools)
 		}
 		if popPools, ok := d.GetOk(cisGLBPopPools); ok {
 			expandedPopPools, err := expandGeoPools(popPools, cisGLBPopPoolsPop)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			opt.SetPopPools(expandedPopPools)
 		}
@@ -318,22 +320,22 @@ func resourceCISGlbUpdate(d *schema.ResourceData, meta interface{}) error {
 		_, resp, err := cisClient.EditLoadBalancer(opt)
 		if err != nil {
 			log.Printf("[WARN] Error updating GLB %v\n", resp)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceCISGlbRead(d, meta)
+	return resourceCISGlbRead(context, d, meta)
 }
 
-func resourceCISGlbDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceCISGlbDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisGLBClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// Extract CIS Ids from TF Id
 	glbID, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -342,7 +344,7 @@ func resourceCISGlbDelete(d *schema.ResourceData, meta interface{}) error {
 	result, resp, err := cisClient.DeleteLoadBalancer(opt)
 	if err != nil {
 		log.Printf("[WARN] Error deleting GLB %v\n", resp)
-		return err
+		return diag.FromErr(err)
 	}
 	log.Printf("Deletion successful : %s", *result.Result.ID)
 	return nil
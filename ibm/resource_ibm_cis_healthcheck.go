@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"log"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -35,12 +37,11 @@ const (
 func resourceIBMCISHealthCheck() *schema.Resource {
 	return &schema.Resource{
 
-		Create:   resourceCISHealthCheckCreate,
-		Read:     resourceCISHealthCheckRead,
-		Update:   resourceCISHealthCheckUpdate,
-		Delete:   resourceCISHealthCheckDelete,
-		Exists:   resourceCISHealthCheckExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceCISHealthCheckCreate,
+		ReadContext:   resourceCISHealthCheckRead,
+		UpdateContext: resourceCISHealthCheckUpdate,
+		DeleteContext: resourceCISHealthCheckDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			cisID: {
@@ -218,10 +219,10 @@ func resourceIBMCISHealthCheckValidator() *ResourceValidator {
 	return &cisHealthCheckValidator
 }
 
-func resourceCISHealthCheckCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISHealthCheckCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).CisGLBHealthCheckClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -272,22 +273,31 @@ func resourceCISHealthCheckCreate(d *schema.ResourceData, meta interface{}) erro
 	result, resp, err := sess.CreateLoadBalancerMonitor(opt)
 	if err != nil {
 		log.Printf("create global load balancer health check failed %s", resp)
-		return err
+		return diag.FromErr(err)
 	}
 	log.Printf("global load balancer created successfully : %s", *result.Result.ID)
 	d.SetId(convertCisToTfTwoVar(*result.Result.ID, crn))
-	return resourceCISHealthCheckRead(d, meta)
+	return resourceCISHealthCheckRead(context, d, meta)
 }
 
-func resourceCISHealthCheckRead(d *schema.ResourceData, meta interface{}) error {
+func resourceCISHealthCheckRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceCISHealthCheckExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess, err := meta.(ClientSession).CisGLBHealthCheckClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	monitorID, crn, err := convertTftoCisTwoVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	sess.Crn = core.StringPtr(crn)
 
@@ -296,7 +306,7 @@ func resourceCISHealthCheckRead(d *schema.ResourceData, meta interface{}) error
 	result, resp, err := sess.GetLoadBalancerMonitor(opt)
 	if err != nil {
 		log.Printf("Error reading global load balancer health check detail: %s", resp)
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(cisGLBHealthCheckID, result.Result.ID)
 	d.Set(cisID, crn)
@@ -321,15 +331,15 @@ func resourceCISHealthCheckRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceCISHealthCheckUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISHealthCheckUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).CisGLBHealthCheckClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	monitorID, crn, err := convertTftoCisTwoVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	sess.Crn = core.StringPtr(crn)
 
@@ -389,23 +399,23 @@ func resourceCISHealthCheckUpdate(d *schema.ResourceData, meta interface{}) erro
 		result, resp, err := sess.EditLoadBalancerMonitor(opt)
 		if err != nil {
 			log.Printf("Error updating global load balancer health check detail: %s", resp)
-			return err
+			return diag.FromErr(err)
 		}
 		log.Printf("Monitor update succesful : %s", *result.Result.ID)
 	}
 
-	return resourceCISHealthCheckRead(d, meta)
+	return resourceCISHealthCheckRead(context, d, meta)
 }
 
-func resourceCISHealthCheckDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceCISHealthCheckDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).CisGLBHealthCheckClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	monitorID, crn, err := convertTftoCisTwoVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	sess.Crn = core.StringPtr(crn)
 
@@ -414,7 +424,7 @@ func resourceCISHealthCheckDelete(d *schema.ResourceData, meta interface{}) erro
 	result, resp, err := sess.DeleteLoadBalancerMonitor(opt)
 	if err != nil {
 		log.Printf("Error deleting global load balancer health check detail: %s", resp)
-		return err
+		return diag.FromErr(err)
 	}
 	log.Printf("Monitor ID: %s", *result.Result.ID)
 	return nil
@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"log"
 
 	"github.com/IBM/go-sdk-core/v4/core"
 	"github.com/IBM/networking-go-sdk/globalloadbalancerpoolsv0"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -146,20 +148,19 @@ func resourceIBMCISPool() *schema.Resource {
 			},
 		},
 
-		Create:   resourceCISPoolCreate,
-		Read:     resourceCISPoolRead,
-		Update:   resourceCISPoolUpdate,
-		Delete:   resourceCISPoolDelete,
-		Exists:   resourceCISPoolExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceCISPoolCreate,
+		ReadContext:   resourceCISPoolRead,
+		UpdateContext: resourceCISPoolUpdate,
+		DeleteContext: resourceCISPoolDelete,
+		Importer:      &schema.ResourceImporter{},
 	}
 }
 
-func resourceCISPoolCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISPoolCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var regions []string
 	cisClient, err := meta.(ClientSession).CisGLBPoolClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -208,28 +209,37 @@ func resourceCISPoolCreate(d *schema.ResourceData, meta interface{}) error {
 	result, resp, err := cisClient.CreateLoadBalancerPool(opt)
 	if err != nil {
 		log.Printf("[WARN] Create GLB Pools failed %s\n", resp)
-		return err
+		return diag.FromErr(err)
 	}
 	//Set unique TF Id from concatenated CIS Ids
 	d.SetId(convertCisToTfTwoVar(*result.Result.ID, crn))
-	return resourceCISPoolRead(d, meta)
+	return resourceCISPoolRead(context, d, meta)
 }
 
-func resourceCISPoolRead(d *schema.ResourceData, meta interface{}) error {
+func resourceCISPoolRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceCISPoolExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	cisClient, err := meta.(ClientSession).CisGLBPoolClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	poolID, crn, err := convertTftoCisTwoVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	opt := cisClient.NewGetLoadBalancerPoolOptions(poolID)
 	result, resp, err := cisClient.GetLoadBalancerPool(opt)
 	if err != nil {
 		log.Printf("[WARN] Create GLB Pools failed %s\n", resp)
-		return err
+		return diag.FromErr(err)
 	}
 
 	poolObj := *result.Result
@@ -251,14 +261,14 @@ func resourceCISPoolRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceCISPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISPoolUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisGLBPoolClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	poolID, crn, err := convertTftoCisTwoVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	if d.HasChange(cisGLBPoolName) ||
@@ -318,27 +328,27 @@ func resourceCISPoolUpdate(d *schema.ResourceData, meta interface{}) error {
 		_, resp, err := cisClient.EditLoadBalancerPool(opt)
 		if err != nil {
 			log.Printf("[WARN] Error getting zone during PoolUpdate %v\n", resp)
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceCISPoolRead(d, meta)
+	return resourceCISPoolRead(context, d, meta)
 }
 
-func resourceCISPoolDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceCISPoolDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisGLBPoolClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	poolID, crn, err := convertTftoCisTwoVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	opt := cisClient.NewDeleteLoadBalancerPoolOptions(poolID)
 	result, resp, err := cisClient.DeleteLoadBalancerPool(opt)
 	if err != nil {
 		log.Printf("[WARN] Delete GLB Pools failed %s\n", resp)
-		return err
+		return diag.FromErr(err)
 	}
 	log.Printf("Pool %s deleted successfully.", *result.Result.ID)
 	return nil
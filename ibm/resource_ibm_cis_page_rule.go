@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"log"
 	"strconv"
 
 	"github.com/IBM/go-sdk-core/v4/core"
 	cispagerulev1 "github.com/IBM/networking-go-sdk/pageruleapiv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -36,12 +38,11 @@ const (
 
 func resourceIBMCISPageRule() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceCISPageRuleCreate,
-		Read:     resourceCISPageRuleRead,
-		Update:   resourceCISPageRuleUpdate,
-		Delete:   resourceCISPageRuleDelete,
-		Exists:   resourceCISPageRuleExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceCISPageRuleCreate,
+		ReadContext:   resourceCISPageRuleRead,
+		UpdateContext: resourceCISPageRuleUpdate,
+		DeleteContext: resourceCISPageRuleDelete,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -172,10 +173,10 @@ func resourceCISPageRuleValidator() *ResourceValidator {
 	return &cisPageRuleValidator
 }
 
-func resourceCISPageRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISPageRuleCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisPageRuleClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -199,20 +200,29 @@ func resourceCISPageRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	result, response, err := cisClient.CreatePageRule(opt)
 	if err != nil {
 		log.Printf("Create page rule failed: %v", response)
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(convertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
-	return resourceCISPageRuleRead(d, meta)
+	return resourceCISPageRuleRead(context, d, meta)
 }
-func resourceCISPageRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceCISPageRuleRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceCISPageRuleExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	cisClient, err := meta.(ClientSession).CisPageRuleClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ruleID, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneID = core.StringPtr(zoneID)
@@ -221,7 +231,7 @@ func resourceCISPageRuleRead(d *schema.ResourceData, meta interface{}) error {
 	result, response, err := cisClient.GetPageRule(opt)
 	if err != nil {
 		log.Printf("Get page rule failed: %v", response)
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -232,10 +242,10 @@ func resourceCISPageRuleRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set(cisPageRuleActions, flattenCISPageRuleActions(result.Result.Actions))
 	return nil
 }
-func resourceCISPageRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISPageRuleUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisPageRuleClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ruleID, zoneID, crn, _ := convertTfToCisThreeVar(d.Id())
@@ -263,16 +273,16 @@ func resourceCISPageRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 		_, response, err := cisClient.UpdatePageRule(opt)
 		if err != nil {
 			log.Printf("Update page rule failed: %v", response)
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceCISPageRuleRead(d, meta)
+	return resourceCISPageRuleRead(context, d, meta)
 }
 
-func resourceCISPageRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceCISPageRuleDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisPageRuleClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ruleID, zoneID, crn, _ := convertTfToCisThreeVar(d.Id())
@@ -282,7 +292,7 @@ func resourceCISPageRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	_, response, err := cisClient.DeletePageRule(opt)
 	if err != nil {
 		log.Printf("Delete page rule failed: %v", response)
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
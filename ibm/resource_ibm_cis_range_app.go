@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/IBM/go-sdk-core/v4/core"
 	cisrangeappv1 "github.com/IBM/networking-go-sdk/rangeapplicationsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -48,12 +50,11 @@ const (
 
 func resourceIBMCISRangeApp() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMCISRangeAppCreate,
-		Read:     resourceIBMCISRangeAppRead,
-		Update:   resourceIBMCISRangeAppUpdate,
-		Delete:   resourceIBMCISRangeAppDelete,
-		Exists:   resourceIBMCISRangeAppExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMCISRangeAppCreate,
+		ReadContext:   resourceIBMCISRangeAppRead,
+		UpdateContext: resourceIBMCISRangeAppUpdate,
+		DeleteContext: resourceIBMCISRangeAppDelete,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -205,10 +206,10 @@ func resourceIBMCISRangeAppValidator() *ResourceValidator {
 	ibmCISRangeAppResourceValidator := ResourceValidator{ResourceName: ibmCISRangeApp, Schema: validateSchema}
 	return &ibmCISRangeAppResourceValidator
 }
-func resourceIBMCISRangeAppCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISRangeAppCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisRangeAppClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -264,16 +265,25 @@ func resourceIBMCISRangeAppCreate(d *schema.ResourceData, meta interface{}) erro
 
 	result, resp, err := cisClient.CreateRangeApp(opt)
 	if err != nil {
-		return fmt.Errorf("Failed to create range application: %v", resp)
+		return diag.FromErr(fmt.Errorf("Failed to create range application: %v", resp))
 	}
 	d.SetId(convertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
-	return resourceIBMCISRangeAppRead(d, meta)
+	return resourceIBMCISRangeAppRead(context, d, meta)
 }
 
-func resourceIBMCISRangeAppRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISRangeAppRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMCISRangeAppExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	cisClient, err := meta.(ClientSession).CisRangeAppClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	rangeAppID, zoneID, crn, _ := convertTfToCisThreeVar(d.Id())
@@ -283,7 +293,7 @@ func resourceIBMCISRangeAppRead(d *schema.ResourceData, meta interface{}) error
 	opt := cisClient.NewGetRangeAppOptions(rangeAppID)
 	result, resp, err := cisClient.GetRangeApp(opt)
 	if err != nil {
-		return fmt.Errorf("Failed to read range application: %v", resp)
+		return diag.FromErr(fmt.Errorf("Failed to read range application: %v", resp))
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -303,10 +313,10 @@ func resourceIBMCISRangeAppRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMCISRangeAppUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISRangeAppUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisRangeAppClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange(cisRangeAppOriginDirect) ||
@@ -369,16 +379,16 @@ func resourceIBMCISRangeAppUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 		_, resp, err := cisClient.UpdateRangeApp(opt)
 		if err != nil {
-			return fmt.Errorf("Failed to update range application: %v", resp)
+			return diag.FromErr(fmt.Errorf("Failed to update range application: %v", resp))
 		}
 	}
-	return resourceIBMCISRangeAppRead(d, meta)
+	return resourceIBMCISRangeAppRead(context, d, meta)
 }
 
-func resourceIBMCISRangeAppDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISRangeAppDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisRangeAppClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	rangeAppID, zoneID, cisID, _ := convertTfToCisThreeVar(d.Id())
@@ -387,7 +397,7 @@ func resourceIBMCISRangeAppDelete(d *schema.ResourceData, meta interface{}) erro
 	opt := cisClient.NewDeleteRangeAppOptions(rangeAppID)
 	_, resp, err := cisClient.DeleteRangeApp(opt)
 	if err != nil {
-		return fmt.Errorf("Failed to delete range application: %v", resp)
+		return diag.FromErr(fmt.Errorf("Failed to delete range application: %v", resp))
 	}
 	return nil
 }
@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/IBM/go-sdk-core/v4/core"
 	"github.com/IBM/networking-go-sdk/zoneratelimitsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -23,12 +25,11 @@ const (
 
 func resourceIBMCISRateLimit() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMCISRateLimitCreate,
-		Read:     resourceIBMCISRateLimitRead,
-		Update:   resourceIBMCISRateLimitUpdate,
-		Delete:   resourceIBMCISRateLimitDelete,
-		Exists:   resourceIBMCISRateLimitExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMCISRateLimitCreate,
+		ReadContext:   resourceIBMCISRateLimitRead,
+		UpdateContext: resourceIBMCISRateLimitUpdate,
+		DeleteContext: resourceIBMCISRateLimitDelete,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			"cis_id": {
 				Type:        schema.TypeString,
@@ -346,16 +347,16 @@ func resourceIBMCISRateLimitValidator() *ResourceValidator {
 	ibmCISRateLimitResourceValidator := ResourceValidator{ResourceName: "ibm_cis_rate_limit", Schema: validateSchema}
 	return &ibmCISRateLimitResourceValidator
 }
-func resourceIBMCISRateLimitCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISRateLimitCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisRLClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	cisID := d.Get("cis_id").(string)
 	zoneID, _, err := convertTftoCisTwoVar(d.Get("domain_id").(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(cisID)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -375,13 +376,13 @@ func resourceIBMCISRateLimitCreate(d *schema.ResourceData, meta interface{}) err
 
 	action, err := expandRateLimitAction(d)
 	if err != nil {
-		return fmt.Errorf("Error in getting action from expandRateLimitAction %s", err)
+		return diag.FromErr(fmt.Errorf("Error in getting action from expandRateLimitAction %s", err))
 	}
 	opt.SetAction(action)
 
 	match, err := expandRateLimitMatch(d)
 	if err != nil {
-		return fmt.Errorf("Error in getting match from expandRateLimitMatch %s", err)
+		return diag.FromErr(fmt.Errorf("Error in getting match from expandRateLimitMatch %s", err))
 	}
 	opt.SetMatch(match)
 
@@ -392,35 +393,44 @@ func resourceIBMCISRateLimitCreate(d *schema.ResourceData, meta interface{}) err
 
 	byPass, err := expandRateLimitBypass(d)
 	if err != nil {
-		return fmt.Errorf("Error in getting bypass from expandRateLimitBypass %s", err)
+		return diag.FromErr(fmt.Errorf("Error in getting bypass from expandRateLimitBypass %s", err))
 	}
 	opt.SetBypass(byPass)
 
 	//creating rate limit rule
 	result, resp, err := cisClient.CreateZoneRateLimits(opt)
 	if err != nil {
-		return fmt.Errorf("Failed to create RateLimit: %v", resp)
+		return diag.FromErr(fmt.Errorf("Failed to create RateLimit: %v", resp))
 	}
 	record := result.Result
 	d.SetId(convertCisToTfThreeVar(*record.ID, zoneID, cisID))
-	return resourceIBMCISRateLimitRead(d, meta)
+	return resourceIBMCISRateLimitRead(context, d, meta)
 }
 
-func resourceIBMCISRateLimitRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISRateLimitRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMCISRateLimitExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	cisClient, err := meta.(ClientSession).CisRLClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	recordID, zoneID, cisID, _ := convertTfToCisThreeVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(cisID)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 	opt := cisClient.NewGetRateLimitOptions(recordID)
 	result, resp, err := cisClient.GetRateLimit(opt)
 	if err != nil {
-		return fmt.Errorf("Failed to read RateLimit: %v", resp)
+		return diag.FromErr(fmt.Errorf("Failed to read RateLimit: %v", resp))
 	}
 
 	rule := result.Result
@@ -439,10 +449,10 @@ func resourceIBMCISRateLimitRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMCISRateLimitUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISRateLimitUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisRLClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	recordID, zoneID, cisID, _ := convertTfToCisThreeVar(d.Id())
@@ -472,40 +482,40 @@ func resourceIBMCISRateLimitUpdate(d *schema.ResourceData, meta interface{}) err
 
 		action, err := expandRateLimitAction(d)
 		if err != nil {
-			return fmt.Errorf("Error in getting action from expandRateLimitAction %s", err)
+			return diag.FromErr(fmt.Errorf("Error in getting action from expandRateLimitAction %s", err))
 		}
 		opt.SetAction(action)
 
 		match, err := expandRateLimitMatch(d)
 		if err != nil {
-			return fmt.Errorf("Error in getting match from expandRateLimitMatch %s", err)
+			return diag.FromErr(fmt.Errorf("Error in getting match from expandRateLimitMatch %s", err))
 		}
 		opt.SetMatch(match)
 
 		correlate, err := expandRateLimitCorrelate(d)
 		if err != nil {
-			return fmt.Errorf("Error in getting correlate from expandRateLimitCorrelate %s", err)
+			return diag.FromErr(fmt.Errorf("Error in getting correlate from expandRateLimitCorrelate %s", err))
 		}
 		opt.SetCorrelate(correlate)
 
 		byPass, err := expandRateLimitBypass(d)
 		if err != nil {
-			return fmt.Errorf("Error in getting bypass from expandRateLimitBypass %s", err)
+			return diag.FromErr(fmt.Errorf("Error in getting bypass from expandRateLimitBypass %s", err))
 		}
 		opt.SetBypass(byPass)
 		_, resp, err := cisClient.UpdateRateLimit(opt)
 		if err != nil {
-			return fmt.Errorf("Failed to update RateLimit: %v", resp)
+			return diag.FromErr(fmt.Errorf("Failed to update RateLimit: %v", resp))
 		}
 	}
 	d.SetId(convertCisToTfThreeVar(recordID, zoneID, cisID))
-	return resourceIBMCISRateLimitRead(d, meta)
+	return resourceIBMCISRateLimitRead(context, d, meta)
 }
 
-func resourceIBMCISRateLimitDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISRateLimitDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisRLClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	recordID, zoneID, cisID, _ := convertTfToCisThreeVar(d.Id())
@@ -514,7 +524,7 @@ func resourceIBMCISRateLimitDelete(d *schema.ResourceData, meta interface{}) err
 	opt := cisClient.NewDeleteZoneRateLimitOptions(recordID)
 	_, resp, err := cisClient.DeleteZoneRateLimit(opt)
 	if err != nil {
-		return fmt.Errorf("Failed to delete RateLimit: %v", resp)
+		return diag.FromErr(fmt.Errorf("Failed to delete RateLimit: %v", resp))
 	}
 	return nil
 }
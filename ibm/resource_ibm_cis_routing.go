@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"log"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -17,11 +19,11 @@ const (
 
 func resourceIBMCISRouting() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMCISRoutingUpdate,
-		Read:     resourceIBMCISRoutingRead,
-		Update:   resourceIBMCISRoutingUpdate,
-		Delete:   resourceIBMCISRoutingDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMCISRoutingUpdate,
+		ReadContext:   resourceIBMCISRoutingRead,
+		UpdateContext: resourceIBMCISRoutingUpdate,
+		DeleteContext: resourceIBMCISRoutingDelete,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -61,10 +63,10 @@ func resourceIBMCISRoutingValidator() *ResourceValidator {
 	return &ibmCISRoutingValidator
 }
 
-func resourceIBMCISRoutingUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISRoutingUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisRoutingClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -79,18 +81,18 @@ func resourceIBMCISRoutingUpdate(d *schema.ResourceData, meta interface{}) error
 		_, response, err := cisClient.UpdateSmartRouting(opt)
 		if err != nil {
 			log.Printf("Update smart route setting failed: %v", response)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.SetId(convertCisToTfTwoVar(zoneID, crn))
-	return resourceIBMCISRoutingRead(d, meta)
+	return resourceIBMCISRoutingRead(context, d, meta)
 }
 
-func resourceIBMCISRoutingRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISRoutingRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisRoutingClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	zoneID, crn, err := convertTftoCisTwoVar(d.Id())
 	cisClient.Crn = core.StringPtr(crn)
@@ -99,7 +101,7 @@ func resourceIBMCISRoutingRead(d *schema.ResourceData, meta interface{}) error {
 	result, response, err := cisClient.GetSmartRouting(opt)
 	if err != nil {
 		log.Printf("Get smart route setting failed: %v", response)
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -107,7 +109,7 @@ func resourceIBMCISRoutingRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMCISRoutingDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISRoutingDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Nothing to delete on CIS resource
 	d.SetId("")
 	return nil
@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"log"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -54,11 +56,11 @@ func resourceIBMCISTLSSettings() *schema.Resource {
 				Default:      "1.1",
 			},
 		},
-		Create:   resourceCISTLSSettingsUpdate,
-		Read:     resourceCISTLSSettingsRead,
-		Update:   resourceCISTLSSettingsUpdate,
-		Delete:   resourceCISTLSSettingsDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceCISTLSSettingsUpdate,
+		ReadContext:   resourceCISTLSSettingsRead,
+		UpdateContext: resourceCISTLSSettingsUpdate,
+		DeleteContext: resourceCISTLSSettingsDelete,
+		Importer:      &schema.ResourceImporter{},
 	}
 }
 
@@ -85,10 +87,10 @@ func resourceIBMCISTLSSettingsValidator() *ResourceValidator {
 	return &ibmCISTLSSettingsResourceValidator
 }
 
-func resourceCISTLSSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISTLSSettingsUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisSSLClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	crn := d.Get(cisID).(string)
 	zoneID, _, err := convertTftoCisTwoVar(d.Get(cisDomainID).(string))
@@ -107,7 +109,7 @@ func resourceCISTLSSettingsUpdate(d *schema.ResourceData, meta interface{}) erro
 			_, resp, err := cisClient.ChangeTls13Setting(opt)
 			if err != nil {
 				log.Printf("Update TLS 1.3 setting Failed : %v\n", resp)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
@@ -118,7 +120,7 @@ func resourceCISTLSSettingsUpdate(d *schema.ResourceData, meta interface{}) erro
 			resp, err := cisClient.ChangeUniversalCertificateSetting(opt)
 			if err != nil {
 				log.Printf("Update universal ssl setting Failed : %v\n", resp)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
@@ -126,7 +128,7 @@ func resourceCISTLSSettingsUpdate(d *schema.ResourceData, meta interface{}) erro
 		if minTLSVer, ok := d.GetOk(cisTLSSettingsMinTLSVersion); ok {
 			cisClient, err := meta.(ClientSession).CisDomainSettingsClientSession()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			cisClient.Crn = core.StringPtr(crn)
 			cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -135,18 +137,18 @@ func resourceCISTLSSettingsUpdate(d *schema.ResourceData, meta interface{}) erro
 			_, resp, err := cisClient.UpdateMinTlsVersion(opt)
 			if err != nil {
 				log.Printf("Update minimum TLS version setting Failed : %v\n", resp)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 	d.SetId(convertCisToTfTwoVar(zoneID, crn))
-	return resourceCISTLSSettingsRead(d, meta)
+	return resourceCISTLSSettingsRead(context, d, meta)
 }
 
-func resourceCISTLSSettingsRead(d *schema.ResourceData, meta interface{}) error {
+func resourceCISTLSSettingsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisSSLClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	zoneID, crn, _ := convertTftoCisTwoVar(d.Id())
 	cisClient.Crn = core.StringPtr(crn)
@@ -156,7 +158,7 @@ func resourceCISTLSSettingsRead(d *schema.ResourceData, meta interface{}) error
 	tls13Result, resp, err := cisClient.GetTls13Setting(cisClient.NewGetTls13SettingOptions())
 	if err != nil {
 		log.Printf("Get TLS 1.3 setting failed : %v\n", resp)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Universal SSL setting
@@ -164,13 +166,13 @@ func resourceCISTLSSettingsRead(d *schema.ResourceData, meta interface{}) error
 		cisClient.NewGetUniversalCertificateSettingOptions())
 	if err != nil {
 		log.Printf("Update TLS 1.3 setting failed : %v\n", resp)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Minumum TLS version setting
 	minTLSClient, err := meta.(ClientSession).CisDomainSettingsClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	minTLSClient.Crn = core.StringPtr(crn)
 	minTLSClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -178,7 +180,7 @@ func resourceCISTLSSettingsRead(d *schema.ResourceData, meta interface{}) error
 		minTLSClient.NewGetMinTlsVersionOptions())
 	if err != nil {
 		log.Printf("Min TLS Version setting get request failed : %v", resp)
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -188,7 +190,7 @@ func resourceCISTLSSettingsRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceCISTLSSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceCISTLSSettingsDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Nothing to delete on CIS resource
 	d.SetId("")
 	return nil
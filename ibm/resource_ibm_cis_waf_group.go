@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"log"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -23,11 +25,11 @@ const (
 
 func resourceIBMCISWAFGroup() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMCISWAFGroupUpdate,
-		Read:     resourceIBMCISWAFGroupRead,
-		Update:   resourceIBMCISWAFGroupUpdate,
-		Delete:   resourceIBMCISWAFGroupDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMCISWAFGroupUpdate,
+		ReadContext:   resourceIBMCISWAFGroupRead,
+		UpdateContext: resourceIBMCISWAFGroupUpdate,
+		DeleteContext: resourceIBMCISWAFGroupDelete,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -99,10 +101,10 @@ func resourceIBMCISWAFGroupValidator() *ResourceValidator {
 	return &ibmCISWAFGroupValidator
 }
 
-func resourceIBMCISWAFGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISWAFGroupUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisWAFGroupClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -119,17 +121,17 @@ func resourceIBMCISWAFGroupUpdate(d *schema.ResourceData, meta interface{}) erro
 		_, response, err := cisClient.UpdateWafRuleGroup(opt)
 		if err != nil {
 			log.Printf("Update waf rule group mode failed: %v", response)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	d.SetId(convertCisToTfFourVar(groupID, packageID, zoneID, crn))
-	return resourceIBMCISWAFGroupRead(d, meta)
+	return resourceIBMCISWAFGroupRead(context, d, meta)
 }
 
-func resourceIBMCISWAFGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISWAFGroupRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisWAFGroupClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	groupID, packageID, zoneID, crn, err := convertTfToCisFourVar(d.Id())
 	cisClient.Crn = core.StringPtr(crn)
@@ -143,7 +145,7 @@ func resourceIBMCISWAFGroupRead(d *schema.ResourceData, meta interface{}) error
 			return nil
 		}
 		log.Printf("Get waf rule group setting failed: %v", response)
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -157,7 +159,7 @@ func resourceIBMCISWAFGroupRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMCISWAFGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISWAFGroupDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Nothing to delete on CIS WAF Group resource
 	d.SetId("")
 	return nil
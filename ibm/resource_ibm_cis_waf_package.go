@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"log"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -22,11 +24,11 @@ const (
 
 func resourceIBMCISWAFPackage() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMCISWAFPackageUpdate,
-		Read:     resourceIBMCISWAFPackageRead,
-		Update:   resourceIBMCISWAFPackageUpdate,
-		Delete:   resourceIBMCISWAFPackageDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMCISWAFPackageUpdate,
+		ReadContext:   resourceIBMCISWAFPackageRead,
+		UpdateContext: resourceIBMCISWAFPackageUpdate,
+		DeleteContext: resourceIBMCISWAFPackageDelete,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -103,10 +105,10 @@ func resourceIBMCISWAFPackageValidator() *ResourceValidator {
 	return &ibmCISWAFPackageValidator
 }
 
-func resourceIBMCISWAFPackageUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISWAFPackageUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisWAFPackageClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -127,18 +129,18 @@ func resourceIBMCISWAFPackageUpdate(d *schema.ResourceData, meta interface{}) er
 		result, response, err := cisClient.UpdateWafPackage(opt)
 		if err != nil {
 			log.Printf("Update waf package setting failed: %v", response)
-			return err
+			return diag.FromErr(err)
 		}
 		d.SetId(convertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
 	}
 
-	return resourceIBMCISWAFPackageRead(d, meta)
+	return resourceIBMCISWAFPackageRead(context, d, meta)
 }
 
-func resourceIBMCISWAFPackageRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISWAFPackageRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisWAFPackageClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	packageID, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
 	cisClient.Crn = core.StringPtr(crn)
@@ -152,7 +154,7 @@ func resourceIBMCISWAFPackageRead(d *schema.ResourceData, meta interface{}) erro
 			return nil
 		}
 		log.Printf("Get waf package setting failed: %v", response)
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -165,7 +167,7 @@ func resourceIBMCISWAFPackageRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceIBMCISWAFPackageDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISWAFPackageDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Nothing to delete on CIS WAF Package resource
 	d.SetId("")
 	return nil
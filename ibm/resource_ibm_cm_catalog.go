@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
@@ -14,10 +16,10 @@ import (
 
 func resourceIBMCmCatalog() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMCmCatalogCreate,
-		Read:     resourceIBMCmCatalogRead,
-		Delete:   resourceIBMCmCatalogDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMCmCatalogCreate,
+		ReadContext:   resourceIBMCmCatalogRead,
+		DeleteContext: resourceIBMCmCatalogDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"label": &schema.Schema{
@@ -64,10 +66,10 @@ func resourceIBMCmCatalog() *schema.Resource {
 	}
 }
 
-func resourceIBMCmCatalogCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCmCatalogCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	catalogManagementClient, err := meta.(ClientSession).CatalogManagementV1()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	createCatalogOptions := &catalogmanagementv1.CreateCatalogOptions{}
@@ -88,18 +90,18 @@ func resourceIBMCmCatalogCreate(d *schema.ResourceData, meta interface{}) error
 	catalog, response, err := catalogManagementClient.CreateCatalog(createCatalogOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateCatalog failed %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(*catalog.ID)
 
-	return resourceIBMCmCatalogRead(d, meta)
+	return resourceIBMCmCatalogRead(context, d, meta)
 }
 
-func resourceIBMCmCatalogRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCmCatalogRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	catalogManagementClient, err := meta.(ClientSession).CatalogManagementV1()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	log.Printf("[DEBUG] client is a nil pointer: %v\n", catalogManagementClient == nil)
 
@@ -114,39 +116,39 @@ func resourceIBMCmCatalogRead(d *schema.ResourceData, meta interface{}) error {
 			return nil
 		}
 		log.Printf("[DEBUG] GetCatalog failed %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("label", catalog.Label); err != nil {
-		return fmt.Errorf("Error setting label: %s", err)
+		return diag.FromErr(fmt.Errorf("Error setting label: %s", err))
 	}
 	if err = d.Set("short_description", catalog.ShortDescription); err != nil {
-		return fmt.Errorf("Error setting short_description: %s", err)
+		return diag.FromErr(fmt.Errorf("Error setting short_description: %s", err))
 	}
 	if err = d.Set("catalog_icon_url", catalog.CatalogIconURL); err != nil {
-		return fmt.Errorf("Error setting catalog_icon_url: %s", err)
+		return diag.FromErr(fmt.Errorf("Error setting catalog_icon_url: %s", err))
 	}
 	if catalog.Tags != nil {
 		if err = d.Set("tags", catalog.Tags); err != nil {
-			return fmt.Errorf("Error setting tags: %s", err)
+			return diag.FromErr(fmt.Errorf("Error setting tags: %s", err))
 		}
 	}
 	if err = d.Set("url", catalog.URL); err != nil {
-		return fmt.Errorf("Error setting url: %s", err)
+		return diag.FromErr(fmt.Errorf("Error setting url: %s", err))
 	}
 	if err = d.Set("crn", catalog.CRN); err != nil {
-		return fmt.Errorf("Error setting crn: %s", err)
+		return diag.FromErr(fmt.Errorf("Error setting crn: %s", err))
 	}
 	if err = d.Set("offerings_url", catalog.OfferingsURL); err != nil {
-		return fmt.Errorf("Error setting offerings_url: %s", err)
+		return diag.FromErr(fmt.Errorf("Error setting offerings_url: %s", err))
 	}
 
 	return nil
 }
 
-func resourceIBMCmCatalogDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCmCatalogDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	catalogManagementClient, err := meta.(ClientSession).CatalogManagementV1()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	deleteCatalogOptions := &catalogmanagementv1.DeleteCatalogOptions{}
@@ -156,7 +158,7 @@ func resourceIBMCmCatalogDelete(d *schema.ResourceData, meta interface{}) error
 	response, err := catalogManagementClient.DeleteCatalog(deleteCatalogOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteCatalog failed %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
@@ -14,10 +16,10 @@ import (
 
 func resourceIBMCmVersion() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMCmVersionCreate,
-		Read:     resourceIBMCmVersionRead,
-		Delete:   resourceIBMCmVersionDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMCmVersionCreate,
+		ReadContext:   resourceIBMCmVersionRead,
+		DeleteContext: resourceIBMCmVersionDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"catalog_identifier": &schema.Schema{
@@ -118,10 +120,10 @@ func resourceIBMCmVersion() *schema.Resource {
 	}
 }
 
-func resourceIBMCmVersionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCmVersionCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	catalogManagementClient, err := meta.(ClientSession).CatalogManagementV1()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	importOfferingVersionOptions := catalogManagementClient.NewImportOfferingVersionOptions(d.Get("catalog_identifier").(string), d.Get("offering_id").(string))
@@ -148,20 +150,20 @@ func resourceIBMCmVersionCreate(d *schema.ResourceData, meta interface{}) error
 
 	if err != nil {
 		log.Printf("[DEBUG] ImportOfferingVersion failed %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	versionLocator := *offering.Kinds[0].Versions[0].VersionLocator
 
 	d.SetId(versionLocator)
 
-	return resourceIBMCmVersionRead(d, meta)
+	return resourceIBMCmVersionRead(context, d, meta)
 }
 
-func resourceIBMCmVersionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCmVersionRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	catalogManagementClient, err := meta.(ClientSession).CatalogManagementV1()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	getVersionOptions := &catalogmanagementv1.GetVersionOptions{}
@@ -177,47 +179,47 @@ func resourceIBMCmVersionRead(d *schema.ResourceData, meta interface{}) error {
 			return nil
 		}
 		log.Printf("[DEBUG] GetVersion failed %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("crn", version.CRN); err != nil {
-		return fmt.Errorf("Error setting crn: %s", err)
+		return diag.FromErr(fmt.Errorf("Error setting crn: %s", err))
 	}
 	if err = d.Set("version", version.Version); err != nil {
-		return fmt.Errorf("Error setting version: %s", err)
+		return diag.FromErr(fmt.Errorf("Error setting version: %s", err))
 	}
 	if err = d.Set("sha", version.Sha); err != nil {
-		return fmt.Errorf("Error setting sha: %s", err)
+		return diag.FromErr(fmt.Errorf("Error setting sha: %s", err))
 	}
 	if err = d.Set("created", version.Created.String()); err != nil {
-		return fmt.Errorf("Error setting created: %s", err)
+		return diag.FromErr(fmt.Errorf("Error setting created: %s", err))
 	}
 	if err = d.Set("updated", version.Updated.String()); err != nil {
-		return fmt.Errorf("Error setting updated: %s", err)
+		return diag.FromErr(fmt.Errorf("Error setting updated: %s", err))
 	}
 	if err = d.Set("catalog_id", version.CatalogID); err != nil {
-		return fmt.Errorf("Error setting catalog_id: %s", err)
+		return diag.FromErr(fmt.Errorf("Error setting catalog_id: %s", err))
 	}
 	if err = d.Set("kind_id", version.KindID); err != nil {
-		return fmt.Errorf("Error setting kind_id: %s", err)
+		return diag.FromErr(fmt.Errorf("Error setting kind_id: %s", err))
 	}
 	if err = d.Set("repo_url", version.RepoURL); err != nil {
-		return fmt.Errorf("Error setting repo_url: %s", err)
+		return diag.FromErr(fmt.Errorf("Error setting repo_url: %s", err))
 	}
 	if err = d.Set("source_url", version.SourceURL); err != nil {
-		return fmt.Errorf("Error setting source_url: %s", err)
+		return diag.FromErr(fmt.Errorf("Error setting source_url: %s", err))
 	}
 	if err = d.Set("tgz_url", version.TgzURL); err != nil {
-		return fmt.Errorf("Error setting tgz_url: %s", err)
+		return diag.FromErr(fmt.Errorf("Error setting tgz_url: %s", err))
 	}
 
 	return nil
 }
 
-func resourceIBMCmVersionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCmVersionDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	catalogManagementClient, err := meta.(ClientSession).CatalogManagementV1()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	deleteVersionOptions := &catalogmanagementv1.DeleteVersionOptions{}
@@ -226,7 +228,7 @@ func resourceIBMCmVersionDelete(d *schema.ResourceData, meta interface{}) error
 	response, err := catalogManagementClient.DeleteVersion(deleteVersionOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteVersion failed %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -11,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -38,12 +40,11 @@ var IBMComputeAutoScaleGroupObjectMask = []string{
 
 func resourceIBMComputeAutoScaleGroup() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMComputeAutoScaleGroupCreate,
-		Read:     resourceIBMComputeAutoScaleGroupRead,
-		Update:   resourceIBMComputeAutoScaleGroupUpdate,
-		Delete:   resourceIBMComputeAutoScaleGroupDelete,
-		Exists:   resourceIBMComputeAutoScaleGroupExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMComputeAutoScaleGroupCreate,
+		ReadContext:   resourceIBMComputeAutoScaleGroupRead,
+		UpdateContext: resourceIBMComputeAutoScaleGroupUpdate,
+		DeleteContext: resourceIBMComputeAutoScaleGroupDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 
@@ -245,23 +246,23 @@ func getVirtualGuestTemplate(vGuestTemplateList []interface{}, meta interface{})
 	return vgs[0], err
 }
 
-func resourceIBMComputeAutoScaleGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeAutoScaleGroupCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	accountServiceNoRetry := services.GetScaleGroupService(sess.SetRetries(0))
 
 	virtualGuestTemplateOpts, err := getVirtualGuestTemplate(d.Get("virtual_guest_member_template").([]interface{}), meta)
 	if err != nil {
-		return fmt.Errorf("Error while parsing virtual_guest_member_template values: %s", err)
+		return diag.FromErr(fmt.Errorf("Error while parsing virtual_guest_member_template values: %s", err))
 	}
 
 	scaleNetworkVlans, err := buildScaleVlansFromResourceData(d.Get("network_vlan_ids").(*schema.Set).List(), meta)
 	if err != nil {
-		return fmt.Errorf("Error while parsing network vlan values: %s", err)
+		return diag.FromErr(fmt.Errorf("Error while parsing network vlan values: %s", err))
 	}
 
 	locationGroupRegionalId, err := getLocationGroupRegionalId(sess, d.Get("regional_group").(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Build up our creation options
@@ -282,12 +283,12 @@ func resourceIBMComputeAutoScaleGroupCreate(d *schema.ResourceData, meta interfa
 
 	opts.LoadBalancers, err = buildLoadBalancers(d)
 	if err != nil {
-		return fmt.Errorf("Error creating Scale Group: %s", err)
+		return diag.FromErr(fmt.Errorf("Error creating Scale Group: %s", err))
 	}
 
 	res, err := accountServiceNoRetry.CreateObject(&opts)
 	if err != nil {
-		return fmt.Errorf("Error creating Scale Group: %s", err)
+		return diag.FromErr(fmt.Errorf("Error creating Scale Group: %s", err))
 	}
 
 	d.SetId(strconv.Itoa(*res.Id))
@@ -296,13 +297,13 @@ func resourceIBMComputeAutoScaleGroupCreate(d *schema.ResourceData, meta interfa
 	time.Sleep(60)
 
 	// wait for scale group to become active
-	_, err = waitForActiveStatus(d, meta)
+	_, err = waitForActiveStatus(context, d, meta)
 
 	if err != nil {
-		return fmt.Errorf("Error waiting for scale group (%s) to become active: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("Error waiting for scale group (%s) to become active: %s", d.Id(), err))
 	}
 
-	return resourceIBMComputeAutoScaleGroupRead(d, meta)
+	return resourceIBMComputeAutoScaleGroupRead(context, d, meta)
 }
 
 func buildLoadBalancers(d *schema.ResourceData, ids ...int) ([]datatypes.Scale_LoadBalancer, error) {
@@ -338,7 +339,16 @@ func buildLoadBalancers(d *schema.ResourceData, ids ...int) ([]datatypes.Scale_L
 	}
 }
 
-func resourceIBMComputeAutoScaleGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeAutoScaleGroupRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMComputeAutoScaleGroupExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetScaleGroupService(sess)
 
@@ -352,7 +362,7 @@ func resourceIBMComputeAutoScaleGroupRead(d *schema.ResourceData, meta interface
 			return nil
 		}
 
-		return fmt.Errorf("Error retrieving autoscale Group: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving autoscale Group: %s", err))
 	}
 
 	d.Set("name", slGroupObj.Name)
@@ -452,7 +462,7 @@ func populateMemberTemplateResourceData(template datatypes.Virtual_Guest) []map[
 	return []map[string]interface{}{d}
 }
 
-func resourceIBMComputeAutoScaleGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeAutoScaleGroupUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess := meta.(ClientSession).SoftLayerSession()
 	scaleGroupService := services.GetScaleGroupService(sess)
@@ -462,14 +472,14 @@ func resourceIBMComputeAutoScaleGroupUpdate(d *schema.ResourceData, meta interfa
 
 	groupId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid ID. Must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("Not a valid ID. Must be an integer: %s", err))
 	}
 
 	// Fetch the complete object from SoftLayer, update with current values from the configuration, and send the
 	// whole thing back to SoftLayer (effectively, a PUT)
 	groupObj, err := scaleGroupService.Id(groupId).Mask(strings.Join(IBMComputeAutoScaleGroupObjectMask, ",")).GetObject()
 	if err != nil {
-		return fmt.Errorf("Error retrieving autoscale_group resource: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving autoscale_group resource: %s", err))
 	}
 
 	groupObj.Name = sl.String(d.Get("name").(string))
@@ -485,7 +495,7 @@ func resourceIBMComputeAutoScaleGroupUpdate(d *schema.ResourceData, meta interfa
 		groupObj.LoadBalancers, err = buildLoadBalancers(d)
 	}
 	if err != nil {
-		return fmt.Errorf("Error creating Scale Group: %s", err)
+		return diag.FromErr(fmt.Errorf("Error creating Scale Group: %s", err))
 	}
 
 	if d.HasChange("network_vlan_ids") {
@@ -503,13 +513,13 @@ func resourceIBMComputeAutoScaleGroupUpdate(d *schema.ResourceData, meta interfa
 			Id(groupId).
 			GetNetworkVlans()
 		if err != nil {
-			return fmt.Errorf("Could not retrieve current vlans for scale group (%d): %s", groupId, err)
+			return diag.FromErr(fmt.Errorf("Could not retrieve current vlans for scale group (%d): %s", groupId, err))
 		}
 
 		for _, oldScaleVlan := range oldScaleVlans {
 			_, err := scaleNetworkVlanService.Id(*oldScaleVlan.Id).DeleteObject()
 			if err != nil {
-				return fmt.Errorf("Error deleting scale network vlan %d: %s", *oldScaleVlan.Id, err)
+				return diag.FromErr(fmt.Errorf("Error deleting scale network vlan %d: %s", *oldScaleVlan.Id, err))
 			}
 		}
 
@@ -517,7 +527,7 @@ func resourceIBMComputeAutoScaleGroupUpdate(d *schema.ResourceData, meta interfa
 		scaleVlans, err := buildScaleVlansFromResourceData(newIds, meta)
 
 		if err != nil {
-			return fmt.Errorf("Unable to parse network vlan options: %s", err)
+			return diag.FromErr(fmt.Errorf("Unable to parse network vlan options: %s", err))
 		}
 
 		groupObj.NetworkVlans = scaleVlans
@@ -526,7 +536,7 @@ func resourceIBMComputeAutoScaleGroupUpdate(d *schema.ResourceData, meta interfa
 	if d.HasChange("virtual_guest_member_template") {
 		virtualGuestTemplateOpts, err := getVirtualGuestTemplate(d.Get("virtual_guest_member_template").([]interface{}), meta)
 		if err != nil {
-			return fmt.Errorf("Unable to parse virtual guest member template options: %s", err)
+			return diag.FromErr(fmt.Errorf("Unable to parse virtual guest member template options: %s", err))
 		}
 
 		groupObj.VirtualGuestMemberTemplate = &virtualGuestTemplateOpts
@@ -534,14 +544,14 @@ func resourceIBMComputeAutoScaleGroupUpdate(d *schema.ResourceData, meta interfa
 	}
 	_, err = scaleGroupServiceNoRetry.Id(groupId).EditObject(&groupObj)
 	if err != nil {
-		return fmt.Errorf("Error received while editing autoscale_group: %s", err)
+		return diag.FromErr(fmt.Errorf("Error received while editing autoscale_group: %s", err))
 	}
 
 	// wait for scale group to become active
-	_, err = waitForActiveStatus(d, meta)
+	_, err = waitForActiveStatus(context, d, meta)
 
 	if err != nil {
-		return fmt.Errorf("Error waiting for scale group (%s) to become active: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("Error waiting for scale group (%s) to become active: %s", d.Id(), err))
 	}
 
 	// Delete a load balancer if there is the load balancer in a scale group
@@ -549,26 +559,26 @@ func resourceIBMComputeAutoScaleGroupUpdate(d *schema.ResourceData, meta interfa
 	if len(currentLoadBalancers) > 0 && len(groupObj.LoadBalancers) <= 0 {
 		_, err = scaleLoadBalancerService.Id(*currentLoadBalancers[0].Id).DeleteObject()
 		if err != nil {
-			return fmt.Errorf("Error received while deleting loadbalancers: %s", err)
+			return diag.FromErr(fmt.Errorf("Error received while deleting loadbalancers: %s", err))
 		}
 	}
 
 	return nil
 }
 
-func resourceIBMComputeAutoScaleGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeAutoScaleGroupDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	scaleGroupService := services.GetScaleGroupService(sess)
 
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error deleting scale group: %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting scale group: %s", err))
 	}
 
 	log.Printf("[INFO] Deleting scale group: %d", id)
 	_, err = scaleGroupService.Id(id).ForceDeleteObject()
 	if err != nil {
-		return fmt.Errorf("Error deleting scale group: %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting scale group: %s", err))
 	}
 
 	d.SetId("")
@@ -576,7 +586,7 @@ func resourceIBMComputeAutoScaleGroupDelete(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func waitForActiveStatus(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForActiveStatus(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	sess := meta.(ClientSession).SoftLayerSession()
 	scaleGroupService := services.GetScaleGroupService(sess)
 
@@ -635,7 +645,7 @@ func waitForActiveStatus(d *schema.ResourceData, meta interface{}) (interface{},
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func resourceIBMComputeAutoScaleGroupExists(d *schema.ResourceData, meta interface{}) (bool, error) {
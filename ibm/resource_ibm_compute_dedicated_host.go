@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -24,12 +26,11 @@ var dedicatedHostPackageType = "DEDICATED_HOST"
 
 func resourceIBMComputeDedicatedHost() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMComputeDedicatedHostCreate,
-		Read:     resourceIBMComputeDedicatedHostRead,
-		Delete:   resourceIBMComputeDedicatedHostDelete,
-		Exists:   resourceIBMComputeDedicatedHostExists,
-		Update:   resourceIBMComputeDedicatedHostUpdate,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMComputeDedicatedHostCreate,
+		ReadContext:   resourceIBMComputeDedicatedHostRead,
+		DeleteContext: resourceIBMComputeDedicatedHostDelete,
+		UpdateContext: resourceIBMComputeDedicatedHostUpdate,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"hostname": {
@@ -99,12 +100,12 @@ func resourceIBMComputeDedicatedHost() *schema.Resource {
 	}
 }
 
-func resourceIBMComputeDedicatedHostCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeDedicatedHostCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	pkg, err := product.GetPackageByType(sess, dedicatedHostPackageType)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	datacenter := d.Get("datacenter").(string)
@@ -114,12 +115,12 @@ func resourceIBMComputeDedicatedHostCreate(d *schema.ResourceData, meta interfac
 	// Lookup the data center ID
 	dc, err := location.GetDatacenterByName(sess, datacenter)
 	if err != nil {
-		return fmt.Errorf("No data centers matching %s could be found", datacenter)
+		return diag.FromErr(fmt.Errorf("No data centers matching %s could be found", datacenter))
 	}
 
 	rt, err := hardware.GetRouterByName(sess, router, "id")
 	if err != nil {
-		return fmt.Errorf("Error creating dedicated host: %s", err)
+		return diag.FromErr(fmt.Errorf("Error creating dedicated host: %s", err))
 	}
 
 	primaryBackendNetworkComponent := datatypes.Network_Component{
@@ -138,7 +139,7 @@ func resourceIBMComputeDedicatedHostCreate(d *schema.ResourceData, meta interfac
 	// 2. Get all prices for the package
 	productItems, err := product.GetPackageProducts(sess, *pkg.Id, productItemMaskWithPriceLocationGroupID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	priceItems := []datatypes.Product_Item_Price{}
@@ -180,33 +181,42 @@ func resourceIBMComputeDedicatedHostCreate(d *schema.ResourceData, meta interfac
 	_, err = services.GetProductOrderService(sess.SetRetries(0)).
 		VerifyOrder(&productOrderContainer)
 	if err != nil {
-		return fmt.Errorf("Error during creation of dedicated host: %s", err)
+		return diag.FromErr(fmt.Errorf("Error during creation of dedicated host: %s", err))
 	}
 	//place order
 	_, err = services.GetProductOrderService(sess.SetRetries(0)).
 		PlaceOrder(&productOrderContainer, sl.Bool(false))
 	if err != nil {
-		return fmt.Errorf("Error during creation of dedicated host: %s", err)
+		return diag.FromErr(fmt.Errorf("Error during creation of dedicated host: %s", err))
 	}
 
 	// wait for machine availability
-	dedicated, err := findDedicatedHostByOrderID(&hardware, d, meta)
+	dedicated, err := findDedicatedHostByOrderID(context, &hardware, d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for dedicated host (%s) to become ready: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf(
+			"Error waiting for dedicated host (%s) to become ready: %s", d.Id(), err))
 	}
 
 	id := *dedicated.(datatypes.Virtual_DedicatedHost).Id
 	d.SetId(fmt.Sprintf("%d", id))
-	return resourceIBMComputeDedicatedHostRead(d, meta)
+	return resourceIBMComputeDedicatedHostRead(context, d, meta)
 }
 
-func resourceIBMComputeDedicatedHostRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeDedicatedHostRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMComputeDedicatedHostExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	service := services.GetVirtualDedicatedHostService(meta.(ClientSession).SoftLayerSession())
 
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 	}
 
 	result, err := service.Id(id).Mask(
@@ -214,7 +224,7 @@ func resourceIBMComputeDedicatedHostRead(d *schema.ResourceData, meta interface{
 	).GetObject()
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving dedicated host: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving dedicated host: %s", err))
 	}
 
 	d.Set("hostname", result.Name)
@@ -226,49 +236,49 @@ func resourceIBMComputeDedicatedHostRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceIBMComputeDedicatedHostUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeDedicatedHostUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetVirtualDedicatedHostService(sess.SetRetries(0))
 
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 	}
 
 	result, err := service.Id(id).GetObject()
 	if err != nil {
-		return fmt.Errorf("Error retrieving dedicated host: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving dedicated host: %s", err))
 	}
 
 	if d.HasChange("hostname") {
 		result.Name = sl.String(d.Get("hostname").(string))
 		_, err = service.Id(id).EditObject(&result)
 		if err != nil {
-			return fmt.Errorf("Couldn't update dedicated host: %s", err)
+			return diag.FromErr(fmt.Errorf("Couldn't update dedicated host: %s", err))
 		}
 
 	}
-	return resourceIBMComputeDedicatedHostRead(d, meta)
+	return resourceIBMComputeDedicatedHostRead(context, d, meta)
 }
 
-func resourceIBMComputeDedicatedHostDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeDedicatedHostDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	service := services.GetVirtualDedicatedHostService(meta.(ClientSession).SoftLayerSession())
 
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 	}
 
 	ok, err := service.Id(id).DeleteObject()
 
 	if err != nil {
-		return fmt.Errorf("Error deleting dedicated host: %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting dedicated host: %s", err))
 	}
 
 	if !ok {
-		return fmt.Errorf(
-			"API reported it was unsuccessful in removing the dedicated host '%d'", id)
+		return diag.FromErr(fmt.Errorf(
+			"API reported it was unsuccessful in removing the dedicated host '%d'", id))
 	}
 
 	d.SetId("")
@@ -295,7 +305,7 @@ func resourceIBMComputeDedicatedHostExists(d *schema.ResourceData, meta interfac
 	return result.Id != nil && *result.Id == dedicatedID, nil
 }
 
-func findDedicatedHostByOrderID(d *datatypes.Hardware, r *schema.ResourceData, meta interface{}) (interface{}, error) {
+func findDedicatedHostByOrderID(context context.Context, d *datatypes.Hardware, r *schema.ResourceData, meta interface{}) (interface{}, error) {
 	hostname := *d.Hostname
 
 	log.Printf("Waiting for dedicated host (%s) to have to be provisioned", hostname)
@@ -325,5 +335,5 @@ func findDedicatedHostByOrderID(d *datatypes.Hardware, r *schema.ResourceData, m
 		MinTimeout: 1 * time.Minute,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
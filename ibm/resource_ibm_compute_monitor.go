@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/services"
@@ -17,12 +19,11 @@ import (
 
 func resourceIBMComputeMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMComputeMonitorCreate,
-		Read:     resourceIBMComputeMonitorRead,
-		Update:   resourceIBMComputeMonitorUpdate,
-		Delete:   resourceIBMComputeMonitorDelete,
-		Exists:   resourceIBMComputeMonitorExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMComputeMonitorCreate,
+		ReadContext:   resourceIBMComputeMonitorRead,
+		UpdateContext: resourceIBMComputeMonitorUpdate,
+		DeleteContext: resourceIBMComputeMonitorDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 
@@ -76,7 +77,7 @@ func resourceIBMComputeMonitor() *schema.Resource {
 	}
 }
 
-func resourceIBMComputeMonitorCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeMonitorCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	virtualGuestService := services.GetVirtualGuestService(sess)
 	monitorService := services.GetNetworkMonitorVersion1QueryHostService(sess.SetRetries(0))
@@ -86,12 +87,12 @@ func resourceIBMComputeMonitorCreate(d *schema.ResourceData, meta interface{}) e
 	if ipAddress == "" {
 		virtualGuest, err := virtualGuestService.Id(guestId).GetObject()
 		if err != nil {
-			return fmt.Errorf("Error looking up virtual guest %d: %s", guestId, err)
+			return diag.FromErr(fmt.Errorf("Error looking up virtual guest %d: %s", guestId, err))
 		}
 
 		if virtualGuest.PrimaryIpAddress == nil {
-			return fmt.Errorf(
-				"No primary ip address found for virtual guest %d. Please specify it.", guestId)
+			return diag.FromErr(fmt.Errorf(
+				"No primary ip address found for virtual guest %d. Please specify it.", guestId))
 		}
 
 		ipAddress = *virtualGuest.PrimaryIpAddress
@@ -111,7 +112,7 @@ func resourceIBMComputeMonitorCreate(d *schema.ResourceData, meta interface{}) e
 	// Create a monitor
 	res, err := monitorService.CreateObject(&opts)
 	if err != nil {
-		return fmt.Errorf("Error creating Basic Monitor : %s", err)
+		return diag.FromErr(fmt.Errorf("Error creating Basic Monitor : %s", err))
 	}
 
 	d.SetId(strconv.Itoa(*res.Id))
@@ -119,10 +120,10 @@ func resourceIBMComputeMonitorCreate(d *schema.ResourceData, meta interface{}) e
 
 	err = createNotifications(d, meta, guestId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMComputeMonitorRead(d, meta)
+	return resourceIBMComputeMonitorRead(context, d, meta)
 }
 
 func createNotifications(d *schema.ResourceData, meta interface{}, guestId int) error {
@@ -165,7 +166,15 @@ func notificationExists(notificationLinks []datatypes.User_Customer_Notification
 	return false
 }
 
-func resourceIBMComputeMonitorRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeMonitorRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMComputeMonitorExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
 
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkMonitorVersion1QueryHostService(sess)
@@ -182,7 +191,7 @@ func resourceIBMComputeMonitorRead(d *schema.ResourceData, meta interface{}) err
 			return nil
 		}
 
-		return fmt.Errorf("Error retrieving Basic Monitor : %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving Basic Monitor : %s", err))
 	}
 
 	guestId := *basicMonitor.GuestId
@@ -195,7 +204,7 @@ func resourceIBMComputeMonitorRead(d *schema.ResourceData, meta interface{}) err
 
 	notificationLinks, err := virtualGuestService.Id(guestId).GetMonitoringUserNotification()
 	if err != nil {
-		return fmt.Errorf("Error looking up user notifications for virtual guest %d", guestId)
+		return diag.FromErr(fmt.Errorf("Error looking up user notifications for virtual guest %d", guestId))
 	}
 
 	notificationUserIds := schema.NewSet(func(v interface{}) int { return v.(int) }, make([]interface{}, 0, len(notificationLinks)))
@@ -226,7 +235,7 @@ func resourceIBMComputeMonitorRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceIBMComputeMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeMonitorUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess := meta.(ClientSession).SoftLayerSession()
 	serviceNoRetry := services.GetNetworkMonitorVersion1QueryHostService(sess.SetRetries(0))
@@ -237,7 +246,7 @@ func resourceIBMComputeMonitorUpdate(d *schema.ResourceData, meta interface{}) e
 
 	basicMonitor, err := service.Id(basicMonitorId).GetObject()
 	if err != nil {
-		return fmt.Errorf("Error retrieving Basic Monitor : %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving Basic Monitor : %s", err))
 	}
 	if d.HasChange("query_type_id") {
 		basicMonitor.QueryTypeId = sl.Int(d.Get("query_type_id").(int))
@@ -251,20 +260,20 @@ func resourceIBMComputeMonitorUpdate(d *schema.ResourceData, meta interface{}) e
 
 	_, err = serviceNoRetry.Id(basicMonitorId).EditObject(&basicMonitor)
 	if err != nil {
-		return fmt.Errorf("Error editing Basic Monitor : %s", err)
+		return diag.FromErr(fmt.Errorf("Error editing Basic Monitor : %s", err))
 	}
 
 	// Will only create notification objects for user/vm relationships that
 	// don't exist yet.
 	err = createNotifications(d, meta, guestId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMComputeMonitorRead(d, meta)
+	return resourceIBMComputeMonitorRead(context, d, meta)
 }
 
-func resourceIBMComputeMonitorDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeMonitorDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkMonitorVersion1QueryHostService(sess)
 
@@ -274,7 +283,7 @@ func resourceIBMComputeMonitorDelete(d *schema.ResourceData, meta interface{}) e
 	log.Printf("[INFO] Deleting Basic Monitor : %d", id)
 	_, err = service.Id(id).DeleteObject()
 	if err != nil {
-		return fmt.Errorf("Error deleting Basic Monitor : %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting Basic Monitor : %s", err))
 	}
 
 	d.SetId("")
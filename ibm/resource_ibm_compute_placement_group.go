@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
@@ -12,6 +13,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/filter"
@@ -23,12 +25,11 @@ import (
 
 func resourceIBMComputePlacementGroup() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMComputePlacementGroupCreate,
-		Read:     resourceIBMComputePlacementGroupRead,
-		Update:   resourceIBMComputePlacementGroupUpdate,
-		Delete:   resourceIBMComputePlacementGroupDelete,
-		Exists:   resourceIBMComputePlacementGroupExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMComputePlacementGroupCreate,
+		ReadContext:   resourceIBMComputePlacementGroupRead,
+		UpdateContext: resourceIBMComputePlacementGroupUpdate,
+		DeleteContext: resourceIBMComputePlacementGroupDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -78,7 +79,7 @@ func resourceIBMComputePlacementGroup() *schema.Resource {
 	}
 }
 
-func resourceIBMComputePlacementGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputePlacementGroupCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	name := d.Get("name").(string)
 	datacenter := d.Get("datacenter").(string)
@@ -91,7 +92,7 @@ func resourceIBMComputePlacementGroupCreate(d *schema.ResourceData, meta interfa
 	// 1.Getting the router ID
 	routerids, err := PodService.Filter(filter.Path("datacenterName").Eq(datacenter).Build()).Mask(podMask).GetAllObjects()
 	if err != nil {
-		return fmt.Errorf("Encountered problem trying to get the router ID: %s", err)
+		return diag.FromErr(fmt.Errorf("Encountered problem trying to get the router ID: %s", err))
 	}
 	var routerid int
 	for _, iterate := range routerids {
@@ -105,7 +106,7 @@ func resourceIBMComputePlacementGroupCreate(d *schema.ResourceData, meta interfa
 		Mask("id,name").
 		Filter(filter.Path("name").Eq(rule).Build()).GetObject()
 	if err != nil {
-		return fmt.Errorf("Encountered problem trying to get the placement group rule ID: %s", err)
+		return diag.FromErr(fmt.Errorf("Encountered problem trying to get the placement group rule ID: %s", err))
 	}
 
 	opts := datatypes.Virtual_PlacementGroup{
@@ -118,16 +119,25 @@ func resourceIBMComputePlacementGroupCreate(d *schema.ResourceData, meta interfa
 
 	pgrp, err := service.CreateObject(&opts)
 	if err != nil {
-		return fmt.Errorf("Error creating Placement Group: %s", err)
+		return diag.FromErr(fmt.Errorf("Error creating Placement Group: %s", err))
 	}
 
 	d.SetId(strconv.Itoa(*pgrp.Id))
 	log.Printf("[INFO] Placement Group ID: %d", *pgrp.Id)
 
-	return resourceIBMComputePlacementGroupRead(d, meta)
+	return resourceIBMComputePlacementGroupRead(context, d, meta)
 }
 
-func resourceIBMComputePlacementGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputePlacementGroupRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMComputePlacementGroupExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetVirtualPlacementGroupService(sess)
 
@@ -141,7 +151,7 @@ func resourceIBMComputePlacementGroupRead(d *schema.ResourceData, meta interface
 				return nil
 			}
 		}
-		return fmt.Errorf("Error retrieving Placement Group: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving Placement Group: %s", err))
 	}
 
 	d.Set("name", pgrp.Name)
@@ -155,7 +165,7 @@ func resourceIBMComputePlacementGroupRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceIBMComputePlacementGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputePlacementGroupUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetVirtualPlacementGroupService(sess.SetRetries(0))
 
@@ -168,7 +178,7 @@ func resourceIBMComputePlacementGroupUpdate(d *schema.ResourceData, meta interfa
 		_, err := service.Id(pgrpID).EditObject(&opts)
 
 		if err != nil {
-			return fmt.Errorf("Error editing Placement Group: %s", err)
+			return diag.FromErr(fmt.Errorf("Error editing Placement Group: %s", err))
 		}
 	}
 
@@ -197,7 +207,7 @@ func resourceIBMComputePlacementGroupExists(d *schema.ResourceData, meta interfa
 	return result.Id != nil && *result.Id == pgrpID, nil
 }
 
-func resourceIBMComputePlacementGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputePlacementGroupDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetVirtualPlacementGroupService(sess)
 
@@ -228,14 +238,14 @@ func resourceIBMComputePlacementGroupDelete(d *schema.ResourceData, meta interfa
 			return vms, noVms, nil
 		},
 	}
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(context)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_, err = service.Id(pgrpID).DeleteObject()
 	if err != nil {
-		return fmt.Errorf("Error deleting Placement Group: %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting Placement Group: %s", err))
 	}
 
 	return nil
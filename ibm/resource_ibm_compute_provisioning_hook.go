@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/services"
@@ -17,12 +19,11 @@ import (
 
 func resourceIBMComputeProvisioningHook() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMComputeProvisioningHookCreate,
-		Read:     resourceIBMComputeProvisioningHookRead,
-		Update:   resourceIBMComputeProvisioningHookUpdate,
-		Delete:   resourceIBMComputeProvisioningHookDelete,
-		Exists:   resourceIBMComputeProvisioningHookExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMComputeProvisioningHookCreate,
+		ReadContext:   resourceIBMComputeProvisioningHookRead,
+		UpdateContext: resourceIBMComputeProvisioningHookUpdate,
+		DeleteContext: resourceIBMComputeProvisioningHookDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -48,7 +49,7 @@ func resourceIBMComputeProvisioningHook() *schema.Resource {
 	}
 }
 
-func resourceIBMComputeProvisioningHookCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeProvisioningHookCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetProvisioningHookService(sess.SetRetries(0))
 
@@ -59,16 +60,25 @@ func resourceIBMComputeProvisioningHookCreate(d *schema.ResourceData, meta inter
 
 	hook, err := service.CreateObject(&opts)
 	if err != nil {
-		return fmt.Errorf("Error creating Provisioning Hook: %s", err)
+		return diag.FromErr(fmt.Errorf("Error creating Provisioning Hook: %s", err))
 	}
 
 	d.SetId(strconv.Itoa(*hook.Id))
 	log.Printf("[INFO] Provisioning Hook ID: %d", *hook.Id)
 
-	return resourceIBMComputeProvisioningHookRead(d, meta)
+	return resourceIBMComputeProvisioningHookRead(context, d, meta)
 }
 
-func resourceIBMComputeProvisioningHookRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeProvisioningHookRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMComputeProvisioningHookExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetProvisioningHookService(sess)
 
@@ -82,7 +92,7 @@ func resourceIBMComputeProvisioningHookRead(d *schema.ResourceData, meta interfa
 				return nil
 			}
 		}
-		return fmt.Errorf("Error retrieving Provisioning Hook: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving Provisioning Hook: %s", err))
 	}
 
 	d.Set("name", hook.Name)
@@ -91,7 +101,7 @@ func resourceIBMComputeProvisioningHookRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceIBMComputeProvisioningHookUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeProvisioningHookUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetProvisioningHookService(sess.SetRetries(0))
 
@@ -111,12 +121,12 @@ func resourceIBMComputeProvisioningHookUpdate(d *schema.ResourceData, meta inter
 	_, err := service.Id(hookId).EditObject(&opts)
 
 	if err != nil {
-		return fmt.Errorf("Error editing Provisioning Hook: %s", err)
+		return diag.FromErr(fmt.Errorf("Error editing Provisioning Hook: %s", err))
 	}
 	return nil
 }
 
-func resourceIBMComputeProvisioningHookDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeProvisioningHookDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetProvisioningHookService(sess)
 
@@ -124,7 +134,7 @@ func resourceIBMComputeProvisioningHookDelete(d *schema.ResourceData, meta inter
 	log.Printf("[INFO] Deleting Provisioning Hook: %d", hookId)
 	_, err = service.Id(hookId).DeleteObject()
 	if err != nil {
-		return fmt.Errorf("Error deleting Provisioning Hook: %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting Provisioning Hook: %s", err))
 	}
 
 	return nil
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
@@ -11,6 +12,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/filter"
@@ -20,12 +22,11 @@ import (
 
 func resourceIBMComputeSSHKey() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMComputeSSHKeyCreate,
-		Read:     resourceIBMComputeSSHKeyRead,
-		Update:   resourceIBMComputeSSHKeyUpdate,
-		Delete:   resourceIBMComputeSSHKeyDelete,
-		Exists:   resourceIBMComputeSSHKeyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMComputeSSHKeyCreate,
+		ReadContext:   resourceIBMComputeSSHKeyRead,
+		UpdateContext: resourceIBMComputeSSHKeyUpdate,
+		DeleteContext: resourceIBMComputeSSHKeyDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"label": {
@@ -68,7 +69,7 @@ func resourceIBMComputeSSHKey() *schema.Resource {
 	}
 }
 
-func resourceIBMComputeSSHKeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeSSHKeyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetSecuritySshKeyService(sess)
 
@@ -79,7 +80,7 @@ func resourceIBMComputeSSHKeyCreate(d *schema.ResourceData, meta interface{}) er
 
 	fingerprint, err := computeSSHKeyFingerprint(key)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	keys, err := services.GetAccountService(sess).
@@ -108,7 +109,7 @@ func resourceIBMComputeSSHKeyCreate(d *schema.ResourceData, meta interface{}) er
 
 		if editKey {
 			_, err = service.Id(id).EditObject(&slKey)
-			return err
+			return diag.FromErr(err)
 		}
 
 		return nil
@@ -126,16 +127,25 @@ func resourceIBMComputeSSHKeyCreate(d *schema.ResourceData, meta interface{}) er
 
 	res, err := service.CreateObject(&opts)
 	if err != nil {
-		return fmt.Errorf("Error creating SSH Key: %s", err)
+		return diag.FromErr(fmt.Errorf("Error creating SSH Key: %s", err))
 	}
 
 	d.SetId(strconv.Itoa(*res.Id))
 	log.Printf("[INFO] SSH Key: %d", *res.Id)
 
-	return resourceIBMComputeSSHKeyRead(d, meta)
+	return resourceIBMComputeSSHKeyRead(context, d, meta)
 }
 
-func resourceIBMComputeSSHKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeSSHKeyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMComputeSSHKeyExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetSecuritySshKeyService(sess)
 
@@ -148,7 +158,7 @@ func resourceIBMComputeSSHKeyRead(d *schema.ResourceData, meta interface{}) erro
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error retrieving SSH key: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving SSH key: %s", err))
 	}
 
 	d.Set("label", key.Label)
@@ -158,7 +168,7 @@ func resourceIBMComputeSSHKeyRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceIBMComputeSSHKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeSSHKeyUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetSecuritySshKeyService(sess)
 
@@ -166,7 +176,7 @@ func resourceIBMComputeSSHKeyUpdate(d *schema.ResourceData, meta interface{}) er
 
 	key, err := service.Id(keyID).GetObject()
 	if err != nil {
-		return fmt.Errorf("Error retrieving SSH key: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving SSH key: %s", err))
 	}
 
 	if d.HasChange("label") {
@@ -179,24 +189,24 @@ func resourceIBMComputeSSHKeyUpdate(d *schema.ResourceData, meta interface{}) er
 
 	_, err = service.Id(keyID).EditObject(&key)
 	if err != nil {
-		return fmt.Errorf("Error editing SSH key: %s", err)
+		return diag.FromErr(fmt.Errorf("Error editing SSH key: %s", err))
 	}
-	return resourceIBMComputeSSHKeyRead(d, meta)
+	return resourceIBMComputeSSHKeyRead(context, d, meta)
 }
 
-func resourceIBMComputeSSHKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeSSHKeyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetSecuritySshKeyService(sess)
 
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error deleting SSH Key: %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting SSH Key: %s", err))
 	}
 
 	log.Printf("[INFO] Deleting SSH key: %d", id)
 	_, err = service.Id(id).DeleteObject()
 	if err != nil {
-		return fmt.Errorf("Error deleting SSH key: %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting SSH key: %s", err))
 	}
 
 	d.SetId("")
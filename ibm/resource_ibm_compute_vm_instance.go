@@ -5,6 +5,7 @@ package ibm
 
 import (
 	"bytes"
+	"context"
 	"crypto/rand"
 	"encoding/hex"
 	"errors"
@@ -16,6 +17,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -62,12 +64,11 @@ const (
 
 func resourceIBMComputeVmInstance() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMComputeVmInstanceCreate,
-		Read:     resourceIBMComputeVmInstanceRead,
-		Update:   resourceIBMComputeVmInstanceUpdate,
-		Delete:   resourceIBMComputeVmInstanceDelete,
-		Exists:   resourceIBMComputeVmInstanceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMComputeVmInstanceCreate,
+		ReadContext:   resourceIBMComputeVmInstanceRead,
+		UpdateContext: resourceIBMComputeVmInstanceUpdate,
+		DeleteContext: resourceIBMComputeVmInstanceDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
@@ -927,7 +928,7 @@ func getVirtualGuestTemplateFromResourceData(d *schema.ResourceData, meta interf
 	return vms, nil
 }
 
-func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeVmInstanceCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetVirtualGuestService(sess)
@@ -951,11 +952,11 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 	}
 
 	if dcName == "" && len(retryOptions) == 0 {
-		return fmt.Errorf("Provide either `datacenter` or `datacenter_choice`")
+		return diag.FromErr(fmt.Errorf("Provide either `datacenter` or `datacenter_choice`"))
 	}
 
 	if (d.Get("hostname").(string) == "" || d.Get("domain").(string) == "") && len(d.Get("bulk_vms").(*schema.Set).List()) == 0 {
-		return fmt.Errorf("Provide either `hostname` and `domain` or `bulk_vms`")
+		return diag.FromErr(fmt.Errorf("Provide either `hostname` and `domain` or `bulk_vms`"))
 	}
 
 	if dcName != "" {
@@ -975,11 +976,11 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 
 		err := validateDatacenterOption(retryOptions, []string{"datacenter", "public_vlan_id", "private_vlan_id"})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		for _, option := range retryOptions {
 			if option == nil {
-				return fmt.Errorf("Provide a valid `datacenter_choice`")
+				return diag.FromErr(fmt.Errorf("Provide a valid `datacenter_choice`"))
 			}
 			center := option.(map[string]interface{})
 			var publicVlan, privateVlan int
@@ -988,7 +989,7 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 			if v, ok := center["datacenter"]; ok {
 				name = v.(string)
 			} else {
-				return fmt.Errorf("Missing datacenter in `datacenter_choice`")
+				return diag.FromErr(fmt.Errorf("Missing datacenter in `datacenter_choice`"))
 			}
 
 			if v, ok := center["public_vlan_id"]; ok {
@@ -1007,7 +1008,7 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 	}
 
 	if err1 != nil {
-		return fmt.Errorf("Error ordering virtual guest: %s", err1)
+		return diag.FromErr(fmt.Errorf("Error ordering virtual guest: %s", err1))
 	}
 
 	var idStrings []string
@@ -1030,7 +1031,7 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 	for _, str := range idStrings {
 		id, err = strconv.Atoi(str)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		// Set tags
 		tags := getTags(d)
@@ -1038,7 +1039,7 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 			//Try setting only when it is non empty as we are creating virtual guest
 			err = setGuestTags(id, tags, meta)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
@@ -1053,38 +1054,47 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 		if len(storageIds) > 0 {
 			err := addAccessToStorageList(service.Id(id), id, storageIds, meta)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
 		// Set notes
 		err = setNotes(id, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		// wait for machine availability
 
-		_, err = WaitForVirtualGuestAvailable(id, d, meta)
+		_, err = WaitForVirtualGuestAvailable(context, id, d, meta)
 
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for virtual machine (%s) to become ready: %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf(
+				"Error waiting for virtual machine (%s) to become ready: %s", d.Id(), err))
 		}
 	}
 
-	return resourceIBMComputeVmInstanceRead(d, meta)
+	return resourceIBMComputeVmInstanceRead(context, d, meta)
 }
 
-func resourceIBMComputeVmInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeVmInstanceRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMComputeVmInstanceExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	service := services.GetVirtualGuestService(meta.(ClientSession).SoftLayerSession())
 	parts, err := vmIdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id, err := strconv.Atoi(parts[0])
 	if err != nil {
-		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 	}
 
 	result, err := service.Id(id).Mask(
@@ -1106,7 +1116,7 @@ func resourceIBMComputeVmInstanceRead(d *schema.ResourceData, meta interface{})
 	).GetObject()
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving virtual guest: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving virtual guest: %s", err))
 	}
 
 	if len(parts) == 1 {
@@ -1117,7 +1127,7 @@ func resourceIBMComputeVmInstanceRead(d *schema.ResourceData, meta interface{})
 		for _, part := range parts {
 			vmId, err := strconv.Atoi(part)
 			if err != nil {
-				return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+				return diag.FromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 			}
 			vmResult, err := service.Id(vmId).Mask(
 				"hostname,domain",
@@ -1304,7 +1314,7 @@ func resourceIBMComputeVmInstanceRead(d *schema.ResourceData, meta interface{})
 	d.Set(ResourceName, *result.Hostname)
 	d.Set(ResourceStatus, *result.Status.Name)
 	err = readSecondaryIPAddresses(d, meta, result.PrimaryIpAddress)
-	return err
+	return diag.FromErr(err)
 }
 
 func readSecondaryIPAddresses(d *schema.ResourceData, meta interface{}, primaryIPAddress *string) error {
@@ -1334,23 +1344,23 @@ func readSecondaryIPAddresses(d *schema.ResourceData, meta interface{}, primaryI
 	}
 	return nil
 }
-func resourceIBMComputeVmInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeVmInstanceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetVirtualGuestService(sess)
 
 	parts, err := vmIdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id, err := strconv.Atoi(parts[0])
 	if err != nil {
-		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 	}
 
 	result, err := service.Id(id).GetObject()
 	if err != nil {
-		return fmt.Errorf("Error retrieving virtual guest: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving virtual guest: %s", err))
 	}
 
 	isChanged := false
@@ -1371,7 +1381,7 @@ func resourceIBMComputeVmInstanceUpdate(d *schema.ResourceData, meta interface{}
 	if isChanged {
 		_, err = service.Id(id).EditObject(&result)
 		if err != nil {
-			return fmt.Errorf("Couldn't update virtual guest: %s", err)
+			return diag.FromErr(fmt.Errorf("Couldn't update virtual guest: %s", err))
 		}
 	}
 
@@ -1380,13 +1390,13 @@ func resourceIBMComputeVmInstanceUpdate(d *schema.ResourceData, meta interface{}
 		tags := getTags(d)
 		err := setGuestTags(id, tags, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	err = modifyStorageAccess(service.Id(id), id, meta, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Upgrade "cores", "memory" and "network_speed" if provided and changed
@@ -1414,7 +1424,7 @@ func resourceIBMComputeVmInstanceUpdate(d *schema.ResourceData, meta interface{}
 
 		//Remove is not supported for now.
 		if len(oldDisk) > len(newDisk) {
-			return fmt.Errorf("Removing drives is not supported.")
+			return diag.FromErr(fmt.Errorf("Removing drives is not supported."))
 		}
 
 		var diskName string
@@ -1450,30 +1460,30 @@ func resourceIBMComputeVmInstanceUpdate(d *schema.ResourceData, meta interface{}
 			presetKeyName := d.Get("flavor_key_name").(string)
 			_, err = virtual.UpgradeVirtualGuestWithPreset(sess.SetRetries(0), &result, presetKeyName, upgradeOptions)
 			if err != nil {
-				return fmt.Errorf("Couldn't upgrade virtual guest: %s", err)
+				return diag.FromErr(fmt.Errorf("Couldn't upgrade virtual guest: %s", err))
 			}
 
 		} else {
 			_, err = virtual.UpgradeVirtualGuest(sess.SetRetries(0), &result, upgradeOptions)
 			if err != nil {
-				return fmt.Errorf("Couldn't upgrade virtual guest: %s", err)
+				return diag.FromErr(fmt.Errorf("Couldn't upgrade virtual guest: %s", err))
 			}
 		}
 
 		// Wait for softlayer to start upgrading...
-		_, err = WaitForUpgradeTransactionsToAppear(d, meta)
+		_, err = WaitForUpgradeTransactionsToAppear(context, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		// Wait for upgrade transactions to finish
-		_, err = WaitForNoActiveTransactions(id, d, d.Timeout(schema.TimeoutUpdate), meta)
+		_, err = WaitForNoActiveTransactions(context, id, d, d.Timeout(schema.TimeoutUpdate), meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
-	return resourceIBMComputeVmInstanceRead(d, meta)
+	return resourceIBMComputeVmInstanceRead(context, d, meta)
 }
 
 func modifyStorageAccess(sam storageAccessModifier, deviceID int, meta interface{}, d *schema.ResourceData) error {
@@ -1510,36 +1520,36 @@ func modifyStorageAccess(sam storageAccessModifier, deviceID int, meta interface
 	return nil
 }
 
-func resourceIBMComputeVmInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeVmInstanceDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetVirtualGuestService(sess)
 	parts, err := vmIdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	for _, part := range parts {
 		id, err := strconv.Atoi(part)
 		if err != nil {
-			return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+			return diag.FromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 		}
 
-		_, err = WaitForNoActiveTransactions(id, d, d.Timeout(schema.TimeoutDelete), meta)
+		_, err = WaitForNoActiveTransactions(context, id, d, d.Timeout(schema.TimeoutDelete), meta)
 
 		if err != nil {
-			return fmt.Errorf("Error deleting virtual guest, couldn't wait for zero active transactions: %s", err)
+			return diag.FromErr(fmt.Errorf("Error deleting virtual guest, couldn't wait for zero active transactions: %s", err))
 		}
 		err = detachSecurityGroupNetworkComponentBindings(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		ok, err := service.Id(id).DeleteObject()
 		if err != nil {
-			return fmt.Errorf("Error deleting virtual guest: %s", err)
+			return diag.FromErr(fmt.Errorf("Error deleting virtual guest: %s", err))
 		}
 
 		if !ok {
-			return fmt.Errorf(
-				"API reported it was unsuccessful in removing the virtual guest '%d'", id)
+			return diag.FromErr(fmt.Errorf(
+				"API reported it was unsuccessful in removing the virtual guest '%d'", id))
 		}
 	}
 
@@ -1612,7 +1622,7 @@ func genID() (interface{}, error) {
 }
 
 // WaitForUpgradeTransactionsToAppear Wait for upgrade transactions
-func WaitForUpgradeTransactionsToAppear(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func WaitForUpgradeTransactionsToAppear(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	log.Printf("Waiting for server (%s) to have upgrade transactions", d.Id())
 
 	parts, err := vmIdParts(d.Id())
@@ -1648,11 +1658,11 @@ func WaitForUpgradeTransactionsToAppear(d *schema.ResourceData, meta interface{}
 		MinTimeout: 5 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 // WaitForNoActiveTransactions Wait for no active transactions
-func WaitForNoActiveTransactions(id int, d *schema.ResourceData, timeout time.Duration, meta interface{}) (interface{}, error) {
+func WaitForNoActiveTransactions(context context.Context, id int, d *schema.ResourceData, timeout time.Duration, meta interface{}) (interface{}, error) {
 	log.Printf("Waiting for server (%s) to have zero active transactions", d.Id())
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"retry", activeTransaction},
@@ -1676,11 +1686,11 @@ func WaitForNoActiveTransactions(id int, d *schema.ResourceData, timeout time.Du
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 // WaitForVirtualGuestAvailable Waits for virtual guest creation
-func WaitForVirtualGuestAvailable(id int, d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func WaitForVirtualGuestAvailable(context context.Context, id int, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	log.Printf("Waiting for server (%s) to be available.", d.Id())
 	sess := meta.(ClientSession).SoftLayerSession()
 	stateConf := &resource.StateChangeConf{
@@ -1692,7 +1702,7 @@ func WaitForVirtualGuestAvailable(id int, d *schema.ResourceData, meta interface
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func virtualGuestStateRefreshFunc(sess *session.Session, instanceID int, d *schema.ResourceData) resource.StateRefreshFunc {
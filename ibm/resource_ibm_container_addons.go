@@ -5,10 +5,12 @@ package ibm
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -19,12 +21,11 @@ import (
 
 func resourceIBMContainerAddOns() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerAddOnsCreate,
-		Read:     resourceIBMContainerAddOnsRead,
-		Update:   resourceIBMContainerAddOnsUpdate,
-		Delete:   resourceIBMContainerAddOnsDelete,
-		Exists:   resourceIBMContainerAddOnsExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerAddOnsCreate,
+		ReadContext:   resourceIBMContainerAddOnsRead,
+		UpdateContext: resourceIBMContainerAddOnsUpdate,
+		DeleteContext: resourceIBMContainerAddOnsDelete,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
 			Update: schema.DefaultTimeout(20 * time.Minute),
@@ -113,16 +114,16 @@ func resourceIBMContainerAddOns() *schema.Resource {
 		},
 	}
 }
-func resourceIBMContainerAddOnsCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerAddOnsCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	addOnAPI := csClient.AddOns()
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cluster := d.Get("cluster").(string)
 	existingAddons, err := addOnAPI.GetAddons(cluster, targetEnv)
@@ -130,25 +131,24 @@ func resourceIBMContainerAddOnsCreate(d *schema.ResourceData, meta interface{})
 		fmt.Println("[ WARN ] Error getting Addons.")
 	}
 
-	payload, err := expandAddOns(d, meta, cluster, targetEnv, existingAddons)
+	payload, err := expandAddOns(context, d, meta, cluster, targetEnv, existingAddons)
 	if err != nil {
-		return fmt.Errorf("Error in getting addons from expandAddOns %s", err)
+		return diag.FromErr(fmt.Errorf("Error in getting addons from expandAddOns %s", err))
 	}
 	payload.Enable = true
 	_, err = addOnAPI.ConfigureAddons(cluster, &payload, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	_, err = waitForContainerAddOns(d, meta, cluster, schema.TimeoutCreate)
+	_, err = waitForContainerAddOns(context, d, meta, cluster, schema.TimeoutCreate)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for Enabling Addon (%s) : %s", d.Id(), err)
+		return diag.FromErr(apiErrorf("container", err, nil, "Error waiting for Enabling Addon (%s)", d.Id()))
 	}
 	d.SetId(cluster)
 
-	return resourceIBMContainerAddOnsRead(d, meta)
+	return resourceIBMContainerAddOnsRead(context, d, meta)
 }
-func expandAddOns(d *schema.ResourceData, meta interface{}, cluster string, targetEnv v1.ClusterTargetHeader, existingAddons []v1.AddOn) (addOns v1.ConfigureAddOns, err error) {
+func expandAddOns(context context.Context, d *schema.ResourceData, meta interface{}, cluster string, targetEnv v1.ClusterTargetHeader, existingAddons []v1.AddOn) (addOns v1.ConfigureAddOns, err error) {
 	addOnSet := d.Get("addons").(*schema.Set).List()
 	if existingAddons == nil || len(existingAddons) < 1 {
 		for _, aoSet := range addOnSet {
@@ -170,7 +170,7 @@ func expandAddOns(d *schema.ResourceData, meta interface{}, cluster string, targ
 				if existAddon.Name == ao["name"].(string) {
 					exist = true
 					if existAddon.Version != ao["version"].(string) {
-						err := updateAddOnVersion(d, meta, ao, cluster, targetEnv)
+						err := updateAddOnVersion(context, d, meta, ao, cluster, targetEnv)
 						if err != nil {
 							return addOns, err
 						}
@@ -191,7 +191,7 @@ func expandAddOns(d *schema.ResourceData, meta interface{}, cluster string, targ
 
 	return addOns, nil
 }
-func updateAddOnVersion(d *schema.ResourceData, meta interface{}, u map[string]interface{}, cluster string, targetEnv v1.ClusterTargetHeader) error {
+func updateAddOnVersion(context context.Context, d *schema.ResourceData, meta interface{}, u map[string]interface{}, cluster string, targetEnv v1.ClusterTargetHeader) error {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
 		return err
@@ -212,31 +212,39 @@ func updateAddOnVersion(d *schema.ResourceData, meta interface{}, u map[string]i
 		return err
 	}
 	if !d.IsNewResource() {
-		_, err = waitForContainerAddOns(d, meta, cluster, schema.TimeoutUpdate)
+		_, err = waitForContainerAddOns(context, d, meta, cluster, schema.TimeoutUpdate)
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for Updating Addon (%s) : %s", d.Id(), err)
+			return apiErrorf("container", err, nil, "Error waiting for Updating Addon (%s)", d.Id())
 		}
 	}
 
 	return nil
 }
-func resourceIBMContainerAddOnsRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerAddOnsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMContainerAddOnsExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	addOnAPI := csClient.AddOns()
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cluster := d.Id()
 
 	result, err := addOnAPI.GetAddons(cluster, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set("cluster", cluster)
 	addOns, err := flattenAddOns(result)
@@ -285,16 +293,16 @@ func flattenAddOns(result []v1.AddOn) (resp *schema.Set, err error) {
 
 	return schema.NewSet(resourceIBMContainerAddonsHash, addOns), nil
 }
-func resourceIBMContainerAddOnsUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerAddOnsUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	addOnAPI := csClient.AddOns()
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cluster := d.Id()
 
@@ -313,9 +321,9 @@ func resourceIBMContainerAddOnsUpdate(d *schema.ResourceData, meta interface{})
 			for _, oA := range os.List() {
 				oldPack := oA.(map[string]interface{})
 				if (strings.Compare(newPack["name"].(string), oldPack["name"].(string)) == 0) && (strings.Compare(newPack["version"].(string), oldPack["version"].(string)) != 0) && (newPack["version"].(string) != "") {
-					err := updateAddOnVersion(d, meta, newPack, cluster, targetEnv)
+					err := updateAddOnVersion(context, d, meta, newPack, cluster, targetEnv)
 					if err != nil {
-						return err
+						return diag.FromErr(err)
 					}
 					ns.Remove(nA)
 					os.Remove(oA)
@@ -341,12 +349,11 @@ func resourceIBMContainerAddOnsUpdate(d *schema.ResourceData, meta interface{})
 			addOnParams.Enable = true
 			_, err = addOnAPI.ConfigureAddons(cluster, &addOnParams, targetEnv)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
-			_, err = waitForContainerAddOns(d, meta, cluster, schema.TimeoutCreate)
+			_, err = waitForContainerAddOns(context, d, meta, cluster, schema.TimeoutCreate)
 			if err != nil {
-				return fmt.Errorf(
-					"Error waiting for Enabling Addon (%s) : %s", d.Id(), err)
+				return diag.FromErr(apiErrorf("container", err, nil, "Error waiting for Enabling Addon (%s)", d.Id()))
 			}
 		}
 		if len(remove) > 0 {
@@ -364,39 +371,39 @@ func resourceIBMContainerAddOnsUpdate(d *schema.ResourceData, meta interface{})
 			addOnParams.Enable = false
 			_, err = addOnAPI.ConfigureAddons(cluster, &addOnParams, targetEnv)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 
-	return resourceIBMContainerAddOnsRead(d, meta)
+	return resourceIBMContainerAddOnsRead(context, d, meta)
 }
-func resourceIBMContainerAddOnsDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerAddOnsDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	addOnAPI := csClient.AddOns()
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cluster := d.Id()
-	payload, err := expandAddOns(d, meta, cluster, targetEnv, nil)
+	payload, err := expandAddOns(context, d, meta, cluster, targetEnv, nil)
 	if err != nil {
-		return fmt.Errorf("Error in getting addons from expandAddOns %s", err)
+		return diag.FromErr(fmt.Errorf("Error in getting addons from expandAddOns %s", err))
 	}
 
 	payload.Enable = false
 	_, err = addOnAPI.ConfigureAddons(cluster, &payload, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
-func waitForContainerAddOns(d *schema.ResourceData, meta interface{}, cluster, timeout string) (interface{}, error) {
+func waitForContainerAddOns(context context.Context, d *schema.ResourceData, meta interface{}, cluster, timeout string) (interface{}, error) {
 	addOnClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
 		return false, err
@@ -429,7 +436,7 @@ func waitForContainerAddOns(d *schema.ResourceData, meta interface{}, cluster, t
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 func resourceIBMContainerAddOnsExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 
@@ -452,7 +459,7 @@ func resourceIBMContainerAddOnsExists(d *schema.ResourceData, meta interface{})
 				return false, nil
 			}
 		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
+		return false, apiErrorf("container", err, nil, "Error communicating with the API")
 	}
 
 	return true, nil
@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -17,11 +19,11 @@ import (
 
 func resourceIBMContainerALB() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerALBCreate,
-		Read:     resourceIBMContainerALBRead,
-		Update:   resourceIBMContainerALBUpdate,
-		Delete:   resourceIBMContainerALBDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerALBCreate,
+		ReadContext:   resourceIBMContainerALBRead,
+		UpdateContext: resourceIBMContainerALBUpdate,
+		DeleteContext: resourceIBMContainerALBDelete,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Update: schema.DefaultTimeout(60 * time.Minute),
@@ -85,10 +87,10 @@ func resourceIBMContainerALB() *schema.Resource {
 	}
 }
 
-func resourceIBMContainerALBCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerALBCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	albClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	var userIP string
 	var enable, disableDeployment bool
@@ -98,7 +100,7 @@ func resourceIBMContainerALBCreate(d *schema.ResourceData, meta interface{}) err
 	} else if v, ok := d.GetOkExists("disable_deployment"); ok {
 		disableDeployment = v.(bool)
 	} else {
-		return fmt.Errorf("Provide either `enable` or `disable_deployment`")
+		return diag.FromErr(fmt.Errorf("Provide either `enable` or `disable_deployment`"))
 	}
 
 	numOfInstances := "2"
@@ -114,35 +116,33 @@ func resourceIBMContainerALBCreate(d *schema.ResourceData, meta interface{}) err
 		params.ALBIP = userIP
 	}
 
-	_, err = waitForClusterAvailable(d, meta, albID)
+	_, err = waitForClusterAvailable(context, d, meta, albID)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for cluster resources availabilty (%s) : %s", d.Id(), err)
+		return diag.FromErr(apiErrorf("container", err, nil, "Error waiting for cluster resources availabilty (%s)", d.Id()))
 	}
 
 	albAPI := albClient.Albs()
 	targetEnv, err := getAlbTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = albAPI.ConfigureALB(albID, params, disableDeployment, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(albID)
-	_, err = waitForContainerALB(d, meta, albID, schema.TimeoutCreate, enable, disableDeployment)
+	_, err = waitForContainerALB(context, d, meta, albID, schema.TimeoutCreate, enable, disableDeployment)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for create resource alb (%s) : %s", d.Id(), err)
+		return diag.FromErr(apiErrorf("container", err, nil, "Error waiting for create resource alb (%s)", d.Id()))
 	}
 
-	return resourceIBMContainerALBRead(d, meta)
+	return resourceIBMContainerALBRead(context, d, meta)
 }
 
-func resourceIBMContainerALBRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerALBRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	albClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	albID := d.Id()
@@ -150,11 +150,11 @@ func resourceIBMContainerALBRead(d *schema.ResourceData, meta interface{}) error
 	albAPI := albClient.Albs()
 	targetEnv, err := getAlbTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	albConfig, err := albAPI.GetALB(albID, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("alb_type", albConfig.ALBType)
@@ -169,10 +169,10 @@ func resourceIBMContainerALBRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMContainerALBUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerALBUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	albClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	albAPI := albClient.Albs()
 
@@ -187,30 +187,28 @@ func resourceIBMContainerALBUpdate(d *schema.ResourceData, meta interface{}) err
 
 		targetEnv, err := getAlbTargetHeader(d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		_, err = waitForClusterAvailable(d, meta, albID)
+		_, err = waitForClusterAvailable(context, d, meta, albID)
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for cluster resources availabilty (%s) : %s", d.Id(), err)
+			return diag.FromErr(apiErrorf("container", err, nil, "Error waiting for cluster resources availabilty (%s)", d.Id()))
 		}
 
 		err = albAPI.ConfigureALB(albID, params, disableDeployment, targetEnv)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
-		_, err = waitForContainerALB(d, meta, albID, schema.TimeoutUpdate, enable, disableDeployment)
+		_, err = waitForContainerALB(context, d, meta, albID, schema.TimeoutUpdate, enable, disableDeployment)
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for updating resource alb (%s) : %s", d.Id(), err)
+			return diag.FromErr(apiErrorf("container", err, nil, "Error waiting for updating resource alb (%s)", d.Id()))
 		}
 
 	}
-	return resourceIBMContainerALBRead(d, meta)
+	return resourceIBMContainerALBRead(context, d, meta)
 }
 
-func waitForContainerALB(d *schema.ResourceData, meta interface{}, albID, timeout string, enable, disableDeployment bool) (interface{}, error) {
+func waitForContainerALB(context context.Context, d *schema.ResourceData, meta interface{}, albID, timeout string, enable, disableDeployment bool) (interface{}, error) {
 	albClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
 		return false, err
@@ -247,17 +245,17 @@ func waitForContainerALB(d *schema.ResourceData, meta interface{}, albID, timeou
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
-func resourceIBMContainerALBDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerALBDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	d.SetId("")
 
 	return nil
 }
 
 // WaitForWorkerAvailable Waits for worker creation
-func waitForClusterAvailable(d *schema.ResourceData, meta interface{}, albID string) (interface{}, error) {
+func waitForClusterAvailable(context context.Context, d *schema.ResourceData, meta interface{}, albID string) (interface{}, error) {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -286,7 +284,7 @@ func waitForClusterAvailable(d *schema.ResourceData, meta interface{}, albID str
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 func getAlbTargetHeader(d *schema.ResourceData, meta interface{}) (v1.ClusterTargetHeader, error) {
 	var region string
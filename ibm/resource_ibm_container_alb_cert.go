@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -17,12 +19,11 @@ import (
 
 func resourceIBMContainerALBCert() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerALBCertCreate,
-		Read:     resourceIBMContainerALBCertRead,
-		Update:   resourceIBMContainerALBCertUpdate,
-		Delete:   resourceIBMContainerALBCertDelete,
-		Exists:   resourceIBMContainerALBCertExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerALBCertCreate,
+		ReadContext:   resourceIBMContainerALBCertRead,
+		UpdateContext: resourceIBMContainerALBCertUpdate,
+		DeleteContext: resourceIBMContainerALBCertDelete,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -97,10 +98,10 @@ func resourceIBMContainerALBCert() *schema.Resource {
 	}
 }
 
-func resourceIBMContainerALBCertCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerALBCertCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	ingressClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	certCRN := d.Get("cert_crn").(string)
@@ -123,26 +124,34 @@ func resourceIBMContainerALBCertCreate(d *schema.ResourceData, meta interface{})
 	response, err := ingressAPI.CreateIngressSecret(params)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%s/%s/%s", cluster, secretName, response.Namespace))
-	_, err = waitForContainerALBCert(d, meta, schema.TimeoutCreate)
+	_, err = waitForContainerALBCert(context, d, meta, schema.TimeoutCreate)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for create resource alb cert (%s) : %s", d.Id(), err)
+		return diag.FromErr(apiErrorf("container", err, nil, "Error waiting for create resource alb cert (%s)", d.Id()))
 	}
 
-	return resourceIBMContainerALBCertRead(d, meta)
+	return resourceIBMContainerALBCertRead(context, d, meta)
 }
 
-func resourceIBMContainerALBCertRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerALBCertRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMContainerALBCertExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	ingressClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	clusterID := parts[0]
 	secretName := parts[1]
@@ -154,7 +163,7 @@ func resourceIBMContainerALBCertRead(d *schema.ResourceData, meta interface{}) e
 	ingressAPI := ingressClient.Ingresses()
 	ingressSecretConfig, err := ingressAPI.GetIngressSecret(clusterID, secretName, namespace)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%s/%s/%s", clusterID, secretName, namespace))
 	d.Set("cluster_id", ingressSecretConfig.Cluster)
@@ -171,17 +180,17 @@ func resourceIBMContainerALBCertRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceIBMContainerALBCertDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerALBCertDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	ingressClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ingressAPI := ingressClient.Ingresses()
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	clusterID := parts[0]
 	secretName := parts[1]
@@ -197,17 +206,17 @@ func resourceIBMContainerALBCertDelete(d *schema.ResourceData, meta interface{})
 
 	err = ingressAPI.DeleteIngressSecret(params)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	_, albCertDeletionError := waitForALBCertDelete(d, meta, schema.TimeoutDelete)
+	_, albCertDeletionError := waitForALBCertDelete(context, d, meta, schema.TimeoutDelete)
 	if albCertDeletionError != nil {
-		return albCertDeletionError
+		return diag.FromErr(albCertDeletionError)
 	}
 	d.SetId("")
 	return nil
 }
 
-func waitForALBCertDelete(d *schema.ResourceData, meta interface{}, timeout string) (interface{}, error) {
+func waitForALBCertDelete(context context.Context, d *schema.ResourceData, meta interface{}, timeout string) (interface{}, error) {
 	ingressClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
 		return false, err
@@ -245,17 +254,17 @@ func waitForALBCertDelete(d *schema.ResourceData, meta interface{}, timeout stri
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
-func resourceIBMContainerALBCertUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerALBCertUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	ingressClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cluster := parts[0]
 	secretName := parts[1]
@@ -277,16 +286,15 @@ func resourceIBMContainerALBCertUpdate(d *schema.ResourceData, meta interface{})
 		ingressAPI := ingressClient.Ingresses()
 		_, err = ingressAPI.UpdateIngressSecret(params)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		_, err = waitForContainerALBCert(d, meta, schema.TimeoutUpdate)
+		_, err = waitForContainerALBCert(context, d, meta, schema.TimeoutUpdate)
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for updating resource alb cert (%s) : %s", d.Id(), err)
+			return diag.FromErr(apiErrorf("container", err, nil, "Error waiting for updating resource alb cert (%s)", d.Id()))
 		}
 	}
-	return resourceIBMContainerALBCertRead(d, meta)
+	return resourceIBMContainerALBCertRead(context, d, meta)
 }
 
 func resourceIBMContainerALBCertExists(d *schema.ResourceData, meta interface{}) (bool, error) {
@@ -315,13 +323,13 @@ func resourceIBMContainerALBCertExists(d *schema.ResourceData, meta interface{})
 				return false, nil
 			}
 		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
+		return false, apiErrorf("container", err, nil, "Error communicating with the API")
 	}
 
 	return ingressSecretConfig.Cluster == clusterID && ingressSecretConfig.Name == secretName, nil
 }
 
-func waitForContainerALBCert(d *schema.ResourceData, meta interface{}, timeout string) (interface{}, error) {
+func waitForContainerALBCert(context context.Context, d *schema.ResourceData, meta interface{}, timeout string) (interface{}, error) {
 	ingressClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
 		return false, err
@@ -366,5 +374,5 @@ func waitForContainerALBCert(d *schema.ResourceData, meta interface{}, timeout s
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
@@ -4,17 +4,19 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMContainerAPIKeyReset() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMContainerAPIKeyResetUpdate,
-		Read:   resourceIBMContainerAPIKeyResetRead,
-		Update: resourceIBMContainerAPIKeyResetUpdate,
-		Delete: resourceIBMContainerAPIKeyResetdelete,
+		CreateContext: resourceIBMContainerAPIKeyResetUpdate,
+		ReadContext:   resourceIBMContainerAPIKeyResetRead,
+		UpdateContext: resourceIBMContainerAPIKeyResetUpdate,
+		DeleteContext: resourceIBMContainerAPIKeyResetdelete,
 
 		Schema: map[string]*schema.Schema{
 			"region": {
@@ -39,28 +41,28 @@ func resourceIBMContainerAPIKeyReset() *schema.Resource {
 	}
 }
 
-func resourceIBMContainerAPIKeyResetUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerAPIKeyResetUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	if d.HasChange("reset_api_key") {
 		apikeyClient, err := meta.(ClientSession).ContainerAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		apikeyAPI := apikeyClient.Apikeys()
 		region := d.Get("region").(string)
 		targetEnv, err := getClusterTargetHeader(d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		targetEnv.Region = region
 		err = apikeyAPI.ResetApiKey(targetEnv)
 		if err != nil {
-			return err
+			return diag.FromErr(apiErrorf("container", err, nil, "Error resetting the API key of region %s", region))
 		}
 		if targetEnv.ResourceGroup == "" {
 			defaultRg, err := defaultResourceGroup(meta)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			targetEnv.ResourceGroup = defaultRg
 		}
@@ -70,10 +72,10 @@ func resourceIBMContainerAPIKeyResetUpdate(d *schema.ResourceData, meta interfac
 
 	return nil
 }
-func resourceIBMContainerAPIKeyResetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerAPIKeyResetRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return nil
 }
-func resourceIBMContainerAPIKeyResetdelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerAPIKeyResetdelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	d.SetId("")
 	return nil
 }
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -49,12 +50,11 @@ const PUBLIC_SUBNET_TYPE = "public"
 
 func resourceIBMContainerCluster() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerClusterCreate,
-		Read:     resourceIBMContainerClusterRead,
-		Update:   resourceIBMContainerClusterUpdate,
-		Delete:   resourceIBMContainerClusterDelete,
-		Exists:   resourceIBMContainerClusterExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerClusterCreate,
+		ReadContext:   resourceIBMContainerClusterRead,
+		UpdateContext: resourceIBMContainerClusterUpdate,
+		DeleteContext: resourceIBMContainerClusterDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
@@ -595,11 +595,11 @@ func resourceIBMContainerClusterValidator() *ResourceValidator {
 	return &ibmContainerClusterResourceValidator
 }
 
-func resourceIBMContainerClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerClusterCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	name := d.Get("name").(string)
@@ -648,10 +648,10 @@ func resourceIBMContainerClusterCreate(d *schema.ResourceData, meta interface{})
 				params.PrivateEndpointEnabled = v.(bool)
 				params.GatewayEnabled = gatewayEnabled
 			} else {
-				return fmt.Errorf("set private_service_endpoint to true for gateway_enabled clusters")
+				return diag.FromErr(fmt.Errorf("set private_service_endpoint to true for gateway_enabled clusters"))
 			}
 		} else {
-			return fmt.Errorf("set private_service_endpoint to true for gateway_enabled clusters")
+			return diag.FromErr(fmt.Errorf("set private_service_endpoint to true for gateway_enabled clusters"))
 		}
 	}
 	if v, ok := d.GetOk("kube_version"); ok {
@@ -666,34 +666,43 @@ func resourceIBMContainerClusterCreate(d *schema.ResourceData, meta interface{})
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	cls, err := csClient.Clusters().Create(params, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(cls.ID)
 
-	_, err = waitForClusterMasterAvailable(d, meta)
+	_, err = waitForClusterMasterAvailable(context, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if d.Get("wait_till").(string) == oneWorkerNodeReady {
-		_, err = waitForClusterOneWorkerAvailable(d, meta)
+		_, err = waitForClusterOneWorkerAvailable(context, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	d.Set("force_delete_storage", d.Get("force_delete_storage").(bool))
 
-	return resourceIBMContainerClusterUpdate(d, meta)
+	return resourceIBMContainerClusterUpdate(context, d, meta)
 }
 
-func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerClusterRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMContainerClusterExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	wrkAPI := csClient.Workers()
 	workerPoolsAPI := csClient.WorkerPools()
@@ -701,18 +710,18 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterID := d.Id()
 	cls, err := csClient.Clusters().Find(clusterID, targetEnv)
 	if err != nil {
-		return fmt.Errorf("Error retrieving armada cluster: %s", err)
+		return diag.FromErr(apiErrorf("container", err, nil, "Error retrieving armada cluster"))
 	}
 
 	workerFields, err := wrkAPI.List(clusterID, targetEnv)
 	if err != nil {
-		return fmt.Errorf("Error retrieving workers for cluster: %s", err)
+		return diag.FromErr(apiErrorf("container", err, nil, "Error retrieving workers for cluster"))
 	}
 	workerCount := 0
 	workers := []map[string]string{}
@@ -732,7 +741,7 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 
 	workerPools, err := workerPoolsAPI.ListWorkerPools(clusterID, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	var poolName string
 	var poolContains bool
@@ -747,14 +756,14 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 	if poolContains {
 		workersByPool, err := wrkAPI.ListByWorkerPool(clusterID, poolName, false, targetEnv)
 		if err != nil {
-			return fmt.Errorf("Error retrieving workers of default worker pool for cluster: %s", err)
+			return diag.FromErr(apiErrorf("container", err, nil, "Error retrieving workers of default worker pool for cluster"))
 		}
 
 		// to get the private and public vlan IDs of the gateway enabled cluster.
 		if poolName == computeWorkerPool {
 			gatewayWorkersByPool, err := wrkAPI.ListByWorkerPool(clusterID, gatewayWorkerpool, false, targetEnv)
 			if err != nil {
-				return fmt.Errorf("Error retrieving workers of default worker pool for cluster: %s", err)
+				return diag.FromErr(apiErrorf("container", err, nil, "Error retrieving workers of default worker pool for cluster"))
 			}
 			d.Set("public_vlan_id", gatewayWorkersByPool[0].PublicVlan)
 			d.Set("private_vlan_id", gatewayWorkersByPool[0].PrivateVlan)
@@ -787,7 +796,7 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 
 		defaultWorkerPool, err := workerPoolsAPI.GetWorkerPool(clusterID, poolName, targetEnv)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		d.Set("labels", IgnoreSystemLabels(defaultWorkerPool.Labels))
 		zones := defaultWorkerPool.Zones
@@ -803,7 +812,7 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 	albs, err := albsAPI.ListClusterALBs(clusterID, targetEnv)
 	if err != nil && !strings.Contains(err.Error(), "The specified cluster is a lite cluster.") && !strings.Contains(err.Error(), "This operation is not supported for your cluster's version.") && !strings.Contains(err.Error(), "The specified cluster is a free cluster.") {
 
-		return fmt.Errorf("Error retrieving alb's of the cluster %s: %s", clusterID, err)
+		return diag.FromErr(apiErrorf("container", err, nil, "Error retrieving alb's of the cluster %s", clusterID))
 	}
 
 	d.Set("name", cls.Name)
@@ -835,7 +844,7 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("tags", tags)
 	controller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(ResourceControllerURL, controller+"/kubernetes/clusters")
 	d.Set(ResourceName, cls.Name)
@@ -845,16 +854,17 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerClusterUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	subnetAPI := csClient.Subnets()
@@ -878,12 +888,11 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 			}
 			err := clusterAPI.Update(clusterID, params, targetEnv)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
-			_, err = WaitForClusterVersionUpdate(d, meta, targetEnv)
+			_, err = WaitForClusterVersionUpdate(context, d, meta, targetEnv)
 			if err != nil {
-				return fmt.Errorf(
-					"Error waiting for cluster (%s) version to be updated: %s", d.Id(), err)
+				return diag.FromErr(apiErrorf("container", err, nil, "Error waiting for cluster (%s) version to be updated", d.Id()))
 			}
 		}
 		// "update_all_workers" deafult is false, enable to true when all worker nodes to be updated
@@ -893,11 +902,11 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 			patchVersion := d.Get("patch_version").(string)
 			workerFields, err := wrkAPI.List(clusterID, targetEnv)
 			if err != nil {
-				return fmt.Errorf("Error retrieving workers for cluster: %s", err)
+				return diag.FromErr(apiErrorf("container", err, nil, "Error retrieving workers for cluster"))
 			}
 			cluster, err := clusterAPI.Find(clusterID, targetEnv)
 			if err != nil {
-				return fmt.Errorf("Error retrieving cluster %s: %s", clusterID, err)
+				return diag.FromErr(apiErrorf("container", err, nil, "Error retrieving cluster %s", clusterID))
 			}
 
 			waitForWorkerUpdate := d.Get("wait_for_worker_update").(bool)
@@ -914,14 +923,13 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 					err = wrkAPI.Update(clusterID, w.ID, params, targetEnv)
 					if err != nil {
 						d.Set("patch_version", nil)
-						return fmt.Errorf("Error updating worker %s: %s", w.ID, err)
+						return diag.FromErr(apiErrorf("container", err, nil, "Error updating worker %s", w.ID))
 					}
 					if waitForWorkerUpdate {
-						_, err = WaitForWorkerAvailable(d, meta, targetEnv)
+						_, err = WaitForWorkerAvailable(context, d, meta, targetEnv)
 						if err != nil {
 							d.Set("patch_version", nil)
-							return fmt.Errorf(
-								"Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err)
+							return diag.FromErr(apiErrorf("container", err, nil, "Error waiting for workers of cluster (%s) to become ready", d.Id()))
 						}
 					}
 				}
@@ -960,7 +968,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		if err != nil {
 			log.Printf(
 				"An error occured during EnableKms (cluster: %s) error: %s", d.Id(), err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -976,7 +984,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		workerPoolsAPI := csClient.WorkerPools()
 		workerPools, err := workerPoolsAPI.ListWorkerPools(clusterID, targetEnv)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		var poolName string
 		var poolContains bool
@@ -993,18 +1001,16 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 			poolSize := d.Get("default_pool_size").(int)
 			err = workerPoolsAPI.ResizeWorkerPool(clusterID, poolName, poolSize, targetEnv)
 			if err != nil {
-				return fmt.Errorf(
-					"Error updating the default_pool_size %d: %s", poolSize, err)
+				return diag.FromErr(apiErrorf("container", err, nil, "Error updating the default_pool_size %d", poolSize))
 			}
 
-			_, err = WaitForWorkerAvailable(d, meta, targetEnv)
+			_, err = WaitForWorkerAvailable(context, d, meta, targetEnv)
 			if err != nil {
-				return fmt.Errorf(
-					"Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err)
+				return diag.FromErr(apiErrorf("container", err, nil, "Error waiting for workers of cluster (%s) to become ready", d.Id()))
 			}
 		} else {
-			return fmt.Errorf(
-				"The default worker pool does not exist. Use ibm_container_worker_pool and ibm_container_worker_pool_zone attachment resources to make changes to your cluster, such as adding zones, adding worker nodes, or updating worker nodes..")
+			return diag.FromErr(fmt.Errorf(
+				"The default worker pool does not exist. Use ibm_container_worker_pool and ibm_container_worker_pool_zone attachment resources to make changes to your cluster, such as adding zones, adding worker nodes, or updating worker nodes.."))
 		}
 	}
 
@@ -1012,7 +1018,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		workerPoolsAPI := csClient.WorkerPools()
 		workerPools, err := workerPoolsAPI.ListWorkerPools(clusterID, targetEnv)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		var poolName string
 		var poolContains bool
@@ -1033,18 +1039,16 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 			}
 			err = workerPoolsAPI.UpdateLabelsWorkerPool(clusterID, poolName, labels, targetEnv)
 			if err != nil {
-				return fmt.Errorf(
-					"Error updating the labels %s", err)
+				return diag.FromErr(apiErrorf("container", err, nil, "Error updating the labels"))
 			}
 
-			_, err = WaitForWorkerAvailable(d, meta, targetEnv)
+			_, err = WaitForWorkerAvailable(context, d, meta, targetEnv)
 			if err != nil {
-				return fmt.Errorf(
-					"Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err)
+				return diag.FromErr(apiErrorf("container", err, nil, "Error waiting for workers of cluster (%s) to become ready", d.Id()))
 			}
 		} else {
-			return fmt.Errorf(
-				"The default worker pool does not exist. Use ibm_container_worker_pool and ibm_container_worker_pool_zone attachment resources to make changes to your cluster, such as adding zones, adding worker nodes, or updating worker nodes..")
+			return diag.FromErr(fmt.Errorf(
+				"The default worker pool does not exist. Use ibm_container_worker_pool and ibm_container_worker_pool_zone attachment resources to make changes to your cluster, such as adding zones, adding worker nodes, or updating worker nodes.."))
 		}
 	}
 
@@ -1076,21 +1080,19 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 			count := oldCount - newCount
 			workerFields, err := wrkAPI.List(clusterID, targetEnv)
 			if err != nil {
-				return fmt.Errorf("Error retrieving workers for cluster: %s", err)
+				return diag.FromErr(apiErrorf("container", err, nil, "Error retrieving workers for cluster"))
 			}
 			for i := 0; i < count; i++ {
 				err := wrkAPI.Delete(clusterID, workerFields[i].ID, targetEnv)
 				if err != nil {
-					return fmt.Errorf(
-						"Error deleting workers of cluster (%s): %s", d.Id(), err)
+					return diag.FromErr(apiErrorf("container", err, nil, "Error deleting workers of cluster (%s)", d.Id()))
 				}
 			}
 		}
 
-		_, err = WaitForWorkerAvailable(d, meta, targetEnv)
+		_, err = WaitForWorkerAvailable(context, d, meta, targetEnv)
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err)
+			return diag.FromErr(apiErrorf("container", err, nil, "Error waiting for workers of cluster (%s) to become ready", d.Id()))
 		}
 	}
 
@@ -1105,23 +1107,22 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 				if strings.Compare(newPack["version"].(string), oldPack["version"].(string)) != 0 {
 					cluster, err := clusterAPI.Find(clusterID, targetEnv)
 					if err != nil {
-						return fmt.Errorf("Error retrieving cluster %s: %s", clusterID, err)
+						return diag.FromErr(apiErrorf("container", err, nil, "Error retrieving cluster %s", clusterID))
 					}
 					if newPack["version"].(string) != strings.Split(cluster.MasterKubeVersion, "_")[0] {
-						return fmt.Errorf("Worker version %s should match the master kube version %s", newPack["version"].(string), strings.Split(cluster.MasterKubeVersion, "_")[0])
+						return diag.FromErr(fmt.Errorf("Worker version %s should match the master kube version %s", newPack["version"].(string), strings.Split(cluster.MasterKubeVersion, "_")[0]))
 					}
 					params := v1.WorkerUpdateParam{
 						Action: "update",
 					}
 					err = wrkAPI.Update(clusterID, oldPack["id"].(string), params, targetEnv)
 					if err != nil {
-						return fmt.Errorf("Error updating worker %s: %s", oldPack["id"].(string), err)
+						return diag.FromErr(apiErrorf("container", err, nil, "Error updating worker %s", oldPack["id"].(string)))
 					}
 
-					_, err = WaitForWorkerAvailable(d, meta, targetEnv)
+					_, err = WaitForWorkerAvailable(context, d, meta, targetEnv)
 					if err != nil {
-						return fmt.Errorf(
-							"Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err)
+						return diag.FromErr(apiErrorf("container", err, nil, "Error waiting for workers of cluster (%s) to become ready", d.Id()))
 					}
 				}
 			}
@@ -1167,14 +1168,14 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		newSubnet := newSubnets.(*schema.Set)
 		rem := oldSubnet.Difference(newSubnet).List()
 		if len(rem) > 0 {
-			return fmt.Errorf("Subnet(s) %v cannot be deleted", rem)
+			return diag.FromErr(fmt.Errorf("Subnet(s) %v cannot be deleted", rem))
 		}
 		metro := d.Get("datacenter").(string)
 		//from datacenter retrive the metro for filtering the subnets
 		metro = metro[0:3]
 		subnets, err := subnetAPI.List(targetEnv, metro)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		for _, nS := range newSubnet.List() {
 			exists := false
@@ -1186,7 +1187,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 			if !exists {
 				err := subnetAPI.AddSubnet(clusterID, nS.(string), targetEnv)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 				subnet := getSubnet(subnets, nS.(string))
 				if subnet.Type == PUBLIC_SUBNET_TYPE {
@@ -1196,10 +1197,9 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 	if publicSubnetAdded && d.Get("wait_till").(string) == ingressReady {
-		_, err = WaitForSubnetAvailable(d, meta, targetEnv)
+		_, err = WaitForSubnetAvailable(context, d, meta, targetEnv)
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for initializing ingress hostname and secret: %s", err)
+			return diag.FromErr(apiErrorf("container", err, nil, "Error waiting for initializing ingress hostname and secret"))
 		}
 	}
 
@@ -1208,17 +1208,18 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		oldList, newList := d.GetChange("tags")
 		cluster, err := clusterAPI.Find(clusterID, targetEnv)
 		if err != nil {
-			return fmt.Errorf("Error retrieving cluster %s: %s", clusterID, err)
+			return diag.FromErr(apiErrorf("container", err, nil, "Error retrieving cluster %s", clusterID))
 		}
 		err = UpdateTagsUsingCRN(oldList, newList, meta, cluster.CRN)
 		if err != nil {
 			log.Printf(
 				"An error occured during update of instance (%s) tags: %s", clusterID, err)
+			diags = append(diags, tagsWarning(err))
 		}
 
 	}
 
-	return resourceIBMContainerClusterRead(d, meta)
+	return append(diags, resourceIBMContainerClusterRead(context, d, meta)...)
 }
 
 func getID(d *schema.ResourceData, meta interface{}, clusterID string, oldWorkers []interface{}, workerInfo []map[string]string) (string, error) {
@@ -1256,30 +1257,30 @@ func getID(d *schema.ResourceData, meta interface{}, clusterID string, oldWorker
 	return "", fmt.Errorf("Unable to get ID of worker")
 }
 
-func resourceIBMContainerClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerClusterDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	clusterID := d.Id()
 	forceDeleteStorage := d.Get("force_delete_storage").(bool)
 	err = csClient.Clusters().Delete(clusterID, targetEnv, forceDeleteStorage)
 	if err != nil {
-		return fmt.Errorf("Error deleting cluster: %s", err)
+		return diag.FromErr(apiErrorf("container", err, nil, "Error deleting cluster"))
 	}
-	_, err = waitForClusterDelete(d, meta)
+	_, err = waitForClusterDelete(context, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func waitForClusterDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForClusterDelete(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
 		return nil, err
@@ -1308,11 +1309,11 @@ func waitForClusterDelete(d *schema.ResourceData, meta interface{}) (interface{}
 		PollInterval: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 // WaitForClusterAvailable Waits for cluster creation
-func WaitForClusterAvailable(d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForClusterAvailable(context context.Context, d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -1329,14 +1330,14 @@ func WaitForClusterAvailable(d *schema.ResourceData, meta interface{}, target v1
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func clusterStateRefreshFunc(client v1.Clusters, instanceID string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		clusterFields, err := client.FindWithOutShowResourcesCompatible(instanceID, target)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error retrieving cluster: %s", err)
+			return nil, "", apiErrorf("container", err, nil, "Error retrieving cluster")
 		}
 		// Check active transactions
 		log.Println("Checking cluster")
@@ -1350,7 +1351,7 @@ func clusterStateRefreshFunc(client v1.Clusters, instanceID string, target v1.Cl
 }
 
 // waitForClusterMasterAvailable Waits for cluster creation
-func waitForClusterMasterAvailable(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForClusterMasterAvailable(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
 		return nil, err
@@ -1367,7 +1368,7 @@ func waitForClusterMasterAvailable(d *schema.ResourceData, meta interface{}) (in
 		Refresh: func() (interface{}, string, error) {
 			clusterFields, err := csClient.Clusters().FindWithOutShowResourcesCompatible(clusterID, targetEnv)
 			if err != nil {
-				return nil, "", fmt.Errorf("Error retrieving cluster: %s", err)
+				return nil, "", apiErrorf("container", err, nil, "Error retrieving cluster")
 			}
 
 			if clusterFields.MasterStatus == ready {
@@ -1380,11 +1381,11 @@ func waitForClusterMasterAvailable(d *schema.ResourceData, meta interface{}) (in
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 // waitForClusterOneWorkerAvailable Waits for cluster creation
-func waitForClusterOneWorkerAvailable(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForClusterOneWorkerAvailable(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
 		return nil, err
@@ -1419,7 +1420,7 @@ func waitForClusterOneWorkerAvailable(d *schema.ResourceData, meta interface{})
 				wrkAPI := csClient.Workers()
 				workersByPool, err := wrkAPI.ListByWorkerPool(clusterID, poolName, false, targetEnv)
 				if err != nil {
-					return nil, "", fmt.Errorf("Error retrieving workers of default worker pool for cluster: %s", err)
+					return nil, "", apiErrorf("container", err, nil, "Error retrieving workers of default worker pool for cluster")
 				}
 				if len(workersByPool) == 0 {
 					return workersByPool, "provisioning", nil
@@ -1439,11 +1440,11 @@ func waitForClusterOneWorkerAvailable(d *schema.ResourceData, meta interface{})
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 // WaitForWorkerAvailable Waits for worker creation
-func WaitForWorkerAvailable(d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForWorkerAvailable(context context.Context, d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -1460,14 +1461,14 @@ func WaitForWorkerAvailable(d *schema.ResourceData, meta interface{}, target v1.
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func workerStateRefreshFunc(client v1.Workers, instanceID string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		workerFields, err := client.List(instanceID, target)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error retrieving workers for cluster: %s", err)
+			return nil, "", apiErrorf("container", err, nil, "Error retrieving workers for cluster")
 		}
 		log.Println("Checking workers...")
 		//Done worker has two fields State and Status , so check for those 2
@@ -1482,7 +1483,7 @@ func workerStateRefreshFunc(client v1.Workers, instanceID string, target v1.Clus
 	}
 }
 
-func WaitForClusterCreation(d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForClusterCreation(context context.Context, d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -1497,7 +1498,7 @@ func WaitForClusterCreation(d *schema.ResourceData, meta interface{}, target v1.
 			workerFields, err := csClient.Workers().List(ClusterID, target)
 			log.Println("Total workers: ", len(workerFields))
 			if err != nil {
-				return nil, "", fmt.Errorf("Error retrieving workers for cluster: %s", err)
+				return nil, "", apiErrorf("container", err, nil, "Error retrieving workers for cluster")
 			}
 			log.Println("Checking workers...")
 			//verifying for atleast sing node to be in normal state
@@ -1514,10 +1515,10 @@ func WaitForClusterCreation(d *schema.ResourceData, meta interface{}, target v1.
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
-func WaitForSubnetAvailable(d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForSubnetAvailable(context context.Context, d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -1534,14 +1535,14 @@ func WaitForSubnetAvailable(d *schema.ResourceData, meta interface{}, target v1.
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func subnetStateRefreshFunc(client v1.Clusters, instanceID string, d *schema.ResourceData, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		cluster, err := client.FindWithOutShowResourcesCompatible(instanceID, target)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error retrieving cluster: %s", err)
+			return nil, "", apiErrorf("container", err, nil, "Error retrieving cluster")
 		}
 		if cluster.IngressHostname == "" || cluster.IngressSecretName == "" {
 			return cluster, subnetProvisioning, nil
@@ -1551,7 +1552,7 @@ func subnetStateRefreshFunc(client v1.Clusters, instanceID string, d *schema.Res
 }
 
 // WaitForClusterVersionUpdate Waits for cluster creation
-func WaitForClusterVersionUpdate(d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForClusterVersionUpdate(context context.Context, d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -1569,14 +1570,14 @@ func WaitForClusterVersionUpdate(d *schema.ResourceData, meta interface{}, targe
 		ContinuousTargetOccurence: 5,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func clusterVersionRefreshFunc(client v1.Clusters, instanceID string, d *schema.ResourceData, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		clusterFields, err := client.FindWithOutShowResourcesCompatible(instanceID, target)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error retrieving cluster: %s", err)
+			return nil, "", apiErrorf("container", err, nil, "Error retrieving cluster")
 		}
 		// Check active transactions
 		kubeversion := d.Get("kube_version").(string)
@@ -1608,7 +1609,7 @@ func resourceIBMContainerClusterExists(d *schema.ResourceData, meta interface{})
 				return false, nil
 			}
 		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
+		return false, apiErrorf("container", err, nil, "Error communicating with the API")
 	}
 	return cls.ID == clusterID, nil
 }
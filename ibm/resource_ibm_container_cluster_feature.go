@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -23,11 +25,11 @@ const (
 
 func resourceIBMContainerClusterFeature() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerClusterFeatureCreate,
-		Read:     resourceIBMContainerClusterFeatureRead,
-		Update:   resourceIBMContainerClusterFeatureUpdate,
-		Delete:   resourceIBMContainerClusterFeatureDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerClusterFeatureCreate,
+		ReadContext:   resourceIBMContainerClusterFeatureRead,
+		UpdateContext: resourceIBMContainerClusterFeatureUpdate,
+		DeleteContext: resourceIBMContainerClusterFeatureDelete,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
 			Update: schema.DefaultTimeout(90 * time.Minute),
@@ -89,24 +91,24 @@ func resourceIBMContainerClusterFeature() *schema.Resource {
 	}
 }
 
-func resourceIBMContainerClusterFeatureCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerClusterFeatureCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	cluster := d.Get("cluster").(string)
 	var isOptionSet bool
 
 	if v, ok := d.GetOkExists("private_service_endpoint"); ok {
 		if v.(bool) {
-			err := updateCluster(cluster, enablePrivateSECmdAction, d.Timeout(schema.TimeoutCreate), d, meta)
+			err := updateCluster(context, cluster, enablePrivateSECmdAction, d.Timeout(schema.TimeoutCreate), d, meta)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		} else {
-			return fmt.Errorf("The `private_service_endpoint` can not be disabled")
+			return diag.FromErr(fmt.Errorf("The `private_service_endpoint` can not be disabled"))
 		}
 		d.SetId(cluster)
-		err := reloadCluster(cluster, d.Timeout(schema.TimeoutCreate), d, meta)
+		err := reloadCluster(context, cluster, d.Timeout(schema.TimeoutCreate), d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		isOptionSet = true
 	}
@@ -119,25 +121,25 @@ func resourceIBMContainerClusterFeatureCreate(d *schema.ResourceData, meta inter
 			cmd = disablePublicSECmdAction
 		}
 		log.Printf("Started enabling the public ep %s", cmd)
-		err := updateCluster(cluster, cmd, d.Timeout(schema.TimeoutCreate), d, meta)
+		err := updateCluster(context, cluster, cmd, d.Timeout(schema.TimeoutCreate), d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		d.SetId(cluster)
-		err = reloadCluster(cluster, d.Timeout(schema.TimeoutCreate), d, meta)
+		err = reloadCluster(context, cluster, d.Timeout(schema.TimeoutCreate), d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		isOptionSet = true
 	}
 
 	if !isOptionSet {
-		return fmt.Errorf("Provide either `public_service_endpoint` or  `private_service_endpoint` or both.")
+		return diag.FromErr(fmt.Errorf("Provide either `public_service_endpoint` or  `private_service_endpoint` or both."))
 	}
-	return resourceIBMContainerClusterFeatureRead(d, meta)
+	return resourceIBMContainerClusterFeatureRead(context, d, meta)
 }
 
-func reloadCluster(cluster string, timeout time.Duration, d *schema.ResourceData, meta interface{}) error {
+func reloadCluster(context context.Context, cluster string, timeout time.Duration, d *schema.ResourceData, meta interface{}) error {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
 		return err
@@ -157,23 +159,21 @@ func reloadCluster(cluster string, timeout time.Duration, d *schema.ResourceData
 	if v, ok := d.GetOkExists("reload_workers"); ok {
 		if v.(bool) {
 			log.Printf("Waiting for cluster (%s) to be available.", cluster)
-			_, err = WaitForClusterAvailableForFeatureUpdate(cluster, timeout, meta, targetEnv)
+			_, err = WaitForClusterAvailableForFeatureUpdate(context, cluster, timeout, meta, targetEnv)
 			if err != nil {
-				return fmt.Errorf(
-					"Error waiting for cluster (%s) to become ready: %s", cluster, err)
+				return apiErrorf("container", err, nil, "Error waiting for cluster (%s) to become ready", cluster)
 			}
 			log.Printf("Waiting for workers (%s) to be available.", cluster)
-			_, err = WaitForWorkerAvailableForFeatureUpdate(cluster, timeout, meta, targetEnv)
+			_, err = WaitForWorkerAvailableForFeatureUpdate(context, cluster, timeout, meta, targetEnv)
 			if err != nil {
-				return fmt.Errorf(
-					"Error waiting for workers of cluster (%s) to become ready: %s", cluster, err)
+				return apiErrorf("container", err, nil, "Error waiting for workers of cluster (%s) to become ready", cluster)
 			}
 			params := v1.UpdateWorkerCommand{
 				Action: reloadAction,
 			}
 			workerFields, err := csClient.Workers().List(cluster, targetEnv)
 			if err != nil {
-				return fmt.Errorf("Error retrieving workers for cluster: %s", err)
+				return apiErrorf("container", err, nil, "Error retrieving workers for cluster")
 			}
 			workers := make([]string, len(workerFields))
 			for i, worker := range workerFields {
@@ -183,15 +183,13 @@ func reloadCluster(cluster string, timeout time.Duration, d *schema.ResourceData
 			if err != nil {
 				return err
 			}
-			_, err = WaitForClusterAvailableForFeatureUpdate(cluster, timeout, meta, targetEnv)
+			_, err = WaitForClusterAvailableForFeatureUpdate(context, cluster, timeout, meta, targetEnv)
 			if err != nil {
-				return fmt.Errorf(
-					"Error waiting for cluster (%s) to become ready: %s", d.Id(), err)
+				return apiErrorf("container", err, nil, "Error waiting for cluster (%s) to become ready", d.Id())
 			}
-			_, err = WaitForWorkerAvailableForFeatureUpdate(cluster, timeout, meta, targetEnv)
+			_, err = WaitForWorkerAvailableForFeatureUpdate(context, cluster, timeout, meta, targetEnv)
 			if err != nil {
-				return fmt.Errorf(
-					"Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err)
+				return apiErrorf("container", err, nil, "Error waiting for workers of cluster (%s) to become ready", d.Id())
 			}
 		}
 	}
@@ -199,7 +197,7 @@ func reloadCluster(cluster string, timeout time.Duration, d *schema.ResourceData
 	return nil
 }
 
-func updateCluster(cluster, actionCmd string, timeout time.Duration, d *schema.ResourceData, meta interface{}) error {
+func updateCluster(context context.Context, cluster, actionCmd string, timeout time.Duration, d *schema.ResourceData, meta interface{}) error {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
 		return err
@@ -212,16 +210,14 @@ func updateCluster(cluster, actionCmd string, timeout time.Duration, d *schema.R
 		return err
 	}
 	log.Printf("Waiting for cluster (%s) to be available.", cluster)
-	_, err = WaitForClusterAvailableForFeatureUpdate(cluster, timeout, meta, targetEnv)
+	_, err = WaitForClusterAvailableForFeatureUpdate(context, cluster, timeout, meta, targetEnv)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for cluster (%s) to become ready: %s", d.Id(), err)
+		return apiErrorf("container", err, nil, "Error waiting for cluster (%s) to become ready", d.Id())
 	}
 	log.Printf("Waiting for workers (%s) to be available.", cluster)
-	_, err = WaitForWorkerAvailableForFeatureUpdate(cluster, timeout, meta, targetEnv)
+	_, err = WaitForWorkerAvailableForFeatureUpdate(context, cluster, timeout, meta, targetEnv)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err)
+		return apiErrorf("container", err, nil, "Error waiting for workers of cluster (%s) to become ready", d.Id())
 	}
 	log.Printf("Calling update with action cmd %s", actionCmd)
 	err = csClient.Clusters().Update(cluster, params, targetEnv)
@@ -233,20 +229,20 @@ func updateCluster(cluster, actionCmd string, timeout time.Duration, d *schema.R
 	return nil
 }
 
-func resourceIBMContainerClusterFeatureRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerClusterFeatureRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterID := d.Id()
 	targetEnv, err := getWorkerPoolTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cls, err := csClient.Clusters().Find(clusterID, targetEnv)
 	if err != nil {
-		return fmt.Errorf("Error retrieving armada cluster: %s", err)
+		return diag.FromErr(apiErrorf("container", err, nil, "Error retrieving armada cluster"))
 	}
 
 	d.Set("cluster", clusterID)
@@ -258,28 +254,28 @@ func resourceIBMContainerClusterFeatureRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceIBMContainerClusterFeatureDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerClusterFeatureDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	d.SetId("")
 	return nil
 }
 
-func resourceIBMContainerClusterFeatureUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerClusterFeatureUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	cluster := d.Get("cluster").(string)
 	var isOptionSet bool
 	if d.HasChange("private_service_endpoint") {
 		if v, ok := d.GetOkExists("private_service_endpoint"); ok {
 			if v.(bool) {
-				err := updateCluster(cluster, enablePrivateSECmdAction, d.Timeout(schema.TimeoutUpdate), d, meta)
+				err := updateCluster(context, cluster, enablePrivateSECmdAction, d.Timeout(schema.TimeoutUpdate), d, meta)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			} else {
-				return fmt.Errorf("The `private_service_endpoint` can not be disabled")
+				return diag.FromErr(fmt.Errorf("The `private_service_endpoint` can not be disabled"))
 			}
-			err := reloadCluster(cluster, d.Timeout(schema.TimeoutUpdate), d, meta)
+			err := reloadCluster(context, cluster, d.Timeout(schema.TimeoutUpdate), d, meta)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			isOptionSet = true
 		}
@@ -292,27 +288,27 @@ func resourceIBMContainerClusterFeatureUpdate(d *schema.ResourceData, meta inter
 			} else {
 				cmd = disablePublicSECmdAction
 			}
-			err := updateCluster(cluster, cmd, d.Timeout(schema.TimeoutUpdate), d, meta)
+			err := updateCluster(context, cluster, cmd, d.Timeout(schema.TimeoutUpdate), d, meta)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
-			err = reloadCluster(cluster, d.Timeout(schema.TimeoutUpdate), d, meta)
+			err = reloadCluster(context, cluster, d.Timeout(schema.TimeoutUpdate), d, meta)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			isOptionSet = true
 		}
 	}
 
 	if !isOptionSet {
-		return fmt.Errorf("Provide either `public_service_endpoint` or  `private_service_endpoint` or both.")
+		return diag.FromErr(fmt.Errorf("Provide either `public_service_endpoint` or  `private_service_endpoint` or both."))
 	}
 
-	return resourceIBMContainerClusterFeatureRead(d, meta)
+	return resourceIBMContainerClusterFeatureRead(context, d, meta)
 }
 
 // WaitForClusterAvailable Waits for cluster creation
-func WaitForClusterAvailableForFeatureUpdate(cluster string, timeout time.Duration, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForClusterAvailableForFeatureUpdate(context context.Context, cluster string, timeout time.Duration, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -329,10 +325,10 @@ func WaitForClusterAvailableForFeatureUpdate(cluster string, timeout time.Durati
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
-func WaitForWorkerAvailableForFeatureUpdate(cluster string, timeout time.Duration, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForWorkerAvailableForFeatureUpdate(context context.Context, cluster string, timeout time.Duration, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -349,5 +345,5 @@ func WaitForWorkerAvailableForFeatureUpdate(cluster string, timeout time.Duratio
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
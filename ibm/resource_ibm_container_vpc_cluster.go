@@ -14,6 +14,7 @@ import (
 	"github.com/IBM/go-sdk-core/v3/core"
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -36,17 +37,19 @@ const (
 
 func resourceIBMContainerVpcCluster() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerVpcClusterCreate,
-		Read:     resourceIBMContainerVpcClusterRead,
-		Update:   resourceIBMContainerVpcClusterUpdate,
-		Delete:   resourceIBMContainerVpcClusterDelete,
-		Exists:   resourceIBMContainerVpcClusterExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerVpcClusterCreate,
+		ReadContext:   resourceIBMContainerVpcClusterRead,
+		UpdateContext: resourceIBMContainerVpcClusterUpdate,
+		DeleteContext: resourceIBMContainerVpcClusterDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return resourceTagsCustomizeDiff(diff)
 			},
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return vpcClusterWorkerUpdateStrategyCustomizeDiff(diff)
+			},
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -166,6 +169,30 @@ func resourceIBMContainerVpcCluster() *schema.Resource {
 				Description: "Wait for worker node to update during kube version update.",
 			},
 
+			"worker_update_strategy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Rolling replacement of the worker nodes during kube version and patch updates",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_unavailable": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Maximum number of worker nodes replaced at the same time",
+						},
+						"pause_on_failure": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Stop replacing worker nodes when a batch fails to become ready, instead of continuing with the next batch",
+						},
+					},
+				},
+			},
+
 			"service_subnet": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -222,6 +249,56 @@ func resourceIBMContainerVpcCluster() *schema.Resource {
 				Description:      "wait_till can be configured for Master Ready, One worker Ready or Ingress Ready",
 			},
 
+			"readiness_gates": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Conditions the cluster must meet, after the wait_till stage, before it is created or updated",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"all_workers_normal": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Wait for all the worker nodes of the cluster to be deployed with a normal health",
+						},
+						"addons_healthy": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Wait for all the add-ons enabled on the cluster to have a normal health",
+						},
+						"albs_enabled": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "IDs of the ALBs to wait for to be enabled and healthy",
+						},
+						"worker_pool": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Number of ready worker nodes to wait for in a worker pool",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Name of the worker pool",
+									},
+									"ready_count": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+										Description:  "Number of worker nodes of the worker pool deployed with a normal health",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"entitlement": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -260,6 +337,51 @@ func resourceIBMContainerVpcCluster() *schema.Resource {
 				Computed: true,
 			},
 
+			"health": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Summary of the health of the master, worker nodes, add-ons and ingress of the cluster",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"master_state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "State of the master",
+						},
+						"master_health": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Health of the master",
+						},
+						"workers_health": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Worst health of the worker nodes",
+						},
+						"workers_total": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of worker nodes of the cluster",
+						},
+						"workers_normal": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of worker nodes deployed with a normal health",
+						},
+						"addons_health": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Worst health of the add-ons enabled on the cluster",
+						},
+						"ingress_health": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Health of the ingress, from its hostname, secret and enabled ALBs",
+						},
+					},
+				},
+			},
+
 			"resource_group_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -371,6 +493,16 @@ func resourceIBMContainerVpcCluster() *schema.Resource {
 	}
 }
 
+// vpcClusterWorkerUpdateStrategyCustomizeDiff rejects a worker_update_strategy
+// without wait_for_worker_update, as the batches of worker nodes can't be rolled
+// without waiting for each of them.
+func vpcClusterWorkerUpdateStrategyCustomizeDiff(diff *schema.ResourceDiff) error {
+	if v, ok := diff.GetOk("worker_update_strategy"); ok && len(v.([]interface{})) > 0 && !diff.Get("wait_for_worker_update").(bool) {
+		return fmt.Errorf("worker_update_strategy requires wait_for_worker_update to be true")
+	}
+	return nil
+}
+
 func resourceIBMContainerVpcClusterValidator() *ResourceValidator {
 	validateSchema := make([]ValidateSchema, 1)
 	validateSchema = append(validateSchema,
@@ -387,12 +519,12 @@ func resourceIBMContainerVpcClusterValidator() *ResourceValidator {
 	return &ibmContainerVpcClusteresourceValidator
 }
 
-func resourceIBMContainerVpcClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcClusterCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	var vpcProvider string
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if userDetails.generation == 1 {
 		vpcProvider = "vpc-classic"
@@ -402,7 +534,7 @@ func resourceIBMContainerVpcClusterCreate(d *schema.ResourceData, meta interface
 
 	csClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	disablePublicServiceEndpoint := d.Get("disable_public_service_endpoint").(bool)
@@ -475,65 +607,76 @@ func resourceIBMContainerVpcClusterCreate(d *schema.ResourceData, meta interface
 
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	cls, err := csClient.Clusters().Create(params, targetEnv)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(cls.ID)
 	switch strings.ToLower(timeoutStage) {
 
 	case strings.ToLower(masterNodeReady):
-		_, err = waitForVpcClusterMasterAvailable(d, meta)
+		_, err = waitForVpcClusterMasterAvailable(context, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	case strings.ToLower(oneWorkerNodeReady):
-		_, err = waitForVpcClusterOneWorkerAvailable(d, meta)
+		_, err = waitForVpcClusterOneWorkerAvailable(context, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	case strings.ToLower(ingressReady):
-		_, err = waitForVpcClusterIngressAvailable(d, meta)
+		_, err = waitForVpcClusterIngressAvailable(context, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
-	return resourceIBMContainerVpcClusterUpdate(d, meta)
+	if _, ok := d.GetOk("readiness_gates"); ok {
+		_, err = waitForVpcClusterReadinessGates(context, d, meta, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceIBMContainerVpcClusterUpdate(context, d, meta)
 
 }
 
-func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcClusterUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 
 	csClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterID := d.Id()
+	// workersChanged reports whether the update changed the version or the worker
+	// nodes of the cluster, after which the readiness gates are waited on
+	workersChanged := false
 
 	v := os.Getenv("IC_ENV_TAGS")
 	if d.HasChange("tags") || v != "" {
 		oldList, newList := d.GetChange("tags")
 		cluster, err := csClient.Clusters().GetCluster(clusterID, targetEnv)
 		if err != nil {
-			return fmt.Errorf("Error retrieving cluster %s: %s", clusterID, err)
+			return diag.FromErr(apiErrorf("container", err, nil, "Error retrieving cluster %s", clusterID))
 		}
 		err = UpdateTagsUsingCRN(oldList, newList, meta, cluster.CRN)
 		if err != nil {
 			log.Printf(
 				"An error occured during update of instance (%s) tags: %s", clusterID, err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 
@@ -568,17 +711,18 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 		if err != nil {
 			log.Printf(
 				"An error occured during EnableKms (cluster: %s) error: %s", d.Id(), err)
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	if (d.HasChange("kube_version") || d.HasChange("update_all_workers") || d.HasChange("patch_version") || d.HasChange("retry_patch_version")) && !d.IsNewResource() {
+		workersChanged = true
 
 		if d.HasChange("kube_version") {
 			ClusterClient, err := meta.(ClientSession).ContainerAPI()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			var masterVersion string
 			if v, ok := d.GetOk("kube_version"); ok {
@@ -593,32 +737,31 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 			Env, err := getClusterTargetHeader(d, meta)
 
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			Error := ClusterClient.Clusters().Update(clusterID, params, Env)
 			if Error != nil {
-				return Error
+				return diag.FromErr(Error)
 			}
-			_, err = WaitForVpcClusterVersionUpdate(d, meta, targetEnv)
+			_, err = WaitForVpcClusterVersionUpdate(context, d, meta, targetEnv)
 			if err != nil {
-				return fmt.Errorf(
-					"Error waiting for cluster (%s) version to be updated: %s", d.Id(), err)
+				return diag.FromErr(apiErrorf("container", err, nil, "Error waiting for cluster (%s) version to be updated", d.Id()))
 			}
 		}
 
 		csClient, err := meta.(ClientSession).VpcContainerAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		targetEnv, err := getVpcClusterTargetHeader(d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		clusterID := d.Id()
 		cls, err := csClient.Clusters().GetCluster(clusterID, targetEnv)
 		if err != nil {
-			return fmt.Errorf("Error retrieving conatiner vpc cluster: %s", err)
+			return diag.FromErr(apiErrorf("container", err, nil, "Error retrieving conatiner vpc cluster"))
 		}
 
 		// Update the worker nodes after master node kube-version is updated.
@@ -632,7 +775,7 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 			workers, err := csClient.Workers().ListWorkers(clusterID, false, targetEnv)
 			if err != nil {
 				d.Set("patch_version", nil)
-				return fmt.Errorf("Error retrieving workers for cluster: %s", err)
+				return diag.FromErr(apiErrorf("container", err, nil, "Error retrieving workers for cluster"))
 			}
 
 			for index, worker := range workers {
@@ -642,50 +785,40 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 
 			waitForWorkerUpdate := d.Get("wait_for_worker_update").(bool)
 
-			for _, worker := range workers {
-				// check if change is present in MAJOR.MINOR version or in PATCH version
-				if strings.Split(worker.KubeVersion.Actual, "_")[0] != strings.Split(cls.MasterKubeVersion, "_")[0] || (strings.Split(worker.KubeVersion.Actual, ".")[2] != patchVersion && patchVersion == strings.Split(worker.KubeVersion.Target, ".")[2]) {
-					_, err := csClient.Workers().ReplaceWokerNode(clusterID, worker.ID, targetEnv)
-					// As API returns http response 204 NO CONTENT, error raised will be exempted.
-					if err != nil && !strings.Contains(err.Error(), "EmptyResponseBody") {
-						d.Set("patch_version", nil)
-						return fmt.Errorf("Error replacing the worker node from the cluster: %s", err)
-					}
-
-					if waitForWorkerUpdate {
-						//1. wait for worker node to delete
-						_, deleteError := waitForWorkerNodetoDelete(d, meta, targetEnv, worker.ID)
-						if deleteError != nil {
-							d.Set("patch_version", nil)
-							return fmt.Errorf("Worker node - %s is failed to replace", worker.ID)
-						}
-
-						//2. wait for new workerNode
-						_, newWorkerError := waitForNewWorker(d, meta, targetEnv, workersCount)
-						if newWorkerError != nil {
-							d.Set("patch_version", nil)
-							return fmt.Errorf("Failed to spawn new worker node")
-						}
-
-						//3. Get new worker node ID and update the map
-						newWorkerID, index, newNodeError := getNewWorkerID(d, meta, targetEnv, workersInfo)
-						if newNodeError != nil {
-							d.Set("patch_version", nil)
-							return fmt.Errorf("Unable to find the new worker node info")
-						}
-
-						delete(workersInfo, worker.ID)
-						workersInfo[newWorkerID] = index
+			// Without waiting for the workers, all of them are replaced at once
+			maxUnavailable, pauseOnFailure := workersCount, true
+			if waitForWorkerUpdate {
+				maxUnavailable = 1
+				if v, ok := d.GetOk("worker_update_strategy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+					strategy := v.([]interface{})[0].(map[string]interface{})
+					maxUnavailable = strategy["max_unavailable"].(int)
+					pauseOnFailure = strategy["pause_on_failure"].(bool)
+				}
+			}
 
-						//4. wait for the worker's version update and normal state
-						_, Err := WaitForVpcClusterWokersVersionUpdate(d, meta, targetEnv, cls.MasterKubeVersion, newWorkerID)
-						if Err != nil {
-							d.Set("patch_version", nil)
-							return fmt.Errorf(
-								"Error waiting for cluster (%s) worker nodes kube version to be updated: %s", d.Id(), Err)
-						}
+			batches := vpcClusterWorkerUpdateBatches(workers, cls.MasterKubeVersion, patchVersion, maxUnavailable)
+			var failures []string
+			for i, batch := range batches {
+				err := replaceVpcClusterWorkers(context, d, meta, targetEnv, cls.MasterKubeVersion, batch, workersInfo, workersCount, waitForWorkerUpdate)
+				if err == nil {
+					continue
+				}
+				d.Set("patch_version", nil)
+				if pauseOnFailure {
+					var remaining []string
+					for _, next := range batches[i+1:] {
+						remaining = append(remaining, vpcClusterWorkerIDs(next)...)
 					}
+					if len(remaining) > 0 {
+						return diag.FromErr(fmt.Errorf("%s, the update of the worker nodes %s is paused", err, strings.Join(remaining, ", ")))
+					}
+					return diag.FromErr(err)
 				}
+				log.Printf("[WARN] %s, continuing with the next worker nodes", err)
+				failures = append(failures, err.Error())
+			}
+			if len(failures) > 0 {
+				return diag.FromErr(fmt.Errorf("Error updating the worker nodes of cluster (%s): %s", d.Id(), strings.Join(failures, "; ")))
 			}
 		}
 	}
@@ -700,14 +833,13 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 
 		ClusterClient, err := meta.(ClientSession).ContainerAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		Env := v1.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
 
 		err = ClusterClient.WorkerPools().UpdateLabelsWorkerPool(clusterID, "default", labels, Env)
 		if err != nil {
-			return fmt.Errorf(
-				"Error updating the labels: %s", err)
+			return diag.FromErr(apiErrorf("container", err, nil, "Error updating the labels"))
 		}
 	}
 
@@ -715,15 +847,15 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 		count := d.Get("worker_count").(int)
 		ClusterClient, err := meta.(ClientSession).ContainerAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		Env := v1.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
 
 		err = ClusterClient.WorkerPools().ResizeWorkerPool(clusterID, "default", count, Env)
 		if err != nil {
-			return fmt.Errorf(
-				"Error updating the worker_count %d: %s", count, err)
+			return diag.FromErr(apiErrorf("container", err, nil, "Error updating the worker_count %d", count))
 		}
+		workersChanged = true
 	}
 	if d.HasChange("zones") && !d.IsNewResource() {
 		oldList, newList := d.GetChange("zones")
@@ -737,6 +869,7 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 		ns := newList.(*schema.Set)
 		remove := os.Difference(ns).List()
 		add := ns.Difference(os).List()
+		workersChanged = workersChanged || len(add) > 0 || len(remove) > 0
 		if len(add) > 0 {
 			for _, zone := range add {
 				newZone := zone.(map[string]interface{})
@@ -748,12 +881,11 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 				}
 				err = csClient.WorkerPools().CreateWorkerPoolZone(zoneParam, targetEnv)
 				if err != nil {
-					return fmt.Errorf("Error adding zone to conatiner vpc cluster: %s", err)
+					return diag.FromErr(apiErrorf("container", err, nil, "Error adding zone to conatiner vpc cluster"))
 				}
-				_, err = WaitForWorkerPoolAvailable(d, meta, clusterID, "default", d.Timeout(schema.TimeoutCreate), targetEnv)
+				_, err = WaitForWorkerPoolAvailable(context, d, meta, clusterID, "default", d.Timeout(schema.TimeoutCreate), targetEnv)
 				if err != nil {
-					return fmt.Errorf(
-						"Error waiting for workerpool (%s) to become ready: %s", d.Id(), err)
+					return diag.FromErr(apiErrorf("container", err, nil, "Error waiting for workerpool (%s) to become ready", d.Id()))
 				}
 
 			}
@@ -763,22 +895,30 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 				oldZone := zone.(map[string]interface{})
 				ClusterClient, err := meta.(ClientSession).ContainerAPI()
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 				Env := v1.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
 				err = ClusterClient.WorkerPools().RemoveZone(clusterID, oldZone["name"].(string), "default", Env)
 				if err != nil {
-					return fmt.Errorf("Error deleting zone to conatiner vpc cluster: %s", err)
+					return diag.FromErr(apiErrorf("container", err, nil, "Error deleting zone to conatiner vpc cluster"))
 				}
-				_, err = WaitForV2WorkerZoneDeleted(clusterID, "default", oldZone["name"].(string), meta, d.Timeout(schema.TimeoutDelete), targetEnv)
+				_, err = WaitForV2WorkerZoneDeleted(context, clusterID, "default", oldZone["name"].(string), meta, d.Timeout(schema.TimeoutDelete), targetEnv)
 				if err != nil {
-					return fmt.Errorf(
-						"Error waiting for deleting workers of worker pool (%s) of cluster (%s):  %s", "default", clusterID, err)
+					return diag.FromErr(apiErrorf("container", err, nil, "Error waiting for deleting workers of worker pool (%s) of cluster (%s)", "default", clusterID))
 				}
 			}
 		}
 	}
 
+	if (d.HasChange("readiness_gates") || workersChanged) && !d.IsNewResource() {
+		if _, ok := d.GetOk("readiness_gates"); ok {
+			_, err = waitForVpcClusterReadinessGates(context, d, meta, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
 	if d.HasChange("force_delete_storage") {
 		var forceDeleteStorage bool
 		if v, ok := d.GetOk("force_delete_storage"); ok {
@@ -787,9 +927,9 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 		d.Set("force_delete_storage", forceDeleteStorage)
 	}
 
-	return resourceIBMContainerVpcClusterRead(d, meta)
+	return append(diags, resourceIBMContainerVpcClusterRead(context, d, meta)...)
 }
-func WaitForV2WorkerZoneDeleted(clusterNameOrID, workerPoolNameOrID, zone string, meta interface{}, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
+func WaitForV2WorkerZoneDeleted(context context.Context, clusterNameOrID, workerPoolNameOrID, zone string, meta interface{}, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
 		return nil, err
@@ -803,13 +943,13 @@ func WaitForV2WorkerZoneDeleted(clusterNameOrID, workerPoolNameOrID, zone string
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 func workerPoolV2ZoneDeleteStateRefreshFunc(client v2.Workers, instanceID, workerPoolNameOrID, zone string, target v2.ClusterTargetHeader) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		workerFields, err := client.ListByWorkerPool(instanceID, workerPoolNameOrID, true, target)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error retrieving workers for cluster: %s", err)
+			return nil, "", apiErrorf("container", err, nil, "Error retrieving workers for cluster")
 		}
 		//Done worker has two fields State and Status , so check for those 2
 		for _, e := range workerFields {
@@ -822,23 +962,31 @@ func workerPoolV2ZoneDeleteStateRefreshFunc(client v2.Workers, instanceID, worke
 		return workerFields, workerDeleteState, nil
 	}
 }
-func resourceIBMContainerVpcClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcClusterRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMContainerVpcClusterExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
 
 	csClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	albsAPI := csClient.Albs()
 
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterID := d.Id()
 	cls, err := csClient.Clusters().GetCluster(clusterID, targetEnv)
 	if err != nil {
-		return fmt.Errorf("Error retrieving conatiner vpc cluster: %s", err)
+		return diag.FromErr(apiErrorf("container", err, nil, "Error retrieving conatiner vpc cluster"))
 	}
 
 	workerPool, err := csClient.WorkerPools().GetWorkerPool(clusterID, "default", targetEnv)
@@ -858,7 +1006,7 @@ func resourceIBMContainerVpcClusterRead(d *schema.ResourceData, meta interface{}
 
 	albs, err := albsAPI.ListClusterAlbs(clusterID, targetEnv)
 	if err != nil && !strings.Contains(err.Error(), "This operation is not supported for your cluster's version.") {
-		return fmt.Errorf("Error retrieving alb's of the cluster %s: %s", clusterID, err)
+		return diag.FromErr(apiErrorf("container", err, nil, "Error retrieving alb's of the cluster %s", clusterID))
 	}
 
 	d.Set("name", cls.Name)
@@ -883,6 +1031,19 @@ func resourceIBMContainerVpcClusterRead(d *schema.ResourceData, meta interface{}
 	d.Set("ingress_hostname", cls.Ingress.HostName)
 	d.Set("ingress_secret", cls.Ingress.SecretName)
 	d.Set("albs", flattenVpcAlbs(albs, "all"))
+	// The health is a summary only, it is left unset rather than failing the refresh
+	var diags diag.Diagnostics
+	health, err := getVpcClusterHealth(meta, cls, albs, targetEnv)
+	if err != nil {
+		log.Printf("[WARN] Error reading the health of cluster (%s): %s", clusterID, err)
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Error reading the cluster health",
+			Detail:   err.Error(),
+		})
+	} else {
+		d.Set("health", []interface{}{health})
+	}
 	d.Set("resource_group_id", cls.ResourceGroupID)
 	d.Set("public_service_endpoint_url", cls.ServiceEndpoints.PublicServiceEndpointURL)
 	d.Set("private_service_endpoint_url", cls.ServiceEndpoints.PrivateServiceEndpointURL)
@@ -900,7 +1061,7 @@ func resourceIBMContainerVpcClusterRead(d *schema.ResourceData, meta interface{}
 	d.Set("tags", tags)
 	controller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(ResourceControllerURL, controller+"/kubernetes/clusters")
 	d.Set(ResourceName, cls.Name)
@@ -908,18 +1069,18 @@ func resourceIBMContainerVpcClusterRead(d *schema.ResourceData, meta interface{}
 	d.Set(ResourceStatus, cls.State)
 	d.Set(ResourceGroupName, cls.ResourceGroupName)
 
-	return nil
+	return diags
 }
 
-func resourceIBMContainerVpcClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcClusterDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	csClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	clusterID := d.Id()
 
@@ -945,7 +1106,7 @@ func resourceIBMContainerVpcClusterDelete(d *schema.ResourceData, meta interface
 
 	bxsession, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var authenticator *core.BearerTokenAuthenticator
@@ -962,17 +1123,17 @@ func resourceIBMContainerVpcClusterDelete(d *schema.ResourceData, meta interface
 	forceDeleteStorage := d.Get("force_delete_storage").(bool)
 	err = csClient.Clusters().Delete(clusterID, targetEnv, forceDeleteStorage)
 	if err != nil {
-		return fmt.Errorf("Error deleting cluster: %s", err)
+		return diag.FromErr(apiErrorf("container", err, nil, "Error deleting cluster"))
 	}
-	_, err = waitForVpcClusterDelete(d, meta)
+	_, err = waitForVpcClusterDelete(context, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if region != "" {
 		userDetails, err := meta.(ClientSession).BluemixUserDetails()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if userDetails.generation == 1 {
 			vpcclassicurl := fmt.Sprintf("https://%s.iaas.cloud.ibm.com/v1", region)
@@ -994,7 +1155,7 @@ func resourceIBMContainerVpcClusterDelete(d *schema.ResourceData, meta interface
 					if strings.Contains(*lb.Name, clusterID) {
 						log.Println("Deleting Load Balancer", *lb.Name)
 						id := *lb.ID
-						_, err = isWaitForClassicLBDeleted(sess1, id, d.Timeout(schema.TimeoutDelete))
+						_, err = isWaitForClassicLBDeleted(context, sess1, id, d.Timeout(schema.TimeoutDelete))
 						if err != nil {
 							log.Printf("Error waiting for vpc load balancer to be deleted: %s\n", err)
 
@@ -1022,7 +1183,7 @@ func resourceIBMContainerVpcClusterDelete(d *schema.ResourceData, meta interface
 					if strings.Contains(*lb.Name, clusterID) {
 						log.Println("Deleting Load Balancer", *lb.Name)
 						id := *lb.ID
-						_, err = isWaitForLBDeleted(sess1, id, d.Timeout(schema.TimeoutDelete))
+						_, err = isWaitForLBDeleted(context, sess1, id, d.Timeout(schema.TimeoutDelete))
 						if err != nil {
 							log.Printf("Error waiting for vpc load balancer to be deleted: %s\n", err)
 
@@ -1035,7 +1196,7 @@ func resourceIBMContainerVpcClusterDelete(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func waitForVpcClusterDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForVpcClusterDelete(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
 		return nil, err
@@ -1064,10 +1225,10 @@ func waitForVpcClusterDelete(d *schema.ResourceData, meta interface{}) (interfac
 		PollInterval: 5 * time.Second,
 	}
 
-	return deleteStateConf.WaitForState()
+	return deleteStateConf.WaitForStateContext(context)
 }
 
-func waitForVpcClusterOneWorkerAvailable(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForVpcClusterOneWorkerAvailable(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
 		return nil, err
@@ -1105,10 +1266,10 @@ func waitForVpcClusterOneWorkerAvailable(d *schema.ResourceData, meta interface{
 		MinTimeout:                5 * time.Second,
 		ContinuousTargetOccurence: 5,
 	}
-	return createStateConf.WaitForState()
+	return createStateConf.WaitForStateContext(context)
 }
 
-func waitForVpcClusterMasterAvailable(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForVpcClusterMasterAvailable(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
 		return nil, err
@@ -1139,10 +1300,10 @@ func waitForVpcClusterMasterAvailable(d *schema.ResourceData, meta interface{})
 		MinTimeout:                5 * time.Second,
 		ContinuousTargetOccurence: 5,
 	}
-	return createStateConf.WaitForState()
+	return createStateConf.WaitForStateContext(context)
 }
 
-func waitForVpcClusterIngressAvailable(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForVpcClusterIngressAvailable(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
 		return nil, err
@@ -1173,7 +1334,228 @@ func waitForVpcClusterIngressAvailable(d *schema.ResourceData, meta interface{})
 		MinTimeout:                5 * time.Second,
 		ContinuousTargetOccurence: 5,
 	}
-	return createStateConf.WaitForState()
+	return createStateConf.WaitForStateContext(context)
+}
+
+// containerHealthSeverity orders the health states of the Kubernetes Service, from
+// the best to the worst. The states it does not know are ranked as warning.
+var containerHealthSeverity = map[string]int{
+	"normal":   0,
+	"pending":  1,
+	"warning":  2,
+	"critical": 3,
+}
+
+// worstContainerHealth returns the worst of the health states, normal when there
+// are none.
+func worstContainerHealth(states ...string) string {
+	worst, worstSeverity := normal, 0
+	for _, state := range states {
+		severity, ok := containerHealthSeverity[state]
+		if !ok {
+			severity = containerHealthSeverity["warning"]
+		}
+		if severity > worstSeverity {
+			worst, worstSeverity = state, severity
+		}
+	}
+	return worst
+}
+
+// getVpcClusterHealth returns the health block of the cluster, summarizing the
+// states of its master, worker nodes, add-ons and ingress.
+func getVpcClusterHealth(meta interface{}, cls *v2.ClusterInfo, albs []v2.AlbConfig, target v2.ClusterTargetHeader) (map[string]interface{}, error) {
+	csClient, err := meta.(ClientSession).VpcContainerAPI()
+	if err != nil {
+		return nil, err
+	}
+	workers, err := csClient.Workers().ListWorkers(cls.ID, false, target)
+	if err != nil {
+		return nil, apiErrorf("container", err, nil, "Error retrieving workers of cluster (%s)", cls.ID)
+	}
+	workerStates := make([]string, 0, len(workers))
+	workersNormal := 0
+	for _, worker := range workers {
+		workerStates = append(workerStates, worker.Health.State)
+		if isVpcClusterWorkerReady(worker) {
+			workersNormal++
+		}
+	}
+
+	addOnClient, err := meta.(ClientSession).ContainerAPI()
+	if err != nil {
+		return nil, err
+	}
+	addOns, err := addOnClient.AddOns().GetAddons(cls.ID, v1.ClusterTargetHeader{ResourceGroup: target.ResourceGroup})
+	if err != nil {
+		return nil, apiErrorf("container", err, nil, "Error retrieving the add-ons of cluster (%s)", cls.ID)
+	}
+	addOnStates := make([]string, 0, len(addOns))
+	for _, addOn := range addOns {
+		addOnStates = append(addOnStates, addOn.HealthState)
+	}
+
+	ingressHealth := "pending"
+	if cls.Ingress.HostName != "" && cls.Ingress.SecretName != "" {
+		albStates := []string{}
+		for _, alb := range albs {
+			if alb.Enable {
+				albStates = append(albStates, vpcAlbHealth(alb))
+			}
+		}
+		ingressHealth = worstContainerHealth(albStates...)
+	}
+
+	return map[string]interface{}{
+		"master_state":   cls.Lifecycle.MasterState,
+		"master_health":  cls.Lifecycle.MasterHealth,
+		"workers_health": worstContainerHealth(workerStates...),
+		"workers_total":  len(workers),
+		"workers_normal": workersNormal,
+		"addons_health":  worstContainerHealth(addOnStates...),
+		"ingress_health": ingressHealth,
+	}, nil
+}
+
+// isVpcClusterWorkerReady reports whether the worker node is deployed with a normal
+// health.
+func isVpcClusterWorkerReady(worker v2.Worker) bool {
+	return worker.LifeCycle.ActualState == "deployed" && worker.Health.State == normal
+}
+
+// vpcAlbHealth returns the health of an ALB, which reports healthy rather than
+// normal.
+func vpcAlbHealth(alb v2.AlbConfig) string {
+	switch {
+	case alb.State != "enabled":
+		return "pending"
+	case alb.Status == "" || alb.Status == "healthy":
+		return normal
+	}
+	return alb.Status
+}
+
+// vpcClusterUnreadyGates returns the conditions of the readiness gates which the
+// cluster does not meet yet.
+func vpcClusterUnreadyGates(meta interface{}, clusterID string, gates map[string]interface{}, target v2.ClusterTargetHeader) ([]string, error) {
+	csClient, err := meta.(ClientSession).VpcContainerAPI()
+	if err != nil {
+		return nil, err
+	}
+	var unready []string
+
+	poolGates := []interface{}{}
+	if pools, ok := gates["worker_pool"].(*schema.Set); ok {
+		poolGates = pools.List()
+	}
+	if gates["all_workers_normal"].(bool) || len(poolGates) > 0 {
+		workers, err := csClient.Workers().ListWorkers(clusterID, false, target)
+		if err != nil {
+			return nil, apiErrorf("container", err, nil, "Error retrieving workers of cluster (%s)", clusterID)
+		}
+		readyByPool := make(map[string]int)
+		for _, worker := range workers {
+			if isVpcClusterWorkerReady(worker) {
+				readyByPool[worker.PoolName]++
+			} else if gates["all_workers_normal"].(bool) {
+				unready = append(unready, fmt.Sprintf("worker %s is %s with a %s health", worker.ID, worker.LifeCycle.ActualState, worker.Health.State))
+			}
+		}
+		for _, p := range poolGates {
+			pool := p.(map[string]interface{})
+			name, count := pool["name"].(string), pool["ready_count"].(int)
+			if readyByPool[name] < count {
+				unready = append(unready, fmt.Sprintf("worker pool %s has %d of %d ready workers", name, readyByPool[name], count))
+			}
+		}
+	}
+
+	if gates["addons_healthy"].(bool) {
+		addOnClient, err := meta.(ClientSession).ContainerAPI()
+		if err != nil {
+			return nil, err
+		}
+		addOns, err := addOnClient.AddOns().GetAddons(clusterID, v1.ClusterTargetHeader{ResourceGroup: target.ResourceGroup})
+		if err != nil {
+			return nil, apiErrorf("container", err, nil, "Error retrieving the add-ons of cluster (%s)", clusterID)
+		}
+		for _, addOn := range addOns {
+			if addOn.HealthState != normal {
+				unready = append(unready, fmt.Sprintf("add-on %s has a %s health: %s", addOn.Name, addOn.HealthState, addOn.HealthStatus))
+			}
+		}
+	}
+
+	if albIDs, ok := gates["albs_enabled"].(*schema.Set); ok && albIDs.Len() > 0 {
+		albs, err := csClient.Albs().ListClusterAlbs(clusterID, target)
+		if err != nil {
+			return nil, apiErrorf("container", err, nil, "Error retrieving alb's of the cluster %s", clusterID)
+		}
+		found := make(map[string]v2.AlbConfig)
+		for _, alb := range albs {
+			found[alb.AlbID] = alb
+		}
+		for _, id := range expandStringList(albIDs.List()) {
+			alb, ok := found[id]
+			switch {
+			case !ok:
+				unready = append(unready, fmt.Sprintf("ALB %s is not found", id))
+			case alb.State != "enabled":
+				unready = append(unready, fmt.Sprintf("ALB %s is %s", id, alb.State))
+			case vpcAlbHealth(alb) != normal:
+				unready = append(unready, fmt.Sprintf("ALB %s has a %s status", id, alb.Status))
+			}
+		}
+	}
+	return unready, nil
+}
+
+// waitForVpcClusterReadinessGates waits for the cluster to meet all the conditions
+// of its readiness_gates, which are checked at once before polling them.
+func waitForVpcClusterReadinessGates(context context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) (interface{}, error) {
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	gates := map[string]interface{}{
+		"all_workers_normal": false,
+		"addons_healthy":     false,
+	}
+	if v := d.Get("readiness_gates").([]interface{}); len(v) > 0 && v[0] != nil {
+		gates = v[0].(map[string]interface{})
+	}
+	clusterID := d.Id()
+	unready, err := vpcClusterUnreadyGates(meta, clusterID, gates, targetEnv)
+	if err != nil {
+		return nil, err
+	}
+	if len(unready) == 0 {
+		return unready, nil
+	}
+	log.Printf("[DEBUG] Cluster (%s) is not ready: %s", clusterID, strings.Join(unready, ", "))
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{deployInProgress},
+		Target:  []string{ready},
+		Refresh: func() (interface{}, string, error) {
+			unready, err = vpcClusterUnreadyGates(meta, clusterID, gates, targetEnv)
+			if err != nil {
+				return nil, "", err
+			}
+			if len(unready) > 0 {
+				log.Printf("[DEBUG] Cluster (%s) is not ready: %s", clusterID, strings.Join(unready, ", "))
+				return unready, deployInProgress, nil
+			}
+			return unready, ready, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	result, err := stateConf.WaitForStateContext(context)
+	if err != nil && len(unready) > 0 {
+		return result, fmt.Errorf("Error waiting for the readiness gates of cluster (%s), %s: %s", clusterID, strings.Join(unready, ", "), err)
+	}
+	return result, err
 }
 
 func getVpcClusterTargetHeader(d *schema.ResourceData, meta interface{}) (v2.ClusterTargetHeader, error) {
@@ -1205,13 +1587,13 @@ func resourceIBMContainerVpcClusterExists(d *schema.ResourceData, meta interface
 				return false, nil
 			}
 		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
+		return false, apiErrorf("container", err, nil, "Error communicating with the API")
 	}
 	return cls.ID == clusterID, nil
 }
 
 // WaitForVpcClusterVersionUpdate Waits for cluster creation
-func WaitForVpcClusterVersionUpdate(d *schema.ResourceData, meta interface{}, target v2.ClusterTargetHeader) (interface{}, error) {
+func WaitForVpcClusterVersionUpdate(context context.Context, d *schema.ResourceData, meta interface{}, target v2.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
 		return nil, err
@@ -1229,14 +1611,14 @@ func WaitForVpcClusterVersionUpdate(d *schema.ResourceData, meta interface{}, ta
 		ContinuousTargetOccurence: 5,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func vpcClusterVersionRefreshFunc(client v2.Clusters, instanceID string, d *schema.ResourceData, target v2.ClusterTargetHeader) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		cls, err := client.GetCluster(instanceID, target)
 		if err != nil {
-			return nil, "retry", fmt.Errorf("Error retrieving conatiner vpc cluster: %s", err)
+			return nil, "retry", apiErrorf("container", err, nil, "Error retrieving conatiner vpc cluster")
 		}
 
 		// Check active transactions
@@ -1248,43 +1630,47 @@ func vpcClusterVersionRefreshFunc(client v2.Clusters, instanceID string, d *sche
 	}
 }
 
-// WaitForVpcClusterWokersVersionUpdate Waits for Cluster version Update
-func WaitForVpcClusterWokersVersionUpdate(d *schema.ResourceData, meta interface{}, target v2.ClusterTargetHeader, masterVersion, workerID string) (interface{}, error) {
+// WaitForVpcClusterWokersVersionUpdate Waits for Cluster version Update of the workers
+func WaitForVpcClusterWokersVersionUpdate(context context.Context, d *schema.ResourceData, meta interface{}, target v2.ClusterTargetHeader, masterVersion string, workerIDs ...string) (interface{}, error) {
 	csClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
 		return nil, err
 	}
 
-	log.Printf("Waiting for worker (%s) version to be updated.", workerID)
+	log.Printf("Waiting for workers (%s) version to be updated.", strings.Join(workerIDs, ", "))
 	clusterID := d.Id()
 	stateConf := &resource.StateChangeConf{
 		Pending:                   []string{"retry", versionUpdating},
 		Target:                    []string{workerNormal},
-		Refresh:                   vpcClusterWorkersVersionRefreshFunc(csClient.Workers(), workerID, clusterID, d, target, masterVersion),
+		Refresh:                   vpcClusterWorkersVersionRefreshFunc(csClient.Workers(), workerIDs, clusterID, d, target, masterVersion),
 		Timeout:                   d.Timeout(schema.TimeoutUpdate),
 		Delay:                     10 * time.Second,
 		MinTimeout:                10 * time.Second,
 		ContinuousTargetOccurence: 5,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
-func vpcClusterWorkersVersionRefreshFunc(client v2.Workers, workerID, clusterID string, d *schema.ResourceData, target v2.ClusterTargetHeader, masterVersion string) resource.StateRefreshFunc {
+func vpcClusterWorkersVersionRefreshFunc(client v2.Workers, workerIDs []string, clusterID string, d *schema.ResourceData, target v2.ClusterTargetHeader, masterVersion string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		worker, err := client.Get(clusterID, workerID, target)
-		if err != nil {
-			return nil, "retry", fmt.Errorf("Error retrieving worker of container vpc cluster: %s", err)
-		}
-		// Check active updates
-		if worker.Health.State == "normal" && strings.Split(worker.KubeVersion.Actual, "_")[0] == strings.Split(masterVersion, "_")[0] {
-			return worker, workerNormal, nil
+		workers := make([]v2.Worker, 0, len(workerIDs))
+		for _, workerID := range workerIDs {
+			worker, err := client.Get(clusterID, workerID, target)
+			if err != nil {
+				return nil, "retry", apiErrorf("container", err, nil, "Error retrieving worker of container vpc cluster")
+			}
+			// Check active updates
+			if worker.Health.State != "normal" || strings.Split(worker.KubeVersion.Actual, "_")[0] != strings.Split(masterVersion, "_")[0] {
+				return worker, versionUpdating, nil
+			}
+			workers = append(workers, worker)
 		}
-		return worker, versionUpdating, nil
+		return workers, workerNormal, nil
 	}
 }
 
-func waitForWorkerNodetoDelete(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workerID string) (interface{}, error) {
+func waitForWorkerNodetoDelete(context context.Context, d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workerID string) (interface{}, error) {
 
 	csClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
@@ -1310,10 +1696,10 @@ func waitForWorkerNodetoDelete(d *schema.ResourceData, meta interface{}, targetE
 		MinTimeout:   5 * time.Second,
 		PollInterval: 5 * time.Second,
 	}
-	return deleteStateConf.WaitForState()
+	return deleteStateConf.WaitForStateContext(context)
 }
 
-func waitForNewWorker(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workersCount int) (interface{}, error) {
+func waitForNewWorker(context context.Context, d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workersCount int) (interface{}, error) {
 	csClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
 		return nil, err
@@ -1338,27 +1724,111 @@ func waitForNewWorker(d *schema.ResourceData, meta interface{}, targetEnv v2.Clu
 		MinTimeout:   5 * time.Second,
 		PollInterval: 5 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
-func getNewWorkerID(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workersInfo map[string]int) (string, int, error) {
+func getNewWorkerIDs(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workersInfo map[string]int) ([]string, error) {
 	csClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return "", -1, err
+		return nil, err
 	}
 
 	clusterID := d.Id()
 
 	workers, err := csClient.Workers().ListWorkers(clusterID, false, targetEnv)
 	if err != nil {
-		return "", -1, fmt.Errorf("Error in retriving the list of worker nodes")
+		return nil, fmt.Errorf("Error in retriving the list of worker nodes")
 	}
 
+	var newWorkerIDs []string
 	for index, worker := range workers {
 		if _, ok := workersInfo[worker.ID]; !ok {
 			log.Println("found new replaced node: ", worker.ID)
-			return worker.ID, index, nil
+			workersInfo[worker.ID] = index
+			newWorkerIDs = append(newWorkerIDs, worker.ID)
 		}
 	}
-	return "", -1, fmt.Errorf("no new node found")
+	if len(newWorkerIDs) == 0 {
+		return nil, fmt.Errorf("no new node found")
+	}
+	return newWorkerIDs, nil
+}
+
+// vpcClusterWorkerUpdateBatches returns the workers to replace for the kube version
+// of the master or the patch version, in batches of at most maxUnavailable workers.
+func vpcClusterWorkerUpdateBatches(workers []v2.Worker, masterVersion, patchVersion string, maxUnavailable int) [][]v2.Worker {
+	var batches [][]v2.Worker
+	var batch []v2.Worker
+	for _, worker := range workers {
+		// check if change is present in MAJOR.MINOR version or in PATCH version
+		if strings.Split(worker.KubeVersion.Actual, "_")[0] != strings.Split(masterVersion, "_")[0] || (strings.Split(worker.KubeVersion.Actual, ".")[2] != patchVersion && patchVersion == strings.Split(worker.KubeVersion.Target, ".")[2]) {
+			batch = append(batch, worker)
+			if len(batch) == maxUnavailable {
+				batches = append(batches, batch)
+				batch = nil
+			}
+		}
+	}
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+	return batches
+}
+
+func vpcClusterWorkerIDs(workers []v2.Worker) []string {
+	ids := make([]string, 0, len(workers))
+	for _, worker := range workers {
+		ids = append(ids, worker.ID)
+	}
+	return ids
+}
+
+// replaceVpcClusterWorkers replaces a batch of workers and, with wait, waits until
+// the new workers are ready with the kube version of the master.
+func replaceVpcClusterWorkers(context context.Context, d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, masterVersion string, batch []v2.Worker, workersInfo map[string]int, workersCount int, wait bool) error {
+	csClient, err := meta.(ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	clusterID := d.Id()
+	ids := strings.Join(vpcClusterWorkerIDs(batch), ", ")
+
+	for _, worker := range batch {
+		_, err := csClient.Workers().ReplaceWokerNode(clusterID, worker.ID, targetEnv)
+		// As API returns http response 204 NO CONTENT, error raised will be exempted.
+		if err != nil && !strings.Contains(err.Error(), "EmptyResponseBody") {
+			return apiErrorf("container", err, nil, "Error replacing the worker node %s from the cluster", worker.ID)
+		}
+	}
+	if !wait {
+		return nil
+	}
+
+	//1. wait for worker nodes to delete
+	for _, worker := range batch {
+		_, deleteError := waitForWorkerNodetoDelete(context, d, meta, targetEnv, worker.ID)
+		if deleteError != nil {
+			return fmt.Errorf("Worker node - %s is failed to replace", worker.ID)
+		}
+		delete(workersInfo, worker.ID)
+	}
+
+	//2. wait for new workerNodes
+	_, newWorkerError := waitForNewWorker(context, d, meta, targetEnv, workersCount)
+	if newWorkerError != nil {
+		return fmt.Errorf("Failed to spawn new worker nodes for %s", ids)
+	}
+
+	//3. Get new worker node IDs and update the map
+	newWorkerIDs, newNodeError := getNewWorkerIDs(d, meta, targetEnv, workersInfo)
+	if newNodeError != nil {
+		return fmt.Errorf("Unable to find the new worker nodes info for %s", ids)
+	}
+
+	//4. wait for the workers' version update and normal state
+	_, err = WaitForVpcClusterWokersVersionUpdate(context, d, meta, targetEnv, masterVersion, newWorkerIDs...)
+	if err != nil {
+		return fmt.Errorf("Error waiting for cluster (%s) worker nodes %s replacing %s kube version to be updated: %s", d.Id(), strings.Join(newWorkerIDs, ", "), ids, err)
+	}
+	return nil
 }
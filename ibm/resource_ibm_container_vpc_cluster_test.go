@@ -4,14 +4,18 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
 
 	bluemix "github.com/IBM-Cloud/bluemix-go"
 	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
@@ -61,6 +65,10 @@ func TestAccIBMContainerVpcClusterBasic(t *testing.T) {
 						"ibm_container_vpc_cluster.cluster", "worker_labels.%", "2"),
 					resource.TestCheckResourceAttr(
 						"ibm_container_vpc_cluster.cluster", "kms_config.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.cluster", "health.0.workers_health", "normal"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.cluster", "health.0.workers_normal", "2"),
 				),
 			},
 			{
@@ -68,7 +76,7 @@ func TestAccIBMContainerVpcClusterBasic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"wait_till", "update_all_workers", "kms_config"},
+					"wait_till", "update_all_workers", "kms_config", "readiness_gates"},
 			},
 		},
 	})
@@ -277,9 +285,232 @@ resource "ibm_container_vpc_cluster" "cluster" {
 	"test"  = "test-default-pool"
 	"test1" = "test-default-pool1"
 	}
-	
+	readiness_gates {
+		all_workers_normal = true
+		addons_healthy     = true
+		worker_pool {
+			name        = "default"
+			ready_count = 1
+		}
+	}
   }`, name)
 }
+
+func TestAccIBMContainerVpcClusterWorkerUpdateStrategy(t *testing.T) {
+	name := fmt.Sprintf("tf-vpc-cluster-%d", acctest.RandIntRange(10, 100))
+	var conf *v2.ClusterInfo
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMContainerVpcClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMContainerVpcClusterWorkerUpdateStrategy(name, "1.20.7", false),
+				ExpectError: regexp.MustCompile("worker_update_strategy requires wait_for_worker_update"),
+			},
+			{
+				Config: testAccCheckIBMContainerVpcClusterWorkerUpdateStrategy(name, "1.20.7", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMContainerVpcExists("ibm_container_vpc_cluster.cluster", conf),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.cluster", "kube_version", "1.20.7"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.cluster", "worker_update_strategy.0.max_unavailable", "2"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerVpcClusterWorkerUpdateStrategy(name, "1.21.1", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMContainerVpcExists("ibm_container_vpc_cluster.cluster", conf),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.cluster", "kube_version", "1.21.1"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.cluster", "health.0.workers_normal", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerVpcClusterWorkerUpdateStrategy(name, kubeVersion string, waitForWorkerUpdate bool) string {
+	return fmt.Sprintf(`
+provider "ibm" {
+	region ="eu-de"
+}
+data "ibm_resource_group" "resource_group" {
+	is_default = "true"
+}
+resource "ibm_is_vpc" "vpc" {
+	name = "%[1]s"
+}
+resource "ibm_is_subnet" "subnet" {
+	name                     = "%[1]s"
+	vpc                      = ibm_is_vpc.vpc.id
+	zone                     = "eu-de-1"
+	total_ipv4_address_count = 256
+}
+resource "ibm_container_vpc_cluster" "cluster" {
+	name                   = "%[1]s"
+	vpc_id                 = ibm_is_vpc.vpc.id
+	flavor                 = "cx2.2x4"
+	worker_count           = 3
+	kube_version           = "%[2]s"
+	update_all_workers     = true
+	wait_for_worker_update = %[3]t
+	wait_till              = "OneWorkerNodeReady"
+	resource_group_id      = data.ibm_resource_group.resource_group.id
+	zones {
+		subnet_id = ibm_is_subnet.subnet.id
+		name      = "eu-de-1"
+	}
+	worker_update_strategy {
+		max_unavailable  = 2
+		pause_on_failure = true
+	}
+}`, name, kubeVersion, waitForWorkerUpdate)
+}
+
+func TestVpcClusterWorkerUpdateBatches(t *testing.T) {
+	worker := func(id, actual, target string) v2.Worker {
+		w := v2.Worker{ID: id}
+		w.KubeVersion.Actual = actual
+		w.KubeVersion.Target = target
+		return w
+	}
+	workers := []v2.Worker{
+		worker("w1", "1.20.7_1535", "1.20.7_1540"),
+		worker("w2", "1.20.7_1540", "1.20.7_1540"),
+		worker("w3", "1.20.7_1535", "1.20.7_1540"),
+		worker("w4", "1.19.11_1545", "1.20.7_1540"),
+	}
+	batchIDs := func(batches [][]v2.Worker) [][]string {
+		ids := [][]string{}
+		for _, batch := range batches {
+			ids = append(ids, vpcClusterWorkerIDs(batch))
+		}
+		return ids
+	}
+
+	// Only the workers behind the master are replaced without a patch version
+	assert.DeepEqual(t, batchIDs(vpcClusterWorkerUpdateBatches(workers, "1.20.7_1535", "", 1)), [][]string{{"w4"}})
+	assert.DeepEqual(t, batchIDs(vpcClusterWorkerUpdateBatches(workers, "1.20.7_1535", "7_1540", 1)), [][]string{{"w1"}, {"w3"}, {"w4"}})
+	assert.DeepEqual(t, batchIDs(vpcClusterWorkerUpdateBatches(workers, "1.20.7_1535", "7_1540", 2)), [][]string{{"w1", "w3"}, {"w4"}})
+	assert.DeepEqual(t, batchIDs(vpcClusterWorkerUpdateBatches(workers, "1.20.7_1535", "7_1540", 10)), [][]string{{"w1", "w3", "w4"}})
+	assert.DeepEqual(t, batchIDs(vpcClusterWorkerUpdateBatches(workers, "1.20.7_1535", "7_1541", 1)), [][]string{{"w4"}})
+}
+
+func TestIBMContainerVpcClusterMock(t *testing.T) {
+	t.Parallel()
+	srv := testMockServer(t)
+	meta := testMockClientSession(t, srv)
+	vpcID, subnetID := testMockSubnet(t, meta)
+	clusterID := testMockVpcCluster(t, meta, vpcID, subnetID)
+	csClient, err := meta.(ClientSession).VpcContainerAPI()
+	assert.NilError(t, err)
+	r := resourceIBMContainerVpcCluster()
+
+	d := r.Data(nil)
+	d.SetId(clusterID)
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), d, meta)))
+	assert.DeepEqual(t, d.Get("health").([]interface{})[0], map[string]interface{}{
+		"master_state":   "deployed",
+		"master_health":  "normal",
+		"workers_health": "normal",
+		"workers_total":  1,
+		"workers_normal": 1,
+		"addons_health":  "normal",
+		"ingress_health": "normal",
+	})
+
+	privateALB := "private-cr" + clusterID + "-alb1"
+	gates := map[string]interface{}{
+		"all_workers_normal": true,
+		"addons_healthy":     true,
+		"albs_enabled":       schema.NewSet(schema.HashString, []interface{}{privateALB, "public-cr" + clusterID + "-alb1"}),
+		"worker_pool": schema.NewSet(schema.HashResource(r.Schema["readiness_gates"].Elem.(*schema.Resource).Schema["worker_pool"].Elem.(*schema.Resource)), []interface{}{
+			map[string]interface{}{"name": "default", "ready_count": 2},
+		}),
+	}
+	unready, err := vpcClusterUnreadyGates(meta, clusterID, gates, v2.ClusterTargetHeader{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, unready, []string{
+		"worker pool default has 1 of 2 ready workers",
+		"ALB " + privateALB + " is disabled",
+	})
+
+	assert.NilError(t, csClient.Albs().EnableAlb(v2.AlbConfig{AlbID: privateALB, Enable: true}, v2.ClusterTargetHeader{}))
+	updated := testMockResourceData(t, r, d, map[string]interface{}{
+		"name":   "mock-cluster",
+		"flavor": "bx2.4x16",
+		"vpc_id": vpcID,
+		"zones": []interface{}{map[string]interface{}{
+			"name":      "us-south-1",
+			"subnet_id": subnetID,
+		}},
+		"readiness_gates": []interface{}{map[string]interface{}{
+			"all_workers_normal": true,
+			"addons_healthy":     true,
+			"albs_enabled":       []interface{}{privateALB},
+			"worker_pool": []interface{}{map[string]interface{}{
+				"name":        "default",
+				"ready_count": 1,
+			}},
+		}},
+	})
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), updated, meta)))
+	assert.Equal(t, updated.Get("albs.#"), 2)
+	assert.Equal(t, updated.Get("health.0.ingress_health"), "normal")
+
+	// Resizing the worker pool waits on the unchanged readiness gates
+	assert.NilError(t, csClient.Albs().DisableAlb(v2.AlbConfig{AlbID: privateALB, Enable: false}, v2.ClusterTargetHeader{}))
+	resizedRaw := map[string]interface{}{
+		"name":         "mock-cluster",
+		"flavor":       "bx2.4x16",
+		"vpc_id":       vpcID,
+		"worker_count": 2,
+		"zones": []interface{}{map[string]interface{}{
+			"name":      "us-south-1",
+			"subnet_id": subnetID,
+		}},
+		"readiness_gates": []interface{}{map[string]interface{}{
+			"all_workers_normal": true,
+			"addons_healthy":     true,
+			"albs_enabled":       []interface{}{privateALB},
+			"worker_pool": []interface{}{map[string]interface{}{
+				"name":        "default",
+				"ready_count": 1,
+			}},
+		}},
+	}
+	resized := testMockResourceData(t, r, updated, resizedRaw)
+	// the gates are checked before the wait is canceled
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorContains(t, testDiagsErr(r.UpdateContext(ctx, resized, meta)),
+		"Error waiting for the readiness gates of cluster ("+clusterID+"), ALB "+privateALB+" is disabled: context canceled")
+	assert.NilError(t, csClient.Albs().EnableAlb(v2.AlbConfig{AlbID: privateALB, Enable: true}, v2.ClusterTargetHeader{}))
+	resized = testMockResourceData(t, r, updated, resizedRaw)
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), resized, meta)))
+	assert.Equal(t, resized.Get("worker_count"), 2)
+	assert.Equal(t, resized.Get("health.0.workers_total"), 2)
+	assert.Equal(t, resized.Get("health.0.workers_normal"), 2)
+
+	// The rolling update of the workers needs to wait for them
+	_, err = r.Diff(context.Background(), resized.State(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":                   "mock-cluster",
+		"flavor":                 "bx2.4x16",
+		"vpc_id":                 vpcID,
+		"wait_for_worker_update": false,
+		"worker_update_strategy": []interface{}{map[string]interface{}{"max_unavailable": 2}},
+		"zones": []interface{}{map[string]interface{}{
+			"name":      "us-south-1",
+			"subnet_id": subnetID,
+		}},
+	}), meta)
+	assert.ErrorContains(t, err, "worker_update_strategy requires wait_for_worker_update")
+}
+
 func testAccCheckIBMContainerOcpClusterBasic(name, openshiftFlavour, openShiftworkerCount string) string {
 	return fmt.Sprintf(`
 provider "ibm" {
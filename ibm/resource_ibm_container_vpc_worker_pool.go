@@ -4,17 +4,20 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
 	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.ibm.com/ibmcloud/kubernetesservice-go-sdk/kubernetesserviceapiv1"
 )
 
 const (
@@ -24,12 +27,11 @@ const (
 func resourceIBMContainerVpcWorkerPool() *schema.Resource {
 
 	return &schema.Resource{
-		Create:   resourceIBMContainerVpcWorkerPoolCreate,
-		Update:   resourceIBMContainerVpcWorkerPoolUpdate,
-		Read:     resourceIBMContainerVpcWorkerPoolRead,
-		Delete:   resourceIBMContainerVpcWorkerPoolDelete,
-		Exists:   resourceIBMContainerVpcWorkerPoolExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerVpcWorkerPoolCreate,
+		UpdateContext: resourceIBMContainerVpcWorkerPoolUpdate,
+		ReadContext:   resourceIBMContainerVpcWorkerPoolRead,
+		DeleteContext: resourceIBMContainerVpcWorkerPoolDelete,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
 			Delete: schema.DefaultTimeout(90 * time.Minute),
@@ -86,6 +88,38 @@ func resourceIBMContainerVpcWorkerPool() *schema.Resource {
 				Description: "Labels",
 			},
 
+			"taints": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Taints of the worker nodes of the worker pool",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Key of the taint",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Value of the taint",
+						},
+						"effect": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue([]string{"NoSchedule", "PreferNoSchedule", "NoExecute"}),
+							Description:  "Effect of the taint, NoSchedule, PreferNoSchedule or NoExecute",
+						},
+					},
+				},
+			},
+
+			"autoscale_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the cluster autoscaler scales the worker pool",
+			},
+
 			"resource_group_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -119,11 +153,11 @@ func resourceIBMContainerVpcWorkerPool() *schema.Resource {
 	}
 }
 
-func resourceIBMContainerVpcWorkerPoolCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcWorkerPoolCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	wpClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterNameorID := d.Get("cluster").(string)
@@ -179,27 +213,26 @@ func resourceIBMContainerVpcWorkerPoolCreate(d *schema.ResourceData, meta interf
 	workerPoolsAPI := wpClient.WorkerPools()
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	res, err := workerPoolsAPI.CreateWorkerPool(params, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", clusterNameorID, res.ID))
 
 	//wait for workerpool availability
-	_, err = WaitForWorkerPoolAvailable(d, meta, clusterNameorID, res.ID, d.Timeout(schema.TimeoutCreate), targetEnv)
+	_, err = WaitForWorkerPoolAvailable(context, d, meta, clusterNameorID, res.ID, d.Timeout(schema.TimeoutCreate), targetEnv)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for workerpool (%s) to become ready: %s", d.Id(), err)
+		return diag.FromErr(apiErrorf("container", err, nil, "Error waiting for workerpool (%s) to become ready", d.Id()))
 	}
 
-	return resourceIBMContainerVpcWorkerPoolUpdate(d, meta)
+	return resourceIBMContainerVpcWorkerPoolUpdate(context, d, meta)
 }
 
-func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcWorkerPoolUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	if d.HasChange("labels") && !d.IsNewResource() {
 		clusterNameOrID := d.Get("cluster").(string)
@@ -214,18 +247,17 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 
 		targetEnv, err := getVpcClusterTargetHeader(d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		ClusterClient, err := meta.(ClientSession).ContainerAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		Env := v1.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
 
 		err = ClusterClient.WorkerPools().UpdateLabelsWorkerPool(clusterNameOrID, workerPoolName, labels, Env)
 		if err != nil {
-			return fmt.Errorf(
-				"Error updating the labels: %s", err)
+			return diag.FromErr(apiErrorf("container", err, nil, "Error updating the labels"))
 		}
 	}
 
@@ -235,18 +267,30 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 		count := d.Get("worker_count").(int)
 		targetEnv, err := getVpcClusterTargetHeader(d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		ClusterClient, err := meta.(ClientSession).ContainerAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		Env := v1.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
 
 		err = ClusterClient.WorkerPools().ResizeWorkerPool(clusterNameOrID, workerPoolName, count, Env)
 		if err != nil {
-			return fmt.Errorf(
-				"Error updating the worker_count %d: %s", count, err)
+			return diag.FromErr(apiErrorf("container", err, nil, "Error updating the worker_count %d", count))
+		}
+	}
+
+	if d.HasChange("taints") {
+		clusterNameOrID := d.Get("cluster").(string)
+		workerPoolName := d.Get("worker_pool_name").(string)
+		targetEnv, err := getVpcClusterTargetHeader(d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = updateVpcWorkerPoolTaints(context, meta, clusterNameOrID, workerPoolName, d.Get("taints").(*schema.Set), targetEnv)
+		if err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
@@ -255,7 +299,7 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 		workerPoolName := d.Get("worker_pool_name").(string)
 		targetEnv, err := getVpcClusterTargetHeader(d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		oldList, newList := d.GetChange("zones")
 		if oldList == nil {
@@ -271,7 +315,7 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 		if len(add) > 0 {
 			csClient, err := meta.(ClientSession).VpcContainerAPI()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			for _, zone := range add {
 				newZone := zone.(map[string]interface{})
@@ -283,12 +327,11 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 				}
 				err = csClient.WorkerPools().CreateWorkerPoolZone(zoneParam, targetEnv)
 				if err != nil {
-					return fmt.Errorf("Error adding zone to conatiner vpc cluster: %s", err)
+					return diag.FromErr(apiErrorf("container", err, nil, "Error adding zone to conatiner vpc cluster"))
 				}
-				_, err = WaitForWorkerPoolAvailable(d, meta, clusterID, workerPoolName, d.Timeout(schema.TimeoutCreate), targetEnv)
+				_, err = WaitForWorkerPoolAvailable(context, d, meta, clusterID, workerPoolName, d.Timeout(schema.TimeoutCreate), targetEnv)
 				if err != nil {
-					return fmt.Errorf(
-						"Error waiting for workerpool (%s) to become ready: %s", d.Id(), err)
+					return diag.FromErr(apiErrorf("container", err, nil, "Error waiting for workerpool (%s) to become ready", d.Id()))
 				}
 
 			}
@@ -298,32 +341,40 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 				oldZone := zone.(map[string]interface{})
 				ClusterClient, err := meta.(ClientSession).ContainerAPI()
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 				Env := v1.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
 				err = ClusterClient.WorkerPools().RemoveZone(clusterID, oldZone["name"].(string), workerPoolName, Env)
 				if err != nil {
-					return fmt.Errorf("Error deleting zone to conatiner vpc cluster: %s", err)
+					return diag.FromErr(apiErrorf("container", err, nil, "Error deleting zone to conatiner vpc cluster"))
 				}
-				_, err = WaitForV2WorkerZoneDeleted(clusterID, workerPoolName, oldZone["name"].(string), meta, d.Timeout(schema.TimeoutDelete), targetEnv)
+				_, err = WaitForV2WorkerZoneDeleted(context, clusterID, workerPoolName, oldZone["name"].(string), meta, d.Timeout(schema.TimeoutDelete), targetEnv)
 				if err != nil {
-					return fmt.Errorf(
-						"Error waiting for deleting workers of worker pool (%s) of cluster (%s):  %s", workerPoolName, clusterID, err)
+					return diag.FromErr(apiErrorf("container", err, nil, "Error waiting for deleting workers of worker pool (%s) of cluster (%s)", workerPoolName, clusterID))
 				}
 			}
 		}
 	}
-	return resourceIBMContainerVpcWorkerPoolRead(d, meta)
+	return resourceIBMContainerVpcWorkerPoolRead(context, d, meta)
 }
 
-func resourceIBMContainerVpcWorkerPoolRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcWorkerPoolRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMContainerVpcWorkerPoolExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	wpClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cluster := parts[0]
 	workerPoolID := parts[1]
@@ -331,12 +382,12 @@ func resourceIBMContainerVpcWorkerPoolRead(d *schema.ResourceData, meta interfac
 	workerPoolsAPI := wpClient.WorkerPools()
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	workerPool, err := workerPoolsAPI.GetWorkerPool(cluster, workerPoolID, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var zones = make([]map[string]interface{}, 0)
@@ -352,7 +403,25 @@ func resourceIBMContainerVpcWorkerPoolRead(d *schema.ResourceData, meta interfac
 
 	cls, err := wpClient.Clusters().GetCluster(cluster, targetEnv)
 	if err != nil {
-		return fmt.Errorf("Error retrieving conatiner vpc cluster: %s", err)
+		return diag.FromErr(apiErrorf("container", err, nil, "Error retrieving conatiner vpc cluster"))
+	}
+
+	// The taints and the autoscaler status are only returned by the Kubernetes
+	// Service API client
+	ksClient, err := meta.(ClientSession).SatelliteClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	getWorkerPoolOptions := &kubernetesserviceapiv1.VpcGetWorkerPoolOptions{
+		Cluster:    &cluster,
+		Workerpool: &workerPoolID,
+	}
+	if targetEnv.ResourceGroup != "" {
+		getWorkerPoolOptions.XAuthResourceGroup = &targetEnv.ResourceGroup
+	}
+	ksWorkerPool, response, err := ksClient.VpcGetWorkerPoolWithContext(context, getWorkerPoolOptions)
+	if err != nil {
+		return diag.FromErr(apiErrorf("container", err, response, "Error retrieving worker pool (%s)", d.Id()))
 	}
 
 	d.Set("worker_pool_name", workerPool.PoolName)
@@ -361,26 +430,28 @@ func resourceIBMContainerVpcWorkerPoolRead(d *schema.ResourceData, meta interfac
 	// d.Set("provider", workerPool.Provider)
 	d.Set("labels", IgnoreSystemLabels(workerPool.Labels))
 	d.Set("zones", zones)
+	d.Set("taints", flattenWorkerPoolTaints(ksWorkerPool.Taints))
+	d.Set("autoscale_enabled", ksWorkerPool.AutoscaleEnabled != nil && *ksWorkerPool.AutoscaleEnabled)
 	d.Set("resource_group_id", cls.ResourceGroupID)
 	d.Set("cluster", cluster)
 	d.Set("vpc_id", workerPool.VpcID)
 
 	controller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(ResourceControllerURL, controller+"/kubernetes/clusters")
 	return nil
 }
 
-func resourceIBMContainerVpcWorkerPoolDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcWorkerPoolDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	wpClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	clusterNameorID := parts[0]
 	workerPoolNameorID := parts[1]
@@ -388,17 +459,16 @@ func resourceIBMContainerVpcWorkerPoolDelete(d *schema.ResourceData, meta interf
 	workerPoolsAPI := wpClient.WorkerPools()
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = workerPoolsAPI.DeleteWorkerPool(clusterNameorID, workerPoolNameorID, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	_, err = WaitForVpcWorkerDelete(clusterNameorID, workerPoolNameorID, meta, d.Timeout(schema.TimeoutDelete), targetEnv)
+	_, err = WaitForVpcWorkerDelete(context, clusterNameorID, workerPoolNameorID, meta, d.Timeout(schema.TimeoutDelete), targetEnv)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for removing workers of worker pool (%s) of cluster (%s): %s", workerPoolNameorID, clusterNameorID, err)
+		return diag.FromErr(apiErrorf("container", err, nil, "Error waiting for removing workers of worker pool (%s) of cluster (%s)", workerPoolNameorID, clusterNameorID))
 	}
 	d.SetId("")
 	return nil
@@ -429,14 +499,61 @@ func resourceIBMContainerVpcWorkerPoolExists(d *schema.ResourceData, meta interf
 				return false, nil
 			}
 		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
+		return false, apiErrorf("container", err, nil, "Error communicating with the API")
 	}
 
 	return workerPool.ID == workerPoolID, nil
 }
 
+// updateVpcWorkerPoolTaints replaces the taints of the worker pool, which the API
+// takes as key to value:effect.
+func updateVpcWorkerPoolTaints(context context.Context, meta interface{}, clusterNameOrID, workerPoolNameOrID string, taintSet *schema.Set, target v2.ClusterTargetHeader) error {
+	ksClient, err := meta.(ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+	taints := make(map[string]string)
+	for _, t := range taintSet.List() {
+		taint := t.(map[string]interface{})
+		key := taint["key"].(string)
+		if _, ok := taints[key]; ok {
+			return fmt.Errorf("The taint key %q of worker pool %s is set more than once", key, workerPoolNameOrID)
+		}
+		taints[key] = fmt.Sprintf("%s:%s", taint["value"].(string), taint["effect"].(string))
+	}
+	setTaintsOptions := &kubernetesserviceapiv1.V2SetWorkerPoolTaintsOptions{
+		Cluster:    &clusterNameOrID,
+		Workerpool: &workerPoolNameOrID,
+		Taints:     taints,
+	}
+	if target.ResourceGroup != "" {
+		setTaintsOptions.XAuthResourceGroup = &target.ResourceGroup
+	}
+	response, err := ksClient.V2SetWorkerPoolTaintsWithContext(context, setTaintsOptions)
+	if err != nil {
+		return apiErrorf("container", err, response, "Error updating the taints of worker pool %s", workerPoolNameOrID)
+	}
+	return nil
+}
+
+func flattenWorkerPoolTaints(taints map[string]string) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(taints))
+	for key, valueEffect := range taints {
+		i := strings.LastIndex(valueEffect, ":")
+		if i < 0 {
+			continue
+		}
+		flattened = append(flattened, map[string]interface{}{
+			"key":    key,
+			"value":  valueEffect[:i],
+			"effect": valueEffect[i+1:],
+		})
+	}
+	return flattened
+}
+
 // WaitForWorkerPoolAvailable Waits for worker creation
-func WaitForWorkerPoolAvailable(d *schema.ResourceData, meta interface{}, clusterNameOrID, workerPoolNameOrID string, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
+func WaitForWorkerPoolAvailable(context context.Context, d *schema.ResourceData, meta interface{}, clusterNameOrID, workerPoolNameOrID string, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
 	wpClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
 		return nil, err
@@ -453,14 +570,14 @@ func WaitForWorkerPoolAvailable(d *schema.ResourceData, meta interface{}, cluste
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func vpcWorkerPoolStateRefreshFunc(client v2.Workers, instanceID string, workerPoolNameOrID string, target v2.ClusterTargetHeader) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		workerFields, err := client.ListByWorkerPool(instanceID, "", false, target)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error retrieving workers for cluster: %s", err)
+			return nil, "", apiErrorf("container", err, nil, "Error retrieving workers for cluster")
 		}
 		// Check active transactions
 		//Check for worker state to be deployed
@@ -477,7 +594,7 @@ func vpcWorkerPoolStateRefreshFunc(client v2.Workers, instanceID string, workerP
 	}
 }
 
-func WaitForVpcWorkerDelete(clusterNameOrID, workerPoolNameOrID string, meta interface{}, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
+func WaitForVpcWorkerDelete(context context.Context, clusterNameOrID, workerPoolNameOrID string, meta interface{}, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
 	wpClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
 		return nil, err
@@ -492,14 +609,14 @@ func WaitForVpcWorkerDelete(clusterNameOrID, workerPoolNameOrID string, meta int
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func vpcworkerPoolDeleteStateRefreshFunc(client v2.Workers, instanceID, workerPoolNameOrID string, target v2.ClusterTargetHeader) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		workerFields, err := client.ListByWorkerPool(instanceID, "", true, target)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error retrieving workers for cluster: %s", err)
+			return nil, "", apiErrorf("container", err, nil, "Error retrieving workers for cluster")
 		}
 		//Done worker has two fields desiredState and actualState , so check for those 2
 		for _, e := range workerFields {
@@ -0,0 +1,266 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+)
+
+const (
+	containerAutoscalerAddon     = "cluster-autoscaler"
+	containerAutoscalerNamespace = "kube-system"
+	containerAutoscalerConfigMap = "iks-ca-configmap"
+	containerAutoscalerPoolsKey  = "workerPoolsConfig.json"
+)
+
+// autoscalerWorkerPoolConfig is the entry of a worker pool in the configuration of
+// the cluster-autoscaler add-on, the workerPoolsConfig.json of its config map.
+type autoscalerWorkerPoolConfig struct {
+	Name    string `json:"name"`
+	MinSize int    `json:"minSize"`
+	MaxSize int    `json:"maxSize"`
+	Enabled bool   `json:"enabled"`
+}
+
+func resourceIBMContainerVpcWorkerPoolAutoscaler() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMContainerVpcWorkerPoolAutoscalerCreate,
+		ReadContext:   resourceIBMContainerVpcWorkerPoolAutoscalerRead,
+		UpdateContext: resourceIBMContainerVpcWorkerPoolAutoscalerUpdate,
+		DeleteContext: resourceIBMContainerVpcWorkerPoolAutoscalerDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+			minSize, maxSize := diff.Get("min_size").(int), diff.Get("max_size").(int)
+			if diff.NewValueKnown("min_size") && diff.NewValueKnown("max_size") && minSize > maxSize {
+				return fmt.Errorf("min_size %d of the worker pool must not exceed its max_size %d", minSize, maxSize)
+			}
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name or ID of the cluster",
+			},
+			"worker_pool": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the worker pool to autoscale",
+			},
+			"min_size": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Minimum number of worker nodes per zone of the worker pool",
+			},
+			"max_size": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Maximum number of worker nodes per zone of the worker pool",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the cluster autoscaler scales the worker pool",
+			},
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "ID of the resource group of the cluster",
+			},
+		},
+	}
+}
+
+func resourceIBMContainerVpcWorkerPoolAutoscalerCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	csClient, err := meta.(ClientSession).VpcContainerAPI()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	clusterNameOrID := d.Get("cluster").(string)
+	workerPoolName := d.Get("worker_pool").(string)
+	workerPool, err := csClient.WorkerPools().GetWorkerPool(clusterNameOrID, workerPoolName, targetEnv)
+	if err != nil {
+		return diag.FromErr(apiErrorf("container", err, nil, "Error retrieving worker pool %s of cluster %s", workerPoolName, clusterNameOrID))
+	}
+	// The entries of the config map are keyed by pool name
+	workerPoolName = workerPool.PoolName
+
+	err = updateAutoscalerWorkerPoolConfig(meta, clusterNameOrID, targetEnv, autoscalerWorkerPoolConfig{
+		Name:    workerPoolName,
+		MinSize: d.Get("min_size").(int),
+		MaxSize: d.Get("max_size").(int),
+		Enabled: d.Get("enabled").(bool),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", clusterNameOrID, workerPoolName))
+	return resourceIBMContainerVpcWorkerPoolAutoscalerRead(context, d, meta)
+}
+
+func resourceIBMContainerVpcWorkerPoolAutoscalerRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	clusterNameOrID, workerPoolName := parts[0], parts[1]
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	kubeClient, err := createContainerKubeRESTClient(meta, clusterNameOrID, targetEnv)
+	if err != nil {
+		if isContainerNotFound(err) {
+			log.Printf("[WARN] Cluster %s of the worker pool autoscaler is gone", clusterNameOrID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	_, pools, err := getAutoscalerWorkerPoolsConfig(kubeClient, clusterNameOrID)
+	if err != nil {
+		if isContainerNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	for _, pool := range pools {
+		if pool.Name == workerPoolName {
+			d.Set("cluster", clusterNameOrID)
+			d.Set("worker_pool", pool.Name)
+			d.Set("min_size", pool.MinSize)
+			d.Set("max_size", pool.MaxSize)
+			d.Set("enabled", pool.Enabled)
+			return nil
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+func resourceIBMContainerVpcWorkerPoolAutoscalerUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("min_size") || d.HasChange("max_size") || d.HasChange("enabled") {
+		parts, err := idParts(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		targetEnv, err := getVpcClusterTargetHeader(d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = updateAutoscalerWorkerPoolConfig(meta, parts[0], targetEnv, autoscalerWorkerPoolConfig{
+			Name:    parts[1],
+			MinSize: d.Get("min_size").(int),
+			MaxSize: d.Get("max_size").(int),
+			Enabled: d.Get("enabled").(bool),
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceIBMContainerVpcWorkerPoolAutoscalerRead(context, d, meta)
+}
+
+// resourceIBMContainerVpcWorkerPoolAutoscalerDelete disables the autoscaling of the
+// worker pool, its entry is kept in the config map as the add-on creates it.
+func resourceIBMContainerVpcWorkerPoolAutoscalerDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = updateAutoscalerWorkerPoolConfig(meta, parts[0], targetEnv, autoscalerWorkerPoolConfig{
+		Name:    parts[1],
+		MinSize: d.Get("min_size").(int),
+		MaxSize: d.Get("max_size").(int),
+		Enabled: false,
+	})
+	if err != nil && !isContainerNotFound(err) {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+// getAutoscalerWorkerPoolsConfig returns the config map of the cluster-autoscaler
+// add-on with the worker pool entries of its configuration.
+func getAutoscalerWorkerPoolsConfig(kubeClient *containerKubeRESTClient, clusterNameOrID string) (*kubeConfigMap, []autoscalerWorkerPoolConfig, error) {
+	configMap, err := kubeClient.getConfigMap(containerAutoscalerNamespace, containerAutoscalerConfigMap)
+	if err != nil {
+		return nil, nil, apiErrorf("container", err, nil, "Error getting the configuration of the %s add-on of cluster %s, make sure the add-on is enabled", containerAutoscalerAddon, clusterNameOrID)
+	}
+	pools := []autoscalerWorkerPoolConfig{}
+	if config := configMap.Data[containerAutoscalerPoolsKey]; config != "" {
+		if err := json.Unmarshal([]byte(config), &pools); err != nil {
+			return nil, nil, fmt.Errorf("Error parsing %s of the %s config map of cluster %s: %s", containerAutoscalerPoolsKey, containerAutoscalerConfigMap, clusterNameOrID, err)
+		}
+	}
+	return configMap, pools, nil
+}
+
+// updateAutoscalerWorkerPoolConfig sets the entry of a worker pool in the
+// configuration of the cluster-autoscaler add-on. The changes to the config map of
+// a cluster are serialized, as each one replaces the entries of every pool.
+func updateAutoscalerWorkerPoolConfig(meta interface{}, clusterNameOrID string, target v2.ClusterTargetHeader, config autoscalerWorkerPoolConfig) error {
+	kubeClient, err := createContainerKubeRESTClient(meta, clusterNameOrID, target)
+	if err != nil {
+		return err
+	}
+	ibmMutexKV.Lock(clusterNameOrID)
+	defer ibmMutexKV.Unlock(clusterNameOrID)
+
+	configMap, pools, err := getAutoscalerWorkerPoolsConfig(kubeClient, clusterNameOrID)
+	if err != nil {
+		return err
+	}
+	found := false
+	for i, pool := range pools {
+		if pool.Name == config.Name {
+			pools[i] = config
+			found = true
+		}
+	}
+	if !found {
+		pools = append(pools, config)
+	}
+	data, err := json.MarshalIndent(pools, "", " ")
+	if err != nil {
+		return err
+	}
+	if configMap.Data == nil {
+		configMap.Data = make(map[string]string)
+	}
+	configMap.Data[containerAutoscalerPoolsKey] = string(data)
+	err = kubeClient.replaceConfigMap(configMap)
+	if err != nil {
+		return apiErrorf("container", err, nil, "Error updating the autoscaling of worker pool %s of cluster %s", config.Name, clusterNameOrID)
+	}
+	return nil
+}
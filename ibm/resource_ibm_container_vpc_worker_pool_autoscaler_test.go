@@ -0,0 +1,217 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
+
+	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+)
+
+func TestAccIBMContainerVpcWorkerPoolAutoscalerBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-vpc-autoscaler-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMVpcContainerWorkerPoolAutoscaler(name, 1, 3, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool_autoscaler.autoscaler", "min_size", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool_autoscaler.autoscaler", "max_size", "3"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool_autoscaler.autoscaler", "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccCheckIBMVpcContainerWorkerPoolAutoscaler(name, 2, 4, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool_autoscaler.autoscaler", "min_size", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool_autoscaler.autoscaler", "max_size", "4"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool_autoscaler.autoscaler", "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      "ibm_container_vpc_worker_pool_autoscaler.autoscaler",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMVpcContainerWorkerPoolAutoscaler(name string, minSize, maxSize int, enabled bool) string {
+	return fmt.Sprintf(`
+	provider "ibm" {
+		region="eu-de"
+	}
+	data "ibm_resource_group" "resource_group" {
+		is_default=true
+	}
+	resource "ibm_is_vpc" "vpc" {
+	  name = "%[1]s"
+	}
+	resource "ibm_is_subnet" "subnet1" {
+	  name                     = "%[1]s-1"
+	  vpc                      = ibm_is_vpc.vpc.id
+	  zone                     = "eu-de-1"
+	  total_ipv4_address_count = 256
+	}
+	resource "ibm_container_vpc_cluster" "cluster" {
+	  name              = "%[1]s"
+	  vpc_id            = ibm_is_vpc.vpc.id
+	  flavor            = "cx2.2x4"
+	  worker_count      = 1
+	  resource_group_id = data.ibm_resource_group.resource_group.id
+	  zones {
+		subnet_id = ibm_is_subnet.subnet1.id
+		name      = "eu-de-1"
+	  }
+	}
+	resource "ibm_container_addons" "addons" {
+	  cluster = ibm_container_vpc_cluster.cluster.id
+	  addons {
+		name = "cluster-autoscaler"
+	  }
+	}
+	resource "ibm_container_vpc_worker_pool" "test_pool" {
+	  cluster           = ibm_container_vpc_cluster.cluster.id
+	  worker_pool_name  = "%[1]s"
+	  flavor            = "cx2.2x4"
+	  vpc_id            = ibm_is_vpc.vpc.id
+	  worker_count      = 1
+	  resource_group_id = data.ibm_resource_group.resource_group.id
+	  zones {
+		name      = "eu-de-1"
+		subnet_id = ibm_is_subnet.subnet1.id
+	  }
+	  lifecycle {
+		ignore_changes = [worker_count]
+	  }
+	}
+	resource "ibm_container_vpc_worker_pool_autoscaler" "autoscaler" {
+	  cluster           = ibm_container_vpc_cluster.cluster.id
+	  worker_pool       = ibm_container_vpc_worker_pool.test_pool.worker_pool_name
+	  resource_group_id = data.ibm_resource_group.resource_group.id
+	  min_size          = %[2]d
+	  max_size          = %[3]d
+	  enabled           = %[4]t
+	  depends_on        = [ibm_container_addons.addons]
+	}
+		`, name, minSize, maxSize, enabled)
+}
+
+func TestIBMContainerVpcWorkerPoolAutoscalerMock(t *testing.T) {
+	t.Parallel()
+	srv := testMockServer(t)
+	meta := testMockClientSession(t, srv)
+	vpcID, subnetID := testMockSubnet(t, meta)
+	clusterID := testMockVpcCluster(t, meta, vpcID, subnetID)
+	r := resourceIBMContainerVpcWorkerPoolAutoscaler()
+
+	raw := map[string]interface{}{
+		"cluster":     clusterID,
+		"worker_pool": "default",
+		"min_size":    1,
+		"max_size":    3,
+	}
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	assert.ErrorContains(t, testDiagsErr(r.CreateContext(context.Background(), d, meta)), "make sure the add-on is enabled")
+
+	clusterClient, err := meta.(ClientSession).ContainerAPI()
+	assert.NilError(t, err)
+	_, err = clusterClient.AddOns().ConfigureAddons(clusterID, &v1.ConfigureAddOns{
+		AddonsList: []v1.AddOn{{Name: containerAutoscalerAddon}},
+		Enable:     true,
+	}, v1.ClusterTargetHeader{})
+	assert.NilError(t, err)
+
+	missing := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"cluster":     clusterID,
+		"worker_pool": "mock-missing",
+		"min_size":    1,
+		"max_size":    3,
+	})
+	assert.ErrorContains(t, testDiagsErr(r.CreateContext(context.Background(), missing, meta)), "mock-missing")
+
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), d, meta)))
+	assert.Equal(t, d.Id(), clusterID+"/default")
+	assert.Equal(t, d.Get("enabled"), true)
+	assert.DeepEqual(t, testMockAutoscalerPools(t, meta, clusterID), []autoscalerWorkerPoolConfig{
+		{Name: "default", MinSize: 1, MaxSize: 3, Enabled: true},
+	})
+
+	csClient, err := meta.(ClientSession).VpcContainerAPI()
+	assert.NilError(t, err)
+	defaultPool, err := csClient.WorkerPools().GetWorkerPool(clusterID, "default", v2.ClusterTargetHeader{})
+	assert.NilError(t, err)
+	pools := resourceIBMContainerVpcWorkerPool()
+	pool := pools.Data(nil)
+	pool.SetId(clusterID + "/" + defaultPool.ID)
+	assert.NilError(t, testDiagsErr(pools.ReadContext(context.Background(), pool, meta)))
+	assert.Equal(t, pool.Get("autoscale_enabled"), true)
+
+	raw["min_size"], raw["max_size"], raw["enabled"] = 2, 4, false
+	updated := testMockResourceData(t, r, d, raw)
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), updated, meta)))
+	assert.Equal(t, updated.Get("min_size"), 2)
+	assert.Equal(t, updated.Get("max_size"), 4)
+	assert.Equal(t, updated.Get("enabled"), false)
+
+	_, err = r.Diff(context.Background(), updated.State(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"cluster":     clusterID,
+		"worker_pool": "default",
+		"min_size":    5,
+		"max_size":    4,
+	}), meta)
+	assert.ErrorContains(t, err, "must not exceed its max_size")
+
+	imported := r.Data(nil)
+	imported.SetId(d.Id())
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), imported, meta)))
+	assert.Equal(t, imported.Get("cluster"), clusterID)
+	assert.Equal(t, imported.Get("worker_pool"), "default")
+	assert.Equal(t, imported.Get("max_size"), 4)
+
+	// The entry of the pool is disabled, not removed
+	raw["enabled"] = true
+	enabled := testMockResourceData(t, r, updated, raw)
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), enabled, meta)))
+	assert.NilError(t, testDiagsErr(r.DeleteContext(context.Background(), enabled, meta)))
+	assert.DeepEqual(t, testMockAutoscalerPools(t, meta, clusterID), []autoscalerWorkerPoolConfig{
+		{Name: "default", MinSize: 2, MaxSize: 4, Enabled: false},
+	})
+
+	gone := r.Data(nil)
+	gone.SetId("mock-missing/default")
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), gone, meta)))
+	assert.Equal(t, gone.Id(), "")
+}
+
+// testMockAutoscalerPools returns the worker pool entries of the config map of the
+// cluster-autoscaler add-on.
+func testMockAutoscalerPools(t *testing.T, meta interface{}, clusterID string) []autoscalerWorkerPoolConfig {
+	kubeClient, err := createContainerKubeRESTClient(meta, clusterID, v2.ClusterTargetHeader{})
+	assert.NilError(t, err)
+	configMap, err := kubeClient.getConfigMap(containerAutoscalerNamespace, containerAutoscalerConfigMap)
+	assert.NilError(t, err)
+	pools := []autoscalerWorkerPoolConfig{}
+	assert.NilError(t, json.Unmarshal([]byte(configMap.Data[containerAutoscalerPoolsKey]), &pools))
+	return pools
+}
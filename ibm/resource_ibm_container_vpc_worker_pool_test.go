@@ -4,13 +4,16 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
 
 	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
 )
@@ -43,6 +46,8 @@ func TestAccIBMContainerVpcClusterWorkerPoolBasic(t *testing.T) {
 						"ibm_container_vpc_worker_pool.test_pool", "zones.#", "2"),
 					resource.TestCheckResourceAttr(
 						"ibm_container_vpc_worker_pool.test_pool", "labels.%", "3"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "taints.#", "1"),
 				),
 			},
 			{
@@ -200,6 +205,77 @@ func testAccCheckIBMVpcContainerWorkerPoolUpdate(name string) string {
 		"test1" = "test-pool1"
 		"test2" = "test-pool2"
 	  }
+	  taints {
+		key    = "dedicated"
+		value  = "edge"
+		effect = "NoExecute"
+	  }
 	}
 		`, name)
 }
+
+func TestIBMContainerVpcWorkerPoolMock(t *testing.T) {
+	t.Parallel()
+	srv := testMockServer(t)
+	meta := testMockClientSession(t, srv)
+	vpcID, subnetID := testMockSubnet(t, meta)
+	clusterID := testMockVpcCluster(t, meta, vpcID, subnetID)
+	r := resourceIBMContainerVpcWorkerPool()
+
+	raw := map[string]interface{}{
+		"cluster":          clusterID,
+		"worker_pool_name": "mock-pool",
+		"flavor":           "bx2.4x16",
+		"vpc_id":           vpcID,
+		"worker_count":     1,
+		"zones": []interface{}{map[string]interface{}{
+			"name":      "us-south-1",
+			"subnet_id": subnetID,
+		}},
+		"taints": []interface{}{map[string]interface{}{
+			"key":    "dedicated",
+			"value":  "edge",
+			"effect": "NoSchedule",
+		}},
+	}
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	d.MarkNewResource()
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), d, meta)))
+	assert.Equal(t, d.Get("taints.#"), 1)
+	assert.DeepEqual(t, d.Get("taints").(*schema.Set).List()[0], map[string]interface{}{
+		"key":    "dedicated",
+		"value":  "edge",
+		"effect": "NoSchedule",
+	})
+	assert.Equal(t, d.Get("autoscale_enabled"), false)
+
+	raw["taints"] = []interface{}{
+		map[string]interface{}{"key": "dedicated", "value": "edge", "effect": "NoExecute"},
+		map[string]interface{}{"key": "gpu", "value": "", "effect": "PreferNoSchedule"},
+	}
+	updated := testMockResourceData(t, r, d, raw)
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), updated, meta)))
+	assert.Equal(t, updated.Get("taints.#"), 2)
+
+	diags := r.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"cluster":          clusterID,
+		"worker_pool_name": "mock-pool",
+		"flavor":           "bx2.4x16",
+		"vpc_id":           vpcID,
+		"worker_count":     1,
+		"zones":            raw["zones"],
+		"taints": []interface{}{map[string]interface{}{
+			"key":    "dedicated",
+			"value":  "edge",
+			"effect": "NoRun",
+		}},
+	}))
+	assert.ErrorContains(t, testDiagsErr(diags), "NoRun")
+
+	raw["taints"] = []interface{}{}
+	cleared := testMockResourceData(t, r, updated, raw)
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), cleared, meta)))
+	assert.Equal(t, cleared.Get("taints.#"), 0)
+
+	assert.NilError(t, testDiagsErr(r.DeleteContext(context.Background(), cleared, meta)))
+}
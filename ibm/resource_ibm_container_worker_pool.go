@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -18,12 +20,11 @@ import (
 func resourceIBMContainerWorkerPool() *schema.Resource {
 
 	return &schema.Resource{
-		Create:   resourceIBMContainerWorkerPoolCreate,
-		Read:     resourceIBMContainerWorkerPoolRead,
-		Update:   resourceIBMContainerWorkerPoolUpdate,
-		Delete:   resourceIBMContainerWorkerPoolDelete,
-		Exists:   resourceIBMContainerWorkerPoolExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerWorkerPoolCreate,
+		ReadContext:   resourceIBMContainerWorkerPoolRead,
+		UpdateContext: resourceIBMContainerWorkerPoolUpdate,
+		DeleteContext: resourceIBMContainerWorkerPoolDelete,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Update: schema.DefaultTimeout(90 * time.Minute),
 		},
@@ -147,11 +148,11 @@ func resourceIBMContainerWorkerPool() *schema.Resource {
 	}
 }
 
-func resourceIBMContainerWorkerPoolCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerWorkerPoolCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterNameorID := d.Get("cluster").(string)
@@ -193,27 +194,36 @@ func resourceIBMContainerWorkerPoolCreate(d *schema.ResourceData, meta interface
 	workerPoolsAPI := csClient.WorkerPools()
 	targetEnv, err := getWorkerPoolTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	res, err := workerPoolsAPI.CreateWorkerPool(clusterNameorID, params, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", clusterNameorID, res.ID))
 
-	return resourceIBMContainerWorkerPoolRead(d, meta)
+	return resourceIBMContainerWorkerPoolRead(context, d, meta)
 }
 
-func resourceIBMContainerWorkerPoolRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerWorkerPoolRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMContainerWorkerPoolExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cluster := parts[0]
 	workerPoolID := parts[1]
@@ -221,12 +231,12 @@ func resourceIBMContainerWorkerPoolRead(d *schema.ResourceData, meta interface{}
 	workerPoolsAPI := csClient.WorkerPools()
 	targetEnv, err := getWorkerPoolTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	workerPool, err := workerPoolsAPI.GetWorkerPool(cluster, workerPoolID, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	machineType := workerPool.MachineType
@@ -254,39 +264,38 @@ func resourceIBMContainerWorkerPoolRead(d *schema.ResourceData, meta interface{}
 	}
 	controller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(ResourceControllerURL, controller+"/kubernetes/clusters")
 	return nil
 }
 
-func resourceIBMContainerWorkerPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerWorkerPoolUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	clusterNameorID := parts[0]
 	workerPoolNameorID := parts[1]
 	workerPoolsAPI := csClient.WorkerPools()
 	targetEnv, err := getWorkerPoolTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("size_per_zone") {
 		err = workerPoolsAPI.ResizeWorkerPool(clusterNameorID, workerPoolNameorID, d.Get("size_per_zone").(int), targetEnv)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		_, err = WaitForWorkerNormal(clusterNameorID, workerPoolNameorID, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
+		_, err = WaitForWorkerNormal(context, clusterNameorID, workerPoolNameorID, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for workers of worker pool (%s) of cluster (%s) to become ready: %s", workerPoolNameorID, clusterNameorID, err)
+			return diag.FromErr(apiErrorf("container", err, nil, "Error waiting for workers of worker pool (%s) of cluster (%s) to become ready", workerPoolNameorID, clusterNameorID))
 		}
 	}
 	if d.HasChange("labels") {
@@ -298,27 +307,26 @@ func resourceIBMContainerWorkerPoolUpdate(d *schema.ResourceData, meta interface
 		}
 		err = workerPoolsAPI.UpdateLabelsWorkerPool(clusterNameorID, workerPoolNameorID, labels, targetEnv)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		_, err = WaitForWorkerNormal(clusterNameorID, workerPoolNameorID, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
+		_, err = WaitForWorkerNormal(context, clusterNameorID, workerPoolNameorID, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for workers of worker pool (%s) of cluster (%s) to become ready: %s", workerPoolNameorID, clusterNameorID, err)
+			return diag.FromErr(apiErrorf("container", err, nil, "Error waiting for workers of worker pool (%s) of cluster (%s) to become ready", workerPoolNameorID, clusterNameorID))
 		}
 	}
 
-	return resourceIBMContainerWorkerPoolRead(d, meta)
+	return resourceIBMContainerWorkerPoolRead(context, d, meta)
 }
 
-func resourceIBMContainerWorkerPoolDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerWorkerPoolDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	clusterNameorID := parts[0]
 	workerPoolNameorID := parts[1]
@@ -326,17 +334,16 @@ func resourceIBMContainerWorkerPoolDelete(d *schema.ResourceData, meta interface
 	workerPoolsAPI := csClient.WorkerPools()
 	targetEnv, err := getWorkerPoolTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = workerPoolsAPI.DeleteWorkerPool(clusterNameorID, workerPoolNameorID, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	_, err = WaitForWorkerDelete(clusterNameorID, workerPoolNameorID, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
+	_, err = WaitForWorkerDelete(context, clusterNameorID, workerPoolNameorID, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for removing workers of worker pool (%s) of cluster (%s): %s", workerPoolNameorID, clusterNameorID, err)
+		return diag.FromErr(apiErrorf("container", err, nil, "Error waiting for removing workers of worker pool (%s) of cluster (%s)", workerPoolNameorID, clusterNameorID))
 	}
 	return nil
 }
@@ -366,13 +373,13 @@ func resourceIBMContainerWorkerPoolExists(d *schema.ResourceData, meta interface
 				return false, nil
 			}
 		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
+		return false, apiErrorf("container", err, nil, "Error communicating with the API")
 	}
 
 	return workerPool.ID == workerPoolID, nil
 }
 
-func WaitForWorkerNormal(clusterNameOrID, workerPoolNameOrID string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForWorkerNormal(context context.Context, clusterNameOrID, workerPoolNameOrID string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -387,14 +394,14 @@ func WaitForWorkerNormal(clusterNameOrID, workerPoolNameOrID string, meta interf
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func workerPoolStateRefreshFunc(client v1.Workers, instanceID, workerPoolNameOrID string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		workerFields, err := client.ListByWorkerPool(instanceID, workerPoolNameOrID, false, target)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error retrieving workers for cluster: %s", err)
+			return nil, "", apiErrorf("container", err, nil, "Error retrieving workers for cluster")
 		}
 		//Done worker has two fields State and Status , so check for those 2
 		for _, e := range workerFields {
@@ -408,7 +415,7 @@ func workerPoolStateRefreshFunc(client v1.Workers, instanceID, workerPoolNameOrI
 	}
 }
 
-func WaitForWorkerDelete(clusterNameOrID, workerPoolNameOrID string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForWorkerDelete(context context.Context, clusterNameOrID, workerPoolNameOrID string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -423,14 +430,14 @@ func WaitForWorkerDelete(clusterNameOrID, workerPoolNameOrID string, meta interf
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func workerPoolDeleteStateRefreshFunc(client v1.Workers, instanceID, workerPoolNameOrID string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		workerFields, err := client.ListByWorkerPool(instanceID, "", true, target)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error retrieving workers for cluster: %s", err)
+			return nil, "", apiErrorf("container", err, nil, "Error retrieving workers for cluster")
 		}
 		//Done worker has two fields State and Status , so check for those 2
 		for _, e := range workerFields {
@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -17,12 +19,11 @@ import (
 func resourceIBMContainerWorkerPoolZoneAttachment() *schema.Resource {
 
 	return &schema.Resource{
-		Create:   resourceIBMContainerWorkerPoolZoneAttachmentCreate,
-		Read:     resourceIBMContainerWorkerPoolZoneAttachmentRead,
-		Update:   resourceIBMContainerWorkerPoolZoneAttachmentUpdate,
-		Delete:   resourceIBMContainerWorkerPoolZoneAttachmentDelete,
-		Exists:   resourceIBMContainerWorkerPoolZoneAttachmentExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerWorkerPoolZoneAttachmentCreate,
+		ReadContext:   resourceIBMContainerWorkerPoolZoneAttachmentRead,
+		UpdateContext: resourceIBMContainerWorkerPoolZoneAttachmentUpdate,
+		DeleteContext: resourceIBMContainerWorkerPoolZoneAttachmentDelete,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
 			Update: schema.DefaultTimeout(90 * time.Minute),
@@ -94,10 +95,10 @@ func resourceIBMContainerWorkerPoolZoneAttachment() *schema.Resource {
 	}
 }
 
-func resourceIBMContainerWorkerPoolZoneAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerWorkerPoolZoneAttachmentCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	zone := d.Get("zone").(string)
@@ -111,8 +112,8 @@ func resourceIBMContainerWorkerPoolZoneAttachmentCreate(d *schema.ResourceData,
 	}
 
 	if publicVLAN != "" && privateVLAN == "" {
-		return fmt.Errorf(
-			"A private_vlan_id must be specified if a public_vlan_id is specified.")
+		return diag.FromErr(fmt.Errorf(
+			"A private_vlan_id must be specified if a public_vlan_id is specified."))
 	}
 
 	workerPoolZoneNetwork := v1.WorkerPoolZoneNetwork{
@@ -131,19 +132,18 @@ func resourceIBMContainerWorkerPoolZoneAttachmentCreate(d *schema.ResourceData,
 	workerPoolsAPI := csClient.WorkerPools()
 	targetEnv, err := getWorkerPoolTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = workerPoolsAPI.AddZone(cluster, workerPool, workerPoolZone, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%s/%s/%s", cluster, workerPool, zone))
 
-	_, err = WaitForWorkerZoneNormal(cluster, workerPool, zone, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
+	_, err = WaitForWorkerZoneNormal(context, cluster, workerPool, zone, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for workers of worker pool (%s) of cluster (%s) to become ready: %s", workerPool, cluster, err)
+		return diag.FromErr(apiErrorf("container", err, nil, "Error waiting for workers of worker pool (%s) of cluster (%s) to become ready", workerPool, cluster))
 	}
 
 	var waitTillALBs bool
@@ -152,25 +152,33 @@ func resourceIBMContainerWorkerPoolZoneAttachmentCreate(d *schema.ResourceData,
 	}
 
 	if waitTillALBs {
-		_, err = waitForWorkerZoneALB(cluster, zone, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
+		_, err = waitForWorkerZoneALB(context, cluster, zone, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for ALBs in zone (%s) of cluster (%s) to become ready: %s", zone, cluster, err)
+			return diag.FromErr(apiErrorf("container", err, nil, "Error waiting for ALBs in zone (%s) of cluster (%s) to become ready", zone, cluster))
 		}
 	}
 
-	return resourceIBMContainerWorkerPoolZoneAttachmentRead(d, meta)
+	return resourceIBMContainerWorkerPoolZoneAttachmentRead(context, d, meta)
 
 }
 
-func resourceIBMContainerWorkerPoolZoneAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerWorkerPoolZoneAttachmentRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMContainerWorkerPoolZoneAttachmentExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cluster := parts[0]
 	workerPool := parts[1]
@@ -179,12 +187,12 @@ func resourceIBMContainerWorkerPoolZoneAttachmentRead(d *schema.ResourceData, me
 	workerPoolsAPI := csClient.WorkerPools()
 	targetEnv, err := getWorkerPoolTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	workerPoolRes, err := workerPoolsAPI.GetWorkerPool(cluster, workerPool, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	zones := workerPoolRes.Zones
 
@@ -204,10 +212,10 @@ func resourceIBMContainerWorkerPoolZoneAttachmentRead(d *schema.ResourceData, me
 	return nil
 }
 
-func resourceIBMContainerWorkerPoolZoneAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerWorkerPoolZoneAttachmentUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	workerPoolsAPI := csClient.WorkerPools()
@@ -216,38 +224,38 @@ func resourceIBMContainerWorkerPoolZoneAttachmentUpdate(d *schema.ResourceData,
 		privateVLAN := d.Get("private_vlan_id").(string)
 		publicVLAN := d.Get("public_vlan_id").(string)
 		if publicVLAN != "" && privateVLAN == "" {
-			return fmt.Errorf(
-				"A private VLAN must be specified if a public VLAN is specified.")
+			return diag.FromErr(fmt.Errorf(
+				"A private VLAN must be specified if a public VLAN is specified."))
 		}
 		targetEnv, err := getWorkerPoolTargetHeader(d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		parts, err := idParts(d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		cluster := parts[0]
 		workerPool := parts[1]
 		zone := parts[2]
 		err = workerPoolsAPI.UpdateZoneNetwork(cluster, zone, workerPool, privateVLAN, publicVLAN, targetEnv)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceIBMContainerWorkerPoolZoneAttachmentRead(d, meta)
+	return resourceIBMContainerWorkerPoolZoneAttachmentRead(context, d, meta)
 }
 
-func resourceIBMContainerWorkerPoolZoneAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerWorkerPoolZoneAttachmentDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cluster := parts[0]
 	workerPool := parts[1]
@@ -256,16 +264,15 @@ func resourceIBMContainerWorkerPoolZoneAttachmentDelete(d *schema.ResourceData,
 	workerPoolsAPI := csClient.WorkerPools()
 	targetEnv, err := getWorkerPoolTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = workerPoolsAPI.RemoveZone(cluster, zone, workerPool, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	_, err = WaitForWorkerZoneDeleted(cluster, workerPool, zone, meta, d.Timeout(schema.TimeoutDelete), targetEnv)
+	_, err = WaitForWorkerZoneDeleted(context, cluster, workerPool, zone, meta, d.Timeout(schema.TimeoutDelete), targetEnv)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for deleting workers of worker pool (%s) of cluster (%s):  %s", workerPool, cluster, err)
+		return diag.FromErr(apiErrorf("container", err, nil, "Error waiting for deleting workers of worker pool (%s) of cluster (%s)", workerPool, cluster))
 	}
 
 	return nil
@@ -297,7 +304,7 @@ func resourceIBMContainerWorkerPoolZoneAttachmentExists(d *schema.ResourceData,
 				return false, nil
 			}
 		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
+		return false, apiErrorf("container", err, nil, "Error communicating with the API")
 	}
 	zones := workerPool.Zones
 	var zone v1.WorkerPoolZoneResponse
@@ -309,7 +316,7 @@ func resourceIBMContainerWorkerPoolZoneAttachmentExists(d *schema.ResourceData,
 	return zone.ID == zoneID, nil
 }
 
-func WaitForWorkerZoneNormal(clusterNameOrID, workerPoolNameOrID, zone string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForWorkerZoneNormal(context context.Context, clusterNameOrID, workerPoolNameOrID, zone string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -324,14 +331,14 @@ func WaitForWorkerZoneNormal(clusterNameOrID, workerPoolNameOrID, zone string, m
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func workerPoolZoneStateRefreshFunc(client v1.Workers, instanceID, workerPoolNameOrID, zone string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		workerFields, err := client.ListByWorkerPool(instanceID, workerPoolNameOrID, false, target)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error retrieving workers for cluster: %s", err)
+			return nil, "", apiErrorf("container", err, nil, "Error retrieving workers for cluster")
 		}
 		//Done worker has two fields State and Status , so check for those 2
 		for _, e := range workerFields {
@@ -347,7 +354,7 @@ func workerPoolZoneStateRefreshFunc(client v1.Workers, instanceID, workerPoolNam
 	}
 }
 
-func WaitForWorkerZoneDeleted(clusterNameOrID, workerPoolNameOrID, zone string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForWorkerZoneDeleted(context context.Context, clusterNameOrID, workerPoolNameOrID, zone string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -362,14 +369,14 @@ func WaitForWorkerZoneDeleted(clusterNameOrID, workerPoolNameOrID, zone string,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func workerPoolZoneDeleteStateRefreshFunc(client v1.Workers, instanceID, workerPoolNameOrID, zone string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		workerFields, err := client.ListByWorkerPool(instanceID, workerPoolNameOrID, true, target)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error retrieving workers for cluster: %s", err)
+			return nil, "", apiErrorf("container", err, nil, "Error retrieving workers for cluster")
 		}
 		//Done worker has two fields State and Status , so check for those 2
 		for _, e := range workerFields {
@@ -383,7 +390,7 @@ func workerPoolZoneDeleteStateRefreshFunc(client v1.Workers, instanceID, workerP
 	}
 }
 
-func waitForWorkerZoneALB(clusterNameOrID, zone string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
+func waitForWorkerZoneALB(context context.Context, clusterNameOrID, zone string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -398,7 +405,7 @@ func waitForWorkerZoneALB(clusterNameOrID, zone string, meta interface{}, timeou
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func workerZoneALBStateRefreshFunc(client v1.Albs, instanceID, zone string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -406,7 +413,7 @@ func workerZoneALBStateRefreshFunc(client v1.Albs, instanceID, zone string, targ
 		// Get all ALBs associated with cluster
 		albs, err := client.ListClusterALBs(instanceID, target)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error retrieving ALBs for cluster: %s", err)
+			return nil, "", apiErrorf("container", err, nil, "Error retrieving ALBs for cluster")
 		}
 
 		privateALBsByZone := []v1.ALBConfig{}
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -12,10 +13,12 @@ import (
 
 	"github.com/IBM/ibm-cos-sdk-go-config/resourceconfigurationv1"
 	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
 	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam"
 	token "github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
 	"github.com/IBM/ibm-cos-sdk-go/aws/session"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -43,12 +46,11 @@ const (
 
 func resourceIBMCOSBucket() *schema.Resource {
 	return &schema.Resource{
-		Read:     resourceIBMCOSBucketRead,
-		Create:   resourceIBMCOSBucketCreate,
-		Update:   resourceIBMCOSBucketUpdate,
-		Delete:   resourceIBMCOSBucketDelete,
-		Exists:   resourceIBMCOSBucketExists,
-		Importer: &schema.ResourceImporter{},
+		ReadContext:   resourceIBMCOSBucketRead,
+		CreateContext: resourceIBMCOSBucketCreate,
+		UpdateContext: resourceIBMCOSBucketUpdate,
+		DeleteContext: resourceIBMCOSBucketDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -257,6 +259,183 @@ func resourceIBMCOSBucket() *schema.Resource {
 					},
 				},
 			},
+			"lifecycle_rule": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1000,
+				ConflictsWith: []string{"archive_rule", "expire_rule"},
+				Description:   "Lifecycle rules of the objects of the bucket, with the transition, expiration, noncurrent version expiration and abort of incomplete multipart uploads actions",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "Unique identifier for the rule",
+						},
+						"enable": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "Enable or disable the rule",
+						},
+						"prefix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The rule applies to any objects with keys that match this prefix, all the objects by default",
+						},
+						"tag": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "The rule applies to the objects with all these tags, along with the prefix",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Key of the tag",
+									},
+									"value": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Value of the tag",
+									},
+								},
+							},
+						},
+						"transition": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Transition of the objects to an archive storage class",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateCOSLifecycleDate,
+										Description:  "Date of the transition, in YYYY-MM-DD format",
+									},
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validateAllowedRangeInt(0, 3650),
+										Description:  "Number of days after the creation of the objects the transition takes effect",
+									},
+									"storage_class": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateFunc:     validateAllowedStringValue([]string{"GLACIER", "ACCELERATED", "Glacier", "Accelerated", "glacier", "accelerated"}),
+										DiffSuppressFunc: caseDiffSuppress,
+										Description:      "Archive type of the transition, Glacier or Accelerated",
+									},
+								},
+							},
+						},
+						"expiration": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Expiration of the current version of the objects",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateCOSLifecycleDate,
+										Description:  "Date of the expiration, in YYYY-MM-DD format",
+									},
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validateAllowedRangeInt(0, 3650),
+										Description:  "Number of days after the creation of the objects they expire",
+									},
+									"expired_object_delete_marker": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Description: "Removes the delete markers with no noncurrent versions",
+									},
+								},
+							},
+						},
+						"noncurrent_version_expiration": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Expiration of the noncurrent versions of the objects of a versioned bucket",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"noncurrent_days": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validateAllowedRangeInt(1, 3650),
+										Description:  "Number of days after the objects become noncurrent they expire",
+									},
+								},
+							},
+						},
+						"abort_incomplete_multipart_upload": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Abort of the multipart uploads not completed",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days_after_initiation": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validateAllowedRangeInt(1, 3650),
+										Description:  "Number of days after the initiation of the uploads they are aborted",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"replication_rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1000,
+				Description: "Replication rules of the objects of the bucket to other buckets, versioning must be enabled on both buckets",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "Unique identifier for the rule",
+						},
+						"enable": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "Enable or disable the rule",
+						},
+						"prefix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The rule applies to any objects with keys that match this prefix, all the objects by default",
+						},
+						"priority": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							Description: "Priority of the rule when several rules apply to an object, the highest one wins",
+						},
+						"destination_bucket_crn": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "CRN of the bucket the objects are replicated to",
+						},
+						"delete_marker_replication": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Replicates the delete markers to the destination bucket",
+						},
+					},
+				},
+			},
 			"retention_rule": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -312,6 +491,181 @@ func resourceIBMCOSBucket() *schema.Resource {
 					},
 				},
 			},
+			"cors_rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    100,
+				Description: "Cross-origin resource sharing rules of the bucket, allowing the web applications of other domains to access its objects",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_headers": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Headers allowed in the Access-Control-Request-Headers header of a preflight request",
+						},
+						"allowed_methods": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateAllowedStringValue([]string{"GET", "PUT", "POST", "DELETE", "HEAD"})},
+							Description: "HTTP methods allowed for the origins",
+						},
+						"allowed_origins": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Origins allowed to access the bucket",
+						},
+						"expose_headers": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Response headers exposed to the applications",
+						},
+						"max_age_seconds": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Time in seconds the browser caches the response of a preflight request",
+						},
+					},
+				},
+			},
+			"website_configuration": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Static website hosting configuration of the bucket",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"index_document": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Suffix appended to the requests for a directory, index.html for instance",
+						},
+						"error_document": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Key of the object returned when an error occurs",
+						},
+						"redirect_all_requests_to": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"website_configuration.0.index_document", "website_configuration.0.error_document", "website_configuration.0.routing_rule"},
+							Description:   "Redirects all the requests of the website to another host",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"host_name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Host name the requests are redirected to",
+									},
+									"protocol": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateAllowedStringValue([]string{"http", "https"}),
+										Description:  "Protocol of the redirected requests, the protocol of the original request by default",
+									},
+								},
+							},
+						},
+						"routing_rule": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Rules redirecting the requests matching a condition",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"condition": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										MaxItems:    1,
+										Description: "Condition of the redirect, all the requests by default",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"http_error_code_returned_equals": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "HTTP error code of the requests redirected",
+												},
+												"key_prefix_equals": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "Object key prefix of the requests redirected",
+												},
+											},
+										},
+									},
+									"redirect": {
+										Type:        schema.TypeList,
+										Required:    true,
+										MaxItems:    1,
+										Description: "Redirect of the requests matching the condition",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"host_name": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "Host name the requests are redirected to",
+												},
+												"http_redirect_code": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "HTTP redirect code of the response",
+												},
+												"protocol": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validateAllowedStringValue([]string{"http", "https"}),
+													Description:  "Protocol of the redirected requests",
+												},
+												"replace_key_prefix_with": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "Object key prefix replacing the key_prefix_equals of the condition",
+												},
+												"replace_key_with": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "Object key replacing the key of the requests",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"acl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"private", "public-read"}),
+				Description:  "Canned ACL of the bucket, public-read allows anonymous users to read its objects",
+			},
+			"public_access_block": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Blocks the public access to the bucket granted by ACLs",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"block_public_acls": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Rejects the requests setting a public ACL on the bucket or its objects",
+						},
+						"ignore_public_acls": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Ignores the public ACLs of the bucket and its objects",
+						},
+					},
+				},
+			},
 			"force_delete": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -420,11 +774,207 @@ func expireRuleList(expireList []interface{}) []*s3.LifecycleRule {
 	return rules
 }
 
-func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error {
+func cosRuleStatus(enable bool) *string {
+	if enable {
+		return aws.String("Enabled")
+	}
+	return aws.String("Disabled")
+}
+
+// cosRuleID returns the rule_id of a rule, nil to let COS generate it.
+func cosRuleID(ruleMap map[string]interface{}) *string {
+	if id := ruleMap["rule_id"].(string); id != "" {
+		return aws.String(id)
+	}
+	return nil
+}
+
+// lifecycleRuleFilter returns the filter of a lifecycle rule, combining the
+// prefix and the tags with the and operator when the rule has several conditions.
+func lifecycleRuleFilter(prefix string, tagList []interface{}) *cosLifecycleRuleFilter {
+	var tags []*s3.Tag
+	for _, t := range tagList {
+		tagMap, _ := t.(map[string]interface{})
+		tags = append(tags, &s3.Tag{
+			Key:   aws.String(tagMap["key"].(string)),
+			Value: aws.String(tagMap["value"].(string)),
+		})
+	}
+	switch {
+	case len(tags) == 0:
+		return &cosLifecycleRuleFilter{Prefix: aws.String(prefix)}
+	case len(tags) == 1 && prefix == "":
+		return &cosLifecycleRuleFilter{Tag: tags[0]}
+	default:
+		and := &cosLifecycleRuleAndOperator{Tags: tags}
+		if prefix != "" {
+			and.Prefix = aws.String(prefix)
+		}
+		return &cosLifecycleRuleFilter{And: and}
+	}
+}
+
+func lifecycleRuleList(lifecycleList []interface{}) []*cosLifecycleRule {
+	optionalDate := func(m map[string]interface{}) *time.Time {
+		if date, _ := time.Parse(cosLifecycleDateFormat, m["date"].(string)); !date.IsZero() {
+			return &date
+		}
+		return nil
+	}
+	optionalDays := func(m map[string]interface{}) *int64 {
+		if m["date"].(string) == "" {
+			return aws.Int64(int64(m["days"].(int)))
+		}
+		return nil
+	}
+	var rules []*cosLifecycleRule
+	for _, l := range lifecycleList {
+		ruleMap, _ := l.(map[string]interface{})
+		rule := &cosLifecycleRule{
+			ID:     cosRuleID(ruleMap),
+			Status: cosRuleStatus(ruleMap["enable"].(bool)),
+			Filter: lifecycleRuleFilter(ruleMap["prefix"].(string), ruleMap["tag"].([]interface{})),
+		}
+		if transitions := ruleMap["transition"].([]interface{}); len(transitions) > 0 && transitions[0] != nil {
+			transitionMap := transitions[0].(map[string]interface{})
+			rule.Transitions = []*s3.Transition{{
+				Date:         optionalDate(transitionMap),
+				Days:         optionalDays(transitionMap),
+				StorageClass: aws.String(transitionMap["storage_class"].(string)),
+			}}
+		}
+		if expirations := ruleMap["expiration"].([]interface{}); len(expirations) > 0 && expirations[0] != nil {
+			expirationMap := expirations[0].(map[string]interface{})
+			rule.Expiration = &cosLifecycleExpiration{
+				Date: optionalDate(expirationMap),
+			}
+			if expirationMap["expired_object_delete_marker"].(bool) {
+				rule.Expiration.ExpiredObjectDeleteMarker = aws.Bool(true)
+			} else {
+				rule.Expiration.Days = optionalDays(expirationMap)
+			}
+		}
+		if noncurrent := ruleMap["noncurrent_version_expiration"].([]interface{}); len(noncurrent) > 0 && noncurrent[0] != nil {
+			rule.NoncurrentVersionExpiration = &cosNoncurrentVersionExpiration{
+				NoncurrentDays: aws.Int64(int64(noncurrent[0].(map[string]interface{})["noncurrent_days"].(int))),
+			}
+		}
+		if abort := ruleMap["abort_incomplete_multipart_upload"].([]interface{}); len(abort) > 0 && abort[0] != nil {
+			rule.AbortIncompleteMultipartUpload = &cosAbortIncompleteMultipartUpload{
+				DaysAfterInitiation: aws.Int64(int64(abort[0].(map[string]interface{})["days_after_initiation"].(int))),
+			}
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func replicationRuleList(replicationList []interface{}) []*cosReplicationRule {
+	var rules []*cosReplicationRule
+	for _, l := range replicationList {
+		ruleMap, _ := l.(map[string]interface{})
+		rule := &cosReplicationRule{
+			ID:     cosRuleID(ruleMap),
+			Status: cosRuleStatus(ruleMap["enable"].(bool)),
+			Filter: &s3.LifecycleRuleFilter{
+				Prefix: aws.String(ruleMap["prefix"].(string)),
+			},
+			Destination: &cosReplicationDestination{
+				Bucket: aws.String(ruleMap["destination_bucket_crn"].(string)),
+			},
+			DeleteMarkerReplication: &cosDeleteMarkerReplication{
+				Status: cosRuleStatus(ruleMap["delete_marker_replication"].(bool)),
+			},
+		}
+		if priority := ruleMap["priority"].(int); priority > 0 {
+			rule.Priority = aws.Int64(int64(priority))
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func corsRuleList(corsList []interface{}) []*s3.CORSRule {
+	var rules []*s3.CORSRule
+	for _, l := range corsList {
+		corsMap, _ := l.(map[string]interface{})
+		rule := &s3.CORSRule{
+			AllowedHeaders: aws.StringSlice(expandStringList(corsMap["allowed_headers"].([]interface{}))),
+			AllowedMethods: aws.StringSlice(expandStringList(corsMap["allowed_methods"].([]interface{}))),
+			AllowedOrigins: aws.StringSlice(expandStringList(corsMap["allowed_origins"].([]interface{}))),
+			ExposeHeaders:  aws.StringSlice(expandStringList(corsMap["expose_headers"].([]interface{}))),
+		}
+		if maxAge := corsMap["max_age_seconds"].(int); maxAge > 0 {
+			rule.MaxAgeSeconds = aws.Int64(int64(maxAge))
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func websiteConfiguration(websiteMap map[string]interface{}) *s3.WebsiteConfiguration {
+	optionalString := func(m map[string]interface{}, key string) *string {
+		if v, ok := m[key].(string); ok && v != "" {
+			return aws.String(v)
+		}
+		return nil
+	}
+	website := &s3.WebsiteConfiguration{}
+	if index := optionalString(websiteMap, "index_document"); index != nil {
+		website.IndexDocument = &s3.IndexDocument{Suffix: index}
+	}
+	if key := optionalString(websiteMap, "error_document"); key != nil {
+		website.ErrorDocument = &s3.ErrorDocument{Key: key}
+	}
+	if redirects := websiteMap["redirect_all_requests_to"].([]interface{}); len(redirects) > 0 && redirects[0] != nil {
+		redirectMap := redirects[0].(map[string]interface{})
+		website.RedirectAllRequestsTo = &s3.RedirectAllRequestsTo{
+			HostName: aws.String(redirectMap["host_name"].(string)),
+			Protocol: optionalString(redirectMap, "protocol"),
+		}
+	}
+	for _, r := range websiteMap["routing_rule"].([]interface{}) {
+		ruleMap, _ := r.(map[string]interface{})
+		rule := &s3.RoutingRule{Redirect: &s3.Redirect{}}
+		if conditions := ruleMap["condition"].([]interface{}); len(conditions) > 0 && conditions[0] != nil {
+			conditionMap := conditions[0].(map[string]interface{})
+			rule.Condition = &s3.Condition{
+				HttpErrorCodeReturnedEquals: optionalString(conditionMap, "http_error_code_returned_equals"),
+				KeyPrefixEquals:             optionalString(conditionMap, "key_prefix_equals"),
+			}
+		}
+		if redirects := ruleMap["redirect"].([]interface{}); len(redirects) > 0 && redirects[0] != nil {
+			redirectMap := redirects[0].(map[string]interface{})
+			rule.Redirect = &s3.Redirect{
+				HostName:             optionalString(redirectMap, "host_name"),
+				HttpRedirectCode:     optionalString(redirectMap, "http_redirect_code"),
+				Protocol:             optionalString(redirectMap, "protocol"),
+				ReplaceKeyPrefixWith: optionalString(redirectMap, "replace_key_prefix_with"),
+				ReplaceKeyWith:       optionalString(redirectMap, "replace_key_with"),
+			}
+		}
+		website.RoutingRules = append(website.RoutingRules, rule)
+	}
+	return website
+}
+
+// isCOSErrorCode reports whether err is an S3 API error with one of the codes.
+func isCOSErrorCode(err error, codes ...string) bool {
+	if aerr, ok := err.(awserr.Error); ok {
+		for _, code := range codes {
+			if aerr.Code() == code {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func resourceIBMCOSBucketUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var s3Conf *aws.Config
 	rsConClient, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	bucketName := parseBucketId(d.Id(), "bucketName")
 	serviceID := parseBucketId(d.Id(), "serviceID")
@@ -435,7 +985,7 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 	}
 	authEndpoint, err := rsConClient.Config.EndpointLocator.IAMEndpoint()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	authEndpointPath := fmt.Sprintf("%s%s", authEndpoint, "/identity/token")
 	apiKey := rsConClient.Config.BluemixAPIKey
@@ -458,12 +1008,18 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 	s3Sess := session.Must(session.NewSession())
 	s3Client := s3.New(s3Sess, s3Conf)
 
-	//// Update  the lifecycle (Archive or Expire)
-	if d.HasChange("archive_rule") || d.HasChange("expire_rule") {
+	//// Update  the lifecycle (Archive, Expire or full lifecycle rules)
+	if d.HasChange("archive_rule") || d.HasChange("expire_rule") || d.HasChange("lifecycle_rule") {
 		var archive, archive_ok = d.GetOk("archive_rule")
 		var expire, expire_ok = d.GetOk("expire_rule")
+		var lifecycle, lifecycle_ok = d.GetOk("lifecycle_rule")
 		var rules []*s3.LifecycleRule
-		if archive_ok || expire_ok {
+		if lifecycle_ok {
+			err := cosPutBucketLifecycle(s3Client, bucketName, lifecycleRuleList(lifecycle.([]interface{})))
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("failed to update the lifecycle rules on COS bucket %s, %v", bucketName, err))
+			}
+		} else if archive_ok || expire_ok {
 			if expire_ok {
 				rules = append(rules, expireRuleList(expire.([]interface{}))...)
 			}
@@ -479,7 +1035,7 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 			}
 			_, err := s3Client.PutBucketLifecycleConfiguration(lInput)
 			if err != nil {
-				return fmt.Errorf("failed to update the archive rule on COS bucket %s, %v", bucketName, err)
+				return diag.FromErr(fmt.Errorf("failed to update the archive rule on COS bucket %s, %v", bucketName, err))
 			}
 
 		} else {
@@ -490,7 +1046,7 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 			delarchive, _ := s3Client.DeleteBucketLifecycleRequest(DelInput)
 			err := delarchive.Send()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -502,7 +1058,7 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 		if retention, ok := d.GetOk("retention_rule"); ok {
 			retentionList := retention.([]interface{})
 			if len(retentionList) > 1 {
-				return fmt.Errorf("Can not more than 1 retention policy")
+				return diag.FromErr(fmt.Errorf("Can not more than 1 retention policy"))
 			}
 			for _, l := range retentionList {
 				retentionMap, _ := l.(map[string]interface{})
@@ -545,7 +1101,7 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 			}
 			_, err := s3Client.PutBucketProtectionConfiguration(pInput)
 			if err != nil {
-				return fmt.Errorf("failed to update the retention rule on COS bucket %s, %v", bucketName, err)
+				return diag.FromErr(fmt.Errorf("failed to update the retention rule on COS bucket %s, %v", bucketName, err))
 			}
 		}
 	}
@@ -577,13 +1133,103 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 		_, err := s3Client.PutBucketVersioning(input)
 		if err != nil {
-			return fmt.Errorf("failed to update the object versioning on COS bucket %s, %v", bucketName, err)
+			return diag.FromErr(fmt.Errorf("failed to update the object versioning on COS bucket %s, %v", bucketName, err))
+		}
+	}
+
+	//update the replication rules
+	if d.HasChange("replication_rule") {
+		if replication, ok := d.GetOk("replication_rule"); ok {
+			err := cosPutBucketReplication(s3Client, bucketName, replicationRuleList(replication.([]interface{})))
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("failed to update the replication rules on COS bucket %s, %v", bucketName, err))
+			}
+		} else {
+			err := cosDeleteBucketReplication(s3Client, bucketName)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("failed to delete the replication rules on COS bucket %s, %v", bucketName, err))
+			}
+		}
+	}
+
+	//update the CORS rules
+	if d.HasChange("cors_rule") {
+		if rules := corsRuleList(d.Get("cors_rule").([]interface{})); len(rules) > 0 {
+			input := &s3.PutBucketCorsInput{
+				Bucket: aws.String(bucketName),
+				CORSConfiguration: &s3.CORSConfiguration{
+					CORSRules: rules,
+				},
+			}
+			_, err := s3Client.PutBucketCors(input)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("failed to update the CORS rules on COS bucket %s, %v", bucketName, err))
+			}
+		} else {
+			_, err := s3Client.DeleteBucketCors(&s3.DeleteBucketCorsInput{Bucket: aws.String(bucketName)})
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("failed to delete the CORS rules on COS bucket %s, %v", bucketName, err))
+			}
+		}
+	}
+
+	//update the static website hosting
+	if d.HasChange("website_configuration") {
+		if website, ok := d.GetOk("website_configuration"); ok && website.([]interface{})[0] != nil {
+			input := &s3.PutBucketWebsiteInput{
+				Bucket:               aws.String(bucketName),
+				WebsiteConfiguration: websiteConfiguration(website.([]interface{})[0].(map[string]interface{})),
+			}
+			_, err := s3Client.PutBucketWebsite(input)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("failed to update the website configuration on COS bucket %s, %v", bucketName, err))
+			}
+		} else {
+			_, err := s3Client.DeleteBucketWebsite(&s3.DeleteBucketWebsiteInput{Bucket: aws.String(bucketName)})
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("failed to delete the website configuration on COS bucket %s, %v", bucketName, err))
+			}
+		}
+	}
+
+	//update the public access block before the ACL, which it may reject
+	if d.HasChange("public_access_block") {
+		if block, ok := d.GetOk("public_access_block"); ok && block.([]interface{})[0] != nil {
+			blockMap := block.([]interface{})[0].(map[string]interface{})
+			input := &s3.PutPublicAccessBlockInput{
+				Bucket: aws.String(bucketName),
+				PublicAccessBlockConfiguration: &s3.PublicAccessBlockConfiguration{
+					BlockPublicAcls:  aws.Bool(blockMap["block_public_acls"].(bool)),
+					IgnorePublicAcls: aws.Bool(blockMap["ignore_public_acls"].(bool)),
+				},
+			}
+			_, err := s3Client.PutPublicAccessBlock(input)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("failed to update the public access block on COS bucket %s, %v", bucketName, err))
+			}
+		} else {
+			_, err := s3Client.DeletePublicAccessBlock(&s3.DeletePublicAccessBlockInput{Bucket: aws.String(bucketName)})
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("failed to delete the public access block on COS bucket %s, %v", bucketName, err))
+			}
+		}
+	}
+
+	//update the canned ACL
+	if acl, ok := d.GetOk("acl"); ok && d.HasChange("acl") {
+		input := &s3.PutBucketAclInput{
+			Bucket: aws.String(bucketName),
+			ACL:    aws.String(acl.(string)),
+		}
+		_, err := s3Client.PutBucketAcl(input)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to update the ACL on COS bucket %s, %v", bucketName, err))
 		}
 	}
 
 	sess, err := meta.(ClientSession).CosConfigV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if endpointType == "private" {
 		sess.SetServiceURL("https://config.private.cloud-object-storage.cloud.ibm.com/v1")
@@ -668,18 +1314,27 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 	if hasChanged {
 		response, err := sess.UpdateBucketConfig(updateBucketConfigOptions)
 		if err != nil {
-			return fmt.Errorf("Error Update COS Bucket: %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("Error Update COS Bucket: %s\n%s", err, response))
 		}
 	}
 
-	return resourceIBMCOSBucketRead(d, meta)
+	return resourceIBMCOSBucketRead(context, d, meta)
 }
 
-func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCOSBucketRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMCOSBucketExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	var s3Conf *aws.Config
 	rsConClient, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	bucketName := parseBucketId(d.Id(), "bucketName")
 	serviceID := parseBucketId(d.Id(), "serviceID")
@@ -691,7 +1346,7 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 	apiEndpoint = envFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)
 	authEndpoint, err := rsConClient.Config.EndpointLocator.IAMEndpoint()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	authEndpointPath := fmt.Sprintf("%s%s", authEndpoint, "/identity/token")
 	apiKey := rsConClient.Config.BluemixAPIKey
@@ -719,14 +1374,14 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	err = s3Client.WaitUntilBucketExists(headInput)
 	if err != nil {
-		return fmt.Errorf("failed waiting for bucket %s to be created, %v",
-			bucketName, err)
+		return diag.FromErr(fmt.Errorf("failed waiting for bucket %s to be created, %v",
+			bucketName, err))
 	}
 
 	bucketOutput, err := s3Client.ListBucketsExtended(&s3.ListBucketsExtendedInput{})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	var bLocationConstraint string
 	for _, b := range bucketOutput.Buckets {
@@ -737,15 +1392,15 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	singleSiteLocationRegex, err := regexp.Compile("^[a-z]{3}[0-9][0-9]-[a-z]{4,8}$")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	regionLocationRegex, err := regexp.Compile("^[a-z]{2}-[a-z]{2,5}-[a-z]{4,8}$")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	crossRegionLocationRegex, err := regexp.Compile("^[a-z]{2}-[a-z]{4,8}$")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if singleSiteLocationRegex.MatchString(bLocationConstraint) {
@@ -777,7 +1432,7 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	sess, err := meta.(ClientSession).CosConfigV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if endpointType == "private" {
 		sess.SetServiceURL("https://config.private.cloud-object-storage.cloud.ibm.com/v1")
@@ -785,7 +1440,7 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	bucketPtr, response, err := sess.GetBucketConfig(getBucketConfigOptions)
 	if err != nil {
-		return fmt.Errorf("Error in getting bucket info rule: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error in getting bucket info rule: %s\n%s", err, response))
 	}
 
 	if bucketPtr != nil {
@@ -800,26 +1455,22 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 			d.Set("metrics_monitoring", flattenMetricsMonitor(bucketPtr.MetricsMonitoring))
 		}
 	}
-	// Read the lifecycle configuration (archive)
-
-	gInput := &s3.GetBucketLifecycleConfigurationInput{
-		Bucket: aws.String(bucketName),
-	}
-
-	lifecycleptr, err := s3Client.GetBucketLifecycleConfiguration(gInput)
-
-	if (err != nil && !strings.Contains(err.Error(), "NoSuchLifecycleConfiguration: The lifecycle configuration does not exist")) && (err != nil && bucketPtr != nil && bucketPtr.Firewall != nil && !strings.Contains(err.Error(), "AccessDenied: Access Denied")) {
-		return err
-	}
-
-	if lifecycleptr != nil {
-		archiveRules := archiveRuleGet(lifecycleptr.Rules)
-		expireRules := expireRuleGet(lifecycleptr.Rules)
-		if len(archiveRules) > 0 {
-			d.Set("archive_rule", archiveRules)
-		}
-		if len(expireRules) > 0 {
-			d.Set("expire_rule", expireRules)
+	// Read the lifecycle configuration. The rules archive_rule and expire_rule can
+	// describe are read into them, unless the rules are managed by lifecycle_rule
+	lifecycleRules, err := cosGetBucketLifecycle(s3Client, bucketName)
+	if err != nil && !isCOSErrorCode(err, "NoSuchLifecycleConfiguration") && !(bucketPtr != nil && bucketPtr.Firewall != nil && isCOSErrorCode(err, "AccessDenied")) {
+		return diag.FromErr(err)
+	}
+	if err == nil || isCOSErrorCode(err, "NoSuchLifecycleConfiguration") {
+		_, lifecycleOk := d.GetOk("lifecycle_rule")
+		if simpleRules, ok := cosArchiveExpireRules(lifecycleRules); ok && !lifecycleOk {
+			d.Set("archive_rule", archiveRuleGet(simpleRules))
+			d.Set("expire_rule", expireRuleGet(simpleRules))
+			d.Set("lifecycle_rule", []interface{}{})
+		} else {
+			d.Set("archive_rule", []interface{}{})
+			d.Set("expire_rule", []interface{}{})
+			d.Set("lifecycle_rule", flattenCosLifecycleRules(lifecycleRules))
 		}
 	}
 
@@ -829,7 +1480,7 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 	retentionptr, err := s3Client.GetBucketProtectionConfiguration(retentionInput)
 
 	if err != nil && bucketPtr != nil && bucketPtr.Firewall != nil && !strings.Contains(err.Error(), "AccessDenied: Access Denied") {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if retentionptr != nil {
@@ -846,21 +1497,70 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 	versionPtr, err := s3Client.GetBucketVersioning(versionInput)
 
 	if err != nil && bucketPtr != nil && bucketPtr.Firewall != nil && !strings.Contains(err.Error(), "AccessDenied: Access Denied") {
-		return err
+		return diag.FromErr(err)
 	}
 	versioningData := flattenCosObejctVersioning(versionPtr)
 	if versioningData != nil {
 		d.Set("object_versioning", versioningData)
 	}
 
+	// The configurations below can't be read from outside the allowed IPs of the bucket
+	firewalled := bucketPtr != nil && bucketPtr.Firewall != nil
+
+	replicationRules, err := cosGetBucketReplication(s3Client, bucketName)
+	if err != nil && !isCOSErrorCode(err, "ReplicationConfigurationNotFoundError") && !(firewalled && isCOSErrorCode(err, "AccessDenied")) {
+		return diag.FromErr(err)
+	}
+	if err == nil || isCOSErrorCode(err, "ReplicationConfigurationNotFoundError") {
+		d.Set("replication_rule", flattenCosReplicationRules(replicationRules))
+	}
+
+	corsPtr, err := s3Client.GetBucketCors(&s3.GetBucketCorsInput{Bucket: aws.String(bucketName)})
+	if err != nil && !isCOSErrorCode(err, "NoSuchCORSConfiguration") && !(firewalled && isCOSErrorCode(err, "AccessDenied")) {
+		return diag.FromErr(err)
+	}
+	if err == nil {
+		d.Set("cors_rule", flattenCosCORSRules(corsPtr.CORSRules))
+	} else if isCOSErrorCode(err, "NoSuchCORSConfiguration") {
+		d.Set("cors_rule", []interface{}{})
+	}
+
+	websitePtr, err := s3Client.GetBucketWebsite(&s3.GetBucketWebsiteInput{Bucket: aws.String(bucketName)})
+	if err != nil && !isCOSErrorCode(err, "NoSuchWebsiteConfiguration") && !(firewalled && isCOSErrorCode(err, "AccessDenied")) {
+		return diag.FromErr(err)
+	}
+	if err == nil {
+		d.Set("website_configuration", flattenCosWebsite(websitePtr))
+	} else if isCOSErrorCode(err, "NoSuchWebsiteConfiguration") {
+		d.Set("website_configuration", []interface{}{})
+	}
+
+	blockPtr, err := s3Client.GetPublicAccessBlock(&s3.GetPublicAccessBlockInput{Bucket: aws.String(bucketName)})
+	if err != nil && !isCOSErrorCode(err, "NoSuchPublicAccessBlockConfiguration") && !(firewalled && isCOSErrorCode(err, "AccessDenied")) {
+		return diag.FromErr(err)
+	}
+	if err == nil {
+		d.Set("public_access_block", flattenCosPublicAccessBlock(blockPtr.PublicAccessBlockConfiguration))
+	} else if isCOSErrorCode(err, "NoSuchPublicAccessBlockConfiguration") {
+		d.Set("public_access_block", []interface{}{})
+	}
+
+	aclPtr, err := s3Client.GetBucketAcl(&s3.GetBucketAclInput{Bucket: aws.String(bucketName)})
+	if err != nil && !(firewalled && isCOSErrorCode(err, "AccessDenied")) {
+		return diag.FromErr(err)
+	}
+	if err == nil {
+		d.Set("acl", flattenCosBucketACL(aclPtr.Grants))
+	}
+
 	return nil
 }
 
-func resourceIBMCOSBucketCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCOSBucketCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var s3Conf *aws.Config
 	rsConClient, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	bucketName := d.Get("bucket_name").(string)
 	storageClass := d.Get("storage_class").(string)
@@ -881,7 +1581,7 @@ func resourceIBMCOSBucketCreate(d *schema.ResourceData, meta interface{}) error
 		apiType = "ssl"
 	}
 	if bLocation == "" {
-		return fmt.Errorf("Provide either `cross_region_location` or `region_location` or `single_site_location`")
+		return diag.FromErr(fmt.Errorf("Provide either `cross_region_location` or `region_location` or `single_site_location`"))
 	}
 	lConstraint := fmt.Sprintf("%s-%s", bLocation, storageClass)
 	var endpointType = d.Get("endpoint_type").(string)
@@ -891,7 +1591,7 @@ func resourceIBMCOSBucketCreate(d *schema.ResourceData, meta interface{}) error
 	}
 	apiEndpoint = envFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)
 	if apiEndpoint == "" {
-		return fmt.Errorf("The endpoint doesn't exists for given location %s and endpoint type %s", bLocation, endpointType)
+		return diag.FromErr(fmt.Errorf("The endpoint doesn't exists for given location %s and endpoint type %s", bLocation, endpointType))
 	}
 	create := &s3.CreateBucketInput{
 		Bucket: aws.String(bucketName),
@@ -907,7 +1607,7 @@ func resourceIBMCOSBucketCreate(d *schema.ResourceData, meta interface{}) error
 
 	authEndpoint, err := rsConClient.Config.EndpointLocator.IAMEndpoint()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	authEndpointPath := fmt.Sprintf("%s%s", authEndpoint, "/identity/token")
 	apiKey := rsConClient.Config.BluemixAPIKey
@@ -933,17 +1633,17 @@ func resourceIBMCOSBucketCreate(d *schema.ResourceData, meta interface{}) error
 
 	_, err = s3Client.CreateBucket(create)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// Generating a fake id which contains every information about to get the bucket via s3 api
 	bucketID := fmt.Sprintf("%s:%s:%s:meta:%s:%s:%s", strings.Replace(serviceID, "::", "", -1), "bucket", bucketName, apiType, bLocation, endpointType)
 	d.SetId(bucketID)
 
-	return resourceIBMCOSBucketUpdate(d, meta)
+	return resourceIBMCOSBucketUpdate(context, d, meta)
 
 }
 
-func resourceIBMCOSBucketDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCOSBucketDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var s3Conf *aws.Config
 	rsConClient, _ := meta.(ClientSession).BluemixSession()
 	bucketName := parseBucketId(d.Id(), "bucketName")
@@ -969,11 +1669,11 @@ func resourceIBMCOSBucketDelete(d *schema.ResourceData, meta interface{}) error
 	}
 	apiEndpoint = envFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)
 	if apiEndpoint == "" {
-		return fmt.Errorf("The endpoint doesn't exists for given location %s and endpoint type %s", bLocation, endpointType)
+		return diag.FromErr(fmt.Errorf("The endpoint doesn't exists for given location %s and endpoint type %s", bLocation, endpointType))
 	}
 	authEndpoint, err := rsConClient.Config.EndpointLocator.IAMEndpoint()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	authEndpointPath := fmt.Sprintf("%s%s", authEndpoint, "/identity/token")
 
@@ -1018,16 +1718,16 @@ func resourceIBMCOSBucketDelete(d *schema.ResourceData, meta interface{}) error
 				err = deleteAllCOSObjectVersions(s3Client, bucketName, "", false, false)
 
 				if err != nil {
-					return fmt.Errorf("error COS Bucket force_delete: %s", err)
+					return diag.FromErr(fmt.Errorf("error COS Bucket force_delete: %s", err))
 				}
 
 				// this line recurses until all objects are deleted or an error is returned
-				return resourceIBMCOSBucketDelete(d, meta)
+				return resourceIBMCOSBucketDelete(context, d, meta)
 			}
 		}
 	}
 	if err != nil {
-		return fmt.Errorf("error deleting COS Bucket (%s): %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error deleting COS Bucket (%s): %s", d.Id(), err))
 	}
 
 	return nil
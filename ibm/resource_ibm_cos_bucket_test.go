@@ -4,11 +4,15 @@
 package ibm
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"os"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/mockserver"
 	"github.com/IBM/ibm-cos-sdk-go/aws"
 	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam"
 	token "github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
@@ -16,7 +20,9 @@ import (
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
 )
 
 func TestAccIBMCosBucket_Basic(t *testing.T) {
@@ -128,6 +134,13 @@ func TestAccIBMCosBucket_Archive_Expiration(t *testing.T) {
 					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "expire_rule.#", "1"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "ibm_cos_bucket.bucket",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"wait_time_minutes", "parameters", "force_delete"},
+			},
 			resource.TestStep{
 				Config: testAccCheckIBMCosBucket_archive_expire_updateDays(cosServiceName, bucketName, bucketRegionType, bucketRegion, bucketClass, arch_ruleId, arch_enable, archDaysUpdate, ruleType, exp_ruleId, exp_enable, expDaysUpdate, prefix),
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -961,3 +974,201 @@ func testAccCheckIBMCosBucket_object_versioning(cosServiceName string, bucketNam
 	}
 	`, cosServiceName, bucketName, region, storageClass)
 }
+
+func TestIBMCosBucketMock(t *testing.T) {
+	srv := testMockServer(t)
+	// The S3 endpoint is only read from the environment, set it before the test pauses
+	// so no sequential test runs with it.
+	os.Setenv("IBMCLOUD_COS_ENDPOINT", srv.URL(mockserver.ServiceCOS))
+	t.Cleanup(func() { os.Unsetenv("IBMCLOUD_COS_ENDPOINT") })
+	t.Parallel()
+	meta := testMockClientSession(t, srv)
+	instanceResource := resourceIBMResourceInstance()
+	r := resourceIBMCOSBucket()
+
+	instance := schema.TestResourceDataRaw(t, instanceResource.Schema, map[string]interface{}{
+		"name":              "mock-bucket-cos",
+		"service":           "cloud-object-storage",
+		"plan":              "standard",
+		"location":          "global",
+		"resource_group_id": "mock-resource-group",
+	})
+	assert.NilError(t, testDiagsErr(instanceResource.CreateContext(context.Background(), instance, meta)))
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"bucket_name":          "mock-bucket",
+		"resource_instance_id": instance.Id(),
+		"region_location":      "us-south",
+		"storage_class":        "standard",
+		"allowed_ip":           []interface{}{"10.0.0.0/8"},
+	})
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), d, meta)))
+	assert.Equal(t, d.Get("crn"), fmt.Sprintf("%s:bucket:mock-bucket", strings.TrimSuffix(instance.Id(), "::")))
+	assert.Equal(t, d.Get("region_location"), "us-south")
+	assert.Equal(t, d.Get("storage_class"), "standard")
+	assert.DeepEqual(t, d.Get("allowed_ip"), []interface{}{"10.0.0.0/8"})
+	assert.Equal(t, d.Get("object_versioning.0.enable"), false)
+
+	d = testMockResourceData(t, r, d, map[string]interface{}{
+		"bucket_name":          "mock-bucket",
+		"resource_instance_id": instance.Id(),
+		"region_location":      "us-south",
+		"storage_class":        "standard",
+		"allowed_ip":           []interface{}{"10.0.0.0/8", "192.168.0.0/16"},
+		"object_versioning":    []interface{}{map[string]interface{}{"enable": true}},
+	})
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), d, meta)))
+	assert.DeepEqual(t, d.Get("allowed_ip"), []interface{}{"10.0.0.0/8", "192.168.0.0/16"})
+	assert.Equal(t, d.Get("object_versioning.0.enable"), true)
+	assert.Equal(t, d.Get("acl"), "private")
+	assert.Equal(t, len(d.Get("cors_rule").([]interface{})), 0)
+
+	d = testMockResourceData(t, r, d, map[string]interface{}{
+		"bucket_name":          "mock-bucket",
+		"resource_instance_id": instance.Id(),
+		"region_location":      "us-south",
+		"storage_class":        "standard",
+		"acl":                  "public-read",
+		"cors_rule": []interface{}{map[string]interface{}{
+			"allowed_methods": []interface{}{"GET", "HEAD"},
+			"allowed_origins": []interface{}{"https://example.com"},
+			"max_age_seconds": 3600,
+		}},
+		"website_configuration": []interface{}{map[string]interface{}{
+			"index_document": "index.html",
+			"error_document": "error.html",
+			"routing_rule": []interface{}{map[string]interface{}{
+				"condition": []interface{}{map[string]interface{}{"key_prefix_equals": "docs/"}},
+				"redirect":  []interface{}{map[string]interface{}{"replace_key_prefix_with": "documents/"}},
+			}},
+		}},
+		"public_access_block": []interface{}{map[string]interface{}{"ignore_public_acls": true}},
+		"lifecycle_rule": []interface{}{
+			map[string]interface{}{
+				"rule_id":                           "noncurrent",
+				"enable":                            true,
+				"noncurrent_version_expiration":     []interface{}{map[string]interface{}{"noncurrent_days": 30}},
+				"abort_incomplete_multipart_upload": []interface{}{map[string]interface{}{"days_after_initiation": 7}},
+			},
+			map[string]interface{}{
+				"rule_id": "logs",
+				"enable":  true,
+				"prefix":  "logs/",
+				"tag": []interface{}{
+					map[string]interface{}{"key": "retention", "value": "short"},
+					map[string]interface{}{"key": "env", "value": "dev"},
+				},
+				"expiration": []interface{}{map[string]interface{}{"date": "2030-01-01"}},
+				"transition": []interface{}{map[string]interface{}{"days": 90, "storage_class": "GLACIER"}},
+			},
+			map[string]interface{}{
+				"rule_id":    "tmp",
+				"enable":     true,
+				"tag":        []interface{}{map[string]interface{}{"key": "tmp", "value": "true"}},
+				"expiration": []interface{}{map[string]interface{}{"days": 1}},
+			},
+		},
+		"replication_rule": []interface{}{map[string]interface{}{
+			"rule_id":                   "replicate",
+			"enable":                    true,
+			"priority":                  1,
+			"prefix":                    "data/",
+			"destination_bucket_crn":    "crn:v1:bluemix:public:cloud-object-storage:global:a/mock:instance:bucket:mock-replica",
+			"delete_marker_replication": true,
+		}},
+	})
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), d, meta)))
+	assert.Equal(t, d.Get("acl"), "public-read")
+	assert.DeepEqual(t, d.Get("cors_rule.0.allowed_methods"), []interface{}{"GET", "HEAD"})
+	assert.Equal(t, d.Get("cors_rule.0.max_age_seconds"), 3600)
+	assert.Equal(t, d.Get("website_configuration.0.index_document"), "index.html")
+	assert.Equal(t, d.Get("website_configuration.0.routing_rule.0.condition.0.key_prefix_equals"), "docs/")
+	assert.Equal(t, d.Get("website_configuration.0.routing_rule.0.redirect.0.replace_key_prefix_with"), "documents/")
+	assert.Equal(t, d.Get("public_access_block.0.block_public_acls"), false)
+	assert.Equal(t, d.Get("public_access_block.0.ignore_public_acls"), true)
+	assert.Equal(t, d.Get("lifecycle_rule.#"), 3)
+	assert.Equal(t, d.Get("lifecycle_rule.0.noncurrent_version_expiration.0.noncurrent_days"), 30)
+	assert.Equal(t, d.Get("lifecycle_rule.0.abort_incomplete_multipart_upload.0.days_after_initiation"), 7)
+	assert.Equal(t, d.Get("lifecycle_rule.0.tag.#"), 0)
+	assert.Equal(t, d.Get("lifecycle_rule.1.prefix"), "logs/")
+	assert.Equal(t, d.Get("lifecycle_rule.1.tag.#"), 2)
+	assert.Equal(t, d.Get("lifecycle_rule.1.tag.1.key"), "env")
+	assert.Equal(t, d.Get("lifecycle_rule.1.expiration.0.date"), "2030-01-01")
+	assert.Equal(t, d.Get("lifecycle_rule.1.transition.0.days"), 90)
+	assert.Equal(t, d.Get("lifecycle_rule.2.prefix"), "")
+	assert.Equal(t, d.Get("lifecycle_rule.2.tag.0.key"), "tmp")
+	assert.Equal(t, d.Get("lifecycle_rule.2.tag.0.value"), "true")
+
+	// The lifecycle configuration is read when it is not in the config, on import
+	imported := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+	imported.SetId(d.Id())
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), imported, meta)))
+	assert.Equal(t, imported.Get("lifecycle_rule.#"), 3)
+	assert.Equal(t, imported.Get("lifecycle_rule.1.tag.0.key"), "retention")
+	assert.Equal(t, len(imported.Get("archive_rule").([]interface{})), 0)
+	assert.Equal(t, d.Get("replication_rule.0.destination_bucket_crn"), "crn:v1:bluemix:public:cloud-object-storage:global:a/mock:instance:bucket:mock-replica")
+	assert.Equal(t, d.Get("replication_rule.0.prefix"), "data/")
+	assert.Equal(t, d.Get("replication_rule.0.priority"), 1)
+	assert.Equal(t, d.Get("replication_rule.0.delete_marker_replication"), true)
+
+	d = testMockResourceData(t, r, d, map[string]interface{}{
+		"bucket_name":          "mock-bucket",
+		"resource_instance_id": instance.Id(),
+		"region_location":      "us-south",
+		"storage_class":        "standard",
+		"acl":                  "private",
+	})
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), d, meta)))
+	assert.Equal(t, d.Get("acl"), "private")
+	assert.Equal(t, len(d.Get("cors_rule").([]interface{})), 0)
+	assert.Equal(t, len(d.Get("website_configuration").([]interface{})), 0)
+	assert.Equal(t, len(d.Get("public_access_block").([]interface{})), 0)
+	assert.Equal(t, len(d.Get("replication_rule").([]interface{})), 0)
+	assert.Equal(t, len(d.Get("lifecycle_rule").([]interface{})), 0)
+
+	// The archive and expire rules are read back into archive_rule and
+	// expire_rule, on import too
+	archiveExpireRaw := map[string]interface{}{
+		"bucket_name":          "mock-bucket",
+		"resource_instance_id": instance.Id(),
+		"region_location":      "us-south",
+		"storage_class":        "standard",
+		"archive_rule": []interface{}{map[string]interface{}{
+			"rule_id": "archive",
+			"enable":  true,
+			"days":    30,
+			"type":    "GLACIER",
+		}},
+		"expire_rule": []interface{}{map[string]interface{}{
+			"rule_id": "expire",
+			"enable":  true,
+			"days":    365,
+			"prefix":  "logs/",
+		}},
+	}
+	d = testMockResourceData(t, r, d, archiveExpireRaw)
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), d, meta)))
+	imported = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+	imported.SetId(d.Id())
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), imported, meta)))
+	assert.Equal(t, len(imported.Get("lifecycle_rule").([]interface{})), 0)
+	assert.Equal(t, imported.Get("archive_rule.0.rule_id"), "archive")
+	assert.Equal(t, imported.Get("archive_rule.0.days"), 30)
+	assert.Equal(t, imported.Get("archive_rule.0.type"), "GLACIER")
+	assert.Equal(t, imported.Get("expire_rule.0.rule_id"), "expire")
+	assert.Equal(t, imported.Get("expire_rule.0.days"), 365)
+	assert.Equal(t, imported.Get("expire_rule.0.prefix"), "logs/")
+	diff, err := schema.InternalMap(r.Schema).Diff(context.Background(), imported.State(), terraform.NewResourceConfigRaw(archiveExpireRaw), nil, nil, true)
+	assert.NilError(t, err)
+	for k := range diff.Attributes {
+		assert.Assert(t, !strings.Contains(k, "_rule"), "%v", diff)
+	}
+
+	id := d.Id()
+	assert.NilError(t, testDiagsErr(r.DeleteContext(context.Background(), d, meta)))
+	d.SetId(id)
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), d, meta)))
+	assert.Equal(t, d.Id(), "")
+
+	assert.NilError(t, testDiagsErr(instanceResource.DeleteContext(context.Background(), instance, meta)))
+}
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -59,11 +60,10 @@ type CsEntry struct {
 
 func resourceIBMDatabaseInstance() *schema.Resource {
 	return &schema.Resource{
-		Create:        resourceIBMDatabaseInstanceCreate,
-		Read:          resourceIBMDatabaseInstanceRead,
-		Update:        resourceIBMDatabaseInstanceUpdate,
-		Delete:        resourceIBMDatabaseInstanceDelete,
-		Exists:        resourceIBMDatabaseInstanceExists,
+		CreateContext: resourceIBMDatabaseInstanceCreate,
+		ReadContext:   resourceIBMDatabaseInstanceRead,
+		UpdateContext: resourceIBMDatabaseInstanceUpdate,
+		DeleteContext: resourceIBMDatabaseInstanceDelete,
 		CustomizeDiff: resourceIBMDatabaseInstanceDiff,
 		Importer:      &schema.ResourceImporter{},
 
@@ -915,10 +915,11 @@ func resourceIBMDatabaseInstanceDiff(_ context.Context, diff *schema.ResourceDif
 }
 
 // Replace with func wrapper for resourceIBMResourceInstanceCreate specifying serviceName := "database......."
-func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	serviceName := d.Get("service").(string)
@@ -932,27 +933,27 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 
 	rsCatClient, err := meta.(ClientSession).ResourceCatalogAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	rsCatRepo := rsCatClient.ResourceCatalog()
 
 	serviceOff, err := rsCatRepo.FindByName(serviceName, true)
 	if err != nil {
-		return fmt.Errorf("Error retrieving database service offering: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving database service offering: %s", err))
 	}
 
 	servicePlan, err := rsCatRepo.GetServicePlanID(serviceOff[0], plan)
 	if err != nil {
-		return fmt.Errorf("Error retrieving plan: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving plan: %s", err))
 	}
 	rsInst.ResourcePlanID = &servicePlan
 
 	deployments, err := rsCatRepo.ListDeployments(servicePlan)
 	if err != nil {
-		return fmt.Errorf("Error retrieving deployment for plan %s : %s", plan, err)
+		return diag.FromErr(fmt.Errorf("Error retrieving deployment for plan %s : %s", plan, err))
 	}
 	if len(deployments) == 0 {
-		return fmt.Errorf("No deployment found for service plan : %s", plan)
+		return diag.FromErr(fmt.Errorf("No deployment found for service plan : %s", plan))
 	}
 	deployments, supportedLocations := filterDatabaseDeployments(deployments, location)
 
@@ -961,7 +962,7 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 		for l := range supportedLocations {
 			locationList = append(locationList, l)
 		}
-		return fmt.Errorf("No deployment found for service plan %s at location %s.\nValid location(s) are: %q.", plan, location, locationList)
+		return diag.FromErr(fmt.Errorf("No deployment found for service plan %s at location %s.\nValid location(s) are: %q.", plan, location, locationList))
 	}
 	catalogCRN := deployments[0].CatalogCRN
 	rsInst.Target = &catalogCRN
@@ -972,14 +973,14 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 	} else {
 		defaultRg, err := defaultResourceGroup(meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		rsInst.ResourceGroup = &defaultRg
 	}
 
 	initialNodeCount, err := getInitialNodeCount(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	params := Params{}
@@ -1035,16 +1036,16 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 
 	instance, response, err := rsConClient.CreateResourceInstance(&rsInst)
 	if err != nil {
-		return fmt.Errorf("Error creating database instance: %s %s", err, response)
+		return diag.FromErr(fmt.Errorf("Error creating database instance: %s %s", err, response))
 	}
 
 	// Moved d.SetId(instance.ID) to after waiting for resource to finish creation. Otherwise Terraform initates depedent tasks too early.
 	// Original flow had SetId here as its required as input to waitForDatabaseInstanceCreate
 
-	_, err = waitForDatabaseInstanceCreate(d, meta, *instance.ID)
+	_, err = waitForDatabaseInstanceCreate(context, d, meta, *instance.ID)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for create database instance (%s) to complete: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf(
+			"Error waiting for create database instance (%s) to complete: %s", d.Id(), err))
 	}
 
 	d.SetId(*instance.ID)
@@ -1053,12 +1054,12 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 		if initialNodeCount != node_count {
 			icdClient, err := meta.(ClientSession).ICDAPI()
 			if err != nil {
-				return fmt.Errorf("Error getting database client settings: %s", err)
+				return diag.FromErr(fmt.Errorf("Error getting database client settings: %s", err))
 			}
 
-			err = horizontalScale(d, meta, icdClient)
+			err = horizontalScale(context, d, meta, icdClient)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -1069,13 +1070,14 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 		if err != nil {
 			log.Printf(
 				"Error on create of ibm database (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 
 	icdId := EscapeUrlParm(*instance.ID)
 	icdClient, err := meta.(ClientSession).ICDAPI()
 	if err != nil {
-		return fmt.Errorf("Error getting database client settings: %s", err)
+		return diag.FromErr(fmt.Errorf("Error getting database client settings: %s", err))
 	}
 
 	if pw, ok := d.GetOk("adminpassword"); ok {
@@ -1083,9 +1085,9 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 		cdb, err := icdClient.Cdbs().GetCdb(icdId)
 		if err != nil {
 			if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
-				return fmt.Errorf("The database instance was not found in the region set for the Provider, or the default of us-south. Specify the correct region in the provider definition, or create a provider alias for the correct region. %v", err)
+				return diag.FromErr(fmt.Errorf("The database instance was not found in the region set for the Provider, or the default of us-south. Specify the correct region in the provider definition, or create a provider alias for the correct region. %v", err))
 			}
-			return fmt.Errorf("Error getting database config while updating adminpassword for: %s with error %s\n", icdId, err)
+			return diag.FromErr(fmt.Errorf("Error getting database config while updating adminpassword for: %s with error %s\n", icdId, err))
 		}
 
 		userParams := icdv4.UserReq{
@@ -1095,12 +1097,12 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 		}
 		task, err := icdClient.Users().UpdateUser(icdId, cdb.AdminUser, userParams)
 		if err != nil {
-			return fmt.Errorf("Error updating database admin password: %s", err)
+			return diag.FromErr(fmt.Errorf("Error updating database admin password: %s", err))
 		}
 		_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for update of database (%s) admin password task to complete: %s", icdId, err)
+			return diag.FromErr(fmt.Errorf(
+				"Error waiting for update of database (%s) admin password task to complete: %s", icdId, err))
 		}
 	}
 
@@ -1115,12 +1117,12 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 			}
 			task, err := icdClient.Whitelists().CreateWhitelist(icdId, whitelistReq)
 			if err != nil {
-				return fmt.Errorf("Error updating database whitelist entry: %s", err)
+				return diag.FromErr(fmt.Errorf("Error updating database whitelist entry: %s", err))
 			}
 			_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutCreate))
 			if err != nil {
-				return fmt.Errorf(
-					"Error waiting for update of database (%s) whitelist task to complete: %s", icdId, err)
+				return diag.FromErr(fmt.Errorf(
+					"Error waiting for update of database (%s) whitelist task to complete: %s", icdId, err))
 			}
 		}
 	}
@@ -1128,17 +1130,17 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 		params := icdv4.AutoscalingSetGroup{}
 		cpuBody, err := expandICDAutoScalingGroup(d, cpuRecord, "cpu")
 		if err != nil {
-			return fmt.Errorf("Error in getting cpuBody from expandICDAutoScalingGroup %s", err)
+			return diag.FromErr(fmt.Errorf("Error in getting cpuBody from expandICDAutoScalingGroup %s", err))
 		}
 		params.Autoscaling.CPU = &cpuBody
 		task, err := icdClient.AutoScaling().SetAutoScaling(icdId, "member", params)
 		if err != nil {
-			return fmt.Errorf("Error updating database cpu auto_scaling group: %s", err)
+			return diag.FromErr(fmt.Errorf("Error updating database cpu auto_scaling group: %s", err))
 		}
 		_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for database (%s) cpu auto_scaling group update task to complete: %s", icdId, err)
+			return diag.FromErr(fmt.Errorf(
+				"Error waiting for database (%s) cpu auto_scaling group update task to complete: %s", icdId, err))
 		}
 
 	}
@@ -1146,17 +1148,17 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 		params := icdv4.AutoscalingSetGroup{}
 		diskBody, err := expandICDAutoScalingGroup(d, diskRecord, "disk")
 		if err != nil {
-			return fmt.Errorf("Error in getting diskBody from expandICDAutoScalingGroup %s", err)
+			return diag.FromErr(fmt.Errorf("Error in getting diskBody from expandICDAutoScalingGroup %s", err))
 		}
 		params.Autoscaling.Disk = &diskBody
 		task, err := icdClient.AutoScaling().SetAutoScaling(icdId, "member", params)
 		if err != nil {
-			return fmt.Errorf("Error updating database disk auto_scaling group: %s", err)
+			return diag.FromErr(fmt.Errorf("Error updating database disk auto_scaling group: %s", err))
 		}
 		_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for database (%s) disk auto_scaling group update task to complete: %s", icdId, err)
+			return diag.FromErr(fmt.Errorf(
+				"Error waiting for database (%s) disk auto_scaling group update task to complete: %s", icdId, err))
 		}
 
 	}
@@ -1164,17 +1166,17 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 		params := icdv4.AutoscalingSetGroup{}
 		memoryBody, err := expandICDAutoScalingGroup(d, memoryRecord, "memory")
 		if err != nil {
-			return fmt.Errorf("Error in getting memoryBody from expandICDAutoScalingGroup %s", err)
+			return diag.FromErr(fmt.Errorf("Error in getting memoryBody from expandICDAutoScalingGroup %s", err))
 		}
 		params.Autoscaling.Memory = &memoryBody
 		task, err := icdClient.AutoScaling().SetAutoScaling(icdId, "member", params)
 		if err != nil {
-			return fmt.Errorf("Error updating database memory auto_scaling group: %s", err)
+			return diag.FromErr(fmt.Errorf("Error updating database memory auto_scaling group: %s", err))
 		}
 		_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for database (%s) memory auto_scaling group update task to complete: %s", icdId, err)
+			return diag.FromErr(fmt.Errorf(
+				"Error waiting for database (%s) memory auto_scaling group update task to complete: %s", icdId, err))
 		}
 
 	}
@@ -1190,23 +1192,32 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 			}
 			task, err := icdClient.Users().CreateUser(icdId, userReq)
 			if err != nil {
-				return fmt.Errorf("Error updating database user (%s) entry: %s", user.UserName, err)
+				return diag.FromErr(fmt.Errorf("Error updating database user (%s) entry: %s", user.UserName, err))
 			}
 			_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutCreate))
 			if err != nil {
-				return fmt.Errorf(
-					"Error waiting for update of database (%s) user (%s) create task to complete: %s", icdId, user.UserName, err)
+				return diag.FromErr(fmt.Errorf(
+					"Error waiting for update of database (%s) user (%s) create task to complete: %s", icdId, user.UserName, err))
 			}
 		}
 	}
 
-	return resourceIBMDatabaseInstanceRead(d, meta)
+	return append(diags, resourceIBMDatabaseInstanceRead(context, d, meta)...)
 }
 
-func resourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDatabaseInstanceRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMDatabaseInstanceExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceID := d.Id()
@@ -1222,7 +1233,7 @@ func resourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{}) e
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error retrieving resource instance: %s %s", err, response)
+		return diag.FromErr(fmt.Errorf("Error retrieving resource instance: %s %s", err, response))
 	}
 	if strings.Contains(*instance.State, "removed") {
 		log.Printf("[WARN] Removing instance from TF state because it's now in removed state")
@@ -1264,48 +1275,48 @@ func resourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{}) e
 
 	rcontroller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(ResourceControllerURL, rcontroller+"/services/"+url.QueryEscape(*instance.CRN))
 
 	rsCatClient, err := meta.(ClientSession).ResourceCatalogAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	rsCatRepo := rsCatClient.ResourceCatalog()
 
 	serviceOff, err := rsCatRepo.GetServiceName(*instance.ResourceID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving service offering: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving service offering: %s", err))
 	}
 
 	d.Set("service", serviceOff)
 
 	servicePlan, err := rsCatRepo.GetServicePlanName(*instance.ResourcePlanID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving plan: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving plan: %s", err))
 	}
 	d.Set("plan", servicePlan)
 
 	icdClient, err := meta.(ClientSession).ICDAPI()
 	if err != nil {
-		return fmt.Errorf("Error getting database client settings: %s", err)
+		return diag.FromErr(fmt.Errorf("Error getting database client settings: %s", err))
 	}
 
 	icdId := EscapeUrlParm(instanceID)
 	cdb, err := icdClient.Cdbs().GetCdb(icdId)
 	if err != nil {
 		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
-			return fmt.Errorf("The database instance was not found in the region set for the Provider. Specify the correct region in the provider definition. %v", err)
+			return diag.FromErr(fmt.Errorf("The database instance was not found in the region set for the Provider. Specify the correct region in the provider definition. %v", err))
 		}
-		return fmt.Errorf("Error getting database config for: %s with error %s\n", icdId, err)
+		return diag.FromErr(fmt.Errorf("Error getting database config for: %s with error %s\n", icdId, err))
 	}
 	d.Set("adminuser", cdb.AdminUser)
 	d.Set("version", cdb.Version)
 
 	groupList, err := icdClient.Groups().GetGroups(icdId)
 	if err != nil {
-		return fmt.Errorf("Error getting database groups: %s", err)
+		return diag.FromErr(fmt.Errorf("Error getting database groups: %s", err))
 	}
 	d.Set("groups", flattenIcdGroups(groupList))
 	d.Set("node_count", groupList.Groups[0].Members.AllocationCount)
@@ -1321,13 +1332,13 @@ func resourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{}) e
 
 	autoSclaingGroup, err := icdClient.AutoScaling().GetAutoScaling(icdId, "member")
 	if err != nil {
-		return fmt.Errorf("Error getting database groups: %s", err)
+		return diag.FromErr(fmt.Errorf("Error getting database groups: %s", err))
 	}
 	d.Set("auto_scaling", flattenICDAutoScalingGroup(autoSclaingGroup))
 
 	whitelist, err := icdClient.Whitelists().GetWhitelist(icdId)
 	if err != nil {
-		return fmt.Errorf("Error getting database whitelist: %s", err)
+		return diag.FromErr(fmt.Errorf("Error getting database whitelist: %s", err))
 	}
 	d.Set("whitelist", flattenWhitelist(whitelist))
 
@@ -1343,7 +1354,7 @@ func resourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{}) e
 		userName := user.UserName
 		csEntry, err := getConnectionString(d, userName, connectionEndpoint, meta)
 		if err != nil {
-			return fmt.Errorf("Error getting user connection string for user (%s): %s", userName, err)
+			return diag.FromErr(fmt.Errorf("Error getting user connection string for user (%s): %s", userName, err))
 		}
 		connectionStrings = append(connectionStrings, csEntry)
 	}
@@ -1352,10 +1363,11 @@ func resourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceID := d.Id()
@@ -1381,13 +1393,13 @@ func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 	if update {
 		_, response, err := rsConClient.UpdateResourceInstance(&updateReq)
 		if err != nil {
-			return fmt.Errorf("Error updating resource instance: %s %s", err, response)
+			return diag.FromErr(fmt.Errorf("Error updating resource instance: %s %s", err, response))
 		}
 
-		_, err = waitForDatabaseInstanceUpdate(d, meta)
+		_, err = waitForDatabaseInstanceUpdate(context, d, meta)
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for update of resource instance (%s) to complete: %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf(
+				"Error waiting for update of resource instance (%s) to complete: %s", d.Id(), err))
 		}
 
 	}
@@ -1399,19 +1411,20 @@ func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 		if err != nil {
 			log.Printf(
 				"Error on update of Database (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 
 	icdClient, err := meta.(ClientSession).ICDAPI()
 	if err != nil {
-		return fmt.Errorf("Error getting database client settings: %s", err)
+		return diag.FromErr(fmt.Errorf("Error getting database client settings: %s", err))
 	}
 	icdId := EscapeUrlParm(instanceID)
 
 	if d.HasChange("node_count") {
-		err = horizontalScale(d, meta, icdClient)
+		err = horizontalScale(context, d, meta, icdClient)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -1452,12 +1465,12 @@ func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 		}
 		task, err := icdClient.Groups().UpdateGroup(icdId, "member", params)
 		if err != nil {
-			return fmt.Errorf("Error updating database scaling group: %s", err)
+			return diag.FromErr(fmt.Errorf("Error updating database scaling group: %s", err))
 		}
 		_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for database (%s) scaling group update task to complete: %s", icdId, err)
+			return diag.FromErr(fmt.Errorf(
+				"Error waiting for database (%s) scaling group update task to complete: %s", icdId, err))
 		}
 	}
 
@@ -1466,17 +1479,17 @@ func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 		params := icdv4.AutoscalingSetGroup{}
 		cpuBody, err := expandICDAutoScalingGroup(d, cpuRecord, "cpu")
 		if err != nil {
-			return fmt.Errorf("Error in getting cpuBody from expandICDAutoScalingGroup %s", err)
+			return diag.FromErr(fmt.Errorf("Error in getting cpuBody from expandICDAutoScalingGroup %s", err))
 		}
 		params.Autoscaling.CPU = &cpuBody
 		task, err := icdClient.AutoScaling().SetAutoScaling(icdId, "member", params)
 		if err != nil {
-			return fmt.Errorf("Error updating database cpu auto_scaling group: %s", err)
+			return diag.FromErr(fmt.Errorf("Error updating database cpu auto_scaling group: %s", err))
 		}
 		_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for database (%s) cpu auto_scaling group update task to complete: %s", icdId, err)
+			return diag.FromErr(fmt.Errorf(
+				"Error waiting for database (%s) cpu auto_scaling group update task to complete: %s", icdId, err))
 		}
 
 	}
@@ -1485,17 +1498,17 @@ func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 		params := icdv4.AutoscalingSetGroup{}
 		diskBody, err := expandICDAutoScalingGroup(d, diskRecord, "disk")
 		if err != nil {
-			return fmt.Errorf("Error in getting diskBody from expandICDAutoScalingGroup %s", err)
+			return diag.FromErr(fmt.Errorf("Error in getting diskBody from expandICDAutoScalingGroup %s", err))
 		}
 		params.Autoscaling.Disk = &diskBody
 		task, err := icdClient.AutoScaling().SetAutoScaling(icdId, "member", params)
 		if err != nil {
-			return fmt.Errorf("Error updating database disk auto_scaling  group: %s", err)
+			return diag.FromErr(fmt.Errorf("Error updating database disk auto_scaling  group: %s", err))
 		}
 		_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for database (%s) disk auto_scaling group update task to complete: %s", icdId, err)
+			return diag.FromErr(fmt.Errorf(
+				"Error waiting for database (%s) disk auto_scaling group update task to complete: %s", icdId, err))
 		}
 
 	}
@@ -1504,17 +1517,17 @@ func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 		params := icdv4.AutoscalingSetGroup{}
 		memoryBody, err := expandICDAutoScalingGroup(d, memoryRecord, "memory")
 		if err != nil {
-			return fmt.Errorf("Error in getting memoryBody from expandICDAutoScalingGroup %s", err)
+			return diag.FromErr(fmt.Errorf("Error in getting memoryBody from expandICDAutoScalingGroup %s", err))
 		}
 		params.Autoscaling.Memory = &memoryBody
 		task, err := icdClient.AutoScaling().SetAutoScaling(icdId, "member", params)
 		if err != nil {
-			return fmt.Errorf("Error updating database memory auto_scaling  group: %s", err)
+			return diag.FromErr(fmt.Errorf("Error updating database memory auto_scaling  group: %s", err))
 		}
 		_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for database (%s) memory auto_scaling group update task to complete: %s", icdId, err)
+			return diag.FromErr(fmt.Errorf(
+				"Error waiting for database (%s) memory auto_scaling group update task to complete: %s", icdId, err))
 		}
 
 	}
@@ -1529,12 +1542,12 @@ func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 		}
 		task, err := icdClient.Users().UpdateUser(icdId, adminUser, userParams)
 		if err != nil {
-			return fmt.Errorf("Error updating database admin password: %s", err)
+			return diag.FromErr(fmt.Errorf("Error updating database admin password: %s", err))
 		}
 		_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for database (%s) admin password update task to complete: %s", icdId, err)
+			return diag.FromErr(fmt.Errorf(
+				"Error waiting for database (%s) admin password update task to complete: %s", icdId, err))
 		}
 	}
 
@@ -1563,12 +1576,12 @@ func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 				}
 				task, err := icdClient.Whitelists().CreateWhitelist(icdId, whitelistReq)
 				if err != nil {
-					return fmt.Errorf("Error updating database whitelist entry %v : %s", wlEntry.Address, err)
+					return diag.FromErr(fmt.Errorf("Error updating database whitelist entry %v : %s", wlEntry.Address, err))
 				}
 				_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
-					return fmt.Errorf(
-						"Error waiting for database (%s) whitelist create task to complete for entry %s : %s", icdId, wlEntry.Address, err)
+					return diag.FromErr(fmt.Errorf(
+						"Error waiting for database (%s) whitelist create task to complete for entry %s : %s", icdId, wlEntry.Address, err))
 				}
 
 			}
@@ -1585,12 +1598,12 @@ func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 				ipAddress := wlEntry.Address
 				task, err := icdClient.Whitelists().DeleteWhitelist(icdId, ipAddress)
 				if err != nil {
-					return fmt.Errorf("Error deleting database whitelist entry: %s", err)
+					return diag.FromErr(fmt.Errorf("Error deleting database whitelist entry: %s", err))
 				}
 				_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
-					return fmt.Errorf(
-						"Error waiting for database (%s) whitelist delete task to complete for ipAddress %s : %s", icdId, ipAddress, err)
+					return diag.FromErr(fmt.Errorf(
+						"Error waiting for database (%s) whitelist delete task to complete for ipAddress %s : %s", icdId, ipAddress, err))
 				}
 
 			}
@@ -1631,18 +1644,18 @@ func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 					}
 					task, err := icdClient.Users().UpdateUser(icdId, newEntry["name"].(string), userParams)
 					if err != nil {
-						return fmt.Errorf("Error updating database user (%s) password: %s", newEntry["name"].(string), err)
+						return diag.FromErr(fmt.Errorf("Error updating database user (%s) password: %s", newEntry["name"].(string), err))
 					}
 					_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
 					if err != nil {
-						return fmt.Errorf(
-							"Error waiting for database (%s) user (%s) password update task to complete: %s", icdId, newEntry["name"].(string), err)
+						return diag.FromErr(fmt.Errorf(
+							"Error waiting for database (%s) user (%s) password update task to complete: %s", icdId, newEntry["name"].(string), err))
 					}
 				} else {
 					_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
 					if err != nil {
-						return fmt.Errorf(
-							"Error waiting for database (%s) user (%s) create task to complete: %s", icdId, newEntry["name"].(string), err)
+						return diag.FromErr(fmt.Errorf(
+							"Error waiting for database (%s) user (%s) create task to complete: %s", icdId, newEntry["name"].(string), err))
 					}
 				}
 			}
@@ -1659,21 +1672,21 @@ func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 				user := userEntry.UserName
 				task, err := icdClient.Users().DeleteUser(icdId, user)
 				if err != nil {
-					return fmt.Errorf("Error deleting database user (%s) entry: %s", user, err)
+					return diag.FromErr(fmt.Errorf("Error deleting database user (%s) entry: %s", user, err))
 				}
 				_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
-					return fmt.Errorf(
-						"Error waiting for database (%s) user (%s) delete task to complete: %s", icdId, user, err)
+					return diag.FromErr(fmt.Errorf(
+						"Error waiting for database (%s) user (%s) delete task to complete: %s", icdId, user, err))
 				}
 			}
 		}
 	}
 
-	return resourceIBMDatabaseInstanceRead(d, meta)
+	return append(diags, resourceIBMDatabaseInstanceRead(context, d, meta)...)
 }
 
-func horizontalScale(d *schema.ResourceData, meta interface{}, icdClient icdv4.ICDServiceAPI) error {
+func horizontalScale(context context.Context, d *schema.ResourceData, meta interface{}, icdClient icdv4.ICDServiceAPI) error {
 	params := icdv4.GroupReq{}
 
 	icdId := EscapeUrlParm(d.Id())
@@ -1695,7 +1708,7 @@ func horizontalScale(d *schema.ResourceData, meta interface{}, icdClient icdv4.I
 	//		"Error waiting for database (%s) scaling group update task to complete: %s", icdId, err)
 	//}
 
-	_, err = waitForDatabaseInstanceUpdate(d, meta)
+	_, err = waitForDatabaseInstanceUpdate(context, d, meta)
 	if err != nil {
 		return fmt.Errorf(
 			"Error waiting for database (%s) horizontal scale to complete: %s", d.Id(), err)
@@ -1766,10 +1779,10 @@ func getConnectionString(d *schema.ResourceData, userName, connectionEndpoint st
 	return csEntry, nil
 }
 
-func resourceIBMDatabaseInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDatabaseInstanceDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	recursive := true
@@ -1786,14 +1799,14 @@ func resourceIBMDatabaseInstanceDelete(d *schema.ResourceData, meta interface{})
 			log.Printf("[WARN] Resource instance already deleted %s\n ", err)
 			err = nil
 		} else {
-			return fmt.Errorf("Error deleting resource instance: %s %s ", err, response)
+			return diag.FromErr(fmt.Errorf("Error deleting resource instance: %s %s ", err, response))
 		}
 	}
 
-	_, err = waitForDatabaseInstanceDelete(d, meta)
+	_, err = waitForDatabaseInstanceDelete(context, d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for resource instance (%s) to be deleted: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf(
+			"Error waiting for resource instance (%s) to be deleted: %s", d.Id(), err))
 	}
 
 	d.SetId("")
@@ -1827,7 +1840,7 @@ func resourceIBMDatabaseInstanceExists(d *schema.ResourceData, meta interface{})
 	return *instance.ID == instanceID, nil
 }
 
-func waitForDatabaseInstanceCreate(d *schema.ResourceData, meta interface{}, instanceID string) (interface{}, error) {
+func waitForDatabaseInstanceCreate(context context.Context, d *schema.ResourceData, meta interface{}, instanceID string) (interface{}, error) {
 	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return false, err
@@ -1857,10 +1870,10 @@ func waitForDatabaseInstanceCreate(d *schema.ResourceData, meta interface{}, ins
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
-func waitForDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForDatabaseInstanceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return false, err
@@ -1891,7 +1904,7 @@ func waitForDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{}) (in
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func waitForDatabaseTaskComplete(taskId string, d *schema.ResourceData, meta interface{}, t time.Duration) (bool, error) {
@@ -1926,7 +1939,7 @@ func waitForDatabaseTaskComplete(taskId string, d *schema.ResourceData, meta int
 	}
 }
 
-func waitForDatabaseInstanceDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForDatabaseInstanceDelete(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return false, err
@@ -1956,7 +1969,7 @@ func waitForDatabaseInstanceDelete(d *schema.ResourceData, meta interface{}) (in
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func filterDatabaseDeployments(deployments []models.ServiceDeployment, location string) ([]models.ServiceDeployment, map[string]bool) {
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/IBM/networking-go-sdk/directlinkv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -64,12 +65,11 @@ const (
 
 func resourceIBMDLGateway() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMdlGatewayCreate,
-		Read:     resourceIBMdlGatewayRead,
-		Delete:   resourceIBMdlGatewayDelete,
-		Exists:   resourceIBMdlGatewayExists,
-		Update:   resourceIBMdlGatewayUpdate,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMdlGatewayCreate,
+		ReadContext:   resourceIBMdlGatewayRead,
+		DeleteContext: resourceIBMdlGatewayDelete,
+		UpdateContext: resourceIBMdlGatewayUpdate,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -410,10 +410,11 @@ func directlinkClient(meta interface{}) (*directlinkv1.DirectLinkV1, error) {
 	return sess, err
 }
 
-func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlGatewayCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dtype := d.Get(dlType).(string)
 	createGatewayOptionsModel := &directlinkv1.CreateGatewayOptions{}
@@ -431,7 +432,7 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 		} else {
 			err = fmt.Errorf("Error creating gateway, %s is a required field", dlCarrierName)
 			log.Printf("%s is a required field", dlCarrierName)
-			return err
+			return diag.FromErr(err)
 		}
 		if _, ok := d.GetOk(dlCrossConnectRouter); ok {
 			crossConnectRouter = d.Get(dlCrossConnectRouter).(string)
@@ -439,7 +440,7 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 		} else {
 			err = fmt.Errorf("Error creating gateway, %s is a required field", dlCrossConnectRouter)
 			log.Printf("%s is a required field", dlCrossConnectRouter)
-			return err
+			return diag.FromErr(err)
 		}
 		if _, ok := d.GetOk(dlLocationName); ok {
 			locationName = d.Get(dlLocationName).(string)
@@ -447,7 +448,7 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 		} else {
 			err = fmt.Errorf("Error creating gateway, %s is a required field", dlLocationName)
 			log.Printf("%s is a required field", dlLocationName)
-			return err
+			return diag.FromErr(err)
 		}
 		if _, ok := d.GetOk(dlCustomerName); ok {
 			customerName = d.Get(dlCustomerName).(string)
@@ -455,7 +456,7 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 		} else {
 			err = fmt.Errorf("Error creating gateway, %s is a required field", dlCustomerName)
 			log.Printf("%s is a required field", dlCustomerName)
-			return err
+			return diag.FromErr(err)
 		}
 		gatewayDedicatedTemplateModel, _ := directLink.NewGatewayTemplateGatewayTypeDedicatedTemplate(bgpAsn, global, metered, name, speed, dtype, carrierName, crossConnectRouter, customerName, locationName)
 
@@ -537,13 +538,13 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 
 		} else {
 			err = fmt.Errorf("Error creating direct link connect gateway, %s is a required field", dlPort)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	gateway, response, err := directLink.CreateGateway(createGatewayOptionsModel)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Create Direct Link Gateway (%s) err %s\n%s", dtype, err, response)
+		return diag.FromErr(fmt.Errorf("[DEBUG] Create Direct Link Gateway (%s) err %s\n%s", dtype, err, response))
 	}
 	d.SetId(*gateway.ID)
 
@@ -552,12 +553,12 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 		getPortOptions := directLink.NewGetPortOptions(*gateway.Port.ID)
 		port, response, err := directLink.GetPort(getPortOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting port %s %s", response, err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error getting port %s %s", response, err))
 		}
 		if port != nil && port.ProviderName != nil && !strings.Contains(strings.ToLower(*port.ProviderName), "netbond") && !strings.Contains(strings.ToLower(*port.ProviderName), "megaport") {
-			_, err = isWaitForDirectLinkAvailable(directLink, d.Id(), d.Timeout(schema.TimeoutCreate))
+			_, err = isWaitForDirectLinkAvailable(context, directLink, d.Id(), d.Timeout(schema.TimeoutCreate))
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
@@ -570,19 +571,29 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 		if err != nil {
 			log.Printf(
 				"Error on create of resource direct link gateway %s (%s) tags: %s", dtype, d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 
-	return resourceIBMdlGatewayRead(d, meta)
+	return append(diags, resourceIBMdlGatewayRead(context, d, meta)...)
 }
 
-func resourceIBMdlGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlGatewayRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMdlGatewayExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	dtype := d.Get(dlType).(string)
 	log.Printf("[INFO] Inside resourceIBMdlGatewayRead: %s", dtype)
 
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ID := d.Id()
@@ -598,7 +609,7 @@ func resourceIBMdlGatewayRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Direct Link Gateway (%s Template): %s\n%s", dtype, err, response)
+		return diag.FromErr(fmt.Errorf("Error Getting Direct Link Gateway (%s Template): %s\n%s", dtype, err, response))
 	}
 	if instance.Name != nil {
 		d.Set(dlName, *instance.Name)
@@ -716,6 +727,7 @@ func resourceIBMdlGatewayRead(d *schema.ResourceData, meta interface{}) error {
 			d.Set(dlMacSecConfig, macsecList)
 		}
 	}
+	d.Set(dlChangeRequest, "")
 	if instance.ChangeRequest != nil {
 		gatewayChangeRequestIntf := instance.ChangeRequest
 		gatewayChangeRequest := gatewayChangeRequestIntf.(*directlinkv1.GatewayChangeRequest)
@@ -729,7 +741,7 @@ func resourceIBMdlGatewayRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set(dlTags, tags)
 	controller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(ResourceControllerURL, controller+"/interconnectivity/direct-link")
 	d.Set(ResourceName, *instance.Name)
@@ -743,7 +755,7 @@ func resourceIBMdlGatewayRead(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
-func isWaitForDirectLinkAvailable(client *directlinkv1.DirectLinkV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForDirectLinkAvailable(context context.Context, client *directlinkv1.DirectLinkV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for direct link (%s) to be provisioned.", id)
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", dlGatewayProvisioning},
@@ -753,7 +765,7 @@ func isWaitForDirectLinkAvailable(client *directlinkv1.DirectLinkV1, id string,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 func isDirectLinkRefreshFunc(client *directlinkv1.DirectLinkV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -771,11 +783,12 @@ func isDirectLinkRefreshFunc(client *directlinkv1.DirectLinkV1, id string) resou
 	}
 }
 
-func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlGatewayUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ID := d.Id()
@@ -786,7 +799,7 @@ func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 
 	if err != nil {
 		log.Printf("Error fetching Direct Link Gateway :%s", detail)
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateGatewayOptionsModel := &directlinkv1.UpdateGatewayOptions{}
@@ -799,6 +812,7 @@ func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 		if err != nil {
 			log.Printf(
 				"Error on update of resource direct link gateway (%s) tags: %s", *instance.ID, err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 
@@ -877,17 +891,17 @@ func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 	_, response, err := directLink.UpdateGateway(updateGatewayOptionsModel)
 	if err != nil {
 		log.Printf("[DEBUG] Update Direct Link Gateway err %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMdlGatewayRead(d, meta)
+	return append(diags, resourceIBMdlGatewayRead(context, d, meta)...)
 }
 
-func resourceIBMdlGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlGatewayDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ID := d.Id()
@@ -898,7 +912,7 @@ func resourceIBMdlGatewayDelete(d *schema.ResourceData, meta interface{}) error
 
 	if err != nil && response.StatusCode != 404 {
 		log.Printf("Error deleting Direct Link Gateway : %s", response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
@@ -0,0 +1,211 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM/networking-go-sdk/directlinkv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	dlActionGateway = "gateway"
+	dlAction        = "action"
+)
+
+func resourceIBMDLGatewayAction() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMdlGatewayActionCreate,
+		ReadContext:   resourceIBMdlGatewayActionRead,
+		UpdateContext: resourceIBMdlGatewayActionUpdate,
+		DeleteContext: resourceIBMdlGatewayActionDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			dlActionGateway: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The Direct Link Connect gateway identifier",
+			},
+			dlAction: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: InvokeValidator("ibm_dl_gateway_action", dlAction),
+				Description:  "Approve or reject the change requested by the provider of the gateway, one of create_gateway_approve, create_gateway_reject, delete_gateway_approve, delete_gateway_reject, update_attributes_approve and update_attributes_reject",
+			},
+			dlGlobal: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Gateways with global routing (true) can connect to networks outside their associated region. Required for the create_gateway_approve action.",
+			},
+			dlMetered: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Metered billing option. When true gateway usage is billed per gigabyte. Required for the create_gateway_approve action.",
+			},
+			dlResourceGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The resource group of the gateway, set by the create_gateway_approve action. If unspecified, the account's default resource group is used.",
+			},
+			dlSpeedMbps: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The gateway speed in megabits per second of the pending update approved or rejected. Required for the update_attributes_approve and update_attributes_reject actions.",
+			},
+			dlName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique user-defined name for this gateway",
+			},
+			dlOperationalStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Gateway operational status",
+			},
+			dlChangeRequest: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Changes pending approval for provider managed Direct Link Connect gateways",
+			},
+		},
+	}
+}
+
+func resourceIBMDLGatewayActionValidator() *ResourceValidator {
+
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 dlAction,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Required:                   true,
+			AllowedValues:              "create_gateway_approve, create_gateway_reject, delete_gateway_approve, delete_gateway_reject, update_attributes_approve, update_attributes_reject"})
+
+	ibmDLGatewayActionValidator := ResourceValidator{ResourceName: "ibm_dl_gateway_action", Schema: validateSchema}
+	return &ibmDLGatewayActionValidator
+}
+
+func resourceIBMdlGatewayActionCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	directLink, err := directlinkClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	gatewayId := d.Get(dlActionGateway).(string)
+	err = dlGatewayAction(context, directLink, d, gatewayId, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(gatewayId)
+	return resourceIBMdlGatewayActionRead(context, d, meta)
+}
+
+func resourceIBMdlGatewayActionRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	directLink, err := directlinkClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	ID := d.Id()
+	instance, response, err := directLink.GetGateway(&directlinkv1.GetGatewayOptions{
+		ID: &ID,
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			// The gateway is gone once its deletion is approved
+			if d.Get(dlAction).(string) == directlinkv1.CreateGatewayActionOptions_Action_DeleteGatewayApprove {
+				d.Set(dlOperationalStatus, "deleted")
+				d.Set(dlChangeRequest, "")
+				return nil
+			}
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("Error Getting Direct Link Gateway (%s): %s\n%s", ID, err, response))
+	}
+	d.Set(dlActionGateway, *instance.ID)
+	d.Set(dlName, *instance.Name)
+	d.Set(dlOperationalStatus, *instance.OperationalStatus)
+	d.Set(dlChangeRequest, "")
+	if instance.ChangeRequest != nil {
+		gatewayChangeRequest := instance.ChangeRequest.(*directlinkv1.GatewayChangeRequest)
+		d.Set(dlChangeRequest, *gatewayChangeRequest.Type)
+	}
+	if instance.ResourceGroup != nil {
+		d.Set(dlResourceGroup, *instance.ResourceGroup.ID)
+	}
+	return nil
+}
+
+func resourceIBMdlGatewayActionUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	directLink, err := directlinkClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChange(dlAction) || d.HasChange(dlSpeedMbps) {
+		err = dlGatewayAction(context, directLink, d, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceIBMdlGatewayActionRead(context, d, meta)
+}
+
+func resourceIBMdlGatewayActionDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+
+	d.SetId("")
+	return nil
+}
+
+// dlGatewayAction approves or rejects the change requested by the provider of the
+// gateway, and waits for the gateway to be provisioned when its creation is
+// approved.
+func dlGatewayAction(context context.Context, directLink *directlinkv1.DirectLinkV1, d *schema.ResourceData, id string, timeout time.Duration) error {
+	action := d.Get(dlAction).(string)
+	actionOptions := directLink.NewCreateGatewayActionOptions(id, action)
+	switch action {
+	case directlinkv1.CreateGatewayActionOptions_Action_CreateGatewayApprove:
+		global, globalOk := d.GetOkExists(dlGlobal)
+		metered, meteredOk := d.GetOkExists(dlMetered)
+		if !globalOk || !meteredOk {
+			return fmt.Errorf("global and metered are required for the %s action", action)
+		}
+		actionOptions.SetGlobal(global.(bool))
+		actionOptions.SetMetered(metered.(bool))
+		if rg, ok := d.GetOk(dlResourceGroup); ok {
+			resourceGroup := rg.(string)
+			actionOptions.SetResourceGroup(&directlinkv1.ResourceGroupIdentity{ID: &resourceGroup})
+		}
+	case directlinkv1.CreateGatewayActionOptions_Action_UpdateAttributesApprove, directlinkv1.CreateGatewayActionOptions_Action_UpdateAttributesReject:
+		speed, ok := d.GetOk(dlSpeedMbps)
+		if !ok {
+			return fmt.Errorf("speed_mbps is required for the %s action", action)
+		}
+		speedMbps := int64(speed.(int))
+		actionOptions.Updates = []directlinkv1.GatewayActionTemplateUpdatesItemIntf{
+			&directlinkv1.GatewayActionTemplateUpdatesItem{SpeedMbps: &speedMbps},
+		}
+	}
+	_, response, err := directLink.CreateGatewayAction(actionOptions)
+	if err != nil {
+		return fmt.Errorf("Error Creating Direct Link Gateway Action %s on gateway (%s): %s\n%s", action, id, err, response)
+	}
+	log.Printf("[INFO] Direct Link Gateway action %s on gateway (%s)", action, id)
+	if action == directlinkv1.CreateGatewayActionOptions_Action_CreateGatewayApprove {
+		_, err = isWaitForDirectLinkAvailable(context, directLink, id, timeout)
+	}
+	return err
+}
@@ -0,0 +1,162 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/IBM/networking-go-sdk/directlinkv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gotest.tools/assert"
+)
+
+func TestAccIBMDLGatewayAction_basic(t *testing.T) {
+	node := "ibm_dl_gateway_action.test_dl_gateway_action"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDLGatewayActionConfig(dlProviderGatewayID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(node, "gateway", dlProviderGatewayID),
+					resource.TestCheckResourceAttr(node, "operational_status", "provisioned"),
+					resource.TestCheckResourceAttr(node, "change_request", ""),
+					resource.TestCheckResourceAttrSet(node, "resource_group"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDLGatewayActionConfig(gatewayID string) string {
+	return fmt.Sprintf(`
+	resource "ibm_dl_gateway_action" "test_dl_gateway_action" {
+		gateway = "%s"
+		action  = "create_gateway_approve"
+		global  = true
+		metered = false
+	}
+	`, gatewayID)
+}
+
+func TestIBMDLGatewayActionMock(t *testing.T) {
+	t.Parallel()
+	srv := testMockServer(t)
+	meta := testMockClientSession(t, srv)
+	gatewayID := testMockDLProviderGateway(t, meta)
+	provider, err := meta.(ClientSession).DirectlinkProviderV2API()
+	assert.NilError(t, err)
+
+	r := resourceIBMDLGatewayAction()
+	ctx := context.Background()
+
+	// The creation can't be approved without the billing options
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"gateway": gatewayID,
+		"action":  "create_gateway_approve",
+	})
+	assert.ErrorContains(t, testDiagsErr(r.CreateContext(ctx, d, meta)), "global and metered are required")
+
+	// There is no pending update to approve yet
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"gateway":    gatewayID,
+		"action":     "update_attributes_approve",
+		"speed_mbps": 2000,
+	})
+	assert.ErrorContains(t, testDiagsErr(r.CreateContext(ctx, d, meta)), "409")
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"gateway": gatewayID,
+		"action":  "create_gateway_approve",
+		"global":  true,
+		"metered": false,
+	})
+	assert.NilError(t, testDiagsErr(r.CreateContext(ctx, d, meta)))
+	assert.Equal(t, d.Id(), gatewayID)
+	assert.Equal(t, d.Get("operational_status"), "provisioned")
+	assert.Equal(t, d.Get("change_request"), "")
+	assert.Equal(t, d.Get("resource_group"), "mock-resource-group")
+	assert.Equal(t, d.Get("name"), "mock-dl-connect")
+
+	// The provider requests a speed change, which the update approves
+	update := provider.NewUpdateProviderGatewayOptions(gatewayID)
+	update.SetSpeedMbps(2000)
+	_, _, err = provider.UpdateProviderGateway(update)
+	assert.NilError(t, err)
+	assert.NilError(t, testDiagsErr(r.ReadContext(ctx, d, meta)))
+	assert.Equal(t, d.Get("change_request"), "update_attributes")
+
+	d = testMockResourceData(t, r, d, map[string]interface{}{
+		"gateway":    gatewayID,
+		"action":     "update_attributes_approve",
+		"global":     true,
+		"metered":    false,
+		"speed_mbps": 1000,
+	})
+	// The speed must match the pending update
+	assert.ErrorContains(t, testDiagsErr(r.UpdateContext(ctx, d, meta)), "updates must match")
+	d = testMockResourceData(t, r, d, map[string]interface{}{
+		"gateway":    gatewayID,
+		"action":     "update_attributes_approve",
+		"global":     true,
+		"metered":    false,
+		"speed_mbps": 2000,
+	})
+	assert.NilError(t, testDiagsErr(r.UpdateContext(ctx, d, meta)))
+	assert.Equal(t, d.Get("change_request"), "")
+
+	client, err := directlinkClient(meta)
+	assert.NilError(t, err)
+	gateway, _, err := client.GetGateway(&directlinkv1.GetGatewayOptions{ID: &gatewayID})
+	assert.NilError(t, err)
+	assert.Equal(t, *gateway.SpeedMbps, int64(2000))
+
+	// The provider requests the deletion, which the update approves
+	_, _, err = provider.DeleteProviderGateway(provider.NewDeleteProviderGatewayOptions(gatewayID))
+	assert.NilError(t, err)
+	d = testMockResourceData(t, r, d, map[string]interface{}{
+		"gateway": gatewayID,
+		"action":  "delete_gateway_approve",
+	})
+	assert.NilError(t, testDiagsErr(r.UpdateContext(ctx, d, meta)))
+	assert.Equal(t, d.Id(), gatewayID)
+	assert.Equal(t, d.Get("operational_status"), "deleted")
+	_, response, err := client.GetGateway(&directlinkv1.GetGatewayOptions{ID: &gatewayID})
+	assert.Assert(t, err != nil)
+	assert.Equal(t, response.StatusCode, 404)
+
+	assert.NilError(t, testDiagsErr(r.DeleteContext(ctx, d, meta)))
+	assert.Equal(t, d.Id(), "")
+}
+
+func TestIBMDLGatewayActionRejectMock(t *testing.T) {
+	t.Parallel()
+	srv := testMockServer(t)
+	meta := testMockClientSession(t, srv)
+	gatewayID := testMockDLProviderGateway(t, meta)
+
+	r := resourceIBMDLGatewayAction()
+	ctx := context.Background()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"gateway": gatewayID,
+		"action":  "create_gateway_reject",
+	})
+	assert.NilError(t, testDiagsErr(r.CreateContext(ctx, d, meta)))
+	assert.Equal(t, d.Get("operational_status"), "create_rejected")
+	assert.Equal(t, d.Get("change_request"), "")
+
+	// The rejected request can't be approved anymore
+	d = testMockResourceData(t, r, d, map[string]interface{}{
+		"gateway": gatewayID,
+		"action":  "create_gateway_approve",
+		"global":  false,
+		"metered": true,
+	})
+	assert.ErrorContains(t, testDiagsErr(r.UpdateContext(ctx, d, meta)), "409")
+}
@@ -0,0 +1,295 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM/networking-go-sdk/directlinkv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	dlCakID          = "cak_id"
+	dlCakKeyCrn      = "key"
+	dlCakSession     = "session"
+	dlCakStatus      = "status"
+	dlCakActiveDelta = "active_delta"
+	dlCakUpdatedAt   = "updated_at"
+	dlCakRotating    = "rotating"
+	dlCakRotated     = "rotated"
+	dlCakFailed      = "failed"
+)
+
+func resourceIBMDLGatewayMacsecCak() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMdlGatewayMacsecCakCreate,
+		ReadContext:   resourceIBMdlGatewayMacsecCakRead,
+		UpdateContext: resourceIBMdlGatewayMacsecCakUpdate,
+		DeleteContext: resourceIBMdlGatewayMacsecCakDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			dlActionGateway: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The MACsec enabled Direct Link dedicated gateway identifier",
+			},
+			dlName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: InvokeValidator("ibm_dl_gateway_macsec_cak", dlName),
+				Description:  "The connectivity association key name (CKN), an even number of 2 to 64 hexadecimal characters. Updating it rotates the CAK.",
+			},
+			dlCakKeyCrn: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The CRN of the Hyper Protect Crypto Services key of the CAK. Updating it rotates the CAK.",
+			},
+			dlCakSession: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: InvokeValidator("ibm_dl_gateway_macsec_cak", dlCakSession),
+				Description:  "The MACsec session of the CAK, primary or fallback",
+			},
+			dlCakID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CAK identifier",
+			},
+			dlCakStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the CAK",
+			},
+			dlCakActiveDelta: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The name and key the CAK is being rotated to",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						dlName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						dlCakKeyCrn: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						dlCakStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			dlCreatedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time the CAK was created",
+			},
+			dlCakUpdatedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time the CAK was last updated",
+			},
+		},
+	}
+}
+
+func resourceIBMDLGatewayMacsecCakValidator() *ResourceValidator {
+
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 dlName,
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Required:                   true,
+			Regexp:                     `^([a-fA-F0-9]{2}){1,32}$`,
+			MinValueLength:             2,
+			MaxValueLength:             64})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 dlCakSession,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Required:                   true,
+			AllowedValues:              "primary, fallback"})
+
+	ibmDLGatewayMacsecCakValidator := ResourceValidator{ResourceName: "ibm_dl_gateway_macsec_cak", Schema: validateSchema}
+	return &ibmDLGatewayMacsecCakValidator
+}
+
+func resourceIBMdlGatewayMacsecCakCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	directLink, err := directlinkClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	gatewayId := d.Get(dlActionGateway).(string)
+	cak := dlMacsecCak{
+		Name:    d.Get(dlName).(string),
+		Key:     &dlCakKey{Crn: d.Get(dlCakKeyCrn).(string)},
+		Session: d.Get(dlCakSession).(string),
+	}
+	result, response, err := createDLMacsecCak(directLink, gatewayId, cak)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error Creating Direct Link Gateway MACsec CAK: %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", gatewayId, result.ID))
+	return resourceIBMdlGatewayMacsecCakRead(context, d, meta)
+}
+
+func resourceIBMdlGatewayMacsecCakRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	directLink, err := directlinkClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) != 2 {
+		return diag.Errorf("Incorrect ID %s: the ID must be in the form gateway/cak_id", d.Id())
+	}
+
+	gatewayId := parts[0]
+	ID := parts[1]
+	cak, response, err := getDLMacsecCak(directLink, gatewayId, ID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("Direct Link Gateway MACsec CAK %s is not found", ID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("Error Getting Direct Link Gateway MACsec CAK (%s): %s\n%s", ID, err, response))
+	}
+
+	d.Set(dlActionGateway, gatewayId)
+	d.Set(dlCakID, cak.ID)
+	d.Set(dlCakSession, cak.Session)
+	d.Set(dlCakStatus, cak.Status)
+	d.Set(dlCreatedAt, cak.CreatedAt)
+	d.Set(dlCakUpdatedAt, cak.UpdatedAt)
+	name, key := cak.Name, cak.Key
+	activeDelta := []map[string]interface{}{}
+	if cak.ActiveDelta != nil {
+		// The CAK is reported with the name and key it is rotated to, so a rotation
+		// in progress doesn't cause a diff, unless the rotation failed
+		if cak.ActiveDelta.Status != dlCakFailed {
+			name, key = cak.ActiveDelta.Name, cak.ActiveDelta.Key
+		}
+		delta := map[string]interface{}{
+			dlName:      cak.ActiveDelta.Name,
+			dlCakStatus: cak.ActiveDelta.Status,
+		}
+		if cak.ActiveDelta.Key != nil {
+			delta[dlCakKeyCrn] = cak.ActiveDelta.Key.Crn
+		}
+		activeDelta = append(activeDelta, delta)
+	}
+	d.Set(dlName, name)
+	if key != nil {
+		d.Set(dlCakKeyCrn, key.Crn)
+	}
+	d.Set(dlCakActiveDelta, activeDelta)
+
+	return nil
+}
+
+func resourceIBMdlGatewayMacsecCakUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	directLink, err := directlinkClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	gatewayId := parts[0]
+	ID := parts[1]
+	patch := map[string]interface{}{}
+	if d.HasChange(dlName) {
+		patch[dlName] = d.Get(dlName).(string)
+	}
+	if d.HasChange(dlCakKeyCrn) {
+		patch[dlCakKeyCrn] = dlCakKey{Crn: d.Get(dlCakKeyCrn).(string)}
+	}
+	if len(patch) > 0 {
+		_, response, err := updateDLMacsecCak(directLink, gatewayId, ID, patch)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("Error Updating Direct Link Gateway MACsec CAK (%s): %s\n%s", ID, err, response))
+		}
+		_, err = isWaitForDirectLinkMacsecCakRotated(context, directLink, gatewayId, ID, d.Get(dlName).(string), d.Get(dlCakKeyCrn).(string), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMdlGatewayMacsecCakRead(context, d, meta)
+}
+
+func resourceIBMdlGatewayMacsecCakDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	directLink, err := directlinkClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	gatewayId := parts[0]
+	ID := parts[1]
+	response, err := deleteDLMacsecCak(directLink, gatewayId, ID)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return diag.FromErr(fmt.Errorf("Error Deleting Direct Link Gateway MACsec CAK (%s): %s\n%s", ID, err, response))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// isWaitForDirectLinkMacsecCakRotated waits until the CAK is active with the name and
+// key it is rotated to, its active delta being applied.
+func isWaitForDirectLinkMacsecCakRotated(context context.Context, client *directlinkv1.DirectLinkV1, gatewayId, id, name, keyCrn string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for direct link gateway MACsec CAK (%s) to be rotated.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"retry", dlCakRotating},
+		Target:  []string{dlCakRotated},
+		Refresh: func() (interface{}, string, error) {
+			cak, response, err := getDLMacsecCak(client, gatewayId, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error Getting Direct Link Gateway MACsec CAK: %s\n%s", err, response)
+			}
+			if cak.Status == dlCakFailed || (cak.ActiveDelta != nil && cak.ActiveDelta.Status == dlCakFailed) {
+				return cak, "", fmt.Errorf("The rotation of Direct Link Gateway MACsec CAK %s failed", id)
+			}
+			if cak.ActiveDelta == nil && cak.Name == name && cak.Key != nil && cak.Key.Crn == keyCrn {
+				return cak, dlCakRotated, nil
+			}
+			return cak, dlCakRotating, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
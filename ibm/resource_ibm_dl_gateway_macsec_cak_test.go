@@ -0,0 +1,167 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/IBM/networking-go-sdk/directlinkv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gotest.tools/assert"
+)
+
+func TestAccIBMDLGatewayMacsecCak_basic(t *testing.T) {
+	node := "ibm_dl_gateway_macsec_cak.test_dl_macsec_cak"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDLGatewayMacsecCakConfig(dlMacsecGatewayID, "1000", dlMacsecCakCRN),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(node, "gateway", dlMacsecGatewayID),
+					resource.TestCheckResourceAttr(node, "name", "1000"),
+					resource.TestCheckResourceAttr(node, "session", "fallback"),
+					resource.TestCheckResourceAttrSet(node, "cak_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMDLGatewayMacsecCakConfig(dlMacsecGatewayID, "2000", dlMacsecCakCRN),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(node, "name", "2000"),
+					resource.TestCheckResourceAttr(node, "active_delta.#", "0"),
+				),
+			},
+			{
+				ResourceName:      node,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDLGatewayMacsecCakConfig(gatewayID, name, crn string) string {
+	return fmt.Sprintf(`
+	resource "ibm_dl_gateway_macsec_cak" "test_dl_macsec_cak" {
+		gateway = "%s"
+		name    = "%s"
+		key     = "%s"
+		session = "fallback"
+	}
+	`, gatewayID, name, crn)
+}
+
+func TestIBMDLGatewayMacsecCakMock(t *testing.T) {
+	t.Parallel()
+	srv := testMockServer(t)
+	meta := testMockClientSession(t, srv)
+	gatewayID := testMockDLMacsecGateway(t, meta)
+	fallbackCRN := "crn:v1:bluemix:public:hs-crypto:us-south:a/mockserver:mock-hpcs:key:mock-fallback"
+	rotatedCRN := "crn:v1:bluemix:public:hs-crypto:us-south:a/mockserver:mock-hpcs:key:mock-rotated"
+
+	r := resourceIBMDLGatewayMacsecCak()
+	ctx := context.Background()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"gateway": gatewayID,
+		"name":    "1000",
+		"key":     fallbackCRN,
+		"session": "fallback",
+	})
+	assert.NilError(t, testDiagsErr(r.CreateContext(ctx, d, meta)))
+	cakID := d.Get("cak_id").(string)
+	assert.Equal(t, d.Id(), gatewayID+"/"+cakID)
+	assert.Equal(t, d.Get("key"), fallbackCRN)
+	assert.Equal(t, d.Get("active_delta.#"), 0)
+
+	client, err := directlinkClient(meta)
+	assert.NilError(t, err)
+	gateway, _, err := client.GetGateway(&directlinkv1.GetGatewayOptions{ID: &gatewayID})
+	assert.NilError(t, err)
+	assert.Equal(t, *gateway.MacsecConfig.FallbackCak.Crn, fallbackCRN)
+
+	// The gateway has a single CAK per session
+	dup := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"gateway": gatewayID,
+		"name":    "2000",
+		"key":     fallbackCRN,
+		"session": "fallback",
+	})
+	assert.ErrorContains(t, testDiagsErr(r.CreateContext(ctx, dup, meta)), "409")
+
+	// Updating the name and key rotates the CAK
+	d = testMockResourceData(t, r, d, map[string]interface{}{
+		"gateway": gatewayID,
+		"name":    "2000",
+		"key":     rotatedCRN,
+		"session": "fallback",
+	})
+	assert.NilError(t, testDiagsErr(r.UpdateContext(ctx, d, meta)))
+	assert.Equal(t, d.Get("name"), "2000")
+	assert.Equal(t, d.Get("key"), rotatedCRN)
+	assert.Equal(t, d.Get("status"), "inactive")
+	assert.Equal(t, d.Get("active_delta.#"), 0)
+
+	// A failed rotation is an error, the CAK keeping its name and key
+	failed := testMockResourceData(t, r, d, map[string]interface{}{
+		"gateway": gatewayID,
+		"name":    "2000",
+		"key":     "crn:v1:bluemix:public:hs-crypto:us-south:a/mockserver:mock-hpcs:key:mock-failed",
+		"session": "fallback",
+	})
+	assert.ErrorContains(t, testDiagsErr(r.UpdateContext(ctx, failed, meta)), "rotation of Direct Link Gateway MACsec CAK")
+	assert.NilError(t, testDiagsErr(r.ReadContext(ctx, failed, meta)))
+	assert.Equal(t, failed.Get("key"), rotatedCRN)
+	assert.Equal(t, failed.Get("status"), "failed")
+	assert.Equal(t, failed.Get("active_delta.0.status"), "failed")
+
+	// A rotation in progress is reported with the new name and key
+	_, _, err = updateDLMacsecCak(client, gatewayID, cakID, map[string]interface{}{"name": "3000"})
+	assert.NilError(t, err)
+	imported := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+	imported.SetId(gatewayID + "/" + cakID)
+	assert.NilError(t, testDiagsErr(r.ReadContext(ctx, imported, meta)))
+	assert.Equal(t, imported.Get("gateway"), gatewayID)
+	assert.Equal(t, imported.Get("session"), "fallback")
+	assert.Equal(t, imported.Get("name"), "3000")
+	assert.Equal(t, imported.Get("key"), rotatedCRN)
+
+	assert.NilError(t, testDiagsErr(r.DeleteContext(ctx, d, meta)))
+	assert.Equal(t, d.Id(), "")
+	assert.NilError(t, testDiagsErr(r.ReadContext(ctx, imported, meta)))
+	assert.Equal(t, imported.Id(), "")
+
+	// The primary CAK can't be deleted while MACsec is enabled
+	caks, err := testMockDLMacsecCaks(client, gatewayID)
+	assert.NilError(t, err)
+	assert.Equal(t, len(caks), 1)
+	primary := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+	primary.SetId(gatewayID + "/" + caks[0].ID)
+	assert.NilError(t, testDiagsErr(r.ReadContext(ctx, primary, meta)))
+	assert.Equal(t, primary.Get("session"), "primary")
+	assert.Equal(t, primary.Get("status"), "operational")
+	assert.ErrorContains(t, testDiagsErr(r.DeleteContext(ctx, primary, meta)), "409")
+
+	bad := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+	bad.SetId(cakID)
+	assert.ErrorContains(t, testDiagsErr(r.ReadContext(ctx, bad, meta)), "does not contain /")
+}
+
+// testMockDLMacsecCaks lists the CAKs of the gateway.
+func testMockDLMacsecCaks(client *directlinkv1.DirectLinkV1, gatewayID string) ([]dlMacsecCak, error) {
+	result := struct {
+		Caks []dlMacsecCak `json:"caks"`
+	}{}
+	_, err := directLinkRequest(client, directLinkRequestOptions{
+		Method:     core.GET,
+		Path:       "/gateways/{gateway_id}/macsec/caks",
+		PathParams: map[string]string{"gateway_id": gatewayID},
+	}, &result)
+	return result.Caks, err
+}
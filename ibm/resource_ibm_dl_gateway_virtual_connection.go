@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/IBM/networking-go-sdk/directlinkv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -27,12 +29,11 @@ const (
 
 func resourceIBMDLGatewayVC() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMdlGatewayVCCreate,
-		Read:     resourceIBMdlGatewayVCRead,
-		Delete:   resourceIBMdlGatewayVCDelete,
-		Exists:   resourceIBMdlGatewayVCExists,
-		Update:   resourceIBMdlGatewayVCUpdate,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMdlGatewayVCCreate,
+		ReadContext:   resourceIBMdlGatewayVCRead,
+		DeleteContext: resourceIBMdlGatewayVCDelete,
+		UpdateContext: resourceIBMdlGatewayVCUpdate,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -123,10 +124,10 @@ func resourceIBMdlGatewayVCValidator() *ResourceValidator {
 
 	return &ibmDLGatewayVCResourceValidator
 }
-func resourceIBMdlGatewayVCCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlGatewayVCCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	createGatewayVCOptions := &directlinkv1.CreateGatewayVirtualConnectionOptions{}
@@ -146,23 +147,31 @@ func resourceIBMdlGatewayVCCreate(d *schema.ResourceData, meta interface{}) erro
 	gatewayVC, response, err := directLink.CreateGatewayVirtualConnection(createGatewayVCOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Create Direct Link Gateway (Dedicated) Virtual connection err %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", gatewayId, *gatewayVC.ID))
 	d.Set(dlVirtualConnectionId, *gatewayVC.ID)
-	return resourceIBMdlGatewayVCRead(d, meta)
+	return resourceIBMdlGatewayVCRead(context, d, meta)
 }
 
-func resourceIBMdlGatewayVCRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlGatewayVCRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMdlGatewayVCExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
 
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gatewayId := parts[0]
@@ -177,7 +186,7 @@ func resourceIBMdlGatewayVCRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Directlink Gateway Connection (%s): %s\n%s", ID, err, response)
+		return diag.FromErr(fmt.Errorf("Error Getting Directlink Gateway Connection (%s): %s\n%s", ID, err, response))
 	}
 
 	if instance.Name != nil {
@@ -205,21 +214,21 @@ func resourceIBMdlGatewayVCRead(d *schema.ResourceData, meta interface{}) error
 	}
 	dlgw, response, err := directLink.GetGateway(getGatewayOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Direct Link Gateway (Dedicated Template): %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error Getting Direct Link Gateway (Dedicated Template): %s\n%s", err, response))
 	}
 	d.Set(RelatedCRN, *dlgw.Crn)
 	return nil
 }
 
-func resourceIBMdlGatewayVCUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlGatewayVCUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gatewayId := parts[0]
@@ -233,7 +242,7 @@ func resourceIBMdlGatewayVCUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	if err != nil {
 		log.Printf("Error fetching Direct Link Gateway (Dedicated Template) Virtual Connection:%s", detail)
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateGatewayVCOptions := &directlinkv1.UpdateGatewayVirtualConnectionOptions{}
@@ -249,21 +258,21 @@ func resourceIBMdlGatewayVCUpdate(d *schema.ResourceData, meta interface{}) erro
 	_, response, err := directLink.UpdateGatewayVirtualConnection(updateGatewayVCOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Update Direct Link Gateway (Dedicated) Virtual Connection err %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMdlGatewayVCRead(d, meta)
+	return resourceIBMdlGatewayVCRead(context, d, meta)
 }
 
-func resourceIBMdlGatewayVCDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlGatewayVCDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gatewayId := parts[0]
@@ -276,7 +285,7 @@ func resourceIBMdlGatewayVCDelete(d *schema.ResourceData, meta interface{}) erro
 
 	if err != nil && response.StatusCode != 404 {
 		log.Printf("Error deleting Direct Link Gateway (Dedicated Template) Virtual Connection: %s", response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
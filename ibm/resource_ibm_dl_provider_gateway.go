@@ -9,6 +9,7 @@ import (
 	"log"
 	"os"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -19,12 +20,11 @@ const (
 
 func resourceIBMDLProviderGateway() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMdlProviderGatewayCreate,
-		Read:     resourceIBMdlProviderGatewayRead,
-		Delete:   resourceIBMdlProviderGatewayDelete,
-		Exists:   resourceIBMdlProviderGatewayExists,
-		Update:   resourceIBMdlProviderGatewayUpdate,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMdlProviderGatewayCreate,
+		ReadContext:   resourceIBMdlProviderGatewayRead,
+		DeleteContext: resourceIBMdlProviderGatewayDelete,
+		UpdateContext: resourceIBMdlProviderGatewayUpdate,
+		Importer:      &schema.ResourceImporter{},
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return resourceTagsCustomizeDiff(diff)
@@ -187,10 +187,11 @@ func resourceIBMDLProviderGatewayValidator() *ResourceValidator {
 	return &ibmISDLGatewayResourceValidator
 }
 
-func resourceIBMdlProviderGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlProviderGatewayCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	directLink, err := directlinkProviderClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	name := d.Get(dlName).(string)
 	speed := int64(d.Get(dlSpeedMbps).(int))
@@ -214,7 +215,7 @@ func resourceIBMdlProviderGatewayCreate(d *schema.ResourceData, meta interface{}
 	gateway, response, err := directLink.CreateProviderGateway(gatewayOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Create Direct Link Provider Gateway err %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(*gateway.ID)
 
@@ -227,19 +228,29 @@ func resourceIBMdlProviderGatewayCreate(d *schema.ResourceData, meta interface{}
 		if err != nil {
 			log.Printf(
 				"Error on create of resource direct link Provider gateway (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 
-	return resourceIBMdlProviderGatewayRead(d, meta)
+	return append(diags, resourceIBMdlProviderGatewayRead(context, d, meta)...)
 }
 
-func resourceIBMdlProviderGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlProviderGatewayRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMdlProviderGatewayExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	dtype := d.Get(dlType).(string)
 	log.Printf("[INFO] Inside resourceIBMdlGatewayRead: %s", dtype)
 
 	directLink, err := directlinkProviderClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ID := d.Id()
@@ -254,7 +265,7 @@ func resourceIBMdlProviderGatewayRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Direct Link Gateway (%s Template): %s\n%s", dtype, err, response)
+		return diag.FromErr(fmt.Errorf("Error Getting Direct Link Gateway (%s Template): %s\n%s", dtype, err, response))
 	}
 	if instance.ID != nil {
 		d.Set("id", *instance.ID)
@@ -315,7 +326,7 @@ func resourceIBMdlProviderGatewayRead(d *schema.ResourceData, meta interface{})
 	d.Set(dlTags, tags)
 	controller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(ResourceControllerURL, controller+"/interconnectivity/direct-link")
 	d.Set(ResourceName, *instance.Name)
@@ -324,11 +335,12 @@ func resourceIBMdlProviderGatewayRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceIBMdlProviderGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlProviderGatewayUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 
 	directLink, err := directlinkProviderClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ID := d.Id()
@@ -346,6 +358,7 @@ func resourceIBMdlProviderGatewayUpdate(d *schema.ResourceData, meta interface{}
 		if err != nil {
 			log.Printf(
 				"Error on update of resource direct link gateway dedicated (%s) tags: %s", *instance.ID, err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 
@@ -361,17 +374,17 @@ func resourceIBMdlProviderGatewayUpdate(d *schema.ResourceData, meta interface{}
 	_, response, err = directLink.UpdateProviderGateway(updateGatewayOptionsModel)
 	if err != nil {
 		log.Printf("[DEBUG] Update Direct Link Provider Gateway  err %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMdlProviderGatewayRead(d, meta)
+	return append(diags, resourceIBMdlProviderGatewayRead(context, d, meta)...)
 }
 
-func resourceIBMdlProviderGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlProviderGatewayDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	directLink, err := directlinkProviderClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ID := d.Id()
@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/services"
@@ -17,12 +19,11 @@ import (
 
 func resourceIBMDNSDomain() *schema.Resource {
 	return &schema.Resource{
-		Exists:   resourceIBMDNSDomainExists,
-		Create:   resourceIBMDNSDomainCreate,
-		Read:     resourceIBMDNSDomainRead,
-		Update:   resourceIBMDNSDomainUpdate,
-		Delete:   resourceIBMDNSDomainDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMDNSDomainCreate,
+		ReadContext:   resourceIBMDNSDomainRead,
+		UpdateContext: resourceIBMDNSDomainUpdate,
+		DeleteContext: resourceIBMDNSDomainDelete,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -60,7 +61,7 @@ func resourceIBMDNSDomain() *schema.Resource {
 	}
 }
 
-func resourceIBMDNSDomainCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSDomainCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetDnsDomainService(sess.SetRetries(0))
 
@@ -85,7 +86,7 @@ func resourceIBMDNSDomainCreate(d *schema.ResourceData, meta interface{}) error
 	// create Dns_Domain object
 	response, err := service.CreateObject(&opts)
 	if err != nil {
-		return fmt.Errorf("Error creating Dns Domain: %s", err)
+		return diag.FromErr(fmt.Errorf("Error creating Dns Domain: %s", err))
 	}
 
 	// populate id
@@ -94,10 +95,19 @@ func resourceIBMDNSDomainCreate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[INFO] Created Dns Domain: %d", id)
 
 	// read remote state
-	return resourceIBMDNSDomainRead(d, meta)
+	return resourceIBMDNSDomainRead(context, d, meta)
 }
 
-func resourceIBMDNSDomainRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSDomainRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMDNSDomainExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetDnsDomainService(sess)
 
@@ -108,7 +118,7 @@ func resourceIBMDNSDomainRead(d *schema.ResourceData, meta interface{}) error {
 		"id,name,updateDate,resourceRecords",
 	).GetObject()
 	if err != nil {
-		return fmt.Errorf("Error retrieving Dns Domain %d: %s", dnsId, err)
+		return diag.FromErr(fmt.Errorf("Error retrieving Dns Domain %d: %s", dnsId, err))
 	}
 
 	// populate fields
@@ -127,7 +137,7 @@ func resourceIBMDNSDomainRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMDNSDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSDomainUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// If the target has been updated, find the corresponding dns record and update its data
 
 	sess := meta.(ClientSession).SoftLayerSession()
@@ -147,7 +157,7 @@ func resourceIBMDNSDomainUpdate(d *schema.ResourceData, meta interface{}) error
 		"id,name,updateDate,resourceRecords",
 	).GetObject()
 	if err != nil {
-		return fmt.Errorf("Error retrieving DNS resource %d: %s", domainId, err)
+		return diag.FromErr(fmt.Errorf("Error retrieving DNS resource %d: %s", domainId, err))
 	}
 
 	// find a record with host @; that will have the current target.
@@ -159,8 +169,8 @@ func resourceIBMDNSDomainUpdate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if record.Id == nil {
-		return fmt.Errorf("Could not find DNS target record for domain %s (%d)",
-			sl.Get(domain.Name), sl.Get(domain.Id))
+		return diag.FromErr(fmt.Errorf("Could not find DNS target record for domain %s (%d)",
+			sl.Get(domain.Name), sl.Get(domain.Id)))
 	}
 
 	record.Data = sl.String(newTarget)
@@ -168,30 +178,30 @@ func resourceIBMDNSDomainUpdate(d *schema.ResourceData, meta interface{}) error
 	_, err = service.Id(*record.Id).EditObject(&record)
 
 	if err != nil {
-		return fmt.Errorf("Error editing DNS target record for domain %s (%d): %s",
-			sl.Get(domain.Name), sl.Get(domain.Id), err)
+		return diag.FromErr(fmt.Errorf("Error editing DNS target record for domain %s (%d): %s",
+			sl.Get(domain.Name), sl.Get(domain.Id), err))
 	}
 
 	return nil
 }
 
-func resourceIBMDNSDomainDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSDomainDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetDnsDomainService(sess)
 
 	dnsId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error deleting Dns Domain: %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting Dns Domain: %s", err))
 	}
 
 	log.Printf("[INFO] Deleting Dns Domain: %d", dnsId)
 	result, err := service.Id(dnsId).DeleteObject()
 	if err != nil {
-		return fmt.Errorf("Error deleting Dns Domain: %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting Dns Domain: %s", err))
 	}
 
 	if !result {
-		return errors.New("Error deleting Dns Domain")
+		return diag.FromErr(errors.New("Error deleting Dns Domain"))
 	}
 
 	d.SetId("")
@@ -4,21 +4,23 @@
 package ibm
 
 import (
+	"context"
 	//"errors"
 	"fmt"
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/services"
 )
 
 func resourceIBMDNSDomainRegistrationNameservers() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMDNSDomainRegistrationNSCreate,
-		Read:   resourceIBMDNSDomainRegistrationNSRead,
-		Update: resourceIBMDNSDomainRegistrationNSUpdate,
-		Delete: resourceIBMDNSDomainRegistrationNSDelete,
+		CreateContext: resourceIBMDNSDomainRegistrationNSCreate,
+		ReadContext:   resourceIBMDNSDomainRegistrationNSRead,
+		UpdateContext: resourceIBMDNSDomainRegistrationNSUpdate,
+		DeleteContext: resourceIBMDNSDomainRegistrationNSDelete,
 		Schema: map[string]*schema.Schema{
 			"dns_registration_id": {
 				Type:        schema.TypeString,
@@ -45,7 +47,7 @@ func resourceIBMDNSDomainRegistrationNameservers() *schema.Resource {
 	}
 }
 
-func resourceIBMDNSDomainRegistrationNSCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSDomainRegistrationNSCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	nService := services.GetDnsDomainRegistrationService(sess)
 	dnsId, _ := strconv.Atoi(d.Get("dns_registration_id").(string))
@@ -57,11 +59,11 @@ func resourceIBMDNSDomainRegistrationNSCreate(d *schema.ResourceData, meta inter
 		GetDomainNameservers()
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving domain Registration NSCreate: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving domain Registration NSCreate: %s", err))
 	}
 
 	if len(dns_domain_nameservers) == 0 {
-		return fmt.Errorf("No domain found with id NSCreate [%d]", dnsId)
+		return diag.FromErr(fmt.Errorf("No domain found with id NSCreate [%d]", dnsId))
 	}
 	oldNameServers := make([]string, len(dns_domain_nameservers[0].Nameservers))
 	for i, elem := range dns_domain_nameservers[0].Nameservers {
@@ -93,13 +95,13 @@ func resourceIBMDNSDomainRegistrationNSCreate(d *schema.ResourceData, meta inter
 	// So return at this point.
 	if len(addNs) == 0 {
 		d.SetId(fmt.Sprintf("%d", dnsId))
-		return resourceIBMDNSDomainRegistrationNSRead(d, meta)
+		return resourceIBMDNSDomainRegistrationNSRead(context, d, meta)
 	}
 
 	nsUnlock_res, err := nService.Id(dnsId).
 		UnlockDomain()
 	if err != nil || nsUnlock_res != true {
-		return fmt.Errorf("Error unlocking domain registration record: %s", err)
+		return diag.FromErr(fmt.Errorf("Error unlocking domain registration record: %s", err))
 	}
 
 	nsAdd_res := false
@@ -107,7 +109,7 @@ func resourceIBMDNSDomainRegistrationNSCreate(d *schema.ResourceData, meta inter
 		AddNameserversToDomain(addNs)
 
 	if err != nil || nsAdd_res != true {
-		return fmt.Errorf("Error Adding name servers to record: %s", err)
+		return diag.FromErr(fmt.Errorf("Error Adding name servers to record: %s", err))
 	}
 
 	// old NS to delete, if not found in new list
@@ -133,7 +135,7 @@ func resourceIBMDNSDomainRegistrationNSCreate(d *schema.ResourceData, meta inter
 		RemoveNameserversFromDomain(delNs)
 
 	if err != nil || nsDel_res != true {
-		return fmt.Errorf("Error Deleting name servers from record: %s", err)
+		return diag.FromErr(fmt.Errorf("Error Deleting name servers from record: %s", err))
 	}
 
 	_, _ = nService.Id(dnsId).LockDomain()
@@ -142,10 +144,10 @@ func resourceIBMDNSDomainRegistrationNSCreate(d *schema.ResourceData, meta inter
 	// save the original name servers now as not available on read
 	d.SetId(fmt.Sprintf("%d", dnsId))
 	d.Set("original_name_servers", oldNameServers)
-	return resourceIBMDNSDomainRegistrationNSRead(d, meta)
+	return resourceIBMDNSDomainRegistrationNSRead(context, d, meta)
 }
 
-func resourceIBMDNSDomainRegistrationNSRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSDomainRegistrationNSRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	dnsId, _ := strconv.Atoi(d.Id())
 	//service := services.GetDnsDomainService(sess)
@@ -156,11 +158,11 @@ func resourceIBMDNSDomainRegistrationNSRead(d *schema.ResourceData, meta interfa
 		GetDomainNameservers()
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving domain registration NSReaD: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving domain registration NSReaD: %s", err))
 	}
 
 	if len(dns_domain_nameservers) == 0 {
-		return fmt.Errorf("No domain found with id [%d]", dnsId)
+		return diag.FromErr(fmt.Errorf("No domain found with id [%d]", dnsId))
 	}
 
 	log.Printf("list %v\n", dns_domain_nameservers)
@@ -172,7 +174,7 @@ func resourceIBMDNSDomainRegistrationNSRead(d *schema.ResourceData, meta interfa
 	log.Printf("names %v\n", ns)
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving domain registration nameservers: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving domain registration nameservers: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%d", dnsId))
@@ -181,12 +183,12 @@ func resourceIBMDNSDomainRegistrationNSRead(d *schema.ResourceData, meta interfa
 }
 
 // No delete on IBM Cloud
-func resourceIBMDNSDomainRegistrationNSUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSDomainRegistrationNSUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return nil
 }
 
 // No delete on IBM Cloud
-func resourceIBMDNSDomainRegistrationNSDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSDomainRegistrationNSDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Exact reverse of create to restore name servers back to original values
 	sess := meta.(ClientSession).SoftLayerSession()
 	nService := services.GetDnsDomainRegistrationService(sess)
@@ -217,13 +219,13 @@ func resourceIBMDNSDomainRegistrationNSDelete(d *schema.ResourceData, meta inter
 	// So return at this point.
 	if len(addNs) == 0 {
 		d.SetId(fmt.Sprintf("%d", dnsId))
-		return resourceIBMDNSDomainRegistrationNSRead(d, meta)
+		return resourceIBMDNSDomainRegistrationNSRead(context, d, meta)
 	}
 
 	nsUnlock_res, err := nService.Id(dnsId).
 		UnlockDomain()
 	if err != nil || nsUnlock_res != true {
-		return fmt.Errorf("Error unlocking domain registration record: %s", err)
+		return diag.FromErr(fmt.Errorf("Error unlocking domain registration record: %s", err))
 	}
 
 	nsAdd_res := false
@@ -231,7 +233,7 @@ func resourceIBMDNSDomainRegistrationNSDelete(d *schema.ResourceData, meta inter
 		AddNameserversToDomain(addNs)
 
 	if err != nil || nsAdd_res != true {
-		return fmt.Errorf("Error Adding name servers to record: %s", err)
+		return diag.FromErr(fmt.Errorf("Error Adding name servers to record: %s", err))
 	}
 
 	// current NS to delete, if not found in original list
@@ -257,7 +259,7 @@ func resourceIBMDNSDomainRegistrationNSDelete(d *schema.ResourceData, meta inter
 		RemoveNameserversFromDomain(delNs)
 
 	if err != nil || nsDel_res != true {
-		return fmt.Errorf("Error Deleting name servers from record: %s", err)
+		return diag.FromErr(fmt.Errorf("Error Deleting name servers from record: %s", err))
 	}
 
 	_, _ = nService.Id(dnsId).LockDomain()
@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/services"
@@ -32,12 +34,11 @@ func init() {
 
 func resourceIBMDNSRecord() *schema.Resource {
 	return &schema.Resource{
-		Exists:   resourceIBMDNSRecordExists,
-		Create:   resourceIBMDNSRecordCreate,
-		Read:     resourceIBMDNSRecordRead,
-		Update:   resourceIBMDNSRecordUpdate,
-		Delete:   resourceIBMDNSRecordDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMDNSRecordCreate,
+		ReadContext:   resourceIBMDNSRecordRead,
+		UpdateContext: resourceIBMDNSRecordUpdate,
+		DeleteContext: resourceIBMDNSRecordDelete,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			"data": {
 				Type:     schema.TypeString,
@@ -190,7 +191,7 @@ func resourceIBMDNSRecord() *schema.Resource {
 
 //  Creates DNS Domain Resource Record
 //  https://sldn.softlayer.com/reference/services/SoftLayer_Dns_Domain_ResourceRecord/createObject
-func resourceIBMDNSRecordCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSRecordCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetDnsDomainResourceRecordService(sess.SetRetries(0))
 
@@ -266,29 +267,38 @@ func resourceIBMDNSRecordCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error creating DNS Resource %s Record: %s", *opts.Type, err)
+		return diag.FromErr(fmt.Errorf("Error creating DNS Resource %s Record: %s", *opts.Type, err))
 	}
 
 	d.SetId(fmt.Sprintf("%d", id))
 
 	log.Printf("[INFO] Dns Resource %s Record ID: %s", *opts.Type, d.Id())
 
-	return resourceIBMDNSRecordRead(d, meta)
+	return resourceIBMDNSRecordRead(context, d, meta)
 }
 
 //  Reads DNS Domain Resource Record from SL system
 //  https://sldn.softlayer.com/reference/services/SoftLayer_Dns_Domain_ResourceRecord/getObject
-func resourceIBMDNSRecordRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSRecordRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMDNSRecordExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetDnsDomainResourceRecordService(sess)
 
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 	}
 	result, err := service.Id(id).GetObject()
 	if err != nil {
-		return fmt.Errorf("Error retrieving DNS Resource Record: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving DNS Resource Record: %s", err))
 	}
 
 	// Required fields
@@ -319,7 +329,7 @@ func resourceIBMDNSRecordRead(d *schema.ResourceData, meta interface{}) error {
 
 //  Updates DNS Domain Resource Record in SL system
 //  https://sldn.softlayer.com/reference/services/SoftLayer_Dns_Domain_ResourceRecord/editObject
-func resourceIBMDNSRecordUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSRecordUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetDnsDomainResourceRecordService(sess)
 	serviceNoRetry := services.GetDnsDomainResourceRecordService(sess.SetRetries(0))
@@ -327,7 +337,7 @@ func resourceIBMDNSRecordUpdate(d *schema.ResourceData, meta interface{}) error
 	recordId, _ := strconv.Atoi(d.Id())
 	record, err := service.Id(recordId).GetObject()
 	if err != nil {
-		return fmt.Errorf("Error retrieving DNS Resource Record: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving DNS Resource Record: %s", err))
 	}
 
 	recordType := d.Get("type").(string)
@@ -405,7 +415,7 @@ func resourceIBMDNSRecordUpdate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error editing DNS Resource %s Record %d: %s", recordType, recordId, err)
+		return diag.FromErr(fmt.Errorf("Error editing DNS Resource %s Record %d: %s", recordType, recordId, err))
 	}
 
 	return nil
@@ -413,19 +423,19 @@ func resourceIBMDNSRecordUpdate(d *schema.ResourceData, meta interface{}) error
 
 //  Deletes DNS Domain Resource Record in SL system
 //  https://sldn.softlayer.com/reference/services/SoftLayer_Dns_Domain_ResourceRecord/deleteObject
-func resourceIBMDNSRecordDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSRecordDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetDnsDomainResourceRecordService(sess)
 
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 	}
 
 	_, err = service.Id(id).DeleteObject()
 
 	if err != nil {
-		return fmt.Errorf("Error deleting DNS Resource Record: %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting DNS Resource Record: %s", err))
 	}
 
 	return nil
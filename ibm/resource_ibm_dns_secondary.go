@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/services"
@@ -17,12 +19,11 @@ import (
 
 func resourceIBMDNSSecondary() *schema.Resource {
 	return &schema.Resource{
-		Exists:   resourceIBMDNSSecondaryExists,
-		Create:   resourceIBMDNSSecondaryCreate,
-		Read:     resourceIBMDNSSecondaryRead,
-		Update:   resourceIBMDNSSecondaryUpdate,
-		Delete:   resourceIBMDNSSecondaryDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMDNSSecondaryCreate,
+		ReadContext:   resourceIBMDNSSecondaryRead,
+		UpdateContext: resourceIBMDNSSecondaryUpdate,
+		DeleteContext: resourceIBMDNSSecondaryDelete,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			"master_ip_address": {
 				Type:        schema.TypeString,
@@ -66,7 +67,7 @@ func resourceIBMDNSSecondary() *schema.Resource {
 	}
 }
 
-func resourceIBMDNSSecondaryCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSSecondaryCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetDnsSecondaryService(sess)
 
@@ -80,7 +81,7 @@ func resourceIBMDNSSecondaryCreate(d *schema.ResourceData, meta interface{}) err
 	// create Dns_Secondary object
 	response, err := service.CreateObject(&opts)
 	if err != nil {
-		return fmt.Errorf("Error creating Dns Secondary Zone: %s", err)
+		return diag.FromErr(fmt.Errorf("Error creating Dns Secondary Zone: %s", err))
 	}
 
 	// populate id
@@ -89,10 +90,19 @@ func resourceIBMDNSSecondaryCreate(d *schema.ResourceData, meta interface{}) err
 	log.Printf("[INFO] Created Dns Secondary Zone: %d", id)
 
 	// read remote state
-	return resourceIBMDNSSecondaryRead(d, meta)
+	return resourceIBMDNSSecondaryRead(context, d, meta)
 }
 
-func resourceIBMDNSSecondaryRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSSecondaryRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMDNSSecondaryExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetDnsSecondaryService(sess)
 
@@ -101,7 +111,7 @@ func resourceIBMDNSSecondaryRead(d *schema.ResourceData, meta interface{}) error
 	// retrieve remote object state
 	dns_domain_secondary, err := service.Id(dnsId).GetObject()
 	if err != nil {
-		return fmt.Errorf("Error retrieving Dns Secondary Zone %d: %s", dnsId, err)
+		return diag.FromErr(fmt.Errorf("Error retrieving Dns Secondary Zone %d: %s", dnsId, err))
 	}
 
 	// populate fields
@@ -114,7 +124,7 @@ func resourceIBMDNSSecondaryRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMDNSSecondaryUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSSecondaryUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	domainId, _ := strconv.Atoi(d.Id())
 	hasChange := false
@@ -135,30 +145,30 @@ func resourceIBMDNSSecondaryUpdate(d *schema.ResourceData, meta interface{}) err
 		_, err := service.Id(domainId).EditObject(&opts)
 
 		if err != nil {
-			return fmt.Errorf("Error editing DNS secondary zone (%d): %s", domainId, err)
+			return diag.FromErr(fmt.Errorf("Error editing DNS secondary zone (%d): %s", domainId, err))
 		}
 	}
 
 	return nil
 }
 
-func resourceIBMDNSSecondaryDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSSecondaryDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetDnsSecondaryService(sess)
 
 	dnsId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error deleting Dns Secondary Zone: %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting Dns Secondary Zone: %s", err))
 	}
 
 	log.Printf("[INFO] Deleting Dns Secondary Zone: %d", dnsId)
 	result, err := service.Id(dnsId).DeleteObject()
 	if err != nil {
-		return fmt.Errorf("Error deleting Dns Secondary Zone: %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting Dns Secondary Zone: %s", err))
 	}
 
 	if !result {
-		return errors.New("Error deleting Dns Secondary Zone")
+		return diag.FromErr(errors.New("Error deleting Dns Secondary Zone"))
 	}
 
 	d.SetId("")
@@ -0,0 +1,243 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Shopify/sarama"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	aclResourceTypes = map[string]sarama.AclResourceType{
+		"topic":            sarama.AclResourceTopic,
+		"group":            sarama.AclResourceGroup,
+		"cluster":          sarama.AclResourceCluster,
+		"transactional_id": sarama.AclResourceTransactionalID,
+	}
+	aclPatternTypes = map[string]sarama.AclResourcePatternType{
+		"literal":  sarama.AclPatternLiteral,
+		"prefixed": sarama.AclPatternPrefixed,
+	}
+	aclOperations = map[string]sarama.AclOperation{
+		"all":              sarama.AclOperationAll,
+		"read":             sarama.AclOperationRead,
+		"write":            sarama.AclOperationWrite,
+		"create":           sarama.AclOperationCreate,
+		"delete":           sarama.AclOperationDelete,
+		"alter":            sarama.AclOperationAlter,
+		"describe":         sarama.AclOperationDescribe,
+		"cluster_action":   sarama.AclOperationClusterAction,
+		"describe_configs": sarama.AclOperationDescribeConfigs,
+		"alter_configs":    sarama.AclOperationAlterConfigs,
+		"idempotent_write": sarama.AclOperationIdempotentWrite,
+	}
+	aclPermissionTypes = map[string]sarama.AclPermissionType{
+		"allow": sarama.AclPermissionAllow,
+		"deny":  sarama.AclPermissionDeny,
+	}
+	// aclIDFields are the fields identifying an ACL, in the order of its ID
+	aclIDFields = []string{"resource_type", "pattern_type", "resource_name", "principal", "host", "operation", "permission_type"}
+)
+
+func resourceIBMEventStreamsACL() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMEventStreamsACLCreate,
+		ReadContext:   resourceIBMEventStreamsACLRead,
+		DeleteContext: resourceIBMEventStreamsACLDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMEventStreamsACLImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The CRN of the Event Streams instance",
+			},
+			"kafka_http_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "API endpoint for interacting with Event Streams REST API",
+			},
+			"kafka_brokers_sasl": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Kafka brokers addresses for interacting with Kafka native API",
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"topic", "group", "cluster", "transactional_id"}),
+				Description:  "The type of the resource the ACL applies to: topic, group, cluster or transactional_id",
+			},
+			"resource_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the resource the ACL applies to, kafka-cluster for the cluster",
+			},
+			"pattern_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "literal",
+				ValidateFunc: validateAllowedStringValue([]string{"literal", "prefixed"}),
+				Description:  "Whether resource_name is the name of the resource, literal, or a prefix of the names, prefixed",
+			},
+			"principal": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The principal the ACL applies to, User:<IAM ID> of a user or service ID, or User:* for all of them",
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "*",
+				Description: "The host the principal connects from",
+			},
+			"operation": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"all", "read", "write", "create", "delete", "alter", "describe", "cluster_action", "describe_configs", "alter_configs", "idempotent_write"}),
+				Description:  "The operation the ACL allows or denies",
+			},
+			"permission_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "allow",
+				ValidateFunc: validateAllowedStringValue([]string{"allow", "deny"}),
+				Description:  "Whether the ACL allows or denies the operation",
+			},
+		},
+	}
+}
+
+// expandEventStreamsACL returns the filter matching exactly the ACL of d.
+func expandEventStreamsACL(d *schema.ResourceData) sarama.AclFilter {
+	resourceName := d.Get("resource_name").(string)
+	principal := d.Get("principal").(string)
+	host := d.Get("host").(string)
+	return sarama.AclFilter{
+		ResourceType:              aclResourceTypes[d.Get("resource_type").(string)],
+		ResourceName:              &resourceName,
+		ResourcePatternTypeFilter: aclPatternTypes[d.Get("pattern_type").(string)],
+		Principal:                 &principal,
+		Host:                      &host,
+		Operation:                 aclOperations[d.Get("operation").(string)],
+		PermissionType:            aclPermissionTypes[d.Get("permission_type").(string)],
+	}
+}
+
+// getEventStreamsACLID returns the ID of an ACL, the CRN of its instance with the
+// acl resource type, followed by the fields identifying the ACL separated by /.
+func getEventStreamsACLID(instanceCRN string, d *schema.ResourceData) string {
+	crnSegments := strings.Split(instanceCRN, ":")
+	crnSegments[8] = "acl"
+	fields := make([]string, len(aclIDFields))
+	for i, field := range aclIDFields {
+		fields[i] = d.Get(field).(string)
+	}
+	crnSegments[9] = strings.Join(fields, "/")
+	return strings.Join(crnSegments, ":")
+}
+
+func resourceIBMEventStreamsACLCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminClient, instanceCRN, err := createSaramaAdminClient(d, meta)
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsACLCreate createSaramaAdminClient err %s", err)
+		return diag.FromErr(err)
+	}
+	filter := expandEventStreamsACL(d)
+	resource := sarama.Resource{
+		ResourceType:        filter.ResourceType,
+		ResourceName:        *filter.ResourceName,
+		ResourcePatternType: filter.ResourcePatternTypeFilter,
+	}
+	acl := sarama.Acl{
+		Principal:      *filter.Principal,
+		Host:           *filter.Host,
+		Operation:      filter.Operation,
+		PermissionType: filter.PermissionType,
+	}
+	err = adminClient.CreateACL(resource, acl)
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsACLCreate CreateACL err %s", err)
+		return diag.FromErr(fmt.Errorf("Error creating the ACL of %s on %s %s: %s", acl.Principal, d.Get("resource_type"), resource.ResourceName, err))
+	}
+	d.SetId(getEventStreamsACLID(instanceCRN, d))
+	log.Printf("[INFO] resourceIBMEventStreamsACLCreate ACL %s created", d.Id())
+	return resourceIBMEventStreamsACLRead(context, d, meta)
+}
+
+func resourceIBMEventStreamsACLRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminClient, _, err := createSaramaAdminClient(d, meta)
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsACLRead createSaramaAdminClient err %s", err)
+		return diag.FromErr(err)
+	}
+	resourceAcls, err := adminClient.ListAcls(expandEventStreamsACL(d))
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsACLRead ListAcls err %s", err)
+		return diag.FromErr(fmt.Errorf("Error listing the ACLs of %s: %s", d.Id(), err))
+	}
+	for _, resource := range resourceAcls {
+		if len(resource.Acls) > 0 {
+			return nil
+		}
+	}
+	log.Printf("[INFO] resourceIBMEventStreamsACLRead ACL %s does not exist", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceIBMEventStreamsACLDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminClient, _, err := createSaramaAdminClient(d, meta)
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsACLDelete createSaramaAdminClient err %s", err)
+		return diag.FromErr(err)
+	}
+	matching, err := adminClient.DeleteACL(expandEventStreamsACL(d), false)
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsACLDelete DeleteACL err %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting the ACL %s: %s", d.Id(), err))
+	}
+	for _, acl := range matching {
+		if acl.Err != sarama.ErrNoError {
+			return diag.FromErr(fmt.Errorf("Error deleting the ACL %s: %s", d.Id(), acl.Err))
+		}
+	}
+	d.SetId("")
+	log.Printf("[INFO] resourceIBMEventStreamsACLDelete %d ACL deleted", len(matching))
+	return nil
+}
+
+func resourceIBMEventStreamsACLImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	crnSegments := strings.SplitN(d.Id(), ":", 10)
+	if len(crnSegments) != 10 || crnSegments[8] != "acl" {
+		return nil, fmt.Errorf("Incorrect ID %s: the ID must be the CRN of the instance with acl:<resource_type>/<pattern_type>/<resource_name>/<principal>/<host>/<operation>/<permission_type> as resource", d.Id())
+	}
+	fields := strings.SplitN(crnSegments[9], "/", len(aclIDFields))
+	if len(fields) != len(aclIDFields) {
+		return nil, fmt.Errorf("Incorrect ID %s: the ACL must be <resource_type>/<pattern_type>/<resource_name>/<principal>/<host>/<operation>/<permission_type>", d.Id())
+	}
+	crnSegments[8] = ""
+	crnSegments[9] = ""
+	d.Set("resource_instance_id", strings.Join(crnSegments, ":"))
+	for i, field := range aclIDFields {
+		d.Set(field, fields[i])
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gotest.tools/assert"
+)
+
+func testIBMEventStreamsACLMock(t *testing.T, es *testEventStreams) {
+	r := resourceIBMEventStreamsACL()
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"resource_instance_id": es.InstanceCRN,
+		"resource_type":        "topic",
+		"resource_name":        "orders-",
+		"pattern_type":         "prefixed",
+		"principal":            "User:iam-ServiceId-1234",
+		"operation":            "write",
+	})
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), d, es.Meta)))
+	instanceSegments := strings.Split(es.InstanceCRN, ":")
+	assert.Equal(t, d.Id(), strings.Join(instanceSegments[:8], ":")+":acl:topic/prefixed/orders-/User:iam-ServiceId-1234/*/write/allow")
+	filter := expandEventStreamsACL(d)
+	assert.Equal(t, filter.ResourcePatternTypeFilter, sarama.AclPatternPrefixed)
+	assert.Equal(t, filter.Operation, sarama.AclOperationWrite)
+	assert.Equal(t, filter.PermissionType, sarama.AclPermissionAllow)
+
+	imported := r.Data(nil)
+	imported.SetId(d.Id())
+	states, err := r.Importer.StateContext(context.Background(), imported, es.Meta)
+	assert.NilError(t, err)
+	for _, field := range append(aclIDFields, "resource_instance_id") {
+		assert.Equal(t, states[0].Get(field), d.Get(field), field)
+	}
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), states[0], es.Meta)))
+	assert.Equal(t, states[0].Id(), d.Id())
+
+	assert.NilError(t, testDiagsErr(r.DeleteContext(context.Background(), d, es.Meta)))
+	assert.Equal(t, d.Id(), "")
+}
@@ -0,0 +1,181 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// eventStreamsQuota is the quota of an entity in the admin REST API.
+type eventStreamsQuota struct {
+	ProducerByteRate *int `json:"producer_byte_rate,omitempty"`
+	ConsumerByteRate *int `json:"consumer_byte_rate,omitempty"`
+}
+
+func resourceIBMEventStreamsQuota() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMEventStreamsQuotaCreate,
+		ReadContext:   resourceIBMEventStreamsQuotaRead,
+		UpdateContext: resourceIBMEventStreamsQuotaUpdate,
+		DeleteContext: resourceIBMEventStreamsQuotaDelete,
+		Importer:      &schema.ResourceImporter{},
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The CRN of the Event Streams instance",
+			},
+			"kafka_http_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "API endpoint for interacting with Event Streams REST API",
+			},
+			"kafka_brokers_sasl": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Kafka brokers addresses for interacting with Kafka native API",
+			},
+			"entity": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The entity the quota applies to, the IAM ID of a service ID or user, or default for the entities with no quota of their own",
+			},
+			"producer_byte_rate": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      -1,
+				ValidateFunc: validateAllowedRangeInt(-1, 2147483647),
+				AtLeastOneOf: []string{"producer_byte_rate", "consumer_byte_rate"},
+				Description:  "The maximum number of bytes per second the entity can produce, -1 for no limit",
+			},
+			"consumer_byte_rate": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      -1,
+				ValidateFunc: validateAllowedRangeInt(-1, 2147483647),
+				AtLeastOneOf: []string{"producer_byte_rate", "consumer_byte_rate"},
+				Description:  "The maximum number of bytes per second the entity can consume, -1 for no limit",
+			},
+		},
+	}
+}
+
+func expandEventStreamsQuota(d *schema.ResourceData) eventStreamsQuota {
+	quota := eventStreamsQuota{}
+	if rate := d.Get("producer_byte_rate").(int); rate != -1 {
+		quota.ProducerByteRate = &rate
+	}
+	if rate := d.Get("consumer_byte_rate").(int); rate != -1 {
+		quota.ConsumerByteRate = &rate
+	}
+	return quota
+}
+
+func getEventStreamsQuotaPath(entity string) string {
+	return "/admin/quotas/" + url.PathEscape(entity)
+}
+
+func resourceIBMEventStreamsQuotaCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, instanceCRN, err := createEventStreamsRESTClient(d, meta)
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsQuotaCreate createEventStreamsRESTClient err %s", err)
+		return diag.FromErr(err)
+	}
+	entity := d.Get("entity").(string)
+	err = client.do(http.MethodPost, getEventStreamsQuotaPath(entity), expandEventStreamsQuota(d), nil)
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsQuotaCreate create quota err %s", err)
+		return diag.FromErr(fmt.Errorf("Error creating the quota of %s: %s", entity, err))
+	}
+	d.SetId(getEventStreamsEntityID(instanceCRN, "quota", entity))
+	log.Printf("[INFO] resourceIBMEventStreamsQuotaCreate quota of %s created", entity)
+	return resourceIBMEventStreamsQuotaRead(context, d, meta)
+}
+
+func resourceIBMEventStreamsQuotaRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, instanceCRN, err := createEventStreamsRESTClient(d, meta)
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsQuotaRead createEventStreamsRESTClient err %s", err)
+		return diag.FromErr(err)
+	}
+	entity := getTopicName(d.Id())
+	quota := eventStreamsQuota{}
+	err = client.do(http.MethodGet, getEventStreamsQuotaPath(entity), nil, &quota)
+	if isEventStreamsNotFound(err) {
+		log.Printf("[INFO] resourceIBMEventStreamsQuotaRead quota of %s does not exist", entity)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsQuotaRead get quota err %s", err)
+		return diag.FromErr(fmt.Errorf("Error getting the quota of %s: %s", entity, err))
+	}
+	d.Set("resource_instance_id", instanceCRN)
+	d.Set("entity", entity)
+	d.Set("producer_byte_rate", -1)
+	if quota.ProducerByteRate != nil {
+		d.Set("producer_byte_rate", *quota.ProducerByteRate)
+	}
+	d.Set("consumer_byte_rate", -1)
+	if quota.ConsumerByteRate != nil {
+		d.Set("consumer_byte_rate", *quota.ConsumerByteRate)
+	}
+	return nil
+}
+
+func resourceIBMEventStreamsQuotaUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, err := createEventStreamsRESTClient(d, meta)
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsQuotaUpdate createEventStreamsRESTClient err %s", err)
+		return diag.FromErr(err)
+	}
+	entity := d.Get("entity").(string)
+	if d.HasChange("producer_byte_rate") || d.HasChange("consumer_byte_rate") {
+		// The rates missing from the update are removed from the quota
+		err = client.do(http.MethodPatch, getEventStreamsQuotaPath(entity), expandEventStreamsQuota(d), nil)
+		if err != nil {
+			log.Printf("[DEBUG] resourceIBMEventStreamsQuotaUpdate update quota err %s", err)
+			return diag.FromErr(fmt.Errorf("Error updating the quota of %s: %s", entity, err))
+		}
+	}
+	return resourceIBMEventStreamsQuotaRead(context, d, meta)
+}
+
+func resourceIBMEventStreamsQuotaDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, err := createEventStreamsRESTClient(d, meta)
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsQuotaDelete createEventStreamsRESTClient err %s", err)
+		return diag.FromErr(err)
+	}
+	entity := d.Get("entity").(string)
+	err = client.do(http.MethodDelete, getEventStreamsQuotaPath(entity), nil, nil)
+	if err != nil && !isEventStreamsNotFound(err) {
+		log.Printf("[DEBUG] resourceIBMEventStreamsQuotaDelete delete quota err %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting the quota of %s: %s", entity, err))
+	}
+	d.SetId("")
+	log.Printf("[INFO] resourceIBMEventStreamsQuotaDelete quota of %s deleted", entity)
+	return nil
+}
+
+// getEventStreamsEntityID returns the ID of an entity of an Event Streams instance,
+// the CRN of the instance with the resource type and name of the entity, like the
+// IDs of the topics.
+func getEventStreamsEntityID(instanceCRN, resourceType, name string) string {
+	crnSegments := strings.Split(instanceCRN, ":")
+	crnSegments[8] = resourceType
+	crnSegments[9] = name
+	return strings.Join(crnSegments, ":")
+}
@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gotest.tools/assert"
+)
+
+func testIBMEventStreamsQuotaMock(t *testing.T, es *testEventStreams) {
+	r := resourceIBMEventStreamsQuota()
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"resource_instance_id": es.InstanceCRN,
+		"entity":               "iam-ServiceId-1234",
+		"producer_byte_rate":   1024,
+	})
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), d, es.Meta)))
+	assert.Equal(t, d.Id(), getEventStreamsEntityID(es.InstanceCRN, "quota", "iam-ServiceId-1234"))
+	assert.Equal(t, d.Get("producer_byte_rate"), 1024)
+	assert.Equal(t, d.Get("consumer_byte_rate"), -1)
+	assert.DeepEqual(t, es.quotas["iam-ServiceId-1234"], map[string]interface{}{"producer_byte_rate": 1024.0})
+
+	// A second quota of the same entity is refused
+	duplicate := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"resource_instance_id": es.InstanceCRN,
+		"entity":               "iam-ServiceId-1234",
+		"consumer_byte_rate":   2048,
+	})
+	assert.ErrorContains(t, testDiagsErr(r.CreateContext(context.Background(), duplicate, es.Meta)), "Quota already exists")
+
+	// Unsetting producer_byte_rate removes it from the quota
+	updated := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"resource_instance_id": es.InstanceCRN,
+		"entity":               "iam-ServiceId-1234",
+		"consumer_byte_rate":   2048,
+	})
+	updated.SetId(d.Id())
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), updated, es.Meta)))
+	assert.Equal(t, updated.Get("producer_byte_rate"), -1)
+	assert.Equal(t, updated.Get("consumer_byte_rate"), 2048)
+
+	imported := r.Data(nil)
+	imported.SetId(d.Id())
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), imported, es.Meta)))
+	assert.Equal(t, imported.Get("resource_instance_id"), es.InstanceCRN)
+	assert.Equal(t, imported.Get("entity"), "iam-ServiceId-1234")
+	assert.Equal(t, imported.Get("consumer_byte_rate"), 2048)
+
+	assert.NilError(t, testDiagsErr(r.DeleteContext(context.Background(), updated, es.Meta)))
+	assert.Equal(t, len(es.quotas), 0)
+	imported.SetId(d.Id())
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), imported, es.Meta)))
+	assert.Equal(t, imported.Id(), "")
+}
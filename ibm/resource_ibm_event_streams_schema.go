@@ -0,0 +1,204 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// eventStreamsSchemaVersion is a version of a subject in the schema registry.
+type eventStreamsSchemaVersion struct {
+	Subject string `json:"subject,omitempty"`
+	Version int    `json:"version,omitempty"`
+	ID      int    `json:"id,omitempty"`
+	Schema  string `json:"schema,omitempty"`
+}
+
+// eventStreamsSchemaConfig is the compatibility configuration of a subject, set
+// with compatibility and returned in compatibilityLevel.
+type eventStreamsSchemaConfig struct {
+	Compatibility      string `json:"compatibility,omitempty"`
+	CompatibilityLevel string `json:"compatibilityLevel,omitempty"`
+}
+
+func resourceIBMEventStreamsSchema() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMEventStreamsSchemaCreate,
+		ReadContext:   resourceIBMEventStreamsSchemaRead,
+		UpdateContext: resourceIBMEventStreamsSchemaUpdate,
+		DeleteContext: resourceIBMEventStreamsSchemaDelete,
+		Importer:      &schema.ResourceImporter{},
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The CRN of the Event Streams instance",
+			},
+			"kafka_http_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "API endpoint for interacting with Event Streams REST API",
+			},
+			"kafka_brokers_sasl": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Kafka brokers addresses for interacting with Kafka native API",
+			},
+			"subject": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The subject of the schema, <topic>-value or <topic>-key for the schemas of the values or keys of a topic",
+			},
+			"schema": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateJSONString(),
+				DiffSuppressFunc: suppressEquivalentJSON,
+				Description:      "The Avro schema, registered as a new version of the subject when it changes",
+			},
+			"compatibility": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"NONE", "BACKWARD", "BACKWARD_TRANSITIVE", "FORWARD", "FORWARD_TRANSITIVE", "FULL", "FULL_TRANSITIVE"}),
+				Description:  "The compatibility rule the new versions of the schema must follow",
+			},
+			"schema_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the schema in the registry",
+			},
+			"version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The version of the schema in the subject",
+			},
+		},
+	}
+}
+
+func getEventStreamsSubjectPath(subject string) string {
+	return "/confluent/subjects/" + url.PathEscape(subject)
+}
+
+func getEventStreamsSubjectConfigPath(subject string) string {
+	return "/confluent/config/" + url.PathEscape(subject)
+}
+
+func resourceIBMEventStreamsSchemaCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, instanceCRN, err := createEventStreamsRESTClient(d, meta)
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsSchemaCreate createEventStreamsRESTClient err %s", err)
+		return diag.FromErr(err)
+	}
+	subject := d.Get("subject").(string)
+	// The compatibility is set first, the version being checked against it
+	if compatibility, ok := d.GetOk("compatibility"); ok {
+		err = client.do(http.MethodPut, getEventStreamsSubjectConfigPath(subject), eventStreamsSchemaConfig{Compatibility: compatibility.(string)}, nil)
+		if err != nil {
+			log.Printf("[DEBUG] resourceIBMEventStreamsSchemaCreate set compatibility err %s", err)
+			return diag.FromErr(fmt.Errorf("Error setting the compatibility of subject %s: %s", subject, err))
+		}
+	}
+	version := eventStreamsSchemaVersion{}
+	err = client.do(http.MethodPost, getEventStreamsSubjectPath(subject)+"/versions", eventStreamsSchemaVersion{Schema: d.Get("schema").(string)}, &version)
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsSchemaCreate register schema err %s", err)
+		return diag.FromErr(fmt.Errorf("Error registering the schema of subject %s: %s", subject, err))
+	}
+	d.SetId(getEventStreamsEntityID(instanceCRN, "schema", subject))
+	log.Printf("[INFO] resourceIBMEventStreamsSchemaCreate schema %d of subject %s registered", version.ID, subject)
+	return resourceIBMEventStreamsSchemaRead(context, d, meta)
+}
+
+func resourceIBMEventStreamsSchemaRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, instanceCRN, err := createEventStreamsRESTClient(d, meta)
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsSchemaRead createEventStreamsRESTClient err %s", err)
+		return diag.FromErr(err)
+	}
+	subject := getTopicName(d.Id())
+	version := eventStreamsSchemaVersion{}
+	err = client.do(http.MethodGet, getEventStreamsSubjectPath(subject)+"/versions/latest", nil, &version)
+	if isEventStreamsNotFound(err) {
+		log.Printf("[INFO] resourceIBMEventStreamsSchemaRead subject %s does not exist", subject)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsSchemaRead get schema err %s", err)
+		return diag.FromErr(fmt.Errorf("Error getting the schema of subject %s: %s", subject, err))
+	}
+	d.Set("resource_instance_id", instanceCRN)
+	d.Set("subject", subject)
+	d.Set("schema", version.Schema)
+	d.Set("schema_id", version.ID)
+	d.Set("version", version.Version)
+
+	// The subjects with no compatibility of their own follow the global one
+	config := eventStreamsSchemaConfig{}
+	err = client.do(http.MethodGet, getEventStreamsSubjectConfigPath(subject), nil, &config)
+	if isEventStreamsNotFound(err) {
+		err = client.do(http.MethodGet, "/confluent/config", nil, &config)
+	}
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsSchemaRead get compatibility err %s", err)
+		return diag.FromErr(fmt.Errorf("Error getting the compatibility of subject %s: %s", subject, err))
+	}
+	d.Set("compatibility", config.CompatibilityLevel)
+	return nil
+}
+
+func resourceIBMEventStreamsSchemaUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, err := createEventStreamsRESTClient(d, meta)
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsSchemaUpdate createEventStreamsRESTClient err %s", err)
+		return diag.FromErr(err)
+	}
+	subject := d.Get("subject").(string)
+	if d.HasChange("compatibility") {
+		err = client.do(http.MethodPut, getEventStreamsSubjectConfigPath(subject), eventStreamsSchemaConfig{Compatibility: d.Get("compatibility").(string)}, nil)
+		if err != nil {
+			log.Printf("[DEBUG] resourceIBMEventStreamsSchemaUpdate set compatibility err %s", err)
+			return diag.FromErr(fmt.Errorf("Error setting the compatibility of subject %s: %s", subject, err))
+		}
+	}
+	if d.HasChange("schema") {
+		version := eventStreamsSchemaVersion{}
+		err = client.do(http.MethodPost, getEventStreamsSubjectPath(subject)+"/versions", eventStreamsSchemaVersion{Schema: d.Get("schema").(string)}, &version)
+		if err != nil {
+			log.Printf("[DEBUG] resourceIBMEventStreamsSchemaUpdate register schema err %s", err)
+			return diag.FromErr(fmt.Errorf("Error registering the schema of subject %s: %s", subject, err))
+		}
+		log.Printf("[INFO] resourceIBMEventStreamsSchemaUpdate schema %d of subject %s registered", version.ID, subject)
+	}
+	return resourceIBMEventStreamsSchemaRead(context, d, meta)
+}
+
+func resourceIBMEventStreamsSchemaDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, err := createEventStreamsRESTClient(d, meta)
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsSchemaDelete createEventStreamsRESTClient err %s", err)
+		return diag.FromErr(err)
+	}
+	subject := d.Get("subject").(string)
+	err = client.do(http.MethodDelete, getEventStreamsSubjectPath(subject), nil, nil)
+	if err != nil && !isEventStreamsNotFound(err) {
+		log.Printf("[DEBUG] resourceIBMEventStreamsSchemaDelete delete subject err %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting subject %s: %s", subject, err))
+	}
+	d.SetId("")
+	log.Printf("[INFO] resourceIBMEventStreamsSchemaDelete subject %s deleted", subject)
+	return nil
+}
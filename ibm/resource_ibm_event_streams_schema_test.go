@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gotest.tools/assert"
+)
+
+const testEventStreamsAvroSchema = `{"type": "record", "name": "order", "fields": [{"name": "id", "type": "string"}]}`
+
+func testIBMEventStreamsSchemaMock(t *testing.T, es *testEventStreams) {
+	r := resourceIBMEventStreamsSchema()
+
+	// With no compatibility of its own, the subject follows the global one
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"resource_instance_id": es.InstanceCRN,
+		"subject":              "orders-value",
+		"schema":               testEventStreamsAvroSchema,
+	})
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), d, es.Meta)))
+	assert.Equal(t, d.Id(), getEventStreamsEntityID(es.InstanceCRN, "schema", "orders-value"))
+	assert.Equal(t, d.Get("version"), 1)
+	assert.Equal(t, d.Get("schema_id"), 101)
+	assert.Equal(t, d.Get("compatibility"), "BACKWARD")
+
+	// A new version is registered when the schema changes
+	updated := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"resource_instance_id": es.InstanceCRN,
+		"subject":              "orders-value",
+		"schema":               `{"type": "record", "name": "order", "fields": [{"name": "id", "type": "string"}, {"name": "total", "type": "int", "default": 0}]}`,
+		"compatibility":        "FULL",
+	})
+	updated.SetId(d.Id())
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), updated, es.Meta)))
+	assert.Equal(t, updated.Get("version"), 2)
+	assert.Equal(t, updated.Get("compatibility"), "FULL")
+	assert.Equal(t, es.configs["orders-value"], "FULL")
+
+	imported := r.Data(nil)
+	imported.SetId(d.Id())
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), imported, es.Meta)))
+	assert.Equal(t, imported.Get("subject"), "orders-value")
+	assert.Equal(t, imported.Get("schema"), updated.Get("schema"))
+	assert.Equal(t, imported.Get("compatibility"), "FULL")
+
+	assert.NilError(t, testDiagsErr(r.DeleteContext(context.Background(), updated, es.Meta)))
+	assert.Equal(t, len(es.subjects), 0)
+	imported.SetId(d.Id())
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), imported, es.Meta)))
+	assert.Equal(t, imported.Id(), "")
+}
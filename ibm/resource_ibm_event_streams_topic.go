@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
 	"strings"
 
 	"github.com/Shopify/sarama"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -41,12 +43,11 @@ var (
 
 func resourceIBMEventStreamsTopic() *schema.Resource {
 	return &schema.Resource{
-		Exists:   resourceIBMEventStreamsTopicExists,
-		Create:   resourceIBMEventStreamsTopicCreate,
-		Read:     resourceIBMEventStreamsTopicRead,
-		Update:   resourceIBMEventStreamsTopicUpdate,
-		Delete:   resourceIBMEventStreamsTopicDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMEventStreamsTopicCreate,
+		ReadContext:   resourceIBMEventStreamsTopicRead,
+		UpdateContext: resourceIBMEventStreamsTopicUpdate,
+		DeleteContext: resourceIBMEventStreamsTopicDelete,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			"resource_instance_id": &schema.Schema{
 				Type:        schema.TypeString,
@@ -88,6 +89,9 @@ func resourceIBMEventStreamsTopic() *schema.Resource {
 // key is instance's CRN
 var clientPool = map[string]sarama.ClusterAdmin{}
 
+// newSaramaClusterAdmin creates the Kafka admin clients, replaced in the tests.
+var newSaramaClusterAdmin = sarama.NewClusterAdmin
+
 func resourceIBMEventStreamsTopicExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	adminClient, _, err := createSaramaAdminClient(d, meta)
 	if err != nil {
@@ -104,11 +108,11 @@ func resourceIBMEventStreamsTopicExists(d *schema.ResourceData, meta interface{}
 	return true, nil
 }
 
-func resourceIBMEventStreamsTopicCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMEventStreamsTopicCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	adminClient, instanceCRN, err := createSaramaAdminClient(d, meta)
 	if err != nil {
 		log.Printf("[DEBUG] resourceIBMEventStreamsTopicCreate createSaramaAdminClient err %s", err)
-		return err
+		return diag.FromErr(err)
 	}
 	topicName := d.Get("name").(string)
 	partitions := d.Get("partitions").(int)
@@ -124,21 +128,30 @@ func resourceIBMEventStreamsTopicCreate(d *schema.ResourceData, meta interface{}
 	}
 	log.Printf("[INFO] resourceIBMEventStreamsTopicCreate CreateTopic: topic is %s, detail is %v", topicName, topicDetail)
 	d.SetId(getTopicID(instanceCRN, topicName))
-	return resourceIBMEventStreamsTopicRead(d, meta)
+	return resourceIBMEventStreamsTopicRead(context, d, meta)
 }
 
-func resourceIBMEventStreamsTopicRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMEventStreamsTopicRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMEventStreamsTopicExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	adminClient, instanceCRN, err := createSaramaAdminClient(d, meta)
 	if err != nil {
 		log.Printf("[DEBUG] resourceIBMEventStreamsTopicRead createSaramaAdminClient err %s", err)
-		return err
+		return diag.FromErr(err)
 	}
 	topicID := d.Id()
 	topicName := getTopicName(topicID)
 	topics, err := adminClient.ListTopics()
 	if err != nil {
 		log.Printf("[DEBUG] resourceIBMEventStreamsTopicRead ListTopics err %s", err)
-		return err
+		return diag.FromErr(err)
 	}
 	for name, detail := range topics {
 		if name == topicName {
@@ -162,11 +175,11 @@ func resourceIBMEventStreamsTopicRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceIBMEventStreamsTopicUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMEventStreamsTopicUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	adminClient, _, err := createSaramaAdminClient(d, meta)
 	if err != nil {
 		log.Printf("[DEBUG] resourceIBMEventStreamsTopicUpdate createSaramaAdminClient err %s", err)
-		return err
+		return diag.FromErr(err)
 	}
 	topicName := d.Get("name").(string)
 	if d.HasChange("partitions") {
@@ -177,7 +190,7 @@ func resourceIBMEventStreamsTopicUpdate(d *schema.ResourceData, meta interface{}
 		err = adminClient.CreatePartitions(topicName, int32(newPartitions), nil, false)
 		if err != nil {
 			log.Printf("[DEBUG]resourceIBMEventStreamsTopicUpdate CreatePartitions err %s", err)
-			return err
+			return diag.FromErr(err)
 		}
 		d.Set("partitions", int32(newPartitions))
 		log.Printf("[INFO]resourceIBMEventStreamsTopicUpdate partitions is set to %d", newPartitions)
@@ -188,25 +201,25 @@ func resourceIBMEventStreamsTopicUpdate(d *schema.ResourceData, meta interface{}
 		err = adminClient.AlterConfig(sarama.TopicResource, topicName, configEntries, false)
 		if err != nil {
 			log.Printf("[DEBUG]resourceIBMEventStreamsTopicUpdate AlterConfig err %s", err)
-			return err
+			return diag.FromErr(err)
 		}
 		d.Set("config", topicDetail2Config(configEntries))
 		log.Printf("[INFO]resourceIBMEventStreamsTopicUpdate config is set to %v", topicDetail2Config(configEntries))
 	}
-	return resourceIBMEventStreamsTopicRead(d, meta)
+	return resourceIBMEventStreamsTopicRead(context, d, meta)
 }
 
-func resourceIBMEventStreamsTopicDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMEventStreamsTopicDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	adminClient, _, err := createSaramaAdminClient(d, meta)
 	if err != nil {
 		log.Printf("[DEBUG] resourceIBMEventStreamsTopicDelete createSaramaAdminClient err %s", err)
-		return err
+		return diag.FromErr(err)
 	}
 	topicName := d.Get("name").(string)
 	err = adminClient.DeleteTopic(topicName)
 	if err != nil {
 		log.Printf("[DEBUG] resourceIBMEventStreamsTopicDelete DeleteTopic err %s", err)
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	log.Printf("[INFO]resourceIBMEventStreamsTopicDelete topic %s deleted", topicName)
@@ -214,46 +227,10 @@ func resourceIBMEventStreamsTopicDelete(d *schema.ResourceData, meta interface{}
 }
 
 func createSaramaAdminClient(d *schema.ResourceData, meta interface{}) (sarama.ClusterAdmin, string, error) {
-	bxSession, err := meta.(ClientSession).BluemixSession()
-	if err != nil {
-		log.Printf("[DEBUG] createSaramaAdminClient BluemixSession err %s", err)
-		return nil, "", err
-	}
-	apiKey := bxSession.Config.BluemixAPIKey
-	if len(apiKey) == 0 {
-		log.Printf("[DEBUG] createSaramaAdminClient BluemixAPIKey is empty")
-		return nil, "", fmt.Errorf("failed to get IBM cloud API key")
-	}
-	rsConClient, err := meta.(ClientSession).ResourceControllerAPI()
-	if err != nil {
-		log.Printf("[DEBUG] createSaramaAdminClient ResourceControllerAPI err %s", err)
-		return nil, "", err
-	}
-	rcAPI := rsConClient.ResourceServiceInstance()
-	instanceCRN := d.Get("resource_instance_id").(string)
-	if len(instanceCRN) == 0 {
-		topicID := d.Id()
-		if len(topicID) == 0 || !strings.Contains(topicID, ":") {
-			log.Printf("[DEBUG] createSaramaAdminClient resource_instance_id is missing")
-			return nil, "", fmt.Errorf("resource_instance_id is required")
-		}
-		instanceCRN = getInstanceCRN(topicID)
-	}
-	instance, err := rcAPI.GetInstance(instanceCRN)
+	instanceCRN, adminURL, brokerAddress, apiKey, err := getEventStreamsInstance(d, meta)
 	if err != nil {
-		log.Printf("[DEBUG] createSaramaAdminClient GetInstance err %s", err)
 		return nil, "", err
 	}
-	if instance.Extensions == nil {
-		log.Printf("[DEBUG] createSaramaAdminClient instance %s extension is nil", instance.ID)
-		return nil, "", fmt.Errorf("instance %s extension is nil", instance.ID)
-	}
-	adminURL := instance.Extensions["kafka_http_url"].(string)
-	d.Set("kafka_http_url", adminURL)
-	log.Printf("[INFO] createSaramaAdminClient kafka_http_url is set to %s", adminURL)
-	brokerAddress := expandStringList(instance.Extensions["kafka_brokers_sasl"].([]interface{}))
-	d.Set("kafka_brokers_sasl", brokerAddress)
-	log.Printf("[INFO] createSaramaAdminClient kafka_brokers_sasl is set to %s", brokerAddress)
 	tenantID := strings.TrimPrefix(strings.Split(adminURL, ".")[0], "https://")
 
 	config := sarama.NewConfig()
@@ -266,7 +243,7 @@ func createSaramaAdminClient(d *schema.ResourceData, meta interface{}) (sarama.C
 	config.Net.SASL.Password = apiKey
 	config.Net.TLS.Enable = true
 	config.Version = brokerVersion
-	adminClient, err := sarama.NewClusterAdmin(brokerAddress, config)
+	adminClient, err := newSaramaClusterAdmin(brokerAddress, config)
 	if err != nil {
 		log.Printf("[DEBUG] createSaramaAdminClient NewClusterAdmin err %s", err)
 		return nil, "", err
@@ -276,6 +253,57 @@ func createSaramaAdminClient(d *schema.ResourceData, meta interface{}) (sarama.C
 	return adminClient, instanceCRN, nil
 }
 
+// getEventStreamsInstance returns the CRN, admin REST API endpoint and Kafka brokers
+// of the Event Streams instance of a resource, and the API key authenticating
+// with them.
+func getEventStreamsInstance(d *schema.ResourceData, meta interface{}) (instanceCRN, adminURL string, brokerAddress []string, apiKey string, err error) {
+	bxSession, err := meta.(ClientSession).BluemixSession()
+	if err != nil {
+		log.Printf("[DEBUG] getEventStreamsInstance BluemixSession err %s", err)
+		return "", "", nil, "", err
+	}
+	apiKey = bxSession.Config.BluemixAPIKey
+	if len(apiKey) == 0 {
+		log.Printf("[DEBUG] getEventStreamsInstance BluemixAPIKey is empty")
+		return "", "", nil, "", fmt.Errorf("failed to get IBM cloud API key")
+	}
+	rsConClient, err := meta.(ClientSession).ResourceControllerAPI()
+	if err != nil {
+		log.Printf("[DEBUG] getEventStreamsInstance ResourceControllerAPI err %s", err)
+		return "", "", nil, "", err
+	}
+	rcAPI := rsConClient.ResourceServiceInstance()
+	instanceCRN = d.Get("resource_instance_id").(string)
+	if len(instanceCRN) == 0 {
+		topicID := d.Id()
+		if len(topicID) == 0 || !strings.Contains(topicID, ":") {
+			log.Printf("[DEBUG] getEventStreamsInstance resource_instance_id is missing")
+			return "", "", nil, "", fmt.Errorf("resource_instance_id is required")
+		}
+		instanceCRN = getInstanceCRN(topicID)
+	}
+	instance, err := rcAPI.GetInstance(instanceCRN)
+	if err != nil {
+		log.Printf("[DEBUG] getEventStreamsInstance GetInstance err %s", err)
+		return "", "", nil, "", err
+	}
+	if instance.Extensions == nil {
+		log.Printf("[DEBUG] getEventStreamsInstance instance %s extension is nil", instance.ID)
+		return "", "", nil, "", fmt.Errorf("instance %s extension is nil", instance.ID)
+	}
+	adminURL, _ = instance.Extensions["kafka_http_url"].(string)
+	brokers, _ := instance.Extensions["kafka_brokers_sasl"].([]interface{})
+	if adminURL == "" || len(brokers) == 0 {
+		return "", "", nil, "", fmt.Errorf("instance %s is not an Event Streams instance", instance.ID)
+	}
+	d.Set("kafka_http_url", adminURL)
+	log.Printf("[INFO] getEventStreamsInstance kafka_http_url is set to %s", adminURL)
+	brokerAddress = expandStringList(brokers)
+	d.Set("kafka_brokers_sasl", brokerAddress)
+	log.Printf("[INFO] getEventStreamsInstance kafka_brokers_sasl is set to %s", brokerAddress)
+	return instanceCRN, adminURL, brokerAddress, apiKey, nil
+}
+
 func topicDetail2Config(topicConfigEntries map[string]*string) map[string]*string {
 	configs := map[string]*string{}
 	for key, value := range topicConfigEntries {
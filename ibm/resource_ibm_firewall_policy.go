@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"strconv"
@@ -12,6 +13,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/services"
@@ -25,12 +27,11 @@ const (
 
 func resourceIBMFirewallPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMFirewallPolicyCreate,
-		Read:     resourceIBMFirewallPolicyRead,
-		Update:   resourceIBMFirewallPolicyUpdate,
-		Delete:   resourceIBMFirewallPolicyDelete,
-		Exists:   resourceIBMFirewallPolicyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMFirewallPolicyCreate,
+		ReadContext:   resourceIBMFirewallPolicyRead,
+		UpdateContext: resourceIBMFirewallPolicyUpdate,
+		DeleteContext: resourceIBMFirewallPolicyDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"firewall_id": {
@@ -158,7 +159,7 @@ func getFirewallContextAccessControlListId(fwId int, sess *session.Session) (int
 	return 0, fmt.Errorf("No firewallContextAccessControlListId.")
 }
 
-func resourceIBMFirewallPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFirewallPolicyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	fwId := d.Get("firewall_id").(int)
@@ -166,7 +167,7 @@ func resourceIBMFirewallPolicyCreate(d *schema.ResourceData, meta interface{}) e
 
 	fwContextACLId, err := getFirewallContextAccessControlListId(fwId, sess)
 	if err != nil {
-		return fmt.Errorf("Error during creation of dedicated hardware firewall rules: %s", err)
+		return diag.FromErr(fmt.Errorf("Error during creation of dedicated hardware firewall rules: %s", err))
 	}
 
 	ruleTemplate := datatypes.Network_Firewall_Update_Request{
@@ -178,7 +179,7 @@ func resourceIBMFirewallPolicyCreate(d *schema.ResourceData, meta interface{}) e
 
 	_, err = services.GetNetworkFirewallUpdateRequestService(sess.SetRetries(0)).CreateObject(&ruleTemplate)
 	if err != nil {
-		return fmt.Errorf("Error during creation of dedicated hardware firewall rules: %s", err)
+		return diag.FromErr(fmt.Errorf("Error during creation of dedicated hardware firewall rules: %s", err))
 	}
 
 	d.SetId(strconv.Itoa(fwId))
@@ -187,10 +188,19 @@ func resourceIBMFirewallPolicyCreate(d *schema.ResourceData, meta interface{}) e
 	log.Printf("[INFO] Wait one minute for applying the rules.")
 	time.Sleep(time.Minute)
 
-	return resourceIBMFirewallPolicyRead(d, meta)
+	return resourceIBMFirewallPolicyRead(context, d, meta)
 }
 
-func resourceIBMFirewallPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFirewallPolicyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMFirewallPolicyExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	fwRulesID, _ := strconv.Atoi(d.Id())
@@ -201,7 +211,7 @@ func resourceIBMFirewallPolicyRead(d *schema.ResourceData, meta interface{}) err
 		GetObject()
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving firewall rules: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving firewall rules: %s", err))
 	}
 
 	rules := make([]map[string]interface{}, 0, len(fw.Rules))
@@ -258,18 +268,18 @@ func appendAnyOpenRule(rules []datatypes.Network_Firewall_Update_Request_Rule, p
 	return append(rules, ruleAnyOpen, ruleAnyOpenIpv6)
 }
 
-func resourceIBMFirewallPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFirewallPolicyUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	fwId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid firewall ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("Not a valid firewall ID, must be an integer: %s", err))
 	}
 	rules := prepareRules(d)
 
 	fwContextACLId, err := getFirewallContextAccessControlListId(fwId, sess)
 	if err != nil {
-		return fmt.Errorf("Error during updating of dedicated hardware firewall rules: %s", err)
+		return diag.FromErr(fmt.Errorf("Error during updating of dedicated hardware firewall rules: %s", err))
 	}
 
 	ruleTemplate := datatypes.Network_Firewall_Update_Request{
@@ -281,23 +291,23 @@ func resourceIBMFirewallPolicyUpdate(d *schema.ResourceData, meta interface{}) e
 
 	_, err = services.GetNetworkFirewallUpdateRequestService(sess.SetRetries(0)).CreateObject(&ruleTemplate)
 	if err != nil {
-		return fmt.Errorf("Error during updating of dedicated hardware firewall rules: %s", err)
+		return diag.FromErr(fmt.Errorf("Error during updating of dedicated hardware firewall rules: %s", err))
 	}
 	time.Sleep(time.Minute)
 
-	return resourceIBMFirewallPolicyRead(d, meta)
+	return resourceIBMFirewallPolicyRead(context, d, meta)
 }
 
-func resourceIBMFirewallPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFirewallPolicyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	fwId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid firewall ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("Not a valid firewall ID, must be an integer: %s", err))
 	}
 
 	fwContextACLId, err := getFirewallContextAccessControlListId(fwId, sess)
 	if err != nil {
-		return fmt.Errorf("Error during deleting of dedicated hardware firewall rules: %s", err)
+		return diag.FromErr(fmt.Errorf("Error during deleting of dedicated hardware firewall rules: %s", err))
 	}
 
 	ruleTemplate := datatypes.Network_Firewall_Update_Request{
@@ -316,7 +326,7 @@ func resourceIBMFirewallPolicyDelete(d *schema.ResourceData, meta interface{}) e
 
 	_, err = services.GetNetworkFirewallUpdateRequestService(sess.SetRetries(0)).CreateObject(&ruleTemplate)
 	if err != nil {
-		return fmt.Errorf("Error during deleting of dedicated hardware firewall rules: %s", err)
+		return diag.FromErr(fmt.Errorf("Error during deleting of dedicated hardware firewall rules: %s", err))
 	}
 	time.Sleep(time.Minute)
 
@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -23,11 +25,10 @@ const (
 
 func resourceIBMFirewallShared() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMFirewallSharedCreate,
-		Read:     resourceIBMFirewallSharedRead,
-		Delete:   resourceIBMFirewallSharedDelete,
-		Exists:   resourceIBMFirewallSharedExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMFirewallSharedCreate,
+		ReadContext:   resourceIBMFirewallSharedRead,
+		DeleteContext: resourceIBMFirewallSharedDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -67,7 +68,7 @@ func resourceIBMFirewallShared() *schema.Resource {
 
 // keyName is in between:[10MBPS_HARDWARE_FIREWALL, 20MBPS_HARDWARE_FIREWALL,
 //                         100MBPS_HARDWARE_FIREWALL, 1000MBPS_HARDWARE_FIREWALL]
-func resourceIBMFirewallSharedCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFirewallSharedCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	keyName := d.Get("firewall_type").(string)
@@ -82,19 +83,19 @@ func resourceIBMFirewallSharedCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	if virtualId == 0 && hardwareId == 0 {
-		return fmt.Errorf("Provide either `virtual_instance_id` or `hardware_instance_id`")
+		return diag.FromErr(fmt.Errorf("Provide either `virtual_instance_id` or `hardware_instance_id`"))
 	}
 
 	//var productOrderContainer *string
 	pkg, err := product.GetPackageByType(sess, FwHardwarePackageType)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Get all prices for ADDITIONAL_SERVICES_FIREWALL with the given capacity
 	productItems, err := product.GetPackageProducts(sess, *pkg.Id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Select only those product items with a matching keyname
@@ -106,7 +107,7 @@ func resourceIBMFirewallSharedCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	if len(targetItems) == 0 {
-		return fmt.Errorf("No product items matching %s could be found", keyName)
+		return diag.FromErr(fmt.Errorf("No product items matching %s could be found", keyName))
 	}
 
 	masked := "id,firewallServiceComponent[id,status]"
@@ -154,9 +155,9 @@ func resourceIBMFirewallSharedCreate(d *schema.ResourceData, meta interface{}) e
 			NotFoundChecks: 24 * 60,
 		}
 
-		_, err = stateConf.WaitForState()
+		_, err = stateConf.WaitForStateContext(context)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		result, err := service.Id(virtualId).Mask(masked).GetObject()
@@ -165,7 +166,7 @@ func resourceIBMFirewallSharedCreate(d *schema.ResourceData, meta interface{}) e
 		d.SetId(fmt.Sprintf("%d", idd))
 
 		if err != nil {
-			return fmt.Errorf("Error during creation of hardware firewall: %s", err)
+			return diag.FromErr(fmt.Errorf("Error during creation of hardware firewall: %s", err))
 		}
 
 	}
@@ -214,9 +215,9 @@ func resourceIBMFirewallSharedCreate(d *schema.ResourceData, meta interface{}) e
 			NotFoundChecks: 24 * 60,
 		}
 
-		_, err = stateConf.WaitForState()
+		_, err = stateConf.WaitForStateContext(context)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		resultNew, err := service.Id(hardwareId).Mask(masked).GetObject()
@@ -225,16 +226,25 @@ func resourceIBMFirewallSharedCreate(d *schema.ResourceData, meta interface{}) e
 		d.SetId(fmt.Sprintf("%d", idd2))
 		log.Print(idd2)
 		if err != nil {
-			return fmt.Errorf("Error during creation of hardware firewall: %s", err)
+			return diag.FromErr(fmt.Errorf("Error during creation of hardware firewall: %s", err))
 		}
 
 	}
 	log.Println("[INFO] Creating hardware firewall shared")
 
-	return resourceIBMFirewallSharedRead(d, meta)
+	return resourceIBMFirewallSharedRead(context, d, meta)
 }
 
-func resourceIBMFirewallSharedRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFirewallSharedRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMFirewallSharedExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	firewall_type := (d.Get("firewall_type").(string))
@@ -247,25 +257,25 @@ func resourceIBMFirewallSharedRead(d *schema.ResourceData, meta interface{}) err
 	data, err := fservice.Id(fwID).Mask("billingItem.id").GetObject()
 	d.Set("billing_item_id", *data.BillingItem.Id)
 	if err != nil {
-		return fmt.Errorf("Error during creation of hardware firewall: %s", err)
+		return diag.FromErr(fmt.Errorf("Error during creation of hardware firewall: %s", err))
 	}
 
 	return nil
 }
 
 //detach hardware firewall from particular machine
-func resourceIBMFirewallSharedDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFirewallSharedDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	idd2 := (d.Get("billing_item_id")).(int)
 
 	success, err := services.GetBillingItemService(sess).Id(idd2).CancelService()
 	log.Print(success)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if !success {
-		return fmt.Errorf("SoftLayer reported an unsuccessful cancellation")
+		return diag.FromErr(fmt.Errorf("SoftLayer reported an unsuccessful cancellation"))
 	}
 	return nil
 }
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
@@ -11,6 +12,7 @@ import (
 	"strings"
 
 	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -23,12 +25,11 @@ const (
 
 func resourceIBMFunctionAction() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMFunctionActionCreate,
-		Read:     resourceIBMFunctionActionRead,
-		Update:   resourceIBMFunctionActionUpdate,
-		Delete:   resourceIBMFunctionActionDelete,
-		Exists:   resourceIBMFunctionActionExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMFunctionActionCreate,
+		ReadContext:   resourceIBMFunctionActionRead,
+		UpdateContext: resourceIBMFunctionActionUpdate,
+		DeleteContext: resourceIBMFunctionActionDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			funcActionName: {
@@ -218,20 +219,20 @@ func resourceIBMFuncActionValidator() *ResourceValidator {
 	return &ibmFuncActionResourceValidator
 }
 
-func resourceIBMFunctionActionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionActionCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bxSession, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	namespace := d.Get("namespace").(string)
 	wskClient, err := setupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 
 	}
 
@@ -241,7 +242,7 @@ func resourceIBMFunctionActionCreate(d *schema.ResourceData, meta interface{}) e
 	var qualifiedName = new(QualifiedName)
 
 	if qualifiedName, err = NewQualifiedName(name); err != nil {
-		return NewQualifiedNameError(name, err)
+		return diag.FromErr(NewQualifiedNameError(name, err))
 	}
 
 	payload := whisk.Action{
@@ -255,13 +256,13 @@ func resourceIBMFunctionActionCreate(d *schema.ResourceData, meta interface{}) e
 	userDefinedAnnotations := d.Get("user_defined_annotations").(string)
 	payload.Annotations, err = expandAnnotations(userDefinedAnnotations)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	userDefinedParameters := d.Get("user_defined_parameters").(string)
 	payload.Parameters, err = expandParameters(userDefinedParameters)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok := d.GetOk("limits"); ok {
@@ -277,18 +278,27 @@ func resourceIBMFunctionActionCreate(d *schema.ResourceData, meta interface{}) e
 	_, _, err = actionService.Insert(&payload, true)
 
 	if err != nil {
-		return fmt.Errorf("Error creating IBM Cloud Function Action: %s", err)
+		return diag.FromErr(fmt.Errorf("Error creating IBM Cloud Function Action: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", namespace, qualifiedName.GetEntityName()))
 
-	return resourceIBMFunctionActionRead(d, meta)
+	return resourceIBMFunctionActionRead(context, d, meta)
 }
 
-func resourceIBMFunctionActionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionActionRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMFunctionActionExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	parts, err := cfIdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	namespace := ""
@@ -304,23 +314,23 @@ func resourceIBMFunctionActionRead(d *schema.ResourceData, meta interface{}) err
 
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bxSession, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	wskClient, err := setupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 
 	}
 
 	actionService := wskClient.Actions
 	action, _, err := actionService.Get(actionID, true)
 	if err != nil {
-		return fmt.Errorf("Error retrieving IBM Cloud Function Action %s : %s", actionID, err)
+		return diag.FromErr(fmt.Errorf("Error retrieving IBM Cloud Function Action %s : %s", actionID, err))
 	}
 	d.Set("namespace", namespace)
 	d.Set("limits", flattenLimits(action.Limits))
@@ -330,13 +340,13 @@ func resourceIBMFunctionActionRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("action_id", action.Name)
 	annotations, err := flattenAnnotations(action.Annotations)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("annotations", annotations)
 	parameters, err := flattenParameters(action.Parameters)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set("parameters", parameters)
 
@@ -354,31 +364,31 @@ func resourceIBMFunctionActionRead(d *schema.ResourceData, meta interface{}) err
 
 		pkg, _, err := c.Packages.Get(pkgName)
 		if err != nil {
-			return fmt.Errorf("Error retrieving package IBM Cloud Function package %s : %s", pkgName, err)
+			return diag.FromErr(fmt.Errorf("Error retrieving package IBM Cloud Function package %s : %s", pkgName, err))
 		}
 
 		userAnnotations, err := flattenAnnotations(filterInheritedAnnotations(pkg.Annotations, action.Annotations))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		d.Set("user_defined_annotations", userAnnotations)
 		userParameters, err := flattenParameters(filterInheritedParameters(pkg.Parameters, action.Parameters))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		d.Set("user_defined_parameters", userParameters)
 	} else {
 		d.Set("name", action.Name)
 		userDefinedAnnotations, err := filterActionAnnotations(action.Annotations)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		d.Set("user_defined_annotations", userDefinedAnnotations)
 
 		userDefinedParameters, err := filterActionParameters(action.Parameters)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		d.Set("user_defined_parameters", userDefinedParameters)
 	}
@@ -394,10 +404,10 @@ func resourceIBMFunctionActionRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceIBMFunctionActionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionActionUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := cfIdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	namespace := parts[0]
@@ -405,17 +415,17 @@ func resourceIBMFunctionActionUpdate(d *schema.ResourceData, meta interface{}) e
 
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bxSession, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	wskClient, err := setupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 
 	}
 
@@ -424,7 +434,7 @@ func resourceIBMFunctionActionUpdate(d *schema.ResourceData, meta interface{}) e
 	var qualifiedName = new(QualifiedName)
 
 	if qualifiedName, err = NewQualifiedName(actionID); err != nil {
-		return NewQualifiedNameError(actionID, err)
+		return diag.FromErr(NewQualifiedNameError(actionID, err))
 	}
 
 	payload := whisk.Action{
@@ -443,7 +453,7 @@ func resourceIBMFunctionActionUpdate(d *schema.ResourceData, meta interface{}) e
 		var err error
 		payload.Parameters, err = expandParameters(d.Get("user_defined_parameters").(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		ischanged = true
 	}
@@ -452,7 +462,7 @@ func resourceIBMFunctionActionUpdate(d *schema.ResourceData, meta interface{}) e
 		var err error
 		payload.Annotations, err = expandAnnotations(d.Get("user_defined_annotations").(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		ischanged = true
 	}
@@ -473,17 +483,17 @@ func resourceIBMFunctionActionUpdate(d *schema.ResourceData, meta interface{}) e
 		log.Println("[INFO] Update IBM Cloud Function Action")
 		_, _, err = actionService.Insert(&payload, true)
 		if err != nil {
-			return fmt.Errorf("Error updating IBM Cloud Function Action: %s", err)
+			return diag.FromErr(fmt.Errorf("Error updating IBM Cloud Function Action: %s", err))
 		}
 	}
 
-	return resourceIBMFunctionActionRead(d, meta)
+	return resourceIBMFunctionActionRead(context, d, meta)
 }
 
-func resourceIBMFunctionActionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionActionDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := cfIdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	namespace := parts[0]
@@ -491,16 +501,16 @@ func resourceIBMFunctionActionDelete(d *schema.ResourceData, meta interface{}) e
 
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bxSession, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	wskClient, err := setupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 
 	}
 
@@ -508,7 +518,7 @@ func resourceIBMFunctionActionDelete(d *schema.ResourceData, meta interface{}) e
 
 	_, err = actionService.Delete(actionID)
 	if err != nil {
-		return fmt.Errorf("Error deleting IBM Cloud Function Action: %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting IBM Cloud Function Action: %s", err))
 	}
 
 	d.SetId("")
@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/IBM-Cloud/bluemix-go/api/functions"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -21,12 +23,11 @@ const (
 
 func resourceIBMFunctionNamespace() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMFunctionNamespaceCreate,
-		Read:     resourceIBMFunctionNamespaceRead,
-		Update:   resourceIBMFunctionNamespaceUpdate,
-		Delete:   resourceIBMFunctionNamespaceDelete,
-		Exists:   resourceIBMFunctionNamespaceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMFunctionNamespaceCreate,
+		ReadContext:   resourceIBMFunctionNamespaceRead,
+		UpdateContext: resourceIBMFunctionNamespaceUpdate,
+		DeleteContext: resourceIBMFunctionNamespaceDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			funcNamespaceName: {
@@ -77,10 +78,10 @@ func resourceIBMFuncNamespaceValidator() *ResourceValidator {
 	return &ibmFuncNamespaceResourceValidator
 }
 
-func resourceIBMFunctionNamespaceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionNamespaceCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	createNamespaceOptions := functions.CreateNamespaceOptions{}
@@ -99,19 +100,28 @@ func resourceIBMFunctionNamespaceCreate(d *schema.ResourceData, meta interface{}
 
 	namespace, err := functionNamespaceAPI.Namespaces().CreateNamespace(createNamespaceOptions)
 	if err != nil {
-		return fmt.Errorf("Error Creating Namespace: %s", err)
+		return diag.FromErr(fmt.Errorf("Error Creating Namespace: %s", err))
 	}
 
 	d.SetId(*namespace.ID)
 	log.Printf("[INFO] Created namespace (IAM) : %s", *namespace.Name)
 
-	return resourceIBMFunctionNamespaceRead(d, meta)
+	return resourceIBMFunctionNamespaceRead(context, d, meta)
 }
 
-func resourceIBMFunctionNamespaceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionNamespaceRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMFunctionNamespaceExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	id := d.Id()
@@ -146,10 +156,10 @@ func resourceIBMFunctionNamespaceRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceIBMFunctionNamespaceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionNamespaceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	nsClient, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ID := d.Id()
@@ -167,24 +177,24 @@ func resourceIBMFunctionNamespaceUpdate(d *schema.ResourceData, meta interface{}
 	updateNamespaceOptions.ID = &ID
 	namespace, err := nsClient.Namespaces().UpdateNamespace(updateNamespaceOptions)
 	if err != nil {
-		return fmt.Errorf("Error Updating Namespace: %s", err)
+		return diag.FromErr(fmt.Errorf("Error Updating Namespace: %s", err))
 	}
 
 	log.Printf("[INFO] Updated namespace (IAM) : %s", *namespace.Name)
 
-	return resourceIBMFunctionNamespaceRead(d, meta)
+	return resourceIBMFunctionNamespaceRead(context, d, meta)
 }
 
-func resourceIBMFunctionNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionNamespaceDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	nsClient, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ID := d.Id()
 	_, err = nsClient.Namespaces().DeleteNamespace(ID)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Namespace: %s", err)
+		return diag.FromErr(fmt.Errorf("Error Deleting Namespace: %s", err))
 	}
 
 	d.SetId("")
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
@@ -11,6 +12,7 @@ import (
 	"strings"
 
 	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -24,12 +26,11 @@ const (
 
 func resourceIBMFunctionPackage() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMFunctionPackageCreate,
-		Read:     resourceIBMFunctionPackageRead,
-		Update:   resourceIBMFunctionPackageUpdate,
-		Delete:   resourceIBMFunctionPackageDelete,
-		Exists:   resourceIBMFunctionPackageExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMFunctionPackageCreate,
+		ReadContext:   resourceIBMFunctionPackageRead,
+		UpdateContext: resourceIBMFunctionPackageUpdate,
+		DeleteContext: resourceIBMFunctionPackageDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			funcPkgNamespace: {
@@ -149,20 +150,20 @@ func resourceIBMFuncPackageValidator() *ResourceValidator {
 	return &ibmFuncPackageResourceValidator
 }
 
-func resourceIBMFunctionPackageCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionPackageCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bxSession, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	namespace := d.Get("namespace").(string)
 	wskClient, err := setupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 
 	}
 
@@ -173,7 +174,7 @@ func resourceIBMFunctionPackageCreate(d *schema.ResourceData, meta interface{})
 	var qualifiedName = new(QualifiedName)
 
 	if qualifiedName, err = NewQualifiedName(name); err != nil {
-		return NewQualifiedNameError(name, err)
+		return diag.FromErr(NewQualifiedNameError(name, err))
 	}
 
 	payload := whisk.Package{
@@ -184,13 +185,13 @@ func resourceIBMFunctionPackageCreate(d *schema.ResourceData, meta interface{})
 	userDefinedAnnotations := d.Get("user_defined_annotations").(string)
 	payload.Annotations, err = expandAnnotations(userDefinedAnnotations)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	userDefinedParameters := d.Get("user_defined_parameters").(string)
 	payload.Parameters, err = expandParameters(userDefinedParameters)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if publish, ok := d.GetOk("publish"); ok {
@@ -201,7 +202,7 @@ func resourceIBMFunctionPackageCreate(d *schema.ResourceData, meta interface{})
 	if v, ok := d.GetOk("bind_package_name"); ok {
 		var BindingQualifiedName = new(QualifiedName)
 		if BindingQualifiedName, err = NewQualifiedName(v.(string)); err != nil {
-			return NewQualifiedNameError(v.(string), err)
+			return diag.FromErr(NewQualifiedNameError(v.(string), err))
 		}
 		BindingPayload := whisk.Binding{
 			Name:      BindingQualifiedName.GetEntityName(),
@@ -213,18 +214,27 @@ func resourceIBMFunctionPackageCreate(d *schema.ResourceData, meta interface{})
 	log.Println("[INFO] Creating IBM CLoud Function package")
 	result, _, err := packageService.Insert(&payload, false)
 	if err != nil {
-		return fmt.Errorf("Error creating IBM CLoud Function package: %s", err)
+		return diag.FromErr(fmt.Errorf("Error creating IBM CLoud Function package: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", namespace, result.Name))
 
-	return resourceIBMFunctionPackageRead(d, meta)
+	return resourceIBMFunctionPackageRead(context, d, meta)
 }
 
-func resourceIBMFunctionPackageRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionPackageRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMFunctionPackageExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	parts, err := cfIdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	namespace := ""
@@ -240,23 +250,23 @@ func resourceIBMFunctionPackageRead(d *schema.ResourceData, meta interface{}) er
 
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bxSession, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	wskClient, err := setupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 
 	}
 	packageService := wskClient.Packages
 
 	pkg, _, err := packageService.Get(packageID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving IBM Cloud Function package %s : %s", packageID, err)
+		return diag.FromErr(fmt.Errorf("Error retrieving IBM Cloud Function package %s : %s", packageID, err))
 	}
 	d.Set("package_id", pkg.Name)
 	d.Set("name", pkg.Name)
@@ -265,12 +275,12 @@ func resourceIBMFunctionPackageRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("version", pkg.Version)
 	annotations, err := flattenAnnotations(pkg.Annotations)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set("annotations", annotations)
 	parameters, err := flattenParameters(pkg.Parameters)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set("parameters", parameters)
 	if isEmpty(*pkg.Binding) {
@@ -288,18 +298,18 @@ func resourceIBMFunctionPackageRead(d *schema.ResourceData, meta interface{}) er
 		})
 		bindedPkg, _, err := c.Packages.Get(pkg.Binding.Name)
 		if err != nil {
-			return fmt.Errorf("Error retrieving Binded IBM Cloud Function package %s : %s", pkg.Binding.Name, err)
+			return diag.FromErr(fmt.Errorf("Error retrieving Binded IBM Cloud Function package %s : %s", pkg.Binding.Name, err))
 		}
 
 		userAnnotations, err := flattenAnnotations(filterInheritedAnnotations(bindedPkg.Annotations, pkg.Annotations))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		d.Set("user_defined_annotations", userAnnotations)
 
 		userParameters, err := flattenParameters(filterInheritedParameters(bindedPkg.Parameters, pkg.Parameters))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		d.Set("user_defined_parameters", userParameters)
 	}
@@ -307,26 +317,26 @@ func resourceIBMFunctionPackageRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func resourceIBMFunctionPackageUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionPackageUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := cfIdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	namespace := parts[0]
 
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bxSession, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	wskClient, err := setupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 
 	}
 
@@ -335,7 +345,7 @@ func resourceIBMFunctionPackageUpdate(d *schema.ResourceData, meta interface{})
 	var qualifiedName = new(QualifiedName)
 
 	if qualifiedName, err = NewQualifiedName(d.Get("name").(string)); err != nil {
-		return NewQualifiedNameError(d.Get("name").(string), err)
+		return diag.FromErr(NewQualifiedNameError(d.Get("name").(string), err))
 	}
 
 	payload := whisk.Package{
@@ -353,7 +363,7 @@ func resourceIBMFunctionPackageUpdate(d *schema.ResourceData, meta interface{})
 		var err error
 		payload.Parameters, err = expandParameters(d.Get("user_defined_parameters").(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		ischanged = true
 	}
@@ -362,7 +372,7 @@ func resourceIBMFunctionPackageUpdate(d *schema.ResourceData, meta interface{})
 		var err error
 		payload.Annotations, err = expandAnnotations(d.Get("user_defined_annotations").(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		ischanged = true
 	}
@@ -371,17 +381,17 @@ func resourceIBMFunctionPackageUpdate(d *schema.ResourceData, meta interface{})
 		log.Println("[INFO] Update IBM Cloud Function Package")
 		_, _, err = packageService.Insert(&payload, true)
 		if err != nil {
-			return fmt.Errorf("Error updating IBM Cloud Function Package: %s", err)
+			return diag.FromErr(fmt.Errorf("Error updating IBM Cloud Function Package: %s", err))
 		}
 	}
 
-	return resourceIBMFunctionPackageRead(d, meta)
+	return resourceIBMFunctionPackageRead(context, d, meta)
 }
 
-func resourceIBMFunctionPackageDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionPackageDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := cfIdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	namespace := parts[0]
@@ -389,16 +399,16 @@ func resourceIBMFunctionPackageDelete(d *schema.ResourceData, meta interface{})
 
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bxSession, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	wskClient, err := setupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 
 	}
 
@@ -406,7 +416,7 @@ func resourceIBMFunctionPackageDelete(d *schema.ResourceData, meta interface{})
 
 	_, err = packageService.Delete(packageID)
 	if err != nil {
-		return fmt.Errorf("Error deleting IBM Cloud Function Package: %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting IBM Cloud Function Package: %s", err))
 	}
 
 	d.SetId("")
@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
 	"strings"
 
 	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -20,12 +22,11 @@ const (
 
 func resourceIBMFunctionRule() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMFunctionRuleCreate,
-		Read:     resourceIBMFunctionRuleRead,
-		Update:   resourceIBMFunctionRuleUpdate,
-		Delete:   resourceIBMFunctionRuleDelete,
-		Exists:   resourceIBMFunctionRuleExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMFunctionRuleCreate,
+		ReadContext:   resourceIBMFunctionRuleRead,
+		UpdateContext: resourceIBMFunctionRuleUpdate,
+		DeleteContext: resourceIBMFunctionRuleDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			funcRuleNamespace: {
@@ -125,20 +126,20 @@ func resourceIBMFuncRuleValidator() *ResourceValidator {
 	return &ibmFuncRuleResourceValidator
 }
 
-func resourceIBMFunctionRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionRuleCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bxSession, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	namespace := d.Get("namespace").(string)
 	wskClient, err := setupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 
 	}
 
@@ -148,7 +149,7 @@ func resourceIBMFunctionRuleCreate(d *schema.ResourceData, meta interface{}) err
 
 	var qualifiedName = new(QualifiedName)
 	if qualifiedName, err = NewQualifiedName(name); err != nil {
-		return NewQualifiedNameError(name, err)
+		return diag.FromErr(NewQualifiedNameError(name, err))
 	}
 	trigger := d.Get("trigger_name").(string)
 	action := d.Get("action_name").(string)
@@ -164,18 +165,27 @@ func resourceIBMFunctionRuleCreate(d *schema.ResourceData, meta interface{}) err
 	log.Println("[INFO] Creating IBM Cloud Function rule")
 	result, _, err := ruleService.Insert(&payload, false)
 	if err != nil {
-		return fmt.Errorf("Error creating IBM Cloud Function rule: %s", err)
+		return diag.FromErr(fmt.Errorf("Error creating IBM Cloud Function rule: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", namespace, result.Name))
 
-	return resourceIBMFunctionRuleRead(d, meta)
+	return resourceIBMFunctionRuleRead(context, d, meta)
 }
 
-func resourceIBMFunctionRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionRuleRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMFunctionRuleExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	parts, err := cfIdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	namespace := ""
@@ -191,24 +201,24 @@ func resourceIBMFunctionRuleRead(d *schema.ResourceData, meta interface{}) error
 
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bxSession, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	wskClient, err := setupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 
 	}
 
 	ruleService := wskClient.Rules
 	rule, _, err := ruleService.Get(ruleID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving IBM Cloud Function rule %s : %s", ruleID, err)
+		return diag.FromErr(fmt.Errorf("Error retrieving IBM Cloud Function rule %s : %s", ruleID, err))
 	}
 
 	d.Set("rule_id", rule.Name)
@@ -226,26 +236,26 @@ func resourceIBMFunctionRuleRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMFunctionRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionRuleUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bxSession, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := cfIdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	namespace := parts[0]
 	wskClient, err := setupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 
 	}
 
@@ -254,7 +264,7 @@ func resourceIBMFunctionRuleUpdate(d *schema.ResourceData, meta interface{}) err
 	var qualifiedName = new(QualifiedName)
 
 	if qualifiedName, err = NewQualifiedName(d.Get("name").(string)); err != nil {
-		return NewQualifiedNameError(d.Get("name").(string), err)
+		return diag.FromErr(NewQualifiedNameError(d.Get("name").(string), err))
 	}
 
 	payload := whisk.Rule{
@@ -279,38 +289,38 @@ func resourceIBMFunctionRuleUpdate(d *schema.ResourceData, meta interface{}) err
 		log.Println("[INFO] Update IBM Cloud Function Rule")
 		result, _, err := ruleService.Insert(&payload, true)
 		if err != nil {
-			return fmt.Errorf("Error updating IBM Cloud Function Rule: %s", err)
+			return diag.FromErr(fmt.Errorf("Error updating IBM Cloud Function Rule: %s", err))
 		}
 		_, _, err = ruleService.SetState(result.Name, "active")
 		if err != nil {
-			return fmt.Errorf("Error updating IBM Cloud Function Rule: %s", err)
+			return diag.FromErr(fmt.Errorf("Error updating IBM Cloud Function Rule: %s", err))
 		}
 	}
 
-	return resourceIBMFunctionRuleRead(d, meta)
+	return resourceIBMFunctionRuleRead(context, d, meta)
 }
 
-func resourceIBMFunctionRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionRuleDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bxSession, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := cfIdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	namespace := parts[0]
 	ruleID := parts[1]
 	wskClient, err := setupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 
 	}
 
@@ -318,7 +328,7 @@ func resourceIBMFunctionRuleDelete(d *schema.ResourceData, meta interface{}) err
 
 	_, err = ruleService.Delete(ruleID)
 	if err != nil {
-		return fmt.Errorf("Error deleting IBM Cloud Function Rule: %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting IBM Cloud Function Rule: %s", err))
 	}
 
 	d.SetId("")
@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
 
 	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -29,12 +31,11 @@ const (
 
 func resourceIBMFunctionTrigger() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMFunctionTriggerCreate,
-		Read:     resourceIBMFunctionTriggerRead,
-		Update:   resourceIBMFunctionTriggerUpdate,
-		Delete:   resourceIBMFunctionTriggerDelete,
-		Exists:   resourceIBMFunctionTriggerExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMFunctionTriggerCreate,
+		ReadContext:   resourceIBMFunctionTriggerRead,
+		UpdateContext: resourceIBMFunctionTriggerUpdate,
+		DeleteContext: resourceIBMFunctionTriggerDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			funcTriggerNamespace: {
@@ -181,20 +182,20 @@ func resourceIBMFuncTriggerValidator() *ResourceValidator {
 	return &ibmFuncTriggerResourceValidator
 }
 
-func resourceIBMFunctionTriggerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionTriggerCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bxSession, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	namespace := d.Get("namespace").(string)
 	wskClient, err := setupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 
 	}
 
@@ -206,7 +207,7 @@ func resourceIBMFunctionTriggerCreate(d *schema.ResourceData, meta interface{})
 	var qualifiedName = new(QualifiedName)
 
 	if qualifiedName, err = NewQualifiedName(name); err != nil {
-		return NewQualifiedNameError(name, err)
+		return diag.FromErr(NewQualifiedNameError(name, err))
 	}
 
 	payload := whisk.Trigger{
@@ -217,13 +218,13 @@ func resourceIBMFunctionTriggerCreate(d *schema.ResourceData, meta interface{})
 	userDefinedAnnotations := d.Get("user_defined_annotations").(string)
 	payload.Annotations, err = expandAnnotations(userDefinedAnnotations)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	userDefinedParameters := d.Get("user_defined_parameters").(string)
 	payload.Parameters, err = expandParameters(userDefinedParameters)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok := d.GetOk("feed"); ok {
@@ -241,7 +242,7 @@ func resourceIBMFunctionTriggerCreate(d *schema.ResourceData, meta interface{})
 	log.Println("[INFO] Creating IBM Cloud Function trigger")
 	result, _, err := triggerService.Insert(&payload, false)
 	if err != nil {
-		return fmt.Errorf("Error creating IBM Cloud Function trigger: %s", err)
+		return diag.FromErr(fmt.Errorf("Error creating IBM Cloud Function trigger: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", namespace, result.Name))
@@ -253,7 +254,7 @@ func resourceIBMFunctionTriggerCreate(d *schema.ResourceData, meta interface{})
 		var err error
 		feedParameters, err := expandParameters(parameters)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		for _, value := range feedParameters {
 			feedPayload[value.Key] = value.Value
@@ -263,9 +264,9 @@ func resourceIBMFunctionTriggerCreate(d *schema.ResourceData, meta interface{})
 		if feedQualifiedName, err = NewQualifiedName(actionName); err != nil {
 			_, _, delerr := triggerService.Delete(name)
 			if delerr != nil {
-				return fmt.Errorf("Error creating IBM Cloud Function trigger with feed: %s", err)
+				return diag.FromErr(fmt.Errorf("Error creating IBM Cloud Function trigger with feed: %s", err))
 			}
-			return NewQualifiedNameError(actionName, err)
+			return diag.FromErr(NewQualifiedNameError(actionName, err))
 		}
 
 		feedPayload[feedLifeCycleEvent] = feedCreate
@@ -286,22 +287,31 @@ func resourceIBMFunctionTriggerCreate(d *schema.ResourceData, meta interface{})
 		if err != nil {
 			_, _, delerr := triggerService.Delete(name)
 			if delerr != nil {
-				return fmt.Errorf("Error creating IBM Cloud Function trigger with feed: %s", err)
+				return diag.FromErr(fmt.Errorf("Error creating IBM Cloud Function trigger with feed: %s", err))
 			}
 			d.SetId("")
-			return fmt.Errorf("Error creating IBM Cloud Function trigger with feed: %s", err)
+			return diag.FromErr(fmt.Errorf("Error creating IBM Cloud Function trigger with feed: %s", err))
 		}
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", namespace, result.Name))
 
-	return resourceIBMFunctionTriggerRead(d, meta)
+	return resourceIBMFunctionTriggerRead(context, d, meta)
 }
 
-func resourceIBMFunctionTriggerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionTriggerRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMFunctionTriggerExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	parts, err := cfIdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	namespace := ""
@@ -317,17 +327,17 @@ func resourceIBMFunctionTriggerRead(d *schema.ResourceData, meta interface{}) er
 
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bxSession, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	wskClient, err := setupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 
 	}
 
@@ -335,7 +345,7 @@ func resourceIBMFunctionTriggerRead(d *schema.ResourceData, meta interface{}) er
 
 	trigger, _, err := triggerService.Get(triggerID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving IBM Cloud Function Trigger %s : %s", triggerID, err)
+		return diag.FromErr(fmt.Errorf("Error retrieving IBM Cloud Function Trigger %s : %s", triggerID, err))
 	}
 	d.Set("trigger_id", trigger.Name)
 	d.Set("namespace", namespace)
@@ -344,19 +354,19 @@ func resourceIBMFunctionTriggerRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("version", trigger.Version)
 	annotations, err := flattenAnnotations(trigger.Annotations)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set("annotations", annotations)
 	parameters, err := flattenParameters(trigger.Parameters)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set("parameters", parameters)
 	d.Set("user_defined_parameters", parameters)
 
 	userDefinedAnnotations, err := filterTriggerAnnotations(trigger.Annotations)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set("user_defined_annotations", userDefinedAnnotations)
 
@@ -369,25 +379,25 @@ func resourceIBMFunctionTriggerRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func resourceIBMFunctionTriggerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionTriggerUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bxSession, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := cfIdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	namespace := parts[0]
 	wskClient, err := setupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 
 	}
 
@@ -398,7 +408,7 @@ func resourceIBMFunctionTriggerUpdate(d *schema.ResourceData, meta interface{})
 	var qualifiedName = new(QualifiedName)
 
 	if qualifiedName, err = NewQualifiedName(name); err != nil {
-		return NewQualifiedNameError(name, err)
+		return diag.FromErr(NewQualifiedNameError(name, err))
 	}
 
 	payload := whisk.Trigger{
@@ -411,7 +421,7 @@ func resourceIBMFunctionTriggerUpdate(d *schema.ResourceData, meta interface{})
 		var err error
 		payload.Parameters, err = expandParameters(d.Get("user_defined_parameters").(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		ischanged = true
 	}
@@ -420,7 +430,7 @@ func resourceIBMFunctionTriggerUpdate(d *schema.ResourceData, meta interface{})
 		var err error
 		payload.Annotations, err = expandAnnotations(d.Get("user_defined_annotations").(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		ischanged = true
 	}
@@ -430,34 +440,34 @@ func resourceIBMFunctionTriggerUpdate(d *schema.ResourceData, meta interface{})
 
 		_, _, err = triggerService.Insert(&payload, true)
 		if err != nil {
-			return fmt.Errorf("Error updating IBM Cloud Function Trigger: %s", err)
+			return diag.FromErr(fmt.Errorf("Error updating IBM Cloud Function Trigger: %s", err))
 		}
 	}
 
-	return resourceIBMFunctionTriggerRead(d, meta)
+	return resourceIBMFunctionTriggerRead(context, d, meta)
 }
 
-func resourceIBMFunctionTriggerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionTriggerDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	bxSession, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := cfIdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	namespace := parts[0]
 	triggerID := parts[1]
 
 	wskClient, err := setupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 
 	}
 
@@ -465,11 +475,11 @@ func resourceIBMFunctionTriggerDelete(d *schema.ResourceData, meta interface{})
 	var qualifiedName = new(QualifiedName)
 	fmt.Println(qualifiedName)
 	if qualifiedName, err = NewQualifiedName(triggerID); err != nil {
-		return NewQualifiedNameError(triggerID, err)
+		return diag.FromErr(NewQualifiedNameError(triggerID, err))
 	}
 	trigger, _, err := triggerService.Get(triggerID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving IBM Cloud Function Trigger %s : %s", triggerID, err)
+		return diag.FromErr(fmt.Errorf("Error retrieving IBM Cloud Function Trigger %s : %s", triggerID, err))
 	}
 	found := trigger.Annotations.FindKeyValue("feed")
 	if found >= 0 {
@@ -477,7 +487,7 @@ func resourceIBMFunctionTriggerDelete(d *schema.ResourceData, meta interface{})
 		var feedQualifiedName = new(QualifiedName)
 
 		if feedQualifiedName, err = NewQualifiedName(actionName); err != nil {
-			return NewQualifiedNameError(actionName, err)
+			return diag.FromErr(NewQualifiedNameError(actionName, err))
 		}
 
 		feedPayload := map[string]interface{}{
@@ -498,14 +508,14 @@ func resourceIBMFunctionTriggerDelete(d *schema.ResourceData, meta interface{})
 		actionService := c.Actions
 		_, _, err = actionService.Invoke(feedQualifiedName.GetEntityName(), feedPayload, true, true)
 		if err != nil {
-			return fmt.Errorf("Error deleting IBM Cloud Function trigger with feed: %s", err)
+			return diag.FromErr(fmt.Errorf("Error deleting IBM Cloud Function trigger with feed: %s", err))
 
 		}
 	}
 
 	_, _, err = triggerService.Delete(triggerID)
 	if err != nil {
-		return fmt.Errorf("Error deleting IBM Cloud Function Trigger: %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting IBM Cloud Function Trigger: %s", err))
 	}
 
 	d.SetId("")
@@ -4,23 +4,23 @@
 package ibm
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/IBM-Cloud/bluemix-go/api/iamuum/iamuumv2"
 	"github.com/IBM-Cloud/bluemix-go/models"
 
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMIAMAccessGroup() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMIAMAccessGroupCreate,
-		Read:     resourceIBMIAMAccessGroupRead,
-		Update:   resourceIBMIAMAccessGroupUpdate,
-		Delete:   resourceIBMIAMAccessGroupDelete,
-		Exists:   resourceIBMIAMAccessGroupExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMIAMAccessGroupCreate,
+		ReadContext:   resourceIBMIAMAccessGroupRead,
+		UpdateContext: resourceIBMIAMAccessGroupUpdate,
+		DeleteContext: resourceIBMIAMAccessGroupDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -50,10 +50,10 @@ func resourceIBMIAMAccessGroup() *schema.Resource {
 	}
 }
 
-func resourceIBMIAMAccessGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAccessGroupCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamuumClient, err := meta.(ClientSession).IAMUUMAPIV2()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	request := models.AccessGroupV2{
@@ -68,29 +68,38 @@ func resourceIBMIAMAccessGroupCreate(d *schema.ResourceData, meta interface{}) e
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	agrp, err := iamuumClient.AccessGroup().Create(request, userDetails.userAccount)
 	if err != nil {
-		return fmt.Errorf("Error creating access group: %s", err)
+		return diag.FromErr(apiErrorf("iam", err, nil, "Error creating access group"))
 	}
 
 	d.SetId(agrp.ID)
 
-	return resourceIBMIAMAccessGroupRead(d, meta)
+	return resourceIBMIAMAccessGroupRead(context, d, meta)
 }
 
-func resourceIBMIAMAccessGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAccessGroupRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMIAMAccessGroupExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	iamuumClient, err := meta.(ClientSession).IAMUUMAPIV2()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	agrpID := d.Id()
 
 	agrp, version, err := iamuumClient.AccessGroup().Get(agrpID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving access group: %s", err)
+		return diag.FromErr(apiErrorf("iam", err, nil, "Error retrieving access group"))
 	}
 
 	d.Set("name", agrp.Name)
@@ -100,11 +109,11 @@ func resourceIBMIAMAccessGroupRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceIBMIAMAccessGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAccessGroupUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	iamuumClient, err := meta.(ClientSession).IAMUUMAPIV2()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	agrpID := d.Id()
 
@@ -124,25 +133,25 @@ func resourceIBMIAMAccessGroupUpdate(d *schema.ResourceData, meta interface{}) e
 	if hasChange {
 		_, err = iamuumClient.AccessGroup().Update(agrpID, updateReq, d.Get("version").(string))
 		if err != nil {
-			return fmt.Errorf("Error updating access group: %s", err)
+			return diag.FromErr(apiErrorf("iam", err, nil, "Error updating access group"))
 		}
 	}
 
-	return resourceIBMIAMAccessGroupRead(d, meta)
+	return resourceIBMIAMAccessGroupRead(context, d, meta)
 
 }
 
-func resourceIBMIAMAccessGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAccessGroupDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamuumClient, err := meta.(ClientSession).IAMUUMAPIV2()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	agID := d.Id()
 
 	err = iamuumClient.AccessGroup().Delete(agID, true)
 	if err != nil {
-		return fmt.Errorf("Error deleting access group: %s", err)
+		return diag.FromErr(apiErrorf("iam", err, nil, "Error deleting access group"))
 	}
 
 	d.SetId("")
@@ -164,7 +173,7 @@ func resourceIBMIAMAccessGroupExists(d *schema.ResourceData, meta interface{}) (
 				return false, nil
 			}
 		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
+		return false, apiErrorf("iam", err, nil, "Error communicating with the API")
 	}
 
 	return agrp.ID == agID, nil
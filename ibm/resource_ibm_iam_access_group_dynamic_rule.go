@@ -4,23 +4,24 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/IBM-Cloud/bluemix-go/api/iamuum/iamuumv2"
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMIAMDynamicRule() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMIAMDynamicRuleCreate,
-		Read:     resourceIBMIAMDynamicRuleRead,
-		Update:   resourceIBMIAMDynamicRuleUpdate,
-		Delete:   resourceIBMIAMDynamicRuleDelete,
-		Exists:   resourceIBMIAMDynamicRuleExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMIAMDynamicRuleCreate,
+		ReadContext:   resourceIBMIAMDynamicRuleRead,
+		UpdateContext: resourceIBMIAMDynamicRuleUpdate,
+		DeleteContext: resourceIBMIAMDynamicRuleDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"access_group_id": {
@@ -76,10 +77,10 @@ func resourceIBMIAMDynamicRule() *schema.Resource {
 	}
 }
 
-func resourceIBMIAMDynamicRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMDynamicRuleCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamuumClient, err := meta.(ClientSession).IAMUUMAPIV2()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grpID := d.Get("access_group_id").(string)
@@ -111,23 +112,32 @@ func resourceIBMIAMDynamicRuleCreate(d *schema.ResourceData, meta interface{}) e
 
 	response, err := iamuumClient.DynamicRule().Create(grpID, createRuleReq)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	ruleID := response.RuleID
 	d.SetId(fmt.Sprintf("%s/%s", grpID, ruleID))
 
-	return resourceIBMIAMDynamicRuleRead(d, meta)
+	return resourceIBMIAMDynamicRuleRead(context, d, meta)
 }
 
-func resourceIBMIAMDynamicRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMDynamicRuleRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMIAMDynamicRuleExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	iamuumClient, err := meta.(ClientSession).IAMUUMAPIV2()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grpID := parts[0]
@@ -135,7 +145,7 @@ func resourceIBMIAMDynamicRuleRead(d *schema.ResourceData, meta interface{}) err
 
 	rules, _, err := iamuumClient.DynamicRule().Get(grpID, ruleID)
 	if err != nil && !strings.Contains(err.Error(), "404") {
-		return fmt.Errorf("Error retrieving access group Rules: %s", err)
+		return diag.FromErr(apiErrorf("iam", err, nil, "Error retrieving access group Rules"))
 	} else if err != nil && strings.Contains(err.Error(), "404") {
 		d.SetId("")
 
@@ -152,22 +162,22 @@ func resourceIBMIAMDynamicRuleRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceIBMIAMDynamicRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMDynamicRuleUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	iamuumClient, err := meta.(ClientSession).IAMUUMAPIV2()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grpID := parts[0]
 	ruleID := parts[1]
 	_, etag, err := iamuumClient.DynamicRule().Get(grpID, ruleID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving access group Rules: %s", err)
+		return diag.FromErr(apiErrorf("iam", err, nil, "Error retrieving access group Rules"))
 	}
 
 	name := d.Get("name").(string)
@@ -197,22 +207,22 @@ func resourceIBMIAMDynamicRuleUpdate(d *schema.ResourceData, meta interface{}) e
 	}
 	_, err = iamuumClient.DynamicRule().Replace(grpID, ruleID, createRuleReq, etag)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMIAMDynamicRuleRead(d, meta)
+	return resourceIBMIAMDynamicRuleRead(context, d, meta)
 
 }
 
-func resourceIBMIAMDynamicRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMDynamicRuleDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamuumClient, err := meta.(ClientSession).IAMUUMAPIV2()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grpID := parts[0]
@@ -220,7 +230,7 @@ func resourceIBMIAMDynamicRuleDelete(d *schema.ResourceData, meta interface{}) e
 
 	err = iamuumClient.DynamicRule().Delete(grpID, ruleID)
 	if err != nil && !strings.Contains(err.Error(), "404") {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
@@ -248,7 +258,7 @@ func resourceIBMIAMDynamicRuleExists(d *schema.ResourceData, meta interface{}) (
 				return false, nil
 			}
 		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
+		return false, apiErrorf("iam", err, nil, "Error communicating with the API")
 	}
 
 	return rules.AccessGroupID == grpID, nil
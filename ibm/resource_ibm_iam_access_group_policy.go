@@ -4,22 +4,23 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMIAMAccessGroupPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMIAMAccessGroupPolicyCreate,
-		Read:   resourceIBMIAMAccessGroupPolicyRead,
-		Update: resourceIBMIAMAccessGroupPolicyUpdate,
-		Delete: resourceIBMIAMAccessGroupPolicyDelete,
-		Exists: resourceIBMIAMAccessGroupPolicyExists,
+		CreateContext: resourceIBMIAMAccessGroupPolicyCreate,
+		ReadContext:   resourceIBMIAMAccessGroupPolicyRead,
+		UpdateContext: resourceIBMIAMAccessGroupPolicyUpdate,
+		DeleteContext: resourceIBMIAMAccessGroupPolicyDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				resources, resourceAttributes, err := importAccessGroupPolicy(d, meta)
@@ -149,23 +150,23 @@ func resourceIBMIAMAccessGroupPolicy() *schema.Resource {
 	}
 }
 
-func resourceIBMIAMAccessGroupPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAccessGroupPolicyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	accessGroupId := d.Get("access_group_id").(string)
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var policyOptions iampolicymanagementv1.CreatePolicyOptions
 	policyOptions, err = generatePolicyOptions(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Keep configuring the policy options by adding subject part
@@ -196,7 +197,7 @@ func resourceIBMIAMAccessGroupPolicyCreate(d *schema.ResourceData, meta interfac
 
 	accessGroupPolicy, res, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
 	if err != nil || accessGroupPolicy == nil {
-		return fmt.Errorf("Error creating access group policy: %s\n%s", err, res)
+		return diag.FromErr(apiErrorf("iam", err, res, "Error creating access group policy"))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", accessGroupId, *accessGroupPolicy.ID))
@@ -221,22 +222,30 @@ func resourceIBMIAMAccessGroupPolicyCreate(d *schema.ResourceData, meta interfac
 		_, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
 	}
 	if err != nil {
-		return fmt.Errorf("Error fetching access group policy: %s\n%s", err, res)
+		return diag.FromErr(apiErrorf("iam", err, res, "Error fetching access group policy"))
 	}
 
-	return resourceIBMIAMAccessGroupPolicyRead(d, meta)
+	return resourceIBMIAMAccessGroupPolicyRead(context, d, meta)
 }
 
-func resourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAccessGroupPolicyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMIAMAccessGroupPolicyExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
 
 	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	accessGroupId := parts[0]
 	accessGroupPolicyId := parts[1]
@@ -247,12 +256,12 @@ func resourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interface{
 
 	accessGroupPolicy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
 	if err != nil {
-		return fmt.Errorf("Error retrieving access group policy: %s\n%s", err, res)
+		return diag.FromErr(apiErrorf("iam", err, res, "Error retrieving access group policy"))
 	}
 
 	retrievedAttribute := getSubjectAttribute("access_group_id", accessGroupPolicy.Subjects[0])
 	if accessGroupId != *retrievedAttribute {
-		return fmt.Errorf("Policy %s does not belong to access group %s, retrievedAttr: %s", accessGroupPolicyId, accessGroupId, *retrievedAttribute)
+		return diag.FromErr(fmt.Errorf("Policy %s does not belong to access group %s, retrievedAttr: %s", accessGroupPolicyId, accessGroupId, *retrievedAttribute))
 	}
 
 	d.Set("access_group_id", accessGroupId)
@@ -281,29 +290,29 @@ func resourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceIBMIAMAccessGroupPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAccessGroupPolicyUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") {
 		parts, err := idParts(d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		accessGroupId := parts[0]
 		accessGroupPolicyId := parts[1]
 
 		userDetails, err := meta.(ClientSession).BluemixUserDetails()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		var policyOptions iampolicymanagementv1.CreatePolicyOptions
 		policyOptions, err = generatePolicyOptions(d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		accessGroupIdSubject := &iampolicymanagementv1.PolicySubject{
@@ -335,22 +344,22 @@ func resourceIBMIAMAccessGroupPolicyUpdate(d *schema.ResourceData, meta interfac
 
 		_, res, err := iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
 		if err != nil {
-			return fmt.Errorf("Error updating access group policy: %s\n%s", err, res)
+			return diag.FromErr(apiErrorf("iam", err, res, "Error updating access group policy"))
 		}
 	}
 
-	return resourceIBMIAMAccessGroupPolicyRead(d, meta)
+	return resourceIBMIAMAccessGroupPolicyRead(context, d, meta)
 }
 
-func resourceIBMIAMAccessGroupPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAccessGroupPolicyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	accessGroupPolicyId := parts[1]
@@ -361,7 +370,7 @@ func resourceIBMIAMAccessGroupPolicyDelete(d *schema.ResourceData, meta interfac
 
 	res, err := iamPolicyManagementClient.DeletePolicy(deletePolicyOptions)
 	if err != nil {
-		return fmt.Errorf("Error deleting access group policy: %s\n%s", err, res)
+		return diag.FromErr(apiErrorf("iam", err, res, "Error deleting access group policy"))
 	}
 
 	d.SetId("")
@@ -390,7 +399,7 @@ func resourceIBMIAMAccessGroupPolicyExists(d *schema.ResourceData, meta interfac
 		if res != nil && res.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error communicating with the API: %s\n%s", err, res)
+		return false, apiErrorf("iam", err, res, "Error communicating with the API")
 	}
 
 	tempID := fmt.Sprintf("%s/%s", *getSubjectAttribute("access_group_id", accessGroupPolicy.Subjects[0]), *accessGroupPolicy.ID)
@@ -416,7 +425,7 @@ func importAccessGroupPolicy(d *schema.ResourceData, meta interface{}) (interfac
 
 	accessGroupPolicy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
 	if err != nil {
-		return nil, nil, fmt.Errorf("Error retrieving access group policy: %s\n%s", err, res)
+		return nil, nil, apiErrorf("iam", err, res, "Error retrieving access group policy")
 	}
 
 	resources := flattenPolicyResource(accessGroupPolicy.Resources)
@@ -135,7 +135,7 @@ func resourceIbmIamApiKeyCreate(context context.Context, d *schema.ResourceData,
 	apiKey, response, err := iamIdentityClient.CreateAPIKey(createApiKeyOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateApiKey failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return diag.FromErr(apiErrorf("iam", err, response, "Error creating API key"))
 	}
 
 	d.SetId(*apiKey.ID)
@@ -167,7 +167,7 @@ func resourceIbmIamApiKeyRead(context context.Context, d *schema.ResourceData, m
 			return nil
 		}
 		log.Printf("[DEBUG] GetApiKey failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return diag.FromErr(apiErrorf("iam", err, response, "Error getting API key (%s)", d.Id()))
 	}
 
 	if err = d.Set("name", apiKey.Name); err != nil {
@@ -230,7 +230,7 @@ func resourceIbmIamApiKeyUpdate(context context.Context, d *schema.ResourceData,
 	_, response, err := iamIdentityClient.UpdateAPIKey(updateApiKeyOptions)
 	if err != nil {
 		log.Printf("[DEBUG] UpdateApiKey failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return diag.FromErr(apiErrorf("iam", err, response, "Error updating API key (%s)", d.Id()))
 	}
 
 	return resourceIbmIamApiKeyRead(context, d, meta)
@@ -249,7 +249,7 @@ func resourceIbmIamApiKeyDelete(context context.Context, d *schema.ResourceData,
 	response, err := iamIdentityClient.DeleteAPIKey(deleteApiKeyOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteApiKey failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return diag.FromErr(apiErrorf("iam", err, response, "Error deleting API key (%s)", d.Id()))
 	}
 
 	d.SetId("")
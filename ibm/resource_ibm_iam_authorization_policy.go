@@ -4,7 +4,7 @@
 package ibm
 
 import (
-	"fmt"
+	"context"
 	"log"
 
 	"github.com/IBM-Cloud/bluemix-go/models"
@@ -12,17 +12,17 @@ import (
 	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
 
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMIAMAuthorizationPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMIAMAuthorizationPolicyCreate,
-		Read:     resourceIBMIAMAuthorizationPolicyRead,
-		Update:   resourceIBMIAMAuthorizationPolicyUpdate,
-		Delete:   resourceIBMIAMAuthorizationPolicyDelete,
-		Exists:   resourceIBMIAMAuthorizationPolicyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMIAMAuthorizationPolicyCreate,
+		ReadContext:   resourceIBMIAMAuthorizationPolicyRead,
+		UpdateContext: resourceIBMIAMAuthorizationPolicyUpdate,
+		DeleteContext: resourceIBMIAMAuthorizationPolicyDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"source_service_name": {
@@ -104,18 +104,18 @@ func resourceIBMIAMAuthorizationPolicy() *schema.Resource {
 	}
 }
 
-func resourceIBMIAMAuthorizationPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAuthorizationPolicyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sourceServiceName := d.Get("source_service_name").(string)
 	targetServiceName := d.Get("target_service_name").(string)
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	iampapClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	sourceServiceAccount := userDetails.userAccount
@@ -203,7 +203,7 @@ func resourceIBMIAMAuthorizationPolicyCreate(d *schema.ResourceData, meta interf
 
 	roles, err := getAuthorizationRolesByName(expandStringList(d.Get("roles").([]interface{})), sourceServiceName, targetServiceName, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	createPolicyOptions := iampapClient.NewCreatePolicyOptions(
@@ -215,19 +215,27 @@ func resourceIBMIAMAuthorizationPolicyCreate(d *schema.ResourceData, meta interf
 	authPolicy, _, err := iampapClient.CreatePolicy(createPolicyOptions)
 
 	if err != nil {
-		return fmt.Errorf("Error creating authorization policy: %s", err)
+		return diag.FromErr(apiErrorf("iam", err, nil, "Error creating authorization policy"))
 	}
 
 	d.SetId(*authPolicy.ID)
 
-	return resourceIBMIAMAuthorizationPolicyRead(d, meta)
+	return resourceIBMIAMAuthorizationPolicyRead(context, d, meta)
 }
 
-func resourceIBMIAMAuthorizationPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAuthorizationPolicyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMIAMAuthorizationPolicyExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
 
 	iampapClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	getPolicyOptions := &iampolicymanagementv1.GetPolicyOptions{
@@ -236,7 +244,7 @@ func resourceIBMIAMAuthorizationPolicyRead(d *schema.ResourceData, meta interfac
 
 	authorizationPolicy, _, err := iampapClient.GetPolicy(getPolicyOptions)
 	if err != nil {
-		return fmt.Errorf("Error retrieving authorizationPolicy: %s", err)
+		return diag.FromErr(apiErrorf("iam", err, nil, "Error retrieving authorizationPolicy"))
 	}
 	roles := make([]string, len(authorizationPolicy.Roles))
 	for i, role := range authorizationPolicy.Roles {
@@ -258,14 +266,14 @@ func resourceIBMIAMAuthorizationPolicyRead(d *schema.ResourceData, meta interfac
 }
 
 // Returns nil, because ibmcloud iam cli authoirization policy does not have an update command
-func resourceIBMIAMAuthorizationPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAuthorizationPolicyUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return nil
 }
 
-func resourceIBMIAMAuthorizationPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAuthorizationPolicyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iampapClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	authorizationPolicyID := d.Id()
@@ -300,7 +308,7 @@ func resourceIBMIAMAuthorizationPolicyExists(d *schema.ResourceData, meta interf
 				return false, nil
 			}
 		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
+		return false, apiErrorf("iam", err, nil, "Error communicating with the API")
 	}
 
 	return *authorizationPolicy.ID == d.Id(), nil
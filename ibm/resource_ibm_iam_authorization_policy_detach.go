@@ -4,18 +4,18 @@
 package ibm
 
 import (
-	"fmt"
+	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMIAMAuthorizationPolicyDetach() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMIAMAuthorizationPolicyDetachCreate,
-		Read:   resourceIBMIAMAuthorizationPolicyDetachRead,
-		Delete: resourceIBMIAMAuthorizationPolicyDetachDelete,
-		Exists: resourceIBMIAMAuthorizationPolicyDetachExists,
+		CreateContext: resourceIBMIAMAuthorizationPolicyDetachCreate,
+		ReadContext:   resourceIBMIAMAuthorizationPolicyDetachRead,
+		DeleteContext: resourceIBMIAMAuthorizationPolicyDetachDelete,
 
 		Schema: map[string]*schema.Schema{
 			"authorization_policy_id": {
@@ -28,10 +28,10 @@ func resourceIBMIAMAuthorizationPolicyDetach() *schema.Resource {
 	}
 }
 
-func resourceIBMIAMAuthorizationPolicyDetachCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAuthorizationPolicyDetachCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iampapClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	policyID := d.Get("authorization_policy_id").(string)
 
@@ -40,19 +40,28 @@ func resourceIBMIAMAuthorizationPolicyDetachCreate(d *schema.ResourceData, meta
 	)
 	_, err = iampapClient.DeletePolicy(deletePolicyOptions)
 	if err != nil {
-		return fmt.Errorf("Error detaching authorization policy: %s", err)
+		return diag.FromErr(apiErrorf("iam", err, nil, "Error detaching authorization policy"))
 	}
 
 	d.SetId(time.Now().UTC().String())
 
-	return resourceIBMIAMAuthorizationPolicyDetachRead(d, meta)
+	return resourceIBMIAMAuthorizationPolicyDetachRead(context, d, meta)
 }
 
-func resourceIBMIAMAuthorizationPolicyDetachRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAuthorizationPolicyDetachRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMIAMAuthorizationPolicyDetachExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	return nil
 }
 
-func resourceIBMIAMAuthorizationPolicyDetachDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAuthorizationPolicyDetachDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	d.SetId("")
 
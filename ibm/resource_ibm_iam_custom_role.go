@@ -4,10 +4,11 @@
 package ibm
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
 	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -21,12 +22,11 @@ const (
 
 func resourceIBMIAMCustomRole() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMIAMCustomRoleCreate,
-		Read:     resourceIBMIAMCustomRoleRead,
-		Update:   resourceIBMIAMCustomRoleUpdate,
-		Delete:   resourceIBMIAMCustomRoleDelete,
-		Exists:   resourceIBMIAMCustomRoleExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMIAMCustomRoleCreate,
+		ReadContext:   resourceIBMIAMCustomRoleRead,
+		UpdateContext: resourceIBMIAMCustomRoleUpdate,
+		DeleteContext: resourceIBMIAMCustomRoleDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			iamCRDisplayName: {
@@ -120,10 +120,10 @@ func resourceIBMIAMCustomRoleValidator() *ResourceValidator {
 	return &ibmIAMCustomRoleResourceValidator
 }
 
-func resourceIBMIAMCustomRoleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMCustomRoleCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	displayName := d.Get(iamCRDisplayName).(string)
@@ -134,7 +134,7 @@ func resourceIBMIAMCustomRoleCreate(d *schema.ResourceData, meta interface{}) er
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	roleOptions := &iampolicymanagementv1.CreateRoleOptions{
@@ -148,18 +148,27 @@ func resourceIBMIAMCustomRoleCreate(d *schema.ResourceData, meta interface{}) er
 
 	role, response, err := iamPolicyManagementClient.CreateRole(roleOptions)
 	if err != nil || role == nil {
-		return fmt.Errorf("Error creating Custom Roles: %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("iam", err, response, "Error creating Custom Roles"))
 	}
 
 	d.SetId(*role.ID)
 
-	return resourceIBMIAMCustomRoleRead(d, meta)
+	return resourceIBMIAMCustomRoleRead(context, d, meta)
 }
 
-func resourceIBMIAMCustomRoleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMCustomRoleRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMIAMCustomRoleExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	roleID := d.Id()
@@ -173,7 +182,7 @@ func resourceIBMIAMCustomRoleRead(d *schema.ResourceData, meta interface{}) erro
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error retrieving Custom Roles: %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("iam", err, response, "Error retrieving Custom Roles"))
 	}
 
 	d.Set(iamCRDisplayName, role.DisplayName)
@@ -187,7 +196,7 @@ func resourceIBMIAMCustomRoleRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set(ResourceCRN, role.CRN)
 	rcontroller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set(ResourceControllerURL, rcontroller+"/iam/roles")
@@ -195,11 +204,11 @@ func resourceIBMIAMCustomRoleRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceIBMIAMCustomRoleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMCustomRoleUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	roleID := d.Id()
 
@@ -218,7 +227,7 @@ func resourceIBMIAMCustomRoleUpdate(d *schema.ResourceData, meta interface{}) er
 				d.SetId("")
 				return nil
 			}
-			return fmt.Errorf("Error retrieving Custom Roles: %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("iam", err, response, "Error retrieving Custom Roles"))
 		}
 
 		roleETag := response.Headers.Get("ETag")
@@ -232,17 +241,17 @@ func resourceIBMIAMCustomRoleUpdate(d *schema.ResourceData, meta interface{}) er
 
 		_, response, err = iamPolicyManagementClient.UpdateRole(roleUpdateOptions)
 		if err != nil {
-			return fmt.Errorf("Error updating Custom Roles: %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("iam", err, response, "Error updating Custom Roles"))
 		}
 	}
 
-	return resourceIBMIAMCustomRoleRead(d, meta)
+	return resourceIBMIAMCustomRoleRead(context, d, meta)
 }
 
-func resourceIBMIAMCustomRoleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMCustomRoleDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	roleID := d.Id()
@@ -252,7 +261,7 @@ func resourceIBMIAMCustomRoleDelete(d *schema.ResourceData, meta interface{}) er
 
 	response, err := iamPolicyManagementClient.DeleteRole(roleDeleteOptions)
 	if err != nil && !strings.Contains(err.Error(), "404") {
-		return fmt.Errorf("Error deleting Custom Roles: %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("iam", err, response, "Error deleting Custom Roles"))
 	}
 
 	d.SetId("")
@@ -276,7 +285,7 @@ func resourceIBMIAMCustomRoleExists(d *schema.ResourceData, meta interface{}) (b
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error retrieving Custom Roles: %s\n%s", err, response)
+		return false, apiErrorf("iam", err, response, "Error retrieving Custom Roles")
 	}
 
 	return *role.ID == roleID, nil
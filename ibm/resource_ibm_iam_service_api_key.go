@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -11,18 +12,18 @@ import (
 	"strconv"
 
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	homedir "github.com/mitchellh/go-homedir"
 )
 
 func resourceIBMIAMServiceAPIKey() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMIAMServiceAPIkeyCreate,
-		Read:     resourceIBMIAMServiceAPIKeyRead,
-		Update:   resourceIBMIAMServiceAPIKeyUpdate,
-		Delete:   resourceIBMIAMServiceAPIKeyDelete,
-		Exists:   resourceIBMIAMServiceAPIKeyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMIAMServiceAPIkeyCreate,
+		ReadContext:   resourceIBMIAMServiceAPIKeyRead,
+		UpdateContext: resourceIBMIAMServiceAPIKeyUpdate,
+		DeleteContext: resourceIBMIAMServiceAPIKeyDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -122,10 +123,10 @@ type APIKey struct {
 	Locked      bool
 }
 
-func resourceIBMIAMServiceAPIkeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMServiceAPIkeyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	name := d.Get("name").(string)
@@ -143,7 +144,7 @@ func resourceIBMIAMServiceAPIkeyCreate(d *schema.ResourceData, meta interface{})
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	createAPIKeyOptions.AccountID = &userDetails.userAccount
 
@@ -164,7 +165,7 @@ func resourceIBMIAMServiceAPIkeyCreate(d *schema.ResourceData, meta interface{})
 
 	apiKey, response, err := iamIdentityClient.CreateAPIKey(createAPIKeyOptions)
 	if err != nil || apiKey == nil {
-		return fmt.Errorf("[DEBUG] Service API Key creation Error: %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("iam", err, response, "Error creating Service API Key"))
 	}
 
 	d.SetId(*apiKey.ID)
@@ -176,13 +177,22 @@ func resourceIBMIAMServiceAPIkeyCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	return resourceIBMIAMServiceAPIKeyRead(d, meta)
+	return resourceIBMIAMServiceAPIKeyRead(context, d, meta)
 }
 
-func resourceIBMIAMServiceAPIKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMServiceAPIKeyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMIAMServiceAPIKeyExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	apiKeyID := d.Id()
 
@@ -196,7 +206,7 @@ func resourceIBMIAMServiceAPIKeyRead(d *schema.ResourceData, meta interface{}) e
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[DEBUG] Error retrieving Service API Key: %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("iam", err, response, "Error retrieving Service API Key"))
 	}
 	if apiKey.Name != nil {
 		d.Set("name", *apiKey.Name)
@@ -235,11 +245,11 @@ func resourceIBMIAMServiceAPIKeyRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceIBMIAMServiceAPIKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMServiceAPIKeyUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	apiKeyID := d.Id()
 
@@ -249,7 +259,7 @@ func resourceIBMIAMServiceAPIKeyUpdate(d *schema.ResourceData, meta interface{})
 
 	apiKey, resp, err := iamIdentityClient.GetAPIKey(getAPIKeyOptions)
 	if err != nil || apiKey == nil {
-		return fmt.Errorf("[DEBUG] Error retrieving Service API Key: %s\n%s", err, resp)
+		return diag.FromErr(apiErrorf("iam", err, resp, "Error retrieving Service API Key"))
 	}
 
 	updateAPIKeyOptions := &iamidentityv1.UpdateAPIKeyOptions{
@@ -273,18 +283,18 @@ func resourceIBMIAMServiceAPIKeyUpdate(d *schema.ResourceData, meta interface{})
 	if hasChange {
 		_, response, err := iamIdentityClient.UpdateAPIKey(updateAPIKeyOptions)
 		if err != nil {
-			return fmt.Errorf("[DEBUG] Error updating Service API Key: %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("iam", err, response, "Error updating Service API Key"))
 		}
 	}
 
-	return resourceIBMIAMServiceAPIKeyRead(d, meta)
+	return resourceIBMIAMServiceAPIKeyRead(context, d, meta)
 
 }
 
-func resourceIBMIAMServiceAPIKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMServiceAPIKeyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	apiKeyID := d.Id()
 
@@ -298,7 +308,7 @@ func resourceIBMIAMServiceAPIKeyDelete(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[DEBUG] Error retrieving Service API Key: %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("iam", err, response, "Error retrieving Service API Key"))
 	}
 
 	deleteAPIKeyOptions := &iamidentityv1.DeleteAPIKeyOptions{
@@ -307,7 +317,7 @@ func resourceIBMIAMServiceAPIKeyDelete(d *schema.ResourceData, meta interface{})
 
 	resp, err := iamIdentityClient.DeleteAPIKey(deleteAPIKeyOptions)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Error deleting Service API Key: %s\n%s", err, resp)
+		return diag.FromErr(apiErrorf("iam", err, resp, "Error deleting Service API Key"))
 	}
 	d.SetId("")
 
@@ -330,7 +340,7 @@ func resourceIBMIAMServiceAPIKeyExists(d *schema.ResourceData, meta interface{})
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error retrieving Service API Key: %s\n%s", err, response)
+		return false, apiErrorf("iam", err, response, "Error retrieving Service API Key")
 	}
 	return *apiKey.ID == apiKeyID, nil
 }
@@ -4,24 +4,24 @@
 package ibm
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
 	"github.com/IBM-Cloud/bluemix-go/models"
 
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/bluemix-go/crn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMIAMServiceID() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMIAMServiceIDCreate,
-		Read:     resourceIBMIAMServiceIDRead,
-		Update:   resourceIBMIAMServiceIDUpdate,
-		Delete:   resourceIBMIAMServiceIDDelete,
-		Exists:   resourceIBMIAMServiceIDExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMIAMServiceIDCreate,
+		ReadContext:   resourceIBMIAMServiceIDRead,
+		UpdateContext: resourceIBMIAMServiceIDUpdate,
+		DeleteContext: resourceIBMIAMServiceIDDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -64,10 +64,10 @@ func resourceIBMIAMServiceID() *schema.Resource {
 	}
 }
 
-func resourceIBMIAMServiceIDCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMServiceIDCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamClient, err := meta.(ClientSession).IAMAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	name := d.Get("name").(string)
 
@@ -88,24 +88,33 @@ func resourceIBMIAMServiceIDCreate(d *schema.ResourceData, meta interface{}) err
 
 	serviceID, err := iamClient.ServiceIds().Create(request)
 	if err != nil {
-		return fmt.Errorf("Error creating serviceID: %s", err)
+		return diag.FromErr(apiErrorf("iam", err, nil, "Error creating serviceID"))
 	}
 
 	d.SetId(serviceID.UUID)
 
-	return resourceIBMIAMServiceIDRead(d, meta)
+	return resourceIBMIAMServiceIDRead(context, d, meta)
 }
 
-func resourceIBMIAMServiceIDRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMServiceIDRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMIAMServiceIDExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	iamClient, err := meta.(ClientSession).IAMAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	serviceIDUUID := d.Id()
 
 	serviceID, err := iamClient.ServiceIds().Get(serviceIDUUID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving serviceID: %s", err)
+		return diag.FromErr(apiErrorf("iam", err, nil, "Error retrieving serviceID"))
 	}
 
 	d.Set("name", serviceID.Name)
@@ -117,11 +126,11 @@ func resourceIBMIAMServiceIDRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMIAMServiceIDUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMServiceIDUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	iamClient, err := meta.(ClientSession).IAMAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	serviceIDUUID := d.Id()
 
@@ -141,25 +150,25 @@ func resourceIBMIAMServiceIDUpdate(d *schema.ResourceData, meta interface{}) err
 	if hasChange {
 		_, err = iamClient.ServiceIds().Update(serviceIDUUID, updateReq, "*")
 		if err != nil {
-			return fmt.Errorf("Error updating serviceID: %s", err)
+			return diag.FromErr(apiErrorf("iam", err, nil, "Error updating serviceID"))
 		}
 	}
 
-	return resourceIBMIAMServiceIDRead(d, meta)
+	return resourceIBMIAMServiceIDRead(context, d, meta)
 
 }
 
-func resourceIBMIAMServiceIDDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMServiceIDDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamClient, err := meta.(ClientSession).IAMAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	serviceIDUUID := d.Id()
 
 	err = iamClient.ServiceIds().Delete(serviceIDUUID)
 	if err != nil {
-		return fmt.Errorf("Error deleting serviceID: %s", err)
+		return diag.FromErr(apiErrorf("iam", err, nil, "Error deleting serviceID"))
 	}
 
 	d.SetId("")
@@ -181,7 +190,7 @@ func resourceIBMIAMServiceIDExists(d *schema.ResourceData, meta interface{}) (bo
 				return false, nil
 			}
 		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
+		return false, apiErrorf("iam", err, nil, "Error communicating with the API")
 	}
 
 	return serviceID.UUID == serviceIDUUID, nil
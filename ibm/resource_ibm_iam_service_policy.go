@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -18,11 +20,10 @@ import (
 
 func resourceIBMIAMServicePolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMIAMServicePolicyCreate,
-		Read:   resourceIBMIAMServicePolicyRead,
-		Update: resourceIBMIAMServicePolicyUpdate,
-		Delete: resourceIBMIAMServicePolicyDelete,
-		Exists: resourceIBMIAMServicePolicyExists,
+		CreateContext: resourceIBMIAMServicePolicyCreate,
+		ReadContext:   resourceIBMIAMServicePolicyRead,
+		UpdateContext: resourceIBMIAMServicePolicyUpdate,
+		DeleteContext: resourceIBMIAMServicePolicyDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				resources, resourceAttributes, err := importServicePolicy(d, meta)
@@ -154,7 +155,7 @@ func resourceIBMIAMServicePolicy() *schema.Resource {
 	}
 }
 
-func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMServicePolicyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	var iamID string
 	if v, ok := d.GetOk("iam_service_id"); ok && v != nil {
@@ -162,11 +163,11 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 
 		iamClient, err := meta.(ClientSession).IAMAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		serviceID, err := iamClient.ServiceIds().Get(serviceIDUUID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		iamID = serviceID.IAMID
 	}
@@ -176,12 +177,12 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	policyOptions, err := generatePolicyOptions(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	subjectAttribute := &iampolicymanagementv1.SubjectAttribute{
@@ -205,7 +206,7 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 
 	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	createPolicyOptions := iamPolicyManagementClient.NewCreatePolicyOptions(
@@ -218,7 +219,7 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 	servicePolicy, _, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
 
 	if err != nil {
-		return fmt.Errorf("Error creating servicePolicy: %s", err)
+		return diag.FromErr(apiErrorf("iam", err, nil, "Error creating servicePolicy"))
 	}
 	if v, ok := d.GetOk("iam_service_id"); ok && v != nil {
 		serviceIDUUID := v.(string)
@@ -251,22 +252,30 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 		_, _, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
 	}
 	if err != nil {
-		return fmt.Errorf("error fetching service  policy: %w", err)
+		return diag.FromErr(apiErrorf("iam", err, nil, "error fetching service  policy"))
 	}
 
-	return resourceIBMIAMServicePolicyRead(d, meta)
+	return resourceIBMIAMServicePolicyRead(context, d, meta)
 }
 
-func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMServicePolicyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMIAMServicePolicyExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
 
 	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	serviceIDUUID := parts[0]
 	servicePolicyID := parts[1]
@@ -276,7 +285,7 @@ func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) e
 	)
 	servicePolicy, _, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
 	if err != nil {
-		return fmt.Errorf("Error retrieving servicePolicy: %s", err)
+		return diag.FromErr(apiErrorf("iam", err, nil, "Error retrieving servicePolicy"))
 	}
 	if strings.HasPrefix(serviceIDUUID, "iam-") {
 		d.Set("iam_id", serviceIDUUID)
@@ -308,13 +317,13 @@ func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMServicePolicyUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") {
 
 		parts, err := idParts(d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		servicePolicyID := parts[1]
 
@@ -324,11 +333,11 @@ func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{})
 
 			iamClient, err := meta.(ClientSession).IAMAPI()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			serviceID, err := iamClient.ServiceIds().Get(serviceIDUUID)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			iamID = serviceID.IAMID
 		}
@@ -338,12 +347,12 @@ func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{})
 
 		userDetails, err := meta.(ClientSession).BluemixUserDetails()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		createPolicyOptions, err := generatePolicyOptions(d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		accountIDResourceAttribute := &iampolicymanagementv1.ResourceAttribute{
@@ -366,7 +375,7 @@ func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{})
 
 		iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
@@ -377,7 +386,7 @@ func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{})
 			if response != nil && response.StatusCode == 404 {
 				return nil
 			}
-			return fmt.Errorf("Error retrieving Policy: %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("iam", err, response, "Error retrieving Policy"))
 		}
 
 		servicePolicyETag := response.Headers.Get("ETag")
@@ -392,24 +401,24 @@ func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{})
 
 		_, _, err = iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
 		if err != nil {
-			return fmt.Errorf("Error updating service policy: %s", err)
+			return diag.FromErr(apiErrorf("iam", err, nil, "Error updating service policy"))
 		}
 
 	}
 
-	return resourceIBMIAMServicePolicyRead(d, meta)
+	return resourceIBMIAMServicePolicyRead(context, d, meta)
 
 }
 
-func resourceIBMIAMServicePolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMServicePolicyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	servicePolicyID := parts[1]
 
@@ -419,7 +428,7 @@ func resourceIBMIAMServicePolicyDelete(d *schema.ResourceData, meta interface{})
 
 	_, err = iamPolicyManagementClient.DeletePolicy(deletePolicyOptions)
 	if err != nil {
-		return fmt.Errorf("Error deleting service policy: %s", err)
+		return diag.FromErr(apiErrorf("iam", err, nil, "Error deleting service policy"))
 	}
 
 	d.SetId("")
@@ -450,7 +459,7 @@ func resourceIBMIAMServicePolicyExists(d *schema.ResourceData, meta interface{})
 				return false, nil
 			}
 		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
+		return false, apiErrorf("iam", err, nil, "Error communicating with the API")
 	}
 
 	tempID := fmt.Sprintf("%s/%s", serviceIDUUID, *servicePolicy.ID)
@@ -474,7 +483,7 @@ func importServicePolicy(d *schema.ResourceData, meta interface{}) (interface{},
 	)
 	servicePolicy, _, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
 	if err != nil {
-		return nil, nil, fmt.Errorf("Error retrieving servicePolicy: %s", err)
+		return nil, nil, apiErrorf("iam", err, nil, "Error retrieving servicePolicy")
 	}
 	resources := flattenPolicyResource(servicePolicy.Resources)
 	resource_attributes := flattenPolicyResourceAttributes(servicePolicy.Resources)
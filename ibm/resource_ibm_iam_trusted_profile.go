@@ -0,0 +1,187 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"log"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMIAMTrustedProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIAMTrustedProfileCreate,
+		ReadContext:   resourceIBMIAMTrustedProfileRead,
+		UpdateContext: resourceIBMIAMTrustedProfileUpdate,
+		DeleteContext: resourceIBMIAMTrustedProfileDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the trusted profile, unique in the account",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the trusted profile",
+			},
+			"profile_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the trusted profile",
+			},
+			"iam_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IAM ID of the trusted profile, the subject of its access policies",
+			},
+			"account_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the account of the trusted profile",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "CRN of the trusted profile",
+			},
+			"entity_tag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Version of the trusted profile, required to update it",
+			},
+			"ims_account_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "ID of the IMS account of the trusted profile",
+			},
+			"ims_user_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "ID of the IMS user of the trusted profile",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation date of the trusted profile",
+			},
+			"modified_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last modification date of the trusted profile",
+			},
+		},
+	}
+}
+
+func resourceIBMIAMTrustedProfileCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	userDetails, err := meta.(ClientSession).BluemixUserDetails()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	prototype := &iamTrustedProfile{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		AccountID:   userDetails.userAccount,
+	}
+	profile := &iamTrustedProfile{}
+	options := iamIdentityRequestOptions{Method: core.POST, Path: "/v1/profiles", Body: prototype}
+	response, err := iamIdentityRequest(iamIdentityClient, options, profile)
+	if err != nil {
+		return diag.FromErr(apiErrorf("iam", err, response, "Error creating trusted profile %s", prototype.Name))
+	}
+	d.SetId(profile.ID)
+	log.Printf("[INFO] Trusted profile : %s", profile.ID)
+
+	return resourceIBMIAMTrustedProfileRead(context, d, meta)
+}
+
+func resourceIBMIAMTrustedProfileRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	profile, response, err := getIAMTrustedProfile(iamIdentityClient, d.Id())
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(apiErrorf("iam", err, response, "Error getting trusted profile %s", d.Id()))
+	}
+
+	d.Set("name", profile.Name)
+	d.Set("description", profile.Description)
+	d.Set("profile_id", profile.ID)
+	d.Set("iam_id", profile.IamID)
+	d.Set("account_id", profile.AccountID)
+	d.Set("crn", profile.CRN)
+	d.Set("entity_tag", profile.EntityTag)
+	d.Set("ims_account_id", profile.ImsAccountID)
+	d.Set("ims_user_id", profile.ImsUserID)
+	d.Set("created_at", profile.CreatedAt)
+	d.Set("modified_at", profile.ModifiedAt)
+
+	return nil
+}
+
+func resourceIBMIAMTrustedProfileUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") || d.HasChange("description") {
+		options := iamIdentityRequestOptions{
+			Method:     core.PUT,
+			Path:       "/v1/profiles/{profile-id}",
+			PathParams: map[string]string{"profile-id": d.Id()},
+			IfMatch:    d.Get("entity_tag").(string),
+			Body: map[string]string{
+				"name":        d.Get("name").(string),
+				"description": d.Get("description").(string),
+			},
+		}
+		response, err := iamIdentityRequest(iamIdentityClient, options, nil)
+		if err != nil {
+			return diag.FromErr(apiErrorf("iam", err, response, "Error updating trusted profile %s", d.Id()))
+		}
+	}
+
+	return resourceIBMIAMTrustedProfileRead(context, d, meta)
+}
+
+// resourceIBMIAMTrustedProfileDelete deletes the trusted profile along with its
+// claim rules and links.
+func resourceIBMIAMTrustedProfileDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := iamIdentityRequestOptions{
+		Method:     core.DELETE,
+		Path:       "/v1/profiles/{profile-id}",
+		PathParams: map[string]string{"profile-id": d.Id()},
+	}
+	response, err := iamIdentityRequest(iamIdentityClient, options, nil)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return diag.FromErr(apiErrorf("iam", err, response, "Error deleting trusted profile %s", d.Id()))
+	}
+
+	d.SetId("")
+
+	return nil
+}
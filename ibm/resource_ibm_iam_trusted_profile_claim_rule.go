@@ -0,0 +1,283 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// iamProfileComputeResourceTypes are the types of compute resources which can assume
+// a trusted profile: virtual server instances and the service accounts of IKS and
+// OpenShift clusters.
+var iamProfileComputeResourceTypes = []string{"VSI", "IKS_SA", "ROKS_SA"}
+
+func resourceIBMIAMTrustedProfileClaimRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIAMTrustedProfileClaimRuleCreate,
+		ReadContext:   resourceIBMIAMTrustedProfileClaimRuleRead,
+		UpdateContext: resourceIBMIAMTrustedProfileClaimRuleUpdate,
+		DeleteContext: resourceIBMIAMTrustedProfileClaimRuleDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+			ruleType := diff.Get("type").(string)
+			_, realm := diff.GetOk("realm_name")
+			_, crType := diff.GetOk("cr_type")
+			switch {
+			case ruleType == "Profile-SAML" && diff.NewValueKnown("realm_name") && !realm:
+				return fmt.Errorf("realm_name is required by the Profile-SAML claim rules")
+			case ruleType == "Profile-SAML" && crType:
+				return fmt.Errorf("cr_type is only supported by the Profile-CR claim rules")
+			case ruleType == "Profile-CR" && diff.NewValueKnown("cr_type") && !crType:
+				return fmt.Errorf("cr_type is required by the Profile-CR claim rules")
+			case ruleType == "Profile-CR" && realm:
+				return fmt.Errorf("realm_name is only supported by the Profile-SAML claim rules")
+			}
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"profile_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the trusted profile",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"Profile-SAML", "Profile-CR"}),
+				Description:  "Type of the claim rule, Profile-SAML for the federated users or Profile-CR for the compute resources",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the claim rule",
+			},
+			"realm_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The realm name of the identity provider of the Profile-SAML claim rule",
+			},
+			"cr_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAllowedStringValue(iamProfileComputeResourceTypes),
+				Description:  "The type of the compute resources of the Profile-CR claim rule, VSI, IKS_SA or ROKS_SA",
+			},
+			"expiration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validatePortRange(0, 86400),
+				Description:  "Session expiration in seconds, only for the Profile-SAML claim rules",
+			},
+			"conditions": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "Conditions on the claims of the identity, all of them must match",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"claim": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The claim to evaluate",
+						},
+						"operator": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue([]string{"EQUALS", "EQUALS_IGNORE_CASE", "IN", "NOT_EQUALS_IGNORE_CASE", "NOT_EQUALS", "CONTAINS"}),
+							Description:  "The operation to perform on the claim",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The value the claim is compared to",
+						},
+					},
+				},
+			},
+			"rule_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the claim rule",
+			},
+			"entity_tag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Version of the claim rule, required to update it",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation date of the claim rule",
+			},
+			"modified_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last modification date of the claim rule",
+			},
+		},
+	}
+}
+
+// expandIAMProfileClaimRule returns the claim rule of the configuration. Like the
+// dynamic rules of the access groups, the API expects the values of the conditions
+// as JSON strings.
+func expandIAMProfileClaimRule(d *schema.ResourceData) *iamProfileClaimRule {
+	rule := &iamProfileClaimRule{
+		Name:       d.Get("name").(string),
+		Type:       d.Get("type").(string),
+		RealmName:  d.Get("realm_name").(string),
+		CrType:     d.Get("cr_type").(string),
+		Expiration: d.Get("expiration").(int),
+		Conditions: []iamProfileClaimRuleCondition{},
+	}
+	for _, e := range d.Get("conditions").([]interface{}) {
+		r, _ := e.(map[string]interface{})
+		rule.Conditions = append(rule.Conditions, iamProfileClaimRuleCondition{
+			Claim:    r["claim"].(string),
+			Operator: r["operator"].(string),
+			Value:    fmt.Sprintf("\"%s\"", r["value"].(string)),
+		})
+	}
+	return rule
+}
+
+func flattenIAMProfileClaimRuleConditions(list []iamProfileClaimRuleCondition) []map[string]interface{} {
+	conditions := make([]map[string]interface{}, len(list))
+	for i, cond := range list {
+		conditions[i] = map[string]interface{}{
+			"claim":    cond.Claim,
+			"operator": cond.Operator,
+			"value":    strings.ReplaceAll(cond.Value, "\"", ""),
+		}
+	}
+	return conditions
+}
+
+func resourceIBMIAMTrustedProfileClaimRuleCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	profileID := d.Get("profile_id").(string)
+	rule := &iamProfileClaimRule{}
+	options := iamIdentityRequestOptions{
+		Method:     core.POST,
+		Path:       "/v1/profiles/{profile-id}/rules",
+		PathParams: map[string]string{"profile-id": profileID},
+		Body:       expandIAMProfileClaimRule(d),
+	}
+	response, err := iamIdentityRequest(iamIdentityClient, options, rule)
+	if err != nil {
+		return diag.FromErr(apiErrorf("iam", err, response, "Error creating claim rule of trusted profile %s", profileID))
+	}
+	d.SetId(fmt.Sprintf("%s/%s", profileID, rule.ID))
+
+	return resourceIBMIAMTrustedProfileClaimRuleRead(context, d, meta)
+}
+
+func resourceIBMIAMTrustedProfileClaimRuleRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) != 2 {
+		return diag.FromErr(fmt.Errorf("Invalid ID %s of the claim rule, it must be <profile_id>/<rule_id>", d.Id()))
+	}
+	profileID, ruleID := parts[0], parts[1]
+
+	rule := &iamProfileClaimRule{}
+	options := iamIdentityRequestOptions{
+		Method:     core.GET,
+		Path:       "/v1/profiles/{profile-id}/rules/{rule-id}",
+		PathParams: map[string]string{"profile-id": profileID, "rule-id": ruleID},
+	}
+	response, err := iamIdentityRequest(iamIdentityClient, options, rule)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(apiErrorf("iam", err, response, "Error getting claim rule %s of trusted profile %s", ruleID, profileID))
+	}
+
+	d.Set("profile_id", profileID)
+	d.Set("rule_id", rule.ID)
+	d.Set("type", rule.Type)
+	d.Set("name", rule.Name)
+	d.Set("realm_name", rule.RealmName)
+	d.Set("cr_type", rule.CrType)
+	d.Set("expiration", rule.Expiration)
+	d.Set("conditions", flattenIAMProfileClaimRuleConditions(rule.Conditions))
+	d.Set("entity_tag", rule.EntityTag)
+	d.Set("created_at", rule.CreatedAt)
+	d.Set("modified_at", rule.ModifiedAt)
+
+	return nil
+}
+
+func resourceIBMIAMTrustedProfileClaimRuleUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	profileID, ruleID := parts[0], parts[1]
+
+	options := iamIdentityRequestOptions{
+		Method:     core.PUT,
+		Path:       "/v1/profiles/{profile-id}/rules/{rule-id}",
+		PathParams: map[string]string{"profile-id": profileID, "rule-id": ruleID},
+		IfMatch:    d.Get("entity_tag").(string),
+		Body:       expandIAMProfileClaimRule(d),
+	}
+	response, err := iamIdentityRequest(iamIdentityClient, options, nil)
+	if err != nil {
+		return diag.FromErr(apiErrorf("iam", err, response, "Error updating claim rule %s of trusted profile %s", ruleID, profileID))
+	}
+
+	return resourceIBMIAMTrustedProfileClaimRuleRead(context, d, meta)
+}
+
+func resourceIBMIAMTrustedProfileClaimRuleDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	profileID, ruleID := parts[0], parts[1]
+
+	options := iamIdentityRequestOptions{
+		Method:     core.DELETE,
+		Path:       "/v1/profiles/{profile-id}/rules/{rule-id}",
+		PathParams: map[string]string{"profile-id": profileID, "rule-id": ruleID},
+	}
+	response, err := iamIdentityRequest(iamIdentityClient, options, nil)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return diag.FromErr(apiErrorf("iam", err, response, "Error deleting claim rule %s of trusted profile %s", ruleID, profileID))
+	}
+
+	d.SetId("")
+
+	return nil
+}
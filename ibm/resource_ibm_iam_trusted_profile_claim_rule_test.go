@@ -0,0 +1,158 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
+)
+
+func TestAccIBMIAMTrustedProfileClaimRule_basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMTrustedProfileClaimRuleConfig(name, "payments"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_claim_rule.rule", "type", "Profile-CR"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_claim_rule.rule", "conditions.0.value", "payments"),
+					resource.TestCheckResourceAttrSet("ibm_iam_trusted_profile_claim_rule.rule", "rule_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMTrustedProfileClaimRuleConfig(name, "billing"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_claim_rule.rule", "conditions.0.value", "billing"),
+				),
+			},
+			{
+				ResourceName:      "ibm_iam_trusted_profile_claim_rule.rule",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMTrustedProfileClaimRuleConfig(name, namespace string) string {
+	return testAccCheckIBMIAMTrustedProfileConfig(name, "claim rule test") + fmt.Sprintf(`
+	resource "ibm_iam_trusted_profile_claim_rule" "rule" {
+		profile_id = ibm_iam_trusted_profile.profile.id
+		type       = "Profile-CR"
+		cr_type    = "IKS_SA"
+		name       = "%s"
+		conditions {
+			claim    = "namespace"
+			operator = "EQUALS"
+			value    = "%s"
+		}
+	}
+	`, name, namespace)
+}
+
+func TestIBMIAMTrustedProfileClaimRuleMock(t *testing.T) {
+	t.Parallel()
+	srv := testMockServer(t)
+	meta := testMockClientSession(t, srv)
+	profiles := resourceIBMIAMTrustedProfile()
+	profile := schema.TestResourceDataRaw(t, profiles.Schema, map[string]interface{}{"name": "mock-profile"})
+	assert.NilError(t, testDiagsErr(profiles.CreateContext(context.Background(), profile, meta)))
+	r := resourceIBMIAMTrustedProfileClaimRule()
+
+	for _, c := range []struct {
+		raw map[string]interface{}
+		err string
+	}{
+		{map[string]interface{}{"type": "Profile-SAML"}, "realm_name is required"},
+		{map[string]interface{}{"type": "Profile-SAML", "realm_name": "https://idp.example.com", "cr_type": "VSI"}, "cr_type is only supported"},
+		{map[string]interface{}{"type": "Profile-CR"}, "cr_type is required"},
+		{map[string]interface{}{"type": "Profile-CR", "cr_type": "VSI", "realm_name": "https://idp.example.com"}, "realm_name is only supported"},
+	} {
+		c.raw["profile_id"] = profile.Id()
+		c.raw["conditions"] = []interface{}{map[string]interface{}{"claim": "name", "operator": "EQUALS", "value": "x"}}
+		_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(c.raw), meta)
+		assert.ErrorContains(t, err, c.err)
+	}
+
+	raw := map[string]interface{}{
+		"profile_id": profile.Id(),
+		"type":       "Profile-SAML",
+		"name":       "mock-rule",
+		"realm_name": "https://idp.example.com/saml",
+		"expiration": 43200,
+		"conditions": []interface{}{map[string]interface{}{
+			"claim":    "blueGroups",
+			"operator": "CONTAINS",
+			"value":    "cloud-operators",
+		}},
+	}
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	d.MarkNewResource()
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), d, meta)))
+	ruleID := d.Get("rule_id").(string)
+	assert.Equal(t, d.Id(), profile.Id()+"/"+ruleID)
+	assert.Equal(t, d.Get("conditions.0.value"), "cloud-operators")
+	assert.Equal(t, d.Get("expiration"), 43200)
+
+	// The API holds the values of the conditions as JSON strings
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	assert.NilError(t, err)
+	rule := &iamProfileClaimRule{}
+	_, err = iamIdentityRequest(iamIdentityClient, iamIdentityRequestOptions{
+		Method:     core.GET,
+		Path:       "/v1/profiles/{profile-id}/rules/{rule-id}",
+		PathParams: map[string]string{"profile-id": profile.Id(), "rule-id": ruleID},
+	}, rule)
+	assert.NilError(t, err)
+	assert.Equal(t, rule.Conditions[0].Value, `"cloud-operators"`)
+
+	raw["conditions"] = []interface{}{
+		map[string]interface{}{"claim": "blueGroups", "operator": "CONTAINS", "value": "cloud-admins"},
+		map[string]interface{}{"claim": "email", "operator": "NOT_EQUALS", "value": "guest@example.com"},
+	}
+	delete(raw, "expiration")
+	updated := testMockResourceData(t, r, d, raw)
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), updated, meta)))
+	assert.Equal(t, len(updated.Get("conditions").([]interface{})), 2)
+	assert.Equal(t, updated.Get("conditions.0.value"), "cloud-admins")
+	assert.Equal(t, updated.Get("expiration"), 0)
+
+	compute := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"profile_id": profile.Id(),
+		"type":       "Profile-CR",
+		"cr_type":    "ROKS_SA",
+		"conditions": []interface{}{map[string]interface{}{"claim": "namespace", "operator": "EQUALS", "value": "payments"}},
+	})
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), compute, meta)))
+	assert.Equal(t, compute.Get("cr_type"), "ROKS_SA")
+	assert.Equal(t, compute.Get("realm_name"), "")
+
+	imported := r.Data(nil)
+	imported.SetId(d.Id())
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), imported, meta)))
+	assert.Equal(t, imported.Get("profile_id"), profile.Id())
+	assert.Equal(t, imported.Get("realm_name"), "https://idp.example.com/saml")
+	invalid := r.Data(nil)
+	invalid.SetId(ruleID)
+	assert.ErrorContains(t, testDiagsErr(r.ReadContext(context.Background(), invalid, meta)), "does not contain /")
+
+	assert.NilError(t, testDiagsErr(r.DeleteContext(context.Background(), updated, meta)))
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), imported, meta)))
+	assert.Equal(t, imported.Id(), "")
+
+	// Deleting the profile deletes its rules
+	assert.NilError(t, testDiagsErr(profiles.DeleteContext(context.Background(), profile, meta)))
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), compute, meta)))
+	assert.Equal(t, compute.Id(), "")
+}
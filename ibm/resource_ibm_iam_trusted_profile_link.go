@@ -0,0 +1,208 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMIAMTrustedProfileLink() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIAMTrustedProfileLinkCreate,
+		ReadContext:   resourceIBMIAMTrustedProfileLinkRead,
+		DeleteContext: resourceIBMIAMTrustedProfileLinkDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+			crType := diff.Get("cr_type").(string)
+			_, namespace := diff.GetOk("link.0.namespace")
+			if (crType == "IKS_SA" || crType == "ROKS_SA") && diff.NewValueKnown("link.0.namespace") && !namespace {
+				return fmt.Errorf("link.0.namespace is required to link the service account of a cluster")
+			}
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"profile_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the trusted profile",
+			},
+			"cr_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue(iamProfileComputeResourceTypes),
+				Description:  "The type of the compute resource, VSI, IKS_SA or ROKS_SA",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Name of the link",
+			},
+			"link": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				MaxItems:    1,
+				Description: "The compute resource linked to the trusted profile",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"crn": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "CRN of the virtual server instance or the cluster",
+						},
+						"namespace": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "Kubernetes namespace of the service account, required for IKS_SA and ROKS_SA",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Description: "Name of the service account, the default service account when it is not set",
+						},
+					},
+				},
+			},
+			"link_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the link",
+			},
+			"entity_tag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Version of the link",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation date of the link",
+			},
+			"modified_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last modification date of the link",
+			},
+		},
+	}
+}
+
+func resourceIBMIAMTrustedProfileLinkCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	profileID := d.Get("profile_id").(string)
+	prototype := &iamProfileLink{
+		Name:   d.Get("name").(string),
+		CrType: d.Get("cr_type").(string),
+		Link: iamProfileLinkTarget{
+			CRN:       d.Get("link.0.crn").(string),
+			Namespace: d.Get("link.0.namespace").(string),
+			Name:      d.Get("link.0.name").(string),
+		},
+	}
+	link := &iamProfileLink{}
+	options := iamIdentityRequestOptions{
+		Method:     core.POST,
+		Path:       "/v1/profiles/{profile-id}/links",
+		PathParams: map[string]string{"profile-id": profileID},
+		Body:       prototype,
+	}
+	response, err := iamIdentityRequest(iamIdentityClient, options, link)
+	if err != nil {
+		return diag.FromErr(apiErrorf("iam", err, response, "Error linking %s to trusted profile %s", prototype.Link.CRN, profileID))
+	}
+	d.SetId(fmt.Sprintf("%s/%s", profileID, link.ID))
+
+	return resourceIBMIAMTrustedProfileLinkRead(context, d, meta)
+}
+
+func resourceIBMIAMTrustedProfileLinkRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) != 2 {
+		return diag.FromErr(fmt.Errorf("Invalid ID %s of the trusted profile link, it must be <profile_id>/<link_id>", d.Id()))
+	}
+	profileID, linkID := parts[0], parts[1]
+
+	link := &iamProfileLink{}
+	options := iamIdentityRequestOptions{
+		Method:     core.GET,
+		Path:       "/v1/profiles/{profile-id}/links/{link-id}",
+		PathParams: map[string]string{"profile-id": profileID, "link-id": linkID},
+	}
+	response, err := iamIdentityRequest(iamIdentityClient, options, link)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(apiErrorf("iam", err, response, "Error getting link %s of trusted profile %s", linkID, profileID))
+	}
+
+	d.Set("profile_id", profileID)
+	d.Set("link_id", link.ID)
+	d.Set("name", link.Name)
+	d.Set("cr_type", link.CrType)
+	d.Set("link", []map[string]interface{}{{
+		"crn":       link.Link.CRN,
+		"namespace": link.Link.Namespace,
+		"name":      link.Link.Name,
+	}})
+	d.Set("entity_tag", link.EntityTag)
+	d.Set("created_at", link.CreatedAt)
+	d.Set("modified_at", link.ModifiedAt)
+
+	return nil
+}
+
+func resourceIBMIAMTrustedProfileLinkDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	profileID, linkID := parts[0], parts[1]
+
+	options := iamIdentityRequestOptions{
+		Method:     core.DELETE,
+		Path:       "/v1/profiles/{profile-id}/links/{link-id}",
+		PathParams: map[string]string{"profile-id": profileID, "link-id": linkID},
+	}
+	response, err := iamIdentityRequest(iamIdentityClient, options, nil)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return diag.FromErr(apiErrorf("iam", err, response, "Error deleting link %s of trusted profile %s", linkID, profileID))
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
+)
+
+func TestAccIBMIAMTrustedProfileLink_basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMTrustedProfileLinkConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_link.link", "cr_type", "VSI"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_link.link", "link.0.crn", iamTrustedProfileVSICRN),
+					resource.TestCheckResourceAttrSet("ibm_iam_trusted_profile_link.link", "link_id"),
+				),
+			},
+			{
+				ResourceName:      "ibm_iam_trusted_profile_link.link",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMTrustedProfileLinkConfig(name string) string {
+	return testAccCheckIBMIAMTrustedProfileConfig(name, "link test") + fmt.Sprintf(`
+	resource "ibm_iam_trusted_profile_link" "link" {
+		profile_id = ibm_iam_trusted_profile.profile.id
+		cr_type    = "VSI"
+		name       = "%s"
+		link {
+			crn = "%s"
+		}
+	}
+	`, name, iamTrustedProfileVSICRN)
+}
+
+func TestIBMIAMTrustedProfileLinkMock(t *testing.T) {
+	t.Parallel()
+	srv := testMockServer(t)
+	meta := testMockClientSession(t, srv)
+	profiles := resourceIBMIAMTrustedProfile()
+	profile := schema.TestResourceDataRaw(t, profiles.Schema, map[string]interface{}{"name": "mock-profile"})
+	assert.NilError(t, testDiagsErr(profiles.CreateContext(context.Background(), profile, meta)))
+	r := resourceIBMIAMTrustedProfileLink()
+	clusterCRN := fmt.Sprintf("crn:v1:bluemix:public:containers-kubernetes:us-south:a/%s:c3h0000000000000000::", srv.Account)
+
+	_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"profile_id": profile.Id(),
+		"cr_type":    "IKS_SA",
+		"link":       []interface{}{map[string]interface{}{"crn": clusterCRN}},
+	}), meta)
+	assert.ErrorContains(t, err, "namespace is required")
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"profile_id": profile.Id(),
+		"cr_type":    "IKS_SA",
+		"link":       []interface{}{map[string]interface{}{"crn": clusterCRN, "namespace": "payments"}},
+	})
+	d.MarkNewResource()
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), d, meta)))
+	linkID := d.Get("link_id").(string)
+	assert.Equal(t, d.Id(), profile.Id()+"/"+linkID)
+	assert.Equal(t, d.Get("link.0.namespace"), "payments")
+	assert.Equal(t, d.Get("link.0.name"), "default")
+	assert.Assert(t, d.Get("name") != "")
+
+	vsiCRN := fmt.Sprintf("crn:v1:bluemix:public:is:us-south-1:a/%s::instance:0717-00000000", srv.Account)
+	vsi := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"profile_id": profile.Id(),
+		"cr_type":    "VSI",
+		"name":       "mock-vsi-link",
+		"link":       []interface{}{map[string]interface{}{"crn": vsiCRN}},
+	})
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), vsi, meta)))
+	assert.Equal(t, vsi.Get("name"), "mock-vsi-link")
+	assert.Equal(t, vsi.Get("link.0.crn"), vsiCRN)
+
+	imported := r.Data(nil)
+	imported.SetId(d.Id())
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), imported, meta)))
+	assert.Equal(t, imported.Get("profile_id"), profile.Id())
+	assert.Equal(t, imported.Get("cr_type"), "IKS_SA")
+	assert.Equal(t, imported.Get("link.0.crn"), clusterCRN)
+
+	assert.NilError(t, testDiagsErr(r.DeleteContext(context.Background(), d, meta)))
+	assert.Equal(t, d.Id(), "")
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), imported, meta)))
+	assert.Equal(t, imported.Id(), "")
+
+	// Deleting the profile deletes its links
+	assert.NilError(t, testDiagsErr(profiles.DeleteContext(context.Background(), profile, meta)))
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), vsi, meta)))
+	assert.Equal(t, vsi.Id(), "")
+}
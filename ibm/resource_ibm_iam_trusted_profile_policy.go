@@ -0,0 +1,479 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMIAMTrustedProfilePolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIAMTrustedProfilePolicyCreate,
+		ReadContext:   resourceIBMIAMTrustedProfilePolicyRead,
+		UpdateContext: resourceIBMIAMTrustedProfilePolicyUpdate,
+		DeleteContext: resourceIBMIAMTrustedProfilePolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				resources, resourceAttributes, err := importTrustedProfilePolicy(d, meta)
+				if err != nil {
+					return nil, fmt.Errorf("Error reading resource ID: %s", err)
+				}
+				d.Set("resources", resources)
+				d.Set("resource_attributes", resourceAttributes)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"profile_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"profile_id", "iam_id"},
+				Description:  "ID of the trusted profile",
+				ForceNew:     true,
+			},
+			"iam_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"profile_id", "iam_id"},
+				Description:  "IAM ID of the trusted profile",
+				ForceNew:     true,
+			},
+			"roles": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Role names of the policy definition",
+			},
+
+			"resources": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"account_management", "resource_attributes"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Service name of the policy definition",
+						},
+
+						"resource_instance_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of resource instance of the policy definition",
+						},
+
+						"region": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Region of the policy definition",
+						},
+
+						"resource_type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Resource type of the policy definition",
+						},
+
+						"resource": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Resource of the policy definition",
+						},
+
+						"resource_group_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of the resource group.",
+						},
+
+						"attributes": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Description: "Set resource attributes in the form of 'name=value,name=value....",
+							Elem:        schema.TypeString,
+						},
+					},
+				},
+			},
+
+			"resource_attributes": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Description:   "Set resource attributes.",
+				ConflictsWith: []string{"resources", "account_management"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of attribute.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Value of attribute.",
+						},
+						"operator": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "stringEquals",
+							Description: "Operator of attribute.",
+						},
+					},
+				},
+			},
+			"account_management": {
+				Type:          schema.TypeBool,
+				Default:       false,
+				Optional:      true,
+				Description:   "Give access to all account management services",
+				ConflictsWith: []string{"resources", "resource_attributes"},
+			},
+
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceIBMIAMTrustedProfilePolicyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+
+	iamID, err := trustedProfilePolicyIAMID(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userDetails, err := meta.(ClientSession).BluemixUserDetails()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policyOptions, err := generatePolicyOptions(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	subjectAttribute := &iampolicymanagementv1.SubjectAttribute{
+		Name:  core.StringPtr("iam_id"),
+		Value: &iamID,
+	}
+
+	policySubjects := &iampolicymanagementv1.PolicySubject{
+		Attributes: []iampolicymanagementv1.SubjectAttribute{*subjectAttribute},
+	}
+
+	accountIDResourceAttribute := &iampolicymanagementv1.ResourceAttribute{
+		Name:     core.StringPtr("accountId"),
+		Value:    core.StringPtr(userDetails.userAccount),
+		Operator: core.StringPtr("stringEquals"),
+	}
+
+	policyResources := iampolicymanagementv1.PolicyResource{
+		Attributes: append(policyOptions.Resources[0].Attributes, *accountIDResourceAttribute),
+	}
+
+	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	createPolicyOptions := iamPolicyManagementClient.NewCreatePolicyOptions(
+		"access",
+		[]iampolicymanagementv1.PolicySubject{*policySubjects},
+		policyOptions.Roles,
+		[]iampolicymanagementv1.PolicyResource{policyResources},
+	)
+
+	profilePolicy, response, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+
+	if err != nil {
+		return diag.FromErr(apiErrorf("iam", err, response, "Error creating trusted profile policy"))
+	}
+	if v, ok := d.GetOk("profile_id"); ok && v != nil {
+		profileID := v.(string)
+		d.SetId(fmt.Sprintf("%s/%s", profileID, *profilePolicy.ID))
+	} else {
+		d.SetId(fmt.Sprintf("%s/%s", iamID, *profilePolicy.ID))
+	}
+
+	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
+		*profilePolicy.ID,
+	)
+
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		var err error
+		_, response, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, response, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	}
+	if err != nil {
+		return diag.FromErr(apiErrorf("iam", err, response, "Error fetching trusted profile policy"))
+	}
+
+	return resourceIBMIAMTrustedProfilePolicyRead(context, d, meta)
+}
+
+func resourceIBMIAMTrustedProfilePolicyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMIAMTrustedProfilePolicyExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	profileID := parts[0]
+	profilePolicyID := parts[1]
+
+	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
+		profilePolicyID,
+	)
+	profilePolicy, response, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	if err != nil {
+		return diag.FromErr(apiErrorf("iam", err, response, "Error retrieving trusted profile policy"))
+	}
+	if strings.HasPrefix(profileID, "iam-") {
+		d.Set("iam_id", profileID)
+	} else {
+		d.Set("profile_id", profileID)
+	}
+
+	roles := make([]string, len(profilePolicy.Roles))
+	for i, role := range profilePolicy.Roles {
+		roles[i] = *role.DisplayName
+	}
+	d.Set("roles", roles)
+
+	if _, ok := d.GetOk("resources"); ok {
+		d.Set("resources", flattenPolicyResource(profilePolicy.Resources))
+	}
+	if _, ok := d.GetOk("resource_attributes"); ok {
+		d.Set("resource_attributes", flattenPolicyResourceAttributes(profilePolicy.Resources))
+	}
+	if len(profilePolicy.Resources) > 0 {
+		if *getResourceAttribute("serviceType", profilePolicy.Resources[0]) == "service" {
+			d.Set("account_management", false)
+		}
+		if *getResourceAttribute("serviceType", profilePolicy.Resources[0]) == "platform_service" {
+			d.Set("account_management", true)
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMIAMTrustedProfilePolicyUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+
+	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") {
+
+		parts, err := idParts(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		profilePolicyID := parts[1]
+
+		iamID, err := trustedProfilePolicyIAMID(d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		userDetails, err := meta.(ClientSession).BluemixUserDetails()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		createPolicyOptions, err := generatePolicyOptions(d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		accountIDResourceAttribute := &iampolicymanagementv1.ResourceAttribute{
+			Name:     core.StringPtr("accountId"),
+			Value:    core.StringPtr(userDetails.userAccount),
+			Operator: core.StringPtr("stringEquals"),
+		}
+
+		policyResources := iampolicymanagementv1.PolicyResource{
+			Attributes: append(createPolicyOptions.Resources[0].Attributes, *accountIDResourceAttribute),
+		}
+
+		subjectAttribute := &iampolicymanagementv1.SubjectAttribute{
+			Name:  core.StringPtr("iam_id"),
+			Value: &iamID,
+		}
+		policySubjects := &iampolicymanagementv1.PolicySubject{
+			Attributes: []iampolicymanagementv1.SubjectAttribute{*subjectAttribute},
+		}
+
+		iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
+			profilePolicyID,
+		)
+		policy, response, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		if err != nil || policy == nil {
+			if response != nil && response.StatusCode == 404 {
+				return nil
+			}
+			return diag.FromErr(apiErrorf("iam", err, response, "Error retrieving Policy"))
+		}
+
+		profilePolicyETag := response.Headers.Get("ETag")
+		updatePolicyOptions := iamPolicyManagementClient.NewUpdatePolicyOptions(
+			profilePolicyID,
+			profilePolicyETag,
+			"access",
+			[]iampolicymanagementv1.PolicySubject{*policySubjects},
+			createPolicyOptions.Roles,
+			[]iampolicymanagementv1.PolicyResource{policyResources},
+		)
+
+		_, response, err = iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
+		if err != nil {
+			return diag.FromErr(apiErrorf("iam", err, response, "Error updating trusted profile policy"))
+		}
+
+	}
+
+	return resourceIBMIAMTrustedProfilePolicyRead(context, d, meta)
+
+}
+
+func resourceIBMIAMTrustedProfilePolicyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	profilePolicyID := parts[1]
+
+	deletePolicyOptions := iamPolicyManagementClient.NewDeletePolicyOptions(
+		profilePolicyID,
+	)
+
+	response, err := iamPolicyManagementClient.DeletePolicy(deletePolicyOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(apiErrorf("iam", err, response, "Error deleting trusted profile policy"))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIBMIAMTrustedProfilePolicyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return false, err
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return false, err
+	}
+	profileID := parts[0]
+	profilePolicyID := parts[1]
+
+	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
+		profilePolicyID,
+	)
+
+	profilePolicy, response, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return false, nil
+		}
+		return false, apiErrorf("iam", err, response, "Error communicating with the API")
+	}
+
+	tempID := fmt.Sprintf("%s/%s", profileID, *profilePolicy.ID)
+
+	return tempID == d.Id(), nil
+}
+
+func importTrustedProfilePolicy(d *schema.ResourceData, meta interface{}) (interface{}, interface{}, error) {
+
+	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return nil, nil, err
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return nil, nil, err
+	}
+	profilePolicyID := parts[1]
+	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
+		profilePolicyID,
+	)
+	profilePolicy, response, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	if err != nil {
+		return nil, nil, apiErrorf("iam", err, response, "Error retrieving trusted profile policy")
+	}
+	resources := flattenPolicyResource(profilePolicy.Resources)
+	resource_attributes := flattenPolicyResourceAttributes(profilePolicy.Resources)
+	return resources, resource_attributes, nil
+}
+
+// trustedProfilePolicyIAMID returns the IAM ID of the trusted profile, the subject of
+// the policy.
+func trustedProfilePolicyIAMID(d *schema.ResourceData, meta interface{}) (string, error) {
+	if v, ok := d.GetOk("iam_id"); ok && v != nil {
+		return v.(string), nil
+	}
+	profileID := d.Get("profile_id").(string)
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return "", err
+	}
+	profile, response, err := getIAMTrustedProfile(iamIdentityClient, profileID)
+	if err != nil {
+		return "", apiErrorf("iam", err, response, "Error getting trusted profile %s", profileID)
+	}
+	return profile.IamID, nil
+}
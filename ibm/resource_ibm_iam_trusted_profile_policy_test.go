@@ -0,0 +1,154 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMIAMTrustedProfilePolicy_Basic(t *testing.T) {
+	var conf iampolicymanagementv1.Policy
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMIAMTrustedProfilePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMTrustedProfilePolicyBasic(name, `["Reader"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIAMTrustedProfilePolicyExists("ibm_iam_trusted_profile_policy.policy", conf),
+					resource.TestCheckResourceAttrPair("ibm_iam_trusted_profile_policy.policy", "profile_id", "ibm_iam_trusted_profile.profile", "id"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_policy.policy", "roles.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_policy.policy", "resources.0.service", "cloud-object-storage"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMTrustedProfilePolicyBasic(name, `["Reader", "Writer"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_policy.policy", "roles.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "ibm_iam_trusted_profile_policy.policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIBMIAMTrustedProfilePolicy_With_IAM_ID(t *testing.T) {
+	var conf iampolicymanagementv1.Policy
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMIAMTrustedProfilePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMTrustedProfilePolicyIAMID(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIAMTrustedProfilePolicyExists("ibm_iam_trusted_profile_policy.policy", conf),
+					resource.TestCheckResourceAttrPair("ibm_iam_trusted_profile_policy.policy", "iam_id", "ibm_iam_trusted_profile.profile", "iam_id"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_policy.policy", "account_management", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMTrustedProfilePolicyDestroy(s *terraform.State) error {
+	rsContClient, err := testAccProvider.Meta().(ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_iam_trusted_profile_policy" {
+			continue
+		}
+		parts, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		getPolicyOptions := rsContClient.NewGetPolicyOptions(
+			parts[1],
+		)
+
+		destroyedPolicy, response, err := rsContClient.GetPolicy(getPolicyOptions)
+		if err == nil && *destroyedPolicy.State != "deleted" {
+			return fmt.Errorf("Trusted profile policy still exists: %s\n", rs.Primary.ID)
+		} else if response.StatusCode != 404 && *destroyedPolicy.State != "deleted" {
+			return fmt.Errorf("Error waiting for trusted profile policy (%s) to be destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMIAMTrustedProfilePolicyExists(n string, obj iampolicymanagementv1.Policy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		rsContClient, err := testAccProvider.Meta().(ClientSession).IAMPolicyManagementV1API()
+		if err != nil {
+			return err
+		}
+		parts, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		getPolicyOptions := rsContClient.NewGetPolicyOptions(
+			parts[1],
+		)
+
+		policy, _, err := rsContClient.GetPolicy(getPolicyOptions)
+		if err != nil {
+			return err
+		}
+		obj = *policy
+		return nil
+	}
+}
+
+func testAccCheckIBMIAMTrustedProfilePolicyBasic(name, roles string) string {
+	return fmt.Sprintf(`
+	resource "ibm_iam_trusted_profile" "profile" {
+		name = "%s"
+	}
+
+	resource "ibm_iam_trusted_profile_policy" "policy" {
+		profile_id = ibm_iam_trusted_profile.profile.id
+		roles      = %s
+		resources {
+			service = "cloud-object-storage"
+		}
+	}
+	`, name, roles)
+}
+
+func testAccCheckIBMIAMTrustedProfilePolicyIAMID(name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_iam_trusted_profile" "profile" {
+		name = "%s"
+	}
+
+	resource "ibm_iam_trusted_profile_policy" "policy" {
+		iam_id             = ibm_iam_trusted_profile.profile.iam_id
+		roles              = ["Viewer"]
+		account_management = true
+	}
+	`, name)
+}
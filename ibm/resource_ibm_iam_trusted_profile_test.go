@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
+)
+
+func TestAccIBMIAMTrustedProfile_basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMIAMTrustedProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMTrustedProfileConfig(name, "first description"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile.profile", "name", name),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile.profile", "description", "first description"),
+					resource.TestCheckResourceAttrSet("ibm_iam_trusted_profile.profile", "iam_id"),
+					resource.TestCheckResourceAttrSet("ibm_iam_trusted_profile.profile", "crn"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMTrustedProfileConfig(name+"_renamed", "second description"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile.profile", "name", name+"_renamed"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile.profile", "description", "second description"),
+				),
+			},
+			{
+				ResourceName:      "ibm_iam_trusted_profile.profile",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMTrustedProfileDestroy(s *terraform.State) error {
+	iamIdentityClient, err := testAccProvider.Meta().(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_iam_trusted_profile" {
+			continue
+		}
+		_, response, err := getIAMTrustedProfile(iamIdentityClient, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Trusted profile still exists: %s", rs.Primary.ID)
+		} else if response == nil || response.StatusCode != 404 {
+			return fmt.Errorf("Error waiting for trusted profile (%s) to be destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMIAMTrustedProfileConfig(name, description string) string {
+	return fmt.Sprintf(`
+	resource "ibm_iam_trusted_profile" "profile" {
+		name        = "%s"
+		description = "%s"
+	}
+	`, name, description)
+}
+
+func TestIBMIAMTrustedProfileMock(t *testing.T) {
+	t.Parallel()
+	srv := testMockServer(t)
+	meta := testMockClientSession(t, srv)
+	r := resourceIBMIAMTrustedProfile()
+
+	raw := map[string]interface{}{
+		"name":        "mock-profile",
+		"description": "created by the mock test",
+	}
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	d.MarkNewResource()
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), d, meta)))
+	assert.Assert(t, strings.HasPrefix(d.Id(), "Profile-"))
+	assert.Equal(t, d.Get("profile_id"), d.Id())
+	assert.Equal(t, d.Get("iam_id"), "iam-"+d.Id())
+	assert.Equal(t, d.Get("account_id"), srv.Account)
+	tag := d.Get("entity_tag").(string)
+	assert.Assert(t, tag != "")
+
+	duplicate := schema.TestResourceDataRaw(t, r.Schema, raw)
+	assert.ErrorContains(t, testDiagsErr(r.CreateContext(context.Background(), duplicate, meta)), "already exists")
+
+	raw["name"] = "mock-profile-renamed"
+	delete(raw, "description")
+	updated := testMockResourceData(t, r, d, raw)
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), updated, meta)))
+	assert.Equal(t, updated.Get("name"), "mock-profile-renamed")
+	assert.Equal(t, updated.Get("description"), "")
+	assert.Assert(t, updated.Get("entity_tag") != tag)
+
+	// The update of a profile changed since it was read conflicts
+	stale := testMockResourceData(t, r, d, map[string]interface{}{"name": "mock-profile-stale"})
+	assert.ErrorContains(t, testDiagsErr(r.UpdateContext(context.Background(), stale, meta)), "409")
+
+	imported := r.Data(nil)
+	imported.SetId(d.Id())
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), imported, meta)))
+	assert.Equal(t, imported.Get("name"), "mock-profile-renamed")
+	assert.Equal(t, imported.Get("crn"), updated.Get("crn"))
+
+	assert.NilError(t, testDiagsErr(r.DeleteContext(context.Background(), updated, meta)))
+	assert.Equal(t, updated.Id(), "")
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), imported, meta)))
+	assert.Equal(t, imported.Id(), "")
+}
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -13,6 +14,7 @@ import (
 	v2 "github.com/IBM-Cloud/bluemix-go/api/usermanagement/usermanagementv2"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -130,12 +132,11 @@ var permissionSets = map[string][]string{NOACCESS: noAccess, VIEWONLY: viewOnly,
 
 func resourceIBMUserInvite() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMIAMInviteUsers,
-		Read:     resourceIBMIAMGetUsers,
-		Update:   resourceIBMIAMUpdateUserProfile,
-		Delete:   resourceIBMIAMRemoveUser,
-		Exists:   resourceIBMIAMGetUserProfileExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMIAMInviteUsers,
+		ReadContext:   resourceIBMIAMGetUsers,
+		UpdateContext: resourceIBMIAMUpdateUserProfile,
+		DeleteContext: resourceIBMIAMRemoveUser,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 
 			"users": {
@@ -468,10 +469,10 @@ func resourceIBMUserInvite() *schema.Resource {
 	}
 }
 
-func resourceIBMIAMInviteUsers(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMInviteUsers(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userManagement, err := meta.(ClientSession).UserManagementAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	client := userManagement.UserInvite()
 
@@ -482,7 +483,7 @@ func resourceIBMIAMInviteUsers(d *schema.ResourceData, meta interface{}) error {
 		users = append(users, v2.User{Email: user, AccountRole: MEMBER})
 	}
 	if len(users) == 0 {
-		return fmt.Errorf("Users email not provided")
+		return diag.FromErr(fmt.Errorf("Users email not provided"))
 	}
 	var accessGroups = make([]string, 0)
 	if data, ok := d.GetOk("access_groups"); ok {
@@ -496,7 +497,7 @@ func resourceIBMIAMInviteUsers(d *schema.ResourceData, meta interface{}) error {
 		accessPolicies, err = getPolicies(d, meta, accessPolicyData.([]interface{}))
 		if err != nil {
 			log.Println("IAM Acess policy: ", err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -515,7 +516,7 @@ func resourceIBMIAMInviteUsers(d *schema.ResourceData, meta interface{}) error {
 	}
 	orgRoles, err := getCloudFoundryRoles(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if len(orgRoles) != 0 {
 		inviteUserPayload.OrganizationRoles = orgRoles
@@ -523,38 +524,47 @@ func resourceIBMIAMInviteUsers(d *schema.ResourceData, meta interface{}) error {
 
 	accountID, err := getAccountID(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_, InviteUserError := client.InviteUsers(accountID, inviteUserPayload)
 	if InviteUserError != nil {
-		return InviteUserError
+		return diag.FromErr(InviteUserError)
 	}
 	d.SetId(time.Now().UTC().String())
-	return resourceIBMIAMUpdateUserProfile(d, meta)
+	return resourceIBMIAMUpdateUserProfile(context, d, meta)
 }
 
-func resourceIBMIAMGetUsers(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMGetUsers(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMIAMGetUserProfileExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	userManagement, err := meta.(ClientSession).UserManagementAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	Client := userManagement.UserInvite()
 	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	iamuumClient, err := meta.(ClientSession).IAMUUMAPIV2()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	accountID, err := getAccountID(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	res, err := Client.ListUsers(accountID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	users := make([]string, 0)
 	invitedUsers := make([]map[string]interface{}, 0, len(res))
@@ -580,7 +590,7 @@ func resourceIBMIAMGetUsers(d *schema.ResourceData, meta interface{}) error {
 		policies := policyList.Policies
 
 		if err != nil {
-			return fmt.Errorf("Error retrieving user policies: %s", err)
+			return diag.FromErr(apiErrorf("iam", err, nil, "Error retrieving user policies"))
 		}
 		userPolicies := make([]map[string]interface{}, 0, len(policies))
 		for _, policy := range policies {
@@ -602,7 +612,7 @@ func resourceIBMIAMGetUsers(d *schema.ResourceData, meta interface{}) error {
 		// Get AccessGroups associated with user
 		retreivedGroups, err := iamuumClient.AccessGroup().List(accountID, user.IamID)
 		if err != nil {
-			return fmt.Errorf("Error retrieving access groups: %s", err)
+			return diag.FromErr(apiErrorf("iam", err, nil, "Error retrieving access groups"))
 		}
 
 		accGroupList := make([]map[string]interface{}, 0, len(retreivedGroups))
@@ -614,7 +624,7 @@ func resourceIBMIAMGetUsers(d *schema.ResourceData, meta interface{}) error {
 			})
 			accgrpPolicy := policyList.Policies
 			if err != nil {
-				return fmt.Errorf("Error retrieving access group policy: %s", err)
+				return diag.FromErr(apiErrorf("iam", err, nil, "Error retrieving access group policy"))
 			}
 
 			//Fetch access group policies
@@ -655,11 +665,11 @@ func resourceIBMIAMGetUsers(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMIAMUpdateUserProfile(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMUpdateUserProfile(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// validate change
 	userManagement, err := meta.(ClientSession).UserManagementAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	Client := userManagement.UserInvite()
 
@@ -667,7 +677,7 @@ func resourceIBMIAMUpdateUserProfile(d *schema.ResourceData, meta interface{}) e
 		//var removedUsers, addedUsers []string
 		accountID, err := getAccountID(d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		ousrs, nusrs := d.GetChange("users")
 		old := ousrs.(*schema.Set)
@@ -683,7 +693,7 @@ func resourceIBMIAMUpdateUserProfile(d *schema.ResourceData, meta interface{}) e
 				users = append(users, v2.User{Email: user, AccountRole: MEMBER})
 			}
 			if len(users) == 0 {
-				return fmt.Errorf("Users email not provided")
+				return diag.FromErr(fmt.Errorf("Users email not provided"))
 			}
 
 			var accessPolicies []v2.UserPolicy
@@ -691,7 +701,7 @@ func resourceIBMIAMUpdateUserProfile(d *schema.ResourceData, meta interface{}) e
 				accessPolicies, err = getPolicies(d, meta, accessPolicyData.([]interface{}))
 				if err != nil {
 					log.Println("IAM Acess policy: ", err.Error())
-					return err
+					return diag.FromErr(err)
 				}
 			}
 
@@ -705,7 +715,7 @@ func resourceIBMIAMUpdateUserProfile(d *schema.ResourceData, meta interface{}) e
 			infraPermissions := getInfraPermissions(d, meta)
 			orgRoles, err := getCloudFoundryRoles(d, meta)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			inviteUserPayload := v2.UserInvite{}
@@ -725,7 +735,7 @@ func resourceIBMIAMUpdateUserProfile(d *schema.ResourceData, meta interface{}) e
 			}
 			_, InviteUserError := Client.InviteUsers(accountID, inviteUserPayload)
 			if InviteUserError != nil {
-				return InviteUserError
+				return diag.FromErr(InviteUserError)
 			}
 		}
 
@@ -734,30 +744,30 @@ func resourceIBMIAMUpdateUserProfile(d *schema.ResourceData, meta interface{}) e
 			for _, user := range removed {
 				IAMID, err := getUserIAMID(d, meta, user)
 				if err != nil {
-					return fmt.Errorf("User's IAM ID not found: %s", err.Error())
+					return diag.FromErr(fmt.Errorf("User's IAM ID not found: %s", err.Error()))
 				}
 				Err := Client.RemoveUsers(accountID, IAMID)
 				if Err != nil {
 					log.Println("Failed to remove user: ", user)
-					return Err
+					return diag.FromErr(Err)
 				}
 			}
 		}
 
 	}
-	return resourceIBMIAMGetUsers(d, meta)
+	return resourceIBMIAMGetUsers(context, d, meta)
 }
 
-func resourceIBMIAMRemoveUser(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMRemoveUser(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userManagement, err := meta.(ClientSession).UserManagementAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	Client := userManagement.UserInvite()
 
 	accountID, err := getAccountID(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	usersSet := d.Get("users").(*schema.Set)
@@ -766,11 +776,11 @@ func resourceIBMIAMRemoveUser(d *schema.ResourceData, meta interface{}) error {
 		IAMID, err := getUserIAMID(d, meta, user)
 
 		if err != nil {
-			return fmt.Errorf("User's IAM ID not found: %s", err.Error())
+			return diag.FromErr(fmt.Errorf("User's IAM ID not found: %s", err.Error()))
 		}
 		Err := Client.RemoveUsers(accountID, IAMID)
 		if Err != nil {
-			return Err
+			return diag.FromErr(Err)
 		}
 	}
 	return nil
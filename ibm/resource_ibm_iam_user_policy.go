@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -17,11 +19,10 @@ import (
 
 func resourceIBMIAMUserPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMIAMUserPolicyCreate,
-		Read:   resourceIBMIAMUserPolicyRead,
-		Update: resourceIBMIAMUserPolicyUpdate,
-		Delete: resourceIBMIAMUserPolicyDelete,
-		Exists: resourceIBMIAMUserPolicyExists,
+		CreateContext: resourceIBMIAMUserPolicyCreate,
+		ReadContext:   resourceIBMIAMUserPolicyRead,
+		UpdateContext: resourceIBMIAMUserPolicyUpdate,
+		DeleteContext: resourceIBMIAMUserPolicyDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				resources, resourceAttributes, err := importServicePolicy(d, meta)
@@ -145,31 +146,31 @@ func resourceIBMIAMUserPolicy() *schema.Resource {
 	}
 }
 
-func resourceIBMIAMUserPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMUserPolicyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var policyOptions iampolicymanagementv1.CreatePolicyOptions
 	policyOptions, err = generatePolicyOptions(d, meta)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	userEmail := d.Get("ibm_id").(string)
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	accountID := userDetails.userAccount
 
 	ibmUniqueID, err := getIBMUniqueId(accountID, userEmail, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	subjectAttribute := &iampolicymanagementv1.SubjectAttribute{
@@ -201,7 +202,7 @@ func resourceIBMIAMUserPolicyCreate(d *schema.ResourceData, meta interface{}) er
 	userPolicy, _, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", userEmail, *userPolicy.ID))
@@ -229,28 +230,37 @@ func resourceIBMIAMUserPolicyCreate(d *schema.ResourceData, meta interface{}) er
 		_, _, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
 	}
 	if err != nil {
-		return fmt.Errorf("error fetching user policy: %w", err)
+		return diag.FromErr(apiErrorf("iam", err, nil, "error fetching user policy"))
 	}
 
-	return resourceIBMIAMUserPolicyRead(d, meta)
+	return resourceIBMIAMUserPolicyRead(context, d, meta)
 }
 
-func resourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMUserPolicyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMIAMUserPolicyExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	userEmail := parts[0]
 	userPolicyID := parts[1]
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	getPolicyOptions := &iampolicymanagementv1.GetPolicyOptions{
@@ -259,7 +269,7 @@ func resourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) erro
 
 	userPolicy, _, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set("ibm_id", userEmail)
 	roles := make([]string, len(userPolicy.Roles))
@@ -285,34 +295,34 @@ func resourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMUserPolicyUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") {
 		parts, err := idParts(d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		userEmail := parts[0]
 		userPolicyID := parts[1]
 
 		userDetails, err := meta.(ClientSession).BluemixUserDetails()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		accountID := userDetails.userAccount
 
 		createPolicyOptions, err := generatePolicyOptions(d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		ibmUniqueID, err := getIBMUniqueId(accountID, userEmail, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		accountIDResourceAttribute := &iampolicymanagementv1.ResourceAttribute{
@@ -341,7 +351,7 @@ func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) er
 			if response != nil && response.StatusCode == 404 {
 				return nil
 			}
-			return fmt.Errorf("Error retrieving Policy: %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("iam", err, response, "Error retrieving Policy"))
 		}
 
 		userPolicyETag := response.Headers.Get("ETag")
@@ -356,22 +366,22 @@ func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) er
 
 		policy, _, err = iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
 		if err != nil {
-			return fmt.Errorf("Error updating user policy: %s", err)
+			return diag.FromErr(apiErrorf("iam", err, nil, "Error updating user policy"))
 		}
 	}
-	return resourceIBMIAMUserPolicyRead(d, meta)
+	return resourceIBMIAMUserPolicyRead(context, d, meta)
 }
 
-func resourceIBMIAMUserPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMUserPolicyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	userPolicyID := parts[1]
 
@@ -380,7 +390,7 @@ func resourceIBMIAMUserPolicyDelete(d *schema.ResourceData, meta interface{}) er
 	)
 	_, err = iamPolicyManagementClient.DeletePolicy(deletePolicyOptions)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return nil
@@ -410,7 +420,7 @@ func resourceIBMIAMUserPolicyExists(d *schema.ResourceData, meta interface{}) (b
 				return false, nil
 			}
 		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
+		return false, apiErrorf("iam", err, nil, "Error communicating with the API")
 	}
 
 	tempID := fmt.Sprintf("%s/%s", userEmail, *userPolicy.ID)
@@ -436,7 +446,7 @@ func importUserPolicy(d *schema.ResourceData, meta interface{}) (interface{}, in
 	)
 	userPolicy, _, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
 	if err != nil {
-		return nil, nil, fmt.Errorf("Error retrieving User Policy: %s", err)
+		return nil, nil, apiErrorf("iam", err, nil, "Error retrieving User Policy")
 	}
 	resources := flattenPolicyResource(userPolicy.Resources)
 	resource_attributes := flattenPolicyResourceAttributes(userPolicy.Resources)
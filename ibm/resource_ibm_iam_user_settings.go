@@ -4,10 +4,11 @@
 package ibm
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
 	v2 "github.com/IBM-Cloud/bluemix-go/api/usermanagement/usermanagementv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -18,12 +19,11 @@ const (
 
 func resourceIBMUserSettings() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMIAMUserSettingsCreate,
-		Read:     resourceIBMIAMUserSettingsRead,
-		Update:   resourceIBMIAMUserSettingsUpdate,
-		Delete:   resourceIBMIAMUserSettingsDelete,
-		Exists:   resourceIBMIAMUserSettingsExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMIAMUserSettingsCreate,
+		ReadContext:   resourceIBMIAMUserSettingsRead,
+		UpdateContext: resourceIBMIAMUserSettingsUpdate,
+		DeleteContext: resourceIBMIAMUserSettingsDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 
@@ -45,10 +45,10 @@ func resourceIBMUserSettings() *schema.Resource {
 	}
 }
 
-func resourceIBMIAMUserSettingsCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMUserSettingsCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userManagement, err := meta.(ClientSession).UserManagementAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	client := userManagement.UserInvite()
 
@@ -57,12 +57,12 @@ func resourceIBMIAMUserSettingsCreate(d *schema.ResourceData, meta interface{})
 	//Read from Bluemix UserConfig
 	accountID, err := getUserAccountID(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	iamID, err := getIBMUniqueId(accountID, userEmail, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	UserSettingsPayload := v2.UserSettingOptions{}
@@ -79,12 +79,12 @@ func resourceIBMIAMUserSettingsCreate(d *schema.ResourceData, meta interface{})
 	_, UserSettingError := client.ManageUserSettings(accountID, iamID, UserSettingsPayload)
 
 	if UserSettingError != nil && !strings.Contains(UserSettingError.Error(), "EmptyResponseBody") {
-		return fmt.Errorf("Error occured during user settings: %s", UserSettingError)
+		return diag.FromErr(apiErrorf("user_management", UserSettingError, nil, "Error updating the user settings of %s", userEmail))
 	}
 
 	d.SetId(userEmail)
 
-	return resourceIBMIAMUserSettingsRead(d, meta)
+	return resourceIBMIAMUserSettingsRead(context, d, meta)
 }
 
 func getUserAccountID(d *schema.ResourceData, meta interface{}) (string, error) {
@@ -95,26 +95,35 @@ func getUserAccountID(d *schema.ResourceData, meta interface{}) (string, error)
 	return userDetails.userAccount, nil
 }
 
-func resourceIBMIAMUserSettingsRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMUserSettingsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMIAMUserSettingsExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	userManagement, err := meta.(ClientSession).UserManagementAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	client := userManagement.UserInvite()
 
 	accountID, err := getUserAccountID(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	iamID, err := getIBMUniqueId(accountID, d.Id(), meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	UserSettings, UserSettingError := client.GetUserSettings(accountID, iamID)
 	if UserSettingError != nil {
-		return UserSettingError
+		return diag.FromErr(apiErrorf("user_management", UserSettingError, nil, "Error getting the user settings of %s", d.Id()))
 	}
 
 	iplist := strings.Split(UserSettings.AllowedIPAddresses, ",")
@@ -124,22 +133,22 @@ func resourceIBMIAMUserSettingsRead(d *schema.ResourceData, meta interface{}) er
 
 }
 
-func resourceIBMIAMUserSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMUserSettingsUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// validate change
 	userManagement, err := meta.(ClientSession).UserManagementAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	client := userManagement.UserInvite()
 
 	accountID, err := getUserAccountID(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	iamID, err := getIBMUniqueId(accountID, d.Id(), meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	hasChanged := false
@@ -161,35 +170,35 @@ func resourceIBMIAMUserSettingsUpdate(d *schema.ResourceData, meta interface{})
 	if hasChanged {
 		_, UserSettingError := client.ManageUserSettings(accountID, iamID, userSettingPayload)
 		if UserSettingError != nil && !strings.Contains(UserSettingError.Error(), "EmptyResponseBody") {
-			return fmt.Errorf("Error occured during user settings: %s", UserSettingError)
+			return diag.FromErr(apiErrorf("user_management", UserSettingError, nil, "Error updating the user settings of %s", d.Id()))
 		}
 	}
 
-	return resourceIBMIAMUserSettingsRead(d, meta)
+	return resourceIBMIAMUserSettingsRead(context, d, meta)
 }
 
-func resourceIBMIAMUserSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMUserSettingsDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userManagement, err := meta.(ClientSession).UserManagementAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	client := userManagement.UserInvite()
 
 	accountID, err := getUserAccountID(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	iamID, err := getIBMUniqueId(accountID, d.Id(), meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	userSettingPayload := v2.UserSettingOptions{}
 
 	_, UserSettingError := client.ManageUserSettings(accountID, iamID, userSettingPayload)
 	if UserSettingError != nil && !strings.Contains(UserSettingError.Error(), "EmptyResponseBody") {
-		return fmt.Errorf("Error occured during user settings: %s", UserSettingError)
+		return diag.FromErr(apiErrorf("user_management", UserSettingError, nil, "Error resetting the user settings of %s", d.Id()))
 	}
 
 	return nil
@@ -215,7 +224,7 @@ func resourceIBMIAMUserSettingsExists(d *schema.ResourceData, meta interface{})
 	_, settingErr := client.GetUserSettings(accountID, iamID)
 
 	if settingErr != nil {
-		return false, settingErr
+		return false, apiErrorf("user_management", settingErr, nil, "Error getting the user settings of %s", d.Id())
 	}
 	return true, nil
 }
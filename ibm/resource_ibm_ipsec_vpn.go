@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -23,12 +25,11 @@ import (
 
 func resourceIBMIPSecVPN() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMIPSecVpnCreate,
-		Read:     resourceIBMIPSecVPNRead,
-		Delete:   resourceIBMIPSecVPNDelete,
-		Update:   resourceIBMIPSecVPNUpdate,
-		Exists:   resourceIBMIPSecVPNExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMIPSecVpnCreate,
+		ReadContext:   resourceIBMIPSecVPNRead,
+		DeleteContext: resourceIBMIPSecVPNDelete,
+		UpdateContext: resourceIBMIPSecVPNUpdate,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"datacenter": {
@@ -193,14 +194,14 @@ const (
 	ipsecMask = "billingItem.orderItem.order.id,serviceSubnets,staticRouteSubnets"
 )
 
-func resourceIBMIPSecVpnCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIPSecVpnCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	datacenter := d.Get("datacenter").(string)
 	dc, err := location.GetDatacenterByName(sess, datacenter, "id")
 	locationid := strconv.Itoa(*dc.Id)
 	packageid := 0
 	if err != nil {
-		return fmt.Errorf("Datacenter not found")
+		return diag.FromErr(fmt.Errorf("Datacenter not found"))
 	}
 	locationservice := services.GetLocationService(sess)
 	priceidds, _ := locationservice.Id(*dc.Id).GetPriceGroups()
@@ -227,26 +228,26 @@ func resourceIBMIPSecVpnCreate(d *schema.ResourceData, meta interface{}) error {
 	_, err = services.GetProductOrderService(sess.SetRetries(0)).
 		VerifyOrder(&IPSecOrder)
 	if err != nil {
-		return fmt.Errorf("Error during Verify order for Creating: %s", err)
+		return diag.FromErr(fmt.Errorf("Error during Verify order for Creating: %s", err))
 	}
 
 	//Calling place order
 	receipt, err := services.GetProductOrderService(sess.SetRetries(0)).
 		PlaceOrder(&IPSecOrder, sl.Bool(false))
 	if err != nil {
-		return fmt.Errorf("Error during Place order for Creating: %s", err)
+		return diag.FromErr(fmt.Errorf("Error during Place order for Creating: %s", err))
 	}
-	vpn, _ := findIPSecVpnByOrderID(sess, *receipt.OrderId, d)
+	vpn, _ := findIPSecVpnByOrderID(context, sess, *receipt.OrderId, d)
 	if err != nil {
-		return fmt.Errorf("Error during creation of IPSec VPN: %s", err)
+		return diag.FromErr(fmt.Errorf("Error during creation of IPSec VPN: %s", err))
 	}
 	id := *vpn.Id
 	d.SetId(fmt.Sprintf("%d", id))
 	log.Printf("[INFO] IPSec VPN ID: %s", d.Id())
-	return resourceIBMIPSecVPNUpdate(d, meta)
+	return resourceIBMIPSecVPNUpdate(context, d, meta)
 }
 
-func findIPSecVpnByOrderID(sess *session.Session, orderID int, d *schema.ResourceData) (datatypes.Network_Tunnel_Module_Context, error) {
+func findIPSecVpnByOrderID(context context.Context, sess *session.Session, orderID int, d *schema.ResourceData) (datatypes.Network_Tunnel_Module_Context, error) {
 	filterPath := "networkTunnelContexts.billingItem.orderItem.order.id"
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"pending"},
@@ -275,7 +276,7 @@ func findIPSecVpnByOrderID(sess *session.Session, orderID int, d *schema.Resourc
 		NotFoundChecks: 24 * 60,
 	}
 
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := stateConf.WaitForStateContext(context)
 
 	if err != nil {
 		return datatypes.Network_Tunnel_Module_Context{}, err
@@ -289,7 +290,16 @@ func findIPSecVpnByOrderID(sess *session.Session, orderID int, d *schema.Resourc
 		fmt.Errorf("Cannot find IPSec Vpn with order id '%d'", orderID)
 }
 
-func resourceIBMIPSecVPNRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIPSecVPNRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMIPSecVPNExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 	vpnID, _ := strconv.Atoi(d.Id())
 
@@ -297,7 +307,7 @@ func resourceIBMIPSecVPNRead(d *schema.ResourceData, meta interface{}) error {
 		Id(vpnID).Mask(ipsecMask).
 		GetObject()
 	if err != nil {
-		return fmt.Errorf("Error retrieving firewall information: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving firewall information: %s", err))
 	}
 	d.Set("name", *vpn.Name)
 	d.Set("internal_peer_ip_address", *vpn.InternalPeerIpAddress)
@@ -353,7 +363,7 @@ func resourceIBMIPSecVPNExists(d *schema.ResourceData, meta interface{}) (bool,
 	return true, nil
 }
 
-func resourceIBMIPSecVPNDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIPSecVPNDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	vpnService := services.GetNetworkTunnelModuleContextService(sess)
 
@@ -363,31 +373,31 @@ func resourceIBMIPSecVPNDelete(d *schema.ResourceData, meta interface{}) error {
 	billingItem, err := vpnService.Id(vpnID).GetBillingItem()
 
 	if err != nil {
-		return fmt.Errorf("Error while looking up billing item associated with the ipsecvpn: %s", err)
+		return diag.FromErr(fmt.Errorf("Error while looking up billing item associated with the ipsecvpn: %s", err))
 	}
 
 	if billingItem.Id == nil {
-		return fmt.Errorf("Error while looking up billing item associated with the ipsecvpn: No billing item for ID:%d", vpnID)
+		return diag.FromErr(fmt.Errorf("Error while looking up billing item associated with the ipsecvpn: No billing item for ID:%d", vpnID))
 	}
 
 	success, err := services.GetBillingItemService(sess).Id(*billingItem.Id).CancelService()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if !success {
-		return fmt.Errorf("SoftLayer reported an unsuccessful cancellation")
+		return diag.FromErr(fmt.Errorf("SoftLayer reported an unsuccessful cancellation"))
 	}
 
 	return nil
 }
 
-func resourceIBMIPSecVPNUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIPSecVPNUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	vpnID, err := strconv.Atoi(d.Id())
 	var addresstranslation datatypes.Network_Tunnel_Module_Context_Address_Translation
 	if err != nil {
-		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 	}
 
 	vpn, err := services.GetNetworkTunnelModuleContextService(sess).
@@ -395,7 +405,7 @@ func resourceIBMIPSecVPNUpdate(d *schema.ResourceData, meta interface{}) error {
 		GetObject()
 
 	if err != nil {
-		return fmt.Errorf("Error updating storage information: %s", err)
+		return diag.FromErr(fmt.Errorf("Error updating storage information: %s", err))
 	}
 	if d.HasChange("phase_one") {
 		for _, e := range d.Get("phase_one").([]interface{}) {
@@ -434,28 +444,28 @@ func resourceIBMIPSecVPNUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		_, err = services.GetNetworkTunnelModuleContextService(sess).Id(vpnID).EditObject(&vpn)
 		if err != nil {
-			return fmt.Errorf("SoftLayer reported an unsuccessful edit")
+			return diag.FromErr(fmt.Errorf("SoftLayer reported an unsuccessful edit"))
 		}
 	}
 	if d.HasChange("internal_subnet_id") {
 		subnetid := d.Get("internal_subnet_id").(int)
 		_, err = services.GetNetworkTunnelModuleContextService(sess).AddPrivateSubnetToNetworkTunnel(&subnetid)
 		if err != nil {
-			return fmt.Errorf("Unable to find object with id of: %s", err)
+			return diag.FromErr(fmt.Errorf("Unable to find object with id of: %s", err))
 		}
 	}
 	if d.HasChange("remote_subnet_id") {
 		subnetid := d.Get("remote_subnet_id").(int)
 		_, err = services.GetNetworkTunnelModuleContextService(sess).AddCustomerSubnetToNetworkTunnel(&subnetid)
 		if err != nil {
-			return fmt.Errorf("Unable to find object with id of: %s", err)
+			return diag.FromErr(fmt.Errorf("Unable to find object with id of: %s", err))
 		}
 	}
 	if d.HasChange("service_subnet_id") {
 		subnetid := d.Get("service_subnet_id").(int)
 		_, err = services.GetNetworkTunnelModuleContextService(sess).AddServiceSubnetToNetworkTunnel(&subnetid)
 		if err != nil {
-			return fmt.Errorf("Unable to find object with id of: %s", err)
+			return diag.FromErr(fmt.Errorf("Unable to find object with id of: %s", err))
 		}
 	}
 	if d.HasChange("address_translation") {
@@ -470,7 +480,7 @@ func resourceIBMIPSecVPNUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		_, err = services.GetNetworkTunnelModuleContextService(sess).Id(vpnID).CreateAddressTranslation(&addresstranslation)
 		if err != nil {
-			return fmt.Errorf("Unable to create the address translation: %s", err)
+			return diag.FromErr(fmt.Errorf("Unable to create the address translation: %s", err))
 		}
 	}
 	if d.HasChange("remote_subnet") {
@@ -487,11 +497,11 @@ func resourceIBMIPSecVPNUpdate(d *schema.ResourceData, meta interface{}) error {
 			remoteSubnet.AccountId = &accountID
 			subnet, err := services.GetNetworkCustomerSubnetService(sess).Id(vpnID).CreateObject(&remoteSubnet)
 			if err != nil {
-				return fmt.Errorf("Some error occured creating the customer subnet resource %s", err)
+				return diag.FromErr(fmt.Errorf("Some error occured creating the customer subnet resource %s", err))
 			}
 			_, err = services.GetNetworkTunnelModuleContextService(sess).Id(vpnID).AddCustomerSubnetToNetworkTunnel(subnet.Id)
 			if err != nil {
-				return fmt.Errorf("Some error occured adding the customer subnet to the network tunnel module %s", err)
+				return diag.FromErr(fmt.Errorf("Some error occured adding the customer subnet to the network tunnel module %s", err))
 			}
 
 		}
@@ -500,14 +510,14 @@ func resourceIBMIPSecVPNUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		_, err = services.GetNetworkTunnelModuleContextService(sess).Id(vpnID).ApplyConfigurationsToDevice()
 		if err != nil {
-			return fmt.Errorf("There is some erorr applying the configuration %s", err)
+			return diag.FromErr(fmt.Errorf("There is some erorr applying the configuration %s", err))
 		}
 	} else if _, ok := d.GetOk("remote_subnet"); ok {
 		_, err = services.GetNetworkTunnelModuleContextService(sess).Id(vpnID).ApplyConfigurationsToDevice()
 		if err != nil {
-			return fmt.Errorf("There is some erorr applying the configuration %s", err)
+			return diag.FromErr(fmt.Errorf("There is some erorr applying the configuration %s", err))
 		}
 	}
 
-	return resourceIBMIPSecVPNRead(d, meta)
+	return resourceIBMIPSecVPNRead(context, d, meta)
 }
@@ -406,12 +406,12 @@ func resourceIbmIsDedicatedHostCreate(context context.Context, d *schema.Resourc
 	dedicatedHost, response, err := vpcClient.CreateDedicatedHostWithContext(context, createDedicatedHostOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateDedicatedHostWithContext failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error creating dedicated host"))
 	}
 
 	d.SetId(*dedicatedHost.ID)
 
-	_, err = isWaitForDedicatedHostAvailable(vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForDedicatedHostAvailable(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -436,7 +436,7 @@ func resourceIbmIsDedicatedHostRead(context context.Context, d *schema.ResourceD
 			return nil
 		}
 		log.Printf("[DEBUG] GetDedicatedHostWithContext failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error getting dedicated host (%s)", d.Id()))
 	}
 
 	if err = d.Set("available_memory", intValue(dedicatedHost.AvailableMemory)); err != nil {
@@ -607,7 +607,7 @@ func resourceIbmIsDedicatedHostUpdate(context context.Context, d *schema.Resourc
 		_, response, err := vpcClient.UpdateDedicatedHostWithContext(context, updateDedicatedHostOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateDedicatedHostWithContext fails %s\n%s", err, response)
-			return diag.FromErr(err)
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error updating dedicated host (%s)", d.Id()))
 		}
 	}
 
@@ -631,7 +631,7 @@ func resourceIbmIsDedicatedHostDelete(context context.Context, d *schema.Resourc
 			return nil
 		}
 		log.Printf("[DEBUG] GetDedicatedHostWithContext failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error getting dedicated host (%s)", d.Id()))
 	}
 	if dedicatedHost != nil && dedicatedHost.LifecycleState != nil && *dedicatedHost.LifecycleState != isDedicatedHostSuspended && *dedicatedHost.LifecycleState != isDedicatedHostFailed {
 
@@ -643,7 +643,7 @@ func resourceIbmIsDedicatedHostDelete(context context.Context, d *schema.Resourc
 		_, updateresponse, err := vpcClient.UpdateDedicatedHostWithContext(context, updateDedicatedHostOptions)
 		if err != nil {
 			log.Printf("[DEBUG] Failed disabling instance placement %s\n%s", err, updateresponse)
-			return diag.FromErr(err)
+			return diag.FromErr(apiErrorf("vpc", err, updateresponse, "Error disabling the instance placement of dedicated host (%s)", d.Id()))
 		}
 	}
 	deleteDedicatedHostOptions := &vpcv1.DeleteDedicatedHostOptions{}
@@ -653,9 +653,9 @@ func resourceIbmIsDedicatedHostDelete(context context.Context, d *schema.Resourc
 	response, err = vpcClient.DeleteDedicatedHostWithContext(context, deleteDedicatedHostOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteDedicatedHostWithContext failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error deleting dedicated host (%s)", d.Id()))
 	}
-	_, err = isWaitForDedicatedHostDelete(vpcClient, d, d.Id())
+	_, err = isWaitForDedicatedHostDelete(context, vpcClient, d, d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -665,7 +665,7 @@ func resourceIbmIsDedicatedHostDelete(context context.Context, d *schema.Resourc
 	return nil
 }
 
-func isWaitForDedicatedHostDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
+func isWaitForDedicatedHostDelete(context context.Context, instanceC *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isDedicatedHostDeleting, isDedicatedHostStable},
@@ -679,7 +679,7 @@ func isWaitForDedicatedHostDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData
 				if response != nil && response.StatusCode == 404 {
 					return dedicatedhost, isDedicatedHostDeleteDone, nil
 				}
-				return nil, "", fmt.Errorf("Error getting dedicated Host: %s\n%s", err, response)
+				return nil, "", apiErrorf("vpc", err, response, "Error getting dedicated Host")
 			}
 			if *dedicatedhost.State == isDedicatedHostFailed {
 				return dedicatedhost, *dedicatedhost.State, fmt.Errorf("The  Dedicated host %s failed to delete: %v", d.Id(), err)
@@ -691,10 +691,10 @@ func isWaitForDedicatedHostDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
-func isWaitForDedicatedHostAvailable(instanceC *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForDedicatedHostAvailable(context context.Context, instanceC *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for dedicated host (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -706,7 +706,7 @@ func isWaitForDedicatedHostAvailable(instanceC *vpcv1.VpcV1, id string, timeout
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isDedicatedHostRefreshFunc(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData) resource.StateRefreshFunc {
@@ -716,7 +716,7 @@ func isDedicatedHostRefreshFunc(instanceC *vpcv1.VpcV1, id string, d *schema.Res
 		}
 		dhost, response, err := instanceC.GetDedicatedHost(getinsOptions)
 		if dhost == nil || err != nil {
-			return nil, "", fmt.Errorf("Error getting dedicated host : %s\n%s", err, response)
+			return nil, "", apiErrorf("vpc", err, response, "Error getting dedicated host")
 		}
 		d.Set("state", *dhost.State)
 		d.Set("lifecycle_state", *dhost.LifecycleState)
@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -14,11 +16,11 @@ const ()
 
 func resourceIBMISDedicatedHostDiskManagement() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMisDedicatedHostDiskManagementCreate,
-		Read:     resourceIBMisDedicatedHostDiskManagementRead,
-		Update:   resourceIBMisDedicatedHostDiskManagementUpdate,
-		Delete:   resourceIBMisDedicatedHostDiskManagementDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMisDedicatedHostDiskManagementCreate,
+		ReadContext:   resourceIBMisDedicatedHostDiskManagementRead,
+		UpdateContext: resourceIBMisDedicatedHostDiskManagementUpdate,
+		DeleteContext: resourceIBMisDedicatedHostDiskManagementDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"dedicated_host": {
@@ -68,10 +70,10 @@ func resourceIBMISDedicatedHostDiskManagementValidator() *ResourceValidator {
 	return &ibmISDedicatedHostDiskManagementValidator
 }
 
-func resourceIBMisDedicatedHostDiskManagementCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisDedicatedHostDiskManagementCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dedicatedhost := d.Get("dedicated_host").(string)
 	disks := d.Get("disks")
@@ -91,24 +93,24 @@ func resourceIBMisDedicatedHostDiskManagementCreate(d *schema.ResourceData, meta
 
 		dedicatedHostDiskPatch, err := dedicatedHostDiskPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for DedicatedHostDiskPatch: %s", err)
+			return diag.FromErr(fmt.Errorf("Error calling asPatch for DedicatedHostDiskPatch: %s", err))
 		}
 		updateDedicatedHostDiskOptions.SetDedicatedHostDiskPatch(dedicatedHostDiskPatch)
 
 		_, _, err = sess.UpdateDedicatedHostDisk(updateDedicatedHostDiskOptions)
 		if err != nil {
-			return fmt.Errorf("Error calling UpdateDedicatedHostDisk: %s", err)
+			return diag.FromErr(fmt.Errorf("Error calling UpdateDedicatedHostDisk: %s", err))
 		}
 
 	}
 	d.SetId(dedicatedhost)
-	return resourceIBMisDedicatedHostDiskManagementRead(d, meta)
+	return resourceIBMisDedicatedHostDiskManagementRead(context, d, meta)
 }
 
-func resourceIBMisDedicatedHostDiskManagementUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisDedicatedHostDiskManagementUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if d.HasChange("disks") && !d.IsNewResource() {
 
@@ -128,28 +130,28 @@ func resourceIBMisDedicatedHostDiskManagementUpdate(d *schema.ResourceData, meta
 
 			dedicatedHostDiskPatch, err := dedicatedHostDiskPatchModel.AsPatch()
 			if err != nil {
-				return fmt.Errorf("Error calling asPatch for DedicatedHostDiskPatch: %s", err)
+				return diag.FromErr(fmt.Errorf("Error calling asPatch for DedicatedHostDiskPatch: %s", err))
 			}
 			updateDedicatedHostDiskOptions.SetDedicatedHostDiskPatch(dedicatedHostDiskPatch)
 
 			_, response, err := sess.UpdateDedicatedHostDisk(updateDedicatedHostDiskOptions)
 			if err != nil {
-				return fmt.Errorf("Error updating dedicated host disk: %s %s", err, response)
+				return diag.FromErr(apiErrorf("vpc", err, response, "Error updating dedicated host disk"))
 			}
 
 		}
 
 	}
-	return resourceIBMisDedicatedHostDiskManagementRead(d, meta)
+	return resourceIBMisDedicatedHostDiskManagementRead(context, d, meta)
 }
 
-func resourceIBMisDedicatedHostDiskManagementDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisDedicatedHostDiskManagementDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	d.SetId("")
 	return nil
 }
 
-func resourceIBMisDedicatedHostDiskManagementRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisDedicatedHostDiskManagementRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	d.Set("dedicated_host", d.Id())
 
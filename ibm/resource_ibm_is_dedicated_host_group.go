@@ -208,7 +208,7 @@ func resourceIbmIsDedicatedHostGroupCreate(context context.Context, d *schema.Re
 	dedicatedHostGroup, response, err := vpcClient.CreateDedicatedHostGroupWithContext(context, createDedicatedHostGroupOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateDedicatedHostGroupWithContext failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error creating dedicated host group"))
 	}
 
 	d.SetId(*dedicatedHostGroup.ID)
@@ -251,7 +251,7 @@ func resourceIbmIsDedicatedHostGroupRead(context context.Context, d *schema.Reso
 			return nil
 		}
 		log.Printf("[DEBUG] GetDedicatedHostGroupWithContext failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error getting dedicated host group (%s)", d.Id()))
 	}
 
 	if err = d.Set("class", dedicatedHostGroup.Class); err != nil {
@@ -411,7 +411,7 @@ func resourceIbmIsDedicatedHostGroupUpdate(context context.Context, d *schema.Re
 		_, response, err := vpcClient.UpdateDedicatedHostGroupWithContext(context, updateDedicatedHostGroupOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateDedicatedHostGroupWithContext failed %s\n%s", err, response)
-			return diag.FromErr(err)
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error updating dedicated host group (%s)", d.Id()))
 		}
 	}
 
@@ -435,7 +435,7 @@ func resourceIbmIsDedicatedHostGroupDelete(context context.Context, d *schema.Re
 			return nil
 		}
 		log.Printf("[DEBUG] GetDedicatedHostGroupWithContext failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error getting dedicated host group (%s)", d.Id()))
 	}
 
 	deleteDedicatedHostGroupOptions := &vpcv1.DeleteDedicatedHostGroupOptions{}
@@ -445,7 +445,7 @@ func resourceIbmIsDedicatedHostGroupDelete(context context.Context, d *schema.Re
 	response, err = vpcClient.DeleteDedicatedHostGroupWithContext(context, deleteDedicatedHostGroupOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteDedicatedHostGroupWithContext failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error deleting dedicated host group (%s)", d.Id()))
 	}
 
 	d.SetId("")
@@ -12,6 +12,7 @@ import (
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -34,12 +35,11 @@ const (
 
 func resourceIBMISFloatingIP() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISFloatingIPCreate,
-		Read:     resourceIBMISFloatingIPRead,
-		Update:   resourceIBMISFloatingIPUpdate,
-		Delete:   resourceIBMISFloatingIPDelete,
-		Exists:   resourceIBMISFloatingIPExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISFloatingIPCreate,
+		ReadContext:   resourceIBMISFloatingIPRead,
+		UpdateContext: resourceIBMISFloatingIPUpdate,
+		DeleteContext: resourceIBMISFloatingIPDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -177,32 +177,34 @@ func resourceIBMISFloatingIPValidator() *ResourceValidator {
 	return &ibmISFloatingIPResourceValidator
 }
 
-func resourceIBMISFloatingIPCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISFloatingIPCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	name := d.Get(isFloatingIPName).(string)
 	if userDetails.generation == 1 {
-		err := classicFipCreate(d, meta, name)
-		if err != nil {
-			return err
+		diags = append(diags, classicFipCreate(context, d, meta, name)...)
+		if diags.HasError() {
+			return diags
 		}
 	} else {
-		err := fipCreate(d, meta, name)
-		if err != nil {
-			return err
+		diags = append(diags, fipCreate(context, d, meta, name)...)
+		if diags.HasError() {
+			return diags
 		}
 	}
 
-	return resourceIBMISFloatingIPRead(d, meta)
+	return append(diags, resourceIBMISFloatingIPRead(context, d, meta)...)
 }
-func classicFipCreate(d *schema.ResourceData, meta interface{}, name string) error {
+func classicFipCreate(context context.Context, d *schema.ResourceData, meta interface{}, name string) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := classicVpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	floatingIPPrototype := &vpcclassicv1.FloatingIPPrototype{
@@ -225,7 +227,7 @@ func classicFipCreate(d *schema.ResourceData, meta interface{}, name string) err
 	}
 
 	if zone == "" && target == "" {
-		return fmt.Errorf("%s or %s need to be provided", isFloatingIPZone, isFloatingIPTarget)
+		return diag.FromErr(fmt.Errorf("%s or %s need to be provided", isFloatingIPZone, isFloatingIPTarget))
 	}
 
 	createFloatingIPOptions := &vpcclassicv1.CreateFloatingIPOptions{
@@ -233,13 +235,13 @@ func classicFipCreate(d *schema.ResourceData, meta interface{}, name string) err
 	}
 	floatingip, response, err := sess.CreateFloatingIP(createFloatingIPOptions)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Floating IP err %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc_classic", err, response, "Error creating Floating IP"))
 	}
 	d.SetId(*floatingip.ID)
 	log.Printf("[INFO] Floating IP : %s[%s]", *floatingip.ID, *floatingip.Address)
-	_, err = isWaitForClassicInstanceFloatingIP(sess, d.Id(), d)
+	_, err = isWaitForClassicInstanceFloatingIP(context, sess, d.Id(), d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isFloatingIPTags); ok || v != "" {
@@ -248,15 +250,17 @@ func classicFipCreate(d *schema.ResourceData, meta interface{}, name string) err
 		if err != nil {
 			log.Printf(
 				"Error on create of vpc Floating IP (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
-	return nil
+	return diags
 }
 
-func fipCreate(d *schema.ResourceData, meta interface{}, name string) error {
+func fipCreate(context context.Context, d *schema.ResourceData, meta interface{}, name string) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	floatingIPPrototype := &vpcv1.FloatingIPPrototype{
@@ -278,7 +282,7 @@ func fipCreate(d *schema.ResourceData, meta interface{}, name string) error {
 	}
 
 	if zone == "" && target == "" {
-		return fmt.Errorf("%s or %s need to be provided", isFloatingIPZone, isFloatingIPTarget)
+		return diag.FromErr(fmt.Errorf("%s or %s need to be provided", isFloatingIPZone, isFloatingIPTarget))
 	}
 
 	if rgrp, ok := d.GetOk(isFloatingIPResourceGroup); ok {
@@ -294,13 +298,13 @@ func fipCreate(d *schema.ResourceData, meta interface{}, name string) error {
 
 	floatingip, response, err := sess.CreateFloatingIP(createFloatingIPOptions)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Floating IP err %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error creating Floating IP"))
 	}
 	d.SetId(*floatingip.ID)
 	log.Printf("[INFO] Floating IP : %s[%s]", *floatingip.ID, *floatingip.Address)
-	_, err = isWaitForInstanceFloatingIP(sess, d.Id(), d)
+	_, err = isWaitForInstanceFloatingIP(context, sess, d.Id(), d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isFloatingIPTags); ok || v != "" {
@@ -309,27 +313,37 @@ func fipCreate(d *schema.ResourceData, meta interface{}, name string) error {
 		if err != nil {
 			log.Printf(
 				"Error on create of vpc Floating IP (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
-	return nil
+	return diags
 }
 
-func resourceIBMISFloatingIPRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISFloatingIPRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMISFloatingIPExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicFipGet(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := fipGet(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -349,7 +363,7 @@ func classicFipGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Floating IP (%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting Floating IP (%s)", id)
 
 	}
 	d.Set(isFloatingIPName, *floatingip.Name)
@@ -391,7 +405,7 @@ func fipGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Floating IP (%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc", err, response, "Error Getting Floating IP (%s)", id)
 
 	}
 	d.Set(isFloatingIPName, *floatingip.Name)
@@ -423,31 +437,33 @@ func fipGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISFloatingIPUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISFloatingIPUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	id := d.Id()
 	if userDetails.generation == 1 {
-		err := classicFipUpdate(d, meta, id)
-		if err != nil {
-			return err
+		diags = append(diags, classicFipUpdate(d, meta, id)...)
+		if diags.HasError() {
+			return diags
 		}
 	} else {
-		err := fipUpdate(d, meta, id)
-		if err != nil {
-			return err
+		diags = append(diags, fipUpdate(d, meta, id)...)
+		if diags.HasError() {
+			return diags
 		}
 	}
-	return resourceIBMISFloatingIPRead(d, meta)
+	return append(diags, resourceIBMISFloatingIPRead(context, d, meta)...)
 }
 
-func classicFipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
+func classicFipUpdate(d *schema.ResourceData, meta interface{}, id string) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := classicVpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if d.HasChange(isFloatingIPTags) {
 		options := &vpcclassicv1.GetFloatingIPOptions{
@@ -455,13 +471,14 @@ func classicFipUpdate(d *schema.ResourceData, meta interface{}, id string) error
 		}
 		fip, response, err := sess.GetFloatingIP(options)
 		if err != nil {
-			return fmt.Errorf("Error getting Floating IP: %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc_classic", err, response, "Error getting Floating IP"))
 		}
 		oldList, newList := d.GetChange(isFloatingIPTags)
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *fip.CRN)
 		if err != nil {
 			log.Printf(
 				"Error on update of vpc Floating IP (%s) tags: %s", id, err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 	hasChanged := false
@@ -475,7 +492,7 @@ func classicFipUpdate(d *schema.ResourceData, meta interface{}, id string) error
 		hasChanged = true
 		floatingIPPatch, err := floatingIPPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for FloatingIPPatch: %s", err)
+			return diag.FromErr(fmt.Errorf("Error calling asPatch for FloatingIPPatch: %s", err))
 		}
 		options.FloatingIPPatch = floatingIPPatch
 	}
@@ -488,7 +505,7 @@ func classicFipUpdate(d *schema.ResourceData, meta interface{}, id string) error
 		hasChanged = true
 		floatingIPPatch, err := floatingIPPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for FloatingIPPatch: %s", err)
+			return diag.FromErr(fmt.Errorf("Error calling asPatch for FloatingIPPatch: %s", err))
 		}
 		options.FloatingIPPatch = floatingIPPatch
 	}
@@ -496,16 +513,17 @@ func classicFipUpdate(d *schema.ResourceData, meta interface{}, id string) error
 	if hasChanged {
 		_, response, err := sess.UpdateFloatingIP(options)
 		if err != nil {
-			return fmt.Errorf("Error updating vpc Floating IP: %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc_classic", err, response, "Error updating vpc Floating IP"))
 		}
 	}
-	return nil
+	return diags
 }
 
-func fipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
+func fipUpdate(d *schema.ResourceData, meta interface{}, id string) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if d.HasChange(isFloatingIPTags) {
 		options := &vpcv1.GetFloatingIPOptions{
@@ -513,13 +531,14 @@ func fipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 		fip, response, err := sess.GetFloatingIP(options)
 		if err != nil {
-			return fmt.Errorf("Error getting Floating IP: %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error getting Floating IP"))
 		}
 		oldList, newList := d.GetChange(isFloatingIPTags)
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *fip.CRN)
 		if err != nil {
 			log.Printf(
 				"Error on update of vpc Floating IP (%s) tags: %s", id, err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 	hasChanged := false
@@ -533,7 +552,7 @@ func fipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 		hasChanged = true
 		floatingIPPatch, err := floatingIPPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for FloatingIPPatch: %s", err)
+			return diag.FromErr(fmt.Errorf("Error calling asPatch for FloatingIPPatch: %s", err))
 		}
 		options.FloatingIPPatch = floatingIPPatch
 	}
@@ -546,40 +565,40 @@ func fipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 		hasChanged = true
 		floatingIPPatch, err := floatingIPPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for floatingIPPatch: %s", err)
+			return diag.FromErr(fmt.Errorf("Error calling asPatch for floatingIPPatch: %s", err))
 		}
 		options.FloatingIPPatch = floatingIPPatch
 	}
 	if hasChanged {
 		_, response, err := sess.UpdateFloatingIP(options)
 		if err != nil {
-			return fmt.Errorf("Error updating vpc Floating IP: %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error updating vpc Floating IP"))
 		}
 	}
-	return nil
+	return diags
 }
 
-func resourceIBMISFloatingIPDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISFloatingIPDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
-		err := classicFipDelete(d, meta, id)
+		err := classicFipDelete(context, d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		err := fipDelete(d, meta, id)
+		err := fipDelete(context, d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
 }
 
-func classicFipDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func classicFipDelete(context context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -593,7 +612,7 @@ func classicFipDelete(d *schema.ResourceData, meta interface{}, id string) error
 			return nil
 		}
 
-		return fmt.Errorf("Error Getting Floating IP (%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting Floating IP (%s)", id)
 
 	}
 
@@ -602,9 +621,9 @@ func classicFipDelete(d *schema.ResourceData, meta interface{}, id string) error
 	}
 	response, err = sess.DeleteFloatingIP(options)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Floating IP : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Deleting Floating IP")
 	}
-	_, err = isWaitForClassicFloatingIPDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicFloatingIPDeleted(context, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -612,7 +631,7 @@ func classicFipDelete(d *schema.ResourceData, meta interface{}, id string) error
 	return nil
 }
 
-func fipDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func fipDelete(context context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -626,7 +645,7 @@ func fipDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			return nil
 		}
 
-		return fmt.Errorf("Error Getting Floating IP (%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc", err, response, "Error Getting Floating IP (%s)", id)
 	}
 
 	options := &vpcv1.DeleteFloatingIPOptions{
@@ -634,9 +653,9 @@ func fipDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteFloatingIP(options)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Floating IP : %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Deleting Floating IP")
 	}
-	_, err = isWaitForFloatingIPDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForFloatingIPDeleted(context, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -673,7 +692,7 @@ func classicFipExists(d *schema.ResourceData, meta interface{}, id string) (bool
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting floating IP: %s\n%s", err, response)
+		return false, apiErrorf("vpc_classic", err, response, "Error getting floating IP")
 	}
 	return true, nil
 }
@@ -691,12 +710,12 @@ func fipExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting floating IP: %s\n%s", err, response)
+		return false, apiErrorf("vpc", err, response, "Error getting floating IP")
 	}
 	return true, nil
 }
 
-func isWaitForClassicFloatingIPDeleted(fip *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicFloatingIPDeleted(context context.Context, fip *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for FloatingIP (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -704,11 +723,11 @@ func isWaitForClassicFloatingIPDeleted(fip *vpcclassicv1.VpcClassicV1, id string
 		Target:     []string{"", isFloatingIPDeleted},
 		Refresh:    isClassicFloatingIPDeleteRefreshFunc(fip, id),
 		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Delay:      vpcWaitDelay,
+		MinTimeout: vpcWaitMinTimeout,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isClassicFloatingIPDeleteRefreshFunc(fip *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -722,13 +741,13 @@ func isClassicFloatingIPDeleteRefreshFunc(fip *vpcclassicv1.VpcClassicV1, id str
 			if response != nil && response.StatusCode == 404 {
 				return FloatingIP, isFloatingIPDeleted, nil
 			}
-			return FloatingIP, "", fmt.Errorf("Error Getting Floating IP: %s\n%s", err, response)
+			return FloatingIP, "", apiErrorf("vpc_classic", err, response, "Error Getting Floating IP")
 		}
 		return FloatingIP, isFloatingIPDeleting, err
 	}
 }
 
-func isWaitForFloatingIPDeleted(fip *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForFloatingIPDeleted(context context.Context, fip *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for FloatingIP (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -736,11 +755,11 @@ func isWaitForFloatingIPDeleted(fip *vpcv1.VpcV1, id string, timeout time.Durati
 		Target:     []string{"", isFloatingIPDeleted},
 		Refresh:    isFloatingIPDeleteRefreshFunc(fip, id),
 		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Delay:      vpcWaitDelay,
+		MinTimeout: vpcWaitMinTimeout,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isFloatingIPDeleteRefreshFunc(fip *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -754,13 +773,13 @@ func isFloatingIPDeleteRefreshFunc(fip *vpcv1.VpcV1, id string) resource.StateRe
 			if response != nil && response.StatusCode == 404 {
 				return FloatingIP, isFloatingIPDeleted, nil
 			}
-			return FloatingIP, "", fmt.Errorf("Error Getting Floating IP: %s\n%s", err, response)
+			return FloatingIP, "", apiErrorf("vpc", err, response, "Error Getting Floating IP")
 		}
 		return FloatingIP, isFloatingIPDeleting, err
 	}
 }
 
-func isWaitForClassicInstanceFloatingIP(floatingipC *vpcclassicv1.VpcClassicV1, id string, d *schema.ResourceData) (interface{}, error) {
+func isWaitForClassicInstanceFloatingIP(context context.Context, floatingipC *vpcclassicv1.VpcClassicV1, id string, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for floating IP (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -768,11 +787,11 @@ func isWaitForClassicInstanceFloatingIP(floatingipC *vpcclassicv1.VpcClassicV1,
 		Target:     []string{isFloatingIPAvailable, ""},
 		Refresh:    isClassicInstanceFloatingIPRefreshFunc(floatingipC, id),
 		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Delay:      vpcWaitDelay,
+		MinTimeout: vpcWaitMinTimeout,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isClassicInstanceFloatingIPRefreshFunc(floatingipC *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -782,7 +801,7 @@ func isClassicInstanceFloatingIPRefreshFunc(floatingipC *vpcclassicv1.VpcClassic
 		}
 		instance, response, err := floatingipC.GetFloatingIP(getfipoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting Floating IP for the instance: %s\n%s", err, response)
+			return nil, "", apiErrorf("vpc_classic", err, response, "Error Getting Floating IP for the instance")
 		}
 
 		if *instance.Status == "available" {
@@ -793,7 +812,7 @@ func isClassicInstanceFloatingIPRefreshFunc(floatingipC *vpcclassicv1.VpcClassic
 	}
 }
 
-func isWaitForInstanceFloatingIP(floatingipC *vpcv1.VpcV1, id string, d *schema.ResourceData) (interface{}, error) {
+func isWaitForInstanceFloatingIP(context context.Context, floatingipC *vpcv1.VpcV1, id string, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for floating IP (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -801,11 +820,11 @@ func isWaitForInstanceFloatingIP(floatingipC *vpcv1.VpcV1, id string, d *schema.
 		Target:     []string{isFloatingIPAvailable, ""},
 		Refresh:    isInstanceFloatingIPRefreshFunc(floatingipC, id),
 		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Delay:      vpcWaitDelay,
+		MinTimeout: vpcWaitMinTimeout,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isInstanceFloatingIPRefreshFunc(floatingipC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -815,7 +834,7 @@ func isInstanceFloatingIPRefreshFunc(floatingipC *vpcv1.VpcV1, id string) resour
 		}
 		instance, response, err := floatingipC.GetFloatingIP(getfipoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting Floating IP for the instance: %s\n%s", err, response)
+			return nil, "", apiErrorf("vpc", err, response, "Error Getting Floating IP for the instance")
 		}
 
 		if *instance.Status == "available" {
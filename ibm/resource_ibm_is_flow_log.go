@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -34,12 +35,11 @@ const (
 
 func resourceIBMISFlowLog() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISFlowLogCreate,
-		Read:     resourceIBMISFlowLogRead,
-		Update:   resourceIBMISFlowLogUpdate,
-		Delete:   resourceIBMISFlowLogDelete,
-		Exists:   resourceIBMISFlowLogExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISFlowLogCreate,
+		ReadContext:   resourceIBMISFlowLogRead,
+		UpdateContext: resourceIBMISFlowLogUpdate,
+		DeleteContext: resourceIBMISFlowLogDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -196,10 +196,11 @@ func resourceIBMISFlowLogValidator() *ResourceValidator {
 	return &ibmISFlowLogValidator
 }
 
-func resourceIBMISFlowLogCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISFlowLogCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	createFlowLogCollectorOptionsModel := &vpcv1.CreateFlowLogCollectorOptions{}
@@ -229,7 +230,7 @@ func resourceIBMISFlowLogCreate(d *schema.ResourceData, meta interface{}) error
 
 	flowlogCollector, response, err := sess.CreateFlowLogCollector(createFlowLogCollectorOptionsModel)
 	if err != nil {
-		return fmt.Errorf("Create Flow Log Collector err %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Create Flow Log Collector"))
 	}
 	d.SetId(*flowlogCollector.ID)
 
@@ -242,17 +243,26 @@ func resourceIBMISFlowLogCreate(d *schema.ResourceData, meta interface{}) error
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc flow log (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 
-	return resourceIBMISFlowLogRead(d, meta)
+	return append(diags, resourceIBMISFlowLogRead(context, d, meta)...)
 }
 
-func resourceIBMISFlowLogRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISFlowLogRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMISFlowLogExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
 
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ID := d.Id()
@@ -262,7 +272,7 @@ func resourceIBMISFlowLogRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	flowlogCollector, response, err := sess.GetFlowLogCollector(getOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Flow Log Collector: %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error Getting Flow Log Collector"))
 	}
 
 	if flowlogCollector.Name != nil {
@@ -312,7 +322,7 @@ func resourceIBMISFlowLogRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set(isFlowLogTags, tags)
 	controller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set(ResourceControllerURL, controller+"/vpc-ext/network/flowLogs")
@@ -328,11 +338,12 @@ func resourceIBMISFlowLogRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMISFlowLogUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISFlowLogUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ID := d.Id()
@@ -342,7 +353,7 @@ func resourceIBMISFlowLogUpdate(d *schema.ResourceData, meta interface{}) error
 	}
 	flowlogCollector, response, err := sess.GetFlowLogCollector(getOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Flow Log Collector: %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error Getting Flow Log Collector"))
 	}
 
 	if d.HasChange(isFlowLogTags) {
@@ -351,6 +362,7 @@ func resourceIBMISFlowLogUpdate(d *schema.ResourceData, meta interface{}) error
 		if err != nil {
 			log.Printf(
 				"Error on update of resource flow log (%s) tags: %s", *flowlogCollector.ID, err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 
@@ -366,23 +378,23 @@ func resourceIBMISFlowLogUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 		flowLogCollectorPatch, err := flowLogCollectorPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for FlowLogCollectorPatch: %s", err)
+			return diag.FromErr(fmt.Errorf("Error calling asPatch for FlowLogCollectorPatch: %s", err))
 		}
 		updoptions.FlowLogCollectorPatch = flowLogCollectorPatch
 		_, response, err = sess.UpdateFlowLogCollector(updoptions)
 		if err != nil {
-			return fmt.Errorf("Error updating flow log collector:%s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error updating flow log collector"))
 		}
 	}
 
-	return resourceIBMISFlowLogRead(d, meta)
+	return append(diags, resourceIBMISFlowLogRead(context, d, meta)...)
 }
 
-func resourceIBMISFlowLogDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISFlowLogDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	ID := d.Id()
 	delOptions := &vpcv1.DeleteFlowLogCollectorOptions{
@@ -391,7 +403,7 @@ func resourceIBMISFlowLogDelete(d *schema.ResourceData, meta interface{}) error
 	response, err := sess.DeleteFlowLogCollector(delOptions)
 
 	if err != nil && response.StatusCode != 404 {
-		return fmt.Errorf("Error deleting flow log collector:%s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error deleting flow log collector"))
 	}
 
 	d.SetId("")
@@ -411,7 +423,7 @@ func resourceIBMISFlowLogExists(d *schema.ResourceData, meta interface{}) (bool,
 	}
 	_, response, err := sess.GetFlowLogCollector(getOptions)
 	if err != nil && response.StatusCode != 404 {
-		return false, fmt.Errorf("Error Getting Flow Log Collector : %s\n%s", err, response)
+		return false, apiErrorf("vpc", err, response, "Error Getting Flow Log Collector")
 	}
 	if response.StatusCode == 404 {
 		d.SetId("")
@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -30,12 +32,11 @@ const (
 
 func resourceIBMISIKEPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISIKEPolicyCreate,
-		Read:     resourceIBMISIKEPolicyRead,
-		Update:   resourceIBMISIKEPolicyUpdate,
-		Delete:   resourceIBMISIKEPolicyDelete,
-		Exists:   resourceIBMISIKEPolicyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISIKEPolicyCreate,
+		ReadContext:   resourceIBMISIKEPolicyRead,
+		UpdateContext: resourceIBMISIKEPolicyUpdate,
+		DeleteContext: resourceIBMISIKEPolicyDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			isIKEName: {
@@ -190,10 +191,10 @@ func resourceIBMISIKEValidator() *ResourceValidator {
 	return &ibmISIKEResourceValidator
 }
 
-func resourceIBMISIKEPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISIKEPolicyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] IKE Policy create")
@@ -205,15 +206,15 @@ func resourceIBMISIKEPolicyCreate(d *schema.ResourceData, meta interface{}) erro
 	if userDetails.generation == 1 {
 		err := classicIkepCreate(d, meta, authenticationAlg, encryptionAlg, name, dhGroup)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := ikepCreate(d, meta, authenticationAlg, encryptionAlg, name, dhGroup)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceIBMISIKEPolicyRead(d, meta)
+	return resourceIBMISIKEPolicyRead(context, d, meta)
 }
 
 func classicIkepCreate(d *schema.ResourceData, meta interface{}, authenticationAlg, encryptionAlg, name string, dhGroup int64) error {
@@ -250,7 +251,7 @@ func classicIkepCreate(d *schema.ResourceData, meta interface{}, authenticationA
 
 	ike, response, err := sess.CreateIkePolicy(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] ike policy err %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error creating IKE Policy")
 	}
 	d.SetId(*ike.ID)
 	log.Printf("[INFO] ike policy : %s", *ike.ID)
@@ -290,28 +291,37 @@ func ikepCreate(d *schema.ResourceData, meta interface{}, authenticationAlg, enc
 	}
 	ike, response, err := sess.CreateIkePolicy(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] ike policy err %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error creating IKE Policy")
 	}
 	d.SetId(*ike.ID)
 	log.Printf("[INFO] ike policy : %s", *ike.ID)
 	return nil
 }
 
-func resourceIBMISIKEPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISIKEPolicyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMISIKEPolicyExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicIkepGet(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := ikepGet(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -331,7 +341,7 @@ func classicIkepGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting IKE Policy(%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error getting IKE Policy(%s)", id)
 	}
 
 	d.Set(isIKEName, *ike.Name)
@@ -394,7 +404,7 @@ func ikepGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting IKE Policy(%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc", err, response, "Error getting IKE Policy(%s)", id)
 	}
 
 	d.Set(isIKEName, *ike.Name)
@@ -433,25 +443,25 @@ func ikepGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISIKEPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISIKEPolicyUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 
 	if userDetails.generation == 1 {
 		err := classicIkepUpdate(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := ikepUpdate(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceIBMISIKEPolicyRead(d, meta)
+	return resourceIBMISIKEPolicyRead(context, d, meta)
 }
 
 func classicIkepUpdate(d *schema.ResourceData, meta interface{}, id string) error {
@@ -485,7 +495,7 @@ func classicIkepUpdate(d *schema.ResourceData, meta interface{}, id string) erro
 
 		_, response, err := sess.UpdateIkePolicy(options)
 		if err != nil {
-			return fmt.Errorf("Error on update of IKE Policy(%s): %s\n%s", id, err, response)
+			return apiErrorf("vpc_classic", err, response, "Error on update of IKE Policy(%s)", id)
 		}
 	}
 	return nil
@@ -522,28 +532,28 @@ func ikepUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 
 		_, response, err := sess.UpdateIkePolicy(options)
 		if err != nil {
-			return fmt.Errorf("Error on update of IKE Policy(%s): %s\n%s", id, err, response)
+			return apiErrorf("vpc", err, response, "Error on update of IKE Policy(%s)", id)
 		}
 	}
 	return nil
 }
 
-func resourceIBMISIKEPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISIKEPolicyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicIkepDelete(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := ikepDelete(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -564,7 +574,7 @@ func classicIkepDelete(d *schema.ResourceData, meta interface{}, id string) erro
 			return nil
 		}
 
-		return fmt.Errorf("Error getting IKE Policy(%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error getting IKE Policy(%s)", id)
 	}
 
 	deleteIkePolicyOptions := &vpcclassicv1.DeleteIkePolicyOptions{
@@ -572,7 +582,7 @@ func classicIkepDelete(d *schema.ResourceData, meta interface{}, id string) erro
 	}
 	response, err = sess.DeleteIkePolicy(deleteIkePolicyOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting IKE Policy(%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Deleting IKE Policy(%s)", id)
 	}
 	d.SetId("")
 	return nil
@@ -592,7 +602,7 @@ func ikepDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting IKE Policy(%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc", err, response, "Error getting IKE Policy(%s)", id)
 	}
 
 	deleteIkePolicyOptions := &vpcv1.DeleteIkePolicyOptions{
@@ -600,7 +610,7 @@ func ikepDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteIkePolicy(deleteIkePolicyOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting IKE Policy(%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc", err, response, "Error Deleting IKE Policy(%s)", id)
 	}
 	d.SetId("")
 	return nil
@@ -635,7 +645,7 @@ func classicikepExists(d *schema.ResourceData, meta interface{}, id string) (boo
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting IKE Policy(%s): %s\n%s", id, err, response)
+		return false, apiErrorf("vpc_classic", err, response, "Error getting IKE Policy(%s)", id)
 	}
 
 	return true, nil
@@ -654,7 +664,7 @@ func ikepExists(d *schema.ResourceData, meta interface{}, id string) (bool, erro
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting IKE Policy(%s): %s\n%s", id, err, response)
+		return false, apiErrorf("vpc", err, response, "Error getting IKE Policy(%s)", id)
 	}
 
 	return true, nil
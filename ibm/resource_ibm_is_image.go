@@ -12,6 +12,7 @@ import (
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -41,12 +42,11 @@ const (
 
 func resourceIBMISImage() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISImageCreate,
-		Read:     resourceIBMISImageRead,
-		Update:   resourceIBMISImageUpdate,
-		Delete:   resourceIBMISImageDelete,
-		Exists:   resourceIBMISImageExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISImageCreate,
+		ReadContext:   resourceIBMISImageRead,
+		UpdateContext: resourceIBMISImageUpdate,
+		DeleteContext: resourceIBMISImageDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -205,10 +205,11 @@ func resourceIBMISImageValidator() *ResourceValidator {
 	return &ibmISImageResourceValidator
 }
 
-func resourceIBMISImageCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISImageCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Image create")
@@ -217,23 +218,24 @@ func resourceIBMISImageCreate(d *schema.ResourceData, meta interface{}) error {
 	operatingSystem := d.Get(isImageOperatingSystem).(string)
 
 	if userDetails.generation == 1 {
-		err := classicImgCreate(d, meta, href, name, operatingSystem)
-		if err != nil {
-			return err
+		diags = append(diags, classicImgCreate(context, d, meta, href, name, operatingSystem)...)
+		if diags.HasError() {
+			return diags
 		}
 	} else {
-		err := imgCreate(d, meta, href, name, operatingSystem)
-		if err != nil {
-			return err
+		diags = append(diags, imgCreate(context, d, meta, href, name, operatingSystem)...)
+		if diags.HasError() {
+			return diags
 		}
 	}
-	return resourceIBMISImageRead(d, meta)
+	return append(diags, resourceIBMISImageRead(context, d, meta)...)
 }
 
-func classicImgCreate(d *schema.ResourceData, meta interface{}, href, name, operatingSystem string) error {
+func classicImgCreate(context context.Context, d *schema.ResourceData, meta interface{}, href, name, operatingSystem string) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := classicVpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	imagePrototype := &vpcclassicv1.ImagePrototype{
 		Name: &name,
@@ -256,13 +258,13 @@ func classicImgCreate(d *schema.ResourceData, meta interface{}, href, name, oper
 
 	image, response, err := sess.CreateImage(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Image creation err %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc_classic", err, response, "Error creating Image"))
 	}
 	d.SetId(*image.ID)
 	log.Printf("[INFO] Floating IP : %s", *image.ID)
-	_, err = isWaitForClassicImageAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicImageAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isImageTags); ok || v != "" {
@@ -271,15 +273,17 @@ func classicImgCreate(d *schema.ResourceData, meta interface{}, href, name, oper
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc image (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
-	return nil
+	return diags
 }
 
-func imgCreate(d *schema.ResourceData, meta interface{}, href, name, operatingSystem string) error {
+func imgCreate(context context.Context, d *schema.ResourceData, meta interface{}, href, name, operatingSystem string) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	imagePrototype := &vpcv1.ImagePrototype{
 		Name: &name,
@@ -313,13 +317,13 @@ func imgCreate(d *schema.ResourceData, meta interface{}, href, name, operatingSy
 
 	image, response, err := sess.CreateImage(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Image creation err %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error creating Image"))
 	}
 	d.SetId(*image.ID)
 	log.Printf("[INFO] Image ID : %s", *image.ID)
-	_, err = isWaitForImageAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForImageAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isImageTags); ok || v != "" {
@@ -328,12 +332,13 @@ func imgCreate(d *schema.ResourceData, meta interface{}, href, name, operatingSy
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc image (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
-	return nil
+	return diags
 }
 
-func isWaitForClassicImageAvailable(imageC *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicImageAvailable(context context.Context, imageC *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for image (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -345,7 +350,7 @@ func isWaitForClassicImageAvailable(imageC *vpcclassicv1.VpcClassicV1, id string
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isClassicImageRefreshFunc(imageC *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -355,7 +360,7 @@ func isClassicImageRefreshFunc(imageC *vpcclassicv1.VpcClassicV1, id string) res
 		}
 		image, response, err := imageC.GetImage(getimgoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting Image: %s\n%s", err, response)
+			return nil, "", apiErrorf("vpc_classic", err, response, "Error Getting Image")
 		}
 
 		if *image.Status == "available" || *image.Status == "failed" {
@@ -365,7 +370,7 @@ func isClassicImageRefreshFunc(imageC *vpcclassicv1.VpcClassicV1, id string) res
 		return image, isImageProvisioning, nil
 	}
 }
-func isWaitForImageAvailable(imageC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForImageAvailable(context context.Context, imageC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for image (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -377,7 +382,7 @@ func isWaitForImageAvailable(imageC *vpcv1.VpcV1, id string, timeout time.Durati
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isImageRefreshFunc(imageC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -387,7 +392,7 @@ func isImageRefreshFunc(imageC *vpcv1.VpcV1, id string) resource.StateRefreshFun
 		}
 		image, response, err := imageC.GetImage(getimgoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting Image: %s\n%s", err, response)
+			return nil, "", apiErrorf("vpc", err, response, "Error Getting Image")
 		}
 
 		if *image.Status == "available" || *image.Status == "failed" {
@@ -398,10 +403,11 @@ func isImageRefreshFunc(imageC *vpcv1.VpcV1, id string) resource.StateRefreshFun
 	}
 }
 
-func resourceIBMISImageUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISImageUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	id := d.Id()
@@ -413,23 +419,24 @@ func resourceIBMISImageUpdate(d *schema.ResourceData, meta interface{}) error {
 		hasChanged = true
 	}
 	if userDetails.generation == 1 {
-		err := classicImgUpdate(d, meta, id, name, hasChanged)
-		if err != nil {
-			return err
+		diags = append(diags, classicImgUpdate(d, meta, id, name, hasChanged)...)
+		if diags.HasError() {
+			return diags
 		}
 	} else {
-		err := imgUpdate(d, meta, id, name, hasChanged)
-		if err != nil {
-			return err
+		diags = append(diags, imgUpdate(d, meta, id, name, hasChanged)...)
+		if diags.HasError() {
+			return diags
 		}
 	}
-	return resourceIBMISImageRead(d, meta)
+	return append(diags, resourceIBMISImageRead(context, d, meta)...)
 }
 
-func classicImgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
+func classicImgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := classicVpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if d.HasChange(isImageTags) {
 		options := &vpcclassicv1.GetImageOptions{
@@ -437,13 +444,14 @@ func classicImgUpdate(d *schema.ResourceData, meta interface{}, id, name string,
 		}
 		image, response, err := sess.GetImage(options)
 		if err != nil {
-			return fmt.Errorf("Error getting Image IP: %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc_classic", err, response, "Error getting Image IP"))
 		}
 		oldList, newList := d.GetChange(isImageTags)
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *image.CRN)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource vpc Image (%s) tags: %s", id, err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 	if hasChanged {
@@ -455,22 +463,23 @@ func classicImgUpdate(d *schema.ResourceData, meta interface{}, id, name string,
 		}
 		imagePatch, err := imagePatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for ImagePatch: %s", err)
+			return diag.FromErr(fmt.Errorf("Error calling asPatch for ImagePatch: %s", err))
 		}
 		options.ImagePatch = imagePatch
 
 		_, response, err := sess.UpdateImage(options)
 		if err != nil {
-			return fmt.Errorf("Error on update of resource vpc Image: %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc_classic", err, response, "Error on update of resource vpc Image"))
 		}
 	}
-	return nil
+	return diags
 }
 
-func imgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
+func imgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if d.HasChange(isImageTags) {
 		options := &vpcv1.GetImageOptions{
@@ -478,13 +487,14 @@ func imgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		}
 		image, response, err := sess.GetImage(options)
 		if err != nil {
-			return fmt.Errorf("Error getting Image IP: %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error getting Image IP"))
 		}
 		oldList, newList := d.GetChange(isImageTags)
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *image.CRN)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource vpc Image (%s) tags: %s", id, err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 	if hasChanged {
@@ -496,33 +506,42 @@ func imgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		}
 		imagePatch, err := imagePatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for ImagePatch: %s", err)
+			return diag.FromErr(fmt.Errorf("Error calling asPatch for ImagePatch: %s", err))
 		}
 		options.ImagePatch = imagePatch
 		_, response, err := sess.UpdateImage(options)
 		if err != nil {
-			return fmt.Errorf("Error on update of resource vpc Image: %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error on update of resource vpc Image"))
 		}
 	}
-	return nil
+	return diags
 }
 
-func resourceIBMISImageRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISImageRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMISImageExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicImgGet(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := imgGet(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -542,7 +561,7 @@ func classicImgGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Image (%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting Image (%s)", id)
 	}
 	// d.Set(isImageArchitecure, image.Architecture)
 	d.Set(isImageMinimumProvisionedSize, *image.MinimumProvisionedSize)
@@ -596,7 +615,7 @@ func imgGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Image (%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc", err, response, "Error Getting Image (%s)", id)
 	}
 	// d.Set(isImageArchitecure, image.Architecture)
 	d.Set(isImageMinimumProvisionedSize, *image.MinimumProvisionedSize)
@@ -636,27 +655,27 @@ func imgGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISImageDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISImageDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
-		err := classicImgDelete(d, meta, id)
+		err := classicImgDelete(context, d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		err := imgDelete(d, meta, id)
+		err := imgDelete(context, d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
 }
 
-func classicImgDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func classicImgDelete(context context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -669,7 +688,7 @@ func classicImgDelete(d *schema.ResourceData, meta interface{}, id string) error
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error Getting Image (%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting Image (%s)", id)
 	}
 
 	options := &vpcclassicv1.DeleteImageOptions{
@@ -677,9 +696,9 @@ func classicImgDelete(d *schema.ResourceData, meta interface{}, id string) error
 	}
 	response, err = sess.DeleteImage(options)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Image : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Deleting Image")
 	}
-	_, err = isWaitForClassicImageDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicImageDeleted(context, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -687,7 +706,7 @@ func classicImgDelete(d *schema.ResourceData, meta interface{}, id string) error
 	return nil
 }
 
-func imgDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func imgDelete(context context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -700,7 +719,7 @@ func imgDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error Getting Image (%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc", err, response, "Error Getting Image (%s)", id)
 	}
 
 	options := &vpcv1.DeleteImageOptions{
@@ -708,9 +727,9 @@ func imgDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteImage(options)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Image : %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Deleting Image")
 	}
-	_, err = isWaitForImageDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForImageDeleted(context, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -718,7 +737,7 @@ func imgDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func isWaitForClassicImageDeleted(imageC *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicImageDeleted(context context.Context, imageC *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for image (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -730,7 +749,7 @@ func isWaitForClassicImageDeleted(imageC *vpcclassicv1.VpcClassicV1, id string,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isClassicImageDeleteRefreshFunc(imageC *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -744,12 +763,12 @@ func isClassicImageDeleteRefreshFunc(imageC *vpcclassicv1.VpcClassicV1, id strin
 			if response != nil && response.StatusCode == 404 {
 				return image, isImageDeleted, nil
 			}
-			return image, "", fmt.Errorf("Error Getting Image: %s\n%s", err, response)
+			return image, "", apiErrorf("vpc_classic", err, response, "Error Getting Image")
 		}
 		return image, isImageDeleting, err
 	}
 }
-func isWaitForImageDeleted(imageC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForImageDeleted(context context.Context, imageC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for image (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -761,7 +780,7 @@ func isWaitForImageDeleted(imageC *vpcv1.VpcV1, id string, timeout time.Duration
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isImageDeleteRefreshFunc(imageC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -775,7 +794,7 @@ func isImageDeleteRefreshFunc(imageC *vpcv1.VpcV1, id string) resource.StateRefr
 			if response != nil && response.StatusCode == 404 {
 				return image, isImageDeleted, nil
 			}
-			return image, "", fmt.Errorf("Error Getting Image: %s\n%s", err, response)
+			return image, "", apiErrorf("vpc", err, response, "Error Getting Image")
 		}
 		return image, isImageDeleting, err
 	}
@@ -809,7 +828,7 @@ func classicImgExists(d *schema.ResourceData, meta interface{}, id string) (bool
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Image: %s\n%s", err, response)
+		return false, apiErrorf("vpc_classic", err, response, "Error getting Image")
 	}
 	return true, nil
 }
@@ -827,7 +846,7 @@ func imgExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Image: %s\n%s", err, response)
+		return false, apiErrorf("vpc", err, response, "Error getting Image")
 	}
 	return true, nil
 }
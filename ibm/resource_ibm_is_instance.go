@@ -5,13 +5,16 @@ package ibm
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"os"
 	"time"
 
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -63,6 +66,8 @@ const (
 	isInstanceDisks                   = "disks"
 	isInstanceDedicatedHost           = "dedicated_host"
 	isInstanceStatus                  = "status"
+	isInstancePowerState              = "power_state"
+	isInstanceSourceTemplate          = "instance_template"
 
 	isEnableCleanDelete        = "wait_before_delete"
 	isInstanceProvisioning     = "provisioning"
@@ -78,11 +83,14 @@ const (
 	isInstanceStatusRunning        = "running"
 	isInstanceStatusFailed         = "failed"
 
-	isInstanceBootName       = "name"
-	isInstanceBootSize       = "size"
-	isInstanceBootIOPS       = "iops"
-	isInstanceBootEncryption = "encryption"
-	isInstanceBootProfile    = "profile"
+	isInstanceBootName         = "name"
+	isInstanceBootSize         = "size"
+	isInstanceBootIOPS         = "iops"
+	isInstanceBootEncryption   = "encryption"
+	isInstanceBootProfile      = "profile"
+	isInstanceBootSnapshot     = "snapshot"
+	isInstanceBootSourceVolume = "source_volume"
+	isInstanceBootVolumeID     = "volume_id"
 
 	isInstanceVolumeAttachments = "volume_attachments"
 	isInstanceVolumeAttaching   = "attaching"
@@ -97,12 +105,11 @@ const (
 
 func resourceIBMISInstance() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMisInstanceCreate,
-		Read:     resourceIBMisInstanceRead,
-		Update:   resourceIBMisInstanceUpdate,
-		Delete:   resourceIBMisInstanceDelete,
-		Exists:   resourceIBMisInstanceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMisInstanceCreate,
+		ReadContext:   resourceIBMisInstanceRead,
+		UpdateContext: resourceIBMisInstanceUpdate,
+		DeleteContext: resourceIBMisInstanceDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -114,6 +121,8 @@ func resourceIBMISInstance() *schema.Resource {
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return resourceTagsCustomizeDiff(diff)
 			},
+			instanceSourceTemplateCustomizeDiff,
+			instanceNetworkInterfacesCustomizeDiff,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -125,30 +134,45 @@ func resourceIBMISInstance() *schema.Resource {
 				Description:  "Instance name",
 			},
 
+			isInstanceSourceTemplate: {
+				Type:          schema.TypeString,
+				ForceNew:      true,
+				Optional:      true,
+				ConflictsWith: []string{"boot_volume.0.snapshot", "boot_volume.0.source_volume"},
+				Description:   "Id of the instance template to create the instance from, the arguments of the instance override the properties of the template",
+			},
+
 			isInstanceVPC: {
-				Type:        schema.TypeString,
-				ForceNew:    true,
-				Required:    true,
-				Description: "VPC id",
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{isInstanceVPC, isInstanceSourceTemplate},
+				Description:  "VPC id",
 			},
 
 			isInstanceZone: {
-				Type:        schema.TypeString,
-				ForceNew:    true,
-				Required:    true,
-				Description: "Zone name",
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{isInstanceZone, isInstanceSourceTemplate},
+				Description:  "Zone name",
 			},
 
 			isInstanceProfile: {
-				Type:        schema.TypeString,
-				ForceNew:    false,
-				Required:    true,
-				Description: "Profile info",
+				Type:         schema.TypeString,
+				ForceNew:     false,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{isInstanceProfile, isInstanceSourceTemplate},
+				Description:  "Profile info",
 			},
 
 			isInstanceKeys: {
 				Type:             schema.TypeSet,
-				Required:         true,
+				Optional:         true,
+				AtLeastOneOf:     []string{isInstanceKeys, isInstanceSourceTemplate},
 				Elem:             &schema.Schema{Type: schema.TypeString},
 				Set:              schema.HashString,
 				DiffSuppressFunc: applyOnce,
@@ -201,11 +225,13 @@ func resourceIBMISInstance() *schema.Resource {
 			},
 
 			isInstancePrimaryNetworkInterface: {
-				Type:        schema.TypeList,
-				MinItems:    1,
-				MaxItems:    1,
-				Required:    true,
-				Description: "Primary Network interface info",
+				Type:         schema.TypeList,
+				MinItems:     1,
+				MaxItems:     1,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{isInstancePrimaryNetworkInterface, isInstanceSourceTemplate},
+				Description:  "Primary Network interface info",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
@@ -273,7 +299,6 @@ func resourceIBMISInstance() *schema.Resource {
 						},
 						isInstanceNicPrimaryIpv4Address: {
 							Type:     schema.TypeString,
-							ForceNew: true,
 							Optional: true,
 							Computed: true,
 						},
@@ -287,7 +312,6 @@ func resourceIBMISInstance() *schema.Resource {
 						isInstanceNicSubnet: {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 					},
 				},
@@ -301,29 +325,33 @@ func resourceIBMISInstance() *schema.Resource {
 			},
 
 			isInstanceImage: {
-				Type:        schema.TypeString,
-				ForceNew:    true,
-				Required:    true,
-				Description: "image name",
+				Type:          schema.TypeString,
+				ForceNew:      true,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"boot_volume.0.snapshot", "boot_volume.0.source_volume"},
+				AtLeastOneOf:  []string{isInstanceImage, "boot_volume.0.snapshot", "boot_volume.0.source_volume", isInstanceSourceTemplate},
+				Description:   "image name",
 			},
 
 			isInstanceBootVolume: {
-				Type:             schema.TypeList,
-				DiffSuppressFunc: applyOnce,
-				Optional:         true,
-				Computed:         true,
-				MaxItems:         1,
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						isInstanceBootName: {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
+							Type:             schema.TypeString,
+							DiffSuppressFunc: applyOnce,
+							Optional:         true,
+							Computed:         true,
 						},
 						isInstanceBootEncryption: {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
+							Type:             schema.TypeString,
+							DiffSuppressFunc: applyOnce,
+							Optional:         true,
+							Computed:         true,
 						},
 						isInstanceBootSize: {
 							Type:     schema.TypeInt,
@@ -334,8 +362,30 @@ func resourceIBMISInstance() *schema.Resource {
 							Computed: true,
 						},
 						isInstanceBootProfile: {
-							Type:     schema.TypeString,
-							Computed: true,
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Description: "The profile of the boot volume created with the instance, general-purpose by default",
+						},
+						isInstanceBootSnapshot: {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ForceNew:      true,
+							ConflictsWith: []string{isInstanceImage, "boot_volume.0.source_volume", isInstanceSourceTemplate},
+							Description:   "The unique identifier of the bootable snapshot the boot volume is restored from, instead of an image",
+						},
+						isInstanceBootSourceVolume: {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ForceNew:      true,
+							ConflictsWith: []string{isInstanceImage, "boot_volume.0.snapshot", isInstanceSourceTemplate},
+							Description:   "The unique identifier of an existing bootable volume to boot from, instead of an image. The volume is kept when the instance is deleted",
+						},
+						isInstanceBootVolumeID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the boot volume",
 						},
 					},
 				},
@@ -438,6 +488,14 @@ func resourceIBMISInstance() *schema.Resource {
 				Description: "instance status",
 			},
 
+			isInstancePowerState: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: InvokeValidator("ibm_is_instance", isInstancePowerState),
+				Description:  "The desired power state of the instance, running or stopped",
+			},
+
 			ResourceControllerURL: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -521,6 +579,272 @@ func resourceIBMISInstance() *schema.Resource {
 	}
 }
 
+// instanceManagedNetworkInterfaces returns the IDs of the network interfaces
+// managed by network_interfaces, nil when all of them are. A new instance manages
+// those of network_interfaces, not those of its instance template, and an imported
+// instance none of them, like its volumes. The network interfaces added later, with
+// ibm_is_instance_network_interface, are left alone.
+func instanceManagedNetworkInterfaces(d *schema.ResourceData) map[string]bool {
+	if d.IsNewResource() {
+		if _, ok := d.GetOk(isInstanceNetworkInterfaces); ok {
+			return nil
+		}
+		return map[string]bool{}
+	}
+	managed := map[string]bool{}
+	for _, nic := range d.Get(isInstanceNetworkInterfaces).([]interface{}) {
+		if nic != nil {
+			managed[nic.(map[string]interface{})["id"].(string)] = true
+		}
+	}
+	return managed
+}
+
+// instanceAttachedNetworkInterface returns the network interface of the instance
+// matching an element of network_interfaces by its subnet, and by its name and primary
+// IPv4 address when they are set, among those not managed yet, nil when there is none.
+func instanceAttachedNetworkInterface(nics []vpcv1.NetworkInterfaceInstanceContextReference, managed map[string]bool, nic map[string]interface{}) *vpcv1.NetworkInterfaceInstanceContextReference {
+	for i := range nics {
+		attached := &nics[i]
+		if managed[*attached.ID] || attached.Subnet == nil || *attached.Subnet.ID != nic[isInstanceNicSubnet].(string) {
+			continue
+		}
+		if name, _ := nic[isInstanceNicName].(string); name != "" && name != *attached.Name {
+			continue
+		}
+		if address, _ := nic[isInstanceNicPrimaryIpv4Address].(string); address != "" && address != *attached.PrimaryIpv4Address {
+			continue
+		}
+		return attached
+	}
+	return nil
+}
+
+// instanceNetworkInterfacesCustomizeDiff replaces an instance when the subnet or the
+// primary IPv4 address of its network_interfaces change, but for the network interfaces
+// added to network_interfaces that are already attached to the instance, such as those
+// of an imported instance, which are managed without replacing it.
+func instanceNetworkInterfacesCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange(isInstanceNetworkInterfaces) {
+		return nil
+	}
+	o, n := diff.GetChange(isInstanceNetworkInterfaces)
+	oldNics, newNics := o.([]interface{}), n.([]interface{})
+
+	var instance *vpcv1.Instance
+	managed := map[string]bool{}
+	if len(newNics) > len(oldNics) {
+		userDetails, err := meta.(ClientSession).BluemixUserDetails()
+		if err != nil {
+			return err
+		}
+		if userDetails.generation != 1 {
+			sess, err := vpcClient(meta)
+			if err != nil {
+				return err
+			}
+			id := diff.Id()
+			var response *core.DetailedResponse
+			instance, response, err = sess.GetInstance(&vpcv1.GetInstanceOptions{
+				ID: &id,
+			})
+			if err != nil {
+				return apiErrorf("vpc", err, response, "Error Getting Instance (%s)", id)
+			}
+			managed[*instance.PrimaryNetworkInterface.ID] = true
+			for _, nic := range oldNics {
+				managed[nic.(map[string]interface{})["id"].(string)] = true
+			}
+		}
+	}
+
+	for i := 0; i < len(oldNics) || i < len(newNics); i++ {
+		subnetKey := fmt.Sprintf("network_interfaces.%d.subnet", i)
+		addressKey := fmt.Sprintf("network_interfaces.%d.primary_ipv4_address", i)
+		if i >= len(oldNics) && instance != nil && diff.NewValueKnown(subnetKey) {
+			if attached := instanceAttachedNetworkInterface(instance.NetworkInterfaces, managed, newNics[i].(map[string]interface{})); attached != nil {
+				managed[*attached.ID] = true
+				continue
+			}
+		}
+		for _, key := range []string{subnetKey, addressKey} {
+			if diff.HasChange(key) {
+				if err := diff.ForceNew(key); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// instanceAdoptNetworkInterfaces manages the network interfaces added to
+// network_interfaces that are already attached to the instance, updating their name,
+// IP spoofing and security groups to the configuration.
+func instanceAdoptNetworkInterfaces(context context.Context, instanceC *vpcv1.VpcV1, d *schema.ResourceData) error {
+	o, n := d.GetChange(isInstanceNetworkInterfaces)
+	oldNics, newNics := o.([]interface{}), n.([]interface{})
+	if len(newNics) <= len(oldNics) {
+		return nil
+	}
+	id := d.Id()
+	instance, response, err := instanceC.GetInstance(&vpcv1.GetInstanceOptions{
+		ID: &id,
+	})
+	if err != nil {
+		return apiErrorf("vpc", err, response, "Error Getting Instance (%s)", id)
+	}
+	managed := map[string]bool{*instance.PrimaryNetworkInterface.ID: true}
+	for _, nic := range oldNics {
+		managed[nic.(map[string]interface{})["id"].(string)] = true
+	}
+
+	for i := len(oldNics); i < len(newNics); i++ {
+		nic := newNics[i].(map[string]interface{})
+		attached := instanceAttachedNetworkInterface(instance.NetworkInterfaces, managed, nic)
+		if attached == nil {
+			return fmt.Errorf("no network interface of instance %s matches network interface %d of %s", id, i, isInstanceNetworkInterfaces)
+		}
+		managed[*attached.ID] = true
+		nic["id"] = *attached.ID
+
+		insnic, response, err := instanceC.GetInstanceNetworkInterface(&vpcv1.GetInstanceNetworkInterfaceOptions{
+			InstanceID: &id,
+			ID:         attached.ID,
+		})
+		if err != nil {
+			return apiErrorf("vpc", err, response, "Error getting network interfaces attached to the instance")
+		}
+		name := nic[isInstanceNicName].(string)
+		if name == "" {
+			name = *insnic.Name
+		}
+		ipSpoofing := nic[isInstanceNicAllowIPSpoofing].(bool)
+		if name != *insnic.Name || ipSpoofing != *insnic.AllowIPSpoofing {
+			networkInterfacePatch, err := (&vpcv1.NetworkInterfacePatch{
+				Name:            &name,
+				AllowIPSpoofing: &ipSpoofing,
+			}).AsPatch()
+			if err != nil {
+				return fmt.Errorf("Error calling asPatch for NetworkInterfacePatch: %s", err)
+			}
+			_, response, err := instanceC.UpdateInstanceNetworkInterface(&vpcv1.UpdateInstanceNetworkInterfaceOptions{
+				InstanceID:            &id,
+				ID:                    attached.ID,
+				NetworkInterfacePatch: networkInterfacePatch,
+			})
+			if err != nil {
+				return apiErrorf("vpc", err, response, "Error while updating name %s for network interface of instance %s", name, id)
+			}
+		}
+
+		groups := nic[isInstanceNicSecurityGroups].(*schema.Set)
+		if groups.Len() == 0 {
+			continue
+		}
+		current := map[string]bool{}
+		for _, group := range insnic.SecurityGroups {
+			current[*group.ID] = true
+		}
+		for _, group := range expandStringList(groups.List()) {
+			if current[group] {
+				delete(current, group)
+				continue
+			}
+			_, response, err := instanceC.AddSecurityGroupNetworkInterface(&vpcv1.AddSecurityGroupNetworkInterfaceOptions{
+				SecurityGroupID: &group,
+				ID:              attached.ID,
+			})
+			if err != nil {
+				return apiErrorf("vpc", err, response, "Error while creating security group %q for network interface of instance %s", group, id)
+			}
+		}
+		for group := range current {
+			group := group
+			response, err := instanceC.RemoveSecurityGroupNetworkInterface(&vpcv1.RemoveSecurityGroupNetworkInterfaceOptions{
+				SecurityGroupID: &group,
+				ID:              attached.ID,
+			})
+			if err != nil {
+				return apiErrorf("vpc", err, response, "Error while removing security group %q for network interface of instance %s", group, id)
+			}
+		}
+		if _, err := isWaitForInstanceAvailable(context, instanceC, id, d.Timeout(schema.TimeoutUpdate), d); err != nil {
+			return err
+		}
+	}
+	return d.Set(isInstanceNetworkInterfaces, newNics)
+}
+
+// instanceSourceTemplateCustomizeDiff checks at plan time that a new instance created
+// from an instance template doesn't override the zone or the VPC of the template
+// network interface, and that its own network interface is in its zone.
+func instanceSourceTemplateCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" || !diff.NewValueKnown(isInstanceSourceTemplate) {
+		return nil
+	}
+	template := diff.Get(isInstanceSourceTemplate).(string)
+	if template == "" {
+		return nil
+	}
+
+	userDetails, err := meta.(ClientSession).BluemixUserDetails()
+	if err != nil {
+		return err
+	}
+	if userDetails.generation == 1 {
+		return fmt.Errorf("%s is not supported for generation 1 instances", isInstanceSourceTemplate)
+	}
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	instanceTemplateIntf, response, err := sess.GetInstanceTemplate(&vpcv1.GetInstanceTemplateOptions{
+		ID: &template,
+	})
+	if err != nil {
+		return apiErrorf("vpc", err, response, "Error Getting Instance template (%s)", template)
+	}
+	instanceTemplate := instanceTemplateIntf.(*vpcv1.InstanceTemplate)
+	templateZone, templateVPC := "", ""
+	if zone, ok := instanceTemplate.Zone.(*vpcv1.ZoneIdentity); ok && zone.Name != nil {
+		templateZone = *zone.Name
+	}
+	if vpc, ok := instanceTemplate.VPC.(*vpcv1.VPCIdentity); ok && vpc.ID != nil {
+		templateVPC = *vpc.ID
+	}
+
+	zone := templateZone
+	if v, ok := diff.GetOk(isInstanceZone); ok {
+		zone = v.(string)
+	}
+	if _, ok := diff.GetOk(isInstancePrimaryNetworkInterface); !ok {
+		// the subnet of the template network interface is in the zone and the VPC
+		// of the template
+		if zone != templateZone && diff.NewValueKnown(isInstanceZone) {
+			return fmt.Errorf("%s %s conflicts with the zone %s of instance template %s, set a %s in zone %s", isInstanceZone, zone, templateZone, template, isInstancePrimaryNetworkInterface, zone)
+		}
+		if v, ok := diff.GetOk(isInstanceVPC); ok && v.(string) != templateVPC {
+			return fmt.Errorf("%s %s conflicts with the VPC %s of instance template %s, set a %s in VPC %s", isInstanceVPC, v.(string), templateVPC, template, isInstancePrimaryNetworkInterface, v.(string))
+		}
+		return nil
+	}
+	subnetID := diff.Get("primary_network_interface.0.subnet").(string)
+	if subnetID == "" || !diff.NewValueKnown(isInstanceZone) {
+		return nil
+	}
+	subnet, response, err := sess.GetSubnet(&vpcv1.GetSubnetOptions{
+		ID: &subnetID,
+	})
+	if err != nil {
+		return apiErrorf("vpc", err, response, "Error Getting Subnet (%s)", subnetID)
+	}
+	if subnet.Zone != nil && *subnet.Zone.Name != zone {
+		return fmt.Errorf("subnet %s of %s is in zone %s, not in the zone %s of the instance", subnetID, isInstancePrimaryNetworkInterface, *subnet.Zone.Name, zone)
+	}
+	return nil
+}
+
 func resourceIBMISInstanceValidator() *ResourceValidator {
 
 	validateSchema := make([]ValidateSchema, 1)
@@ -542,15 +866,23 @@ func resourceIBMISInstanceValidator() *ResourceValidator {
 			Regexp:                     `^[A-Za-z0-9:_ .-]+$`,
 			MinValueLength:             1,
 			MaxValueLength:             128})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isInstancePowerState,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "running, stopped"})
 
 	ibmISInstanceValidator := ResourceValidator{ResourceName: "ibm_is_instance", Schema: validateSchema}
 	return &ibmISInstanceValidator
 }
 
-func classicInstanceCreate(d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, image string) error {
+func classicInstanceCreate(context context.Context, d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, image string) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := classicVpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	instanceproto := &vpcclassicv1.InstancePrototype{
 		Image: &vpcclassicv1.ImageIdentity{
@@ -585,7 +917,7 @@ func classicInstanceCreate(d *schema.ResourceData, meta interface{}, profile, na
 		}
 		volcap := 100
 		volcapint64 := int64(volcap)
-		volprof := "general-purpose"
+		volprof := instanceBootVolumeProfile(bootvol)
 		volTemplate.Capacity = &volcapint64
 		volTemplate.Profile = &vpcclassicv1.VolumeProfileIdentity{
 			Name: &volprof,
@@ -705,16 +1037,16 @@ func classicInstanceCreate(d *schema.ResourceData, meta interface{}, profile, na
 	instance, response, err := sess.CreateInstance(options)
 	if err != nil {
 		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
-		return err
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error creating instance"))
 	}
 	d.SetId(*instance.ID)
 
 	log.Printf("[INFO] Instance : %s", *instance.ID)
 	d.Set(isInstanceStatus, instance.Status)
 
-	_, err = isWaitForClassicInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForClassicInstanceAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
@@ -724,30 +1056,47 @@ func classicInstanceCreate(d *schema.ResourceData, meta interface{}, profile, na
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc instance (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
-	return nil
+	return diags
 }
 
-func instanceCreate(d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, image string) error {
+func instanceCreate(context context.Context, d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, image string) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	instanceproto := &vpcv1.InstancePrototype{
-		Image: &vpcv1.ImageIdentity{
-			ID: &image,
-		},
-		Zone: &vpcv1.ZoneIdentity{
+		Name: &name,
+	}
+	// the properties of the instance template are the defaults of the instance
+	template := d.Get(isInstanceSourceTemplate).(string)
+	if template != "" {
+		instanceproto.SourceTemplate = &vpcv1.InstanceTemplateIdentity{
+			ID: &template,
+		}
+	}
+	if zone != "" {
+		instanceproto.Zone = &vpcv1.ZoneIdentity{
 			Name: &zone,
-		},
-		Profile: &vpcv1.InstanceProfileIdentity{
+		}
+	}
+	if profile != "" {
+		instanceproto.Profile = &vpcv1.InstanceProfileIdentity{
 			Name: &profile,
-		},
-		Name: &name,
-		VPC: &vpcv1.VPCIdentity{
+		}
+	}
+	if vpcID != "" {
+		instanceproto.VPC = &vpcv1.VPCIdentity{
 			ID: &vpcID,
-		},
+		}
+	}
+	if image != "" {
+		instanceproto.Image = &vpcv1.ImageIdentity{
+			ID: &image,
+		}
 	}
 
 	if dHostIdInf, ok := d.GetOk(isPlacementTargetDedicatedHost); ok {
@@ -766,7 +1115,10 @@ func instanceCreate(d *schema.ResourceData, meta interface{}, profile, name, vpc
 		instanceproto.PlacementTarget = dHostGrpPlaementTarget
 	}
 
-	if boot, ok := d.GetOk(isInstanceBootVolume); ok {
+	var bootVolumeAttachment map[string]interface{}
+	if boot, ok := d.GetOk(isInstanceBootVolume); ok && image == "" && template == "" {
+		bootVolumeAttachment = instanceBootVolumeAttachment(boot.([]interface{})[0].(map[string]interface{}))
+	} else if ok {
 		bootvol := boot.([]interface{})[0].(map[string]interface{})
 		var volTemplate = &vpcv1.VolumePrototypeInstanceByImageContext{}
 		name, ok := bootvol[isInstanceBootName]
@@ -783,7 +1135,7 @@ func instanceCreate(d *schema.ResourceData, meta interface{}, profile, name, vpc
 		}
 		volcap := 100
 		volcapint64 := int64(volcap)
-		volprof := "general-purpose"
+		volprof := instanceBootVolumeProfile(bootvol)
 		volTemplate.Capacity = &volcapint64
 		volTemplate.Profile = &vpcv1.VolumeProfileIdentity{
 			Name: &volprof,
@@ -909,19 +1261,25 @@ func instanceCreate(d *schema.ResourceData, meta interface{}, profile, name, vpc
 		InstancePrototype: instanceproto,
 	}
 
-	instance, response, err := sess.CreateInstance(options)
+	var instance *vpcv1.Instance
+	var response *core.DetailedResponse
+	if bootVolumeAttachment != nil {
+		instance, response, err = createInstanceWithBootVolume(sess, instanceproto, bootVolumeAttachment)
+	} else {
+		instance, response, err = sess.CreateInstance(options)
+	}
 	if err != nil {
 		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
-		return err
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error creating instance"))
 	}
 	d.SetId(*instance.ID)
 
 	log.Printf("[INFO] Instance : %s", *instance.ID)
 	d.Set(isInstanceStatus, instance.Status)
 
-	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForInstanceAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
@@ -931,15 +1289,85 @@ func instanceCreate(d *schema.ResourceData, meta interface{}, profile, name, vpc
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc instance (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
-	return nil
+	return diags
+}
+
+// instanceBootVolumeAttachment returns the boot volume attachment of an instance
+// restoring the snapshot or attaching the existing volume of the boot_volume block.
+// The existing volume is kept when the instance is deleted.
+func instanceBootVolumeAttachment(bootvol map[string]interface{}) map[string]interface{} {
+	if sourceVolume := bootvol[isInstanceBootSourceVolume].(string); sourceVolume != "" {
+		return map[string]interface{}{
+			"delete_volume_on_instance_delete": false,
+			"volume": map[string]interface{}{
+				"id": sourceVolume,
+			},
+		}
+	}
+	volume := map[string]interface{}{
+		"profile": map[string]interface{}{
+			"name": instanceBootVolumeProfile(bootvol),
+		},
+		"source_snapshot": map[string]interface{}{
+			"id": bootvol[isInstanceBootSnapshot].(string),
+		},
+	}
+	if name := bootvol[isInstanceBootName].(string); name != "" {
+		volume["name"] = name
+	}
+	if enc := bootvol[isInstanceBootEncryption].(string); enc != "" {
+		volume["encryption_key"] = map[string]interface{}{
+			"crn": enc,
+		}
+	}
+	return map[string]interface{}{
+		"delete_volume_on_instance_delete": true,
+		"volume":                           volume,
+	}
 }
 
-func resourceIBMisInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+// instanceBootVolumeProfile returns the profile of the boot volume created with the
+// instance.
+func instanceBootVolumeProfile(bootvol map[string]interface{}) string {
+	if profile, ok := bootvol[isInstanceBootProfile].(string); ok && profile != "" {
+		return profile
+	}
+	return "general-purpose"
+}
+
+// createInstanceWithBootVolume creates an instance from the prototype with the boot
+// volume attachment, as the prototypes of the vpc-go-sdk only boot from an image.
+func createInstanceWithBootVolume(sess *vpcv1.VpcV1, instanceproto *vpcv1.InstancePrototype, bootVolumeAttachment map[string]interface{}) (*vpcv1.Instance, *core.DetailedResponse, error) {
+	data, err := json.Marshal(instanceproto)
+	if err != nil {
+		return nil, nil, err
+	}
+	body := map[string]interface{}{}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return nil, nil, err
+	}
+	body["boot_volume_attachment"] = bootVolumeAttachment
+
+	var rawResponse map[string]json.RawMessage
+	response, err := vpcRequest(sess, vpcRequestOptions{Method: core.POST, Path: "/instances", Body: body}, &rawResponse)
+	if err != nil {
+		return nil, response, err
+	}
+	var instance *vpcv1.Instance
+	if err := core.UnmarshalModel(rawResponse, "", &instance, vpcv1.UnmarshalInstance); err != nil {
+		return nil, response, err
+	}
+	return instance, response, nil
+}
+
+func resourceIBMisInstanceCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	profile := d.Get(isInstanceProfile).(string)
@@ -949,21 +1377,30 @@ func resourceIBMisInstanceCreate(d *schema.ResourceData, meta interface{}) error
 	image := d.Get(isInstanceImage).(string)
 
 	if userDetails.generation == 1 {
-		err := classicInstanceCreate(d, meta, profile, name, vpcID, zone, image)
-		if err != nil {
-			return err
+		if image == "" {
+			return diag.FromErr(fmt.Errorf("%s is required, generation 1 instances can't boot from a snapshot or a volume", isInstanceImage))
+		}
+		if _, ok := d.GetOk(isInstancePowerState); ok {
+			return diag.FromErr(fmt.Errorf("%s is not supported for generation 1 instances", isInstancePowerState))
+		}
+		if _, ok := d.GetOk(isInstanceSourceTemplate); ok {
+			return diag.FromErr(fmt.Errorf("%s is not supported for generation 1 instances", isInstanceSourceTemplate))
+		}
+		diags = append(diags, classicInstanceCreate(context, d, meta, profile, name, vpcID, zone, image)...)
+		if diags.HasError() {
+			return diags
 		}
 	} else {
-		err := instanceCreate(d, meta, profile, name, vpcID, zone, image)
-		if err != nil {
-			return err
+		diags = append(diags, instanceCreate(context, d, meta, profile, name, vpcID, zone, image)...)
+		if diags.HasError() {
+			return diags
 		}
 	}
 
-	return resourceIBMisInstanceUpdate(d, meta)
+	return append(diags, resourceIBMisInstanceUpdate(context, d, meta)...)
 }
 
-func isWaitForClassicInstanceAvailable(instanceC *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForClassicInstanceAvailable(context context.Context, instanceC *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for instance (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -971,14 +1408,14 @@ func isWaitForClassicInstanceAvailable(instanceC *vpcclassicv1.VpcClassicV1, id
 		Target:     []string{isInstanceStatusRunning, "available", "failed", ""},
 		Refresh:    isClassicInstanceRefreshFunc(instanceC, id, d),
 		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Delay:      vpcWaitDelay,
+		MinTimeout: vpcWaitMinTimeout,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
-func isWaitForInstanceAvailable(instanceC *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForInstanceAvailable(context context.Context, instanceC *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for instance (%s) to be available.", id)
 
 	communicator := make(chan interface{})
@@ -988,15 +1425,15 @@ func isWaitForInstanceAvailable(instanceC *vpcv1.VpcV1, id string, timeout time.
 		Target:     []string{isInstanceStatusRunning, "available", "failed", ""},
 		Refresh:    isInstanceRefreshFunc(instanceC, id, d, communicator),
 		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Delay:      vpcWaitDelay,
+		MinTimeout: vpcWaitMinTimeout,
 	}
 
 	if v, ok := d.GetOk("force_recovery_time"); ok {
 		forceTimeout := v.(int)
-		go isRestartStartAction(instanceC, id, d, forceTimeout, communicator)
+		go isRestartStartAction(context, instanceC, id, d, forceTimeout, communicator)
 	}
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isClassicInstanceRefreshFunc(instanceC *vpcclassicv1.VpcClassicV1, id string, d *schema.ResourceData) resource.StateRefreshFunc {
@@ -1006,7 +1443,7 @@ func isClassicInstanceRefreshFunc(instanceC *vpcclassicv1.VpcClassicV1, id strin
 		}
 		instance, response, err := instanceC.GetInstance(getinsOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting instance: %s\n%s", err, response)
+			return nil, "", apiErrorf("vpc_classic", err, response, "Error Getting instance")
 		}
 
 		d.Set(isInstanceStatus, *instance.Status)
@@ -1026,7 +1463,7 @@ func isInstanceRefreshFunc(instanceC *vpcv1.VpcV1, id string, d *schema.Resource
 		}
 		instance, response, err := instanceC.GetInstance(getinsOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
+			return nil, "", apiErrorf("vpc", err, response, "Error Getting Instance")
 		}
 		d.Set(isInstanceStatus, *instance.Status)
 
@@ -1047,7 +1484,7 @@ func isInstanceRefreshFunc(instanceC *vpcv1.VpcV1, id string, d *schema.Resource
 	}
 }
 
-func isRestartStartAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int, communicator chan interface{}) {
+func isRestartStartAction(context context.Context, instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int, communicator chan interface{}) {
 	subticker := time.NewTicker(time.Duration(forceTimeout) * time.Minute)
 	//subticker := time.NewTicker(time.Duration(forceTimeout) * time.Second)
 	for {
@@ -1062,11 +1499,11 @@ func isRestartStartAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceD
 			}
 			_, response, err := instanceC.CreateInstanceAction(createinsactoptions)
 			if err != nil {
-				communicator <- fmt.Errorf("Error retrying instance action start: %s\n%s", err, response)
+				communicator <- apiErrorf("vpc", err, response, "Error retrying instance action start")
 				return
 			}
 			waitTimeout := time.Duration(1) * time.Minute
-			_, _ = isWaitForInstanceActionStop(instanceC, waitTimeout, id, d)
+			_, _ = isWaitForInstanceActionStop(context, instanceC, waitTimeout, id, d)
 			actiontype = "start"
 			createinsactoptions = &vpcv1.CreateInstanceActionOptions{
 				InstanceID: &id,
@@ -1074,7 +1511,7 @@ func isRestartStartAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceD
 			}
 			_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
 			if err != nil {
-				communicator <- fmt.Errorf("Error retrying instance action start: %s\n%s", err, response)
+				communicator <- apiErrorf("vpc", err, response, "Error retrying instance action start")
 				return
 			}
 		case <-communicator:
@@ -1085,21 +1522,30 @@ func isRestartStartAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceD
 		}
 	}
 }
-func resourceIBMisInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMisInstanceExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	ID := d.Id()
 	if userDetails.generation == 1 {
 		err := classicInstanceGet(d, meta, ID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := instanceGet(d, meta, ID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -1119,7 +1565,7 @@ func classicInstanceGet(d *schema.ResourceData, meta interface{}, id string) err
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting Instance")
 	}
 	d.Set(isInstanceName, *instance.Name)
 	if instance.Profile != nil {
@@ -1160,7 +1606,7 @@ func classicInstanceGet(d *schema.ResourceData, meta interface{}, id string) err
 		}
 		insnic, response, err := instanceC.GetInstanceNetworkInterface(getnicoptions)
 		if err != nil {
-			return fmt.Errorf("Error getting network interfaces attached to the instance %s\n%s", err, response)
+			return apiErrorf("vpc_classic", err, response, "Error getting network interfaces attached to the instance")
 		}
 		currentPrimNic[isInstanceNicSubnet] = *insnic.Subnet.ID
 		if len(insnic.SecurityGroups) != 0 {
@@ -1189,7 +1635,7 @@ func classicInstanceGet(d *schema.ResourceData, meta interface{}, id string) err
 				}
 				insnic, response, err := instanceC.GetInstanceNetworkInterface(getnicoptions)
 				if err != nil {
-					return fmt.Errorf("Error getting network interfaces attached to the instance %s\n%s", err, response)
+					return apiErrorf("vpc_classic", err, response, "Error getting network interfaces attached to the instance")
 				}
 				currentNic[isInstanceNicSubnet] = *insnic.Subnet.ID
 				if len(insnic.SecurityGroups) != 0 {
@@ -1298,8 +1744,9 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Getting Instance")
 	}
+	managedNics := instanceManagedNetworkInterfaces(d)
 	d.Set(isInstanceName, *instance.Name)
 	if instance.Profile != nil {
 		d.Set(isInstanceProfile, *instance.Profile.Name)
@@ -1339,7 +1786,7 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 		insnic, response, err := instanceC.GetInstanceNetworkInterface(getnicoptions)
 		if err != nil {
-			return fmt.Errorf("Error getting network interfaces attached to the instance %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error getting network interfaces attached to the instance")
 		}
 		currentPrimNic[isInstanceNicAllowIPSpoofing] = *insnic.AllowIPSpoofing
 		currentPrimNic[isInstanceNicSubnet] = *insnic.Subnet.ID
@@ -1358,6 +1805,9 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 	if instance.NetworkInterfaces != nil {
 		interfacesList := make([]map[string]interface{}, 0)
 		for _, intfc := range instance.NetworkInterfaces {
+			if managedNics != nil && !managedNics[*intfc.ID] {
+				continue
+			}
 			if *intfc.ID != *instance.PrimaryNetworkInterface.ID {
 				currentNic := map[string]interface{}{}
 				currentNic["id"] = *intfc.ID
@@ -1369,7 +1819,7 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 				}
 				insnic, response, err := instanceC.GetInstanceNetworkInterface(getnicoptions)
 				if err != nil {
-					return fmt.Errorf("Error getting network interfaces attached to the instance %s\n%s", err, response)
+					return apiErrorf("vpc", err, response, "Error getting network interfaces attached to the instance")
 				}
 				currentNic[isInstanceNicAllowIPSpoofing] = *insnic.AllowIPSpoofing
 				currentNic[isInstanceNicSubnet] = *insnic.Subnet.ID
@@ -1393,14 +1843,23 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 
 	d.Set(isInstanceStatus, *instance.Status)
+	if *instance.Status == isInstanceStatusRunning || *instance.Status == isInstanceActionStatusStopped {
+		d.Set(isInstancePowerState, *instance.Status)
+	}
 	d.Set(isInstanceVPC, *instance.VPC.ID)
 	d.Set(isInstanceZone, *instance.Zone.Name)
 
 	var volumes []string
 	volumes = make([]string, 0)
+	// only the volumes attached by volumes are managed by it, not the volumes of the
+	// instance template nor those of ibm_is_instance_volume_attachment
+	managed := d.Get(isInstanceVolumes).(*schema.Set)
 	if instance.VolumeAttachments != nil {
 		for _, volume := range instance.VolumeAttachments {
 			if volume.Volume != nil && *volume.Volume.ID != *instance.BootVolumeAttachment.Volume.ID {
+				if !managed.Contains(*volume.Volume.ID) {
+					continue
+				}
 				volumes = append(volumes, *volume.Volume.ID)
 			}
 		}
@@ -1426,6 +1885,11 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 		bootVol := map[string]interface{}{}
 		if instance.BootVolumeAttachment.Volume != nil {
 			bootVol[isInstanceBootName] = *instance.BootVolumeAttachment.Volume.Name
+			bootVol[isInstanceBootVolumeID] = *instance.BootVolumeAttachment.Volume.ID
+			// The boot source can't be told from the boot volume, an existing volume
+			// only differs by its auto-delete flag which can be changed afterwards
+			bootVol[isInstanceBootSnapshot] = d.Get("boot_volume.0.snapshot").(string)
+			bootVol[isInstanceBootSourceVolume] = d.Get("boot_volume.0.source_volume").(string)
 			options := &vpcv1.GetVolumeOptions{
 				ID: instance.BootVolumeAttachment.Volume.ID,
 			}
@@ -1478,12 +1942,16 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func classicInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func classicInstanceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	instanceC, err := classicVpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
+	if d.HasChange(isInstancePowerState) {
+		return diag.FromErr(fmt.Errorf("%s is not supported for generation 1 instances", isInstancePowerState))
+	}
 	if d.HasChange(isInstanceVolumes) {
 		ovs, nvs := d.GetChange(isInstanceVolumes)
 		ov := ovs.(*schema.Set)
@@ -1502,11 +1970,11 @@ func classicInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 				vol, response, err := instanceC.CreateInstanceVolumeAttachment(createvolattoptions)
 				if err != nil {
-					return fmt.Errorf("Error while attaching volume %q for instance %s\n%s: %q", add[i], d.Id(), err, response)
+					return diag.FromErr(apiErrorf("vpc_classic", err, response, "Error while attaching volume %q for instance %s", add[i], d.Id()))
 				}
-				_, err = isWaitForClassicInstanceVolumeAttached(instanceC, d, id, *vol.ID)
+				_, err = isWaitForClassicInstanceVolumeAttached(context, instanceC, d, id, *vol.ID)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 
@@ -1518,7 +1986,7 @@ func classicInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 				vols, _, err := instanceC.ListInstanceVolumeAttachments(listvolattoptions)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 				for _, vol := range vols.VolumeAttachments {
 					if *vol.Volume.ID == remove[i] {
@@ -1528,11 +1996,11 @@ func classicInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 						}
 						response, err := instanceC.DeleteInstanceVolumeAttachment(delvolattoptions)
 						if err != nil {
-							return fmt.Errorf("Error while removing volume %q for instance %s\n%s: %q", remove[i], d.Id(), err, response)
+							return diag.FromErr(apiErrorf("vpc_classic", err, response, "Error while removing volume %q for instance %s", remove[i], d.Id()))
 						}
-						_, err = isWaitForClassicInstanceVolumeDetached(instanceC, d, d.Id(), *vol.ID)
+						_, err = isWaitForClassicInstanceVolumeDetached(context, instanceC, d, d.Id(), *vol.ID)
 						if err != nil {
-							return err
+							return diag.FromErr(err)
 						}
 						break
 					}
@@ -1556,11 +2024,11 @@ func classicInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 				_, response, err := instanceC.AddSecurityGroupNetworkInterface(createsgnicoptions)
 				if err != nil {
-					return fmt.Errorf("Error while creating security group %q for primary network interface of instance %s\n%s: %q", add[i], d.Id(), err, response)
+					return diag.FromErr(apiErrorf("vpc_classic", err, response, "Error while creating security group %q for primary network interface of instance %s", add[i], d.Id()))
 				}
-				_, err = isWaitForClassicInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForClassicInstanceAvailable(context, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 
@@ -1574,11 +2042,11 @@ func classicInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 				response, err := instanceC.RemoveSecurityGroupNetworkInterface(deletesgnicoptions)
 				if err != nil {
-					return fmt.Errorf("Error while removing security group %q for primary network interface of instance %s\n%s: %q", remove[i], d.Id(), err, response)
+					return diag.FromErr(apiErrorf("vpc_classic", err, response, "Error while removing security group %q for primary network interface of instance %s", remove[i], d.Id()))
 				}
-				_, err = isWaitForClassicInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForClassicInstanceAvailable(context, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
@@ -1618,11 +2086,11 @@ func classicInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 						}
 						_, response, err := instanceC.AddSecurityGroupNetworkInterface(createsgnicoptions)
 						if err != nil {
-							return fmt.Errorf("Error while creating security group %q for network interface of instance %s\n%s: %q", add[i], d.Id(), err, response)
+							return diag.FromErr(apiErrorf("vpc_classic", err, response, "Error while creating security group %q for network interface of instance %s", add[i], d.Id()))
 						}
-						_, err = isWaitForClassicInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+						_, err = isWaitForClassicInstanceAvailable(context, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 						if err != nil {
-							return err
+							return diag.FromErr(err)
 						}
 					}
 
@@ -1637,11 +2105,11 @@ func classicInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 						}
 						response, err := instanceC.RemoveSecurityGroupNetworkInterface(deletesgnicoptions)
 						if err != nil {
-							return fmt.Errorf("Error while removing security group %q for network interface of instance %s\n%s: %q", remove[i], d.Id(), err, response)
+							return diag.FromErr(apiErrorf("vpc_classic", err, response, "Error while removing security group %q for network interface of instance %s", remove[i], d.Id()))
 						}
-						_, err = isWaitForClassicInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+						_, err = isWaitForClassicInstanceAvailable(context, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 						if err != nil {
-							return err
+							return diag.FromErr(err)
 						}
 					}
 				}
@@ -1676,13 +2144,13 @@ func classicInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		instancePatch, err := instancePatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for ImagePatch: %s", err)
+			return diag.FromErr(fmt.Errorf("Error calling asPatch for ImagePatch: %s", err))
 		}
 		updnetoptions.InstancePatch = instancePatch
 
 		_, _, err = instanceC.UpdateInstance(updnetoptions)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -1699,15 +2167,17 @@ func classicInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			log.Printf(
 				"Error on update of resource vpc Instance (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
-	return nil
+	return diags
 }
 
-func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func instanceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	instanceC, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	if d.HasChange(isInstanceVolumes) {
@@ -1724,7 +2194,24 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		if len(add) > 0 {
+			// the volumes already attached to the instance, such as those of an
+			// imported instance, are managed by volumes without attaching them again
+			vols, response, err := instanceC.ListInstanceVolumeAttachments(&vpcv1.ListInstanceVolumeAttachmentsOptions{
+				InstanceID: &id,
+			})
+			if err != nil {
+				return diag.FromErr(apiErrorf("vpc", err, response, "Error Listing volume attachments to the instance (%s)", id))
+			}
+			attached := map[string]bool{}
+			for _, vol := range vols.VolumeAttachments {
+				if vol.Volume != nil {
+					attached[*vol.Volume.ID] = true
+				}
+			}
 			for i := range add {
+				if attached[add[i]] {
+					continue
+				}
 				createvolattoptions := &vpcv1.CreateInstanceVolumeAttachmentOptions{
 					InstanceID: &id,
 					Volume: &vpcv1.VolumeIdentity{
@@ -1734,11 +2221,11 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 				vol, _, err := instanceC.CreateInstanceVolumeAttachment(createvolattoptions)
 				if err != nil {
-					return fmt.Errorf("Error while attaching volume %q for instance %s: %q", add[i], d.Id(), err)
+					return diag.FromErr(fmt.Errorf("Error while attaching volume %q for instance %s: %q", add[i], d.Id(), err))
 				}
-				_, err = isWaitForInstanceVolumeAttached(instanceC, d, id, *vol.ID)
+				_, err = isWaitForInstanceVolumeAttached(context, instanceC, d, id, *vol.ID, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 
@@ -1750,7 +2237,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 				vols, _, err := instanceC.ListInstanceVolumeAttachments(listvolattoptions)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 				for _, vol := range vols.VolumeAttachments {
 					if *vol.Volume.ID == remove[i] {
@@ -1760,11 +2247,11 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 						}
 						_, err := instanceC.DeleteInstanceVolumeAttachment(delvolattoptions)
 						if err != nil {
-							return fmt.Errorf("Error while removing volume %q for instance %s: %q", remove[i], d.Id(), err)
+							return diag.FromErr(fmt.Errorf("Error while removing volume %q for instance %s: %q", remove[i], d.Id(), err))
 						}
-						_, err = isWaitForInstanceVolumeDetached(instanceC, d, d.Id(), *vol.ID)
+						_, err = isWaitForInstanceVolumeDetached(context, instanceC, d, d.Id(), *vol.ID, d.Timeout(schema.TimeoutUpdate))
 						if err != nil {
-							return err
+							return diag.FromErr(err)
 						}
 						break
 					}
@@ -1788,11 +2275,11 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 				_, response, err := instanceC.AddSecurityGroupNetworkInterface(createsgnicoptions)
 				if err != nil {
-					return fmt.Errorf("Error while creating security group %q for primary network interface of instance %s\n%s: %q", add[i], d.Id(), err, response)
+					return diag.FromErr(apiErrorf("vpc", err, response, "Error while creating security group %q for primary network interface of instance %s", add[i], d.Id()))
 				}
-				_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForInstanceAvailable(context, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 
@@ -1806,11 +2293,11 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 				response, err := instanceC.RemoveSecurityGroupNetworkInterface(deletesgnicoptions)
 				if err != nil {
-					return fmt.Errorf("Error while removing security group %q for primary network interface of instance %s\n%s: %q", remove[i], d.Id(), err, response)
+					return diag.FromErr(apiErrorf("vpc", err, response, "Error while removing security group %q for primary network interface of instance %s", remove[i], d.Id()))
 				}
-				_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForInstanceAvailable(context, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
@@ -1831,22 +2318,28 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		networkInterfacePatch, err := networkInterfacePatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for NetworkInterfacePatch: %s", err)
+			return diag.FromErr(fmt.Errorf("Error calling asPatch for NetworkInterfacePatch: %s", err))
 		}
 		updatepnicfoptions.NetworkInterfacePatch = networkInterfacePatch
 
 		_, response, err := instanceC.UpdateInstanceNetworkInterface(updatepnicfoptions)
 		if err != nil {
-			return fmt.Errorf("Error while updating name %s for primary network interface of instance %s\n%s: %q", newName, d.Id(), err, response)
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error while updating name %s for primary network interface of instance %s", newName, d.Id()))
 		}
-		_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+		_, err = isWaitForInstanceAvailable(context, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if d.HasChange(isInstanceNetworkInterfaces) && !d.IsNewResource() {
-		nics := d.Get(isInstanceNetworkInterfaces).([]interface{})
+		// the network interfaces added to network_interfaces are already attached
+		// to the instance, the others replace it
+		o, _ := d.GetChange(isInstanceNetworkInterfaces)
+		if err := instanceAdoptNetworkInterfaces(context, instanceC, d); err != nil {
+			return diag.FromErr(err)
+		}
+		nics := o.([]interface{})
 		for i := range nics {
 			securitygrpKey := fmt.Sprintf("network_interfaces.%d.security_groups", i)
 			networkNameKey := fmt.Sprintf("network_interfaces.%d.name", i)
@@ -1867,11 +2360,11 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 						}
 						_, response, err := instanceC.AddSecurityGroupNetworkInterface(createsgnicoptions)
 						if err != nil {
-							return fmt.Errorf("Error while creating security group %q for network interface of instance %s\n%s: %q", add[i], d.Id(), err, response)
+							return diag.FromErr(apiErrorf("vpc", err, response, "Error while creating security group %q for network interface of instance %s", add[i], d.Id()))
 						}
-						_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+						_, err = isWaitForInstanceAvailable(context, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 						if err != nil {
-							return err
+							return diag.FromErr(err)
 						}
 					}
 
@@ -1886,11 +2379,11 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 						}
 						response, err := instanceC.RemoveSecurityGroupNetworkInterface(deletesgnicoptions)
 						if err != nil {
-							return fmt.Errorf("Error while removing security group %q for network interface of instance %s\n%s: %q", remove[i], d.Id(), err, response)
+							return diag.FromErr(apiErrorf("vpc", err, response, "Error while removing security group %q for network interface of instance %s", remove[i], d.Id()))
 						}
-						_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+						_, err = isWaitForInstanceAvailable(context, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 						if err != nil {
-							return err
+							return diag.FromErr(err)
 						}
 					}
 				}
@@ -1913,16 +2406,16 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 				networkInterfacePatch, err := instancePatchModel.AsPatch()
 				if err != nil {
-					return fmt.Errorf("Error calling asPatch for NetworkInterfacePatch: %s", err)
+					return diag.FromErr(fmt.Errorf("Error calling asPatch for NetworkInterfacePatch: %s", err))
 				}
 				updatepnicfoptions.NetworkInterfacePatch = networkInterfacePatch
 
 				_, response, err := instanceC.UpdateInstanceNetworkInterface(updatepnicfoptions)
 				if err != nil {
-					return fmt.Errorf("Error while updating name %s for network interface of instance %s\n%s: %q", newName, d.Id(), err, response)
+					return diag.FromErr(apiErrorf("vpc", err, response, "Error while updating name %s for network interface of instance %s", newName, d.Id()))
 				}
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
@@ -1940,13 +2433,13 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		instancePatch, err := instancePatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for InstancePatch: %s", err)
+			return diag.FromErr(fmt.Errorf("Error calling asPatch for InstancePatch: %s", err))
 		}
 		updnetoptions.InstancePatch = instancePatch
 
 		_, _, err = instanceC.UpdateInstance(updnetoptions)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -1959,9 +2452,9 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				d.SetId("")
-				return nil
+				return diags
 			}
-			return fmt.Errorf("Error Getting Instance (%s): %s\n%s", id, err, response)
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error Getting Instance (%s)", id))
 		}
 
 		if instance != nil && *instance.Status == "running" {
@@ -1973,13 +2466,13 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 404 {
-					return nil
+					return diags
 				}
-				return fmt.Errorf("Error Creating Instance Action: %s\n%s", err, response)
+				return diag.FromErr(apiErrorf("vpc", err, response, "Error Creating Instance Action"))
 			}
-			_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
+			_, err = isWaitForInstanceActionStop(context, instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
@@ -1996,32 +2489,56 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		instancePatch, err := instancePatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for InstancePatch: %s", err)
+			return diag.FromErr(fmt.Errorf("Error calling asPatch for InstancePatch: %s", err))
 		}
 		updnetoptions.InstancePatch = instancePatch
 
 		_, response, err = instanceC.UpdateInstance(updnetoptions)
 		if err != nil {
-			return fmt.Errorf("Error in UpdateInstancePatch: %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error in UpdateInstancePatch"))
 		}
 
-		actiontype := "start"
-		createinsactoptions := &vpcv1.CreateInstanceActionOptions{
-			InstanceID: &id,
-			Type:       &actiontype,
-		}
-		_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
-		if err != nil {
-			if response != nil && response.StatusCode == 404 {
-				return nil
+		// an instance meant to be stopped is left stopped
+		if d.Get(isInstancePowerState).(string) != isInstanceActionStatusStopped {
+			actiontype := "start"
+			createinsactoptions := &vpcv1.CreateInstanceActionOptions{
+				InstanceID: &id,
+				Type:       &actiontype,
+			}
+			_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return diags
+				}
+				return diag.FromErr(apiErrorf("vpc", err, response, "Error Creating Instance Action"))
+			}
+			_, err = isWaitForInstanceAvailable(context, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+			if err != nil {
+				return diag.FromErr(err)
 			}
-			return fmt.Errorf("Error Creating Instance Action: %s\n%s", err, response)
 		}
-		_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+
+	}
+
+	if d.HasChange(isInstancePowerState) {
+		powerState := d.Get(isInstancePowerState).(string)
+		getinsOptions := &vpcv1.GetInstanceOptions{
+			ID: &id,
+		}
+		instance, response, err := instanceC.GetInstance(getinsOptions)
 		if err != nil {
-			return err
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error Getting Instance (%s)", id))
+		}
+		if powerState != "" && *instance.Status != powerState {
+			actiontype := isInstanceActionStart
+			if powerState == isInstanceActionStatusStopped {
+				actiontype = isInstanceActionStop
+			}
+			err = instanceAction(context, instanceC, d, id, actiontype, false, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return diag.FromErr(err)
+			}
 		}
-
 	}
 
 	getinsOptions := &vpcv1.GetInstanceOptions{
@@ -2029,7 +2546,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 	instance, response, err := instanceC.GetInstance(getinsOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error Getting Instance"))
 	}
 	if d.HasChange(isInstanceTags) {
 		oldList, newList := d.GetChange(isInstanceTags)
@@ -2037,33 +2554,35 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			log.Printf(
 				"Error on update of resource vpc Instance (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
-	return nil
+	return diags
 }
 
-func resourceIBMisInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if userDetails.generation == 1 {
-		err := classicInstanceUpdate(d, meta)
-		if err != nil {
-			return err
+		diags = append(diags, classicInstanceUpdate(context, d, meta)...)
+		if diags.HasError() {
+			return diags
 		}
 	} else {
-		err := instanceUpdate(d, meta)
-		if err != nil {
-			return err
+		diags = append(diags, instanceUpdate(context, d, meta)...)
+		if diags.HasError() {
+			return diags
 		}
 	}
 
-	return resourceIBMisInstanceRead(d, meta)
+	return append(diags, resourceIBMisInstanceRead(context, d, meta)...)
 }
 
-func classicInstanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func classicInstanceDelete(context context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	instanceC, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -2078,7 +2597,7 @@ func classicInstanceDelete(d *schema.ResourceData, meta interface{}, id string)
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Instance (%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting Instance (%s)", id)
 	}
 	actiontype := "stop"
 	createinsactoptions := &vpcclassicv1.CreateInstanceActionOptions{
@@ -2090,9 +2609,9 @@ func classicInstanceDelete(d *schema.ResourceData, meta interface{}, id string)
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error Creating Instance Action: %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Creating Instance Action")
 	}
-	_, err = isWaitForClassicInstanceActionStop(instanceC, d, meta, id)
+	_, err = isWaitForClassicInstanceActionStop(context, instanceC, d, meta, id)
 	if err != nil {
 		return err
 	}
@@ -2101,7 +2620,7 @@ func classicInstanceDelete(d *schema.ResourceData, meta interface{}, id string)
 	}
 	vols, response, err := instanceC.ListInstanceVolumeAttachments(listvolattoptions)
 	if err != nil {
-		return fmt.Errorf("Error Listing volume attachments to the instance: %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Listing volume attachments to the instance")
 	}
 	bootvolid := ""
 	for _, vol := range vols.VolumeAttachments {
@@ -2114,7 +2633,7 @@ func classicInstanceDelete(d *schema.ResourceData, meta interface{}, id string)
 			if err != nil {
 				return fmt.Errorf("Error while removing volume attachment %q for instance %s: %q", *vol.ID, d.Id(), err)
 			}
-			_, err = isWaitForClassicInstanceVolumeDetached(instanceC, d, d.Id(), *vol.ID)
+			_, err = isWaitForClassicInstanceVolumeDetached(context, instanceC, d, d.Id(), *vol.ID)
 			if err != nil {
 				return err
 			}
@@ -2130,12 +2649,12 @@ func classicInstanceDelete(d *schema.ResourceData, meta interface{}, id string)
 	if err != nil {
 		return err
 	}
-	_, err = isWaitForClassicInstanceDelete(instanceC, d, d.Id())
+	_, err = isWaitForClassicInstanceDelete(context, instanceC, d, d.Id())
 	if err != nil {
 		return err
 	}
 	if _, ok := d.GetOk(isInstanceBootVolume); ok {
-		_, err = isWaitForClassicVolumeDeleted(instanceC, bootvolid, d.Timeout(schema.TimeoutDelete))
+		_, err = isWaitForClassicVolumeDeleted(context, instanceC, bootvolid, d.Timeout(schema.TimeoutDelete))
 		if err != nil {
 			return err
 		}
@@ -2143,7 +2662,7 @@ func classicInstanceDelete(d *schema.ResourceData, meta interface{}, id string)
 	return nil
 }
 
-func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func instanceDelete(context context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	instanceC, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -2159,7 +2678,7 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Instance (%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc", err, response, "Error Getting Instance (%s)", id)
 	}
 
 	bootvolid := ""
@@ -2175,9 +2694,9 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			if response != nil && response.StatusCode == 404 {
 				return nil
 			}
-			return fmt.Errorf("Error Creating Instance Action: %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Creating Instance Action")
 		}
-		_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutDelete), id, d)
+		_, err = isWaitForInstanceActionStop(context, instanceC, d.Timeout(schema.TimeoutDelete), id, d)
 		if err != nil {
 			return err
 		}
@@ -2186,7 +2705,7 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 		vols, response, err := instanceC.ListInstanceVolumeAttachments(listvolattoptions)
 		if err != nil {
-			return fmt.Errorf("Error Listing volume attachments to the instance: %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Listing volume attachments to the instance")
 		}
 
 		for _, vol := range vols.VolumeAttachments {
@@ -2199,12 +2718,13 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 				if err != nil {
 					return fmt.Errorf("Error while removing volume Attachment %q for instance %s: %q", *vol.ID, d.Id(), err)
 				}
-				_, err = isWaitForInstanceVolumeDetached(instanceC, d, d.Id(), *vol.ID)
+				_, err = isWaitForInstanceVolumeDetached(context, instanceC, d, d.Id(), *vol.ID, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					return err
 				}
 			}
-			if *vol.Type == "boot" {
+			// An existing volume the instance booted from is kept
+			if *vol.Type == "boot" && vol.DeleteVolumeOnInstanceDelete != nil && *vol.DeleteVolumeOnInstanceDelete {
 				bootvolid = *vol.Volume.ID
 			}
 		}
@@ -2217,12 +2737,12 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		return err
 	}
 	if cleanDelete {
-		_, err = isWaitForInstanceDelete(instanceC, d, d.Id())
+		_, err = isWaitForInstanceDelete(context, instanceC, d, d.Id())
 		if err != nil {
 			return err
 		}
-		if _, ok := d.GetOk(isInstanceBootVolume); ok {
-			_, err = isWaitForVolumeDeleted(instanceC, bootvolid, d.Timeout(schema.TimeoutDelete))
+		if _, ok := d.GetOk(isInstanceBootVolume); ok && bootvolid != "" {
+			_, err = isWaitForVolumeDeleted(context, instanceC, bootvolid, d.Timeout(schema.TimeoutDelete))
 			if err != nil {
 				return err
 			}
@@ -2231,22 +2751,22 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMisInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
-		err := classicInstanceDelete(d, meta, id)
+		err := classicInstanceDelete(context, d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		err := instanceDelete(d, meta, id)
+		err := instanceDelete(context, d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -2267,7 +2787,7 @@ func classicInstanceExists(d *schema.ResourceData, meta interface{}, id string)
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
+		return false, apiErrorf("vpc_classic", err, response, "Error Getting Instance")
 	}
 	return true, nil
 }
@@ -2285,7 +2805,7 @@ func instanceExists(d *schema.ResourceData, meta interface{}, id string) (bool,
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
+		return false, apiErrorf("vpc", err, response, "Error Getting Instance")
 	}
 	return true, nil
 }
@@ -2305,7 +2825,7 @@ func resourceIBMisInstanceExists(d *schema.ResourceData, meta interface{}) (bool
 	}
 }
 
-func isWaitForClassicInstanceDelete(instanceC *vpcclassicv1.VpcClassicV1, d *schema.ResourceData, id string) (interface{}, error) {
+func isWaitForClassicInstanceDelete(context context.Context, instanceC *vpcclassicv1.VpcClassicV1, d *schema.ResourceData, id string) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isInstanceDeleting, isInstanceAvailable},
@@ -2319,7 +2839,7 @@ func isWaitForClassicInstanceDelete(instanceC *vpcclassicv1.VpcClassicV1, d *sch
 				if response != nil && response.StatusCode == 404 {
 					return instance, isInstanceDeleteDone, nil
 				}
-				return nil, "", fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
+				return nil, "", apiErrorf("vpc_classic", err, response, "Error Getting Instance")
 			}
 			if *instance.Status == isInstanceFailed {
 				return instance, *instance.Status, fmt.Errorf("The  instance %s failed to delete: %v", d.Id(), err)
@@ -2327,14 +2847,14 @@ func isWaitForClassicInstanceDelete(instanceC *vpcclassicv1.VpcClassicV1, d *sch
 			return instance, isInstanceDeleting, nil
 		},
 		Timeout:    d.Timeout(schema.TimeoutDelete),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Delay:      vpcWaitDelay,
+		MinTimeout: vpcWaitMinTimeout,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
-func isWaitForInstanceDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
+func isWaitForInstanceDelete(context context.Context, instanceC *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isInstanceDeleting, isInstanceAvailable},
@@ -2348,7 +2868,7 @@ func isWaitForInstanceDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id
 				if response != nil && response.StatusCode == 404 {
 					return instance, isInstanceDeleteDone, nil
 				}
-				return nil, "", fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
+				return nil, "", apiErrorf("vpc", err, response, "Error Getting Instance")
 			}
 			if *instance.Status == isInstanceFailed {
 				return instance, *instance.Status, fmt.Errorf("The  instance %s failed to delete: %v", d.Id(), err)
@@ -2356,13 +2876,13 @@ func isWaitForInstanceDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id
 			return instance, isInstanceDeleting, nil
 		},
 		Timeout:    d.Timeout(schema.TimeoutDelete),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Delay:      vpcWaitDelay,
+		MinTimeout: vpcWaitMinTimeout,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
-func isWaitForClassicInstanceActionStop(instanceC *vpcclassicv1.VpcClassicV1, d *schema.ResourceData, meta interface{}, id string) (interface{}, error) {
+func isWaitForClassicInstanceActionStop(context context.Context, instanceC *vpcclassicv1.VpcClassicV1, d *schema.ResourceData, meta interface{}, id string) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isInstanceStatusRunning, isInstanceStatusPending, isInstanceActionStatusStopping},
@@ -2373,7 +2893,7 @@ func isWaitForClassicInstanceActionStop(instanceC *vpcclassicv1.VpcClassicV1, d
 			}
 			instance, response, err := instanceC.GetInstance(getinsoptions)
 			if err != nil {
-				return nil, "", fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
+				return nil, "", apiErrorf("vpc_classic", err, response, "Error Getting Instance")
 			}
 			if *instance.Status == isInstanceStatusFailed {
 				return instance, *instance.Status, fmt.Errorf("The  instance %s failed to stop: %v", d.Id(), err)
@@ -2381,13 +2901,13 @@ func isWaitForClassicInstanceActionStop(instanceC *vpcclassicv1.VpcClassicV1, d
 			return instance, *instance.Status, nil
 		},
 		Timeout:    d.Timeout(schema.TimeoutDelete),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Delay:      vpcWaitDelay,
+		MinTimeout: vpcWaitMinTimeout,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
-func isWaitForInstanceActionStop(instanceC *vpcv1.VpcV1, timeout time.Duration, id string, d *schema.ResourceData) (interface{}, error) {
+func isWaitForInstanceActionStop(context context.Context, instanceC *vpcv1.VpcV1, timeout time.Duration, id string, d *schema.ResourceData) (interface{}, error) {
 	communicator := make(chan interface{})
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isInstanceStatusRunning, isInstanceStatusPending, isInstanceActionStatusStopping},
@@ -2398,7 +2918,7 @@ func isWaitForInstanceActionStop(instanceC *vpcv1.VpcV1, timeout time.Duration,
 			}
 			instance, response, err := instanceC.GetInstance(getinsoptions)
 			if err != nil {
-				return nil, "", fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
+				return nil, "", apiErrorf("vpc", err, response, "Error Getting Instance")
 			}
 			select {
 			case data := <-communicator:
@@ -2414,8 +2934,8 @@ func isWaitForInstanceActionStop(instanceC *vpcv1.VpcV1, timeout time.Duration,
 			return instance, *instance.Status, nil
 		},
 		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Delay:      vpcWaitDelay,
+		MinTimeout: vpcWaitMinTimeout,
 	}
 
 	if v, ok := d.GetOk("force_recovery_time"); ok {
@@ -2423,7 +2943,7 @@ func isWaitForInstanceActionStop(instanceC *vpcv1.VpcV1, timeout time.Duration,
 		go isRestartStopAction(instanceC, id, d, forceTimeout, communicator)
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isRestartStopAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int, communicator chan interface{}) {
@@ -2441,7 +2961,7 @@ func isRestartStopAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceDa
 			}
 			_, response, err := instanceC.CreateInstanceAction(createinsactoptions)
 			if err != nil {
-				communicator <- fmt.Errorf("Error retrying instance action stop: %s\n%s", err, response)
+				communicator <- apiErrorf("vpc", err, response, "Error retrying instance action stop")
 				return
 			}
 		case <-communicator:
@@ -2453,7 +2973,7 @@ func isRestartStopAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceDa
 	}
 }
 
-func isWaitForClassicInstanceVolumeAttached(instanceC *vpcclassicv1.VpcClassicV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
+func isWaitForClassicInstanceVolumeAttached(context context.Context, instanceC *vpcclassicv1.VpcClassicV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
 	log.Printf("Waiting for instance volume (%s) to be attched.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -2461,11 +2981,11 @@ func isWaitForClassicInstanceVolumeAttached(instanceC *vpcclassicv1.VpcClassicV1
 		Target:     []string{isInstanceVolumeAttached, ""},
 		Refresh:    isClassicInstanceVolumeRefreshFunc(instanceC, id, volID),
 		Timeout:    d.Timeout(schema.TimeoutUpdate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Delay:      vpcWaitDelay,
+		MinTimeout: vpcWaitMinTimeout,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isClassicInstanceVolumeRefreshFunc(instanceC *vpcclassicv1.VpcClassicV1, id, volID string) resource.StateRefreshFunc {
@@ -2476,7 +2996,7 @@ func isClassicInstanceVolumeRefreshFunc(instanceC *vpcclassicv1.VpcClassicV1, id
 		}
 		vol, response, err := instanceC.GetInstanceVolumeAttachment(getvolattoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Attaching volume: %s\n%s", err, response)
+			return nil, "", apiErrorf("vpc_classic", err, response, "Error Attaching volume")
 		}
 
 		if *vol.Status == isInstanceVolumeAttached {
@@ -2487,19 +3007,19 @@ func isClassicInstanceVolumeRefreshFunc(instanceC *vpcclassicv1.VpcClassicV1, id
 	}
 }
 
-func isWaitForInstanceVolumeAttached(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
+func isWaitForInstanceVolumeAttached(context context.Context, instanceC *vpcv1.VpcV1, d *schema.ResourceData, id, volID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for instance volume (%s) to be attched.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isInstanceVolumeAttaching},
 		Target:     []string{isInstanceVolumeAttached, ""},
 		Refresh:    isInstanceVolumeRefreshFunc(instanceC, id, volID),
-		Timeout:    d.Timeout(schema.TimeoutUpdate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout:    timeout,
+		Delay:      vpcWaitDelay,
+		MinTimeout: vpcWaitMinTimeout,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isInstanceVolumeRefreshFunc(instanceC *vpcv1.VpcV1, id, volID string) resource.StateRefreshFunc {
@@ -2510,7 +3030,7 @@ func isInstanceVolumeRefreshFunc(instanceC *vpcv1.VpcV1, id, volID string) resou
 		}
 		vol, response, err := instanceC.GetInstanceVolumeAttachment(getvolattoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Attaching volume: %s\n%s", err, response)
+			return nil, "", apiErrorf("vpc", err, response, "Error Attaching volume")
 		}
 
 		if *vol.Status == isInstanceVolumeAttached {
@@ -2521,7 +3041,7 @@ func isInstanceVolumeRefreshFunc(instanceC *vpcv1.VpcV1, id, volID string) resou
 	}
 }
 
-func isWaitForClassicInstanceVolumeDetached(instanceC *vpcclassicv1.VpcClassicV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
+func isWaitForClassicInstanceVolumeDetached(context context.Context, instanceC *vpcclassicv1.VpcClassicV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isInstanceVolumeAttached, isInstanceVolumeDetaching},
@@ -2536,7 +3056,7 @@ func isWaitForClassicInstanceVolumeDetached(instanceC *vpcclassicv1.VpcClassicV1
 				if response != nil && response.StatusCode == 404 {
 					return vol, isInstanceDeleteDone, nil
 				}
-				return nil, "", fmt.Errorf("Error Detaching volume: %s\n%s", err, response)
+				return nil, "", apiErrorf("vpc_classic", err, response, "Error Detaching volume")
 			}
 			if *vol.Status == isInstanceFailed {
 				return vol, *vol.Status, fmt.Errorf("The instance %s failed to detach volume %s: %v", d.Id(), volID, err)
@@ -2544,14 +3064,14 @@ func isWaitForClassicInstanceVolumeDetached(instanceC *vpcclassicv1.VpcClassicV1
 			return vol, isInstanceVolumeDetaching, nil
 		},
 		Timeout:    d.Timeout(schema.TimeoutUpdate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Delay:      vpcWaitDelay,
+		MinTimeout: vpcWaitMinTimeout,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
-func isWaitForInstanceVolumeDetached(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
+func isWaitForInstanceVolumeDetached(context context.Context, instanceC *vpcv1.VpcV1, d *schema.ResourceData, id, volID string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isInstanceVolumeAttached, isInstanceVolumeDetaching},
@@ -2566,19 +3086,19 @@ func isWaitForInstanceVolumeDetached(instanceC *vpcv1.VpcV1, d *schema.ResourceD
 				if response != nil && response.StatusCode == 404 {
 					return vol, isInstanceDeleteDone, nil
 				}
-				return nil, "", fmt.Errorf("Error Detaching: %s\n%s", err, response)
+				return nil, "", apiErrorf("vpc", err, response, "Error Detaching")
 			}
 			if *vol.Status == isInstanceFailed {
 				return vol, *vol.Status, fmt.Errorf("The instance %s failed to detach volume %s: %v", d.Id(), volID, err)
 			}
 			return vol, isInstanceVolumeDetaching, nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutUpdate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout:    timeout,
+		Delay:      vpcWaitDelay,
+		MinTimeout: vpcWaitMinTimeout,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func resourceIbmIsInstanceInstanceDiskToMap(instanceDisk vpcv1.InstanceDisk) map[string]interface{} {
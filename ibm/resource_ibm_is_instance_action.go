@@ -0,0 +1,158 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isInstanceActionInstance    = "instance"
+	isInstanceActionType        = "action"
+	isInstanceActionForceAction = "force_action"
+	isInstanceActionStart       = "start"
+	isInstanceActionStop        = "stop"
+	isInstanceActionReboot      = "reboot"
+)
+
+func resourceIBMISInstanceAction() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMisInstanceActionCreate,
+		ReadContext:   resourceIBMisInstanceActionRead,
+		UpdateContext: resourceIBMisInstanceActionUpdate,
+		DeleteContext: resourceIBMisInstanceActionDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isInstanceActionInstance: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the instance on which the action is performed",
+			},
+			isInstanceActionType: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: InvokeValidator("ibm_is_instance_action", isInstanceActionType),
+				Description:  "The type of action to perform on the instance, one of start, stop and reboot",
+			},
+			isInstanceActionForceAction: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set to true, the action will be forced immediately, and all queued actions deleted. Ignored for the start action.",
+			},
+			isInstanceStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the instance",
+			},
+		},
+	}
+}
+
+func resourceIBMISInstanceActionValidator() *ResourceValidator {
+
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isInstanceActionType,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Required:                   true,
+			AllowedValues:              "start, stop, reboot"})
+
+	ibmISInstanceActionValidator := ResourceValidator{ResourceName: "ibm_is_instance_action", Schema: validateSchema}
+	return &ibmISInstanceActionValidator
+}
+
+func resourceIBMisInstanceActionCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instance := d.Get(isInstanceActionInstance).(string)
+	err = instanceAction(context, sess, d, instance, d.Get(isInstanceActionType).(string), d.Get(isInstanceActionForceAction).(bool), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(instance)
+	return resourceIBMisInstanceActionRead(context, d, meta)
+}
+
+func resourceIBMisInstanceActionRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id := d.Id()
+	instance, response, err := sess.GetInstance(&vpcv1.GetInstanceOptions{
+		ID: &id,
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error Getting Instance (%s)", id))
+	}
+	d.Set(isInstanceActionInstance, *instance.ID)
+	d.Set(isInstanceStatus, *instance.Status)
+	return nil
+}
+
+func resourceIBMisInstanceActionUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChange(isInstanceActionType) {
+		err = instanceAction(context, sess, d, d.Id(), d.Get(isInstanceActionType).(string), d.Get(isInstanceActionForceAction).(bool), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceIBMisInstanceActionRead(context, d, meta)
+}
+
+func resourceIBMisInstanceActionDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+
+	d.SetId("")
+	return nil
+}
+
+// instanceAction performs the start, stop or reboot action on the instance and waits
+// for the instance to be running, or stopped for the stop action. The stop is retried
+// as for the instance when force_recovery_time is set on d.
+func instanceAction(context context.Context, sess *vpcv1.VpcV1, d *schema.ResourceData, id, actiontype string, force bool, timeout time.Duration) error {
+	createinsactoptions := &vpcv1.CreateInstanceActionOptions{
+		InstanceID: &id,
+		Type:       &actiontype,
+	}
+	if actiontype != isInstanceActionStart {
+		createinsactoptions.Force = &force
+	}
+	_, response, err := sess.CreateInstanceAction(createinsactoptions)
+	if err != nil {
+		return apiErrorf("vpc", err, response, "Error Creating Instance Action %s on instance (%s)", actiontype, id)
+	}
+	log.Printf("[INFO] Instance action %s on instance (%s)", actiontype, id)
+	if actiontype == isInstanceActionStop {
+		_, err = isWaitForInstanceActionStop(context, sess, timeout, id, d)
+	} else {
+		_, err = isWaitForInstanceAvailable(context, sess, id, timeout, d)
+	}
+	return err
+}
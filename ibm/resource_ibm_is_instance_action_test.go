@@ -0,0 +1,198 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
+)
+
+func TestAccIBMISInstanceAction_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-instance-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMISInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISInstanceActionConfig(vpcname, subnetname, name, "stop"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_instance_action.testacc_action", "status", "stopped"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISInstanceActionConfig(vpcname, subnetname, name, "start"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_instance_action.testacc_action", "status", "running"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMISInstance_powerState(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-instance-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMISInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISInstancePowerStateConfig(vpcname, subnetname, name, "stopped"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_instance.testacc_instance", "status", "stopped"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISInstancePowerStateConfig(vpcname, subnetname, name, "running"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_instance.testacc_instance", "status", "running"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckIBMISInstancePowerStateConfig returns the config of an instance, with
+// its power state managed unless powerState is empty.
+func testAccCheckIBMISInstancePowerStateConfig(vpcname, subnetname, name, powerState string) string {
+	powerStateArg := ""
+	if powerState != "" {
+		powerStateArg = fmt.Sprintf("power_state = %q", powerState)
+	}
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+	}
+
+	resource "ibm_is_instance" "testacc_instance" {
+		name    = "%s"
+		image   = "%s"
+		profile = "%s"
+		%s
+		primary_network_interface {
+			subnet = ibm_is_subnet.testacc_subnet.id
+		}
+		vpc  = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+	}`, vpcname, subnetname, ISZoneName, ISCIDR, name, isImage, instanceProfileName, powerStateArg, ISZoneName)
+}
+
+func testAccCheckIBMISInstanceActionConfig(vpcname, subnetname, name, action string) string {
+	// The power state of the instance is left to the action
+	return testAccCheckIBMISInstancePowerStateConfig(vpcname, subnetname, name, "") + fmt.Sprintf(`
+
+	resource "ibm_is_instance_action" "testacc_action" {
+		instance     = ibm_is_instance.testacc_instance.id
+		action       = "%s"
+		force_action = true
+	}`, action)
+}
+
+func TestIBMISInstanceActionMock(t *testing.T) {
+	t.Parallel()
+	srv := testMockServer(t)
+	meta := testMockClientSession(t, srv)
+	sess, err := vpcClient(meta)
+	assert.NilError(t, err)
+	vpcID, subnetID := testMockSubnet(t, meta)
+	instanceResource := resourceIBMISInstance()
+	r := resourceIBMISInstanceAction()
+
+	raw := map[string]interface{}{
+		isInstanceName:       "mock-parked",
+		isInstanceImage:      "mock-image",
+		isInstanceProfile:    "bx2-2x8",
+		isInstanceVPC:        vpcID,
+		isInstanceZone:       "us-south-1",
+		isInstancePowerState: "stopped",
+		isInstancePrimaryNetworkInterface: []interface{}{map[string]interface{}{
+			isInstanceNicSubnet: subnetID,
+		}},
+	}
+	instance := testMockInstance(t, meta, raw)
+	assert.Equal(t, instance.Get(isInstanceStatus), "stopped")
+	assert.Equal(t, instance.Get(isInstancePowerState), "stopped")
+
+	// A new profile leaves the stopped instance stopped
+	raw[isInstanceProfile] = "bx2-4x16"
+	instance = testMockResourceData(t, instanceResource, instance, raw)
+	assert.NilError(t, testDiagsErr(instanceResource.UpdateContext(context.Background(), instance, meta)))
+	assert.Equal(t, instance.Get(isInstanceStatus), "stopped")
+	assert.Equal(t, instance.Get(isInstanceProfile), "bx2-4x16")
+
+	raw[isInstancePowerState] = "running"
+	instance = testMockResourceData(t, instanceResource, instance, raw)
+	assert.NilError(t, testDiagsErr(instanceResource.UpdateContext(context.Background(), instance, meta)))
+	assert.Equal(t, instance.Get(isInstanceStatus), "running")
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		isInstanceActionInstance:    instance.Id(),
+		isInstanceActionType:        "stop",
+		isInstanceActionForceAction: true,
+	})
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), d, meta)))
+	assert.Equal(t, d.Id(), instance.Id())
+	assert.Equal(t, d.Get(isInstanceStatus), "stopped")
+
+	// The instance reports the drift from its power state
+	assert.NilError(t, testDiagsErr(instanceResource.ReadContext(context.Background(), instance, meta)))
+	assert.Equal(t, instance.Get(isInstancePowerState), "stopped")
+	diff, err := instanceResource.Diff(context.Background(), instance.State(), terraform.NewResourceConfigRaw(raw), meta)
+	assert.NilError(t, err)
+	assert.Equal(t, diff.Attributes[isInstancePowerState].New, "running")
+
+	updated := testMockResourceData(t, r, d, map[string]interface{}{
+		isInstanceActionInstance: instance.Id(),
+		isInstanceActionType:     "start",
+	})
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), updated, meta)))
+	assert.Equal(t, updated.Get(isInstanceStatus), "running")
+
+	rebooted := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		isInstanceActionInstance: instance.Id(),
+		isInstanceActionType:     "reboot",
+	})
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), rebooted, meta)))
+	assert.Equal(t, rebooted.Get(isInstanceStatus), "running")
+
+	diags := r.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		isInstanceActionInstance: instance.Id(),
+		isInstanceActionType:     "hibernate",
+	}))
+	assert.ErrorContains(t, testDiagsErr(diags), "hibernate")
+
+	// Removing the action leaves the instance as it is
+	assert.NilError(t, testDiagsErr(r.DeleteContext(context.Background(), updated, meta)))
+	id := instance.Id()
+	got, _, err := sess.GetInstance(&vpcv1.GetInstanceOptions{ID: &id})
+	assert.NilError(t, err)
+	assert.Equal(t, *got.Status, "running")
+
+	instance.Set(isEnableCleanDelete, false)
+	assert.NilError(t, testDiagsErr(instanceResource.DeleteContext(context.Background(), instance, meta)))
+	imported := r.Data(nil)
+	imported.SetId(d.Id())
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), imported, meta)))
+	assert.Equal(t, imported.Id(), "")
+}
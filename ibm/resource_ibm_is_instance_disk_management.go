@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -14,11 +16,11 @@ const ()
 
 func resourceIBMISInstanceDiskManagement() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMisInstanceDiskManagementCreate,
-		Read:     resourceIBMisInstanceDiskManagementRead,
-		Update:   resourceIBMisInstanceDiskManagementUpdate,
-		Delete:   resourceIBMisInstanceDiskManagementDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMisInstanceDiskManagementCreate,
+		ReadContext:   resourceIBMisInstanceDiskManagementRead,
+		UpdateContext: resourceIBMisInstanceDiskManagementUpdate,
+		DeleteContext: resourceIBMisInstanceDiskManagementDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"instance": {
@@ -68,10 +70,10 @@ func resourceIBMISInstanceDiskManagementValidator() *ResourceValidator {
 	return &ibmISInstanceDiskManagementValidator
 }
 
-func resourceIBMisInstanceDiskManagementCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceDiskManagementCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	instance := d.Get("instance").(string)
 	disks := d.Get("disks")
@@ -92,24 +94,24 @@ func resourceIBMisInstanceDiskManagementCreate(d *schema.ResourceData, meta inte
 
 		instanceDiskPatch, err := instanceDiskPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for InstanceDiskPatch: %s", err)
+			return diag.FromErr(fmt.Errorf("Error calling asPatch for InstanceDiskPatch: %s", err))
 		}
 		updateInstanceDiskOptions.SetInstanceDiskPatch(instanceDiskPatch)
 
 		_, response, err := sess.UpdateInstanceDisk(updateInstanceDiskOptions)
 		if err != nil {
-			return fmt.Errorf("Error calling UpdateInstanceDisk: %s %s", err, response)
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error calling UpdateInstanceDisk"))
 		}
 
 	}
 	d.SetId(instance)
-	return resourceIBMisInstanceDiskManagementRead(d, meta)
+	return resourceIBMisInstanceDiskManagementRead(context, d, meta)
 }
 
-func resourceIBMisInstanceDiskManagementUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceDiskManagementUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if d.HasChange("disks") && !d.IsNewResource() {
 
@@ -130,27 +132,27 @@ func resourceIBMisInstanceDiskManagementUpdate(d *schema.ResourceData, meta inte
 
 			instanceDiskPatch, err := instanceDiskPatchModel.AsPatch()
 			if err != nil {
-				return fmt.Errorf("Error calling asPatch for InstanceDiskPatch: %s", err)
+				return diag.FromErr(fmt.Errorf("Error calling asPatch for InstanceDiskPatch: %s", err))
 			}
 			updateInstanceDiskOptions.SetInstanceDiskPatch(instanceDiskPatch)
 
 			_, _, err = sess.UpdateInstanceDisk(updateInstanceDiskOptions)
 			if err != nil {
-				return fmt.Errorf("Error updating instance disk: %s", err)
+				return diag.FromErr(fmt.Errorf("Error updating instance disk: %s", err))
 			}
 
 		}
 	}
-	return resourceIBMisInstanceDiskManagementRead(d, meta)
+	return resourceIBMisInstanceDiskManagementRead(context, d, meta)
 }
 
-func resourceIBMisInstanceDiskManagementDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceDiskManagementDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	d.SetId("")
 	return nil
 }
 
-func resourceIBMisInstanceDiskManagementRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceDiskManagementRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	d.Set("instance", d.Id())
 
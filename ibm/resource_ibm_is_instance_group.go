@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,12 +29,11 @@ const (
 
 func resourceIBMISInstanceGroup() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISInstanceGroupCreate,
-		Read:     resourceIBMISInstanceGroupRead,
-		Update:   resourceIBMISInstanceGroupUpdate,
-		Delete:   resourceIBMISInstanceGroupDelete,
-		Exists:   resourceIBMISInstanceGroupExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISInstanceGroupCreate,
+		ReadContext:   resourceIBMISInstanceGroupRead,
+		UpdateContext: resourceIBMISInstanceGroupUpdate,
+		DeleteContext: resourceIBMISInstanceGroupDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
@@ -180,7 +180,8 @@ func resourceIBMISInstanceGroupValidator() *ResourceValidator {
 	return &ibmISInstanceGroupResourceValidator
 }
 
-func resourceIBMISInstanceGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISInstanceGroupCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 
 	name := d.Get("name").(string)
 	instanceTemplate := d.Get("instance_template").(string)
@@ -189,7 +190,7 @@ func resourceIBMISInstanceGroupCreate(d *schema.ResourceData, meta interface{})
 
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var subnetIDs []vpcv1.SubnetIdentityIntf
@@ -235,13 +236,13 @@ func resourceIBMISInstanceGroupCreate(d *schema.ResourceData, meta interface{})
 
 	instanceGroup, response, err := sess.CreateInstanceGroup(&instanceGroupOptions)
 	if err != nil || instanceGroup == nil {
-		return fmt.Errorf("Error Creating InstanceGroup: %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error Creating InstanceGroup"))
 	}
 	d.SetId(*instanceGroup.ID)
 
-	_, healthError := waitForHealthyInstanceGroup(d.Id(), meta, d.Timeout(schema.TimeoutCreate))
+	_, healthError := waitForHealthyInstanceGroup(context, d.Id(), meta, d.Timeout(schema.TimeoutCreate))
 	if healthError != nil {
-		return healthError
+		return diag.FromErr(healthError)
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
@@ -251,17 +252,19 @@ func resourceIBMISInstanceGroupCreate(d *schema.ResourceData, meta interface{})
 		if err != nil {
 			log.Printf(
 				"Error on create of instance group (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 
-	return resourceIBMISInstanceGroupRead(d, meta)
+	return append(diags, resourceIBMISInstanceGroupRead(context, d, meta)...)
 
 }
 
-func resourceIBMISInstanceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISInstanceGroupUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var changed bool
@@ -273,13 +276,14 @@ func resourceIBMISInstanceGroupUpdate(d *schema.ResourceData, meta interface{})
 		getInstanceGroupOptions := vpcv1.GetInstanceGroupOptions{ID: &instanceGroupID}
 		instanceGroup, response, err := sess.GetInstanceGroup(&getInstanceGroupOptions)
 		if err != nil || instanceGroup == nil {
-			return fmt.Errorf("Error getting instance group: %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error getting instance group"))
 		}
 		oldList, newList := d.GetChange("tags")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *instanceGroup.CRN)
 		if err != nil {
 			log.Printf(
 				"Error on update of instance group (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 
@@ -330,27 +334,36 @@ func resourceIBMISInstanceGroupUpdate(d *schema.ResourceData, meta interface{})
 		instanceGroupUpdateOptions.ID = &instanceGroupID
 		instanceGroupPatch, err := instanceGroupPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for InstanceGroupPatch: %s", err)
+			return diag.FromErr(fmt.Errorf("Error calling asPatch for InstanceGroupPatch: %s", err))
 		}
 		instanceGroupUpdateOptions.InstanceGroupPatch = instanceGroupPatch
 		_, response, err := sess.UpdateInstanceGroup(&instanceGroupUpdateOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating InstanceGroup: %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error Updating InstanceGroup"))
 		}
 
 		// wait for instance group health update with update timeout configured.
-		_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
+		_, healthError := waitForHealthyInstanceGroup(context, instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
 		if healthError != nil {
-			return healthError
+			return diag.FromErr(healthError)
 		}
 	}
-	return resourceIBMISInstanceGroupRead(d, meta)
+	return append(diags, resourceIBMISInstanceGroupRead(context, d, meta)...)
 }
 
-func resourceIBMISInstanceGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISInstanceGroupRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMISInstanceGroupExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceGroupID := d.Id()
@@ -361,7 +374,7 @@ func resourceIBMISInstanceGroupRead(d *schema.ResourceData, meta interface{}) er
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting InstanceGroup: %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error Getting InstanceGroup"))
 	}
 	d.Set("name", *instanceGroup.Name)
 	d.Set("instance_template", *instanceGroup.InstanceTemplate.ID)
@@ -404,15 +417,15 @@ func getLBStatus(sess *vpcv1.VpcV1, lbId string) (string, error) {
 	}
 	lb, response, err := sess.GetLoadBalancer(getlboptions)
 	if err != nil || lb == nil {
-		return "", fmt.Errorf("Error Getting Load Balancer : %s\n%s", err, response)
+		return "", apiErrorf("vpc", err, response, "Error Getting Load Balancer")
 	}
 	return *lb.ProvisioningStatus, nil
 }
 
-func resourceIBMISInstanceGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISInstanceGroupDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	instanceGroupID := d.Id()
 
@@ -424,9 +437,9 @@ func resourceIBMISInstanceGroupDelete(d *schema.ResourceData, meta interface{})
 	instanceGroup, response, err := sess.GetInstanceGroup(&igOpts)
 	if err != nil || instanceGroup == nil {
 		if response != nil && response.StatusCode == 404 {
-			return fmt.Errorf("Instance Group with id:[%s] not found!!", instanceGroupID)
+			return diag.FromErr(fmt.Errorf("Instance Group with id:[%s] not found!!", instanceGroupID))
 		}
-		return fmt.Errorf("Internal Error fetching info for instance group [%s]", instanceGroupID)
+		return diag.FromErr(fmt.Errorf("Internal Error fetching info for instance group [%s]", instanceGroupID))
 	}
 	// Inorder to delete instance group, need to update membership count to 0
 	zeroMembers := int64(0)
@@ -436,18 +449,18 @@ func resourceIBMISInstanceGroupDelete(d *schema.ResourceData, meta interface{})
 	instanceGroupPatchModel.MembershipCount = &zeroMembers
 	instanceGroupPatch, err := instanceGroupPatchModel.AsPatch()
 	if err != nil {
-		return fmt.Errorf("Error calling asPatch for ImagePatch: %s", err)
+		return diag.FromErr(fmt.Errorf("Error calling asPatch for ImagePatch: %s", err))
 	}
 
 	instanceGroupUpdateOptions.ID = &instanceGroupID
 	instanceGroupUpdateOptions.InstanceGroupPatch = instanceGroupPatch
 	_, response, err = sess.UpdateInstanceGroup(&instanceGroupUpdateOptions)
 	if err != nil {
-		return fmt.Errorf("Error updating instanceGroup's instance count to 0 : %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error updating instanceGroup's instance count to 0"))
 	}
-	_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
+	_, healthError := waitForHealthyInstanceGroup(context, instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
 	if healthError != nil {
-		return healthError
+		return diag.FromErr(healthError)
 	}
 
 	// If there is any load balancer, please check if it is active
@@ -459,20 +472,20 @@ func resourceIBMISInstanceGroupDelete(d *schema.ResourceData, meta interface{})
 		// Now check if the load balancer is in active state or not
 		lbStatus, err := getLBStatus(sess, loadBalancerID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if lbStatus != "active" {
 			log.Printf("Load Balancer [%s] is not active....Waiting it to be active!\n", loadBalancerID)
-			_, err := isWaitForLBAvailable(sess, loadBalancerID, d.Timeout(schema.TimeoutDelete))
+			_, err := isWaitForLBAvailable(context, sess, loadBalancerID, d.Timeout(schema.TimeoutDelete))
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			lbStatus, err = getLBStatus(sess, loadBalancerID)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			if lbStatus != "active" {
-				return fmt.Errorf("LoadBalancer [%s] is not active yet! Current Load Balancer status is [%s]", loadBalancerID, lbStatus)
+				return diag.FromErr(fmt.Errorf("LoadBalancer [%s] is not active yet! Current Load Balancer status is [%s]", loadBalancerID, lbStatus))
 			}
 		}
 	}
@@ -484,12 +497,12 @@ func resourceIBMISInstanceGroupDelete(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Deleting the InstanceGroup: %s\n%s", Err, response)
+		return diag.FromErr(fmt.Errorf("Error Deleting the InstanceGroup: %s\n%s", Err, response))
 	}
 
-	_, deleteError := waitForInstanceGroupDelete(d, meta)
+	_, deleteError := waitForInstanceGroupDelete(context, d, meta)
 	if deleteError != nil {
-		return deleteError
+		return diag.FromErr(deleteError)
 	}
 	return nil
 }
@@ -506,12 +519,12 @@ func resourceIBMISInstanceGroupExists(d *schema.ResourceData, meta interface{})
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error Getting InstanceGroup: %s\n%s", err, response)
+		return false, apiErrorf("vpc", err, response, "Error Getting InstanceGroup")
 	}
 	return true, nil
 }
 
-func waitForHealthyInstanceGroup(instanceGroupID string, meta interface{}, timeout time.Duration) (interface{}, error) {
+func waitForHealthyInstanceGroup(context context.Context, instanceGroupID string, meta interface{}, timeout time.Duration) (interface{}, error) {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return nil, err
@@ -525,7 +538,7 @@ func waitForHealthyInstanceGroup(instanceGroupID string, meta interface{}, timeo
 		Refresh: func() (interface{}, string, error) {
 			instanceGroup, response, err := sess.GetInstanceGroup(&getInstanceGroupOptions)
 			if err != nil || instanceGroup == nil {
-				return nil, SCALING, fmt.Errorf("Error Getting InstanceGroup: %s\n%s", err, response)
+				return nil, SCALING, apiErrorf("vpc", err, response, "Error Getting InstanceGroup")
 			}
 			log.Println("Status : ", *instanceGroup.Status)
 
@@ -540,11 +553,11 @@ func waitForHealthyInstanceGroup(instanceGroupID string, meta interface{}, timeo
 		PollInterval: 10 * time.Second,
 	}
 
-	return healthStateConf.WaitForState()
+	return healthStateConf.WaitForStateContext(context)
 
 }
 
-func waitForInstanceGroupDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForInstanceGroupDelete(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	healthStateConf := &resource.StateChangeConf{
 		Pending: []string{HEALTHY},
 		Target:  []string{DELETING},
@@ -561,6 +574,6 @@ func waitForInstanceGroupDelete(d *schema.ResourceData, meta interface{}) (inter
 		PollInterval: 10 * time.Second,
 	}
 
-	return healthStateConf.WaitForState()
+	return healthStateConf.WaitForStateContext(context)
 
 }
@@ -4,20 +4,21 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMISInstanceGroupManager() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISInstanceGroupManagerCreate,
-		Read:     resourceIBMISInstanceGroupManagerRead,
-		Update:   resourceIBMISInstanceGroupManagerUpdate,
-		Delete:   resourceIBMISInstanceGroupManagerDelete,
-		Exists:   resourceIBMISInstanceGroupManagerExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISInstanceGroupManagerCreate,
+		ReadContext:   resourceIBMISInstanceGroupManagerRead,
+		UpdateContext: resourceIBMISInstanceGroupManagerUpdate,
+		DeleteContext: resourceIBMISInstanceGroupManagerDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 
@@ -149,14 +150,14 @@ func resourceIBMISInstanceGroupManagerValidator() *ResourceValidator {
 	return &ibmISInstanceGroupManagerResourceValidator
 }
 
-func resourceIBMISInstanceGroupManagerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISInstanceGroupManagerCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	instanceGroupID := d.Get("instance_group").(string)
 	maxMembershipCount := int64(d.Get("max_membership_count").(int))
 
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceGroupManagerPrototype := vpcv1.InstanceGroupManagerPrototype{}
@@ -198,20 +199,20 @@ func resourceIBMISInstanceGroupManagerCreate(d *schema.ResourceData, meta interf
 	}
 	instanceGroupManagerIntf, response, err := sess.CreateInstanceGroupManager(&createInstanceGroupManagerOptions)
 	if err != nil || instanceGroupManagerIntf == nil {
-		return fmt.Errorf("Error creating InstanceGroup manager: %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error creating InstanceGroup manager"))
 	}
 	instanceGroupManager := instanceGroupManagerIntf.(*vpcv1.InstanceGroupManager)
 
 	d.SetId(fmt.Sprintf("%s/%s", instanceGroupID, *instanceGroupManager.ID))
 
-	return resourceIBMISInstanceGroupManagerRead(d, meta)
+	return resourceIBMISInstanceGroupManagerRead(context, d, meta)
 
 }
 
-func resourceIBMISInstanceGroupManagerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISInstanceGroupManagerUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var changed bool
@@ -257,7 +258,7 @@ func resourceIBMISInstanceGroupManagerUpdate(d *schema.ResourceData, meta interf
 	if changed {
 		parts, err := idParts(d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		instanceGroupID := parts[0]
 		instanceGroupManagerID := parts[1]
@@ -265,26 +266,35 @@ func resourceIBMISInstanceGroupManagerUpdate(d *schema.ResourceData, meta interf
 		updateInstanceGroupManagerOptions.InstanceGroupID = &instanceGroupID
 		instanceGroupManagerPatch, err := instanceGroupManagerPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for InstanceGroupManagerPatch: %s", err)
+			return diag.FromErr(fmt.Errorf("Error calling asPatch for InstanceGroupManagerPatch: %s", err))
 		}
 		updateInstanceGroupManagerOptions.InstanceGroupManagerPatch = instanceGroupManagerPatch
 		_, response, err := sess.UpdateInstanceGroupManager(&updateInstanceGroupManagerOptions)
 		if err != nil {
-			return fmt.Errorf("Error updating InstanceGroup manager: %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error updating InstanceGroup manager"))
 		}
 	}
-	return resourceIBMISInstanceGroupManagerRead(d, meta)
+	return resourceIBMISInstanceGroupManagerRead(context, d, meta)
 }
 
-func resourceIBMISInstanceGroupManagerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISInstanceGroupManagerRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMISInstanceGroupManagerExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	instanceGroupID := parts[0]
 	instanceGroupManagerID := parts[1]
@@ -299,7 +309,7 @@ func resourceIBMISInstanceGroupManagerRead(d *schema.ResourceData, meta interfac
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting InstanceGroup Manager: %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error Getting InstanceGroup Manager"))
 	}
 	instanceGroupManager := instanceGroupManagerIntf.(*vpcv1.InstanceGroupManager)
 	d.Set("name", *instanceGroupManager.Name)
@@ -321,15 +331,15 @@ func resourceIBMISInstanceGroupManagerRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceIBMISInstanceGroupManagerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISInstanceGroupManagerDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	instanceGroupID := parts[0]
 	instanceGroupManagerID := parts[1]
@@ -344,7 +354,7 @@ func resourceIBMISInstanceGroupManagerDelete(d *schema.ResourceData, meta interf
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Deleting the InstanceGroup Manager: %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error Deleting the InstanceGroup Manager"))
 	}
 	return nil
 }
@@ -372,7 +382,7 @@ func resourceIBMISInstanceGroupManagerExists(d *schema.ResourceData, meta interf
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error Getting InstanceGroup Manager: %s\n%s", err, response)
+		return false, apiErrorf("vpc", err, response, "Error Getting InstanceGroup Manager")
 	}
 	return true, nil
 }
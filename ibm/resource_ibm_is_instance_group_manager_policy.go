@@ -4,20 +4,21 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMISInstanceGroupManagerPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISInstanceGroupManagerPolicyCreate,
-		Read:     resourceIBMISInstanceGroupManagerPolicyRead,
-		Update:   resourceIBMISInstanceGroupManagerPolicyUpdate,
-		Delete:   resourceIBMISInstanceGroupManagerPolicyDelete,
-		Exists:   resourceIBMISInstanceGroupManagerPolicyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISInstanceGroupManagerPolicyCreate,
+		ReadContext:   resourceIBMISInstanceGroupManagerPolicyRead,
+		UpdateContext: resourceIBMISInstanceGroupManagerPolicyUpdate,
+		DeleteContext: resourceIBMISInstanceGroupManagerPolicyDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 
@@ -102,13 +103,13 @@ func resourceIBMISInstanceGroupManagerPolicyValidator() *ResourceValidator {
 	return &ibmISInstanceGroupManagerPolicyResourceValidator
 }
 
-func resourceIBMISInstanceGroupManagerPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISInstanceGroupManagerPolicyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceGroupID := d.Get("instance_group").(string)
 	instanceGroupManagerID := d.Get("instance_group_manager").(string)
 
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceGroupManagerPolicyPrototype := vpcv1.InstanceGroupManagerPolicyPrototype{}
@@ -133,27 +134,27 @@ func resourceIBMISInstanceGroupManagerPolicyCreate(d *schema.ResourceData, meta
 	ibmMutexKV.Lock(isInsGrpKey)
 	defer ibmMutexKV.Unlock(isInsGrpKey)
 
-	_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutCreate))
+	_, healthError := waitForHealthyInstanceGroup(context, instanceGroupID, meta, d.Timeout(schema.TimeoutCreate))
 	if healthError != nil {
-		return healthError
+		return diag.FromErr(healthError)
 	}
 
 	data, response, err := sess.CreateInstanceGroupManagerPolicy(&createInstanceGroupManagerPolicyOptions)
 	if err != nil || data == nil {
-		return fmt.Errorf("Error Creating InstanceGroup Manager Policy: %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error Creating InstanceGroup Manager Policy"))
 	}
 	instanceGroupManagerPolicy := data.(*vpcv1.InstanceGroupManagerPolicy)
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", instanceGroupID, instanceGroupManagerID, *instanceGroupManagerPolicy.ID))
 
-	return resourceIBMISInstanceGroupManagerPolicyRead(d, meta)
+	return resourceIBMISInstanceGroupManagerPolicyRead(context, d, meta)
 
 }
 
-func resourceIBMISInstanceGroupManagerPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISInstanceGroupManagerPolicyUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var changed bool
@@ -180,7 +181,7 @@ func resourceIBMISInstanceGroupManagerPolicyUpdate(d *schema.ResourceData, meta
 	if changed {
 		parts, err := idParts(d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		instanceGroupID := parts[0]
 		instanceGroupManagerID := parts[1]
@@ -194,28 +195,37 @@ func resourceIBMISInstanceGroupManagerPolicyUpdate(d *schema.ResourceData, meta
 		ibmMutexKV.Lock(isInsGrpKey)
 		defer ibmMutexKV.Unlock(isInsGrpKey)
 
-		_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
+		_, healthError := waitForHealthyInstanceGroup(context, instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
 		if healthError != nil {
-			return healthError
+			return diag.FromErr(healthError)
 		}
 
 		_, response, err := sess.UpdateInstanceGroupManagerPolicy(&updateInstanceGroupManagerPolicyOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating InstanceGroup Manager Policy: %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error Updating InstanceGroup Manager Policy"))
 		}
 	}
-	return resourceIBMISInstanceGroupManagerPolicyRead(d, meta)
+	return resourceIBMISInstanceGroupManagerPolicyRead(context, d, meta)
 }
 
-func resourceIBMISInstanceGroupManagerPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISInstanceGroupManagerPolicyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMISInstanceGroupManagerPolicyExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	instanceGroupID := parts[0]
 	instanceGroupManagerID := parts[1]
@@ -232,7 +242,7 @@ func resourceIBMISInstanceGroupManagerPolicyRead(d *schema.ResourceData, meta in
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting InstanceGroup Manager Policy: %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error Getting InstanceGroup Manager Policy"))
 	}
 	instanceGroupManagerPolicy := data.(*vpcv1.InstanceGroupManagerPolicy)
 	d.Set("name", *instanceGroupManagerPolicy.Name)
@@ -246,14 +256,14 @@ func resourceIBMISInstanceGroupManagerPolicyRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceIBMISInstanceGroupManagerPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISInstanceGroupManagerPolicyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	instanceGroupID := parts[0]
 	instanceGroupManagerID := parts[1]
@@ -269,9 +279,9 @@ func resourceIBMISInstanceGroupManagerPolicyDelete(d *schema.ResourceData, meta
 	ibmMutexKV.Lock(isInsGrpKey)
 	defer ibmMutexKV.Unlock(isInsGrpKey)
 
-	_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutDelete))
+	_, healthError := waitForHealthyInstanceGroup(context, instanceGroupID, meta, d.Timeout(schema.TimeoutDelete))
 	if healthError != nil {
-		return healthError
+		return diag.FromErr(healthError)
 	}
 
 	response, err := sess.DeleteInstanceGroupManagerPolicy(&deleteInstanceGroupManagerPolicyOptions)
@@ -280,7 +290,7 @@ func resourceIBMISInstanceGroupManagerPolicyDelete(d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Deleting the InstanceGroup Manager Policy: %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error Deleting the InstanceGroup Manager Policy"))
 	}
 	return nil
 }
@@ -310,7 +320,7 @@ func resourceIBMISInstanceGroupManagerPolicyExists(d *schema.ResourceData, meta
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error Getting InstanceGroup Manager Policy: %s\n%s", err, response)
+		return false, apiErrorf("vpc", err, response, "Error Getting InstanceGroup Manager Policy")
 	}
 	return true, nil
 }
@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -28,11 +30,11 @@ const (
 
 func resourceIBMISInstanceGroupMembership() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISInstanceGroupMembershipUpdate,
-		Read:     resourceIBMISInstanceGroupMembershipRead,
-		Update:   resourceIBMISInstanceGroupMembershipUpdate,
-		Delete:   resourceIBMISInstanceGroupMembershipDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISInstanceGroupMembershipUpdate,
+		ReadContext:   resourceIBMISInstanceGroupMembershipRead,
+		UpdateContext: resourceIBMISInstanceGroupMembershipUpdate,
+		DeleteContext: resourceIBMISInstanceGroupMembershipDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 
@@ -160,10 +162,10 @@ func resourceIBMISInstanceGroupMembershipValidator() *ResourceValidator {
 	return &ibmISInstanceGroupMembershipResourceValidator
 }
 
-func resourceIBMISInstanceGroupMembershipUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISInstanceGroupMembershipUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceGroupID := d.Get(isInstanceGroup).(string)
@@ -176,14 +178,14 @@ func resourceIBMISInstanceGroupMembershipUpdate(d *schema.ResourceData, meta int
 
 	instanceGroupMembership, response, err := sess.GetInstanceGroupMembership(&getInstanceGroupMembershipOptions)
 	if err != nil || instanceGroupMembership == nil {
-		return fmt.Errorf("Error Getting InstanceGroup Membership: %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error Getting InstanceGroup Membership"))
 	}
 	d.SetId(fmt.Sprintf("%s/%s", instanceGroupID, instanceGroupMembershipID))
 
 	if v, ok := d.GetOk(isInstanceGroupMemershipActionDelete); ok {
 		actionDelete := v.(bool)
 		if actionDelete {
-			return resourceIBMISInstanceGroupMembershipDelete(d, meta)
+			return resourceIBMISInstanceGroupMembershipDelete(context, d, meta)
 		}
 	}
 
@@ -199,27 +201,27 @@ func resourceIBMISInstanceGroupMembershipUpdate(d *schema.ResourceData, meta int
 			updateInstanceGroupMembershipOptions.InstanceGroupID = &instanceGroupID
 			instanceGroupMembershipPatch, err := instanceGroupMembershipPatchModel.AsPatch()
 			if err != nil {
-				return fmt.Errorf("Error calling asPatch for InstanceGroupMembershipPatch: %s", err)
+				return diag.FromErr(fmt.Errorf("Error calling asPatch for InstanceGroupMembershipPatch: %s", err))
 			}
 			updateInstanceGroupMembershipOptions.InstanceGroupMembershipPatch = instanceGroupMembershipPatch
 			_, response, err := sess.UpdateInstanceGroupMembership(&updateInstanceGroupMembershipOptions)
 			if err != nil {
-				return fmt.Errorf("Error updating InstanceGroup Membership: %s\n%s", err, response)
+				return diag.FromErr(apiErrorf("vpc", err, response, "Error updating InstanceGroup Membership"))
 			}
 		}
 	}
-	return resourceIBMISInstanceGroupMembershipRead(d, meta)
+	return resourceIBMISInstanceGroupMembershipRead(context, d, meta)
 }
 
-func resourceIBMISInstanceGroupMembershipRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISInstanceGroupMembershipRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	instanceGroupID := parts[0]
 	instanceGroupMembershipID := parts[1]
@@ -234,7 +236,7 @@ func resourceIBMISInstanceGroupMembershipRead(d *schema.ResourceData, meta inter
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting InstanceGroup Membership: %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error Getting InstanceGroup Membership"))
 	}
 	d.Set(isInstanceGroupMemershipDeleteInstanceOnMembershipDelete, *instanceGroupMembership.DeleteInstanceOnMembershipDelete)
 	d.Set(isInstanceGroupMembership, *instanceGroupMembership.ID)
@@ -268,15 +270,15 @@ func resourceIBMISInstanceGroupMembershipRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceIBMISInstanceGroupMembershipDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISInstanceGroupMembershipDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	instanceGroupID := parts[0]
 	instanceGroupMembershipID := parts[1]
@@ -291,7 +293,7 @@ func resourceIBMISInstanceGroupMembershipDelete(d *schema.ResourceData, meta int
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Deleting the InstanceGroup Membership: %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error Deleting the InstanceGroup Membership"))
 	}
 	return nil
 }
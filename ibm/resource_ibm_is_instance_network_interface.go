@@ -0,0 +1,461 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isInstanceNetworkInterfaceInstance          = "instance"
+	isInstanceNetworkInterfaceSubnet            = "subnet"
+	isInstanceNetworkInterfaceName              = "name"
+	isInstanceNetworkInterfaceAllowIPSpoofing   = "allow_ip_spoofing"
+	isInstanceNetworkInterfacePrimaryIpv4       = "primary_ipv4_address"
+	isInstanceNetworkInterfaceSecurityGroups    = "security_groups"
+	isInstanceNetworkInterfaceFloatingIP        = "floating_ip"
+	isInstanceNetworkInterfaceFloatingIPAddress = "floating_ip_address"
+	isInstanceNetworkInterfaceID                = "network_interface_id"
+	isInstanceNetworkInterfaceStatus            = "status"
+	isInstanceNetworkInterfaceType              = "type"
+	isInstanceNetworkInterfacePending           = "pending"
+	isInstanceNetworkInterfaceAvailable         = "available"
+	isInstanceNetworkInterfaceDeleting          = "deleting"
+	isInstanceNetworkInterfaceDeleted           = "deleted"
+)
+
+func resourceIBMISInstanceNetworkInterface() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMisInstanceNetworkInterfaceCreate,
+		ReadContext:   resourceIBMisInstanceNetworkInterfaceRead,
+		UpdateContext: resourceIBMisInstanceNetworkInterfaceUpdate,
+		DeleteContext: resourceIBMisInstanceNetworkInterfaceDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isInstanceNetworkInterfaceInstance: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the instance, the running instance is stopped to add or remove the network interface and started again",
+			},
+			isInstanceNetworkInterfaceSubnet: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the subnet, in the VPC and the zone of the instance",
+			},
+			isInstanceNetworkInterfaceName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: InvokeValidator("ibm_is_instance_network_interface", isInstanceNetworkInterfaceName),
+				Description:  "The name of the network interface",
+			},
+			isInstanceNetworkInterfaceAllowIPSpoofing: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates whether IP spoofing is allowed on this interface.",
+			},
+			isInstanceNetworkInterfacePrimaryIpv4: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The primary IPv4 address of the network interface, by default an available address of the subnet",
+			},
+			isInstanceNetworkInterfaceSecurityGroups: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "IDs of the security groups of the network interface, by default the default security group of the VPC",
+			},
+			isInstanceNetworkInterfaceFloatingIP: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of a floating IP in the zone of the instance to associate with the network interface",
+			},
+			isInstanceNetworkInterfaceFloatingIPAddress: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The address of the associated floating IP",
+			},
+			isInstanceNetworkInterfaceID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the network interface",
+			},
+			isInstanceNetworkInterfaceStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the network interface",
+			},
+			isInstanceNetworkInterfaceType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the network interface, primary or secondary",
+			},
+		},
+	}
+}
+
+func resourceIBMISInstanceNetworkInterfaceValidator() *ResourceValidator {
+
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isInstanceNetworkInterfaceName,
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63})
+
+	ibmISInstanceNetworkInterfaceValidator := ResourceValidator{ResourceName: "ibm_is_instance_network_interface", Schema: validateSchema}
+	return &ibmISInstanceNetworkInterfaceValidator
+}
+
+func resourceIBMisInstanceNetworkInterfaceCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceID := d.Get(isInstanceNetworkInterfaceInstance).(string)
+	subnetID := d.Get(isInstanceNetworkInterfaceSubnet).(string)
+	allowIPSpoofing := d.Get(isInstanceNetworkInterfaceAllowIPSpoofing).(bool)
+	options := &vpcv1.CreateInstanceNetworkInterfaceOptions{
+		InstanceID: &instanceID,
+		Subnet: &vpcv1.SubnetIdentity{
+			ID: &subnetID,
+		},
+		AllowIPSpoofing: &allowIPSpoofing,
+	}
+	if v, ok := d.GetOk(isInstanceNetworkInterfaceName); ok {
+		name := v.(string)
+		options.Name = &name
+	}
+	if v, ok := d.GetOk(isInstanceNetworkInterfacePrimaryIpv4); ok {
+		address := v.(string)
+		options.PrimaryIpv4Address = &address
+	}
+	if v, ok := d.GetOk(isInstanceNetworkInterfaceSecurityGroups); ok {
+		for _, group := range expandStringList(v.(*schema.Set).List()) {
+			groupID := group
+			options.SecurityGroups = append(options.SecurityGroups, &vpcv1.SecurityGroupIdentity{
+				ID: &groupID,
+			})
+		}
+	}
+
+	ibmMutexKV.Lock(instanceID)
+	defer ibmMutexKV.Unlock(instanceID)
+	err = instanceStoppedFor(context, sess, d, instanceID, d.Timeout(schema.TimeoutCreate), func() error {
+		nic, response, err := sess.CreateInstanceNetworkInterface(options)
+		if err != nil {
+			return apiErrorf("vpc", err, response, "Error while creating network interface on subnet %s for instance %s", subnetID, instanceID)
+		}
+		d.SetId(fmt.Sprintf("%s/%s", instanceID, *nic.ID))
+		log.Printf("[INFO] Instance network interface : %s", d.Id())
+		_, err = isWaitForInstanceNetworkInterfaceAvailable(context, sess, instanceID, *nic.ID, d.Timeout(schema.TimeoutCreate))
+		return err
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if v, ok := d.GetOk(isInstanceNetworkInterfaceFloatingIP); ok {
+		parts, err := idParts(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = instanceNetworkInterfaceAddFloatingIP(sess, instanceID, parts[1], v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceIBMisInstanceNetworkInterfaceRead(context, d, meta)
+}
+
+func resourceIBMisInstanceNetworkInterfaceRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceID, id := parts[0], parts[1]
+	nic, response, err := sess.GetInstanceNetworkInterface(&vpcv1.GetInstanceNetworkInterfaceOptions{
+		InstanceID: &instanceID,
+		ID:         &id,
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error Getting Network Interface (%s)", d.Id()))
+	}
+	d.Set(isInstanceNetworkInterfaceInstance, instanceID)
+	d.Set(isInstanceNetworkInterfaceID, *nic.ID)
+	d.Set(isInstanceNetworkInterfaceName, *nic.Name)
+	d.Set(isInstanceNetworkInterfaceAllowIPSpoofing, *nic.AllowIPSpoofing)
+	d.Set(isInstanceNetworkInterfacePrimaryIpv4, *nic.PrimaryIpv4Address)
+	d.Set(isInstanceNetworkInterfaceStatus, *nic.Status)
+	d.Set(isInstanceNetworkInterfaceType, *nic.Type)
+	if nic.Subnet != nil {
+		d.Set(isInstanceNetworkInterfaceSubnet, *nic.Subnet.ID)
+	}
+	groups := make([]string, 0)
+	for _, group := range nic.SecurityGroups {
+		groups = append(groups, *group.ID)
+	}
+	d.Set(isInstanceNetworkInterfaceSecurityGroups, newStringSet(schema.HashString, groups))
+	d.Set(isInstanceNetworkInterfaceFloatingIP, "")
+	d.Set(isInstanceNetworkInterfaceFloatingIPAddress, "")
+	if len(nic.FloatingIps) > 0 {
+		d.Set(isInstanceNetworkInterfaceFloatingIP, *nic.FloatingIps[0].ID)
+		d.Set(isInstanceNetworkInterfaceFloatingIPAddress, *nic.FloatingIps[0].Address)
+	}
+	return nil
+}
+
+func resourceIBMisInstanceNetworkInterfaceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceID, id := parts[0], parts[1]
+
+	if d.HasChange(isInstanceNetworkInterfaceName) || d.HasChange(isInstanceNetworkInterfaceAllowIPSpoofing) {
+		allowIPSpoofing := d.Get(isInstanceNetworkInterfaceAllowIPSpoofing).(bool)
+		networkInterfacePatchModel := &vpcv1.NetworkInterfacePatch{
+			AllowIPSpoofing: &allowIPSpoofing,
+		}
+		if v, ok := d.GetOk(isInstanceNetworkInterfaceName); ok {
+			name := v.(string)
+			networkInterfacePatchModel.Name = &name
+		}
+		networkInterfacePatch, err := networkInterfacePatchModel.AsPatch()
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("Error calling asPatch for NetworkInterfacePatch: %s", err))
+		}
+		_, response, err := sess.UpdateInstanceNetworkInterface(&vpcv1.UpdateInstanceNetworkInterfaceOptions{
+			InstanceID:            &instanceID,
+			ID:                    &id,
+			NetworkInterfacePatch: networkInterfacePatch,
+		})
+		if err != nil {
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error Updating Network Interface (%s)", d.Id()))
+		}
+	}
+
+	if d.HasChange(isInstanceNetworkInterfaceSecurityGroups) {
+		ovs, nvs := d.GetChange(isInstanceNetworkInterfaceSecurityGroups)
+		ov := ovs.(*schema.Set)
+		nv := nvs.(*schema.Set)
+		remove := expandStringList(ov.Difference(nv).List())
+		add := expandStringList(nv.Difference(ov).List())
+		// the network interface is added first as it must stay in a security group
+		for i := range add {
+			_, response, err := sess.AddSecurityGroupNetworkInterface(&vpcv1.AddSecurityGroupNetworkInterfaceOptions{
+				SecurityGroupID: &add[i],
+				ID:              &id,
+			})
+			if err != nil {
+				return diag.FromErr(apiErrorf("vpc", err, response, "Error while adding security group %q to network interface %s", add[i], d.Id()))
+			}
+		}
+		for i := range remove {
+			response, err := sess.RemoveSecurityGroupNetworkInterface(&vpcv1.RemoveSecurityGroupNetworkInterfaceOptions{
+				SecurityGroupID: &remove[i],
+				ID:              &id,
+			})
+			if err != nil {
+				return diag.FromErr(apiErrorf("vpc", err, response, "Error while removing security group %q from network interface %s", remove[i], d.Id()))
+			}
+		}
+	}
+
+	if d.HasChange(isInstanceNetworkInterfaceFloatingIP) {
+		old, new := d.GetChange(isInstanceNetworkInterfaceFloatingIP)
+		if old.(string) != "" {
+			err = instanceNetworkInterfaceRemoveFloatingIP(sess, instanceID, id, old.(string))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if new.(string) != "" {
+			err = instanceNetworkInterfaceAddFloatingIP(sess, instanceID, id, new.(string))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+	return resourceIBMisInstanceNetworkInterfaceRead(context, d, meta)
+}
+
+func resourceIBMisInstanceNetworkInterfaceDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceID, id := parts[0], parts[1]
+	if v, ok := d.GetOk(isInstanceNetworkInterfaceFloatingIP); ok {
+		err = instanceNetworkInterfaceRemoveFloatingIP(sess, instanceID, id, v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	ibmMutexKV.Lock(instanceID)
+	defer ibmMutexKV.Unlock(instanceID)
+	err = instanceStoppedFor(context, sess, d, instanceID, d.Timeout(schema.TimeoutDelete), func() error {
+		response, err := sess.DeleteInstanceNetworkInterface(&vpcv1.DeleteInstanceNetworkInterfaceOptions{
+			InstanceID: &instanceID,
+			ID:         &id,
+		})
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				return nil
+			}
+			return apiErrorf("vpc", err, response, "Error while deleting Network Interface (%s)", d.Id())
+		}
+		_, err = isWaitForInstanceNetworkInterfaceDeleted(context, sess, instanceID, id, d.Timeout(schema.TimeoutDelete))
+		return err
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+// instanceStoppedFor runs change, which adds or removes a network interface, with
+// the instance stopped. A running instance is stopped first and started again after
+// the change, even when it fails. A deleted instance is left alone.
+func instanceStoppedFor(context context.Context, sess *vpcv1.VpcV1, d *schema.ResourceData, instanceID string, timeout time.Duration, change func() error) error {
+	instance, response, err := sess.GetInstance(&vpcv1.GetInstanceOptions{
+		ID: &instanceID,
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 && !d.IsNewResource() {
+			return nil
+		}
+		return apiErrorf("vpc", err, response, "Error Getting Instance (%s)", instanceID)
+	}
+	running := *instance.Status == isInstanceStatusRunning
+	if running {
+		err = instanceAction(context, sess, d, instanceID, isInstanceActionStop, false, timeout)
+		if err != nil {
+			return err
+		}
+	}
+	err = change()
+	if running {
+		if starterr := instanceAction(context, sess, d, instanceID, isInstanceActionStart, false, timeout); err == nil {
+			err = starterr
+		}
+	}
+	return err
+}
+
+func instanceNetworkInterfaceAddFloatingIP(sess *vpcv1.VpcV1, instanceID, nicID, floatingIP string) error {
+	_, response, err := sess.AddInstanceNetworkInterfaceFloatingIP(&vpcv1.AddInstanceNetworkInterfaceFloatingIPOptions{
+		InstanceID:         &instanceID,
+		NetworkInterfaceID: &nicID,
+		ID:                 &floatingIP,
+	})
+	if err != nil {
+		return apiErrorf("vpc", err, response, "Error while associating floating IP %s with network interface %s", floatingIP, nicID)
+	}
+	return nil
+}
+
+func instanceNetworkInterfaceRemoveFloatingIP(sess *vpcv1.VpcV1, instanceID, nicID, floatingIP string) error {
+	response, err := sess.RemoveInstanceNetworkInterfaceFloatingIP(&vpcv1.RemoveInstanceNetworkInterfaceFloatingIPOptions{
+		InstanceID:         &instanceID,
+		NetworkInterfaceID: &nicID,
+		ID:                 &floatingIP,
+	})
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return apiErrorf("vpc", err, response, "Error while disassociating floating IP %s from network interface %s", floatingIP, nicID)
+	}
+	return nil
+}
+
+func isWaitForInstanceNetworkInterfaceAvailable(context context.Context, sess *vpcv1.VpcV1, instanceID, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for network interface (%s) to be available.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{isInstanceNetworkInterfacePending},
+		Target:     []string{isInstanceNetworkInterfaceAvailable},
+		Refresh:    isInstanceNetworkInterfaceRefreshFunc(sess, instanceID, id),
+		Timeout:    timeout,
+		Delay:      vpcWaitDelay,
+		MinTimeout: vpcWaitMinTimeout,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+func isWaitForInstanceNetworkInterfaceDeleted(context context.Context, sess *vpcv1.VpcV1, instanceID, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for network interface (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{isInstanceNetworkInterfaceAvailable, isInstanceNetworkInterfaceDeleting},
+		Target:     []string{isInstanceNetworkInterfaceDeleted},
+		Refresh:    isInstanceNetworkInterfaceRefreshFunc(sess, instanceID, id),
+		Timeout:    timeout,
+		Delay:      vpcWaitDelay,
+		MinTimeout: vpcWaitMinTimeout,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+func isInstanceNetworkInterfaceRefreshFunc(sess *vpcv1.VpcV1, instanceID, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		nic, response, err := sess.GetInstanceNetworkInterface(&vpcv1.GetInstanceNetworkInterfaceOptions{
+			InstanceID: &instanceID,
+			ID:         &id,
+		})
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				return id, isInstanceNetworkInterfaceDeleted, nil
+			}
+			return nil, "", apiErrorf("vpc", err, response, "Error Getting Network Interface (%s)", id)
+		}
+		if *nic.Status == isInstanceFailed {
+			return nic, *nic.Status, fmt.Errorf("The network interface %s of instance %s failed", id, instanceID)
+		}
+		return nic, *nic.Status, nil
+	}
+}
@@ -0,0 +1,237 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
+)
+
+func TestAccIBMISInstanceNetworkInterface_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-instance-%d", acctest.RandIntRange(10, 100))
+	fipname := fmt.Sprintf("tf-fip-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMISInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISInstanceNetworkInterfaceConfig(vpcname, subnetname, name, fipname, "tf-eth1", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_instance_network_interface.testacc_nic", "name", "tf-eth1"),
+					resource.TestCheckResourceAttr("ibm_is_instance_network_interface.testacc_nic", "type", "secondary"),
+					resource.TestCheckResourceAttrPair("ibm_is_instance_network_interface.testacc_nic", "floating_ip_address", "ibm_is_floating_ip.testacc_fip", "address"),
+					resource.TestCheckResourceAttr("ibm_is_instance.testacc_instance", "status", "running"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISInstanceNetworkInterfaceConfig(vpcname, subnetname, name, fipname, "tf-eth1-renamed", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_instance_network_interface.testacc_nic", "name", "tf-eth1-renamed"),
+					resource.TestCheckResourceAttr("ibm_is_instance_network_interface.testacc_nic", "allow_ip_spoofing", "true"),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_instance_network_interface.testacc_nic",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISInstanceNetworkInterfaceConfig(vpcname, subnetname, name, fipname, nicname string, allowIPSpoofing bool) string {
+	return testAccCheckIBMISInstancePowerStateConfig(vpcname, subnetname, name, "running") + fmt.Sprintf(`
+
+	resource "ibm_is_floating_ip" "testacc_fip" {
+		name = "%s"
+		zone = "%s"
+	}
+
+	resource "ibm_is_instance_network_interface" "testacc_nic" {
+		instance          = ibm_is_instance.testacc_instance.id
+		subnet            = ibm_is_subnet.testacc_subnet.id
+		name              = "%s"
+		allow_ip_spoofing = %t
+		floating_ip       = ibm_is_floating_ip.testacc_fip.id
+	}`, fipname, ISZoneName, nicname, allowIPSpoofing)
+}
+
+func TestIBMISInstanceNetworkInterfaceMock(t *testing.T) {
+	t.Parallel()
+	srv := testMockServer(t)
+	meta := testMockClientSession(t, srv)
+	sess, err := vpcClient(meta)
+	assert.NilError(t, err)
+	vpcID, subnetID := testMockSubnet(t, meta)
+	instanceResource := resourceIBMISInstance()
+	r := resourceIBMISInstanceNetworkInterface()
+
+	raw := map[string]interface{}{
+		isInstanceName:    "mock-multihomed",
+		isInstanceImage:   "mock-image",
+		isInstanceProfile: "bx2-2x8",
+		isInstanceVPC:     vpcID,
+		isInstanceZone:    "us-south-1",
+		isInstancePrimaryNetworkInterface: []interface{}{map[string]interface{}{
+			isInstanceNicSubnet: subnetID,
+		}},
+		isEnableCleanDelete: false,
+	}
+	instance := testMockInstance(t, meta, raw)
+	floatingIP := func(name string) *vpcv1.FloatingIP {
+		fip, _, err := sess.CreateFloatingIP(&vpcv1.CreateFloatingIPOptions{
+			FloatingIPPrototype: &vpcv1.FloatingIPPrototypeFloatingIPByZone{
+				Name: core.StringPtr(name),
+				Zone: &vpcv1.ZoneIdentityByName{Name: core.StringPtr("us-south-1")},
+			},
+		})
+		assert.NilError(t, err)
+		return fip
+	}
+	fip, other := floatingIP("mock-fip"), floatingIP("mock-other-fip")
+	instanceStatus := func() string {
+		id := instance.Id()
+		got, _, err := sess.GetInstance(&vpcv1.GetInstanceOptions{ID: &id})
+		assert.NilError(t, err)
+		return *got.Status
+	}
+
+	// The running instance is stopped for the change and started again
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		isInstanceNetworkInterfaceInstance:   instance.Id(),
+		isInstanceNetworkInterfaceSubnet:     subnetID,
+		isInstanceNetworkInterfaceName:       "mock-eth1",
+		isInstanceNetworkInterfaceFloatingIP: *fip.ID,
+	})
+	d.MarkNewResource()
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), d, meta)))
+	assert.Equal(t, d.Id(), instance.Id()+"/"+d.Get(isInstanceNetworkInterfaceID).(string))
+	assert.Equal(t, d.Get(isInstanceNetworkInterfaceType), "secondary")
+	assert.Equal(t, d.Get(isInstanceNetworkInterfaceStatus), "available")
+	assert.Equal(t, d.Get(isInstanceNetworkInterfacePrimaryIpv4), "10.240.0.5")
+	assert.Equal(t, d.Get(isInstanceNetworkInterfaceFloatingIPAddress), *fip.Address)
+	assert.Equal(t, d.Get("security_groups.#"), 1)
+	assert.Equal(t, instanceStatus(), "running")
+
+	// The instance doesn't manage the network interface
+	assert.NilError(t, testDiagsErr(instanceResource.ReadContext(context.Background(), instance, meta)))
+	assert.Equal(t, instance.Get("network_interfaces.#"), 0)
+	diff, err := instanceResource.Diff(context.Background(), instance.State(), terraform.NewResourceConfigRaw(raw), meta)
+	assert.NilError(t, err)
+	assert.Assert(t, diff == nil || diff.Empty(), "%v", diff)
+
+	// Nor does the imported instance, until the network interface is added to
+	// network_interfaces, which manages it without replacing the instance
+	importedInstance := instanceResource.Data(nil)
+	importedInstance.SetId(instance.Id())
+	assert.NilError(t, testDiagsErr(instanceResource.ReadContext(context.Background(), importedInstance, meta)))
+	assert.Equal(t, importedInstance.Get("network_interfaces.#"), 0)
+	diff, err = instanceResource.Diff(context.Background(), importedInstance.State(), terraform.NewResourceConfigRaw(raw), meta)
+	assert.NilError(t, err)
+	if diff != nil {
+		_, removed := diff.Attributes["network_interfaces.#"]
+		assert.Assert(t, !removed && !diff.RequiresNew(), "%v", diff)
+	}
+
+	withNics := func(name string) map[string]interface{} {
+		config := map[string]interface{}{}
+		for k, v := range raw {
+			config[k] = v
+		}
+		config[isInstanceNetworkInterfaces] = []interface{}{map[string]interface{}{
+			isInstanceNicSubnet: subnetID,
+			isInstanceNicName:   name,
+		}}
+		return config
+	}
+	diff, err = instanceResource.Diff(context.Background(), importedInstance.State(), terraform.NewResourceConfigRaw(withNics("mock-eth2")), meta)
+	assert.NilError(t, err)
+	assert.Assert(t, diff != nil && diff.RequiresNew(), "%v", diff)
+	diff, err = instanceResource.Diff(context.Background(), importedInstance.State(), terraform.NewResourceConfigRaw(withNics("mock-eth1")), meta)
+	assert.NilError(t, err)
+	assert.Assert(t, diff != nil && !diff.RequiresNew(), "%v", diff)
+	adopted := testMockResourceData(t, instanceResource, importedInstance, withNics("mock-eth1"))
+	assert.NilError(t, testDiagsErr(instanceResource.UpdateContext(context.Background(), adopted, meta)))
+	assert.Equal(t, adopted.Get("network_interfaces.#"), 1)
+	assert.Equal(t, adopted.Get("network_interfaces.0.id"), d.Get(isInstanceNetworkInterfaceID))
+	assert.Equal(t, adopted.Get("network_interfaces.0.primary_ipv4_address"), "10.240.0.5")
+	diff, err = instanceResource.Diff(context.Background(), adopted.State(), terraform.NewResourceConfigRaw(withNics("mock-eth1")), meta)
+	assert.NilError(t, err)
+	if diff != nil {
+		for k := range diff.Attributes {
+			assert.Assert(t, !strings.HasPrefix(k, "network_interfaces."), "%v", diff)
+		}
+	}
+
+	updated := testMockResourceData(t, r, d, map[string]interface{}{
+		isInstanceNetworkInterfaceInstance:        instance.Id(),
+		isInstanceNetworkInterfaceSubnet:          subnetID,
+		isInstanceNetworkInterfaceName:            "mock-eth1-renamed",
+		isInstanceNetworkInterfaceAllowIPSpoofing: true,
+		isInstanceNetworkInterfaceFloatingIP:      *other.ID,
+	})
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), updated, meta)))
+	assert.Equal(t, updated.Get(isInstanceNetworkInterfaceName), "mock-eth1-renamed")
+	assert.Equal(t, updated.Get(isInstanceNetworkInterfaceAllowIPSpoofing), true)
+	assert.Equal(t, updated.Get(isInstanceNetworkInterfaceFloatingIPAddress), *other.Address)
+	released, _, err := sess.GetFloatingIP(&vpcv1.GetFloatingIPOptions{ID: fip.ID})
+	assert.NilError(t, err)
+	assert.Assert(t, released.Target == nil)
+
+	// A floating IP is bound to one network interface at a time
+	primary := instance.Get("primary_network_interface.0.id").(string)
+	err = instanceNetworkInterfaceAddFloatingIP(sess, instance.Id(), primary, *other.ID)
+	assert.ErrorContains(t, err, "is bound to network interface")
+
+	diags := r.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		isInstanceNetworkInterfaceInstance: instance.Id(),
+		isInstanceNetworkInterfaceSubnet:   subnetID,
+		isInstanceNetworkInterfaceName:     "Mock_Eth1",
+	}))
+	assert.ErrorContains(t, testDiagsErr(diags), "Mock_Eth1")
+
+	imported := r.Data(nil)
+	imported.SetId(updated.Id())
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), imported, meta)))
+	assert.Equal(t, imported.Get(isInstanceNetworkInterfaceSubnet), subnetID)
+	assert.Equal(t, imported.Get(isInstanceNetworkInterfaceFloatingIP), *other.ID)
+
+	// A stopped instance is left stopped
+	assert.NilError(t, instanceAction(context.Background(), sess, instance, instance.Id(), isInstanceActionStop, false, instance.Timeout(schema.TimeoutUpdate)))
+	assert.NilError(t, testDiagsErr(r.DeleteContext(context.Background(), updated, meta)))
+	assert.Equal(t, instanceStatus(), "stopped")
+	released, _, err = sess.GetFloatingIP(&vpcv1.GetFloatingIPOptions{ID: other.ID})
+	assert.NilError(t, err)
+	assert.Assert(t, released.Target == nil)
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), imported, meta)))
+	assert.Equal(t, imported.Id(), "")
+
+	orphan := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		isInstanceNetworkInterfaceInstance: instance.Id(),
+		isInstanceNetworkInterfaceSubnet:   subnetID,
+	})
+	orphan.MarkNewResource()
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), orphan, meta)))
+	assert.Equal(t, instanceStatus(), "stopped")
+
+	// The network interface is gone with its instance
+	assert.NilError(t, testDiagsErr(instanceResource.DeleteContext(context.Background(), instance, meta)))
+	orphaned := r.Data(nil)
+	orphaned.SetId(orphan.Id())
+	assert.NilError(t, testDiagsErr(r.DeleteContext(context.Background(), orphaned, meta)))
+	assert.Equal(t, orphaned.Id(), "")
+}
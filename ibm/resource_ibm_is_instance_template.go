@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -48,12 +49,11 @@ const (
 
 func resourceIBMISInstanceTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMisInstanceTemplateCreate,
-		Read:     resourceIBMisInstanceTemplateRead,
-		Update:   resourceIBMisInstanceTemplateUpdate,
-		Delete:   resourceIBMisInstanceTemplateDelete,
-		Exists:   resourceIBMisInstanceTemplateExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMisInstanceTemplateCreate,
+		ReadContext:   resourceIBMisInstanceTemplateRead,
+		UpdateContext: resourceIBMisInstanceTemplateUpdate,
+		DeleteContext: resourceIBMisInstanceTemplateDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
@@ -344,7 +344,7 @@ func resourceIBMISInstanceTemplate() *schema.Resource {
 	}
 }
 
-func resourceIBMisInstanceTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceTemplateCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	profile := d.Get(isInstanceTemplateProfile).(string)
 	name := d.Get(isInstanceTemplateName).(string)
 	vpcID := d.Get(isInstanceTemplateVPC).(string)
@@ -353,39 +353,48 @@ func resourceIBMisInstanceTemplateCreate(d *schema.ResourceData, meta interface{
 
 	err := instanceTemplateCreate(d, meta, profile, name, vpcID, zone, image)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMisInstanceTemplateRead(d, meta)
+	return resourceIBMisInstanceTemplateRead(context, d, meta)
 }
 
-func resourceIBMisInstanceTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceTemplateRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMisInstanceTemplateExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	ID := d.Id()
-	err := instanceTemplateGet(d, meta, ID)
+	err = instanceTemplateGet(d, meta, ID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func resourceIBMisInstanceTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceTemplateDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	ID := d.Id()
 
 	err := instanceTemplateDelete(d, meta, ID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func resourceIBMisInstanceTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceTemplateUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	err := instanceTemplateUpdate(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMisInstanceTemplateRead(d, meta)
+	return resourceIBMisInstanceTemplateRead(context, d, meta)
 }
 
 func resourceIBMisInstanceTemplateExists(d *schema.ResourceData, meta interface{}) (bool, error) {
@@ -647,7 +656,7 @@ func instanceTemplateCreate(d *schema.ResourceData, meta interface{}, profile, n
 
 	instanceIntf, response, err := sess.CreateInstanceTemplate(options)
 	if err != nil {
-		return fmt.Errorf("Error creating InstanceTemplate: %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error creating InstanceTemplate")
 	}
 	instance := instanceIntf.(*vpcv1.InstanceTemplate)
 	d.SetId(*instance.ID)
@@ -664,7 +673,7 @@ func instanceTemplateGet(d *schema.ResourceData, meta interface{}, ID string) er
 	}
 	instanceIntf, response, err := instanceC.GetInstanceTemplate(getinsOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Instance template: %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Getting Instance template")
 	}
 	instance := instanceIntf.(*vpcv1.InstanceTemplate)
 	d.Set(isInstanceTemplateName, *instance.Name)
@@ -884,7 +893,7 @@ func instanceTemplateExists(d *schema.ResourceData, meta interface{}, ID string)
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error Getting InstanceTemplate: %s\n%s", err, response)
+		return false, apiErrorf("vpc", err, response, "Error Getting InstanceTemplate")
 	}
 	return true, nil
 }
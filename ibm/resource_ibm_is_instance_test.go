@@ -4,16 +4,20 @@
 package ibm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
 	"testing"
 
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
 )
 
 func TestAccIBMISInstance_basic(t *testing.T) {
@@ -661,3 +665,182 @@ func testAccCheckIBMISInstanceVolumeUpdate(vpcname, subnetname, sshname, publicK
 	
 `, vpcname, subnetname, ISZoneName, ISCIDR, sshname, publicKey, volName, ISZoneName, name, isImage, instanceProfileName, ISZoneName)
 }
+
+func TestAccIBMISInstance_template(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	sshname := fmt.Sprintf("tf-ssh-%d", acctest.RandIntRange(10, 100))
+	templatename := fmt.Sprintf("tf-template-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-instance-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+`)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMISInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISInstanceTemplateConfig(vpcname, subnetname, sshname, publicKey, templatename) + fmt.Sprintf(`
+	resource "ibm_is_instance" "testacc_instance" {
+		name              = "%s"
+		instance_template = ibm_is_instance_template.instancetemplate1.id
+		profile           = "bx2-2x8"
+	}`, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_instance.testacc_instance", "zone", "us-south-2"),
+					resource.TestCheckResourceAttr("ibm_is_instance.testacc_instance", "profile", "bx2-2x8"),
+					resource.TestCheckResourceAttrPair("ibm_is_instance.testacc_instance", "image", "ibm_is_instance_template.instancetemplate1", "image"),
+					resource.TestCheckResourceAttrPair("ibm_is_instance.testacc_instance", "primary_network_interface.0.subnet", "ibm_is_subnet.subnet2", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestIBMISInstanceTemplateMock(t *testing.T) {
+	t.Parallel()
+	srv := testMockServer(t)
+	meta := testMockClientSession(t, srv)
+	sess, err := vpcClient(meta)
+	assert.NilError(t, err)
+	vpcID, subnetID := testMockSubnet(t, meta)
+	other, _, err := sess.CreateSubnet(&vpcv1.CreateSubnetOptions{
+		SubnetPrototype: &vpcv1.SubnetPrototypeSubnetByCIDR{
+			Name:          core.StringPtr("mock-other-subnet"),
+			VPC:           &vpcv1.VPCIdentityByID{ID: &vpcID},
+			Ipv4CIDRBlock: core.StringPtr("10.240.64.0/24"),
+			Zone:          &vpcv1.ZoneIdentityByName{Name: core.StringPtr("us-south-2")},
+		},
+	})
+	assert.NilError(t, err)
+	r := resourceIBMISInstance()
+
+	tr := resourceIBMISInstanceTemplate()
+	template := schema.TestResourceDataRaw(t, tr.Schema, map[string]interface{}{
+		isInstanceTemplateName:    "mock-template",
+		isInstanceTemplateVPC:     vpcID,
+		isInstanceTemplateZone:    "us-south-1",
+		isInstanceTemplateProfile: "bx2-2x8",
+		isInstanceTemplateImage:   "mock-image",
+		isInstanceTemplateKeys:    []interface{}{"mock-key"},
+		isInstanceTemplatePrimaryNetworkInterface: []interface{}{map[string]interface{}{
+			isInstanceTemplateNicSubnet: subnetID,
+		}},
+		isInstanceTemplateNetworkInterfaces: []interface{}{map[string]interface{}{
+			isInstanceTemplateNicSubnet: subnetID,
+		}},
+		isInstanceTemplateVolumeAttachments: []interface{}{map[string]interface{}{
+			isInstanceTemplateVolumeDeleteOnInstanceDelete: true,
+			isInstanceTemplateVolAttachmentName:            "mock-data",
+			isInstanceTemplateVolAttVolPrototype: []interface{}{map[string]interface{}{
+				isInstanceTemplateVolAttVolProfile:  "general-purpose",
+				isInstanceTemplateVolAttVolCapacity: 20,
+			}},
+		}},
+	})
+	assert.NilError(t, testDiagsErr(tr.CreateContext(context.Background(), template, meta)))
+
+	// The arguments are validated, then checked against the template at plan time
+	plan := func(raw map[string]interface{}) error {
+		config := terraform.NewResourceConfigRaw(raw)
+		if err := testDiagsErr(r.Validate(config)); err != nil {
+			return err
+		}
+		_, err := r.Diff(context.Background(), nil, config, meta)
+		return err
+	}
+	assert.NilError(t, plan(map[string]interface{}{
+		isInstanceName:           "mock-fleet",
+		isInstanceSourceTemplate: template.Id(),
+	}))
+	assert.ErrorContains(t, plan(map[string]interface{}{
+		isInstanceName: "mock-fleet",
+	}), "must be specified")
+	assert.ErrorContains(t, plan(map[string]interface{}{
+		isInstanceName:           "mock-fleet",
+		isInstanceSourceTemplate: template.Id(),
+		isInstanceBootVolume:     []interface{}{map[string]interface{}{isInstanceBootSnapshot: "mock-snapshot"}},
+	}), "conflicts with")
+	assert.ErrorContains(t, plan(map[string]interface{}{
+		isInstanceName:           "mock-fleet",
+		isInstanceSourceTemplate: template.Id(),
+		isInstanceZone:           "us-south-2",
+	}), "conflicts with the zone us-south-1")
+	assert.ErrorContains(t, plan(map[string]interface{}{
+		isInstanceName:           "mock-fleet",
+		isInstanceSourceTemplate: template.Id(),
+		isInstanceZone:           "us-south-1",
+		isInstancePrimaryNetworkInterface: []interface{}{map[string]interface{}{
+			isInstanceNicSubnet: *other.ID,
+		}},
+	}), "is in zone us-south-2")
+	assert.NilError(t, plan(map[string]interface{}{
+		isInstanceName:           "mock-fleet",
+		isInstanceSourceTemplate: template.Id(),
+		isInstanceZone:           "us-south-2",
+		isInstancePrimaryNetworkInterface: []interface{}{map[string]interface{}{
+			isInstanceNicSubnet: *other.ID,
+		}},
+	}))
+	assert.ErrorContains(t, plan(map[string]interface{}{
+		isInstanceName:           "mock-fleet",
+		isInstanceSourceTemplate: "mock-missing-template",
+	}), "not found")
+
+	raw := map[string]interface{}{
+		isInstanceName:           "mock-fleet-1",
+		isInstanceSourceTemplate: template.Id(),
+		isEnableCleanDelete:      false,
+	}
+	d := testMockInstance(t, meta, raw)
+	assert.Equal(t, d.Get(isInstanceZone), "us-south-1")
+	assert.Equal(t, d.Get(isInstanceVPC), vpcID)
+	assert.Equal(t, d.Get(isInstanceProfile), "bx2-2x8")
+	assert.Equal(t, d.Get(isInstanceImage), "mock-image")
+	assert.Equal(t, d.Get("primary_network_interface.0.subnet"), subnetID)
+	assert.Equal(t, d.Get("volume_attachments.#"), 2)
+	// the volume and the network interface of the template aren't managed by
+	// volumes and network_interfaces
+	assert.Equal(t, d.Get("volumes.#"), 0)
+	assert.Equal(t, d.Get("network_interfaces.#"), 0)
+
+	diff, err := r.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(raw), meta)
+	assert.NilError(t, err)
+	assert.Assert(t, diff == nil || diff.Empty(), "%v", diff)
+	refreshed := testMockResourceData(t, r, d, raw)
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), refreshed, meta)))
+	assert.Equal(t, refreshed.Get("network_interfaces.#"), 0)
+
+	overriddenRaw := map[string]interface{}{
+		isInstanceName:           "mock-fleet-2",
+		isInstanceSourceTemplate: template.Id(),
+		isInstanceProfile:        "bx2-4x16",
+		isInstanceZone:           "us-south-2",
+		isInstancePrimaryNetworkInterface: []interface{}{map[string]interface{}{
+			isInstanceNicSubnet: *other.ID,
+		}},
+		isInstanceNetworkInterfaces: []interface{}{map[string]interface{}{
+			isInstanceNicSubnet: *other.ID,
+			isInstanceNicName:   "mock-eth1",
+		}},
+		isEnableCleanDelete: false,
+	}
+	overridden := testMockInstance(t, meta, overriddenRaw)
+	assert.Equal(t, overridden.Get(isInstanceZone), "us-south-2")
+	assert.Equal(t, overridden.Get(isInstanceProfile), "bx2-4x16")
+	assert.Equal(t, overridden.Get(isInstanceMemory), 16)
+	assert.Equal(t, overridden.Get("primary_network_interface.0.subnet"), *other.ID)
+	// the network interfaces of the instance override those of the template, and
+	// removing them replaces the instance
+	assert.Equal(t, overridden.Get("network_interfaces.#"), 1)
+	assert.Equal(t, overridden.Get("network_interfaces.0.name"), "mock-eth1")
+	delete(overriddenRaw, isInstanceNetworkInterfaces)
+	diff, err = r.Diff(context.Background(), overridden.State(), terraform.NewResourceConfigRaw(overriddenRaw), meta)
+	assert.NilError(t, err)
+	assert.Assert(t, diff != nil && diff.RequiresNew(), "%v", diff)
+
+	for _, instance := range []*schema.ResourceData{d, overridden} {
+		assert.NilError(t, testDiagsErr(r.DeleteContext(context.Background(), instance, meta)))
+	}
+}
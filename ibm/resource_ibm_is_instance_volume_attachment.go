@@ -0,0 +1,254 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isInstanceVolumeAttachmentInstance     = "instance"
+	isInstanceVolumeAttachmentVolume       = "volume"
+	isInstanceVolumeAttachmentName         = "name"
+	isInstanceVolumeAttachmentDeleteVolume = "delete_volume_on_instance_delete"
+	isInstanceVolumeAttachmentID           = "volume_attachment_id"
+	isInstanceVolumeAttachmentDevice       = "device"
+	isInstanceVolumeAttachmentStatus       = "status"
+	isInstanceVolumeAttachmentType         = "type"
+	isInstanceVolumeAttachmentVolumeName   = "volume_name"
+	isInstanceVolumeAttachmentVolumeCRN    = "volume_crn"
+)
+
+func resourceIBMISInstanceVolumeAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMisInstanceVolumeAttachmentCreate,
+		ReadContext:   resourceIBMisInstanceVolumeAttachmentRead,
+		UpdateContext: resourceIBMisInstanceVolumeAttachmentUpdate,
+		DeleteContext: resourceIBMisInstanceVolumeAttachmentDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isInstanceVolumeAttachmentInstance: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the instance to attach the volume to",
+			},
+			isInstanceVolumeAttachmentVolume: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the data volume to attach, the volume must be unattached and in the zone of the instance",
+			},
+			isInstanceVolumeAttachmentName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: InvokeValidator("ibm_is_instance_volume_attachment", isInstanceVolumeAttachmentName),
+				Description:  "The name of the volume attachment",
+			},
+			isInstanceVolumeAttachmentDeleteVolume: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set to true, the volume is deleted with the instance",
+			},
+			isInstanceVolumeAttachmentID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the volume attachment",
+			},
+			isInstanceVolumeAttachmentDevice: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The identifier of the device of the volume on the instance",
+			},
+			isInstanceVolumeAttachmentStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the volume attachment",
+			},
+			isInstanceVolumeAttachmentType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the volume attachment, boot or data",
+			},
+			isInstanceVolumeAttachmentVolumeName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the attached volume",
+			},
+			isInstanceVolumeAttachmentVolumeCRN: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the attached volume",
+			},
+		},
+	}
+}
+
+func resourceIBMISInstanceVolumeAttachmentValidator() *ResourceValidator {
+
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isInstanceVolumeAttachmentName,
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63})
+
+	ibmISInstanceVolumeAttachmentValidator := ResourceValidator{ResourceName: "ibm_is_instance_volume_attachment", Schema: validateSchema}
+	return &ibmISInstanceVolumeAttachmentValidator
+}
+
+func resourceIBMisInstanceVolumeAttachmentCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceID := d.Get(isInstanceVolumeAttachmentInstance).(string)
+	volumeID := d.Get(isInstanceVolumeAttachmentVolume).(string)
+	deleteVolume := d.Get(isInstanceVolumeAttachmentDeleteVolume).(bool)
+	options := &vpcv1.CreateInstanceVolumeAttachmentOptions{
+		InstanceID: &instanceID,
+		Volume: &vpcv1.VolumeIdentity{
+			ID: &volumeID,
+		},
+		DeleteVolumeOnInstanceDelete: &deleteVolume,
+	}
+	if v, ok := d.GetOk(isInstanceVolumeAttachmentName); ok {
+		name := v.(string)
+		options.Name = &name
+	}
+	attachment, response, err := sess.CreateInstanceVolumeAttachment(options)
+	if err != nil {
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error while attaching volume %q to instance %s", volumeID, instanceID))
+	}
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, *attachment.ID))
+	log.Printf("[INFO] Volume attachment : %s", d.Id())
+	_, err = isWaitForInstanceVolumeAttached(context, sess, d, instanceID, *attachment.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceIBMisInstanceVolumeAttachmentRead(context, d, meta)
+}
+
+func resourceIBMisInstanceVolumeAttachmentRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceID, id := parts[0], parts[1]
+	attachment, response, err := sess.GetInstanceVolumeAttachment(&vpcv1.GetInstanceVolumeAttachmentOptions{
+		InstanceID: &instanceID,
+		ID:         &id,
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error Getting Volume Attachment (%s)", d.Id()))
+	}
+	d.Set(isInstanceVolumeAttachmentInstance, instanceID)
+	d.Set(isInstanceVolumeAttachmentID, *attachment.ID)
+	d.Set(isInstanceVolumeAttachmentName, *attachment.Name)
+	d.Set(isInstanceVolumeAttachmentStatus, *attachment.Status)
+	d.Set(isInstanceVolumeAttachmentType, *attachment.Type)
+	if attachment.DeleteVolumeOnInstanceDelete != nil {
+		d.Set(isInstanceVolumeAttachmentDeleteVolume, *attachment.DeleteVolumeOnInstanceDelete)
+	}
+	if attachment.Device != nil && attachment.Device.ID != nil {
+		d.Set(isInstanceVolumeAttachmentDevice, *attachment.Device.ID)
+	}
+	if attachment.Volume != nil {
+		d.Set(isInstanceVolumeAttachmentVolume, *attachment.Volume.ID)
+		d.Set(isInstanceVolumeAttachmentVolumeName, *attachment.Volume.Name)
+		d.Set(isInstanceVolumeAttachmentVolumeCRN, *attachment.Volume.CRN)
+	}
+	return nil
+}
+
+func resourceIBMisInstanceVolumeAttachmentUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceID, id := parts[0], parts[1]
+	if d.HasChange(isInstanceVolumeAttachmentName) || d.HasChange(isInstanceVolumeAttachmentDeleteVolume) {
+		deleteVolume := d.Get(isInstanceVolumeAttachmentDeleteVolume).(bool)
+		volumeAttachmentPatchModel := &vpcv1.VolumeAttachmentPatch{
+			DeleteVolumeOnInstanceDelete: &deleteVolume,
+		}
+		if v, ok := d.GetOk(isInstanceVolumeAttachmentName); ok {
+			name := v.(string)
+			volumeAttachmentPatchModel.Name = &name
+		}
+		volumeAttachmentPatch, err := volumeAttachmentPatchModel.AsPatch()
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("Error calling asPatch for VolumeAttachmentPatch: %s", err))
+		}
+		_, response, err := sess.UpdateInstanceVolumeAttachment(&vpcv1.UpdateInstanceVolumeAttachmentOptions{
+			InstanceID:            &instanceID,
+			ID:                    &id,
+			VolumeAttachmentPatch: volumeAttachmentPatch,
+		})
+		if err != nil {
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error Updating Volume Attachment (%s)", d.Id()))
+		}
+	}
+	return resourceIBMisInstanceVolumeAttachmentRead(context, d, meta)
+}
+
+func resourceIBMisInstanceVolumeAttachmentDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceID, id := parts[0], parts[1]
+	response, err := sess.DeleteInstanceVolumeAttachment(&vpcv1.DeleteInstanceVolumeAttachmentOptions{
+		InstanceID: &instanceID,
+		ID:         &id,
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error while detaching Volume Attachment (%s)", d.Id()))
+	}
+	_, err = isWaitForInstanceVolumeDetached(context, sess, d, instanceID, id, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
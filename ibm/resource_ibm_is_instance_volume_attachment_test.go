@@ -0,0 +1,182 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
+)
+
+func TestAccIBMISInstanceVolumeAttachment_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-instance-%d", acctest.RandIntRange(10, 100))
+	volname := fmt.Sprintf("tf-vol-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMISInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISInstanceVolumeAttachmentConfig(vpcname, subnetname, name, volname, "tf-data", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_instance_volume_attachment.testacc_attachment", "name", "tf-data"),
+					resource.TestCheckResourceAttr("ibm_is_instance_volume_attachment.testacc_attachment", "type", "data"),
+					resource.TestCheckResourceAttr("ibm_is_instance_volume_attachment.testacc_attachment", "volume_name", volname),
+					resource.TestCheckResourceAttrSet("ibm_is_instance_volume_attachment.testacc_attachment", "volume_attachment_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISInstanceVolumeAttachmentConfig(vpcname, subnetname, name, volname, "tf-data-renamed", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_instance_volume_attachment.testacc_attachment", "name", "tf-data-renamed"),
+					resource.TestCheckResourceAttr("ibm_is_instance_volume_attachment.testacc_attachment", "delete_volume_on_instance_delete", "true"),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_instance_volume_attachment.testacc_attachment",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISInstanceVolumeAttachmentConfig(vpcname, subnetname, name, volname, attachmentname string, deleteVolume bool) string {
+	return testAccCheckIBMISInstancePowerStateConfig(vpcname, subnetname, name, "running") + fmt.Sprintf(`
+
+	resource "ibm_is_volume" "testacc_volume" {
+		name     = "%s"
+		profile  = "10iops-tier"
+		zone     = "%s"
+		capacity = 20
+	}
+
+	resource "ibm_is_instance_volume_attachment" "testacc_attachment" {
+		instance                         = ibm_is_instance.testacc_instance.id
+		volume                           = ibm_is_volume.testacc_volume.id
+		name                             = "%s"
+		delete_volume_on_instance_delete = %t
+	}`, volname, ISZoneName, attachmentname, deleteVolume)
+}
+
+func TestIBMISInstanceVolumeAttachmentMock(t *testing.T) {
+	t.Parallel()
+	srv := testMockServer(t)
+	meta := testMockClientSession(t, srv)
+	sess, err := vpcClient(meta)
+	assert.NilError(t, err)
+	vpcID, subnetID := testMockSubnet(t, meta)
+	instanceResource := resourceIBMISInstance()
+	r := resourceIBMISInstanceVolumeAttachment()
+
+	raw := map[string]interface{}{
+		isInstanceName:    "mock-attached",
+		isInstanceImage:   "mock-image",
+		isInstanceProfile: "bx2-2x8",
+		isInstanceVPC:     vpcID,
+		isInstanceZone:    "us-south-1",
+		isInstancePrimaryNetworkInterface: []interface{}{map[string]interface{}{
+			isInstanceNicSubnet: subnetID,
+		}},
+		isEnableCleanDelete: false,
+	}
+	instance := testMockInstance(t, meta, raw)
+	volume, _, err := sess.CreateVolume(&vpcv1.CreateVolumeOptions{
+		VolumePrototype: &vpcv1.VolumePrototypeVolumeByCapacity{
+			Name:     core.StringPtr("mock-data"),
+			Profile:  &vpcv1.VolumeProfileIdentityByName{Name: core.StringPtr("general-purpose")},
+			Zone:     &vpcv1.ZoneIdentityByName{Name: core.StringPtr("us-south-1")},
+			Capacity: core.Int64Ptr(20),
+		},
+	})
+	assert.NilError(t, err)
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		isInstanceVolumeAttachmentInstance: instance.Id(),
+		isInstanceVolumeAttachmentVolume:   *volume.ID,
+		isInstanceVolumeAttachmentName:     "mock-attachment",
+	})
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), d, meta)))
+	assert.Equal(t, d.Id(), instance.Id()+"/"+d.Get(isInstanceVolumeAttachmentID).(string))
+	assert.Equal(t, d.Get(isInstanceVolumeAttachmentStatus), "attached")
+	assert.Equal(t, d.Get(isInstanceVolumeAttachmentType), "data")
+	assert.Equal(t, d.Get(isInstanceVolumeAttachmentVolumeName), "mock-data")
+	assert.Equal(t, d.Get(isInstanceVolumeAttachmentDeleteVolume), false)
+
+	// The instance leaves the attachment alone
+	assert.NilError(t, testDiagsErr(instanceResource.ReadContext(context.Background(), instance, meta)))
+	assert.Equal(t, instance.Get("volumes.#"), 0)
+	assert.Equal(t, instance.Get("volume_attachments.#"), 2)
+	diff, err := instanceResource.Diff(context.Background(), instance.State(), terraform.NewResourceConfigRaw(raw), meta)
+	assert.NilError(t, err)
+	assert.Assert(t, diff == nil || diff.Empty(), "%v", diff)
+
+	// An imported instance doesn't detach the volumes of the attachments either
+	imported := instanceResource.Data(nil)
+	imported.SetId(instance.Id())
+	assert.NilError(t, testDiagsErr(instanceResource.ReadContext(context.Background(), imported, meta)))
+	assert.Equal(t, imported.Get("volumes.#"), 0)
+	diff, err = instanceResource.Diff(context.Background(), imported.State(), terraform.NewResourceConfigRaw(raw), meta)
+	assert.NilError(t, err)
+	if diff != nil {
+		_, detached := diff.Attributes["volumes.#"]
+		assert.Assert(t, !detached && !diff.RequiresNew(), "%v", diff)
+	}
+
+	// The attached volume is managed by volumes without attaching it again
+	withVolumes := map[string]interface{}{}
+	for k, v := range raw {
+		withVolumes[k] = v
+	}
+	withVolumes[isInstanceVolumes] = []interface{}{*volume.ID}
+	adopted := testMockResourceData(t, instanceResource, instance, withVolumes)
+	assert.NilError(t, testDiagsErr(instanceResource.UpdateContext(context.Background(), adopted, meta)))
+	assert.DeepEqual(t, adopted.Get(isInstanceVolumes).(*schema.Set).List(), []interface{}{*volume.ID})
+	assert.Equal(t, adopted.Get("volume_attachments.#"), 2)
+
+	updated := testMockResourceData(t, r, d, map[string]interface{}{
+		isInstanceVolumeAttachmentInstance:     instance.Id(),
+		isInstanceVolumeAttachmentVolume:       *volume.ID,
+		isInstanceVolumeAttachmentName:         "mock-renamed",
+		isInstanceVolumeAttachmentDeleteVolume: true,
+	})
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), updated, meta)))
+	assert.Equal(t, updated.Get(isInstanceVolumeAttachmentName), "mock-renamed")
+	assert.Equal(t, updated.Get(isInstanceVolumeAttachmentDeleteVolume), true)
+
+	again := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		isInstanceVolumeAttachmentInstance: instance.Id(),
+		isInstanceVolumeAttachmentVolume:   *volume.ID,
+	})
+	assert.ErrorContains(t, testDiagsErr(r.CreateContext(context.Background(), again, meta)), "attached to an instance")
+
+	diags := r.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		isInstanceVolumeAttachmentInstance: instance.Id(),
+		isInstanceVolumeAttachmentVolume:   *volume.ID,
+		isInstanceVolumeAttachmentName:     "Mock_Attachment",
+	}))
+	assert.ErrorContains(t, testDiagsErr(diags), "Mock_Attachment")
+
+	// The volume is kept when it is detached
+	assert.NilError(t, testDiagsErr(r.DeleteContext(context.Background(), updated, meta)))
+	got, _, err := sess.GetVolume(&vpcv1.GetVolumeOptions{ID: volume.ID})
+	assert.NilError(t, err)
+	assert.Equal(t, len(got.VolumeAttachments), 0)
+	gone := r.Data(nil)
+	gone.SetId(d.Id())
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), gone, meta)))
+	assert.Equal(t, gone.Id(), "")
+
+	assert.NilError(t, testDiagsErr(instanceResource.DeleteContext(context.Background(), instance, meta)))
+}
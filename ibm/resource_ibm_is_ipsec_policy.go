@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -29,12 +31,11 @@ const (
 
 func resourceIBMISIPSecPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISIPSecPolicyCreate,
-		Read:     resourceIBMISIPSecPolicyRead,
-		Update:   resourceIBMISIPSecPolicyUpdate,
-		Delete:   resourceIBMISIPSecPolicyDelete,
-		Exists:   resourceIBMISIPSecPolicyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISIPSecPolicyCreate,
+		ReadContext:   resourceIBMISIPSecPolicyRead,
+		UpdateContext: resourceIBMISIPSecPolicyUpdate,
+		DeleteContext: resourceIBMISIPSecPolicyDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			isIpSecName: {
@@ -181,10 +182,10 @@ func resourceIBMISIPSECValidator() *ResourceValidator {
 	return &ibmISIPSECResourceValidator
 }
 
-func resourceIBMISIPSecPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISIPSecPolicyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Ip Sec create")
@@ -196,15 +197,15 @@ func resourceIBMISIPSecPolicyCreate(d *schema.ResourceData, meta interface{}) er
 	if userDetails.generation == 1 {
 		err := classicIpsecpCreate(d, meta, authenticationAlg, encryptionAlg, name, pfs)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := ipsecpCreate(d, meta, authenticationAlg, encryptionAlg, name, pfs)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceIBMISIPSecPolicyRead(d, meta)
+	return resourceIBMISIPSecPolicyRead(context, d, meta)
 }
 
 func classicIpsecpCreate(d *schema.ResourceData, meta interface{}, authenticationAlg, encryptionAlg, name, pfs string) error {
@@ -235,7 +236,7 @@ func classicIpsecpCreate(d *schema.ResourceData, meta interface{}, authenticatio
 	}
 	ipSec, response, err := sess.CreateIpsecPolicy(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] ipSec policy err %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error creating IPSEC Policy")
 	}
 	d.SetId(*ipSec.ID)
 	log.Printf("[INFO] ipSec policy : %s", *ipSec.ID)
@@ -270,28 +271,37 @@ func ipsecpCreate(d *schema.ResourceData, meta interface{}, authenticationAlg, e
 	}
 	ipSec, response, err := sess.CreateIpsecPolicy(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] ipSec policy err %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error creating IPSEC Policy")
 	}
 	d.SetId(*ipSec.ID)
 	log.Printf("[INFO] ipSec policy : %s", *ipSec.ID)
 	return nil
 }
 
-func resourceIBMISIPSecPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISIPSecPolicyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMISIPSecPolicyExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicIpsecpGet(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := ipsecpGet(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -311,7 +321,7 @@ func classicIpsecpGet(d *schema.ResourceData, meta interface{}, id string) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting IPSEC Policy(%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error getting IPSEC Policy(%s)", id)
 	}
 
 	d.Set(isIpSecName, *ipSec.Name)
@@ -375,7 +385,7 @@ func ipsecpGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting IPSEC Policy(%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc", err, response, "Error getting IPSEC Policy(%s)", id)
 	}
 	d.Set(isIpSecName, *ipSec.Name)
 	d.Set(isIpSecAuthenticationAlg, *ipSec.AuthenticationAlgorithm)
@@ -414,24 +424,24 @@ func ipsecpGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISIPSecPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISIPSecPolicyUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicIpsecpUpdate(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := ipsecpUpdate(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceIBMISIPSecPolicyRead(d, meta)
+	return resourceIBMISIPSecPolicyRead(context, d, meta)
 }
 
 func classicIpsecpUpdate(d *schema.ResourceData, meta interface{}, id string) error {
@@ -465,7 +475,7 @@ func classicIpsecpUpdate(d *schema.ResourceData, meta interface{}, id string) er
 
 		_, response, err := sess.UpdateIpsecPolicy(options)
 		if err != nil {
-			return fmt.Errorf("Error on update of IPSEC Policy(%s): %s\n%s", id, err, response)
+			return apiErrorf("vpc_classic", err, response, "Error on update of IPSEC Policy(%s)", id)
 		}
 	}
 	return nil
@@ -502,27 +512,27 @@ func ipsecpUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 
 		_, response, err := sess.UpdateIpsecPolicy(options)
 		if err != nil {
-			return fmt.Errorf("Error on update of IPSEC Policy(%s): %s\n%s", id, err, response)
+			return apiErrorf("vpc", err, response, "Error on update of IPSEC Policy(%s)", id)
 		}
 	}
 	return nil
 }
 
-func resourceIBMISIPSecPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISIPSecPolicyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicIpsecpDelete(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := ipsecpDelete(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -542,7 +552,7 @@ func classicIpsecpDelete(d *schema.ResourceData, meta interface{}, id string) er
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting IPSEC Policy(%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error getting IPSEC Policy(%s)", id)
 	}
 
 	deleteIpsecPolicyOptions := &vpcclassicv1.DeleteIpsecPolicyOptions{
@@ -550,7 +560,7 @@ func classicIpsecpDelete(d *schema.ResourceData, meta interface{}, id string) er
 	}
 	response, err = sess.DeleteIpsecPolicy(deleteIpsecPolicyOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting IPSEC Policy(%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Deleting IPSEC Policy(%s)", id)
 	}
 	d.SetId("")
 	return nil
@@ -570,14 +580,14 @@ func ipsecpDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting IPSEC Policy(%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc", err, response, "Error getting IPSEC Policy(%s)", id)
 	}
 	deleteIpsecPolicyOptions := &vpcv1.DeleteIpsecPolicyOptions{
 		ID: &id,
 	}
 	response, err = sess.DeleteIpsecPolicy(deleteIpsecPolicyOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting IPSEC Policy(%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc", err, response, "Error Deleting IPSEC Policy(%s)", id)
 	}
 	d.SetId("")
 	return nil
@@ -612,7 +622,7 @@ func classicIpsecpExists(d *schema.ResourceData, meta interface{}, id string) (b
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting IPSEC Policy(%s): %s\n%s", id, err, response)
+		return false, apiErrorf("vpc_classic", err, response, "Error getting IPSEC Policy(%s)", id)
 	}
 	return true, nil
 }
@@ -630,7 +640,7 @@ func ipsecpExists(d *schema.ResourceData, meta interface{}, id string) (bool, er
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting IPSEC Policy(%s): %s\n%s", id, err, response)
+		return false, apiErrorf("vpc", err, response, "Error getting IPSEC Policy(%s)", id)
 	}
 	return true, nil
 }
@@ -12,6 +12,7 @@ import (
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -42,12 +43,11 @@ const (
 
 func resourceIBMISLB() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISLBCreate,
-		Read:     resourceIBMISLBRead,
-		Update:   resourceIBMISLBUpdate,
-		Delete:   resourceIBMISLBDelete,
-		Exists:   resourceIBMISLBExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISLBCreate,
+		ReadContext:   resourceIBMISLBRead,
+		UpdateContext: resourceIBMISLBUpdate,
+		DeleteContext: resourceIBMISLBDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -227,10 +227,11 @@ func resourceIBMISLBValidator() *ResourceValidator {
 	return &ibmISLBResourceValidator
 }
 
-func resourceIBMISLBCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	name := d.Get(isLBName).(string)
@@ -262,23 +263,24 @@ func resourceIBMISLBCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if userDetails.generation == 1 {
-		err := classicLBCreate(d, meta, name, lbType, rg, subnets, isPublic)
-		if err != nil {
-			return err
+		diags = append(diags, classicLBCreate(context, d, meta, name, lbType, rg, subnets, isPublic)...)
+		if diags.HasError() {
+			return diags
 		}
 	} else {
-		err := lbCreate(d, meta, name, lbType, rg, subnets, isPublic, isLogging, securityGroups)
-		if err != nil {
-			return err
+		diags = append(diags, lbCreate(context, d, meta, name, lbType, rg, subnets, isPublic, isLogging, securityGroups)...)
+		if diags.HasError() {
+			return diags
 		}
 	}
-	return resourceIBMISLBRead(d, meta)
+	return append(diags, resourceIBMISLBRead(context, d, meta)...)
 }
 
-func classicLBCreate(d *schema.ResourceData, meta interface{}, name, lbType, rg string, subnets *schema.Set, isPublic bool) error {
+func classicLBCreate(context context.Context, d *schema.ResourceData, meta interface{}, name, lbType, rg string, subnets *schema.Set, isPublic bool) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := classicVpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	options := &vpcclassicv1.CreateLoadBalancerOptions{
 		IsPublic: &isPublic,
@@ -302,13 +304,13 @@ func classicLBCreate(d *schema.ResourceData, meta interface{}, name, lbType, rg
 
 	lb, response, err := sess.CreateLoadBalancer(options)
 	if err != nil {
-		return fmt.Errorf("Error while creating Load Balancer err %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc_classic", err, response, "Error while creating Load Balancer"))
 	}
 	d.SetId(*lb.ID)
 	log.Printf("[INFO] Load Balancer : %s", *lb.ID)
-	_, err = isWaitForClassicLBAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicLBAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isLBTags); ok || v != "" {
@@ -317,15 +319,17 @@ func classicLBCreate(d *schema.ResourceData, meta interface{}, name, lbType, rg
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc Load Balancer (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
-	return nil
+	return diags
 }
 
-func lbCreate(d *schema.ResourceData, meta interface{}, name, lbType, rg string, subnets *schema.Set, isPublic bool, isLogging bool, securityGroups *schema.Set) error {
+func lbCreate(context context.Context, d *schema.ResourceData, meta interface{}, name, lbType, rg string, subnets *schema.Set, isPublic bool, isLogging bool, securityGroups *schema.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	options := &vpcv1.CreateLoadBalancerOptions{
@@ -380,13 +384,13 @@ func lbCreate(d *schema.ResourceData, meta interface{}, name, lbType, rg string,
 
 	lb, response, err := sess.CreateLoadBalancer(options)
 	if err != nil {
-		return fmt.Errorf("Error while creating Load Balancer err %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error while creating Load Balancer"))
 	}
 	d.SetId(*lb.ID)
 	log.Printf("[INFO] Load Balancer : %s", *lb.ID)
-	_, err = isWaitForLBAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isLBTags); ok || v != "" {
@@ -395,26 +399,36 @@ func lbCreate(d *schema.ResourceData, meta interface{}, name, lbType, rg string,
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc Load Balancer (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
-	return nil
+	return diags
 }
 
-func resourceIBMISLBRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMISLBExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicLBGet(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := lbGet(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -434,7 +448,7 @@ func classicLBGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting Load Balancer : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error getting Load Balancer")
 	}
 	d.Set(isLBName, *lb.Name)
 	if *lb.IsPublic {
@@ -508,7 +522,7 @@ func lbGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting Load Balancer : %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error getting Load Balancer")
 	}
 	d.Set(isLBName, *lb.Name)
 	if *lb.IsPublic {
@@ -593,10 +607,11 @@ func lbGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISLBUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	name := ""
@@ -624,23 +639,24 @@ func resourceIBMISLBUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if userDetails.generation == 1 {
-		err := classicLBUpdate(d, meta, id, name, hasChanged)
-		if err != nil {
-			return err
+		diags = append(diags, classicLBUpdate(d, meta, id, name, hasChanged)...)
+		if diags.HasError() {
+			return diags
 		}
 	} else {
-		err := lbUpdate(d, meta, id, name, hasChanged, isLogging, hasChangedLog, hasChangedSecurityGroups, remove, add)
-		if err != nil {
-			return err
+		diags = append(diags, lbUpdate(d, meta, id, name, hasChanged, isLogging, hasChangedLog, hasChangedSecurityGroups, remove, add)...)
+		if diags.HasError() {
+			return diags
 		}
 	}
 
-	return resourceIBMISLBRead(d, meta)
+	return append(diags, resourceIBMISLBRead(context, d, meta)...)
 }
-func classicLBUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
+func classicLBUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := classicVpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if d.HasChange(isLBTags) {
 		getLoadBalancerOptions := &vpcclassicv1.GetLoadBalancerOptions{
@@ -648,13 +664,14 @@ func classicLBUpdate(d *schema.ResourceData, meta interface{}, id, name string,
 		}
 		lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 		if err != nil {
-			return fmt.Errorf("Error getting Load Balancer : %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc_classic", err, response, "Error getting Load Balancer"))
 		}
 		oldList, newList := d.GetChange(isLBTags)
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *lb.CRN)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource vpc Load Balancer (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 	if hasChanged {
@@ -667,22 +684,23 @@ func classicLBUpdate(d *schema.ResourceData, meta interface{}, id, name string,
 		}
 		loadBalancerPatch, err := loadBalancerPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for LoadBalancerPatch: %s", err)
+			return diag.FromErr(fmt.Errorf("Error calling asPatch for LoadBalancerPatch: %s", err))
 		}
 		updateLoadBalancerOptions.LoadBalancerPatch = loadBalancerPatch
 
 		_, response, err := sess.UpdateLoadBalancer(updateLoadBalancerOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating vpc Load Balancer : %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc_classic", err, response, "Error Updating vpc Load Balancer"))
 		}
 	}
-	return nil
+	return diags
 }
 
-func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool, isLogging bool, hasChangedLog bool, hasChangedSecurityGroups bool, remove, add []string) error {
+func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool, isLogging bool, hasChangedLog bool, hasChangedSecurityGroups bool, remove, add []string) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if d.HasChange(isLBTags) {
 		getLoadBalancerOptions := &vpcv1.GetLoadBalancerOptions{
@@ -690,13 +708,14 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 		}
 		lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 		if err != nil {
-			return fmt.Errorf("Error getting Load Balancer : %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error getting Load Balancer"))
 		}
 		oldList, newList := d.GetChange(isLBTags)
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *lb.CRN)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource vpc Load Balancer (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 	if hasChanged {
@@ -708,13 +727,13 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 		}
 		loadBalancerPatch, err := loadBalancerPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for LoadBalancerPatch: %s", err)
+			return diag.FromErr(fmt.Errorf("Error calling asPatch for LoadBalancerPatch: %s", err))
 		}
 		updateLoadBalancerOptions.LoadBalancerPatch = loadBalancerPatch
 
 		_, response, err := sess.UpdateLoadBalancer(updateLoadBalancerOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating vpc Load Balancer : %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error Updating vpc Load Balancer"))
 		}
 	}
 	if hasChangedLog {
@@ -732,13 +751,13 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 		}
 		loadBalancerPatch, err := loadBalancerPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for LoadBalancerPatch: %s", err)
+			return diag.FromErr(fmt.Errorf("Error calling asPatch for LoadBalancerPatch: %s", err))
 		}
 		updateLoadBalancerOptions.LoadBalancerPatch = loadBalancerPatch
 
 		_, response, err := sess.UpdateLoadBalancer(updateLoadBalancerOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating vpc Load Balancer : %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error Updating vpc Load Balancer"))
 		}
 	}
 
@@ -750,7 +769,7 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 				createSecurityGroupTargetBindingOptions.ID = &id
 				_, response, err := sess.CreateSecurityGroupTargetBinding(createSecurityGroupTargetBindingOptions)
 				if err != nil {
-					return fmt.Errorf("Error while creating Security Group Target Binding %s\n%s", err, response)
+					return diag.FromErr(apiErrorf("vpc", err, response, "Error while creating Security Group Target Binding"))
 				}
 			}
 		}
@@ -765,34 +784,34 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 					if response != nil && response.StatusCode == 404 {
 						continue
 					}
-					return fmt.Errorf("Error Getting Security Group Target for this load balancer (%s): %s\n%s", d, err, response)
+					return diag.FromErr(apiErrorf("vpc", err, response, "Error Getting Security Group Target for this load balancer (%s)", d))
 				}
 				deleteSecurityGroupTargetBindingOptions := sess.NewDeleteSecurityGroupTargetBindingOptions(d, id)
 				response, err = sess.DeleteSecurityGroupTargetBinding(deleteSecurityGroupTargetBindingOptions)
 				if err != nil {
-					return fmt.Errorf("Error Deleting Security Group Target for this load balancer : %s\n%s", err, response)
+					return diag.FromErr(apiErrorf("vpc", err, response, "Error Deleting Security Group Target for this load balancer"))
 				}
 			}
 		}
 	}
-	return nil
+	return diags
 }
 
-func resourceIBMISLBDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
-		err := classicLBDelete(d, meta, id)
+		err := classicLBDelete(context, d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		err := lbDelete(d, meta, id)
+		err := lbDelete(context, d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -800,7 +819,7 @@ func resourceIBMISLBDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func classicLBDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func classicLBDelete(context context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -815,7 +834,7 @@ func classicLBDelete(d *schema.ResourceData, meta interface{}, id string) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting vpc load balancer(%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting vpc load balancer(%s)", id)
 	}
 
 	deleteLoadBalancerOptions := &vpcclassicv1.DeleteLoadBalancerOptions{
@@ -823,9 +842,9 @@ func classicLBDelete(d *schema.ResourceData, meta interface{}, id string) error
 	}
 	response, err = sess.DeleteLoadBalancer(deleteLoadBalancerOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting vpc load balancer : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Deleting vpc load balancer")
 	}
-	_, err = isWaitForClassicLBDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicLBDeleted(context, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -833,7 +852,7 @@ func classicLBDelete(d *schema.ResourceData, meta interface{}, id string) error
 	return nil
 }
 
-func lbDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func lbDelete(context context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -848,7 +867,7 @@ func lbDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting vpc load balancer(%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc", err, response, "Error Getting vpc load balancer(%s)", id)
 	}
 
 	deleteLoadBalancerOptions := &vpcv1.DeleteLoadBalancerOptions{
@@ -856,9 +875,9 @@ func lbDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteLoadBalancer(deleteLoadBalancerOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting vpc load balancer : %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Deleting vpc load balancer")
 	}
-	_, err = isWaitForLBDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBDeleted(context, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -866,7 +885,7 @@ func lbDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func isWaitForClassicLBDeleted(lbc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicLBDeleted(context context.Context, lbc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -878,7 +897,7 @@ func isWaitForClassicLBDeleted(lbc *vpcclassicv1.VpcClassicV1, id string, timeou
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isClassicLBDeleteRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -892,13 +911,13 @@ func isClassicLBDeleteRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, id string) res
 			if response != nil && response.StatusCode == 404 {
 				return lb, isLBDeleted, nil
 			}
-			return nil, "failed", fmt.Errorf("The vpc load balancer %s failed to delete: %s\n%s", id, err, response)
+			return nil, "failed", apiErrorf("vpc_classic", err, response, "The vpc load balancer %s failed to delete", id)
 		}
 		return lb, isLBDeleting, nil
 	}
 }
 
-func isWaitForLBDeleted(lbc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBDeleted(context context.Context, lbc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -910,7 +929,7 @@ func isWaitForLBDeleted(lbc *vpcv1.VpcV1, id string, timeout time.Duration) (int
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isLBDeleteRefreshFunc(lbc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -924,7 +943,7 @@ func isLBDeleteRefreshFunc(lbc *vpcv1.VpcV1, id string) resource.StateRefreshFun
 			if response != nil && response.StatusCode == 404 {
 				return lb, isLBDeleted, nil
 			}
-			return nil, "failed", fmt.Errorf("The vpc load balancer %s failed to delete: %s\n%s", id, err, response)
+			return nil, "failed", apiErrorf("vpc", err, response, "The vpc load balancer %s failed to delete", id)
 		}
 		return lb, isLBDeleting, nil
 	}
@@ -958,7 +977,7 @@ func classicLBExists(d *schema.ResourceData, meta interface{}, id string) (bool,
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting vpc load balancer: %s\n%s", err, response)
+		return false, apiErrorf("vpc_classic", err, response, "Error getting vpc load balancer")
 	}
 	return true, nil
 }
@@ -976,12 +995,12 @@ func lbExists(d *schema.ResourceData, meta interface{}, id string) (bool, error)
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting vpc load balancer: %s\n%s", err, response)
+		return false, apiErrorf("vpc", err, response, "Error getting vpc load balancer")
 	}
 	return true, nil
 }
 
-func isWaitForLBAvailable(sess *vpcv1.VpcV1, lbId string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBAvailable(context context.Context, sess *vpcv1.VpcV1, lbId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for load balancer (%s) to be available.", lbId)
 
 	stateConf := &resource.StateChangeConf{
@@ -993,7 +1012,7 @@ func isWaitForLBAvailable(sess *vpcv1.VpcV1, lbId string, timeout time.Duration)
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isLBRefreshFunc(sess *vpcv1.VpcV1, lbId string) resource.StateRefreshFunc {
@@ -1004,7 +1023,7 @@ func isLBRefreshFunc(sess *vpcv1.VpcV1, lbId string) resource.StateRefreshFunc {
 		}
 		lb, response, err := sess.GetLoadBalancer(getlboptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting Load Balancer : %s\n%s", err, response)
+			return nil, "", apiErrorf("vpc", err, response, "Error Getting Load Balancer")
 		}
 
 		if *lb.ProvisioningStatus == "active" || *lb.ProvisioningStatus == "failed" {
@@ -1015,7 +1034,7 @@ func isLBRefreshFunc(sess *vpcv1.VpcV1, lbId string) resource.StateRefreshFunc {
 	}
 }
 
-func isWaitForClassicLBAvailable(sess *vpcclassicv1.VpcClassicV1, lbId string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicLBAvailable(context context.Context, sess *vpcclassicv1.VpcClassicV1, lbId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for load balancer (%s) to be available.", lbId)
 
 	stateConf := &resource.StateChangeConf{
@@ -1027,7 +1046,7 @@ func isWaitForClassicLBAvailable(sess *vpcclassicv1.VpcClassicV1, lbId string, t
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isClassicLBRefreshFunc(sess *vpcclassicv1.VpcClassicV1, lbId string) resource.StateRefreshFunc {
@@ -1038,7 +1057,7 @@ func isClassicLBRefreshFunc(sess *vpcclassicv1.VpcClassicV1, lbId string) resour
 		}
 		lb, response, err := sess.GetLoadBalancer(getlboptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting Load Balancer : %s\n%s", err, response)
+			return nil, "", apiErrorf("vpc_classic", err, response, "Error Getting Load Balancer")
 		}
 
 		if *lb.ProvisioningStatus == "active" || *lb.ProvisioningStatus == "failed" {
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -33,12 +35,11 @@ const (
 
 func resourceIBMISLBListener() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISLBListenerCreate,
-		Read:     resourceIBMISLBListenerRead,
-		Update:   resourceIBMISLBListenerUpdate,
-		Delete:   resourceIBMISLBListenerDelete,
-		Exists:   resourceIBMISLBListenerExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISLBListenerCreate,
+		ReadContext:   resourceIBMISLBListenerRead,
+		UpdateContext: resourceIBMISLBListenerUpdate,
+		DeleteContext: resourceIBMISLBListenerDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -150,10 +151,10 @@ func resourceIBMISLBListenerValidator() *ResourceValidator {
 	return &ibmISLBListenerResourceValidator
 }
 
-func resourceIBMISLBListenerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBListenerCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] LB Listener create")
@@ -165,7 +166,7 @@ func resourceIBMISLBListenerCreate(d *schema.ResourceData, meta interface{}) err
 	if pool, ok := d.GetOk(isLBListenerDefaultPool); ok {
 		lbPool, err := getPoolId(pool.(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		defPool = lbPool
 	}
@@ -185,20 +186,20 @@ func resourceIBMISLBListenerCreate(d *schema.ResourceData, meta interface{}) err
 	defer ibmMutexKV.Unlock(isLBKey)
 
 	if userDetails.generation == 1 {
-		err := classicLBListenerCreate(d, meta, lbID, protocol, defPool, certificateCRN, port, connLimit)
+		err := classicLBListenerCreate(context, d, meta, lbID, protocol, defPool, certificateCRN, port, connLimit)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		err := lbListenerCreate(d, meta, lbID, protocol, defPool, certificateCRN, port, connLimit, acceptProxyProtocol)
+		err := lbListenerCreate(context, d, meta, lbID, protocol, defPool, certificateCRN, port, connLimit, acceptProxyProtocol)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceIBMISLBListenerRead(d, meta)
+	return resourceIBMISLBListenerRead(context, d, meta)
 }
 
-func classicLBListenerCreate(d *schema.ResourceData, meta interface{}, lbID, protocol, defPool, certificateCRN string, port, connLimit int64) error {
+func classicLBListenerCreate(context context.Context, d *schema.ResourceData, meta interface{}, lbID, protocol, defPool, certificateCRN string, port, connLimit int64) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -221,7 +222,7 @@ func classicLBListenerCreate(d *schema.ResourceData, meta interface{}, lbID, pro
 	if connLimit > int64(0) {
 		options.ConnectionLimit = &connLimit
 	}
-	_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -229,15 +230,15 @@ func classicLBListenerCreate(d *schema.ResourceData, meta interface{}, lbID, pro
 
 	lbListener, response, err := sess.CreateLoadBalancerListener(options)
 	if err != nil {
-		return fmt.Errorf("Error while creating Load Balanacer Listener err %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error while creating Load Balanacer Listener")
 	}
 	d.SetId(fmt.Sprintf("%s/%s", lbID, *lbListener.ID))
-	_, err = isWaitForClassicLBListenerAvailable(sess, lbID, *lbListener.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicLBListenerAvailable(context, sess, lbID, *lbListener.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error waiting for load balancer listener(%s) to become ready: %s", d.Id(), err)
 	}
-	_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error waiting for load balancer (%s) to become ready: %s", lbID, err)
@@ -247,7 +248,7 @@ func classicLBListenerCreate(d *schema.ResourceData, meta interface{}, lbID, pro
 	return nil
 }
 
-func lbListenerCreate(d *schema.ResourceData, meta interface{}, lbID, protocol, defPool, certificateCRN string, port, connLimit int64, acceptProxyProtocol bool) error {
+func lbListenerCreate(context context.Context, d *schema.ResourceData, meta interface{}, lbID, protocol, defPool, certificateCRN string, port, connLimit int64, acceptProxyProtocol bool) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -271,7 +272,7 @@ func lbListenerCreate(d *schema.ResourceData, meta interface{}, lbID, protocol,
 	if connLimit > int64(0) {
 		options.ConnectionLimit = &connLimit
 	}
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -279,15 +280,15 @@ func lbListenerCreate(d *schema.ResourceData, meta interface{}, lbID, protocol,
 
 	lbListener, response, err := sess.CreateLoadBalancerListener(options)
 	if err != nil {
-		return fmt.Errorf("Error while creating Load Balanacer Listener err %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error while creating Load Balanacer Listener")
 	}
 	d.SetId(fmt.Sprintf("%s/%s", lbID, *lbListener.ID))
-	_, err = isWaitForLBListenerAvailable(sess, lbID, *lbListener.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBListenerAvailable(context, sess, lbID, *lbListener.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error waiting for load balancer listener(%s) to become ready: %s", d.Id(), err)
 	}
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error waiting for load balancer (%s) to become ready: %s", lbID, err)
@@ -297,7 +298,7 @@ func lbListenerCreate(d *schema.ResourceData, meta interface{}, lbID, protocol,
 	return nil
 }
 
-func isWaitForClassicLBListenerAvailable(sess *vpcclassicv1.VpcClassicV1, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicLBListenerAvailable(context context.Context, sess *vpcclassicv1.VpcClassicV1, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for load balancer Listener(%s) to be available.", lbListenerID)
 
 	stateConf := &resource.StateChangeConf{
@@ -309,7 +310,7 @@ func isWaitForClassicLBListenerAvailable(sess *vpcclassicv1.VpcClassicV1, lbID,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isClassicLBListenerRefreshFunc(sess *vpcclassicv1.VpcClassicV1, lbID, lbListenerID string) resource.StateRefreshFunc {
@@ -321,7 +322,7 @@ func isClassicLBListenerRefreshFunc(sess *vpcclassicv1.VpcClassicV1, lbID, lbLis
 		}
 		lblis, response, err := sess.GetLoadBalancerListener(getLoadBalancerListenerOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting Load Balancer Listener: %s\n%s", err, response)
+			return nil, "", apiErrorf("vpc_classic", err, response, "Error Getting Load Balancer Listener")
 		}
 
 		if *lblis.ProvisioningStatus == "active" || *lblis.ProvisioningStatus == "failed" {
@@ -332,7 +333,7 @@ func isClassicLBListenerRefreshFunc(sess *vpcclassicv1.VpcClassicV1, lbID, lbLis
 	}
 }
 
-func isWaitForLBListenerAvailable(sess *vpcv1.VpcV1, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBListenerAvailable(context context.Context, sess *vpcv1.VpcV1, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for load balancer Listener(%s) to be available.", lbListenerID)
 
 	stateConf := &resource.StateChangeConf{
@@ -344,7 +345,7 @@ func isWaitForLBListenerAvailable(sess *vpcv1.VpcV1, lbID, lbListenerID string,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isLBListenerRefreshFunc(sess *vpcv1.VpcV1, lbID, lbListenerID string) resource.StateRefreshFunc {
@@ -356,7 +357,7 @@ func isLBListenerRefreshFunc(sess *vpcv1.VpcV1, lbID, lbListenerID string) resou
 		}
 		lblis, response, err := sess.GetLoadBalancerListener(getLoadBalancerListenerOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting Load Balancer Listener: %s\n%s", err, response)
+			return nil, "", apiErrorf("vpc", err, response, "Error Getting Load Balancer Listener")
 		}
 
 		if *lblis.ProvisioningStatus == "active" || *lblis.ProvisioningStatus == "failed" {
@@ -367,15 +368,24 @@ func isLBListenerRefreshFunc(sess *vpcv1.VpcV1, lbID, lbListenerID string) resou
 	}
 }
 
-func resourceIBMISLBListenerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBListenerRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMISLBListenerExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lbID := parts[0]
@@ -384,12 +394,12 @@ func resourceIBMISLBListenerRead(d *schema.ResourceData, meta interface{}) error
 	if userDetails.generation == 1 {
 		err := classicLBListenerGet(d, meta, lbID, lbListenerID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := lbListenerGet(d, meta, lbID, lbListenerID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -411,7 +421,7 @@ func classicLBListenerGet(d *schema.ResourceData, meta interface{}, lbID, lbList
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Load Balancer Listener : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting Load Balancer Listener")
 	}
 	d.Set(isLBListenerLBID, lbID)
 	d.Set(isLBListenerPort, *lbListener.Port)
@@ -432,7 +442,7 @@ func classicLBListenerGet(d *schema.ResourceData, meta interface{}, lbID, lbList
 	}
 	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Load Balancer : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting Load Balancer")
 	}
 	d.Set(RelatedCRN, *lb.CRN)
 	return nil
@@ -453,7 +463,7 @@ func lbListenerGet(d *schema.ResourceData, meta interface{}, lbID, lbListenerID
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Load Balancer Listener : %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Getting Load Balancer Listener")
 	}
 	d.Set(isLBListenerLBID, lbID)
 	d.Set(isLBListenerPort, *lbListener.Port)
@@ -475,42 +485,42 @@ func lbListenerGet(d *schema.ResourceData, meta interface{}, lbID, lbListenerID
 	}
 	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Load Balancer : %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Getting Load Balancer")
 	}
 	d.Set(RelatedCRN, *lb.CRN)
 	return nil
 }
 
-func resourceIBMISLBListenerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBListenerUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lbID := parts[0]
 	lbListenerID := parts[1]
 
 	if userDetails.generation == 1 {
-		err := classicLBListenerUpdate(d, meta, lbID, lbListenerID)
+		err := classicLBListenerUpdate(context, d, meta, lbID, lbListenerID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		err := lbListenerUpdate(d, meta, lbID, lbListenerID)
+		err := lbListenerUpdate(context, d, meta, lbID, lbListenerID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceIBMISLBListenerRead(d, meta)
+	return resourceIBMISLBListenerRead(context, d, meta)
 }
 
-func classicLBListenerUpdate(d *schema.ResourceData, meta interface{}, lbID, lbListenerID string) error {
+func classicLBListenerUpdate(context context.Context, d *schema.ResourceData, meta interface{}, lbID, lbListenerID string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -572,23 +582,23 @@ func classicLBListenerUpdate(d *schema.ResourceData, meta interface{}, lbID, lbL
 		ibmMutexKV.Lock(isLBKey)
 		defer ibmMutexKV.Unlock(isLBKey)
 
-		_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForClassicLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer (%s) is active: %s", lbID, err)
 		}
 		_, response, err := sess.UpdateLoadBalancerListener(updateLoadBalancerListenerOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating Load Balancer Listener : %s\n%s", err, response)
+			return apiErrorf("vpc_classic", err, response, "Error Updating Load Balancer Listener")
 		}
 
-		_, err = isWaitForClassicLBListenerAvailable(sess, lbID, lbListenerID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForClassicLBListenerAvailable(context, sess, lbID, lbListenerID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error waiting for load balancer listener(%s) to become ready: %s", d.Id(), err)
 		}
 
-		_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForClassicLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error waiting for load balancer (%s) to become ready: %s", lbID, err)
@@ -597,7 +607,7 @@ func classicLBListenerUpdate(d *schema.ResourceData, meta interface{}, lbID, lbL
 	return nil
 }
 
-func lbListenerUpdate(d *schema.ResourceData, meta interface{}, lbID, lbListenerID string) error {
+func lbListenerUpdate(context context.Context, d *schema.ResourceData, meta interface{}, lbID, lbListenerID string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -666,23 +676,23 @@ func lbListenerUpdate(d *schema.ResourceData, meta interface{}, lbID, lbListener
 		ibmMutexKV.Lock(isLBKey)
 		defer ibmMutexKV.Unlock(isLBKey)
 
-		_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer (%s) is active: %s", lbID, err)
 		}
 		_, response, err := sess.UpdateLoadBalancerListener(updateLoadBalancerListenerOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating Load Balancer Listener : %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Updating Load Balancer Listener")
 		}
 
-		_, err = isWaitForLBListenerAvailable(sess, lbID, lbListenerID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBListenerAvailable(context, sess, lbID, lbListenerID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error waiting for load balancer listener(%s) to become ready: %s", d.Id(), err)
 		}
 
-		_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error waiting for load balancer (%s) to become ready: %s", lbID, err)
@@ -691,14 +701,14 @@ func lbListenerUpdate(d *schema.ResourceData, meta interface{}, lbID, lbListener
 	return nil
 }
 
-func resourceIBMISLBListenerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBListenerDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lbID := parts[0]
@@ -709,20 +719,20 @@ func resourceIBMISLBListenerDelete(d *schema.ResourceData, meta interface{}) err
 	defer ibmMutexKV.Unlock(isLBKey)
 
 	if userDetails.generation == 1 {
-		err := classicLBListenerDelete(d, meta, lbID, lbListenerID)
+		err := classicLBListenerDelete(context, d, meta, lbID, lbListenerID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		err := lbListenerDelete(d, meta, lbID, lbListenerID)
+		err := lbListenerDelete(context, d, meta, lbID, lbListenerID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
 }
 
-func classicLBListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbListenerID string) error {
+func classicLBListenerDelete(context context.Context, d *schema.ResourceData, meta interface{}, lbID, lbListenerID string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -738,9 +748,9 @@ func classicLBListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbL
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting vpc load balancer listener(%s): %s\n%s", lbListenerID, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting vpc load balancer listener(%s)", lbListenerID)
 	}
-	_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -751,13 +761,13 @@ func classicLBListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbL
 	}
 	response, err = sess.DeleteLoadBalancerListener(deleteLoadBalancerListenerOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Load Balancer Pool : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Deleting Load Balancer Pool")
 	}
-	_, err = isWaitForClassicLBListenerDeleted(sess, lbID, lbListenerID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicLBListenerDeleted(context, sess, lbID, lbListenerID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
-	_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error waiting for load balancer (%s) to be active: %s", lbID, err)
@@ -767,7 +777,7 @@ func classicLBListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbL
 	return nil
 }
 
-func lbListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbListenerID string) error {
+func lbListenerDelete(context context.Context, d *schema.ResourceData, meta interface{}, lbID, lbListenerID string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -783,9 +793,9 @@ func lbListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbListener
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting vpc load balancer listener(%s): %s\n%s", lbListenerID, err, response)
+		return apiErrorf("vpc", err, response, "Error Getting vpc load balancer listener(%s)", lbListenerID)
 	}
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -796,13 +806,13 @@ func lbListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbListener
 	}
 	response, err = sess.DeleteLoadBalancerListener(deleteLoadBalancerListenerOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Load Balancer Pool : %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Deleting Load Balancer Pool")
 	}
-	_, err = isWaitForLBListenerDeleted(sess, lbID, lbListenerID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBListenerDeleted(context, sess, lbID, lbListenerID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error waiting for load balancer (%s) to be active: %s", lbID, err)
@@ -812,7 +822,7 @@ func lbListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbListener
 	return nil
 }
 
-func isWaitForClassicLBListenerDeleted(lbc *vpcclassicv1.VpcClassicV1, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicLBListenerDeleted(context context.Context, lbc *vpcclassicv1.VpcClassicV1, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", lbListenerID)
 
 	stateConf := &resource.StateChangeConf{
@@ -824,7 +834,7 @@ func isWaitForClassicLBListenerDeleted(lbc *vpcclassicv1.VpcClassicV1, lbID, lbL
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isClassicLBListenerDeleteRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, lbID, lbListenerID string) resource.StateRefreshFunc {
@@ -838,13 +848,13 @@ func isClassicLBListenerDeleteRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, lbID,
 			if response != nil && response.StatusCode == 404 {
 				return lbLis, isLBListenerDeleted, nil
 			}
-			return nil, "", fmt.Errorf("The vpc load balancer listener %s failed to delete: %s\n%s", lbListenerID, err, response)
+			return nil, "", apiErrorf("vpc_classic", err, response, "The vpc load balancer listener %s failed to delete", lbListenerID)
 		}
 		return lbLis, isLBListenerDeleting, nil
 	}
 }
 
-func isWaitForLBListenerDeleted(lbc *vpcv1.VpcV1, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBListenerDeleted(context context.Context, lbc *vpcv1.VpcV1, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", lbListenerID)
 
 	stateConf := &resource.StateChangeConf{
@@ -856,7 +866,7 @@ func isWaitForLBListenerDeleted(lbc *vpcv1.VpcV1, lbID, lbListenerID string, tim
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isLBListenerDeleteRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbListenerID string) resource.StateRefreshFunc {
@@ -870,7 +880,7 @@ func isLBListenerDeleteRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbListenerID string)
 			if response != nil && response.StatusCode == 404 {
 				return lbLis, isLBListenerDeleted, nil
 			}
-			return nil, "", fmt.Errorf("The vpc load balancer listener %s failed to delete: %s\n%s", lbListenerID, err, response)
+			return nil, "", apiErrorf("vpc", err, response, "The vpc load balancer listener %s failed to delete", lbListenerID)
 		}
 		return lbLis, isLBListenerDeleting, nil
 	}
@@ -914,7 +924,7 @@ func classicLBListenerExists(d *schema.ResourceData, meta interface{}, lbID, lbL
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Load balancer Listener: %s\n%s", err, response)
+		return false, apiErrorf("vpc_classic", err, response, "Error getting Load balancer Listener")
 	}
 	return true, nil
 }
@@ -934,7 +944,7 @@ func lbListenerExists(d *schema.ResourceData, meta interface{}, lbID, lbListener
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Load balancer Listener: %s\n%s", err, response)
+		return false, apiErrorf("vpc", err, response, "Error getting Load balancer Listener")
 	}
 	return true, nil
 }
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strings"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -45,12 +47,11 @@ const (
 
 func resourceIBMISLBListenerPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISLBListenerPolicyCreate,
-		Read:     resourceIBMISLBListenerPolicyRead,
-		Update:   resourceIBMISLBListenerPolicyUpdate,
-		Delete:   resourceIBMISLBListenerPolicyDelete,
-		Exists:   resourceIBMISLBListenerPolicyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISLBListenerPolicyCreate,
+		ReadContext:   resourceIBMISLBListenerPolicyRead,
+		UpdateContext: resourceIBMISLBListenerPolicyUpdate,
+		DeleteContext: resourceIBMISLBListenerPolicyDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -251,10 +252,10 @@ func resourceIBMISLBListenerPolicyValidator() *ResourceValidator {
 	return &ibmISLBListenerPolicyResourceValidator
 }
 
-func resourceIBMISLBListenerPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBListenerPolicyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	//Get the Load balancer ID
@@ -263,7 +264,7 @@ func resourceIBMISLBListenerPolicyCreate(d *schema.ResourceData, meta interface{
 	//User can set listener id as combination of lbID/listenerID, parse and get the listenerID
 	listenerID, err := getListenerID(d.Get(isLBListenerPolicyListenerID).(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	action := d.Get(isLBListenerPolicyAction).(string)
@@ -276,18 +277,18 @@ func resourceIBMISLBListenerPolicyCreate(d *schema.ResourceData, meta interface{
 	}
 
 	if userDetails.generation == 1 {
-		err := classicLbListenerPolicyCreate(d, meta, lbID, listenerID, action, name, priority)
+		err := classicLbListenerPolicyCreate(context, d, meta, lbID, listenerID, action, name, priority)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		err := lbListenerPolicyCreate(d, meta, lbID, listenerID, action, name, priority)
+		err := lbListenerPolicyCreate(context, d, meta, lbID, listenerID, action, name, priority)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceIBMISLBListenerPolicyRead(d, meta)
+	return resourceIBMISLBListenerPolicyRead(context, d, meta)
 }
 
 func getListenerID(id string) (string, error) {
@@ -303,7 +304,7 @@ func getListenerID(id string) (string, error) {
 	}
 }
 
-func classicLbListenerPolicyCreate(d *schema.ResourceData, meta interface{}, lbID, listenerID, action, name string, priority int64) error {
+func classicLbListenerPolicyCreate(context context.Context, d *schema.ResourceData, meta interface{}, lbID, listenerID, action, name string, priority int64) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -407,7 +408,7 @@ func classicLbListenerPolicyCreate(d *schema.ResourceData, meta interface{}, lbI
 	ibmMutexKV.Lock(isLBKey)
 	defer ibmMutexKV.Unlock(isLBKey)
 
-	_, err = isWaitForClassicLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicLbAvailable(context, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -415,12 +416,12 @@ func classicLbListenerPolicyCreate(d *schema.ResourceData, meta interface{}, lbI
 
 	policy, response, err := sess.CreateLoadBalancerListenerPolicy(options)
 	if err != nil {
-		return fmt.Errorf("Error while creating lb listener policy for LB %s: Error %v Response %v", lbID, err, *response)
+		return apiErrorf("vpc_classic", err, response, "Error while creating lb listener policy for LB %s", lbID)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", lbID, listenerID, *(policy.ID)))
 
-	_, err = isWaitForClassicLbListenerPolicyAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicLbListenerPolicyAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -440,7 +441,7 @@ func getPoolID(id string) (string, error) {
 
 }
 
-func isWaitForClassicLbAvailable(vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicLbAvailable(context context.Context, vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isLBListenerProvisioning, "create_pending", "update_pending", "maintenance_pending"},
@@ -451,7 +452,7 @@ func isWaitForClassicLbAvailable(vpc *vpcclassicv1.VpcClassicV1, id string, time
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isLbClassicRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -474,7 +475,7 @@ func isLbClassicRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string) resource.
 	}
 }
 
-func isWaitForClassicLbListenerPolicyAvailable(vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicLbListenerPolicyAvailable(context context.Context, vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isLBListenerProvisioning, "create_pending", "update_pending", "maintenance_pending"},
@@ -485,7 +486,7 @@ func isWaitForClassicLbListenerPolicyAvailable(vpc *vpcclassicv1.VpcClassicV1, i
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isLbListenerPolicyClassicRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -519,7 +520,7 @@ func isLbListenerPolicyClassicRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id str
 	}
 }
 
-func lbListenerPolicyCreate(d *schema.ResourceData, meta interface{}, lbID, listenerID, action, name string, priority int64) error {
+func lbListenerPolicyCreate(context context.Context, d *schema.ResourceData, meta interface{}, lbID, listenerID, action, name string, priority int64) error {
 
 	sess, err := vpcClient(meta)
 	if err != nil {
@@ -623,7 +624,7 @@ func lbListenerPolicyCreate(d *schema.ResourceData, meta interface{}, lbID, list
 	ibmMutexKV.Lock(isLBKey)
 	defer ibmMutexKV.Unlock(isLBKey)
 
-	_, err = isWaitForLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLbAvailable(context, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -631,19 +632,19 @@ func lbListenerPolicyCreate(d *schema.ResourceData, meta interface{}, lbID, list
 
 	policy, response, err := sess.CreateLoadBalancerListenerPolicy(options)
 	if err != nil {
-		return fmt.Errorf("Error while creating lb listener policy for LB %s: Error %v Response %v", lbID, err, *response)
+		return apiErrorf("vpc", err, response, "Error while creating lb listener policy for LB %s", lbID)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", lbID, listenerID, *(policy.ID)))
 
-	_, err = isWaitForLbListenerPolicyAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLbListenerPolicyAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func isWaitForLbAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbAvailable(context context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isLBListenerPolicyPending},
@@ -654,7 +655,7 @@ func isWaitForLbAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (i
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isLbRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -677,7 +678,7 @@ func isLbRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	}
 }
 
-func isWaitForLbListenerPolicyAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbListenerPolicyAvailable(context context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isLBListenerProvisioning, "create_pending", "update_pending", "maintenance_pending"},
@@ -688,7 +689,7 @@ func isWaitForLbListenerPolicyAvailable(vpc *vpcv1.VpcV1, id string, timeout tim
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isLbListenerPolicyRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -723,17 +724,25 @@ func isLbListenerPolicyRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRe
 	}
 }
 
-func resourceIBMISLBListenerPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBListenerPolicyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMISLBListenerPolicyExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ID := d.Id()
 	parts, err := idParts(ID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lbID := parts[0]
@@ -743,12 +752,12 @@ func resourceIBMISLBListenerPolicyRead(d *schema.ResourceData, meta interface{})
 	if userDetails.generation == 1 {
 		err := classicLbListenerPolicyGet(d, meta, lbID, listenerID, policyID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := lbListenerPolicyGet(d, meta, lbID, listenerID, policyID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -799,7 +808,7 @@ func classicLbListenerPolicyExists(d *schema.ResourceData, meta interface{}, ID
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Load balancer policy: %s\n%s", err, response)
+		return false, apiErrorf("vpc_classic", err, response, "Error getting Load balancer policy")
 	}
 
 	return true, nil
@@ -832,20 +841,20 @@ func lbListenerPolicyExists(d *schema.ResourceData, meta interface{}, ID string)
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Load balancer policy: %s\n%s", err, response)
+		return false, apiErrorf("vpc", err, response, "Error getting Load balancer policy")
 	}
 	return true, nil
 }
-func resourceIBMISLBListenerPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBListenerPolicyUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lbID := parts[0]
@@ -854,22 +863,22 @@ func resourceIBMISLBListenerPolicyUpdate(d *schema.ResourceData, meta interface{
 
 	if userDetails.generation == 1 {
 
-		err := classicLbListenerPolicyUpdate(d, meta, lbID, listenerID, policyID)
+		err := classicLbListenerPolicyUpdate(context, d, meta, lbID, listenerID, policyID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 
-		err := lbListenerPolicyUpdate(d, meta, lbID, listenerID, policyID)
+		err := lbListenerPolicyUpdate(context, d, meta, lbID, listenerID, policyID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceIBMISLBListenerPolicyRead(d, meta)
+	return resourceIBMISLBListenerPolicyRead(context, d, meta)
 }
 
-func classicLbListenerPolicyUpdate(d *schema.ResourceData, meta interface{}, lbID, listenerID, ID string) error {
+func classicLbListenerPolicyUpdate(context context.Context, d *schema.ResourceData, meta interface{}, lbID, listenerID, ID string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -952,17 +961,17 @@ func classicLbListenerPolicyUpdate(d *schema.ResourceData, meta interface{}, lbI
 			return fmt.Errorf("Error calling asPatch for LoadBalancerListenerPolicyPatch: %s", err)
 		}
 		updatePolicyOptions.LoadBalancerListenerPolicyPatch = loadBalancerListenerPolicyPatch
-		_, err = isWaitForClassicLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForClassicLbAvailable(context, sess, lbID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return fmt.Errorf(
 				"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
 		}
 		_, response, err := sess.UpdateLoadBalancerListenerPolicy(&updatePolicyOptions)
 		if err != nil {
-			return fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
+			return apiErrorf("vpc_classic", err, response, "Error Getting Instance")
 		}
 
-		_, err = isWaitForClassicLbListenerPolicyAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForClassicLbListenerPolicyAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
@@ -970,7 +979,7 @@ func classicLbListenerPolicyUpdate(d *schema.ResourceData, meta interface{}, lbI
 	return nil
 }
 
-func lbListenerPolicyUpdate(d *schema.ResourceData, meta interface{}, lbID, listenerID, ID string) error {
+func lbListenerPolicyUpdate(context context.Context, d *schema.ResourceData, meta interface{}, lbID, listenerID, ID string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -1050,17 +1059,17 @@ func lbListenerPolicyUpdate(d *schema.ResourceData, meta interface{}, lbID, list
 		ibmMutexKV.Lock(isLBKey)
 		defer ibmMutexKV.Unlock(isLBKey)
 
-		_, err = isWaitForLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForLbAvailable(context, sess, lbID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return fmt.Errorf(
 				"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
 		}
 		_, response, err := sess.UpdateLoadBalancerListenerPolicy(&updatePolicyOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating in policy : %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Updating in policy")
 		}
 
-		_, err = isWaitForLbListenerPolicyAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForLbListenerPolicyAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
@@ -1068,17 +1077,17 @@ func lbListenerPolicyUpdate(d *schema.ResourceData, meta interface{}, lbID, list
 	return nil
 }
 
-func resourceIBMISLBListenerPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBListenerPolicyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	//Retrieve lbId, listenerId and policyID
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lbID := parts[0]
@@ -1090,14 +1099,14 @@ func resourceIBMISLBListenerPolicyDelete(d *schema.ResourceData, meta interface{
 	defer ibmMutexKV.Unlock(isLBKey)
 
 	if userDetails.generation == 1 {
-		err := classicLbListenerPolicycDelete(d, meta, lbID, listenerID, policyID)
+		err := classicLbListenerPolicycDelete(context, d, meta, lbID, listenerID, policyID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		err := lbListenerPolicyDelete(d, meta, lbID, listenerID, policyID)
+		err := lbListenerPolicyDelete(context, d, meta, lbID, listenerID, policyID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	d.SetId("")
@@ -1105,7 +1114,7 @@ func resourceIBMISLBListenerPolicyDelete(d *schema.ResourceData, meta interface{
 
 }
 
-func classicLbListenerPolicycDelete(d *schema.ResourceData, meta interface{}, lbID, listenerID, ID string) error {
+func classicLbListenerPolicycDelete(context context.Context, d *schema.ResourceData, meta interface{}, lbID, listenerID, ID string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -1125,7 +1134,7 @@ func classicLbListenerPolicycDelete(d *schema.ResourceData, meta interface{}, lb
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error in classicLbListenerPolicyGet : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error in classicLbListenerPolicyGet")
 	}
 
 	deleteLbListenerPolicyOptions := &vpcclassicv1.DeleteLoadBalancerListenerPolicyOptions{
@@ -1134,7 +1143,7 @@ func classicLbListenerPolicycDelete(d *schema.ResourceData, meta interface{}, lb
 		ID:             &ID,
 	}
 
-	_, err = isWaitForClassicLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicLbAvailable(context, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -1142,16 +1151,16 @@ func classicLbListenerPolicycDelete(d *schema.ResourceData, meta interface{}, lb
 
 	response, err = sess.DeleteLoadBalancerListenerPolicy(deleteLbListenerPolicyOptions)
 	if err != nil {
-		return fmt.Errorf("Error in classicLbListenerPolicycDelete: %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error in classicLbListenerPolicycDelete")
 	}
-	_, err = isWaitForLbListenerPolicyClassicDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLbListenerPolicyClassicDeleted(context, sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func lbListenerPolicyDelete(d *schema.ResourceData, meta interface{}, lbID, listenerID, ID string) error {
+func lbListenerPolicyDelete(context context.Context, d *schema.ResourceData, meta interface{}, lbID, listenerID, ID string) error {
 
 	sess, err := vpcClient(meta)
 	if err != nil {
@@ -1179,7 +1188,7 @@ func lbListenerPolicyDelete(d *schema.ResourceData, meta interface{}, lbID, list
 		ID:             &ID,
 	}
 
-	_, err = isWaitForLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLbAvailable(context, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -1187,15 +1196,15 @@ func lbListenerPolicyDelete(d *schema.ResourceData, meta interface{}, lbID, list
 
 	response, err = sess.DeleteLoadBalancerListenerPolicy(deleteLbListenerPolicyOptions)
 	if err != nil {
-		return fmt.Errorf("Error in lbListenerPolicyDelete: %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error in lbListenerPolicyDelete")
 	}
-	_, err = isWaitForLbListnerPolicyDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLbListnerPolicyDeleted(context, sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
 	return nil
 }
-func isWaitForLbListnerPolicyDeleted(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbListnerPolicyDeleted(context context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isLBListenerPolicyRetry, isLBListenerPolicyDeleting},
@@ -1206,7 +1215,7 @@ func isWaitForLbListnerPolicyDeleted(vpc *vpcv1.VpcV1, id string, timeout time.D
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isLbListenerPolicyDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -1261,7 +1270,7 @@ func classicLbListenerPolicyGet(d *schema.ResourceData, meta interface{}, lbID,
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error in classicLbListenerPolicyGet : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error in classicLbListenerPolicyGet")
 	}
 
 	d.Set(isLBListenerPolicyLBID, lbID)
@@ -1283,7 +1292,7 @@ func classicLbListenerPolicyGet(d *schema.ResourceData, meta interface{}, lbID,
 			}
 			ruleInfo, response, err := sess.GetLoadBalancerListenerPolicyRule(getLbListenerPolicyRulesOptions)
 			if err != nil {
-				return fmt.Errorf("Error in classicLbListenerPolicyGet rule: %s\n%s", err, response)
+				return apiErrorf("vpc_classic", err, response, "Error in classicLbListenerPolicyGet rule")
 			}
 
 			r := map[string]interface{}{
@@ -1324,7 +1333,7 @@ func classicLbListenerPolicyGet(d *schema.ResourceData, meta interface{}, lbID,
 	}
 	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Load Balancer : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting Load Balancer")
 	}
 	d.Set(RelatedCRN, *lb.CRN)
 
@@ -1352,7 +1361,7 @@ func lbListenerPolicyGet(d *schema.ResourceData, meta interface{}, lbID, listene
 			d.SetId("")
 			return nil
 		}
-		return err
+		return apiErrorf("vpc", err, response, "Error getting lb listener policy (%s)", id)
 	}
 
 	//set the argument values
@@ -1404,14 +1413,14 @@ func lbListenerPolicyGet(d *schema.ResourceData, meta interface{}, lbID, listene
 	}
 	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Load Balancer : %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Getting Load Balancer")
 	}
 	d.Set(RelatedCRN, *lb.CRN)
 
 	return nil
 }
 
-func isWaitForLbListenerPolicyClassicDeleted(vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbListenerPolicyClassicDeleted(context context.Context, vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isLBListenerPolicyRetry, isLBListenerPolicyDeleting, "delete_pending"},
@@ -1422,7 +1431,7 @@ func isWaitForLbListenerPolicyClassicDeleted(vpc *vpcclassicv1.VpcClassicV1, id
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isLbListenerPolicyClassicDeleteRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
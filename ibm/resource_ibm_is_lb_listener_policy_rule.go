@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -37,12 +39,11 @@ const (
 
 func resourceIBMISLBListenerPolicyRule() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISLBListenerPolicyRuleCreate,
-		Read:     resourceIBMISLBListenerPolicyRuleRead,
-		Update:   resourceIBMISLBListenerPolicyRuleUpdate,
-		Delete:   resourceIBMISLBListenerPolicyRuleDelete,
-		Exists:   resourceIBMISLBListenerPolicyRuleExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISLBListenerPolicyRuleCreate,
+		ReadContext:   resourceIBMISLBListenerPolicyRuleRead,
+		UpdateContext: resourceIBMISLBListenerPolicyRuleUpdate,
+		DeleteContext: resourceIBMISLBListenerPolicyRuleDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -181,10 +182,10 @@ func resourceIBMISLBListenerPolicyRuleValidator() *ResourceValidator {
 	return &ibmISLBListenerPolicyRuleResourceValidator
 }
 
-func resourceIBMISLBListenerPolicyRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBListenerPolicyRuleCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	//Read lb, listerner, policy IDs
@@ -192,12 +193,12 @@ func resourceIBMISLBListenerPolicyRuleCreate(d *schema.ResourceData, meta interf
 	lbID := d.Get(isLBListenerPolicyRuleLBID).(string)
 	listenerID, err := getLbListenerID(d.Get(isLBListenerPolicyRuleListenerID).(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	policyID, err := getLbPolicyID(d.Get(isLBListenerPolicyRulePolicyID).(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	condition := d.Get(isLBListenerPolicyRulecondition).(string)
@@ -208,18 +209,18 @@ func resourceIBMISLBListenerPolicyRuleCreate(d *schema.ResourceData, meta interf
 	}
 
 	if userDetails.generation == 1 {
-		err := classicLbListenerPolicyRuleCreate(d, meta, lbID, listenerID, policyID, condition, ty, value, field)
+		err := classicLbListenerPolicyRuleCreate(context, d, meta, lbID, listenerID, policyID, condition, ty, value, field)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		err := lbListenerPolicyRuleCreate(d, meta, lbID, listenerID, policyID, condition, ty, value, field)
+		err := lbListenerPolicyRuleCreate(context, d, meta, lbID, listenerID, policyID, condition, ty, value, field)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceIBMISLBListenerPolicyRuleRead(d, meta)
+	return resourceIBMISLBListenerPolicyRuleRead(context, d, meta)
 }
 
 func getLbListenerID(id string) (string, error) {
@@ -248,7 +249,7 @@ func getLbPolicyID(id string) (string, error) {
 	}
 }
 
-func classicLbListenerPolicyRuleCreate(d *schema.ResourceData, meta interface{}, lbID, listenerID, policyID, condition, ty, value, field string) error {
+func classicLbListenerPolicyRuleCreate(context context.Context, d *schema.ResourceData, meta interface{}, lbID, listenerID, policyID, condition, ty, value, field string) error {
 	sess, err := classicVpcSdkClient(meta)
 	if err != nil {
 		return err
@@ -268,7 +269,7 @@ func classicLbListenerPolicyRuleCreate(d *schema.ResourceData, meta interface{},
 	ibmMutexKV.Lock(isLBKey)
 	defer ibmMutexKV.Unlock(isLBKey)
 
-	_, err = isWaitForClassicLoadbalancerAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicLoadbalancerAvailable(context, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -276,19 +277,19 @@ func classicLbListenerPolicyRuleCreate(d *schema.ResourceData, meta interface{},
 
 	rule, response, err := sess.CreateLoadBalancerListenerPolicyRule(options)
 	if err != nil {
-		return fmt.Errorf("Error while creating lb listener policy for LB %s: Error %v Response %v", lbID, err, *response)
+		return apiErrorf("vpc_classic", err, response, "Error while creating lb listener policy rule for LB %s", lbID)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s/%s", lbID, listenerID, policyID, *(rule.ID)))
 
-	_, err = isWaitForClassicLbListenerPolicyRuleAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicLbListenerPolicyRuleAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func isWaitForClassicLoadbalancerAvailable(vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicLoadbalancerAvailable(context context.Context, vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isLBListenerPolicyRuleProvisioning, "create_pending", "update_pending", "maintenance_pending"},
@@ -299,7 +300,7 @@ func isWaitForClassicLoadbalancerAvailable(vpc *vpcclassicv1.VpcClassicV1, id st
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isLoadbalancerClassicRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -322,7 +323,7 @@ func isLoadbalancerClassicRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string)
 	}
 }
 
-func isWaitForClassicLbListenerPolicyRuleAvailable(vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicLbListenerPolicyRuleAvailable(context context.Context, vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isLBListenerPolicyRuleProvisioning, "create_pending", "update_pending", "maintenance_pending"},
@@ -333,7 +334,7 @@ func isWaitForClassicLbListenerPolicyRuleAvailable(vpc *vpcclassicv1.VpcClassicV
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isLbListenerPolicyRuleClassicRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -374,7 +375,7 @@ func vpcSdkClient(meta interface{}) (*vpcv1.VpcV1, error) {
 	return sess, err
 }
 
-func lbListenerPolicyRuleCreate(d *schema.ResourceData, meta interface{}, lbID, listenerID, policyID, condition, ty, value, field string) error {
+func lbListenerPolicyRuleCreate(context context.Context, d *schema.ResourceData, meta interface{}, lbID, listenerID, policyID, condition, ty, value, field string) error {
 
 	sess, err := vpcSdkClient(meta)
 	if err != nil {
@@ -395,7 +396,7 @@ func lbListenerPolicyRuleCreate(d *schema.ResourceData, meta interface{}, lbID,
 	ibmMutexKV.Lock(isLBKey)
 	defer ibmMutexKV.Unlock(isLBKey)
 
-	_, err = isWaitForLoadbalancerAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLoadbalancerAvailable(context, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -403,12 +404,12 @@ func lbListenerPolicyRuleCreate(d *schema.ResourceData, meta interface{}, lbID,
 
 	rule, response, err := sess.CreateLoadBalancerListenerPolicyRule(options)
 	if err != nil {
-		return fmt.Errorf("Error while creating lb listener policy for LB %s: Error %v Response %v", lbID, err, *response)
+		return apiErrorf("vpc", err, response, "Error while creating lb listener policy rule for LB %s", lbID)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s/%s", lbID, listenerID, policyID, *(rule.ID)))
 
-	_, err = isWaitForLbListenerPolicyRuleAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLbListenerPolicyRuleAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -416,7 +417,7 @@ func lbListenerPolicyRuleCreate(d *schema.ResourceData, meta interface{}, lbID,
 	return nil
 }
 
-func isWaitForLoadbalancerAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLoadbalancerAvailable(context context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isLBListenerPolicyRulePending},
@@ -427,7 +428,7 @@ func isWaitForLoadbalancerAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Du
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isLoadbalancerRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -450,7 +451,7 @@ func isLoadbalancerRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefres
 	}
 }
 
-func isWaitForLbListenerPolicyRuleAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbListenerPolicyRuleAvailable(context context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isLBListenerPolicyRuleProvisioning, "create_pending", "update_pending", "maintenance_pending"},
@@ -461,7 +462,7 @@ func isWaitForLbListenerPolicyRuleAvailable(vpc *vpcv1.VpcV1, id string, timeout
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isLbListenerPolicyRuleRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -498,17 +499,25 @@ func isLbListenerPolicyRuleRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.Sta
 	}
 }
 
-func resourceIBMISLBListenerPolicyRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBListenerPolicyRuleRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMISLBListenerPolicyRuleExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ID := d.Id()
 	parts, err := idParts(ID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lbID := parts[0]
@@ -519,12 +528,12 @@ func resourceIBMISLBListenerPolicyRuleRead(d *schema.ResourceData, meta interfac
 	if userDetails.generation == 1 {
 		err := classicLbListenerPolicyRuleGet(d, meta, lbID, listenerID, policyID, ruleID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := lbListenerPolicyRuleGet(d, meta, lbID, listenerID, policyID, ruleID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -576,7 +585,7 @@ func classicLbListenerPolicyRuleExists(d *schema.ResourceData, meta interface{},
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting policy: %s\n%s", err, response)
+		return false, apiErrorf("vpc_classic", err, response, "Error getting policy")
 	}
 	return true, nil
 }
@@ -610,20 +619,20 @@ func lbListenerPolicyRuleExists(d *schema.ResourceData, meta interface{}, ID str
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting policy: %s\n%s", err, response)
+		return false, apiErrorf("vpc", err, response, "Error getting policy")
 	}
 	return true, nil
 }
-func resourceIBMISLBListenerPolicyRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBListenerPolicyRuleUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lbID := parts[0]
@@ -633,22 +642,22 @@ func resourceIBMISLBListenerPolicyRuleUpdate(d *schema.ResourceData, meta interf
 
 	if userDetails.generation == 1 {
 
-		err := classicLbListenerPolicyRuleUpdate(d, meta, lbID, listenerID, policyID, ruleID)
+		err := classicLbListenerPolicyRuleUpdate(context, d, meta, lbID, listenerID, policyID, ruleID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 
-		err := lbListenerPolicyRuleUpdate(d, meta, lbID, listenerID, policyID, ruleID)
+		err := lbListenerPolicyRuleUpdate(context, d, meta, lbID, listenerID, policyID, ruleID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceIBMISLBListenerPolicyRuleRead(d, meta)
+	return resourceIBMISLBListenerPolicyRuleRead(context, d, meta)
 }
 
-func classicLbListenerPolicyRuleUpdate(d *schema.ResourceData, meta interface{}, lbID, listenerID, policyID, ID string) error {
+func classicLbListenerPolicyRuleUpdate(context context.Context, d *schema.ResourceData, meta interface{}, lbID, listenerID, policyID, ID string) error {
 	sess, err := classicVpcSdkClient(meta)
 	if err != nil {
 		return err
@@ -698,17 +707,17 @@ func classicLbListenerPolicyRuleUpdate(d *schema.ResourceData, meta interface{},
 		}
 		updatePolicyRuleOptions.LoadBalancerListenerPolicyRulePatch = loadBalancerListenerPolicyRulePatch
 
-		_, err = isWaitForClassicLoadbalancerAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForClassicLoadbalancerAvailable(context, sess, lbID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return fmt.Errorf(
 				"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
 		}
 		_, response, err := sess.UpdateLoadBalancerListenerPolicyRule(&updatePolicyRuleOptions)
 		if err != nil {
-			return fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
+			return apiErrorf("vpc_classic", err, response, "Error Getting Instance")
 		}
 
-		_, err = isWaitForClassicLbListenerPolicyRuleAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForClassicLbListenerPolicyRuleAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
@@ -716,7 +725,7 @@ func classicLbListenerPolicyRuleUpdate(d *schema.ResourceData, meta interface{},
 	return nil
 }
 
-func lbListenerPolicyRuleUpdate(d *schema.ResourceData, meta interface{}, lbID, listenerID, policyID, ID string) error {
+func lbListenerPolicyRuleUpdate(context context.Context, d *schema.ResourceData, meta interface{}, lbID, listenerID, policyID, ID string) error {
 	sess, err := vpcSdkClient(meta)
 	if err != nil {
 		return err
@@ -765,7 +774,7 @@ func lbListenerPolicyRuleUpdate(d *schema.ResourceData, meta interface{}, lbID,
 		ibmMutexKV.Lock(isLBKey)
 		defer ibmMutexKV.Unlock(isLBKey)
 
-		_, err = isWaitForLoadbalancerAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForLoadbalancerAvailable(context, sess, lbID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return fmt.Errorf(
 				"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -773,10 +782,10 @@ func lbListenerPolicyRuleUpdate(d *schema.ResourceData, meta interface{}, lbID,
 
 		_, response, err := sess.UpdateLoadBalancerListenerPolicyRule(&updatePolicyRuleOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating in policy : %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Updating in policy")
 		}
 
-		_, err = isWaitForLbListenerPolicyRuleAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForLbListenerPolicyRuleAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
@@ -784,17 +793,17 @@ func lbListenerPolicyRuleUpdate(d *schema.ResourceData, meta interface{}, lbID,
 	return nil
 }
 
-func resourceIBMISLBListenerPolicyRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBListenerPolicyRuleDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	//Retrieve lbId, listenerId and policyID
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lbID := parts[0]
@@ -807,14 +816,14 @@ func resourceIBMISLBListenerPolicyRuleDelete(d *schema.ResourceData, meta interf
 	defer ibmMutexKV.Unlock(isLBKey)
 
 	if userDetails.generation == 1 {
-		err := classicLbListenerPolicyRuleDelete(d, meta, lbID, listenerID, policyID, ruleID)
+		err := classicLbListenerPolicyRuleDelete(context, d, meta, lbID, listenerID, policyID, ruleID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		err := lbListenerPolicyRuleDelete(d, meta, lbID, listenerID, policyID, ruleID)
+		err := lbListenerPolicyRuleDelete(context, d, meta, lbID, listenerID, policyID, ruleID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -823,7 +832,7 @@ func resourceIBMISLBListenerPolicyRuleDelete(d *schema.ResourceData, meta interf
 
 }
 
-func classicLbListenerPolicyRuleDelete(d *schema.ResourceData, meta interface{}, lbID, listenerID, policyID, ID string) error {
+func classicLbListenerPolicyRuleDelete(context context.Context, d *schema.ResourceData, meta interface{}, lbID, listenerID, policyID, ID string) error {
 	sess, err := classicVpcSdkClient(meta)
 	if err != nil {
 		return err
@@ -844,7 +853,7 @@ func classicLbListenerPolicyRuleDelete(d *schema.ResourceData, meta interface{},
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error in classicLbListenerPolicyGet : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error in classicLbListenerPolicyGet")
 	}
 
 	deleteLbListenerPolicyRuleOptions := &vpcclassicv1.DeleteLoadBalancerListenerPolicyRuleOptions{
@@ -857,16 +866,16 @@ func classicLbListenerPolicyRuleDelete(d *schema.ResourceData, meta interface{},
 	response, err = sess.DeleteLoadBalancerListenerPolicyRule(deleteLbListenerPolicyRuleOptions)
 
 	if err != nil {
-		return fmt.Errorf("Error in classicLbListenerPolicyRuleDelete: %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error in classicLbListenerPolicyRuleDelete")
 	}
-	_, err = isWaitForLbListenerPolicyRuleClassicDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLbListenerPolicyRuleClassicDeleted(context, sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func lbListenerPolicyRuleDelete(d *schema.ResourceData, meta interface{}, lbID, listenerID, policyID, ID string) error {
+func lbListenerPolicyRuleDelete(context context.Context, d *schema.ResourceData, meta interface{}, lbID, listenerID, policyID, ID string) error {
 
 	sess, err := vpcSdkClient(meta)
 	if err != nil {
@@ -888,7 +897,7 @@ func lbListenerPolicyRuleDelete(d *schema.ResourceData, meta interface{}, lbID,
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error in LbListenerPolicyGet : %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error in LbListenerPolicyGet")
 	}
 
 	deleteLbListenerPolicyRuleOptions := &vpcv1.DeleteLoadBalancerListenerPolicyRuleOptions{
@@ -899,15 +908,15 @@ func lbListenerPolicyRuleDelete(d *schema.ResourceData, meta interface{}, lbID,
 	}
 	response, err = sess.DeleteLoadBalancerListenerPolicyRule(deleteLbListenerPolicyRuleOptions)
 	if err != nil {
-		return fmt.Errorf("Error in lbListenerPolicyRuleDelete: %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error in lbListenerPolicyRuleDelete")
 	}
-	_, err = isWaitForLbListnerPolicyRuleDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLbListnerPolicyRuleDeleted(context, sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
 	return nil
 }
-func isWaitForLbListnerPolicyRuleDeleted(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbListnerPolicyRuleDeleted(context context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isLBListenerPolicyRuleRetry, isLBListenerPolicyRuleDeleting},
@@ -918,7 +927,7 @@ func isWaitForLbListnerPolicyRuleDeleted(vpc *vpcv1.VpcV1, id string, timeout ti
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isLbListenerPolicyRuleDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -981,7 +990,7 @@ func classicLbListenerPolicyRuleGet(d *schema.ResourceData, meta interface{}, lb
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error in classicLbListenerPolicyGet : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error in classicLbListenerPolicyGet")
 	}
 
 	d.Set(isLBListenerPolicyRuleLBID, lbID)
@@ -998,7 +1007,7 @@ func classicLbListenerPolicyRuleGet(d *schema.ResourceData, meta interface{}, lb
 	}
 	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Load Balancer : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting Load Balancer")
 	}
 	d.Set(RelatedCRN, *lb.CRN)
 
@@ -1027,7 +1036,7 @@ func lbListenerPolicyRuleGet(d *schema.ResourceData, meta interface{}, lbID, lis
 			d.SetId("")
 			return nil
 		}
-		return err
+		return apiErrorf("vpc", err, response, "Error getting lb listener policy rule (%s)", id)
 	}
 
 	//set the argument values
@@ -1045,14 +1054,14 @@ func lbListenerPolicyRuleGet(d *schema.ResourceData, meta interface{}, lbID, lis
 	}
 	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Load Balancer : %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Getting Load Balancer")
 	}
 	d.Set(RelatedCRN, *lb.CRN)
 
 	return nil
 }
 
-func isWaitForLbListenerPolicyRuleClassicDeleted(vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbListenerPolicyRuleClassicDeleted(context context.Context, vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isLBListenerPolicyRuleRetry, isLBListenerPolicyRuleDeleting, "delete_pending"},
@@ -1063,7 +1072,7 @@ func isWaitForLbListenerPolicyRuleClassicDeleted(vpc *vpcclassicv1.VpcClassicV1,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isLbListenerPolicyRuleClassicDeleteRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -41,12 +43,11 @@ const (
 
 func resourceIBMISLBPool() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISLBPoolCreate,
-		Read:     resourceIBMISLBPoolRead,
-		Update:   resourceIBMISLBPoolUpdate,
-		Delete:   resourceIBMISLBPoolDelete,
-		Exists:   resourceIBMISLBPoolExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISLBPoolCreate,
+		ReadContext:   resourceIBMISLBPoolRead,
+		UpdateContext: resourceIBMISLBPoolUpdate,
+		DeleteContext: resourceIBMISLBPoolDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -220,10 +221,10 @@ func resourceIBMISLBPoolValidator() *ResourceValidator {
 	return &ibmISLBPoolResourceValidator
 }
 
-func resourceIBMISLBPoolCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBPoolCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] LB Pool create")
@@ -261,26 +262,26 @@ func resourceIBMISLBPoolCreate(d *schema.ResourceData, meta interface{}) error {
 	defer ibmMutexKV.Unlock(isLBKey)
 
 	if userDetails.generation == 1 {
-		err := classicLBPoolCreate(d, meta, name, lbID, algorithm, protocol, healthType, spType, cName, healthMonitorURL, healthDelay, maxRetries, healthTimeOut, healthMonitorPort)
+		err := classicLBPoolCreate(context, d, meta, name, lbID, algorithm, protocol, healthType, spType, cName, healthMonitorURL, healthDelay, maxRetries, healthTimeOut, healthMonitorPort)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		err := lbPoolCreate(d, meta, name, lbID, algorithm, protocol, healthType, spType, cName, healthMonitorURL, pProtocol, healthDelay, maxRetries, healthTimeOut, healthMonitorPort)
+		err := lbPoolCreate(context, d, meta, name, lbID, algorithm, protocol, healthType, spType, cName, healthMonitorURL, pProtocol, healthDelay, maxRetries, healthTimeOut, healthMonitorPort)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceIBMISLBPoolRead(d, meta)
+	return resourceIBMISLBPoolRead(context, d, meta)
 }
 
-func classicLBPoolCreate(d *schema.ResourceData, meta interface{}, name, lbID, algorithm, protocol, healthType, spType, cName, healthMonitorURL string, healthDelay, maxRetries, healthTimeOut, healthMonitorPort int64) error {
+func classicLBPoolCreate(context context.Context, d *schema.ResourceData, meta interface{}, name, lbID, algorithm, protocol, healthType, spType, cName, healthMonitorURL string, healthDelay, maxRetries, healthTimeOut, healthMonitorPort int64) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
 	}
 
-	_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -311,19 +312,19 @@ func classicLBPoolCreate(d *schema.ResourceData, meta interface{}, name, lbID, a
 	}
 	lbPool, response, err := sess.CreateLoadBalancerPool(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] lbpool create err: %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error creating vpc load balancer pool")
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", lbID, *lbPool.ID))
 	log.Printf("[INFO] lbpool : %s", *lbPool.ID)
 
-	_, err = isWaitForClassicLBPoolActive(sess, lbID, *lbPool.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicLBPoolActive(context, sess, lbID, *lbPool.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer pool (%s) is active: %s", *lbPool.ID, err)
 	}
 
-	_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -332,13 +333,13 @@ func classicLBPoolCreate(d *schema.ResourceData, meta interface{}, name, lbID, a
 	return nil
 }
 
-func lbPoolCreate(d *schema.ResourceData, meta interface{}, name, lbID, algorithm, protocol, healthType, spType, cName, healthMonitorURL, pProtocol string, healthDelay, maxRetries, healthTimeOut, healthMonitorPort int64) error {
+func lbPoolCreate(context context.Context, d *schema.ResourceData, meta interface{}, name, lbID, algorithm, protocol, healthType, spType, cName, healthMonitorURL, pProtocol string, healthDelay, maxRetries, healthTimeOut, healthMonitorPort int64) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -372,19 +373,19 @@ func lbPoolCreate(d *schema.ResourceData, meta interface{}, name, lbID, algorith
 	}
 	lbPool, response, err := sess.CreateLoadBalancerPool(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] lbpool create err: %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error creating vpc load balancer pool")
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", lbID, *lbPool.ID))
 	log.Printf("[INFO] lbpool : %s", *lbPool.ID)
 
-	_, err = isWaitForLBPoolActive(sess, lbID, *lbPool.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBPoolActive(context, sess, lbID, *lbPool.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer pool (%s) is active: %s", *lbPool.ID, err)
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -393,15 +394,24 @@ func lbPoolCreate(d *schema.ResourceData, meta interface{}, name, lbID, algorith
 	return nil
 }
 
-func resourceIBMISLBPoolRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBPoolRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMISLBPoolExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lbID := parts[0]
@@ -410,12 +420,12 @@ func resourceIBMISLBPoolRead(d *schema.ResourceData, meta interface{}) error {
 	if userDetails.generation == 1 {
 		err := classicLBPoolGet(d, meta, lbID, lbPoolID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := lbPoolGet(d, meta, lbID, lbPoolID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -436,7 +446,7 @@ func classicLBPoolGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID s
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Load Balancer Pool : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting Load Balancer Pool")
 	}
 
 	d.Set(isLBPoolName, *lbPool.Name)
@@ -466,7 +476,7 @@ func classicLBPoolGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID s
 	}
 	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Load Balancer : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting Load Balancer")
 	}
 	d.Set(RelatedCRN, *lb.CRN)
 	return nil
@@ -488,7 +498,7 @@ func lbPoolGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID string)
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Load Balancer Pool : %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Getting Load Balancer Pool")
 	}
 	d.Set(isLBPoolName, *lbPool.Name)
 	d.Set(isLBPool, lbPoolID)
@@ -518,42 +528,42 @@ func lbPoolGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID string)
 	}
 	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Load Balancer : %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Getting Load Balancer")
 	}
 	d.Set(RelatedCRN, *lb.CRN)
 	return nil
 }
 
-func resourceIBMISLBPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBPoolUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lbID := parts[0]
 	lbPoolID := parts[1]
 
 	if userDetails.generation == 1 {
-		err := classicLBPoolUpdate(d, meta, lbID, lbPoolID)
+		err := classicLBPoolUpdate(context, d, meta, lbID, lbPoolID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		err := lbPoolUpdate(d, meta, lbID, lbPoolID)
+		err := lbPoolUpdate(context, d, meta, lbID, lbPoolID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceIBMISLBPoolRead(d, meta)
+	return resourceIBMISLBPoolRead(context, d, meta)
 }
 
-func classicLBPoolUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID string) error {
+func classicLBPoolUpdate(context context.Context, d *schema.ResourceData, meta interface{}, lbID, lbPoolID string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -613,13 +623,13 @@ func classicLBPoolUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolI
 		isLBKey := "load_balancer_key_" + lbID
 		ibmMutexKV.Lock(isLBKey)
 		defer ibmMutexKV.Unlock(isLBKey)
-		_, err := isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err := isWaitForClassicLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer (%s) is active: %s", lbID, err)
 		}
 
-		_, err = isWaitForClassicLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForClassicLBPoolActive(context, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
@@ -633,16 +643,16 @@ func classicLBPoolUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolI
 
 		_, response, err := sess.UpdateLoadBalancerPool(updateLoadBalancerPoolOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating Load Balancer Pool : %s\n%s", err, response)
+			return apiErrorf("vpc_classic", err, response, "Error Updating Load Balancer Pool")
 		}
 
-		_, err = isWaitForClassicLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForClassicLBPoolActive(context, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 		}
 
-		_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForClassicLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -651,7 +661,7 @@ func classicLBPoolUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolI
 	return nil
 }
 
-func lbPoolUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID string) error {
+func lbPoolUpdate(context context.Context, d *schema.ResourceData, meta interface{}, lbID, lbPoolID string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -716,13 +726,13 @@ func lbPoolUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 		isLBKey := "load_balancer_key_" + lbID
 		ibmMutexKV.Lock(isLBKey)
 		defer ibmMutexKV.Unlock(isLBKey)
-		_, err := isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err := isWaitForLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer (%s) is active: %s", lbID, err)
 		}
 
-		_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBPoolActive(context, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
@@ -736,16 +746,16 @@ func lbPoolUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 
 		_, response, err := sess.UpdateLoadBalancerPool(updateLoadBalancerPoolOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating Load Balancer Pool : %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Updating Load Balancer Pool")
 		}
 
-		_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBPoolActive(context, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 		}
 
-		_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -754,15 +764,15 @@ func lbPoolUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 	return nil
 }
 
-func resourceIBMISLBPoolDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBPoolDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lbID := parts[0]
@@ -773,20 +783,20 @@ func resourceIBMISLBPoolDelete(d *schema.ResourceData, meta interface{}) error {
 	defer ibmMutexKV.Unlock(isLBKey)
 
 	if userDetails.generation == 1 {
-		err := classicLBPoolDelete(d, meta, lbID, lbPoolID)
+		err := classicLBPoolDelete(context, d, meta, lbID, lbPoolID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		err := lbPoolDelete(d, meta, lbID, lbPoolID)
+		err := lbPoolDelete(context, d, meta, lbID, lbPoolID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
 }
 
-func classicLBPoolDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID string) error {
+func classicLBPoolDelete(context context.Context, d *schema.ResourceData, meta interface{}, lbID, lbPoolID string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -802,15 +812,15 @@ func classicLBPoolDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolI
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting vpc load balancer pool(%s): %s\n%s", lbPoolID, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting vpc load balancer pool(%s)", lbPoolID)
 	}
-	_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
 
-	_, err = isWaitForClassicLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicLBPoolActive(context, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
@@ -822,15 +832,15 @@ func classicLBPoolDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolI
 	}
 	response, err = sess.DeleteLoadBalancerPool(deleteLoadBalancerPoolOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Load Balancer Pool : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Deleting Load Balancer Pool")
 	}
-	_, err = isWaitForClassicLBPoolDeleted(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicLBPoolDeleted(context, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer pool (%s) is deleted: %s", lbPoolID, err)
 	}
 
-	_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -839,7 +849,7 @@ func classicLBPoolDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolI
 	return nil
 }
 
-func lbPoolDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID string) error {
+func lbPoolDelete(context context.Context, d *schema.ResourceData, meta interface{}, lbID, lbPoolID string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -854,15 +864,15 @@ func lbPoolDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting vpc load balancer pool(%s): %s\n%s", lbPoolID, err, response)
+		return apiErrorf("vpc", err, response, "Error Getting vpc load balancer pool(%s)", lbPoolID)
 	}
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
 
-	_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBPoolActive(context, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
@@ -874,15 +884,15 @@ func lbPoolDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 	}
 	response, err = sess.DeleteLoadBalancerPool(deleteLoadBalancerPoolOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Load Balancer Pool : %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Deleting Load Balancer Pool")
 	}
-	_, err = isWaitForLBPoolDeleted(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBPoolDeleted(context, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer pool (%s) is deleted: %s", lbPoolID, err)
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -929,7 +939,7 @@ func classicLBPoolExists(d *schema.ResourceData, meta interface{}, lbID, lbPoolI
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Load balancer pool: %s\n%s", err, response)
+		return false, apiErrorf("vpc_classic", err, response, "Error getting Load balancer pool")
 	}
 	return true, nil
 }
@@ -949,12 +959,12 @@ func lbPoolExists(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Load balancer pool: %s\n%s", err, response)
+		return false, apiErrorf("vpc", err, response, "Error getting Load balancer pool")
 	}
 	return true, nil
 }
 
-func isWaitForLBPoolActive(sess *vpcv1.VpcV1, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBPoolActive(context context.Context, sess *vpcv1.VpcV1, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for load balancer pool (%s) to be available.", lbPoolId)
 
 	stateConf := &resource.StateChangeConf{
@@ -966,7 +976,7 @@ func isWaitForLBPoolActive(sess *vpcv1.VpcV1, lbId, lbPoolId string, timeout tim
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isLBPoolRefreshFunc(sess *vpcv1.VpcV1, lbId, lbPoolId string) resource.StateRefreshFunc {
@@ -978,7 +988,7 @@ func isLBPoolRefreshFunc(sess *vpcv1.VpcV1, lbId, lbPoolId string) resource.Stat
 		}
 		lbPool, response, err := sess.GetLoadBalancerPool(getlbpOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting Load Balancer Pool: %s\n%s", err, response)
+			return nil, "", apiErrorf("vpc", err, response, "Error Getting Load Balancer Pool")
 		}
 
 		if *lbPool.ProvisioningStatus == isLBPoolActive || *lbPool.ProvisioningStatus == isLBPoolFailed {
@@ -989,7 +999,7 @@ func isLBPoolRefreshFunc(sess *vpcv1.VpcV1, lbId, lbPoolId string) resource.Stat
 	}
 }
 
-func isWaitForClassicLBPoolActive(sess *vpcclassicv1.VpcClassicV1, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicLBPoolActive(context context.Context, sess *vpcclassicv1.VpcClassicV1, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for load balancer pool (%s) to be available.", lbPoolId)
 
 	stateConf := &resource.StateChangeConf{
@@ -1001,7 +1011,7 @@ func isWaitForClassicLBPoolActive(sess *vpcclassicv1.VpcClassicV1, lbId, lbPoolI
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isClassicLBPoolRefreshFunc(sess *vpcclassicv1.VpcClassicV1, lbId, lbPoolId string) resource.StateRefreshFunc {
@@ -1013,7 +1023,7 @@ func isClassicLBPoolRefreshFunc(sess *vpcclassicv1.VpcClassicV1, lbId, lbPoolId
 		}
 		lbPool, response, err := sess.GetLoadBalancerPool(getlbpOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting Load Balancer Pool: %s\n%s", err, response)
+			return nil, "", apiErrorf("vpc_classic", err, response, "Error Getting Load Balancer Pool")
 		}
 
 		if *lbPool.ProvisioningStatus == isLBPoolActive || *lbPool.ProvisioningStatus == isLBPoolFailed {
@@ -1024,7 +1034,7 @@ func isClassicLBPoolRefreshFunc(sess *vpcclassicv1.VpcClassicV1, lbId, lbPoolId
 	}
 }
 
-func isWaitForClassicLBPoolDeleted(lbc *vpcclassicv1.VpcClassicV1, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicLBPoolDeleted(context context.Context, lbc *vpcclassicv1.VpcClassicV1, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", lbPoolId)
 
 	stateConf := &resource.StateChangeConf{
@@ -1036,7 +1046,7 @@ func isWaitForClassicLBPoolDeleted(lbc *vpcclassicv1.VpcClassicV1, lbId, lbPoolI
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isClassicLBPoolDeleteRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, lbId, lbPoolId string) resource.StateRefreshFunc {
@@ -1051,13 +1061,13 @@ func isClassicLBPoolDeleteRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, lbId, lbPo
 			if response != nil && response.StatusCode == 404 {
 				return lbPool, isLBPoolDeleteDone, nil
 			}
-			return nil, "", fmt.Errorf("The vpc load balancer pool %s failed to delete: %s\n%s", lbPoolId, err, response)
+			return nil, "", apiErrorf("vpc_classic", err, response, "The vpc load balancer pool %s failed to delete", lbPoolId)
 		}
 		return lbPool, isLBPoolDeletePending, nil
 	}
 }
 
-func isWaitForLBPoolDeleted(lbc *vpcv1.VpcV1, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBPoolDeleted(context context.Context, lbc *vpcv1.VpcV1, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", lbPoolId)
 
 	stateConf := &resource.StateChangeConf{
@@ -1069,7 +1079,7 @@ func isWaitForLBPoolDeleted(lbc *vpcv1.VpcV1, lbId, lbPoolId string, timeout tim
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isLBPoolDeleteRefreshFunc(lbc *vpcv1.VpcV1, lbId, lbPoolId string) resource.StateRefreshFunc {
@@ -1084,7 +1094,7 @@ func isLBPoolDeleteRefreshFunc(lbc *vpcv1.VpcV1, lbId, lbPoolId string) resource
 			if response != nil && response.StatusCode == 404 {
 				return lbPool, isLBPoolDeleteDone, nil
 			}
-			return nil, "", fmt.Errorf("The vpc load balancer pool %s failed to delete: %s\n%s", lbPoolId, err, response)
+			return nil, "", apiErrorf("vpc", err, response, "The vpc load balancer pool %s failed to delete", lbPoolId)
 		}
 		return lbPool, isLBPoolDeletePending, nil
 	}
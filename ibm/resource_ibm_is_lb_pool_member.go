@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -32,12 +34,11 @@ const (
 
 func resourceIBMISLBPoolMember() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISLBPoolMemberCreate,
-		Read:     resourceIBMISLBPoolMemberRead,
-		Update:   resourceIBMISLBPoolMemberUpdate,
-		Delete:   resourceIBMISLBPoolMemberDelete,
-		Exists:   resourceIBMISLBPoolMemberExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISLBPoolMemberCreate,
+		ReadContext:   resourceIBMISLBPoolMemberRead,
+		UpdateContext: resourceIBMISLBPoolMemberUpdate,
+		DeleteContext: resourceIBMISLBPoolMemberDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -132,15 +133,15 @@ func resourceIBMISLBPoolMember() *schema.Resource {
 	}
 }
 
-func resourceIBMISLBPoolMemberCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBPoolMemberCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	log.Printf("[DEBUG] LB Pool create")
 	lbPoolID, err := getPoolId(d.Get(isLBPoolID).(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lbID := d.Get(isLBID).(string)
@@ -157,32 +158,32 @@ func resourceIBMISLBPoolMemberCreate(d *schema.ResourceData, meta interface{}) e
 
 	if userDetails.generation == 1 {
 		targetAddress := d.Get(isLBPoolMemberTargetAddress).(string)
-		err := classiclbpMemberCreate(d, meta, lbID, lbPoolID, targetAddress, port64, weight)
+		err := classiclbpMemberCreate(context, d, meta, lbID, lbPoolID, targetAddress, port64, weight)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		err := lbpMemberCreate(d, meta, lbID, lbPoolID, port64, weight)
+		err := lbpMemberCreate(context, d, meta, lbID, lbPoolID, port64, weight)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceIBMISLBPoolMemberRead(d, meta)
+	return resourceIBMISLBPoolMemberRead(context, d, meta)
 }
 
-func classiclbpMemberCreate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, targetAddress string, port, weight int64) error {
+func classiclbpMemberCreate(context context.Context, d *schema.ResourceData, meta interface{}, lbID, lbPoolID, targetAddress string, port, weight int64) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
 	}
-	_, err = isWaitForClassicLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicLBPoolActive(context, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 	}
 
-	_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -201,24 +202,24 @@ func classiclbpMemberCreate(d *schema.ResourceData, meta interface{}, lbID, lbPo
 	}
 	lbPoolMember, response, err := sess.CreateLoadBalancerPoolMember(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] lbpool member create err: %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error creating vpc load balancer pool member")
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", lbID, lbPoolID, *lbPoolMember.ID))
 	log.Printf("[INFO] lbpool member : %s", *lbPoolMember.ID)
 
-	_, err = isWaitForClassicLBPoolMemberAvailable(sess, lbID, lbPoolID, *lbPoolMember.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicLBPoolMemberAvailable(context, sess, lbID, lbPoolID, *lbPoolMember.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
 
-	_, err = isWaitForClassicLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicLBPoolActive(context, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 	}
 
-	_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -227,18 +228,18 @@ func classiclbpMemberCreate(d *schema.ResourceData, meta interface{}, lbID, lbPo
 	return nil
 }
 
-func lbpMemberCreate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID string, port, weight int64) error {
+func lbpMemberCreate(context context.Context, d *schema.ResourceData, meta interface{}, lbID, lbPoolID string, port, weight int64) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
 	}
-	_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBPoolActive(context, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -269,24 +270,24 @@ func lbpMemberCreate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID st
 	}
 	lbPoolMember, response, err := sess.CreateLoadBalancerPoolMember(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] lbpool member create err: %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error creating vpc load balancer pool member")
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", lbID, lbPoolID, *lbPoolMember.ID))
 	log.Printf("[INFO] lbpool member : %s", *lbPoolMember.ID)
 
-	_, err = isWaitForLBPoolMemberAvailable(sess, lbID, lbPoolID, *lbPoolMember.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBPoolMemberAvailable(context, sess, lbID, lbPoolID, *lbPoolMember.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
 
-	_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBPoolActive(context, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -295,7 +296,7 @@ func lbpMemberCreate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID st
 	return nil
 }
 
-func isWaitForClassicLBPoolMemberAvailable(lbc *vpcclassicv1.VpcClassicV1, lbID, lbPoolID, lbPoolMemID string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicLBPoolMemberAvailable(context context.Context, lbc *vpcclassicv1.VpcClassicV1, lbID, lbPoolID, lbPoolMemID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for load balancer pool member(%s) to be available.", lbPoolMemID)
 
 	stateConf := &resource.StateChangeConf{
@@ -307,7 +308,7 @@ func isWaitForClassicLBPoolMemberAvailable(lbc *vpcclassicv1.VpcClassicV1, lbID,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isClassicLBPoolMemberRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, lbID, lbPoolID, lbPoolMemID string) resource.StateRefreshFunc {
@@ -320,7 +321,7 @@ func isClassicLBPoolMemberRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, lbID, lbPo
 		}
 		lbPoolMem, response, err := lbc.GetLoadBalancerPoolMember(getlbpmoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting Load Balancer Pool Member: %s\n%s", err, response)
+			return nil, "", apiErrorf("vpc_classic", err, response, "Error Getting Load Balancer Pool Member")
 		}
 
 		if *lbPoolMem.ProvisioningStatus == isLBPoolMemberActive {
@@ -331,7 +332,7 @@ func isClassicLBPoolMemberRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, lbID, lbPo
 	}
 }
 
-func isWaitForLBPoolMemberAvailable(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBPoolMemberAvailable(context context.Context, lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for load balancer pool member(%s) to be available.", lbPoolMemID)
 
 	stateConf := &resource.StateChangeConf{
@@ -343,7 +344,7 @@ func isWaitForLBPoolMemberAvailable(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemI
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isLBPoolMemberRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string) resource.StateRefreshFunc {
@@ -356,7 +357,7 @@ func isLBPoolMemberRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID str
 		}
 		lbPoolMem, response, err := lbc.GetLoadBalancerPoolMember(getlbpmoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting Load Balancer Pool Member: %s\n%s", err, response)
+			return nil, "", apiErrorf("vpc", err, response, "Error Getting Load Balancer Pool Member")
 		}
 
 		if *lbPoolMem.ProvisioningStatus == isLBPoolMemberActive {
@@ -367,20 +368,29 @@ func isLBPoolMemberRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID str
 	}
 }
 
-func resourceIBMISLBPoolMemberRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBPoolMemberRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMISLBPoolMemberExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(parts) < 3 {
-		return fmt.Errorf(
-			"The id should contain loadbalancer Id, loadbalancer pool Id and loadbalancer poolmemebr Id")
+		return diag.FromErr(fmt.Errorf(
+			"The id should contain loadbalancer Id, loadbalancer pool Id and loadbalancer poolmemebr Id"))
 	}
 
 	lbID := parts[0]
@@ -390,12 +400,12 @@ func resourceIBMISLBPoolMemberRead(d *schema.ResourceData, meta interface{}) err
 	if userDetails.generation == 1 {
 		err := classiclbpmemberGet(d, meta, lbID, lbPoolID, lbPoolMemID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := lbpmemberGet(d, meta, lbID, lbPoolID, lbPoolMemID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -418,7 +428,7 @@ func classiclbpmemberGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolI
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Load Balancer Pool Member: %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting Load Balancer Pool Member")
 	}
 
 	d.Set(isLBPoolID, lbPoolID)
@@ -436,7 +446,7 @@ func classiclbpmemberGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolI
 	}
 	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Load Balancer : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting Load Balancer")
 	}
 	d.Set(RelatedCRN, *lb.CRN)
 	return nil
@@ -458,7 +468,7 @@ func lbpmemberGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPo
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Load Balancer Pool Member: %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Getting Load Balancer Pool Member")
 	}
 	d.Set(isLBPoolID, lbPoolID)
 	d.Set(isLBID, lbID)
@@ -480,21 +490,21 @@ func lbpmemberGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPo
 	}
 	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Load Balancer : %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Getting Load Balancer")
 	}
 	d.Set(RelatedCRN, *lb.CRN)
 	return nil
 }
 
-func resourceIBMISLBPoolMemberUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBPoolMemberUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lbID := parts[0]
@@ -502,20 +512,20 @@ func resourceIBMISLBPoolMemberUpdate(d *schema.ResourceData, meta interface{}) e
 	lbPoolMemID := parts[2]
 
 	if userDetails.generation == 1 {
-		err := classiclbpmemberUpdate(d, meta, lbID, lbPoolID, lbPoolMemID)
+		err := classiclbpmemberUpdate(context, d, meta, lbID, lbPoolID, lbPoolMemID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		err := lbpmemberUpdate(d, meta, lbID, lbPoolID, lbPoolMemID)
+		err := lbpmemberUpdate(context, d, meta, lbID, lbPoolID, lbPoolMemID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceIBMISLBPoolMemberRead(d, meta)
+	return resourceIBMISLBPoolMemberRead(context, d, meta)
 }
-func classiclbpmemberUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPoolMemID string) error {
+func classiclbpmemberUpdate(context context.Context, d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPoolMemID string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -530,18 +540,18 @@ func classiclbpmemberUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPo
 		ibmMutexKV.Lock(isLBKey)
 		defer ibmMutexKV.Unlock(isLBKey)
 
-		_, err = isWaitForClassicLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForClassicLBPoolActive(context, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 		}
 
-		_, err = isWaitForClassicLBPoolMemberAvailable(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForClassicLBPoolMemberAvailable(context, sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return err
 		}
 
-		_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForClassicLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -569,20 +579,20 @@ func classiclbpmemberUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPo
 
 		_, response, err := sess.UpdateLoadBalancerPoolMember(updatelbpmoptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating Load Balancer Pool Member: %s\n%s", err, response)
+			return apiErrorf("vpc_classic", err, response, "Error Updating Load Balancer Pool Member")
 		}
-		_, err = isWaitForClassicLBPoolMemberAvailable(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForClassicLBPoolMemberAvailable(context, sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return err
 		}
 
-		_, err = isWaitForClassicLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForClassicLBPoolActive(context, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 		}
 
-		_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForClassicLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -591,7 +601,7 @@ func classiclbpmemberUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPo
 	return nil
 }
 
-func lbpmemberUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPoolMemID string) error {
+func lbpmemberUpdate(context context.Context, d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPoolMemID string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -606,18 +616,18 @@ func lbpmemberUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 		ibmMutexKV.Lock(isLBKey)
 		defer ibmMutexKV.Unlock(isLBKey)
 
-		_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBPoolActive(context, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 		}
 
-		_, err = isWaitForLBPoolMemberAvailable(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForLBPoolMemberAvailable(context, sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
 
-		_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -656,20 +666,20 @@ func lbpmemberUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 
 		_, response, err := sess.UpdateLoadBalancerPoolMember(updatelbpmoptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating Load Balancer Pool Member: %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Updating Load Balancer Pool Member")
 		}
-		_, err = isWaitForLBPoolMemberAvailable(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForLBPoolMemberAvailable(context, sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
 
-		_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBPoolActive(context, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 		}
 
-		_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -678,16 +688,16 @@ func lbpmemberUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 	return nil
 }
 
-func resourceIBMISLBPoolMemberDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBPoolMemberDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lbID := parts[0]
@@ -699,21 +709,21 @@ func resourceIBMISLBPoolMemberDelete(d *schema.ResourceData, meta interface{}) e
 	defer ibmMutexKV.Unlock(isLBKey)
 
 	if userDetails.generation == 1 {
-		err := classiclbpmemberDelete(d, meta, lbID, lbPoolID, lbPoolMemID)
+		err := classiclbpmemberDelete(context, d, meta, lbID, lbPoolID, lbPoolMemID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		err := lbpmemberDelete(d, meta, lbID, lbPoolID, lbPoolMemID)
+		err := lbpmemberDelete(context, d, meta, lbID, lbPoolID, lbPoolMemID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	return nil
 }
 
-func classiclbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPoolMemID string) error {
+func classiclbpmemberDelete(context context.Context, d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPoolMemID string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -729,20 +739,20 @@ func classiclbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPo
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Load Balancer Pool Member: %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting Load Balancer Pool Member")
 	}
-	_, err = isWaitForClassicLBPoolMemberAvailable(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicLBPoolMemberAvailable(context, sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
 
-	_, err = isWaitForClassicLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicLBPoolActive(context, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 	}
 
-	_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -755,21 +765,21 @@ func classiclbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPo
 	}
 	response, err = sess.DeleteLoadBalancerPoolMember(dellbpmoptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Load Balancer Pool Member: %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Deleting Load Balancer Pool Member")
 	}
 
-	_, err = isWaitForClassicLBPoolMemberDeleted(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicLBPoolMemberDeleted(context, sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
 
-	_, err = isWaitForClassicLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicLBPoolActive(context, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 	}
 
-	_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -779,7 +789,7 @@ func classiclbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPo
 	return nil
 }
 
-func lbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPoolMemID string) error {
+func lbpmemberDelete(context context.Context, d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPoolMemID string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -796,20 +806,20 @@ func lbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Load Balancer Pool Member: %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Getting Load Balancer Pool Member")
 	}
-	_, err = isWaitForLBPoolMemberAvailable(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBPoolMemberAvailable(context, sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
 
-	_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBPoolActive(context, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -822,21 +832,21 @@ func lbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 	}
 	response, err = sess.DeleteLoadBalancerPoolMember(dellbpmoptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Load Balancer Pool Member: %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Deleting Load Balancer Pool Member")
 	}
 
-	_, err = isWaitForLBPoolMemberDeleted(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBPoolMemberDeleted(context, sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
 
-	_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBPoolActive(context, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -845,7 +855,7 @@ func lbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 	d.SetId("")
 	return nil
 }
-func isWaitForClassicLBPoolMemberDeleted(lbc *vpcclassicv1.VpcClassicV1, lbID, lbPoolID, lbPoolMemID string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicLBPoolMemberDeleted(context context.Context, lbc *vpcclassicv1.VpcClassicV1, lbID, lbPoolID, lbPoolMemID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", lbPoolMemID)
 
 	stateConf := &resource.StateChangeConf{
@@ -857,9 +867,9 @@ func isWaitForClassicLBPoolMemberDeleted(lbc *vpcclassicv1.VpcClassicV1, lbID, l
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
-func isWaitForLBPoolMemberDeleted(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBPoolMemberDeleted(context context.Context, lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", lbPoolMemID)
 
 	stateConf := &resource.StateChangeConf{
@@ -871,7 +881,7 @@ func isWaitForLBPoolMemberDeleted(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isDeleteClassicLBPoolMemberRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, lbID, lbPoolID, lbPoolMemID string) resource.StateRefreshFunc {
@@ -887,7 +897,7 @@ func isDeleteClassicLBPoolMemberRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, lbID
 			if response != nil && response.StatusCode == 404 {
 				return lbPoolMem, isLBPoolMemberDeleted, nil
 			}
-			return nil, "", fmt.Errorf("Error Deleting Load balancer pool member: %s\n%s", err, response)
+			return nil, "", apiErrorf("vpc_classic", err, response, "Error Deleting Load balancer pool member")
 		}
 		return lbPoolMem, isLBPoolMemberDeletePending, nil
 	}
@@ -906,7 +916,7 @@ func isDeleteLBPoolMemberRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMem
 			if response != nil && response.StatusCode == 404 {
 				return lbPoolMem, isLBPoolMemberDeleted, nil
 			}
-			return nil, "", fmt.Errorf("Error Deleting Load balancer pool member: %s\n%s", err, response)
+			return nil, "", apiErrorf("vpc", err, response, "Error Deleting Load balancer pool member")
 		}
 		return lbPoolMem, isLBPoolMemberDeletePending, nil
 	}
@@ -955,7 +965,7 @@ func classiclbpmemberExists(d *schema.ResourceData, meta interface{}, lbID, lbPo
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Load balancer pool member: %s\n%s", err, response)
+		return false, apiErrorf("vpc_classic", err, response, "Error getting Load balancer pool member")
 	}
 	return true, nil
 }
@@ -976,7 +986,7 @@ func lbpmemberExists(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Load balancer pool member: %s\n%s", err, response)
+		return false, apiErrorf("vpc", err, response, "Error getting Load balancer pool member")
 	}
 	return true, nil
 }
@@ -0,0 +1,469 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isNetworkACLRuleNetworkACL = "network_acl"
+	isNetworkACLRuleRuleID     = "rule_id"
+	isNetworkACLRuleBefore     = "before"
+)
+
+func resourceIBMISNetworkACLRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISNetworkACLRuleCreate,
+		ReadContext:   resourceIBMISNetworkACLRuleRead,
+		UpdateContext: resourceIBMISNetworkACLRuleUpdate,
+		DeleteContext: resourceIBMISNetworkACLRuleDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			isNetworkACLRuleNetworkACL: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Network ACL id",
+			},
+			isNetworkACLRuleRuleID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The network ACL rule id",
+			},
+			isNetworkACLRuleBefore: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The rule that this rule is immediately before. The rule is added at the end of the ACL when not set.",
+			},
+			isNetworkACLRuleName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: InvokeValidator("ibm_is_network_acl_rule", isNetworkACLRuleName),
+				Description:  "The user-defined name for this rule",
+			},
+			isNetworkACLRuleAction: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: InvokeValidator("ibm_is_network_acl_rule", isNetworkACLRuleAction),
+				Description:  "Whether to allow or deny matching traffic",
+			},
+			isNetworkACLRuleIPVersion: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IP version for this rule",
+			},
+			isNetworkACLRuleSource: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: InvokeValidator("ibm_is_network_acl_rule", isNetworkACLRuleSource),
+				Description:  "The source IP address or CIDR block",
+			},
+			isNetworkACLRuleDestination: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: InvokeValidator("ibm_is_network_acl_rule", isNetworkACLRuleDestination),
+				Description:  "The destination IP address or CIDR block",
+			},
+			isNetworkACLRuleDirection: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: InvokeValidator("ibm_is_network_acl_rule", isNetworkACLRuleDirection),
+				Description:  "Direction of traffic to enforce, either inbound or outbound",
+			},
+			isNetworkACLRuleICMP: {
+				Type:          schema.TypeList,
+				MaxItems:      1,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{isNetworkACLRuleTCP, isNetworkACLRuleUDP},
+				Description:   "The protocol ICMP",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isNetworkACLRuleICMPCode: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: InvokeValidator("ibm_is_network_acl_rule", isNetworkACLRuleICMPCode),
+							Description:  "The ICMP traffic code to allow",
+						},
+						isNetworkACLRuleICMPType: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: InvokeValidator("ibm_is_network_acl_rule", isNetworkACLRuleICMPType),
+							Description:  "The ICMP traffic type to allow",
+						},
+					},
+				},
+			},
+			isNetworkACLRuleTCP: {
+				Type:          schema.TypeList,
+				MaxItems:      1,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{isNetworkACLRuleICMP, isNetworkACLRuleUDP},
+				Description:   "TCP protocol",
+				Elem:          resourceIBMISNetworkACLRulePorts(),
+			},
+			isNetworkACLRuleUDP: {
+				Type:          schema.TypeList,
+				MaxItems:      1,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{isNetworkACLRuleICMP, isNetworkACLRuleTCP},
+				Description:   "UDP protocol",
+				Elem:          resourceIBMISNetworkACLRulePorts(),
+			},
+		},
+	}
+}
+
+// resourceIBMISNetworkACLRulePorts is the schema of the port ranges of the tcp and
+// udp rules.
+func resourceIBMISNetworkACLRulePorts() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			isNetworkACLRulePortMax: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      65535,
+				ValidateFunc: InvokeValidator("ibm_is_network_acl_rule", isNetworkACLRulePortMax),
+				Description:  "The highest port in the range of ports to be matched",
+			},
+			isNetworkACLRulePortMin: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: InvokeValidator("ibm_is_network_acl_rule", isNetworkACLRulePortMin),
+				Description:  "The lowest port in the range of ports to be matched",
+			},
+			isNetworkACLRuleSourcePortMax: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      65535,
+				ValidateFunc: InvokeValidator("ibm_is_network_acl_rule", isNetworkACLRuleSourcePortMax),
+				Description:  "The highest port in the range of source ports to be matched",
+			},
+			isNetworkACLRuleSourcePortMin: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: InvokeValidator("ibm_is_network_acl_rule", isNetworkACLRuleSourcePortMin),
+				Description:  "The lowest port in the range of source ports to be matched",
+			},
+		},
+	}
+}
+
+func resourceIBMISNetworkACLRuleValidator() *ResourceValidator {
+	validator := resourceIBMISNetworkACLValidator()
+	validateSchema := make([]ValidateSchema, 0, len(validator.Schema))
+	for _, s := range validator.Schema {
+		if s.Identifier != isNetworkACLName && s.Identifier != "tag" {
+			validateSchema = append(validateSchema, s)
+		}
+	}
+
+	ibmISNetworkACLRuleResourceValidator := ResourceValidator{ResourceName: "ibm_is_network_acl_rule", Schema: validateSchema}
+	return &ibmISNetworkACLRuleResourceValidator
+}
+
+func resourceIBMISNetworkACLRuleCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	nwaclID := d.Get(isNetworkACLRuleNetworkACL).(string)
+
+	action := d.Get(isNetworkACLRuleAction).(string)
+	direction := d.Get(isNetworkACLRuleDirection).(string)
+	source := d.Get(isNetworkACLRuleSource).(string)
+	destination := d.Get(isNetworkACLRuleDestination).(string)
+	protocol := "all"
+	ruleTemplate := &vpcv1.NetworkACLRulePrototype{
+		Action:      &action,
+		Direction:   &direction,
+		Source:      &source,
+		Destination: &destination,
+		Protocol:    &protocol,
+	}
+	if name, ok := d.GetOk(isNetworkACLRuleName); ok {
+		ruleName := name.(string)
+		ruleTemplate.Name = &ruleName
+	}
+	if before, ok := d.GetOk(isNetworkACLRuleBefore); ok {
+		beforeID := before.(string)
+		ruleTemplate.Before = &vpcv1.NetworkACLRuleBeforePrototypeNetworkACLRuleIdentityByID{
+			ID: &beforeID,
+		}
+	}
+	if _, ok := d.GetOk(isNetworkACLRuleICMP); ok {
+		protocol = "icmp"
+		ruleTemplate.Type, ruleTemplate.Code = networkACLRuleICMP(d)
+	} else if _, ok := d.GetOk(isNetworkACLRuleTCP); ok {
+		protocol = "tcp"
+		ruleTemplate.DestinationPortMin, ruleTemplate.DestinationPortMax, ruleTemplate.SourcePortMin, ruleTemplate.SourcePortMax = networkACLRulePorts(d, isNetworkACLRuleTCP)
+	} else if _, ok := d.GetOk(isNetworkACLRuleUDP); ok {
+		protocol = "udp"
+		ruleTemplate.DestinationPortMin, ruleTemplate.DestinationPortMax, ruleTemplate.SourcePortMin, ruleTemplate.SourcePortMax = networkACLRulePorts(d, isNetworkACLRuleUDP)
+	}
+
+	// The rules of an ACL are ordered, so they are changed one at a time
+	isNetworkACLRuleKey := "network_acl_rule_key_" + nwaclID
+	ibmMutexKV.Lock(isNetworkACLRuleKey)
+	defer ibmMutexKV.Unlock(isNetworkACLRuleKey)
+
+	createNetworkACLRuleOptions := &vpcv1.CreateNetworkACLRuleOptions{
+		NetworkACLID:            &nwaclID,
+		NetworkACLRulePrototype: ruleTemplate,
+	}
+	rule, response, err := sess.CreateNetworkACLRule(createNetworkACLRuleOptions)
+	if err != nil {
+		log.Printf("[DEBUG] Create network ACL rule err %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error Creating network ACL rule"))
+	}
+	ruleID, err := networkACLRuleID(rule)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", nwaclID, ruleID))
+
+	return resourceIBMISNetworkACLRuleRead(context, d, meta)
+}
+
+// networkACLRuleICMP returns the ICMP type and code of the rule, nil when not set.
+func networkACLRuleICMP(d *schema.ResourceData) (icmpType, icmpCode *int64) {
+	if v, ok := d.GetOk(isNetworkACLRuleICMP + ".0." + isNetworkACLRuleICMPType); ok {
+		t := int64(v.(int))
+		icmpType = &t
+	}
+	if v, ok := d.GetOk(isNetworkACLRuleICMP + ".0." + isNetworkACLRuleICMPCode); ok {
+		c := int64(v.(int))
+		icmpCode = &c
+	}
+	return icmpType, icmpCode
+}
+
+// networkACLRulePorts returns the destination and source port ranges of the tcp or
+// udp rule.
+func networkACLRulePorts(d *schema.ResourceData, protocol string) (portMin, portMax, sourcePortMin, sourcePortMax *int64) {
+	port := func(key string) *int64 {
+		p := int64(d.Get(protocol + ".0." + key).(int))
+		return &p
+	}
+	return port(isNetworkACLRulePortMin), port(isNetworkACLRulePortMax), port(isNetworkACLRuleSourcePortMin), port(isNetworkACLRuleSourcePortMax)
+}
+
+// networkACLRuleID returns the ID of a rule of any protocol.
+func networkACLRuleID(rule vpcv1.NetworkACLRuleIntf) (string, error) {
+	switch rule := rule.(type) {
+	case *vpcv1.NetworkACLRuleNetworkACLRuleProtocolIcmp:
+		return *rule.ID, nil
+	case *vpcv1.NetworkACLRuleNetworkACLRuleProtocolTcpudp:
+		return *rule.ID, nil
+	case *vpcv1.NetworkACLRuleNetworkACLRuleProtocolAll:
+		return *rule.ID, nil
+	}
+	return "", fmt.Errorf("Unexpected network ACL rule type %T", rule)
+}
+
+// parseNetworkACLRuleID splits the ID of a rule, <network_acl_id>/<rule_id>.
+func parseNetworkACLRuleID(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Incorrect ID %s: ID should be a combination of networkACLID/ruleID", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func resourceIBMISNetworkACLRuleRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	nwaclID, ruleID, err := parseNetworkACLRuleID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getNetworkACLRuleOptions := &vpcv1.GetNetworkACLRuleOptions{
+		NetworkACLID: &nwaclID,
+		ID:           &ruleID,
+	}
+	rule, response, err := sess.GetNetworkACLRule(getNetworkACLRuleOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error Getting network ACL rule (%s)", ruleID))
+	}
+
+	d.Set(isNetworkACLRuleNetworkACL, nwaclID)
+	d.Set(isNetworkACLRuleRuleID, ruleID)
+	var before *vpcv1.NetworkACLRuleReference
+	icmp := make([]map[string]interface{}, 0, 1)
+	tcp := make([]map[string]interface{}, 0, 1)
+	udp := make([]map[string]interface{}, 0, 1)
+	switch rule := rule.(type) {
+	case *vpcv1.NetworkACLRuleNetworkACLRuleProtocolIcmp:
+		before = rule.Before
+		d.Set(isNetworkACLRuleName, *rule.Name)
+		d.Set(isNetworkACLRuleAction, *rule.Action)
+		d.Set(isNetworkACLRuleIPVersion, *rule.IPVersion)
+		d.Set(isNetworkACLRuleSource, *rule.Source)
+		d.Set(isNetworkACLRuleDestination, *rule.Destination)
+		d.Set(isNetworkACLRuleDirection, *rule.Direction)
+		icmpval := map[string]interface{}{}
+		if rule.Type != nil {
+			icmpval[isNetworkACLRuleICMPType] = int(*rule.Type)
+		}
+		if rule.Code != nil {
+			icmpval[isNetworkACLRuleICMPCode] = int(*rule.Code)
+		}
+		icmp = append(icmp, icmpval)
+	case *vpcv1.NetworkACLRuleNetworkACLRuleProtocolTcpudp:
+		before = rule.Before
+		d.Set(isNetworkACLRuleName, *rule.Name)
+		d.Set(isNetworkACLRuleAction, *rule.Action)
+		d.Set(isNetworkACLRuleIPVersion, *rule.IPVersion)
+		d.Set(isNetworkACLRuleSource, *rule.Source)
+		d.Set(isNetworkACLRuleDestination, *rule.Destination)
+		d.Set(isNetworkACLRuleDirection, *rule.Direction)
+		ports := map[string]interface{}{
+			isNetworkACLRulePortMin:       checkNetworkACLNil(rule.DestinationPortMin),
+			isNetworkACLRulePortMax:       checkNetworkACLNil(rule.DestinationPortMax),
+			isNetworkACLRuleSourcePortMin: checkNetworkACLNil(rule.SourcePortMin),
+			isNetworkACLRuleSourcePortMax: checkNetworkACLNil(rule.SourcePortMax),
+		}
+		if *rule.Protocol == "tcp" {
+			tcp = append(tcp, ports)
+		} else {
+			udp = append(udp, ports)
+		}
+	case *vpcv1.NetworkACLRuleNetworkACLRuleProtocolAll:
+		before = rule.Before
+		d.Set(isNetworkACLRuleName, *rule.Name)
+		d.Set(isNetworkACLRuleAction, *rule.Action)
+		d.Set(isNetworkACLRuleIPVersion, *rule.IPVersion)
+		d.Set(isNetworkACLRuleSource, *rule.Source)
+		d.Set(isNetworkACLRuleDestination, *rule.Destination)
+		d.Set(isNetworkACLRuleDirection, *rule.Direction)
+	}
+	if before != nil {
+		d.Set(isNetworkACLRuleBefore, *before.ID)
+	} else {
+		d.Set(isNetworkACLRuleBefore, "")
+	}
+	d.Set(isNetworkACLRuleICMP, icmp)
+	d.Set(isNetworkACLRuleTCP, tcp)
+	d.Set(isNetworkACLRuleUDP, udp)
+
+	return nil
+}
+
+func resourceIBMISNetworkACLRuleUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	nwaclID, ruleID, err := parseNetworkACLRuleID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	hasChanged := false
+	networkACLRulePatchModel := &vpcv1.NetworkACLRulePatch{}
+	for key, field := range map[string]**string{
+		isNetworkACLRuleName:        &networkACLRulePatchModel.Name,
+		isNetworkACLRuleAction:      &networkACLRulePatchModel.Action,
+		isNetworkACLRuleDirection:   &networkACLRulePatchModel.Direction,
+		isNetworkACLRuleSource:      &networkACLRulePatchModel.Source,
+		isNetworkACLRuleDestination: &networkACLRulePatchModel.Destination,
+	} {
+		if d.HasChange(key) {
+			value := d.Get(key).(string)
+			*field = &value
+			hasChanged = true
+		}
+	}
+	if d.HasChange(isNetworkACLRuleBefore) && d.Get(isNetworkACLRuleBefore).(string) != "" {
+		beforeID := d.Get(isNetworkACLRuleBefore).(string)
+		networkACLRulePatchModel.Before = &vpcv1.NetworkACLRuleBeforePatchNetworkACLRuleIdentityByID{
+			ID: &beforeID,
+		}
+		hasChanged = true
+	}
+	if d.HasChange(isNetworkACLRuleICMP) {
+		networkACLRulePatchModel.Type, networkACLRulePatchModel.Code = networkACLRuleICMP(d)
+		hasChanged = true
+	}
+	for _, protocol := range []string{isNetworkACLRuleTCP, isNetworkACLRuleUDP} {
+		if _, ok := d.GetOk(protocol); ok && d.HasChange(protocol) {
+			networkACLRulePatchModel.DestinationPortMin, networkACLRulePatchModel.DestinationPortMax, networkACLRulePatchModel.SourcePortMin, networkACLRulePatchModel.SourcePortMax = networkACLRulePorts(d, protocol)
+			hasChanged = true
+		}
+	}
+
+	if hasChanged {
+		networkACLRulePatch, err := networkACLRulePatchModel.AsPatch()
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("Error calling asPatch for NetworkACLRulePatch: %s", err))
+		}
+
+		isNetworkACLRuleKey := "network_acl_rule_key_" + nwaclID
+		ibmMutexKV.Lock(isNetworkACLRuleKey)
+		defer ibmMutexKV.Unlock(isNetworkACLRuleKey)
+
+		updateNetworkACLRuleOptions := &vpcv1.UpdateNetworkACLRuleOptions{
+			NetworkACLID:        &nwaclID,
+			ID:                  &ruleID,
+			NetworkACLRulePatch: networkACLRulePatch,
+		}
+		_, response, err := sess.UpdateNetworkACLRule(updateNetworkACLRuleOptions)
+		if err != nil {
+			log.Printf("[DEBUG] Update network ACL rule err %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error Updating network ACL rule (%s)", ruleID))
+		}
+	}
+
+	return resourceIBMISNetworkACLRuleRead(context, d, meta)
+}
+
+func resourceIBMISNetworkACLRuleDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	nwaclID, ruleID, err := parseNetworkACLRuleID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	isNetworkACLRuleKey := "network_acl_rule_key_" + nwaclID
+	ibmMutexKV.Lock(isNetworkACLRuleKey)
+	defer ibmMutexKV.Unlock(isNetworkACLRuleKey)
+
+	deleteNetworkACLRuleOptions := &vpcv1.DeleteNetworkACLRuleOptions{
+		NetworkACLID: &nwaclID,
+		ID:           &ruleID,
+	}
+	response, err := sess.DeleteNetworkACLRule(deleteNetworkACLRuleOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] Delete network ACL rule err %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error Deleting network ACL rule (%s)", ruleID))
+	}
+
+	d.SetId("")
+	return nil
+}
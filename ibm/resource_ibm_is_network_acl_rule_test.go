@@ -0,0 +1,217 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
+)
+
+func TestAccIBMISNetworkACLRule_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tfnwacl-vpc-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: checkNetworkACLRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISNetworkACLRuleConfig(vpcname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_network_acl_rule.deny_ssh", "action", "deny"),
+					resource.TestCheckResourceAttr("ibm_is_network_acl_rule.deny_ssh", "tcp.0.port_min", "22"),
+					resource.TestCheckResourceAttrPair("ibm_is_network_acl_rule.deny_ssh", "before", "ibm_is_network_acl_rule.allow_all", "rule_id"),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_network_acl_rule.deny_ssh",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func checkNetworkACLRuleDestroy(s *terraform.State) error {
+	sess, _ := testAccProvider.Meta().(ClientSession).VpcV1API()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_network_acl_rule" {
+			continue
+		}
+		nwaclID, ruleID, err := parseNetworkACLRuleID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		getNetworkACLRuleOptions := &vpcv1.GetNetworkACLRuleOptions{
+			NetworkACLID: &nwaclID,
+			ID:           &ruleID,
+		}
+		if _, _, err := sess.GetNetworkACLRule(getNetworkACLRuleOptions); err == nil {
+			return fmt.Errorf("network acl rule still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISNetworkACLRuleConfig(vpcname string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_network_acl_rule" "allow_all" {
+		network_acl = ibm_is_vpc.testacc_vpc.default_network_acl
+		name        = "allow-all"
+		action      = "allow"
+		source      = "0.0.0.0/0"
+		destination = "0.0.0.0/0"
+		direction   = "inbound"
+	}
+
+	resource "ibm_is_network_acl_rule" "deny_ssh" {
+		network_acl = ibm_is_vpc.testacc_vpc.default_network_acl
+		before      = ibm_is_network_acl_rule.allow_all.rule_id
+		name        = "deny-ssh"
+		action      = "deny"
+		source      = "0.0.0.0/0"
+		destination = "0.0.0.0/0"
+		direction   = "inbound"
+		tcp {
+			port_min = 22
+			port_max = 22
+		}
+	}`, vpcname)
+}
+
+func TestIBMISNetworkACLRuleMock(t *testing.T) {
+	t.Parallel()
+	srv := testMockServer(t)
+	meta := testMockClientSession(t, srv)
+	vpcResource := resourceIBMISVPC()
+	r := resourceIBMISNetworkACLRule()
+
+	vpc := schema.TestResourceDataRaw(t, vpcResource.Schema, map[string]interface{}{
+		isVPCName: "mock-acl-rule-vpc",
+	})
+	assert.NilError(t, testDiagsErr(vpcResource.CreateContext(context.Background(), vpc, meta)))
+	aclID := vpc.Get(isVPCDefaultNetworkACL).(string)
+
+	allowAll := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		isNetworkACLRuleNetworkACL:  aclID,
+		isNetworkACLRuleName:        "allow-all",
+		isNetworkACLRuleAction:      "allow",
+		isNetworkACLRuleSource:      "0.0.0.0/0",
+		isNetworkACLRuleDestination: "0.0.0.0/0",
+		isNetworkACLRuleDirection:   "inbound",
+	})
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), allowAll, meta)))
+	assert.Equal(t, allowAll.Id(), aclID+"/"+allowAll.Get(isNetworkACLRuleRuleID).(string))
+	assert.Equal(t, allowAll.Get(isNetworkACLRuleBefore), "")
+
+	// A rule inserted before another one is evaluated first
+	denySSH := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		isNetworkACLRuleNetworkACL:  aclID,
+		isNetworkACLRuleBefore:      allowAll.Get(isNetworkACLRuleRuleID),
+		isNetworkACLRuleName:        "deny-ssh",
+		isNetworkACLRuleAction:      "deny",
+		isNetworkACLRuleSource:      "0.0.0.0/0",
+		isNetworkACLRuleDestination: "0.0.0.0/0",
+		isNetworkACLRuleDirection:   "inbound",
+		isNetworkACLRuleTCP: []interface{}{map[string]interface{}{
+			isNetworkACLRulePortMin: 22,
+			isNetworkACLRulePortMax: 22,
+		}},
+	})
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), denySSH, meta)))
+	assert.Equal(t, denySSH.Get(isNetworkACLRuleBefore), allowAll.Get(isNetworkACLRuleRuleID))
+	assert.Equal(t, denySSH.Get(isNetworkACLRuleTCP+".0."+isNetworkACLRulePortMin), 22)
+	assert.Equal(t, denySSH.Get(isNetworkACLRuleTCP+".0."+isNetworkACLRuleSourcePortMax), 65535)
+	assert.DeepEqual(t, testNetworkACLRuleNames(t, meta, aclID), []string{"deny-ssh", "allow-all"})
+
+	// Rules created concurrently are all added
+	var wg sync.WaitGroup
+	errs := make([]error, 5)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			d := r.TestResourceData()
+			d.Set(isNetworkACLRuleNetworkACL, aclID)
+			d.Set(isNetworkACLRuleName, fmt.Sprintf("allow-icmp-%d", i))
+			d.Set(isNetworkACLRuleAction, "allow")
+			d.Set(isNetworkACLRuleSource, fmt.Sprintf("10.0.%d.0/24", i))
+			d.Set(isNetworkACLRuleDestination, "0.0.0.0/0")
+			d.Set(isNetworkACLRuleDirection, "inbound")
+			d.Set(isNetworkACLRuleICMP, []interface{}{map[string]interface{}{isNetworkACLRuleICMPType: 8}})
+			errs[i] = testDiagsErr(r.CreateContext(context.Background(), d, meta))
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		assert.NilError(t, err)
+	}
+	assert.Equal(t, len(testNetworkACLRuleNames(t, meta, aclID)), 7)
+
+	// Moving the rule and changing its source update it in place
+	updated := testMockResourceData(t, r, allowAll, map[string]interface{}{
+		isNetworkACLRuleNetworkACL:  aclID,
+		isNetworkACLRuleBefore:      denySSH.Get(isNetworkACLRuleRuleID),
+		isNetworkACLRuleName:        "allow-all",
+		isNetworkACLRuleAction:      "allow",
+		isNetworkACLRuleSource:      "10.0.0.0/8",
+		isNetworkACLRuleDestination: "0.0.0.0/0",
+		isNetworkACLRuleDirection:   "inbound",
+	})
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), updated, meta)))
+	assert.Equal(t, updated.Get(isNetworkACLRuleSource), "10.0.0.0/8")
+	assert.Equal(t, updated.Get(isNetworkACLRuleBefore), denySSH.Get(isNetworkACLRuleRuleID))
+	assert.DeepEqual(t, testNetworkACLRuleNames(t, meta, aclID)[:2], []string{"allow-all", "deny-ssh"})
+
+	// The import passes the ID through to read
+	imported := r.Data(nil)
+	imported.SetId(denySSH.Id())
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), imported, meta)))
+	assert.Equal(t, imported.Get(isNetworkACLRuleNetworkACL), aclID)
+	assert.Equal(t, imported.Get(isNetworkACLRuleAction), "deny")
+	assert.Equal(t, imported.Get(isNetworkACLRuleTCP+".0."+isNetworkACLRulePortMax), 22)
+
+	invalid := r.Data(nil)
+	invalid.SetId(aclID)
+	assert.ErrorContains(t, testDiagsErr(r.ReadContext(context.Background(), invalid, meta)), "networkACLID/ruleID")
+
+	assert.NilError(t, testDiagsErr(r.DeleteContext(context.Background(), denySSH, meta)))
+	assert.Equal(t, denySSH.Id(), "")
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), imported, meta)))
+	assert.Equal(t, imported.Id(), "")
+	assert.Equal(t, len(testNetworkACLRuleNames(t, meta, aclID)), 6)
+}
+
+// testNetworkACLRuleNames returns the names of the rules of the network ACL in
+// evaluation order.
+func testNetworkACLRuleNames(t *testing.T, meta interface{}, aclID string) []string {
+	sess, err := vpcClient(meta)
+	assert.NilError(t, err)
+	acl, _, err := sess.GetNetworkACL(&vpcv1.GetNetworkACLOptions{ID: &aclID})
+	assert.NilError(t, err)
+	names := make([]string, 0, len(acl.Rules))
+	for _, rule := range acl.Rules {
+		switch rule := rule.(type) {
+		case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp:
+			names = append(names, *rule.Name)
+		case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp:
+			names = append(names, *rule.Name)
+		case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll:
+			names = append(names, *rule.Name)
+		}
+	}
+	return names
+}
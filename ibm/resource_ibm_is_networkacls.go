@@ -13,6 +13,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
@@ -49,12 +50,11 @@ const (
 
 func resourceIBMISNetworkACL() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISNetworkACLCreate,
-		Read:     resourceIBMISNetworkACLRead,
-		Update:   resourceIBMISNetworkACLUpdate,
-		Delete:   resourceIBMISNetworkACLDelete,
-		Exists:   resourceIBMISNetworkACLExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISNetworkACLCreate,
+		ReadContext:   resourceIBMISNetworkACLRead,
+		UpdateContext: resourceIBMISNetworkACLUpdate,
+		DeleteContext: resourceIBMISNetworkACLDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -376,25 +376,26 @@ func resourceIBMISNetworkACLValidator() *ResourceValidator {
 	return &ibmISNetworkACLResourceValidator
 }
 
-func resourceIBMISNetworkACLCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISNetworkACLCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	name := d.Get(isNetworkACLName).(string)
 
 	if userDetails.generation == 1 {
 		err := classicNwaclCreate(d, meta, name)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		err := nwaclCreate(d, meta, name)
-		if err != nil {
-			return err
+		diags = append(diags, nwaclCreate(d, meta, name)...)
+		if diags.HasError() {
+			return diags
 		}
 	}
-	return resourceIBMISNetworkACLRead(d, meta)
+	return append(diags, resourceIBMISNetworkACLRead(context, d, meta)...)
 
 }
 
@@ -423,7 +424,7 @@ func classicNwaclCreate(d *schema.ResourceData, meta interface{}, name string) e
 
 	nwacl, response, err := sess.CreateNetworkACL(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG]Error while creating Network ACL err %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error while creating Network ACL")
 	}
 	d.SetId(*nwacl.ID)
 	log.Printf("[INFO] Network ACL : %s", *nwacl.ID)
@@ -442,16 +443,17 @@ func classicNwaclCreate(d *schema.ResourceData, meta interface{}, name string) e
 	return nil
 }
 
-func nwaclCreate(d *schema.ResourceData, meta interface{}, name string) error {
+func nwaclCreate(d *schema.ResourceData, meta interface{}, name string) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	var vpc, rg string
 	if vpcID, ok := d.GetOk(isNetworkACLVPC); ok {
 		vpc = vpcID.(string)
 	} else {
-		return fmt.Errorf("Required parameter vpc is not set")
+		return diag.FromErr(fmt.Errorf("Required parameter vpc is not set"))
 	}
 
 	nwaclTemplate := &vpcv1.NetworkACLPrototype{
@@ -474,7 +476,7 @@ func nwaclCreate(d *schema.ResourceData, meta interface{}, name string) error {
 	}
 	err = validateInlineRules(rules)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	options := &vpcv1.CreateNetworkACLOptions{
@@ -483,7 +485,7 @@ func nwaclCreate(d *schema.ResourceData, meta interface{}, name string) error {
 
 	nwacl, response, err := sess.CreateNetworkACL(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG]Error while creating Network ACL err %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error while creating Network ACL"))
 	}
 	d.SetId(*nwacl.ID)
 	log.Printf("[INFO] Network ACL : %s", *nwacl.ID)
@@ -492,12 +494,12 @@ func nwaclCreate(d *schema.ResourceData, meta interface{}, name string) error {
 	//Remove default rules
 	err = clearRules(sess, nwaclid)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = createInlineRules(sess, nwaclid, rules)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isNetworkACLTags); ok || v != "" {
@@ -506,27 +508,36 @@ func nwaclCreate(d *schema.ResourceData, meta interface{}, name string) error {
 		if err != nil {
 			log.Printf(
 				"Error on create of resource network acl (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
-	return nil
+	return diags
 }
 
-func resourceIBMISNetworkACLRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISNetworkACLRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMISNetworkACLExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicNwaclGet(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := nwaclGet(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -546,7 +557,7 @@ func classicNwaclGet(d *schema.ResourceData, meta interface{}, id string) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting Network ACL(%s) : %s\n%s", id, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error getting Network ACL(%s)", id)
 	}
 	d.Set(isNetworkACLName, *nwacl.Name)
 	d.Set(isNetworkACLSubnets, len(nwacl.Subnets))
@@ -655,7 +666,7 @@ func nwaclGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting Network ACL(%s) : %s\n%s", id, err, response)
+		return apiErrorf("vpc", err, response, "Error getting Network ACL(%s)", id)
 	}
 	d.Set(isNetworkACLName, *nwacl.Name)
 	d.Set(isNetworkACLVPC, *nwacl.VPC.ID)
@@ -761,10 +772,11 @@ func nwaclGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISNetworkACLUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISNetworkACLUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 
@@ -779,15 +791,15 @@ func resourceIBMISNetworkACLUpdate(d *schema.ResourceData, meta interface{}) err
 	if userDetails.generation == 1 {
 		err := classicNwaclUpdate(d, meta, id, name, hasChanged)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		err := nwaclUpdate(d, meta, id, name, hasChanged)
-		if err != nil {
-			return err
+		diags = append(diags, nwaclUpdate(d, meta, id, name, hasChanged)...)
+		if diags.HasError() {
+			return diags
 		}
 	}
-	return resourceIBMISNetworkACLRead(d, meta)
+	return append(diags, resourceIBMISNetworkACLRead(context, d, meta)...)
 }
 
 func classicNwaclUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
@@ -811,7 +823,7 @@ func classicNwaclUpdate(d *schema.ResourceData, meta interface{}, id, name strin
 
 		_, response, err := sess.UpdateNetworkACL(updateNetworkAclOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating Network ACL(%s) : %s\n%s", id, err, response)
+			return apiErrorf("vpc_classic", err, response, "Error Updating Network ACL(%s)", id)
 		}
 	}
 	if d.HasChange(isNetworkACLRules) {
@@ -833,10 +845,11 @@ func classicNwaclUpdate(d *schema.ResourceData, meta interface{}, id, name strin
 	return nil
 }
 
-func nwaclUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
+func nwaclUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	rules := d.Get(isNetworkACLRules).([]interface{})
 	if hasChanged {
@@ -848,12 +861,12 @@ func nwaclUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 		}
 		networkACLPatch, err := networkACLPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for NetworkACLPatch: %s", err)
+			return diag.FromErr(fmt.Errorf("Error calling asPatch for NetworkACLPatch: %s", err))
 		}
 		updateNetworkACLOptions.NetworkACLPatch = networkACLPatch
 		_, response, err := sess.UpdateNetworkACL(updateNetworkACLOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating Network ACL(%s) : %s\n%s", id, err, response)
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error Updating Network ACL(%s)", id))
 		}
 	}
 	if d.HasChange(isNetworkACLTags) {
@@ -862,42 +875,46 @@ func nwaclUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 		if err != nil {
 			log.Printf(
 				"Error on update of resource network acl (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 	if d.HasChange(isNetworkACLRules) {
 		err := validateInlineRules(rules)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
+		isNetworkACLRuleKey := "network_acl_rule_key_" + id
+		ibmMutexKV.Lock(isNetworkACLRuleKey)
+		defer ibmMutexKV.Unlock(isNetworkACLRuleKey)
 		//Delete all existing rules
 		err = clearRules(sess, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		//Create the rules as per the def
 		err = createInlineRules(sess, id, rules)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return nil
+	return diags
 }
 
-func resourceIBMISNetworkACLDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISNetworkACLDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicNwaclDelete(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := nwaclDelete(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -920,7 +937,7 @@ func classicNwaclDelete(d *schema.ResourceData, meta interface{}, id string) err
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Network ACL (%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting Network ACL (%s)", id)
 	}
 
 	deleteNetworkAclOptions := &vpcclassicv1.DeleteNetworkACLOptions{
@@ -928,7 +945,7 @@ func classicNwaclDelete(d *schema.ResourceData, meta interface{}, id string) err
 	}
 	response, err = sess.DeleteNetworkACL(deleteNetworkAclOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Network ACL : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Deleting Network ACL")
 	}
 	d.SetId("")
 	return nil
@@ -950,7 +967,7 @@ func nwaclDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Network ACL (%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc", err, response, "Error Getting Network ACL (%s)", id)
 	}
 
 	deleteNetworkAclOptions := &vpcv1.DeleteNetworkACLOptions{
@@ -958,7 +975,7 @@ func nwaclDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteNetworkACL(deleteNetworkAclOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Network ACL : %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Deleting Network ACL")
 	}
 	d.SetId("")
 	return nil
@@ -992,7 +1009,7 @@ func classicNwaclExists(d *schema.ResourceData, meta interface{}, id string) (bo
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Network ACL: %s\n%s", err, response)
+		return false, apiErrorf("vpc_classic", err, response, "Error getting Network ACL")
 	}
 	return true, nil
 }
@@ -1010,7 +1027,7 @@ func nwaclExists(d *schema.ResourceData, meta interface{}, id string) (bool, err
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Network ACL: %s\n%s", err, response)
+		return false, apiErrorf("vpc", err, response, "Error getting Network ACL")
 	}
 	return true, nil
 }
@@ -1058,7 +1075,7 @@ func classicClearRules(nwaclC *vpcclassicv1.VpcClassicV1, nwaclid string) error
 		}
 		rawrules, response, err := nwaclC.ListNetworkACLRules(listNetworkAclRulesOptions)
 		if err != nil {
-			return fmt.Errorf("Error Listing network ACL rules : %s\n%s", err, response)
+			return apiErrorf("vpc_classic", err, response, "Error Listing network ACL rules")
 		}
 		start = GetNext(rawrules.Next)
 		allrecs = append(allrecs, rawrules.Rules...)
@@ -1085,7 +1102,7 @@ func classicClearRules(nwaclC *vpcclassicv1.VpcClassicV1, nwaclid string) error
 
 		response, err := nwaclC.DeleteNetworkACLRule(deleteNetworkAclRuleOptions)
 		if err != nil {
-			return fmt.Errorf("Error Deleting network ACL rule : %s\n%s", err, response)
+			return apiErrorf("vpc_classic", err, response, "Error Deleting network ACL rule")
 		}
 	}
 	return nil
@@ -1103,7 +1120,7 @@ func clearRules(nwaclC *vpcv1.VpcV1, nwaclid string) error {
 		}
 		rawrules, response, err := nwaclC.ListNetworkACLRules(listNetworkAclRulesOptions)
 		if err != nil {
-			return fmt.Errorf("Error Listing network ACL rules : %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Listing network ACL rules")
 		}
 		start = GetNext(rawrules.Next)
 		allrecs = append(allrecs, rawrules.Rules...)
@@ -1130,7 +1147,7 @@ func clearRules(nwaclC *vpcv1.VpcV1, nwaclid string) error {
 
 		response, err := nwaclC.DeleteNetworkACLRule(deleteNetworkAclRuleOptions)
 		if err != nil {
-			return fmt.Errorf("Error Deleting network ACL rule : %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Deleting network ACL rule")
 		}
 	}
 	return nil
@@ -1260,7 +1277,7 @@ func classicCreateInlineRules(nwaclC *vpcclassicv1.VpcClassicV1, nwaclid string,
 		}
 		_, response, err := nwaclC.CreateNetworkACLRule(createNetworkAclRuleOptions)
 		if err != nil {
-			return fmt.Errorf("Error Creating network ACL rule : %s\n%s", err, response)
+			return apiErrorf("vpc_classic", err, response, "Error Creating network ACL rule")
 		}
 	}
 	return nil
@@ -1367,7 +1384,7 @@ func createInlineRules(nwaclC *vpcv1.VpcV1, nwaclid string, rules []interface{})
 		}
 		_, response, err := nwaclC.CreateNetworkACLRule(createNetworkAclRuleOptions)
 		if err != nil {
-			return fmt.Errorf("Error Creating network ACL rule : %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Creating network ACL rule")
 		}
 	}
 	return nil
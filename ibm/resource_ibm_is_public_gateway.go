@@ -12,6 +12,7 @@ import (
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -36,12 +37,11 @@ const (
 
 func resourceIBMISPublicGateway() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISPublicGatewayCreate,
-		Read:     resourceIBMISPublicGatewayRead,
-		Update:   resourceIBMISPublicGatewayUpdate,
-		Delete:   resourceIBMISPublicGatewayDelete,
-		Exists:   resourceIBMISPublicGatewayExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISPublicGatewayCreate,
+		ReadContext:   resourceIBMISPublicGatewayRead,
+		UpdateContext: resourceIBMISPublicGatewayUpdate,
+		DeleteContext: resourceIBMISPublicGatewayDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -167,11 +167,12 @@ func resourceIBMISPublicGatewayValidator() *ResourceValidator {
 	return &ibmISPublicGatewayResourceValidator
 }
 
-func resourceIBMISPublicGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISPublicGatewayCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	name := d.Get(isPublicGatewayName).(string)
@@ -179,23 +180,24 @@ func resourceIBMISPublicGatewayCreate(d *schema.ResourceData, meta interface{})
 	zone := d.Get(isPublicGatewayZone).(string)
 
 	if userDetails.generation == 1 {
-		err := classicPgwCreate(d, meta, name, vpc, zone)
-		if err != nil {
-			return err
+		diags = append(diags, classicPgwCreate(context, d, meta, name, vpc, zone)...)
+		if diags.HasError() {
+			return diags
 		}
 	} else {
-		err := pgwCreate(d, meta, name, vpc, zone)
-		if err != nil {
-			return err
+		diags = append(diags, pgwCreate(context, d, meta, name, vpc, zone)...)
+		if diags.HasError() {
+			return diags
 		}
 	}
-	return resourceIBMISPublicGatewayRead(d, meta)
+	return append(diags, resourceIBMISPublicGatewayRead(context, d, meta)...)
 }
 
-func classicPgwCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone string) error {
+func classicPgwCreate(context context.Context, d *schema.ResourceData, meta interface{}, name, vpc, zone string) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := classicVpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	options := &vpcclassicv1.CreatePublicGatewayOptions{
@@ -225,14 +227,14 @@ func classicPgwCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone
 
 	publicgw, response, err := sess.CreatePublicGateway(options)
 	if err != nil {
-		return fmt.Errorf("Error while creating Public Gateway %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc_classic", err, response, "Error while creating Public Gateway"))
 	}
 	d.SetId(*publicgw.ID)
 	log.Printf("[INFO] PublicGateway : %s", *publicgw.ID)
 
-	_, err = isWaitForClassicPublicGatewayAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicPublicGatewayAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
@@ -242,15 +244,17 @@ func classicPgwCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone
 		if err != nil {
 			log.Printf(
 				"Error on create of vpc public gateway (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
-	return nil
+	return diags
 }
 
-func pgwCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone string) error {
+func pgwCreate(context context.Context, d *schema.ResourceData, meta interface{}, name, vpc, zone string) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	options := &vpcv1.CreatePublicGatewayOptions{
@@ -286,14 +290,14 @@ func pgwCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone string)
 
 	publicgw, response, err := sess.CreatePublicGateway(options)
 	if err != nil {
-		return fmt.Errorf("Error while creating Public Gateway %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error while creating Public Gateway"))
 	}
 	d.SetId(*publicgw.ID)
 	log.Printf("[INFO] PublicGateway : %s", *publicgw.ID)
 
-	_, err = isWaitForPublicGatewayAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForPublicGatewayAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
@@ -303,12 +307,13 @@ func pgwCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone string)
 		if err != nil {
 			log.Printf(
 				"Error on create of vpc public gateway (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
-	return nil
+	return diags
 }
 
-func isWaitForClassicPublicGatewayAvailable(publicgwC *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicPublicGatewayAvailable(context context.Context, publicgwC *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for public gateway (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -320,7 +325,7 @@ func isWaitForClassicPublicGatewayAvailable(publicgwC *vpcclassicv1.VpcClassicV1
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isClassicPublicGatewayRefreshFunc(publicgwC *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -330,7 +335,7 @@ func isClassicPublicGatewayRefreshFunc(publicgwC *vpcclassicv1.VpcClassicV1, id
 		}
 		publicgw, response, err := publicgwC.GetPublicGateway(getPublicGatewayOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error getting Public Gateway : %s\n%s", err, response)
+			return nil, "", apiErrorf("vpc_classic", err, response, "Error getting Public Gateway")
 		}
 
 		if *publicgw.Status == isPublicGatewayProvisioningDone {
@@ -341,7 +346,7 @@ func isClassicPublicGatewayRefreshFunc(publicgwC *vpcclassicv1.VpcClassicV1, id
 	}
 }
 
-func isWaitForPublicGatewayAvailable(publicgwC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForPublicGatewayAvailable(context context.Context, publicgwC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for public gateway (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -353,7 +358,7 @@ func isWaitForPublicGatewayAvailable(publicgwC *vpcv1.VpcV1, id string, timeout
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isPublicGatewayRefreshFunc(publicgwC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -363,7 +368,7 @@ func isPublicGatewayRefreshFunc(publicgwC *vpcv1.VpcV1, id string) resource.Stat
 		}
 		publicgw, response, err := publicgwC.GetPublicGateway(getPublicGatewayOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error getting Public Gateway : %s\n%s", err, response)
+			return nil, "", apiErrorf("vpc", err, response, "Error getting Public Gateway")
 		}
 
 		if *publicgw.Status == isPublicGatewayProvisioningDone {
@@ -374,21 +379,30 @@ func isPublicGatewayRefreshFunc(publicgwC *vpcv1.VpcV1, id string) resource.Stat
 	}
 }
 
-func resourceIBMISPublicGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISPublicGatewayRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMISPublicGatewayExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicPgwGet(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := pgwGet(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -408,7 +422,7 @@ func classicPgwGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting Public Gateway : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error getting Public Gateway")
 	}
 	d.Set(isPublicGatewayName, *publicgw.Name)
 	if publicgw.FloatingIP != nil {
@@ -453,7 +467,7 @@ func pgwGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting Public Gateway : %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error getting Public Gateway")
 	}
 	d.Set(isPublicGatewayName, *publicgw.Name)
 	if publicgw.FloatingIP != nil {
@@ -488,10 +502,11 @@ func pgwGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISPublicGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISPublicGatewayUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 
@@ -503,23 +518,24 @@ func resourceIBMISPublicGatewayUpdate(d *schema.ResourceData, meta interface{})
 		hasChanged = true
 	}
 	if userDetails.generation == 1 {
-		err := classicPgwUpdate(d, meta, id, name, hasChanged)
-		if err != nil {
-			return err
+		diags = append(diags, classicPgwUpdate(d, meta, id, name, hasChanged)...)
+		if diags.HasError() {
+			return diags
 		}
 	} else {
-		err := pgwUpdate(d, meta, id, name, hasChanged)
-		if err != nil {
-			return err
+		diags = append(diags, pgwUpdate(d, meta, id, name, hasChanged)...)
+		if diags.HasError() {
+			return diags
 		}
 	}
-	return resourceIBMISPublicGatewayRead(d, meta)
+	return append(diags, resourceIBMISPublicGatewayRead(context, d, meta)...)
 }
 
-func classicPgwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
+func classicPgwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := classicVpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if d.HasChange(isPublicGatewayTags) {
 		getPublicGatewayOptions := &vpcclassicv1.GetPublicGatewayOptions{
@@ -527,13 +543,14 @@ func classicPgwUpdate(d *schema.ResourceData, meta interface{}, id, name string,
 		}
 		publicgw, response, err := sess.GetPublicGateway(getPublicGatewayOptions)
 		if err != nil {
-			return fmt.Errorf("Error getting Public Gateway : %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc_classic", err, response, "Error getting Public Gateway"))
 		}
 		oldList, newList := d.GetChange(isPublicGatewayTags)
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *publicgw.CRN)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource Public Gateway (%s) tags: %s", id, err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 	if hasChanged {
@@ -546,22 +563,23 @@ func classicPgwUpdate(d *schema.ResourceData, meta interface{}, id, name string,
 		}
 		PublicGatewayPatch, err := PublicGatewayPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for PublicGatewayPatch: %s", err)
+			return diag.FromErr(fmt.Errorf("Error calling asPatch for PublicGatewayPatch: %s", err))
 		}
 		updatePublicGatewayOptions.PublicGatewayPatch = PublicGatewayPatch
 
 		_, response, err := sess.UpdatePublicGateway(updatePublicGatewayOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating Public Gateway  : %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc_classic", err, response, "Error Updating Public Gateway"))
 		}
 	}
-	return nil
+	return diags
 }
 
-func pgwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
+func pgwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if d.HasChange(isPublicGatewayTags) {
 		getPublicGatewayOptions := &vpcv1.GetPublicGatewayOptions{
@@ -569,13 +587,14 @@ func pgwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		}
 		publicgw, response, err := sess.GetPublicGateway(getPublicGatewayOptions)
 		if err != nil {
-			return fmt.Errorf("Error getting Public Gateway : %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error getting Public Gateway"))
 		}
 		oldList, newList := d.GetChange(isPublicGatewayTags)
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *publicgw.CRN)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource Public Gateway (%s) tags: %s", id, err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 	if hasChanged {
@@ -587,38 +606,38 @@ func pgwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		}
 		PublicGatewayPatch, err := PublicGatewayPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for PublicGatewayPatch: %s", err)
+			return diag.FromErr(fmt.Errorf("Error calling asPatch for PublicGatewayPatch: %s", err))
 		}
 		updatePublicGatewayOptions.PublicGatewayPatch = PublicGatewayPatch
 		_, response, err := sess.UpdatePublicGateway(updatePublicGatewayOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating Public Gateway  : %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error Updating Public Gateway"))
 		}
 	}
-	return nil
+	return diags
 }
 
-func resourceIBMISPublicGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISPublicGatewayDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
-		err := classicPgwDelete(d, meta, id)
+		err := classicPgwDelete(context, d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		err := pgwDelete(d, meta, id)
+		err := pgwDelete(context, d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
 }
 
-func classicPgwDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func classicPgwDelete(context context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -633,7 +652,7 @@ func classicPgwDelete(d *schema.ResourceData, meta interface{}, id string) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Public Gateway (%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting Public Gateway (%s)", id)
 	}
 
 	deletePublicGatewayOptions := &vpcclassicv1.DeletePublicGatewayOptions{
@@ -641,9 +660,9 @@ func classicPgwDelete(d *schema.ResourceData, meta interface{}, id string) error
 	}
 	response, err = sess.DeletePublicGateway(deletePublicGatewayOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Public Gateway : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Deleting Public Gateway")
 	}
-	_, err = isWaitForClassicPublicGatewayDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicPublicGatewayDeleted(context, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -651,7 +670,7 @@ func classicPgwDelete(d *schema.ResourceData, meta interface{}, id string) error
 	return nil
 }
 
-func pgwDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func pgwDelete(context context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -666,7 +685,7 @@ func pgwDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Public Gateway (%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc", err, response, "Error Getting Public Gateway (%s)", id)
 	}
 
 	deletePublicGatewayOptions := &vpcv1.DeletePublicGatewayOptions{
@@ -674,9 +693,9 @@ func pgwDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeletePublicGateway(deletePublicGatewayOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Public Gateway : %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Deleting Public Gateway")
 	}
-	_, err = isWaitForPublicGatewayDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForPublicGatewayDeleted(context, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -684,7 +703,7 @@ func pgwDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func isWaitForClassicPublicGatewayDeleted(pg *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicPublicGatewayDeleted(context context.Context, pg *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for public gateway (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -696,7 +715,7 @@ func isWaitForClassicPublicGatewayDeleted(pg *vpcclassicv1.VpcClassicV1, id stri
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isClassicPublicGatewayDeleteRefreshFunc(pg *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -710,13 +729,13 @@ func isClassicPublicGatewayDeleteRefreshFunc(pg *vpcclassicv1.VpcClassicV1, id s
 			if response != nil && response.StatusCode == 404 {
 				return pgw, isPublicGatewayDeleted, nil
 			}
-			return nil, "", fmt.Errorf("The Public Gateway %s failed to delete: %s\n%s", id, err, response)
+			return nil, "", apiErrorf("vpc_classic", err, response, "The Public Gateway %s failed to delete", id)
 		}
 		return pgw, isPublicGatewayDeleting, nil
 	}
 }
 
-func isWaitForPublicGatewayDeleted(pg *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForPublicGatewayDeleted(context context.Context, pg *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for public gateway (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -728,7 +747,7 @@ func isWaitForPublicGatewayDeleted(pg *vpcv1.VpcV1, id string, timeout time.Dura
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isPublicGatewayDeleteRefreshFunc(pg *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -742,7 +761,7 @@ func isPublicGatewayDeleteRefreshFunc(pg *vpcv1.VpcV1, id string) resource.State
 			if response != nil && response.StatusCode == 404 {
 				return pgw, isPublicGatewayDeleted, nil
 			}
-			return nil, "", fmt.Errorf("The Public Gateway %s failed to delete: %s\n%s", id, err, response)
+			return nil, "", apiErrorf("vpc", err, response, "The Public Gateway %s failed to delete", id)
 		}
 		return pgw, isPublicGatewayDeleting, nil
 	}
@@ -776,7 +795,7 @@ func classicPgwExists(d *schema.ResourceData, meta interface{}, id string) (bool
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Public Gateway: %s\n%s", err, response)
+		return false, apiErrorf("vpc_classic", err, response, "Error getting Public Gateway")
 	}
 	return true, nil
 }
@@ -794,7 +813,7 @@ func pgwExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Public Gateway: %s\n%s", err, response)
+		return false, apiErrorf("vpc", err, response, "Error getting Public Gateway")
 	}
 	return true, nil
 }
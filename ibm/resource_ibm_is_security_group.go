@@ -12,6 +12,7 @@ import (
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -28,12 +29,11 @@ const (
 func resourceIBMISSecurityGroup() *schema.Resource {
 
 	return &schema.Resource{
-		Create:   resourceIBMISSecurityGroupCreate,
-		Read:     resourceIBMISSecurityGroupRead,
-		Update:   resourceIBMISSecurityGroupUpdate,
-		Delete:   resourceIBMISSecurityGroupDelete,
-		Exists:   resourceIBMISSecurityGroupExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISSecurityGroupCreate,
+		ReadContext:   resourceIBMISSecurityGroupRead,
+		UpdateContext: resourceIBMISSecurityGroupUpdate,
+		DeleteContext: resourceIBMISSecurityGroupDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
@@ -143,30 +143,32 @@ func resourceIBMISSecurityGroupValidator() *ResourceValidator {
 	return &ibmISSecurityGroupResourceValidator
 }
 
-func resourceIBMISSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	vpc := d.Get(isSecurityGroupVPC).(string)
 	if userDetails.generation == 1 {
-		err := classicSgCreate(d, meta, vpc)
-		if err != nil {
-			return err
+		diags = append(diags, classicSgCreate(d, meta, vpc)...)
+		if diags.HasError() {
+			return diags
 		}
 	} else {
-		err := sgCreate(d, meta, vpc)
-		if err != nil {
-			return err
+		diags = append(diags, sgCreate(d, meta, vpc)...)
+		if diags.HasError() {
+			return diags
 		}
 	}
-	return resourceIBMISSecurityGroupRead(d, meta)
+	return append(diags, resourceIBMISSecurityGroupRead(context, d, meta)...)
 }
 
-func classicSgCreate(d *schema.ResourceData, meta interface{}, vpc string) error {
+func classicSgCreate(d *schema.ResourceData, meta interface{}, vpc string) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := classicVpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	createSecurityGroupOptions := &vpcclassicv1.CreateSecurityGroupOptions{
 		VPC: &vpcclassicv1.VPCIdentity{
@@ -187,7 +189,7 @@ func classicSgCreate(d *schema.ResourceData, meta interface{}, vpc string) error
 
 	sg, response, err := sess.CreateSecurityGroup(createSecurityGroupOptions)
 	if err != nil {
-		return fmt.Errorf("Error while creating Security Group %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc_classic", err, response, "Error while creating Security Group"))
 	}
 	d.SetId(*sg.ID)
 	v := os.Getenv("IC_ENV_TAGS")
@@ -196,15 +198,17 @@ func classicSgCreate(d *schema.ResourceData, meta interface{}, vpc string) error
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *sg.CRN)
 		if err != nil {
 			log.Printf("Error while creating Security Group tags %s\n%s", *sg.ID, err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
-	return nil
+	return diags
 }
 
-func sgCreate(d *schema.ResourceData, meta interface{}, vpc string) error {
+func sgCreate(d *schema.ResourceData, meta interface{}, vpc string) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	createSecurityGroupOptions := &vpcv1.CreateSecurityGroupOptions{
 		VPC: &vpcv1.VPCIdentity{
@@ -224,7 +228,7 @@ func sgCreate(d *schema.ResourceData, meta interface{}, vpc string) error {
 	}
 	sg, response, err := sess.CreateSecurityGroup(createSecurityGroupOptions)
 	if err != nil {
-		return fmt.Errorf("Error while creating Security Group %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error while creating Security Group"))
 	}
 	d.SetId(*sg.ID)
 	v := os.Getenv("IC_ENV_TAGS")
@@ -234,26 +238,36 @@ func sgCreate(d *schema.ResourceData, meta interface{}, vpc string) error {
 		if err != nil {
 			log.Printf(
 				"Error while creating Security Group tags : %s\n%s", *sg.ID, err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
-	return nil
+	return diags
 }
 
-func resourceIBMISSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMISSecurityGroupExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicSgGet(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := sgGet(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -273,7 +287,7 @@ func classicSgGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting Security Group : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error getting Security Group")
 	}
 	tags, err := GetTagsUsingCRN(meta, *group.CRN)
 	if err != nil {
@@ -410,7 +424,7 @@ func sgGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting Security Group : %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error getting Security Group")
 	}
 	tags, err := GetTagsUsingCRN(meta, *group.CRN)
 	if err != nil {
@@ -525,10 +539,11 @@ func sgGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	name := ""
@@ -540,6 +555,7 @@ func resourceIBMISSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 		if err != nil {
 			log.Printf(
 				"Error Updating Security Group tags: %s\n%s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 
@@ -547,20 +563,20 @@ func resourceIBMISSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 		name = d.Get(isSecurityGroupName).(string)
 		hasChanged = true
 	} else {
-		return resourceIBMISSecurityGroupRead(d, meta)
+		return append(diags, resourceIBMISSecurityGroupRead(context, d, meta)...)
 	}
 	if userDetails.generation == 1 {
 		err := classicSgUpdate(d, meta, id, name, hasChanged)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := sgUpdate(d, meta, id, name, hasChanged)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceIBMISSecurityGroupRead(d, meta)
+	return append(diags, resourceIBMISSecurityGroupRead(context, d, meta)...)
 }
 
 func classicSgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
@@ -582,7 +598,7 @@ func classicSgUpdate(d *schema.ResourceData, meta interface{}, id, name string,
 		updateSecurityGroupOptions.SecurityGroupPatch = securityGroupPatch
 		_, response, err := sess.UpdateSecurityGroup(updateSecurityGroupOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating Security Group : %s\n%s", err, response)
+			return apiErrorf("vpc_classic", err, response, "Error Updating Security Group")
 		}
 	}
 	return nil
@@ -607,27 +623,27 @@ func sgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 		updateSecurityGroupOptions.SecurityGroupPatch = securityGroupPatch
 		_, response, err := sess.UpdateSecurityGroup(updateSecurityGroupOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating Security Group : %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Updating Security Group")
 		}
 	}
 	return nil
 }
 
-func resourceIBMISSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicSgDelete(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := sgDelete(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	d.SetId("")
@@ -648,7 +664,7 @@ func classicSgDelete(d *schema.ResourceData, meta interface{}, id string) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Security Group (%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting Security Group (%s)", id)
 	}
 
 	deleteSecurityGroupOptions := &vpcclassicv1.DeleteSecurityGroupOptions{
@@ -656,7 +672,7 @@ func classicSgDelete(d *schema.ResourceData, meta interface{}, id string) error
 	}
 	response, err = sess.DeleteSecurityGroup(deleteSecurityGroupOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Security Group : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Deleting Security Group")
 	}
 	d.SetId("")
 	return nil
@@ -676,7 +692,7 @@ func sgDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Security Group (%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc", err, response, "Error Getting Security Group (%s)", id)
 	}
 
 	deleteSecurityGroupOptions := &vpcv1.DeleteSecurityGroupOptions{
@@ -684,7 +700,7 @@ func sgDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteSecurityGroup(deleteSecurityGroupOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Security Group : %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Deleting Security Group")
 	}
 	d.SetId("")
 	return nil
@@ -718,7 +734,7 @@ func classicSgExists(d *schema.ResourceData, meta interface{}, id string) (bool,
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Security Group: %s\n%s", err, response)
+		return false, apiErrorf("vpc_classic", err, response, "Error getting Security Group")
 	}
 	return true, nil
 }
@@ -736,7 +752,7 @@ func sgExists(d *schema.ResourceData, meta interface{}, id string) (bool, error)
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Security Group: %s\n%s", err, response)
+		return false, apiErrorf("vpc", err, response, "Error getting Security Group")
 	}
 	return true, nil
 }
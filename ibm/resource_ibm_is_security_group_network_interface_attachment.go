@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -34,11 +36,10 @@ const (
 
 func resourceIBMISSecurityGroupNetworkInterfaceAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISSecurityGroupNetworkInterfaceAttachmentCreate,
-		Read:     resourceIBMISSecurityGroupNetworkInterfaceAttachmentRead,
-		Delete:   resourceIBMISSecurityGroupNetworkInterfaceAttachmentDelete,
-		Exists:   resourceIBMISSecurityGroupNetworkInterfaceAttachmentExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISSecurityGroupNetworkInterfaceAttachmentCreate,
+		ReadContext:   resourceIBMISSecurityGroupNetworkInterfaceAttachmentRead,
+		DeleteContext: resourceIBMISSecurityGroupNetworkInterfaceAttachmentDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			isSGNICAGroupId: {
@@ -151,10 +152,10 @@ func resourceIBMISSecurityGroupNetworkInterfaceAttachment() *schema.Resource {
 	}
 }
 
-func resourceIBMISSecurityGroupNetworkInterfaceAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupNetworkInterfaceAttachmentCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	sgID := d.Get(isSGNICAGroupId).(string)
 	nicID := d.Get(isSGNICANicId).(string)
@@ -162,15 +163,15 @@ func resourceIBMISSecurityGroupNetworkInterfaceAttachmentCreate(d *schema.Resour
 	if userDetails.generation == 1 {
 		err := classicSgnicCreate(d, meta, sgID, nicID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := sgnicCreate(d, meta, sgID, nicID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceIBMISSecurityGroupNetworkInterfaceAttachmentRead(d, meta)
+	return resourceIBMISSecurityGroupNetworkInterfaceAttachmentRead(context, d, meta)
 
 }
 
@@ -185,7 +186,7 @@ func classicSgnicCreate(d *schema.ResourceData, meta interface{}, sgID, nicID st
 	}
 	_, response, err := sess.AddSecurityGroupNetworkInterface(options)
 	if err != nil {
-		return fmt.Errorf("Error while creating SecurityGroup NetworkInterface Binding %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error while creating SecurityGroup NetworkInterface Binding")
 	}
 	d.SetId(fmt.Sprintf("%s/%s", sgID, nicID))
 	return nil
@@ -202,32 +203,41 @@ func sgnicCreate(d *schema.ResourceData, meta interface{}, sgID, nicID string) e
 	}
 	_, response, err := sess.AddSecurityGroupNetworkInterface(options)
 	if err != nil {
-		return fmt.Errorf("Error while creating SecurityGroup NetworkInterface Binding %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error while creating SecurityGroup NetworkInterface Binding")
 	}
 	d.SetId(fmt.Sprintf("%s/%s", sgID, nicID))
 	return nil
 }
 
-func resourceIBMISSecurityGroupNetworkInterfaceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupNetworkInterfaceAttachmentRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMISSecurityGroupNetworkInterfaceAttachmentExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	sgID := parts[0]
 	nicID := parts[1]
 	if userDetails.generation == 1 {
 		err := classicSgnicGet(d, meta, sgID, nicID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := sgnicGet(d, meta, sgID, nicID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -248,7 +258,7 @@ func classicSgnicGet(d *schema.ResourceData, meta interface{}, sgID, nicID strin
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting NetworkInterface(%s) for the SecurityGroup (%s) : %s\n%s", nicID, sgID, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error getting NetworkInterface(%s) for the SecurityGroup (%s)", nicID, sgID)
 	}
 	d.Set(isSGNICAGroupId, sgID)
 	d.Set(isSGNICANicId, nicID)
@@ -287,7 +297,7 @@ func classicSgnicGet(d *schema.ResourceData, meta interface{}, sgID, nicID strin
 	}
 	sg, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Security Group : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting Security Group")
 	}
 	d.Set(RelatedCRN, *sg.CRN)
 	return nil
@@ -308,7 +318,7 @@ func sgnicGet(d *schema.ResourceData, meta interface{}, sgID, nicID string) erro
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting NetworkInterface(%s) for the SecurityGroup (%s) : %s\n%s", nicID, sgID, err, response)
+		return apiErrorf("vpc", err, response, "Error getting NetworkInterface(%s) for the SecurityGroup (%s)", nicID, sgID)
 	}
 	d.Set(isSGNICAGroupId, sgID)
 	d.Set(isSGNICANicId, nicID)
@@ -347,20 +357,20 @@ func sgnicGet(d *schema.ResourceData, meta interface{}, sgID, nicID string) erro
 	}
 	sg, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Security Group : %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Getting Security Group")
 	}
 	d.Set(RelatedCRN, *sg.CRN)
 	return nil
 }
 
-func resourceIBMISSecurityGroupNetworkInterfaceAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupNetworkInterfaceAttachmentDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	sgID := parts[0]
@@ -368,12 +378,12 @@ func resourceIBMISSecurityGroupNetworkInterfaceAttachmentDelete(d *schema.Resour
 	if userDetails.generation == 1 {
 		err := classicSgnicDelete(d, meta, sgID, nicID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := sgnicDelete(d, meta, sgID, nicID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	d.SetId("")
@@ -396,7 +406,7 @@ func classicSgnicDelete(d *schema.ResourceData, meta interface{}, sgID, nicID st
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting NetworkInterface(%s) for the SecurityGroup (%s) : %s\n%s", nicID, sgID, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error getting NetworkInterface(%s) for the SecurityGroup (%s)", nicID, sgID)
 	}
 
 	removeSecurityGroupNetworkInterfaceOptions := &vpcclassicv1.RemoveSecurityGroupNetworkInterfaceOptions{
@@ -405,7 +415,7 @@ func classicSgnicDelete(d *schema.ResourceData, meta interface{}, sgID, nicID st
 	}
 	response, err = sess.RemoveSecurityGroupNetworkInterface(removeSecurityGroupNetworkInterfaceOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting NetworkInterface(%s) for the SecurityGroup (%s) : %s\n%s", nicID, sgID, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Deleting NetworkInterface(%s) for the SecurityGroup (%s)", nicID, sgID)
 	}
 	d.SetId("")
 	return nil
@@ -427,7 +437,7 @@ func sgnicDelete(d *schema.ResourceData, meta interface{}, sgID, nicID string) e
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting NetworkInterface(%s) for the SecurityGroup (%s) : %s\n%s", nicID, sgID, err, response)
+		return apiErrorf("vpc", err, response, "Error getting NetworkInterface(%s) for the SecurityGroup (%s)", nicID, sgID)
 	}
 
 	removeSecurityGroupNetworkInterfaceOptions := &vpcv1.RemoveSecurityGroupNetworkInterfaceOptions{
@@ -436,7 +446,7 @@ func sgnicDelete(d *schema.ResourceData, meta interface{}, sgID, nicID string) e
 	}
 	response, err = sess.RemoveSecurityGroupNetworkInterface(removeSecurityGroupNetworkInterfaceOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting NetworkInterface(%s) for the SecurityGroup (%s) : %s\n%s", nicID, sgID, err, response)
+		return apiErrorf("vpc", err, response, "Error Deleting NetworkInterface(%s) for the SecurityGroup (%s)", nicID, sgID)
 	}
 	d.SetId("")
 	return nil
@@ -477,7 +487,7 @@ func classicSgnicExists(d *schema.ResourceData, meta interface{}, sgID, nicID st
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting NetworkInterface(%s) for the SecurityGroup (%s) : %s\n%s", nicID, sgID, err, response)
+		return false, apiErrorf("vpc_classic", err, response, "Error getting NetworkInterface(%s) for the SecurityGroup (%s)", nicID, sgID)
 	}
 	return true, nil
 }
@@ -496,7 +506,7 @@ func sgnicExists(d *schema.ResourceData, meta interface{}, sgID, nicID string) (
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting NetworkInterface(%s) for the SecurityGroup (%s) : %s\n%s", nicID, sgID, err, response)
+		return false, apiErrorf("vpc", err, response, "Error getting NetworkInterface(%s) for the SecurityGroup (%s)", nicID, sgID)
 	}
 	return true, nil
 }
@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strings"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -33,12 +35,11 @@ const (
 func resourceIBMISSecurityGroupRule() *schema.Resource {
 
 	return &schema.Resource{
-		Create:   resourceIBMISSecurityGroupRuleCreate,
-		Read:     resourceIBMISSecurityGroupRuleRead,
-		Update:   resourceIBMISSecurityGroupRuleUpdate,
-		Delete:   resourceIBMISSecurityGroupRuleDelete,
-		Exists:   resourceIBMISSecurityGroupRuleExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISSecurityGroupRuleCreate,
+		ReadContext:   resourceIBMISSecurityGroupRuleRead,
+		UpdateContext: resourceIBMISSecurityGroupRuleUpdate,
+		DeleteContext: resourceIBMISSecurityGroupRuleDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 
@@ -225,24 +226,24 @@ func resourceIBMISSecurityGroupRuleValidator() *ResourceValidator {
 	return &ibmISSecurityGroupRuleResourceValidator
 }
 
-func resourceIBMISSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupRuleCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if userDetails.generation == 1 {
 		err := classicSgRuleCreate(d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := sgRuleCreate(d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return err
+	return diag.FromErr(err)
 }
 
 func classicSgRuleCreate(d *schema.ResourceData, meta interface{}) error {
@@ -265,7 +266,7 @@ func classicSgRuleCreate(d *schema.ResourceData, meta interface{}) error {
 
 	rule, response, err := sess.CreateSecurityGroupRule(options)
 	if err != nil {
-		return fmt.Errorf("Error while creating Security Group Rule %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error while creating Security Group Rule")
 	}
 	switch reflect.TypeOf(rule).String() {
 	case "*vpcclassicv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp":
@@ -313,7 +314,7 @@ func sgRuleCreate(d *schema.ResourceData, meta interface{}) error {
 
 	rule, response, err := sess.CreateSecurityGroupRule(options)
 	if err != nil {
-		return fmt.Errorf("Error while creating Security Group Rule %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error while creating Security Group Rule")
 	}
 	switch reflect.TypeOf(rule).String() {
 	case "*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp":
@@ -341,24 +342,33 @@ func sgRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMISSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupRuleRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMISSecurityGroupRuleExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	secgrpID, ruleID, err := parseISTerraformID(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if userDetails.generation == 1 {
 		err := classicSgRuleGet(d, meta, secgrpID, ruleID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := sgRuleGet(d, meta, secgrpID, ruleID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -379,7 +389,7 @@ func classicSgRuleGet(d *schema.ResourceData, meta interface{}, secgrpID, ruleID
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Security Group Rule (%s): %s\n%s", ruleID, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting Security Group Rule (%s)", ruleID)
 	}
 
 	d.Set(isSecurityGroupID, secgrpID)
@@ -388,7 +398,7 @@ func classicSgRuleGet(d *schema.ResourceData, meta interface{}, secgrpID, ruleID
 	}
 	sg, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Security Group : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting Security Group")
 	}
 	d.Set(RelatedCRN, *sg.CRN)
 	switch reflect.TypeOf(sgrule).String() {
@@ -500,7 +510,7 @@ func sgRuleGet(d *schema.ResourceData, meta interface{}, secgrpID, ruleID string
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Security Group Rule (%s): %s\n%s", ruleID, err, response)
+		return apiErrorf("vpc", err, response, "Error Getting Security Group Rule (%s)", ruleID)
 	}
 	d.Set(isSecurityGroupID, secgrpID)
 	getSecurityGroupOptions := &vpcv1.GetSecurityGroupOptions{
@@ -508,7 +518,7 @@ func sgRuleGet(d *schema.ResourceData, meta interface{}, secgrpID, ruleID string
 	}
 	sg, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Security Group : %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Getting Security Group")
 	}
 	d.Set(RelatedCRN, *sg.CRN)
 	switch reflect.TypeOf(sgrule).String() {
@@ -605,23 +615,23 @@ func sgRuleGet(d *schema.ResourceData, meta interface{}, secgrpID, ruleID string
 	return nil
 }
 
-func resourceIBMISSecurityGroupRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupRuleUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if userDetails.generation == 1 {
 		err := classicSgRuleUpdate(d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := sgRuleUpdate(d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceIBMISSecurityGroupRuleRead(d, meta)
+	return resourceIBMISSecurityGroupRuleRead(context, d, meta)
 }
 
 func classicSgRuleUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -644,7 +654,7 @@ func classicSgRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 	_, response, err := sess.UpdateSecurityGroupRule(updateSecurityGroupRuleOptions)
 	if err != nil {
-		return fmt.Errorf("Error Updating Security Group Rule : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Updating Security Group Rule")
 	}
 	return nil
 }
@@ -665,19 +675,19 @@ func sgRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 	updateSecurityGroupRuleOptions := sgTemplate
 	_, response, err := sess.UpdateSecurityGroupRule(updateSecurityGroupRuleOptions)
 	if err != nil {
-		return fmt.Errorf("Error Updating Security Group Rule : %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Updating Security Group Rule")
 	}
 	return nil
 }
 
-func resourceIBMISSecurityGroupRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupRuleDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	secgrpID, ruleID, err := parseISTerraformID(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	isSecurityGroupRuleKey := "security_group_rule_key_" + secgrpID
@@ -687,12 +697,12 @@ func resourceIBMISSecurityGroupRuleDelete(d *schema.ResourceData, meta interface
 	if userDetails.generation == 1 {
 		err := classicSgRuleDelete(d, meta, secgrpID, ruleID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := sgRuleDelete(d, meta, secgrpID, ruleID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	d.SetId("")
@@ -716,7 +726,7 @@ func classicSgRuleDelete(d *schema.ResourceData, meta interface{}, secgrpID, rul
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Security Group Rule (%s): %s\n%s", ruleID, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting Security Group Rule (%s)", ruleID)
 	}
 
 	deleteSecurityGroupRuleOptions := &vpcclassicv1.DeleteSecurityGroupRuleOptions{
@@ -725,7 +735,7 @@ func classicSgRuleDelete(d *schema.ResourceData, meta interface{}, secgrpID, rul
 	}
 	response, err = sess.DeleteSecurityGroupRule(deleteSecurityGroupRuleOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Security Group Rule : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Deleting Security Group Rule")
 	}
 	d.SetId("")
 	return nil
@@ -748,7 +758,7 @@ func sgRuleDelete(d *schema.ResourceData, meta interface{}, secgrpID, ruleID str
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Security Group Rule (%s): %s\n%s", ruleID, err, response)
+		return apiErrorf("vpc", err, response, "Error Getting Security Group Rule (%s)", ruleID)
 	}
 
 	deleteSecurityGroupRuleOptions := &vpcv1.DeleteSecurityGroupRuleOptions{
@@ -757,7 +767,7 @@ func sgRuleDelete(d *schema.ResourceData, meta interface{}, secgrpID, ruleID str
 	}
 	response, err = sess.DeleteSecurityGroupRule(deleteSecurityGroupRuleOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Security Group Rule : %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Deleting Security Group Rule")
 	}
 	d.SetId("")
 	return nil
@@ -795,7 +805,7 @@ func classicSgRuleExists(d *schema.ResourceData, meta interface{}, secgrpID, rul
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Security Group Rule (%s): %s\n%s", ruleID, err, response)
+		return false, apiErrorf("vpc_classic", err, response, "Error getting Security Group Rule (%s)", ruleID)
 	}
 	return true, nil
 }
@@ -814,7 +824,7 @@ func sgRuleExists(d *schema.ResourceData, meta interface{}, secgrpID, ruleID str
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Security Group Rule (%s): %s\n%s", ruleID, err, response)
+		return false, apiErrorf("vpc", err, response, "Error getting Security Group Rule (%s)", ruleID)
 	}
 	return true, nil
 }
@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -18,11 +20,10 @@ const (
 func resourceIBMISSecurityGroupTarget() *schema.Resource {
 
 	return &schema.Resource{
-		Create:   resourceIBMISSecurityGroupTargetCreate,
-		Read:     resourceIBMISSecurityGroupTargetRead,
-		Delete:   resourceIBMISSecurityGroupTargetDelete,
-		Exists:   resourceIBMISSecurityGroupTargetExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISSecurityGroupTargetCreate,
+		ReadContext:   resourceIBMISSecurityGroupTargetRead,
+		DeleteContext: resourceIBMISSecurityGroupTargetDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 
@@ -81,11 +82,11 @@ func resourceIBMISSecurityGroupTargetValidator() *ResourceValidator {
 	return &ibmISSecurityGroupResourceValidator
 }
 
-func resourceIBMISSecurityGroupTargetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupTargetCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	securityGroupID := d.Get("security_group").(string)
@@ -97,23 +98,31 @@ func resourceIBMISSecurityGroupTargetCreate(d *schema.ResourceData, meta interfa
 
 	sg, response, err := sess.CreateSecurityGroupTargetBinding(createSecurityGroupTargetBindingOptions)
 	if err != nil || sg == nil {
-		return fmt.Errorf("error while creating Security Group Target Binding %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "error while creating Security Group Target Binding"))
 	}
 	sgtarget := sg.(*vpcv1.SecurityGroupTargetReference)
 	d.SetId(fmt.Sprintf("%s/%s", securityGroupID, *sgtarget.ID))
-	return resourceIBMISSecurityGroupTargetRead(d, meta)
+	return resourceIBMISSecurityGroupTargetRead(context, d, meta)
 }
 
-func resourceIBMISSecurityGroupTargetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupTargetRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMISSecurityGroupTargetExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
 
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	securityGroupID := parts[0]
 	securityGroupTargetID := parts[1]
@@ -129,7 +138,7 @@ func resourceIBMISSecurityGroupTargetRead(d *schema.ResourceData, meta interface
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error getting Security Group Target : %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "error getting Security Group Target"))
 	}
 
 	target := data.(*vpcv1.SecurityGroupTargetReference)
@@ -139,15 +148,15 @@ func resourceIBMISSecurityGroupTargetRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceIBMISSecurityGroupTargetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupTargetDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	securityGroupID := parts[0]
 	securityGroupTargetID := parts[1]
@@ -162,12 +171,12 @@ func resourceIBMISSecurityGroupTargetDelete(d *schema.ResourceData, meta interfa
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error Getting Security Group Targets (%s): %s\n%s", securityGroupID, err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "error Getting Security Group Targets (%s)", securityGroupID))
 	}
 	deleteSecurityGroupTargetBindingOptions := sess.NewDeleteSecurityGroupTargetBindingOptions(securityGroupID, securityGroupTargetID)
 	response, err = sess.DeleteSecurityGroupTargetBinding(deleteSecurityGroupTargetBindingOptions)
 	if err != nil {
-		return fmt.Errorf("error Deleting Security Group Targets : %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "error Deleting Security Group Targets"))
 	}
 	d.SetId("")
 	return nil
@@ -198,7 +207,7 @@ func resourceIBMISSecurityGroupTargetExists(d *schema.ResourceData, meta interfa
 			d.SetId("")
 			return false, nil
 		}
-		return false, fmt.Errorf("error getting Security Group Target : %s\n%s", err, response)
+		return false, apiErrorf("vpc", err, response, "error getting Security Group Target")
 	}
 	return true, nil
 
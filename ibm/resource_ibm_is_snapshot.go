@@ -0,0 +1,431 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isSnapshotName            = "name"
+	isSnapshotSourceVolume    = "source_volume"
+	isSnapshotResourceGroup   = "resource_group"
+	isSnapshotTags            = "tags"
+	isSnapshotCRN             = "crn"
+	isSnapshotHref            = "href"
+	isSnapshotBootable        = "bootable"
+	isSnapshotDeletable       = "deletable"
+	isSnapshotEncryption      = "encryption"
+	isSnapshotEncryptionKey   = "encryption_key"
+	isSnapshotLifecycleState  = "lifecycle_state"
+	isSnapshotMinimumCapacity = "minimum_capacity"
+	isSnapshotSize            = "size"
+	isSnapshotOperatingSystem = "operating_system"
+	isSnapshotSourceImage     = "source_image"
+	isSnapshotResourceType    = "resource_type"
+	isSnapshotCreatedAt       = "created_at"
+	isSnapshotStable          = "stable"
+	isSnapshotFailed          = "failed"
+	isSnapshotPending         = "pending"
+	isSnapshotDeleting        = "deleting"
+	isSnapshotDeleted         = "done"
+)
+
+func resourceIBMISSnapshot() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISSnapshotCreate,
+		ReadContext:   resourceIBMISSnapshotRead,
+		UpdateContext: resourceIBMISSnapshotUpdate,
+		DeleteContext: resourceIBMISSnapshotDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceTagsCustomizeDiff(diff)
+			},
+		),
+
+		Schema: map[string]*schema.Schema{
+			isSnapshotName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: InvokeValidator("ibm_is_snapshot", isSnapshotName),
+				Description:  "Snapshot name",
+			},
+
+			isSnapshotSourceVolume: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of the volume to snapshot",
+			},
+
+			isSnapshotResourceGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of the resource group of the snapshot",
+			},
+
+			isSnapshotTags: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: InvokeValidator("ibm_is_snapshot", "tag")},
+				Set:         resourceIBMVPCHash,
+				Description: "Tags for the snapshot",
+			},
+
+			isSnapshotCRN: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the snapshot",
+			},
+
+			isSnapshotHref: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the snapshot",
+			},
+
+			isSnapshotBootable: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether an instance can be provisioned from the snapshot, when its source volume has an operating system",
+			},
+
+			isSnapshotDeletable: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the snapshot can be deleted",
+			},
+
+			isSnapshotEncryption: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of encryption of the snapshot, provider_managed or user_managed",
+			},
+
+			isSnapshotEncryptionKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the root key of the snapshot, for user_managed encryption",
+			},
+
+			isSnapshotLifecycleState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the snapshot",
+			},
+
+			isSnapshotMinimumCapacity: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The minimum capacity, in gigabytes, of a volume created from the snapshot",
+			},
+
+			isSnapshotSize: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The size of the snapshot, in gigabytes",
+			},
+
+			isSnapshotOperatingSystem: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the operating system of a bootable snapshot",
+			},
+
+			isSnapshotSourceImage: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the image the source volume was created from",
+			},
+
+			isSnapshotResourceType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type of the snapshot",
+			},
+
+			isSnapshotCreatedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time the snapshot was created",
+			},
+		},
+	}
+}
+
+func resourceIBMISSnapshotValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isSnapshotName,
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63})
+
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "tag",
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Optional:                   true,
+			Regexp:                     `^[A-Za-z0-9:_ .-]+$`,
+			MinValueLength:             1,
+			MaxValueLength:             128})
+
+	ibmISSnapshotResourceValidator := ResourceValidator{ResourceName: "ibm_is_snapshot", Schema: validateSchema}
+	return &ibmISSnapshotResourceValidator
+}
+
+func resourceIBMISSnapshotCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	prototype := &vpcSnapshotPrototype{
+		Name:         d.Get(isSnapshotName).(string),
+		SourceVolume: vpcReference{ID: d.Get(isSnapshotSourceVolume).(string)},
+	}
+	if rg, ok := d.GetOk(isSnapshotResourceGroup); ok {
+		prototype.ResourceGroup = &vpcReference{ID: rg.(string)}
+	}
+	snapshot := &vpcSnapshot{}
+	response, err := vpcRequest(sess, vpcRequestOptions{Method: core.POST, Path: "/snapshots", Body: prototype}, snapshot)
+	if err != nil {
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error creating snapshot of volume %s", prototype.SourceVolume.ID))
+	}
+	d.SetId(snapshot.ID)
+	log.Printf("[INFO] Snapshot : %s", snapshot.ID)
+
+	_, err = isWaitForSnapshotAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	v := os.Getenv("IC_ENV_TAGS")
+	if _, ok := d.GetOk(isSnapshotTags); ok || v != "" {
+		oldList, newList := d.GetChange(isSnapshotTags)
+		err = UpdateTagsUsingCRN(oldList, newList, meta, snapshot.CRN)
+		if err != nil {
+			log.Printf(
+				"Error on create of resource snapshot (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
+		}
+	}
+	return append(diags, resourceIBMISSnapshotRead(context, d, meta)...)
+}
+
+func resourceIBMISSnapshotRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	snapshot, response, err := getSnapshot(sess, d.Id())
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error getting snapshot (%s)", d.Id()))
+	}
+	if err := setSnapshot(d, snapshot); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set(isSnapshotSourceVolume, snapshot.SourceVolume.ID)
+	d.Set(isSnapshotResourceGroup, snapshot.ResourceGroup.ID)
+
+	tags, err := GetTagsUsingCRN(meta, snapshot.CRN)
+	if err != nil {
+		log.Printf(
+			"Error on get of resource snapshot (%s) tags: %s", d.Id(), err)
+	}
+	d.Set(isSnapshotTags, tags)
+	return nil
+}
+
+func resourceIBMISSnapshotUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChange(isSnapshotTags) {
+		oldList, newList := d.GetChange(isSnapshotTags)
+		err = UpdateTagsUsingCRN(oldList, newList, meta, d.Get(isSnapshotCRN).(string))
+		if err != nil {
+			log.Printf(
+				"Error on update of resource snapshot (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
+		}
+	}
+	if d.HasChange(isSnapshotName) {
+		options := vpcRequestOptions{
+			Method:     core.PATCH,
+			Path:       "/snapshots/{id}",
+			PathParams: map[string]string{"id": d.Id()},
+			Body:       map[string]interface{}{"name": d.Get(isSnapshotName).(string)},
+		}
+		response, err := vpcRequest(sess, options, nil)
+		if err != nil {
+			return append(diags, diag.FromErr(apiErrorf("vpc", err, response, "Error updating snapshot (%s)", d.Id()))...)
+		}
+	}
+	return append(diags, resourceIBMISSnapshotRead(context, d, meta)...)
+}
+
+func resourceIBMISSnapshotDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	options := vpcRequestOptions{
+		Method:     core.DELETE,
+		Path:       "/snapshots/{id}",
+		PathParams: map[string]string{"id": d.Id()},
+	}
+	response, err := vpcRequest(sess, options, nil)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error deleting snapshot (%s)", d.Id()))
+	}
+	_, err = isWaitForSnapshotDeleted(context, sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getSnapshot(sess *vpcv1.VpcV1, id string) (*vpcSnapshot, *core.DetailedResponse, error) {
+	snapshot := &vpcSnapshot{}
+	options := vpcRequestOptions{
+		Method:     core.GET,
+		Path:       "/snapshots/{id}",
+		PathParams: map[string]string{"id": id},
+	}
+	response, err := vpcRequest(sess, options, snapshot)
+	if err != nil {
+		return nil, response, err
+	}
+	return snapshot, response, nil
+}
+
+// setSnapshot sets the attributes of a snapshot shared by the resource and the data
+// sources.
+func setSnapshot(d *schema.ResourceData, snapshot *vpcSnapshot) error {
+	d.Set(isSnapshotName, snapshot.Name)
+	d.Set(isSnapshotCRN, snapshot.CRN)
+	d.Set(isSnapshotHref, snapshot.Href)
+	d.Set(isSnapshotBootable, snapshot.Bootable)
+	d.Set(isSnapshotDeletable, snapshot.Deletable)
+	d.Set(isSnapshotEncryption, snapshot.Encryption)
+	d.Set(isSnapshotLifecycleState, snapshot.LifecycleState)
+	d.Set(isSnapshotMinimumCapacity, snapshot.MinimumCapacity)
+	d.Set(isSnapshotSize, snapshot.Size)
+	d.Set(isSnapshotResourceType, snapshot.ResourceType)
+	d.Set(isSnapshotCreatedAt, snapshot.CreatedAt)
+	encryptionKey, operatingSystem, sourceImage := "", "", ""
+	if snapshot.EncryptionKey != nil {
+		encryptionKey = snapshot.EncryptionKey.CRN
+	}
+	if snapshot.OperatingSystem != nil {
+		operatingSystem = snapshot.OperatingSystem.Name
+	}
+	if snapshot.SourceImage != nil {
+		sourceImage = snapshot.SourceImage.ID
+	}
+	d.Set(isSnapshotEncryptionKey, encryptionKey)
+	d.Set(isSnapshotOperatingSystem, operatingSystem)
+	if err := d.Set(isSnapshotSourceImage, sourceImage); err != nil {
+		return fmt.Errorf("Error setting snapshot %s: %s", snapshot.ID, err)
+	}
+	return nil
+}
+
+func isWaitForSnapshotAvailable(context context.Context, sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for snapshot (%s) to be available.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"retry", isSnapshotPending},
+		Target:     []string{isSnapshotStable, isSnapshotFailed},
+		Refresh:    isSnapshotRefreshFunc(sess, id),
+		Timeout:    timeout,
+		Delay:      vpcWaitDelay,
+		MinTimeout: vpcWaitMinTimeout,
+	}
+
+	snapshot, err := stateConf.WaitForStateContext(context)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot.(*vpcSnapshot).LifecycleState == isSnapshotFailed {
+		return snapshot, fmt.Errorf("Snapshot (%s) creation failed", id)
+	}
+	return snapshot, nil
+}
+
+func isSnapshotRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		snapshot, response, err := getSnapshot(sess, id)
+		if err != nil {
+			return nil, "", apiErrorf("vpc", err, response, "Error getting snapshot")
+		}
+		if snapshot.LifecycleState == isSnapshotStable || snapshot.LifecycleState == isSnapshotFailed {
+			return snapshot, snapshot.LifecycleState, nil
+		}
+		return snapshot, isSnapshotPending, nil
+	}
+}
+
+func isWaitForSnapshotDeleted(context context.Context, sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for snapshot (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"retry", isSnapshotDeleting},
+		Target:  []string{isSnapshotDeleted},
+		Refresh: func() (interface{}, string, error) {
+			snapshot, response, err := getSnapshot(sess, id)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return id, isSnapshotDeleted, nil
+				}
+				return nil, "", apiErrorf("vpc", err, response, "Error getting snapshot")
+			}
+			return snapshot, isSnapshotDeleting, nil
+		},
+		Timeout:    timeout,
+		Delay:      vpcWaitDelay,
+		MinTimeout: vpcWaitMinTimeout,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
@@ -0,0 +1,334 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
+)
+
+func TestAccIBMISSnapshot_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-instance-%d", acctest.RandIntRange(10, 100))
+	snapshotname := fmt.Sprintf("tf-snapshot-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: checkSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISSnapshotConfig(vpcname, subnetname, name, snapshotname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_snapshot.testacc_snapshot", "name", snapshotname),
+					resource.TestCheckResourceAttr("ibm_is_snapshot.testacc_snapshot", "bootable", "true"),
+					resource.TestCheckResourceAttr("ibm_is_snapshot.testacc_snapshot", "lifecycle_state", "stable"),
+					resource.TestCheckResourceAttrPair("ibm_is_snapshot.testacc_snapshot", "source_image", "ibm_is_instance.testacc_instance", "image"),
+					resource.TestCheckResourceAttrPair("ibm_is_instance.testacc_restored", "boot_volume.0.snapshot", "ibm_is_snapshot.testacc_snapshot", "id"),
+					resource.TestCheckResourceAttr("ibm_is_instance.testacc_restored", "status", "running"),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_snapshot.testacc_snapshot",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func checkSnapshotDestroy(s *terraform.State) error {
+	sess, err := vpcClient(testAccProvider.Meta())
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_snapshot" {
+			continue
+		}
+		if _, _, err := getSnapshot(sess, rs.Primary.ID); err == nil {
+			return fmt.Errorf("snapshot still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISSnapshotConfig(vpcname, subnetname, name, snapshotname string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+	}
+
+	resource "ibm_is_instance" "testacc_instance" {
+		name    = "%s"
+		image   = "%s"
+		profile = "%s"
+		primary_network_interface {
+			subnet = ibm_is_subnet.testacc_subnet.id
+		}
+		vpc  = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+	}
+
+	resource "ibm_is_snapshot" "testacc_snapshot" {
+		name          = "%s"
+		source_volume = ibm_is_instance.testacc_instance.boot_volume.0.volume_id
+	}
+
+	resource "ibm_is_instance" "testacc_restored" {
+		name    = "%s-restored"
+		profile = "%s"
+		boot_volume {
+			snapshot = ibm_is_snapshot.testacc_snapshot.id
+		}
+		primary_network_interface {
+			subnet = ibm_is_subnet.testacc_subnet.id
+		}
+		vpc  = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+	}`, vpcname, subnetname, ISZoneName, ISCIDR, name, isImage, instanceProfileName, ISZoneName, snapshotname, name, instanceProfileName, ISZoneName)
+}
+
+func TestIBMISSnapshotMock(t *testing.T) {
+	t.Parallel()
+	srv := testMockServer(t)
+	meta := testMockClientSession(t, srv)
+	sess, err := vpcClient(meta)
+	assert.NilError(t, err)
+	instanceResource := resourceIBMISInstance()
+	r := resourceIBMISSnapshot()
+
+	vpcID, subnetID := testMockSubnet(t, meta)
+	instanceConfig := func(name string, boot map[string]interface{}) map[string]interface{} {
+		raw := map[string]interface{}{
+			isInstanceName:    name,
+			isInstanceProfile: "bx2-2x8",
+			isInstanceVPC:     vpcID,
+			isInstanceZone:    "us-south-1",
+			isInstancePrimaryNetworkInterface: []interface{}{map[string]interface{}{
+				isInstanceNicSubnet: subnetID,
+			}},
+			isInstanceBootVolume: []interface{}{boot},
+		}
+		if image, ok := boot[isInstanceImage]; ok {
+			raw[isInstanceImage] = image
+			delete(boot, isInstanceImage)
+		}
+		return raw
+	}
+	source := testMockInstance(t, meta, instanceConfig("mock-source", map[string]interface{}{
+		isInstanceImage:    "mock-image",
+		isInstanceBootName: "mock-source-boot",
+	}))
+	bootVolumeID := source.Get("boot_volume.0.volume_id").(string)
+	assert.Assert(t, bootVolumeID != "")
+	assert.Equal(t, source.Get(isInstanceImage), "mock-image")
+	assert.Equal(t, source.Get("boot_volume.0.snapshot"), "")
+	assert.Equal(t, source.Get("boot_volume.0.source_volume"), "")
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		isSnapshotName:         "mock-snapshot",
+		isSnapshotSourceVolume: bootVolumeID,
+	})
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), d, meta)))
+	assert.Equal(t, d.Get(isSnapshotLifecycleState), "stable")
+	assert.Equal(t, d.Get(isSnapshotBootable), true)
+	assert.Equal(t, d.Get(isSnapshotSize), 100)
+	assert.Equal(t, d.Get(isSnapshotSourceImage), "mock-image")
+	assert.Equal(t, d.Get(isSnapshotOperatingSystem), "mock-os")
+	assert.Equal(t, d.Get(isSnapshotResourceGroup), "mockserver-default-resource-group")
+
+	t.Run("boot", func(t *testing.T) {
+		t.Run("snapshot", func(t *testing.T) {
+			t.Parallel()
+			restored := testMockInstance(t, meta, instanceConfig("mock-restored", map[string]interface{}{
+				isInstanceBootSnapshot: d.Id(),
+				isInstanceBootName:     "mock-restored-boot",
+				isInstanceBootProfile:  "10iops-tier",
+			}))
+			assert.Equal(t, restored.Get(isInstanceStatus), "running")
+			assert.Equal(t, restored.Get(isInstanceImage), "")
+			assert.Equal(t, restored.Get("boot_volume.0.snapshot"), d.Id())
+			assert.Equal(t, restored.Get("boot_volume.0.name"), "mock-restored-boot")
+			assert.Equal(t, restored.Get("boot_volume.0.size"), 100)
+			assert.Equal(t, restored.Get("boot_volume.0.profile"), "10iops-tier")
+
+			// The boot source is kept on refresh, and changing it replaces the
+			// instance
+			refreshed := instanceResource.Data(restored.State())
+			assert.NilError(t, testDiagsErr(instanceResource.ReadContext(context.Background(), refreshed, meta)))
+			assert.Equal(t, refreshed.Get("boot_volume.0.snapshot"), d.Id())
+			assert.Equal(t, refreshed.Get("boot_volume.0.source_volume"), "")
+			diff, err := instanceResource.Diff(context.Background(), restored.State(), terraform.NewResourceConfigRaw(instanceConfig("mock-restored", map[string]interface{}{
+				isInstanceBootSnapshot: "mock-other-snapshot",
+				isInstanceBootName:     "mock-restored-boot",
+				isInstanceBootProfile:  "10iops-tier",
+			})), meta)
+			assert.NilError(t, err)
+			assert.Assert(t, diff.RequiresNew(), "%v", diff)
+
+			// The restored boot volume goes with the instance
+			restoredVolumeID := restored.Get("boot_volume.0.volume_id").(string)
+			assert.NilError(t, testDiagsErr(instanceResource.DeleteContext(context.Background(), restored, meta)))
+			_, response, err := sess.GetVolume(&vpcv1.GetVolumeOptions{ID: &restoredVolumeID})
+			assert.ErrorContains(t, err, "not found")
+			assert.Equal(t, response.StatusCode, 404)
+		})
+
+		t.Run("source_volume", func(t *testing.T) {
+			t.Parallel()
+			volume := map[string]interface{}{}
+			_, err := vpcRequest(sess, vpcRequestOptions{
+				Method: core.POST,
+				Path:   "/volumes",
+				Body: map[string]interface{}{
+					"name":            "mock-bootable",
+					"zone":            map[string]interface{}{"name": "us-south-1"},
+					"profile":         map[string]interface{}{"name": "general-purpose"},
+					"source_snapshot": map[string]interface{}{"id": d.Id()},
+				},
+			}, &volume)
+			assert.NilError(t, err)
+			volumeID := volume["id"].(string)
+
+			attached := testMockInstance(t, meta, instanceConfig("mock-attached", map[string]interface{}{
+				isInstanceBootSourceVolume: volumeID,
+			}))
+			assert.Equal(t, attached.Get("boot_volume.0.volume_id"), volumeID)
+			assert.Equal(t, attached.Get("boot_volume.0.name"), "mock-bootable")
+			refreshed := instanceResource.Data(attached.State())
+			assert.NilError(t, testDiagsErr(instanceResource.ReadContext(context.Background(), refreshed, meta)))
+			assert.Equal(t, refreshed.Get("boot_volume.0.source_volume"), volumeID)
+			assert.Equal(t, refreshed.Get("boot_volume.0.snapshot"), "")
+
+			// The existing volume is kept, without waiting for its deletion
+			assert.NilError(t, testDiagsErr(instanceResource.DeleteContext(context.Background(), attached, meta)))
+			vol, _, err := sess.GetVolume(&vpcv1.GetVolumeOptions{ID: &volumeID})
+			assert.NilError(t, err)
+			assert.Equal(t, len(vol.VolumeAttachments), 0)
+		})
+
+		t.Run("auto_delete", func(t *testing.T) {
+			t.Parallel()
+			raw := instanceConfig("mock-kept-boot", map[string]interface{}{
+				isInstanceImage: "mock-image",
+			})
+			kept := testMockInstance(t, meta, raw)
+			instanceID := kept.Id()
+			attachmentID := kept.Get("boot_volume.0.volume_id").(string)
+			attachments, _, err := sess.ListInstanceVolumeAttachments(&vpcv1.ListInstanceVolumeAttachmentsOptions{InstanceID: &instanceID})
+			assert.NilError(t, err)
+			for _, attachment := range attachments.VolumeAttachments {
+				if *attachment.Volume.ID == attachmentID {
+					attachmentID = *attachment.ID
+				}
+			}
+			patch, err := (&vpcv1.VolumeAttachmentPatch{DeleteVolumeOnInstanceDelete: core.BoolPtr(false)}).AsPatch()
+			assert.NilError(t, err)
+			_, _, err = sess.UpdateInstanceVolumeAttachment(&vpcv1.UpdateInstanceVolumeAttachmentOptions{
+				InstanceID:            &instanceID,
+				ID:                    &attachmentID,
+				VolumeAttachmentPatch: patch,
+			})
+			assert.NilError(t, err)
+
+			// An image booted instance doesn't become a source_volume one when its
+			// boot volume is no longer deleted with it
+			refreshed := instanceResource.Data(kept.State())
+			assert.NilError(t, testDiagsErr(instanceResource.ReadContext(context.Background(), refreshed, meta)))
+			assert.Equal(t, refreshed.Get("boot_volume.0.source_volume"), "")
+			assert.Equal(t, refreshed.Get("boot_volume.0.snapshot"), "")
+			diff, err := instanceResource.Diff(context.Background(), refreshed.State(), terraform.NewResourceConfigRaw(raw), meta)
+			assert.NilError(t, err)
+			assert.Assert(t, diff == nil || diff.Empty(), "%v", diff)
+		})
+
+		t.Run("data_sources", func(t *testing.T) {
+			t.Parallel()
+			ds := dataSourceIBMISSnapshot()
+			byName := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{isSnapshotName: "mock-snapshot"})
+			assert.NilError(t, testDiagsErr(ds.ReadContext(context.Background(), byName, meta)))
+			assert.Equal(t, byName.Id(), d.Id())
+			assert.Equal(t, byName.Get(isSnapshotSourceVolume), bootVolumeID)
+
+			byID := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{isSnapshotIdentifier: d.Id()})
+			assert.NilError(t, testDiagsErr(ds.ReadContext(context.Background(), byID, meta)))
+			assert.Equal(t, byID.Get(isSnapshotName), "mock-snapshot")
+
+			missing := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{isSnapshotName: "mock-missing"})
+			assert.ErrorContains(t, testDiagsErr(ds.ReadContext(context.Background(), missing, meta)), "No snapshot found")
+
+			list := dataSourceIBMISSnapshots()
+			all := schema.TestResourceDataRaw(t, list.Schema, map[string]interface{}{isSnapshotSourceVolume: bootVolumeID})
+			assert.NilError(t, testDiagsErr(list.ReadContext(context.Background(), all, meta)))
+			assert.Equal(t, all.Get("snapshots.#"), 1)
+			assert.Equal(t, all.Get("snapshots.0.id"), d.Id())
+			assert.Equal(t, all.Get("snapshots.0.bootable"), true)
+		})
+
+		t.Run("validation", func(t *testing.T) {
+			t.Parallel()
+			// the arguments are validated, then the boot source is checked at plan time
+			plan := func(boot map[string]interface{}) error {
+				raw := instanceConfig("mock-invalid", boot)
+				raw[isInstanceKeys] = []interface{}{"mock-key"}
+				config := terraform.NewResourceConfigRaw(raw)
+				if err := testDiagsErr(instanceResource.Validate(config)); err != nil {
+					return err
+				}
+				_, err := instanceResource.Diff(context.Background(), nil, config, meta)
+				return err
+			}
+			assert.NilError(t, plan(map[string]interface{}{isInstanceBootSnapshot: d.Id()}))
+			assert.ErrorContains(t, plan(map[string]interface{}{
+				isInstanceImage:        "mock-image",
+				isInstanceBootSnapshot: d.Id(),
+			}), "conflicts with")
+			assert.ErrorContains(t, plan(map[string]interface{}{
+				isInstanceBootName: "mock-boot",
+			}), "must be specified")
+		})
+	})
+
+	updated := testMockResourceData(t, r, d, map[string]interface{}{
+		isSnapshotName:         "mock-snapshot-renamed",
+		isSnapshotSourceVolume: bootVolumeID,
+	})
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), updated, meta)))
+	assert.Equal(t, updated.Get(isSnapshotName), "mock-snapshot-renamed")
+
+	// The import passes the ID through to read
+	imported := r.Data(nil)
+	imported.SetId(d.Id())
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), imported, meta)))
+	assert.Equal(t, imported.Get(isSnapshotSourceVolume), bootVolumeID)
+	assert.Equal(t, imported.Get(isSnapshotName), "mock-snapshot-renamed")
+
+	assert.NilError(t, testDiagsErr(r.DeleteContext(context.Background(), updated, meta)))
+	assert.Equal(t, updated.Id(), "")
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), imported, meta)))
+	assert.Equal(t, imported.Id(), "")
+
+	source.Set(isEnableCleanDelete, false)
+	assert.NilError(t, testDiagsErr(instanceResource.DeleteContext(context.Background(), source, meta)))
+	_, response, err := sess.GetVolume(&vpcv1.GetVolumeOptions{ID: &bootVolumeID})
+	assert.Assert(t, err != nil)
+	assert.Equal(t, response.StatusCode, 404)
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -27,12 +28,11 @@ const (
 
 func resourceIBMISSSHKey() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISSSHKeyCreate,
-		Read:     resourceIBMISSSHKeyRead,
-		Update:   resourceIBMISSSHKeyUpdate,
-		Delete:   resourceIBMISSSHKeyDelete,
-		Exists:   resourceIBMISSSHKeyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISSSHKeyCreate,
+		ReadContext:   resourceIBMISSSHKeyRead,
+		UpdateContext: resourceIBMISSSHKeyUpdate,
+		DeleteContext: resourceIBMISSSHKeyDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
@@ -144,33 +144,35 @@ func resourceIBMISSHKeyValidator() *ResourceValidator {
 	return &ibmISSSHKeyResourceValidator
 }
 
-func resourceIBMISSSHKeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSSHKeyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	log.Printf("[DEBUG] Key create")
 	name := d.Get(isKeyName).(string)
 	publickey := d.Get(isKeyPublicKey).(string)
 
 	if userDetails.generation == 1 {
-		err := classicKeyCreate(d, meta, name, publickey)
-		if err != nil {
-			return err
+		diags = append(diags, classicKeyCreate(d, meta, name, publickey)...)
+		if diags.HasError() {
+			return diags
 		}
 	} else {
-		err := keyCreate(d, meta, name, publickey)
-		if err != nil {
-			return err
+		diags = append(diags, keyCreate(d, meta, name, publickey)...)
+		if diags.HasError() {
+			return diags
 		}
 	}
-	return resourceIBMISSSHKeyRead(d, meta)
+	return append(diags, resourceIBMISSSHKeyRead(context, d, meta)...)
 }
 
-func classicKeyCreate(d *schema.ResourceData, meta interface{}, name, publickey string) error {
+func classicKeyCreate(d *schema.ResourceData, meta interface{}, name, publickey string) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := classicVpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	options := &vpcclassicv1.CreateKeyOptions{
 		PublicKey: &publickey,
@@ -186,7 +188,7 @@ func classicKeyCreate(d *schema.ResourceData, meta interface{}, name, publickey
 
 	key, response, err := sess.CreateKey(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Create SSH Key %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc_classic", err, response, "Error creating SSH Key"))
 	}
 	d.SetId(*key.ID)
 	log.Printf("[INFO] Key : %s", *key.ID)
@@ -198,15 +200,17 @@ func classicKeyCreate(d *schema.ResourceData, meta interface{}, name, publickey
 		if err != nil {
 			log.Printf(
 				"Error on create of vpc SSH Key (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
-	return nil
+	return diags
 }
 
-func keyCreate(d *schema.ResourceData, meta interface{}, name, publickey string) error {
+func keyCreate(d *schema.ResourceData, meta interface{}, name, publickey string) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	options := &vpcv1.CreateKeyOptions{
 		PublicKey: &publickey,
@@ -222,7 +226,7 @@ func keyCreate(d *schema.ResourceData, meta interface{}, name, publickey string)
 
 	key, response, err := sess.CreateKey(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Create SSH Key %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error creating SSH Key"))
 	}
 	d.SetId(*key.ID)
 	log.Printf("[INFO] Key : %s", *key.ID)
@@ -234,27 +238,37 @@ func keyCreate(d *schema.ResourceData, meta interface{}, name, publickey string)
 		if err != nil {
 			log.Printf(
 				"Error on create of vpc SSH Key (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
-	return nil
+	return diags
 }
 
-func resourceIBMISSSHKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSSHKeyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMISSSHKeyExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicKeyGet(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := keyGet(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -274,7 +288,7 @@ func classicKeyGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting SSH Key (%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting SSH Key (%s)", id)
 	}
 	d.Set(isKeyName, *key.Name)
 	d.Set(isKeyPublicKey, *key.PublicKey)
@@ -315,7 +329,7 @@ func keyGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting SSH Key (%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc", err, response, "Error Getting SSH Key (%s)", id)
 	}
 	d.Set(isKeyName, *key.Name)
 	d.Set(isKeyPublicKey, *key.PublicKey)
@@ -342,10 +356,11 @@ func keyGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISSSHKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSSHKeyUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	id := d.Id()
@@ -358,23 +373,24 @@ func resourceIBMISSSHKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if userDetails.generation == 1 {
-		err := classicKeyUpdate(d, meta, id, name, hasChanged)
-		if err != nil {
-			return err
+		diags = append(diags, classicKeyUpdate(d, meta, id, name, hasChanged)...)
+		if diags.HasError() {
+			return diags
 		}
 	} else {
-		err := keyUpdate(d, meta, id, name, hasChanged)
-		if err != nil {
-			return err
+		diags = append(diags, keyUpdate(d, meta, id, name, hasChanged)...)
+		if diags.HasError() {
+			return diags
 		}
 	}
-	return resourceIBMISSSHKeyRead(d, meta)
+	return append(diags, resourceIBMISSSHKeyRead(context, d, meta)...)
 }
 
-func classicKeyUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
+func classicKeyUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := classicVpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if d.HasChange(isKeyTags) {
 		options := &vpcclassicv1.GetKeyOptions{
@@ -382,13 +398,14 @@ func classicKeyUpdate(d *schema.ResourceData, meta interface{}, id, name string,
 		}
 		key, response, err := sess.GetKey(options)
 		if err != nil {
-			return fmt.Errorf("Error getting SSH Key : %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc_classic", err, response, "Error getting SSH Key"))
 		}
 		oldList, newList := d.GetChange(isKeyTags)
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *key.CRN)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource vpc SSH Key (%s) tags: %s", id, err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 	if hasChanged {
@@ -400,21 +417,22 @@ func classicKeyUpdate(d *schema.ResourceData, meta interface{}, id, name string,
 		}
 		keyPatch, err := keyPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for KeyPatch: %s", err)
+			return diag.FromErr(fmt.Errorf("Error calling asPatch for KeyPatch: %s", err))
 		}
 		options.KeyPatch = keyPatch
 		_, response, err := sess.UpdateKey(options)
 		if err != nil {
-			return fmt.Errorf("Error updating vpc SSH Key: %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc_classic", err, response, "Error updating vpc SSH Key"))
 		}
 	}
-	return nil
+	return diags
 }
 
-func keyUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
+func keyUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if d.HasChange(isKeyTags) {
 		options := &vpcv1.GetKeyOptions{
@@ -422,13 +440,14 @@ func keyUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		}
 		key, response, err := sess.GetKey(options)
 		if err != nil {
-			return fmt.Errorf("Error getting SSH Key : %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error getting SSH Key"))
 		}
 		oldList, newList := d.GetChange(isKeyTags)
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *key.CRN)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource vpc SSH Key (%s) tags: %s", id, err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 	if hasChanged {
@@ -440,32 +459,32 @@ func keyUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		}
 		keyPatch, err := keyPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for KeyPatch: %s", err)
+			return diag.FromErr(fmt.Errorf("Error calling asPatch for KeyPatch: %s", err))
 		}
 		options.KeyPatch = keyPatch
 		_, response, err := sess.UpdateKey(options)
 		if err != nil {
-			return fmt.Errorf("Error updating vpc SSH Key: %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error updating vpc SSH Key"))
 		}
 	}
-	return nil
+	return diags
 }
 
-func resourceIBMISSSHKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSSHKeyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicKeyDelete(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := keyDelete(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -485,7 +504,7 @@ func classicKeyDelete(d *schema.ResourceData, meta interface{}, id string) error
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error Getting SSH Key (%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting SSH Key (%s)", id)
 	}
 
 	options := &vpcclassicv1.DeleteKeyOptions{
@@ -493,7 +512,7 @@ func classicKeyDelete(d *schema.ResourceData, meta interface{}, id string) error
 	}
 	response, err = sess.DeleteKey(options)
 	if err != nil {
-		return fmt.Errorf("Error Deleting SSH Key : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Deleting SSH Key")
 	}
 	d.SetId("")
 	return nil
@@ -513,7 +532,7 @@ func keyDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error Getting SSH Key (%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc", err, response, "Error Getting SSH Key (%s)", id)
 	}
 
 	options := &vpcv1.DeleteKeyOptions{
@@ -521,7 +540,7 @@ func keyDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteKey(options)
 	if err != nil {
-		return fmt.Errorf("Error Deleting SSH Key : %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Deleting SSH Key")
 	}
 	d.SetId("")
 	return nil
@@ -556,7 +575,7 @@ func classicKeyExists(d *schema.ResourceData, meta interface{}, id string) (bool
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting SSH Key: %s\n%s", err, response)
+		return false, apiErrorf("vpc_classic", err, response, "Error getting SSH Key")
 	}
 
 	return true, nil
@@ -575,7 +594,7 @@ func keyExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting SSH Key: %s\n%s", err, response)
+		return false, apiErrorf("vpc", err, response, "Error getting SSH Key")
 	}
 	return true, nil
 }
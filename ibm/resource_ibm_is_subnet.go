@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
@@ -44,12 +45,11 @@ const (
 
 func resourceIBMISSubnet() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISSubnetCreate,
-		Read:     resourceIBMISSubnetRead,
-		Update:   resourceIBMISSubnetUpdate,
-		Delete:   resourceIBMISSubnetDelete,
-		Exists:   resourceIBMISSubnetExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISSubnetCreate,
+		ReadContext:   resourceIBMISSubnetRead,
+		UpdateContext: resourceIBMISSubnetUpdate,
+		DeleteContext: resourceIBMISSubnetDelete,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -241,10 +241,11 @@ func resourceIBMISSubnetValidator() *ResourceValidator {
 	return &ibmISSubnetResourceValidator
 }
 
-func resourceIBMISSubnetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSubnetCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	name := d.Get(isSubnetName).(string)
@@ -262,11 +263,11 @@ func resourceIBMISSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 		ipv4addrcount64 = int64(ipv4addrcount)
 	}
 	if ipv4cidr == "" && ipv4addrcount == 0 {
-		return fmt.Errorf("%s or %s need to be provided", isSubnetIpv4CidrBlock, isSubnetTotalIpv4AddressCount)
+		return diag.FromErr(fmt.Errorf("%s or %s need to be provided", isSubnetIpv4CidrBlock, isSubnetTotalIpv4AddressCount))
 	}
 
 	if ipv4cidr != "" && ipv4addrcount != 0 {
-		return fmt.Errorf("only one of %s or %s needs to be provided", isSubnetIpv4CidrBlock, isSubnetTotalIpv4AddressCount)
+		return diag.FromErr(fmt.Errorf("only one of %s or %s needs to be provided", isSubnetIpv4CidrBlock, isSubnetTotalIpv4AddressCount))
 	}
 	isSubnetKey := "subnet_key_" + vpc + "_" + zone
 	ibmMutexKV.Lock(isSubnetKey)
@@ -288,24 +289,25 @@ func resourceIBMISSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 		rtID = rt.(string)
 	}
 	if userDetails.generation == 1 {
-		err := classicSubnetCreate(d, meta, name, vpc, zone, ipv4cidr, acl, gw, ipv4addrcount64)
-		if err != nil {
-			return err
+		diags = append(diags, classicSubnetCreate(context, d, meta, name, vpc, zone, ipv4cidr, acl, gw, ipv4addrcount64)...)
+		if diags.HasError() {
+			return diags
 		}
 	} else {
-		err := subnetCreate(d, meta, name, vpc, zone, ipv4cidr, acl, gw, rtID, ipv4addrcount64)
-		if err != nil {
-			return err
+		diags = append(diags, subnetCreate(context, d, meta, name, vpc, zone, ipv4cidr, acl, gw, rtID, ipv4addrcount64)...)
+		if diags.HasError() {
+			return diags
 		}
 	}
 
-	return resourceIBMISSubnetRead(d, meta)
+	return append(diags, resourceIBMISSubnetRead(context, d, meta)...)
 }
 
-func classicSubnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv4cidr, acl, gw string, ipv4addrcount64 int64) error {
+func classicSubnetCreate(context context.Context, d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv4cidr, acl, gw string, ipv4addrcount64 int64) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := classicVpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	subnetTemplate := &vpcclassicv1.SubnetPrototype{
 		Name: &name,
@@ -340,13 +342,13 @@ func classicSubnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zo
 	subnet, response, err := sess.CreateSubnet(createSubnetOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Subnet err %s\n%s", err, response)
-		return fmt.Errorf("Error while creating Subnet %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc_classic", err, response, "Error while creating Subnet"))
 	}
 	d.SetId(*subnet.ID)
 	log.Printf("[INFO] Subnet : %s", *subnet.ID)
-	_, err = isWaitForClassicSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicSubnetAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isSubnetTags); ok || v != "" {
@@ -355,16 +357,18 @@ func classicSubnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zo
 		if err != nil {
 			log.Printf(
 				"Error on create of resource subnet (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 
-	return nil
+	return diags
 }
 
-func subnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv4cidr, acl, gw, rtID string, ipv4addrcount64 int64) error {
+func subnetCreate(context context.Context, d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv4cidr, acl, gw, rtID string, ipv4addrcount64 int64) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	subnetTemplate := &vpcv1.SubnetPrototype{
 		Name: &name,
@@ -412,13 +416,13 @@ func subnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv
 	subnet, response, err := sess.CreateSubnet(createSubnetOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Subnet err %s\n%s", err, response)
-		return fmt.Errorf("Error while creating Subnet %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error while creating Subnet"))
 	}
 	d.SetId(*subnet.ID)
 	log.Printf("[INFO] Subnet : %s", *subnet.ID)
-	_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForSubnetAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isSubnetTags); ok || v != "" {
@@ -427,12 +431,13 @@ func subnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv
 		if err != nil {
 			log.Printf(
 				"Error on create of resource subnet (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
-	return nil
+	return diags
 }
 
-func isWaitForClassicSubnetAvailable(subnetC *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicSubnetAvailable(context context.Context, subnetC *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for subnet (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -444,7 +449,7 @@ func isWaitForClassicSubnetAvailable(subnetC *vpcclassicv1.VpcClassicV1, id stri
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isClassicSubnetRefreshFunc(subnetC *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -454,7 +459,7 @@ func isClassicSubnetRefreshFunc(subnetC *vpcclassicv1.VpcClassicV1, id string) r
 		}
 		subnet, response, err := subnetC.GetSubnet(getSubnetOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error getting Subnet : %s\n%s", err, response)
+			return nil, "", apiErrorf("vpc_classic", err, response, "Error getting Subnet")
 		}
 
 		if *subnet.Status == "available" || *subnet.Status == "failed" {
@@ -465,7 +470,7 @@ func isClassicSubnetRefreshFunc(subnetC *vpcclassicv1.VpcClassicV1, id string) r
 	}
 }
 
-func isWaitForSubnetAvailable(subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSubnetAvailable(context context.Context, subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for subnet (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -477,7 +482,7 @@ func isWaitForSubnetAvailable(subnetC *vpcv1.VpcV1, id string, timeout time.Dura
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isSubnetRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -487,7 +492,7 @@ func isSubnetRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRefreshF
 		}
 		subnet, response, err := subnetC.GetSubnet(getSubnetOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error getting Subnet : %s\n%s", err, response)
+			return nil, "", apiErrorf("vpc", err, response, "Error getting Subnet")
 		}
 
 		if *subnet.Status == "available" || *subnet.Status == "failed" {
@@ -498,21 +503,30 @@ func isSubnetRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRefreshF
 	}
 }
 
-func resourceIBMISSubnetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSubnetRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMISSubnetExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicSubnetGet(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := subnetGet(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -531,7 +545,7 @@ func classicSubnetGet(d *schema.ResourceData, meta interface{}, id string) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Subnet (%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting Subnet (%s)", id)
 	}
 	d.Set(isSubnetName, *subnet.Name)
 	d.Set(isSubnetIpv4CidrBlock, *subnet.Ipv4CIDRBlock)
@@ -582,7 +596,7 @@ func subnetGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Subnet (%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc", err, response, "Error Getting Subnet (%s)", id)
 	}
 	d.Set(isSubnetName, *subnet.Name)
 	d.Set(isSubnetIPVersion, *subnet.IPVersion)
@@ -629,11 +643,12 @@ func subnetGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSubnetUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	if d.HasChange(isSubnetTags) {
@@ -642,23 +657,24 @@ func resourceIBMISSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			log.Printf(
 				"Error on update of resource subnet (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 	if userDetails.generation == 1 {
-		err := classicSubnetUpdate(d, meta, id)
+		err := classicSubnetUpdate(context, d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		err := subnetUpdate(d, meta, id)
+		err := subnetUpdate(context, d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceIBMISSubnetRead(d, meta)
+	return append(diags, resourceIBMISSubnetRead(context, d, meta)...)
 }
 
-func classicSubnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
+func classicSubnetUpdate(context context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -690,9 +706,9 @@ func classicSubnetUpdate(d *schema.ResourceData, meta interface{}, id string) er
 			}
 			response, err := sess.UnsetSubnetPublicGateway(unsetSubnetPublicGatewayOptions)
 			if err != nil {
-				return fmt.Errorf("Error Detaching the public gateway attached to the subnet : %s\n%s", err, response)
+				return apiErrorf("vpc_classic", err, response, "Error Detaching the public gateway attached to the subnet")
 			}
-			_, err = isWaitForClassicSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+			_, err = isWaitForClassicSubnetAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
 				return err
 			}
@@ -705,9 +721,9 @@ func classicSubnetUpdate(d *schema.ResourceData, meta interface{}, id string) er
 			}
 			_, response, err := sess.SetSubnetPublicGateway(setSubnetPublicGatewayOptions)
 			if err != nil {
-				return fmt.Errorf("Error Attaching public gateway to the subnet : %s\n%s", err, response)
+				return apiErrorf("vpc_classic", err, response, "Error Attaching public gateway to the subnet")
 			}
-			_, err = isWaitForClassicSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+			_, err = isWaitForClassicSubnetAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
 				return err
 			}
@@ -722,13 +738,13 @@ func classicSubnetUpdate(d *schema.ResourceData, meta interface{}, id string) er
 		updateSubnetOptions.ID = &id
 		_, response, err := sess.UpdateSubnet(updateSubnetOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating Subnet : %s\n%s", err, response)
+			return apiErrorf("vpc_classic", err, response, "Error Updating Subnet")
 		}
 	}
 	return nil
 }
 
-func subnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
+func subnetUpdate(context context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -758,9 +774,9 @@ func subnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 			}
 			response, err := sess.UnsetSubnetPublicGateway(unsetSubnetPublicGatewayOptions)
 			if err != nil {
-				return fmt.Errorf("Error Detaching the public gateway attached to the subnet : %s\n%s", err, response)
+				return apiErrorf("vpc", err, response, "Error Detaching the public gateway attached to the subnet")
 			}
-			_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+			_, err = isWaitForSubnetAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
 				return err
 			}
@@ -773,9 +789,9 @@ func subnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 			}
 			_, response, err := sess.SetSubnetPublicGateway(setSubnetPublicGatewayOptions)
 			if err != nil {
-				return fmt.Errorf("Error Attaching public gateway to the subnet : %s\n%s", err, response)
+				return apiErrorf("vpc", err, response, "Error Attaching public gateway to the subnet")
 			}
-			_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+			_, err = isWaitForSubnetAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
 				return err
 			}
@@ -809,27 +825,27 @@ func subnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 		updateSubnetOptions.ID = &id
 		_, response, err := sess.UpdateSubnet(updateSubnetOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating Subnet : %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Updating Subnet")
 		}
 	}
 	return nil
 }
 
-func resourceIBMISSubnetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSubnetDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
-		err := classicSubnetDelete(d, meta, id)
+		err := classicSubnetDelete(context, d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		err := subnetDelete(d, meta, id)
+		err := subnetDelete(context, d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -837,7 +853,7 @@ func resourceIBMISSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func classicSubnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func classicSubnetDelete(context context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -850,7 +866,7 @@ func classicSubnetDelete(d *schema.ResourceData, meta interface{}, id string) er
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error Getting Subnet (%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting Subnet (%s)", id)
 	}
 	if subnet.PublicGateway != nil {
 		unsetSubnetPublicGatewayOptions := &vpcclassicv1.UnsetSubnetPublicGatewayOptions{
@@ -860,7 +876,7 @@ func classicSubnetDelete(d *schema.ResourceData, meta interface{}, id string) er
 		if err != nil {
 			return err
 		}
-		_, err = isWaitForClassicSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+		_, err = isWaitForClassicSubnetAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 		if err != nil {
 			return err
 		}
@@ -870,9 +886,9 @@ func classicSubnetDelete(d *schema.ResourceData, meta interface{}, id string) er
 	}
 	response, err = sess.DeleteSubnet(deleteSubnetOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Subnet : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Deleting Subnet")
 	}
-	_, err = isWaitForClassicSubnetDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicSubnetDeleted(context, sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -880,7 +896,7 @@ func classicSubnetDelete(d *schema.ResourceData, meta interface{}, id string) er
 	return nil
 }
 
-func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func subnetDelete(context context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -893,7 +909,7 @@ func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error Getting Subnet (%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc", err, response, "Error Getting Subnet (%s)", id)
 	}
 	if subnet.PublicGateway != nil {
 		unsetSubnetPublicGatewayOptions := &vpcv1.UnsetSubnetPublicGatewayOptions{
@@ -903,7 +919,7 @@ func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		if err != nil {
 			return err
 		}
-		_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+		_, err = isWaitForSubnetAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 		if err != nil {
 			return err
 		}
@@ -913,9 +929,9 @@ func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteSubnet(deleteSubnetOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Subnet : %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Deleting Subnet")
 	}
-	_, err = isWaitForSubnetDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForSubnetDeleted(context, sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -923,7 +939,7 @@ func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func isWaitForClassicSubnetDeleted(subnetC *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicSubnetDeleted(context context.Context, subnetC *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for subnet (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -935,7 +951,7 @@ func isWaitForClassicSubnetDeleted(subnetC *vpcclassicv1.VpcClassicV1, id string
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isClassicSubnetDeleteRefreshFunc(subnetC *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -949,13 +965,13 @@ func isClassicSubnetDeleteRefreshFunc(subnetC *vpcclassicv1.VpcClassicV1, id str
 			if response != nil && response.StatusCode == 404 {
 				return subnet, isSubnetDeleted, nil
 			}
-			return subnet, "", fmt.Errorf("The Subnet %s failed to delete: %s\n%s", id, err, response)
+			return subnet, "", apiErrorf("vpc_classic", err, response, "The Subnet %s failed to delete", id)
 		}
 		return subnet, isSubnetDeleting, err
 	}
 }
 
-func isWaitForSubnetDeleted(subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSubnetDeleted(context context.Context, subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for subnet (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -967,7 +983,7 @@ func isWaitForSubnetDeleted(subnetC *vpcv1.VpcV1, id string, timeout time.Durati
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isSubnetDeleteRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -984,7 +1000,7 @@ func isSubnetDeleteRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRe
 			if response != nil && strings.Contains(err.Error(), "please detach all network interfaces from subnet before deleting it") {
 				return subnet, isSubnetDeleting, nil
 			}
-			return subnet, "", fmt.Errorf("The Subnet %s failed to delete: %s\n%s", id, err, response)
+			return subnet, "", apiErrorf("vpc", err, response, "The Subnet %s failed to delete", id)
 		}
 		return subnet, isSubnetDeleting, err
 	}
@@ -1018,7 +1034,7 @@ func classicSubnetExists(d *schema.ResourceData, meta interface{}, id string) (b
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Subnet: %s\n%s", err, response)
+		return false, apiErrorf("vpc_classic", err, response, "Error getting Subnet")
 	}
 	return true, nil
 }
@@ -1036,7 +1052,7 @@ func subnetExists(d *schema.ResourceData, meta interface{}, id string) (bool, er
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Subnet: %s\n%s", err, response)
+		return false, apiErrorf("vpc", err, response, "Error getting Subnet")
 	}
 	return true, nil
 }
@@ -4,12 +4,13 @@
 package ibm
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"reflect"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -20,12 +21,11 @@ const (
 
 func resourceIBMISSubnetNetworkACLAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISSubnetNetworkACLAttachmentCreate,
-		Read:     resourceIBMISSubnetNetworkACLAttachmentRead,
-		Update:   resourceIBMISSubnetNetworkACLAttachmentUpdate,
-		Delete:   resourceIBMISSubnetNetworkACLAttachmentDelete,
-		Exists:   resourceIBMISSubnetNetworkACLAttachmentExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISSubnetNetworkACLAttachmentCreate,
+		ReadContext:   resourceIBMISSubnetNetworkACLAttachmentRead,
+		UpdateContext: resourceIBMISSubnetNetworkACLAttachmentUpdate,
+		DeleteContext: resourceIBMISSubnetNetworkACLAttachmentDelete,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -188,10 +188,10 @@ func resourceIBMISSubnetNetworkACLAttachment() *schema.Resource {
 	}
 }
 
-func resourceIBMISSubnetNetworkACLAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSubnetNetworkACLAttachmentCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	subnet := d.Get(isSubnetID).(string)
@@ -208,21 +208,30 @@ func resourceIBMISSubnetNetworkACLAttachmentCreate(d *schema.ResourceData, meta
 	resultACL, response, err := sess.ReplaceSubnetNetworkACL(replaceSubnetNetworkACLOptionsModel)
 
 	if err != nil {
-		log.Printf("[DEBUG] Error while attaching a network ACL to a subnet %s\n%s", err, response)
-		return fmt.Errorf("Error while attaching a network ACL to a subnet %s\n%s", err, response)
+		log.Printf("Error while attaching a network ACL to a subnet %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error while attaching a network ACL to a subnet"))
 	}
 	d.SetId(subnet)
 	log.Printf("[INFO] Network ACL : %s", *resultACL.ID)
 	log.Printf("[INFO] Subnet ID : %s", subnet)
 
-	return resourceIBMISSubnetNetworkACLAttachmentRead(d, meta)
+	return resourceIBMISSubnetNetworkACLAttachmentRead(context, d, meta)
 }
 
-func resourceIBMISSubnetNetworkACLAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSubnetNetworkACLAttachmentRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMISSubnetNetworkACLAttachmentExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	id := d.Id()
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	getSubnetNetworkACLOptionsModel := &vpcv1.GetSubnetNetworkACLOptions{
@@ -235,7 +244,7 @@ func resourceIBMISSubnetNetworkACLAttachmentRead(d *schema.ResourceData, meta in
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting subnet's (%s) attached network ACL: %s\n%s", id, err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error getting subnet's (%s) attached network ACL", id))
 	}
 	d.Set(isNetworkACLName, *nwacl.Name)
 	d.Set(isNetworkACLVPC, *nwacl.VPC.ID)
@@ -326,11 +335,11 @@ func resourceIBMISSubnetNetworkACLAttachmentRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceIBMISSubnetNetworkACLAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSubnetNetworkACLAttachmentUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if d.HasChange(isNetworkACLID) {
 		subnet := d.Get(isSubnetID).(string)
@@ -347,23 +356,23 @@ func resourceIBMISSubnetNetworkACLAttachmentUpdate(d *schema.ResourceData, meta
 		resultACL, response, err := sess.ReplaceSubnetNetworkACL(replaceSubnetNetworkACLOptionsModel)
 
 		if err != nil {
-			log.Printf("[DEBUG] Error while attaching a network ACL to a subnet %s\n%s", err, response)
-			return fmt.Errorf("Error while attaching a network ACL to a subnet %s\n%s", err, response)
+			log.Printf("Error while attaching a network ACL to a subnet %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error while attaching a network ACL to a subnet"))
 		}
 		log.Printf("[INFO] Updated subnet %s with Network ACL : %s", subnet, *resultACL.ID)
 
 		d.SetId(subnet)
-		return resourceIBMISSubnetNetworkACLAttachmentRead(d, meta)
+		return resourceIBMISSubnetNetworkACLAttachmentRead(context, d, meta)
 	}
 
-	return resourceIBMISSubnetNetworkACLAttachmentRead(d, meta)
+	return resourceIBMISSubnetNetworkACLAttachmentRead(context, d, meta)
 }
 
-func resourceIBMISSubnetNetworkACLAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSubnetNetworkACLAttachmentDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// Set the subnet with VPC default network ACL
 	getSubnetOptions := &vpcv1.GetSubnetOptions{
@@ -375,7 +384,7 @@ func resourceIBMISSubnetNetworkACLAttachmentDelete(d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Subnet (%s): %s\n%s", id, err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error Getting Subnet (%s)", id))
 	}
 	// Fetch VPC
 	vpcID := *subnet.VPC.ID
@@ -389,7 +398,7 @@ func resourceIBMISSubnetNetworkACLAttachmentDelete(d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting VPC : %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error getting VPC"))
 	}
 
 	// Fetch default network ACL
@@ -406,8 +415,8 @@ func resourceIBMISSubnetNetworkACLAttachmentDelete(d *schema.ResourceData, meta
 		resultACL, response, err := sess.ReplaceSubnetNetworkACL(replaceSubnetNetworkACLOptionsModel)
 
 		if err != nil {
-			log.Printf("[DEBUG] Error while attaching a network ACL to a subnet %s\n%s", err, response)
-			return fmt.Errorf("Error while attaching a network ACL to a subnet %s\n%s", err, response)
+			log.Printf("Error while attaching a network ACL to a subnet %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error while attaching a network ACL to a subnet"))
 		}
 		log.Printf("[INFO] Updated subnet %s with VPC default Network ACL : %s", id, *resultACL.ID)
 	} else {
@@ -432,7 +441,7 @@ func resourceIBMISSubnetNetworkACLAttachmentExists(d *schema.ResourceData, meta
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting subnet's attached network ACL: %s\n%s", err, response)
+		return false, apiErrorf("vpc", err, response, "Error getting subnet's attached network ACL")
 	}
 	return true, nil
 }
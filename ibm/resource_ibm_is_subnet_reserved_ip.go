@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/IBM/go-sdk-core/v4/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -21,12 +23,11 @@ const (
 
 func resourceIBMISReservedIP() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISReservedIPCreate,
-		Read:     resourceIBMISReservedIPRead,
-		Update:   resourceIBMISReservedIPUpdate,
-		Delete:   resourceIBMISReservedIPDelete,
-		Exists:   resourceIBMISReservedIPExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISReservedIPCreate,
+		ReadContext:   resourceIBMISReservedIPRead,
+		UpdateContext: resourceIBMISReservedIPUpdate,
+		DeleteContext: resourceIBMISReservedIPDelete,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -121,10 +122,10 @@ func resourceIBMISSubnetReservedIPValidator() *ResourceValidator {
 }
 
 // resourceIBMISReservedIPCreate Creates a reserved IP given a subnet ID
-func resourceIBMISReservedIPCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISReservedIPCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	subnetID := d.Get(isSubNetID).(string)
@@ -148,24 +149,33 @@ func resourceIBMISReservedIPCreate(d *schema.ResourceData, meta interface{}) err
 	}
 	rip, response, err := sess.CreateSubnetReservedIP(options)
 	if err != nil || response == nil || rip == nil {
-		return fmt.Errorf("Error creating the reserved IP: %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error creating the reserved IP"))
 	}
 
 	// Set id for the reserved IP as combination of subnet ID and reserved IP ID
 	d.SetId(fmt.Sprintf("%s/%s", subnetID, *rip.ID))
 
-	return resourceIBMISReservedIPRead(d, meta)
+	return resourceIBMISReservedIPRead(context, d, meta)
 }
 
-func resourceIBMISReservedIPRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISReservedIPRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMISReservedIPExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	rip, err := get(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	allIDs, err := idParts(d.Id())
 	if err != nil {
-		return fmt.Errorf("The ID can not be split into subnet ID and reserved IP ID. %s", err)
+		return diag.FromErr(fmt.Errorf("The ID can not be split into subnet ID and reserved IP ID. %s", err))
 	}
 	subnetID := allIDs[0]
 
@@ -189,7 +199,7 @@ func resourceIBMISReservedIPRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMISReservedIPUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISReservedIPUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	// For updating the name
 	nameChanged := d.HasChange(isReservedIPName)
@@ -198,12 +208,12 @@ func resourceIBMISReservedIPUpdate(d *schema.ResourceData, meta interface{}) err
 	if nameChanged || autoDeleteChanged {
 		sess, err := vpcClient(meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		allIDs, err := idParts(d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		subnetID := allIDs[0]
 		reservedIPID := allIDs[1]
@@ -227,24 +237,24 @@ func resourceIBMISReservedIPUpdate(d *schema.ResourceData, meta interface{}) err
 
 		reservedIPPatch, err := patch.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error updating the reserved IP %s", err)
+			return diag.FromErr(fmt.Errorf("Error updating the reserved IP %s", err))
 		}
 
 		options.ReservedIPPatch = reservedIPPatch
 
 		_, response, err := sess.UpdateSubnetReservedIP(options)
 		if err != nil {
-			return fmt.Errorf("Error updating the reserved IP %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error updating the reserved IP"))
 		}
 	}
-	return resourceIBMISReservedIPRead(d, meta)
+	return resourceIBMISReservedIPRead(context, d, meta)
 }
 
-func resourceIBMISReservedIPDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISReservedIPDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	rip, err := get(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err == nil && rip == nil {
 		// If there is no such reserved IP, it can not be deleted
@@ -253,18 +263,18 @@ func resourceIBMISReservedIPDelete(d *schema.ResourceData, meta interface{}) err
 
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	allIDs, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	subnetID := allIDs[0]
 	reservedIPID := allIDs[1]
 	deleteOptions := sess.NewDeleteSubnetReservedIPOptions(subnetID, reservedIPID)
 	response, err := sess.DeleteSubnetReservedIP(deleteOptions)
 	if err != nil || response == nil {
-		return fmt.Errorf("Error deleting the reserverd ip %s in subnet %s, %s\n%s", reservedIPID, subnetID, err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error deleting the reserverd ip %s in subnet %s", reservedIPID, subnetID))
 	}
 	d.SetId("")
 	return nil
@@ -297,7 +307,7 @@ func get(d *schema.ResourceData, meta interface{}) (*vpcv1.ReservedIP, error) {
 			d.SetId("")
 			return nil, nil
 		}
-		return nil, fmt.Errorf("Error Getting Reserved IP : %s\n%s", err, response)
+		return nil, apiErrorf("vpc", err, response, "Error Getting Reserved IP")
 	}
 	return rip, nil
 }
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -12,7 +13,9 @@ import (
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
 )
 
 func TestAccIBMISSubnet_basic(t *testing.T) {
@@ -175,3 +178,52 @@ func testAccCheckIBMISSubnetConfigUpdate(vpcname, name, zone, cidr, gwname strin
 		tags = ["tag1"]
 	}`, vpcname, gwname, zone, name, zone, cidr)
 }
+
+func TestIBMISSubnetMock(t *testing.T) {
+	t.Parallel()
+	srv := testMockServer(t)
+	meta := testMockClientSession(t, srv)
+	vpcResource := resourceIBMISVPC()
+	r := resourceIBMISSubnet()
+
+	vpc := schema.TestResourceDataRaw(t, vpcResource.Schema, map[string]interface{}{
+		isVPCName: "mock-subnet-vpc",
+	})
+	assert.NilError(t, testDiagsErr(vpcResource.CreateContext(context.Background(), vpc, meta)))
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		isSubnetName:                  "mock-subnet",
+		isSubnetVPC:                   vpc.Id(),
+		isSubnetZone:                  "us-south-1",
+		isSubnetTotalIpv4AddressCount: 256,
+		isSubnetTags:                  []interface{}{"env:test"},
+	})
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), d, meta)))
+	assert.Assert(t, d.Id() != "")
+	assert.Equal(t, d.Get(isSubnetStatus), "available")
+	assert.Equal(t, d.Get(isSubnetIpv4CidrBlock), "10.240.1.0/24")
+	assert.Equal(t, d.Get(isSubnetAvailableIpv4AddressCount), 251)
+	assert.Equal(t, d.Get(isSubnetNetworkACL), vpc.Get(isVPCDefaultNetworkACL))
+	assert.DeepEqual(t, sortedTags(d.Get(isSubnetTags)), []string{"env:test"})
+
+	// The VPC lists its subnets and cannot be deleted before them
+	assert.NilError(t, testDiagsErr(vpcResource.ReadContext(context.Background(), vpc, meta)))
+	assert.Equal(t, len(vpc.Get(subnetsList).([]interface{})), 1)
+	assert.ErrorContains(t, testDiagsErr(vpcResource.DeleteContext(context.Background(), vpc, meta)), "delete them first")
+
+	d = testMockResourceData(t, r, d, map[string]interface{}{
+		isSubnetName:                  "mock-subnet-renamed",
+		isSubnetVPC:                   vpc.Id(),
+		isSubnetZone:                  "us-south-1",
+		isSubnetTotalIpv4AddressCount: 256,
+		isSubnetTags:                  []interface{}{"team:network"},
+	})
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), d, meta)))
+	assert.Equal(t, d.Get(isSubnetName), "mock-subnet-renamed")
+	assert.DeepEqual(t, sortedTags(d.Get(isSubnetTags)), []string{"team:network"})
+
+	assert.NilError(t, testDiagsErr(r.DeleteContext(context.Background(), d, meta)))
+	assert.Equal(t, d.Id(), "")
+	assert.NilError(t, testDiagsErr(vpcResource.DeleteContext(context.Background(), vpc, meta)))
+	assert.Equal(t, vpc.Id(), "")
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/IBM/go-sdk-core/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -41,12 +42,11 @@ func resourceIBMISEndpointGateway() *schema.Resource {
 	targetNameFmt := fmt.Sprintf("%s.0.%s", isVirtualEndpointGatewayTarget, isVirtualEndpointGatewayTargetName)
 	targetCRNFmt := fmt.Sprintf("%s.0.%s", isVirtualEndpointGatewayTarget, isVirtualEndpointGatewayTargetCRN)
 	return &schema.Resource{
-		Create:   resourceIBMisVirtualEndpointGatewayCreate,
-		Read:     resourceIBMisVirtualEndpointGatewayRead,
-		Update:   resourceIBMisVirtualEndpointGatewayUpdate,
-		Delete:   resourceIBMisVirtualEndpointGatewayDelete,
-		Exists:   resourceIBMisVirtualEndpointGatewayExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMisVirtualEndpointGatewayCreate,
+		ReadContext:   resourceIBMisVirtualEndpointGatewayRead,
+		UpdateContext: resourceIBMisVirtualEndpointGatewayUpdate,
+		DeleteContext: resourceIBMisVirtualEndpointGatewayDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
@@ -199,10 +199,11 @@ func resourceIBMISEndpointGatewayValidator() *ResourceValidator {
 	return &ibmEndpointGatewayResourceValidator
 }
 
-func resourceIBMisVirtualEndpointGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisVirtualEndpointGatewayCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	name := d.Get(isVirtualEndpointGatewayName).(string)
@@ -250,7 +251,7 @@ func resourceIBMisVirtualEndpointGatewayCreate(d *schema.ResourceData, meta inte
 	result, response, err := sess.CreateEndpointGateway(opt)
 	if err != nil {
 		log.Printf("Create Endpoint Gateway failed: %v", response)
-		return err
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error creating endpoint gateway"))
 	}
 
 	d.SetId(*result.ID)
@@ -261,15 +262,17 @@ func resourceIBMisVirtualEndpointGatewayCreate(d *schema.ResourceData, meta inte
 		if err != nil {
 			log.Printf(
 				"Error on create of VPE (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
-	return resourceIBMisVirtualEndpointGatewayRead(d, meta)
+	return append(diags, resourceIBMisVirtualEndpointGatewayRead(context, d, meta)...)
 }
 
-func resourceIBMisVirtualEndpointGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisVirtualEndpointGatewayUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange(isVirtualEndpointGatewayName) {
@@ -283,7 +286,7 @@ func resourceIBMisVirtualEndpointGatewayUpdate(d *schema.ResourceData, meta inte
 		_, response, err := sess.UpdateEndpointGateway(opt)
 		if err != nil {
 			log.Printf("Update Endpoint Gateway failed: %v", response)
-			return err
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error updating endpoint gateway (%s)", d.Id()))
 		}
 
 	}
@@ -291,29 +294,39 @@ func resourceIBMisVirtualEndpointGatewayUpdate(d *schema.ResourceData, meta inte
 		opt := sess.NewGetEndpointGatewayOptions(d.Id())
 		result, response, err := sess.GetEndpointGateway(opt)
 		if err != nil {
-			return fmt.Errorf("Error getting VPE: %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error getting VPE"))
 		}
 		oldList, newList := d.GetChange(isVirtualEndpointGatewayTags)
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *result.CRN)
 		if err != nil {
 			log.Printf(
 				"Error on update of VPE (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
-	return resourceIBMisVirtualEndpointGatewayRead(d, meta)
+	return append(diags, resourceIBMisVirtualEndpointGatewayRead(context, d, meta)...)
 }
 
-func resourceIBMisVirtualEndpointGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisVirtualEndpointGatewayRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMisVirtualEndpointGatewayExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// read option
 	opt := sess.NewGetEndpointGatewayOptions(d.Id())
 	result, response, err := sess.GetEndpointGateway(opt)
 	if err != nil {
 		log.Printf("Get Endpoint Gateway failed: %v", response)
-		return err
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error getting endpoint gateway (%s)", d.Id()))
 	}
 	d.Set(isVirtualEndpointGatewayName, result.Name)
 	d.Set(isVirtualEndpointGatewayHealthState, result.HealthState)
@@ -334,10 +347,10 @@ func resourceIBMisVirtualEndpointGatewayRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceIBMisVirtualEndpointGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisVirtualEndpointGatewayDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	opt := sess.NewDeleteEndpointGatewayOptions(d.Id())
@@ -362,7 +375,7 @@ func resourceIBMisVirtualEndpointGatewayExists(d *schema.ResourceData, meta inte
 			return false, nil
 		}
 		log.Printf("Error : %s", response)
-		return false, err
+		return false, apiErrorf("vpc", err, response, "Error getting endpoint gateway (%s)", d.Id())
 	}
 	return true, nil
 }
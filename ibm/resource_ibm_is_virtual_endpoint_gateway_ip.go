@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -28,11 +30,10 @@ const (
 
 func resourceIBMISEndpointGatewayIP() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMisVirtualEndpointGatewayIPCreate,
-		Read:     resourceIBMisVirtualEndpointGatewayIPRead,
-		Delete:   resourceIBMisVirtualEndpointGatewayIPDelete,
-		Exists:   resourceIBMisVirtualEndpointGatewayIPExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMisVirtualEndpointGatewayIPCreate,
+		ReadContext:   resourceIBMisVirtualEndpointGatewayIPRead,
+		DeleteContext: resourceIBMisVirtualEndpointGatewayIPDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -106,10 +107,10 @@ func resourceIBMISEndpointGatewayIP() *schema.Resource {
 	}
 }
 
-func resourceIBMisVirtualEndpointGatewayIPCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisVirtualEndpointGatewayIPCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	gatewayID := d.Get(isVirtualEndpointGatewayID).(string)
 	ipID := d.Get(isVirtualEndpointGatewayIPID).(string)
@@ -117,20 +118,29 @@ func resourceIBMisVirtualEndpointGatewayIPCreate(d *schema.ResourceData, meta in
 	_, response, err := sess.AddEndpointGatewayIP(opt)
 	if err != nil {
 		log.Printf("Add Endpoint Gateway failed: %v", response)
-		return err
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error adding the reserved IP %s to endpoint gateway %s", ipID, gatewayID))
 	}
 	d.SetId(fmt.Sprintf("%s/%s", gatewayID, ipID))
-	return resourceIBMisVirtualEndpointGatewayIPRead(d, meta)
+	return resourceIBMisVirtualEndpointGatewayIPRead(context, d, meta)
 }
 
-func resourceIBMisVirtualEndpointGatewayIPRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisVirtualEndpointGatewayIPRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMisVirtualEndpointGatewayIPExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	gatewayID := parts[0]
 	ipID := parts[1]
@@ -138,7 +148,7 @@ func resourceIBMisVirtualEndpointGatewayIPRead(d *schema.ResourceData, meta inte
 	result, response, err := sess.GetEndpointGatewayIP(opt)
 	if err != nil {
 		log.Printf("Get Endpoint Gateway IP failed: %v", response)
-		return err
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error getting the reserved IP %s of endpoint gateway %s", ipID, gatewayID))
 	}
 	d.Set(isVirtualEndpointGatewayIPID, result.ID)
 	d.Set(isVirtualEndpointGatewayIPName, result.Name)
@@ -151,22 +161,22 @@ func resourceIBMisVirtualEndpointGatewayIPRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceIBMisVirtualEndpointGatewayIPDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisVirtualEndpointGatewayIPDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	gatewayID := parts[0]
 	ipID := parts[1]
 	opt := sess.NewRemoveEndpointGatewayIPOptions(gatewayID, ipID)
 	response, err := sess.RemoveEndpointGatewayIP(opt)
-	if err != nil && response.StatusCode != 404 {
+	if err != nil && (response == nil || response.StatusCode != 404) {
 		log.Printf("Remove Endpoint Gateway IP failed: %v", response)
-		return err
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error removing the reserved IP %s from endpoint gateway %s", ipID, gatewayID))
 	}
 	d.SetId("")
 	return nil
@@ -192,7 +202,7 @@ func resourceIBMisVirtualEndpointGatewayIPExists(d *schema.ResourceData, meta in
 			return false, nil
 		}
 		log.Printf("Error : %s", response)
-		return false, err
+		return false, apiErrorf("vpc", err, response, "Error getting the reserved IP %s of endpoint gateway %s", ipID, gatewayID)
 	}
 	return true, nil
 }
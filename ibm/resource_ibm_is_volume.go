@@ -12,6 +12,7 @@ import (
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -39,12 +40,11 @@ const (
 
 func resourceIBMISVolume() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISVolumeCreate,
-		Read:     resourceIBMISVolumeRead,
-		Update:   resourceIBMISVolumeUpdate,
-		Delete:   resourceIBMISVolumeDelete,
-		Exists:   resourceIBMISVolumeExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISVolumeCreate,
+		ReadContext:   resourceIBMISVolumeRead,
+		UpdateContext: resourceIBMISVolumeUpdate,
+		DeleteContext: resourceIBMISVolumeDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -209,10 +209,11 @@ func resourceIBMISVolumeValidator() *ResourceValidator {
 	return &ibmISVolumeResourceValidator
 }
 
-func resourceIBMISVolumeCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVolumeCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	volName := d.Get(isVolumeName).(string)
@@ -225,23 +226,24 @@ func resourceIBMISVolumeCreate(d *schema.ResourceData, meta interface{}) error {
 		volCapacity = 100
 	}
 	if userDetails.generation == 1 {
-		err := classicVolCreate(d, meta, volName, profile, zone, volCapacity)
-		if err != nil {
-			return err
+		diags = append(diags, classicVolCreate(context, d, meta, volName, profile, zone, volCapacity)...)
+		if diags.HasError() {
+			return diags
 		}
 	} else {
-		err := volCreate(d, meta, volName, profile, zone, volCapacity)
-		if err != nil {
-			return err
+		diags = append(diags, volCreate(context, d, meta, volName, profile, zone, volCapacity)...)
+		if diags.HasError() {
+			return diags
 		}
 	}
-	return resourceIBMISVolumeRead(d, meta)
+	return append(diags, resourceIBMISVolumeRead(context, d, meta)...)
 }
 
-func classicVolCreate(d *schema.ResourceData, meta interface{}, volName, profile, zone string, volCapacity int64) error {
+func classicVolCreate(context context.Context, d *schema.ResourceData, meta interface{}, volName, profile, zone string, volCapacity int64) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := classicVpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	options := &vpcclassicv1.CreateVolumeOptions{
 		VolumePrototype: &vpcclassicv1.VolumePrototype{
@@ -278,13 +280,13 @@ func classicVolCreate(d *schema.ResourceData, meta interface{}, volName, profile
 
 	vol, response, err := sess.CreateVolume(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Create volume err %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc_classic", err, response, "Error creating volume"))
 	}
 	d.SetId(*vol.ID)
 	log.Printf("[INFO] Volume : %s", *vol.ID)
-	_, err = isWaitForClassicVolumeAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicVolumeAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isVolumeTags); ok || v != "" {
@@ -293,15 +295,17 @@ func classicVolCreate(d *schema.ResourceData, meta interface{}, volName, profile
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc volume (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
-	return nil
+	return diags
 }
 
-func volCreate(d *schema.ResourceData, meta interface{}, volName, profile, zone string, volCapacity int64) error {
+func volCreate(context context.Context, d *schema.ResourceData, meta interface{}, volName, profile, zone string, volCapacity int64) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	options := &vpcv1.CreateVolumeOptions{
 		VolumePrototype: &vpcv1.VolumePrototype{
@@ -338,13 +342,13 @@ func volCreate(d *schema.ResourceData, meta interface{}, volName, profile, zone
 
 	vol, response, err := sess.CreateVolume(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Create volume err %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error creating volume"))
 	}
 	d.SetId(*vol.ID)
 	log.Printf("[INFO] Volume : %s", *vol.ID)
-	_, err = isWaitForVolumeAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForVolumeAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isVolumeTags); ok || v != "" {
@@ -353,27 +357,37 @@ func volCreate(d *schema.ResourceData, meta interface{}, volName, profile, zone
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc volume (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
-	return nil
+	return diags
 }
 
-func resourceIBMISVolumeRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVolumeRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMISVolumeExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicVolGet(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := volGet(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -393,7 +407,7 @@ func classicVolGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Volume (%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting Volume (%s)", id)
 	}
 	d.SetId(*vol.ID)
 	d.Set(isVolumeName, *vol.Name)
@@ -441,7 +455,7 @@ func volGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Volume (%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc", err, response, "Error Getting Volume (%s)", id)
 	}
 	d.SetId(*vol.ID)
 	d.Set(isVolumeName, *vol.Name)
@@ -488,10 +502,11 @@ func volGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVolumeUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	id := d.Id()
@@ -504,23 +519,24 @@ func resourceIBMISVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if userDetails.generation == 1 {
-		err := classicVolUpdate(d, meta, id, name, hasChanged)
-		if err != nil {
-			return err
+		diags = append(diags, classicVolUpdate(d, meta, id, name, hasChanged)...)
+		if diags.HasError() {
+			return diags
 		}
 	} else {
-		err := volUpdate(d, meta, id, name, hasChanged)
-		if err != nil {
-			return err
+		diags = append(diags, volUpdate(d, meta, id, name, hasChanged)...)
+		if diags.HasError() {
+			return diags
 		}
 	}
-	return resourceIBMISVolumeRead(d, meta)
+	return append(diags, resourceIBMISVolumeRead(context, d, meta)...)
 }
 
-func classicVolUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
+func classicVolUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := classicVpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if d.HasChange(isVolumeTags) {
 		options := &vpcclassicv1.GetVolumeOptions{
@@ -528,13 +544,14 @@ func classicVolUpdate(d *schema.ResourceData, meta interface{}, id, name string,
 		}
 		vol, response, err := sess.GetVolume(options)
 		if err != nil {
-			return fmt.Errorf("Error getting Volume : %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc_classic", err, response, "Error getting Volume"))
 		}
 		oldList, newList := d.GetChange(isVolumeTags)
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *vol.CRN)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource vpc volume (%s) tags: %s", id, err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 	if hasChanged {
@@ -546,21 +563,22 @@ func classicVolUpdate(d *schema.ResourceData, meta interface{}, id, name string,
 		}
 		volumePatch, err := volumePatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for VolumePatch: %s", err)
+			return diag.FromErr(fmt.Errorf("Error calling asPatch for VolumePatch: %s", err))
 		}
 		options.VolumePatch = volumePatch
 		_, response, err := sess.UpdateVolume(options)
 		if err != nil {
-			return fmt.Errorf("Error updating vpc volume: %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc_classic", err, response, "Error updating vpc volume"))
 		}
 	}
-	return nil
+	return diags
 }
 
-func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
+func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if d.HasChange(isVolumeTags) {
 		options := &vpcv1.GetVolumeOptions{
@@ -568,13 +586,14 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		}
 		vol, response, err := sess.GetVolume(options)
 		if err != nil {
-			return fmt.Errorf("Error getting Volume : %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error getting Volume"))
 		}
 		oldList, newList := d.GetChange(isVolumeTags)
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *vol.CRN)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource vpc volume (%s) tags: %s", id, err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 	if hasChanged {
@@ -586,38 +605,38 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		}
 		volumePatch, err := volumePatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for VolumePatch: %s", err)
+			return diag.FromErr(fmt.Errorf("Error calling asPatch for VolumePatch: %s", err))
 		}
 		options.VolumePatch = volumePatch
 		_, response, err := sess.UpdateVolume(options)
 		if err != nil {
-			return fmt.Errorf("Error updating vpc volume: %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error updating vpc volume"))
 		}
 	}
-	return nil
+	return diags
 }
 
-func resourceIBMISVolumeDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVolumeDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
-		err := classicVolDelete(d, meta, id)
+		err := classicVolDelete(context, d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		err := volDelete(d, meta, id)
+		err := volDelete(context, d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
 }
 
-func classicVolDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func classicVolDelete(context context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -631,7 +650,7 @@ func classicVolDelete(d *schema.ResourceData, meta interface{}, id string) error
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error Getting Volume (%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting Volume (%s)", id)
 	}
 
 	options := &vpcclassicv1.DeleteVolumeOptions{
@@ -639,9 +658,9 @@ func classicVolDelete(d *schema.ResourceData, meta interface{}, id string) error
 	}
 	response, err = sess.DeleteVolume(options)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Volume : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Deleting Volume")
 	}
-	_, err = isWaitForClassicVolumeDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicVolumeDeleted(context, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -649,7 +668,7 @@ func classicVolDelete(d *schema.ResourceData, meta interface{}, id string) error
 	return nil
 }
 
-func volDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func volDelete(context context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -663,7 +682,7 @@ func volDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error Getting Volume (%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc", err, response, "Error Getting Volume (%s)", id)
 	}
 
 	options := &vpcv1.DeleteVolumeOptions{
@@ -671,9 +690,9 @@ func volDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteVolume(options)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Volume : %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Deleting Volume")
 	}
-	_, err = isWaitForVolumeDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForVolumeDeleted(context, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -681,7 +700,7 @@ func volDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func isWaitForClassicVolumeDeleted(vol *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicVolumeDeleted(context context.Context, vol *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -689,11 +708,11 @@ func isWaitForClassicVolumeDeleted(vol *vpcclassicv1.VpcClassicV1, id string, ti
 		Target:     []string{"done", ""},
 		Refresh:    isClassicVolumeDeleteRefreshFunc(vol, id),
 		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Delay:      vpcWaitDelay,
+		MinTimeout: vpcWaitMinTimeout,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isClassicVolumeDeleteRefreshFunc(vol *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -706,13 +725,13 @@ func isClassicVolumeDeleteRefreshFunc(vol *vpcclassicv1.VpcClassicV1, id string)
 			if response != nil && response.StatusCode == 404 {
 				return vol, isVolumeDeleted, nil
 			}
-			return vol, "", fmt.Errorf("Error Getting Volume: %s\n%s", err, response)
+			return vol, "", apiErrorf("vpc_classic", err, response, "Error Getting Volume")
 		}
 		return vol, isVolumeDeleting, err
 	}
 }
 
-func isWaitForVolumeDeleted(vol *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVolumeDeleted(context context.Context, vol *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -720,11 +739,11 @@ func isWaitForVolumeDeleted(vol *vpcv1.VpcV1, id string, timeout time.Duration)
 		Target:     []string{"done", ""},
 		Refresh:    isVolumeDeleteRefreshFunc(vol, id),
 		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Delay:      vpcWaitDelay,
+		MinTimeout: vpcWaitMinTimeout,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isVolumeDeleteRefreshFunc(vol *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -737,7 +756,7 @@ func isVolumeDeleteRefreshFunc(vol *vpcv1.VpcV1, id string) resource.StateRefres
 			if response != nil && response.StatusCode == 404 {
 				return vol, isVolumeDeleted, nil
 			}
-			return vol, "", fmt.Errorf("Error Getting Volume: %s\n%s", err, response)
+			return vol, "", apiErrorf("vpc", err, response, "Error Getting Volume")
 		}
 		return vol, isVolumeDeleting, err
 	}
@@ -772,7 +791,7 @@ func classicVolExists(d *schema.ResourceData, meta interface{}, id string) (bool
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Volume: %s\n%s", err, response)
+		return false, apiErrorf("vpc_classic", err, response, "Error getting Volume")
 	}
 	return true, nil
 }
@@ -790,12 +809,12 @@ func volExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Volume: %s\n%s", err, response)
+		return false, apiErrorf("vpc", err, response, "Error getting Volume")
 	}
 	return true, nil
 }
 
-func isWaitForClassicVolumeAvailable(client *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicVolumeAvailable(context context.Context, client *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Volume (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -803,11 +822,11 @@ func isWaitForClassicVolumeAvailable(client *vpcclassicv1.VpcClassicV1, id strin
 		Target:     []string{isVolumeProvisioningDone, ""},
 		Refresh:    isClassicVolumeRefreshFunc(client, id),
 		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Delay:      vpcWaitDelay,
+		MinTimeout: vpcWaitMinTimeout,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isClassicVolumeRefreshFunc(client *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -817,7 +836,7 @@ func isClassicVolumeRefreshFunc(client *vpcclassicv1.VpcClassicV1, id string) re
 		}
 		vol, response, err := client.GetVolume(volgetoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting volume: %s\n%s", err, response)
+			return nil, "", apiErrorf("vpc_classic", err, response, "Error Getting volume")
 		}
 
 		if *vol.Status == "available" {
@@ -828,7 +847,7 @@ func isClassicVolumeRefreshFunc(client *vpcclassicv1.VpcClassicV1, id string) re
 	}
 }
 
-func isWaitForVolumeAvailable(client *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVolumeAvailable(context context.Context, client *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Volume (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -836,11 +855,11 @@ func isWaitForVolumeAvailable(client *vpcv1.VpcV1, id string, timeout time.Durat
 		Target:     []string{isVolumeProvisioningDone, ""},
 		Refresh:    isVolumeRefreshFunc(client, id),
 		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Delay:      vpcWaitDelay,
+		MinTimeout: vpcWaitMinTimeout,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isVolumeRefreshFunc(client *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -850,7 +869,7 @@ func isVolumeRefreshFunc(client *vpcv1.VpcV1, id string) resource.StateRefreshFu
 		}
 		vol, response, err := client.GetVolume(volgetoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting volume: %s\n%s", err, response)
+			return nil, "", apiErrorf("vpc", err, response, "Error Getting volume")
 		}
 
 		if *vol.Status == "available" {
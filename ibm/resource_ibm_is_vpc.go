@@ -16,6 +16,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/hashcode"
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -61,12 +62,11 @@ const (
 
 func resourceIBMISVPC() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISVPCCreate,
-		Read:     resourceIBMISVPCRead,
-		Update:   resourceIBMISVPCUpdate,
-		Delete:   resourceIBMISVPCDelete,
-		Exists:   resourceIBMISVPCExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISVPCCreate,
+		ReadContext:   resourceIBMISVPCRead,
+		UpdateContext: resourceIBMISVPCUpdate,
+		DeleteContext: resourceIBMISVPCDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -418,10 +418,11 @@ func resourceIBMISVPCValidator() *ResourceValidator {
 	return &ibmISVPCResourceValidator
 }
 
-func resourceIBMISVPCCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] VPC create")
@@ -441,23 +442,24 @@ func resourceIBMISVPCCreate(d *schema.ResourceData, meta interface{}) error {
 		rg = grp.(string)
 	}
 	if userDetails.generation == 1 {
-		err := classicVpcCreate(d, meta, name, apm, rg, isClassic)
-		if err != nil {
-			return err
+		diags = append(diags, classicVpcCreate(context, d, meta, name, apm, rg, isClassic)...)
+		if diags.HasError() {
+			return diags
 		}
 	} else {
-		err := vpcCreate(d, meta, name, apm, rg, isClassic)
-		if err != nil {
-			return err
+		diags = append(diags, vpcCreate(context, d, meta, name, apm, rg, isClassic)...)
+		if diags.HasError() {
+			return diags
 		}
 	}
-	return resourceIBMISVPCRead(d, meta)
+	return append(diags, resourceIBMISVPCRead(context, d, meta)...)
 }
 
-func classicVpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, isClassic bool) error {
+func classicVpcCreate(context context.Context, d *schema.ResourceData, meta interface{}, name, apm, rg string, isClassic bool) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := classicVpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	options := &vpcclassicv1.CreateVPCOptions{
 		Name: &name,
@@ -474,13 +476,13 @@ func classicVpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg st
 
 	vpc, response, err := sess.CreateVPC(options)
 	if err != nil {
-		return fmt.Errorf("Error while creating VPC err %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc_classic", err, response, "Error while creating VPC"))
 	}
 	d.SetId(*vpc.ID)
 	log.Printf("[INFO] VPC : %s", *vpc.ID)
-	_, err = isWaitForClassicVPCAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicVPCAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isVPCTags); ok || v != "" {
@@ -489,12 +491,13 @@ func classicVpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg st
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
-	return nil
+	return diags
 }
 
-func isWaitForClassicVPCAvailable(vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicVPCAvailable(context context.Context, vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VPC (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -506,7 +509,7 @@ func isWaitForClassicVPCAvailable(vpc *vpcclassicv1.VpcClassicV1, id string, tim
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isClassicVPCRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -516,7 +519,7 @@ func isClassicVPCRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string) resource
 		}
 		vpc, response, err := vpc.GetVPC(getvpcOptions)
 		if err != nil {
-			return nil, isVPCFailed, fmt.Errorf("Error getting VPC : %s\n%s", err, response)
+			return nil, isVPCFailed, apiErrorf("vpc_classic", err, response, "Error getting VPC")
 		}
 
 		if *vpc.Status == isVPCAvailable || *vpc.Status == isVPCFailed {
@@ -527,10 +530,11 @@ func isClassicVPCRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string) resource
 	}
 }
 
-func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, isClassic bool) error {
+func vpcCreate(context context.Context, d *schema.ResourceData, meta interface{}, name, apm, rg string, isClassic bool) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	options := &vpcv1.CreateVPCOptions{
 		Name: &name,
@@ -547,7 +551,7 @@ func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, i
 
 	vpc, response, err := sess.CreateVPC(options)
 	if err != nil {
-		return fmt.Errorf("Error while creating VPC %s ", beautifyError(err, response))
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error while creating VPC"))
 	}
 	d.SetId(*vpc.ID)
 
@@ -564,9 +568,9 @@ func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, i
 	}
 
 	log.Printf("[INFO] VPC : %s", *vpc.ID)
-	_, err = isWaitForVPCAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForVPCAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isVPCTags); ok || v != "" {
@@ -575,12 +579,13 @@ func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, i
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
-	return nil
+	return diags
 }
 
-func isWaitForVPCAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVPCAvailable(context context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VPC (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -592,7 +597,7 @@ func isWaitForVPCAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isVPCRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -602,7 +607,7 @@ func isVPCRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 		}
 		vpc, response, err := vpc.GetVPC(getvpcOptions)
 		if err != nil {
-			return nil, isVPCFailed, fmt.Errorf("Error getting VPC : %s\n%s", err, response)
+			return nil, isVPCFailed, apiErrorf("vpc", err, response, "Error getting VPC")
 		}
 
 		if *vpc.Status == isVPCAvailable || *vpc.Status == isVPCFailed {
@@ -613,21 +618,30 @@ func isVPCRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	}
 }
 
-func resourceIBMISVPCRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMISVPCExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicVpcGet(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := vpcGet(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -647,7 +661,7 @@ func classicVpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting VPC : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error getting VPC")
 	}
 
 	d.Set(isVPCName, *vpc.Name)
@@ -707,7 +721,7 @@ func classicVpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 		s, response, err := sess.ListSubnets(options)
 		if err != nil {
-			return fmt.Errorf("Error Fetching subnets %s\n%s", err, response)
+			return apiErrorf("vpc_classic", err, response, "Error Fetching subnets")
 		}
 		start = GetNext(s.Next)
 		allrecs = append(allrecs, s.Subnets...)
@@ -867,7 +881,7 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting VPC : %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error getting VPC")
 	}
 
 	d.Set(isVPCName, *vpc.Name)
@@ -933,7 +947,7 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 		s, response, err := sess.ListSubnets(options)
 		if err != nil {
-			return fmt.Errorf("Error Fetching subnets %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Fetching subnets")
 		}
 		start = GetNext(s.Next)
 		allrecs = append(allrecs, s.Subnets...)
@@ -1077,10 +1091,11 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISVPCUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 
@@ -1092,23 +1107,24 @@ func resourceIBMISVPCUpdate(d *schema.ResourceData, meta interface{}) error {
 		hasChanged = true
 	}
 	if userDetails.generation == 1 {
-		err := classicVpcUpdate(d, meta, id, name, hasChanged)
-		if err != nil {
-			return err
+		diags = append(diags, classicVpcUpdate(d, meta, id, name, hasChanged)...)
+		if diags.HasError() {
+			return diags
 		}
 	} else {
-		err := vpcUpdate(d, meta, id, name, hasChanged)
-		if err != nil {
-			return err
+		diags = append(diags, vpcUpdate(d, meta, id, name, hasChanged)...)
+		if diags.HasError() {
+			return diags
 		}
 	}
-	return resourceIBMISVPCRead(d, meta)
+	return append(diags, resourceIBMISVPCRead(context, d, meta)...)
 }
 
-func classicVpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
+func classicVpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := classicVpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if d.HasChange(isVPCTags) {
 		getvpcOptions := &vpcclassicv1.GetVPCOptions{
@@ -1116,13 +1132,14 @@ func classicVpcUpdate(d *schema.ResourceData, meta interface{}, id, name string,
 		}
 		vpc, response, err := sess.GetVPC(getvpcOptions)
 		if err != nil {
-			return fmt.Errorf("Error getting VPC : %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc_classic", err, response, "Error getting VPC"))
 		}
 		oldList, newList := d.GetChange(isVPCTags)
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *vpc.CRN)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource vpc (%s) tags: %s", id, err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 	if hasChanged {
@@ -1134,21 +1151,22 @@ func classicVpcUpdate(d *schema.ResourceData, meta interface{}, id, name string,
 		}
 		vpcPatch, err := vpcPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for VPCPatch: %s", err)
+			return diag.FromErr(fmt.Errorf("Error calling asPatch for VPCPatch: %s", err))
 		}
 		updateVpcOptions.VPCPatch = vpcPatch
 		_, response, err := sess.UpdateVPC(updateVpcOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating VPC : %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc_classic", err, response, "Error Updating VPC"))
 		}
 	}
-	return nil
+	return diags
 }
 
-func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
+func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if d.HasChange(isVPCTags) {
 		getvpcOptions := &vpcv1.GetVPCOptions{
@@ -1156,13 +1174,14 @@ func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		}
 		vpc, response, err := sess.GetVPC(getvpcOptions)
 		if err != nil {
-			return fmt.Errorf("Error getting VPC : %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error getting VPC"))
 		}
 		oldList, newList := d.GetChange(isVPCTags)
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *vpc.CRN)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource vpc (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 
@@ -1191,39 +1210,39 @@ func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		}
 		vpcPatch, err := vpcPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for VPCPatch: %s", err)
+			return diag.FromErr(fmt.Errorf("Error calling asPatch for VPCPatch: %s", err))
 		}
 		updateVpcOptions.VPCPatch = vpcPatch
 		_, response, err := sess.UpdateVPC(updateVpcOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating VPC : %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error Updating VPC"))
 		}
 	}
-	return nil
+	return diags
 }
 
-func resourceIBMISVPCDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
-		err := classicVpcDelete(d, meta, id)
+		err := classicVpcDelete(context, d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		err := vpcDelete(d, meta, id)
+		err := vpcDelete(context, d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	d.SetId("")
 	return nil
 }
 
-func classicVpcDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func classicVpcDelete(context context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -1239,7 +1258,7 @@ func classicVpcDelete(d *schema.ResourceData, meta interface{}, id string) error
 			return nil
 		}
 
-		return fmt.Errorf("Error Getting VPC (%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting VPC (%s)", id)
 
 	}
 
@@ -1248,9 +1267,9 @@ func classicVpcDelete(d *schema.ResourceData, meta interface{}, id string) error
 	}
 	response, err = sess.DeleteVPC(deletevpcOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting VPC : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Deleting VPC")
 	}
-	_, err = isWaitForClassicVPCDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicVPCDeleted(context, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -1258,7 +1277,7 @@ func classicVpcDelete(d *schema.ResourceData, meta interface{}, id string) error
 	return nil
 }
 
-func vpcDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func vpcDelete(context context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -1273,7 +1292,7 @@ func vpcDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting VPC (%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc", err, response, "Error Getting VPC (%s)", id)
 	}
 
 	deletevpcOptions := &vpcv1.DeleteVPCOptions{
@@ -1281,9 +1300,9 @@ func vpcDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteVPC(deletevpcOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting VPC : %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Deleting VPC")
 	}
-	_, err = isWaitForVPCDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForVPCDeleted(context, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -1291,7 +1310,7 @@ func vpcDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func isWaitForClassicVPCDeleted(vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicVPCDeleted(context context.Context, vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VPC (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -1303,7 +1322,7 @@ func isWaitForClassicVPCDeleted(vpc *vpcclassicv1.VpcClassicV1, id string, timeo
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isClassicVPCDeleteRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -1317,14 +1336,14 @@ func isClassicVPCDeleteRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string) re
 			if response != nil && response.StatusCode == 404 {
 				return vpc, isVPCDeleted, nil
 			}
-			return nil, isVPCFailed, fmt.Errorf("The VPC %s failed to delete: %s\n%s", id, err, response)
+			return nil, isVPCFailed, apiErrorf("vpc_classic", err, response, "The VPC %s failed to delete", id)
 		}
 
 		return vpc, isVPCDeleting, nil
 	}
 }
 
-func isWaitForVPCDeleted(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVPCDeleted(context context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VPC (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -1336,7 +1355,7 @@ func isWaitForVPCDeleted(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (in
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isVPCDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -1350,7 +1369,7 @@ func isVPCDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFu
 			if response != nil && response.StatusCode == 404 {
 				return vpc, isVPCDeleted, nil
 			}
-			return nil, isVPCFailed, fmt.Errorf("The VPC %s failed to delete: %s\n%s", id, err, response)
+			return nil, isVPCFailed, apiErrorf("vpc", err, response, "The VPC %s failed to delete", id)
 		}
 
 		return vpc, isVPCDeleting, nil
@@ -1385,7 +1404,7 @@ func classicVpcExists(d *schema.ResourceData, meta interface{}, id string) (bool
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting VPC: %s\n%s", err, response)
+		return false, apiErrorf("vpc_classic", err, response, "Error getting VPC")
 	}
 
 	return true, nil
@@ -1404,7 +1423,7 @@ func vpcExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting VPC: %s\n%s", err, response)
+		return false, apiErrorf("vpc", err, response, "Error getting VPC")
 	}
 	return true, nil
 }
@@ -1430,7 +1449,7 @@ func nwaclNameUpdate(sess *vpcv1.VpcV1, id, name string) error {
 	updateNetworkACLOptions.NetworkACLPatch = networkACLPatch
 	_, response, err := sess.UpdateNetworkACL(updateNetworkACLOptions)
 	if err != nil {
-		return fmt.Errorf("Error Updating Network ACL(%s) name : %s\n%s", id, err, response)
+		return apiErrorf("vpc", err, response, "Error Updating Network ACL(%s) name", id)
 	}
 	return nil
 }
@@ -1449,7 +1468,7 @@ func sgNameUpdate(sess *vpcv1.VpcV1, id, name string) error {
 	updateSecurityGroupOptions.SecurityGroupPatch = securityGroupPatch
 	_, response, err := sess.UpdateSecurityGroup(updateSecurityGroupOptions)
 	if err != nil {
-		return fmt.Errorf("Error Updating Security Group name : %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Updating Security Group name")
 	}
 	return nil
 }
@@ -1467,7 +1486,7 @@ func rtNameUpdate(sess *vpcv1.VpcV1, vpcID, id, name string) error {
 	updateVpcRoutingTableOptions.RoutingTablePatch = routingTablePatchModelAsPatch
 	_, response, err := sess.UpdateVPCRoutingTable(updateVpcRoutingTableOptions)
 	if err != nil {
-		return fmt.Errorf("Error Updating Routing table name %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Updating Routing table name")
 	}
 	return nil
 }
@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -22,12 +24,11 @@ const (
 
 func resourceIBMISVpcAddressPrefix() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISVpcAddressPrefixCreate,
-		Read:     resourceIBMISVpcAddressPrefixRead,
-		Update:   resourceIBMISVpcAddressPrefixUpdate,
-		Delete:   resourceIBMISVpcAddressPrefixDelete,
-		Exists:   resourceIBMISVpcAddressPrefixExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISVpcAddressPrefixCreate,
+		ReadContext:   resourceIBMISVpcAddressPrefixRead,
+		UpdateContext: resourceIBMISVpcAddressPrefixUpdate,
+		DeleteContext: resourceIBMISVpcAddressPrefixDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			isVPCAddressPrefixPrefixName: {
@@ -111,11 +112,11 @@ func resourceIBMISAddressPrefixValidator() *ResourceValidator {
 	return &ibmISAddressPrefixResourceValidator
 }
 
-func resourceIBMISVpcAddressPrefixCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVpcAddressPrefixCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	isDefault := false
 	prefixName := d.Get(isVPCAddressPrefixPrefixName).(string)
@@ -133,15 +134,15 @@ func resourceIBMISVpcAddressPrefixCreate(d *schema.ResourceData, meta interface{
 	if userDetails.generation == 1 {
 		err := classicVpcAddressPrefixCreate(d, meta, prefixName, zoneName, cidr, vpcID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := vpcAddressPrefixCreate(d, meta, prefixName, zoneName, cidr, vpcID, isDefault)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceIBMISVpcAddressPrefixRead(d, meta)
+	return resourceIBMISVpcAddressPrefixRead(context, d, meta)
 }
 
 func classicVpcAddressPrefixCreate(d *schema.ResourceData, meta interface{}, name, zone, cidr, vpcID string) error {
@@ -159,7 +160,7 @@ func classicVpcAddressPrefixCreate(d *schema.ResourceData, meta interface{}, nam
 	}
 	addrPrefix, response, err := sess.CreateVPCAddressPrefix(options)
 	if err != nil {
-		return fmt.Errorf("Error while creating VPC Address Prefix %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error while creating VPC Address Prefix")
 	}
 
 	addrPrefixID := *addrPrefix.ID
@@ -184,7 +185,7 @@ func vpcAddressPrefixCreate(d *schema.ResourceData, meta interface{}, name, zone
 	}
 	addrPrefix, response, err := sess.CreateVPCAddressPrefix(options)
 	if err != nil {
-		return fmt.Errorf("Error while creating VPC Address Prefix %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error while creating VPC Address Prefix")
 	}
 
 	addrPrefixID := *addrPrefix.ID
@@ -192,14 +193,23 @@ func vpcAddressPrefixCreate(d *schema.ResourceData, meta interface{}, name, zone
 	return nil
 }
 
-func resourceIBMISVpcAddressPrefixRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVpcAddressPrefixRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMISVpcAddressPrefixExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	vpcID := parts[0]
@@ -207,12 +217,12 @@ func resourceIBMISVpcAddressPrefixRead(d *schema.ResourceData, meta interface{})
 	if userDetails.generation == 1 {
 		err := classicVpcAddressPrefixGet(d, meta, vpcID, addrPrefixID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := vpcAddressPrefixGet(d, meta, vpcID, addrPrefixID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -234,7 +244,7 @@ func classicVpcAddressPrefixGet(d *schema.ResourceData, meta interface{}, vpcID,
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting VPC Address Prefix (%s): %s\n%s", addrPrefixID, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting VPC Address Prefix (%s)", addrPrefixID)
 	}
 	d.Set(isVPCAddressPrefixVPCID, vpcID)
 	d.Set(isVPCAddressPrefixPrefixName, *addrPrefix.Name)
@@ -248,7 +258,7 @@ func classicVpcAddressPrefixGet(d *schema.ResourceData, meta interface{}, vpcID,
 	}
 	vpc, response, err := sess.GetVPC(getVPCOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting VPC : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting VPC")
 	}
 	d.Set(RelatedCRN, *vpc.CRN)
 
@@ -270,7 +280,7 @@ func vpcAddressPrefixGet(d *schema.ResourceData, meta interface{}, vpcID, addrPr
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting VPC Address Prefix (%s): %s\n%s", addrPrefixID, err, response)
+		return apiErrorf("vpc", err, response, "Error Getting VPC Address Prefix (%s)", addrPrefixID)
 	}
 	d.Set(isVPCAddressPrefixVPCID, vpcID)
 	d.Set(isVPCAddressPrefixDefault, *addrPrefix.IsDefault)
@@ -285,17 +295,17 @@ func vpcAddressPrefixGet(d *schema.ResourceData, meta interface{}, vpcID, addrPr
 	}
 	vpc, response, err := sess.GetVPC(getVPCOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting VPC : %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Getting VPC")
 	}
 	d.Set(RelatedCRN, *vpc.CRN)
 
 	return nil
 }
 
-func resourceIBMISVpcAddressPrefixUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVpcAddressPrefixUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	name := ""
@@ -305,7 +315,7 @@ func resourceIBMISVpcAddressPrefixUpdate(d *schema.ResourceData, meta interface{
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	vpcID := parts[0]
 	addrPrefixID := parts[1]
@@ -325,16 +335,16 @@ func resourceIBMISVpcAddressPrefixUpdate(d *schema.ResourceData, meta interface{
 	if userDetails.generation == 1 {
 		err := classicVpcAddressPrefixUpdate(d, meta, vpcID, addrPrefixID, name, hasNameChanged)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := vpcAddressPrefixUpdate(d, meta, vpcID, addrPrefixID, name, isDefault, hasNameChanged, hasIsDefaultChanged)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceIBMISVpcAddressPrefixRead(d, meta)
+	return resourceIBMISVpcAddressPrefixRead(context, d, meta)
 }
 
 func classicVpcAddressPrefixUpdate(d *schema.ResourceData, meta interface{}, vpcID, addrPrefixID, name string, hasChanged bool) error {
@@ -357,7 +367,7 @@ func classicVpcAddressPrefixUpdate(d *schema.ResourceData, meta interface{}, vpc
 		updatevpcAddressPrefixoptions.AddressPrefixPatch = addressPrefixPatch
 		_, response, err := sess.UpdateVPCAddressPrefix(updatevpcAddressPrefixoptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating VPC Address Prefix: %s\n%s", err, response)
+			return apiErrorf("vpc_classic", err, response, "Error Updating VPC Address Prefix")
 		}
 	}
 	return nil
@@ -388,21 +398,21 @@ func vpcAddressPrefixUpdate(d *schema.ResourceData, meta interface{}, vpcID, add
 		updatevpcAddressPrefixoptions.AddressPrefixPatch = addressPrefixPatch
 		_, response, err := sess.UpdateVPCAddressPrefix(updatevpcAddressPrefixoptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating VPC Address Prefix: %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Updating VPC Address Prefix")
 		}
 	}
 	return nil
 }
 
-func resourceIBMISVpcAddressPrefixDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVpcAddressPrefixDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	vpcID := parts[0]
 	addrPrefixID := parts[1]
@@ -414,12 +424,12 @@ func resourceIBMISVpcAddressPrefixDelete(d *schema.ResourceData, meta interface{
 	if userDetails.generation == 1 {
 		err := classicVpcAddressPrefixDelete(d, meta, vpcID, addrPrefixID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := vpcAddressPrefixDelete(d, meta, vpcID, addrPrefixID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -442,7 +452,7 @@ func classicVpcAddressPrefixDelete(d *schema.ResourceData, meta interface{}, vpc
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error Getting VPC Address Prefix (%s): %s\n%s", addrPrefixID, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting VPC Address Prefix (%s)", addrPrefixID)
 	}
 	deletevpcAddressPrefixOptions := &vpcclassicv1.DeleteVPCAddressPrefixOptions{
 		VPCID: &vpcID,
@@ -453,7 +463,7 @@ func classicVpcAddressPrefixDelete(d *schema.ResourceData, meta interface{}, vpc
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error Deleting VPC Address Prefix (%s): %s\n%s", addrPrefixID, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Deleting VPC Address Prefix (%s)", addrPrefixID)
 	}
 	d.SetId("")
 	return nil
@@ -474,7 +484,7 @@ func vpcAddressPrefixDelete(d *schema.ResourceData, meta interface{}, vpcID, add
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error Getting VPC Address Prefix (%s): %s\n%s", addrPrefixID, err, response)
+		return apiErrorf("vpc", err, response, "Error Getting VPC Address Prefix (%s)", addrPrefixID)
 	}
 
 	deletevpcAddressPrefixOptions := &vpcv1.DeleteVPCAddressPrefixOptions{
@@ -486,7 +496,7 @@ func vpcAddressPrefixDelete(d *schema.ResourceData, meta interface{}, vpcID, add
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error Deleting VPC Address Prefix (%s): %s\n%s", addrPrefixID, err, response)
+		return apiErrorf("vpc", err, response, "Error Deleting VPC Address Prefix (%s)", addrPrefixID)
 	}
 	d.SetId("")
 	return nil
@@ -527,7 +537,7 @@ func classicVpcAddressPrefixExists(d *schema.ResourceData, meta interface{}, vpc
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting VPC Address Prefix: %s\n%s", err, response)
+		return false, apiErrorf("vpc_classic", err, response, "Error getting VPC Address Prefix")
 	}
 	return true, nil
 }
@@ -546,7 +556,7 @@ func vpcAddressPrefixExists(d *schema.ResourceData, meta interface{}, vpcID, add
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting VPC Address Prefix: %s\n%s", err, response)
+		return false, apiErrorf("vpc", err, response, "Error getting VPC Address Prefix")
 	}
 	return true, nil
 }
@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -33,12 +35,11 @@ const (
 
 func resourceIBMISVpcRoute() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISVpcRouteCreate,
-		Read:     resourceIBMISVpcRouteRead,
-		Update:   resourceIBMISVpcRouteUpdate,
-		Delete:   resourceIBMISVpcRouteDelete,
-		Exists:   resourceIBMISVpcRouteExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISVpcRouteCreate,
+		ReadContext:   resourceIBMISVpcRouteRead,
+		UpdateContext: resourceIBMISVpcRouteUpdate,
+		DeleteContext: resourceIBMISVpcRouteDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -120,10 +121,10 @@ func resourceIBMISRouteValidator() *ResourceValidator {
 	return &ibmISRouteResourceValidator
 }
 
-func resourceIBMISVpcRouteCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVpcRouteCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	routeName := d.Get(isVPCRouteName).(string)
@@ -132,20 +133,20 @@ func resourceIBMISVpcRouteCreate(d *schema.ResourceData, meta interface{}) error
 	vpcID := d.Get(isVPCRouteVPCID).(string)
 	nextHop := d.Get(isVPCRouteNextHop).(string)
 	if userDetails.generation == 1 {
-		err := classicVpcRouteCreate(d, meta, routeName, zoneName, cidr, vpcID, nextHop)
+		err := classicVpcRouteCreate(context, d, meta, routeName, zoneName, cidr, vpcID, nextHop)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		err := vpcRouteCreate(d, meta, routeName, zoneName, cidr, vpcID, nextHop)
+		err := vpcRouteCreate(context, d, meta, routeName, zoneName, cidr, vpcID, nextHop)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceIBMISVpcRouteRead(d, meta)
+	return resourceIBMISVpcRouteRead(context, d, meta)
 }
 
-func classicVpcRouteCreate(d *schema.ResourceData, meta interface{}, routeName, zoneName, cidr, vpcID, nextHop string) error {
+func classicVpcRouteCreate(context context.Context, d *schema.ResourceData, meta interface{}, routeName, zoneName, cidr, vpcID, nextHop string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -163,20 +164,20 @@ func classicVpcRouteCreate(d *schema.ResourceData, meta interface{}, routeName,
 	}
 	route, response, err := sess.CreateVPCRoute(createRouteOptions)
 	if err != nil {
-		return fmt.Errorf("Error while creating VPC Route %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error while creating VPC Route")
 	}
 	routeID := *route.ID
 
 	d.SetId(fmt.Sprintf("%s/%s", vpcID, routeID))
 
-	_, err = isWaitForClassicRouteStable(sess, d, vpcID, routeID)
+	_, err = isWaitForClassicRouteStable(context, sess, d, vpcID, routeID)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func vpcRouteCreate(d *schema.ResourceData, meta interface{}, routeName, zoneName, cidr, vpcID, nextHop string) error {
+func vpcRouteCreate(context context.Context, d *schema.ResourceData, meta interface{}, routeName, zoneName, cidr, vpcID, nextHop string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -194,20 +195,20 @@ func vpcRouteCreate(d *schema.ResourceData, meta interface{}, routeName, zoneNam
 	}
 	route, response, err := sess.CreateVPCRoute(createRouteOptions)
 	if err != nil {
-		return fmt.Errorf("Error while creating VPC Route err %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error while creating VPC Route")
 	}
 	routeID := *route.ID
 
 	d.SetId(fmt.Sprintf("%s/%s", vpcID, routeID))
 
-	_, err = isWaitForRouteStable(sess, d, vpcID, routeID)
+	_, err = isWaitForRouteStable(context, sess, d, vpcID, routeID)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func isWaitForClassicRouteStable(sess *vpcclassicv1.VpcClassicV1, d *schema.ResourceData, vpcID, routeID string) (interface{}, error) {
+func isWaitForClassicRouteStable(context context.Context, sess *vpcclassicv1.VpcClassicV1, d *schema.ResourceData, vpcID, routeID string) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isRouteStatusPending, isRouteStatusUpdating},
@@ -219,7 +220,7 @@ func isWaitForClassicRouteStable(sess *vpcclassicv1.VpcClassicV1, d *schema.Reso
 			}
 			route, response, err := sess.GetVPCRoute(getVpcRouteOptions)
 			if err != nil {
-				return route, "", fmt.Errorf("Error Getting VPC Route: %s\n%s", err, response)
+				return route, "", apiErrorf("vpc_classic", err, response, "Error Getting VPC Route")
 			}
 
 			if *route.LifecycleState == "stable" || *route.LifecycleState == "failed" {
@@ -232,10 +233,10 @@ func isWaitForClassicRouteStable(sess *vpcclassicv1.VpcClassicV1, d *schema.Reso
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
-func isWaitForRouteStable(sess *vpcv1.VpcV1, d *schema.ResourceData, vpcID, routeID string) (interface{}, error) {
+func isWaitForRouteStable(context context.Context, sess *vpcv1.VpcV1, d *schema.ResourceData, vpcID, routeID string) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isRouteStatusPending, isRouteStatusUpdating},
@@ -247,7 +248,7 @@ func isWaitForRouteStable(sess *vpcv1.VpcV1, d *schema.ResourceData, vpcID, rout
 			}
 			route, response, err := sess.GetVPCRoute(getVpcRouteOptions)
 			if err != nil {
-				return route, "", fmt.Errorf("Error Getting VPC Route: %s\n%s", err, response)
+				return route, "", apiErrorf("vpc", err, response, "Error Getting VPC Route")
 			}
 
 			if *route.LifecycleState == "stable" || *route.LifecycleState == "failed" {
@@ -260,17 +261,26 @@ func isWaitForRouteStable(sess *vpcv1.VpcV1, d *schema.ResourceData, vpcID, rout
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
-func resourceIBMISVpcRouteRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVpcRouteRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMISVpcRouteExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	vpcID := parts[0]
@@ -278,12 +288,12 @@ func resourceIBMISVpcRouteRead(d *schema.ResourceData, meta interface{}) error {
 	if userDetails.generation == 1 {
 		err := classicVpcRouteGet(d, meta, vpcID, routeID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := vpcRouteGet(d, meta, vpcID, routeID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -304,7 +314,7 @@ func classicVpcRouteGet(d *schema.ResourceData, meta interface{}, vpcID, routeID
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting VPC Route (%s): %s\n%s", routeID, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting VPC Route (%s)", routeID)
 	}
 	d.Set(isVPCRouteVPCID, vpcID)
 	d.Set(isVPCRouteName, route.Name)
@@ -320,7 +330,7 @@ func classicVpcRouteGet(d *schema.ResourceData, meta interface{}, vpcID, routeID
 	}
 	vpc, response, err := sess.GetVPC(getVPCOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting VPC : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting VPC")
 	}
 	d.Set(RelatedCRN, *vpc.CRN)
 	return nil
@@ -341,7 +351,7 @@ func vpcRouteGet(d *schema.ResourceData, meta interface{}, vpcID, routeID string
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting VPC Route (%s): %s\n%s", routeID, err, response)
+		return apiErrorf("vpc", err, response, "Error Getting VPC Route (%s)", routeID)
 	}
 	d.Set(isVPCRouteVPCID, vpcID)
 	d.Set(isVPCRouteName, route.Name)
@@ -357,25 +367,25 @@ func vpcRouteGet(d *schema.ResourceData, meta interface{}, vpcID, routeID string
 	}
 	vpc, response, err := sess.GetVPC(getVPCOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting VPC : %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Getting VPC")
 	}
 	d.Set(RelatedCRN, *vpc.CRN)
 
 	return nil
 }
 
-func resourceIBMISVpcRouteUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVpcRouteUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	name := ""
 	hasChanged := false
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	vpcID := parts[0]
@@ -388,16 +398,16 @@ func resourceIBMISVpcRouteUpdate(d *schema.ResourceData, meta interface{}) error
 	if userDetails.generation == 1 {
 		err := classicVpcRouteUpdate(d, meta, vpcID, routeID, name, hasChanged)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := vpcRouteUpdate(d, meta, vpcID, routeID, name, hasChanged)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceIBMISVpcRouteRead(d, meta)
+	return resourceIBMISVpcRouteRead(context, d, meta)
 }
 
 func classicVpcRouteUpdate(d *schema.ResourceData, meta interface{}, vpcID, routeID, name string, hasChanged bool) error {
@@ -420,7 +430,7 @@ func classicVpcRouteUpdate(d *schema.ResourceData, meta interface{}, vpcID, rout
 		updateVpcRouteOptions.RoutePatch = routePatch
 		_, response, err := sess.UpdateVPCRoute(updateVpcRouteOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating VPC Route: %s\n%s", err, response)
+			return apiErrorf("vpc_classic", err, response, "Error Updating VPC Route")
 		}
 	}
 	return nil
@@ -446,32 +456,32 @@ func vpcRouteUpdate(d *schema.ResourceData, meta interface{}, vpcID, routeID, na
 		updateVpcRouteOptions.RoutePatch = routePatch
 		_, response, err := sess.UpdateVPCRoute(updateVpcRouteOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating VPC Route: %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error Updating VPC Route")
 		}
 	}
 	return nil
 }
 
-func resourceIBMISVpcRouteDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVpcRouteDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	vpcID := parts[0]
 	routeID := parts[1]
 	if userDetails.generation == 1 {
-		err := classicVpcRouteDelete(d, meta, vpcID, routeID)
+		err := classicVpcRouteDelete(context, d, meta, vpcID, routeID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		err := vpcRouteDelete(d, meta, vpcID, routeID)
+		err := vpcRouteDelete(context, d, meta, vpcID, routeID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -479,7 +489,7 @@ func resourceIBMISVpcRouteDelete(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func classicVpcRouteDelete(d *schema.ResourceData, meta interface{}, vpcID, routeID string) error {
+func classicVpcRouteDelete(context context.Context, d *schema.ResourceData, meta interface{}, vpcID, routeID string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -494,7 +504,7 @@ func classicVpcRouteDelete(d *schema.ResourceData, meta interface{}, vpcID, rout
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error Getting VPC Route (%s): %s\n%s", routeID, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting VPC Route (%s)", routeID)
 	}
 	deleteRouteOptions := &vpcclassicv1.DeleteVPCRouteOptions{
 		VPCID: &vpcID,
@@ -502,9 +512,9 @@ func classicVpcRouteDelete(d *schema.ResourceData, meta interface{}, vpcID, rout
 	}
 	response, err = sess.DeleteVPCRoute(deleteRouteOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting VPC Route: %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Deleting VPC Route")
 	}
-	_, err = isWaitForClassicVPCRouteDeleted(sess, vpcID, routeID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicVPCRouteDeleted(context, sess, vpcID, routeID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -512,7 +522,7 @@ func classicVpcRouteDelete(d *schema.ResourceData, meta interface{}, vpcID, rout
 	return nil
 }
 
-func vpcRouteDelete(d *schema.ResourceData, meta interface{}, vpcID, routeID string) error {
+func vpcRouteDelete(context context.Context, d *schema.ResourceData, meta interface{}, vpcID, routeID string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -527,7 +537,7 @@ func vpcRouteDelete(d *schema.ResourceData, meta interface{}, vpcID, routeID str
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error Getting VPC Route (%s): %s\n%s", routeID, err, response)
+		return apiErrorf("vpc", err, response, "Error Getting VPC Route (%s)", routeID)
 	}
 	deleteRouteOptions := &vpcv1.DeleteVPCRouteOptions{
 		VPCID: &vpcID,
@@ -535,9 +545,9 @@ func vpcRouteDelete(d *schema.ResourceData, meta interface{}, vpcID, routeID str
 	}
 	response, err = sess.DeleteVPCRoute(deleteRouteOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting VPC Route: %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Deleting VPC Route")
 	}
-	_, err = isWaitForVPCRouteDeleted(sess, vpcID, routeID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForVPCRouteDeleted(context, sess, vpcID, routeID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -545,7 +555,7 @@ func vpcRouteDelete(d *schema.ResourceData, meta interface{}, vpcID, routeID str
 	return nil
 }
 
-func isWaitForClassicVPCRouteDeleted(sess *vpcclassicv1.VpcClassicV1, vpcID, routeID string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicVPCRouteDeleted(context context.Context, sess *vpcclassicv1.VpcClassicV1, vpcID, routeID string, timeout time.Duration) (interface{}, error) {
 
 	log.Printf("Waiting for VPC Route (%s) to be deleted.", routeID)
 	stateConf := &resource.StateChangeConf{
@@ -561,7 +571,7 @@ func isWaitForClassicVPCRouteDeleted(sess *vpcclassicv1.VpcClassicV1, vpcID, rou
 				if response != nil && response.StatusCode == 404 {
 					return route, isRouteStatusDeleted, nil
 				}
-				return route, isRouteStatusDeleting, fmt.Errorf("The VPC route %s failed to delete: %s\n%s", routeID, err, response)
+				return route, isRouteStatusDeleting, apiErrorf("vpc_classic", err, response, "The VPC route %s failed to delete", routeID)
 			}
 
 			return route, isRouteStatusDeleting, nil
@@ -571,10 +581,10 @@ func isWaitForClassicVPCRouteDeleted(sess *vpcclassicv1.VpcClassicV1, vpcID, rou
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
-func isWaitForVPCRouteDeleted(sess *vpcv1.VpcV1, vpcID, routeID string, timeout time.Duration) (interface{}, error) {
+func isWaitForVPCRouteDeleted(context context.Context, sess *vpcv1.VpcV1, vpcID, routeID string, timeout time.Duration) (interface{}, error) {
 
 	log.Printf("Waiting for VPC Route (%s) to be deleted.", routeID)
 	stateConf := &resource.StateChangeConf{
@@ -590,7 +600,7 @@ func isWaitForVPCRouteDeleted(sess *vpcv1.VpcV1, vpcID, routeID string, timeout
 				if response != nil && response.StatusCode == 404 {
 					return route, isRouteStatusDeleted, nil
 				}
-				return route, isRouteStatusDeleting, fmt.Errorf("The VPC route %s failed to delete: %s\n%s", routeID, err, response)
+				return route, isRouteStatusDeleting, apiErrorf("vpc", err, response, "The VPC route %s failed to delete", routeID)
 			}
 			return route, isRouteStatusDeleting, nil
 		},
@@ -599,7 +609,7 @@ func isWaitForVPCRouteDeleted(sess *vpcv1.VpcV1, vpcID, routeID string, timeout
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func resourceIBMISVpcRouteExists(d *schema.ResourceData, meta interface{}) (bool, error) {
@@ -637,7 +647,7 @@ func classicVpcRouteExists(d *schema.ResourceData, meta interface{}, vpcID, rout
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting VPC Route: %s\n%s", err, response)
+		return false, apiErrorf("vpc_classic", err, response, "Error getting VPC Route")
 	}
 	return true, nil
 }
@@ -656,7 +666,7 @@ func vpcRouteExists(d *schema.ResourceData, meta interface{}, vpcID, routeID str
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting VPC Route: %s\n%s", err, response)
+		return false, apiErrorf("vpc", err, response, "Error getting VPC Route")
 	}
 	return true, nil
 }
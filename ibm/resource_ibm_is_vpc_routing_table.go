@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/IBM/go-sdk-core/v4/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -36,12 +38,11 @@ const (
 
 func resourceIBMISVPCRoutingTable() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISVPCRoutingTableCreate,
-		Read:     resourceIBMISVPCRoutingTableRead,
-		Update:   resourceIBMISVPCRoutingTableUpdate,
-		Delete:   resourceIBMISVPCRoutingTableDelete,
-		Exists:   resourceIBMISVPCRoutingTableExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISVPCRoutingTableCreate,
+		ReadContext:   resourceIBMISVPCRoutingTableRead,
+		UpdateContext: resourceIBMISVPCRoutingTableUpdate,
+		DeleteContext: resourceIBMISVPCRoutingTableDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -163,10 +164,10 @@ func resourceIBMISVPCRoutingTableValidator() *ResourceValidator {
 	return &ibmISVPCRoutingTableValidator
 }
 
-func resourceIBMISVPCRoutingTableCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCRoutingTableCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	vpcID := d.Get(rtVpcID).(string)
@@ -189,18 +190,27 @@ func resourceIBMISVPCRoutingTableCreate(d *schema.ResourceData, meta interface{}
 	routeTable, response, err := sess.CreateVPCRoutingTable(createVpcRoutingTableOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Create VPC Routing table err %s\n%s", err, response)
-		return err
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error creating VPC routing table"))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", vpcID, *routeTable.ID))
 
-	return resourceIBMISVPCRoutingTableRead(d, meta)
+	return resourceIBMISVPCRoutingTableRead(context, d, meta)
 }
 
-func resourceIBMISVPCRoutingTableRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCRoutingTableRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMISVPCRoutingTableExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	idSet := strings.Split(d.Id(), "/")
@@ -211,7 +221,7 @@ func resourceIBMISVPCRoutingTableRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting VPC Routing table: %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error Getting VPC Routing table"))
 	}
 
 	d.Set(rtID, routeTable.ID)
@@ -240,10 +250,10 @@ func resourceIBMISVPCRoutingTableRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceIBMISVPCRoutingTableUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCRoutingTableUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	idSet := strings.Split(d.Id(), "/")
@@ -271,21 +281,21 @@ func resourceIBMISVPCRoutingTableUpdate(d *schema.ResourceData, meta interface{}
 	}
 	routingTablePatchModelAsPatch, asPatchErr := routingTablePatchModel.AsPatch()
 	if asPatchErr != nil {
-		return fmt.Errorf("Error calling asPatch for RoutingTablePatchModel: %s", asPatchErr)
+		return diag.FromErr(fmt.Errorf("Error calling asPatch for RoutingTablePatchModel: %s", asPatchErr))
 	}
 	updateVpcRoutingTableOptions.RoutingTablePatch = routingTablePatchModelAsPatch
 	_, response, err := sess.UpdateVPCRoutingTable(updateVpcRoutingTableOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Update VPC Routing table err %s\n%s", err, response)
-		return err
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error updating VPC routing table (%s)", d.Id()))
 	}
-	return resourceIBMISVPCRoutingTableRead(d, meta)
+	return resourceIBMISVPCRoutingTableRead(context, d, meta)
 }
 
-func resourceIBMISVPCRoutingTableDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCRoutingTableDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	idSet := strings.Split(d.Id(), "/")
@@ -294,7 +304,7 @@ func resourceIBMISVPCRoutingTableDelete(d *schema.ResourceData, meta interface{}
 	response, err := sess.DeleteVPCRoutingTable(deleteTableOptions)
 	if err != nil && response.StatusCode != 404 {
 		log.Printf("Error deleting VPC Routing table : %s", response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
@@ -318,7 +328,7 @@ func resourceIBMISVPCRoutingTableExists(d *schema.ResourceData, meta interface{}
 			d.SetId("")
 			return false, nil
 		}
-		return false, fmt.Errorf("Error Getting VPC Routing table : %s\n%s", err, response)
+		return false, apiErrorf("vpc", err, response, "Error Getting VPC Routing table")
 	}
 	return true, nil
 }
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/IBM/go-sdk-core/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -26,12 +28,11 @@ const (
 
 func resourceIBMISVPCRoutingTableRoute() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISVPCRoutingTableRouteCreate,
-		Read:     resourceIBMISVPCRoutingTableRouteRead,
-		Update:   resourceIBMISVPCRoutingTableRouteUpdate,
-		Delete:   resourceIBMISVPCRoutingTableRouteDelete,
-		Exists:   resourceIBMISVPCRoutingTableRouteExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISVPCRoutingTableRouteCreate,
+		ReadContext:   resourceIBMISVPCRoutingTableRouteRead,
+		UpdateContext: resourceIBMISVPCRoutingTableRouteUpdate,
+		DeleteContext: resourceIBMISVPCRoutingTableRouteDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -142,10 +143,10 @@ func resourceIBMISVPCRoutingTableRouteValidator() *ResourceValidator {
 	return &ibmVPCRoutingTableRouteValidator
 }
 
-func resourceIBMISVPCRoutingTableRouteCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCRoutingTableRouteCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	vpcID := d.Get(rtVpcID).(string)
@@ -188,18 +189,27 @@ func resourceIBMISVPCRoutingTableRouteCreate(d *schema.ResourceData, meta interf
 	route, response, err := sess.CreateVPCRoutingTableRoute(createVpcRoutingTableRouteOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Create VPC Routing table route err %s\n%s", err, response)
-		return err
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error creating VPC routing table route"))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", vpcID, tableID, *route.ID))
 	d.Set(rID, *route.ID)
-	return resourceIBMISVPCRoutingTableRouteRead(d, meta)
+	return resourceIBMISVPCRoutingTableRouteRead(context, d, meta)
 }
 
-func resourceIBMISVPCRoutingTableRouteRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCRoutingTableRouteRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMISVPCRoutingTableRouteExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	idSet := strings.Split(d.Id(), "/")
@@ -210,7 +220,7 @@ func resourceIBMISVPCRoutingTableRouteRead(d *schema.ResourceData, meta interfac
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting VPC Routing table route: %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error Getting VPC Routing table route"))
 	}
 
 	d.Set(rID, *route.ID)
@@ -235,10 +245,10 @@ func resourceIBMISVPCRoutingTableRouteRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceIBMISVPCRoutingTableRouteUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCRoutingTableRouteUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	idSet := strings.Split(d.Id(), "/")
@@ -253,24 +263,24 @@ func resourceIBMISVPCRoutingTableRouteUpdate(d *schema.ResourceData, meta interf
 		routePatchModelAsPatch, patchErr := routePatchModel.AsPatch()
 
 		if patchErr != nil {
-			return fmt.Errorf("Error calling asPatch for VPC Routing Table Route Patch: %s", patchErr)
+			return diag.FromErr(fmt.Errorf("Error calling asPatch for VPC Routing Table Route Patch: %s", patchErr))
 		}
 
 		updateVpcRoutingTableRouteOptions.RoutePatch = routePatchModelAsPatch
 		_, response, err := sess.UpdateVPCRoutingTableRoute(updateVpcRoutingTableRouteOptions)
 		if err != nil {
 			log.Printf("[DEBUG] Update VPC Routing table route err %s\n%s", err, response)
-			return err
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error updating VPC routing table route (%s)", d.Id()))
 		}
 	}
 
-	return resourceIBMISVPCRoutingTableRouteRead(d, meta)
+	return resourceIBMISVPCRoutingTableRouteRead(context, d, meta)
 }
 
-func resourceIBMISVPCRoutingTableRouteDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCRoutingTableRouteDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	idSet := strings.Split(d.Id(), "/")
@@ -278,7 +288,7 @@ func resourceIBMISVPCRoutingTableRouteDelete(d *schema.ResourceData, meta interf
 	response, err := sess.DeleteVPCRoutingTableRoute(deleteVpcRoutingTableRouteOptions)
 	if err != nil && response.StatusCode != 404 {
 		log.Printf("Error deleting VPC Routing table route : %s", response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
@@ -302,7 +312,7 @@ func resourceIBMISVPCRoutingTableRouteExists(d *schema.ResourceData, meta interf
 			d.SetId("")
 			return false, nil
 		}
-		return false, fmt.Errorf("Error Getting VPC Routing table route : %s\n%s", err, response)
+		return false, apiErrorf("vpc", err, response, "Error Getting VPC Routing table route")
 	}
 	return true, nil
 }
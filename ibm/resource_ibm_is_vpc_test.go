@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -12,7 +13,9 @@ import (
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
 )
 
 func TestAccIBMISVPC_basic(t *testing.T) {
@@ -224,3 +227,38 @@ func testAccCheckIBMISVPCSgConfig(vpcname string, sgname string) string {
 `, vpcname, sgname)
 
 }
+
+func TestIBMISVPCMock(t *testing.T) {
+	t.Parallel()
+	srv := testMockServer(t)
+	meta := testMockClientSession(t, srv)
+	r := resourceIBMISVPC()
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		isVPCName:                     "mock-vpc",
+		isVPCDefaultSecurityGroupName: "mock-vpc-sg",
+		isVPCTags:                     []interface{}{"env:test"},
+	})
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), d, meta)))
+	assert.Assert(t, d.Id() != "")
+	assert.Equal(t, d.Get(isVPCStatus), "available")
+	assert.Equal(t, d.Get(isVPCDefaultSecurityGroupName), "mock-vpc-sg")
+	assert.DeepEqual(t, sortedTags(d.Get(isVPCTags)), []string{"env:test"})
+	assert.Equal(t, len(d.Get(isVPCSecurityGroupList).([]interface{})), 1)
+
+	d = testMockResourceData(t, r, d, map[string]interface{}{
+		isVPCName:                     "mock-vpc-renamed",
+		isVPCDefaultSecurityGroupName: "mock-vpc-sg",
+		isVPCTags:                     []interface{}{"env:test", "team:network"},
+	})
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), d, meta)))
+	assert.Equal(t, d.Get(isVPCName), "mock-vpc-renamed")
+	assert.DeepEqual(t, sortedTags(d.Get(isVPCTags)), []string{"env:test", "team:network"})
+
+	id := d.Id()
+	assert.NilError(t, testDiagsErr(r.DeleteContext(context.Background(), d, meta)))
+	assert.Equal(t, d.Id(), "")
+	d.SetId(id)
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), d, meta)))
+	assert.Equal(t, d.Id(), "")
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -38,12 +39,11 @@ const (
 
 func resourceIBMISVPNGateway() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISVPNGatewayCreate,
-		Read:     resourceIBMISVPNGatewayRead,
-		Update:   resourceIBMISVPNGatewayUpdate,
-		Delete:   resourceIBMISVPNGatewayDelete,
-		Exists:   resourceIBMISVPNGatewayExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISVPNGatewayCreate,
+		ReadContext:   resourceIBMISVPNGatewayRead,
+		UpdateContext: resourceIBMISVPNGatewayUpdate,
+		DeleteContext: resourceIBMISVPNGatewayDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -234,10 +234,11 @@ func resourceIBMISVPNGatewayValidator() *ResourceValidator {
 	return &ibmISVPNGatewayResourceValidator
 }
 
-func resourceIBMISVPNGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPNGatewayCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	log.Printf("[DEBUG] VPNGateway create")
 	name := d.Get(isVPNGatewayName).(string)
@@ -245,23 +246,24 @@ func resourceIBMISVPNGatewayCreate(d *schema.ResourceData, meta interface{}) err
 	mode := d.Get(isVPNGatewayMode).(string)
 
 	if userDetails.generation == 1 {
-		err := classicVpngwCreate(d, meta, name, subnetID)
-		if err != nil {
-			return err
+		diags = append(diags, classicVpngwCreate(context, d, meta, name, subnetID)...)
+		if diags.HasError() {
+			return diags
 		}
 	} else {
-		err := vpngwCreate(d, meta, name, subnetID, mode)
-		if err != nil {
-			return err
+		diags = append(diags, vpngwCreate(context, d, meta, name, subnetID, mode)...)
+		if diags.HasError() {
+			return diags
 		}
 	}
-	return resourceIBMISVPNGatewayRead(d, meta)
+	return append(diags, resourceIBMISVPNGatewayRead(context, d, meta)...)
 }
 
-func classicVpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID string) error {
+func classicVpngwCreate(context context.Context, d *schema.ResourceData, meta interface{}, name, subnetID string) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := classicVpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	vpnGatewayPrototype := &vpcclassicv1.VPNGatewayPrototype{
 		Subnet: &vpcclassicv1.SubnetIdentity{
@@ -282,12 +284,12 @@ func classicVpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID
 
 	vpnGatewayIntf, response, err := sess.CreateVPNGateway(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Create vpc VPN Gateway %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc_classic", err, response, "Error creating VPN Gateway"))
 	}
 	vpnGateway := vpnGatewayIntf.(*vpcclassicv1.VPNGateway)
-	_, err = isWaitForClassicVpnGatewayAvailable(sess, *vpnGateway.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicVpnGatewayAvailable(context, sess, *vpnGateway.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(*vpnGateway.ID)
@@ -300,15 +302,17 @@ func classicVpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc VPN Gateway (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
-	return nil
+	return diags
 }
 
-func vpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID, mode string) error {
+func vpngwCreate(context context.Context, d *schema.ResourceData, meta interface{}, name, subnetID, mode string) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	vpnGatewayPrototype := &vpcv1.VPNGatewayPrototype{
 		Subnet: &vpcv1.SubnetIdentity{
@@ -330,13 +334,13 @@ func vpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID, mode
 
 	vpnGatewayIntf, response, err := sess.CreateVPNGateway(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Create vpc VPN Gateway %s\n%s", err, response)
+		return diag.FromErr(apiErrorf("vpc", err, response, "Error creating VPN Gateway"))
 	}
 	vpnGateway := vpnGatewayIntf.(*vpcv1.VPNGateway)
 
-	_, err = isWaitForVpnGatewayAvailable(sess, *vpnGateway.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForVpnGatewayAvailable(context, sess, *vpnGateway.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(*vpnGateway.ID)
@@ -349,12 +353,13 @@ func vpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID, mode
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc VPN Gateway (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
-	return nil
+	return diags
 }
 
-func isWaitForClassicVpnGatewayAvailable(vpnGateway *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicVpnGatewayAvailable(context context.Context, vpnGateway *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for vpn gateway (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -366,7 +371,7 @@ func isWaitForClassicVpnGatewayAvailable(vpnGateway *vpcclassicv1.VpcClassicV1,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isClassicVpnGatewayRefreshFunc(vpnGateway *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -376,7 +381,7 @@ func isClassicVpnGatewayRefreshFunc(vpnGateway *vpcclassicv1.VpcClassicV1, id st
 		}
 		vpnGatewayIntf, response, err := vpnGateway.GetVPNGateway(getVpnGatewayOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting Vpn Gateway: %s\n%s", err, response)
+			return nil, "", apiErrorf("vpc_classic", err, response, "Error Getting Vpn Gateway")
 		}
 		vpnGateway := vpnGatewayIntf.(*vpcclassicv1.VPNGateway)
 
@@ -388,7 +393,7 @@ func isClassicVpnGatewayRefreshFunc(vpnGateway *vpcclassicv1.VpcClassicV1, id st
 	}
 }
 
-func isWaitForVpnGatewayAvailable(vpnGateway *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVpnGatewayAvailable(context context.Context, vpnGateway *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for vpn gateway (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -400,7 +405,7 @@ func isWaitForVpnGatewayAvailable(vpnGateway *vpcv1.VpcV1, id string, timeout ti
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isVpnGatewayRefreshFunc(vpnGateway *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -410,7 +415,7 @@ func isVpnGatewayRefreshFunc(vpnGateway *vpcv1.VpcV1, id string) resource.StateR
 		}
 		vpnGatewayIntf, response, err := vpnGateway.GetVPNGateway(getVpnGatewayOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting Vpn Gateway: %s\n%s", err, response)
+			return nil, "", apiErrorf("vpc", err, response, "Error Getting Vpn Gateway")
 		}
 		vpnGateway := vpnGatewayIntf.(*vpcv1.VPNGateway)
 
@@ -422,22 +427,31 @@ func isVpnGatewayRefreshFunc(vpnGateway *vpcv1.VpcV1, id string) resource.StateR
 	}
 }
 
-func resourceIBMISVPNGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPNGatewayRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMISVPNGatewayExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicVpngwGet(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := vpngwGet(d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -457,7 +471,7 @@ func classicVpngwGet(d *schema.ResourceData, meta interface{}, id string) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Vpn Gateway (%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting Vpn Gateway (%s)", id)
 	}
 	vpnGateway := vpnGatewayIntf.(*vpcclassicv1.VPNGateway)
 	d.Set(isVPNGatewayName, *vpnGateway.Name)
@@ -508,7 +522,7 @@ func vpngwGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Vpn Gateway (%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc", err, response, "Error Getting Vpn Gateway (%s)", id)
 	}
 	vpnGateway := vpnGatewayIntf.(*vpcv1.VPNGateway)
 
@@ -573,10 +587,11 @@ func vpngwGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISVPNGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPNGatewayUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	id := d.Id()
@@ -589,23 +604,24 @@ func resourceIBMISVPNGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	if userDetails.generation == 1 {
-		err := classicVpngwUpdate(d, meta, id, name, hasChanged)
-		if err != nil {
-			return err
+		diags = append(diags, classicVpngwUpdate(d, meta, id, name, hasChanged)...)
+		if diags.HasError() {
+			return diags
 		}
 	} else {
-		err := vpngwUpdate(d, meta, id, name, hasChanged)
-		if err != nil {
-			return err
+		diags = append(diags, vpngwUpdate(d, meta, id, name, hasChanged)...)
+		if diags.HasError() {
+			return diags
 		}
 	}
-	return resourceIBMISVPNGatewayRead(d, meta)
+	return append(diags, resourceIBMISVPNGatewayRead(context, d, meta)...)
 }
 
-func classicVpngwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
+func classicVpngwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := classicVpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if d.HasChange(isVPNGatewayTags) {
 		getVpnGatewayOptions := &vpcclassicv1.GetVPNGatewayOptions{
@@ -613,7 +629,7 @@ func classicVpngwUpdate(d *schema.ResourceData, meta interface{}, id, name strin
 		}
 		vpnGatewayIntf, response, err := sess.GetVPNGateway(getVpnGatewayOptions)
 		if err != nil {
-			return fmt.Errorf("Error getting Volume : %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc_classic", err, response, "Error getting Volume"))
 		}
 		vpnGateway := vpnGatewayIntf.(*vpcclassicv1.VPNGateway)
 
@@ -622,6 +638,7 @@ func classicVpngwUpdate(d *schema.ResourceData, meta interface{}, id, name strin
 		if err != nil {
 			log.Printf(
 				"Error on update of resource vpc Vpn Gateway (%s) tags: %s", id, err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 	if hasChanged {
@@ -633,21 +650,22 @@ func classicVpngwUpdate(d *schema.ResourceData, meta interface{}, id, name strin
 		}
 		vpnGatewayPatch, err := vpnGatewayPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for VPNGatewayPatch: %s", err)
+			return diag.FromErr(fmt.Errorf("Error calling asPatch for VPNGatewayPatch: %s", err))
 		}
 		options.VPNGatewayPatch = vpnGatewayPatch
 		_, response, err := sess.UpdateVPNGateway(options)
 		if err != nil {
-			return fmt.Errorf("Error updating vpc Vpn Gateway: %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc_classic", err, response, "Error updating vpc Vpn Gateway"))
 		}
 	}
-	return nil
+	return diags
 }
 
-func vpngwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
+func vpngwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if d.HasChange(isVPNGatewayTags) {
 		getVpnGatewayOptions := &vpcv1.GetVPNGatewayOptions{
@@ -655,7 +673,7 @@ func vpngwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 		}
 		vpnGatewayIntf, response, err := sess.GetVPNGateway(getVpnGatewayOptions)
 		if err != nil {
-			return fmt.Errorf("Error getting Volume : %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error getting Volume"))
 		}
 		vpnGateway := vpnGatewayIntf.(*vpcv1.VPNGateway)
 
@@ -664,6 +682,7 @@ func vpngwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 		if err != nil {
 			log.Printf(
 				"Error on update of resource vpc Vpn Gateway (%s) tags: %s", id, err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 	if hasChanged {
@@ -675,38 +694,38 @@ func vpngwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 		}
 		vpnGatewayPatch, err := vpnGatewayPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for VPNGatewayPatch: %s", err)
+			return diag.FromErr(fmt.Errorf("Error calling asPatch for VPNGatewayPatch: %s", err))
 		}
 		options.VPNGatewayPatch = vpnGatewayPatch
 		_, response, err := sess.UpdateVPNGateway(options)
 		if err != nil {
-			return fmt.Errorf("Error updating vpc Vpn Gateway: %s\n%s", err, response)
+			return diag.FromErr(apiErrorf("vpc", err, response, "Error updating vpc Vpn Gateway"))
 		}
 	}
-	return nil
+	return diags
 }
 
-func resourceIBMISVPNGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPNGatewayDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
-		err := classicVpngwDelete(d, meta, id)
+		err := classicVpngwDelete(context, d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		err := vpngwDelete(d, meta, id)
+		err := vpngwDelete(context, d, meta, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
 }
 
-func classicVpngwDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func classicVpngwDelete(context context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -720,7 +739,7 @@ func classicVpngwDelete(d *schema.ResourceData, meta interface{}, id string) err
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error Getting Vpn Gateway (%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting Vpn Gateway (%s)", id)
 	}
 
 	options := &vpcclassicv1.DeleteVPNGatewayOptions{
@@ -728,9 +747,9 @@ func classicVpngwDelete(d *schema.ResourceData, meta interface{}, id string) err
 	}
 	response, err = sess.DeleteVPNGateway(options)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Vpn Gateway : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Deleting Vpn Gateway")
 	}
-	_, err = isWaitForClassicVpnGatewayDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicVpnGatewayDeleted(context, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -738,7 +757,7 @@ func classicVpngwDelete(d *schema.ResourceData, meta interface{}, id string) err
 	return nil
 }
 
-func vpngwDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func vpngwDelete(context context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -752,7 +771,7 @@ func vpngwDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error Getting Vpn Gateway (%s): %s\n%s", id, err, response)
+		return apiErrorf("vpc", err, response, "Error Getting Vpn Gateway (%s)", id)
 	}
 
 	options := &vpcv1.DeleteVPNGatewayOptions{
@@ -760,9 +779,9 @@ func vpngwDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteVPNGateway(options)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Vpn Gateway : %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Deleting Vpn Gateway")
 	}
-	_, err = isWaitForVpnGatewayDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForVpnGatewayDeleted(context, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -770,7 +789,7 @@ func vpngwDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func isWaitForClassicVpnGatewayDeleted(vpnGateway *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicVpnGatewayDeleted(context context.Context, vpnGateway *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VPNGateway (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -782,7 +801,7 @@ func isWaitForClassicVpnGatewayDeleted(vpnGateway *vpcclassicv1.VpcClassicV1, id
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isClassicVpnGatewayDeleteRefreshFunc(vpnGateway *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -795,13 +814,13 @@ func isClassicVpnGatewayDeleteRefreshFunc(vpnGateway *vpcclassicv1.VpcClassicV1,
 			if response != nil && response.StatusCode == 404 {
 				return "", isVPNGatewayDeleted, nil
 			}
-			return "", "", fmt.Errorf("Error Getting Vpn Gateway: %s\n%s", err, response)
+			return "", "", apiErrorf("vpc_classic", err, response, "Error Getting Vpn Gateway")
 		}
 		return vpngw, isVPNGatewayDeleting, err
 	}
 }
 
-func isWaitForVpnGatewayDeleted(vpnGateway *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVpnGatewayDeleted(context context.Context, vpnGateway *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VPNGateway (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -813,7 +832,7 @@ func isWaitForVpnGatewayDeleted(vpnGateway *vpcv1.VpcV1, id string, timeout time
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isVpnGatewayDeleteRefreshFunc(vpnGateway *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -826,7 +845,7 @@ func isVpnGatewayDeleteRefreshFunc(vpnGateway *vpcv1.VpcV1, id string) resource.
 			if response != nil && response.StatusCode == 404 {
 				return "", isVPNGatewayDeleted, nil
 			}
-			return "", "", fmt.Errorf("Error Getting Vpn Gateway: %s\n%s", err, response)
+			return "", "", apiErrorf("vpc", err, response, "Error Getting Vpn Gateway")
 		}
 		return vpngw, isVPNGatewayDeleting, err
 	}
@@ -860,7 +879,7 @@ func classicVpngwExists(d *schema.ResourceData, meta interface{}, id string) (bo
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Vpn Gatewa: %s\n%s", err, response)
+		return false, apiErrorf("vpc_classic", err, response, "Error getting Vpn Gatewa")
 	}
 	return true, nil
 }
@@ -878,7 +897,7 @@ func vpngwExists(d *schema.ResourceData, meta interface{}, id string) (bool, err
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Vpn Gatewa: %s\n%s", err, response)
+		return false, apiErrorf("vpc", err, response, "Error getting Vpn Gatewa")
 	}
 	return true, nil
 }
@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -42,12 +44,11 @@ const (
 
 func resourceIBMISVPNGatewayConnection() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISVPNGatewayConnectionCreate,
-		Read:     resourceIBMISVPNGatewayConnectionRead,
-		Update:   resourceIBMISVPNGatewayConnectionUpdate,
-		Delete:   resourceIBMISVPNGatewayConnectionDelete,
-		Exists:   resourceIBMISVPNGatewayConnectionExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISVPNGatewayConnectionCreate,
+		ReadContext:   resourceIBMISVPNGatewayConnectionRead,
+		UpdateContext: resourceIBMISVPNGatewayConnectionUpdate,
+		DeleteContext: resourceIBMISVPNGatewayConnectionDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -246,10 +247,10 @@ func resourceIBMISVPNGatewayConnectionValidator() *ResourceValidator {
 	return &ibmISVPNGatewayConnectionResourceValidator
 }
 
-func resourceIBMISVPNGatewayConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPNGatewayConnectionCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] VPNGatewayConnection create")
@@ -284,15 +285,15 @@ func resourceIBMISVPNGatewayConnectionCreate(d *schema.ResourceData, meta interf
 	if userDetails.generation == 1 {
 		err := classicVpngwconCreate(d, meta, name, gatewayID, peerAddress, prephasedKey, action, interval, timeout, stateUp)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := vpngwconCreate(d, meta, name, gatewayID, peerAddress, prephasedKey, action, interval, timeout, stateUp)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceIBMISVPNGatewayConnectionRead(d, meta)
+	return resourceIBMISVPNGatewayConnectionRead(context, d, meta)
 }
 
 func classicVpngwconCreate(d *schema.ResourceData, meta interface{}, name, gatewayID, peerAddress, prephasedKey, action string, interval, timeout int64, stateUp bool) error {
@@ -342,7 +343,7 @@ func classicVpngwconCreate(d *schema.ResourceData, meta interface{}, name, gatew
 
 	vpnGatewayConnectionIntf, response, err := sess.CreateVPNGatewayConnection(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Create VPN Gateway Connection err %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error creating VPN Gateway Connection")
 	}
 	vpnGatewayConnection := vpnGatewayConnectionIntf.(*vpcclassicv1.VPNGatewayConnection)
 	d.SetId(fmt.Sprintf("%s/%s", gatewayID, *vpnGatewayConnection.ID))
@@ -402,7 +403,7 @@ func vpngwconCreate(d *schema.ResourceData, meta interface{}, name, gatewayID, p
 
 	vpnGatewayConnectionIntf, response, err := sess.CreateVPNGatewayConnection(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Create VPN Gateway Connection err %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error creating VPN Gateway Connection")
 	}
 	vpnGatewayConnection := vpnGatewayConnectionIntf.(*vpcv1.VPNGatewayConnection)
 	d.SetId(fmt.Sprintf("%s/%s", gatewayID, *vpnGatewayConnection.ID))
@@ -410,15 +411,24 @@ func vpngwconCreate(d *schema.ResourceData, meta interface{}, name, gatewayID, p
 	return nil
 }
 
-func resourceIBMISVPNGatewayConnectionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPNGatewayConnectionRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMISVPNGatewayConnectionExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gID := parts[0]
@@ -427,12 +437,12 @@ func resourceIBMISVPNGatewayConnectionRead(d *schema.ResourceData, meta interfac
 	if userDetails.generation == 1 {
 		err := classicVpngwconGet(d, meta, gID, gConnID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := vpngwconGet(d, meta, gID, gConnID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -453,7 +463,7 @@ func classicVpngwconGet(d *schema.ResourceData, meta interface{}, gID, gConnID s
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Vpn Gateway Connection (%s): %s\n%s", gConnID, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting Vpn Gateway Connection (%s)", gConnID)
 	}
 	vpnGatewayConnection := vpnGatewayConnectionIntf.(*vpcclassicv1.VPNGatewayConnection)
 	d.Set(isVPNGatewayConnectionName, *vpnGatewayConnection.Name)
@@ -477,7 +487,7 @@ func classicVpngwconGet(d *schema.ResourceData, meta interface{}, gID, gConnID s
 	}
 	vpngatewayIntf, response, err := sess.GetVPNGateway(getVPNGatewayOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting VPN Gateway : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting VPN Gateway")
 	}
 	vpngateway := vpngatewayIntf.(*vpcclassicv1.VPNGateway)
 
@@ -500,7 +510,7 @@ func vpngwconGet(d *schema.ResourceData, meta interface{}, gID, gConnID string)
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Vpn Gateway Connection (%s): %s\n%s", gConnID, err, response)
+		return apiErrorf("vpc", err, response, "Error Getting Vpn Gateway Connection (%s)", gConnID)
 	}
 	d.Set(isVPNGatewayConnection, gConnID)
 	vpnGatewayConnection := vpnGatewayConnectionIntf.(*vpcv1.VPNGatewayConnection)
@@ -562,23 +572,23 @@ func vpngwconGet(d *schema.ResourceData, meta interface{}, gID, gConnID string)
 	}
 	vpngatewayIntf, response, err := sess.GetVPNGateway(getVPNGatewayOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting VPN Gateway : %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Getting VPN Gateway")
 	}
 	vpngateway := vpngatewayIntf.(*vpcv1.VPNGateway)
 	d.Set(RelatedCRN, *vpngateway.CRN)
 	return nil
 }
 
-func resourceIBMISVPNGatewayConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPNGatewayConnectionUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	hasChanged := false
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gID := parts[0]
@@ -587,15 +597,15 @@ func resourceIBMISVPNGatewayConnectionUpdate(d *schema.ResourceData, meta interf
 	if userDetails.generation == 1 {
 		err := classicVpngwconUpdate(d, meta, gID, gConnID, hasChanged)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := vpngwconUpdate(d, meta, gID, gConnID, hasChanged)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceIBMISVPNGatewayConnectionRead(d, meta)
+	return resourceIBMISVPNGatewayConnectionRead(context, d, meta)
 }
 
 func classicVpngwconUpdate(d *schema.ResourceData, meta interface{}, gID, gConnID string, hasChanged bool) error {
@@ -671,7 +681,7 @@ func classicVpngwconUpdate(d *schema.ResourceData, meta interface{}, gID, gConnI
 		updateVpnGatewayConnectionOptions.VPNGatewayConnectionPatch = vpnGatewayConnectionPatch
 		_, response, err := sess.UpdateVPNGatewayConnection(updateVpnGatewayConnectionOptions)
 		if err != nil {
-			return fmt.Errorf("Error updating Vpn Gateway Connection: %s\n%s", err, response)
+			return apiErrorf("vpc_classic", err, response, "Error updating Vpn Gateway Connection")
 		}
 	}
 	return nil
@@ -754,41 +764,41 @@ func vpngwconUpdate(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 		updateVpnGatewayConnectionOptions.VPNGatewayConnectionPatch = vpnGatewayConnectionPatch
 		_, response, err := sess.UpdateVPNGatewayConnection(updateVpnGatewayConnectionOptions)
 		if err != nil {
-			return fmt.Errorf("Error updating Vpn Gateway Connection: %s\n%s", err, response)
+			return apiErrorf("vpc", err, response, "Error updating Vpn Gateway Connection")
 		}
 	}
 	return nil
 }
 
-func resourceIBMISVPNGatewayConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPNGatewayConnectionDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gID := parts[0]
 	gConnID := parts[1]
 
 	if userDetails.generation == 1 {
-		err := classicVpngwconDelete(d, meta, gID, gConnID)
+		err := classicVpngwconDelete(context, d, meta, gID, gConnID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		err := vpngwconDelete(d, meta, gID, gConnID)
+		err := vpngwconDelete(context, d, meta, gID, gConnID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
 }
 
-func classicVpngwconDelete(d *schema.ResourceData, meta interface{}, gID, gConnID string) error {
+func classicVpngwconDelete(context context.Context, d *schema.ResourceData, meta interface{}, gID, gConnID string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -804,7 +814,7 @@ func classicVpngwconDelete(d *schema.ResourceData, meta interface{}, gID, gConnI
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Vpn Gateway Connection(%s): %s\n%s", gConnID, err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Getting Vpn Gateway Connection(%s)", gConnID)
 	}
 	deleteVpnGatewayConnectionOptions := &vpcclassicv1.DeleteVPNGatewayConnectionOptions{
 		VPNGatewayID: &gID,
@@ -812,10 +822,10 @@ func classicVpngwconDelete(d *schema.ResourceData, meta interface{}, gID, gConnI
 	}
 	response, err = sess.DeleteVPNGatewayConnection(deleteVpnGatewayConnectionOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Vpn Gateway Connection : %s\n%s", err, response)
+		return apiErrorf("vpc_classic", err, response, "Error Deleting Vpn Gateway Connection")
 	}
 
-	_, err = isWaitForClassicVPNGatewayConnectionDeleted(sess, gID, gConnID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicVPNGatewayConnectionDeleted(context, sess, gID, gConnID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for Vpn Gateway Connection (%s) is deleted: %s", gConnID, err)
@@ -825,7 +835,7 @@ func classicVpngwconDelete(d *schema.ResourceData, meta interface{}, gID, gConnI
 	return nil
 }
 
-func vpngwconDelete(d *schema.ResourceData, meta interface{}, gID, gConnID string) error {
+func vpngwconDelete(context context.Context, d *schema.ResourceData, meta interface{}, gID, gConnID string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -841,7 +851,7 @@ func vpngwconDelete(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Vpn Gateway Connection(%s): %s\n%s", gConnID, err, response)
+		return apiErrorf("vpc", err, response, "Error Getting Vpn Gateway Connection(%s)", gConnID)
 	}
 
 	deleteVpnGatewayConnectionOptions := &vpcv1.DeleteVPNGatewayConnectionOptions{
@@ -850,10 +860,10 @@ func vpngwconDelete(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 	}
 	response, err = sess.DeleteVPNGatewayConnection(deleteVpnGatewayConnectionOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Vpn Gateway Connection : %s\n%s", err, response)
+		return apiErrorf("vpc", err, response, "Error Deleting Vpn Gateway Connection")
 	}
 
-	_, err = isWaitForVPNGatewayConnectionDeleted(sess, gID, gConnID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForVPNGatewayConnectionDeleted(context, sess, gID, gConnID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for Vpn Gateway Connection (%s) is deleted: %s", gConnID, err)
@@ -863,7 +873,7 @@ func vpngwconDelete(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 	return nil
 }
 
-func isWaitForClassicVPNGatewayConnectionDeleted(vpnGatewayConnection *vpcclassicv1.VpcClassicV1, gID, gConnID string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicVPNGatewayConnectionDeleted(context context.Context, vpnGatewayConnection *vpcclassicv1.VpcClassicV1, gID, gConnID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VPNGatewayConnection (%s) to be deleted.", gConnID)
 
 	stateConf := &resource.StateChangeConf{
@@ -875,7 +885,7 @@ func isWaitForClassicVPNGatewayConnectionDeleted(vpnGatewayConnection *vpcclassi
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isClassicVPNGatewayConnectionDeleteRefreshFunc(vpnGatewayConnection *vpcclassicv1.VpcClassicV1, gID, gConnID string) resource.StateRefreshFunc {
@@ -889,13 +899,13 @@ func isClassicVPNGatewayConnectionDeleteRefreshFunc(vpnGatewayConnection *vpccla
 			if response != nil && response.StatusCode == 404 {
 				return "", isVPNGatewayConnectionDeleted, nil
 			}
-			return "", "", fmt.Errorf("The Vpn Gateway Connection %s failed to delete: %s\n%s", gConnID, err, response)
+			return "", "", apiErrorf("vpc_classic", err, response, "The Vpn Gateway Connection %s failed to delete", gConnID)
 		}
 		return vpngwcon, isVPNGatewayConnectionDeleting, nil
 	}
 }
 
-func isWaitForVPNGatewayConnectionDeleted(vpnGatewayConnection *vpcv1.VpcV1, gID, gConnID string, timeout time.Duration) (interface{}, error) {
+func isWaitForVPNGatewayConnectionDeleted(context context.Context, vpnGatewayConnection *vpcv1.VpcV1, gID, gConnID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VPNGatewayConnection (%s) to be deleted.", gConnID)
 
 	stateConf := &resource.StateChangeConf{
@@ -907,7 +917,7 @@ func isWaitForVPNGatewayConnectionDeleted(vpnGatewayConnection *vpcv1.VpcV1, gID
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isVPNGatewayConnectionDeleteRefreshFunc(vpnGatewayConnection *vpcv1.VpcV1, gID, gConnID string) resource.StateRefreshFunc {
@@ -921,7 +931,7 @@ func isVPNGatewayConnectionDeleteRefreshFunc(vpnGatewayConnection *vpcv1.VpcV1,
 			if response != nil && response.StatusCode == 404 {
 				return "", isVPNGatewayConnectionDeleted, nil
 			}
-			return "", "", fmt.Errorf("The Vpn Gateway Connection %s failed to delete: %s\n%s", gConnID, err, response)
+			return "", "", apiErrorf("vpc", err, response, "The Vpn Gateway Connection %s failed to delete", gConnID)
 		}
 		return vpngwcon, isVPNGatewayConnectionDeleting, nil
 	}
@@ -963,7 +973,7 @@ func classicVpngwconExists(d *schema.ResourceData, meta interface{}, gID, gConnI
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Vpn Gateway Connection: %s\n%s", err, response)
+		return false, apiErrorf("vpc_classic", err, response, "Error getting Vpn Gateway Connection")
 	}
 	return true, nil
 }
@@ -983,7 +993,7 @@ func vpngwconExists(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Vpn Gateway Connection: %s\n%s", err, response)
+		return false, apiErrorf("vpc", err, response, "Error getting Vpn Gateway Connection")
 	}
 	return true, nil
 }
@@ -0,0 +1,346 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"log"
+
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// kmsInstancePolicyBlocks are the blocks of the instance policies managed by
+// ibm_kms_instance_policies.
+var kmsInstancePolicyBlocks = []string{"dual_auth_delete", "metrics", "allowed_network", "key_create_import_access"}
+
+func resourceIBMKmsInstancePolicies() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMKmsInstancePoliciesCreate,
+		ReadContext:   resourceIBMKmsInstancePoliciesRead,
+		UpdateContext: resourceIBMKmsInstancePoliciesUpdate,
+		DeleteContext: resourceIBMKmsInstancePoliciesDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Key protect or hpcs instance GUID",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "public",
+				ValidateFunc: validateAllowedStringValue([]string{"public", "private"}),
+				Description:  "public or private",
+			},
+			"dual_auth_delete": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				AtLeastOneOf: kmsInstancePolicyBlocks,
+				Description:  "Dual authorization policy for the deletion of the keys of the instance",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "Whether the deletion of a key requires the authorization of two users",
+						},
+					},
+				},
+			},
+			"metrics": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				AtLeastOneOf: kmsInstancePolicyBlocks,
+				Description:  "Metrics policy of the instance",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "Whether the operational metrics of the instance are sent to IBM Cloud Monitoring",
+						},
+					},
+				},
+			},
+			"allowed_network": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				AtLeastOneOf: kmsInstancePolicyBlocks,
+				Description:  "Allowed network policy of the instance",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "Whether the network access to the instance is restricted",
+						},
+						"network": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "public-and-private",
+							ValidateFunc: validateAllowedStringValue([]string{"public-and-private", "private-only"}),
+							Description:  "The networks the instance is accessible from, public-and-private or private-only",
+						},
+					},
+				},
+			},
+			"key_create_import_access": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				AtLeastOneOf: kmsInstancePolicyBlocks,
+				Description:  "Key create and import access policy of the instance",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "Whether the creation and import of keys is restricted",
+						},
+						"create_root_key": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether root keys can be created",
+						},
+						"create_standard_key": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether standard keys can be created",
+						},
+						"import_root_key": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether root keys can be imported",
+						},
+						"import_standard_key": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether standard keys can be imported",
+						},
+						"enforce_token": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether keys can only be imported with an import token",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMKmsInstancePoliciesCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("instance_id").(string)
+	kpAPI, err := kmsInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	policies := expandKmsInstancePolicies(d, false)
+	if err := kpAPI.SetInstancePolicies(context, policies); err != nil {
+		return diag.FromErr(kmsAPIError(err, "Error setting the policies of instance %s", instanceID))
+	}
+	d.SetId(instanceID)
+	return resourceIBMKmsInstancePoliciesRead(context, d, meta)
+}
+
+func resourceIBMKmsInstancePoliciesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Id()
+	endpointType := d.Get("endpoint_type").(string)
+	if endpointType == "" {
+		endpointType = "public"
+	}
+	kpAPI, err := kmsInstanceClient(meta, instanceID, endpointType)
+	if err != nil {
+		if isKmsNotFound(err) {
+			log.Printf("[WARN] Key management instance %s of the policies is gone", instanceID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	policies, err := kpAPI.GetInstancePolicies(context)
+	if err != nil {
+		if isKmsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(kmsAPIError(err, "Error getting the policies of instance %s", instanceID))
+	}
+
+	// Only the policies of the configuration are read, all of them on import.
+	importing := true
+	for _, block := range kmsInstancePolicyBlocks {
+		if len(d.Get(block).([]interface{})) > 0 {
+			importing = false
+		}
+	}
+	managed := map[string]bool{}
+	for _, block := range kmsInstancePolicyBlocks {
+		managed[block] = importing || len(d.Get(block).([]interface{})) > 0
+	}
+	found := map[string][]interface{}{}
+	for _, policy := range policies {
+		block, value := flattenKmsInstancePolicy(policy)
+		if block != "" {
+			found[block] = value
+		}
+	}
+	for _, block := range kmsInstancePolicyBlocks {
+		if managed[block] {
+			d.Set(block, found[block])
+		}
+	}
+	d.Set("instance_id", instanceID)
+	d.Set("endpoint_type", endpointType)
+	return nil
+}
+
+func resourceIBMKmsInstancePoliciesUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	changed := false
+	for _, block := range kmsInstancePolicyBlocks {
+		changed = changed || d.HasChange(block)
+	}
+	if changed {
+		kpAPI, err := kmsInstanceClient(meta, d.Id(), d.Get("endpoint_type").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		policies := expandKmsInstancePolicies(d, true)
+		if err := kpAPI.SetInstancePolicies(context, policies); err != nil {
+			return diag.FromErr(kmsAPIError(err, "Error updating the policies of instance %s", d.Id()))
+		}
+	}
+	return resourceIBMKmsInstancePoliciesRead(context, d, meta)
+}
+
+// resourceIBMKmsInstancePoliciesDelete disables the policies of the resource, the
+// instance policies cannot be removed.
+func resourceIBMKmsInstancePoliciesDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	kpAPI, err := kmsInstanceClient(meta, d.Id(), d.Get("endpoint_type").(string))
+	if err != nil {
+		if isKmsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	policies := kp.MultiplePolicies{}
+	if v := d.Get("dual_auth_delete").([]interface{}); len(v) > 0 {
+		policies.DualAuthDelete = &kp.BasicPolicyData{Enabled: false}
+	}
+	if v := d.Get("metrics").([]interface{}); len(v) > 0 {
+		policies.Metrics = &kp.BasicPolicyData{Enabled: false}
+	}
+	if v := d.Get("allowed_network").([]interface{}); len(v) > 0 {
+		network := v[0].(map[string]interface{})["network"].(string)
+		policies.AllowedNetwork = &kp.AllowedNetworkPolicyData{Enabled: false, Network: network}
+	}
+	if v := d.Get("key_create_import_access").([]interface{}); len(v) > 0 {
+		policies.KeyCreateImportAccess = &kp.KeyCreateImportAccessInstancePolicy{Enabled: false}
+	}
+	if err := kpAPI.SetInstancePolicies(context, policies); err != nil && !isKmsNotFound(err) {
+		return diag.FromErr(kmsAPIError(err, "Error disabling the policies of instance %s", d.Id()))
+	}
+	d.SetId("")
+	return nil
+}
+
+// expandKmsInstancePolicies returns the policies of the configuration. On update
+// only the changed policies are returned, the ones removed from the configuration
+// being disabled.
+func expandKmsInstancePolicies(d *schema.ResourceData, update bool) kp.MultiplePolicies {
+	policies := kp.MultiplePolicies{}
+	policy := func(block string) (map[string]interface{}, bool) {
+		if update && !d.HasChange(block) {
+			return nil, false
+		}
+		if v := d.Get(block).([]interface{}); len(v) > 0 && v[0] != nil {
+			return v[0].(map[string]interface{}), true
+		}
+		if update {
+			old, _ := d.GetChange(block)
+			if v := old.([]interface{}); len(v) > 0 && v[0] != nil {
+				disabled := map[string]interface{}{}
+				for k, value := range v[0].(map[string]interface{}) {
+					disabled[k] = value
+				}
+				disabled["enabled"] = false
+				return disabled, true
+			}
+		}
+		return nil, false
+	}
+	if p, ok := policy("dual_auth_delete"); ok {
+		policies.DualAuthDelete = &kp.BasicPolicyData{Enabled: p["enabled"].(bool)}
+	}
+	if p, ok := policy("metrics"); ok {
+		policies.Metrics = &kp.BasicPolicyData{Enabled: p["enabled"].(bool)}
+	}
+	if p, ok := policy("allowed_network"); ok {
+		policies.AllowedNetwork = &kp.AllowedNetworkPolicyData{
+			Enabled: p["enabled"].(bool),
+			Network: p["network"].(string),
+		}
+	}
+	if p, ok := policy("key_create_import_access"); ok {
+		policies.KeyCreateImportAccess = &kp.KeyCreateImportAccessInstancePolicy{
+			Enabled:           p["enabled"].(bool),
+			CreateRootKey:     p["create_root_key"].(bool),
+			CreateStandardKey: p["create_standard_key"].(bool),
+			ImportRootKey:     p["import_root_key"].(bool),
+			ImportStandardKey: p["import_standard_key"].(bool),
+			EnforceToken:      p["enforce_token"].(bool),
+		}
+	}
+	return policies
+}
+
+// flattenKmsInstancePolicy returns the block of an instance policy, an empty name
+// for the policies the resource does not manage.
+func flattenKmsInstancePolicy(policy kp.InstancePolicy) (string, []interface{}) {
+	enabled := policy.PolicyData.Enabled != nil && *policy.PolicyData.Enabled
+	attribute := func(v *bool) bool {
+		return v != nil && *v
+	}
+	attributes := policy.PolicyData.Attributes
+	if attributes == nil {
+		attributes = &kp.Attributes{}
+	}
+	switch policy.PolicyType {
+	case kp.DualAuthDelete:
+		return "dual_auth_delete", []interface{}{map[string]interface{}{"enabled": enabled}}
+	case kp.Metrics:
+		return "metrics", []interface{}{map[string]interface{}{"enabled": enabled}}
+	case kp.AllowedNetwork:
+		network := ""
+		if attributes.AllowedNetwork != nil {
+			network = *attributes.AllowedNetwork
+		}
+		return "allowed_network", []interface{}{map[string]interface{}{"enabled": enabled, "network": network}}
+	case kp.KeyCreateImportAccess:
+		return "key_create_import_access", []interface{}{map[string]interface{}{
+			"enabled":             enabled,
+			"create_root_key":     attribute(attributes.CreateRootKey),
+			"create_standard_key": attribute(attributes.CreateStandardKey),
+			"import_root_key":     attribute(attributes.ImportRootKey),
+			"import_standard_key": attribute(attributes.ImportStandardKey),
+			"enforce_token":       attribute(attributes.EnforceToken),
+		}}
+	}
+	return "", nil
+}
@@ -0,0 +1,133 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
+)
+
+func TestAccIBMKMSInstancePolicies_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_kms_%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsInstancePoliciesConfig(instanceName, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.policies", "dual_auth_delete.0.enabled", "true"),
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.policies", "key_create_import_access.0.enabled", "true"),
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.policies", "key_create_import_access.0.import_standard_key", "false"),
+				),
+			},
+			{
+				Config: testAccCheckIBMKmsInstancePoliciesConfig(instanceName, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.policies", "dual_auth_delete.0.enabled", "false"),
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.policies", "metrics.0.enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsInstancePoliciesConfig(instanceName string, dualAuth, metrics bool) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name     = "%s"
+		service  = "kms"
+		plan     = "tiered-pricing"
+		location = "us-south"
+	}
+	resource "ibm_kms_instance_policies" "policies" {
+		instance_id = ibm_resource_instance.kms_instance.guid
+		dual_auth_delete {
+			enabled = %t
+		}
+		metrics {
+			enabled = %t
+		}
+		key_create_import_access {
+			enabled             = true
+			import_standard_key = false
+		}
+	}
+`, instanceName, dualAuth, metrics)
+}
+
+func TestIBMKmsInstancePoliciesMock(t *testing.T) {
+	t.Parallel()
+	srv := testMockServer(t)
+	meta := testMockClientSession(t, srv)
+	instanceID, _ := testMockKMSInstance(t, meta)
+	r := resourceIBMKmsInstancePolicies()
+
+	diags := r.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"instance_id": instanceID,
+	}))
+	assert.Assert(t, diags.HasError(), "a resource without policies must not validate")
+	diags = r.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"instance_id":     instanceID,
+		"allowed_network": []interface{}{map[string]interface{}{"enabled": true, "network": "private"}},
+	}))
+	assert.ErrorContains(t, testDiagsErr(diags), "network")
+
+	raw := map[string]interface{}{
+		"instance_id":      instanceID,
+		"dual_auth_delete": []interface{}{map[string]interface{}{"enabled": true}},
+		"key_create_import_access": []interface{}{map[string]interface{}{
+			"enabled":             true,
+			"create_standard_key": false,
+			"enforce_token":       true,
+		}},
+	}
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	d.MarkNewResource()
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), d, meta)))
+	assert.Equal(t, d.Id(), instanceID)
+	assert.Equal(t, d.Get("dual_auth_delete.0.enabled"), true)
+	assert.Equal(t, d.Get("key_create_import_access.0.create_root_key"), true)
+	assert.Equal(t, d.Get("key_create_import_access.0.create_standard_key"), false)
+	assert.Equal(t, d.Get("key_create_import_access.0.enforce_token"), true)
+	assert.Equal(t, len(d.Get("metrics").([]interface{})), 0)
+
+	// The policies removed from the configuration are disabled
+	delete(raw, "dual_auth_delete")
+	raw["allowed_network"] = []interface{}{map[string]interface{}{"enabled": true, "network": "private-only"}}
+	updated := testMockResourceData(t, r, d, raw)
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), updated, meta)))
+	assert.Equal(t, updated.Get("allowed_network.0.network"), "private-only")
+	kpAPI, err := kmsInstanceClient(meta, instanceID, "public")
+	assert.NilError(t, err)
+	dualAuth, err := kpAPI.GetDualAuthInstancePolicy(context.Background())
+	assert.NilError(t, err)
+	assert.Equal(t, *dualAuth.PolicyData.Enabled, false)
+
+	imported := r.Data(nil)
+	imported.SetId(instanceID)
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), imported, meta)))
+	assert.Equal(t, imported.Get("instance_id"), instanceID)
+	assert.Equal(t, imported.Get("endpoint_type"), "public")
+	assert.Equal(t, imported.Get("dual_auth_delete.0.enabled"), false)
+	assert.Equal(t, imported.Get("allowed_network.0.enabled"), true)
+	assert.Equal(t, imported.Get("key_create_import_access.0.import_root_key"), true)
+
+	assert.NilError(t, testDiagsErr(r.DeleteContext(context.Background(), updated, meta)))
+	policies, err := kpAPI.GetInstancePolicies(context.Background())
+	assert.NilError(t, err)
+	for _, policy := range policies {
+		if policy.PolicyType == kp.AllowedNetwork || policy.PolicyType == kp.KeyCreateImportAccess {
+			assert.Equal(t, *policy.PolicyData.Enabled, false, policy.PolicyType)
+		}
+	}
+}
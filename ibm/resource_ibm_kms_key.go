@@ -13,17 +13,17 @@ import (
 	"time"
 
 	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMKmskey() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMKmsKeyCreate,
-		Read:     resourceIBMKmsKeyRead,
-		Update:   resourceIBMKmsKeyUpdate,
-		Delete:   resourceIBMKmsKeyDelete,
-		Exists:   resourceIBMKmsKeyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMKmsKeyCreate,
+		ReadContext:   resourceIBMKmsKeyRead,
+		UpdateContext: resourceIBMKmsKeyUpdate,
+		DeleteContext: resourceIBMKmsKeyDelete,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -247,15 +247,15 @@ func resourceIBMKmskey() *schema.Resource {
 	}
 }
 
-func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKmsKeyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	kpAPI, err := meta.(ClientSession).keyManagementAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	rContollerClient, err := meta.(ClientSession).ResourceControllerAPIV2()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceID := d.Get("instance_id").(string)
@@ -265,7 +265,7 @@ func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 
 	instanceData, err := rContollerApi.GetInstance(instanceID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	instanceCRN := instanceData.Crn.String()
 	crnData := strings.Split(instanceCRN, ":")
@@ -275,12 +275,12 @@ func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	if crnData[4] == "hs-crypto" {
 		hpcsEndpointAPI, err := meta.(ClientSession).HpcsEndpointAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		resp, err := hpcsEndpointAPI.Endpoint().GetAPIEndpoint(instanceID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		if endpointType == "public" {
@@ -291,7 +291,7 @@ func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 
 		u, err := url.Parse(hpcsEndpointURL)
 		if err != nil {
-			return fmt.Errorf("Error Parsing hpcs EndpointURL")
+			return diag.FromErr(fmt.Errorf("Error Parsing hpcs EndpointURL"))
 		}
 		kpAPI.URL = u
 	} else if crnData[4] == "kms" {
@@ -302,16 +302,16 @@ func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 					kmsEndpointURL := kmsEndpURL[0] + "private." + kmsEndpURL[1]
 					u, err := url.Parse(kmsEndpointURL)
 					if err != nil {
-						return fmt.Errorf("Error Parsing kms EndpointURL")
+						return diag.FromErr(fmt.Errorf("Error Parsing kms EndpointURL"))
 					}
 					kpAPI.URL = u
 				} else {
-					return fmt.Errorf("Error in Kms EndPoint URL ")
+					return diag.FromErr(fmt.Errorf("Error in Kms EndPoint URL "))
 				}
 			}
 		}
 	} else {
-		return fmt.Errorf("Invalid or unsupported service Instance")
+		return diag.FromErr(fmt.Errorf("Invalid or unsupported service Instance"))
 	}
 	kpAPI.Config.InstanceID = instanceID
 
@@ -326,7 +326,7 @@ func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 		// parse string to required time format
 		expiration_time, err := time.Parse(time.RFC3339, expiration_string)
 		if err != nil {
-			return fmt.Errorf("Invalid time format (the date format follows RFC 3339): %s", err)
+			return diag.FromErr(fmt.Errorf("Invalid time format (the date format follows RFC 3339): %s", err))
 		}
 		expiration = &expiration_time
 	} else {
@@ -338,20 +338,20 @@ func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 		if v, ok := d.GetOk("payload"); ok {
 			//import standard key
 			payload := v.(string)
-			stkey, err := kpAPI.CreateImportedStandardKey(context.Background(), name, expiration, payload)
+			stkey, err := kpAPI.CreateImportedStandardKey(context, name, expiration, payload)
 			if err != nil {
-				return fmt.Errorf(
-					"Error while creating standard key with payload: %s", err)
+				return diag.FromErr(fmt.Errorf(
+					"Error while creating standard key with payload: %s", err))
 			}
 			keyCRN = stkey.CRN
 			d.SetId(keyCRN)
 
 		} else {
 			//create standard key
-			stkey, err := kpAPI.CreateStandardKey(context.Background(), name, expiration)
+			stkey, err := kpAPI.CreateStandardKey(context, name, expiration)
 			if err != nil {
-				return fmt.Errorf(
-					"Error while creating standard key: %s", err)
+				return diag.FromErr(fmt.Errorf(
+					"Error while creating standard key: %s", err))
 			}
 			keyCRN = stkey.CRN
 			d.SetId(keyCRN)
@@ -362,31 +362,40 @@ func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 			payload := v.(string)
 			encryptedNonce := d.Get("encrypted_nonce").(string)
 			iv := d.Get("iv_value").(string)
-			stkey, err := kpAPI.CreateImportedRootKey(context.Background(), name, expiration, payload, encryptedNonce, iv)
+			stkey, err := kpAPI.CreateImportedRootKey(context, name, expiration, payload, encryptedNonce, iv)
 			if err != nil {
-				return fmt.Errorf(
-					"Error while creating Root key with payload: %s", err)
+				return diag.FromErr(fmt.Errorf(
+					"Error while creating Root key with payload: %s", err))
 			}
 			keyCRN = stkey.CRN
 			d.SetId(keyCRN)
 
 		} else {
-			stkey, err := kpAPI.CreateRootKey(context.Background(), name, expiration)
+			stkey, err := kpAPI.CreateRootKey(context, name, expiration)
 			if err != nil {
-				return fmt.Errorf(
-					"Error while creating Root key: %s", err)
+				return diag.FromErr(fmt.Errorf(
+					"Error while creating Root key: %s", err))
 			}
 			keyCRN = stkey.CRN
 			d.SetId(keyCRN)
 		}
 	}
-	return resourceIBMKmsKeyUpdate(d, meta)
+	return resourceIBMKmsKeyUpdate(context, d, meta)
 }
 
-func resourceIBMKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKmsKeyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMKmsKeyExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	kpAPI, err := meta.(ClientSession).keyManagementAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	crn := d.Id()
 	crnData := strings.Split(crn, ":")
@@ -401,12 +410,12 @@ func resourceIBMKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 		instanceType = "hs-crypto"
 		hpcsEndpointAPI, err := meta.(ClientSession).HpcsEndpointAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		resp, err := hpcsEndpointAPI.Endpoint().GetAPIEndpoint(instanceID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		if endpointType == "public" {
@@ -417,7 +426,7 @@ func resourceIBMKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 
 		u, err := url.Parse(hpcsEndpointURL)
 		if err != nil {
-			return fmt.Errorf("Error Parsing hpcs EndpointURL")
+			return diag.FromErr(fmt.Errorf("Error Parsing hpcs EndpointURL"))
 
 		}
 		kpAPI.URL = u
@@ -430,29 +439,29 @@ func resourceIBMKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 					kmsEndpointURL := kmsEndpURL[0] + "private." + kmsEndpURL[1]
 					u, err := url.Parse(kmsEndpointURL)
 					if err != nil {
-						return fmt.Errorf("Error Parsing kms EndpointURL")
+						return diag.FromErr(fmt.Errorf("Error Parsing kms EndpointURL"))
 					}
 					kpAPI.URL = u
 				} else {
-					return fmt.Errorf("Error in Kms EndPoint URL ")
+					return diag.FromErr(fmt.Errorf("Error in Kms EndPoint URL "))
 				}
 			}
 		}
 	} else {
-		return fmt.Errorf("Invalid or unsupported service Instance")
+		return diag.FromErr(fmt.Errorf("Invalid or unsupported service Instance"))
 	}
 
 	kpAPI.Config.InstanceID = instanceID
 	// keyid := d.Id()
-	key, err := kpAPI.GetKey(context.Background(), keyid)
+	key, err := kpAPI.GetKey(context, keyid)
 	if err != nil {
-		return fmt.Errorf("Get Key failed with error: %s", err)
+		return diag.FromErr(fmt.Errorf("Get Key failed with error: %s", err))
 	}
 
-	policies, err := kpAPI.GetPolicies(context.Background(), keyid)
+	policies, err := kpAPI.GetPolicies(context, keyid)
 
 	if err != nil {
-		return fmt.Errorf("Failed to read policies: %s", err)
+		return diag.FromErr(fmt.Errorf("Failed to read policies: %s", err))
 	}
 	if len(policies) == 0 {
 		log.Printf("No Policy Configurations read\n")
@@ -483,7 +492,7 @@ func resourceIBMKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set(ResourceStatus, strconv.Itoa(state))
 	rcontroller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := key.ID
 	crn1 := strings.TrimSuffix(key.CRN, ":key:"+id)
@@ -494,7 +503,7 @@ func resourceIBMKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 
 }
 
-func resourceIBMKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKmsKeyUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	if d.HasChange("force_delete") {
 		d.Set("force_delete", d.Get("force_delete").(bool))
@@ -503,12 +512,12 @@ func resourceIBMKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		kpAPI, err := meta.(ClientSession).keyManagementAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		rContollerClient, err := meta.(ClientSession).ResourceControllerAPIV2()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		instanceID := d.Get("instance_id").(string)
@@ -518,7 +527,7 @@ func resourceIBMKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		instanceData, err := rContollerApi.GetInstance(instanceID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		instanceCRN := instanceData.Crn.String()
 		crnData := strings.Split(instanceCRN, ":")
@@ -528,12 +537,12 @@ func resourceIBMKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 		if crnData[4] == "hs-crypto" {
 			hpcsEndpointAPI, err := meta.(ClientSession).HpcsEndpointAPI()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			resp, err := hpcsEndpointAPI.Endpoint().GetAPIEndpoint(instanceID)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			if endpointType == "public" {
@@ -544,7 +553,7 @@ func resourceIBMKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 
 			u, err := url.Parse(hpcsEndpointURL)
 			if err != nil {
-				return fmt.Errorf("Error Parsing hpcs EndpointURL")
+				return diag.FromErr(fmt.Errorf("Error Parsing hpcs EndpointURL"))
 			}
 			kpAPI.URL = u
 		} else if crnData[4] == "kms" {
@@ -555,16 +564,16 @@ func resourceIBMKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 						kmsEndpointURL := kmsEndpURL[0] + "private." + kmsEndpURL[1]
 						u, err := url.Parse(kmsEndpointURL)
 						if err != nil {
-							return fmt.Errorf("Error Parsing kms EndpointURL")
+							return diag.FromErr(fmt.Errorf("Error Parsing kms EndpointURL"))
 						}
 						kpAPI.URL = u
 					} else {
-						return fmt.Errorf("Error in Kms EndPoint URL ")
+						return diag.FromErr(fmt.Errorf("Error in Kms EndPoint URL "))
 					}
 				}
 			}
 		} else {
-			return fmt.Errorf("Invalid or unsupported service Instance")
+			return diag.FromErr(fmt.Errorf("Invalid or unsupported service Instance"))
 		}
 
 		kpAPI.Config.InstanceID = instanceID
@@ -575,18 +584,18 @@ func resourceIBMKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		err = handlePolicies(d, kpAPI, meta, key_id)
 		if err != nil {
-			resourceIBMKmsKeyRead(d, meta)
-			return fmt.Errorf("Could not create policies: %s", err)
+			resourceIBMKmsKeyRead(context, d, meta)
+			return diag.FromErr(fmt.Errorf("Could not create policies: %s", err))
 		}
 	}
-	return resourceIBMKmsKeyRead(d, meta)
+	return resourceIBMKmsKeyRead(context, d, meta)
 
 }
 
-func resourceIBMKmsKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKmsKeyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	kpAPI, err := meta.(ClientSession).keyManagementAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	crn := d.Id()
 	crnData := strings.Split(crn, ":")
@@ -600,12 +609,12 @@ func resourceIBMKmsKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	if crnData[4] == "hs-crypto" {
 		hpcsEndpointAPI, err := meta.(ClientSession).HpcsEndpointAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		resp, err := hpcsEndpointAPI.Endpoint().GetAPIEndpoint(instanceID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		if endpointType == "public" {
@@ -616,7 +625,7 @@ func resourceIBMKmsKeyDelete(d *schema.ResourceData, meta interface{}) error {
 
 		u, err := url.Parse(hpcsEndpointURL)
 		if err != nil {
-			return fmt.Errorf("Error Parsing hpcs EndpointURL")
+			return diag.FromErr(fmt.Errorf("Error Parsing hpcs EndpointURL"))
 		}
 		kpAPI.URL = u
 	} else if crnData[4] == "kms" {
@@ -627,16 +636,16 @@ func resourceIBMKmsKeyDelete(d *schema.ResourceData, meta interface{}) error {
 					kmsEndpointURL := kmsEndpURL[0] + "private." + kmsEndpURL[1]
 					u, err := url.Parse(kmsEndpointURL)
 					if err != nil {
-						return fmt.Errorf("Error Parsing kms EndpointURL")
+						return diag.FromErr(fmt.Errorf("Error Parsing kms EndpointURL"))
 					}
 					kpAPI.URL = u
 				} else {
-					return fmt.Errorf("Error in Kms EndPoint URL ")
+					return diag.FromErr(fmt.Errorf("Error in Kms EndPoint URL "))
 				}
 			}
 		}
 	} else {
-		return fmt.Errorf("Invalid or unsupported service Instance")
+		return diag.FromErr(fmt.Errorf("Invalid or unsupported service Instance"))
 	}
 
 	force := d.Get("force_delete").(bool)
@@ -644,10 +653,10 @@ func resourceIBMKmsKeyDelete(d *schema.ResourceData, meta interface{}) error {
 		Force: force,
 	}
 
-	_, err1 := kpAPI.DeleteKey(context.Background(), keyid, kp.ReturnRepresentation, f)
+	_, err1 := kpAPI.DeleteKey(context, keyid, kp.ReturnRepresentation, f)
 	if err1 != nil {
-		return fmt.Errorf(
-			"Error while deleting: %s", err1)
+		return diag.FromErr(fmt.Errorf(
+			"Error while deleting: %s", err1))
 	}
 	d.SetId("")
 	return nil
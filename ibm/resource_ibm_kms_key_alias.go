@@ -7,15 +7,16 @@ import (
 	"strings"
 
 	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMKmskeyAlias() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMKmsKeyAliasCreate,
-		Delete:   resourceIBMKmsKeyAliasDelete,
-		Read:     resourceIBMKmsKeyAliasRead,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMKmsKeyAliasCreate,
+		DeleteContext: resourceIBMKmsKeyAliasDelete,
+		ReadContext:   resourceIBMKmsKeyAliasRead,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -48,14 +49,14 @@ func resourceIBMKmskeyAlias() *schema.Resource {
 	}
 }
 
-func resourceIBMKmsKeyAliasCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKmsKeyAliasCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	kpAPI, err := meta.(ClientSession).keyManagementAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	rContollerClient, err := meta.(ClientSession).ResourceControllerAPIV2()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceID := d.Get("instance_id").(string)
@@ -65,7 +66,7 @@ func resourceIBMKmsKeyAliasCreate(d *schema.ResourceData, meta interface{}) erro
 
 	instanceData, err := rContollerAPI.GetInstance(instanceID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	instanceCRN := instanceData.Crn.String()
 	crnData := strings.Split(instanceCRN, ":")
@@ -75,12 +76,12 @@ func resourceIBMKmsKeyAliasCreate(d *schema.ResourceData, meta interface{}) erro
 	if crnData[4] == "hs-crypto" {
 		hpcsEndpointAPI, err := meta.(ClientSession).HpcsEndpointAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		resp, err := hpcsEndpointAPI.Endpoint().GetAPIEndpoint(instanceID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		if endpointType == "public" {
@@ -91,7 +92,7 @@ func resourceIBMKmsKeyAliasCreate(d *schema.ResourceData, meta interface{}) erro
 
 		u, err := url.Parse(hpcsEndpointURL)
 		if err != nil {
-			return fmt.Errorf("Error Parsing hpcs EndpointURL")
+			return diag.FromErr(fmt.Errorf("Error Parsing hpcs EndpointURL"))
 		}
 		kpAPI.URL = u
 	} else if crnData[4] == "kms" {
@@ -101,30 +102,30 @@ func resourceIBMKmsKeyAliasCreate(d *schema.ResourceData, meta interface{}) erro
 			}
 		}
 	} else {
-		return fmt.Errorf("Invalid or unsupported service Instance")
+		return diag.FromErr(fmt.Errorf("Invalid or unsupported service Instance"))
 	}
 	kpAPI.Config.InstanceID = instanceID
 
 	aliasName := d.Get("alias").(string)
 	keyID := d.Get("key_id").(string)
-	stkey, err := kpAPI.CreateKeyAlias(context.Background(), aliasName, keyID)
+	stkey, err := kpAPI.CreateKeyAlias(context, aliasName, keyID)
 	if err != nil {
-		return fmt.Errorf(
-			"Error while creating alias name for the key: %s", err)
+		return diag.FromErr(fmt.Errorf(
+			"Error while creating alias name for the key: %s", err))
 	}
-	key, err := kpAPI.GetKey(context.Background(), stkey.KeyID)
+	key, err := kpAPI.GetKey(context, stkey.KeyID)
 	if err != nil {
-		return fmt.Errorf("Get Key failed with error: %s", err)
+		return diag.FromErr(fmt.Errorf("Get Key failed with error: %s", err))
 	}
 	d.SetId(fmt.Sprintf("%s:alias:%s", stkey.Alias, key.CRN))
 
-	return resourceIBMKmsKeyAliasRead(d, meta)
+	return resourceIBMKmsKeyAliasRead(context, d, meta)
 }
 
-func resourceIBMKmsKeyAliasRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKmsKeyAliasRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	kpAPI, err := meta.(ClientSession).keyManagementAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := strings.Split(d.Id(), ":alias:")
 	crn := id[1]
@@ -138,12 +139,12 @@ func resourceIBMKmsKeyAliasRead(d *schema.ResourceData, meta interface{}) error
 	if crnData[4] == "hs-crypto" {
 		hpcsEndpointAPI, err := meta.(ClientSession).HpcsEndpointAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		resp, err := hpcsEndpointAPI.Endpoint().GetAPIEndpoint(instanceID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		if endpointType == "public" {
@@ -154,7 +155,7 @@ func resourceIBMKmsKeyAliasRead(d *schema.ResourceData, meta interface{}) error
 
 		u, err := url.Parse(hpcsEndpointURL)
 		if err != nil {
-			return fmt.Errorf("Error Parsing hpcs EndpointURL")
+			return diag.FromErr(fmt.Errorf("Error Parsing hpcs EndpointURL"))
 
 		}
 		kpAPI.URL = u
@@ -165,18 +166,18 @@ func resourceIBMKmsKeyAliasRead(d *schema.ResourceData, meta interface{}) error
 			}
 		}
 	} else {
-		return fmt.Errorf("Invalid or unsupported service Instance")
+		return diag.FromErr(fmt.Errorf("Invalid or unsupported service Instance"))
 	}
 
 	kpAPI.Config.InstanceID = instanceID
-	key, err := kpAPI.GetKey(context.Background(), keyid)
+	key, err := kpAPI.GetKey(context, keyid)
 	if err != nil {
 		kpError := err.(*kp.Error)
 		if kpError.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		} else {
-			return fmt.Errorf("Get Key failed with error: %s", err)
+			return diag.FromErr(fmt.Errorf("Get Key failed with error: %s", err))
 		}
 	}
 	d.Set("alias", id[0])
@@ -187,10 +188,10 @@ func resourceIBMKmsKeyAliasRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMKmsKeyAliasDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKmsKeyAliasDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	kpAPI, err := meta.(ClientSession).keyManagementAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := strings.Split(d.Id(), ":alias:")
 	crn := id[1]
@@ -204,12 +205,12 @@ func resourceIBMKmsKeyAliasDelete(d *schema.ResourceData, meta interface{}) erro
 	if crnData[4] == "hs-crypto" {
 		hpcsEndpointAPI, err := meta.(ClientSession).HpcsEndpointAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		resp, err := hpcsEndpointAPI.Endpoint().GetAPIEndpoint(instanceID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		if endpointType == "public" {
@@ -220,7 +221,7 @@ func resourceIBMKmsKeyAliasDelete(d *schema.ResourceData, meta interface{}) erro
 
 		u, err := url.Parse(hpcsEndpointURL)
 		if err != nil {
-			return fmt.Errorf("Error Parsing hpcs EndpointURL")
+			return diag.FromErr(fmt.Errorf("Error Parsing hpcs EndpointURL"))
 
 		}
 		kpAPI.URL = u
@@ -231,17 +232,17 @@ func resourceIBMKmsKeyAliasDelete(d *schema.ResourceData, meta interface{}) erro
 			}
 		}
 	} else {
-		return fmt.Errorf("Invalid or unsupported service Instance")
+		return diag.FromErr(fmt.Errorf("Invalid or unsupported service Instance"))
 	}
 
 	kpAPI.Config.InstanceID = instanceID
-	err1 := kpAPI.DeleteKeyAlias(context.Background(), id[0], keyid)
+	err1 := kpAPI.DeleteKeyAlias(context, id[0], keyid)
 	if err1 != nil {
 		kpError := err1.(*kp.Error)
 		if kpError.StatusCode == 404 {
 			return nil
 		} else {
-			return fmt.Errorf(" failed to Destroy alias with error: %s", err1)
+			return diag.FromErr(fmt.Errorf(" failed to Destroy alias with error: %s", err1))
 		}
 	}
 	return nil
@@ -10,15 +10,16 @@ import (
 	"strings"
 
 	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMKmskeyRings() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMKmsKeyRingCreate,
-		Delete:   resourceIBMKmsKeyRingDelete,
-		Read:     resourceIBMKmsKeyRingRead,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMKmsKeyRingCreate,
+		DeleteContext: resourceIBMKmsKeyRingDelete,
+		ReadContext:   resourceIBMKmsKeyRingRead,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -64,14 +65,14 @@ func resourceIBMKeyRingValidator() *ResourceValidator {
 	return &ibmKeyRingResourceValidator
 }
 
-func resourceIBMKmsKeyRingCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKmsKeyRingCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	kpAPI, err := meta.(ClientSession).keyManagementAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	rContollerClient, err := meta.(ClientSession).ResourceControllerAPIV2()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceID := d.Get("instance_id").(string)
@@ -82,7 +83,7 @@ func resourceIBMKmsKeyRingCreate(d *schema.ResourceData, meta interface{}) error
 
 	instanceData, err := rContollerAPI.GetInstance(instanceID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	instanceCRN := instanceData.Crn.String()
 	crnData := strings.Split(instanceCRN, ":")
@@ -92,12 +93,12 @@ func resourceIBMKmsKeyRingCreate(d *schema.ResourceData, meta interface{}) error
 	if crnData[4] == "hs-crypto" {
 		hpcsEndpointAPI, err := meta.(ClientSession).HpcsEndpointAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		resp, err := hpcsEndpointAPI.Endpoint().GetAPIEndpoint(instanceID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		if endpointType == "public" {
@@ -108,7 +109,7 @@ func resourceIBMKmsKeyRingCreate(d *schema.ResourceData, meta interface{}) error
 
 		u, err := url.Parse(hpcsEndpointURL)
 		if err != nil {
-			return fmt.Errorf("Error Parsing hpcs EndpointURL")
+			return diag.FromErr(fmt.Errorf("Error Parsing hpcs EndpointURL"))
 		}
 		kpAPI.URL = u
 	} else if crnData[4] == "kms" {
@@ -118,20 +119,20 @@ func resourceIBMKmsKeyRingCreate(d *schema.ResourceData, meta interface{}) error
 			}
 		}
 	} else {
-		return fmt.Errorf("Invalid or unsupported service Instance")
+		return diag.FromErr(fmt.Errorf("Invalid or unsupported service Instance"))
 	}
 	kpAPI.Config.InstanceID = instanceID
 
-	err = kpAPI.CreateKeyRing(context.Background(), keyRingID)
+	err = kpAPI.CreateKeyRing(context, keyRingID)
 	if err != nil {
-		return fmt.Errorf(
-			"Error while creating key ring : %s", err)
+		return diag.FromErr(fmt.Errorf(
+			"Error while creating key ring : %s", err))
 	}
 	var keyRing string
-	keyRings, err2 := kpAPI.GetKeyRings(context.Background())
+	keyRings, err2 := kpAPI.GetKeyRings(context)
 	if err2 != nil {
-		return fmt.Errorf(
-			"Error while fetching key ring : %s", err2)
+		return diag.FromErr(fmt.Errorf(
+			"Error while fetching key ring : %s", err2))
 	}
 	for _, v := range keyRings.KeyRings {
 		if v.ID == keyRingID {
@@ -142,13 +143,13 @@ func resourceIBMKmsKeyRingCreate(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(fmt.Sprintf("%s:keyRing:%s", keyRing, instanceCRN))
 
-	return resourceIBMKmsKeyRingRead(d, meta)
+	return resourceIBMKmsKeyRingRead(context, d, meta)
 }
 
-func resourceIBMKmsKeyRingRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKmsKeyRingRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	kpAPI, err := meta.(ClientSession).keyManagementAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := strings.Split(d.Id(), ":keyRing:")
 	crn := id[1]
@@ -161,12 +162,12 @@ func resourceIBMKmsKeyRingRead(d *schema.ResourceData, meta interface{}) error {
 	if crnData[4] == "hs-crypto" {
 		hpcsEndpointAPI, err := meta.(ClientSession).HpcsEndpointAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		resp, err := hpcsEndpointAPI.Endpoint().GetAPIEndpoint(instanceID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		if endpointType == "public" {
@@ -177,7 +178,7 @@ func resourceIBMKmsKeyRingRead(d *schema.ResourceData, meta interface{}) error {
 
 		u, err := url.Parse(hpcsEndpointURL)
 		if err != nil {
-			return fmt.Errorf("Error Parsing hpcs EndpointURL")
+			return diag.FromErr(fmt.Errorf("Error Parsing hpcs EndpointURL"))
 
 		}
 		kpAPI.URL = u
@@ -188,18 +189,18 @@ func resourceIBMKmsKeyRingRead(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 	} else {
-		return fmt.Errorf("Invalid or unsupported service Instance")
+		return diag.FromErr(fmt.Errorf("Invalid or unsupported service Instance"))
 	}
 
 	kpAPI.Config.InstanceID = instanceID
-	_, err = kpAPI.GetKeyRings(context.Background())
+	_, err = kpAPI.GetKeyRings(context)
 	if err != nil {
 		kpError := err.(*kp.Error)
 		if kpError.StatusCode == 404 || kpError.StatusCode == 409 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Get Key Rings failed with error: %s", err)
+		return diag.FromErr(fmt.Errorf("Get Key Rings failed with error: %s", err))
 	}
 
 	d.Set("instance_id", instanceID)
@@ -208,10 +209,10 @@ func resourceIBMKmsKeyRingRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMKmsKeyRingDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKmsKeyRingDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	kpAPI, err := meta.(ClientSession).keyManagementAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := strings.Split(d.Id(), ":keyRing:")
 	crn := id[1]
@@ -224,12 +225,12 @@ func resourceIBMKmsKeyRingDelete(d *schema.ResourceData, meta interface{}) error
 	if crnData[4] == "hs-crypto" {
 		hpcsEndpointAPI, err := meta.(ClientSession).HpcsEndpointAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		resp, err := hpcsEndpointAPI.Endpoint().GetAPIEndpoint(instanceID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		if endpointType == "public" {
@@ -240,7 +241,7 @@ func resourceIBMKmsKeyRingDelete(d *schema.ResourceData, meta interface{}) error
 
 		u, err := url.Parse(hpcsEndpointURL)
 		if err != nil {
-			return fmt.Errorf("Error Parsing hpcs EndpointURL")
+			return diag.FromErr(fmt.Errorf("Error Parsing hpcs EndpointURL"))
 
 		}
 		kpAPI.URL = u
@@ -251,17 +252,17 @@ func resourceIBMKmsKeyRingDelete(d *schema.ResourceData, meta interface{}) error
 			}
 		}
 	} else {
-		return fmt.Errorf("Invalid or unsupported service Instance")
+		return diag.FromErr(fmt.Errorf("Invalid or unsupported service Instance"))
 	}
 
 	kpAPI.Config.InstanceID = instanceID
-	err1 := kpAPI.DeleteKeyRing(context.Background(), id[0])
+	err1 := kpAPI.DeleteKeyRing(context, id[0])
 	if err1 != nil {
 		kpError := err1.(*kp.Error)
 		if kpError.StatusCode == 404 || kpError.StatusCode == 409 {
 			return nil
 		} else {
-			return fmt.Errorf(" failed to Destroy key ring with error: %s", err1)
+			return diag.FromErr(fmt.Errorf(" failed to Destroy key ring with error: %s", err1))
 		}
 	}
 	return nil
@@ -0,0 +1,128 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMKmsKeyRotation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMKmsKeyRotationCreate,
+		ReadContext:   resourceIBMKmsKeyRotationRead,
+		DeleteContext: resourceIBMKmsKeyRotationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Key protect or hpcs instance GUID",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "public",
+				ValidateFunc: validateAllowedStringValue([]string{"public", "private"}),
+				Description:  "public or private",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the root key to rotate",
+			},
+			"payload": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "Base64 encoded key material of the new version of an imported root key",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values, the key is rotated again when they change",
+			},
+			"rotated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the key was rotated by the resource",
+			},
+			"last_rotate_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the key was last rotated",
+			},
+			"key_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the current version of the key",
+			},
+		},
+	}
+}
+
+func resourceIBMKmsKeyRotationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("instance_id").(string)
+	keyID := d.Get("key_id").(string)
+	kpAPI, err := kmsInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := kpAPI.Rotate(context, keyID, d.Get("payload").(string)); err != nil {
+		return diag.FromErr(kmsAPIError(err, "Error rotating key %s of instance %s", keyID, instanceID))
+	}
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, keyID))
+	d.Set("rotated_at", time.Now().UTC().Format(time.RFC3339))
+	return resourceIBMKmsKeyRotationRead(context, d, meta)
+}
+
+func resourceIBMKmsKeyRotationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceID, keyID := parts[0], parts[1]
+	kpAPI, err := kmsInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		if isKmsNotFound(err) {
+			log.Printf("[WARN] Key management instance %s of the key rotation is gone", instanceID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	key, err := kpAPI.GetKeyMetadata(context, keyID)
+	if err != nil {
+		if isKmsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(kmsAPIError(err, "Error getting key %s of instance %s", keyID, instanceID))
+	}
+	if key.LastRotateDate != nil {
+		d.Set("last_rotate_date", key.LastRotateDate.Format(time.RFC3339))
+	}
+	if key.KeyVersion != nil {
+		d.Set("key_version", key.KeyVersion.ID)
+	}
+	return nil
+}
+
+// resourceIBMKmsKeyRotationDelete only removes the resource from the state, the
+// rotation of a key cannot be undone.
+func resourceIBMKmsKeyRotationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gotest.tools/assert"
+)
+
+func TestAccIBMKMSKeyRotation_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsKeyRotationConfig(instanceName, keyName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_kms_key_rotation.rotation", "last_rotate_date"),
+					resource.TestCheckResourceAttrSet("ibm_kms_key_rotation.rotation", "key_version"),
+				),
+			},
+			{
+				Config: testAccCheckIBMKmsKeyRotationConfig(instanceName, keyName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key_rotation.rotation", "triggers.rotation", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsKeyRotationConfig(instanceName, keyName, rotation string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name     = "%s"
+		service  = "kms"
+		plan     = "tiered-pricing"
+		location = "us-south"
+	}
+	resource "ibm_kms_key" "test" {
+		instance_id  = ibm_resource_instance.kms_instance.guid
+		key_name     = "%s"
+		standard_key = false
+		force_delete = true
+	}
+	resource "ibm_kms_key_rotation" "rotation" {
+		instance_id = ibm_kms_key.test.instance_id
+		key_id      = ibm_kms_key.test.key_id
+		triggers = {
+			rotation = "%s"
+		}
+	}
+`, instanceName, keyName, rotation)
+}
+
+func TestIBMKmsKeyRotationMock(t *testing.T) {
+	t.Parallel()
+	srv := testMockServer(t)
+	meta := testMockClientSession(t, srv)
+	instanceID, rootKeyID := testMockKMSInstance(t, meta)
+	r := resourceIBMKmsKeyRotation()
+
+	kpAPI, err := kmsInstanceClient(meta, instanceID, "public")
+	assert.NilError(t, err)
+	standardKey, err := kpAPI.CreateStandardKey(context.Background(), "mock-standard-key", nil)
+	assert.NilError(t, err)
+	standard := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"instance_id": instanceID,
+		"key_id":      standardKey.ID,
+	})
+	assert.ErrorContains(t, testDiagsErr(r.CreateContext(context.Background(), standard, meta)), "cannot be rotated")
+
+	key, err := kpAPI.GetKeyMetadata(context.Background(), rootKeyID)
+	assert.NilError(t, err)
+	assert.Assert(t, key.LastRotateDate == nil)
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"instance_id": instanceID,
+		"key_id":      rootKeyID,
+		"triggers":    map[string]interface{}{"rotation": "1"},
+	})
+	d.MarkNewResource()
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), d, meta)))
+	assert.Equal(t, d.Id(), instanceID+"/"+rootKeyID)
+	assert.Assert(t, d.Get("rotated_at") != "")
+	assert.Assert(t, d.Get("last_rotate_date") != "")
+	version := d.Get("key_version").(string)
+	assert.Assert(t, version != "" && version != key.KeyVersion.ID)
+
+	// A new resource rotates the key again
+	again := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"instance_id": instanceID,
+		"key_id":      rootKeyID,
+		"triggers":    map[string]interface{}{"rotation": "2"},
+	})
+	again.MarkNewResource()
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), again, meta)))
+	assert.Assert(t, again.Get("key_version") != version)
+
+	assert.NilError(t, testDiagsErr(r.DeleteContext(context.Background(), d, meta)))
+	assert.Equal(t, d.Id(), "")
+	_, err = kpAPI.DeleteKey(context.Background(), rootKeyID, kp.ReturnMinimal)
+	assert.NilError(t, err)
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), again, meta)))
+	assert.Equal(t, again.Id(), "")
+}
@@ -0,0 +1,162 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMKmsKmipAdapter() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMKmsKmipAdapterCreate,
+		ReadContext:   resourceIBMKmsKmipAdapterRead,
+		DeleteContext: resourceIBMKmsKmipAdapterDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Key protect or hpcs instance GUID",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "public",
+				ValidateFunc: validateAllowedStringValue([]string{"public", "private"}),
+				Description:  "public or private",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Name of the KMIP adapter, unique in the instance",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Description of the KMIP adapter",
+			},
+			"profile": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "native_1.0",
+				ValidateFunc: validateAllowedStringValue([]string{"native_1.0"}),
+				Description:  "Profile of the KMIP adapter",
+			},
+			"crk_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the root key of the instance that wraps the KMIP objects of the adapter",
+			},
+			"adapter_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the KMIP adapter",
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The user who created the KMIP adapter",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation date of the KMIP adapter",
+			},
+		},
+	}
+}
+
+func resourceIBMKmsKmipAdapterCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("instance_id").(string)
+	kmipClient, err := createKmsKmipRESTClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	adapter, err := kmipClient.createAdapter(kmsKmipAdapter{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Profile:     d.Get("profile").(string),
+		ProfileData: map[string]string{"crk_id": d.Get("crk_id").(string)},
+	})
+	if err != nil {
+		return diag.FromErr(apiErrorf("kms", err, nil, "Error creating KMIP adapter in instance %s", instanceID))
+	}
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, adapter.ID))
+	return resourceIBMKmsKmipAdapterRead(context, d, meta)
+}
+
+func resourceIBMKmsKmipAdapterRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceID, adapterID := parts[0], parts[1]
+	endpointType := d.Get("endpoint_type").(string)
+	if endpointType == "" {
+		endpointType = "public"
+	}
+	kmipClient, err := createKmsKmipRESTClient(meta, instanceID, endpointType)
+	if err != nil {
+		if isKmsNotFound(err) {
+			log.Printf("[WARN] Key management instance %s of the KMIP adapter is gone", instanceID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	adapter, err := kmipClient.getAdapter(adapterID)
+	if err != nil {
+		if isKmsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(apiErrorf("kms", err, nil, "Error getting KMIP adapter %s of instance %s", adapterID, instanceID))
+	}
+	d.Set("instance_id", instanceID)
+	d.Set("endpoint_type", endpointType)
+	d.Set("adapter_id", adapter.ID)
+	d.Set("name", adapter.Name)
+	d.Set("description", adapter.Description)
+	d.Set("profile", adapter.Profile)
+	d.Set("crk_id", adapter.ProfileData["crk_id"])
+	d.Set("created_by", adapter.CreatedBy)
+	d.Set("created_at", adapter.CreatedAt)
+	return nil
+}
+
+// resourceIBMKmsKmipAdapterDelete deletes the KMIP adapter, the API deletes its
+// client certificates along with it.
+func resourceIBMKmsKmipAdapterDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceID, adapterID := parts[0], parts[1]
+	kmipClient, err := createKmsKmipRESTClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		if isKmsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if err := kmipClient.deleteAdapter(adapterID); err != nil && !isKmsNotFound(err) {
+		return diag.FromErr(apiErrorf("kms", err, nil, "Error deleting KMIP adapter %s of instance %s", adapterID, instanceID))
+	}
+	d.SetId("")
+	return nil
+}
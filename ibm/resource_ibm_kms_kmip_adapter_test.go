@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gotest.tools/assert"
+)
+
+func TestAccIBMKMSKmipAdapter_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_kms_%d", acctest.RandIntRange(10, 100))
+	adapterName := fmt.Sprintf("tf-kmip-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsKmipAdapterConfig(instanceName, adapterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_kmip_adapter.adapter", "name", adapterName),
+					resource.TestCheckResourceAttr("ibm_kms_kmip_adapter.adapter", "profile", "native_1.0"),
+					resource.TestCheckResourceAttrSet("ibm_kms_kmip_adapter.adapter", "adapter_id"),
+				),
+			},
+			{
+				ResourceName:            "ibm_kms_kmip_adapter.adapter",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"endpoint_type"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsKmipAdapterConfig(instanceName, adapterName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name     = "%s"
+		service  = "kms"
+		plan     = "tiered-pricing"
+		location = "us-south"
+	}
+	resource "ibm_kms_key" "root" {
+		instance_id  = ibm_resource_instance.kms_instance.guid
+		key_name     = "%[2]s-root"
+		standard_key = false
+		force_delete = true
+	}
+	resource "ibm_kms_kmip_adapter" "adapter" {
+		instance_id = ibm_resource_instance.kms_instance.guid
+		name        = "%[2]s"
+		description = "KMIP adapter of the acceptance test"
+		crk_id      = ibm_kms_key.root.key_id
+	}
+`, instanceName, adapterName)
+}
+
+const testMockKmipCertificate = `-----BEGIN CERTIFICATE-----
+MIIBszCCAVmgAwIBAgIUQ2xpZW50IGNlcnRpZmljYXRlIG9mIHRoZSBtb2NrMAoG
+CCqGSM49BAMCMBYxFDASBgNVBAMMC2ttaXAtY2xpZW50MB4XDTIxMDEwMTAwMDAw
+-----END CERTIFICATE-----
+`
+
+func TestIBMKmsKmipAdapterMock(t *testing.T) {
+	t.Parallel()
+	srv := testMockServer(t)
+	meta := testMockClientSession(t, srv)
+	instanceID, rootKeyID := testMockKMSInstance(t, meta)
+	r := resourceIBMKmsKmipAdapter()
+
+	missing := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"instance_id": instanceID,
+		"crk_id":      "mock-missing",
+	})
+	assert.ErrorContains(t, testDiagsErr(r.CreateContext(context.Background(), missing, meta)), "KEY_NOT_FOUND_ERR")
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"instance_id": instanceID,
+		"name":        "mock-adapter",
+		"description": "KMIP adapter of the mock",
+		"crk_id":      rootKeyID,
+	})
+	d.MarkNewResource()
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), d, meta)))
+	adapterID := d.Get("adapter_id").(string)
+	assert.Equal(t, d.Id(), instanceID+"/"+adapterID)
+	assert.Equal(t, d.Get("profile"), "native_1.0")
+	assert.Equal(t, d.Get("crk_id"), rootKeyID)
+	assert.Assert(t, d.Get("created_at") != "")
+
+	duplicate := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"instance_id": instanceID,
+		"name":        "mock-adapter",
+		"crk_id":      rootKeyID,
+	})
+	assert.ErrorContains(t, testDiagsErr(r.CreateContext(context.Background(), duplicate, meta)), "already exists")
+
+	certs := resourceIBMKmsKmipClientCert()
+	cert := schema.TestResourceDataRaw(t, certs.Schema, map[string]interface{}{
+		"instance_id": instanceID,
+		"adapter_id":  adapterID,
+		"certificate": testMockKmipCertificate,
+	})
+	cert.MarkNewResource()
+	assert.NilError(t, testDiagsErr(certs.CreateContext(context.Background(), cert, meta)))
+	certID := cert.Get("cert_id").(string)
+	assert.Equal(t, cert.Id(), fmt.Sprintf("%s/%s/%s", instanceID, adapterID, certID))
+	assert.Equal(t, cert.Get("certificate"), strings.TrimSpace(testMockKmipCertificate))
+	assert.Assert(t, strings.HasPrefix(cert.Get("name").(string), "kmip_cert-"))
+
+	invalid := schema.TestResourceDataRaw(t, certs.Schema, map[string]interface{}{
+		"instance_id": instanceID,
+		"adapter_id":  adapterID,
+		"certificate": "not a certificate",
+	})
+	assert.ErrorContains(t, testDiagsErr(certs.CreateContext(context.Background(), invalid, meta)), "KMIP_CERT_INVALID_ERR")
+
+	imported := r.Data(nil)
+	imported.SetId(d.Id())
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), imported, meta)))
+	assert.Equal(t, imported.Get("name"), "mock-adapter")
+	assert.Equal(t, imported.Get("description"), "KMIP adapter of the mock")
+	assert.Equal(t, imported.Get("endpoint_type"), "public")
+
+	certResourceID := cert.Id()
+	assert.NilError(t, testDiagsErr(certs.DeleteContext(context.Background(), cert, meta)))
+	cert.SetId(certResourceID)
+	assert.NilError(t, testDiagsErr(certs.ReadContext(context.Background(), cert, meta)))
+	assert.Equal(t, cert.Id(), "")
+
+	// Deleting the adapter deletes its certificates
+	cert.MarkNewResource()
+	assert.NilError(t, testDiagsErr(certs.CreateContext(context.Background(), cert, meta)))
+	certResourceID = cert.Id()
+	assert.NilError(t, testDiagsErr(r.DeleteContext(context.Background(), d, meta)))
+	cert.SetId(certResourceID)
+	assert.NilError(t, testDiagsErr(certs.ReadContext(context.Background(), cert, meta)))
+	assert.Equal(t, cert.Id(), "")
+	d.SetId(instanceID + "/" + adapterID)
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), d, meta)))
+	assert.Equal(t, d.Id(), "")
+}
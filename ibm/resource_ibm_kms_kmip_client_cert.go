@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMKmsKmipClientCert() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMKmsKmipClientCertCreate,
+		ReadContext:   resourceIBMKmsKmipClientCertRead,
+		DeleteContext: resourceIBMKmsKmipClientCertDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Key protect or hpcs instance GUID",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "public",
+				ValidateFunc: validateAllowedStringValue([]string{"public", "private"}),
+				Description:  "public or private",
+			},
+			"adapter_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the KMIP adapter",
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "PEM encoded X.509 certificate of the KMIP client",
+				StateFunc: func(v interface{}) string {
+					return strings.TrimSpace(v.(string))
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Name of the certificate, unique in the KMIP adapter",
+			},
+			"cert_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the certificate",
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The user who added the certificate",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation date of the certificate",
+			},
+		},
+	}
+}
+
+func resourceIBMKmsKmipClientCertCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("instance_id").(string)
+	adapterID := d.Get("adapter_id").(string)
+	kmipClient, err := createKmsKmipRESTClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	cert, err := kmipClient.createClientCert(adapterID, kmsKmipClientCert{
+		Name:        d.Get("name").(string),
+		Certificate: strings.TrimSpace(d.Get("certificate").(string)),
+	})
+	if err != nil {
+		return diag.FromErr(apiErrorf("kms", err, nil, "Error adding the client certificate of KMIP adapter %s of instance %s", adapterID, instanceID))
+	}
+	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, adapterID, cert.ID))
+	return resourceIBMKmsKmipClientCertRead(context, d, meta)
+}
+
+func resourceIBMKmsKmipClientCertRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) != 3 {
+		return diag.FromErr(fmt.Errorf("Invalid ID %s of the KMIP client certificate, it must be <instance_id>/<adapter_id>/<cert_id>", d.Id()))
+	}
+	instanceID, adapterID, certID := parts[0], parts[1], parts[2]
+	endpointType := d.Get("endpoint_type").(string)
+	if endpointType == "" {
+		endpointType = "public"
+	}
+	kmipClient, err := createKmsKmipRESTClient(meta, instanceID, endpointType)
+	if err != nil {
+		if isKmsNotFound(err) {
+			log.Printf("[WARN] Key management instance %s of the KMIP client certificate is gone", instanceID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	cert, err := kmipClient.getClientCert(adapterID, certID)
+	if err != nil {
+		if isKmsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(apiErrorf("kms", err, nil, "Error getting client certificate %s of KMIP adapter %s", certID, adapterID))
+	}
+	d.Set("instance_id", instanceID)
+	d.Set("endpoint_type", endpointType)
+	d.Set("adapter_id", adapterID)
+	d.Set("cert_id", cert.ID)
+	d.Set("name", cert.Name)
+	d.Set("certificate", strings.TrimSpace(cert.Certificate))
+	d.Set("created_by", cert.CreatedBy)
+	d.Set("created_at", cert.CreatedAt)
+	return nil
+}
+
+func resourceIBMKmsKmipClientCertDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) != 3 {
+		return diag.FromErr(fmt.Errorf("Invalid ID %s of the KMIP client certificate, it must be <instance_id>/<adapter_id>/<cert_id>", d.Id()))
+	}
+	instanceID, adapterID, certID := parts[0], parts[1], parts[2]
+	kmipClient, err := createKmsKmipRESTClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		if isKmsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if err := kmipClient.deleteClientCert(adapterID, certID); err != nil && !isKmsNotFound(err) {
+		return diag.FromErr(apiErrorf("kms", err, nil, "Error deleting client certificate %s of KMIP adapter %s", certID, adapterID))
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,44 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSKmipClientCert_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_kms_%d", acctest.RandIntRange(10, 100))
+	adapterName := fmt.Sprintf("tf-kmip-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsKmipClientCertConfig(instanceName, adapterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_kmip_client_cert.cert", "name", adapterName+"-cert"),
+					resource.TestCheckResourceAttrPair("ibm_kms_kmip_client_cert.cert", "adapter_id", "ibm_kms_kmip_adapter.adapter", "adapter_id"),
+					resource.TestCheckResourceAttrSet("ibm_kms_kmip_client_cert.cert", "cert_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsKmipClientCertConfig(instanceName, adapterName string) string {
+	return testAccCheckIBMKmsKmipAdapterConfig(instanceName, adapterName) + fmt.Sprintf(`
+	resource "ibm_kms_kmip_client_cert" "cert" {
+		instance_id = ibm_resource_instance.kms_instance.guid
+		adapter_id  = ibm_kms_kmip_adapter.adapter.adapter_id
+		name        = "%s-cert"
+		certificate = <<EOT
+%s
+EOT
+	}
+`, adapterName, kmipClientCert)
+}
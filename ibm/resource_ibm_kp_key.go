@@ -12,17 +12,17 @@ import (
 	"time"
 
 	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMkey() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMKeyCreate,
-		Read:     resourceIBMKeyRead,
-		Update:   resourceIBMKeyUpdate,
-		Delete:   resourceIBMKeyDelete,
-		Exists:   resourceIBMKeyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMKeyCreate,
+		ReadContext:   resourceIBMKeyRead,
+		UpdateContext: resourceIBMKeyUpdate,
+		DeleteContext: resourceIBMKeyDelete,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Update: schema.DefaultTimeout(60 * time.Minute),
@@ -114,10 +114,10 @@ func resourceIBMkey() *schema.Resource {
 	}
 }
 
-func resourceIBMKeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKeyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, err := meta.(ClientSession).keyProtectAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	instanceID := d.Get("key_protect_id").(string)
 	api.Config.InstanceID = instanceID
@@ -129,18 +129,18 @@ func resourceIBMKeyCreate(d *schema.ResourceData, meta interface{}) error {
 		if v, ok := d.GetOk("payload"); ok {
 			//import standard key
 			payload := v.(string)
-			stkey, err := api.CreateImportedStandardKey(context.Background(), name, nil, payload)
+			stkey, err := api.CreateImportedStandardKey(context, name, nil, payload)
 			if err != nil {
-				return fmt.Errorf(
-					"Error while creating standard key: %s", err)
+				return diag.FromErr(fmt.Errorf(
+					"Error while creating standard key: %s", err))
 			}
 			keyCRN = stkey.CRN
 		} else {
 			//create standard key
-			stkey, err := api.CreateStandardKey(context.Background(), name, nil)
+			stkey, err := api.CreateStandardKey(context, name, nil)
 			if err != nil {
-				return fmt.Errorf(
-					"Error while creating standard key: %s", err)
+				return diag.FromErr(fmt.Errorf(
+					"Error while creating standard key: %s", err))
 			}
 			keyCRN = stkey.CRN
 		}
@@ -150,17 +150,17 @@ func resourceIBMKeyCreate(d *schema.ResourceData, meta interface{}) error {
 			payload := v.(string)
 			encryptedNonce := d.Get("encrypted_nonce").(string)
 			iv := d.Get("iv_value").(string)
-			stkey, err := api.CreateImportedRootKey(context.Background(), name, nil, payload, encryptedNonce, iv)
+			stkey, err := api.CreateImportedRootKey(context, name, nil, payload, encryptedNonce, iv)
 			if err != nil {
-				return fmt.Errorf(
-					"Error while creating Root key: %s", err)
+				return diag.FromErr(fmt.Errorf(
+					"Error while creating Root key: %s", err))
 			}
 			keyCRN = stkey.CRN
 		} else {
-			stkey, err := api.CreateRootKey(context.Background(), name, nil)
+			stkey, err := api.CreateRootKey(context, name, nil)
 			if err != nil {
-				return fmt.Errorf(
-					"Error while creating Root key: %s", err)
+				return diag.FromErr(fmt.Errorf(
+					"Error while creating Root key: %s", err))
 			}
 			keyCRN = stkey.CRN
 		}
@@ -170,13 +170,22 @@ func resourceIBMKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("force_delete", d.Get("force_delete").(bool))
 
-	return resourceIBMKeyRead(d, meta)
+	return resourceIBMKeyRead(context, d, meta)
 }
 
-func resourceIBMKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKeyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMKeyExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	api, err := meta.(ClientSession).keyProtectAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	crn := d.Id()
 	crnData := strings.Split(crn, ":")
@@ -185,10 +194,10 @@ func resourceIBMKeyRead(d *schema.ResourceData, meta interface{}) error {
 	keyid := crnData[len(crnData)-1]
 	api.Config.InstanceID = instanceID
 	// keyid := d.Id()
-	key, err := api.GetKey(context.Background(), keyid)
+	key, err := api.GetKey(context, keyid)
 	if err != nil {
-		return fmt.Errorf(
-			"Get Key failed with error: %s", err)
+		return diag.FromErr(fmt.Errorf(
+			"Get Key failed with error: %s", err))
 	}
 	d.Set("key_id", keyid)
 	d.Set("standard_key", key.Extractable)
@@ -206,7 +215,7 @@ func resourceIBMKeyRead(d *schema.ResourceData, meta interface{}) error {
 
 	rcontroller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := key.ID
 	crn1 := strings.TrimSuffix(key.CRN, ":key:"+id)
@@ -217,19 +226,19 @@ func resourceIBMKeyRead(d *schema.ResourceData, meta interface{}) error {
 
 }
 
-func resourceIBMKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKeyUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	if d.HasChange("force_delete") {
 		d.Set("force_delete", d.Get("force_delete").(bool))
 	}
-	return resourceIBMKeyRead(d, meta)
+	return resourceIBMKeyRead(context, d, meta)
 
 }
 
-func resourceIBMKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKeyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, err := meta.(ClientSession).keyProtectAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	crn := d.Id()
 	crnData := strings.Split(crn, ":")
@@ -241,10 +250,10 @@ func resourceIBMKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	f := kp.ForceOpt{
 		Force: force,
 	}
-	_, err1 := api.DeleteKey(context.Background(), keyid, kp.ReturnRepresentation, f)
+	_, err1 := api.DeleteKey(context, keyid, kp.ReturnRepresentation, f)
 	if err1 != nil {
-		return fmt.Errorf(
-			"Error while deleting: %s", err1)
+		return diag.FromErr(fmt.Errorf(
+			"Error while deleting: %s", err1))
 	}
 	d.SetId("")
 	return nil
@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -32,12 +34,11 @@ const (
 
 func resourceIBMLb() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMLbCreate,
-		Read:     resourceIBMLbRead,
-		Update:   resourceIBMLbUpdate,
-		Delete:   resourceIBMLbDelete,
-		Exists:   resourceIBMLbExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMLbCreate,
+		ReadContext:   resourceIBMLbRead,
+		UpdateContext: resourceIBMLbUpdate,
+		DeleteContext: resourceIBMLbDelete,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 		},
@@ -107,7 +108,7 @@ func resourceIBMLb() *schema.Resource {
 	}
 }
 
-func resourceIBMLbCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess := meta.(ClientSession).SoftLayerSession()
 
@@ -145,7 +146,7 @@ func resourceIBMLbCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 	} else {
 		if d.Get("ha_enabled").(bool) {
-			return fmt.Errorf("High Availability is not supported for shared local load balancers")
+			return diag.FromErr(fmt.Errorf("High Availability is not supported for shared local load balancers"))
 		}
 		categoryCode = product.ProxyLoadBalancerCategoryCode
 		if _, ok := d.GetOk("security_certificate_id"); ok {
@@ -161,13 +162,13 @@ func resourceIBMLbCreate(d *schema.ResourceData, meta interface{}) error {
 
 	pkg, err := product.GetPackageByType(sess, LbLocalPackageType)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Get all prices for ADDITIONAL_SERVICE_LOAD_BALANCER with the given capacity
 	productItems, err := product.GetPackageProducts(sess, *pkg.Id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Select only those product items with a matching keyname
@@ -179,7 +180,7 @@ func resourceIBMLbCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if len(targetItems) == 0 {
-		return fmt.Errorf("No product items matching %s could be found", keyName)
+		return diag.FromErr(fmt.Errorf("No product items matching %s could be found", keyName))
 	}
 
 	//select prices with the required capacity
@@ -207,12 +208,12 @@ func resourceIBMLbCreate(d *schema.ResourceData, meta interface{}) error {
 	receipt, err := services.GetProductOrderService(sess.SetRetries(0)).
 		PlaceOrder(&productOrderContainer, sl.Bool(false))
 	if err != nil {
-		return fmt.Errorf("Error during creation of load balancer: %s", err)
+		return diag.FromErr(fmt.Errorf("Error during creation of load balancer: %s", err))
 	}
 
-	loadBalancer, err := findLoadBalancerByOrderId(sess, *receipt.OrderId, dedicated, d)
+	loadBalancer, err := findLoadBalancerByOrderId(context, sess, *receipt.OrderId, dedicated, d)
 	if err != nil {
-		return fmt.Errorf("Error during creation of load balancer: %s", err)
+		return diag.FromErr(fmt.Errorf("Error during creation of load balancer: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%d", *loadBalancer.Id))
@@ -224,10 +225,10 @@ func resourceIBMLbCreate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[INFO] Load Balancer ID: %s", d.Id())
 
-	return resourceIBMLbUpdate(d, meta)
+	return resourceIBMLbUpdate(context, d, meta)
 }
 
-func resourceIBMLbUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	vipID, _ := strconv.Atoi(d.Id())
@@ -236,7 +237,7 @@ func resourceIBMLbUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	err := setLocalLBSecurityCert(sess, vipID, certID)
 	if err != nil {
-		return fmt.Errorf("Update load balancer failed: %s", err)
+		return diag.FromErr(fmt.Errorf("Update load balancer failed: %s", err))
 	}
 
 	if d.HasChange("connections") {
@@ -245,7 +246,7 @@ func resourceIBMLbUpdate(d *schema.ResourceData, meta interface{}) error {
 			Mask(lbMask).
 			GetObject()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		ors, nrs := d.GetChange("connections")
 		oldValue := ors.(int)
@@ -253,7 +254,7 @@ func resourceIBMLbUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		if oldValue > 0 {
 			if *vip.DedicatedFlag {
-				return fmt.Errorf("Error Updating load balancer connection limit: Upgrade for dedicated loadbalancer is not supported")
+				return diag.FromErr(fmt.Errorf("Error Updating load balancer connection limit: Upgrade for dedicated loadbalancer is not supported"))
 			}
 			if vip.BillingItem.UpgradeItems[0].Capacity != nil {
 				validUpgradeValue := vip.BillingItem.UpgradeItems[0].Capacity
@@ -261,16 +262,16 @@ func resourceIBMLbUpdate(d *schema.ResourceData, meta interface{}) error {
 					_, err := services.GetNetworkApplicationDeliveryControllerLoadBalancerVirtualIpAddressService(sess).
 						Id(vipID).UpgradeConnectionLimit()
 					if err != nil {
-						return fmt.Errorf("Error Updating load balancer connection limit: %s", err)
+						return diag.FromErr(fmt.Errorf("Error Updating load balancer connection limit: %s", err))
 					}
 				} else {
 
-					return fmt.Errorf("Error Updating load balancer connection limit : Valid value to which connection limit can be upgraded is : %d ", int(*validUpgradeValue))
+					return diag.FromErr(fmt.Errorf("Error Updating load balancer connection limit : Valid value to which connection limit can be upgraded is : %d ", int(*validUpgradeValue)))
 
 				}
 
 			} else {
-				return fmt.Errorf("Error Updating load balancer connection limit: No upgrade available, already it has maximum connection limit")
+				return diag.FromErr(fmt.Errorf("Error Updating load balancer connection limit: No upgrade available, already it has maximum connection limit"))
 			}
 		}
 
@@ -283,7 +284,7 @@ func resourceIBMLbUpdate(d *schema.ResourceData, meta interface{}) error {
 			_, err := services.GetNetworkApplicationDeliveryControllerLoadBalancerVirtualIpAddressService(sess).
 				Id(vipID).StartSsl()
 			if err != nil {
-				return fmt.Errorf("Error starting ssl acceleration for load balancer : %s", err)
+				return diag.FromErr(fmt.Errorf("Error starting ssl acceleration for load balancer : %s", err))
 			}
 
 		} else {
@@ -291,16 +292,25 @@ func resourceIBMLbUpdate(d *schema.ResourceData, meta interface{}) error {
 			_, err := services.GetNetworkApplicationDeliveryControllerLoadBalancerVirtualIpAddressService(sess).
 				Id(vipID).StopSsl()
 			if err != nil {
-				return fmt.Errorf("Error stopping ssl acceleration for load balancer : %s", err)
+				return diag.FromErr(fmt.Errorf("Error stopping ssl acceleration for load balancer : %s", err))
 			}
 
 		}
 	}
 
-	return resourceIBMLbRead(d, meta)
+	return resourceIBMLbRead(context, d, meta)
 }
 
-func resourceIBMLbRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMLbExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 	vipID, _ := strconv.Atoi(d.Id())
 
@@ -310,7 +320,7 @@ func resourceIBMLbRead(d *schema.ResourceData, meta interface{}) error {
 		GetObject()
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving load balancer: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving load balancer: %s", err))
 	}
 
 	d.Set("connections", getConnectionLimit(*vip.ConnectionLimit))
@@ -327,7 +337,7 @@ func resourceIBMLbRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMLbDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	vipService := services.GetNetworkApplicationDeliveryControllerLoadBalancerVirtualIpAddressService(sess)
 	vipID, _ := strconv.Atoi(d.Id())
@@ -337,7 +347,7 @@ func resourceIBMLbDelete(d *schema.ResourceData, meta interface{}) error {
 	if certID > 0 {
 		err := setLocalLBSecurityCert(sess, vipID, 0)
 		if err != nil {
-			return fmt.Errorf("Remove certificate before deleting load balancer failed: %s", err)
+			return diag.FromErr(fmt.Errorf("Remove certificate before deleting load balancer failed: %s", err))
 		}
 
 	}
@@ -357,19 +367,19 @@ func resourceIBMLbDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error while looking up billing item associated with the load balancer: %s", err)
+		return diag.FromErr(fmt.Errorf("Error while looking up billing item associated with the load balancer: %s", err))
 	}
 
 	if billingItem.Id == nil {
-		return fmt.Errorf("Error while looking up billing item associated with the load balancer: No billing item for ID:%d", vipID)
+		return diag.FromErr(fmt.Errorf("Error while looking up billing item associated with the load balancer: No billing item for ID:%d", vipID))
 	}
 	success, err := services.GetBillingItemService(sess).Id(*billingItem.Id).CancelService()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if !success {
-		return fmt.Errorf("SoftLayer reported an unsuccessful cancellation")
+		return diag.FromErr(fmt.Errorf("SoftLayer reported an unsuccessful cancellation"))
 	}
 
 	return nil
@@ -408,7 +418,7 @@ func getConnectionLimit(connectionLimit int) int {
 	}
 }
 
-func findLoadBalancerByOrderId(sess *session.Session, orderId int, dedicated bool, d *schema.ResourceData) (datatypes.Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress, error) {
+func findLoadBalancerByOrderId(context context.Context, sess *session.Session, orderId int, dedicated bool, d *schema.ResourceData) (datatypes.Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress, error) {
 	var filterPath string
 	if dedicated {
 		filterPath = "adcLoadBalancers.dedicatedBillingItem.orderItem.order.id"
@@ -444,7 +454,7 @@ func findLoadBalancerByOrderId(sess *session.Session, orderId int, dedicated boo
 		NotFoundChecks: 24 * 60,
 	}
 
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := stateConf.WaitForStateContext(context)
 
 	if err != nil {
 		return datatypes.Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress{}, err
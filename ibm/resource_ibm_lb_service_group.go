@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -12,6 +13,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -23,12 +25,11 @@ import (
 
 func resourceIBMLbServiceGroup() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMLbServiceGroupCreate,
-		Read:     resourceIBMLbServiceGroupRead,
-		Update:   resourceIBMLbServiceGroupUpdate,
-		Delete:   resourceIBMLbServiceGroupDelete,
-		Exists:   resourceIBMLbServiceGroupExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMLbServiceGroupCreate,
+		ReadContext:   resourceIBMLbServiceGroupRead,
+		UpdateContext: resourceIBMLbServiceGroupUpdate,
+		DeleteContext: resourceIBMLbServiceGroupDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"virtual_server_id": {
@@ -84,19 +85,19 @@ func resourceIBMLbServiceGroup() *schema.Resource {
 	}
 }
 
-func resourceIBMLbServiceGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbServiceGroupCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	vipID := d.Get("load_balancer_id").(int)
 
 	routingMethodID, err := getRoutingMethodId(sess, d.Get("routing_method").(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	routingTypeID, err := getRoutingTypeId(sess, d.Get("routing_type").(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	timeout := d.Get("timeout").(int)
@@ -119,10 +120,10 @@ func resourceIBMLbServiceGroupCreate(d *schema.ResourceData, meta interface{}) e
 
 	log.Println("[INFO] Creating load balancer service group")
 
-	err = updateLoadBalancerService(sess.SetRetries(0), vipID, &vip)
+	err = updateLoadBalancerService(context, sess.SetRetries(0), vipID, &vip)
 
 	if err != nil {
-		return fmt.Errorf("Error creating load balancer service group: %s", err)
+		return diag.FromErr(fmt.Errorf("Error creating load balancer service group: %s", err))
 	}
 
 	// Retrieve the newly created object, to obtain its ID
@@ -133,7 +134,7 @@ func resourceIBMLbServiceGroupCreate(d *schema.ResourceData, meta interface{}) e
 		GetVirtualServers()
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving load balancer: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving load balancer: %s", err))
 	}
 
 	d.SetId(strconv.Itoa(*vs[0].Id))
@@ -141,9 +142,9 @@ func resourceIBMLbServiceGroupCreate(d *schema.ResourceData, meta interface{}) e
 
 	log.Printf("[INFO] Load Balancer Service Group ID: %s", d.Id())
 
-	return resourceIBMLbServiceGroupRead(d, meta)
+	return resourceIBMLbServiceGroupRead(context, d, meta)
 }
-func resourceIBMLbServiceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbServiceGroupUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	vipID := d.Get("load_balancer_id").(int)
@@ -152,12 +153,12 @@ func resourceIBMLbServiceGroupUpdate(d *schema.ResourceData, meta interface{}) e
 
 	routingMethodId, err := getRoutingMethodId(sess, d.Get("routing_method").(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	routingTypeId, err := getRoutingTypeId(sess, d.Get("routing_type").(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	vip := datatypes.Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress{
@@ -185,16 +186,25 @@ func resourceIBMLbServiceGroupUpdate(d *schema.ResourceData, meta interface{}) e
 
 	log.Println("[INFO] Updating load balancer service group")
 
-	err = updateLoadBalancerService(sess.SetRetries(0), vipID, &vip)
+	err = updateLoadBalancerService(context, sess.SetRetries(0), vipID, &vip)
 
 	if err != nil {
-		return fmt.Errorf("Error creating load balancer service group: %s", err)
+		return diag.FromErr(fmt.Errorf("Error creating load balancer service group: %s", err))
 	}
 
-	return resourceIBMLbServiceGroupRead(d, meta)
+	return resourceIBMLbServiceGroupRead(context, d, meta)
 }
 
-func resourceIBMLbServiceGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbServiceGroupRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMLbServiceGroupExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	vsID, _ := strconv.Atoi(d.Id())
@@ -205,7 +215,7 @@ func resourceIBMLbServiceGroupRead(d *schema.ResourceData, meta interface{}) err
 		GetObject()
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving load balancer: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving load balancer: %s", err))
 	}
 
 	d.Set("allocation", vs.Allocation)
@@ -219,7 +229,7 @@ func resourceIBMLbServiceGroupRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceIBMLbServiceGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbServiceGroupDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	vsID, _ := strconv.Atoi(d.Id())
@@ -257,10 +267,10 @@ func resourceIBMLbServiceGroupDelete(d *schema.ResourceData, meta interface{}) e
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(context)
 
 	if err != nil {
-		return fmt.Errorf("Error deleting service: %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting service: %s", err))
 	}
 
 	return nil
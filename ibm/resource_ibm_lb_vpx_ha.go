@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/minsikl/netscaler-nitro-go/client"
 	dt "github.com/minsikl/netscaler-nitro-go/datatypes"
@@ -18,12 +20,11 @@ import (
 
 func resourceIBMLbVpxHa() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMLbVpxHaCreate,
-		Read:     resourceIBMLbVpxHaRead,
-		Update:   resourceIBMLbVpxHaUpdate,
-		Delete:   resourceIBMLbVpxHaDelete,
-		Exists:   resourceIBMLbVpxHaExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMLbVpxHaCreate,
+		ReadContext:   resourceIBMLbVpxHaRead,
+		UpdateContext: resourceIBMLbVpxHaUpdate,
+		DeleteContext: resourceIBMLbVpxHaDelete,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 
 			"primary_id": {
@@ -191,7 +192,7 @@ func parseHAId(id string) (int, int, error) {
 	return primaryId, secondaryId, nil
 }
 
-func resourceIBMLbVpxHaCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxHaCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	primaryId := d.Get("primary_id").(int)
 	secondaryId := d.Get("secondary_id").(int)
 	staySecondary := false
@@ -201,40 +202,49 @@ func resourceIBMLbVpxHaCreate(d *schema.ResourceData, meta interface{}) error {
 
 	nClientPrimary, err := getNitroClient(meta.(ClientSession).SoftLayerSession(), primaryId)
 	if err != nil {
-		return fmt.Errorf("Error getting primary netscaler information ID: %d", primaryId)
+		return diag.FromErr(fmt.Errorf("Error getting primary netscaler information ID: %d", primaryId))
 	}
 
 	nClientSecondary, err := getNitroClient(meta.(ClientSession).SoftLayerSession(), secondaryId)
 	if err != nil {
-		return fmt.Errorf("Error getting secondary netscaler information ID: %d", secondaryId)
+		return diag.FromErr(fmt.Errorf("Error getting secondary netscaler information ID: %d", secondaryId))
 	}
 
 	err = configureHA(nClientPrimary, nClientSecondary, staySecondary)
 	if err != nil {
-		return fmt.Errorf("Error configuration HA %s", err.Error())
+		return diag.FromErr(fmt.Errorf("Error configuration HA %s", err.Error()))
 	}
 
 	d.SetId(fmt.Sprintf("%d:%d", primaryId, secondaryId))
 
 	log.Printf("[INFO] Netscaler HA ID: %s", d.Id())
 
-	return resourceIBMLbVpxHaRead(d, meta)
+	return resourceIBMLbVpxHaRead(context, d, meta)
 }
 
-func resourceIBMLbVpxHaRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxHaRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMLbVpxHaExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	primaryId, secondaryId, err := parseHAId(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error reading HA %s", err.Error())
+		return diag.FromErr(fmt.Errorf("Error reading HA %s", err.Error()))
 	}
 
 	nClientPrimary, err := getNitroClient(meta.(ClientSession).SoftLayerSession(), primaryId)
 	if err != nil {
-		return fmt.Errorf("Error getting primary netscaler information ID: %d", primaryId)
+		return diag.FromErr(fmt.Errorf("Error getting primary netscaler information ID: %d", primaryId))
 	}
 
 	nClientSecondary, err := getNitroClient(meta.(ClientSession).SoftLayerSession(), secondaryId)
 	if err != nil {
-		return fmt.Errorf("Error getting primary netscaler information ID: %d", primaryId)
+		return diag.FromErr(fmt.Errorf("Error getting primary netscaler information ID: %d", primaryId))
 	}
 
 	nClientSecondary.Password = nClientPrimary.Password
@@ -256,20 +266,20 @@ func resourceIBMLbVpxHaRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMLbVpxHaUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxHaUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	primaryId, secondaryId, err := parseHAId(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error deleting HA %s", err.Error())
+		return diag.FromErr(fmt.Errorf("Error deleting HA %s", err.Error()))
 	}
 
 	nClientPrimary, err := getNitroClient(meta.(ClientSession).SoftLayerSession(), primaryId)
 	if err != nil {
-		return fmt.Errorf("Error getting primary netscaler information ID: %d", primaryId)
+		return diag.FromErr(fmt.Errorf("Error getting primary netscaler information ID: %d", primaryId))
 	}
 
 	nClientSecondary, err := getNitroClient(meta.(ClientSession).SoftLayerSession(), secondaryId)
 	if err != nil {
-		return fmt.Errorf("Error getting secondary netscaler information ID: %d", secondaryId)
+		return diag.FromErr(fmt.Errorf("Error getting secondary netscaler information ID: %d", secondaryId))
 	}
 
 	nClientSecondary.Password = nClientPrimary.Password
@@ -288,31 +298,31 @@ func resourceIBMLbVpxHaUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	err = nClientSecondary.Update(&stay)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceIBMLbVpxHaDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxHaDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	primaryId, secondaryId, err := parseHAId(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error deleting HA %s", err.Error())
+		return diag.FromErr(fmt.Errorf("Error deleting HA %s", err.Error()))
 	}
 	nClientPrimary, err := getNitroClient(meta.(ClientSession).SoftLayerSession(), primaryId)
 	if err != nil {
-		return fmt.Errorf("Error getting primary netscaler information ID: %d", primaryId)
+		return diag.FromErr(fmt.Errorf("Error getting primary netscaler information ID: %d", primaryId))
 	}
 	nClientSecondary, err := getNitroClient(meta.(ClientSession).SoftLayerSession(), secondaryId)
 	if err != nil {
-		return fmt.Errorf("Error getting secondary netscaler information ID: %d", secondaryId)
+		return diag.FromErr(fmt.Errorf("Error getting secondary netscaler information ID: %d", secondaryId))
 	}
 
 	secondaryPassword := nClientSecondary.Password
 	nClientSecondary.Password = nClientPrimary.Password
 	err = deleteHA(nClientPrimary, nClientSecondary)
 	if err != nil {
-		return fmt.Errorf("Error deleting HA %s", err.Error())
+		return diag.FromErr(fmt.Errorf("Error deleting HA %s", err.Error()))
 	}
 
 	// Restore password of the secondary VPX
@@ -324,7 +334,7 @@ func resourceIBMLbVpxHaDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 	err = nClientSecondary.Update(&systemuserReq)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
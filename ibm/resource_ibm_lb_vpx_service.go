@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -11,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dt "github.com/minsikl/netscaler-nitro-go/datatypes"
 	"github.com/minsikl/netscaler-nitro-go/op"
@@ -41,12 +43,11 @@ var (
 
 func resourceIBMLbVpxService() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMLbVpxServiceCreate,
-		Read:     resourceIBMLbVpxServiceRead,
-		Update:   resourceIBMLbVpxServiceUpdate,
-		Delete:   resourceIBMLbVpxServiceDelete,
-		Exists:   resourceIBMLbVpxServiceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMLbVpxServiceCreate,
+		ReadContext:   resourceIBMLbVpxServiceRead,
+		UpdateContext: resourceIBMLbVpxServiceUpdate,
+		DeleteContext: resourceIBMLbVpxServiceDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 
@@ -156,78 +157,92 @@ func updateVpxService(sess *session.Session, nadcId int, lbVip *datatypes.Networ
 	return successFlag, err
 }
 
-func resourceIBMLbVpxServiceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxServiceCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	vipId := d.Get("vip_id").(string)
 	_, nadcId, _, err := parseServiceId(vipId)
 
 	if err != nil {
-		return fmt.Errorf("Error parsing vip id: %s", err)
+		return diag.FromErr(fmt.Errorf("Error parsing vip id: %s", err))
 	}
 
 	version, err := getVPXVersion(nadcId, meta.(ClientSession).SoftLayerSession())
 	if err != nil {
-		return fmt.Errorf("Error creating Virtual Ip Address: %s", err)
+		return diag.FromErr(fmt.Errorf("Error creating Virtual Ip Address: %s", err))
 	}
 
 	if version == VPX_VERSION_10_1 {
-		return resourceIBMLbVpxServiceCreate101(d, meta)
+		err = resourceIBMLbVpxServiceCreate101(d, meta)
+	} else {
+		err = resourceIBMLbVpxServiceCreate105(d, meta)
+	}
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMLbVpxServiceCreate105(d, meta)
+	return resourceIBMLbVpxServiceRead(context, d, meta)
 }
 
-func resourceIBMLbVpxServiceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxServiceRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMLbVpxServiceExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	_, nadcId, _, err := parseServiceId(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error parsing vip id: %s", err)
+		return diag.FromErr(fmt.Errorf("Error parsing vip id: %s", err))
 	}
 
 	version, err := getVPXVersion(nadcId, meta.(ClientSession).SoftLayerSession())
 	if err != nil {
-		return fmt.Errorf("Error Reading Virtual Ip Address: %s", err)
+		return diag.FromErr(fmt.Errorf("Error Reading Virtual Ip Address: %s", err))
 	}
 
 	if version == VPX_VERSION_10_1 {
-		return resourceIBMLbVpxServiceRead101(d, meta)
+		return diag.FromErr(resourceIBMLbVpxServiceRead101(d, meta))
 	}
 
-	return resourceIBMLbVpxServiceRead105(d, meta)
+	return diag.FromErr(resourceIBMLbVpxServiceRead105(d, meta))
 }
 
-func resourceIBMLbVpxServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxServiceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	_, nadcId, _, err := parseServiceId(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error updating Virtual IP Address: %s", err)
+		return diag.FromErr(fmt.Errorf("Error updating Virtual IP Address: %s", err))
 	}
 
 	version, err := getVPXVersion(nadcId, meta.(ClientSession).SoftLayerSession())
 	if err != nil {
-		return fmt.Errorf("Error updating Virtual Ip Address: %s", err)
+		return diag.FromErr(fmt.Errorf("Error updating Virtual Ip Address: %s", err))
 	}
 
 	if version == VPX_VERSION_10_1 {
-		return resourceIBMLbVpxServiceUpdate101(d, meta)
+		return diag.FromErr(resourceIBMLbVpxServiceUpdate101(d, meta))
 	}
 
-	return resourceIBMLbVpxServiceUpdate105(d, meta)
+	return diag.FromErr(resourceIBMLbVpxServiceUpdate105(d, meta))
 }
 
-func resourceIBMLbVpxServiceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxServiceDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	_, nadcId, _, err := parseServiceId(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error deleting Virtual Ip Address: %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting Virtual Ip Address: %s", err))
 	}
 
 	version, err := getVPXVersion(nadcId, meta.(ClientSession).SoftLayerSession())
 	if err != nil {
-		return fmt.Errorf("Error deleting Virtual Ip Address: %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting Virtual Ip Address: %s", err))
 	}
 
 	if version == VPX_VERSION_10_1 {
-		return resourceIBMLbVpxServiceDelete101(d, meta)
+		return diag.FromErr(resourceIBMLbVpxServiceDelete101(d, meta))
 	}
 
-	return resourceIBMLbVpxServiceDelete105(d, meta)
+	return diag.FromErr(resourceIBMLbVpxServiceDelete105(d, meta))
 }
 
 func resourceIBMLbVpxServiceExists(d *schema.ResourceData, meta interface{}) (bool, error) {
@@ -299,7 +314,7 @@ func resourceIBMLbVpxServiceCreate101(d *schema.ResourceData, meta interface{})
 
 	d.SetId(fmt.Sprintf("%s:%s", vipId, serviceName))
 
-	return resourceIBMLbVpxServiceRead(d, meta)
+	return nil
 }
 
 func resourceIBMLbVpxServiceCreate105(d *schema.ResourceData, meta interface{}) error {
@@ -389,7 +404,7 @@ func resourceIBMLbVpxServiceCreate105(d *schema.ResourceData, meta interface{})
 
 	d.SetId(fmt.Sprintf("%s:%s", vipId, serviceName))
 
-	return resourceIBMLbVpxServiceRead(d, meta)
+	return nil
 }
 
 func resourceIBMLbVpxServiceRead101(d *schema.ResourceData, meta interface{}) error {
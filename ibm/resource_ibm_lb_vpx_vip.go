@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"encoding/base64"
 	"errors"
 	"fmt"
@@ -12,6 +13,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/helpers/network"
@@ -58,12 +60,11 @@ var (
 
 func resourceIBMLbVpxVip() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMLbVpxVipCreate,
-		Read:     resourceIBMLbVpxVipRead,
-		Update:   resourceIBMLbVpxVipUpdate,
-		Delete:   resourceIBMLbVpxVipDelete,
-		Exists:   resourceIBMLbVpxVipExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMLbVpxVipCreate,
+		ReadContext:   resourceIBMLbVpxVipRead,
+		UpdateContext: resourceIBMLbVpxVipUpdate,
+		DeleteContext: resourceIBMLbVpxVipDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"nad_controller_id": {
@@ -134,71 +135,90 @@ func resourceIBMLbVpxVip() *schema.Resource {
 	}
 }
 
-func resourceIBMLbVpxVipCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxVipCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	version, err := getVPXVersion(d.Get("nad_controller_id").(int), meta.(ClientSession).SoftLayerSession())
 	if err != nil {
-		return fmt.Errorf("Error creating Virtual Ip Address: %s", err)
+		return diag.FromErr(fmt.Errorf("Error creating Virtual Ip Address: %s", err))
 	}
 
 	if version == VPX_VERSION_10_1 {
-		return resourceIBMLbVpxVipCreate101(d, meta)
+		err = resourceIBMLbVpxVipCreate101(d, meta)
+	} else {
+		err = resourceIBMLbVpxVipCreate105(d, meta)
+	}
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMLbVpxVipCreate105(d, meta)
+	return resourceIBMLbVpxVipRead(context, d, meta)
 }
 
-func resourceIBMLbVpxVipRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxVipRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMLbVpxVipExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	nadcId, _, err := parseId(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error Reading Virtual IP Address: %s", err)
+		return diag.FromErr(fmt.Errorf("Error Reading Virtual IP Address: %s", err))
 	}
 
 	version, err := getVPXVersion(nadcId, meta.(ClientSession).SoftLayerSession())
 	if err != nil {
-		return fmt.Errorf("Error Reading Virtual Ip Address: %s", err)
+		return diag.FromErr(fmt.Errorf("Error Reading Virtual Ip Address: %s", err))
 	}
 
 	if version == VPX_VERSION_10_1 {
-		return resourceIBMLbVpxVipRead101(d, meta)
+		return diag.FromErr(resourceIBMLbVpxVipRead101(d, meta))
 	}
 
-	return resourceIBMLbVpxVipRead105(d, meta)
+	return diag.FromErr(resourceIBMLbVpxVipRead105(d, meta))
 }
 
-func resourceIBMLbVpxVipUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxVipUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	nadcId, _, err := parseId(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error updating Virtual IP Address: %s", err)
+		return diag.FromErr(fmt.Errorf("Error updating Virtual IP Address: %s", err))
 	}
 
 	version, err := getVPXVersion(nadcId, meta.(ClientSession).SoftLayerSession())
 	if err != nil {
-		return fmt.Errorf("Error updating Virtual Ip Address: %s", err)
+		return diag.FromErr(fmt.Errorf("Error updating Virtual Ip Address: %s", err))
 	}
 
 	if version == VPX_VERSION_10_1 {
-		return resourceIBMLbVpxVipUpdate101(d, meta)
+		err = resourceIBMLbVpxVipUpdate101(d, meta)
+	} else {
+		err = resourceIBMLbVpxVipUpdate105(d, meta)
+	}
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMLbVpxVipUpdate105(d, meta)
+	return resourceIBMLbVpxVipRead(context, d, meta)
 }
 
-func resourceIBMLbVpxVipDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxVipDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	nadcId, _, err := parseId(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error deleting Virtual Ip Address: %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting Virtual Ip Address: %s", err))
 	}
 
 	version, err := getVPXVersion(nadcId, meta.(ClientSession).SoftLayerSession())
 	if err != nil {
-		return fmt.Errorf("Error deleting Virtual Ip Address: %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting Virtual Ip Address: %s", err))
 	}
 
 	if version == VPX_VERSION_10_1 {
-		return resourceIBMLbVpxVipDelete101(d, meta)
+		return diag.FromErr(resourceIBMLbVpxVipDelete101(d, meta))
 	}
 
-	return resourceIBMLbVpxVipDelete105(d, meta)
+	return diag.FromErr(resourceIBMLbVpxVipDelete105(d, meta))
 }
 
 func resourceIBMLbVpxVipExists(d *schema.ResourceData, meta interface{}) (bool, error) {
@@ -294,7 +314,7 @@ func resourceIBMLbVpxVipCreate101(d *schema.ResourceData, meta interface{}) erro
 
 	log.Printf("[INFO] Netscaler VPX VIP ID: %s", d.Id())
 
-	return resourceIBMLbVpxVipRead(d, meta)
+	return nil
 }
 
 func resourceIBMLbVpxVipCreate105(d *schema.ResourceData, meta interface{}) error {
@@ -364,7 +384,7 @@ func resourceIBMLbVpxVipCreate105(d *schema.ResourceData, meta interface{}) erro
 
 	log.Printf("[INFO] Netscaler VPX VIP ID: %s", d.Id())
 
-	return resourceIBMLbVpxVipRead(d, meta)
+	return nil
 }
 
 func resourceIBMLbVpxVipRead101(d *schema.ResourceData, meta interface{}) error {
@@ -506,7 +526,7 @@ func resourceIBMLbVpxVipUpdate101(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Error updating Virtual Ip Address: %s", err)
 	}
 
-	return resourceIBMLbVpxVipRead(d, meta)
+	return nil
 }
 
 func resourceIBMLbVpxVipUpdate105(d *schema.ResourceData, meta interface{}) error {
@@ -547,7 +567,7 @@ func resourceIBMLbVpxVipUpdate105(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Error updating Virtual Ip Address: " + err.Error())
 	}
 
-	return resourceIBMLbVpxVipRead(d, meta)
+	return nil
 }
 
 func resourceIBMLbVpxVipDelete101(d *schema.ResourceData, meta interface{}) error {
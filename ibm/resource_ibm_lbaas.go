@@ -5,12 +5,14 @@ package ibm
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -52,12 +54,11 @@ func init() {
 
 func resourceIBMLbaas() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMLbaasCreate,
-		Read:     resourceIBMLbaasRead,
-		Delete:   resourceIBMLbaasDelete,
-		Exists:   resourceIBMLbaasExists,
-		Update:   resourceIBMLbaasUpdate,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMLbaasCreate,
+		ReadContext:   resourceIBMLbaasRead,
+		DeleteContext: resourceIBMLbaasDelete,
+		UpdateContext: resourceIBMLbaasUpdate,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -241,14 +242,14 @@ func resourceIBMLbaas() *schema.Resource {
 	}
 }
 
-func resourceIBMLbaasCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbaasCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	// Find price items
 	productOrderContainer, err := buildLbaasLBProductOrderContainer(d, sess)
 	if err != nil {
-		return fmt.Errorf("Error creating Load balancer: %s", err)
+		return diag.FromErr(fmt.Errorf("Error creating Load balancer: %s", err))
 	}
 	log.Println("[INFO] Creating Load Balancer")
 
@@ -256,34 +257,43 @@ func resourceIBMLbaasCreate(d *schema.ResourceData, meta interface{}) error {
 	_, err = services.GetProductOrderService(sess).
 		VerifyOrder(productOrderContainer)
 	if err != nil {
-		return fmt.Errorf("Error during creation of Load balancer: %s", err)
+		return diag.FromErr(fmt.Errorf("Error during creation of Load balancer: %s", err))
 	}
 	//place order
 	_, err = services.GetProductOrderService(sess.SetRetries(0)).
 		PlaceOrder(productOrderContainer, sl.Bool(false))
 	if err != nil {
-		return fmt.Errorf("Error during creation of Load balancer: %s", err)
+		return diag.FromErr(fmt.Errorf("Error during creation of Load balancer: %s", err))
 	}
 
 	name := d.Get("name").(string)
 
-	lbaasLB, err := findLbaasLBByOrderId(sess, name, d)
+	lbaasLB, err := findLbaasLBByOrderId(context, sess, name, d)
 	if err != nil {
-		return fmt.Errorf("Error during creation of Load balancer: %s", err)
+		return diag.FromErr(fmt.Errorf("Error during creation of Load balancer: %s", err))
 	}
 
 	d.SetId(*lbaasLB.Uuid)
 
-	return resourceIBMLbaasUpdate(d, meta)
+	return resourceIBMLbaasUpdate(context, d, meta)
 }
 
-func resourceIBMLbaasRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbaasRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMLbaasExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkLBaaSLoadBalancerService(sess)
 
 	result, err := service.Mask("datacenter,members,listeners.defaultPool,listeners.defaultPool.sessionAffinity,listeners.defaultPool.healthMonitor,healthMonitors,sslCiphers[name],useSystemPublicIpPool,isPublic,name,description,operatingStatus,address").GetLoadBalancer(sl.String(d.Id()))
 	if err != nil {
-		return fmt.Errorf("Error retrieving load balancer: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving load balancer: %s", err))
 	}
 
 	var lbType string
@@ -316,14 +326,14 @@ func resourceIBMLbaasRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMLbaasUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbaasUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkLBaaSLoadBalancerService(sess.SetRetries(0))
 
 	if d.HasChange("description") {
 		_, err := service.UpdateLoadBalancer(sl.String(d.Id()), sl.String(d.Get("description").(string)))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	listenerService := services.GetNetworkLBaaSListenerService(sess.SetRetries(0))
@@ -334,7 +344,7 @@ func resourceIBMLbaasUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		add, err := expandProtocols(ns.Difference(os).List())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		rem := os.Difference(ns).List()
 		removeList := make([]string, len(rem), len(rem))
@@ -347,24 +357,24 @@ func resourceIBMLbaasUpdate(d *schema.ResourceData, meta interface{}) error {
 		if len(removeList) > 0 {
 			_, err := listenerService.DeleteLoadBalancerProtocols(sl.String(d.Id()), removeList)
 			if err != nil {
-				return fmt.Errorf("Error removing protocols: %#v", err)
+				return diag.FromErr(fmt.Errorf("Error removing protocols: %#v", err))
 			}
-			_, err = waitForLbaasLBAvailable(d, meta)
+			_, err = waitForLbaasLBAvailable(context, d, meta)
 			if err != nil {
-				return fmt.Errorf(
-					"Error waiting for load balancer (%s) to become ready: %s", d.Id(), err)
+				return diag.FromErr(fmt.Errorf(
+					"Error waiting for load balancer (%s) to become ready: %s", d.Id(), err))
 			}
 		}
 
 		if len(add) > 0 {
 			_, err := listenerService.UpdateLoadBalancerProtocols(sl.String(d.Id()), add)
 			if err != nil {
-				return fmt.Errorf("Error adding protocols: %#v", err)
+				return diag.FromErr(fmt.Errorf("Error adding protocols: %#v", err))
 			}
-			_, err = waitForLbaasLBAvailable(d, meta)
+			_, err = waitForLbaasLBAvailable(context, d, meta)
 			if err != nil {
-				return fmt.Errorf(
-					"Error waiting for load balancer (%s) to become ready: %s", d.Id(), err)
+				return diag.FromErr(fmt.Errorf(
+					"Error waiting for load balancer (%s) to become ready: %s", d.Id(), err))
 			}
 		}
 
@@ -374,7 +384,7 @@ func resourceIBMLbaasUpdate(d *schema.ResourceData, meta interface{}) error {
 			service := services.GetNetworkLBaaSLoadBalancerService(sess.SetRetries(0))
 			supportedCiphers, err := services.GetNetworkLBaaSSSLCipherService(sess).Mask("id,name").GetAllObjects()
 			if err != nil {
-				return fmt.Errorf("Error retreving list of ssl ciphers: %#v", err)
+				return diag.FromErr(fmt.Errorf("Error retreving list of ssl ciphers: %#v", err))
 			}
 			ciphers := make([]int, v.(*schema.Set).Len())
 			for i, v := range v.(*schema.Set).List() {
@@ -387,22 +397,22 @@ func resourceIBMLbaasUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 			_, err = service.UpdateSslCiphers(sl.String(d.Id()), ciphers)
 			if err != nil {
-				return fmt.Errorf("Error updating ssl ciphers: %#v", err)
+				return diag.FromErr(fmt.Errorf("Error updating ssl ciphers: %#v", err))
 			}
-			_, err = waitForLbaasLBAvailable(d, meta)
+			_, err = waitForLbaasLBAvailable(context, d, meta)
 			if err != nil {
-				return fmt.Errorf(
-					"Error waiting for load balancer (%s) to become ready: %s", d.Id(), err)
+				return diag.FromErr(fmt.Errorf(
+					"Error waiting for load balancer (%s) to become ready: %s", d.Id(), err))
 			}
 
 		}
 
 	}
 
-	return resourceIBMLbaasRead(d, meta)
+	return resourceIBMLbaasRead(context, d, meta)
 }
 
-func resourceIBMLbaasDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbaasDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkLBaaSLoadBalancerService(sess)
 
@@ -411,13 +421,13 @@ func resourceIBMLbaasDelete(d *schema.ResourceData, meta interface{}) error {
 		if strings.Contains(err.Error(), "DELETE_PENDING") {
 			log.Println("Deletion is already in progress, probably from previous runs")
 		} else {
-			return fmt.Errorf("Error deleting load balancer: %s", err)
+			return diag.FromErr(fmt.Errorf("Error deleting load balancer: %s", err))
 		}
 	}
-	_, err = waitForLbaasLBDelete(d, meta)
+	_, err = waitForLbaasLBDelete(context, d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for load balancer (%s) to be deleted: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf(
+			"Error waiting for load balancer (%s) to be deleted: %s", d.Id(), err))
 	}
 	d.SetId("")
 	return nil
@@ -499,7 +509,7 @@ func buildLbaasLBProductOrderContainer(d *schema.ResourceData, sess *session.Ses
 	return &productOrderContainer, nil
 }
 
-func findLbaasLBByOrderId(sess *session.Session, name string, d *schema.ResourceData) (*datatypes.Network_LBaaS_LoadBalancer, error) {
+func findLbaasLBByOrderId(context context.Context, sess *session.Session, name string, d *schema.ResourceData) (*datatypes.Network_LBaaS_LoadBalancer, error) {
 
 	isIDSet := false
 	stateConf := &resource.StateChangeConf{
@@ -536,7 +546,7 @@ func findLbaasLBByOrderId(sess *session.Session, name string, d *schema.Resource
 		NotFoundChecks: 40,
 	}
 
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := stateConf.WaitForStateContext(context)
 
 	if err != nil {
 		return nil, err
@@ -550,7 +560,7 @@ func findLbaasLBByOrderId(sess *session.Session, name string, d *schema.Resource
 		fmt.Errorf("Cannot find a load balancer with name '%s' ", name)
 }
 
-func waitForLbaasLBAvailable(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForLbaasLBAvailable(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkLBaaSLoadBalancerService(sess)
 
@@ -577,10 +587,10 @@ func waitForLbaasLBAvailable(d *schema.ResourceData, meta interface{}) (interfac
 		NotFoundChecks: 40,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
-func waitForLbaasLBDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForLbaasLBDelete(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkLBaaSLoadBalancerService(sess)
 
@@ -603,7 +613,7 @@ func waitForLbaasLBDelete(d *schema.ResourceData, meta interface{}) (interface{}
 		PollInterval: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func resourceIBMLBProtocolHash(v interface{}) int {
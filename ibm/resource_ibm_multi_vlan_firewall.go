@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/filter"
@@ -21,12 +23,11 @@ import (
 
 func resourceIBMMultiVlanFirewall() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMNetworkMultiVlanCreate,
-		Read:     resourceIBMMultiVlanFirewallRead,
-		Delete:   resourceIBMFirewallDelete,
-		Update:   resourceIBMMultiVlanFirewallUpdate,
-		Exists:   resourceIBMMultiVLanFirewallExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMNetworkMultiVlanCreate,
+		ReadContext:   resourceIBMMultiVlanFirewallRead,
+		DeleteContext: resourceIBMFirewallDelete,
+		UpdateContext: resourceIBMMultiVlanFirewallUpdate,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"datacenter": {
@@ -122,7 +123,7 @@ const (
 	multiVlansMask            = "id,customerManagedFlag,datacenter.name,bandwidthAllocation"
 )
 
-func resourceIBMNetworkMultiVlanCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkMultiVlanCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	name := d.Get("name").(string)
 	FirewallType := d.Get("firewall_type").(string)
@@ -135,7 +136,7 @@ func resourceIBMNetworkMultiVlanCreate(d *schema.ResourceData, meta interface{})
 	// 1.Getting the router ID
 	routerids, err := PodService.Filter(filter.Path("datacenterName").Eq(datacenter).Build()).Mask(podMask).GetAllObjects()
 	if err != nil {
-		return fmt.Errorf("Encountered problem trying to get the router ID: %s", err)
+		return diag.FromErr(fmt.Errorf("Encountered problem trying to get the router ID: %s", err))
 	}
 	var routerid int
 	for _, iterate := range routerids {
@@ -147,7 +148,7 @@ func resourceIBMNetworkMultiVlanCreate(d *schema.ResourceData, meta interface{})
 	//2.Get the datacenter id
 	dc, err := location.GetDatacenterByName(sess, datacenter, "id")
 	if err != nil {
-		return fmt.Errorf("Encountered problem trying to get the Datacenter ID: %s", err)
+		return diag.FromErr(fmt.Errorf("Encountered problem trying to get the Datacenter ID: %s", err))
 	}
 	locationservice := services.GetLocationService(sess)
 
@@ -181,7 +182,7 @@ func resourceIBMNetworkMultiVlanCreate(d *schema.ResourceData, meta interface{})
 	for _, addon := range actualaddons {
 		actualpriceid, err := product.GetPriceIDByPackageIdandLocationGroups(sess, listofpriceids, 863, addon)
 		if err != nil || actualpriceid == 0 {
-			return fmt.Errorf("Encountered problem trying to get priceIds of items which have to be ordered: %s", err)
+			return diag.FromErr(fmt.Errorf("Encountered problem trying to get priceIds of items which have to be ordered: %s", err))
 		}
 		priceItem := datatypes.Product_Item_Price{
 			Id: &actualpriceid,
@@ -213,25 +214,34 @@ func resourceIBMNetworkMultiVlanCreate(d *schema.ResourceData, meta interface{})
 	_, err = services.GetProductOrderService(sess.SetRetries(0)).
 		VerifyOrder(&productOrderContainer)
 	if err != nil {
-		return fmt.Errorf("Error during Verify order for Creating: %s", err)
+		return diag.FromErr(fmt.Errorf("Error during Verify order for Creating: %s", err))
 	}
 	//9.Calling place order
 	receipt, err := services.GetProductOrderService(sess.SetRetries(0)).
 		PlaceOrder(&productOrderContainer, sl.Bool(false))
 	if err != nil {
-		return fmt.Errorf("Error during Place order for Creating: %s", err)
+		return diag.FromErr(fmt.Errorf("Error during Place order for Creating: %s", err))
 	}
-	_, vlan, _, err := findDedicatedFirewallByOrderId(sess, *receipt.OrderId, d)
+	_, vlan, _, err := findDedicatedFirewallByOrderId(context, sess, *receipt.OrderId, d)
 	if err != nil {
-		return fmt.Errorf("Error during creation of dedicated hardware firewall: %s", err)
+		return diag.FromErr(fmt.Errorf("Error during creation of dedicated hardware firewall: %s", err))
 	}
 	id := *vlan.NetworkFirewall.Id
 	d.SetId(fmt.Sprintf("%d", id))
 	log.Printf("[INFO] Firewall ID: %s", d.Id())
-	return resourceIBMMultiVlanFirewallRead(d, meta)
+	return resourceIBMMultiVlanFirewallRead(context, d, meta)
 }
 
-func resourceIBMMultiVlanFirewallRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMMultiVlanFirewallRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMMultiVLanFirewallExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	fwID, _ := strconv.Atoi(d.Id())
@@ -243,7 +253,7 @@ func resourceIBMMultiVlanFirewallRead(d *schema.ResourceData, meta interface{})
 		Mask(multiVlanMask).
 		GetNetworkGateways()
 	if err != nil {
-		return fmt.Errorf("Error retrieving firewall information: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving firewall information: %s", err))
 	}
 	d.Set("datacenter", *firewalls[0].NetworkFirewall.Datacenter.Name)
 	if *firewalls[0].NetworkFirewall.CustomerManagedFlag && *firewalls[0].MemberCount == 1 {
@@ -274,7 +284,7 @@ func resourceIBMMultiVlanFirewallRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceIBMMultiVlanFirewallUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMMultiVlanFirewallUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if d.HasChange("addon_configuration") {
 		sess := meta.(ClientSession).SoftLayerSession()
 		fwID, _ := strconv.Atoi(d.Id())
@@ -300,7 +310,7 @@ func resourceIBMMultiVlanFirewallUpdate(d *schema.ResourceData, meta interface{}
 				Mask(multiVlanMask).
 				GetNetworkGateways()
 			if err != nil {
-				return fmt.Errorf("Some error occured while fetching the information of the Multi-Vlan Firewall")
+				return diag.FromErr(fmt.Errorf("Some error occured while fetching the information of the Multi-Vlan Firewall"))
 			}
 			for _, i := range remove {
 				for _, j := range firewalls[0].NetworkFirewall.BillingItem.ActiveChildren {
@@ -311,7 +321,7 @@ func resourceIBMMultiVlanFirewallUpdate(d *schema.ResourceData, meta interface{}
 						customerNote := "No longer needed"
 						billingitemservice, err := services.GetBillingItemService(sess).Id(*j.Id).CancelItem(&cancelimmediately, &cancelAssociatedBillingItems, &reason, &customerNote)
 						if err != nil || !billingitemservice {
-							return fmt.Errorf("Error while cancelling the addon")
+							return diag.FromErr(fmt.Errorf("Error while cancelling the addon"))
 						}
 					}
 				}
@@ -320,12 +330,12 @@ func resourceIBMMultiVlanFirewallUpdate(d *schema.ResourceData, meta interface{}
 		if len(add) > 0 {
 			datacentername, ok := d.GetOk("datacenter")
 			if !ok {
-				return fmt.Errorf("The attribute datacenter is not defined")
+				return diag.FromErr(fmt.Errorf("The attribute datacenter is not defined"))
 			}
 			//2.Get the datacenter id
 			dc, err := location.GetDatacenterByName(sess, datacentername.(string), "id")
 			if err != nil {
-				return fmt.Errorf("Datacenter not found")
+				return diag.FromErr(fmt.Errorf("Datacenter not found"))
 			}
 			locationservice := services.GetLocationService(sess)
 			//3. get the pricegroups that the datacenter belongs to
@@ -339,7 +349,7 @@ func resourceIBMMultiVlanFirewallUpdate(d *schema.ResourceData, meta interface{}
 			for _, addon := range add {
 				actualpriceid, err := product.GetPriceIDByPackageIdandLocationGroups(sess, listofpriceids, 863, addon)
 				if err != nil || actualpriceid == 0 {
-					return fmt.Errorf("The addon or the firewall is not available for the datacenter you have selected. Please enter a different datacenter")
+					return diag.FromErr(fmt.Errorf("The addon or the firewall is not available for the datacenter you have selected. Please enter a different datacenter"))
 				}
 				priceItem := datatypes.Product_Item_Price{
 					Id: &actualpriceid,
@@ -372,22 +382,22 @@ func resourceIBMMultiVlanFirewallUpdate(d *schema.ResourceData, meta interface{}
 			_, err = services.GetProductOrderService(sess.SetRetries(0)).
 				VerifyOrder(&upgradeproductOrderContainer)
 			if err != nil {
-				return fmt.Errorf("Error during Verify order for Updating: %s", err)
+				return diag.FromErr(fmt.Errorf("Error during Verify order for Updating: %s", err))
 			}
 
 			//9.Calling place order
 			receipt, err := services.GetProductOrderService(sess.SetRetries(0)).
 				PlaceOrder(&upgradeproductOrderContainer, sl.Bool(false))
 			if err != nil {
-				return fmt.Errorf("Error during Place order for Updating: %s", err)
+				return diag.FromErr(fmt.Errorf("Error during Place order for Updating: %s", err))
 			}
-			_, _, _, err = findDedicatedFirewallByOrderId(sess, *receipt.OrderId, d)
+			_, _, _, err = findDedicatedFirewallByOrderId(context, sess, *receipt.OrderId, d)
 			if err != nil {
-				return fmt.Errorf("Error during creation of dedicated hardware firewall: %s", err)
+				return diag.FromErr(fmt.Errorf("Error during creation of dedicated hardware firewall: %s", err))
 			}
 		}
 	}
-	return resourceIBMMultiVlanFirewallRead(d, meta)
+	return resourceIBMMultiVlanFirewallRead(context, d, meta)
 }
 
 func resourceIBMMultiVLanFirewallExists(d *schema.ResourceData, meta interface{}) (bool, error) {
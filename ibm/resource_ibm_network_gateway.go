@@ -5,6 +5,7 @@ package ibm
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"math/rand"
@@ -14,6 +15,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -30,12 +32,11 @@ const GATEWAY_APPLIANCE_CLUSTER = "NETWORK_GATEWAY_APPLIANCE_CLUSTER"
 
 func resourceIBMNetworkGateway() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMNetworkGatewayCreate,
-		Read:     resourceIBMNetworkGatewayRead,
-		Update:   resourceIBMNetworkGatewayUpdate,
-		Delete:   resourceIBMNetworkGatewayDelete,
-		Exists:   resourceIBMNetworkGatewayExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMNetworkGatewayCreate,
+		ReadContext:   resourceIBMNetworkGatewayRead,
+		UpdateContext: resourceIBMNetworkGatewayUpdate,
+		DeleteContext: resourceIBMNetworkGatewayDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 
@@ -363,7 +364,7 @@ func resourceIBMNetworkGateway() *schema.Resource {
 	}
 }
 
-func resourceIBMNetworkGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkGatewayCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	members := []gatewayMember{}
 	for _, v := range d.Get("members").(*schema.Set).List() {
@@ -373,21 +374,21 @@ func resourceIBMNetworkGatewayCreate(d *schema.ResourceData, meta interface{}) e
 
 	if len(members) == 2 {
 		if !areVlanCompatible(members) {
-			return fmt.Errorf("Members should have exactly same public and private vlan configuration," +
-				"please check public_vlan_id and private_vlan_id property on individual members")
+			return diag.FromErr(fmt.Errorf("Members should have exactly same public and private vlan configuration," +
+				"please check public_vlan_id and private_vlan_id property on individual members"))
 		}
 	}
 
 	//Build order for one member
 	order, err := getMonthlyGatewayOrder(members[0], meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Encountered problem trying to get the Gateway order template: %s", err)
+		return diag.FromErr(fmt.Errorf(
+			"Encountered problem trying to get the Gateway order template: %s", err))
 	}
 	err = setHardwareOptions(members[0], &order.Hardware[0])
 	if err != nil {
-		return fmt.Errorf(
-			"Encountered problem trying to configure Gateway options: %s", err)
+		return diag.FromErr(fmt.Errorf(
+			"Encountered problem trying to configure Gateway options: %s", err))
 	}
 
 	// two members can be ordered together if they have same hardware configuration
@@ -422,8 +423,8 @@ func resourceIBMNetworkGatewayCreate(d *schema.ResourceData, meta interface{}) e
 		})
 		err = setHardwareOptions(members[1], &order.Hardware[1])
 		if err != nil {
-			return fmt.Errorf(
-				"Encountered problem trying to configure Gateway options: %s", err)
+			return diag.FromErr(fmt.Errorf(
+				"Encountered problem trying to configure Gateway options: %s", err))
 		}
 
 	}
@@ -446,23 +447,23 @@ func resourceIBMNetworkGatewayCreate(d *schema.ResourceData, meta interface{}) e
 	pkg, err := getPackageByModelGateway(sess, GATEWAY_APPLIANCE_CLUSTER, false)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if pkg.Id == nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// 2. Get all prices for the package
 	items, err := product.GetPackageProducts(sess, *pkg.Id, productItemMaskWithPriceLocationGroupID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// 3. Build price items
 	gwCluster, err := getItemPriceId(items, "gateway_resource_group", "GATEWAY_APPLIANCE_CLUSTER")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterIdentifier := randomString(8)
@@ -500,20 +501,20 @@ func resourceIBMNetworkGatewayCreate(d *schema.ResourceData, meta interface{}) e
 
 	_, err = services.GetProductOrderService(sess).VerifyOrder(&productOrder)
 	if err != nil {
-		return fmt.Errorf(
-			"Encountered problem trying to verify the order: %s", err)
+		return diag.FromErr(fmt.Errorf(
+			"Encountered problem trying to verify the order: %s", err))
 	}
 	orderReceipt, err := services.GetProductOrderService(sess.SetRetries(0)).PlaceOrder(&productOrder, sl.Bool(false))
 	if err != nil {
-		return fmt.Errorf(
-			"Encountered problem trying to place the order: %s", err)
+		return diag.FromErr(fmt.Errorf(
+			"Encountered problem trying to place the order: %s", err))
 	}
 
 	gID := *orderReceipt.OrderDetails.OrderContainers[0].Hardware[0].GlobalIdentifier
-	bm, err := waitForNetworkGatewayMemberProvision(&order.Hardware[0], meta, gID)
+	bm, err := waitForNetworkGatewayMemberProvision(context, &order.Hardware[0], meta, gID)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for Gateway (%s) to become ready: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf(
+			"Error waiting for Gateway (%s) to become ready: %s", d.Id(), err))
 	}
 
 	id := *bm.(datatypes.Hardware).NetworkGatewayMember.NetworkGatewayId
@@ -526,39 +527,39 @@ func resourceIBMNetworkGatewayCreate(d *schema.ResourceData, meta interface{}) e
 
 	err = setTagsAndNotes(members[0], meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if sameOrder {
 		// If we ordered HA and then wait for other member
 		gID1 := *orderReceipt.OrderDetails.OrderContainers[0].Hardware[1].GlobalIdentifier
-		bm, err := waitForNetworkGatewayMemberProvision(&order.Hardware[1], meta, gID1)
+		bm, err := waitForNetworkGatewayMemberProvision(context, &order.Hardware[1], meta, gID1)
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for Gateway (%s) to become ready: %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf(
+				"Error waiting for Gateway (%s) to become ready: %s", d.Id(), err))
 		}
 		member2Id := *bm.(datatypes.Hardware).Id
 		log.Printf("[INFO] Member 2 ID: %d", member2Id)
 		members[1]["member_id"] = member2Id
 		err = setTagsAndNotes(members[1], meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else if len(members) == 2 {
 		//Add the new gateway which has different configuration than the first
-		err := addGatewayMember(id, members[1], meta)
+		err := addGatewayMember(context, id, members[1], meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	name := d.Get("name").(string)
 	err = updateGatewayName(id, name, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMNetworkGatewayRead(d, meta)
+	return resourceIBMNetworkGatewayRead(context, d, meta)
 }
 
 func randomString(length int) string {
@@ -583,11 +584,20 @@ func resourceIBMMemberHostHash(v interface{}) int {
 	return hashcode.String(buf.String())
 }
 
-func resourceIBMNetworkGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkGatewayRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMNetworkGatewayExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	service := services.GetNetworkGatewayService(meta.(ClientSession).SoftLayerSession())
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 	}
 	result, err := service.Id(id).Mask(
 		"insideVlans,members,status,privateIpAddress[ipAddress],publicIpAddress[ipAddress]," +
@@ -598,7 +608,7 @@ func resourceIBMNetworkGatewayRead(d *schema.ResourceData, meta interface{}) err
 			"powerSupplyCount,primaryNetworkComponent[networkVlan],memoryCapacity,networkVlans[id,vlanNumber]]]",
 	).GetObject()
 	if err != nil {
-		return fmt.Errorf("Error retrieving Network Gateway: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving Network Gateway: %s", err))
 	}
 	d.Set("name", result.Name)
 	if result.PrivateIpAddress != nil {
@@ -640,7 +650,7 @@ func updateGatewayName(id int, name string, meta interface{}) error {
 	return err
 }
 
-func addGatewayMember(gwID int, member gatewayMember, meta interface{}) error {
+func addGatewayMember(context context.Context, gwID int, member gatewayMember, meta interface{}) error {
 	sess := meta.(ClientSession).SoftLayerSession()
 	order, err := getMonthlyGatewayOrder(member, meta)
 	if err != nil {
@@ -686,7 +696,7 @@ func addGatewayMember(gwID int, member gatewayMember, meta interface{}) error {
 
 	gID := *orderReceipt.OrderDetails.Hardware[0].GlobalIdentifier
 
-	bm, err := waitForNetworkGatewayMemberProvision(&order.Hardware[0], meta, gID)
+	bm, err := waitForNetworkGatewayMemberProvision(context, &order.Hardware[0], meta, gID)
 	if err != nil {
 		return fmt.Errorf(
 			"Error waiting for Gateway (%d) to become ready: %s", gwID, err)
@@ -698,23 +708,23 @@ func addGatewayMember(gwID int, member gatewayMember, meta interface{}) error {
 	return err
 }
 
-func resourceIBMNetworkGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkGatewayUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id, _ := strconv.Atoi(d.Id())
 	if d.HasChange("name") {
 		gwName := d.Get("name").(string)
 		err := updateGatewayName(id, gwName, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceIBMNetworkGatewayRead(d, meta)
+	return resourceIBMNetworkGatewayRead(context, d, meta)
 }
 
-func resourceIBMNetworkGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkGatewayDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 	}
 	service := services.GetNetworkGatewayService(sess)
 	gw, err := service.Id(id).Mask("members[hardwareId]").GetObject()
@@ -722,9 +732,9 @@ func resourceIBMNetworkGatewayDelete(d *schema.ResourceData, meta interface{}) e
 		m := gatewayMember{
 			"member_id": *v.HardwareId,
 		}
-		err := deleteHardware(m, meta)
+		err := deleteHardware(context, m, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	//If both the hardwares have been deleted then gateway will go away as well
@@ -1012,7 +1022,7 @@ func setHardwareOptions(m gatewayMember, hardware *datatypes.Hardware) error {
 // Have to wait on provision date to become available on server that matches
 // hostname and domain.
 // http://sldn.softlayer.com/blog/bpotter/ordering-bare-metal-servers-using-softlayer-api
-func waitForNetworkGatewayMemberProvision(d *datatypes.Hardware, meta interface{}, globalIdentifier string) (interface{}, error) {
+func waitForNetworkGatewayMemberProvision(context context.Context, d *datatypes.Hardware, meta interface{}, globalIdentifier string) (interface{}, error) {
 	hostname := *d.Hostname
 	domain := *d.Domain
 	log.Printf("Waiting for Gateway (%s.%s) to be provisioned", hostname, domain)
@@ -1042,7 +1052,7 @@ func waitForNetworkGatewayMemberProvision(d *datatypes.Hardware, meta interface{
 		NotFoundChecks: 24 * 60,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func setTagsAndNotes(m gatewayMember, meta interface{}) error {
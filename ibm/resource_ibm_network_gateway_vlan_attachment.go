@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -18,12 +20,11 @@ import (
 
 func resourceIBMNetworkGatewayVlanAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMNetworkGatewayVlanAttachmentCreate,
-		Read:     resourceIBMNetworkGatewayVlanAttachmentRead,
-		Update:   resourceIBMNetworkGatewayVlanAttachmentUpdate,
-		Delete:   resourceIBMNetworkGatewayVlanAttachmentDelete,
-		Exists:   resourceIBMNetworkGatewayVlanAttachmentExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMNetworkGatewayVlanAttachmentCreate,
+		ReadContext:   resourceIBMNetworkGatewayVlanAttachmentRead,
+		UpdateContext: resourceIBMNetworkGatewayVlanAttachmentUpdate,
+		DeleteContext: resourceIBMNetworkGatewayVlanAttachmentDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"gateway_id": {
@@ -48,7 +49,7 @@ func resourceIBMNetworkGatewayVlanAttachment() *schema.Resource {
 	}
 }
 
-func resourceIBMNetworkGatewayVlanAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkGatewayVlanAttachmentCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	gatewayID := d.Get("gateway_id").(int)
 	networkVlanID := d.Get("network_vlan_id").(int)
 	bypass := d.Get("bypass").(bool)
@@ -67,22 +68,22 @@ func resourceIBMNetworkGatewayVlanAttachmentCreate(d *schema.ResourceData, meta
 					if !bypass {
 						err = vlanService.Id(*i.Id).Unbypass()
 						if err != nil {
-							return err
+							return diag.FromErr(err)
 						}
 					} else {
 						err = vlanService.Id(*i.Id).Bypass()
 						if err != nil {
-							return err
+							return diag.FromErr(err)
 						}
 					}
-					_, err = waitForNetworkGatewayActiveState(*i.NetworkGatewayId, meta)
+					_, err = waitForNetworkGatewayActiveState(context, *i.NetworkGatewayId, meta)
 					if err != nil {
-						return err
+						return diag.FromErr(err)
 					}
 				}
 				vlan, err := vlanService.Id(*i.Id).GetObject()
 				if err != nil {
-					return fmt.Errorf("Error trying to retrieve Network Gateway Vlan: %s", err)
+					return diag.FromErr(fmt.Errorf("Error trying to retrieve Network Gateway Vlan: %s", err))
 				}
 				d.SetId(fmt.Sprintf("%d", *vlan.Id))
 				d.Set("bypass", vlan.BypassFlag)
@@ -101,26 +102,35 @@ func resourceIBMNetworkGatewayVlanAttachmentCreate(d *schema.ResourceData, meta
 
 	resp, err := resourceIBMNetworkGatewayVlanAssociate(d, meta, vlan)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%d", *resp.Id))
-	_, err = waitForNetworkGatewayActiveState(gatewayID, meta)
+	_, err = waitForNetworkGatewayActiveState(context, gatewayID, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMNetworkGatewayVlanAttachmentRead(d, meta)
+	return resourceIBMNetworkGatewayVlanAttachmentRead(context, d, meta)
 }
 
-func resourceIBMNetworkGatewayVlanAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkGatewayVlanAttachmentRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMNetworkGatewayVlanAttachmentExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 	}
 
 	vlan, err := services.GetNetworkGatewayVlanService(sess).Id(id).GetObject()
 	if err != nil {
-		return fmt.Errorf("Error trying to retrieve Network Gateway Vlan: %s", err)
+		return diag.FromErr(fmt.Errorf("Error trying to retrieve Network Gateway Vlan: %s", err))
 	}
 	d.Set("gateway_id", vlan.NetworkGatewayId)
 	d.Set("network_vlan_id", vlan.NetworkVlanId)
@@ -128,12 +138,12 @@ func resourceIBMNetworkGatewayVlanAttachmentRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceIBMNetworkGatewayVlanAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkGatewayVlanAttachmentUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkGatewayVlanService(sess)
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 	}
 	if d.HasChange("bypass") {
 		bypass := d.Get("bypass").(bool)
@@ -141,22 +151,22 @@ func resourceIBMNetworkGatewayVlanAttachmentUpdate(d *schema.ResourceData, meta
 		if !bypass {
 			err = service.Id(id).Unbypass()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		} else {
 			err = service.Id(id).Bypass()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 		vlan, err := service.Id(id).GetObject()
-		_, err = waitForNetworkGatewayActiveState(*vlan.NetworkGatewayId, meta)
+		_, err = waitForNetworkGatewayActiveState(context, *vlan.NetworkGatewayId, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceIBMNetworkGatewayVlanAttachmentRead(d, meta)
+	return resourceIBMNetworkGatewayVlanAttachmentRead(context, d, meta)
 }
 
 func resourceIBMNetworkGatewayVlanAttachmentExists(d *schema.ResourceData, meta interface{}) (bool, error) {
@@ -174,22 +184,22 @@ func resourceIBMNetworkGatewayVlanAttachmentExists(d *schema.ResourceData, meta
 	return result.Id != nil && *result.Id == id, nil
 }
 
-func resourceIBMNetworkGatewayVlanAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkGatewayVlanAttachmentDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 	}
 	vlan, err := services.GetNetworkGatewayVlanService(meta.(ClientSession).SoftLayerSession()).Id(id).GetObject()
 
 	err = resourceIBMNetworkGatewayVlanDissociate(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	_, err = waitForNetworkGatewayActiveState(*vlan.NetworkGatewayId, meta)
+	_, err = waitForNetworkGatewayActiveState(context, *vlan.NetworkGatewayId, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -221,7 +231,7 @@ func resourceIBMNetworkGatewayVlanDissociate(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func waitForNetworkGatewayActiveState(id int, meta interface{}) (interface{}, error) {
+func waitForNetworkGatewayActiveState(context context.Context, id int, meta interface{}) (interface{}, error) {
 	log.Printf("Waiting for Gateway (%d) to be active", id)
 	service := services.GetNetworkGatewayService(meta.(ClientSession).SoftLayerSession())
 
@@ -248,5 +258,5 @@ func waitForNetworkGatewayActiveState(id int, meta interface{}) (interface{}, er
 		NotFoundChecks: 24 * 60,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/services"
@@ -19,10 +21,9 @@ import (
 
 func resourceIBMNetworkInterfaceSGAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMNetworkInterfaceSGAttachmentCreate,
-		Read:   resourceIBMNetworkInterfaceSGAttachmentRead,
-		Delete: resourceIBMNetworkInterfaceSGAttachmentDelete,
-		Exists: resourceIBMNetworkInterfaceSGAttachmentExists,
+		CreateContext: resourceIBMNetworkInterfaceSGAttachmentCreate,
+		ReadContext:   resourceIBMNetworkInterfaceSGAttachmentRead,
+		DeleteContext: resourceIBMNetworkInterfaceSGAttachmentDelete,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(15 * time.Minute),
 		},
@@ -50,7 +51,7 @@ func resourceIBMNetworkInterfaceSGAttachment() *schema.Resource {
 	}
 }
 
-func resourceIBMNetworkInterfaceSGAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkInterfaceSGAttachmentCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	mk := "network_interface_sg_attachment_" + strconv.Itoa(d.Get("network_interface_id").(int))
 	ibmMutexKV.Lock(mk)
 	defer ibmMutexKV.Unlock(mk)
@@ -61,14 +62,14 @@ func resourceIBMNetworkInterfaceSGAttachmentCreate(d *schema.ResourceData, meta
 
 	sgID := d.Get("security_group_id").(int)
 	interfaceID := d.Get("network_interface_id").(int)
-	_, err := WaitForVSAvailable(d, meta, d.Timeout(schema.TimeoutCreate))
+	_, err := WaitForVSAvailable(context, d, meta, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_, err = service.Id(sgID).AttachNetworkComponents([]int{interfaceID})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%d_%d", sgID, interfaceID))
 
@@ -77,22 +78,22 @@ func resourceIBMNetworkInterfaceSGAttachmentCreate(d *schema.ResourceData, meta
 		//Check if a soft reboot is required and perform it
 		ready, err := ncs.Id(interfaceID).SecurityGroupsReady()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if !ready {
 			log.Println("Soft reboot the VSI whose network component is", interfaceID)
 		}
 		guest, err := ncs.Id(interfaceID).GetGuest()
 		if err != nil {
-			return fmt.Errorf("Couldn't retrieve the virtual guest on interface %d", interfaceID)
+			return diag.FromErr(fmt.Errorf("Couldn't retrieve the virtual guest on interface %d", interfaceID))
 		}
 		guestService := services.GetVirtualGuestService(sess)
 		ok, err := guestService.Id(*guest.Id).RebootSoft()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if !ok {
-			return fmt.Errorf("Couldn't reboot the VSI %d", *guest.Id)
+			return diag.FromErr(fmt.Errorf("Couldn't reboot the VSI %d", *guest.Id))
 		}
 		//Wait for security group to be ready again after reboot
 		stateConf := &resource.StateChangeConf{
@@ -101,35 +102,44 @@ func resourceIBMNetworkInterfaceSGAttachmentCreate(d *schema.ResourceData, meta
 			Timeout: d.Timeout(schema.TimeoutCreate),
 			Refresh: securityGroupReadyRefreshStateFunc(sess, interfaceID),
 		}
-		_, err = stateConf.WaitForState()
+		_, err = stateConf.WaitForStateContext(context)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceIBMNetworkInterfaceSGAttachmentRead(d, meta)
+	return resourceIBMNetworkInterfaceSGAttachmentRead(context, d, meta)
 }
 
-func resourceIBMNetworkInterfaceSGAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkInterfaceSGAttachmentRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMNetworkInterfaceSGAttachmentExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
 	sgID, interfaceID, err := decomposeNetworkSGAttachmentID(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	bindings, err := service.Id(sgID).GetNetworkComponentBindings()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	for _, b := range bindings {
 		if *b.NetworkComponentId == interfaceID {
 			return nil
 		}
 	}
-	return fmt.Errorf("No association found between security group %d and network interface %d", sgID, interfaceID)
+	return diag.FromErr(fmt.Errorf("No association found between security group %d and network interface %d", sgID, interfaceID))
 }
 
-func resourceIBMNetworkInterfaceSGAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkInterfaceSGAttachmentDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	mk := "network_interface_sg_attachment_" + strconv.Itoa(d.Get("network_interface_id").(int))
 	ibmMutexKV.Lock(mk)
 	defer ibmMutexKV.Unlock(mk)
@@ -137,11 +147,11 @@ func resourceIBMNetworkInterfaceSGAttachmentDelete(d *schema.ResourceData, meta
 	service := services.GetNetworkSecurityGroupService(sess)
 	sgID, interfaceID, err := decomposeNetworkSGAttachmentID(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_, err = service.Id(sgID).DetachNetworkComponents([]int{interfaceID})
 	if err != nil {
-		return fmt.Errorf("Error detaching network components from Security Group: %s", err)
+		return diag.FromErr(fmt.Errorf("Error detaching network components from Security Group: %s", err))
 	}
 	d.SetId("")
 	return nil
@@ -203,7 +213,7 @@ func securityGroupReadyRefreshStateFunc(sess *slsession.Session, ifcID int) reso
 }
 
 // WaitForVirtualGuestAvailable Waits for virtual guest creation
-func WaitForVSAvailable(d *schema.ResourceData, meta interface{}, timeout time.Duration) (interface{}, error) {
+func WaitForVSAvailable(context context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) (interface{}, error) {
 	interfaceID := d.Get("network_interface_id").(int)
 	log.Printf("Waiting for server (%d) to be available.", interfaceID)
 	sess := meta.(ClientSession).SoftLayerSession()
@@ -216,7 +226,7 @@ func WaitForVSAvailable(d *schema.ResourceData, meta interface{}, timeout time.D
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func vsReadyRefreshStateFunc(sess *slsession.Session, ifcID int) resource.StateRefreshFunc {
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
@@ -11,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -29,12 +31,11 @@ const (
 
 func resourceIBMNetworkPublicIp() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMNetworkPublicIpCreate,
-		Read:     resourceIBMNetworkPublicIpRead,
-		Update:   resourceIBMNetworkPublicIpUpdate,
-		Delete:   resourceIBMNetworkPublicIpDelete,
-		Exists:   resourceIBMNetworkPublicIpExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMNetworkPublicIpCreate,
+		ReadContext:   resourceIBMNetworkPublicIpRead,
+		UpdateContext: resourceIBMNetworkPublicIpUpdate,
+		DeleteContext: resourceIBMNetworkPublicIpDelete,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 		},
@@ -80,7 +81,7 @@ func resourceIBMNetworkPublicIp() *schema.Resource {
 	}
 }
 
-func resourceIBMNetworkPublicIpCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkPublicIpCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess := meta.(ClientSession).SoftLayerSession()
 
@@ -90,7 +91,7 @@ func resourceIBMNetworkPublicIpCreate(d *schema.ResourceData, meta interface{})
 		// Find price items with AdditionalServices
 		productOrderContainer, err = buildGlobalIpProductOrderContainer(d, sess, AdditionalServicesPackageType)
 		if err != nil {
-			return fmt.Errorf("Error creating network public ip: %s", err)
+			return diag.FromErr(fmt.Errorf("Error creating network public ip: %s", err))
 		}
 	}
 
@@ -99,32 +100,41 @@ func resourceIBMNetworkPublicIpCreate(d *schema.ResourceData, meta interface{})
 	receipt, err := services.GetProductOrderService(sess.SetRetries(0)).
 		PlaceOrder(productOrderContainer, sl.Bool(false))
 	if err != nil {
-		return fmt.Errorf("Error during creation of network public ip: %s", err)
+		return diag.FromErr(fmt.Errorf("Error during creation of network public ip: %s", err))
 	}
 
-	globalIp, err := findGlobalIpByOrderId(sess, *receipt.OrderId, d)
+	globalIp, err := findGlobalIpByOrderId(context, sess, *receipt.OrderId, d)
 	if err != nil {
-		return fmt.Errorf("Error during creation of network public ip: %s", err)
+		return diag.FromErr(fmt.Errorf("Error during creation of network public ip: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%d", *globalIp.Id))
 	d.Set("ip_address", *globalIp.IpAddress.IpAddress)
 
-	return resourceIBMNetworkPublicIpUpdate(d, meta)
+	return resourceIBMNetworkPublicIpUpdate(context, d, meta)
 }
 
-func resourceIBMNetworkPublicIpRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkPublicIpRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMNetworkPublicIpExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSubnetIpAddressGlobalService(sess)
 
 	globalIpId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid network public ip ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("Not a valid network public ip ID, must be an integer: %s", err))
 	}
 
 	globalIp, err := service.Id(globalIpId).Mask(GlobalIpMask).GetObject()
 	if err != nil {
-		return fmt.Errorf("Error retrieving network public Ip: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving network public Ip: %s", err))
 	}
 
 	d.Set("ip_address", *globalIp.IpAddress.IpAddress)
@@ -137,13 +147,13 @@ func resourceIBMNetworkPublicIpRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func resourceIBMNetworkPublicIpUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkPublicIpUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSubnetIpAddressGlobalService(sess)
 
 	globalIpId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid network public ip ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("Not a valid network public ip ID, must be an integer: %s", err))
 	}
 
 	routes_to := d.Get("routes_to").(string)
@@ -164,17 +174,17 @@ func resourceIBMNetworkPublicIpUpdate(d *schema.ResourceData, meta interface{})
 
 	_, err = service.Id(globalIpId).Route(sl.String(routes_to))
 	if err != nil {
-		return fmt.Errorf("Error editing network public Ip: %s", err)
+		return diag.FromErr(fmt.Errorf("Error editing network public Ip: %s", err))
 	}
 	// Update notes
 	if d.HasChange("notes") {
 		publicIp, err := service.Id(globalIpId).Mask(GlobalIpMask).GetObject()
 		if err != nil {
-			return fmt.Errorf("Error updating network public Ip: %s", err)
+			return diag.FromErr(fmt.Errorf("Error updating network public Ip: %s", err))
 		}
 		err = updatePublicIPNotes(d, sess, publicIp)
 		if err != nil {
-			return fmt.Errorf("Error editing network public Ip: %s", err)
+			return diag.FromErr(fmt.Errorf("Error editing network public Ip: %s", err))
 		}
 	}
 
@@ -196,10 +206,10 @@ func resourceIBMNetworkPublicIpUpdate(d *schema.ResourceData, meta interface{})
 		MinTimeout: 3 * time.Second,
 	}
 
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := stateConf.WaitForStateContext(context)
 
 	if err != nil {
-		return fmt.Errorf("Error waiting for network public ip destination ip address to become active: %s", err)
+		return diag.FromErr(fmt.Errorf("Error waiting for network public ip destination ip address to become active: %s", err))
 	}
 
 	if _, ok := pendingResult.(datatypes.Network_Subnet_IpAddress_Global); ok {
@@ -209,18 +219,18 @@ func resourceIBMNetworkPublicIpUpdate(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceIBMNetworkPublicIpDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkPublicIpDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSubnetIpAddressGlobalService(sess)
 
 	globalIpId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid network public ip ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("Not a valid network public ip ID, must be an integer: %s", err))
 	}
 
 	billingItem, err := service.Id(globalIpId).GetBillingItem()
 	if err != nil {
-		return fmt.Errorf("Error deleting network public ip: %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting network public ip: %s", err))
 	}
 
 	if billingItem.Id == nil {
@@ -229,7 +239,7 @@ func resourceIBMNetworkPublicIpDelete(d *schema.ResourceData, meta interface{})
 
 	_, err = services.GetBillingItemService(sess).Id(*billingItem.Id).CancelService()
 
-	return err
+	return diag.FromErr(err)
 }
 
 func resourceIBMNetworkPublicIpExists(d *schema.ResourceData, meta interface{}) (bool, error) {
@@ -251,7 +261,7 @@ func resourceIBMNetworkPublicIpExists(d *schema.ResourceData, meta interface{})
 	return result.Id != nil && *result.Id == globalIpId, nil
 }
 
-func findGlobalIpByOrderId(sess *session.Session, orderId int, d *schema.ResourceData) (datatypes.Network_Subnet_IpAddress_Global, error) {
+func findGlobalIpByOrderId(context context.Context, sess *session.Session, orderId int, d *schema.ResourceData) (datatypes.Network_Subnet_IpAddress_Global, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"pending"},
 		Target:  []string{"complete"},
@@ -279,7 +289,7 @@ func findGlobalIpByOrderId(sess *session.Session, orderId int, d *schema.Resourc
 		NotFoundChecks: 24 * 60,
 	}
 
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := stateConf.WaitForStateContext(context)
 
 	if err != nil {
 		return datatypes.Network_Subnet_IpAddress_Global{}, err
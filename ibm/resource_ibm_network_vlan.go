@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -12,6 +13,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -34,12 +36,11 @@ const (
 
 func resourceIBMNetworkVlan() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMNetworkVlanCreate,
-		Read:     resourceIBMNetworkVlanRead,
-		Update:   resourceIBMNetworkVlanUpdate,
-		Delete:   resourceIBMNetworkVlanDelete,
-		Exists:   resourceIBMNetworkVlanExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMNetworkVlanCreate,
+		ReadContext:   resourceIBMNetworkVlanRead,
+		UpdateContext: resourceIBMNetworkVlanUpdate,
+		DeleteContext: resourceIBMNetworkVlanDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -148,7 +149,7 @@ func resourceIBMNetworkVlan() *schema.Resource {
 	}
 }
 
-func resourceIBMNetworkVlanCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkVlanCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess := meta.(ClientSession).SoftLayerSession()
 	router := d.Get("router_hostname").(string)
@@ -157,7 +158,7 @@ func resourceIBMNetworkVlanCreate(d *schema.ResourceData, meta interface{}) erro
 	vlanType := d.Get("type").(string)
 	if (vlanType == "PRIVATE" && len(router) > 0 && strings.Contains(router, "fcr")) ||
 		(vlanType == "PUBLIC" && len(router) > 0 && strings.Contains(router, "bcr")) {
-		return fmt.Errorf("Error creating vlan: mismatch between vlan_type '%s' and router_hostname '%s'", vlanType, router)
+		return diag.FromErr(fmt.Errorf("Error creating vlan: mismatch between vlan_type '%s' and router_hostname '%s'", vlanType, router))
 	}
 
 	// Find price items with AdditionalServicesNetworkVlan
@@ -166,7 +167,7 @@ func resourceIBMNetworkVlanCreate(d *schema.ResourceData, meta interface{}) erro
 		// Find price items with AdditionalServices
 		productOrderContainer, err = buildVlanProductOrderContainer(d, sess, AdditionalServicesPackageType)
 		if err != nil {
-			return fmt.Errorf("Error creating vlan: %s", err)
+			return diag.FromErr(fmt.Errorf("Error creating vlan: %s", err))
 		}
 	}
 
@@ -175,19 +176,19 @@ func resourceIBMNetworkVlanCreate(d *schema.ResourceData, meta interface{}) erro
 	receipt, err := services.GetProductOrderService(sess.SetRetries(0)).
 		PlaceOrder(productOrderContainer, sl.Bool(false))
 	if err != nil {
-		return fmt.Errorf("Error during creation of vlan: %s", err)
+		return diag.FromErr(fmt.Errorf("Error during creation of vlan: %s", err))
 	}
 
-	vlan, err := findVlanByOrderId(sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
+	vlan, err := findVlanByOrderId(context, sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return fmt.Errorf("Error finding VLAN order %d: %s", *receipt.OrderId, err)
+		return diag.FromErr(fmt.Errorf("Error finding VLAN order %d: %s", *receipt.OrderId, err))
 	}
 
 	if len(name) > 0 {
 		_, err = services.GetNetworkVlanService(sess).
 			Id(*vlan.Id).EditObject(&datatypes.Network_Vlan{Name: sl.String(name)})
 		if err != nil {
-			return fmt.Errorf("Error updating vlan: %s", err)
+			return diag.FromErr(fmt.Errorf("Error updating vlan: %s", err))
 		}
 	}
 
@@ -200,25 +201,34 @@ func resourceIBMNetworkVlanCreate(d *schema.ResourceData, meta interface{}) erro
 		//Try setting only when it is non empty as we are creating vlan
 		err = setVlanTags(id, tags, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceIBMNetworkVlanRead(d, meta)
+	return resourceIBMNetworkVlanRead(context, d, meta)
 }
 
-func resourceIBMNetworkVlanRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkVlanRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMNetworkVlanExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkVlanService(sess)
 
 	vlanId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid vlan ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("Not a valid vlan ID, must be an integer: %s", err))
 	}
 
 	vlan, err := service.Id(vlanId).Mask(VlanMask).GetObject()
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving vlan: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving vlan: %s", err))
 	}
 
 	d.Set("vlan_number", *vlan.VlanNumber)
@@ -284,13 +294,13 @@ func resourceIBMNetworkVlanRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMNetworkVlanUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkVlanUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkVlanService(sess)
 
 	vlanId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid vlan ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("Not a valid vlan ID, must be an integer: %s", err))
 	}
 
 	opts := datatypes.Network_Vlan{}
@@ -307,7 +317,7 @@ func resourceIBMNetworkVlanUpdate(d *schema.ResourceData, meta interface{}) erro
 		tags := getTags(d)
 		err := setVlanTags(vlanId, tags, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -315,20 +325,20 @@ func resourceIBMNetworkVlanUpdate(d *schema.ResourceData, meta interface{}) erro
 		_, err = service.Id(vlanId).EditObject(&opts)
 
 		if err != nil {
-			return fmt.Errorf("Error updating vlan: %s", err)
+			return diag.FromErr(fmt.Errorf("Error updating vlan: %s", err))
 		}
 	}
 
-	return resourceIBMNetworkVlanRead(d, meta)
+	return resourceIBMNetworkVlanRead(context, d, meta)
 }
 
-func resourceIBMNetworkVlanDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkVlanDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkVlanService(sess)
 
 	vlanId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid vlan ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("Not a valid vlan ID, must be an integer: %s", err))
 	}
 
 	const (
@@ -355,14 +365,14 @@ func resourceIBMNetworkVlanDelete(d *schema.ResourceData, meta interface{}) erro
 			return vms, noVms, nil
 		},
 	}
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(context)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	billingItem, err := service.Id(vlanId).GetBillingItem()
 	if err != nil {
-		return fmt.Errorf("Error deleting vlan: %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting vlan: %s", err))
 	}
 
 	// VLANs which don't have billing items are managed by SoftLayer. They can't be deleted by
@@ -378,7 +388,7 @@ func resourceIBMNetworkVlanDelete(d *schema.ResourceData, meta interface{}) erro
 	// automatically which the VLAN doesn't have any child resources.
 	_, err = services.GetBillingItemService(sess).Id(*billingItem.Id).CancelService()
 
-	return err
+	return diag.FromErr(err)
 }
 
 func resourceIBMNetworkVlanExists(d *schema.ResourceData, meta interface{}) (bool, error) {
@@ -402,7 +412,7 @@ func resourceIBMNetworkVlanExists(d *schema.ResourceData, meta interface{}) (boo
 	return result.Id != nil && *result.Id == vlanID, nil
 }
 
-func findVlanByOrderId(sess *session.Session, orderId int, timeout time.Duration) (datatypes.Network_Vlan, error) {
+func findVlanByOrderId(context context.Context, sess *session.Session, orderId int, timeout time.Duration) (datatypes.Network_Vlan, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"pending"},
 		Target:  []string{"complete"},
@@ -430,7 +440,7 @@ func findVlanByOrderId(sess *session.Session, orderId int, timeout time.Duration
 		NotFoundChecks: 300,
 	}
 
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := stateConf.WaitForStateContext(context)
 
 	if err != nil {
 		return datatypes.Network_Vlan{}, err
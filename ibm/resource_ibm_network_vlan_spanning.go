@@ -4,21 +4,23 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/services"
 )
 
 func resourceIBMNetworkVlanSpan() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMNetworkVlanSpanCreate,
-		Read:     resourceIBMNetworkVlanSpanRead,
-		Update:   resourceIBMNetworkVlanSpanUpdate,
-		Delete:   resourceIBMNetworkVlanSpanDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMNetworkVlanSpanCreate,
+		ReadContext:   resourceIBMNetworkVlanSpanRead,
+		UpdateContext: resourceIBMNetworkVlanSpanUpdate,
+		DeleteContext: resourceIBMNetworkVlanSpanDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"vlan_spanning": {
@@ -31,14 +33,14 @@ func resourceIBMNetworkVlanSpan() *schema.Resource {
 	}
 }
 
-func resourceIBMNetworkVlanSpanRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkVlanSpanRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetAccountService(sess)
 
 	vlanSpan, err := service.GetNetworkVlanSpan()
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving vlan: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving vlan: %s", err))
 	}
 
 	if *vlanSpan.EnabledFlag == true {
@@ -50,7 +52,7 @@ func resourceIBMNetworkVlanSpanRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func resourceIBMNetworkVlanSpanCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkVlanSpanCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetAccountService(sess)
 
@@ -66,14 +68,14 @@ func resourceIBMNetworkVlanSpanCreate(d *schema.ResourceData, meta interface{})
 
 	_, err := service.SetVlanSpan(&enabled)
 	if err != nil {
-		return fmt.Errorf("Error settinging VLAN Spanning %s", err)
+		return diag.FromErr(fmt.Errorf("Error settinging VLAN Spanning %s", err))
 	}
 
 	d.SetId(strconv.Itoa(rnd))
-	return resourceIBMNetworkVlanSpanRead(d, meta)
+	return resourceIBMNetworkVlanSpanRead(context, d, meta)
 }
 
-func resourceIBMNetworkVlanSpanUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkVlanSpanUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetAccountService(sess)
 	vlanSpanning := d.Get("vlan_spanning").(string)
@@ -87,13 +89,13 @@ func resourceIBMNetworkVlanSpanUpdate(d *schema.ResourceData, meta interface{})
 
 	_, err := service.SetVlanSpan(&enabled)
 	if err != nil {
-		return fmt.Errorf("Error settinging VLAN Spanning %s", err)
+		return diag.FromErr(fmt.Errorf("Error settinging VLAN Spanning %s", err))
 	}
 
-	return resourceIBMNetworkVlanSpanRead(d, meta)
+	return resourceIBMNetworkVlanSpanRead(context, d, meta)
 }
 
-func resourceIBMNetworkVlanSpanDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkVlanSpanDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Leave VLAN Spanning setting in current state
 	d.SetId("")
 
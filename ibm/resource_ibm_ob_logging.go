@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -11,6 +12,7 @@ import (
 
 	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -31,11 +33,11 @@ const (
 
 func resourceIBMObLogging() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMLoggingCreate,
-		Read:     resourceIBMLoggingRead,
-		Update:   resourceIBMLoggingUpdate,
-		Delete:   resourceIBMLoggingDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMLoggingCreate,
+		ReadContext:   resourceIBMLoggingRead,
+		UpdateContext: resourceIBMLoggingUpdate,
+		DeleteContext: resourceIBMLoggingDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -116,10 +118,10 @@ func resourceIBMObLogging() *schema.Resource {
 	}
 }
 
-func resourceIBMLoggingCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLoggingCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var ingestionkey string
@@ -149,7 +151,7 @@ func resourceIBMLoggingCreate(d *schema.ResourceData, meta interface{}) error {
 
 	targetEnv, err := getLoggingTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var logging v2.LoggingCreateResponse
@@ -171,12 +173,12 @@ func resourceIBMLoggingCreate(d *schema.ResourceData, meta interface{}) error {
 		logging, err = client.Logging().CreateLoggingConfig(params, targetEnv)
 	}
 	if err != nil {
-		return fmt.Errorf("error latching logging instance to cluster: %w", err)
+		return diag.FromErr(fmt.Errorf("error latching logging instance to cluster: %w", err))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", clusterName, logging.InstanceID))
 
-	return resourceIBMLoggingRead(d, meta)
+	return resourceIBMLoggingRead(context, d, meta)
 }
 
 func getLoggingTargetHeader(d *schema.ResourceData, meta interface{}) (v2.LoggingTargetHeader, error) {
@@ -198,23 +200,23 @@ func getLoggingTargetHeader(d *schema.ResourceData, meta interface{}) (v2.Loggin
 	return targetEnv, nil
 }
 
-func resourceIBMLoggingRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLoggingRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	clusterName := parts[0]
 	loggingID := parts[1]
 
 	targetEnv, err := getLoggingTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	config, err := client.Logging().GetLoggingConfig(clusterName, loggingID, targetEnv)
@@ -225,7 +227,7 @@ func resourceIBMLoggingRead(d *schema.ResourceData, meta interface{}) error {
 				return nil
 			}
 		}
-		return fmt.Errorf("Error in GetLoggingConfig: %s", err)
+		return diag.FromErr(fmt.Errorf("Error in GetLoggingConfig: %s", err))
 	}
 
 	d.Set(obLoggingPrivateEndpoint, config.PrivateEndpoint)
@@ -241,26 +243,26 @@ func resourceIBMLoggingRead(d *schema.ResourceData, meta interface{}) error {
 
 }
 
-func resourceIBMLoggingUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLoggingUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	hasChanged := false
 	idChanged := false
 
 	client, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	targetEnv, err := getLoggingTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	loggingUpdateModel := v2.LoggingUpdateRequest{}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cluster := parts[0]
 	loggingID := parts[1]
@@ -294,29 +296,29 @@ func resourceIBMLoggingUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		_, err := client.Logging().UpdateLoggingConfig(loggingUpdateModel, targetEnv)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		} else if idChanged {
 			d.SetId(fmt.Sprintf("%s/%s", clusterName, loggingUpdateModel.NewInstance))
 		}
 	}
 
-	return resourceIBMLoggingRead(d, meta)
+	return resourceIBMLoggingRead(context, d, meta)
 }
 
-func resourceIBMLoggingDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLoggingDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	targetEnv, err := getLoggingTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	clusterName := parts[0]
 	loggingID := parts[1]
@@ -335,7 +337,7 @@ func resourceIBMLoggingDelete(d *schema.ResourceData, meta interface{}) error {
 				return nil
 			}
 		}
-		return fmt.Errorf("Error in DeleteLoggingConfig: %s", err)
+		return diag.FromErr(fmt.Errorf("Error in DeleteLoggingConfig: %s", err))
 	}
 	d.SetId("")
 	return nil
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -11,6 +12,7 @@ import (
 
 	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -31,11 +33,11 @@ const (
 
 func resourceIBMObMonitoring() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMMonitoringCreate,
-		Read:     resourceIBMMonitoringRead,
-		Update:   resourceIBMMonitoringUpdate,
-		Delete:   resourceIBMMonitoringDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMMonitoringCreate,
+		ReadContext:   resourceIBMMonitoringRead,
+		UpdateContext: resourceIBMMonitoringUpdate,
+		DeleteContext: resourceIBMMonitoringDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -116,11 +118,11 @@ func resourceIBMObMonitoring() *schema.Resource {
 	}
 }
 
-func resourceIBMMonitoringCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMMonitoringCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var ingestionkey string
@@ -150,7 +152,7 @@ func resourceIBMMonitoringCreate(d *schema.ResourceData, meta interface{}) error
 
 	targetEnv, err := getMonitoringTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var monitoring v2.MonitoringCreateResponse
@@ -172,12 +174,12 @@ func resourceIBMMonitoringCreate(d *schema.ResourceData, meta interface{}) error
 		monitoring, err = client.Monitoring().CreateMonitoringConfig(params, targetEnv)
 	}
 	if err != nil {
-		return fmt.Errorf("error latching monitoring instance to cluster: %w", err)
+		return diag.FromErr(fmt.Errorf("error latching monitoring instance to cluster: %w", err))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", clusterName, monitoring.InstanceID))
 
-	return resourceIBMMonitoringRead(d, meta)
+	return resourceIBMMonitoringRead(context, d, meta)
 }
 
 func getMonitoringTargetHeader(d *schema.ResourceData, meta interface{}) (v2.MonitoringTargetHeader, error) {
@@ -199,23 +201,23 @@ func getMonitoringTargetHeader(d *schema.ResourceData, meta interface{}) (v2.Mon
 	return targetEnv, nil
 }
 
-func resourceIBMMonitoringRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMMonitoringRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	clusterName := parts[0]
 	monitoringID := parts[1]
 
 	targetEnv, err := getMonitoringTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	config, err := client.Monitoring().GetMonitoringConfig(clusterName, monitoringID, targetEnv)
@@ -226,7 +228,7 @@ func resourceIBMMonitoringRead(d *schema.ResourceData, meta interface{}) error {
 				return nil
 			}
 		}
-		return fmt.Errorf("Error in GetMonitoringConfig: %s", err)
+		return diag.FromErr(fmt.Errorf("Error in GetMonitoringConfig: %s", err))
 	}
 
 	d.Set(obMonitoringPrivateEndpoint, config.PrivateEndpoint)
@@ -242,26 +244,26 @@ func resourceIBMMonitoringRead(d *schema.ResourceData, meta interface{}) error {
 
 }
 
-func resourceIBMMonitoringUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMMonitoringUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	hasChanged := false
 	idChanged := false
 
 	client, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	targetEnv, err := getMonitoringTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	monitoringUpdateModel := v2.MonitoringUpdateRequest{}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cluster := parts[0]
 	monitoringID := parts[1]
@@ -295,30 +297,30 @@ func resourceIBMMonitoringUpdate(d *schema.ResourceData, meta interface{}) error
 	if hasChanged {
 		_, err := client.Monitoring().UpdateMonitoringConfig(monitoringUpdateModel, targetEnv)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		} else if idChanged {
 			d.SetId(fmt.Sprintf("%s/%s", clusterName, monitoringUpdateModel.NewInstance))
 		}
 	}
 
-	return resourceIBMMonitoringRead(d, meta)
+	return resourceIBMMonitoringRead(context, d, meta)
 }
 
-func resourceIBMMonitoringDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMMonitoringDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	targetEnv, err := getMonitoringTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	clusterName := parts[0]
 	monitoringID := parts[1]
@@ -338,7 +340,7 @@ func resourceIBMMonitoringDelete(d *schema.ResourceData, meta interface{}) error
 				return nil
 			}
 		}
-		return fmt.Errorf("Error in DeleteMonitoringConfig: %s", err)
+		return diag.FromErr(fmt.Errorf("Error in DeleteMonitoringConfig: %s", err))
 	}
 	d.SetId("")
 	return nil
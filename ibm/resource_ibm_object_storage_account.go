@@ -4,12 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"strings"
 
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -21,12 +22,11 @@ import (
 
 func resourceIBMObjectStorageAccount() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMObjectStorageAccountCreate,
-		Read:     resourceIBMObjectStorageAccountRead,
-		Update:   resourceIBMObjectStorageAccountUpdate,
-		Delete:   resourceIBMObjectStorageAccountDelete,
-		Exists:   resourceIBMObjectStorageAccountExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMObjectStorageAccountCreate,
+		ReadContext:   resourceIBMObjectStorageAccountRead,
+		UpdateContext: resourceIBMObjectStorageAccountUpdate,
+		DeleteContext: resourceIBMObjectStorageAccountDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -47,14 +47,14 @@ func resourceIBMObjectStorageAccount() *schema.Resource {
 	}
 }
 
-func resourceIBMObjectStorageAccountCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMObjectStorageAccountCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	accountService := services.GetAccountService(sess)
 
 	// Check if an object storage account exists
 	objectStorageAccounts, err := accountService.GetHubNetworkStorage()
 	if err != nil {
-		return fmt.Errorf("resource_ibm_object_storage_account: Error on create: %s", err)
+		return diag.FromErr(fmt.Errorf("resource_ibm_object_storage_account: Error on create: %s", err))
 	}
 
 	if len(objectStorageAccounts) == 0 {
@@ -69,15 +69,15 @@ func resourceIBMObjectStorageAccountCreate(d *schema.ResourceData, meta interfac
 			},
 		}, sl.Bool(false))
 		if err != nil {
-			return fmt.Errorf(
-				"resource_ibm_object_storage_account: Error ordering account: %s", err)
+			return diag.FromErr(fmt.Errorf(
+				"resource_ibm_object_storage_account: Error ordering account: %s", err))
 		}
 
 		// Wait for the object storage account order to complete.
-		billingOrderItem, err := WaitForOrderCompletion(&receipt, meta)
+		billingOrderItem, err := WaitForOrderCompletion(context, &receipt, meta)
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for object storage account order (%d) to complete: %s", receipt.OrderId, err)
+			return diag.FromErr(fmt.Errorf(
+				"Error waiting for object storage account order (%d) to complete: %s", receipt.OrderId, err))
 		}
 
 		// Get accountName using filter on hub network storage
@@ -85,11 +85,11 @@ func resourceIBMObjectStorageAccountCreate(d *schema.ResourceData, meta interfac
 			filter.Path("billingItem.id").Eq(billingOrderItem.BillingItem.Id).Build(),
 		).GetNetworkStorage()
 		if err != nil {
-			return fmt.Errorf("resource_ibm_object_storage_account: Error on retrieving new: %s", err)
+			return diag.FromErr(fmt.Errorf("resource_ibm_object_storage_account: Error on retrieving new: %s", err))
 		}
 
 		if len(objectStorageAccounts) == 0 {
-			return fmt.Errorf("resource_ibm_object_storage_account: Failed to create object storage account.")
+			return diag.FromErr(fmt.Errorf("resource_ibm_object_storage_account: Failed to create object storage account."))
 		}
 	}
 
@@ -100,7 +100,7 @@ func resourceIBMObjectStorageAccountCreate(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func WaitForOrderCompletion(
+func WaitForOrderCompletion(context context.Context,
 	receipt *datatypes.Container_Product_Order_Receipt, meta interface{}) (datatypes.Billing_Order_Item, error) {
 
 	log.Printf("Waiting for billing order %d to have zero active transactions", receipt.OrderId)
@@ -129,11 +129,11 @@ func WaitForOrderCompletion(
 		MinTimeout: 10 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(context)
 	return *billingOrderItem, err
 }
 
-func resourceIBMObjectStorageAccountRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMObjectStorageAccountRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	accountService := services.GetAccountService(sess)
 	accountName := d.Id()
@@ -144,7 +144,7 @@ func resourceIBMObjectStorageAccountRead(d *schema.ResourceData, meta interface{
 		filter.Path("username").Eq(accountName).Build(),
 	).GetHubNetworkStorage()
 	if err != nil {
-		return fmt.Errorf("resource_ibm_object_storage_account: Error on Read: %s", err)
+		return diag.FromErr(fmt.Errorf("resource_ibm_object_storage_account: Error on Read: %s", err))
 	}
 
 	for _, objectStorageAccount := range objectStorageAccounts {
@@ -153,26 +153,17 @@ func resourceIBMObjectStorageAccountRead(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	return fmt.Errorf("resource_ibm_object_storage_account: Could not find account %s", accountName)
+	log.Printf("[WARN] resource_ibm_object_storage_account: Could not find account %s, removing it from state", accountName)
+	d.SetId("")
+	return nil
 }
 
-func resourceIBMObjectStorageAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMObjectStorageAccountUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Nothing to update for now. Not supported.
 	return nil
 }
 
-func resourceIBMObjectStorageAccountDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMObjectStorageAccountDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Delete is not supported for now.
 	return nil
 }
-
-func resourceIBMObjectStorageAccountExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	err := resourceIBMObjectStorageAccountRead(d, meta)
-	if err != nil {
-		if strings.Contains(err.Error(), "Could not find account") {
-			return false, nil
-		}
-		return false, err
-	}
-	return true, nil
-}
@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/bluemix-go/api/mccp/mccpv2"
@@ -24,12 +26,11 @@ var (
 
 func resourceIBMOrg() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMOrgCreate,
-		Read:     resourceIBMOrgRead,
-		Delete:   resourceIBMOrgDelete,
-		Update:   resourceIBMOrgUpdate,
-		Exists:   resourceIBMOrgExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMOrgCreate,
+		ReadContext:   resourceIBMOrgRead,
+		DeleteContext: resourceIBMOrgDelete,
+		UpdateContext: resourceIBMOrgUpdate,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -77,10 +78,10 @@ func resourceIBMOrg() *schema.Resource {
 		},
 	}
 }
-func resourceIBMOrgCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMOrgCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfAPI, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	orgAPI := cfAPI.Organizations()
 	orgName := d.Get("name").(string)
@@ -92,47 +93,56 @@ func resourceIBMOrgCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 	orgFields, err := orgAPI.Create(req)
 	if err != nil {
-		return fmt.Errorf("Error creating organisation: %s", err)
+		return diag.FromErr(fmt.Errorf("Error creating organisation: %s", err))
 	}
 	orgGUID := orgFields.Metadata.GUID
 	d.SetId(orgGUID)
 
-	return resourceIBMOrgUpdate(d, meta)
+	return resourceIBMOrgUpdate(context, d, meta)
 }
 
-func resourceIBMOrgRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMOrgRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMOrgExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	cfAPI, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	orgAPI := cfAPI.Organizations()
 	id := d.Id()
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	orgOwnerID := userDetails.userEmail
 	orgFields, err := orgAPI.Get(id)
 	if err != nil {
-		return fmt.Errorf("Error retrieving organisation: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving organisation: %s", err))
 	}
 	d.Set("name", orgFields.Entity.Name)
 	billingManager, err := orgAPI.ListBillingManager(id)
 	if err != nil {
-		return fmt.Errorf("Error retrieving billing manager in the org: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving billing manager in the org: %s", err))
 	}
 	managers, err := orgAPI.ListManager(id)
 	if err != nil {
-		return fmt.Errorf("Error retrieving managers in the org: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving managers in the org: %s", err))
 	}
 	auditors, err := orgAPI.ListAuditors(id)
 	if err != nil {
-		return fmt.Errorf("Error retrieving auditors in space: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving auditors in space: %s", err))
 	}
 	users, err := orgAPI.ListUsers(id)
 	if err != nil {
-		return fmt.Errorf("Error retrieving users in space: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving users in space: %s", err))
 	}
 	if len(auditors) > 0 {
 		d.Set("auditors", flattenOrgRole(auditors, ""))
@@ -152,10 +162,10 @@ func resourceIBMOrgRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMOrgUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMOrgUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfAPI, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	orgAPI := cfAPI.Organizations()
 	id := d.Id()
@@ -166,38 +176,38 @@ func resourceIBMOrgUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 	_, err = orgAPI.Update(id, req)
 	if err != nil {
-		return fmt.Errorf("Error updating organisation: %s", err)
+		return diag.FromErr(fmt.Errorf("Error updating organisation: %s", err))
 	}
 	err = updateOrgBillingManagers(orgAPI, id, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = updateOrgManagers(meta, id, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = updateOrgAuditors(orgAPI, id, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = updateOrgUsers(meta, id, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMOrgRead(d, meta)
+	return resourceIBMOrgRead(context, d, meta)
 }
 
-func resourceIBMOrgDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMOrgDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfAPI, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	orgAPI := cfAPI.Organizations()
 	id := d.Id()
 	err = orgAPI.Delete(id, false)
 	if err != nil {
-		return fmt.Errorf("Error deleting organisation: %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting organisation: %s", err))
 	}
 	d.SetId("")
 	return nil
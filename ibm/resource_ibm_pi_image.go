@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -18,12 +20,11 @@ import (
 
 func resourceIBMPIImage() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMPIImageCreate,
-		Read:     resourceIBMPIImageRead,
-		Update:   resourceIBMPIImageUpdate,
-		Delete:   resourceIBMPIImageDelete,
-		Exists:   resourceIBMPIImageExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMPIImageCreate,
+		ReadContext:   resourceIBMPIImageRead,
+		UpdateContext: resourceIBMPIImageUpdate,
+		DeleteContext: resourceIBMPIImageDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -63,11 +64,11 @@ func resourceIBMPIImage() *schema.Resource {
 	}
 }
 
-func resourceIBMPIImageCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIImageCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
 		log.Printf("Failed to get the session")
-		return err
+		return diag.FromErr(err)
 	}
 
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
@@ -78,37 +79,45 @@ func resourceIBMPIImageCreate(d *schema.ResourceData, meta interface{}) error {
 
 	imageResponse, err := client.Create(name, imageid, powerinstanceid)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	IBMPIImageID := imageResponse.ImageID
 	d.SetId(fmt.Sprintf("%s/%s", powerinstanceid, *IBMPIImageID))
 
-	_, err = isWaitForIBMPIImageAvailable(client, *IBMPIImageID, d.Timeout(schema.TimeoutCreate), powerinstanceid)
+	_, err = isWaitForIBMPIImageAvailable(context, client, *IBMPIImageID, d.Timeout(schema.TimeoutCreate), powerinstanceid)
 	if err != nil {
 		log.Printf("[DEBUG]  err %s", err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMPIImageRead(d, meta)
+	return resourceIBMPIImageRead(context, d, meta)
 }
 
-func resourceIBMPIImageRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIImageRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMPIImageExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
 
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	powerinstanceid := parts[0]
 	imageC := st.NewIBMPIImageClient(sess, powerinstanceid)
 	imagedata, err := imageC.Get(parts[1], powerinstanceid)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	imageid := *imagedata.ImageID
@@ -119,25 +128,25 @@ func resourceIBMPIImageRead(d *schema.ResourceData, meta interface{}) error {
 
 }
 
-func resourceIBMPIImageUpdate(data *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIImageUpdate(context context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return nil
 }
 
-func resourceIBMPIImageDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIImageDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	powerinstanceid := parts[0]
 	imageC := st.NewIBMPIImageClient(sess, powerinstanceid)
 	err = imageC.Delete(parts[1], powerinstanceid)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return nil
@@ -170,7 +179,7 @@ func resourceIBMPIImageExists(d *schema.ResourceData, meta interface{}) (bool, e
 	return *image.ImageID == name, nil
 }
 
-func isWaitForIBMPIImageAvailable(client *st.IBMPIImageClient, id string, timeout time.Duration, powerinstanceid string) (interface{}, error) {
+func isWaitForIBMPIImageAvailable(context context.Context, client *st.IBMPIImageClient, id string, timeout time.Duration, powerinstanceid string) (interface{}, error) {
 	log.Printf("Waiting for Power Image (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -182,7 +191,7 @@ func isWaitForIBMPIImageAvailable(client *st.IBMPIImageClient, id string, timeou
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isIBMPIImageRefreshFunc(client *st.IBMPIImageClient, id, powerinstanceid string) resource.StateRefreshFunc {
@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -33,12 +35,11 @@ const (
 
 func resourceIBMPIInstance() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMPIInstanceCreate,
-		Read:     resourceIBMPIInstanceRead,
-		Update:   resourceIBMPIInstanceUpdate,
-		Delete:   resourceIBMPIInstanceDelete,
-		Exists:   resourceIBMPIInstanceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMPIInstanceCreate,
+		ReadContext:   resourceIBMPIInstanceRead,
+		UpdateContext: resourceIBMPIInstanceUpdate,
+		DeleteContext: resourceIBMPIInstanceDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(120 * time.Minute),
@@ -271,11 +272,11 @@ func resourceIBMPIInstance() *schema.Resource {
 	}
 }
 
-func resourceIBMPIInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIInstanceCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("Now in the PowerVMCreate")
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
 	name := d.Get(helpers.PIInstanceName).(string)
@@ -322,7 +323,7 @@ func resourceIBMPIInstanceCreate(d *schema.ResourceData, meta interface{}) error
 	err = checkBase64(userData)
 	if err != nil {
 		log.Printf("Data is not base64 encoded")
-		return err
+		return diag.FromErr(err)
 	}
 
 	//publicinterface := d.Get(helpers.PIInstancePublicNetwork).(bool)
@@ -360,7 +361,7 @@ func resourceIBMPIInstanceCreate(d *schema.ResourceData, meta interface{}) error
 	}, powerinstanceid, createTimeOut)
 
 	if err != nil {
-		return fmt.Errorf("failed to provision %s", err)
+		return diag.FromErr(fmt.Errorf("failed to provision %s", err))
 	}
 
 	var pvminstanceids []string
@@ -379,31 +380,39 @@ func resourceIBMPIInstanceCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	for ids := range pvminstanceids {
-		_, err = isWaitForPIInstanceAvailable(client, pvminstanceids[ids], d.Timeout(schema.TimeoutCreate), powerinstanceid, instanceReadyStatus)
+		_, err = isWaitForPIInstanceAvailable(context, client, pvminstanceids[ids], d.Timeout(schema.TimeoutCreate), powerinstanceid, instanceReadyStatus)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceIBMPIInstanceRead(d, meta)
+	return resourceIBMPIInstanceRead(context, d, meta)
 
 }
 
-func resourceIBMPIInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIInstanceRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMPIInstanceExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
 
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	powerinstanceid := parts[0]
 	powerC := st.NewIBMPIInstanceClient(sess, powerinstanceid)
 	powervmdata, err := powerC.Get(parts[1], powerinstanceid, getTimeOut)
 	if err != nil {
-		return fmt.Errorf("failed to get the instance %v", err)
+		return diag.FromErr(fmt.Errorf("failed to get the instance %v", err))
 	}
 
 	d.Set(helpers.PIInstanceMemory, powervmdata.Memory)
@@ -507,7 +516,7 @@ func resourceIBMPIInstanceRead(d *schema.ResourceData, meta interface{}) error {
 
 }
 
-func resourceIBMPIInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIInstanceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	name := d.Get(helpers.PIInstanceName).(string)
 	mem := d.Get(helpers.PIInstanceMemory).(float64)
@@ -517,16 +526,16 @@ func resourceIBMPIInstanceUpdate(d *schema.ResourceData, meta interface{}) error
 
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return fmt.Errorf("failed to get the session from the IBM Cloud Service")
+		return diag.FromErr(fmt.Errorf("failed to get the session from the IBM Cloud Service"))
 	}
 	if d.Get("health_status") == "WARNING" {
 
-		return fmt.Errorf("the operation cannot be performed when the lpar health in the WARNING State")
+		return diag.FromErr(fmt.Errorf("the operation cannot be performed when the lpar health in the WARNING State"))
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	powerinstanceid := parts[0]
 	client := st.NewIBMPIInstanceClient(sess, powerinstanceid)
@@ -545,13 +554,13 @@ func resourceIBMPIInstanceUpdate(d *schema.ResourceData, meta interface{}) error
 			}
 			_, err = client.Action(&p_cloud_p_vm_instances.PcloudPvminstancesActionPostParams{Body: body}, parts[1], powerinstanceid, postTimeOut)
 			if err != nil {
-				return fmt.Errorf("failed to perform the stop action on the pvm instance %v", err)
+				return diag.FromErr(fmt.Errorf("failed to perform the stop action on the pvm instance %v", err))
 
 			}
 
-			_, err = isWaitForPIInstanceStopped(client, parts[1], d.Timeout(schema.TimeoutUpdate), powerinstanceid)
+			_, err = isWaitForPIInstanceStopped(context, client, parts[1], d.Timeout(schema.TimeoutUpdate), powerinstanceid)
 			if err != nil {
-				return fmt.Errorf("failed to perform the stop action on the pvm instance %v", err)
+				return diag.FromErr(fmt.Errorf("failed to perform the stop action on the pvm instance %v", err))
 			}
 		}
 
@@ -561,11 +570,11 @@ func resourceIBMPIInstanceUpdate(d *schema.ResourceData, meta interface{}) error
 		updatebody := &models.PVMInstanceUpdate{ProcType: processortype}
 		_, err = client.Update(parts[1], powerinstanceid, &p_cloud_p_vm_instances.PcloudPvminstancesPutParams{Body: updatebody}, updateTimeOut)
 		if err != nil {
-			return fmt.Errorf("failed to perform the modify operation on the pvm instance %v", err)
+			return diag.FromErr(fmt.Errorf("failed to perform the modify operation on the pvm instance %v", err))
 		}
-		_, err = isWaitForPIInstanceStopped(client, parts[1], d.Timeout(schema.TimeoutUpdate), powerinstanceid)
+		_, err = isWaitForPIInstanceStopped(context, client, parts[1], d.Timeout(schema.TimeoutUpdate), powerinstanceid)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		// Start
@@ -575,12 +584,12 @@ func resourceIBMPIInstanceUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 		_, err = client.Action(&p_cloud_p_vm_instances.PcloudPvminstancesActionPostParams{Body: startbody}, parts[1], powerinstanceid, postTimeOut)
 		if err != nil {
-			return fmt.Errorf("failed to perform the start action on the pvm instance %v", err)
+			return diag.FromErr(fmt.Errorf("failed to perform the start action on the pvm instance %v", err))
 		}
 
-		_, err = isWaitForPIInstanceAvailable(client, parts[1], d.Timeout(schema.TimeoutUpdate), powerinstanceid, "OK")
+		_, err = isWaitForPIInstanceAvailable(context, client, parts[1], d.Timeout(schema.TimeoutUpdate), powerinstanceid, "OK")
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -589,7 +598,7 @@ func resourceIBMPIInstanceUpdate(d *schema.ResourceData, meta interface{}) error
 	if d.HasChange(helpers.PIVirtualCoresAssigned) {
 		parts, err := idParts(d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		powerinstanceid := parts[0]
 
@@ -600,11 +609,11 @@ func resourceIBMPIInstanceUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 		_, err = client.Update(parts[1], powerinstanceid, &p_cloud_p_vm_instances.PcloudPvminstancesPutParams{Body: body}, updateTimeOut)
 		if err != nil {
-			return fmt.Errorf("failed to update the lpar with the change for virtual cores %s", err)
+			return diag.FromErr(fmt.Errorf("failed to update the lpar with the change for virtual cores %s", err))
 		}
-		_, err = isWaitForPIInstanceAvailable(client, parts[1], d.Timeout(schema.TimeoutUpdate), powerinstanceid, "OK")
+		_, err = isWaitForPIInstanceAvailable(context, client, parts[1], d.Timeout(schema.TimeoutUpdate), powerinstanceid, "OK")
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -626,16 +635,16 @@ func resourceIBMPIInstanceUpdate(d *schema.ResourceData, meta interface{}) error
 
 		if mem > maxMemLpar || procs > maxCPULpar {
 
-			_, err = performChangeAndReboot(client, parts[1], powerinstanceid, mem, procs)
+			_, err = performChangeAndReboot(context, client, parts[1], powerinstanceid, mem, procs)
 			//_, err = stopLparForResourceChange(client, parts[1], powerinstanceid)
 			if err != nil {
-				return fmt.Errorf("failed to perform the operation for the change")
+				return diag.FromErr(fmt.Errorf("failed to perform the operation for the change"))
 			}
 
 		} else {
 			parts, err := idParts(d.Id())
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			powerinstanceid := parts[0]
 
@@ -651,37 +660,37 @@ func resourceIBMPIInstanceUpdate(d *schema.ResourceData, meta interface{}) error
 
 			_, err = client.Update(parts[1], powerinstanceid, &p_cloud_p_vm_instances.PcloudPvminstancesPutParams{Body: body}, updateTimeOut)
 			if err != nil {
-				return fmt.Errorf("failed to update the lpar with the change %s", err)
+				return diag.FromErr(fmt.Errorf("failed to update the lpar with the change %s", err))
 			}
-			_, err = isWaitForPIInstanceAvailable(client, parts[1], d.Timeout(schema.TimeoutUpdate), powerinstanceid, "OK")
+			_, err = isWaitForPIInstanceAvailable(context, client, parts[1], d.Timeout(schema.TimeoutUpdate), powerinstanceid, "OK")
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 		}
 
 	}
 
-	return resourceIBMPIInstanceRead(d, meta)
+	return resourceIBMPIInstanceRead(context, d, meta)
 
 }
 
-func resourceIBMPIInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIInstanceDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, _ := meta.(ClientSession).IBMPISession()
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	powerinstanceid := parts[0]
 	client := st.NewIBMPIInstanceClient(sess, powerinstanceid)
 	err = client.Delete(parts[1], powerinstanceid, deleteTimeOut)
 	if err != nil {
-		return fmt.Errorf("failed to perform the delete action on the pvm instance %s", err)
+		return diag.FromErr(fmt.Errorf("failed to perform the delete action on the pvm instance %s", err))
 	}
 
-	_, err = isWaitForPIInstanceDeleted(client, parts[1], d.Timeout(schema.TimeoutDelete), powerinstanceid)
+	_, err = isWaitForPIInstanceDeleted(context, client, parts[1], d.Timeout(schema.TimeoutDelete), powerinstanceid)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
@@ -718,7 +727,7 @@ func resourceIBMPIInstanceExists(d *schema.ResourceData, meta interface{}) (bool
 	return truepvmid == parts[1], nil
 }
 
-func isWaitForPIInstanceDeleted(client *st.IBMPIInstanceClient, id string, timeout time.Duration, powerinstanceid string) (interface{}, error) {
+func isWaitForPIInstanceDeleted(context context.Context, client *st.IBMPIInstanceClient, id string, timeout time.Duration, powerinstanceid string) (interface{}, error) {
 
 	log.Printf("Waiting for  (%s) to be deleted.", id)
 
@@ -731,7 +740,7 @@ func isWaitForPIInstanceDeleted(client *st.IBMPIInstanceClient, id string, timeo
 		Timeout:    10 * time.Minute,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isPIInstanceDeleteRefreshFunc(client *st.IBMPIInstanceClient, id, powerinstanceid string) resource.StateRefreshFunc {
@@ -747,7 +756,7 @@ func isPIInstanceDeleteRefreshFunc(client *st.IBMPIInstanceClient, id, powerinst
 	}
 }
 
-func isWaitForPIInstanceAvailable(client *st.IBMPIInstanceClient, id string, timeout time.Duration, powerinstanceid string, instanceReadyStatus string) (interface{}, error) {
+func isWaitForPIInstanceAvailable(context context.Context, client *st.IBMPIInstanceClient, id string, timeout time.Duration, powerinstanceid string, instanceReadyStatus string) (interface{}, error) {
 	log.Printf("Waiting for PIInstance (%s) to be available and active ", id)
 
 	var queryTimeOut time.Duration
@@ -767,7 +776,7 @@ func isWaitForPIInstanceAvailable(client *st.IBMPIInstanceClient, id string, tim
 		Timeout:    120 * time.Minute,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isPIInstanceRefreshFunc(client *st.IBMPIInstanceClient, id, powerinstanceid, instanceReadyStatus string) resource.StateRefreshFunc {
@@ -798,7 +807,7 @@ func checkBase64(input string) error {
 
 }
 
-func isWaitForPIInstanceStopped(client *st.IBMPIInstanceClient, id string, timeout time.Duration, powerinstanceid string) (interface{}, error) {
+func isWaitForPIInstanceStopped(context context.Context, client *st.IBMPIInstanceClient, id string, timeout time.Duration, powerinstanceid string) (interface{}, error) {
 	log.Printf("Waiting for PIInstance (%s) to be stopped and powered off ", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -810,7 +819,7 @@ func isWaitForPIInstanceStopped(client *st.IBMPIInstanceClient, id string, timeo
 		Timeout:    30 * time.Minute,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isPIInstanceRefreshFuncOff(client *st.IBMPIInstanceClient, id, powerinstanceid string) resource.StateRefreshFunc {
@@ -828,7 +837,7 @@ func isPIInstanceRefreshFuncOff(client *st.IBMPIInstanceClient, id, powerinstanc
 	}
 }
 
-func stopLparForResourceChange(client *st.IBMPIInstanceClient, id, powerinstanceid string) (interface{}, error) {
+func stopLparForResourceChange(context context.Context, client *st.IBMPIInstanceClient, id, powerinstanceid string) (interface{}, error) {
 	//TODO
 
 	log.Printf("Callin the stop lpar for Resource Change code ..")
@@ -841,7 +850,7 @@ func stopLparForResourceChange(client *st.IBMPIInstanceClient, id, powerinstance
 		return nil, err
 	}
 
-	_, err = isWaitForPIInstanceStopped(client, id, 30, powerinstanceid)
+	_, err = isWaitForPIInstanceStopped(context, client, id, 30, powerinstanceid)
 	if err != nil {
 		return nil, fmt.Errorf("failed to stop the lpar")
 	}
@@ -851,7 +860,7 @@ func stopLparForResourceChange(client *st.IBMPIInstanceClient, id, powerinstance
 
 // Start the lpar
 
-func startLparAfterResourceChange(client *st.IBMPIInstanceClient, id, powerinstanceid string) (interface{}, error) {
+func startLparAfterResourceChange(context context.Context, client *st.IBMPIInstanceClient, id, powerinstanceid string) (interface{}, error) {
 	//TODO
 	body := &models.PVMInstanceAction{
 		Action: ptrToString("start"),
@@ -861,7 +870,7 @@ func startLparAfterResourceChange(client *st.IBMPIInstanceClient, id, powerinsta
 		return nil, fmt.Errorf("start Action failed on [%s] %s", id, err)
 	}
 
-	_, err = isWaitForPIInstanceAvailable(client, id, 30, powerinstanceid, "OK")
+	_, err = isWaitForPIInstanceAvailable(context, client, id, 30, powerinstanceid, "OK")
 	if err != nil {
 		return nil, fmt.Errorf("failed to stop the lpar")
 	}
@@ -871,7 +880,7 @@ func startLparAfterResourceChange(client *st.IBMPIInstanceClient, id, powerinsta
 
 // Stop / Modify / Start only when the lpar is off limits
 
-func performChangeAndReboot(client *st.IBMPIInstanceClient, id, powerinstanceid string, mem, procs float64) (interface{}, error) {
+func performChangeAndReboot(context context.Context, client *st.IBMPIInstanceClient, id, powerinstanceid string, mem, procs float64) (interface{}, error) {
 	/*
 		These are the steps
 		1. Stop the lpar - Check if the lpar is SHUTOFF
@@ -891,7 +900,7 @@ func performChangeAndReboot(client *st.IBMPIInstanceClient, id, powerinstanceid
 	if err != nil {
 		return nil, fmt.Errorf("Stop Action failed on [%s]: %s", id, err)
 	}
-	_, err = isWaitForPIInstanceStopped(client, id, 30, powerinstanceid)
+	_, err = isWaitForPIInstanceStopped(context, client, id, 30, powerinstanceid)
 	if err != nil {
 		return nil, fmt.Errorf("failed to stop the lpar")
 	}
@@ -908,7 +917,7 @@ func performChangeAndReboot(client *st.IBMPIInstanceClient, id, powerinstanceid
 		return nil, fmt.Errorf("failed to update the lpar with the change, %s", updateErr)
 	}
 
-	_, err = isWaitforPIInstanceUpdate(client, id, 30, powerinstanceid)
+	_, err = isWaitforPIInstanceUpdate(context, client, id, 30, powerinstanceid)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get an update from the Service after the resource change, %s", err)
 	}
@@ -927,7 +936,7 @@ func performChangeAndReboot(client *st.IBMPIInstanceClient, id, powerinstanceid
 		return nil, fmt.Errorf("the error from the start is %s", starterr)
 	}
 
-	_, err = isWaitForPIInstanceAvailable(client, id, 30, powerinstanceid, "OK")
+	_, err = isWaitForPIInstanceAvailable(context, client, id, 30, powerinstanceid, "OK")
 	if err != nil {
 		return nil, fmt.Errorf("failed to stop the lpar %s", err)
 	}
@@ -936,7 +945,7 @@ func performChangeAndReboot(client *st.IBMPIInstanceClient, id, powerinstanceid
 
 }
 
-func isWaitforPIInstanceUpdate(client *st.IBMPIInstanceClient, id string, timeout time.Duration, powerinstanceid string) (interface{}, error) {
+func isWaitforPIInstanceUpdate(context context.Context, client *st.IBMPIInstanceClient, id string, timeout time.Duration, powerinstanceid string) (interface{}, error) {
 	log.Printf("Waiting for PIInstance (%s) to be SHUTOFF AFTER THE RESIZE Due to DLPAR Operation ", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -948,7 +957,7 @@ func isWaitforPIInstanceUpdate(client *st.IBMPIInstanceClient, id string, timeou
 		Timeout:    60 * time.Minute,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isPIInstanceShutAfterResourceChange(client *st.IBMPIInstanceClient, id, powerinstanceid string) resource.StateRefreshFunc {
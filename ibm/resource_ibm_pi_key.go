@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
@@ -17,12 +19,11 @@ import (
 
 func resourceIBMPIKey() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMPIKeyCreate,
-		Read:     resourceIBMPIKeyRead,
-		Update:   resourceIBMPIKeyUpdate,
-		Delete:   resourceIBMPIKeyDelete,
-		Exists:   resourceIBMPIKeyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMPIKeyCreate,
+		ReadContext:   resourceIBMPIKeyRead,
+		UpdateContext: resourceIBMPIKeyUpdate,
+		DeleteContext: resourceIBMPIKeyDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -63,10 +64,10 @@ func resourceIBMPIKey() *schema.Resource {
 	}
 }
 
-func resourceIBMPIKeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIKeyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
@@ -77,25 +78,33 @@ func resourceIBMPIKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	sshResponse, _, err := client.Create(name, sshkey, powerinstanceid)
 	if err != nil {
 		log.Printf("[DEBUG]  err %s", err)
-		return fmt.Errorf("Failed to create the key %v", err)
+		return diag.FromErr(fmt.Errorf("Failed to create the key %v", err))
 
 	}
 
 	log.Printf("Printing the sshkey %+v", &sshResponse)
 
 	d.SetId(fmt.Sprintf("%s/%s", powerinstanceid, name))
-	return resourceIBMPIKeyRead(d, meta)
+	return resourceIBMPIKeyRead(context, d, meta)
 }
 
-func resourceIBMPIKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIKeyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMPIKeyExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
 
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return fmt.Errorf("Failed to obtain the key %v", err)
+		return diag.FromErr(fmt.Errorf("Failed to obtain the key %v", err))
 	}
 
 	powerinstanceid := parts[0]
@@ -103,7 +112,7 @@ func resourceIBMPIKeyRead(d *schema.ResourceData, meta interface{}) error {
 	sshkeydata, err := sshkeyC.Get(parts[1], powerinstanceid)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set(helpers.PIKeyName, sshkeydata.Name)
@@ -116,18 +125,18 @@ func resourceIBMPIKeyRead(d *schema.ResourceData, meta interface{}) error {
 
 }
 
-func resourceIBMPIKeyUpdate(data *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIKeyUpdate(context context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return nil
 }
 
-func resourceIBMPIKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIKeyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	powerinstanceid := parts[0]
@@ -135,7 +144,7 @@ func resourceIBMPIKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	err = sshkeyC.Delete(parts[1], powerinstanceid)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return nil
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
@@ -11,6 +12,7 @@ import (
 	"time"
 
 	"github.com/apparentlymart/go-cidr/cidr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -20,10 +22,10 @@ import (
 
 func resourceIBMPINetwork() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMPINetworkCreate,
-		Read:   resourceIBMPINetworkRead,
-		Update: resourceIBMPINetworkUpdate,
-		Delete: resourceIBMPINetworkDelete,
+		CreateContext: resourceIBMPINetworkCreate,
+		ReadContext:   resourceIBMPINetworkRead,
+		UpdateContext: resourceIBMPINetworkUpdate,
+		DeleteContext: resourceIBMPINetworkDelete,
 		//Exists:   resourceIBMPINetworkExists,
 		Importer: &schema.ResourceImporter{},
 
@@ -88,10 +90,10 @@ func resourceIBMPINetwork() *schema.Resource {
 	}
 }
 
-func resourceIBMPINetworkCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPINetworkCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
 	networkname := d.Get(helpers.PINetworkName).(string)
@@ -106,38 +108,38 @@ func resourceIBMPINetworkCreate(d *schema.ResourceData, meta interface{}) error
 	}
 	networkResponse, _, err := client.Create(networkname, networktype, networkcidr, networkdns, networkgateway, firstip, lastip, powerinstanceid, postTimeOut)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	IBMPINetworkID := *networkResponse.NetworkID
 
 	d.SetId(fmt.Sprintf("%s/%s", powerinstanceid, IBMPINetworkID))
 
-	_, err = isWaitForIBMPINetworkAvailable(client, IBMPINetworkID, d.Timeout(schema.TimeoutCreate), powerinstanceid)
+	_, err = isWaitForIBMPINetworkAvailable(context, client, IBMPINetworkID, d.Timeout(schema.TimeoutCreate), powerinstanceid)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMPINetworkRead(d, meta)
+	return resourceIBMPINetworkRead(context, d, meta)
 }
 
-func resourceIBMPINetworkRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPINetworkRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	powerinstanceid := parts[0]
 	networkC := st.NewIBMPINetworkClient(sess, powerinstanceid)
 	networkdata, err := networkC.Get(parts[1], powerinstanceid, getTimeOut)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("network_id", networkdata.NetworkID)
@@ -152,27 +154,27 @@ func resourceIBMPINetworkRead(d *schema.ResourceData, meta interface{}) error {
 
 }
 
-func resourceIBMPINetworkUpdate(data *schema.ResourceData, meta interface{}) error {
+func resourceIBMPINetworkUpdate(context context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return nil
 }
 
-func resourceIBMPINetworkDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPINetworkDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	log.Printf("Calling the network delete functions. ")
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	powerinstanceid := parts[0]
 	networkC := st.NewIBMPINetworkClient(sess, powerinstanceid)
 	err = networkC.Delete(parts[1], powerinstanceid, deleteTimeOut)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return nil
@@ -199,7 +201,7 @@ func resourceIBMPINetworkExists(d *schema.ResourceData, meta interface{}) (bool,
 	return *network.NetworkID == parts[1], nil
 }
 
-func isWaitForIBMPINetworkAvailable(client *st.IBMPINetworkClient, id string, timeout time.Duration, powerinstanceid string) (interface{}, error) {
+func isWaitForIBMPINetworkAvailable(context context.Context, client *st.IBMPINetworkClient, id string, timeout time.Duration, powerinstanceid string) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", helpers.PINetworkProvisioning},
@@ -210,7 +212,7 @@ func isWaitForIBMPINetworkAvailable(client *st.IBMPINetworkClient, id string, ti
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isIBMPINetworkRefreshFunc(client *st.IBMPINetworkClient, id, powerinstanceid string) resource.StateRefreshFunc {
@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -19,10 +21,10 @@ import (
 
 func resourceIBMPINetworkPort() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMPINetworkPortCreate,
-		Read:   resourceIBMPINetworkPortRead,
-		Update: resourceIBMPINetworkPortUpdate,
-		Delete: resourceIBMPINetworkPortDelete,
+		CreateContext: resourceIBMPINetworkPortCreate,
+		ReadContext:   resourceIBMPINetworkPortRead,
+		UpdateContext: resourceIBMPINetworkPortUpdate,
+		DeleteContext: resourceIBMPINetworkPortDelete,
 		//Exists:   resourceIBMPINetworkExists,
 		Importer: &schema.ResourceImporter{},
 
@@ -75,10 +77,10 @@ func resourceIBMPINetworkPort() *schema.Resource {
 	}
 }
 
-func resourceIBMPINetworkPortCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPINetworkPortCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
 	networkname := d.Get(helpers.PINetworkName).(string)
@@ -98,7 +100,7 @@ func resourceIBMPINetworkPortCreate(d *schema.ResourceData, meta interface{}) er
 	networkPortResponse, err := client.CreatePort(networkname, powerinstanceid, &p_cloud_networks.PcloudNetworksPortsPostParams{Body: nwportBody}, postTimeOut)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("Printing the networkresponse %+v", &networkPortResponse)
@@ -108,26 +110,26 @@ func resourceIBMPINetworkPortCreate(d *schema.ResourceData, meta interface{}) er
 	d.SetId(fmt.Sprintf("%s/%s/%s", powerinstanceid, IBMPINetworkPortID, networkname))
 	if err != nil {
 		log.Printf("[DEBUG]  err %s", err)
-		return err
+		return diag.FromErr(err)
 	}
-	_, err = isWaitForIBMPINetworkPortAvailable(client, IBMPINetworkPortID, d.Timeout(schema.TimeoutCreate), powerinstanceid, networkname)
+	_, err = isWaitForIBMPINetworkPortAvailable(context, client, IBMPINetworkPortID, d.Timeout(schema.TimeoutCreate), powerinstanceid, networkname)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMPINetworkPortRead(d, meta)
+	return resourceIBMPINetworkPortRead(context, d, meta)
 }
 
-func resourceIBMPINetworkPortRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPINetworkPortRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	var powernetworkname string
 	if len(parts) > 2 {
@@ -142,7 +144,7 @@ func resourceIBMPINetworkPortRead(d *schema.ResourceData, meta interface{}) erro
 	networkdata, err := networkC.GetPort(powernetworkname, powerinstanceid, parts[1], getTimeOut)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set(helpers.PINetworkPortIPAddress, networkdata.IPAddress)
@@ -155,21 +157,21 @@ func resourceIBMPINetworkPortRead(d *schema.ResourceData, meta interface{}) erro
 
 }
 
-func resourceIBMPINetworkPortUpdate(data *schema.ResourceData, meta interface{}) error {
+func resourceIBMPINetworkPortUpdate(context context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return nil
 }
 
-func resourceIBMPINetworkPortDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPINetworkPortDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	log.Printf("Calling the network delete functions. ")
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := idParts(d.Id())
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	var powernetworkname string
 	if len(parts) > 2 {
@@ -187,13 +189,13 @@ func resourceIBMPINetworkPortDelete(d *schema.ResourceData, meta interface{}) er
 	log.Printf("Response from the deleteport call %v", networkdata)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return nil
 }
 
-func isWaitForIBMPINetworkPortAvailable(client *st.IBMPINetworkClient, id string, timeout time.Duration, powerinstanceid, networkname string) (interface{}, error) {
+func isWaitForIBMPINetworkPortAvailable(context context.Context, client *st.IBMPINetworkClient, id string, timeout time.Duration, powerinstanceid, networkname string) (interface{}, error) {
 	log.Printf("Waiting for Power Network (%s) that was created for Network Zone (%s) to be available.", id, networkname)
 
 	stateConf := &resource.StateChangeConf{
@@ -205,7 +207,7 @@ func isWaitForIBMPINetworkPortAvailable(client *st.IBMPINetworkClient, id string
 		MinTimeout: 10 * time.Minute,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isIBMPINetworkPortRefreshFunc(client *st.IBMPINetworkClient, id, powerinstanceid, networkname string) resource.StateRefreshFunc {
@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
@@ -18,10 +20,10 @@ import (
 func resourceIBMPINetworkPortAttach() *schema.Resource {
 	return &schema.Resource{
 
-		Create: resourceIBMPINetworkPortAttachCreate,
-		Read:   resourceIBMPINetworkPortAttachRead,
-		Update: resourceIBMPINetworkPortAttachUpdate,
-		Delete: resourceIBMPINetworkPortAttachDelete,
+		CreateContext: resourceIBMPINetworkPortAttachCreate,
+		ReadContext:   resourceIBMPINetworkPortAttachRead,
+		UpdateContext: resourceIBMPINetworkPortAttachUpdate,
+		DeleteContext: resourceIBMPINetworkPortAttachDelete,
 		//Exists:   resourceIBMPINetworkExists,
 		Importer: &schema.ResourceImporter{},
 
@@ -69,10 +71,10 @@ func resourceIBMPINetworkPortAttach() *schema.Resource {
 
 }
 
-func resourceIBMPINetworkPortAttachCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPINetworkPortAttachCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
 	networkname := d.Get(helpers.PINetworkName).(string)
@@ -85,7 +87,7 @@ func resourceIBMPINetworkPortAttachCreate(d *schema.ResourceData, meta interface
 	networkPortResponse, err := client.AttachPort(powerinstanceid, networkname, portid, description, instancename, postTimeOut)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("Printing the networkresponse %+v", &networkPortResponse)
@@ -95,17 +97,17 @@ func resourceIBMPINetworkPortAttachCreate(d *schema.ResourceData, meta interface
 	d.SetId(fmt.Sprintf("%s/%s", powerinstanceid, IBMPINetworkPortID))
 	if err != nil {
 		log.Printf("[DEBUG]  err %s", err)
-		return err
+		return diag.FromErr(err)
 	}
-	_, err = isWaitForIBMPINetworkPortAttachAvailable(client, IBMPINetworkPortID, d.Timeout(schema.TimeoutCreate), powerinstanceid, networkname)
+	_, err = isWaitForIBMPINetworkPortAttachAvailable(context, client, IBMPINetworkPortID, d.Timeout(schema.TimeoutCreate), powerinstanceid, networkname)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMPINetworkPortAttachRead(d, meta)
+	return resourceIBMPINetworkPortAttachRead(context, d, meta)
 }
 
-func resourceIBMPINetworkPortAttachRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPINetworkPortAttachRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("Calling ther Network Port Attach Read code")
 	sess, err := meta.(ClientSession).IBMPISession()
 
@@ -115,7 +117,7 @@ func resourceIBMPINetworkPortAttachRead(d *schema.ResourceData, meta interface{}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	powerinstanceid := parts[0]
@@ -133,12 +135,12 @@ func resourceIBMPINetworkPortAttachRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceIBMPINetworkPortAttachUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPINetworkPortAttachUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("Calling the attach update ")
 	return nil
 }
 
-func resourceIBMPINetworkPortAttachDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPINetworkPortAttachDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("Detaching the network port from the Instance ")
 
 	sess, err := meta.(ClientSession).IBMPISession()
@@ -149,7 +151,7 @@ func resourceIBMPINetworkPortAttachDelete(d *schema.ResourceData, meta interface
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	powerinstanceid := parts[0]
@@ -160,7 +162,7 @@ func resourceIBMPINetworkPortAttachDelete(d *schema.ResourceData, meta interface
 	log.Printf("Calling the network delete functions. ")
 	network, err := client.DetachPort(powerinstanceid, powernetworkname, portid, deleteTimeOut)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("Printing the networkresponse %+v", &network)
@@ -172,7 +174,7 @@ func resourceIBMPINetworkPortAttachDelete(d *schema.ResourceData, meta interface
 
 }
 
-func isWaitForIBMPINetworkPortAttachAvailable(client *st.IBMPINetworkClient, id string, timeout time.Duration, powerinstanceid, networkname string) (interface{}, error) {
+func isWaitForIBMPINetworkPortAttachAvailable(context context.Context, client *st.IBMPINetworkClient, id string, timeout time.Duration, powerinstanceid, networkname string) (interface{}, error) {
 	log.Printf("Waiting for Power Network (%s) that was created for Network Zone (%s) to be available.", id, networkname)
 
 	stateConf := &resource.StateChangeConf{
@@ -184,7 +186,7 @@ func isWaitForIBMPINetworkPortAttachAvailable(client *st.IBMPINetworkClient, id
 		MinTimeout: 10 * time.Minute,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isIBMPINetworkPortAttachRefreshFunc(client *st.IBMPINetworkClient, id, powerinstanceid, networkname string) resource.StateRefreshFunc {
@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/helpers"
 	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_p_vm_instances"
 	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -34,10 +36,10 @@ SHUTOFF--> ACTIVE
 
 func resourceIBMPIIOperations() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMPIOperationsCreate,
-		Read:   resourceIBMPIOperationsRead,
-		Update: resourceIBMPIOperationsUpdate,
-		Delete: resourceIBMPIOperationsDelete,
+		CreateContext: resourceIBMPIOperationsCreate,
+		ReadContext:   resourceIBMPIOperationsRead,
+		UpdateContext: resourceIBMPIOperationsUpdate,
+		DeleteContext: resourceIBMPIOperationsDelete,
 		//Exists:   resourceIBMPIOperationsExists,
 		Importer: &schema.ResourceImporter{},
 
@@ -116,12 +118,12 @@ func resourceIBMPIIOperations() *schema.Resource {
 	}
 }
 
-func resourceIBMPIOperationsCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIOperationsCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	log.Printf("Now in the Power Operations Code")
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
 	operation := d.Get(helpers.PIInstanceOperationType).(string)
@@ -142,7 +144,7 @@ func resourceIBMPIOperationsCreate(d *schema.ResourceData, meta interface{}) err
 
 	if err != nil {
 		log.Printf("[DEBUG]  err %s", err)
-		return fmt.Errorf("Failed to perform the operation on  the instance %v", err)
+		return diag.FromErr(fmt.Errorf("Failed to perform the operation on  the instance %v", err))
 
 	} else {
 		log.Printf("Executed the stop operation on the lpar")
@@ -153,9 +155,9 @@ func resourceIBMPIOperationsCreate(d *schema.ResourceData, meta interface{}) err
 	if operation == "stop" || operation == "immediate-shutdown" {
 		var targetStatus = "SHUTOFF"
 		log.Printf("Calling the check opertion that was invoked [%s]  to check for status [ %s ]", operation, targetStatus)
-		_, err = isWaitForPIInstanceOperationStatus(client, name, d.Timeout(schema.TimeoutCreate), powerinstanceid, operation, targetStatus)
+		_, err = isWaitForPIInstanceOperationStatus(context, client, name, d.Timeout(schema.TimeoutCreate), powerinstanceid, operation, targetStatus)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		} else {
 			log.Printf("Executed the start operation on the lpar")
 		}
@@ -165,23 +167,23 @@ func resourceIBMPIOperationsCreate(d *schema.ResourceData, meta interface{}) err
 	if operation == "start" || operation == "soft-reboot" || operation == "hard-reboot" {
 		var targetStatus = "ACTIVE"
 		log.Printf("Calling the check opertion that was invoked [%s]  to check for status [ %s ]", operation, targetStatus)
-		_, err = isWaitForPIInstanceOperationStatus(client, name, d.Timeout(schema.TimeoutCreate), powerinstanceid, operation, targetStatus)
+		_, err = isWaitForPIInstanceOperationStatus(context, client, name, d.Timeout(schema.TimeoutCreate), powerinstanceid, operation, targetStatus)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
-	return resourceIBMPIOperationsRead(d, meta)
+	return resourceIBMPIOperationsRead(context, d, meta)
 }
 
-func resourceIBMPIOperationsRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIOperationsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	log.Printf("Calling the PowerOperations Read code..for instance name %s", d.Get(helpers.PIInstanceOperationServerName).(string))
 
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
 	name := d.Get(helpers.PIInstanceOperationServerName).(string)
@@ -189,7 +191,7 @@ func resourceIBMPIOperationsRead(d *schema.ResourceData, meta interface{}) error
 	powervmdata, err := powerC.Get(name, powerinstanceid, getTimeOut)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("status", powervmdata.Status)
@@ -207,12 +209,12 @@ func resourceIBMPIOperationsRead(d *schema.ResourceData, meta interface{}) error
 
 }
 
-func resourceIBMPIOperationsUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIOperationsUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	return nil
 }
 
-func resourceIBMPIOperationsDelete(data *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIOperationsDelete(context context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	return nil
 }
@@ -237,7 +239,7 @@ func resourceIBMPIOperationsExists(d *schema.ResourceData, meta interface{}) (bo
 	return instance.PvmInstanceID == &id, nil
 }
 
-func isWaitForPIInstanceOperationStatus(client *st.IBMPIInstanceClient, name string, timeout time.Duration, powerinstanceid, operation, targetstatus string) (interface{}, error) {
+func isWaitForPIInstanceOperationStatus(context context.Context, client *st.IBMPIInstanceClient, name string, timeout time.Duration, powerinstanceid, operation, targetstatus string) (interface{}, error) {
 
 	log.Printf("Waiting for the Operation [ %s ] to be performed on the instance with name [ %s ]", operation, name)
 
@@ -250,7 +252,7 @@ func isWaitForPIInstanceOperationStatus(client *st.IBMPIInstanceClient, name str
 		Timeout:    120 * time.Minute,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 
 }
 
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -14,18 +15,18 @@ import (
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMPISnapshot() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMPISnapshotCreate,
-		Read:     resourceIBMPISnapshotRead,
-		Update:   resourceIBMPISnapshotUpdate,
-		Delete:   resourceIBMPISnapshotDelete,
-		Exists:   resourceIBMPISnapshotExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMPISnapshotCreate,
+		ReadContext:   resourceIBMPISnapshotRead,
+		UpdateContext: resourceIBMPISnapshotUpdate,
+		DeleteContext: resourceIBMPISnapshotDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -97,10 +98,10 @@ func resourceIBMPISnapshot() *schema.Resource {
 	}
 }
 
-func resourceIBMPISnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPISnapshotCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
@@ -128,33 +129,42 @@ func resourceIBMPISnapshotCreate(d *schema.ResourceData, meta interface{}) error
 
 	if err != nil {
 		log.Printf("[DEBUG]  err %s", err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", powerinstanceid, *snapshotResponse.SnapshotID))
 	if err != nil {
 		log.Printf("[DEBUG]  err %s", err)
-		return fmt.Errorf("failed to get the snapshotid %v", err)
+		return diag.FromErr(fmt.Errorf("failed to get the snapshotid %v", err))
 	}
 
 	pisnapclient := st.NewIBMPISnapshotClient(sess, powerinstanceid)
-	_, err = isWaitForPIInstanceSnapshotAvailable(pisnapclient, *snapshotResponse.SnapshotID, d.Timeout(schema.TimeoutCreate), powerinstanceid)
+	_, err = isWaitForPIInstanceSnapshotAvailable(context, pisnapclient, *snapshotResponse.SnapshotID, d.Timeout(schema.TimeoutCreate), powerinstanceid)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMPISnapshotRead(d, meta)
+	return resourceIBMPISnapshotRead(context, d, meta)
 }
 
-func resourceIBMPISnapshotRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPISnapshotRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMPISnapshotExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	log.Printf("Calling the Snapshot Read function post create")
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	powerinstanceid := parts[0]
@@ -162,7 +172,7 @@ func resourceIBMPISnapshotRead(d *schema.ResourceData, meta interface{}) error {
 	snapshotdata, err := snapshot.Get(parts[1], powerinstanceid, getTimeOut)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set(helpers.PISnapshotName, snapshotdata.Name)
@@ -175,13 +185,13 @@ func resourceIBMPISnapshotRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMPISnapshotUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPISnapshotUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	log.Printf("Calling the IBM Power Snapshot  update call")
 	sess, _ := meta.(ClientSession).IBMPISession()
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	powerinstanceid := parts[0]
 	client := st.NewIBMPISnapshotClient(sess, powerinstanceid)
@@ -194,25 +204,25 @@ func resourceIBMPISnapshotUpdate(d *schema.ResourceData, meta interface{}) error
 		_, err := client.Update(parts[1], powerinstanceid, snapshotBody, 60)
 
 		if err != nil {
-			return fmt.Errorf("failed to update the snapshot request %v", err)
+			return diag.FromErr(fmt.Errorf("failed to update the snapshot request %v", err))
 
 		}
 
-		_, err = isWaitForPIInstanceSnapshotAvailable(client, parts[1], d.Timeout(schema.TimeoutCreate), powerinstanceid)
+		_, err = isWaitForPIInstanceSnapshotAvailable(context, client, parts[1], d.Timeout(schema.TimeoutCreate), powerinstanceid)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceIBMPISnapshotRead(d, meta)
+	return resourceIBMPISnapshotRead(context, d, meta)
 }
 
-func resourceIBMPISnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPISnapshotDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, _ := meta.(ClientSession).IBMPISession()
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	powerinstanceid := parts[0]
 
@@ -220,19 +230,19 @@ func resourceIBMPISnapshotDelete(d *schema.ResourceData, meta interface{}) error
 
 	snapshot, err := client.Get(parts[1], powerinstanceid, getTimeOut)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("The snapshot  to be deleted is in the following state .. %s", snapshot.Status)
 
 	snapshotdel_err := client.Delete(parts[1], powerinstanceid, deleteTimeOut)
 	if snapshotdel_err != nil {
-		return snapshotdel_err
+		return diag.FromErr(snapshotdel_err)
 	}
 
-	_, err = isWaitForPIInstanceSnapshotDeleted(client, parts[1], d.Timeout(schema.TimeoutDelete), powerinstanceid)
+	_, err = isWaitForPIInstanceSnapshotDeleted(context, client, parts[1], d.Timeout(schema.TimeoutDelete), powerinstanceid)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
@@ -268,7 +278,7 @@ func resourceIBMPISnapshotExists(d *schema.ResourceData, meta interface{}) (bool
 	return volumeid == parts[1], nil
 }
 
-func isWaitForPIInstanceSnapshotAvailable(client *st.IBMPISnapshotClient, id string, timeout time.Duration, powerinstanceid string) (interface{}, error) {
+func isWaitForPIInstanceSnapshotAvailable(context context.Context, client *st.IBMPISnapshotClient, id string, timeout time.Duration, powerinstanceid string) (interface{}, error) {
 
 	log.Printf("Waiting for PIInstance Snapshot (%s) to be available and active ", id)
 
@@ -281,7 +291,7 @@ func isWaitForPIInstanceSnapshotAvailable(client *st.IBMPISnapshotClient, id str
 		Timeout:    60 * time.Minute,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isPIInstanceSnapshotRefreshFunc(client *st.IBMPISnapshotClient, id, powerinstanceid string) resource.StateRefreshFunc {
@@ -304,7 +314,7 @@ func isPIInstanceSnapshotRefreshFunc(client *st.IBMPISnapshotClient, id, powerin
 
 // Delete Snapshot
 
-func isWaitForPIInstanceSnapshotDeleted(client *st.IBMPISnapshotClient, id string, timeout time.Duration, powerinstanceid string) (interface{}, error) {
+func isWaitForPIInstanceSnapshotDeleted(context context.Context, client *st.IBMPISnapshotClient, id string, timeout time.Duration, powerinstanceid string) (interface{}, error) {
 
 	log.Printf("Waiting for  (%s) to be deleted.", id)
 
@@ -317,7 +327,7 @@ func isWaitForPIInstanceSnapshotDeleted(client *st.IBMPISnapshotClient, id strin
 		Timeout:    10 * time.Minute,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isPIInstanceSnapshotDeleteRefreshFunc(client *st.IBMPISnapshotClient, id, powerinstanceid string) resource.StateRefreshFunc {
@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -26,12 +28,11 @@ const (
 
 func resourceIBMPIVolume() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMPIVolumeCreate,
-		Read:     resourceIBMPIVolumeRead,
-		Update:   resourceIBMPIVolumeUpdate,
-		Delete:   resourceIBMPIVolumeDelete,
-		Exists:   resourceIBMPIVolumeExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMPIVolumeCreate,
+		ReadContext:   resourceIBMPIVolumeRead,
+		UpdateContext: resourceIBMPIVolumeUpdate,
+		DeleteContext: resourceIBMPIVolumeDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -98,10 +99,10 @@ func resourceIBMPIVolume() *schema.Resource {
 	}
 }
 
-func resourceIBMPIVolumeCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIVolumeCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	name := d.Get(helpers.PIVolumeName).(string)
@@ -116,32 +117,41 @@ func resourceIBMPIVolumeCreate(d *schema.ResourceData, meta interface{}) error {
 	client := st.NewIBMPIVolumeClient(sess, powerinstanceid)
 	vol, err := client.Create(name, size, volType, shared, powerinstanceid, volPostTimeOut)
 	if err != nil {
-		return fmt.Errorf("Failed to Create the volume %v", err)
+		return diag.FromErr(fmt.Errorf("Failed to Create the volume %v", err))
 	}
 
 	volumeid := *vol.VolumeID
 	d.SetId(fmt.Sprintf("%s/%s", powerinstanceid, volumeid))
 
-	_, err = isWaitForIBMPIVolumeAvailable(client, volumeid, powerinstanceid, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForIBMPIVolumeAvailable(context, client, volumeid, powerinstanceid, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMPIVolumeRead(d, meta)
+	return resourceIBMPIVolumeRead(context, d, meta)
 }
 
-func resourceIBMPIVolumeRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIVolumeRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMPIVolumeExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess, _ := meta.(ClientSession).IBMPISession()
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	powerinstanceid := parts[0]
 	client := st.NewIBMPIVolumeClient(sess, powerinstanceid)
 
 	vol, err := client.Get(parts[1], powerinstanceid, volGetTimeOut)
 	if err != nil {
-		return fmt.Errorf("Failed to get the volume %v", err)
+		return diag.FromErr(fmt.Errorf("Failed to get the volume %v", err))
 
 	}
 	d.Set(helpers.PIVolumeName, vol.Name)
@@ -167,11 +177,11 @@ func resourceIBMPIVolumeRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMPIVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIVolumeUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, _ := meta.(ClientSession).IBMPISession()
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	powerinstanceid := parts[0]
 	client := st.NewIBMPIVolumeClient(sess, powerinstanceid)
@@ -183,33 +193,33 @@ func resourceIBMPIVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 	volrequest, err := client.Update(parts[1], name, size, shareable, powerinstanceid, volPostTimeOut)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	_, err = isWaitForIBMPIVolumeAvailable(client, *volrequest.VolumeID, powerinstanceid, d.Timeout(schema.TimeoutUpdate))
+	_, err = isWaitForIBMPIVolumeAvailable(context, client, *volrequest.VolumeID, powerinstanceid, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMPIVolumeRead(d, meta)
+	return resourceIBMPIVolumeRead(context, d, meta)
 }
 
-func resourceIBMPIVolumeDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIVolumeDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, _ := meta.(ClientSession).IBMPISession()
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	powerinstanceid := parts[0]
 
 	client := st.NewIBMPIVolumeClient(sess, powerinstanceid)
 	voldeleteErr := client.Delete(parts[1], powerinstanceid, deleteTimeOut)
 	if voldeleteErr != nil {
-		return voldeleteErr
+		return diag.FromErr(voldeleteErr)
 	}
-	_, err = isWaitForIBMPIVolumeDeleted(client, parts[1], powerinstanceid, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForIBMPIVolumeDeleted(context, client, parts[1], powerinstanceid, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return nil
@@ -244,7 +254,7 @@ func resourceIBMPIVolumeExists(d *schema.ResourceData, meta interface{}) (bool,
 	return volumeid == parts[1], nil
 }
 
-func isWaitForIBMPIVolumeAvailable(client *st.IBMPIVolumeClient, id, powerinstanceid string, timeout time.Duration) (interface{}, error) {
+func isWaitForIBMPIVolumeAvailable(context context.Context, client *st.IBMPIVolumeClient, id, powerinstanceid string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Volume (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -256,7 +266,7 @@ func isWaitForIBMPIVolumeAvailable(client *st.IBMPIVolumeClient, id, powerinstan
 		Timeout:    30 * time.Minute,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isIBMPIVolumeRefreshFunc(client *st.IBMPIVolumeClient, id, powerinstanceid string) resource.StateRefreshFunc {
@@ -274,7 +284,7 @@ func isIBMPIVolumeRefreshFunc(client *st.IBMPIVolumeClient, id, powerinstanceid
 	}
 }
 
-func isWaitForIBMPIVolumeDeleted(client *st.IBMPIVolumeClient, id, powerinstanceid string, timeout time.Duration) (interface{}, error) {
+func isWaitForIBMPIVolumeDeleted(context context.Context, client *st.IBMPIVolumeClient, id, powerinstanceid string, timeout time.Duration) (interface{}, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"deleting", helpers.PIVolumeProvisioning},
 		Target:     []string{"deleted"},
@@ -283,7 +293,7 @@ func isWaitForIBMPIVolumeDeleted(client *st.IBMPIVolumeClient, id, powerinstance
 		MinTimeout: 2 * time.Minute,
 		Timeout:    30 * time.Minute,
 	}
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isIBMPIVolumeDeleteRefreshFunc(client *st.IBMPIVolumeClient, id, powerinstanceid string) resource.StateRefreshFunc {
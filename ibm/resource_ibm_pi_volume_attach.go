@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -11,6 +12,7 @@ import (
 
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -24,10 +26,10 @@ const (
 
 func resourceIBMPIVolumeAttach() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMPIVolumeAttachCreate,
-		Read:   resourceIBMPIVolumeAttachRead,
-		Update: resourceIBMPIVolumeAttachUpdate,
-		Delete: resourceIBMPIVolumeAttachDelete,
+		CreateContext: resourceIBMPIVolumeAttachCreate,
+		ReadContext:   resourceIBMPIVolumeAttachRead,
+		UpdateContext: resourceIBMPIVolumeAttachUpdate,
+		DeleteContext: resourceIBMPIVolumeAttachDelete,
 		//Exists:   resourceIBMPowerVolumeExists,
 		Importer: &schema.ResourceImporter{},
 
@@ -78,10 +80,10 @@ func resourceIBMPIVolumeAttach() *schema.Resource {
 	}
 }
 
-func resourceIBMPIVolumeAttachCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIVolumeAttachCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	name := d.Get(helpers.PIVolumeAttachName).(string)
@@ -93,7 +95,7 @@ func resourceIBMPIVolumeAttachCreate(d *schema.ResourceData, meta interface{}) e
 	volinfo, err := client.Get(name, powerinstanceid, getTimeOut)
 
 	if err != nil {
-		return fmt.Errorf("The volume [ %s] cannot be attached since it's not available", name)
+		return diag.FromErr(fmt.Errorf("The volume [ %s] cannot be attached since it's not available", name))
 	}
 	//log.Print("The volume info is %s", volinfo)
 
@@ -108,31 +110,31 @@ func resourceIBMPIVolumeAttachCreate(d *schema.ResourceData, meta interface{}) e
 
 	if volinfo.State == helpers.PIVolumeAllowableAttachStatus && *volinfo.Shareable == false {
 
-		return errors.New("The volume cannot be attached in the current state. The volume must be in the *available* state. No other states are permissible")
+		return diag.FromErr(errors.New("The volume cannot be attached in the current state. The volume must be in the *available* state. No other states are permissible"))
 	}
 
 	resp, err := client.Attach(servername, name, powerinstanceid, attachVolumeTimeOut)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	log.Printf("Printing the resp %+v", resp)
 
 	d.SetId(*volinfo.VolumeID)
 	if err != nil {
 		log.Printf("[DEBUG]  err %s", err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	_, err = isWaitForIBMPIVolumeAttachAvailable(client, d.Id(), powerinstanceid, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForIBMPIVolumeAttachAvailable(context, client, d.Id(), powerinstanceid, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	//return nil
-	return resourceIBMPIVolumeAttachRead(d, meta)
+	return resourceIBMPIVolumeAttachRead(context, d, meta)
 }
 
-func resourceIBMPIVolumeAttachRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIVolumeAttachRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, _ := meta.(ClientSession).IBMPISession()
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
 	servername := d.Get(helpers.PIInstanceName).(string)
@@ -141,7 +143,7 @@ func resourceIBMPIVolumeAttachRead(d *schema.ResourceData, meta interface{}) err
 
 	vol, err := client.CheckVolumeAttach(powerinstanceid, servername, d.Id(), getTimeOut)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	//d.SetId(vol.ID.String())
@@ -151,7 +153,7 @@ func resourceIBMPIVolumeAttachRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceIBMPIVolumeAttachUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIVolumeAttachUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, _ := meta.(ClientSession).IBMPISession()
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
@@ -167,18 +169,18 @@ func resourceIBMPIVolumeAttachUpdate(d *schema.ResourceData, meta interface{}) e
 
 	volrequest, err := client.Update(d.Id(), name, size, shareable, powerinstanceid, postTimeOut)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	_, err = isWaitForIBMPIVolumeAttachAvailable(client, *volrequest.VolumeID, powerinstanceid, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForIBMPIVolumeAttachAvailable(context, client, *volrequest.VolumeID, powerinstanceid, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMPIVolumeRead(d, meta)
+	return resourceIBMPIVolumeRead(context, d, meta)
 }
 
-func resourceIBMPIVolumeAttachDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIVolumeAttachDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, _ := meta.(ClientSession).IBMPISession()
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
@@ -189,7 +191,7 @@ func resourceIBMPIVolumeAttachDelete(d *schema.ResourceData, meta interface{}) e
 	log.Printf("the id of the volume to detach is%s ", d.Id())
 	_, err := client.Detach(servername, name, powerinstanceid, deleteTimeOut)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// wait for power volume states to be back as available. if it's attached it will be in-use
@@ -197,7 +199,7 @@ func resourceIBMPIVolumeAttachDelete(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func isWaitForIBMPIVolumeAttachAvailable(client *st.IBMPIVolumeClient, id, powerinstanceid string, timeout time.Duration) (interface{}, error) {
+func isWaitForIBMPIVolumeAttachAvailable(context context.Context, client *st.IBMPIVolumeClient, id, powerinstanceid string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Volume (%s) to be available for attachment", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -209,7 +211,7 @@ func isWaitForIBMPIVolumeAttachAvailable(client *st.IBMPIVolumeClient, id, power
 		Timeout:    10 * time.Minute,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isIBMPIVolumeAttachRefreshFunc(client *st.IBMPIVolumeClient, id, powerinstanceid string) resource.StateRefreshFunc {
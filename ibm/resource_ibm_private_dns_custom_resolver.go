@@ -0,0 +1,373 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	dns "github.com/IBM/networking-go-sdk/dnssvcsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	pdnsCustomResolverID                = "custom_resolver_id"
+	pdnsCustomResolverName              = "name"
+	pdnsCustomResolverDescription       = "description"
+	pdnsCustomResolverEnabled           = "enabled"
+	pdnsCustomResolverHealth            = "health"
+	pdnsCustomResolverLocations         = "locations"
+	pdnsCustomResolverLocationID        = "location_id"
+	pdnsCustomResolverLocationSubnetCrn = "subnet_crn"
+	pdnsCustomResolverLocationEnabled   = "enabled"
+	pdnsCustomResolverLocationHealthy   = "healthy"
+	pdnsCustomResolverLocationServerIP  = "dns_server_ip"
+	pdnsCustomResolverCreatedOn         = "created_on"
+	pdnsCustomResolverModifiedOn        = "modified_on"
+)
+
+func resourceIBMPrivateDNSCustomResolver() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPrivateDNSCustomResolverCreate,
+		ReadContext:   resourceIBMPrivateDNSCustomResolverRead,
+		UpdateContext: resourceIBMPrivateDNSCustomResolverUpdate,
+		DeleteContext: resourceIBMPrivateDNSCustomResolverDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			pdnsInstanceID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Instance Id",
+			},
+
+			pdnsCustomResolverID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Custom resolver Id",
+			},
+
+			pdnsCustomResolverName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Custom resolver name",
+			},
+
+			pdnsCustomResolverDescription: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Descriptive text of the custom resolver",
+			},
+
+			pdnsCustomResolverEnabled: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the custom resolver is enabled, which requires an enabled location",
+			},
+
+			pdnsCustomResolverLocations: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Subnets the custom resolver has a DNS server in",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						pdnsCustomResolverLocationSubnetCrn: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "CRN of the subnet",
+						},
+						pdnsCustomResolverLocationEnabled: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether the location is enabled",
+						},
+						pdnsCustomResolverLocationID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Location Id",
+						},
+						pdnsCustomResolverLocationHealthy: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the DNS server of the location is healthy",
+						},
+						pdnsCustomResolverLocationServerIP: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IP address of the DNS server of the location",
+						},
+					},
+				},
+			},
+
+			pdnsCustomResolverHealth: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Health of the custom resolver, CRITICAL, DEGRADED or HEALTHY",
+			},
+
+			pdnsCustomResolverCreatedOn: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Custom resolver creation date",
+			},
+
+			pdnsCustomResolverModifiedOn: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Custom resolver modification date",
+			},
+		},
+	}
+}
+
+// expandPDNSCustomResolverLocations returns the locations of the configuration by
+// subnet CRN, in the order of the configuration.
+func expandPDNSCustomResolverLocations(l []interface{}) ([]string, map[string]dnsCustomResolverLocation) {
+	subnets := make([]string, 0, len(l))
+	locations := make(map[string]dnsCustomResolverLocation, len(l))
+	for _, v := range l {
+		m := v.(map[string]interface{})
+		location := dnsCustomResolverLocation{
+			SubnetCrn: m[pdnsCustomResolverLocationSubnetCrn].(string),
+			Enabled:   m[pdnsCustomResolverLocationEnabled].(bool),
+		}
+		if id, ok := m[pdnsCustomResolverLocationID].(string); ok {
+			location.ID = id
+		}
+		if _, ok := locations[location.SubnetCrn]; !ok {
+			subnets = append(subnets, location.SubnetCrn)
+		}
+		locations[location.SubnetCrn] = location
+	}
+	return subnets, locations
+}
+
+// flattenPDNSCustomResolverLocations returns the locations of the custom resolver in
+// the order of the subnets of the configuration, followed by the other locations, so
+// adding a location in the middle of the configuration doesn't cause a diff.
+func flattenPDNSCustomResolverLocations(locations []dnsCustomResolverLocation, subnets []string) []map[string]interface{} {
+	order := make(map[string]int, len(subnets))
+	for i, subnet := range subnets {
+		order[subnet] = i
+	}
+	sorted := make([]dnsCustomResolverLocation, 0, len(locations))
+	sorted = append(sorted, locations...)
+	position := func(l dnsCustomResolverLocation) int {
+		if i, ok := order[l.SubnetCrn]; ok {
+			return i
+		}
+		return len(subnets)
+	}
+	sort.SliceStable(sorted, func(i, j int) bool { return position(sorted[i]) < position(sorted[j]) })
+
+	result := make([]map[string]interface{}, 0, len(sorted))
+	for _, l := range sorted {
+		result = append(result, map[string]interface{}{
+			pdnsCustomResolverLocationID:        l.ID,
+			pdnsCustomResolverLocationSubnetCrn: l.SubnetCrn,
+			pdnsCustomResolverLocationEnabled:   l.Enabled,
+			pdnsCustomResolverLocationHealthy:   l.Healthy,
+			pdnsCustomResolverLocationServerIP:  l.DNSServerIP,
+		})
+	}
+	return result
+}
+
+func resourceIBMPrivateDNSCustomResolverCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceID := d.Get(pdnsInstanceID).(string)
+	resolver := dnsCustomResolver{
+		Name:        d.Get(pdnsCustomResolverName).(string),
+		Description: d.Get(pdnsCustomResolverDescription).(string),
+		Locations:   []dnsCustomResolverLocation{},
+	}
+	subnets, locations := expandPDNSCustomResolverLocations(d.Get(pdnsCustomResolverLocations).([]interface{}))
+	for _, subnet := range subnets {
+		resolver.Locations = append(resolver.Locations, locations[subnet])
+	}
+	result, response, err := createDNSCustomResolver(sess, instanceID, resolver)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error creating pdns custom resolver:%s\n%s", err, response))
+	}
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, result.ID))
+
+	// A custom resolver is created disabled, and enabled once it has its locations
+	if d.Get(pdnsCustomResolverEnabled).(bool) {
+		patch := map[string]interface{}{pdnsCustomResolverEnabled: true}
+		if _, response, err := updateDNSCustomResolver(sess, instanceID, result.ID, patch); err != nil {
+			return diag.FromErr(fmt.Errorf("Error enabling pdns custom resolver %s:%s\n%s", result.ID, err, response))
+		}
+	}
+
+	return resourceIBMPrivateDNSCustomResolverRead(context, d, meta)
+}
+
+func resourceIBMPrivateDNSCustomResolverRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	idSet := strings.Split(d.Id(), "/")
+	if len(idSet) != 2 {
+		return diag.Errorf("Incorrect ID %s: the ID must be in the form instance_id/custom_resolver_id", d.Id())
+	}
+	resolver, response, err := getDNSCustomResolver(sess, idSet[0], idSet[1])
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("pdns custom resolver %s is not found", idSet[1])
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("Error reading pdns custom resolver:%s\n%s", err, response))
+	}
+
+	subnets, _ := expandPDNSCustomResolverLocations(d.Get(pdnsCustomResolverLocations).([]interface{}))
+	d.Set(pdnsInstanceID, idSet[0])
+	d.Set(pdnsCustomResolverID, resolver.ID)
+	d.Set(pdnsCustomResolverName, resolver.Name)
+	d.Set(pdnsCustomResolverDescription, resolver.Description)
+	d.Set(pdnsCustomResolverEnabled, resolver.Enabled)
+	d.Set(pdnsCustomResolverHealth, resolver.Health)
+	d.Set(pdnsCustomResolverLocations, flattenPDNSCustomResolverLocations(resolver.Locations, subnets))
+	d.Set(pdnsCustomResolverCreatedOn, resolver.CreatedOn)
+	d.Set(pdnsCustomResolverModifiedOn, resolver.ModifiedOn)
+
+	return nil
+}
+
+func resourceIBMPrivateDNSCustomResolverUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	idSet := strings.Split(d.Id(), "/")
+	instanceID, resolverID := idSet[0], idSet[1]
+	enabled := d.Get(pdnsCustomResolverEnabled).(bool)
+
+	// A custom resolver must keep an enabled location while it is enabled, so it is
+	// disabled before its locations change and enabled after
+	patch := map[string]interface{}{}
+	if d.HasChange(pdnsCustomResolverName) {
+		patch[pdnsCustomResolverName] = d.Get(pdnsCustomResolverName).(string)
+	}
+	if d.HasChange(pdnsCustomResolverDescription) {
+		patch[pdnsCustomResolverDescription] = d.Get(pdnsCustomResolverDescription).(string)
+	}
+	if d.HasChange(pdnsCustomResolverEnabled) && !enabled {
+		patch[pdnsCustomResolverEnabled] = false
+	}
+	if len(patch) > 0 {
+		if _, response, err := updateDNSCustomResolver(sess, instanceID, resolverID, patch); err != nil {
+			return diag.FromErr(fmt.Errorf("Error updating pdns custom resolver %s:%s\n%s", resolverID, err, response))
+		}
+	}
+
+	if d.HasChange(pdnsCustomResolverLocations) {
+		if err := updatePDNSCustomResolverLocations(d, sess, instanceID, resolverID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange(pdnsCustomResolverEnabled) && enabled {
+		patch := map[string]interface{}{pdnsCustomResolverEnabled: true}
+		if _, response, err := updateDNSCustomResolver(sess, instanceID, resolverID, patch); err != nil {
+			return diag.FromErr(fmt.Errorf("Error enabling pdns custom resolver %s:%s\n%s", resolverID, err, response))
+		}
+	}
+
+	return resourceIBMPrivateDNSCustomResolverRead(context, d, meta)
+}
+
+// updatePDNSCustomResolverLocations adds the new locations and enables the locations
+// before it disables the others and deletes the removed ones, so an enabled custom
+// resolver keeps an enabled location.
+func updatePDNSCustomResolverLocations(d *schema.ResourceData, client *dns.DnsSvcsV1, instanceID, resolverID string) error {
+	o, n := d.GetChange(pdnsCustomResolverLocations)
+	oldSubnets, oldLocations := expandPDNSCustomResolverLocations(o.([]interface{}))
+	newSubnets, newLocations := expandPDNSCustomResolverLocations(n.([]interface{}))
+
+	for _, subnet := range newSubnets {
+		location := newLocations[subnet]
+		old, ok := oldLocations[subnet]
+		switch {
+		case !ok:
+			if _, response, err := addDNSCustomResolverLocation(client, instanceID, resolverID, location); err != nil {
+				return fmt.Errorf("Error adding location %s to pdns custom resolver %s:%s\n%s", subnet, resolverID, err, response)
+			}
+		case location.Enabled && !old.Enabled:
+			if response, err := updateDNSCustomResolverLocation(client, instanceID, resolverID, old.ID, true); err != nil {
+				return fmt.Errorf("Error enabling location %s of pdns custom resolver %s:%s\n%s", old.ID, resolverID, err, response)
+			}
+		}
+	}
+
+	for _, subnet := range newSubnets {
+		location := newLocations[subnet]
+		if old, ok := oldLocations[subnet]; ok && !location.Enabled && old.Enabled {
+			if response, err := updateDNSCustomResolverLocation(client, instanceID, resolverID, old.ID, false); err != nil {
+				return fmt.Errorf("Error disabling location %s of pdns custom resolver %s:%s\n%s", old.ID, resolverID, err, response)
+			}
+		}
+	}
+
+	for _, subnet := range oldSubnets {
+		if _, ok := newLocations[subnet]; ok {
+			continue
+		}
+		old := oldLocations[subnet]
+		if old.Enabled {
+			if response, err := updateDNSCustomResolverLocation(client, instanceID, resolverID, old.ID, false); err != nil {
+				return fmt.Errorf("Error disabling location %s of pdns custom resolver %s:%s\n%s", old.ID, resolverID, err, response)
+			}
+		}
+		if response, err := deleteDNSCustomResolverLocation(client, instanceID, resolverID, old.ID); err != nil {
+			return fmt.Errorf("Error deleting location %s of pdns custom resolver %s:%s\n%s", old.ID, resolverID, err, response)
+		}
+	}
+	return nil
+}
+
+func resourceIBMPrivateDNSCustomResolverDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	idSet := strings.Split(d.Id(), "/")
+	instanceID, resolverID := idSet[0], idSet[1]
+
+	// A custom resolver must be disabled before it is deleted
+	if d.Get(pdnsCustomResolverEnabled).(bool) {
+		patch := map[string]interface{}{pdnsCustomResolverEnabled: false}
+		_, response, err := updateDNSCustomResolver(sess, instanceID, resolverID, patch)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				d.SetId("")
+				return nil
+			}
+			return diag.FromErr(fmt.Errorf("Error disabling pdns custom resolver %s:%s\n%s", resolverID, err, response))
+		}
+	}
+	response, err := deleteDNSCustomResolver(sess, instanceID, resolverID)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return diag.FromErr(fmt.Errorf("Error deleting pdns custom resolver %s:%s\n%s", resolverID, err, response))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,213 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	pdnsResolverID                = "resolver_id"
+	pdnsForwardingRuleID          = "rule_id"
+	pdnsForwardingRuleType        = "type"
+	pdnsForwardingRuleMatch       = "match"
+	pdnsForwardingRuleForwardTo   = "forward_to"
+	pdnsForwardingRuleDescription = "description"
+	pdnsForwardingRuleCreatedOn   = "created_on"
+	pdnsForwardingRuleModifiedOn  = "modified_on"
+	pdnsForwardingRuleTypeZone    = "zone"
+)
+
+func resourceIBMPrivateDNSCustomResolverForwardingRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPrivateDNSCustomResolverForwardingRuleCreate,
+		ReadContext:   resourceIBMPrivateDNSCustomResolverForwardingRuleRead,
+		UpdateContext: resourceIBMPrivateDNSCustomResolverForwardingRuleUpdate,
+		DeleteContext: resourceIBMPrivateDNSCustomResolverForwardingRuleDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			pdnsInstanceID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Instance Id",
+			},
+
+			pdnsResolverID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Custom resolver Id",
+			},
+
+			pdnsForwardingRuleID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Forwarding rule Id",
+			},
+
+			pdnsForwardingRuleMatch: {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressPDNSForwardingRuleMatchDiff,
+				Description:      "Zone whose names the queries are forwarded for, such as corp.example.com",
+			},
+
+			pdnsForwardingRuleForwardTo: {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateIP},
+				Description: "IP addresses of the DNS servers the queries are forwarded to",
+			},
+
+			pdnsForwardingRuleDescription: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Descriptive text of the forwarding rule",
+			},
+
+			pdnsForwardingRuleType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the forwarding rule",
+			},
+
+			pdnsForwardingRuleCreatedOn: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Forwarding rule creation date",
+			},
+
+			pdnsForwardingRuleModifiedOn: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Forwarding rule modification date",
+			},
+		},
+	}
+}
+
+// suppressPDNSForwardingRuleMatchDiff ignores the case and the trailing dot of the
+// zone, which the API doesn't tell apart.
+func suppressPDNSForwardingRuleMatchDiff(k, old, new string, d *schema.ResourceData) bool {
+	return strings.EqualFold(strings.TrimSuffix(old, "."), strings.TrimSuffix(new, "."))
+}
+
+func resourceIBMPrivateDNSCustomResolverForwardingRuleCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceID := d.Get(pdnsInstanceID).(string)
+	resolverID := d.Get(pdnsResolverID).(string)
+	mk := "private_dns_forwarding_rule_" + instanceID + resolverID
+	ibmMutexKV.Lock(mk)
+	defer ibmMutexKV.Unlock(mk)
+
+	rule := dnsForwardingRule{
+		Type:        pdnsForwardingRuleTypeZone,
+		Match:       d.Get(pdnsForwardingRuleMatch).(string),
+		ForwardTo:   expandStringList(d.Get(pdnsForwardingRuleForwardTo).([]interface{})),
+		Description: d.Get(pdnsForwardingRuleDescription).(string),
+	}
+	result, response, err := createDNSForwardingRule(sess, instanceID, resolverID, rule)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error creating pdns forwarding rule:%s\n%s", err, response))
+	}
+	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, resolverID, result.ID))
+
+	return resourceIBMPrivateDNSCustomResolverForwardingRuleRead(context, d, meta)
+}
+
+func resourceIBMPrivateDNSCustomResolverForwardingRuleRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	idSet := strings.Split(d.Id(), "/")
+	if len(idSet) != 3 {
+		return diag.Errorf("Incorrect ID %s: the ID must be in the form instance_id/resolver_id/rule_id", d.Id())
+	}
+	rule, response, err := getDNSForwardingRule(sess, idSet[0], idSet[1], idSet[2])
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("pdns forwarding rule %s is not found", idSet[2])
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("Error reading pdns forwarding rule:%s\n%s", err, response))
+	}
+
+	d.Set(pdnsInstanceID, idSet[0])
+	d.Set(pdnsResolverID, idSet[1])
+	d.Set(pdnsForwardingRuleID, rule.ID)
+	d.Set(pdnsForwardingRuleType, rule.Type)
+	d.Set(pdnsForwardingRuleMatch, rule.Match)
+	d.Set(pdnsForwardingRuleForwardTo, rule.ForwardTo)
+	d.Set(pdnsForwardingRuleDescription, rule.Description)
+	d.Set(pdnsForwardingRuleCreatedOn, rule.CreatedOn)
+	d.Set(pdnsForwardingRuleModifiedOn, rule.ModifiedOn)
+
+	return nil
+}
+
+func resourceIBMPrivateDNSCustomResolverForwardingRuleUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	idSet := strings.Split(d.Id(), "/")
+	mk := "private_dns_forwarding_rule_" + idSet[0] + idSet[1]
+	ibmMutexKV.Lock(mk)
+	defer ibmMutexKV.Unlock(mk)
+
+	patch := map[string]interface{}{}
+	if d.HasChange(pdnsForwardingRuleMatch) {
+		patch[pdnsForwardingRuleMatch] = d.Get(pdnsForwardingRuleMatch).(string)
+	}
+	if d.HasChange(pdnsForwardingRuleForwardTo) {
+		patch[pdnsForwardingRuleForwardTo] = expandStringList(d.Get(pdnsForwardingRuleForwardTo).([]interface{}))
+	}
+	if d.HasChange(pdnsForwardingRuleDescription) {
+		patch[pdnsForwardingRuleDescription] = d.Get(pdnsForwardingRuleDescription).(string)
+	}
+	if len(patch) > 0 {
+		if _, response, err := updateDNSForwardingRule(sess, idSet[0], idSet[1], idSet[2], patch); err != nil {
+			return diag.FromErr(fmt.Errorf("Error updating pdns forwarding rule %s:%s\n%s", idSet[2], err, response))
+		}
+	}
+
+	return resourceIBMPrivateDNSCustomResolverForwardingRuleRead(context, d, meta)
+}
+
+func resourceIBMPrivateDNSCustomResolverForwardingRuleDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	idSet := strings.Split(d.Id(), "/")
+	mk := "private_dns_forwarding_rule_" + idSet[0] + idSet[1]
+	ibmMutexKV.Lock(mk)
+	defer ibmMutexKV.Unlock(mk)
+
+	response, err := deleteDNSForwardingRule(sess, idSet[0], idSet[1], idSet[2])
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return diag.FromErr(fmt.Errorf("Error deleting pdns forwarding rule %s:%s\n%s", idSet[2], err, response))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
+)
+
+func TestAccIBMPrivateDNSCustomResolverForwardingRule_Basic(t *testing.T) {
+	node := "ibm_dns_custom_resolver_forwarding_rule.test-pdns-cr-fr"
+	name := fmt.Sprintf("tf-pdns-cr-%d", acctest.RandIntRange(100, 200))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMPrivateDNSCustomResolverForwardingRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPrivateDNSCustomResolverForwardingRuleConfig(name, `"10.0.0.53"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(node, "rule_id"),
+					resource.TestCheckResourceAttr(node, "type", "zone"),
+					resource.TestCheckResourceAttr(node, "match", "corp.example.com"),
+					resource.TestCheckResourceAttr(node, "forward_to.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPrivateDNSCustomResolverForwardingRuleConfig(name, `"10.0.0.53", "10.0.1.53"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(node, "forward_to.#", "2"),
+					resource.TestCheckResourceAttr(node, "forward_to.1", "10.0.1.53"),
+				),
+			},
+			{
+				ResourceName:      node,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPrivateDNSCustomResolverForwardingRuleConfig(name, forwardTo string) string {
+	return testAccCheckIBMPrivateDNSCustomResolverConfig(name, "description", false, false) + fmt.Sprintf(`
+	resource "ibm_dns_custom_resolver_forwarding_rule" "test-pdns-cr-fr" {
+		instance_id = ibm_resource_instance.test-pdns-cr-instance.guid
+		resolver_id = ibm_dns_custom_resolver.test-pdns-cr.custom_resolver_id
+		match       = "corp.example.com"
+		forward_to  = [%s]
+		description = "on-prem DNS"
+	}
+	`, forwardTo)
+}
+
+func testAccCheckIBMPrivateDNSCustomResolverForwardingRuleDestroy(s *terraform.State) error {
+	pdnsClient, err := testAccProvider.Meta().(ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_dns_custom_resolver_forwarding_rule" {
+			continue
+		}
+		idSet := strings.Split(rs.Primary.ID, "/")
+		if _, _, err := getDNSForwardingRule(pdnsClient, idSet[0], idSet[1], idSet[2]); err == nil {
+			return fmt.Errorf("Forwarding rule still exists: %s", rs.Primary.ID)
+		}
+	}
+	return testAccCheckIBMPrivateDNSCustomResolverDestroy(s)
+}
+
+func TestIBMPrivateDNSCustomResolverForwardingRuleMock(t *testing.T) {
+	t.Parallel()
+	srv := testMockServer(t)
+	meta := testMockClientSession(t, srv)
+	r := resourceIBMPrivateDNSCustomResolverForwardingRule()
+
+	resolver := resourceIBMPrivateDNSCustomResolver()
+	resolverData := schema.TestResourceDataRaw(t, resolver.Schema, map[string]interface{}{
+		"instance_id": "instance-1",
+		"name":        "hybrid",
+	})
+	resolverData.MarkNewResource()
+	assert.NilError(t, testDiagsErr(resolver.CreateContext(context.Background(), resolverData, meta)))
+	resolverID := resolverData.Get("custom_resolver_id").(string)
+
+	raw := map[string]interface{}{
+		"instance_id": "instance-1",
+		"resolver_id": resolverID,
+		"match":       "Corp.Example.com.",
+		"forward_to":  []interface{}{"10.0.0.53"},
+		"description": "on-prem DNS",
+	}
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	d.MarkNewResource()
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), d, meta)))
+	ruleID := d.Get("rule_id").(string)
+	assert.Equal(t, d.Id(), "instance-1/"+resolverID+"/"+ruleID)
+	assert.Equal(t, d.Get("type"), "zone")
+	assert.Equal(t, d.Get("match"), "corp.example.com")
+	// The API normalizes the zone, which doesn't cause a diff
+	assert.Assert(t, suppressPDNSForwardingRuleMatchDiff("match", "corp.example.com", "Corp.Example.com.", d))
+
+	duplicate := schema.TestResourceDataRaw(t, r.Schema, raw)
+	duplicate.MarkNewResource()
+	err := testDiagsErr(r.CreateContext(context.Background(), duplicate, meta))
+	assert.ErrorContains(t, err, "Error creating pdns forwarding rule")
+	assert.ErrorContains(t, err, "already matches corp.example.com")
+
+	raw["forward_to"] = []interface{}{"10.0.0.53", "10.0.1.53"}
+	raw["description"] = "on-prem DNS servers"
+	updated := testMockResourceData(t, r, d, raw)
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), updated, meta)))
+	assert.Equal(t, updated.Get("forward_to.#"), 2)
+	assert.Equal(t, updated.Get("forward_to.1"), "10.0.1.53")
+	assert.Equal(t, updated.Get("description"), "on-prem DNS servers")
+
+	// The data source lists the default rule with the zone rules
+	ds := dataSourceIBMPrivateDNSCustomResolverForwardingRules()
+	data := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{
+		"instance_id": "instance-1",
+		"resolver_id": resolverID,
+	})
+	assert.NilError(t, ds.Read(data, meta))
+	assert.Equal(t, data.Get("forwarding_rules.#"), 2)
+	types := []string{data.Get("forwarding_rules.0.type").(string), data.Get("forwarding_rules.1.type").(string)}
+	assert.Assert(t, (types[0] == "default" && types[1] == "zone") || (types[0] == "zone" && types[1] == "default"))
+
+	imported := r.Data(nil)
+	imported.SetId(d.Id())
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), imported, meta)))
+	assert.Equal(t, imported.Get("resolver_id"), resolverID)
+	assert.Equal(t, imported.Get("forward_to.#"), 2)
+
+	assert.NilError(t, testDiagsErr(r.DeleteContext(context.Background(), updated, meta)))
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), imported, meta)))
+	assert.Equal(t, imported.Id(), "")
+	assert.NilError(t, testDiagsErr(resolver.DeleteContext(context.Background(), resolverData, meta)))
+}
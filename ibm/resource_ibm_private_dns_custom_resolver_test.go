@@ -0,0 +1,224 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
+)
+
+func TestAccIBMPrivateDNSCustomResolver_Basic(t *testing.T) {
+	node := "ibm_dns_custom_resolver.test-pdns-cr"
+	name := fmt.Sprintf("tf-pdns-cr-%d", acctest.RandIntRange(100, 200))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMPrivateDNSCustomResolverDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPrivateDNSCustomResolverConfig(name, "description", false, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(node, "custom_resolver_id"),
+					resource.TestCheckResourceAttr(node, "name", name),
+					resource.TestCheckResourceAttr(node, "enabled", "false"),
+					resource.TestCheckResourceAttr(node, "locations.#", "1"),
+					resource.TestCheckResourceAttrSet(node, "locations.0.location_id"),
+					resource.TestCheckResourceAttrSet(node, "locations.0.dns_server_ip"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPrivateDNSCustomResolverConfig(name, "updated description", true, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(node, "description", "updated description"),
+					resource.TestCheckResourceAttr(node, "enabled", "true"),
+					resource.TestCheckResourceAttr(node, "locations.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPrivateDNSCustomResolverConfig(name, "updated description", false, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(node, "enabled", "false"),
+					resource.TestCheckResourceAttr(node, "locations.#", "1"),
+				),
+			},
+			{
+				ResourceName:      node,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPrivateDNSCustomResolverBase(name string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "rg" {
+		is_default = true
+	}
+	resource "ibm_is_vpc" "test-pdns-cr-vpc" {
+		name           = "%[1]s-vpc"
+		resource_group = data.ibm_resource_group.rg.id
+	}
+	resource "ibm_is_subnet" "test-pdns-cr-subnet1" {
+		name            = "%[1]s-subnet1"
+		vpc             = ibm_is_vpc.test-pdns-cr-vpc.id
+		zone            = "us-south-1"
+		ipv4_cidr_block = "10.240.0.0/24"
+		resource_group  = data.ibm_resource_group.rg.id
+	}
+	resource "ibm_is_subnet" "test-pdns-cr-subnet2" {
+		name            = "%[1]s-subnet2"
+		vpc             = ibm_is_vpc.test-pdns-cr-vpc.id
+		zone            = "us-south-2"
+		ipv4_cidr_block = "10.240.64.0/24"
+		resource_group  = data.ibm_resource_group.rg.id
+	}
+	resource "ibm_resource_instance" "test-pdns-cr-instance" {
+		name              = "%[1]s-instance"
+		resource_group_id = data.ibm_resource_group.rg.id
+		location          = "global"
+		service           = "dns-svcs"
+		plan              = "standard-dns"
+	}
+	`, name)
+}
+
+func testAccCheckIBMPrivateDNSCustomResolverConfig(name, description string, enabled, secondLocation bool) string {
+	location := ""
+	if secondLocation {
+		location = `
+		locations {
+			subnet_crn = ibm_is_subnet.test-pdns-cr-subnet2.crn
+		}`
+	}
+	return testAccCheckIBMPrivateDNSCustomResolverBase(name) + fmt.Sprintf(`
+	resource "ibm_dns_custom_resolver" "test-pdns-cr" {
+		name        = "%s"
+		instance_id = ibm_resource_instance.test-pdns-cr-instance.guid
+		description = "%s"
+		enabled     = %t
+		locations {
+			subnet_crn = ibm_is_subnet.test-pdns-cr-subnet1.crn
+		}%s
+	}
+	`, name, description, enabled, location)
+}
+
+func testAccCheckIBMPrivateDNSCustomResolverDestroy(s *terraform.State) error {
+	pdnsClient, err := testAccProvider.Meta().(ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_dns_custom_resolver" {
+			continue
+		}
+		idSet := strings.Split(rs.Primary.ID, "/")
+		if _, _, err := getDNSCustomResolver(pdnsClient, idSet[0], idSet[1]); err == nil {
+			return fmt.Errorf("Custom resolver still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func TestIBMPrivateDNSCustomResolverMock(t *testing.T) {
+	t.Parallel()
+	srv := testMockServer(t)
+	meta := testMockClientSession(t, srv)
+	r := resourceIBMPrivateDNSCustomResolver()
+	subnet := func(name string) string {
+		return "crn:v1:bluemix:public:is:us-south-1:a/mockserver::subnet:" + name
+	}
+
+	raw := map[string]interface{}{
+		"instance_id": "instance-1",
+		"name":        "hybrid",
+		"enabled":     true,
+		"locations": []interface{}{
+			map[string]interface{}{"subnet_crn": subnet("a")},
+			map[string]interface{}{"subnet_crn": subnet("b"), "enabled": false},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	d.MarkNewResource()
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), d, meta)))
+	resolverID := d.Get("custom_resolver_id").(string)
+	assert.Equal(t, d.Id(), "instance-1/"+resolverID)
+	// The custom resolver is enabled once it is created with its locations
+	assert.Equal(t, d.Get("enabled"), true)
+	assert.Equal(t, d.Get("health"), "DEGRADED")
+	assert.Equal(t, d.Get("locations.#"), 2)
+	assert.Equal(t, d.Get("locations.1.enabled"), false)
+	assert.Assert(t, d.Get("locations.0.location_id").(string) != "")
+	assert.Assert(t, d.Get("locations.0.dns_server_ip").(string) != "")
+
+	// A location is added in the middle of the list and a disabled one is removed
+	raw["name"] = "hybrid-dns"
+	raw["locations"] = []interface{}{
+		map[string]interface{}{"subnet_crn": subnet("c")},
+		map[string]interface{}{"subnet_crn": subnet("a")},
+	}
+	updated := testMockResourceData(t, r, d, raw)
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), updated, meta)))
+	assert.Equal(t, updated.Get("name"), "hybrid-dns")
+	assert.Equal(t, updated.Get("health"), "HEALTHY")
+	assert.Equal(t, updated.Get("locations.#"), 2)
+	assert.Equal(t, updated.Get("locations.0.subnet_crn"), subnet("c"))
+	assert.Equal(t, updated.Get("locations.1.subnet_crn"), subnet("a"))
+	assert.Equal(t, updated.Get("locations.1.location_id"), d.Get("locations.0.location_id"))
+
+	// Disabling the custom resolver first lets all its locations be disabled
+	raw["enabled"] = false
+	raw["locations"] = []interface{}{
+		map[string]interface{}{"subnet_crn": subnet("c"), "enabled": false},
+		map[string]interface{}{"subnet_crn": subnet("a"), "enabled": false},
+	}
+	disabled := testMockResourceData(t, r, updated, raw)
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), disabled, meta)))
+	assert.Equal(t, disabled.Get("enabled"), false)
+	assert.Equal(t, disabled.Get("health"), "CRITICAL")
+
+	raw["enabled"] = true
+	invalid := testMockResourceData(t, r, disabled, raw)
+	err := testDiagsErr(r.UpdateContext(context.Background(), invalid, meta))
+	assert.ErrorContains(t, err, "Error enabling pdns custom resolver "+resolverID)
+	assert.ErrorContains(t, err, "has no enabled location")
+
+	// The locations are enabled before the custom resolver is
+	raw["locations"] = []interface{}{
+		map[string]interface{}{"subnet_crn": subnet("c"), "enabled": false},
+		map[string]interface{}{"subnet_crn": subnet("a")},
+	}
+	enabled := testMockResourceData(t, r, disabled, raw)
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), enabled, meta)))
+	assert.Equal(t, enabled.Get("enabled"), true)
+	assert.Equal(t, enabled.Get("health"), "DEGRADED")
+
+	imported := r.Data(nil)
+	imported.SetId(d.Id())
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), imported, meta)))
+	assert.Equal(t, imported.Get("instance_id"), "instance-1")
+	assert.Equal(t, imported.Get("name"), "hybrid-dns")
+	assert.Equal(t, imported.Get("locations.#"), 2)
+
+	ds := dataSourceIBMPrivateDNSCustomResolvers()
+	data := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{"instance_id": "instance-1"})
+	assert.NilError(t, ds.Read(data, meta))
+	assert.Equal(t, data.Get("custom_resolvers.#"), 1)
+	assert.Equal(t, data.Get("custom_resolvers.0.custom_resolver_id"), resolverID)
+	assert.Equal(t, data.Get("custom_resolvers.0.locations.#"), 2)
+
+	// An enabled custom resolver is disabled before it is deleted
+	assert.NilError(t, testDiagsErr(r.DeleteContext(context.Background(), enabled, meta)))
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), imported, meta)))
+	assert.Equal(t, imported.Id(), "")
+}
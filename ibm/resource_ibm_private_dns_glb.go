@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
 	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -34,12 +36,11 @@ const (
 
 func resourceIBMPrivateDNSGLB() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMPrivateDNSGLBCreate,
-		Read:     resourceIBMPrivateDNSGLBRead,
-		Update:   resourceIBMPrivateDNSGLBUpdate,
-		Delete:   resourceIBMPrivateDNSGLBDelete,
-		Exists:   resourceIBMPrivateDNSGLBExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMPrivateDNSGLBCreate,
+		ReadContext:   resourceIBMPrivateDNSGLBRead,
+		UpdateContext: resourceIBMPrivateDNSGLBUpdate,
+		DeleteContext: resourceIBMPrivateDNSGLBDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -143,10 +144,10 @@ func resourceIBMPrivateDNSGLB() *schema.Resource {
 	}
 }
 
-func resourceIBMPrivateDNSGLBCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSGLBCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	instanceID := d.Get(pdnsInstanceID).(string)
 	zoneID := d.Get(pdnsZoneID).(string)
@@ -170,7 +171,7 @@ func resourceIBMPrivateDNSGLBCreate(d *schema.ResourceData, meta interface{}) er
 	if AZpools, ok := d.GetOk(pdnsGLBAZPools); ok {
 		expandedAzpools, err := expandPDNSGlbAZPools(AZpools)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		createlbOptions.SetAzPools(expandedAzpools)
 	}
@@ -178,24 +179,33 @@ func resourceIBMPrivateDNSGLBCreate(d *schema.ResourceData, meta interface{}) er
 	result, resp, err := sess.CreateLoadBalancer(createlbOptions)
 	if err != nil {
 		log.Printf("create global load balancer failed %s", resp)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, zoneID, *result.ID))
-	return resourceIBMPrivateDNSGLBRead(d, meta)
+	return resourceIBMPrivateDNSGLBRead(context, d, meta)
 }
 
-func resourceIBMPrivateDNSGLBRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSGLBRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMPrivateDNSGLBExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	idset := strings.Split(d.Id(), "/")
 
 	getlbOptions := sess.NewGetLoadBalancerOptions(idset[0], idset[1], idset[2])
 	presponse, resp, err := sess.GetLoadBalancer(getlbOptions)
 	if err != nil {
-		return fmt.Errorf("Error fetching pdns GLB :%s\n%s", err, resp)
+		return diag.FromErr(fmt.Errorf("Error fetching pdns GLB :%s\n%s", err, resp))
 	}
 
 	response := *presponse
@@ -215,10 +225,10 @@ func resourceIBMPrivateDNSGLBRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceIBMPrivateDNSGLBUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSGLBUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	idset := strings.Split(d.Id(), "/")
@@ -250,35 +260,35 @@ func resourceIBMPrivateDNSGLBUpdate(d *schema.ResourceData, meta interface{}) er
 		if AZpools, ok := d.GetOk(pdnsGLBAZPools); ok {
 			expandedAzpools, err := expandPDNSGlbAZPools(AZpools)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			updatelbOptions.SetAzPools(expandedAzpools)
 		}
 
 		_, detail, err := sess.UpdateLoadBalancer(updatelbOptions)
 		if err != nil {
-			return fmt.Errorf("Error updating pdns GLB :%s\n%s", err, detail)
+			return diag.FromErr(fmt.Errorf("Error updating pdns GLB :%s\n%s", err, detail))
 		}
 	}
 
-	return resourceIBMPrivateDNSGLBRead(d, meta)
+	return resourceIBMPrivateDNSGLBRead(context, d, meta)
 }
 
-func resourceIBMPrivateDNSGLBDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSGLBDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	idset := strings.Split(d.Id(), "/")
 	deletelbOptions := sess.NewDeleteLoadBalancerOptions(idset[0], idset[1], idset[2])
 	response, err := sess.DeleteLoadBalancer(deletelbOptions)
 	if err != nil {
-		return fmt.Errorf("Error deleting pdns GLB :%s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error deleting pdns GLB :%s\n%s", err, response))
 	}
-	_, err = isWaitForLoadBalancerDeleted(sess, d, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLoadBalancerDeleted(context, sess, d, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
@@ -338,7 +348,7 @@ func suppressPDNSGlbNameDiff(k, old, new string, d *schema.ResourceData) bool {
 	return false
 }
 
-func isWaitForLoadBalancerDeleted(LoadBalancer *dnssvcsv1.DnsSvcsV1, d *schema.ResourceData, timeout time.Duration) (interface{}, error) {
+func isWaitForLoadBalancerDeleted(context context.Context, LoadBalancer *dnssvcsv1.DnsSvcsV1, d *schema.ResourceData, timeout time.Duration) (interface{}, error) {
 	idset := strings.Split(d.Id(), "/")
 	log.Printf("Waiting for PDNS GLB (%s) to be deleted.", idset[2])
 	stateConf := &resource.StateChangeConf{
@@ -350,7 +360,7 @@ func isWaitForLoadBalancerDeleted(LoadBalancer *dnssvcsv1.DnsSvcsV1, d *schema.R
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isVLoadBalancerDeleteRefreshFunc(LoadBalancer *dnssvcsv1.DnsSvcsV1, d *schema.ResourceData) resource.StateRefreshFunc {
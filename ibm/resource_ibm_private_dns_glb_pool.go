@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/IBM/go-sdk-core/v3/core"
 	dns "github.com/IBM/networking-go-sdk/dnssvcsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -43,12 +45,11 @@ const (
 func resourceIBMPrivateDNSGLBPool() *schema.Resource {
 	return &schema.Resource{
 
-		Create:   resourceIBMPrivateDNSGLBPoolCreate,
-		Read:     resourceIBMPrivateDNSGLBPoolRead,
-		Update:   resourceIBMPrivateDNSGLBPoolUpdate,
-		Delete:   resourceIBMPrivateDNSGLBPoolDelete,
-		Exists:   resourceIBMPrivateDNSGLBPoolExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMPrivateDNSGLBPoolCreate,
+		ReadContext:   resourceIBMPrivateDNSGLBPoolRead,
+		UpdateContext: resourceIBMPrivateDNSGLBPoolUpdate,
+		DeleteContext: resourceIBMPrivateDNSGLBPoolDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -185,10 +186,10 @@ func resourceIBMPrivateDNSGLBPoolValidator() *ResourceValidator {
 	return &dnsPoolValidator
 }
 
-func resourceIBMPrivateDNSGLBPoolCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSGLBPoolCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceID := d.Get(pdnsInstanceID).(string)
@@ -226,24 +227,33 @@ func resourceIBMPrivateDNSGLBPoolCreate(d *schema.ResourceData, meta interface{}
 	result, resp, err := sess.CreatePool(CreatePoolOptions)
 	if err != nil {
 		log.Printf("create global load balancer pool failed %s", resp)
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%s/%s", instanceID, *result.ID))
 
-	return resourceIBMPrivateDNSGLBPoolRead(d, meta)
+	return resourceIBMPrivateDNSGLBPoolRead(context, d, meta)
 }
 
-func resourceIBMPrivateDNSGLBPoolRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSGLBPoolRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMPrivateDNSGLBPoolExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	idset := strings.Split(d.Id(), "/")
 
 	getPoolOptions := sess.NewGetPoolOptions(idset[0], idset[1])
 	presponse, resp, err := sess.GetPool(getPoolOptions)
 	if err != nil {
-		return fmt.Errorf("Error fetching pdns GLB Pool:%s\n%s", err, resp)
+		return diag.FromErr(fmt.Errorf("Error fetching pdns GLB Pool:%s\n%s", err, resp))
 	}
 
 	response := *presponse
@@ -281,10 +291,10 @@ func flattenPDNSGlbPoolOrigins(list []dns.Origin) []map[string]interface{} {
 	return origins
 }
 
-func resourceIBMPrivateDNSGLBPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSGLBPoolUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	idset := strings.Split(d.Id(), "/")
@@ -331,28 +341,28 @@ func resourceIBMPrivateDNSGLBPoolUpdate(d *schema.ResourceData, meta interface{}
 		}
 		_, detail, err := sess.UpdatePool(updatePoolOptions)
 		if err != nil {
-			return fmt.Errorf("Error updating pdns GLB Pool:%s\n%s", err, detail)
+			return diag.FromErr(fmt.Errorf("Error updating pdns GLB Pool:%s\n%s", err, detail))
 		}
 	}
 
-	return resourceIBMPrivateDNSGLBPoolRead(d, meta)
+	return resourceIBMPrivateDNSGLBPoolRead(context, d, meta)
 }
 
-func resourceIBMPrivateDNSGLBPoolDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSGLBPoolDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	idset := strings.Split(d.Id(), "/")
 	DeletePoolOptions := sess.NewDeletePoolOptions(idset[0], idset[1])
 	response, err := sess.DeletePool(DeletePoolOptions)
 	if err != nil {
-		return fmt.Errorf("Error deleting pdns GLB Pool:%s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error deleting pdns GLB Pool:%s\n%s", err, response))
 	}
-	_, err = waitForPDNSGlbPoolDelete(d, meta)
+	_, err = waitForPDNSGlbPoolDelete(context, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return nil
@@ -391,7 +401,7 @@ func expandPDNSGlbPoolOrigins(originsList *schema.Set) (origins []dns.OriginInpu
 	return
 }
 
-func waitForPDNSGlbPoolDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForPDNSGlbPoolDelete(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	cisClient, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
 		return nil, err
@@ -417,5 +427,5 @@ func waitForPDNSGlbPoolDelete(d *schema.ResourceData, meta interface{}) (interfa
 		PollInterval: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -27,11 +29,10 @@ var allowedNetworkTypes = []string{
 
 func resourceIBMPrivateDNSPermittedNetwork() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMPrivateDNSPermittedNetworkCreate,
-		Read:     resourceIBMPrivateDNSPermittedNetworkRead,
-		Delete:   resourceIBMPrivateDNSPermittedNetworkDelete,
-		Exists:   resourceIBMPrivateDNSPermittedNetworkExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMPrivateDNSPermittedNetworkCreate,
+		ReadContext:   resourceIBMPrivateDNSPermittedNetworkRead,
+		DeleteContext: resourceIBMPrivateDNSPermittedNetworkDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -96,10 +97,10 @@ func resourceIBMPrivateDNSPermittedNetwork() *schema.Resource {
 	}
 }
 
-func resourceIBMPrivateDNSPermittedNetworkCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSPermittedNetworkCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceID := d.Get(pdnsInstanceID).(string)
@@ -113,25 +114,34 @@ func resourceIBMPrivateDNSPermittedNetworkCreate(d *schema.ResourceData, meta in
 	createPermittedNetworkOptions := sess.NewCreatePermittedNetworkOptions(instanceID, zoneID)
 	permittedNetworkCrn, err := sess.NewPermittedNetworkVpc(vpcCRN)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	createPermittedNetworkOptions.SetPermittedNetwork(permittedNetworkCrn)
 	createPermittedNetworkOptions.SetType(nwType)
 	response, detail, err := sess.CreatePermittedNetwork(createPermittedNetworkOptions)
 	if err != nil {
-		return fmt.Errorf("Error creating pdns permitted network:%s\n%s", err, detail)
+		return diag.FromErr(fmt.Errorf("Error creating pdns permitted network:%s\n%s", err, detail))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, zoneID, *response.ID))
 
-	return resourceIBMPrivateDNSPermittedNetworkRead(d, meta)
+	return resourceIBMPrivateDNSPermittedNetworkRead(context, d, meta)
 }
 
-func resourceIBMPrivateDNSPermittedNetworkRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSPermittedNetworkRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMPrivateDNSPermittedNetworkExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	idSet := strings.Split(d.Id(), "/")
@@ -139,7 +149,7 @@ func resourceIBMPrivateDNSPermittedNetworkRead(d *schema.ResourceData, meta inte
 	response, detail, err := sess.GetPermittedNetwork(getPermittedNetworkOptions)
 
 	if err != nil {
-		return fmt.Errorf("Error reading pdns permitted network:%s\n%s", err, detail)
+		return diag.FromErr(fmt.Errorf("Error reading pdns permitted network:%s\n%s", err, detail))
 	}
 
 	d.Set(pdnsInstanceID, idSet[0])
@@ -154,10 +164,10 @@ func resourceIBMPrivateDNSPermittedNetworkRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceIBMPrivateDNSPermittedNetworkDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSPermittedNetworkDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	idSet := strings.Split(d.Id(), "/")
@@ -168,7 +178,7 @@ func resourceIBMPrivateDNSPermittedNetworkDelete(d *schema.ResourceData, meta in
 	_, response, err := sess.DeletePermittedNetwork(deletePermittedNetworkOptions)
 
 	if err != nil {
-		return fmt.Errorf("Error deleting pdns permitted network:%s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error deleting pdns permitted network:%s\n%s", err, response))
 	}
 
 	d.SetId("")
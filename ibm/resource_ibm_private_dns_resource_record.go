@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -37,12 +39,11 @@ func caseDiffSuppress(_, old, new string, _ *schema.ResourceData) bool {
 
 func resourceIBMPrivateDNSResourceRecord() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMPrivateDNSResourceRecordCreate,
-		Read:     resourceIBMPrivateDNSResourceRecordRead,
-		Update:   resourceIBMPrivateDNSResourceRecordUpdate,
-		Delete:   resourceIBMPrivateDNSResourceRecordDelete,
-		Exists:   resourceIBMPrivateDNSResourceRecordExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMPrivateDNSResourceRecordCreate,
+		ReadContext:   resourceIBMPrivateDNSResourceRecordRead,
+		UpdateContext: resourceIBMPrivateDNSResourceRecordUpdate,
+		DeleteContext: resourceIBMPrivateDNSResourceRecordDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -185,10 +186,10 @@ func resourceIBMPrivateDNSResourceRecord() *schema.Resource {
 	}
 }
 
-func resourceIBMPrivateDNSResourceRecordCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSResourceRecordCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var (
@@ -225,31 +226,31 @@ func resourceIBMPrivateDNSResourceRecordCreate(d *schema.ResourceData, meta inte
 	case "A":
 		resourceRecordAData, err := sess.NewResourceRecordInputRdataRdataARecord(rdata)
 		if err != nil {
-			return fmt.Errorf("Error creating pdns resource record A data:%s", err)
+			return diag.FromErr(fmt.Errorf("Error creating pdns resource record A data:%s", err))
 		}
 		createResourceRecordOptions.SetRdata(resourceRecordAData)
 	case "AAAA":
 		resourceRecordAaaaData, err := sess.NewResourceRecordInputRdataRdataAaaaRecord(rdata)
 		if err != nil {
-			return fmt.Errorf("Error creating pdns resource record Aaaa data:%s", err)
+			return diag.FromErr(fmt.Errorf("Error creating pdns resource record Aaaa data:%s", err))
 		}
 		createResourceRecordOptions.SetRdata(resourceRecordAaaaData)
 	case "CNAME":
 		resourceRecordCnameData, err := sess.NewResourceRecordInputRdataRdataCnameRecord(rdata)
 		if err != nil {
-			return fmt.Errorf("Error creating pdns resource record Cname data:%s", err)
+			return diag.FromErr(fmt.Errorf("Error creating pdns resource record Cname data:%s", err))
 		}
 		createResourceRecordOptions.SetRdata(resourceRecordCnameData)
 	case "PTR":
 		resourceRecordPtrData, err := sess.NewResourceRecordInputRdataRdataPtrRecord(rdata)
 		if err != nil {
-			return fmt.Errorf("Error creating pdns resource record Ptr data:%s", err)
+			return diag.FromErr(fmt.Errorf("Error creating pdns resource record Ptr data:%s", err))
 		}
 		createResourceRecordOptions.SetRdata(resourceRecordPtrData)
 	case "TXT":
 		resourceRecordTxtData, err := sess.NewResourceRecordInputRdataRdataTxtRecord(rdata)
 		if err != nil {
-			return fmt.Errorf("Error creating pdns resource record Txt data:%s", err)
+			return diag.FromErr(fmt.Errorf("Error creating pdns resource record Txt data:%s", err))
 		}
 		createResourceRecordOptions.SetRdata(resourceRecordTxtData)
 	case "MX":
@@ -258,7 +259,7 @@ func resourceIBMPrivateDNSResourceRecordCreate(d *schema.ResourceData, meta inte
 		}
 		resourceRecordMxData, err := sess.NewResourceRecordInputRdataRdataMxRecord(rdata, int64(preference))
 		if err != nil {
-			return fmt.Errorf("Error creating pdns resource record Mx data:%s", err)
+			return diag.FromErr(fmt.Errorf("Error creating pdns resource record Mx data:%s", err))
 		}
 		createResourceRecordOptions.SetRdata(resourceRecordMxData)
 	case "SRV":
@@ -273,7 +274,7 @@ func resourceIBMPrivateDNSResourceRecordCreate(d *schema.ResourceData, meta inte
 		}
 		resourceRecordSrvData, err := sess.NewResourceRecordInputRdataRdataSrvRecord(int64(port), int64(priority), rdata, int64(weight))
 		if err != nil {
-			return fmt.Errorf("Error creating pdns resource record Srv data:%s", err)
+			return diag.FromErr(fmt.Errorf("Error creating pdns resource record Srv data:%s", err))
 		}
 		if v, ok := d.GetOk(pdnsSrvService); ok {
 			service = v.(string)
@@ -290,24 +291,33 @@ func resourceIBMPrivateDNSResourceRecordCreate(d *schema.ResourceData, meta inte
 	defer ibmMutexKV.Unlock(mk)
 	response, detail, err := sess.CreateResourceRecord(createResourceRecordOptions)
 	if err != nil {
-		return fmt.Errorf("Error creating pdns resource record:%s\n%s", err, detail)
+		return diag.FromErr(fmt.Errorf("Error creating pdns resource record:%s\n%s", err, detail))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, zoneID, *response.ID))
 
-	return resourceIBMPrivateDNSResourceRecordRead(d, meta)
+	return resourceIBMPrivateDNSResourceRecordRead(context, d, meta)
 }
 
-func resourceIBMPrivateDNSResourceRecordRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSResourceRecordRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMPrivateDNSResourceRecordExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	idSet := strings.Split(d.Id(), "/")
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	getResourceRecordOptions := sess.NewGetResourceRecordOptions(idSet[0], idSet[1], idSet[2])
 	response, detail, err := sess.GetResourceRecord(getResourceRecordOptions)
 	if err != nil {
-		return fmt.Errorf("Error reading pdns resource record:%s\n%s", err, detail)
+		return diag.FromErr(fmt.Errorf("Error reading pdns resource record:%s\n%s", err, detail))
 	}
 
 	// extract the record name by removing zone details
@@ -367,12 +377,12 @@ func resourceIBMPrivateDNSResourceRecordRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSResourceRecordUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	idSet := strings.Split(d.Id(), "/")
 
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	mk := "private_dns_resource_record_" + idSet[0] + idSet[1]
@@ -401,7 +411,7 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 			rdata = d.Get(pdnsRdata).(string)
 			resourceRecordAData, err := sess.NewResourceRecordUpdateInputRdataRdataARecord(rdata)
 			if err != nil {
-				return fmt.Errorf("Error creating pdns resource record A data:%s", err)
+				return diag.FromErr(fmt.Errorf("Error creating pdns resource record A data:%s", err))
 			}
 			updateResourceRecordOptions.SetRdata(resourceRecordAData)
 
@@ -410,7 +420,7 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 			rdata = d.Get(pdnsRdata).(string)
 			resourceRecordAaaaData, err := sess.NewResourceRecordUpdateInputRdataRdataAaaaRecord(rdata)
 			if err != nil {
-				return fmt.Errorf("Error creating pdns resource record Aaaa data:%s", err)
+				return diag.FromErr(fmt.Errorf("Error creating pdns resource record Aaaa data:%s", err))
 			}
 			updateResourceRecordOptions.SetRdata(resourceRecordAaaaData)
 
@@ -419,7 +429,7 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 			rdata = d.Get(pdnsRdata).(string)
 			resourceRecordCnameData, err := sess.NewResourceRecordUpdateInputRdataRdataCnameRecord(rdata)
 			if err != nil {
-				return fmt.Errorf("Error creating pdns resource record Cname data:%s", err)
+				return diag.FromErr(fmt.Errorf("Error creating pdns resource record Cname data:%s", err))
 			}
 			updateResourceRecordOptions.SetRdata(resourceRecordCnameData)
 
@@ -431,7 +441,7 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 			rdata = d.Get(pdnsRdata).(string)
 			resourceRecordTxtData, err := sess.NewResourceRecordUpdateInputRdataRdataTxtRecord(rdata)
 			if err != nil {
-				return fmt.Errorf("Error creating pdns resource record Txt data:%s", err)
+				return diag.FromErr(fmt.Errorf("Error creating pdns resource record Txt data:%s", err))
 			}
 			updateResourceRecordOptions.SetRdata(resourceRecordTxtData)
 
@@ -442,7 +452,7 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 
 			resourceRecordMxData, err := sess.NewResourceRecordUpdateInputRdataRdataMxRecord(rdata, int64(preference))
 			if err != nil {
-				return fmt.Errorf("Error creating pdns resource record Mx data:%s", err)
+				return diag.FromErr(fmt.Errorf("Error creating pdns resource record Mx data:%s", err))
 			}
 			updateResourceRecordOptions.SetRdata(resourceRecordMxData)
 
@@ -455,7 +465,7 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 
 			resourceRecordSrvData, err := sess.NewResourceRecordUpdateInputRdataRdataSrvRecord(int64(port), int64(priority), rdata, int64(weight))
 			if err != nil {
-				return fmt.Errorf("Error creating pdns resource record Srv data:%s", err)
+				return diag.FromErr(fmt.Errorf("Error creating pdns resource record Srv data:%s", err))
 			}
 			updateResourceRecordOptions.SetRdata(resourceRecordSrvData)
 
@@ -467,19 +477,19 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 
 		_, detail, err := sess.UpdateResourceRecord(updateResourceRecordOptions)
 		if err != nil {
-			return fmt.Errorf("Error updating pdns resource record:%s\n%s", err, detail)
+			return diag.FromErr(fmt.Errorf("Error updating pdns resource record:%s\n%s", err, detail))
 		}
 	}
 
-	return resourceIBMPrivateDNSResourceRecordRead(d, meta)
+	return resourceIBMPrivateDNSResourceRecordRead(context, d, meta)
 }
 
-func resourceIBMPrivateDNSResourceRecordDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSResourceRecordDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	idSet := strings.Split(d.Id(), "/")
 
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	deleteResourceRecordOptions := sess.NewDeleteResourceRecordOptions(idSet[0], idSet[1], idSet[2])
@@ -488,7 +498,7 @@ func resourceIBMPrivateDNSResourceRecordDelete(d *schema.ResourceData, meta inte
 	defer ibmMutexKV.Unlock(mk)
 	response, err := sess.DeleteResourceRecord(deleteResourceRecordOptions)
 	if err != nil {
-		return fmt.Errorf("Error deleting pdns resource record:%s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error deleting pdns resource record:%s\n%s", err, response))
 	}
 
 	d.SetId("")
@@ -1,19 +1,21 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM/push-notifications-go-sdk/pushservicev1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMPNApplicationChrome() *schema.Resource {
 	return &schema.Resource{
-		Read:     resourceApplicationChromeRead,
-		Create:   resourceApplicationChromeCreate,
-		Update:   resourceApplicationChromeUpdate,
-		Delete:   resourceApplicationChromeDelete,
-		Importer: &schema.ResourceImporter{},
+		ReadContext:   resourceApplicationChromeRead,
+		CreateContext: resourceApplicationChromeCreate,
+		UpdateContext: resourceApplicationChromeUpdate,
+		DeleteContext: resourceApplicationChromeDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"guid": {
@@ -36,10 +38,10 @@ func resourceIBMPNApplicationChrome() *schema.Resource {
 	}
 }
 
-func resourceApplicationChromeCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceApplicationChromeCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	pnClient, err := meta.(ClientSession).PushServiceV1()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	serverKey := d.Get("server_key").(string)
@@ -54,25 +56,25 @@ func resourceApplicationChromeCreate(d *schema.ResourceData, meta interface{}) e
 
 	if err != nil {
 		d.SetId("")
-		return fmt.Errorf("Error configuring chrome web platform: %s with responce code  %d", err, response.StatusCode)
+		return diag.FromErr(fmt.Errorf("Error configuring chrome web platform: %s with responce code  %d", err, response.StatusCode))
 	}
 	d.SetId(guid)
 
-	return resourceApplicationChromeRead(d, meta)
+	return resourceApplicationChromeRead(context, d, meta)
 }
 
-func resourceApplicationChromeUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceApplicationChromeUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	if d.HasChanges("server_key", "web_site_url") {
-		return resourceApplicationChromeCreate(d, meta)
+		return resourceApplicationChromeCreate(context, d, meta)
 	}
 	return nil
 }
 
-func resourceApplicationChromeRead(d *schema.ResourceData, meta interface{}) error {
+func resourceApplicationChromeRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	pnClient, err := meta.(ClientSession).PushServiceV1()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	guid := d.Id()
@@ -86,7 +88,7 @@ func resourceApplicationChromeRead(d *schema.ResourceData, meta interface{}) err
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error fetching chrome web platform configuration: %s with responce code  %d", err, response.StatusCode)
+		return diag.FromErr(fmt.Errorf("Error fetching chrome web platform configuration: %s with responce code  %d", err, response.StatusCode))
 	}
 
 	d.SetId(guid)
@@ -98,10 +100,10 @@ func resourceApplicationChromeRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceApplicationChromeDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceApplicationChromeDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	pnClient, err := meta.(ClientSession).PushServiceV1()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	guid := d.Get("guid").(string)
 
@@ -114,7 +116,7 @@ func resourceApplicationChromeDelete(d *schema.ResourceData, meta interface{}) e
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error deleting chrome web platform configuration: %s with responce code  %d", err, response.StatusCode)
+		return diag.FromErr(fmt.Errorf("Error deleting chrome web platform configuration: %s with responce code  %d", err, response.StatusCode))
 	}
 
 	d.SetId("")
@@ -4,21 +4,21 @@
 package ibm
 
 import (
-	"fmt"
+	"context"
 	"log"
 
 	rg "github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMResourceGroup() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMResourceGroupCreate,
-		Read:     resourceIBMResourceGroupRead,
-		Update:   resourceIBMResourceGroupUpdate,
-		Delete:   resourceIBMResourceGroupDelete,
-		Exists:   resourceIBMResourceGroupExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMResourceGroupCreate,
+		ReadContext:   resourceIBMResourceGroupRead,
+		UpdateContext: resourceIBMResourceGroupUpdate,
+		DeleteContext: resourceIBMResourceGroupDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -89,16 +89,16 @@ func resourceIBMResourceGroup() *schema.Resource {
 	}
 }
 
-func resourceIBMResourceGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceGroupCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rMgtClient, err := meta.(ClientSession).ResourceManagerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	name := d.Get("name").(string)
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	accountID := userDetails.userAccount
 
@@ -109,18 +109,27 @@ func resourceIBMResourceGroupCreate(d *schema.ResourceData, meta interface{}) er
 
 	resourceGroup, resp, err := rMgtClient.CreateResourceGroup(&resourceGroupCreate)
 	if err != nil {
-		return fmt.Errorf("Error creating resource group: %s with responce code  %s", err, resp)
+		return diag.FromErr(apiErrorf("resource_manager", err, resp, "Error creating resource group %s", name))
 	}
 
 	d.SetId(*resourceGroup.ID)
 
-	return resourceIBMResourceGroupRead(d, meta)
+	return resourceIBMResourceGroupRead(context, d, meta)
 }
 
-func resourceIBMResourceGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceGroupRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMResourceGroupExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	rMgtClient, err := meta.(ClientSession).ResourceManagerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	resourceGroupID := d.Id()
 	resourceGroupGet := rg.GetResourceGroupOptions{
@@ -134,7 +143,7 @@ func resourceIBMResourceGroupRead(d *schema.ResourceData, meta interface{}) erro
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error retrieving resource group: %swith responce code  %s", err, resp)
+		return diag.FromErr(apiErrorf("resource_manager", err, resp, "Error retrieving resource group %s", resourceGroupID))
 	}
 
 	d.Set("name", *resourceGroup.Name)
@@ -177,10 +186,10 @@ func resourceIBMResourceGroupRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceIBMResourceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceGroupUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rMgtClient, err := meta.(ClientSession).ResourceManagerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	resourceGroupID := d.Id()
@@ -197,17 +206,17 @@ func resourceIBMResourceGroupUpdate(d *schema.ResourceData, meta interface{}) er
 	if hasChange {
 		_, resp, err := rMgtClient.UpdateResourceGroup(&resourceGroupUpdate)
 		if err != nil {
-			return fmt.Errorf("Error updating resource group: %s with responce code  %s", err, resp)
+			return diag.FromErr(apiErrorf("resource_manager", err, resp, "Error updating resource group %s", resourceGroupID))
 		}
 
 	}
-	return resourceIBMResourceGroupRead(d, meta)
+	return resourceIBMResourceGroupRead(context, d, meta)
 }
 
-func resourceIBMResourceGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceGroupDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rMgtClient, err := meta.(ClientSession).ResourceManagerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	resourceGroupID := d.Id()
@@ -221,7 +230,7 @@ func resourceIBMResourceGroupDelete(d *schema.ResourceData, meta interface{}) er
 			log.Printf("[WARN] Resource Group is not found")
 			return nil
 		}
-		return fmt.Errorf("Error Deleting resource group: %s with responce code  %s", err, resp)
+		return diag.FromErr(apiErrorf("resource_manager", err, resp, "Error Deleting resource group %s", resourceGroupID))
 	}
 
 	d.SetId("")
@@ -244,7 +253,7 @@ func resourceIBMResourceGroupExists(d *schema.ResourceData, meta interface{}) (b
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error communicating with the API: %s with responce code  %s", err, resp)
+		return false, apiErrorf("resource_manager", err, resp, "Error retrieving resource group %s", resourceGroupID)
 	}
 
 	return *resourceGroup.ID == resourceGroupID, nil
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -32,12 +33,11 @@ const (
 
 func resourceIBMResourceInstance() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMResourceInstanceCreate,
-		Read:     resourceIBMResourceInstanceRead,
-		Update:   resourceIBMResourceInstanceUpdate,
-		Delete:   resourceIBMResourceInstanceDelete,
-		Exists:   resourceIBMResourceInstanceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMResourceInstanceCreate,
+		ReadContext:   resourceIBMResourceInstanceRead,
+		UpdateContext: resourceIBMResourceInstanceUpdate,
+		DeleteContext: resourceIBMResourceInstanceDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -348,10 +348,11 @@ func resourceIBMResourceInstanceValidator() *ResourceValidator {
 	return &ibmResourceInstanceResourceValidator
 }
 
-func resourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceInstanceCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	serviceName := d.Get("service").(string)
@@ -365,35 +366,35 @@ func resourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 
 	rsCatClient, err := meta.(ClientSession).ResourceCatalogAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	rsCatRepo := rsCatClient.ResourceCatalog()
 
 	serviceOff, err := rsCatRepo.FindByName(serviceName, true)
 	if err != nil {
-		return fmt.Errorf("Error retrieving service offering: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving service offering: %s", err))
 	}
 
 	if metadata, ok := serviceOff[0].Metadata.(*models.ServiceResourceMetadata); ok {
 		if !metadata.Service.RCProvisionable {
-			return fmt.Errorf("%s cannot be provisioned by resource controller", serviceName)
+			return diag.FromErr(fmt.Errorf("%s cannot be provisioned by resource controller", serviceName))
 		}
 	} else {
-		return fmt.Errorf("Cannot create instance of resource %s\nUse 'ibm_service_instance' if the resource is a Cloud Foundry service", serviceName)
+		return diag.FromErr(fmt.Errorf("Cannot create instance of resource %s\nUse 'ibm_service_instance' if the resource is a Cloud Foundry service", serviceName))
 	}
 
 	servicePlan, err := rsCatRepo.GetServicePlanID(serviceOff[0], plan)
 	if err != nil {
-		return fmt.Errorf("Error retrieving plan: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving plan: %s", err))
 	}
 	rsInst.ResourcePlanID = &servicePlan
 
 	deployments, err := rsCatRepo.ListDeployments(servicePlan)
 	if err != nil {
-		return fmt.Errorf("Error retrieving deployment for plan %s : %s", plan, err)
+		return diag.FromErr(fmt.Errorf("Error retrieving deployment for plan %s : %s", plan, err))
 	}
 	if len(deployments) == 0 {
-		return fmt.Errorf("No deployment found for service plan : %s", plan)
+		return diag.FromErr(fmt.Errorf("No deployment found for service plan : %s", plan))
 	}
 	deployments, supportedLocations := filterDeployments(deployments, location)
 
@@ -402,7 +403,7 @@ func resourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 		for l := range supportedLocations {
 			locationList = append(locationList, l)
 		}
-		return fmt.Errorf("No deployment found for service plan %s at location %s.\nValid location(s) are: %q.\nUse 'ibm_service_instance' if the service is a Cloud Foundry service.", plan, location, locationList)
+		return diag.FromErr(fmt.Errorf("No deployment found for service plan %s at location %s.\nValid location(s) are: %q.\nUse 'ibm_service_instance' if the service is a Cloud Foundry service.", plan, location, locationList))
 	}
 
 	rsInst.Target = &deployments[0].CatalogCRN
@@ -413,7 +414,7 @@ func resourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 	} else {
 		defaultRg, err := defaultResourceGroup(meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		rsInst.ResourceGroup = &defaultRg
 	}
@@ -456,15 +457,15 @@ func resourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 		log.Printf(
 			"Error when creating resource instance: %s, Instance info  NAME->%s, LOCATION->%s, GROUP_ID->%s, PLAN_ID->%s",
 			err, *rsInst.Name, *rsInst.Target, *rsInst.ResourceGroup, *rsInst.ResourcePlanID)
-		return fmt.Errorf("Error when creating resource instance: %s with resp code: %s", err, resp)
+		return diag.FromErr(apiErrorf("resource_controller", err, resp, "Error when creating resource instance"))
 	}
 
 	d.SetId(*instance.ID)
 
-	_, err = waitForResourceInstanceCreate(d, meta)
+	_, err = waitForResourceInstanceCreate(context, d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for create resource instance (%s) to be succeeded: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf(
+			"Error waiting for create resource instance (%s) to be succeeded: %s", d.Id(), err))
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
@@ -474,15 +475,25 @@ func resourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 		if err != nil {
 			log.Printf(
 				"Error on create of resource instance (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 
-	return resourceIBMResourceInstanceRead(d, meta)
+	return append(diags, resourceIBMResourceInstanceRead(context, d, meta)...)
 }
-func resourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceInstanceRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMResourceInstanceExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceID := d.Id()
@@ -492,7 +503,7 @@ func resourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) e
 
 	instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
 	if err != nil {
-		return fmt.Errorf("Error retrieving resource instance: %s with resp code: %s", err, resp)
+		return diag.FromErr(apiErrorf("resource_controller", err, resp, "Error retrieving resource instance"))
 	}
 
 	tags, err := GetTagsUsingCRN(meta, *instance.CRN)
@@ -515,13 +526,13 @@ func resourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) e
 
 	rsCatClient, err := meta.(ClientSession).ResourceCatalogAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	rsCatRepo := rsCatClient.ResourceCatalog()
 
 	serviceOff, err := rsCatRepo.GetServiceName(*instance.ResourceID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving service offering: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving service offering: %s", err))
 	}
 
 	d.Set("service", serviceOff)
@@ -533,13 +544,13 @@ func resourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) e
 
 	rcontroller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(ResourceControllerURL, rcontroller+"/services/")
 
 	servicePlan, err := rsCatRepo.GetServicePlanName(*instance.ResourcePlanID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving plan: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving plan: %s", err))
 	}
 	d.Set("plan", servicePlan)
 	d.Set("guid", instance.GUID)
@@ -597,10 +608,11 @@ func resourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceInstanceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceID := d.Id()
@@ -618,18 +630,18 @@ func resourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 		service := d.Get("service").(string)
 		rsCatClient, err := meta.(ClientSession).ResourceCatalogAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		rsCatRepo := rsCatClient.ResourceCatalog()
 
 		serviceOff, err := rsCatRepo.FindByName(service, true)
 		if err != nil {
-			return fmt.Errorf("Error retrieving service offering: %s", err)
+			return diag.FromErr(fmt.Errorf("Error retrieving service offering: %s", err))
 		}
 
 		servicePlan, err := rsCatRepo.GetServicePlanID(serviceOff[0], plan)
 		if err != nil {
-			return fmt.Errorf("Error retrieving plan: %s", err)
+			return diag.FromErr(fmt.Errorf("Error retrieving plan: %s", err))
 		}
 
 		resourceInstanceUpdate.ResourcePlanID = &servicePlan
@@ -648,7 +660,7 @@ func resourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 	if d.HasChange("parameters") {
 		instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
 		if err != nil {
-			return fmt.Errorf("Error retrieving resource instance: %s with resp code: %s", err, resp)
+			return diag.FromErr(apiErrorf("resource_controller", err, resp, "Error retrieving resource instance"))
 		}
 
 		if parameters, ok := d.GetOk("parameters"); ok {
@@ -688,7 +700,7 @@ func resourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 
 	instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
 	if err != nil {
-		return fmt.Errorf("Error Getting resource instance: %s with resp code: %s", err, resp)
+		return diag.FromErr(apiErrorf("resource_controller", err, resp, "Error Getting resource instance"))
 	}
 
 	if d.HasChange("tags") {
@@ -697,27 +709,28 @@ func resourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 		if err != nil {
 			log.Printf(
 				"Error on update of resource instance (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 
 	_, resp, err = rsConClient.UpdateResourceInstance(&resourceInstanceUpdate)
 	if err != nil {
-		return fmt.Errorf("Error updating resource instance: %s with resp code: %s", err, resp)
+		return diag.FromErr(apiErrorf("resource_controller", err, resp, "Error updating resource instance"))
 	}
 
-	_, err = waitForResourceInstanceUpdate(d, meta)
+	_, err = waitForResourceInstanceUpdate(context, d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for update resource instance (%s) to be succeeded: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf(
+			"Error waiting for update resource instance (%s) to be succeeded: %s", d.Id(), err))
 	}
 
-	return resourceIBMResourceInstanceRead(d, meta)
+	return append(diags, resourceIBMResourceInstanceRead(context, d, meta)...)
 }
 
-func resourceIBMResourceInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceInstanceDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	recursive := true
@@ -728,13 +741,13 @@ func resourceIBMResourceInstanceDelete(d *schema.ResourceData, meta interface{})
 
 	resp, error := rsConClient.DeleteResourceInstance(&resourceInstanceDelete)
 	if error != nil {
-		return fmt.Errorf("Error deleting resource instance: %s with resp code: %s", error, resp)
+		return diag.FromErr(apiErrorf("resource_controller", error, resp, "Error deleting resource instance"))
 	}
 
-	_, err = waitForResourceInstanceDelete(d, meta)
+	_, err = waitForResourceInstanceDelete(context, d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for resource instance (%s) to be deleted: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf(
+			"Error waiting for resource instance (%s) to be deleted: %s", d.Id(), err))
 	}
 
 	d.SetId("")
@@ -756,7 +769,7 @@ func resourceIBMResourceInstanceExists(d *schema.ResourceData, meta interface{})
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error communicating with the API: %s with resp code: %s", err, resp)
+		return false, apiErrorf("resource_controller", err, resp, "Error communicating with the API")
 	}
 	if instance != nil && (strings.Contains(*instance.State, "removed") || strings.Contains(*instance.State, rsInstanceReclamation)) {
 		log.Printf("[WARN] Removing instance from state because it's in removed or pending_reclamation state")
@@ -767,7 +780,7 @@ func resourceIBMResourceInstanceExists(d *schema.ResourceData, meta interface{})
 	return *instance.ID == instanceID, nil
 }
 
-func waitForResourceInstanceCreate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForResourceInstanceCreate(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return false, err
@@ -786,7 +799,7 @@ func waitForResourceInstanceCreate(d *schema.ResourceData, meta interface{}) (in
 				if resp != nil && resp.StatusCode == 404 {
 					return nil, "", fmt.Errorf("The resource instance %s does not exist anymore: %v", d.Id(), err)
 				}
-				return nil, "", fmt.Errorf("Get the resource instance %s failed with resp code: %s, err: %v", d.Id(), resp, err)
+				return nil, "", apiErrorf("resource_controller", err, resp, "Get the resource instance %s failed", d.Id())
 			}
 			if *instance.State == rsInstanceFailStatus {
 				return instance, *instance.State, fmt.Errorf("The resource instance %s failed: %v", d.Id(), err)
@@ -798,10 +811,10 @@ func waitForResourceInstanceCreate(d *schema.ResourceData, meta interface{}) (in
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
-func waitForResourceInstanceUpdate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForResourceInstanceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return false, err
@@ -820,7 +833,7 @@ func waitForResourceInstanceUpdate(d *schema.ResourceData, meta interface{}) (in
 				if resp != nil && resp.StatusCode == 404 {
 					return nil, "", fmt.Errorf("The resource instance %s does not exist anymore: %v", d.Id(), err)
 				}
-				return nil, "", fmt.Errorf("Get the resource instance %s failed with resp code: %s, err: %v", d.Id(), resp, err)
+				return nil, "", apiErrorf("resource_controller", err, resp, "Get the resource instance %s failed", d.Id())
 			}
 			if *instance.State == rsInstanceFailStatus {
 				return instance, *instance.State, fmt.Errorf("The resource instance %s failed: %v", d.Id(), err)
@@ -832,10 +845,10 @@ func waitForResourceInstanceUpdate(d *schema.ResourceData, meta interface{}) (in
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
-func waitForResourceInstanceDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForResourceInstanceDelete(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return false, err
@@ -853,7 +866,7 @@ func waitForResourceInstanceDelete(d *schema.ResourceData, meta interface{}) (in
 				if resp != nil && resp.StatusCode == 404 {
 					return instance, rsInstanceSuccessStatus, nil
 				}
-				return nil, "", fmt.Errorf("Get the resource instance %s failed with resp code: %s, err: %v", d.Id(), resp, err)
+				return nil, "", apiErrorf("resource_controller", err, resp, "Get the resource instance %s failed", d.Id())
 			}
 			if *instance.State == rsInstanceFailStatus {
 				return instance, *instance.State, fmt.Errorf("The resource instance %s failed to delete: %v", d.Id(), err)
@@ -865,7 +878,7 @@ func waitForResourceInstanceDelete(d *schema.ResourceData, meta interface{}) (in
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func filterDeployments(deployments []models.ServiceDeployment, location string) ([]models.ServiceDeployment, map[string]bool) {
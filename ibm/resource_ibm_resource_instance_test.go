@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
@@ -11,7 +12,9 @@ import (
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
 )
 
 func TestAccIBMResourceInstanceBasic(t *testing.T) {
@@ -306,3 +309,60 @@ func testAccCheckIBMResourceInstanceServiceendpoints(serviceName string) string
 			
 	`, serviceName)
 }
+
+func TestIBMResourceInstanceMock(t *testing.T) {
+	t.Parallel()
+	srv := testMockServer(t)
+	meta := testMockClientSession(t, srv)
+	r := resourceIBMResourceInstance()
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":              "mock-cos",
+		"service":           "cloud-object-storage",
+		"plan":              "lite",
+		"location":          "global",
+		"resource_group_id": "mock-resource-group",
+		"tags":              []interface{}{"env:test"},
+	})
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), d, meta)))
+	assert.Assert(t, strings.HasPrefix(d.Id(), "crn:v1:bluemix:public:cloud-object-storage:global:"))
+	assert.Equal(t, d.Get("status"), "active")
+	assert.Equal(t, d.Get("service"), "cloud-object-storage")
+	assert.Equal(t, d.Get("plan"), "lite")
+	assert.Equal(t, d.Get("location"), "global")
+	assert.Equal(t, d.Get("resource_group_id"), "mock-resource-group")
+	assert.DeepEqual(t, sortedTags(d.Get("tags")), []string{"env:test"})
+
+	d = testMockResourceData(t, r, d, map[string]interface{}{
+		"name":              "mock-cos-renamed",
+		"service":           "cloud-object-storage",
+		"plan":              "standard",
+		"location":          "global",
+		"resource_group_id": "mock-resource-group",
+		"tags":              []interface{}{"env:test", "team:storage"},
+	})
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), d, meta)))
+	assert.Equal(t, d.Get("name"), "mock-cos-renamed")
+	assert.Equal(t, d.Get("plan"), "standard")
+	assert.Equal(t, len(d.Get("plan_history").([]interface{})), 2)
+	assert.DeepEqual(t, sortedTags(d.Get("tags")), []string{"env:test", "team:storage"})
+
+	assert.NilError(t, testDiagsErr(r.DeleteContext(context.Background(), d, meta)))
+	assert.Equal(t, d.Id(), "")
+}
+
+func TestIBMResourceInstanceMockUnsupportedLocation(t *testing.T) {
+	srv := testMockServer(t)
+	meta := testMockClientSession(t, srv)
+	r := resourceIBMResourceInstance()
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":              "mock-kms",
+		"service":           "kms",
+		"plan":              "tiered-pricing",
+		"location":          "jp-tok",
+		"resource_group_id": "mock-resource-group",
+	})
+	assert.ErrorContains(t, testDiagsErr(r.CreateContext(context.Background(), d, meta)), "No deployment found for service plan tiered-pricing at location jp-tok")
+	assert.Equal(t, d.Id(), "")
+}
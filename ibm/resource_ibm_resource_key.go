@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -12,6 +13,7 @@ import (
 	"time"
 
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
@@ -20,12 +22,11 @@ import (
 
 func resourceIBMResourceKey() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMResourceKeyCreate,
-		Read:     resourceIBMResourceKeyRead,
-		Update:   resourceIBMResourceKeyUpdate,
-		Delete:   resourceIBMResourceKeyDelete,
-		Exists:   resourceIBMResourceKeyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMResourceKeyCreate,
+		ReadContext:   resourceIBMResourceKeyRead,
+		UpdateContext: resourceIBMResourceKeyUpdate,
+		DeleteContext: resourceIBMResourceKeyDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -184,10 +185,10 @@ func resourceIBMResourceKey() *schema.Resource {
 	}
 }
 
-func resourceIBMResourceKeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceKeyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsContClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	name := d.Get("name").(string)
 	role := d.Get("role").(string)
@@ -202,7 +203,7 @@ func resourceIBMResourceKeyCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if instanceID == "" && aliasID == "" {
-		return fmt.Errorf("Provide either `resource_instance_id` or `resource_alias_id`")
+		return diag.FromErr(fmt.Errorf("Provide either `resource_instance_id` or `resource_alias_id`"))
 	}
 
 	keyParameters := rc.ResourceKeyPostParameters{}
@@ -221,23 +222,23 @@ func resourceIBMResourceKeyCreate(d *schema.ResourceData, meta interface{}) erro
 
 	resourceInstance, sourceCRN, err := getResourceInstanceAndCRN(d, meta)
 	if err != nil {
-		return fmt.Errorf("Error creating resource key when get instance and CRN: %s", err)
+		return diag.FromErr(fmt.Errorf("Error creating resource key when get instance and CRN: %s", err))
 	}
 
 	serviceID := resourceInstance.ResourceID
 
 	rsCatClient, err := meta.(ClientSession).ResourceCatalogAPI()
 	if err != nil {
-		return fmt.Errorf("Error creating resource key when get ResourceCatalogAPI: %s", err)
+		return diag.FromErr(fmt.Errorf("Error creating resource key when get ResourceCatalogAPI: %s", err))
 	}
 
 	service, err := rsCatClient.ResourceCatalog().Get(*serviceID, true)
 	if err != nil {
-		return fmt.Errorf("Error creating resource key when get service: %s", err)
+		return diag.FromErr(fmt.Errorf("Error creating resource key when get service: %s", err))
 	}
 	serviceRole, err := getRoleFromName(role, service.Name, meta)
 	if err != nil {
-		return fmt.Errorf("Error creating resource key when get role: %s", err)
+		return diag.FromErr(fmt.Errorf("Error creating resource key when get role: %s", err))
 	}
 
 	keyParameters.SetProperty("role_crn", serviceRole.RoleID)
@@ -250,22 +251,31 @@ func resourceIBMResourceKeyCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 	resourceKey, resp, err := rsContClient.CreateResourceKey(&resourceKeyCreate)
 	if err != nil {
-		return fmt.Errorf("Error creating resource key: %s with resp code: %s", err, resp)
+		return diag.FromErr(apiErrorf("resource_controller", err, resp, "Error creating resource key"))
 	}
 
 	d.SetId(*resourceKey.ID)
 
-	return resourceIBMResourceKeyRead(d, meta)
+	return resourceIBMResourceKeyRead(context, d, meta)
 }
 
-func resourceIBMResourceKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceKeyUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return nil
 }
 
-func resourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceKeyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMResourceKeyExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	rsContClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	resourceKeyID := d.Id()
 	resourceKeyGet := rc.GetResourceKeyOptions{
@@ -274,7 +284,7 @@ func resourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) error
 
 	resourceKey, resp, err := rsContClient.GetResourceKey(&resourceKeyGet)
 	if err != nil || resourceKey == nil {
-		return fmt.Errorf("Error retrieving resource key: %s with resp : %s", err, resp)
+		return diag.FromErr(apiErrorf("resource_controller", err, resp, "Error retrieving resource key"))
 	}
 	var credInterface map[string]interface{}
 	cred, _ := json.Marshal(resourceKey.Credentials)
@@ -354,10 +364,10 @@ func resourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMResourceKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceKeyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsContClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	resourceKeyID := d.Id()
@@ -367,7 +377,7 @@ func resourceIBMResourceKeyDelete(d *schema.ResourceData, meta interface{}) erro
 
 	resp, err := rsContClient.DeleteResourceKey(&resourceKeyDelete)
 	if err != nil {
-		return fmt.Errorf("Error deleting resource key: %s with resp code: %s", err, resp)
+		return diag.FromErr(apiErrorf("resource_controller", err, resp, "Error deleting resource key"))
 	}
 
 	d.SetId("")
@@ -390,7 +400,7 @@ func resourceIBMResourceKeyExists(d *schema.ResourceData, meta interface{}) (boo
 		if resp != nil && (resp.StatusCode == 404 || resp.StatusCode == 410) {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error communicating with the API: %s with resp code: %s", err, resp)
+		return false, apiErrorf("resource_controller", err, resp, "Error communicating with the API")
 	}
 	if err == nil && *resourceKey.State == "removed" {
 		return false, nil
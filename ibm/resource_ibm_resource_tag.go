@@ -12,6 +12,7 @@ import (
 
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -28,11 +29,11 @@ const (
 
 func resourceIBMResourceTag() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMResourceTagCreate,
-		Read:     resourceIBMResourceTagRead,
-		Update:   resourceIBMResourceTagUpdate,
-		Delete:   resourceIBMResourceTagDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMResourceTagCreate,
+		ReadContext:   resourceIBMResourceTagRead,
+		UpdateContext: resourceIBMResourceTagUpdate,
+		DeleteContext: resourceIBMResourceTagDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
@@ -103,19 +104,19 @@ func resourceIBMResourceTagValidator() *ResourceValidator {
 	return &ibmResourceTagValidator
 }
 
-func resourceIBMResourceTagCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceTagCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var rType, tType string
 	resources := []globaltaggingv1.Resource{}
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	accountID := userDetails.userAccount
 
 	gtClient, err := meta.(ClientSession).GlobalTaggingAPIv1()
 	if err != nil {
-		return fmt.Errorf("Error getting global tagging client settings: %s", err)
+		return diag.FromErr(fmt.Errorf("Error getting global tagging client settings: %s", err))
 	}
 
 	resourceID := d.Get(resourceID).(string)
@@ -156,13 +157,13 @@ func resourceIBMResourceTagCreate(d *schema.ResourceData, meta interface{}) erro
 	if len(add) > 0 {
 		_, resp, err := gtClient.AttachTag(AttachTagOptions)
 		if err != nil {
-			return fmt.Errorf("Error attaching resource tags >>>>  %v : %s", resp, err)
+			return diag.FromErr(apiErrorf("global_tagging", err, resp, "Error attaching resource tags to %s", resourceID))
 		}
 	}
 
 	crn, err := regexp.Compile(crnRegex)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if crn.MatchString(resourceID) {
@@ -171,21 +172,21 @@ func resourceIBMResourceTagCreate(d *schema.ResourceData, meta interface{}) erro
 		d.SetId(fmt.Sprintf("%s/%s", resourceID, resourceType))
 	}
 
-	return resourceIBMResourceTagRead(d, meta)
+	return resourceIBMResourceTagRead(context, d, meta)
 }
 
-func resourceIBMResourceTagRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceTagRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var rID, rType, tType string
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	acctID := userDetails.userAccount
 
 	crn, err := regexp.Compile(crnRegex)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if crn.MatchString(d.Id()) {
@@ -193,7 +194,7 @@ func resourceIBMResourceTagRead(d *schema.ResourceData, meta interface{}) error
 	} else {
 		parts, err := vmIdParts(d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		rID = parts[0]
 		rType = parts[1]
@@ -213,7 +214,7 @@ func resourceIBMResourceTagRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting resource tags for: %s with error : %s\n", rID, err)
+		return diag.FromErr(fmt.Errorf("Error getting resource tags for: %s with error : %s\n", rID, err))
 	}
 
 	d.Set(resourceID, rID)
@@ -223,12 +224,12 @@ func resourceIBMResourceTagRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMResourceTagUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceTagUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var rID, rType, tType string
 
 	crn, err := regexp.Compile(crnRegex)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if crn.MatchString(d.Id()) {
@@ -236,7 +237,7 @@ func resourceIBMResourceTagUpdate(d *schema.ResourceData, meta interface{}) erro
 	} else {
 		parts, err := vmIdParts(d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		rID = parts[0]
 		rType = parts[1]
@@ -250,20 +251,20 @@ func resourceIBMResourceTagUpdate(d *schema.ResourceData, meta interface{}) erro
 		oldList, newList := d.GetChange(tags)
 		err := UpdateGlobalTagsUsingCRN(oldList, newList, meta, rID, rType, tType)
 		if err != nil {
-			return fmt.Errorf(
-				"Error on create of resource tags: %s", err)
+			return diag.FromErr(fmt.Errorf(
+				"Error on create of resource tags: %s", err))
 		}
 	}
 
-	return resourceIBMResourceTagRead(d, meta)
+	return resourceIBMResourceTagRead(context, d, meta)
 }
 
-func resourceIBMResourceTagDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceTagDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var rID, rType string
 
 	crn, err := regexp.Compile(crnRegex)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if crn.MatchString(d.Id()) {
@@ -271,7 +272,7 @@ func resourceIBMResourceTagDelete(d *schema.ResourceData, meta interface{}) erro
 	} else {
 		parts, err := vmIdParts(d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		rID = parts[0]
 		rType = parts[1]
@@ -279,7 +280,7 @@ func resourceIBMResourceTagDelete(d *schema.ResourceData, meta interface{}) erro
 
 	gtClient, err := meta.(ClientSession).GlobalTaggingAPIv1()
 	if err != nil {
-		return fmt.Errorf("Error getting global tagging client settings: %s", err)
+		return diag.FromErr(fmt.Errorf("Error getting global tagging client settings: %s", err))
 	}
 
 	var remove []string
@@ -301,7 +302,7 @@ func resourceIBMResourceTagDelete(d *schema.ResourceData, meta interface{}) erro
 
 		_, resp, err := gtClient.DetachTag(detachTagOptions)
 		if err != nil {
-			return fmt.Errorf("Error detaching resource tags %v: %s\n%s", remove, err, resp)
+			return diag.FromErr(apiErrorf("global_tagging", err, resp, "Error detaching resource tags %v", remove))
 		}
 		for _, v := range remove {
 			delTagOptions := &globaltaggingv1.DeleteTagOptions{
@@ -309,7 +310,7 @@ func resourceIBMResourceTagDelete(d *schema.ResourceData, meta interface{}) erro
 			}
 			_, resp, err := gtClient.DeleteTag(delTagOptions)
 			if err != nil {
-				return fmt.Errorf("Error deleting resource tag %v: %s\n%s", v, err, resp)
+				return diag.FromErr(apiErrorf("global_tagging", err, resp, "Error deleting resource tag %v", v))
 			}
 		}
 	}
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -11,7 +12,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
 )
 
 func TestAccResourceTag_Basic(t *testing.T) {
@@ -90,3 +93,46 @@ func testAccCheckResourceTagCreate(name, managed_from string) string {
 	}
 `, name, managed_from)
 }
+
+func TestIBMResourceTagMock(t *testing.T) {
+	t.Parallel()
+	srv := testMockServer(t)
+	meta := testMockClientSession(t, srv)
+	instanceResource := resourceIBMResourceInstance()
+	r := resourceIBMResourceTag()
+
+	instance := schema.TestResourceDataRaw(t, instanceResource.Schema, map[string]interface{}{
+		"name":              "mock-tagged-kms",
+		"service":           "kms",
+		"plan":              "tiered-pricing",
+		"location":          "us-south",
+		"resource_group_id": "mock-resource-group",
+	})
+	assert.NilError(t, testDiagsErr(instanceResource.CreateContext(context.Background(), instance, meta)))
+	crn := instance.Get("crn").(string)
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		resourceID: crn,
+		tags:       []interface{}{"env:test", "team:security"},
+	})
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), d, meta)))
+	assert.Equal(t, d.Id(), crn)
+	assert.DeepEqual(t, sortedTags(d.Get(tags)), []string{"env:test", "team:security"})
+
+	// The tags are visible on the tagged resource
+	assert.NilError(t, testDiagsErr(instanceResource.ReadContext(context.Background(), instance, meta)))
+	assert.DeepEqual(t, sortedTags(instance.Get("tags")), []string{"env:test", "team:security"})
+
+	d = testMockResourceData(t, r, d, map[string]interface{}{
+		resourceID: crn,
+		tags:       []interface{}{"env:prod", "team:security"},
+	})
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), d, meta)))
+	assert.DeepEqual(t, sortedTags(d.Get(tags)), []string{"env:prod", "team:security"})
+
+	assert.NilError(t, testDiagsErr(r.DeleteContext(context.Background(), d, meta)))
+	assert.NilError(t, testDiagsErr(instanceResource.ReadContext(context.Background(), instance, meta)))
+	assert.Equal(t, instance.Get("tags").(*schema.Set).Len(), 0)
+
+	assert.NilError(t, testDiagsErr(instanceResource.DeleteContext(context.Background(), instance, meta)))
+}
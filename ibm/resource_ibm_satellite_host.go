@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.ibm.com/ibmcloud/kubernetesservice-go-sdk/kubernetesserviceapiv1"
@@ -32,11 +34,11 @@ const (
 
 func resourceIBMSatelliteHost() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMSatelliteHostCreate,
-		Read:     resourceIBMSatelliteHostRead,
-		Update:   resourceIBMSatelliteHostUpdate,
-		Delete:   resourceIBMSatelliteHostDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMSatelliteHostCreate,
+		ReadContext:   resourceIBMSatelliteHostRead,
+		UpdateContext: resourceIBMSatelliteHostUpdate,
+		DeleteContext: resourceIBMSatelliteHostDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(75 * time.Minute),
@@ -96,13 +98,13 @@ func resourceIBMSatelliteHost() *schema.Resource {
 	}
 }
 
-func resourceIBMSatelliteHostCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteHostCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	hostName := d.Get(hostID).(string)
 	location := d.Get(hostLocation).(string)
 
 	satClient, err := meta.(ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	hostAssignOptions := &kubernetesserviceapiv1.CreateSatelliteAssignmentOptions{}
@@ -116,10 +118,10 @@ func resourceIBMSatelliteHostCreate(d *schema.ResourceData, meta interface{}) er
 	hostAssignOptions.HostID = ptrToString(hostName)
 
 	//Check host attached to location
-	hostStatus, err := waitForHostAttachment(hostName, location, d, meta)
+	hostStatus, err := waitForHostAttachment(context, hostName, location, d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for attaching host (%s) to be succeeded: %s", hostName, err)
+		return diag.FromErr(fmt.Errorf(
+			"Error waiting for attaching host (%s) to be succeeded: %s", hostName, err))
 	}
 
 	labels := make(map[string]string)
@@ -142,33 +144,33 @@ func resourceIBMSatelliteHostCreate(d *schema.ResourceData, meta interface{}) er
 	if hostStatus == rsHostReadyStatus {
 		_, response, err := satClient.CreateSatelliteAssignment(hostAssignOptions)
 		if err != nil {
-			return fmt.Errorf("Error Assigning Satellite Host: %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("Error Assigning Satellite Host: %s\n%s", err, response))
 		}
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", location, hostName))
 
 	//Wait for host to reach normal state
-	_, err = waitForHostAttachment(hostName, location, d, meta)
+	_, err = waitForHostAttachment(context, hostName, location, d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for host (%s) to get normal state: %s", hostName, err)
+		return diag.FromErr(fmt.Errorf(
+			"Error waiting for host (%s) to get normal state: %s", hostName, err))
 	}
 
-	return resourceIBMSatelliteHostRead(d, meta)
+	return resourceIBMSatelliteHostRead(context, d, meta)
 }
 
-func resourceIBMSatelliteHostRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteHostRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	location := parts[0]
 	hostName := parts[1]
 
 	satClient, err := meta.(ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	hostOptions := &kubernetesserviceapiv1.GetSatelliteHostsOptions{
@@ -181,7 +183,7 @@ func resourceIBMSatelliteHostRead(d *schema.ResourceData, meta interface{}) erro
 			return nil
 		}
 		log.Println("resourceIBMSatelliteHostRead : error in getting hostlist :", err, resp)
-		return err
+		return diag.FromErr(err)
 	}
 
 	for _, h := range hostList {
@@ -214,17 +216,17 @@ func resourceIBMSatelliteHostRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceIBMSatelliteHostUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteHostUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	locationName := parts[0]
 	hostID := parts[1]
 	satClient, err := meta.(ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateHostOptions := &kubernetesserviceapiv1.UpdateSatelliteHostOptions{}
@@ -240,24 +242,24 @@ func resourceIBMSatelliteHostUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 		response, err := satClient.UpdateSatelliteHost(updateHostOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating Satellite Host: %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("Error Updating Satellite Host: %s\n%s", err, response))
 		}
 	}
 
-	return resourceIBMSatelliteHostRead(d, meta)
+	return resourceIBMSatelliteHostRead(context, d, meta)
 }
 
-func resourceIBMSatelliteHostDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteHostDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	location := parts[0]
 	hostID := parts[1]
 	satClient, err := meta.(ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	removeSatHostOptions := &kubernetesserviceapiv1.RemoveSatelliteHostOptions{}
@@ -269,14 +271,14 @@ func resourceIBMSatelliteHostDelete(d *schema.ResourceData, meta interface{}) er
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error Deleting Satellite Host: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error Deleting Satellite Host: %s\n%s", err, response))
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func waitForHostAttachment(hostName, location string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForHostAttachment(context context.Context, hostName, location string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	satClient, err := meta.(ClientSession).SatelliteClientSession()
 	if err != nil {
 		return false, err
@@ -312,5 +314,5 @@ func waitForHostAttachment(hostName, location string, d *schema.ResourceData, me
 		MinTimeout: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
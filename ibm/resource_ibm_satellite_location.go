@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -30,11 +31,11 @@ const (
 
 func resourceIBMSatelliteLocation() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMSatelliteLocationCreate,
-		Read:     resourceIBMSatelliteLocationRead,
-		Update:   resourceIBMSatelliteLocationUpdate,
-		Delete:   resourceIBMSatelliteLocationDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMSatelliteLocationCreate,
+		ReadContext:   resourceIBMSatelliteLocationRead,
+		UpdateContext: resourceIBMSatelliteLocationUpdate,
+		DeleteContext: resourceIBMSatelliteLocationDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
@@ -200,10 +201,11 @@ func resourceIBMSatelliteLocationValidator() *ResourceValidator {
 	return &ibmSatelliteLocationValidator
 }
 
-func resourceIBMSatelliteLocationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteLocationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	satClient, err := meta.(ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	createSatLocOptions := &kubernetesserviceapiv1.CreateSatelliteLocationOptions{}
@@ -244,7 +246,7 @@ func resourceIBMSatelliteLocationCreate(d *schema.ResourceData, meta interface{}
 
 	instance, response, err := satClient.CreateSatelliteLocation(createSatLocOptions)
 	if err != nil || instance == nil {
-		return fmt.Errorf("Error Creating Satellite Location: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error Creating Satellite Location: %s\n%s", err, response))
 	}
 
 	d.SetId(*instance.ID)
@@ -257,24 +259,25 @@ func resourceIBMSatelliteLocationCreate(d *schema.ResourceData, meta interface{}
 		if err != nil {
 			log.Printf(
 				"Error on create of ibm satellite location (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 
 	//Wait for location to be in ready state
-	_, err = waitForLocationToReady(*instance.ID, d, meta)
+	_, err = waitForLocationToReady(context, *instance.ID, d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for location (%s) to reach ready state: %s", *instance.ID, err)
+		return diag.FromErr(fmt.Errorf(
+			"Error waiting for location (%s) to reach ready state: %s", *instance.ID, err))
 	}
 
-	return resourceIBMSatelliteLocationRead(d, meta)
+	return append(diags, resourceIBMSatelliteLocationRead(context, d, meta)...)
 }
 
-func resourceIBMSatelliteLocationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteLocationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	ID := d.Id()
 	satClient, err := meta.(ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	getSatLocOptions := &kubernetesserviceapiv1.GetSatelliteLocationOptions{
@@ -287,7 +290,7 @@ func resourceIBMSatelliteLocationRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set(satLocation, *instance.Name)
@@ -328,11 +331,12 @@ func resourceIBMSatelliteLocationRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceIBMSatelliteLocationUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteLocationUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	ID := d.Id()
 	satClient, err := meta.(ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
@@ -344,21 +348,22 @@ func resourceIBMSatelliteLocationUpdate(d *schema.ResourceData, meta interface{}
 
 		instance, response, err := satClient.GetSatelliteLocation(getSatLocOptions)
 		if err != nil || instance == nil {
-			return fmt.Errorf("Error retrieving satellite location: %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("Error retrieving satellite location: %s\n%s", err, response))
 		}
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *instance.Crn)
 		if err != nil {
 			log.Printf(
 				"An error occured during update of instance (%s) tags: %s", ID, err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
-	return nil
+	return diags
 }
 
-func resourceIBMSatelliteLocationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteLocationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	satClient, err := meta.(ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	removeSatLocOptions := &kubernetesserviceapiv1.RemoveSatelliteLocationOptions{}
@@ -367,21 +372,21 @@ func resourceIBMSatelliteLocationDelete(d *schema.ResourceData, meta interface{}
 
 	response, err := satClient.RemoveSatelliteLocation(removeSatLocOptions)
 	if err != nil && response.StatusCode != 404 {
-		return fmt.Errorf("Error Deleting Satellite Location: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error Deleting Satellite Location: %s\n%s", err, response))
 	}
 
 	//Wait for location to delete
-	_, err = waitForLocationDelete(name, d, meta)
+	_, err = waitForLocationDelete(context, name, d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for deleting location instance: %s", err)
+		return diag.FromErr(fmt.Errorf(
+			"Error waiting for deleting location instance: %s", err))
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func waitForLocationDelete(location string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForLocationDelete(context context.Context, location string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	satClient, err := meta.(ClientSession).SatelliteClientSession()
 	if err != nil {
 		return false, err
@@ -416,10 +421,10 @@ func waitForLocationDelete(location string, d *schema.ResourceData, meta interfa
 		MinTimeout: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
-func waitForLocationToReady(loc string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForLocationToReady(context context.Context, loc string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	satClient, err := meta.(ClientSession).SatelliteClientSession()
 	if err != nil {
 		return false, err
@@ -451,5 +456,5 @@ func waitForLocationToReady(loc string, d *schema.ResourceData, meta interface{}
 		MinTimeout: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
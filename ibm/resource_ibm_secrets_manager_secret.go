@@ -0,0 +1,578 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// secretsManagerSecretFields are the fields of the secrets of each type, the
+// other ones being refused.
+var secretsManagerSecretFields = map[string][]string{
+	"arbitrary":         {"payload", "expiration_date"},
+	"username_password": {"username", "password", "expiration_date"},
+	"iam_credentials":   {"ttl", "access_groups", "reuse_api_key"},
+	"imported_cert":     {"certificate", "private_key", "intermediate"},
+}
+
+// secretsManagerImportedCertResource is an imported_cert secret, whose certificate
+// fields the SDK models do not hold.
+type secretsManagerImportedCertResource struct {
+	secretsmanagerv1.SecretResource
+	Certificate  *string `json:"certificate,omitempty"`
+	PrivateKey   *string `json:"private_key,omitempty"`
+	Intermediate *string `json:"intermediate,omitempty"`
+}
+
+// secretsManagerRotateCertificateBody is the rotate action of an imported_cert
+// secret, importing a new version of the certificate.
+type secretsManagerRotateCertificateBody struct {
+	secretsmanagerv1.SecretActionOneOf
+	Certificate  *string `json:"certificate,omitempty"`
+	PrivateKey   *string `json:"private_key,omitempty"`
+	Intermediate *string `json:"intermediate,omitempty"`
+}
+
+func resourceIBMSecretsManagerSecret() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSecretsManagerSecretCreate,
+		ReadContext:   resourceIBMSecretsManagerSecretRead,
+		UpdateContext: resourceIBMSecretsManagerSecretUpdate,
+		DeleteContext: resourceIBMSecretsManagerSecretDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMSecretsManagerSecretImport,
+		},
+
+		CustomizeDiff: resourceIBMSecretsManagerSecretValidateFields,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Secrets Manager instance GUID",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "public",
+				ValidateFunc: validateAllowedStringValue([]string{"public", "private"}),
+				Description:  "Endpoint Type. 'public' or 'private'",
+			},
+			"secret_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"arbitrary", "username_password", "iam_credentials", "imported_cert"}),
+				Description:  "The secret type. Supported options include: arbitrary, username_password, iam_credentials, imported_cert.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "A human-readable alias to assign to your secret.To protect your privacy, do not use personal data, such as your name or location, as an alias for your secret.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "An extended description of your secret.To protect your privacy, do not use personal data, such as your name or location, as a description for your secret.",
+			},
+			"secret_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The v4 UUID that uniquely identifies the secret group to assign to this secret.If you omit this parameter, your secret is assigned to the `default` secret group.",
+			},
+			"labels": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Labels that you can use to filter for secrets in your instance.Up to 30 labels can be created. Labels can be between 2-30 characters, including spaces. Special characters not permitted include the angled bracket, comma, colon, ampersand, and vertical pipe character (|).To protect your privacy, do not use personal data, such as your name or location, as a label for your secret.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"expiration_date": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentSecretDate,
+				Description:      "The date the secret material expires. The date format follows RFC 3339. Supported for the arbitrary and username_password secrets.",
+			},
+			"payload": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The secret data of an arbitrary secret. A new version of the secret is created when it changes.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The username of a username_password secret.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The password of a username_password secret, generated by Secrets Manager when it is not set. A new version of the secret is created when it changes.",
+			},
+			"ttl": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressEquivalentSecretTTL,
+				Description:      "The time-to-live (TTL) or lease duration to assign to generated credentials.For `iam_credentials` secrets, the TTL defines for how long each generated API key remains valid. The value can be either an integer that specifies the number of seconds, or the string representation of a duration, such as `120m` or `24h`.",
+			},
+			"access_groups": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The access groups that define the capabilities of the service ID and API key that are generated for an `iam_credentials` secret.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"reuse_api_key": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "(IAM credentials) Reuse the service ID and API key for future read operations.",
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The PEM encoded certificate of an imported_cert secret. A new version of the secret is imported when it changes.",
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The PEM encoded private key of the certificate of an imported_cert secret.",
+			},
+			"intermediate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The PEM encoded intermediate certificate of an imported_cert secret.",
+			},
+			"secret_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The v4 UUID that uniquely identifies the secret.",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Cloud Resource Name (CRN) that uniquely identifies your Secrets Manager resource.",
+			},
+			"state": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The secret state based on NIST SP 800-57. States are integers and correspond to the Pre-activation = 0, Active = 1,  Suspended = 2, Deactivated = 3, and Destroyed = 5 values.",
+			},
+			"state_description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A text representation of the secret state.",
+			},
+			"creation_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the secret was created. The date format follows RFC 3339.",
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier for the entity that created the secret.",
+			},
+			"last_update_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Updates when the actual secret is modified. The date format follows RFC 3339.",
+			},
+			"next_rotation_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date that the secret is scheduled for automatic rotation.",
+			},
+			"versions_total": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of versions of the secret.",
+			},
+			"api_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The API key that is generated for an iam_credentials secret.",
+			},
+			"service_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The service ID under which the API key of an iam_credentials secret is created.",
+			},
+		},
+	}
+}
+
+// resourceIBMSecretsManagerSecretValidateFields refuses the fields of the other
+// secret types and requires the secret data of the type.
+func resourceIBMSecretsManagerSecretValidateFields(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	secretType := diff.Get("secret_type").(string)
+	for otherType, fields := range secretsManagerSecretFields {
+		if otherType == secretType {
+			continue
+		}
+		for _, field := range fields {
+			if !stringInSlice(field, secretsManagerSecretFields[secretType]) && diff.Get(field) != nil && !isEmptySecretField(diff.Get(field)) {
+				return fmt.Errorf("%s can't be set for %s secrets", field, secretType)
+			}
+		}
+	}
+	required := map[string]string{"arbitrary": "payload", "username_password": "username", "imported_cert": "certificate"}
+	if field, ok := required[secretType]; ok && diff.NewValueKnown(field) {
+		if _, set := diff.GetOk(field); !set {
+			return fmt.Errorf("%s is required for %s secrets", field, secretType)
+		}
+	}
+	return nil
+}
+
+func isEmptySecretField(value interface{}) bool {
+	switch v := value.(type) {
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case []interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+// suppressEquivalentSecretDate suppresses the differences between two RFC 3339
+// representations of the same time.
+func suppressEquivalentSecretDate(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	return err == nil && oldTime.Equal(newTime)
+}
+
+// suppressEquivalentSecretTTL suppresses the differences between a TTL in seconds
+// and a duration of the same length.
+func suppressEquivalentSecretTTL(k, old, new string, d *schema.ResourceData) bool {
+	oldTTL, ok := secretTTLSeconds(old)
+	if !ok {
+		return false
+	}
+	newTTL, ok := secretTTLSeconds(new)
+	return ok && oldTTL == newTTL
+}
+
+func secretTTLSeconds(ttl string) (int64, bool) {
+	if seconds, err := strconv.ParseInt(ttl, 10, 64); err == nil {
+		return seconds, true
+	}
+	duration, err := time.ParseDuration(ttl)
+	if err != nil {
+		return 0, false
+	}
+	return int64(duration / time.Second), true
+}
+
+func resourceIBMSecretsManagerSecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("instance_id").(string)
+	secretsManagerClient, err := secretsManagerInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secretType := d.Get("secret_type").(string)
+	secret := secretsmanagerv1.SecretResource{
+		Name: core.StringPtr(d.Get("name").(string)),
+	}
+	if description, ok := d.GetOk("description"); ok {
+		secret.Description = core.StringPtr(description.(string))
+	}
+	if secretGroupID, ok := d.GetOk("secret_group_id"); ok {
+		secret.SecretGroupID = core.StringPtr(secretGroupID.(string))
+	}
+	if labels, ok := d.GetOk("labels"); ok {
+		secret.Labels = expandStringList(labels.([]interface{}))
+	}
+	if expirationDate, ok := d.GetOk("expiration_date"); ok {
+		date, err := strfmt.ParseDateTime(expirationDate.(string))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("Error parsing expiration_date %s: %s", expirationDate, err))
+		}
+		secret.ExpirationDate = &date
+	}
+	if payload, ok := d.GetOk("payload"); ok {
+		secret.Payload = core.StringPtr(payload.(string))
+	}
+	if username, ok := d.GetOk("username"); ok {
+		secret.Username = core.StringPtr(username.(string))
+	}
+	if password, ok := d.GetOk("password"); ok {
+		secret.Password = core.StringPtr(password.(string))
+	}
+	if ttl, ok := d.GetOk("ttl"); ok {
+		secret.TTL = ttl.(string)
+	}
+	if accessGroups, ok := d.GetOk("access_groups"); ok {
+		secret.AccessGroups = expandStringList(accessGroups.([]interface{}))
+	}
+	if secretType == "iam_credentials" {
+		secret.ReuseAPIKey = core.BoolPtr(d.Get("reuse_api_key").(bool))
+	}
+	var resource secretsmanagerv1.SecretResourceIntf = &secret
+	if secretType == "imported_cert" {
+		certificate := &secretsManagerImportedCertResource{
+			SecretResource: secret,
+			Certificate:    core.StringPtr(d.Get("certificate").(string)),
+		}
+		if privateKey, ok := d.GetOk("private_key"); ok {
+			certificate.PrivateKey = core.StringPtr(privateKey.(string))
+		}
+		if intermediate, ok := d.GetOk("intermediate"); ok {
+			certificate.Intermediate = core.StringPtr(intermediate.(string))
+		}
+		resource = certificate
+	}
+
+	createSecretOptions := &secretsmanagerv1.CreateSecretOptions{
+		SecretType: &secretType,
+		Metadata: &secretsmanagerv1.CollectionMetadata{
+			CollectionType:  core.StringPtr(secretsmanagerv1.CollectionMetadataCollectionTypeApplicationVndIBMSecretsManagerSecretJSONConst),
+			CollectionTotal: core.Int64Ptr(1),
+		},
+		Resources: []secretsmanagerv1.SecretResourceIntf{resource},
+	}
+	createSecret, response, err := secretsManagerClient.CreateSecretWithContext(context, createSecretOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateSecret failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error creating %s secret %s: %s", secretType, d.Get("name"), err))
+	}
+	created, ok := firstSecretResource(createSecret.Resources)
+	if !ok || created.ID == nil {
+		return diag.FromErr(fmt.Errorf("Error creating %s secret %s: the response has no secret", secretType, d.Get("name")))
+	}
+	d.SetId(secretsManagerSecretResourceID(instanceID, secretType, *created.ID))
+
+	return resourceIBMSecretsManagerSecretRead(context, d, meta)
+}
+
+// firstSecretResource returns the first secret of the resources of a response.
+func firstSecretResource(resources []secretsmanagerv1.SecretResourceIntf) (*secretsmanagerv1.SecretResource, bool) {
+	if len(resources) == 0 {
+		return nil, false
+	}
+	secret, ok := resources[0].(*secretsmanagerv1.SecretResource)
+	return secret, ok
+}
+
+func resourceIBMSecretsManagerSecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := parseSecretsManagerID(d.Id(), 2, "<instance_id>/<secret_type>/<secret_id>")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceID, secretType, secretID := parts[0], parts[1], parts[2]
+	secretsManagerClient, err := secretsManagerInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getSecretOptions := &secretsmanagerv1.GetSecretOptions{
+		SecretType: &secretType,
+		ID:         &secretID,
+	}
+	getSecret, response, err := secretsManagerClient.GetSecretWithContext(context, getSecretOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetSecret failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error getting %s secret %s: %s", secretType, secretID, err))
+	}
+	secret, ok := firstSecretResource(getSecret.Resources)
+	if !ok {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("instance_id", instanceID)
+	d.Set("secret_type", secretType)
+	d.Set("secret_id", secretID)
+	if secret.Name != nil {
+		d.Set("name", *secret.Name)
+	}
+	if secret.Description != nil {
+		d.Set("description", *secret.Description)
+	}
+	if secret.SecretGroupID != nil {
+		d.Set("secret_group_id", *secret.SecretGroupID)
+	}
+	d.Set("labels", secret.Labels)
+	if secret.ExpirationDate != nil {
+		d.Set("expiration_date", secret.ExpirationDate.String())
+	}
+	if secret.CRN != nil {
+		d.Set("crn", *secret.CRN)
+	}
+	if secret.State != nil {
+		d.Set("state", *secret.State)
+	}
+	if secret.StateDescription != nil {
+		d.Set("state_description", *secret.StateDescription)
+	}
+	if secret.CreationDate != nil {
+		d.Set("creation_date", secret.CreationDate.String())
+	}
+	if secret.CreatedBy != nil {
+		d.Set("created_by", *secret.CreatedBy)
+	}
+	if secret.LastUpdateDate != nil {
+		d.Set("last_update_date", secret.LastUpdateDate.String())
+	}
+	if secret.NextRotationDate != nil {
+		d.Set("next_rotation_date", secret.NextRotationDate.String())
+	}
+	d.Set("versions_total", len(secret.Versions))
+	if secret.TTL != nil {
+		d.Set("ttl", fmt.Sprint(secret.TTL))
+	}
+	if secret.AccessGroups != nil {
+		d.Set("access_groups", secret.AccessGroups)
+	}
+	if secret.ReuseAPIKey != nil {
+		d.Set("reuse_api_key", *secret.ReuseAPIKey)
+	}
+	if secret.APIKey != nil {
+		d.Set("api_key", *secret.APIKey)
+	}
+	if secret.ServiceID != nil {
+		d.Set("service_id", *secret.ServiceID)
+	}
+	if secretData, ok := secret.SecretData.(map[string]interface{}); ok {
+		for _, field := range []string{"payload", "username", "password", "certificate", "private_key", "intermediate"} {
+			if value, ok := secretData[field].(string); ok {
+				d.Set(field, value)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMSecretsManagerSecretUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("instance_id").(string)
+	secretsManagerClient, err := secretsManagerInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	secretType := d.Get("secret_type").(string)
+	secretID := d.Get("secret_id").(string)
+
+	if d.HasChange("name") || d.HasChange("description") || d.HasChange("labels") || d.HasChange("expiration_date") || d.HasChange("ttl") {
+		metadata := secretsmanagerv1.SecretMetadata{
+			Name:        core.StringPtr(d.Get("name").(string)),
+			Description: core.StringPtr(d.Get("description").(string)),
+			Labels:      expandStringList(d.Get("labels").([]interface{})),
+		}
+		if expirationDate, ok := d.GetOk("expiration_date"); ok {
+			date, err := strfmt.ParseDateTime(expirationDate.(string))
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("Error parsing expiration_date %s: %s", expirationDate, err))
+			}
+			metadata.ExpirationDate = &date
+		}
+		if ttl, ok := d.GetOk("ttl"); ok {
+			metadata.TTL = ttl.(string)
+		}
+		response, err := updateSecretMetadata(context, secretsManagerClient, secretType, secretID, metadata)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateSecretMetadata failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("Error updating the metadata of %s secret %s: %s", secretType, secretID, err))
+		}
+	}
+
+	// A change of the secret data creates a new version of the secret
+	var action secretsmanagerv1.SecretActionOneOfIntf
+	switch {
+	case secretType == "arbitrary" && d.HasChange("payload"):
+		action = &secretsmanagerv1.SecretActionOneOf{Payload: core.StringPtr(d.Get("payload").(string))}
+	case secretType == "username_password" && d.HasChange("password"):
+		action = &secretsmanagerv1.SecretActionOneOf{Password: core.StringPtr(d.Get("password").(string))}
+	case secretType == "imported_cert" && (d.HasChange("certificate") || d.HasChange("private_key") || d.HasChange("intermediate")):
+		body := &secretsManagerRotateCertificateBody{Certificate: core.StringPtr(d.Get("certificate").(string))}
+		if privateKey, ok := d.GetOk("private_key"); ok {
+			body.PrivateKey = core.StringPtr(privateKey.(string))
+		}
+		if intermediate, ok := d.GetOk("intermediate"); ok {
+			body.Intermediate = core.StringPtr(intermediate.(string))
+		}
+		action = body
+	}
+	if action != nil {
+		updateSecretOptions := &secretsmanagerv1.UpdateSecretOptions{
+			SecretType:        &secretType,
+			ID:                &secretID,
+			Action:            core.StringPtr(secretsmanagerv1.UpdateSecretOptionsActionRotateConst),
+			SecretActionOneOf: action,
+		}
+		_, response, err := secretsManagerClient.UpdateSecretWithContext(context, updateSecretOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateSecret failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("Error rotating %s secret %s: %s", secretType, secretID, err))
+		}
+	}
+
+	return resourceIBMSecretsManagerSecretRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerSecretDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("instance_id").(string)
+	secretsManagerClient, err := secretsManagerInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secretType := d.Get("secret_type").(string)
+	secretID := d.Get("secret_id").(string)
+	deleteSecretOptions := &secretsmanagerv1.DeleteSecretOptions{
+		SecretType: &secretType,
+		ID:         &secretID,
+	}
+	response, err := secretsManagerClient.DeleteSecretWithContext(context, deleteSecretOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] DeleteSecret failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error deleting %s secret %s: %s", secretType, secretID, err))
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIBMSecretsManagerSecretImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := parseSecretsManagerID(d.Id(), 2, "<instance_id>/<secret_type>/<secret_id>")
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := secretsManagerSecretFields[parts[1]]; !ok {
+		return nil, fmt.Errorf("Incorrect ID %s: unsupported secret type %s", d.Id(), parts[1])
+	}
+	d.Set("endpoint_type", "public")
+	return []*schema.ResourceData{d}, nil
+}
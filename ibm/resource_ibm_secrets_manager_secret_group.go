@@ -0,0 +1,206 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMSecretsManagerSecretGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSecretsManagerSecretGroupCreate,
+		ReadContext:   resourceIBMSecretsManagerSecretGroupRead,
+		UpdateContext: resourceIBMSecretsManagerSecretGroupUpdate,
+		DeleteContext: resourceIBMSecretsManagerSecretGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMSecretsManagerSecretGroupImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Secrets Manager instance GUID",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "public",
+				ValidateFunc: validateAllowedStringValue([]string{"public", "private"}),
+				Description:  "Endpoint Type. 'public' or 'private'",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "A human-readable name to assign to your secret group.To protect your privacy, do not use personal data, such as your name or location, as a name for your secret group.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "An extended description of your secret group.To protect your privacy, do not use personal data, such as your name or location, as a description for your secret group.",
+			},
+			"secret_group_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The v4 UUID that uniquely identifies the secret group.",
+			},
+			"creation_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the secret group was created. The date format follows RFC 3339.",
+			},
+			"last_update_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Updates when the metadata of the secret group is modified. The date format follows RFC 3339.",
+			},
+		},
+	}
+}
+
+func resourceIBMSecretsManagerSecretGroupCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("instance_id").(string)
+	secretsManagerClient, err := secretsManagerInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secretGroup := secretsmanagerv1.SecretGroupResource{
+		Name: core.StringPtr(d.Get("name").(string)),
+	}
+	if description, ok := d.GetOk("description"); ok {
+		secretGroup.Description = core.StringPtr(description.(string))
+	}
+	createSecretGroupOptions := &secretsmanagerv1.CreateSecretGroupOptions{
+		Metadata: &secretsmanagerv1.CollectionMetadata{
+			CollectionType:  core.StringPtr(secretsmanagerv1.CollectionMetadataCollectionTypeApplicationVndIBMSecretsManagerSecretGroupJSONConst),
+			CollectionTotal: core.Int64Ptr(1),
+		},
+		Resources: []secretsmanagerv1.SecretGroupResource{secretGroup},
+	}
+	secretGroupDef, response, err := secretsManagerClient.CreateSecretGroupWithContext(context, createSecretGroupOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateSecretGroup failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error creating secret group %s: %s", d.Get("name"), err))
+	}
+	if len(secretGroupDef.Resources) == 0 || secretGroupDef.Resources[0].ID == nil {
+		return diag.FromErr(fmt.Errorf("Error creating secret group %s: the response has no secret group", d.Get("name")))
+	}
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, *secretGroupDef.Resources[0].ID))
+
+	return resourceIBMSecretsManagerSecretGroupRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerSecretGroupRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := parseSecretsManagerID(d.Id(), 1, "<instance_id>/<secret_group_id>")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceID, secretGroupID := parts[0], parts[1]
+	secretsManagerClient, err := secretsManagerInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getSecretGroupOptions := &secretsmanagerv1.GetSecretGroupOptions{
+		ID: &secretGroupID,
+	}
+	secretGroupDef, response, err := secretsManagerClient.GetSecretGroupWithContext(context, getSecretGroupOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetSecretGroup failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error getting secret group %s: %s", secretGroupID, err))
+	}
+	if len(secretGroupDef.Resources) == 0 {
+		d.SetId("")
+		return nil
+	}
+	secretGroup := secretGroupDef.Resources[0]
+
+	d.Set("instance_id", instanceID)
+	d.Set("secret_group_id", secretGroupID)
+	if secretGroup.Name != nil {
+		d.Set("name", *secretGroup.Name)
+	}
+	if secretGroup.Description != nil {
+		d.Set("description", *secretGroup.Description)
+	}
+	if secretGroup.CreationDate != nil {
+		d.Set("creation_date", secretGroup.CreationDate.String())
+	}
+	if secretGroup.LastUpdateDate != nil {
+		d.Set("last_update_date", secretGroup.LastUpdateDate.String())
+	}
+
+	return nil
+}
+
+func resourceIBMSecretsManagerSecretGroupUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("name") || d.HasChange("description") {
+		instanceID := d.Get("instance_id").(string)
+		secretsManagerClient, err := secretsManagerInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		secretGroupID := d.Get("secret_group_id").(string)
+		updateSecretGroupMetadataOptions := &secretsmanagerv1.UpdateSecretGroupMetadataOptions{
+			ID: &secretGroupID,
+			Metadata: &secretsmanagerv1.CollectionMetadata{
+				CollectionType:  core.StringPtr(secretsmanagerv1.CollectionMetadataCollectionTypeApplicationVndIBMSecretsManagerSecretGroupJSONConst),
+				CollectionTotal: core.Int64Ptr(1),
+			},
+			Resources: []secretsmanagerv1.SecretGroupMetadataUpdatable{{
+				Name:        core.StringPtr(d.Get("name").(string)),
+				Description: core.StringPtr(d.Get("description").(string)),
+			}},
+		}
+		_, response, err := secretsManagerClient.UpdateSecretGroupMetadataWithContext(context, updateSecretGroupMetadataOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateSecretGroupMetadata failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("Error updating secret group %s: %s", secretGroupID, err))
+		}
+	}
+
+	return resourceIBMSecretsManagerSecretGroupRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerSecretGroupDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("instance_id").(string)
+	secretsManagerClient, err := secretsManagerInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secretGroupID := d.Get("secret_group_id").(string)
+	deleteSecretGroupOptions := &secretsmanagerv1.DeleteSecretGroupOptions{
+		ID: &secretGroupID,
+	}
+	response, err := secretsManagerClient.DeleteSecretGroupWithContext(context, deleteSecretGroupOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] DeleteSecretGroup failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error deleting secret group %s, the secrets of the group must be deleted first: %s", secretGroupID, err))
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIBMSecretsManagerSecretGroupImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, err := parseSecretsManagerID(d.Id(), 1, "<instance_id>/<secret_group_id>"); err != nil {
+		return nil, err
+	}
+	d.Set("endpoint_type", "public")
+	return []*schema.ResourceData{d}, nil
+}
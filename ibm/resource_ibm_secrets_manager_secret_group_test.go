@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gotest.tools/assert"
+)
+
+func testIBMSecretsManagerSecretGroupMock(t *testing.T, sm *testSecretsManager) {
+	r := resourceIBMSecretsManagerSecretGroup()
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"instance_id": sm.InstanceID,
+		"name":        "mock-group",
+		"description": "created by the mock test",
+	})
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), d, sm.Meta)))
+	assert.Equal(t, d.Id(), sm.InstanceID+"/"+d.Get("secret_group_id").(string))
+	assert.Assert(t, d.Get("creation_date").(string) != "")
+
+	updated := testMockResourceData(t, r, d, map[string]interface{}{
+		"instance_id": sm.InstanceID,
+		"name":        "mock-group-renamed",
+	})
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), updated, sm.Meta)))
+	assert.Equal(t, updated.Get("name"), "mock-group-renamed")
+	assert.Equal(t, updated.Get("description"), "")
+
+	// A group with secrets can't be deleted
+	secretResource := resourceIBMSecretsManagerSecret()
+	secret := schema.TestResourceDataRaw(t, secretResource.Schema, map[string]interface{}{
+		"instance_id":     sm.InstanceID,
+		"secret_type":     "arbitrary",
+		"name":            "mock-grouped-secret",
+		"payload":         "secret-data",
+		"secret_group_id": d.Get("secret_group_id"),
+	})
+	assert.NilError(t, testDiagsErr(secretResource.CreateContext(context.Background(), secret, sm.Meta)))
+	assert.ErrorContains(t, testDiagsErr(r.DeleteContext(context.Background(), updated, sm.Meta)), "must be deleted first")
+	assert.NilError(t, testDiagsErr(secretResource.DeleteContext(context.Background(), secret, sm.Meta)))
+
+	imported := r.Data(nil)
+	imported.SetId(d.Id())
+	states, err := r.Importer.StateContext(context.Background(), imported, sm.Meta)
+	assert.NilError(t, err)
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), states[0], sm.Meta)))
+	assert.Equal(t, states[0].Get("name"), "mock-group-renamed")
+	assert.Equal(t, states[0].Get("instance_id"), sm.InstanceID)
+
+	invalid := r.Data(nil)
+	invalid.SetId(sm.InstanceID)
+	_, err = r.Importer.StateContext(context.Background(), invalid, sm.Meta)
+	assert.ErrorContains(t, err, "<instance_id>/<secret_group_id>")
+
+	assert.NilError(t, testDiagsErr(r.DeleteContext(context.Background(), updated, sm.Meta)))
+	assert.Equal(t, updated.Id(), "")
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), states[0], sm.Meta)))
+	assert.Equal(t, states[0].Id(), "")
+}
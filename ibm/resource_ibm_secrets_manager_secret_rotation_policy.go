@@ -0,0 +1,184 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceIBMSecretsManagerSecretRotationPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSecretsManagerSecretRotationPolicyPut,
+		ReadContext:   resourceIBMSecretsManagerSecretRotationPolicyRead,
+		UpdateContext: resourceIBMSecretsManagerSecretRotationPolicyPut,
+		DeleteContext: resourceIBMSecretsManagerSecretRotationPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMSecretsManagerSecretImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Secrets Manager instance GUID",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "public",
+				ValidateFunc: validateAllowedStringValue([]string{"public", "private"}),
+				Description:  "Endpoint Type. 'public' or 'private'",
+			},
+			"secret_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      secretsmanagerv1.PutPolicyOptionsSecretTypeUsernamePasswordConst,
+				ValidateFunc: validateAllowedStringValue([]string{secretsmanagerv1.PutPolicyOptionsSecretTypeUsernamePasswordConst}),
+				Description:  "The secret type. Rotation policies are supported for the username_password secrets.",
+			},
+			"secret_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The v4 UUID that uniquely identifies the secret.",
+			},
+			"interval": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The length of the secret rotation time interval.",
+			},
+			"unit": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue([]string{secretsmanagerv1.SecretPolicyRotationRotationUnitDayConst, secretsmanagerv1.SecretPolicyRotationRotationUnitMonthConst}),
+				Description:  "The units of the secret rotation time interval, day or month.",
+			},
+			"policy_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The v4 UUID that uniquely identifies the policy.",
+			},
+			"creation_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the policy was created. The date format follows RFC 3339.",
+			},
+			"last_update_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Updates when the policy is modified. The date format follows RFC 3339.",
+			},
+		},
+	}
+}
+
+func resourceIBMSecretsManagerSecretRotationPolicyPut(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("instance_id").(string)
+	secretsManagerClient, err := secretsManagerInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secretType := d.Get("secret_type").(string)
+	secretID := d.Get("secret_id").(string)
+	putPolicyOptions := &secretsmanagerv1.PutPolicyOptions{
+		SecretType: &secretType,
+		ID:         &secretID,
+		Policy:     core.StringPtr(secretsmanagerv1.PutPolicyOptionsPolicyRotationConst),
+		Metadata: &secretsmanagerv1.CollectionMetadata{
+			CollectionType:  core.StringPtr(secretsmanagerv1.CollectionMetadataCollectionTypeApplicationVndIBMSecretsManagerSecretPolicyJSONConst),
+			CollectionTotal: core.Int64Ptr(1),
+		},
+		Resources: []secretsmanagerv1.SecretPolicyRotation{{
+			Type: core.StringPtr(secretsmanagerv1.SecretPolicyRotationTypeApplicationVndIBMSecretsManagerSecretPolicyJSONConst),
+			Rotation: &secretsmanagerv1.SecretPolicyRotationRotation{
+				Interval: core.Int64Ptr(int64(d.Get("interval").(int))),
+				Unit:     core.StringPtr(d.Get("unit").(string)),
+			},
+		}},
+	}
+	_, response, err := secretsManagerClient.PutPolicyWithContext(context, putPolicyOptions)
+	if err != nil {
+		log.Printf("[DEBUG] PutPolicy failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error setting the rotation policy of %s secret %s: %s", secretType, secretID, err))
+	}
+	d.SetId(secretsManagerSecretResourceID(instanceID, secretType, secretID))
+
+	return resourceIBMSecretsManagerSecretRotationPolicyRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerSecretRotationPolicyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := parseSecretsManagerID(d.Id(), 2, "<instance_id>/<secret_type>/<secret_id>")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceID, secretType, secretID := parts[0], parts[1], parts[2]
+	secretsManagerClient, err := secretsManagerInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getPolicyOptions := &secretsmanagerv1.GetPolicyOptions{
+		SecretType: &secretType,
+		ID:         &secretID,
+		Policy:     core.StringPtr(secretsmanagerv1.GetPolicyOptionsPolicyRotationConst),
+	}
+	getPolicy, response, err := secretsManagerClient.GetPolicyWithContext(context, getPolicyOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetPolicy failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error getting the rotation policy of %s secret %s: %s", secretType, secretID, err))
+	}
+	policies, ok := getPolicy.(*secretsmanagerv1.GetSecretPoliciesOneOf)
+	if !ok || len(policies.Resources) == 0 || policies.Resources[0].Rotation == nil {
+		d.SetId("")
+		return nil
+	}
+	policy := policies.Resources[0]
+
+	d.Set("instance_id", instanceID)
+	d.Set("secret_type", secretType)
+	d.Set("secret_id", secretID)
+	if policy.Rotation.Interval != nil {
+		d.Set("interval", *policy.Rotation.Interval)
+	}
+	if policy.Rotation.Unit != nil {
+		d.Set("unit", *policy.Rotation.Unit)
+	}
+	if policy.ID != nil {
+		d.Set("policy_id", *policy.ID)
+	}
+	if policy.CreationDate != nil {
+		d.Set("creation_date", policy.CreationDate.String())
+	}
+	if policy.LastUpdateDate != nil {
+		d.Set("last_update_date", policy.LastUpdateDate.String())
+	}
+
+	return nil
+}
+
+func resourceIBMSecretsManagerSecretRotationPolicyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The API has no way to remove a policy, which stays until the secret is deleted
+	d.SetId("")
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "The rotation policy is removed from the state only",
+		Detail:   fmt.Sprintf("The rotation policy of %s secret %s stays in effect until the secret is deleted.", d.Get("secret_type"), d.Get("secret_id")),
+	}}
+}
@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gotest.tools/assert"
+)
+
+func testIBMSecretsManagerSecretRotationPolicyMock(t *testing.T, sm *testSecretsManager) {
+	secretResource := resourceIBMSecretsManagerSecret()
+	secret := schema.TestResourceDataRaw(t, secretResource.Schema, map[string]interface{}{
+		"instance_id": sm.InstanceID,
+		"secret_type": "username_password",
+		"name":        "mock-rotated",
+		"username":    "mock-user",
+	})
+	assert.NilError(t, testDiagsErr(secretResource.CreateContext(context.Background(), secret, sm.Meta)))
+	assert.Equal(t, secret.Get("next_rotation_date"), "")
+
+	r := resourceIBMSecretsManagerSecretRotationPolicy()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"instance_id": sm.InstanceID,
+		"secret_id":   secret.Get("secret_id"),
+		"interval":    30,
+		"unit":        "day",
+	})
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), d, sm.Meta)))
+	assert.Equal(t, d.Id(), secret.Id())
+	assert.Assert(t, d.Get("policy_id").(string) != "")
+
+	updated := testMockResourceData(t, r, d, map[string]interface{}{
+		"instance_id": sm.InstanceID,
+		"secret_id":   secret.Get("secret_id"),
+		"interval":    2,
+		"unit":        "month",
+	})
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), updated, sm.Meta)))
+	assert.Equal(t, updated.Get("interval"), 2)
+	assert.Equal(t, updated.Get("unit"), "month")
+
+	assert.NilError(t, testDiagsErr(secretResource.ReadContext(context.Background(), secret, sm.Meta)))
+	assert.Assert(t, secret.Get("next_rotation_date").(string) != "")
+
+	imported := r.Data(nil)
+	imported.SetId(d.Id())
+	states, err := r.Importer.StateContext(context.Background(), imported, sm.Meta)
+	assert.NilError(t, err)
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), states[0], sm.Meta)))
+	assert.Equal(t, states[0].Get("interval"), 2)
+	assert.Equal(t, states[0].Get("secret_id"), secret.Get("secret_id"))
+
+	diags := r.DeleteContext(context.Background(), updated, sm.Meta)
+	assert.Equal(t, len(diags), 1)
+	assert.Equal(t, diags[0].Severity, diag.Warning)
+	assert.Equal(t, updated.Id(), "")
+
+	// The policy goes with its secret
+	assert.NilError(t, testDiagsErr(secretResource.DeleteContext(context.Background(), secret, sm.Meta)))
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), states[0], sm.Meta)))
+	assert.Equal(t, states[0].Id(), "")
+}
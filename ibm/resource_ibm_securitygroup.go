@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/filter"
@@ -17,12 +19,11 @@ import (
 
 func resourceIBMSecurityGroup() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMSecurityGroupCreate,
-		Read:     resourceIBMSecurityGroupRead,
-		Update:   resourceIBMSecurityGroupUpdate,
-		Delete:   resourceIBMSecurityGroupDelete,
-		Exists:   resourceIBMSecurityGroupExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMSecurityGroupCreate,
+		ReadContext:   resourceIBMSecurityGroupRead,
+		UpdateContext: resourceIBMSecurityGroupUpdate,
+		DeleteContext: resourceIBMSecurityGroupDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -40,7 +41,7 @@ func resourceIBMSecurityGroup() *schema.Resource {
 	}
 }
 
-func resourceIBMSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSecurityGroupCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess.SetRetries(0))
 
@@ -73,7 +74,7 @@ func resourceIBMSecurityGroupCreate(d *schema.ResourceData, meta interface{}) er
 
 		if editSG {
 			_, err = service.EditObject(&group)
-			return err
+			return diag.FromErr(err)
 		}
 
 		return nil
@@ -84,16 +85,25 @@ func resourceIBMSecurityGroupCreate(d *schema.ResourceData, meta interface{}) er
 	}
 	res, err := service.CreateObject(sg)
 	if err != nil {
-		return fmt.Errorf("Error creating Security Group: %s", err)
+		return diag.FromErr(fmt.Errorf("Error creating Security Group: %s", err))
 	}
 
 	d.SetId(strconv.Itoa(*res.Id))
 	log.Printf("[INFO] Security Group: %d", *res.Id)
 
-	return resourceIBMSecurityGroupRead(d, meta)
+	return resourceIBMSecurityGroupRead(context, d, meta)
 }
 
-func resourceIBMSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSecurityGroupRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMSecurityGroupExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
 
@@ -106,7 +116,7 @@ func resourceIBMSecurityGroupRead(d *schema.ResourceData, meta interface{}) erro
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error retrieving Security Group: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving Security Group: %s", err))
 	}
 
 	d.Set("name", group.Name)
@@ -114,18 +124,18 @@ func resourceIBMSecurityGroupRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceIBMSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSecurityGroupUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
 
 	groupID, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 	}
 
 	group, err := services.GetNetworkSecurityGroupService(sess).Id(groupID).GetObject()
 	if err != nil {
-		return fmt.Errorf("Error retrieving Security Group: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving Security Group: %s", err))
 	}
 
 	if d.HasChange("description") {
@@ -137,23 +147,23 @@ func resourceIBMSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 	_, err = service.Id(groupID).EditObject(&group)
 	if err != nil {
-		return fmt.Errorf("Error editing Security Group: %s", err)
+		return diag.FromErr(fmt.Errorf("Error editing Security Group: %s", err))
 	}
-	return resourceIBMSecurityGroupRead(d, meta)
+	return resourceIBMSecurityGroupRead(context, d, meta)
 }
 
-func resourceIBMSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSecurityGroupDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
 
 	groupID, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 	}
 	log.Printf("[INFO] Deleting Security Group: %d", groupID)
 	_, err = service.Id(groupID).DeleteObject()
 	if err != nil {
-		return fmt.Errorf("Error deleting Security Group: %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting Security Group: %s", err))
 	}
 
 	d.SetId("")
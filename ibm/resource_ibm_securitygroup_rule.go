@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/filter"
@@ -17,12 +19,11 @@ import (
 
 func resourceIBMSecurityGroupRule() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMSecurityGroupRuleCreate,
-		Read:     resourceIBMSecurityGroupRuleRead,
-		Delete:   resourceIBMSecurityGroupRuleDelete,
-		Update:   resourceIBMSecurityGroupRuleUpdate,
-		Exists:   resourceIBMSecurityGroupRuleExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMSecurityGroupRuleCreate,
+		ReadContext:   resourceIBMSecurityGroupRuleRead,
+		DeleteContext: resourceIBMSecurityGroupRuleDelete,
+		UpdateContext: resourceIBMSecurityGroupRuleUpdate,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"direction": {
@@ -117,7 +118,7 @@ func findMatchingRule(sgID int, rule *datatypes.Network_SecurityGroup_Rule,
 	return &rules[0], nil
 }
 
-func resourceIBMSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSecurityGroupRuleCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
 
@@ -162,7 +163,7 @@ func resourceIBMSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}
 
 	matchingrule, err := findMatchingRule(sgID, &sgrule, service)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if matchingrule != nil {
@@ -177,19 +178,28 @@ func resourceIBMSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}
 	log.Println("[INFO] creating security group rule")
 	_, err = service.Id(sgID).AddRules(opts)
 	if err != nil {
-		return fmt.Errorf("Error creating Security Group Rule: %s", err)
+		return diag.FromErr(fmt.Errorf("Error creating Security Group Rule: %s", err))
 	}
 
 	matchingrule, err = findMatchingRule(sgID, &sgrule, service)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(strconv.Itoa(*matchingrule.Id))
 
-	return resourceIBMSecurityGroupRuleRead(d, meta)
+	return resourceIBMSecurityGroupRuleRead(context, d, meta)
 }
 
-func resourceIBMSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSecurityGroupRuleRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMSecurityGroupRuleExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
 
@@ -203,7 +213,7 @@ func resourceIBMSecurityGroupRuleRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error retrieving Security Group Rule: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving Security Group Rule: %s", err))
 	}
 
 	if len(matchingrules) == 0 {
@@ -235,14 +245,14 @@ func resourceIBMSecurityGroupRuleRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceIBMSecurityGroupRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSecurityGroupRuleUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
 	securityGroupID := d.Get("security_group_id").(int)
 	matchingrules, err := service.Filter(filter.Build(
 		filter.Path("rules.id").Eq(d.Id()))).Id(securityGroupID).GetRules()
 	if err != nil {
-		return fmt.Errorf("Error retrieving Security Group Rule: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving Security Group Rule: %s", err))
 	}
 	if d.HasChange("direction") {
 		matchingrules[0].Direction = sl.String(d.Get("direction").(string))
@@ -267,12 +277,12 @@ func resourceIBMSecurityGroupRuleUpdate(d *schema.ResourceData, meta interface{}
 	}
 	_, err = service.Id(securityGroupID).EditRules([]datatypes.Network_SecurityGroup_Rule{matchingrules[0]})
 	if err != nil {
-		return fmt.Errorf("Couldn't update Security Group Rule: %s", err)
+		return diag.FromErr(fmt.Errorf("Couldn't update Security Group Rule: %s", err))
 	}
-	return resourceIBMSecurityGroupRuleRead(d, meta)
+	return resourceIBMSecurityGroupRuleRead(context, d, meta)
 }
 
-func resourceIBMSecurityGroupRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSecurityGroupRuleDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
 	sgID := d.Get("security_group_id").(int)
@@ -283,7 +293,7 @@ func resourceIBMSecurityGroupRuleDelete(d *schema.ResourceData, meta interface{}
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error deleting Security Group Rule: %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting Security Group Rule: %s", err))
 	}
 
 	d.SetId("")
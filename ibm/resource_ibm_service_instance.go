@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/IBM-Cloud/bluemix-go/api/mccp/mccpv2"
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/bluemix-go/helpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -23,12 +25,11 @@ const (
 
 func resourceIBMServiceInstance() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMServiceInstanceCreate,
-		Read:     resourceIBMServiceInstanceRead,
-		Update:   resourceIBMServiceInstanceUpdate,
-		Delete:   resourceIBMServiceInstanceDelete,
-		Exists:   resourceIBMServiceInstanceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMServiceInstanceCreate,
+		ReadContext:   resourceIBMServiceInstanceRead,
+		UpdateContext: resourceIBMServiceInstanceUpdate,
+		DeleteContext: resourceIBMServiceInstanceDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -118,10 +119,10 @@ func resourceIBMServiceInstance() *schema.Resource {
 	}
 }
 
-func resourceIBMServiceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMServiceInstanceCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	serviceName := d.Get("service").(string)
 	plan := d.Get("plan").(string)
@@ -135,12 +136,12 @@ func resourceIBMServiceInstanceCreate(d *schema.ResourceData, meta interface{})
 
 	serviceOff, err := cfClient.ServiceOfferings().FindByLabel(serviceName)
 	if err != nil {
-		return fmt.Errorf("Error retrieving service offering: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving service offering: %s", err))
 	}
 
 	servicePlan, err := cfClient.ServicePlans().FindPlanInServiceOffering(serviceOff.GUID, plan)
 	if err != nil {
-		return fmt.Errorf("Error retrieving plan: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving plan: %s", err))
 	}
 	svcInst.PlanGUID = servicePlan.GUID
 
@@ -165,31 +166,40 @@ func resourceIBMServiceInstanceCreate(d *schema.ResourceData, meta interface{})
 
 	service, err := cfClient.ServiceInstances().Create(svcInst)
 	if err != nil {
-		return fmt.Errorf("Error creating service: %s", err)
+		return diag.FromErr(fmt.Errorf("Error creating service: %s", err))
 	}
 
 	d.SetId(service.Metadata.GUID)
 
-	_, err = waitForServiceInstanceAvailable(d, meta)
+	_, err = waitForServiceInstanceAvailable(context, d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for create service (%s) to be succeeded: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf(
+			"Error waiting for create service (%s) to be succeeded: %s", d.Id(), err))
 	}
 
-	return resourceIBMServiceInstanceRead(d, meta)
+	return resourceIBMServiceInstanceRead(context, d, meta)
 }
 
-func resourceIBMServiceInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMServiceInstanceRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMServiceInstanceExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	serviceGUID := d.Id()
 
 	service, err := cfClient.ServiceInstances().Get(serviceGUID, 1)
 	if err != nil {
-		return fmt.Errorf("Error retrieving service: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving service: %s", err))
 	}
 
 	servicePlanGUID := service.Entity.ServicePlanGUID
@@ -206,17 +216,17 @@ func resourceIBMServiceInstanceRead(d *schema.ResourceData, meta interface{}) er
 
 	svcOff, err := cfClient.ServiceOfferings().Get(service.Entity.ServicePlan.Entity.ServiceGUID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set("service", svcOff.Entity.Label)
 
 	return nil
 }
 
-func resourceIBMServiceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMServiceInstanceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	serviceGUID := d.Id()
@@ -231,12 +241,12 @@ func resourceIBMServiceInstanceUpdate(d *schema.ResourceData, meta interface{})
 		service := d.Get("service").(string)
 		serviceOff, err := cfClient.ServiceOfferings().FindByLabel(service)
 		if err != nil {
-			return fmt.Errorf("Error retrieving service offering: %s", err)
+			return diag.FromErr(fmt.Errorf("Error retrieving service offering: %s", err))
 		}
 
 		servicePlan, err := cfClient.ServicePlans().FindPlanInServiceOffering(serviceOff.GUID, plan)
 		if err != nil {
-			return fmt.Errorf("Error retrieving plan: %s", err)
+			return diag.FromErr(fmt.Errorf("Error retrieving plan: %s", err))
 		}
 		updateReq.PlanGUID = helpers.String(servicePlan.GUID)
 
@@ -253,34 +263,34 @@ func resourceIBMServiceInstanceUpdate(d *schema.ResourceData, meta interface{})
 
 	_, err = cfClient.ServiceInstances().Update(serviceGUID, updateReq)
 	if err != nil {
-		return fmt.Errorf("Error updating service: %s", err)
+		return diag.FromErr(fmt.Errorf("Error updating service: %s", err))
 	}
 
-	_, err = waitForServiceInstanceAvailable(d, meta)
+	_, err = waitForServiceInstanceAvailable(context, d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for update service (%s) to be succeeded: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf(
+			"Error waiting for update service (%s) to be succeeded: %s", d.Id(), err))
 	}
 
-	return resourceIBMServiceInstanceRead(d, meta)
+	return resourceIBMServiceInstanceRead(context, d, meta)
 }
 
-func resourceIBMServiceInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMServiceInstanceDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 
 	err = cfClient.ServiceInstances().Delete(id, true)
 	if err != nil {
-		return fmt.Errorf("Error deleting service: %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting service: %s", err))
 	}
 
-	_, err = waitForServiceInstanceDelete(d, meta)
+	_, err = waitForServiceInstanceDelete(context, d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for service (%s) to be deleted: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf(
+			"Error waiting for service (%s) to be deleted: %s", d.Id(), err))
 	}
 
 	d.SetId("")
@@ -323,7 +333,7 @@ func getServiceTags(d *schema.ResourceData) []string {
 	return tags
 }
 
-func waitForServiceInstanceAvailable(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForServiceInstanceAvailable(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
 		return false, err
@@ -351,10 +361,10 @@ func waitForServiceInstanceAvailable(d *schema.ResourceData, meta interface{}) (
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
-func waitForServiceInstanceDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForServiceInstanceDelete(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
 		return false, err
@@ -381,5 +391,5 @@ func waitForServiceInstanceDelete(d *schema.ResourceData, meta interface{}) (int
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
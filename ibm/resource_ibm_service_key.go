@@ -4,21 +4,22 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMServiceKey() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMServiceKeyCreate,
-		Read:     resourceIBMServiceKeyRead,
-		Update:   resourceIBMServiceKeyUpdate,
-		Delete:   resourceIBMServiceKeyDelete,
-		Exists:   resourceIBMServiceKeyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMServiceKeyCreate,
+		ReadContext:   resourceIBMServiceKeyRead,
+		UpdateContext: resourceIBMServiceKeyUpdate,
+		DeleteContext: resourceIBMServiceKeyDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -56,10 +57,10 @@ func resourceIBMServiceKey() *schema.Resource {
 	}
 }
 
-func resourceIBMServiceKeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMServiceKeyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	name := d.Get("name").(string)
 	serviceInstanceGUID := d.Get("service_instance_guid").(string)
@@ -81,29 +82,38 @@ func resourceIBMServiceKeyCreate(d *schema.ResourceData, meta interface{}) error
 
 	serviceKey, err := cfClient.ServiceKeys().Create(serviceInstanceGUID, name, keyParams)
 	if err != nil {
-		return fmt.Errorf("Error creating service key: %s", err)
+		return diag.FromErr(fmt.Errorf("Error creating service key: %s", err))
 	}
 
 	d.SetId(serviceKey.Metadata.GUID)
 
-	return resourceIBMServiceKeyRead(d, meta)
+	return resourceIBMServiceKeyRead(context, d, meta)
 }
 
-func resourceIBMServiceKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMServiceKeyUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	//Only tags are updated and that too locally hence nothing to validate and update in terms of real API at this point
 	return nil
 }
 
-func resourceIBMServiceKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMServiceKeyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMServiceKeyExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	serviceKeyGUID := d.Id()
 
 	serviceKey, err := cfClient.ServiceKeys().Get(serviceKeyGUID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving service key: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving service key: %s", err))
 	}
 	d.Set("credentials", Flatten(serviceKey.Entity.Credentials))
 	d.Set("service_instance_guid", serviceKey.Entity.ServiceInstanceGUID)
@@ -112,17 +122,17 @@ func resourceIBMServiceKeyRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMServiceKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMServiceKeyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	serviceKeyGUID := d.Id()
 
 	err = cfClient.ServiceKeys().Delete(serviceKeyGUID)
 	if err != nil {
-		return fmt.Errorf("Error deleting service key: %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting service key: %s", err))
 	}
 
 	d.SetId("")
@@ -4,22 +4,23 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM-Cloud/bluemix-go/api/mccp/mccpv2"
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/bluemix-go/helpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMSpace() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMSpaceCreate,
-		Read:     resourceIBMSpaceRead,
-		Update:   resourceIBMSpaceUpdate,
-		Delete:   resourceIBMSpaceDelete,
-		Exists:   resourceIBMSpaceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMSpaceCreate,
+		ReadContext:   resourceIBMSpaceRead,
+		UpdateContext: resourceIBMSpaceUpdate,
+		DeleteContext: resourceIBMSpaceDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -67,10 +68,10 @@ func resourceIBMSpace() *schema.Resource {
 	}
 }
 
-func resourceIBMSpaceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSpaceCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	org := d.Get("org").(string)
 	name := d.Get("name").(string)
@@ -81,14 +82,14 @@ func resourceIBMSpaceCreate(d *schema.ResourceData, meta interface{}) error {
 
 	orgFields, err := cfClient.Organizations().FindByName(org, BluemixRegion)
 	if err != nil {
-		return fmt.Errorf("Error retrieving org: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving org: %s", err))
 	}
 	req.OrgGUID = orgFields.GUID
 
 	if spaceQuota, ok := d.GetOk("space_quota"); ok {
 		quota, err := cfClient.SpaceQuotas().FindByName(spaceQuota.(string), orgFields.GUID)
 		if err != nil {
-			return fmt.Errorf("Error retrieving space quota: %s", err)
+			return diag.FromErr(fmt.Errorf("Error retrieving space quota: %s", err))
 		}
 		req.SpaceQuotaGUID = quota.GUID
 	}
@@ -96,7 +97,7 @@ func resourceIBMSpaceCreate(d *schema.ResourceData, meta interface{}) error {
 	spaceAPI := cfClient.Spaces()
 	space, err := spaceAPI.Create(req)
 	if err != nil {
-		return fmt.Errorf("Error creating space: %s", err)
+		return diag.FromErr(fmt.Errorf("Error creating space: %s", err))
 	}
 
 	spaceGUID := space.Metadata.GUID
@@ -107,7 +108,7 @@ func resourceIBMSpaceCreate(d *schema.ResourceData, meta interface{}) error {
 		for _, d := range developers {
 			_, err := spaceAPI.AssociateDeveloper(spaceGUID, d)
 			if err != nil {
-				return fmt.Errorf("Error associating developer %s with space %s : %s", d, spaceGUID, err)
+				return diag.FromErr(fmt.Errorf("Error associating developer %s with space %s : %s", d, spaceGUID, err))
 			}
 		}
 	}
@@ -117,7 +118,7 @@ func resourceIBMSpaceCreate(d *schema.ResourceData, meta interface{}) error {
 		for _, d := range auditors {
 			_, err := spaceAPI.AssociateAuditor(spaceGUID, d)
 			if err != nil {
-				return fmt.Errorf("Error associating auditor %s with space %s : %s", d, spaceGUID, err)
+				return diag.FromErr(fmt.Errorf("Error associating auditor %s with space %s : %s", d, spaceGUID, err))
 			}
 		}
 
@@ -127,18 +128,27 @@ func resourceIBMSpaceCreate(d *schema.ResourceData, meta interface{}) error {
 		for _, d := range managers {
 			_, err := spaceAPI.AssociateManager(spaceGUID, d)
 			if err != nil {
-				return fmt.Errorf("Error associating manager %s with space %s : %s", d, spaceGUID, err)
+				return diag.FromErr(fmt.Errorf("Error associating manager %s with space %s : %s", d, spaceGUID, err))
 			}
 		}
 	}
 
-	return resourceIBMSpaceRead(d, meta)
+	return resourceIBMSpaceRead(context, d, meta)
 }
 
-func resourceIBMSpaceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSpaceRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMSpaceExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	spaceGUID := d.Id()
 
@@ -146,22 +156,22 @@ func resourceIBMSpaceRead(d *schema.ResourceData, meta interface{}) error {
 	orgAPI := cfClient.Organizations()
 	spaceDetails, err := spaceAPI.Get(spaceGUID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving space: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving space: %s", err))
 	}
 
 	auditors, err := spaceAPI.ListAuditors(spaceGUID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving auditors in the space: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving auditors in the space: %s", err))
 	}
 
 	managers, err := spaceAPI.ListManagers(spaceGUID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving managers in the space: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving managers in the space: %s", err))
 	}
 
 	developers, err := spaceAPI.ListDevelopers(spaceGUID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving developers in space: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving developers in space: %s", err))
 	}
 
 	d.Set("auditors", flattenSpaceRoleUsers(auditors))
@@ -172,23 +182,23 @@ func resourceIBMSpaceRead(d *schema.ResourceData, meta interface{}) error {
 		sqAPI := cfClient.SpaceQuotas()
 		quota, err := sqAPI.Get(spaceDetails.Entity.SpaceQuotaGUID)
 		if err != nil {
-			return fmt.Errorf("Error retrieving quotas details for space: %s", err)
+			return diag.FromErr(fmt.Errorf("Error retrieving quotas details for space: %s", err))
 		}
 		d.Set("space_quota", quota.Entity.Name)
 	}
 	d.Set("name", spaceDetails.Entity.Name)
 	org, err := orgAPI.Get(spaceDetails.Entity.OrgGUID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Organization details for space: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving Organization details for space: %s", err))
 	}
 	d.Set("org", org.Entity.Name)
 	return nil
 }
 
-func resourceIBMSpaceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSpaceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 
@@ -200,34 +210,34 @@ func resourceIBMSpaceUpdate(d *schema.ResourceData, meta interface{}) error {
 	api := cfClient.Spaces()
 	_, err = api.Update(id, req)
 	if err != nil {
-		return fmt.Errorf("Error updating space: %s", err)
+		return diag.FromErr(fmt.Errorf("Error updating space: %s", err))
 	}
 
 	err = updateAuditors(api, id, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = updateManagers(api, id, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = updateDevelopers(api, id, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMSpaceRead(d, meta)
+	return resourceIBMSpaceRead(context, d, meta)
 }
 
-func resourceIBMSpaceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSpaceDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 
 	err = cfClient.Spaces().Delete(id, false)
 	if err != nil {
-		return fmt.Errorf("Error deleting space: %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting space: %s", err))
 	}
 
 	d.SetId("")
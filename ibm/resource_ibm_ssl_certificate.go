@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	fmt "fmt"
 	"log"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -29,11 +31,11 @@ const (
 
 func resourceIBMSSLCertificate() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMSSLCertificateCreate,
-		Read:     resourceIBMSSLCertificateRead,
-		Update:   resourceIBMSSLCertificateUpdate,
-		Delete:   resourceIBMSSLCertificateDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMSSLCertificateCreate,
+		ReadContext:   resourceIBMSSLCertificateRead,
+		UpdateContext: resourceIBMSSLCertificateUpdate,
+		DeleteContext: resourceIBMSSLCertificateDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 
@@ -426,17 +428,17 @@ func resourceIBMSSLCertificate() *schema.Resource {
 		},
 	}
 }
-func resourceIBMSSLCertificateCreate(d *schema.ResourceData, m interface{}) error {
+func resourceIBMSSLCertificateCreate(context context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sess := m.(ClientSession).SoftLayerSession()
 	service := services.GetSecurityCertificateRequestService(sess.SetRetries(0))
 	sslKeyName := sl.String(d.Get("ssl_type").(string))
 	pkg, err := product.GetPackageByType(sess, AdditionalServicesSSLCertificatePackageType)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	productItems, err := product.GetPackageProducts(sess, *pkg.Id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	var itemId *int
 	for _, item := range productItems {
@@ -446,7 +448,7 @@ func resourceIBMSSLCertificateCreate(d *schema.ResourceData, m interface{}) erro
 	}
 	validCSR, err := service.ValidateCsr(sl.String(d.Get("certificate_signing_request").(string)), sl.Int(d.Get("validity_months").(int)), itemId, sl.String(d.Get("server_type").(string)))
 	if err != nil {
-		return fmt.Errorf("Error during validation of CSR: %s", err)
+		return diag.FromErr(fmt.Errorf("Error during validation of CSR: %s", err))
 	}
 	if validCSR == true {
 		productOrderContainer, err := buildSSLProductOrderContainer(d, sess, AdditionalServicesSSLCertificatePackageType)
@@ -454,13 +456,13 @@ func resourceIBMSSLCertificateCreate(d *schema.ResourceData, m interface{}) erro
 			// Find price items with AdditionalServices
 			productOrderContainer, err = buildSSLProductOrderContainer(d, sess, AdditionalSSLServicesPackageType)
 			if err != nil {
-				return fmt.Errorf("Error creating SSL certificate: %s", err)
+				return diag.FromErr(fmt.Errorf("Error creating SSL certificate: %s", err))
 			}
 		}
 		log.Printf("[INFO] Creating SSL Certificate")
 		verifiedOrderContainer, err := services.GetProductOrderService(sess).VerifyOrder(productOrderContainer)
 		if err != nil {
-			return fmt.Errorf("Order verification failed: %s", err)
+			return diag.FromErr(fmt.Errorf("Order verification failed: %s", err))
 		}
 
 		servercorecount := verifiedOrderContainer.ServerCoreCount
@@ -469,57 +471,57 @@ func resourceIBMSSLCertificateCreate(d *schema.ResourceData, m interface{}) erro
 		receipt, err := services.GetProductOrderService(sess).PlaceOrder(productOrderContainer, sl.Bool(false))
 
 		if err != nil {
-			return fmt.Errorf("Error during creation of ssl: %s", err)
+			return diag.FromErr(fmt.Errorf("Error during creation of ssl: %s", err))
 		}
 
-		ssl, err := findSSLByOrderId(sess, *receipt.OrderId)
+		ssl, err := findSSLByOrderId(context, sess, *receipt.OrderId)
 		d.SetId(fmt.Sprintf("%d", *ssl.Id))
-		return resourceIBMSSLCertificateRead(d, m)
+		return resourceIBMSSLCertificateRead(context, d, m)
 	} else {
 		log.Println("Provided CSR is not valid.")
-		return fmt.Errorf("Error while validating CSR: %s", err)
+		return diag.FromErr(fmt.Errorf("Error while validating CSR: %s", err))
 	}
 }
 
-func resourceIBMSSLCertificateRead(d *schema.ResourceData, m interface{}) error {
+func resourceIBMSSLCertificateRead(context context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sess := m.(ClientSession).SoftLayerSession()
 	service := services.GetSecurityCertificateRequestService(sess)
 	sslId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid SSL ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("Not a valid SSL ID, must be an integer: %s", err))
 	}
 
 	ssl, err := service.Id(sslId).Mask(SSLMask).GetObject()
 	if err != nil {
-		return fmt.Errorf("Error retrieving SSL: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving SSL: %s", err))
 	}
 	d.Set("certificate_signing_request", ssl.CertificateSigningRequest)
 	return nil
 }
 
-func resourceIBMSSLCertificateUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceIBMSSLCertificateUpdate(context context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func resourceIBMSSLCertificateDelete(d *schema.ResourceData, m interface{}) error {
+func resourceIBMSSLCertificateDelete(context context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sess := m.(ClientSession).SoftLayerSession()
 	service := services.GetSecurityCertificateService(sess)
 	service1 := services.GetSecurityCertificateRequestService(sess)
 	sslId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid SSL ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("Not a valid SSL ID, must be an integer: %s", err))
 	}
 
 	value, err := service1.Id(sslId).GetObject()
 	if err != nil {
-		return fmt.Errorf("Not a valid Object ID: %s", err)
+		return diag.FromErr(fmt.Errorf("Not a valid Object ID: %s", err))
 	}
 	sslReqId := value.StatusId
 
 	if *sslReqId == 49 || *sslReqId == 43 {
 		deleteObject, err := service.Id(sslId).DeleteObject()
 		if deleteObject == false {
-			return fmt.Errorf("Error deleting SSL: %s", err)
+			return diag.FromErr(fmt.Errorf("Error deleting SSL: %s", err))
 		} else {
 			d.SetId("")
 			return nil
@@ -527,7 +529,7 @@ func resourceIBMSSLCertificateDelete(d *schema.ResourceData, m interface{}) erro
 	} else if *sslReqId == 50 {
 		cancelObject, err := service1.Id(sslId).CancelSslOrder()
 		if cancelObject == false {
-			return fmt.Errorf("Error deleting SSL: %s", err)
+			return diag.FromErr(fmt.Errorf("Error deleting SSL: %s", err))
 		} else {
 			d.SetId("")
 			return nil
@@ -814,7 +816,7 @@ func buildSSLProductOrderContainer(d *schema.ResourceData, sess *session1.Sessio
 	return &sslContainer, nil
 }
 
-func findSSLByOrderId(sess *session1.Session, orderId int) (datatypes.Security_Certificate_Request, error) {
+func findSSLByOrderId(context context.Context, sess *session1.Session, orderId int) (datatypes.Security_Certificate_Request, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"pending"},
 		Target:  []string{"complete"},
@@ -837,7 +839,7 @@ func findSSLByOrderId(sess *session1.Session, orderId int) (datatypes.Security_C
 		Delay:      5 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := stateConf.WaitForStateContext(context)
 
 	if err != nil {
 		return datatypes.Security_Certificate_Request{}, err
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -11,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/helpers/network"
@@ -20,12 +22,11 @@ import (
 
 func resourceIBMStorageBlock() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMStorageBlockCreate,
-		Read:     resourceIBMStorageBlockRead,
-		Update:   resourceIBMStorageBlockUpdate,
-		Delete:   resourceIBMStorageBlockDelete,
-		Exists:   resourceIBMStorageBlockExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMStorageBlockCreate,
+		ReadContext:   resourceIBMStorageBlockRead,
+		UpdateContext: resourceIBMStorageBlockUpdate,
+		DeleteContext: resourceIBMStorageBlockDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(45 * time.Minute),
@@ -246,7 +247,7 @@ func resourceIBMStorageBlock() *schema.Resource {
 	}
 }
 
-func resourceIBMStorageBlockCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMStorageBlockCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	storageType := d.Get("type").(string)
@@ -259,12 +260,12 @@ func resourceIBMStorageBlockCreate(d *schema.ResourceData, meta interface{}) err
 	hourlyBilling := d.Get("hourly_billing").(bool)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	storageOrderContainer, err := buildStorageProductOrderContainer(sess, storageType, iops, capacity, snapshotCapacity, blockStorage, datacenter, hourlyBilling)
 	if err != nil {
-		return fmt.Errorf("Error while creating storage:%s", err)
+		return diag.FromErr(fmt.Errorf("Error while creating storage:%s", err))
 	}
 
 	log.Println("[INFO] Creating storage")
@@ -294,43 +295,52 @@ func resourceIBMStorageBlockCreate(d *schema.ResourceData, meta interface{}) err
 				VolumeSize: &capacity,
 			}, sl.Bool(false))
 	default:
-		return fmt.Errorf("Error during creation of storage: Invalid storageType %s", storageType)
+		return diag.FromErr(fmt.Errorf("Error during creation of storage: Invalid storageType %s", storageType))
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error during creation of storage: %s", err)
+		return diag.FromErr(fmt.Errorf("Error during creation of storage: %s", err))
 	}
 
 	// Find the storage device
-	blockStorage, err := findStorageByOrderId(sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
+	blockStorage, err := findStorageByOrderId(context, sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
-		return fmt.Errorf("Error during creation of storage: %s", err)
+		return diag.FromErr(fmt.Errorf("Error during creation of storage: %s", err))
 	}
 	d.SetId(fmt.Sprintf("%d", *blockStorage.Id))
 
 	// Wait for storage availability
-	_, err = WaitForStorageAvailable(d, meta)
+	_, err = WaitForStorageAvailable(context, d, meta)
 
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for storage (%s) to become ready: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf(
+			"Error waiting for storage (%s) to become ready: %s", d.Id(), err))
 	}
 
 	// SoftLayer changes the device ID after completion of provisioning. It is necessary to refresh device ID.
-	blockStorage, err = findStorageByOrderId(sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
+	blockStorage, err = findStorageByOrderId(context, sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
-		return fmt.Errorf("Error during creation of storage: %s", err)
+		return diag.FromErr(fmt.Errorf("Error during creation of storage: %s", err))
 	}
 	d.SetId(fmt.Sprintf("%d", *blockStorage.Id))
 
 	log.Printf("[INFO] Storage ID: %s", d.Id())
 
-	return resourceIBMStorageBlockUpdate(d, meta)
+	return resourceIBMStorageBlockUpdate(context, d, meta)
 }
 
-func resourceIBMStorageBlockRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMStorageBlockRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMStorageBlockExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 	storageId, _ := strconv.Atoi(d.Id())
 
@@ -340,7 +350,7 @@ func resourceIBMStorageBlockRead(d *schema.ResourceData, meta interface{}) error
 		GetObject()
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving storage information: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving storage information: %s", err))
 	}
 
 	storageType := strings.Fields(*storage.StorageType.Description)[0]
@@ -348,7 +358,7 @@ func resourceIBMStorageBlockRead(d *schema.ResourceData, meta interface{}) error
 	// Calculate IOPS
 	iops, err := getIops(storage, storageType)
 	if err != nil {
-		return fmt.Errorf("Error retrieving storage information: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving storage information: %s", err))
 	}
 
 	d.Set("type", storageType)
@@ -436,11 +446,11 @@ func resourceIBMStorageBlockRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMStorageBlockUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMStorageBlockUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 	}
 
 	storage, err := services.GetNetworkStorageService(sess).
@@ -449,14 +459,14 @@ func resourceIBMStorageBlockUpdate(d *schema.ResourceData, meta interface{}) err
 		GetObject()
 
 	if err != nil {
-		return fmt.Errorf("Error updating storage information: %s", err)
+		return diag.FromErr(fmt.Errorf("Error updating storage information: %s", err))
 	}
 
 	// Update allowed_ip_addresses
 	if d.HasChange("allowed_ip_addresses") {
 		err := updateAllowedIpAddresses(d, sess, storage)
 		if err != nil {
-			return fmt.Errorf("Error updating storage information: %s", err)
+			return diag.FromErr(fmt.Errorf("Error updating storage information: %s", err))
 		}
 	}
 
@@ -464,7 +474,7 @@ func resourceIBMStorageBlockUpdate(d *schema.ResourceData, meta interface{}) err
 	if d.HasChange("allowed_subnets") {
 		err := updateAllowedSubnets(d, sess, storage)
 		if err != nil {
-			return fmt.Errorf("Error updating storage information: %s", err)
+			return diag.FromErr(fmt.Errorf("Error updating storage information: %s", err))
 		}
 	}
 
@@ -472,7 +482,7 @@ func resourceIBMStorageBlockUpdate(d *schema.ResourceData, meta interface{}) err
 	if d.HasChange("allowed_virtual_guest_ids") {
 		err := updateAllowedVirtualGuestIds(d, sess, storage)
 		if err != nil {
-			return fmt.Errorf("Error updating storage information: %s", err)
+			return diag.FromErr(fmt.Errorf("Error updating storage information: %s", err))
 		}
 	}
 
@@ -480,7 +490,7 @@ func resourceIBMStorageBlockUpdate(d *schema.ResourceData, meta interface{}) err
 	if d.HasChange("allowed_hardware_ids") {
 		err := updateAllowedHardwareIds(d, sess, storage)
 		if err != nil {
-			return fmt.Errorf("Error updating storage information: %s", err)
+			return diag.FromErr(fmt.Errorf("Error updating storage information: %s", err))
 		}
 	}
 
@@ -488,7 +498,7 @@ func resourceIBMStorageBlockUpdate(d *schema.ResourceData, meta interface{}) err
 	if d.HasChange("notes") {
 		err := updateNotes(d, sess, storage)
 		if err != nil {
-			return fmt.Errorf("Error updating storage information: %s", err)
+			return diag.FromErr(fmt.Errorf("Error updating storage information: %s", err))
 		}
 	}
 
@@ -498,7 +508,7 @@ func resourceIBMStorageBlockUpdate(d *schema.ResourceData, meta interface{}) err
 
 		modifyOrder, err := prepareModifyOrder(sess, storage, iops, size)
 		if err != nil {
-			return fmt.Errorf("Error updating storage: %s", err)
+			return diag.FromErr(fmt.Errorf("Error updating storage: %s", err))
 		}
 
 		_, err = services.GetProductOrderService(sess.SetRetries(0)).PlaceOrder(
@@ -509,19 +519,19 @@ func resourceIBMStorageBlockUpdate(d *schema.ResourceData, meta interface{}) err
 				},
 			}, sl.Bool(false))
 		// Wait for storage availability
-		_, err = WaitForStorageUpdate(d, meta)
+		_, err = WaitForStorageUpdate(context, d, meta)
 
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for storage (%s) to update: %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf(
+				"Error waiting for storage (%s) to update: %s", d.Id(), err))
 		}
 	}
 
-	return resourceIBMStorageBlockRead(d, meta)
+	return resourceIBMStorageBlockRead(context, d, meta)
 }
 
-func resourceIBMStorageBlockDelete(d *schema.ResourceData, meta interface{}) error {
-	return resourceIBMStorageFileDelete(d, meta)
+func resourceIBMStorageBlockDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceIBMStorageFileDelete(context, d, meta)
 }
 
 func resourceIBMStorageBlockExists(d *schema.ResourceData, meta interface{}) (bool, error) {
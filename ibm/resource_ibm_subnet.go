@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -11,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -36,12 +38,11 @@ var (
 
 func resourceIBMSubnet() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMSubnetCreate,
-		Read:     resourceIBMSubnetRead,
-		Update:   resourceIBMSubnetUpdate,
-		Delete:   resourceIBMSubnetDelete,
-		Exists:   resourceIBMSubnetExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMSubnetCreate,
+		ReadContext:   resourceIBMSubnetRead,
+		UpdateContext: resourceIBMSubnetUpdate,
+		DeleteContext: resourceIBMSubnetDelete,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 		},
@@ -138,13 +139,13 @@ func resourceIBMSubnet() *schema.Resource {
 	}
 }
 
-func resourceIBMSubnetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSubnetCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	// Find price items with AdditionalServicesSubnetAddresses
 	productOrderContainer, err := buildSubnetProductOrderContainer(d, sess)
 	if err != nil {
-		return fmt.Errorf("Error creating subnet: %s", err)
+		return diag.FromErr(fmt.Errorf("Error creating subnet: %s", err))
 	}
 
 	log.Println("[INFO] Creating subnet")
@@ -152,31 +153,40 @@ func resourceIBMSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 	receipt, err := services.GetProductOrderService(sess.SetRetries(0)).
 		PlaceOrder(productOrderContainer, sl.Bool(false))
 	if err != nil {
-		return fmt.Errorf("Error during creation of subnet: %s", err)
+		return diag.FromErr(fmt.Errorf("Error during creation of subnet: %s", err))
 	}
 
-	Subnet, err := findSubnetByOrderID(sess, *receipt.OrderId, d)
+	Subnet, err := findSubnetByOrderID(context, sess, *receipt.OrderId, d)
 	if err != nil {
-		return fmt.Errorf("Error during creation of subnet: %s", err)
+		return diag.FromErr(fmt.Errorf("Error during creation of subnet: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%d", *Subnet.Id))
 
-	return resourceIBMSubnetUpdate(d, meta)
+	return resourceIBMSubnetUpdate(context, d, meta)
 }
 
-func resourceIBMSubnetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSubnetRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMSubnetExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSubnetService(sess)
 
 	subnetID, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid subnet ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("Not a valid subnet ID, must be an integer: %s", err))
 	}
 
 	subnet, err := service.Id(subnetID).Mask(SubnetMask).GetObject()
 	if err != nil {
-		return fmt.Errorf("Error retrieving a subnet: %s", err)
+		return diag.FromErr(fmt.Errorf("Error retrieving a subnet: %s", err))
 	}
 
 	if *subnet.AddressSpace == "PRIVATE" {
@@ -186,14 +196,14 @@ func resourceIBMSubnetRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if subnet.SubnetType == nil {
-		return fmt.Errorf("Invalid vlan type: the subnet type is null")
+		return diag.FromErr(fmt.Errorf("Invalid vlan type: the subnet type is null"))
 	}
 	if strings.Contains(*subnet.SubnetType, "STATIC") {
 		d.Set("type", "Static")
 	} else if strings.Contains(*subnet.SubnetType, "VLAN") {
 		d.Set("type", "Portable")
 	} else {
-		return fmt.Errorf("Invalid vlan type: %s", *subnet.SubnetType)
+		return diag.FromErr(fmt.Errorf("Invalid vlan type: %s", *subnet.SubnetType))
 	}
 	d.Set("ip_version", *subnet.Version)
 	d.Set("capacity", *subnet.TotalIpAddresses)
@@ -215,36 +225,36 @@ func resourceIBMSubnetRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSubnetUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSubnetService(sess)
 
 	subnetID, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid subnet ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("Not a valid subnet ID, must be an integer: %s", err))
 	}
 
 	if d.HasChange("notes") {
 		_, err = service.Id(subnetID).EditNote(sl.String(d.Get("notes").(string)))
 		if err != nil {
-			return fmt.Errorf("Error updating subnet: %s", err)
+			return diag.FromErr(fmt.Errorf("Error updating subnet: %s", err))
 		}
 	}
-	return resourceIBMSubnetRead(d, meta)
+	return resourceIBMSubnetRead(context, d, meta)
 }
 
-func resourceIBMSubnetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSubnetDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSubnetService(sess)
 
 	subnetID, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid subnet ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("Not a valid subnet ID, must be an integer: %s", err))
 	}
 
 	billingItem, err := service.Id(subnetID).GetBillingItem()
 	if err != nil {
-		return fmt.Errorf("Error deleting subnet: %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting subnet: %s", err))
 	}
 
 	if billingItem.Id == nil {
@@ -252,10 +262,10 @@ func resourceIBMSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 	_, err = services.GetBillingItemService(sess).Id(*billingItem.Id).CancelService()
 	if err != nil {
-		return fmt.Errorf("Error deleting subnet: %s", err)
+		return diag.FromErr(fmt.Errorf("Error deleting subnet: %s", err))
 	}
 
-	return err
+	return diag.FromErr(err)
 }
 
 func resourceIBMSubnetExists(d *schema.ResourceData, meta interface{}) (bool, error) {
@@ -277,7 +287,7 @@ func resourceIBMSubnetExists(d *schema.ResourceData, meta interface{}) (bool, er
 	return result.Id != nil && *result.Id == subnetID, nil
 }
 
-func findSubnetByOrderID(sess *session.Session, orderID int, d *schema.ResourceData) (datatypes.Network_Subnet, error) {
+func findSubnetByOrderID(context context.Context, sess *session.Session, orderID int, d *schema.ResourceData) (datatypes.Network_Subnet, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"pending"},
 		Target:  []string{"complete"},
@@ -302,7 +312,7 @@ func findSubnetByOrderID(sess *session.Session, orderID int, d *schema.ResourceD
 		NotFoundChecks: 1440,
 	}
 
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := stateConf.WaitForStateContext(context)
 
 	if err != nil {
 		return datatypes.Network_Subnet{}, err
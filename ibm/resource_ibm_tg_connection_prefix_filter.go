@@ -0,0 +1,262 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	tgPrefixFilterID     = "filter_id"
+	tgPrefixFilterAction = "action"
+	tgPrefixFilterPrefix = "prefix"
+	tgPrefixFilterGe     = "ge"
+	tgPrefixFilterLe     = "le"
+	tgPrefixFilterBefore = "before"
+)
+
+func resourceIBMTransitGatewayConnectionPrefixFilter() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMTransitGatewayConnectionPrefixFilterCreate,
+		ReadContext:   resourceIBMTransitGatewayConnectionPrefixFilterRead,
+		UpdateContext: resourceIBMTransitGatewayConnectionPrefixFilterUpdate,
+		DeleteContext: resourceIBMTransitGatewayConnectionPrefixFilterDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			tgGatewayId: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The Transit Gateway identifier",
+			},
+			tgConnectionId: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The Transit Gateway Connection identifier",
+			},
+			tgPrefixFilterID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Prefix Filter identifier",
+			},
+			tgPrefixFilterAction: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: InvokeValidator("ibm_tg_connection_prefix_filter", tgPrefixFilterAction),
+				Description:  "Whether the routes matching the filter are permitted or denied.Allowable values (permit,deny)",
+			},
+			tgPrefixFilterPrefix: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateCIDR,
+				Description:  "The IPv4 prefix of the routes the filter matches",
+			},
+			tgPrefixFilterGe: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: InvokeValidator("ibm_tg_connection_prefix_filter", tgPrefixFilterGe),
+				Description:  "The minimum length of the prefixes within the prefix the filter matches. Without ge and le, the filter matches the prefix exactly.",
+			},
+			tgPrefixFilterLe: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: InvokeValidator("ibm_tg_connection_prefix_filter", tgPrefixFilterLe),
+				Description:  "The maximum length of the prefixes within the prefix the filter matches. Without ge and le, the filter matches the prefix exactly.",
+			},
+			tgPrefixFilterBefore: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The identifier of the prefix filter this filter is applied before. If unspecified, the filter is applied after the existing filters of the connection.",
+			},
+			tgCreatedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that this prefix filter was created",
+			},
+			tgUpdatedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that this prefix filter was last updated",
+			},
+		},
+	}
+}
+
+func resourceIBMTransitGatewayConnectionPrefixFilterValidator() *ResourceValidator {
+
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 tgPrefixFilterAction,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Required:                   true,
+			AllowedValues:              "permit, deny"})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 tgPrefixFilterGe,
+			ValidateFunctionIdentifier: IntBetween,
+			Type:                       TypeInt,
+			Optional:                   true,
+			MinValue:                   "0",
+			MaxValue:                   "32"})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 tgPrefixFilterLe,
+			ValidateFunctionIdentifier: IntBetween,
+			Type:                       TypeInt,
+			Optional:                   true,
+			MinValue:                   "0",
+			MaxValue:                   "32"})
+
+	ibmTransitGatewayConnectionPrefixFilterResourceValidator := ResourceValidator{ResourceName: "ibm_tg_connection_prefix_filter", Schema: validateSchema}
+
+	return &ibmTransitGatewayConnectionPrefixFilterResourceValidator
+}
+
+func resourceIBMTransitGatewayConnectionPrefixFilterCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := transitgatewayClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	gatewayId := d.Get(tgGatewayId).(string)
+	connectionId := d.Get(tgConnectionId).(string)
+	mk := "tg_connection_prefix_filter_" + gatewayId + connectionId
+	ibmMutexKV.Lock(mk)
+	defer ibmMutexKV.Unlock(mk)
+
+	filter := tgPrefixFilter{
+		Action: d.Get(tgPrefixFilterAction).(string),
+		Prefix: d.Get(tgPrefixFilterPrefix).(string),
+		Ge:     int64(d.Get(tgPrefixFilterGe).(int)),
+		Le:     int64(d.Get(tgPrefixFilterLe).(int)),
+		Before: d.Get(tgPrefixFilterBefore).(string),
+	}
+	prefixFilter, response, err := createTGPrefixFilter(client, gatewayId, connectionId, filter)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Create Transit Gateway connection prefix filter err %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", gatewayId, connectionId, prefixFilter.ID))
+	return resourceIBMTransitGatewayConnectionPrefixFilterRead(context, d, meta)
+}
+
+func resourceIBMTransitGatewayConnectionPrefixFilterRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := transitgatewayClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) != 3 {
+		return diag.Errorf("Incorrect ID %s: the ID must be in the form gateway/connection_id/filter_id", d.Id())
+	}
+
+	gatewayId := parts[0]
+	connectionId := parts[1]
+	ID := parts[2]
+	prefixFilter, response, err := getTGPrefixFilter(client, gatewayId, connectionId, ID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("Transit Gateway connection prefix filter %s is not found", ID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("Error Getting Transit Gateway Connection Prefix Filter (%s): %s\n%s", ID, err, response))
+	}
+
+	d.Set(tgGatewayId, gatewayId)
+	d.Set(tgConnectionId, connectionId)
+	d.Set(tgPrefixFilterID, prefixFilter.ID)
+	d.Set(tgPrefixFilterAction, prefixFilter.Action)
+	d.Set(tgPrefixFilterPrefix, prefixFilter.Prefix)
+	d.Set(tgPrefixFilterGe, prefixFilter.Ge)
+	d.Set(tgPrefixFilterLe, prefixFilter.Le)
+	d.Set(tgPrefixFilterBefore, prefixFilter.Before)
+	d.Set(tgCreatedAt, prefixFilter.CreatedAt)
+	d.Set(tgUpdatedAt, prefixFilter.UpdatedAt)
+
+	return nil
+}
+
+func resourceIBMTransitGatewayConnectionPrefixFilterUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := transitgatewayClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	gatewayId := parts[0]
+	connectionId := parts[1]
+	ID := parts[2]
+	mk := "tg_connection_prefix_filter_" + gatewayId + connectionId
+	ibmMutexKV.Lock(mk)
+	defer ibmMutexKV.Unlock(mk)
+
+	patch := map[string]interface{}{}
+	if d.HasChange(tgPrefixFilterAction) {
+		patch[tgPrefixFilterAction] = d.Get(tgPrefixFilterAction).(string)
+	}
+	if d.HasChange(tgPrefixFilterPrefix) {
+		patch[tgPrefixFilterPrefix] = d.Get(tgPrefixFilterPrefix).(string)
+	}
+	// A zero ge or le removes the bound
+	if d.HasChange(tgPrefixFilterGe) {
+		patch[tgPrefixFilterGe] = d.Get(tgPrefixFilterGe).(int)
+	}
+	if d.HasChange(tgPrefixFilterLe) {
+		patch[tgPrefixFilterLe] = d.Get(tgPrefixFilterLe).(int)
+	}
+	if d.HasChange(tgPrefixFilterBefore) {
+		patch[tgPrefixFilterBefore] = d.Get(tgPrefixFilterBefore).(string)
+	}
+	if len(patch) > 0 {
+		_, response, err := updateTGPrefixFilter(client, gatewayId, connectionId, ID, patch)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("Error in Update Transit Gateway Connection Prefix Filter : %s\n%s", err, response))
+		}
+	}
+
+	return resourceIBMTransitGatewayConnectionPrefixFilterRead(context, d, meta)
+}
+
+func resourceIBMTransitGatewayConnectionPrefixFilterDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := transitgatewayClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	gatewayId := parts[0]
+	connectionId := parts[1]
+	ID := parts[2]
+	mk := "tg_connection_prefix_filter_" + gatewayId + connectionId
+	ibmMutexKV.Lock(mk)
+	defer ibmMutexKV.Unlock(mk)
+
+	response, err := deleteTGPrefixFilter(client, gatewayId, connectionId, ID)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return diag.FromErr(fmt.Errorf("Error deleting Transit Gateway Connection Prefix Filter(%s): %s\n%s", ID, err, response))
+	}
+
+	d.SetId("")
+	return nil
+}
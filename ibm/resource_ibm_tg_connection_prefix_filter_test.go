@@ -0,0 +1,172 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
+)
+
+func TestAccIBMTransitGatewayConnectionPrefixFilter_basic(t *testing.T) {
+	node := "ibm_tg_connection_prefix_filter.test_tg_prefix_filter"
+	gatewayName := fmt.Sprintf("tg-gateway-name-%d", acctest.RandIntRange(10, 100))
+	vpcName := fmt.Sprintf("vpc-name-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMTransitGatewayConnectionPrefixFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMTransitGatewayConnectionPrefixFilterConfig(gatewayName, vpcName, "permit", 24),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(node, "filter_id"),
+					resource.TestCheckResourceAttr(node, "action", "permit"),
+					resource.TestCheckResourceAttr(node, "prefix", "10.240.0.0/16"),
+					resource.TestCheckResourceAttr(node, "le", "24"),
+				),
+			},
+			{
+				Config: testAccCheckIBMTransitGatewayConnectionPrefixFilterConfig(gatewayName, vpcName, "deny", 28),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(node, "action", "deny"),
+					resource.TestCheckResourceAttr(node, "le", "28"),
+				),
+			},
+			{
+				ResourceName:      node,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMTransitGatewayConnectionPrefixFilterConfig(gatewayName, vpcName, action string, le int) string {
+	return testAccCheckIBMTransitGatewayConnectionConfig(vpcName+"-conn", gatewayName, vpcName) + fmt.Sprintf(`
+	resource "ibm_tg_connection_prefix_filter" "test_tg_prefix_filter" {
+		gateway       = ibm_tg_gateway.test_tg_gateway.id
+		connection_id = ibm_tg_connection.test_ibm_tg_connection.connection_id
+		action        = "%s"
+		prefix        = "10.240.0.0/16"
+		le            = %d
+	}
+	`, action, le)
+}
+
+func testAccCheckIBMTransitGatewayConnectionPrefixFilterDestroy(s *terraform.State) error {
+	client, err := transitgatewayClient(testAccProvider.Meta())
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_tg_connection_prefix_filter" {
+			continue
+		}
+		parts, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if _, _, err := getTGPrefixFilter(client, parts[0], parts[1], parts[2]); err == nil {
+			return fmt.Errorf("transit gateway connection prefix filter still exists: %s", rs.Primary.ID)
+		}
+	}
+	return testAccCheckIBMTransitGatewayConnectionDestroy(s)
+}
+
+func TestIBMTransitGatewayConnectionPrefixFilterMock(t *testing.T) {
+	t.Parallel()
+	srv := testMockServer(t)
+	meta := testMockClientSession(t, srv)
+	r := resourceIBMTransitGatewayConnectionPrefixFilter()
+	gatewayID, connectionID := testMockTransitGateway(t, meta)
+
+	create := func(raw map[string]interface{}) *schema.ResourceData {
+		d := schema.TestResourceDataRaw(t, r.Schema, raw)
+		d.MarkNewResource()
+		assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), d, meta)))
+		return d
+	}
+
+	denyRaw := map[string]interface{}{
+		"gateway":       gatewayID,
+		"connection_id": connectionID,
+		"action":        "deny",
+		"prefix":        "10.0.0.0/8",
+	}
+	deny := create(denyRaw)
+	denyID := deny.Get("filter_id").(string)
+	assert.Equal(t, deny.Id(), gatewayID+"/"+connectionID+"/"+denyID)
+	assert.Equal(t, deny.Get("before"), "")
+
+	// A filter is placed before an existing one
+	permitRaw := map[string]interface{}{
+		"gateway":       gatewayID,
+		"connection_id": connectionID,
+		"action":        "permit",
+		"prefix":        "10.0.0.0/8",
+		"ge":            16,
+		"le":            24,
+		"before":        denyID,
+	}
+	permit := create(permitRaw)
+	assert.Equal(t, permit.Get("before"), denyID)
+	assert.Equal(t, permit.Get("ge"), 16)
+	assert.Equal(t, permit.Get("le"), 24)
+
+	// The bounds must be within the prefix length and 32
+	invalid := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"gateway":       gatewayID,
+		"connection_id": connectionID,
+		"action":        "permit",
+		"prefix":        "10.240.0.0/16",
+		"le":            8,
+	})
+	invalid.MarkNewResource()
+	err := testDiagsErr(r.CreateContext(context.Background(), invalid, meta))
+	assert.ErrorContains(t, err, "Create Transit Gateway connection prefix filter err")
+	assert.ErrorContains(t, err, "le must be between the prefix length 16 and 32")
+
+	// Removing ge clears the bound
+	delete(permitRaw, "ge")
+	permitRaw["prefix"] = "10.0.0.0/12"
+	updated := testMockResourceData(t, r, permit, permitRaw)
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), updated, meta)))
+	assert.Equal(t, updated.Get("prefix"), "10.0.0.0/12")
+	assert.Equal(t, updated.Get("ge"), 0)
+	assert.Equal(t, updated.Get("le"), 24)
+
+	// The deny filter is moved before the permit filter
+	denyRaw["before"] = updated.Get("filter_id")
+	moved := testMockResourceData(t, r, deny, denyRaw)
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), moved, meta)))
+	assert.Equal(t, moved.Get("before"), updated.Get("filter_id"))
+
+	client, err := transitgatewayClient(meta)
+	assert.NilError(t, err)
+	filters, _, err := listTGPrefixFilters(client, gatewayID, connectionID)
+	assert.NilError(t, err)
+	assert.Equal(t, len(filters), 2)
+	assert.Equal(t, filters[0].ID, denyID)
+
+	imported := r.Data(nil)
+	imported.SetId(permit.Id())
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), imported, meta)))
+	assert.Equal(t, imported.Get("gateway"), gatewayID)
+	assert.Equal(t, imported.Get("connection_id"), connectionID)
+	assert.Equal(t, imported.Get("action"), "permit")
+
+	assert.NilError(t, testDiagsErr(r.DeleteContext(context.Background(), updated, meta)))
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), imported, meta)))
+	assert.Equal(t, imported.Id(), "")
+	// Deleting a filter that is already gone succeeds
+	assert.NilError(t, testDiagsErr(r.DeleteContext(context.Background(), permit, meta)))
+	assert.NilError(t, testDiagsErr(r.DeleteContext(context.Background(), moved, meta)))
+}
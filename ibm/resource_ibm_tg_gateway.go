@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -37,12 +38,11 @@ const (
 
 func resourceIBMTransitGateway() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMTransitGatewayCreate,
-		Read:     resourceIBMTransitGatewayRead,
-		Delete:   resourceIBMTransitGatewayDelete,
-		Exists:   resourceIBMTransitGatewayExists,
-		Update:   resourceIBMTransitGatewayUpdate,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMTransitGatewayCreate,
+		ReadContext:   resourceIBMTransitGatewayRead,
+		DeleteContext: resourceIBMTransitGatewayDelete,
+		UpdateContext: resourceIBMTransitGatewayUpdate,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -181,10 +181,11 @@ func transitgatewayClient(meta interface{}) (*transitgatewayapisv1.TransitGatewa
 	return sess, err
 }
 
-func resourceIBMTransitGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	location := d.Get(tgLocation).(string)
@@ -207,14 +208,14 @@ func resourceIBMTransitGatewayCreate(d *schema.ResourceData, meta interface{}) e
 
 	if err != nil {
 		log.Printf("[DEBUG] Create Transit Gateway err %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(*tgw.ID)
 
-	_, err = isWaitForTransitGatewayAvailable(client, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForTransitGatewayAvailable(context, client, d.Id(), d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
@@ -224,12 +225,13 @@ func resourceIBMTransitGatewayCreate(d *schema.ResourceData, meta interface{}) e
 		if err != nil {
 			log.Printf(
 				"Error on create of transit gateway (%s) tags: %s", d.Id(), err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
-	return resourceIBMTransitGatewayRead(d, meta)
+	return append(diags, resourceIBMTransitGatewayRead(context, d, meta)...)
 }
 
-func isWaitForTransitGatewayAvailable(client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForTransitGatewayAvailable(context context.Context, client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for transit gateway (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -241,7 +243,7 @@ func isWaitForTransitGatewayAvailable(client *transitgatewayapisv1.TransitGatewa
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isTransitGatewayRefreshFunc(client *transitgatewayapisv1.TransitGatewayApisV1, id string) resource.StateRefreshFunc {
@@ -262,11 +264,20 @@ func isTransitGatewayRefreshFunc(client *transitgatewayapisv1.TransitGatewayApis
 	}
 }
 
-func resourceIBMTransitGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMTransitGatewayExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
 	id := d.Id()
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	tgOptions := &transitgatewayapisv1.GetTransitGatewayOptions{}
 	if id != "" {
@@ -279,7 +290,7 @@ func resourceIBMTransitGatewayRead(d *schema.ResourceData, meta interface{}) err
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(*tgw.ID)
@@ -303,7 +314,7 @@ func resourceIBMTransitGatewayRead(d *schema.ResourceData, meta interface{}) err
 
 	controller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set(ResourceControllerURL, controller+"/interconnectivity/transit")
@@ -318,11 +329,12 @@ func resourceIBMTransitGatewayRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceIBMTransitGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	client, err := transitgatewayClient(meta)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ID := d.Id()
@@ -333,7 +345,7 @@ func resourceIBMTransitGatewayUpdate(d *schema.ResourceData, meta interface{}) e
 
 	if err != nil {
 		log.Printf("Error fetching Tranisit  Gateway: %s", resp)
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateTransitGatewayOptions := &transitgatewayapisv1.UpdateTransitGatewayOptions{}
@@ -356,23 +368,24 @@ func resourceIBMTransitGatewayUpdate(d *schema.ResourceData, meta interface{}) e
 		if err != nil {
 			log.Printf(
 				"Error on update of transit gateway (%s) tags: %s", ID, err)
+			diags = append(diags, tagsWarning(err))
 		}
 	}
 
 	_, response, err := client.UpdateTransitGateway(updateTransitGatewayOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Update Transit Gateway err %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMTransitGatewayRead(d, meta)
+	return append(diags, resourceIBMTransitGatewayRead(context, d, meta)...)
 }
 
-func resourceIBMTransitGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ID := d.Id()
@@ -385,17 +398,17 @@ func resourceIBMTransitGatewayDelete(d *schema.ResourceData, meta interface{}) e
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error deleting Transit Gateway (%s): %s\n%s", ID, err, response)
+		return diag.FromErr(fmt.Errorf("Error deleting Transit Gateway (%s): %s\n%s", ID, err, response))
 	}
-	_, err = isWaitForTransitGatewayDeleted(client, ID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForTransitGatewayDeleted(context, client, ID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return nil
 }
 
-func isWaitForTransitGatewayDeleted(client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForTransitGatewayDeleted(context context.Context, client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for transit gateway (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -407,7 +420,7 @@ func isWaitForTransitGatewayDeleted(client *transitgatewayapisv1.TransitGatewayA
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isTransitGatewayDeleteRefreshFunc(client *transitgatewayapisv1.TransitGatewayApisV1, id string) resource.StateRefreshFunc {
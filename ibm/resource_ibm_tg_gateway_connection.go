@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -28,16 +30,26 @@ const (
 	isTransitGatewayConnectionAttached  = "attached"
 	tgRequestStatus                     = "request_status"
 	tgConnectionId                      = "connection_id"
+	tgPrefixFiltersDefault              = "prefix_filters_default"
+	tgBaseConnectionID                  = "base_connection_id"
+	tgBaseNetworkType                   = "base_network_type"
+	tgLocalGatewayIP                    = "local_gateway_ip"
+	tgLocalTunnelIP                     = "local_tunnel_ip"
+	tgRemoteGatewayIP                   = "remote_gateway_ip"
+	tgRemoteTunnelIP                    = "remote_tunnel_ip"
+	tgRemoteBgpASN                      = "remote_bgp_asn"
+	tgLocalBgpASN                       = "local_bgp_asn"
+	tgMtu                               = "mtu"
+	tgZone                              = "zone"
 )
 
 func resourceIBMTransitGatewayConnection() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMTransitGatewayConnectionCreate,
-		Read:     resourceIBMTransitGatewayConnectionRead,
-		Delete:   resourceIBMTransitGatewayConnectionDelete,
-		Exists:   resourceIBMTransitGatewayConnectionExists,
-		Update:   resourceIBMTransitGatewayConnectionUpdate,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMTransitGatewayConnectionCreate,
+		ReadContext:   resourceIBMTransitGatewayConnectionRead,
+		DeleteContext: resourceIBMTransitGatewayConnectionDelete,
+		UpdateContext: resourceIBMTransitGatewayConnectionUpdate,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -62,7 +74,7 @@ func resourceIBMTransitGatewayConnection() *schema.Resource {
 				Required:     true,
 				ForceNew:     true,
 				ValidateFunc: InvokeValidator("ibm_tg_connection", tgNetworkType),
-				Description:  "Defines what type of network is connected via this connection.Allowable values (classic,vpc)",
+				Description:  "Defines what type of network is connected via this connection.Allowable values (classic,vpc,power_virtual_server,gre_tunnel,unbound_gre_tunnel)",
 			},
 			tgName: {
 				Type:         schema.TypeString,
@@ -85,6 +97,78 @@ func resourceIBMTransitGatewayConnection() *schema.Resource {
 				ForceNew:    true,
 				Description: "The ID of the account which owns the network that is being connected. Generally only used if the network is in a different account than the gateway.",
 			},
+			tgPrefixFiltersDefault: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "permit",
+				ValidateFunc: InvokeValidator("ibm_tg_connection", tgPrefixFiltersDefault),
+				Description:  "Whether the routes of the connection matching none of its prefix filters are permitted or denied.Allowable values (permit,deny)",
+			},
+			tgBaseConnectionID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The ID of the classic or power_virtual_server connection carrying the GRE tunnel. This field is required for network type 'gre_tunnel'.",
+			},
+			tgBaseNetworkType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: InvokeValidator("ibm_tg_connection", tgBaseNetworkType),
+				Description:  "The type of network carrying the GRE tunnel. This field is required for network type 'unbound_gre_tunnel'.Allowable values (classic,power_virtual_server)",
+			},
+			tgZone: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The zone of the transit gateway end of the GRE tunnel. This field is required for the GRE tunnel network types.",
+			},
+			tgLocalGatewayIP: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIP,
+				Description:  "The IP address of the transit gateway end of the GRE tunnel. This field is required for the GRE tunnel network types.",
+			},
+			tgLocalTunnelIP: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIP,
+				Description:  "The link local IP address of the tunnel interface of the transit gateway. This field is required for the GRE tunnel network types.",
+			},
+			tgRemoteGatewayIP: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIP,
+				Description:  "The IP address of the remote end of the GRE tunnel. This field is required for the GRE tunnel network types.",
+			},
+			tgRemoteTunnelIP: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIP,
+				Description:  "The link local IP address of the tunnel interface of the remote end, in the same /30 subnet as the local tunnel IP. This field is required for the GRE tunnel network types.",
+			},
+			tgRemoteBgpASN: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: InvokeValidator("ibm_tg_connection", tgRemoteBgpASN),
+				Description:  "The BGP ASN of the remote end of the GRE tunnel. If unspecified, an ASN is assigned.",
+			},
+			tgLocalBgpASN: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The BGP ASN of the transit gateway end of the GRE tunnel",
+			},
+			tgMtu: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The MTU of the GRE tunnel",
+			},
 			tgCreatedAt: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -116,7 +200,7 @@ func resourceIBMTransitGatewayConnection() *schema.Resource {
 func resourceIBMTransitGatewayConnectionValidator() *ResourceValidator {
 
 	validateSchema := make([]ValidateSchema, 1)
-	networkType := "classic, vpc"
+	networkType := "classic, vpc, power_virtual_server, gre_tunnel, unbound_gre_tunnel"
 	validateSchema = append(validateSchema,
 		ValidateSchema{
 			Identifier:                 tgNetworkType,
@@ -134,57 +218,79 @@ func resourceIBMTransitGatewayConnectionValidator() *ResourceValidator {
 			MinValueLength:             1,
 			MaxValueLength:             63})
 
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 tgPrefixFiltersDefault,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "permit, deny"})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 tgBaseNetworkType,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "classic, power_virtual_server"})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 tgRemoteBgpASN,
+			ValidateFunctionIdentifier: IntBetween,
+			Type:                       TypeInt,
+			Optional:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "4294967295"})
+
 	ibmTransitGatewayConnectionResourceValidator := ResourceValidator{ResourceName: "ibm_tg_connection", Schema: validateSchema}
 
 	return &ibmTransitGatewayConnectionResourceValidator
 }
-func resourceIBMTransitGatewayConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayConnectionCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	createTransitGatewayConnectionOptions := &transitgatewayapisv1.CreateTransitGatewayConnectionOptions{}
-
 	gatewayId := d.Get(tgGatewayId).(string)
-	createTransitGatewayConnectionOptions.SetTransitGatewayID(gatewayId)
-
-	if _, ok := d.GetOk(tgName); ok {
-		name := d.Get(tgName).(string)
-		createTransitGatewayConnectionOptions.SetName(name)
-	}
-
-	networkType := d.Get(tgNetworkType).(string)
-	createTransitGatewayConnectionOptions.SetNetworkType(networkType)
-	if _, ok := d.GetOk(tgNetworkId); ok {
-		networkID := d.Get(tgNetworkId).(string)
-		createTransitGatewayConnectionOptions.SetNetworkID(networkID)
-	}
-	if _, ok := d.GetOk(tgNetworkAccountID); ok {
-		networkAccId := d.Get(tgNetworkAccountID).(string)
-		createTransitGatewayConnectionOptions.SetNetworkAccountID(networkAccId)
-	}
-
-	tgConnections, response, err := client.CreateTransitGatewayConnection(createTransitGatewayConnectionOptions)
+	connection := tgConnection{
+		Name:                 d.Get(tgName).(string),
+		NetworkType:          d.Get(tgNetworkType).(string),
+		NetworkID:            d.Get(tgNetworkId).(string),
+		NetworkAccountID:     d.Get(tgNetworkAccountID).(string),
+		PrefixFiltersDefault: d.Get(tgPrefixFiltersDefault).(string),
+		BaseConnectionID:     d.Get(tgBaseConnectionID).(string),
+		BaseNetworkType:      d.Get(tgBaseNetworkType).(string),
+		LocalGatewayIP:       d.Get(tgLocalGatewayIP).(string),
+		LocalTunnelIP:        d.Get(tgLocalTunnelIP).(string),
+		RemoteGatewayIP:      d.Get(tgRemoteGatewayIP).(string),
+		RemoteTunnelIP:       d.Get(tgRemoteTunnelIP).(string),
+		RemoteBgpASN:         int64(d.Get(tgRemoteBgpASN).(int)),
+	}
+	if zone, ok := d.GetOk(tgZone); ok {
+		connection.Zone = &tgZoneReference{Name: zone.(string)}
+	}
+
+	// The SDK doesn't support the GRE tunnel fields and the prefix filters default
+	tgConnections, response, err := createTGConnection(client, gatewayId, connection)
 	if err != nil {
-		return fmt.Errorf("Create Transit Gateway connection err %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Create Transit Gateway connection err %s\n%s", err, response))
 	}
 
-	d.SetId(fmt.Sprintf("%s/%s", gatewayId, *tgConnections.ID))
-	d.Set(tgConnectionId, *tgConnections.ID)
+	d.SetId(fmt.Sprintf("%s/%s", gatewayId, tgConnections.ID))
+	d.Set(tgConnectionId, tgConnections.ID)
 
-	if tgConnections.NetworkAccountID != nil {
-		d.Set(tgNetworkAccountID, *tgConnections.NetworkAccountID)
-		return resourceIBMTransitGatewayConnectionRead(d, meta)
+	if tgConnections.NetworkAccountID != "" {
+		d.Set(tgNetworkAccountID, tgConnections.NetworkAccountID)
+		return resourceIBMTransitGatewayConnectionRead(context, d, meta)
 	}
-	_, err = isWaitForTransitGatewayConnectionAvailable(client, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForTransitGatewayConnectionAvailable(context, client, d.Id(), d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMTransitGatewayConnectionRead(d, meta)
+	return resourceIBMTransitGatewayConnectionRead(context, d, meta)
 }
-func isWaitForTransitGatewayConnectionAvailable(client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForTransitGatewayConnectionAvailable(context context.Context, client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for transit gateway connection (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -196,7 +302,7 @@ func isWaitForTransitGatewayConnectionAvailable(client *transitgatewayapisv1.Tra
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 func isTransitGatewayConnectionRefreshFunc(client *transitgatewayapisv1.TransitGatewayApisV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -223,121 +329,112 @@ func isTransitGatewayConnectionRefreshFunc(client *transitgatewayapisv1.TransitG
 		return tgConnection, isTransitGatewayConnectionPending, nil
 	}
 }
-func resourceIBMTransitGatewayConnectionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayConnectionRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceIBMTransitGatewayConnectionExists(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
 
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gatewayId := parts[0]
 	ID := parts[1]
 
-	getTransitGatewayConnectionOptions := &transitgatewayapisv1.GetTransitGatewayConnectionOptions{}
-	getTransitGatewayConnectionOptions.SetTransitGatewayID(gatewayId)
-	getTransitGatewayConnectionOptions.SetID(ID)
-	instance, response, err := client.GetTransitGatewayConnection(getTransitGatewayConnectionOptions)
+	instance, response, err := getTGConnection(client, gatewayId, ID)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Transit Gateway Connection (%s): %s\n%s", ID, err, response)
-	}
-
-	if instance.Name != nil {
-		d.Set(tgName, *instance.Name)
-	}
-	if instance.NetworkType != nil {
-		d.Set(tgNetworkType, *instance.NetworkType)
-	}
-	if instance.UpdatedAt != nil {
-		d.Set(tgUpdatedAt, instance.UpdatedAt.String())
-	}
-	if instance.NetworkID != nil {
-		d.Set(tgNetworkId, *instance.NetworkID)
-	}
-	if instance.CreatedAt != nil {
-		d.Set(tgCreatedAt, instance.CreatedAt.String())
-	}
-	if instance.Status != nil {
-		d.Set(tgStatus, *instance.Status)
-	}
-	if instance.NetworkAccountID != nil {
-		d.Set(tgNetworkAccountID, *instance.NetworkAccountID)
-	}
-	if instance.RequestStatus != nil {
-		d.Set(tgRequestStatus, *instance.RequestStatus)
-	}
-	d.Set(tgConnectionId, *instance.ID)
+		return diag.FromErr(fmt.Errorf("Error Getting Transit Gateway Connection (%s): %s\n%s", ID, err, response))
+	}
+
+	d.Set(tgName, instance.Name)
+	d.Set(tgNetworkType, instance.NetworkType)
+	d.Set(tgUpdatedAt, instance.UpdatedAt)
+	d.Set(tgNetworkId, instance.NetworkID)
+	d.Set(tgCreatedAt, instance.CreatedAt)
+	d.Set(tgStatus, instance.Status)
+	d.Set(tgNetworkAccountID, instance.NetworkAccountID)
+	d.Set(tgRequestStatus, instance.RequestStatus)
+	d.Set(tgPrefixFiltersDefault, instance.PrefixFiltersDefault)
+	d.Set(tgBaseConnectionID, instance.BaseConnectionID)
+	d.Set(tgBaseNetworkType, instance.BaseNetworkType)
+	d.Set(tgLocalGatewayIP, instance.LocalGatewayIP)
+	d.Set(tgLocalTunnelIP, instance.LocalTunnelIP)
+	d.Set(tgRemoteGatewayIP, instance.RemoteGatewayIP)
+	d.Set(tgRemoteTunnelIP, instance.RemoteTunnelIP)
+	d.Set(tgRemoteBgpASN, instance.RemoteBgpASN)
+	d.Set(tgLocalBgpASN, instance.LocalBgpASN)
+	d.Set(tgMtu, instance.Mtu)
+	if instance.Zone != nil {
+		d.Set(tgZone, instance.Zone.Name)
+	}
+	d.Set(tgConnectionId, instance.ID)
 	d.Set(tgGatewayId, gatewayId)
 	getTransitGatewayOptions := &transitgatewayapisv1.GetTransitGatewayOptions{
 		ID: &gatewayId,
 	}
 	tgw, response, err := client.GetTransitGateway(getTransitGatewayOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Transit Gateway : %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error Getting Transit Gateway : %s\n%s", err, response))
 	}
 	d.Set(RelatedCRN, *tgw.Crn)
 
 	return nil
 }
 
-func resourceIBMTransitGatewayConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayConnectionUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gatewayId := parts[0]
 	ID := parts[1]
 
-	getTransitGatewayConnectionOptions := &transitgatewayapisv1.GetTransitGatewayConnectionOptions{
-		ID: &ID,
+	patch := map[string]interface{}{}
+	if d.HasChange(tgName) {
+		patch[tgName] = d.Get(tgName).(string)
 	}
-	getTransitGatewayConnectionOptions.SetTransitGatewayID(gatewayId)
-
-	_, response, err := client.GetTransitGatewayConnection(getTransitGatewayConnectionOptions)
-	if err != nil {
-		return fmt.Errorf("Error Getting Transit Gateway Connection: %s\n%s", err, response)
+	if d.HasChange(tgPrefixFiltersDefault) {
+		patch[tgPrefixFiltersDefault] = d.Get(tgPrefixFiltersDefault).(string)
 	}
-
-	updateTransitGatewayConnectionOptions := &transitgatewayapisv1.UpdateTransitGatewayConnectionOptions{}
-	updateTransitGatewayConnectionOptions.ID = &ID
-	updateTransitGatewayConnectionOptions.SetTransitGatewayID(gatewayId)
-	if d.HasChange(tgName) {
-		if d.Get(tgName) != nil {
-			name := d.Get(tgName).(string)
-			updateTransitGatewayConnectionOptions.Name = &name
+	if len(patch) > 0 {
+		_, response, err := updateTGConnection(client, gatewayId, ID, patch)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("Error in Update Transit Gateway Connection : %s\n%s", err, response))
 		}
 	}
 
-	_, response, err = client.UpdateTransitGatewayConnection(updateTransitGatewayConnectionOptions)
-	if err != nil {
-		return fmt.Errorf("Error in Update Transit Gateway Connection : %s\n%s", err, response)
-	}
-
-	return resourceIBMTransitGatewayConnectionRead(d, meta)
+	return resourceIBMTransitGatewayConnectionRead(context, d, meta)
 }
 
-func resourceIBMTransitGatewayConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayConnectionDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gatewayId := parts[0]
@@ -352,19 +449,19 @@ func resourceIBMTransitGatewayConnectionDelete(d *schema.ResourceData, meta inte
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error deleting Transit Gateway Connection(%s): %s\n%s", ID, err, response)
+		return diag.FromErr(fmt.Errorf("Error deleting Transit Gateway Connection(%s): %s\n%s", ID, err, response))
 	}
-	_, err = isWaitForTransitGatewayConnectionDeleted(client, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForTransitGatewayConnectionDeleted(context, client, d.Id(), d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func isWaitForTransitGatewayConnectionDeleted(client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForTransitGatewayConnectionDeleted(context context.Context, client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for transit gateway Connection(%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -376,7 +473,7 @@ func isWaitForTransitGatewayConnectionDeleted(client *transitgatewayapisv1.Trans
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isTransitGatewayConnectionDeleteRefreshFunc(client *transitgatewayapisv1.TransitGatewayApisV1, id string) resource.StateRefreshFunc {
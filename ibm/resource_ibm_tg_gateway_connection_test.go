@@ -4,11 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
 	"log"
 	"testing"
 )
@@ -211,3 +214,123 @@ func TestAccIBMTransitGatewayConnectionImport(t *testing.T) {
 		},
 	})
 }
+
+func TestAccIBMTransitGatewayConnection_greTunnel(t *testing.T) {
+	var tgConnection string
+	node := "ibm_tg_connection.test_ibm_tg_gre_connection"
+	gatewayName := fmt.Sprintf("tg-gateway-name-%d", acctest.RandIntRange(10, 100))
+	greName := fmt.Sprintf("tg-gre-name-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMTransitGatewayConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMTransitGatewayGRETunnelConnectionConfig(gatewayName, greName, "permit"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMTransitGatewayConnectionExists(node, tgConnection),
+					resource.TestCheckResourceAttr(node, "network_type", "gre_tunnel"),
+					resource.TestCheckResourceAttr(node, "zone", "us-south-1"),
+					resource.TestCheckResourceAttr(node, "remote_bgp_asn", "65010"),
+					resource.TestCheckResourceAttrSet(node, "local_bgp_asn"),
+					resource.TestCheckResourceAttrSet(node, "mtu"),
+				),
+			},
+			{
+				Config: testAccCheckIBMTransitGatewayGRETunnelConnectionConfig(gatewayName, greName, "deny"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(node, "prefix_filters_default", "deny"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMTransitGatewayGRETunnelConnectionConfig(gatewayName, greName, prefixFiltersDefault string) string {
+	return fmt.Sprintf(`
+	resource "ibm_tg_gateway" "test_tg_gateway" {
+		name     = "%s"
+		location = "us-south"
+		global   = true
+	}
+	resource "ibm_tg_connection" "test_ibm_tg_classic_connection" {
+		gateway      = ibm_tg_gateway.test_tg_gateway.id
+		network_type = "classic"
+		name         = "%[2]s-classic"
+	}
+	resource "ibm_tg_connection" "test_ibm_tg_gre_connection" {
+		gateway                = ibm_tg_gateway.test_tg_gateway.id
+		network_type           = "gre_tunnel"
+		name                   = "%[2]s"
+		base_connection_id     = ibm_tg_connection.test_ibm_tg_classic_connection.connection_id
+		zone                   = "us-south-1"
+		local_gateway_ip       = "192.168.100.1"
+		local_tunnel_ip        = "169.254.100.1"
+		remote_gateway_ip      = "10.242.63.12"
+		remote_tunnel_ip       = "169.254.100.2"
+		remote_bgp_asn         = 65010
+		prefix_filters_default = "%s"
+	}
+	`, gatewayName, greName, prefixFiltersDefault)
+}
+
+func TestIBMTransitGatewayConnectionMock(t *testing.T) {
+	t.Parallel()
+	srv := testMockServer(t)
+	meta := testMockClientSession(t, srv)
+	r := resourceIBMTransitGatewayConnection()
+	gatewayID, classicID := testMockTransitGateway(t, meta)
+
+	raw := map[string]interface{}{
+		"gateway":            gatewayID,
+		"network_type":       "gre_tunnel",
+		"name":               "mock-gre",
+		"base_connection_id": classicID,
+		"zone":               "us-south-1",
+		"local_gateway_ip":   "192.168.100.1",
+		"local_tunnel_ip":    "169.254.100.1",
+		"remote_gateway_ip":  "10.242.63.12",
+		"remote_tunnel_ip":   "169.254.100.2",
+		"remote_bgp_asn":     65010,
+	}
+
+	// The tunnel addresses must be in the same /30 subnet
+	raw["remote_tunnel_ip"] = "169.254.101.2"
+	invalid := schema.TestResourceDataRaw(t, r.Schema, raw)
+	invalid.MarkNewResource()
+	err := testDiagsErr(r.CreateContext(context.Background(), invalid, meta))
+	assert.ErrorContains(t, err, "Create Transit Gateway connection err")
+	assert.ErrorContains(t, err, "same /30 subnet")
+
+	raw["remote_tunnel_ip"] = "169.254.100.2"
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	d.MarkNewResource()
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), d, meta)))
+	connectionID := d.Get("connection_id").(string)
+	assert.Equal(t, d.Id(), gatewayID+"/"+connectionID)
+	assert.Equal(t, d.Get("status"), "attached")
+	assert.Equal(t, d.Get("prefix_filters_default"), "permit")
+	assert.Equal(t, d.Get("zone"), "us-south-1")
+	assert.Equal(t, d.Get("remote_bgp_asn"), 65010)
+	assert.Equal(t, d.Get("local_bgp_asn"), 64490)
+	assert.Equal(t, d.Get("mtu"), 9000)
+	assert.Assert(t, d.Get("related_crn").(string) != "")
+
+	raw["name"] = "mock-gre-tunnel"
+	raw["prefix_filters_default"] = "deny"
+	updated := testMockResourceData(t, r, d, raw)
+	assert.NilError(t, testDiagsErr(r.UpdateContext(context.Background(), updated, meta)))
+	assert.Equal(t, updated.Get("name"), "mock-gre-tunnel")
+	assert.Equal(t, updated.Get("prefix_filters_default"), "deny")
+
+	imported := r.Data(nil)
+	imported.SetId(d.Id())
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), imported, meta)))
+	assert.Equal(t, imported.Get("network_type"), "gre_tunnel")
+	assert.Equal(t, imported.Get("base_connection_id"), classicID)
+	assert.Equal(t, imported.Get("remote_gateway_ip"), "10.242.63.12")
+
+	assert.NilError(t, testDiagsErr(r.DeleteContext(context.Background(), updated, meta)))
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), imported, meta)))
+	assert.Equal(t, imported.Id(), "")
+}
@@ -0,0 +1,113 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"math/rand"
+	"net/http"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// RetryAPIMaxWait - default maximum wait between two retries of an api call
+const RetryAPIMaxWait = 30 * time.Second
+
+// retryMinWait is the wait before the first retry of a request, doubled at each
+// following retry.
+const retryMinWait = 1 * time.Second
+
+// retryPolicy is the retry policy of the requests sent by the clients of the
+// ClientSession. The requests failing with a network error, a 429 or a 5xx status
+// are retried up to maxRetries times, after an exponential backoff with jitter
+// capped to maxWait. The Retry-After header of the 429 and 503 responses takes
+// precedence over the backoff, within the same cap.
+type retryPolicy struct {
+	maxRetries int
+	maxWait    time.Duration
+}
+
+// httpClientSetter is implemented by the BaseService of all the go-sdk-core versions,
+// which all hold their current client in their Client field.
+type httpClientSetter interface {
+	SetHTTPClient(client *http.Client)
+}
+
+func (c *Config) retryPolicy() retryPolicy {
+	maxWait := c.RetryMaxWait
+	if maxWait <= 0 {
+		maxWait = RetryAPIMaxWait
+	}
+	return retryPolicy{maxRetries: c.RetryCount, maxWait: maxWait}
+}
+
+// backoff returns the wait before the retry attemptNum, starting at 0, of a request
+// answered with resp, nil when the request got no response.
+func (p retryPolicy) backoff(attemptNum int, resp *http.Response) time.Duration {
+	if resp != nil && (resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable) {
+		if wait, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			if wait > p.maxWait {
+				return p.maxWait
+			}
+			return wait
+		}
+	}
+	wait := p.maxWait
+	if attemptNum < 32 && retryMinWait<<uint(attemptNum) < wait {
+		wait = retryMinWait << uint(attemptNum)
+	}
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+}
+
+// retryAfter parses the value of a Retry-After header, either a number of seconds
+// or an HTTP date.
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+// httpClient returns a client sending its requests with client and retrying them
+// according to the policy.
+func (p retryPolicy) httpClient(client *http.Client) *http.Client {
+	if p.maxRetries <= 0 {
+		return client
+	}
+	retryable := core.NewRetryableHTTPClient()
+	retryable.HTTPClient = client
+	retryable.RetryMax = p.maxRetries
+	retryable.RetryWaitMin = retryMinWait
+	retryable.RetryWaitMax = p.maxWait
+	retryable.Backoff = func(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+		return p.backoff(attemptNum, resp)
+	}
+	return retryable.StandardClient()
+}
+
+// enableRetries installs the policy in the service of a go-sdk-core based client,
+// wrapping the client the service was configured with.
+func (p retryPolicy) enableRetries(service httpClientSetter) {
+	client := core.DefaultHTTPClient()
+	if v := reflect.Indirect(reflect.ValueOf(service)); v.Kind() == reflect.Struct {
+		if field := v.FieldByName("Client"); field.IsValid() {
+			if current, ok := field.Interface().(*http.Client); ok && current != nil {
+				client = current
+			}
+		}
+	}
+	service.SetHTTPClient(p.httpClient(client))
+}
@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"gotest.tools/assert"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := retryPolicy{maxRetries: 5, maxWait: 10 * time.Second}
+	response := func(status int, retryAfter string) *http.Response {
+		resp := &http.Response{StatusCode: status, Header: http.Header{}}
+		if retryAfter != "" {
+			resp.Header.Set("Retry-After", retryAfter)
+		}
+		return resp
+	}
+
+	assert.Equal(t, p.backoff(0, response(429, "3")), 3*time.Second)
+	assert.Equal(t, p.backoff(0, response(503, "120")), 10*time.Second)
+	date := p.backoff(0, response(429, time.Now().Add(5*time.Second).UTC().Format(http.TimeFormat)))
+	assert.Assert(t, date > 3*time.Second && date <= 5*time.Second, date)
+
+	for attempt, max := range []time.Duration{1 * time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second} {
+		for _, resp := range []*http.Response{nil, response(500, "3"), response(429, "")} {
+			wait := p.backoff(attempt, resp)
+			assert.Assert(t, wait >= max/2 && wait <= max, "attempt %d: %s", attempt, wait)
+		}
+	}
+	assert.Assert(t, p.backoff(100, nil) <= 10*time.Second)
+}
+
+func TestRetryPolicyHTTPClient(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	client := retryPolicy{maxRetries: 5, maxWait: time.Second}.httpClient(srv.Client())
+	resp, err := client.Get(srv.URL)
+	assert.NilError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, resp.StatusCode, http.StatusOK)
+	assert.Equal(t, requests, 3)
+
+	requests = 0
+	client = retryPolicy{maxRetries: 1, maxWait: time.Second}.httpClient(srv.Client())
+	resp, err = client.Get(srv.URL)
+	assert.NilError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, resp.StatusCode, http.StatusTooManyRequests)
+	assert.Equal(t, requests, 2)
+
+	client = retryPolicy{maxRetries: 0, maxWait: time.Second}.httpClient(srv.Client())
+	assert.Equal(t, client, srv.Client())
+}
+
+type recordingTransport struct {
+	requests int
+}
+
+func (t *recordingTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	t.requests++
+	return http.DefaultTransport.RoundTrip(request)
+}
+
+func TestRetryPolicyEnableRetries(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests < 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	transport := &recordingTransport{}
+	service, err := core.NewBaseService(&core.ServiceOptions{
+		URL:           srv.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.NilError(t, err)
+	service.SetHTTPClient(&http.Client{Transport: transport})
+
+	retryPolicy{maxRetries: 2, maxWait: time.Millisecond}.enableRetries(service)
+	resp, err := service.Client.Get(srv.URL)
+	assert.NilError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, resp.StatusCode, http.StatusOK)
+	assert.Equal(t, requests, 2)
+	assert.Equal(t, transport.requests, 2)
+}
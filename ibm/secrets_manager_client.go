@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+)
+
+// secretsManagerInstanceClient returns a Secrets Manager client sending its requests
+// to the public or private endpoint of the instance, unless the secrets_manager
+// endpoint is overridden in the provider configuration. The client is a copy of the
+// session client, so the clients of different instances can be used concurrently.
+func secretsManagerInstanceClient(meta interface{}, instanceID, endpointType string) (*secretsmanagerv1.SecretsManagerV1, error) {
+	secretsManagerClient, err := meta.(ClientSession).SecretsManagerV1()
+	if err != nil {
+		return nil, err
+	}
+	bluemixSession, err := meta.(ClientSession).BluemixSession()
+	if err != nil {
+		return nil, err
+	}
+	rContollerClient, err := meta.(ClientSession).ResourceControllerAPIV2()
+	if err != nil {
+		return nil, err
+	}
+	instanceData, err := rContollerClient.ResourceServiceInstanceV2().GetInstance(instanceID)
+	if err != nil {
+		return nil, err
+	}
+	crnData := strings.Split(instanceData.Crn.String(), ":")
+	if len(crnData) < 5 || crnData[4] != "secrets-manager" {
+		return nil, fmt.Errorf("Invalid or unsupported service Instance %s, expected a Secrets Manager instance", instanceID)
+	}
+
+	client := secretsManagerClient.Clone()
+	if client.Service.Options.URL == secretsmanagerv1.DefaultServiceURL {
+		region := bluemixSession.Config.Region
+		if endpointType == "private" {
+			client.Service.Options.URL = "https://" + instanceID + ".private." + region + ".secrets-manager.appdomain.cloud"
+		} else {
+			client.Service.Options.URL = "https://" + instanceID + "." + region + ".secrets-manager.appdomain.cloud"
+		}
+	}
+	return client, nil
+}
+
+// secretsManagerSecretResourceID returns the ID of a secret or of its rotation policy,
+// <instance_id>/<secret_type>/<secret_id>.
+func secretsManagerSecretResourceID(instanceID, secretType, secretID string) string {
+	return fmt.Sprintf("%s/%s/%s", instanceID, secretType, secretID)
+}
+
+// parseSecretsManagerID splits an ID made of the GUID of the instance followed by
+// the given number of parts, separated by /.
+func parseSecretsManagerID(id string, parts int, format string) ([]string, error) {
+	fields := strings.Split(id, "/")
+	if len(fields) != parts+1 {
+		return nil, fmt.Errorf("Incorrect ID %s: the ID must be %s", id, format)
+	}
+	for _, field := range fields {
+		if field == "" {
+			return nil, fmt.Errorf("Incorrect ID %s: the ID must be %s", id, format)
+		}
+	}
+	return fields, nil
+}
@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/mockserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gotest.tools/assert"
+)
+
+// testSecretsManager is a Secrets Manager instance served by the stand-ins.
+type testSecretsManager struct {
+	InstanceID string
+	Meta       ClientSession
+}
+
+// TestIBMSecretsManagerMock runs the CRUD tests of the Secrets Manager resources
+// against a single instance.
+func TestIBMSecretsManagerMock(t *testing.T) {
+	t.Parallel()
+	sm := testMockSecretsManager(t)
+	t.Run("secret_group", func(t *testing.T) { testIBMSecretsManagerSecretGroupMock(t, sm) })
+	t.Run("secret", func(t *testing.T) { testIBMSecretsManagerSecretMock(t, sm) })
+	t.Run("rotation_policy", func(t *testing.T) { testIBMSecretsManagerSecretRotationPolicyMock(t, sm) })
+}
+
+func testMockSecretsManager(t *testing.T) *testSecretsManager {
+	if mockserver.ModeFromEnv() != mockserver.ModeStandIn {
+		t.Skip("the Secrets Manager API is only served by the stand-ins")
+	}
+	srv := testMockServer(t)
+	meta := testMockClientSession(t, srv)
+
+	instanceResource := resourceIBMResourceInstance()
+	instance := schema.TestResourceDataRaw(t, instanceResource.Schema, map[string]interface{}{
+		"name":              "mock-secrets-manager",
+		"service":           "secrets-manager",
+		"plan":              "standard",
+		"location":          "us-south",
+		"resource_group_id": "mock-resource-group",
+	})
+	assert.NilError(t, testDiagsErr(instanceResource.CreateContext(context.Background(), instance, meta)))
+	return &testSecretsManager{InstanceID: instance.Get("guid").(string), Meta: meta}
+}
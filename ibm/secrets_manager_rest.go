@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+)
+
+// secretMetadataUpdate is the metadata of a secret sent by updateSecretMetadata,
+// with labels sent even when empty.
+type secretMetadataUpdate struct {
+	secretsmanagerv1.SecretMetadata
+	Labels []string `json:"labels"`
+}
+
+// updateSecretMetadata updates the metadata of a secret like UpdateSecretMetadata,
+// which omits empty labels, the API then keeping the labels of the secret. The
+// labels are always sent, so that all of them can be removed.
+func updateSecretMetadata(ctx context.Context, client *secretsmanagerv1.SecretsManagerV1, secretType, secretID string, metadata secretsmanagerv1.SecretMetadata) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(core.PUT).WithContext(ctx)
+	builder.EnableGzipCompression = client.GetEnableGzipCompression()
+	pathParams := map[string]string{
+		"secret_type": secretType,
+		"id":          secretID,
+	}
+	if _, err := builder.ResolveRequestURL(client.Service.Options.URL, `/api/v1/secrets/{secret_type}/{id}/metadata`, pathParams); err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	builder.AddHeader("Content-Type", "application/json")
+
+	labels := metadata.Labels
+	if labels == nil {
+		labels = []string{}
+	}
+	body := map[string]interface{}{
+		"metadata": &secretsmanagerv1.CollectionMetadata{
+			CollectionType:  core.StringPtr(secretsmanagerv1.CollectionMetadataCollectionTypeApplicationVndIBMSecretsManagerSecretJSONConst),
+			CollectionTotal: core.Int64Ptr(1),
+		},
+		"resources": []secretMetadataUpdate{{SecretMetadata: metadata, Labels: labels}},
+	}
+	if _, err := builder.SetBodyContentJSON(body); err != nil {
+		return nil, err
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return client.Service.Request(request, nil)
+}
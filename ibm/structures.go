@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	b64 "encoding/base64"
 	"encoding/json"
 	"errors"
@@ -19,11 +20,13 @@ import (
 
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/ibm-cos-sdk-go-config/resourceconfigurationv1"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
 	kp "github.com/IBM/keyprotect-go-client"
 	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
 	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
 	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/sl"
@@ -763,6 +766,167 @@ func retentionRuleGet(in *s3.ProtectionConfiguration) []interface{} {
 	return rules
 }
 
+func flattenCosLifecycleRules(in []*cosLifecycleRule) []interface{} {
+	rules := make([]interface{}, 0, len(in))
+	for _, r := range in {
+		rule := map[string]interface{}{
+			"rule_id": aws.StringValue(r.ID),
+			"enable":  aws.StringValue(r.Status) == "Enabled",
+		}
+		if r.Filter != nil {
+			rule["prefix"] = aws.StringValue(r.Filter.Prefix)
+			tags := []*s3.Tag{}
+			if r.Filter.Tag != nil {
+				tags = append(tags, r.Filter.Tag)
+			}
+			if r.Filter.And != nil {
+				rule["prefix"] = aws.StringValue(r.Filter.And.Prefix)
+				tags = append(tags, r.Filter.And.Tags...)
+			}
+			tagList := make([]interface{}, 0, len(tags))
+			for _, tag := range tags {
+				tagList = append(tagList, map[string]interface{}{
+					"key":   aws.StringValue(tag.Key),
+					"value": aws.StringValue(tag.Value),
+				})
+			}
+			rule["tag"] = tagList
+		}
+		for _, t := range r.Transitions {
+			transition := map[string]interface{}{
+				"days":          int(aws.Int64Value(t.Days)),
+				"storage_class": aws.StringValue(t.StorageClass),
+			}
+			if t.Date != nil {
+				transition["date"] = t.Date.UTC().Format(cosLifecycleDateFormat)
+			}
+			rule["transition"] = []interface{}{transition}
+		}
+		if r.Expiration != nil {
+			expiration := map[string]interface{}{
+				"days":                         int(aws.Int64Value(r.Expiration.Days)),
+				"expired_object_delete_marker": aws.BoolValue(r.Expiration.ExpiredObjectDeleteMarker),
+			}
+			if r.Expiration.Date != nil {
+				expiration["date"] = r.Expiration.Date.UTC().Format(cosLifecycleDateFormat)
+			}
+			rule["expiration"] = []interface{}{expiration}
+		}
+		if r.NoncurrentVersionExpiration != nil {
+			rule["noncurrent_version_expiration"] = []interface{}{map[string]interface{}{
+				"noncurrent_days": int(aws.Int64Value(r.NoncurrentVersionExpiration.NoncurrentDays)),
+			}}
+		}
+		if r.AbortIncompleteMultipartUpload != nil {
+			rule["abort_incomplete_multipart_upload"] = []interface{}{map[string]interface{}{
+				"days_after_initiation": int(aws.Int64Value(r.AbortIncompleteMultipartUpload.DaysAfterInitiation)),
+			}}
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func flattenCosReplicationRules(in []*cosReplicationRule) []interface{} {
+	rules := make([]interface{}, 0, len(in))
+	for _, r := range in {
+		rule := map[string]interface{}{
+			"rule_id":  aws.StringValue(r.ID),
+			"enable":   aws.StringValue(r.Status) == "Enabled",
+			"priority": int(aws.Int64Value(r.Priority)),
+		}
+		if r.Filter != nil {
+			rule["prefix"] = aws.StringValue(r.Filter.Prefix)
+		}
+		if r.Destination != nil {
+			rule["destination_bucket_crn"] = aws.StringValue(r.Destination.Bucket)
+		}
+		if r.DeleteMarkerReplication != nil {
+			rule["delete_marker_replication"] = aws.StringValue(r.DeleteMarkerReplication.Status) == "Enabled"
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func flattenCosCORSRules(in []*s3.CORSRule) []interface{} {
+	rules := make([]interface{}, 0, len(in))
+	for _, rule := range in {
+		corsRule := map[string]interface{}{
+			"allowed_headers": flattenStringList(aws.StringValueSlice(rule.AllowedHeaders)),
+			"allowed_methods": flattenStringList(aws.StringValueSlice(rule.AllowedMethods)),
+			"allowed_origins": flattenStringList(aws.StringValueSlice(rule.AllowedOrigins)),
+			"expose_headers":  flattenStringList(aws.StringValueSlice(rule.ExposeHeaders)),
+		}
+		if rule.MaxAgeSeconds != nil {
+			corsRule["max_age_seconds"] = int(*rule.MaxAgeSeconds)
+		}
+		rules = append(rules, corsRule)
+	}
+	return rules
+}
+
+func flattenCosWebsite(in *s3.GetBucketWebsiteOutput) []interface{} {
+	if in.IndexDocument == nil && in.ErrorDocument == nil && in.RedirectAllRequestsTo == nil && len(in.RoutingRules) == 0 {
+		return []interface{}{}
+	}
+	website := map[string]interface{}{}
+	if in.IndexDocument != nil {
+		website["index_document"] = aws.StringValue(in.IndexDocument.Suffix)
+	}
+	if in.ErrorDocument != nil {
+		website["error_document"] = aws.StringValue(in.ErrorDocument.Key)
+	}
+	if in.RedirectAllRequestsTo != nil {
+		website["redirect_all_requests_to"] = []interface{}{map[string]interface{}{
+			"host_name": aws.StringValue(in.RedirectAllRequestsTo.HostName),
+			"protocol":  aws.StringValue(in.RedirectAllRequestsTo.Protocol),
+		}}
+	}
+	rules := make([]interface{}, 0, len(in.RoutingRules))
+	for _, r := range in.RoutingRules {
+		rule := map[string]interface{}{}
+		if r.Condition != nil {
+			rule["condition"] = []interface{}{map[string]interface{}{
+				"http_error_code_returned_equals": aws.StringValue(r.Condition.HttpErrorCodeReturnedEquals),
+				"key_prefix_equals":               aws.StringValue(r.Condition.KeyPrefixEquals),
+			}}
+		}
+		if r.Redirect != nil {
+			rule["redirect"] = []interface{}{map[string]interface{}{
+				"host_name":               aws.StringValue(r.Redirect.HostName),
+				"http_redirect_code":      aws.StringValue(r.Redirect.HttpRedirectCode),
+				"protocol":                aws.StringValue(r.Redirect.Protocol),
+				"replace_key_prefix_with": aws.StringValue(r.Redirect.ReplaceKeyPrefixWith),
+				"replace_key_with":        aws.StringValue(r.Redirect.ReplaceKeyWith),
+			}}
+		}
+		rules = append(rules, rule)
+	}
+	website["routing_rule"] = rules
+	return []interface{}{website}
+}
+
+func flattenCosPublicAccessBlock(in *s3.PublicAccessBlockConfiguration) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+	return []interface{}{map[string]interface{}{
+		"block_public_acls":  aws.BoolValue(in.BlockPublicAcls),
+		"ignore_public_acls": aws.BoolValue(in.IgnorePublicAcls),
+	}}
+}
+
+// flattenCosBucketACL returns the canned ACL matching the grants of a bucket.
+func flattenCosBucketACL(grants []*s3.Grant) string {
+	for _, grant := range grants {
+		if grant.Grantee != nil && aws.StringValue(grant.Grantee.URI) == "http://acs.amazonaws.com/groups/global/AllUsers" && aws.StringValue(grant.Permission) == s3.PermissionRead {
+			return "public-read"
+		}
+	}
+	return "private"
+}
+
 func flattenCosObejctVersioning(in *s3.GetBucketVersioningOutput) []interface{} {
 	out, err := json.Marshal(in)
 	if err != nil {
@@ -1886,6 +2050,16 @@ func GetTagsUsingCRN(meta interface{}, resourceCRN string) (*schema.Set, error)
 	return newStringSet(resourceIBMVPCHash, taglist), nil
 }
 
+// tagsWarning returns the warning reported when the tags of a resource could not be
+// updated, the resource itself being created or updated.
+func tagsWarning(err error) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "Error updating tags",
+		Detail:   err.Error(),
+	}
+}
+
 func UpdateTagsUsingCRN(oldList, newList interface{}, meta interface{}, resourceCRN string) error {
 	gtClient, err := meta.(ClientSession).GlobalTaggingAPI()
 	if err != nil {
@@ -1978,6 +2152,114 @@ func resourceTagsCustomizeDiff(diff *schema.ResourceDiff) error {
 	return nil
 }
 
+// resourceWithDefaultTags attaches the provider default_tags to a resource managing its
+// tags through the global tagging service. The default tags are kept out of the tags
+// attribute so they never show as drift, and the computed tags_all attribute holds
+// the effective tags of the resource.
+func resourceWithDefaultTags(r *schema.Resource) *schema.Resource {
+	tagsSet := r.Schema["tags"].Set
+	if tagsSet == nil {
+		tagsSet = schema.HashString
+	}
+	r.Schema["tags_all"] = &schema.Schema{
+		Type:        schema.TypeSet,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Set:         tagsSet,
+		Description: "List of tags, including the provider default tags",
+	}
+
+	customizeDiff := r.CustomizeDiff
+	r.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(ctx, diff, meta); err != nil {
+				return err
+			}
+		}
+		return defaultTagsCustomizeDiff(diff, meta)
+	}
+
+	if create := r.CreateContext; create != nil {
+		r.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			configured := mergeDefaultTags(d, meta)
+			diags := create(ctx, d, meta)
+			splitDefaultTags(d, meta, configured)
+			return diags
+		}
+	}
+	if read := r.ReadContext; read != nil {
+		r.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			configured := d.Get("tags").(*schema.Set)
+			diags := read(ctx, d, meta)
+			splitDefaultTags(d, meta, configured)
+			return diags
+		}
+	}
+	if update := r.UpdateContext; update != nil {
+		r.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			configured := d.Get("tags").(*schema.Set)
+			if d.HasChange("tags") || d.HasChange("tags_all") {
+				mergeDefaultTags(d, meta)
+			}
+			diags := update(ctx, d, meta)
+			splitDefaultTags(d, meta, configured)
+			return diags
+		}
+	}
+	return r
+}
+
+// defaultTags returns the provider default_tags
+func defaultTags(meta interface{}) []string {
+	if sess, ok := meta.(ClientSession); ok {
+		return sess.DefaultTags()
+	}
+	return nil
+}
+
+// providerTags returns the tags attached by the provider rather than by the resource
+// configuration: the provider default_tags and the IC_ENV_TAGS environment tags.
+func providerTags(meta interface{}) []string {
+	tags := defaultTags(meta)
+	if v := os.Getenv("IC_ENV_TAGS"); v != "" {
+		tags = append(tags, strings.Split(v, ",")...)
+	}
+	return tags
+}
+
+// mergeDefaultTags adds the provider default tags to the tags of the resource so the
+// resource attaches them along with its own tags, and returns the configured tags.
+func mergeDefaultTags(d *schema.ResourceData, meta interface{}) *schema.Set {
+	configured := d.Get("tags").(*schema.Set)
+	tags := defaultTags(meta)
+	if len(tags) == 0 {
+		return configured
+	}
+	merged := configured.Union(newStringSet(configured.F, tags))
+	d.Set("tags", merged)
+	return configured
+}
+
+// splitDefaultTags records every tag of the resource in tags_all and removes from tags
+// the provider tags which are not part of the configured tags.
+func splitDefaultTags(d *schema.ResourceData, meta interface{}, configured *schema.Set) {
+	if d.Id() == "" {
+		return
+	}
+	all := d.Get("tags").(*schema.Set)
+	d.Set("tags_all", all)
+	provided := newStringSet(all.F, providerTags(meta)).Difference(configured)
+	d.Set("tags", all.Difference(provided))
+}
+
+func defaultTagsCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("tags") {
+		return diff.SetNewComputed("tags_all")
+	}
+	tags := diff.Get("tags").(*schema.Set)
+	return diff.SetNew("tags_all", tags.Union(newStringSet(tags.F, providerTags(meta))))
+}
+
 func resourceVolumeAttachmentValidate(diff *schema.ResourceDiff) error {
 
 	if volsintf, ok := diff.GetOk("volume_attachments"); ok {
@@ -2193,37 +2475,6 @@ func flatterSatelliteZones(zones *schema.Set) []string {
 	return zoneList
 }
 
-// error object
-type ServiceErrorResponse struct {
-	Message    string
-	StatusCode int
-	Result     interface{}
-}
-
-func beautifyError(err error, response *core.DetailedResponse) *ServiceErrorResponse {
-	var (
-		statusCode int
-		result     interface{}
-	)
-	if response != nil {
-		statusCode = response.StatusCode
-		result = response.Result
-	}
-	return &ServiceErrorResponse{
-		Message:    err.Error(),
-		StatusCode: statusCode,
-		Result:     result,
-	}
-}
-
-func (response *ServiceErrorResponse) String() string {
-	output, err := json.MarshalIndent(response, "", "    ")
-	if err == nil {
-		return fmt.Sprintf("%+v\n", string(output))
-	}
-	return fmt.Sprintf("Error : %#v", response)
-}
-
 // IAM Policy Management
 func getResourceAttribute(name string, r iampolicymanagementv1.PolicyResource) *string {
 	for _, a := range r.Attributes {
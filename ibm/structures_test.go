@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gotest.tools/assert"
+)
+
+func testTaggedResource(attached *[]string) *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			d.SetId("crn:v1:test")
+			*attached = expandStringList(d.Get("tags").(*schema.Set).List())
+			return nil
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			d.Set("tags", newStringSet(resourceIBMVPCHash, *attached))
+			return nil
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			*attached = expandStringList(d.Get("tags").(*schema.Set).List())
+			return nil
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      resourceIBMVPCHash,
+			},
+		},
+	}
+}
+
+func sortedTags(v interface{}) []string {
+	tags := expandStringList(v.(*schema.Set).List())
+	sort.Strings(tags)
+	return tags
+}
+
+func TestResourceWithDefaultTags(t *testing.T) {
+	var attached []string
+	r := resourceWithDefaultTags(testTaggedResource(&attached))
+	meta := clientSession{defaultTags: []string{"owner:team", "env:dev"}}
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"tags": []interface{}{"app:web", "env:dev"},
+	})
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), d, meta)))
+	sort.Strings(attached)
+	assert.DeepEqual(t, attached, []string{"app:web", "env:dev", "owner:team"})
+	assert.DeepEqual(t, sortedTags(d.Get("tags")), []string{"app:web", "env:dev"})
+	assert.DeepEqual(t, sortedTags(d.Get("tags_all")), []string{"app:web", "env:dev", "owner:team"})
+
+	// An imported resource has no configured tags, every default tag is left out of tags
+	imported := r.TestResourceData()
+	imported.SetId("crn:v1:test")
+	assert.NilError(t, testDiagsErr(r.ReadContext(context.Background(), imported, meta)))
+	assert.DeepEqual(t, sortedTags(imported.Get("tags")), []string{"app:web"})
+	assert.DeepEqual(t, sortedTags(imported.Get("tags_all")), []string{"app:web", "env:dev", "owner:team"})
+}
+
+func TestResourceWithDefaultTagsNoDefaults(t *testing.T) {
+	var attached []string
+	r := resourceWithDefaultTags(testTaggedResource(&attached))
+	meta := clientSession{}
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"tags": []interface{}{"app:web"},
+	})
+	assert.NilError(t, testDiagsErr(r.CreateContext(context.Background(), d, meta)))
+	assert.DeepEqual(t, attached, []string{"app:web"})
+	assert.DeepEqual(t, sortedTags(d.Get("tags")), []string{"app:web"})
+	assert.DeepEqual(t, sortedTags(d.Get("tags_all")), []string{"app:web"})
+}
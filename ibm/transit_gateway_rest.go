@@ -0,0 +1,286 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+)
+
+// transitGatewayRequestOptions describes a request to the Transit Gateway API sent by
+// transitGatewayRequest. The path parameters are escaped into the path, such as
+// /transit_gateways/{transit_gateway_id}/connections/{id}.
+type transitGatewayRequestOptions struct {
+	Method     string
+	Path       string
+	PathParams map[string]string
+	Body       interface{}
+}
+
+// transitGatewayRequest sends a request to the Transit Gateway API with the URL, the
+// authenticator and the API version of the client, for the GRE tunnel connections,
+// prefix filters and route reports the networking-go-sdk doesn't offer yet. The JSON
+// response is decoded into result when it is not nil.
+func transitGatewayRequest(client *transitgatewayapisv1.TransitGatewayApisV1, options transitGatewayRequestOptions, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(options.Method)
+	if _, err := builder.ResolveRequestURL(client.Service.Options.URL, options.Path, options.PathParams); err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	if client.Version != nil {
+		builder.AddQuery("version", fmt.Sprint(*client.Version))
+	}
+	if options.Body != nil {
+		if _, err := builder.SetBodyContentJSON(options.Body); err != nil {
+			return nil, err
+		}
+		builder.AddHeader("Content-Type", "application/json")
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return client.Service.Request(request, result)
+}
+
+// tgZoneReference is the zone of the transit gateway end of a GRE tunnel.
+type tgZoneReference struct {
+	Name string `json:"name"`
+}
+
+// tgConnection is a connection of a transit gateway to a network, or a GRE tunnel
+// carried by a classic or Power Virtual Server connection (gre_tunnel) or network
+// (unbound_gre_tunnel), /transit_gateways/{transit_gateway_id}/connections/{id}.
+type tgConnection struct {
+	ID                   string           `json:"id,omitempty"`
+	Name                 string           `json:"name,omitempty"`
+	NetworkType          string           `json:"network_type,omitempty"`
+	NetworkID            string           `json:"network_id,omitempty"`
+	NetworkAccountID     string           `json:"network_account_id,omitempty"`
+	PrefixFiltersDefault string           `json:"prefix_filters_default,omitempty"`
+	BaseConnectionID     string           `json:"base_connection_id,omitempty"`
+	BaseNetworkType      string           `json:"base_network_type,omitempty"`
+	LocalGatewayIP       string           `json:"local_gateway_ip,omitempty"`
+	LocalTunnelIP        string           `json:"local_tunnel_ip,omitempty"`
+	RemoteGatewayIP      string           `json:"remote_gateway_ip,omitempty"`
+	RemoteTunnelIP       string           `json:"remote_tunnel_ip,omitempty"`
+	RemoteBgpASN         int64            `json:"remote_bgp_asn,omitempty"`
+	LocalBgpASN          int64            `json:"local_bgp_asn,omitempty"`
+	Mtu                  int64            `json:"mtu,omitempty"`
+	Zone                 *tgZoneReference `json:"zone,omitempty"`
+	Status               string           `json:"status,omitempty"`
+	RequestStatus        string           `json:"request_status,omitempty"`
+	CreatedAt            string           `json:"created_at,omitempty"`
+	UpdatedAt            string           `json:"updated_at,omitempty"`
+}
+
+// tgPrefixFilter permits or denies the routes of a connection matching its prefix,
+// /transit_gateways/{transit_gateway_id}/connections/{id}/prefix_filters/{filter_id}.
+// Without ge and le it matches the prefix exactly, otherwise the prefixes it contains
+// whose length is within ge and le. The filters of a connection are applied in order,
+// each filter being before the filter whose ID is in before, and the routes matching
+// no filter get the prefix_filters_default action of the connection.
+type tgPrefixFilter struct {
+	ID        string `json:"id,omitempty"`
+	Action    string `json:"action"`
+	Prefix    string `json:"prefix"`
+	Ge        int64  `json:"ge,omitempty"`
+	Le        int64  `json:"le,omitempty"`
+	Before    string `json:"before,omitempty"`
+	CreatedAt string `json:"created_at,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+// tgRouteReport is a report of the routes of the connections of a transit gateway,
+// /transit_gateways/{transit_gateway_id}/route_reports/{id}. It is generated
+// asynchronously, its status being pending until it is complete.
+type tgRouteReport struct {
+	ID                string                           `json:"id"`
+	Status            string                           `json:"status"`
+	Connections       []tgRouteReportConnection        `json:"connections"`
+	OverlappingRoutes []tgRouteReportOverlappingRoutes `json:"overlapping_routes"`
+	CreatedAt         string                           `json:"created_at"`
+	UpdatedAt         string                           `json:"updated_at"`
+}
+
+type tgRouteReportConnection struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Routes []struct {
+		Prefix string `json:"prefix"`
+	} `json:"routes"`
+	Bgps []struct {
+		AsPath          string `json:"as_path"`
+		IsUsed          bool   `json:"is_used"`
+		LocalPreference string `json:"local_preference"`
+		Prefix          string `json:"prefix"`
+	} `json:"bgps"`
+}
+
+type tgRouteReportOverlappingRoutes struct {
+	Routes []struct {
+		ConnectionID string `json:"connection_id"`
+		Prefix       string `json:"prefix"`
+	} `json:"routes"`
+}
+
+func createTGConnection(client *transitgatewayapisv1.TransitGatewayApisV1, gatewayID string, connection tgConnection) (*tgConnection, *core.DetailedResponse, error) {
+	result := &tgConnection{}
+	options := transitGatewayRequestOptions{
+		Method:     core.POST,
+		Path:       "/transit_gateways/{transit_gateway_id}/connections",
+		PathParams: map[string]string{"transit_gateway_id": gatewayID},
+		Body:       connection,
+	}
+	response, err := transitGatewayRequest(client, options, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+func getTGConnection(client *transitgatewayapisv1.TransitGatewayApisV1, gatewayID, connectionID string) (*tgConnection, *core.DetailedResponse, error) {
+	result := &tgConnection{}
+	options := transitGatewayRequestOptions{
+		Method:     core.GET,
+		Path:       "/transit_gateways/{transit_gateway_id}/connections/{id}",
+		PathParams: map[string]string{"transit_gateway_id": gatewayID, "id": connectionID},
+	}
+	response, err := transitGatewayRequest(client, options, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+// updateTGConnection patches the name or prefix_filters_default fields of the
+// connection set in patch.
+func updateTGConnection(client *transitgatewayapisv1.TransitGatewayApisV1, gatewayID, connectionID string, patch map[string]interface{}) (*tgConnection, *core.DetailedResponse, error) {
+	result := &tgConnection{}
+	options := transitGatewayRequestOptions{
+		Method:     core.PATCH,
+		Path:       "/transit_gateways/{transit_gateway_id}/connections/{id}",
+		PathParams: map[string]string{"transit_gateway_id": gatewayID, "id": connectionID},
+		Body:       patch,
+	}
+	response, err := transitGatewayRequest(client, options, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+func createTGPrefixFilter(client *transitgatewayapisv1.TransitGatewayApisV1, gatewayID, connectionID string, filter tgPrefixFilter) (*tgPrefixFilter, *core.DetailedResponse, error) {
+	result := &tgPrefixFilter{}
+	options := transitGatewayRequestOptions{
+		Method:     core.POST,
+		Path:       "/transit_gateways/{transit_gateway_id}/connections/{id}/prefix_filters",
+		PathParams: map[string]string{"transit_gateway_id": gatewayID, "id": connectionID},
+		Body:       filter,
+	}
+	response, err := transitGatewayRequest(client, options, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+func listTGPrefixFilters(client *transitgatewayapisv1.TransitGatewayApisV1, gatewayID, connectionID string) ([]tgPrefixFilter, *core.DetailedResponse, error) {
+	result := &struct {
+		PrefixFilters []tgPrefixFilter `json:"prefix_filters"`
+	}{}
+	options := transitGatewayRequestOptions{
+		Method:     core.GET,
+		Path:       "/transit_gateways/{transit_gateway_id}/connections/{id}/prefix_filters",
+		PathParams: map[string]string{"transit_gateway_id": gatewayID, "id": connectionID},
+	}
+	response, err := transitGatewayRequest(client, options, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result.PrefixFilters, response, nil
+}
+
+func getTGPrefixFilter(client *transitgatewayapisv1.TransitGatewayApisV1, gatewayID, connectionID, filterID string) (*tgPrefixFilter, *core.DetailedResponse, error) {
+	result := &tgPrefixFilter{}
+	options := transitGatewayRequestOptions{
+		Method: core.GET,
+		Path:   "/transit_gateways/{transit_gateway_id}/connections/{id}/prefix_filters/{filter_id}",
+		PathParams: map[string]string{
+			"transit_gateway_id": gatewayID,
+			"id":                 connectionID,
+			"filter_id":          filterID,
+		},
+	}
+	response, err := transitGatewayRequest(client, options, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+// updateTGPrefixFilter patches the action, prefix, ge, le or before fields of the
+// prefix filter set in patch. A zero ge or le removes the bound.
+func updateTGPrefixFilter(client *transitgatewayapisv1.TransitGatewayApisV1, gatewayID, connectionID, filterID string, patch map[string]interface{}) (*tgPrefixFilter, *core.DetailedResponse, error) {
+	result := &tgPrefixFilter{}
+	options := transitGatewayRequestOptions{
+		Method: core.PATCH,
+		Path:   "/transit_gateways/{transit_gateway_id}/connections/{id}/prefix_filters/{filter_id}",
+		PathParams: map[string]string{
+			"transit_gateway_id": gatewayID,
+			"id":                 connectionID,
+			"filter_id":          filterID,
+		},
+		Body: patch,
+	}
+	response, err := transitGatewayRequest(client, options, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+func deleteTGPrefixFilter(client *transitgatewayapisv1.TransitGatewayApisV1, gatewayID, connectionID, filterID string) (*core.DetailedResponse, error) {
+	options := transitGatewayRequestOptions{
+		Method: core.DELETE,
+		Path:   "/transit_gateways/{transit_gateway_id}/connections/{id}/prefix_filters/{filter_id}",
+		PathParams: map[string]string{
+			"transit_gateway_id": gatewayID,
+			"id":                 connectionID,
+			"filter_id":          filterID,
+		},
+	}
+	return transitGatewayRequest(client, options, nil)
+}
+
+func createTGRouteReport(client *transitgatewayapisv1.TransitGatewayApisV1, gatewayID string) (*tgRouteReport, *core.DetailedResponse, error) {
+	result := &tgRouteReport{}
+	options := transitGatewayRequestOptions{
+		Method:     core.POST,
+		Path:       "/transit_gateways/{transit_gateway_id}/route_reports",
+		PathParams: map[string]string{"transit_gateway_id": gatewayID},
+	}
+	response, err := transitGatewayRequest(client, options, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+func getTGRouteReport(client *transitgatewayapisv1.TransitGatewayApisV1, gatewayID, reportID string) (*tgRouteReport, *core.DetailedResponse, error) {
+	result := &tgRouteReport{}
+	options := transitGatewayRequestOptions{
+		Method:     core.GET,
+		Path:       "/transit_gateways/{transit_gateway_id}/route_reports/{id}",
+		PathParams: map[string]string{"transit_gateway_id": gatewayID, "id": reportID},
+	}
+	response, err := transitGatewayRequest(client, options, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
@@ -12,6 +12,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -1016,6 +1017,17 @@ func validateAllowedRangeInt(start, end int) schema.SchemaValidateFunc {
 	}
 }
 
+// cosLifecycleDateFormat is the format of the dates of the COS lifecycle rules.
+const cosLifecycleDateFormat = "2006-01-02"
+
+func validateCOSLifecycleDate(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := time.Parse(cosLifecycleDateFormat, v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a date in YYYY-MM-DD format, got %s", k, v.(string)))
+	}
+	return
+}
+
 func validateDeadPeerDetectionTimeout(v interface{}, k string) (ws []string, errors []error) {
 	secs := v.(int)
 	if secs < 15 || secs > 86399 {
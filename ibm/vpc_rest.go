@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+// The delay before the first refresh and the minimum interval between refreshes of
+// the waits of the instances, volumes, snapshots and network interfaces. The mock
+// tests set them to zero.
+var (
+	vpcWaitDelay      = 10 * time.Second
+	vpcWaitMinTimeout = 10 * time.Second
+)
+
+// vpcRequestOptions describes a request to the VPC API sent by vpcRequest. The path
+// parameters are escaped into the path, such as /snapshots/{id}.
+type vpcRequestOptions struct {
+	Method     string
+	Path       string
+	PathParams map[string]string
+	Query      map[string]string
+	Body       interface{}
+}
+
+// vpcRequest sends a request to the VPC API for the operations the vpc-go-sdk
+// doesn't offer yet, with the URL, authenticator and version of the client. The
+// body is sent as JSON, as a JSON merge patch for PATCH, and the JSON response is
+// decoded into result when it is not nil. Only generation 2 is supported.
+func vpcRequest(sess *vpcv1.VpcV1, options vpcRequestOptions, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(options.Method)
+	builder.EnableGzipCompression = sess.GetEnableGzipCompression()
+	if _, err := builder.ResolveRequestURL(sess.Service.Options.URL, options.Path, options.PathParams); err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	builder.AddQuery("version", *sess.Version)
+	builder.AddQuery("generation", "2")
+	for k, v := range options.Query {
+		builder.AddQuery(k, v)
+	}
+	if options.Body != nil {
+		if _, err := builder.SetBodyContentJSON(options.Body); err != nil {
+			return nil, err
+		}
+		if options.Method == core.PATCH {
+			builder.AddHeader("Content-Type", "application/merge-patch+json")
+		} else {
+			builder.AddHeader("Content-Type", "application/json")
+		}
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return sess.Service.Request(request, result)
+}
+
+// vpcReference is the reference to a VPC resource embedded by the API.
+type vpcReference struct {
+	CRN  string `json:"crn,omitempty"`
+	Href string `json:"href,omitempty"`
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// vpcSnapshot is a snapshot of a block storage volume, /snapshots/{id}.
+type vpcSnapshot struct {
+	ID              string        `json:"id"`
+	CRN             string        `json:"crn"`
+	Href            string        `json:"href"`
+	Name            string        `json:"name"`
+	Bootable        bool          `json:"bootable"`
+	Deletable       bool          `json:"deletable"`
+	Encryption      string        `json:"encryption"`
+	EncryptionKey   *vpcReference `json:"encryption_key,omitempty"`
+	LifecycleState  string        `json:"lifecycle_state"`
+	MinimumCapacity int64         `json:"minimum_capacity"`
+	Size            int64         `json:"size"`
+	OperatingSystem *vpcReference `json:"operating_system,omitempty"`
+	ResourceGroup   vpcReference  `json:"resource_group"`
+	ResourceType    string        `json:"resource_type"`
+	SourceImage     *vpcReference `json:"source_image,omitempty"`
+	SourceVolume    vpcReference  `json:"source_volume"`
+	CreatedAt       string        `json:"created_at"`
+}
+
+// vpcSnapshotCollection is a page of snapshots, /snapshots.
+type vpcSnapshotCollection struct {
+	Snapshots []vpcSnapshot `json:"snapshots"`
+	Next      *vpcReference `json:"next,omitempty"`
+}
+
+// vpcSnapshotPrototype creates a snapshot of the source volume.
+type vpcSnapshotPrototype struct {
+	Name          string        `json:"name,omitempty"`
+	SourceVolume  vpcReference  `json:"source_volume"`
+	ResourceGroup *vpcReference `json:"resource_group,omitempty"`
+}